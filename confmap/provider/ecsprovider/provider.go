@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ecsprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/ecsprovider"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
+)
+
+const (
+	schemeName = "ecs"
+	// labelPrefix is the prefix of the keys referencing the labels of the Collector container.
+	labelPrefix = "label:"
+)
+
+// taskValues are the values of the task metadata which can be referenced.
+var taskValues = map[string]func(*ecsutil.TaskMetadata) string{
+	"cluster":           func(tm *ecsutil.TaskMetadata) string { return tm.Cluster },
+	"task_arn":          func(tm *ecsutil.TaskMetadata) string { return tm.TaskARN },
+	"task_id":           func(tm *ecsutil.TaskMetadata) string { return tm.TaskARN[strings.LastIndex(tm.TaskARN, "/")+1:] },
+	"family":            func(tm *ecsutil.TaskMetadata) string { return tm.Family },
+	"revision":          func(tm *ecsutil.TaskMetadata) string { return tm.Revision },
+	"service_name":      func(tm *ecsutil.TaskMetadata) string { return tm.ServiceName },
+	"availability_zone": func(tm *ecsutil.TaskMetadata) string { return tm.AvailabilityZone },
+	"launch_type":       func(tm *ecsutil.TaskMetadata) string { return tm.LaunchType },
+}
+
+// containerValues are the values of the metadata of the Collector container which can be referenced.
+var containerValues = map[string]func(*ecsutil.ContainerMetadata) string{
+	"container_name": func(cm *ecsutil.ContainerMetadata) string { return cm.ContainerName },
+	"container_arn":  func(cm *ecsutil.ContainerMetadata) string { return cm.ContainerARN },
+	"docker_id":      func(cm *ecsutil.ContainerMetadata) string { return cm.DockerID },
+	"image":          func(cm *ecsutil.ContainerMetadata) string { return cm.Image },
+}
+
+type provider struct {
+	newMetadataProvider func() (ecsutil.MetadataProvider, error)
+
+	mu        sync.Mutex
+	metadata  ecsutil.MetadataProvider
+	task      *ecsutil.TaskMetadata
+	container *ecsutil.ContainerMetadata
+}
+
+// New returns a new confmap.Provider that reads values from the ECS task metadata endpoint.
+//
+// This Provider supports "ecs" scheme, and can be called with a "uri" that follows:
+//
+//	ecs-uri : ecs:KEY
+//
+// where KEY is one of cluster, task_arn, task_id, family, revision, service_name,
+// availability_zone, launch_type, container_name, container_arn, docker_id, image,
+// or label:NAME for the label NAME of the Collector container.
+//
+// The metadata is fetched once, on the first call of Retrieve, from the endpoint set by the
+// ECS agent in the ECS_CONTAINER_METADATA_URI_V4 or ECS_CONTAINER_METADATA_URI environment variable.
+//
+// Examples:
+// `ecs:cluster` - (unix, windows)
+// `ecs:label:com.amazonaws.ecs.task-definition-family` - (unix, windows)
+func New() confmap.Provider {
+	return &provider{newMetadataProvider: func() (ecsutil.MetadataProvider, error) {
+		return ecsutil.NewDetectedTaskMetadataProvider(component.TelemetrySettings{Logger: zap.NewNop()})
+	}}
+}
+
+func (p *provider) Retrieve(_ context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+	key := uri[len(schemeName)+1:]
+
+	if value, ok := taskValues[key]; ok {
+		tm, err := p.taskMetadata()
+		if err != nil {
+			return nil, err
+		}
+		return confmap.NewRetrieved(value(tm))
+	}
+
+	if value, ok := containerValues[key]; ok {
+		cm, err := p.containerMetadata()
+		if err != nil {
+			return nil, err
+		}
+		return confmap.NewRetrieved(value(cm))
+	}
+
+	if strings.HasPrefix(key, labelPrefix) {
+		label := strings.TrimPrefix(key, labelPrefix)
+		cm, err := p.containerMetadata()
+		if err != nil {
+			return nil, err
+		}
+		value, ok := cm.Labels[label]
+		if !ok {
+			return nil, fmt.Errorf("label %q not found on the ECS container", label)
+		}
+		return confmap.NewRetrieved(value)
+	}
+
+	return nil, fmt.Errorf("%q uri references an unknown ECS metadata key %q", uri, key)
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (*provider) Shutdown(context.Context) error {
+	return nil
+}
+
+// metadataProvider returns the metadata provider, creating it in the first call.
+// It must be called with the lock held.
+func (p *provider) metadataProvider() (ecsutil.MetadataProvider, error) {
+	if p.metadata == nil {
+		mp, err := p.newMetadataProvider()
+		if err != nil {
+			return nil, fmt.Errorf("failed to detect the ECS task metadata endpoint: %w", err)
+		}
+		p.metadata = mp
+	}
+	return p.metadata, nil
+}
+
+// taskMetadata returns the metadata of the task, fetching it in the first call.
+func (p *provider) taskMetadata() (*ecsutil.TaskMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.task != nil {
+		return p.task, nil
+	}
+	mp, err := p.metadataProvider()
+	if err != nil {
+		return nil, err
+	}
+	tm, err := mp.FetchTaskMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the ECS task metadata: %w", err)
+	}
+	p.task = tm
+	return tm, nil
+}
+
+// containerMetadata returns the metadata of the Collector container, fetching it in the first call.
+func (p *provider) containerMetadata() (*ecsutil.ContainerMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.container != nil {
+		return p.container, nil
+	}
+	mp, err := p.metadataProvider()
+	if err != nil {
+		return nil, err
+	}
+	cm, err := mp.FetchContainerMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the ECS container metadata: %w", err)
+	}
+	p.container = cm
+	return cm, nil
+}
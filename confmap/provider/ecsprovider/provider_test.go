@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ecsprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil/ecsutiltest"
+)
+
+type testRestClient struct {
+	t     *testing.T
+	calls int
+}
+
+func (c *testRestClient) GetResponse(path string) ([]byte, error) {
+	c.calls++
+	return ecsutiltest.GetTestdataResponseByPath(c.t, path)
+}
+
+// Create a provider reading the task metadata from the testdata of ecsutil
+func newTestProvider(t *testing.T) (confmap.Provider, *testRestClient) {
+	client := &testRestClient{t: t}
+	return &provider{newMetadataProvider: func() (ecsutil.MetadataProvider, error) {
+		return ecsutil.NewTaskMetadataProvider(client, zap.NewNop()), nil
+	}}, client
+}
+
+func TestRetrieve(t *testing.T) {
+	tests := []struct {
+		uri      string
+		expected string
+	}{
+		{uri: "ecs:cluster", expected: "test200"},
+		{uri: "ecs:task_arn", expected: "arn:aws:ecs:us-west-2:803860917211:task/test200/d22aaa11bf0e4ab19c2c940a1cbabbee"},
+		{uri: "ecs:task_id", expected: "d22aaa11bf0e4ab19c2c940a1cbabbee"},
+		{uri: "ecs:family", expected: "three-nginx"},
+		{uri: "ecs:revision", expected: "1"},
+		{uri: "ecs:availability_zone", expected: "us-west-2a"},
+		{uri: "ecs:launch_type", expected: "ec2"},
+		{uri: "ecs:container_name", expected: "an-image"},
+		{uri: "ecs:container_arn", expected: "arn:aws:ecs:us-east-1:123456789123:an-image/123"},
+		{uri: "ecs:docker_id", expected: "325c979aea914acd93be2fdd2429e1d9-3811061257"},
+		{uri: "ecs:image", expected: "an-image"},
+		{uri: "ecs:label:com.amazonaws.ecs.task-definition-family", expected: "a-task"},
+	}
+	p, _ := newTestProvider(t)
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			ret, err := p.Retrieve(context.Background(), tt.uri, nil)
+			require.NoError(t, err)
+			raw, err := ret.AsRaw()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, raw)
+		})
+	}
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestRetrieveFetchesMetadataOnce(t *testing.T) {
+	p, client := newTestProvider(t)
+	for _, uri := range []string{"ecs:cluster", "ecs:family", "ecs:container_name", "ecs:image"} {
+		_, err := p.Retrieve(context.Background(), uri, nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, client.calls)
+}
+
+func TestRetrieveErrors(t *testing.T) {
+	p, _ := newTestProvider(t)
+
+	_, err := p.Retrieve(context.Background(), "env:cluster", nil)
+	assert.EqualError(t, err, `"env:cluster" uri is not supported by "ecs" provider`)
+
+	_, err = p.Retrieve(context.Background(), "ecs:unknown", nil)
+	assert.EqualError(t, err, `"ecs:unknown" uri references an unknown ECS metadata key "unknown"`)
+
+	_, err = p.Retrieve(context.Background(), "ecs:label:missing", nil)
+	assert.EqualError(t, err, `label "missing" not found on the ECS container`)
+}
+
+func TestRetrieveUndetectedEndpoint(t *testing.T) {
+	p := &provider{newMetadataProvider: func() (ecsutil.MetadataProvider, error) {
+		return nil, errors.New("unable to detect task metadata endpoint")
+	}}
+	_, err := p.Retrieve(context.Background(), "ecs:cluster", nil)
+	assert.EqualError(t, err, "failed to detect the ECS task metadata endpoint: unable to detect task metadata endpoint")
+}
+
+func TestScheme(t *testing.T) {
+	assert.Equal(t, "ecs", New().Scheme())
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataqualityconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/dataqualityconnector"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config for the connector
+type Config struct {
+	// SourceAttribute is the resource attribute identifying the source of the telemetry,
+	// compliance metrics are emitted for each of its values.
+	SourceAttribute string `mapstructure:"source_attribute"`
+	// RequiredResourceAttributes are the resource attributes which must be present
+	// and non-empty on the resource of every item.
+	RequiredResourceAttributes []string `mapstructure:"required_resource_attributes"`
+	// Timestamps configures the range of timestamps considered sane.
+	Timestamps TimestampsConfig `mapstructure:"timestamps"`
+	// RequireSeverity requires log records to have a severity number or text.
+	RequireSeverity bool `mapstructure:"require_severity"`
+}
+
+// TimestampsConfig configures the range of timestamps considered sane,
+// relative to the time the telemetry is evaluated. Unset timestamps are never sane.
+type TimestampsConfig struct {
+	// MaxAge is how far in the past a timestamp may be, 0 means no limit.
+	MaxAge time.Duration `mapstructure:"max_age"`
+	// MaxFutureSkew is how far in the future a timestamp may be.
+	MaxFutureSkew time.Duration `mapstructure:"max_future_skew"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid
+func (c *Config) Validate() error {
+	if c.SourceAttribute == "" {
+		return errors.New("source_attribute must not be empty")
+	}
+	for _, attr := range c.RequiredResourceAttributes {
+		if attr == "" {
+			return errors.New("required_resource_attributes must not contain empty attribute names")
+		}
+	}
+	if c.Timestamps.MaxAge < 0 {
+		return errors.New("timestamps::max_age must not be negative")
+	}
+	if c.Timestamps.MaxFutureSkew < 0 {
+		return errors.New("timestamps::max_future_skew must not be negative")
+	}
+	return nil
+}
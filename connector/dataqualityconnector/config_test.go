@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataqualityconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/dataqualityconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect *Config
+	}{
+		{
+			name: "",
+			expect: &Config{
+				SourceAttribute:            "service.name",
+				RequiredResourceAttributes: []string{"service.name"},
+				Timestamps: TimestampsConfig{
+					MaxAge:        24 * time.Hour,
+					MaxFutureSkew: 5 * time.Minute,
+				},
+				RequireSeverity: true,
+			},
+		},
+		{
+			name: "custom",
+			expect: &Config{
+				SourceAttribute:            "k8s.namespace.name",
+				RequiredResourceAttributes: []string{"service.name", "service.version", "deployment.environment"},
+				Timestamps: TimestampsConfig{
+					MaxAge:        time.Hour,
+					MaxFutureSkew: time.Minute,
+				},
+				RequireSeverity: false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mutate func(*Config)
+		expect string
+	}{
+		{
+			name:   "no_source_attribute",
+			mutate: func(cfg *Config) { cfg.SourceAttribute = "" },
+			expect: "source_attribute must not be empty",
+		},
+		{
+			name:   "empty_required_resource_attribute",
+			mutate: func(cfg *Config) { cfg.RequiredResourceAttributes = []string{"service.name", ""} },
+			expect: "required_resource_attributes must not contain empty attribute names",
+		},
+		{
+			name:   "negative_max_age",
+			mutate: func(cfg *Config) { cfg.Timestamps.MaxAge = -time.Second },
+			expect: "timestamps::max_age must not be negative",
+		},
+		{
+			name:   "negative_max_future_skew",
+			mutate: func(cfg *Config) { cfg.Timestamps.MaxFutureSkew = -time.Second },
+			expect: "timestamps::max_future_skew must not be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tc.mutate(cfg)
+			err := cfg.Validate()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expect)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataqualityconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/dataqualityconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	signalTraces  = "traces"
+	signalMetrics = "metrics"
+	signalLogs    = "logs"
+
+	checkResourceAttributes = "resource_attributes"
+	checkTimestamp          = "timestamp"
+	checkSeverity           = "severity"
+)
+
+// dataQuality evaluates spans, metric data points and log records against the
+// configured expectations, and emits compliance metrics onto a metrics pipeline.
+type dataQuality struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	config          *Config
+	metricsConsumer consumer.Metrics
+	now             func() time.Time
+}
+
+func newDataQuality(cfg *Config, nextConsumer consumer.Metrics) *dataQuality {
+	return &dataQuality{
+		config:          cfg,
+		metricsConsumer: nextConsumer,
+		now:             time.Now,
+	}
+}
+
+func (c *dataQuality) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *dataQuality) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	now := c.now()
+	r := newReport(signalTraces)
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		stats := r.source(rs.Resource().Attributes(), c.config.SourceAttribute)
+		resourceOK := c.hasRequiredResourceAttributes(rs.Resource().Attributes())
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				timestampOK := c.isSaneTimestamp(span.StartTimestamp(), now) &&
+					c.isSaneTimestamp(span.EndTimestamp(), now) &&
+					span.EndTimestamp() >= span.StartTimestamp()
+				stats.record(failedChecks(resourceOK, timestampOK, true))
+			}
+		}
+	}
+	return c.export(ctx, r, now)
+}
+
+func (c *dataQuality) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	now := c.now()
+	r := newReport(signalMetrics)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		stats := r.source(rm.Resource().Attributes(), c.config.SourceAttribute)
+		resourceOK := c.hasRequiredResourceAttributes(rm.Resource().Attributes())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				forEachDataPointTimestamp(metrics.At(k), func(ts pcommon.Timestamp) {
+					stats.record(failedChecks(resourceOK, c.isSaneTimestamp(ts, now), true))
+				})
+			}
+		}
+	}
+	return c.export(ctx, r, now)
+}
+
+func (c *dataQuality) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	now := c.now()
+	r := newReport(signalLogs)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		stats := r.source(rl.Resource().Attributes(), c.config.SourceAttribute)
+		resourceOK := c.hasRequiredResourceAttributes(rl.Resource().Attributes())
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			logs := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				lr := logs.At(k)
+				// The timestamp of a log record is optional, the observed timestamp is used when it is unset.
+				ts := lr.Timestamp()
+				if ts == 0 {
+					ts = lr.ObservedTimestamp()
+				}
+				severityOK := !c.config.RequireSeverity ||
+					lr.SeverityNumber() != plog.SeverityNumberUnspecified || lr.SeverityText() != ""
+				stats.record(failedChecks(resourceOK, c.isSaneTimestamp(ts, now), severityOK))
+			}
+		}
+	}
+	return c.export(ctx, r, now)
+}
+
+func (c *dataQuality) hasRequiredResourceAttributes(attrs pcommon.Map) bool {
+	for _, name := range c.config.RequiredResourceAttributes {
+		val, ok := attrs.Get(name)
+		if !ok || val.AsString() == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// isSaneTimestamp checks that the timestamp is set and within the configured range around now.
+func (c *dataQuality) isSaneTimestamp(ts pcommon.Timestamp, now time.Time) bool {
+	if ts == 0 {
+		return false
+	}
+	t := ts.AsTime()
+	if c.config.Timestamps.MaxAge > 0 && t.Before(now.Add(-c.config.Timestamps.MaxAge)) {
+		return false
+	}
+	return !t.After(now.Add(c.config.Timestamps.MaxFutureSkew))
+}
+
+func (c *dataQuality) export(ctx context.Context, r *report, now time.Time) error {
+	md := r.metrics(c.config.SourceAttribute, now)
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, md)
+}
+
+func failedChecks(resourceOK, timestampOK, severityOK bool) []string {
+	var failed []string
+	if !resourceOK {
+		failed = append(failed, checkResourceAttributes)
+	}
+	if !timestampOK {
+		failed = append(failed, checkTimestamp)
+	}
+	if !severityOK {
+		failed = append(failed, checkSeverity)
+	}
+	return failed
+}
+
+func forEachDataPointTimestamp(metric pmetric.Metric, fn func(pcommon.Timestamp)) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Timestamp())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Timestamp())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Timestamp())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Timestamp())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Timestamp())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataqualityconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var now = time.Unix(1689000000, 0)
+
+func timestamp(offset time.Duration) pcommon.Timestamp {
+	return pcommon.NewTimestampFromTime(now.Add(offset))
+}
+
+func withNow(dq *dataQuality) *dataQuality {
+	dq.now = func() time.Time { return now }
+	return dq
+}
+
+// resourceMetrics returns the compliance metrics of each source, keyed by the value of the source attribute.
+func resourceMetrics(t *testing.T, md pmetric.Metrics, sourceAttribute string) map[string]pmetric.MetricSlice {
+	got := map[string]pmetric.MetricSlice{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		require.Equal(t, 1, rm.ScopeMetrics().Len())
+		assert.Equal(t, scopeName, rm.ScopeMetrics().At(0).Scope().Name())
+		source := ""
+		if val, ok := rm.Resource().Attributes().Get(sourceAttribute); ok {
+			source = val.Str()
+		}
+		got[source] = rm.ScopeMetrics().At(0).Metrics()
+	}
+	return got
+}
+
+func findMetric(t *testing.T, ms pmetric.MetricSlice, name string) pmetric.Metric {
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "metric %q not found", name)
+	return pmetric.NewMetric()
+}
+
+// violations returns the number of violations of each check.
+func violations(t *testing.T, ms pmetric.MetricSlice) map[string]int64 {
+	got := map[string]int64{}
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != metricViolations {
+			continue
+		}
+		dps := ms.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			check, ok := dps.At(j).Attributes().Get(attributeCheck)
+			require.True(t, ok)
+			got[check.Str()] = dps.At(j).IntValue()
+		}
+	}
+	return got
+}
+
+func assertCounts(t *testing.T, ms pmetric.MetricSlice, signal string, items, compliant int64) {
+	for _, name := range []string{metricItems, metricCompliant} {
+		sum := findMetric(t, ms, name).Sum()
+		assert.True(t, sum.IsMonotonic())
+		assert.Equal(t, pmetric.AggregationTemporalityDelta, sum.AggregationTemporality())
+		require.Equal(t, 1, sum.DataPoints().Len())
+		assert.Equal(t, timestamp(0), sum.DataPoints().At(0).Timestamp())
+		val, ok := sum.DataPoints().At(0).Attributes().Get(attributeSignal)
+		require.True(t, ok)
+		assert.Equal(t, signal, val.Str())
+	}
+	assert.Equal(t, items, findMetric(t, ms, metricItems).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, compliant, findMetric(t, ms, metricCompliant).Sum().DataPoints().At(0).IntValue())
+	compliance := findMetric(t, ms, metricCompliance).Gauge().DataPoints()
+	require.Equal(t, 1, compliance.Len())
+	assert.InDelta(t, float64(compliant)/float64(items), compliance.At(0).DoubleValue(), 1e-9)
+}
+
+func TestConsumeTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	good := spans.AppendEmpty()
+	good.SetStartTimestamp(timestamp(-2 * time.Second))
+	good.SetEndTimestamp(timestamp(-time.Second))
+	unset := spans.AppendEmpty()
+	unset.SetEndTimestamp(timestamp(-time.Second))
+	reversed := spans.AppendEmpty()
+	reversed.SetStartTimestamp(timestamp(-time.Second))
+	reversed.SetEndTimestamp(timestamp(-2 * time.Second))
+	future := spans.AppendEmpty()
+	future.SetStartTimestamp(timestamp(time.Hour))
+	future.SetEndTimestamp(timestamp(time.Hour + time.Second))
+
+	// a resource without service.name fails the resource attributes check
+	rs = td.ResourceSpans().AppendEmpty()
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetStartTimestamp(timestamp(-48 * time.Hour))
+	span.SetEndTimestamp(timestamp(-48 * time.Hour))
+
+	sink := new(consumertest.MetricsSink)
+	dq := withNow(newDataQuality(createDefaultConfig().(*Config), sink))
+	require.NoError(t, dq.ConsumeTraces(context.Background(), td))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	got := resourceMetrics(t, sink.AllMetrics()[0], "service.name")
+	require.Len(t, got, 2)
+
+	assertCounts(t, got["checkout"], signalTraces, 4, 1)
+	assert.Equal(t, map[string]int64{checkTimestamp: 3}, violations(t, got["checkout"]))
+
+	assertCounts(t, got[""], signalTraces, 1, 0)
+	assert.Equal(t, map[string]int64{checkResourceAttributes: 1, checkTimestamp: 1}, violations(t, got[""]))
+}
+
+func TestConsumeMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty().SetEmptyGauge()
+	gauge.DataPoints().AppendEmpty().SetTimestamp(timestamp(0))
+	gauge.DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().SetTimestamp(timestamp(-time.Minute))
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().SetTimestamp(timestamp(time.Minute))
+	ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty().SetTimestamp(timestamp(-25 * time.Hour))
+	ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty().SetTimestamp(timestamp(0))
+
+	sink := new(consumertest.MetricsSink)
+	dq := withNow(newDataQuality(createDefaultConfig().(*Config), sink))
+	require.NoError(t, dq.ConsumeMetrics(context.Background(), md))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	got := resourceMetrics(t, sink.AllMetrics()[0], "service.name")
+	require.Len(t, got, 1)
+	assertCounts(t, got["checkout"], signalMetrics, 6, 4)
+	assert.Equal(t, map[string]int64{checkTimestamp: 2}, violations(t, got["checkout"]))
+	_, found := got["checkout"].At(0).Sum().DataPoints().At(0).Attributes().Get(attributeCheck)
+	assert.False(t, found)
+}
+
+func TestConsumeLogs(t *testing.T) {
+	newLogs := func() plog.Logs {
+		ld := plog.NewLogs()
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", "checkout")
+		rl.Resource().Attributes().PutStr("k8s.namespace.name", "shop")
+		logs := rl.ScopeLogs().AppendEmpty().LogRecords()
+		good := logs.AppendEmpty()
+		good.SetTimestamp(timestamp(0))
+		good.SetSeverityNumber(plog.SeverityNumberInfo)
+		text := logs.AppendEmpty()
+		text.SetObservedTimestamp(timestamp(0))
+		text.SetSeverityText("warn")
+		noSeverity := logs.AppendEmpty()
+		noSeverity.SetTimestamp(timestamp(-2 * time.Hour))
+		logs.AppendEmpty()
+
+		rl = ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", "")
+		rl.Resource().Attributes().PutStr("k8s.namespace.name", "shop")
+		lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.SetTimestamp(timestamp(0))
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+
+		// resources without log records are not reported
+		ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("k8s.namespace.name", "empty")
+		return ld
+	}
+
+	t.Run("default", func(t *testing.T) {
+		sink := new(consumertest.MetricsSink)
+		dq := withNow(newDataQuality(createDefaultConfig().(*Config), sink))
+		require.NoError(t, dq.ConsumeLogs(context.Background(), newLogs()))
+
+		require.Len(t, sink.AllMetrics(), 1)
+		got := resourceMetrics(t, sink.AllMetrics()[0], "service.name")
+		require.Len(t, got, 2)
+		assertCounts(t, got["checkout"], signalLogs, 4, 2)
+		assert.Equal(t, map[string]int64{checkSeverity: 2, checkTimestamp: 1}, violations(t, got["checkout"]))
+		assertCounts(t, got[""], signalLogs, 1, 0)
+		assert.Equal(t, map[string]int64{checkResourceAttributes: 1}, violations(t, got[""]))
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		cfg.SourceAttribute = "k8s.namespace.name"
+		cfg.Timestamps.MaxAge = time.Hour
+		cfg.RequireSeverity = false
+		sink := new(consumertest.MetricsSink)
+		dq := withNow(newDataQuality(cfg, sink))
+		require.NoError(t, dq.ConsumeLogs(context.Background(), newLogs()))
+
+		require.Len(t, sink.AllMetrics(), 1)
+		got := resourceMetrics(t, sink.AllMetrics()[0], "k8s.namespace.name")
+		require.Len(t, got, 1)
+		assertCounts(t, got["shop"], signalLogs, 5, 2)
+		assert.Equal(t, map[string]int64{checkResourceAttributes: 1, checkTimestamp: 2}, violations(t, got["shop"]))
+	})
+}
+
+func TestConsumeEmpty(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	dq := withNow(newDataQuality(createDefaultConfig().(*Config), sink))
+	require.NoError(t, dq.ConsumeTraces(context.Background(), ptrace.NewTraces()))
+	require.NoError(t, dq.ConsumeMetrics(context.Background(), pmetric.NewMetrics()))
+	require.NoError(t, dq.ConsumeLogs(context.Background(), plog.NewLogs()))
+	assert.Empty(t, sink.AllMetrics())
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sink := new(consumertest.MetricsSink)
+	set := connectortest.NewNopCreateSettings()
+
+	traces, err := factory.CreateTracesToMetrics(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+	metrics, err := factory.CreateMetricsToMetrics(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+	logs, err := factory.CreateLogsToMetrics(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+
+	for _, c := range []interface {
+		Start(context.Context, component.Host) error
+		Shutdown(context.Context) error
+	}{traces, metrics, logs} {
+		require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
+		assert.NoError(t, c.Shutdown(context.Background()))
+	}
+	assert.False(t, traces.Capabilities().MutatesData)
+}
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataqualityconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/dataqualityconnector"
+
+import (
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	scopeName = "otelcol/dataqualityconnector"
+
+	metricItems      = "dataquality.items"
+	metricCompliant  = "dataquality.items.compliant"
+	metricViolations = "dataquality.violations"
+	metricCompliance = "dataquality.compliance"
+
+	attributeSignal = "signal"
+	attributeCheck  = "check"
+)
+
+// sourceKey identifies a source by the value of the source attribute,
+// items of resources without the attribute are reported together.
+type sourceKey struct {
+	value string
+	found bool
+}
+
+type sourceStats struct {
+	items      int64
+	compliant  int64
+	violations map[string]int64
+}
+
+// record counts an evaluated item along with the checks it failed.
+func (s *sourceStats) record(failed []string) {
+	s.items++
+	if len(failed) == 0 {
+		s.compliant++
+		return
+	}
+	for _, check := range failed {
+		s.violations[check]++
+	}
+}
+
+// report holds the results of the evaluation of a batch of telemetry of one signal.
+type report struct {
+	signal  string
+	sources map[sourceKey]*sourceStats
+}
+
+func newReport(signal string) *report {
+	return &report{
+		signal:  signal,
+		sources: map[sourceKey]*sourceStats{},
+	}
+}
+
+// source returns the stats of the source of a resource.
+func (r *report) source(attrs pcommon.Map, sourceAttribute string) *sourceStats {
+	var key sourceKey
+	if val, ok := attrs.Get(sourceAttribute); ok {
+		key = sourceKey{value: val.AsString(), found: true}
+	}
+	stats, ok := r.sources[key]
+	if !ok {
+		stats = &sourceStats{violations: map[string]int64{}}
+		r.sources[key] = stats
+	}
+	return stats
+}
+
+// metrics converts the report to one resource of compliance metrics per source.
+func (r *report) metrics(sourceAttribute string, now time.Time) pmetric.Metrics {
+	keys := make([]sourceKey, 0, len(r.sources))
+	for key, stats := range r.sources {
+		// resources without items have nothing to report
+		if stats.items > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].found != keys[j].found {
+			return keys[j].found
+		}
+		return keys[i].value < keys[j].value
+	})
+
+	ts := pcommon.NewTimestampFromTime(now)
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().EnsureCapacity(len(keys))
+	for _, key := range keys {
+		stats := r.sources[key]
+		rm := md.ResourceMetrics().AppendEmpty()
+		if key.found {
+			rm.Resource().Attributes().PutStr(sourceAttribute, key.value)
+		}
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(scopeName)
+		metrics := sm.Metrics()
+
+		r.appendSum(metrics, metricItems, "Number of evaluated items.", ts).
+			SetIntValue(stats.items)
+		r.appendSum(metrics, metricCompliant, "Number of evaluated items which passed all checks.", ts).
+			SetIntValue(stats.compliant)
+
+		if len(stats.violations) > 0 {
+			checks := make([]string, 0, len(stats.violations))
+			for check := range stats.violations {
+				checks = append(checks, check)
+			}
+			sort.Strings(checks)
+			violations := newSum(metrics, metricViolations, "Number of evaluated items which failed a check.")
+			for _, check := range checks {
+				dp := violations.DataPoints().AppendEmpty()
+				dp.SetTimestamp(ts)
+				dp.Attributes().PutStr(attributeSignal, r.signal)
+				dp.Attributes().PutStr(attributeCheck, check)
+				dp.SetIntValue(stats.violations[check])
+			}
+		}
+
+		compliance := metrics.AppendEmpty()
+		compliance.SetName(metricCompliance)
+		compliance.SetDescription("Ratio of evaluated items which passed all checks.")
+		compliance.SetUnit("1")
+		dp := compliance.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(ts)
+		dp.Attributes().PutStr(attributeSignal, r.signal)
+		dp.SetDoubleValue(float64(stats.compliant) / float64(stats.items))
+	}
+	return md
+}
+
+// appendSum appends a sum metric with a single data point for the signal of the report.
+func (r *report) appendSum(metrics pmetric.MetricSlice, name, description string, ts pcommon.Timestamp) pmetric.NumberDataPoint {
+	dp := newSum(metrics, name, description).DataPoints().AppendEmpty()
+	dp.SetTimestamp(ts)
+	dp.Attributes().PutStr(attributeSignal, r.signal)
+	return dp
+}
+
+func newSum(metrics pmetric.MetricSlice, name, description string) pmetric.Sum {
+	metric := metrics.AppendEmpty()
+	metric.SetName(name)
+	metric.SetDescription(description)
+	metric.SetUnit("{items}")
+	sum := metric.SetEmptySum()
+	// The delta value is always positive, so a value accumulated downstream is monotonic
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	return sum
+}
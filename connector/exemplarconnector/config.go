@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplarconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/exemplarconnector"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config for the connector
+type Config struct {
+	// ResourceAttributes are the resource attributes whose values must be equal for the spans
+	// of a resource to be attached as exemplars to the metrics of another resource.
+	ResourceAttributes []string `mapstructure:"resource_attributes"`
+	// TimeWindow is how long before the timestamp of a data point a span must have ended
+	// to be attached as an exemplar to the data point.
+	TimeWindow time.Duration `mapstructure:"time_window"`
+	// MaxExemplarsPerDataPoint is the maximum number of exemplars attached to a data point,
+	// the most recent spans are attached first.
+	MaxExemplarsPerDataPoint int `mapstructure:"max_exemplars_per_data_point"`
+	// MaxSpansPerResource is the maximum number of spans kept for each resource,
+	// the oldest spans are dropped first.
+	MaxSpansPerResource int `mapstructure:"max_spans_per_resource"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid
+func (c *Config) Validate() error {
+	if len(c.ResourceAttributes) == 0 {
+		return errors.New("resource_attributes must not be empty")
+	}
+	for _, attr := range c.ResourceAttributes {
+		if attr == "" {
+			return errors.New("resource_attributes must not contain empty attribute names")
+		}
+	}
+	if c.TimeWindow <= 0 {
+		return errors.New("time_window must be positive")
+	}
+	if c.MaxExemplarsPerDataPoint <= 0 {
+		return errors.New("max_exemplars_per_data_point must be positive")
+	}
+	if c.MaxSpansPerResource < c.MaxExemplarsPerDataPoint {
+		return errors.New("max_spans_per_resource must be greater than or equal to max_exemplars_per_data_point")
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplarconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/exemplarconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect *Config
+	}{
+		{
+			name: "",
+			expect: &Config{
+				ResourceAttributes:       []string{"service.name"},
+				TimeWindow:               time.Minute,
+				MaxExemplarsPerDataPoint: 1,
+				MaxSpansPerResource:      1000,
+			},
+		},
+		{
+			name: "custom",
+			expect: &Config{
+				ResourceAttributes:       []string{"service.name", "service.namespace"},
+				TimeWindow:               30 * time.Second,
+				MaxExemplarsPerDataPoint: 3,
+				MaxSpansPerResource:      100,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mutate func(*Config)
+		expect string
+	}{
+		{
+			name:   "no_resource_attributes",
+			mutate: func(cfg *Config) { cfg.ResourceAttributes = nil },
+			expect: "resource_attributes must not be empty",
+		},
+		{
+			name:   "empty_resource_attribute",
+			mutate: func(cfg *Config) { cfg.ResourceAttributes = []string{"service.name", ""} },
+			expect: "resource_attributes must not contain empty attribute names",
+		},
+		{
+			name:   "no_time_window",
+			mutate: func(cfg *Config) { cfg.TimeWindow = 0 },
+			expect: "time_window must be positive",
+		},
+		{
+			name:   "no_exemplars",
+			mutate: func(cfg *Config) { cfg.MaxExemplarsPerDataPoint = 0 },
+			expect: "max_exemplars_per_data_point must be positive",
+		},
+		{
+			name:   "not_enough_spans",
+			mutate: func(cfg *Config) { cfg.MaxSpansPerResource = 0 },
+			expect: "max_spans_per_resource must be greater than or equal to max_exemplars_per_data_point",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tc.mutate(cfg)
+			err := cfg.Validate()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expect)
+		})
+	}
+}
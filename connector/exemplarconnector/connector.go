@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplarconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/exemplarconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+)
+
+// tracesConnector records the spans of a traces pipeline in the shared span store.
+type tracesConnector struct {
+	*sharedcomponent.SharedComponent
+	store *spanStore
+}
+
+func (c *tracesConnector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *tracesConnector) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
+	c.store.record(td)
+	return nil
+}
+
+// metricsConnector attaches the spans of the shared span store as exemplars to
+// the data points of a metrics pipeline.
+type metricsConnector struct {
+	*sharedcomponent.SharedComponent
+	store           *spanStore
+	metricsConsumer consumer.Metrics
+	logger          *zap.Logger
+}
+
+func (c *metricsConnector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	attached := 0
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		key, ok := c.store.resourceKey(rm.Resource().Attributes())
+		if !ok {
+			continue
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				attached += c.attachToMetric(key, metrics.At(k))
+			}
+		}
+	}
+	if attached > 0 {
+		c.logger.Debug("Attached exemplars to data points", zap.Int("exemplars", attached))
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, md)
+}
+
+// attachToMetric attaches exemplars to the data points of the metric, and returns the number of exemplars attached.
+func (c *metricsConnector) attachToMetric(key string, metric pmetric.Metric) int {
+	attached := 0
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		attached += c.attachToNumberDataPoints(key, metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		attached += c.attachToNumberDataPoints(key, metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			mean := 0.0
+			if dp.HasSum() && dp.Count() > 0 {
+				mean = dp.Sum() / float64(dp.Count())
+			}
+			attached += c.attach(key, dp.Timestamp(), dp.Exemplars(), func(e pmetric.Exemplar, span spanRef) {
+				e.SetDoubleValue(spanValue(span, metric.Unit(), mean))
+			})
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			mean := 0.0
+			if dp.HasSum() && dp.Count() > 0 {
+				mean = dp.Sum() / float64(dp.Count())
+			}
+			attached += c.attach(key, dp.Timestamp(), dp.Exemplars(), func(e pmetric.Exemplar, span spanRef) {
+				e.SetDoubleValue(spanValue(span, metric.Unit(), mean))
+			})
+		}
+	case pmetric.MetricTypeSummary, pmetric.MetricTypeEmpty:
+		// summaries do not have exemplars
+	}
+	return attached
+}
+
+func (c *metricsConnector) attachToNumberDataPoints(key string, dps pmetric.NumberDataPointSlice) int {
+	attached := 0
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		attached += c.attach(key, dp.Timestamp(), dp.Exemplars(), func(e pmetric.Exemplar, _ spanRef) {
+			switch dp.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				e.SetIntValue(dp.IntValue())
+			case pmetric.NumberDataPointValueTypeDouble, pmetric.NumberDataPointValueTypeEmpty:
+				e.SetDoubleValue(dp.DoubleValue())
+			}
+		})
+	}
+	return attached
+}
+
+// attach appends the spans matching the data point to its exemplars, unless it already has
+// exemplars, which means that it comes from an instrumented source.
+func (c *metricsConnector) attach(key string, ts pcommon.Timestamp, exemplars pmetric.ExemplarSlice, setValue func(pmetric.Exemplar, spanRef)) int {
+	if exemplars.Len() > 0 {
+		return 0
+	}
+	spans := c.store.lookup(key, ts)
+	for _, span := range spans {
+		e := exemplars.AppendEmpty()
+		e.SetTimestamp(span.end)
+		e.SetTraceID(span.traceID)
+		e.SetSpanID(span.spanID)
+		setValue(e, span)
+	}
+	return len(spans)
+}
+
+// spanValue returns the duration of the span if the unit of the histogram is a duration,
+// so that the exemplar falls in the bucket of the span, otherwise the mean of the data point.
+func spanValue(span spanRef, unit string, mean float64) float64 {
+	switch unit {
+	case "ns":
+		return float64(span.duration.Nanoseconds())
+	case "us":
+		return float64(span.duration) / float64(time.Microsecond)
+	case "ms":
+		return float64(span.duration) / float64(time.Millisecond)
+	case "s":
+		return span.duration.Seconds()
+	default:
+		return mean
+	}
+}
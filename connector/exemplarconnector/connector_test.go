@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplarconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	now     = time.Unix(1689000000, 0)
+	traceID = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+)
+
+func testTraces(service string, spans ...time.Duration) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", service)
+	ss := rs.ScopeSpans().AppendEmpty()
+	for i, age := range spans {
+		span := ss.Spans().AppendEmpty()
+		span.SetTraceID(traceID)
+		span.SetSpanID(pcommon.SpanID([8]byte{byte(i + 1)}))
+		end := now.Add(-age)
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(end.Add(-250 * time.Millisecond)))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(end))
+	}
+	return td
+}
+
+func testMetrics(service string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", service)
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	sum := ms.AppendEmpty()
+	sum.SetName("requests")
+	dp := sum.SetEmptySum().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	dp.SetIntValue(42)
+
+	histogram := ms.AppendEmpty()
+	histogram.SetName("request.duration")
+	histogram.SetUnit("ms")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	hdp.SetCount(2)
+	hdp.SetSum(300)
+
+	summary := ms.AppendEmpty()
+	summary.SetName("request.size")
+	summary.SetEmptySummary().DataPoints().AppendEmpty().SetTimestamp(pcommon.NewTimestampFromTime(now))
+	return md
+}
+
+func createConnectors(t *testing.T, cfg *Config) (*tracesConnector, *metricsConnector, *consumertest.MetricsSink) {
+	factory := NewFactory()
+	sink := new(consumertest.MetricsSink)
+
+	traces, err := factory.CreateTracesToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	metrics, err := factory.CreateMetricsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, traces.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, metrics.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, traces.Shutdown(context.Background()))
+		assert.NoError(t, metrics.Shutdown(context.Background()))
+	})
+
+	tc, mc := traces.(*tracesConnector), metrics.(*metricsConnector)
+	require.Same(t, tc.store, mc.store, "the connectors of a configuration must share the span store")
+	mc.store.now = func() time.Time { return now }
+	return tc, mc, sink
+}
+
+func TestConnectorAttachesExemplars(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxExemplarsPerDataPoint = 2
+	traces, metrics, sink := createConnectors(t, cfg)
+
+	require.NoError(t, traces.ConsumeTraces(context.Background(), testTraces("checkout", 5*time.Second, time.Second, 2*time.Minute)))
+	require.NoError(t, traces.ConsumeTraces(context.Background(), testTraces("cart", time.Second)))
+	require.NoError(t, metrics.ConsumeMetrics(context.Background(), testMetrics("checkout")))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	ms := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+
+	// the most recent spans within the time window are attached
+	exemplars := ms.At(0).Sum().DataPoints().At(0).Exemplars()
+	require.Equal(t, 2, exemplars.Len())
+	assert.Equal(t, pcommon.SpanID([8]byte{2}), exemplars.At(0).SpanID())
+	assert.Equal(t, traceID, exemplars.At(0).TraceID())
+	assert.Equal(t, pcommon.NewTimestampFromTime(now.Add(-time.Second)), exemplars.At(0).Timestamp())
+	assert.Equal(t, int64(42), exemplars.At(0).IntValue())
+	assert.Equal(t, pcommon.SpanID([8]byte{1}), exemplars.At(1).SpanID())
+
+	// the duration of the span is used as value of histogram exemplars
+	exemplars = ms.At(1).Histogram().DataPoints().At(0).Exemplars()
+	require.Equal(t, 2, exemplars.Len())
+	assert.Equal(t, 250.0, exemplars.At(0).DoubleValue())
+}
+
+func TestConnectorKeepsExistingExemplars(t *testing.T) {
+	traces, metrics, sink := createConnectors(t, createDefaultConfig().(*Config))
+	require.NoError(t, traces.ConsumeTraces(context.Background(), testTraces("checkout", time.Second)))
+
+	md := testMetrics("checkout")
+	existing := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Exemplars().AppendEmpty()
+	existing.SetSpanID(pcommon.SpanID([8]byte{9}))
+	require.NoError(t, metrics.ConsumeMetrics(context.Background(), md))
+
+	ms := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	exemplars := ms.At(0).Sum().DataPoints().At(0).Exemplars()
+	require.Equal(t, 1, exemplars.Len())
+	assert.Equal(t, pcommon.SpanID([8]byte{9}), exemplars.At(0).SpanID())
+	assert.Equal(t, 1, ms.At(1).Histogram().DataPoints().At(0).Exemplars().Len())
+}
+
+func TestConnectorUnmatchedResources(t *testing.T) {
+	traces, metrics, sink := createConnectors(t, createDefaultConfig().(*Config))
+	require.NoError(t, traces.ConsumeTraces(context.Background(), testTraces("checkout", time.Second)))
+
+	require.NoError(t, metrics.ConsumeMetrics(context.Background(), testMetrics("cart")))
+	md := testMetrics("checkout")
+	md.ResourceMetrics().At(0).Resource().Attributes().Clear()
+	require.NoError(t, metrics.ConsumeMetrics(context.Background(), md))
+
+	require.Len(t, sink.AllMetrics(), 2)
+	for _, got := range sink.AllMetrics() {
+		ms := got.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		assert.Equal(t, 0, ms.At(0).Sum().DataPoints().At(0).Exemplars().Len())
+		assert.Equal(t, 0, ms.At(1).Histogram().DataPoints().At(0).Exemplars().Len())
+	}
+}
+
+func TestSpanValue(t *testing.T) {
+	span := spanRef{duration: 1500 * time.Millisecond}
+	assert.Equal(t, 1.5, spanValue(span, "s", 7))
+	assert.Equal(t, 1500.0, spanValue(span, "ms", 7))
+	assert.Equal(t, 1.5e6, spanValue(span, "us", 7))
+	assert.Equal(t, 1.5e9, spanValue(span, "ns", 7))
+	assert.Equal(t, 7.0, spanValue(span, "By", 7))
+}
@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exemplarconnector attaches the spans of a traces pipeline as exemplars
+// to the data points of the metrics of the same resources.
+package exemplarconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/exemplarconnector"
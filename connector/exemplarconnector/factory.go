@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+package exemplarconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/exemplarconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/exemplarconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+)
+
+const (
+	defaultTimeWindow               = time.Minute
+	defaultMaxExemplarsPerDataPoint = 1
+	defaultMaxSpansPerResource      = 1000
+)
+
+// stores shares the spans recorded by the traces to metrics connector with the
+// metrics to metrics connector of the same configuration.
+var stores = sharedcomponent.NewSharedComponents()
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithTracesToMetrics(createTracesToMetrics, metadata.TracesToMetricsStability),
+		connector.WithMetricsToMetrics(createMetricsToMetrics, metadata.MetricsToMetricsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		ResourceAttributes:       []string{conventions.AttributeServiceName},
+		TimeWindow:               defaultTimeWindow,
+		MaxExemplarsPerDataPoint: defaultMaxExemplarsPerDataPoint,
+		MaxSpansPerResource:      defaultMaxSpansPerResource,
+	}
+}
+
+func getOrAddStore(cfg *Config) *sharedcomponent.SharedComponent {
+	return stores.GetOrAdd(cfg, func() component.Component {
+		return newSpanStore(cfg)
+	})
+}
+
+// createTracesToMetrics creates a traces to metrics connector, which records the spans
+// to attach as exemplars. It does not emit any metrics.
+func createTracesToMetrics(
+	_ context.Context,
+	_ connector.CreateSettings,
+	cfg component.Config,
+	_ consumer.Metrics,
+) (connector.Traces, error) {
+	store := getOrAddStore(cfg.(*Config))
+	return &tracesConnector{
+		SharedComponent: store,
+		store:           store.Unwrap().(*spanStore),
+	}, nil
+}
+
+// createMetricsToMetrics creates a metrics to metrics connector, which attaches
+// the recorded spans as exemplars to the metrics.
+func createMetricsToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Metrics, error) {
+	store := getOrAddStore(cfg.(*Config))
+	return &metricsConnector{
+		SharedComponent: store,
+		store:           store.Unwrap().(*spanStore),
+		metricsConsumer: nextConsumer,
+		logger:          set.Logger,
+	}, nil
+}
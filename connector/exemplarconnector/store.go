@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplarconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/exemplarconnector"
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// spanRef is a span which can be attached as an exemplar.
+type spanRef struct {
+	traceID  pcommon.TraceID
+	spanID   pcommon.SpanID
+	end      pcommon.Timestamp
+	duration time.Duration
+}
+
+// spanStore keeps the most recent spans of each resource, identified by the
+// values of the configured resource attributes.
+type spanStore struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	resourceAttributes []string
+	timeWindow         time.Duration
+	maxExemplars       int
+	maxSpans           int
+	now                func() time.Time
+
+	mu        sync.Mutex
+	spans     map[string][]spanRef
+	lastPurge time.Time
+}
+
+func newSpanStore(cfg *Config) *spanStore {
+	return &spanStore{
+		resourceAttributes: cfg.ResourceAttributes,
+		timeWindow:         cfg.TimeWindow,
+		maxExemplars:       cfg.MaxExemplarsPerDataPoint,
+		maxSpans:           cfg.MaxSpansPerResource,
+		now:                time.Now,
+		spans:              make(map[string][]spanRef),
+		lastPurge:          time.Now(),
+	}
+}
+
+// resourceKey returns the key identifying the resource, and false if one of the
+// configured resource attributes is missing.
+func (s *spanStore) resourceKey(attrs pcommon.Map) (string, bool) {
+	values := make([]string, 0, len(s.resourceAttributes))
+	for _, name := range s.resourceAttributes {
+		v, ok := attrs.Get(name)
+		if !ok {
+			return "", false
+		}
+		values = append(values, v.AsString())
+	}
+	return strings.Join(values, "\x00"), true
+}
+
+// record keeps the spans of the traces which have valid IDs.
+func (s *spanStore) record(td ptrace.Traces) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		key, ok := s.resourceKey(rs.Resource().Attributes())
+		if !ok {
+			continue
+		}
+		spans := s.spans[key]
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < ss.Len(); k++ {
+				span := ss.At(k)
+				if span.TraceID().IsEmpty() || span.SpanID().IsEmpty() {
+					continue
+				}
+				spans = append(spans, spanRef{
+					traceID:  span.TraceID(),
+					spanID:   span.SpanID(),
+					end:      span.EndTimestamp(),
+					duration: span.EndTimestamp().AsTime().Sub(span.StartTimestamp().AsTime()),
+				})
+			}
+		}
+		if len(spans) > s.maxSpans {
+			spans = append(spans[:0:0], spans[len(spans)-s.maxSpans:]...)
+		}
+		s.spans[key] = spans
+	}
+
+	s.purge()
+}
+
+// purge drops the spans which are too old to be attached to new data points, at most once per time window.
+// The spans are kept for twice the time window, so that data points which are delayed can still be matched.
+func (s *spanStore) purge() {
+	now := s.now()
+	if now.Sub(s.lastPurge) < s.timeWindow {
+		return
+	}
+	s.lastPurge = now
+
+	oldest := pcommon.NewTimestampFromTime(now.Add(-2 * s.timeWindow))
+	for key, spans := range s.spans {
+		kept := spans[:0]
+		for _, span := range spans {
+			if span.end >= oldest {
+				kept = append(kept, span)
+			}
+		}
+		if len(kept) == 0 {
+			delete(s.spans, key)
+			continue
+		}
+		s.spans[key] = kept
+	}
+}
+
+// lookup returns the most recent spans of the resource which ended within the
+// time window before ts.
+func (s *spanStore) lookup(key string, ts pcommon.Timestamp) []spanRef {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from := pcommon.NewTimestampFromTime(ts.AsTime().Add(-s.timeWindow))
+	var matches []spanRef
+	for _, span := range s.spans[key] {
+		if span.end >= from && span.end <= ts {
+			matches = append(matches, span)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].end > matches[j].end
+	})
+	if len(matches) > s.maxExemplars {
+		matches = matches[:s.maxExemplars]
+	}
+	return matches
+}
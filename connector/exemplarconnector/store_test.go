@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplarconnector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestStore(maxSpans int) *spanStore {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ResourceAttributes = []string{"service.name", "service.namespace"}
+	cfg.MaxExemplarsPerDataPoint = maxSpans
+	cfg.MaxSpansPerResource = maxSpans
+	store := newSpanStore(cfg)
+	store.now = func() time.Time { return now }
+	store.lastPurge = now
+	return store
+}
+
+func TestResourceKey(t *testing.T) {
+	store := newTestStore(1)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("service.name", "checkout")
+	_, ok := store.resourceKey(attrs)
+	assert.False(t, ok)
+
+	attrs.PutStr("service.namespace", "shop")
+	key, ok := store.resourceKey(attrs)
+	assert.True(t, ok)
+
+	other := pcommon.NewMap()
+	other.PutStr("service.name", "checkout")
+	other.PutStr("service.namespace", "staging")
+	other.PutStr("host.name", "node-1")
+	otherKey, ok := store.resourceKey(other)
+	assert.True(t, ok)
+	assert.NotEqual(t, key, otherKey)
+}
+
+func TestStoreKeepsMostRecentSpans(t *testing.T) {
+	store := newTestStore(2)
+	td := testTraces("checkout", 3*time.Second, 2*time.Second, time.Second)
+	td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.namespace", "shop")
+	// spans without IDs are ignored
+	td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().AppendEmpty().SetEndTimestamp(pcommon.NewTimestampFromTime(now))
+	store.record(td)
+
+	key, ok := store.resourceKey(td.ResourceSpans().At(0).Resource().Attributes())
+	require.True(t, ok)
+	spans := store.lookup(key, pcommon.NewTimestampFromTime(now))
+	require.Len(t, spans, 2)
+	assert.Equal(t, pcommon.SpanID([8]byte{3}), spans[0].spanID)
+	assert.Equal(t, pcommon.SpanID([8]byte{2}), spans[1].spanID)
+
+	// spans which ended after the data point are not attached
+	spans = store.lookup(key, pcommon.NewTimestampFromTime(now.Add(-1500*time.Millisecond)))
+	require.Len(t, spans, 1)
+	assert.Equal(t, pcommon.SpanID([8]byte{2}), spans[0].spanID)
+}
+
+func TestStorePurge(t *testing.T) {
+	store := newTestStore(10)
+	td := testTraces("checkout", time.Second)
+	td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.namespace", "shop")
+	store.record(td)
+	require.Len(t, store.spans, 1)
+
+	// the spans are kept for twice the time window, and purged at most once per time window
+	store.now = func() time.Time { return now.Add(store.timeWindow) }
+	store.record(ptrace.NewTraces())
+	assert.Len(t, store.spans, 1)
+
+	store.now = func() time.Time { return now.Add(4 * store.timeWindow) }
+	store.record(ptrace.NewTraces())
+	assert.Empty(t, store.spans)
+}
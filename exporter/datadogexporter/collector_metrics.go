@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes/source"
+	"go.opentelemetry.io/collector/exporter"
+	"go.uber.org/zap"
+	zorkian "gopkg.in/zorkian/go-datadog-api.v2"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/scrub"
+)
+
+const (
+	// uptimeMetricName is the name of the metric reporting the uptime of the Collector in seconds.
+	uptimeMetricName = "otelcol.uptime"
+	// sendFailedMetricName is the name of the metric reporting the number of failed exports per signal.
+	sendFailedMetricName = "otelcol.exporter.send_failed"
+)
+
+// signals are the signals whose export failures are reported, even when there are none.
+var signals = []string{"metrics", "traces", "logs"}
+
+// collectorMetrics counts the export failures of the exporters of a factory,
+// and reports them along with the uptime of the Collector.
+type collectorMetrics struct {
+	start time.Time
+
+	mu       sync.Mutex
+	failures map[string]int64 // failures since the last report, per signal
+}
+
+func newCollectorMetrics() *collectorMetrics {
+	return &collectorMetrics{
+		start:    time.Now(),
+		failures: make(map[string]int64),
+	}
+}
+
+// countFailures wraps a push function to count the export failures of a signal.
+func countFailures[T any](cm *collectorMetrics, signal string, push func(context.Context, T) error) func(context.Context, T) error {
+	return func(ctx context.Context, data T) error {
+		err := push(ctx, data)
+		if err != nil {
+			cm.mu.Lock()
+			cm.failures[signal]++
+			cm.mu.Unlock()
+		}
+		return err
+	}
+}
+
+// takeFailures returns the failures counted since the last call.
+func (cm *collectorMetrics) takeFailures() map[string]int64 {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	failures := cm.failures
+	cm.failures = make(map[string]int64)
+	return failures
+}
+
+// restoreFailures adds back failures that could not be reported.
+func (cm *collectorMetrics) restoreFailures(failures map[string]int64) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	for signal, n := range failures {
+		cm.failures[signal] += n
+	}
+}
+
+// series returns the Collector metrics for a host, the failures are reported as counts.
+func (cm *collectorMetrics) series(now time.Time, hostname string, tags []string, failures map[string]int64) []datadogV2.MetricSeries {
+	ts := uint64(now.UnixNano())
+	series := []datadogV2.MetricSeries{
+		metrics.NewGauge(uptimeMetricName, ts, now.Sub(cm.start).Seconds(), tags),
+	}
+	for _, signal := range signals {
+		series = append(series, metrics.NewCount(sendFailedMetricName, ts, float64(failures[signal]), append([]string{"signal:" + signal}, tags...)))
+	}
+	if hostname != "" {
+		for i := range series {
+			series[i].SetResources([]datadogV2.MetricResource{
+				{
+					Name: datadog.PtrString(hostname),
+					Type: datadog.PtrString("host"),
+				},
+			})
+		}
+	}
+	return series
+}
+
+// zorkianSeries is the same as series for the Zorkian API.
+func (cm *collectorMetrics) zorkianSeries(now time.Time, hostname string, tags []string, failures map[string]int64) []zorkian.Metric {
+	ts := uint64(now.UnixNano())
+	series := []zorkian.Metric{
+		metrics.NewZorkianGauge(uptimeMetricName, ts, now.Sub(cm.start).Seconds(), tags),
+	}
+	for _, signal := range signals {
+		series = append(series, metrics.NewZorkianCount(sendFailedMetricName, ts, float64(failures[signal]), append([]string{"signal:" + signal}, tags...)))
+	}
+	if hostname != "" {
+		for i := range series {
+			series[i].SetHost(hostname)
+		}
+	}
+	return series
+}
+
+// collectorMetricsPusher periodically sends the Collector metrics to Datadog,
+// for the host resolved by the source provider, until the context is cancelled.
+func collectorMetricsPusher(ctx context.Context, params exporter.CreateSettings, cfg *Config, p source.Provider, cm *collectorMetrics) {
+	defer params.Logger.Debug("Shut down collector metrics routine")
+
+	src, err := p.Source(ctx)
+	if err != nil {
+		params.Logger.Error("Failed to resolve the source of the collector metrics", zap.Error(err))
+		return
+	}
+	var hostname string
+	tags := metrics.TagsFromBuildInfo(params.BuildInfo)
+	switch src.Kind {
+	case source.HostnameKind:
+		hostname = src.Identifier
+	case source.AWSECSFargateKind:
+		tags = append(tags, src.Tag())
+	case source.InvalidKind:
+	}
+
+	retrier := clientutil.NewRetrier(params.Logger, cfg.RetrySettings, scrub.NewScrubber())
+	var send func(context.Context, time.Time, map[string]int64) error
+	if isMetricExportV2Enabled() {
+		apiClient := clientutil.CreateAPIClient(
+			params.BuildInfo,
+			cfg.Metrics.TCPAddr.Endpoint,
+			cfg.TimeoutSettings,
+			cfg.LimitedHTTPClientSettings.TLSSetting.InsecureSkipVerify)
+		metricsAPI := datadogV2.NewMetricsApi(apiClient)
+		send = func(ctx context.Context, now time.Time, failures map[string]int64) error {
+			payload := datadogV2.MetricPayload{Series: cm.series(now, hostname, tags, failures)}
+			ctx = clientutil.GetRequestContext(ctx, string(cfg.API.Key))
+			_, httpresp, err := metricsAPI.SubmitMetrics(ctx, payload, *clientutil.GZipSubmitMetricsOptionalParameters)
+			return clientutil.WrapError(err, httpresp)
+		}
+	} else {
+		client := clientutil.CreateZorkianClient(string(cfg.API.Key), cfg.Metrics.TCPAddr.Endpoint)
+		client.ExtraHeader["User-Agent"] = clientutil.UserAgent(params.BuildInfo)
+		client.HttpClient = clientutil.NewHTTPClient(cfg.TimeoutSettings, cfg.LimitedHTTPClientSettings.TLSSetting.InsecureSkipVerify)
+		send = func(_ context.Context, now time.Time, failures map[string]int64) error {
+			return client.PostMetrics(cm.zorkianSeries(now, hostname, tags, failures))
+		}
+	}
+
+	ticker := time.NewTicker(cfg.CollectorMetrics.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			failures := cm.takeFailures()
+			_, err := retrier.DoWithRetries(ctx, func(ctx context.Context) error {
+				return send(ctx, now, failures)
+			})
+			if err != nil {
+				// report the failures on the next attempt rather than losing them
+				cm.restoreFailures(failures)
+				params.Logger.Error("Error posting collector metrics", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/testutil"
+)
+
+func TestCountFailures(t *testing.T) {
+	cm := newCollectorMetrics()
+	push := countFailures(cm, "logs", func(_ context.Context, ld plog.Logs) error {
+		if ld.LogRecordCount() == 0 {
+			return errors.New("empty")
+		}
+		return nil
+	})
+
+	logs := plog.NewLogs()
+	require.Error(t, push(context.Background(), logs))
+	require.Error(t, push(context.Background(), logs))
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	require.NoError(t, push(context.Background(), logs))
+
+	failures := cm.takeFailures()
+	assert.Equal(t, map[string]int64{"logs": 2}, failures)
+	assert.Empty(t, cm.takeFailures())
+
+	cm.restoreFailures(failures)
+	assert.Equal(t, map[string]int64{"logs": 2}, cm.takeFailures())
+}
+
+func TestCollectorMetricsSeries(t *testing.T) {
+	cm := newCollectorMetrics()
+	now := cm.start.Add(90 * time.Second)
+
+	series := cm.series(now, "collector-host", []string{"version:latest"}, map[string]int64{"traces": 3})
+	require.Len(t, series, 4)
+	assert.Equal(t, uptimeMetricName, series[0].Metric)
+	assert.Equal(t, 90.0, *series[0].Points[0].Value)
+	assert.Equal(t, []string{"version:latest"}, series[0].Tags)
+	assert.Equal(t, "collector-host", *series[0].Resources[0].Name)
+
+	values := map[string]float64{}
+	for _, s := range series[1:] {
+		assert.Equal(t, sendFailedMetricName, s.Metric)
+		values[s.Tags[0]] = *s.Points[0].Value
+		assert.Equal(t, "version:latest", s.Tags[1])
+	}
+	assert.Equal(t, map[string]float64{"signal:metrics": 0, "signal:traces": 3, "signal:logs": 0}, values)
+
+	zseries := cm.zorkianSeries(now, "", nil, nil)
+	require.Len(t, zseries, 4)
+	assert.Equal(t, uptimeMetricName, zseries[0].GetMetric())
+	assert.Empty(t, zseries[0].GetHost())
+}
+
+func TestCollectorMetricsPusher(t *testing.T) {
+	if isMetricExportV2Enabled() {
+		require.NoError(t, enableZorkianMetricExport())
+		t.Cleanup(func() { require.NoError(t, enableNativeMetricExport()) })
+	}
+
+	bodies := make(chan []byte, 10)
+	server := testutil.DatadogServerMock(func() (string, http.HandlerFunc) {
+		return testutil.MetricV1Endpoint, func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			bodies <- body
+			w.WriteHeader(http.StatusAccepted)
+		}
+	})
+	defer server.Close()
+
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.API.Key = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	cfg.Metrics.TCPAddr.Endpoint = server.URL
+	cfg.CollectorMetrics.Enabled = true
+	cfg.CollectorMetrics.Interval = 10 * time.Millisecond
+
+	params := exportertest.NewNopCreateSettings()
+	params.BuildInfo = component.BuildInfo{Command: "otelcol", Version: "latest"}
+	cm := newCollectorMetrics()
+	cm.failures["metrics"] = 2
+	provider := &testutil.MockSourceProvider{Src: source.Source{Kind: source.HostnameKind, Identifier: "collector-host"}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		collectorMetricsPusher(ctx, params, cfg, provider, cm)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	var body []byte
+	select {
+	case body = <-bodies:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for the collector metrics")
+	}
+
+	var payload struct {
+		Series []struct {
+			Metric string   `json:"metric"`
+			Host   string   `json:"host"`
+			Type   string   `json:"type"`
+			Tags   []string `json:"tags"`
+			Points [][2]float64
+		} `json:"series"`
+	}
+	require.NoError(t, json.Unmarshal(body, &payload))
+	require.Len(t, payload.Series, 4)
+	for _, s := range payload.Series {
+		assert.Equal(t, "collector-host", s.Host)
+		assert.Contains(t, s.Tags, "command:otelcol")
+		if s.Metric == sendFailedMetricName && s.Tags[0] == "signal:metrics" {
+			assert.Equal(t, "count", s.Type)
+			assert.Equal(t, 2.0, s.Points[0][1])
+		}
+	}
+	assert.Equal(t, uptimeMetricName, payload.Series[0].Metric)
+	assert.Equal(t, "gauge", payload.Series[0].Type)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
@@ -18,13 +19,21 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/hostmetadata/valid"
 )
 
 var (
-	errUnsetAPIKey   = errors.New("api.key is not set")
-	errNoMetadata    = errors.New("only_metadata can't be enabled when host_metadata::enabled = false or host_metadata::hostname_source != first_resource")
-	errEmptyEndpoint = errors.New("endpoint cannot be empty")
+	errUnsetAPIKey        = errors.New("api.key is not set")
+	errNoMetadata         = errors.New("only_metadata can't be enabled when host_metadata::enabled = false or host_metadata::hostname_source != first_resource")
+	errEmptyEndpoint      = errors.New("endpoint cannot be empty")
+	errNoAuditLogs        = errors.New("host_metadata::audit_logs can't be enabled when host_metadata::enabled = false")
+	errNoAuditLogsID      = errors.New("host_metadata::audit_logs::exporter must be set when host_metadata::audit_logs is enabled")
+	errNoHostnameKey      = errors.New("unresolved_hostname::attribute must be set when unresolved_hostname::policy is use_attribute")
+	errAllSignalsDisabled = errors.New("at least one of metrics::enabled, traces::enabled or logs::enabled must be true")
+	errCollectorInterval  = errors.New("collector_metrics::interval must be positive")
+
+	errNegativeTracesBatchTimeout = errors.New("traces::batch::timeout must not be negative")
 )
 
 const (
@@ -45,10 +54,70 @@ type APIConfig struct {
 	// FailOnInvalidKey states whether to exit at startup on invalid API key.
 	// The default value is false.
 	FailOnInvalidKey bool `mapstructure:"fail_on_invalid_key"`
+
+	// Failover defines a secondary site to send metrics and logs to
+	// when the primary site is unavailable.
+	Failover FailoverConfig `mapstructure:"failover"`
+}
+
+// FailoverConfig defines the failover to a secondary Datadog site.
+type FailoverConfig struct {
+	// Site is the secondary site of the Datadog intake, failover is disabled if empty.
+	Site string `mapstructure:"site"`
+
+	// Key is the API key of the organization on the secondary site.
+	// If unset, the API key of the primary site is used.
+	Key configopaque.String `mapstructure:"key"`
+
+	// FailureThreshold is the number of consecutive failed requests to the primary site
+	// after which the secondary site is used.
+	// The default value is 5.
+	FailureThreshold int `mapstructure:"failure_threshold"`
+
+	// ProbeInterval is the interval at which the availability of the primary site
+	// is checked while the secondary site is used.
+	// The default value is 1 minute.
+	ProbeInterval time.Duration `mapstructure:"probe_interval"`
+}
+
+// failoverSettings returns the settings of the failover of the HTTP clients to the secondary site.
+func (c *Config) failoverSettings() clientutil.FailoverSettings {
+	key := c.API.Failover.Key
+	if key == "" {
+		key = c.API.Key
+	}
+	return clientutil.FailoverSettings{
+		PrimarySite:      c.API.Site,
+		Site:             c.API.Failover.Site,
+		APIKey:           string(key),
+		FailureThreshold: c.API.Failover.FailureThreshold,
+		ProbeInterval:    c.API.Failover.ProbeInterval,
+	}
+}
+
+// validate the failover configuration.
+func (f *FailoverConfig) validate(site string) error {
+	if f.Site == "" {
+		return nil
+	}
+	if f.Site == site {
+		return errors.New("api::failover::site must be different from api::site")
+	}
+	if f.FailureThreshold <= 0 {
+		return errors.New("api::failover::failure_threshold must be positive")
+	}
+	if f.ProbeInterval <= 0 {
+		return errors.New("api::failover::probe_interval must be positive")
+	}
+	return nil
 }
 
 // MetricsConfig defines the metrics exporter specific configuration options
 type MetricsConfig struct {
+	// Enabled enables the export of metrics. When disabled, metrics are dropped,
+	// and neither the metrics client nor the trace agent used for APM stats is started.
+	Enabled bool `mapstructure:"enabled"`
+
 	// DeltaTTL defines the time that previous points of a cumulative monotonic
 	// metric are kept in memory to calculate deltas
 	DeltaTTL int64 `mapstructure:"delta_ttl"`
@@ -67,6 +136,12 @@ type MetricsConfig struct {
 
 	// SummaryConfig defines the export for OTLP Summaries.
 	SummaryConfig SummaryConfig `mapstructure:"summaries"`
+
+	// SendingQueue overrides the top-level sending_queue settings for metrics.
+	SendingQueue *exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+
+	// Batch defines how metric series are split into requests.
+	Batch BatchConfig `mapstructure:"batch"`
 }
 
 type HistogramMode string
@@ -200,8 +275,8 @@ type SummaryConfig struct {
 // MetricsExporterConfig provides options for a user to customize the behavior of the
 // metrics exporter
 type MetricsExporterConfig struct {
-	// ResourceAttributesAsTags, if set to true, will use the exporterhelper feature to transform all
-	// resource attributes into metric labels, which are then converted into tags
+	// ResourceAttributesAsTags, if set to true, will transform all resource attributes into tags.
+	// The tags of each resource are computed once and cached across batches.
 	ResourceAttributesAsTags bool `mapstructure:"resource_attributes_as_tags"`
 
 	// InstrumentationScopeMetadataAsTags, if set to true, adds the name and version of the
@@ -211,6 +286,10 @@ type MetricsExporterConfig struct {
 
 // TracesConfig defines the traces exporter specific configuration options
 type TracesConfig struct {
+	// Enabled enables the export of traces. When disabled, traces are dropped
+	// and the trace agent is not started.
+	Enabled bool `mapstructure:"enabled"`
+
 	// TCPAddr.Endpoint is the host of the Datadog intake server to send traces to.
 	// If unset, the value is obtained from the Site.
 	confignet.TCPAddr `mapstructure:",squash"`
@@ -245,6 +324,12 @@ type TracesConfig struct {
 	// If the overhead remains high, it will be due to a high cardinality of `peer.service` values from the traces. You may need to check your instrumentation.
 	PeerServiceAggregation bool `mapstructure:"peer_service_aggregation"`
 
+	// SendingQueue overrides the top-level sending_queue settings for traces.
+	SendingQueue *exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+
+	// Batch defines how the trace agent batches traces before sending them.
+	Batch TracesBatchConfig `mapstructure:"batch"`
+
 	// flushInterval defines the interval in seconds at which the writer flushes traces
 	// to the intake; used in tests.
 	flushInterval float64
@@ -252,12 +337,50 @@ type TracesConfig struct {
 
 // LogsConfig defines logs exporter specific configuration
 type LogsConfig struct {
+	// Enabled enables the export of logs. When disabled, logs are dropped
+	// and the logs client is not created.
+	Enabled bool `mapstructure:"enabled"`
+
 	// TCPAddr.Endpoint is the host of the Datadog intake server to send logs to.
 	// If unset, the value is obtained from the Site.
 	confignet.TCPAddr `mapstructure:",squash"`
 
 	// DumpPayloads report whether payloads should be dumped when logging level is debug.
 	DumpPayloads bool `mapstructure:"dump_payloads"`
+
+	// SendingQueue overrides the top-level sending_queue settings for logs.
+	SendingQueue *exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+
+	// Batch defines how log items are split into requests.
+	Batch BatchConfig `mapstructure:"batch"`
+}
+
+// BatchConfig defines how the items of a signal are split into the requests sent to the intake.
+type BatchConfig struct {
+	// MaxSize is the maximum number of items sent in a single request.
+	// There is no limit when 0.
+	MaxSize int `mapstructure:"max_size"`
+}
+
+func (c BatchConfig) validate(signal string) error {
+	if c.MaxSize < 0 {
+		return fmt.Errorf("%s::batch::max_size must not be negative", signal)
+	}
+	return nil
+}
+
+// TracesBatchConfig defines how the trace agent batches traces.
+type TracesBatchConfig struct {
+	// Timeout is the interval at which the trace agent flushes the traces it buffers.
+	// The trace agent default is used when 0.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+func (c TracesBatchConfig) validate() error {
+	if c.Timeout < 0 {
+		return errNegativeTracesBatchTimeout
+	}
+	return nil
 }
 
 // TagsConfig defines the tag-related configuration
@@ -268,6 +391,57 @@ type TagsConfig struct {
 	Hostname string `mapstructure:"hostname"`
 }
 
+// UnresolvedHostnamePolicy is the handling of the telemetry whose resource lacks hostname-like attributes.
+type UnresolvedHostnamePolicy string
+
+const (
+	// UnresolvedHostnamePolicyFallbackToCollectorHost reports the telemetry under the hostname
+	// of the collector, as chosen by the 'hostname' setting or system and cloud provider APIs.
+	UnresolvedHostnamePolicyFallbackToCollectorHost UnresolvedHostnamePolicy = "fallback_to_collector_host"
+
+	// UnresolvedHostnamePolicyDrop drops the telemetry.
+	UnresolvedHostnamePolicyDrop UnresolvedHostnamePolicy = "drop"
+
+	// UnresolvedHostnamePolicyUseAttribute uses the value of the 'unresolved_hostname::attribute'
+	// resource attribute as hostname, falling back to the hostname of the collector if it is missing.
+	UnresolvedHostnamePolicyUseAttribute UnresolvedHostnamePolicy = "use_attribute"
+)
+
+var _ encoding.TextUnmarshaler = (*UnresolvedHostnamePolicy)(nil)
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (p *UnresolvedHostnamePolicy) UnmarshalText(in []byte) error {
+	switch policy := UnresolvedHostnamePolicy(in); policy {
+	case UnresolvedHostnamePolicyFallbackToCollectorHost,
+		UnresolvedHostnamePolicyDrop,
+		UnresolvedHostnamePolicyUseAttribute:
+		*p = policy
+		return nil
+	default:
+		return fmt.Errorf("invalid unresolved hostname policy %q", policy)
+	}
+}
+
+// UnresolvedHostnameConfig defines the handling of the telemetry whose resource lacks hostname-like
+// attributes, e.g. when the collector runs as a gateway receiving telemetry from many hosts.
+type UnresolvedHostnameConfig struct {
+	// Policy is the handling of the telemetry whose hostname cannot be resolved.
+	// Valid values are 'fallback_to_collector_host', 'drop' and 'use_attribute'.
+	//
+	// The default is 'fallback_to_collector_host'.
+	Policy UnresolvedHostnamePolicy `mapstructure:"policy"`
+
+	// Attribute is the resource attribute used as hostname when Policy is 'use_attribute'.
+	Attribute string `mapstructure:"attribute"`
+}
+
+func (c UnresolvedHostnameConfig) validate() error {
+	if c.Policy == UnresolvedHostnamePolicyUseAttribute && c.Attribute == "" {
+		return errNoHostnameKey
+	}
+	return nil
+}
+
 // HostnameSource is the source for the hostname of host metadata.
 type HostnameSource string
 
@@ -324,6 +498,51 @@ type HostMetadataConfig struct {
 	// These tags will be attached to telemetry signals that have the host metadata hostname.
 	// To attach tags to telemetry signals regardless of the host, use a processor instead.
 	Tags []string `mapstructure:"tags"`
+
+	// AuditLogs defines the configuration for emitting the host metadata payloads as logs.
+	AuditLogs AuditLogsConfig `mapstructure:"audit_logs"`
+}
+
+// AuditLogsConfig defines the configuration for emitting each host metadata payload
+// pushed to Datadog as a log record, e.g. to archive what was reported about each host.
+type AuditLogsConfig struct {
+	// Enabled enables the host metadata audit logs.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Exporter is the ID of the logs exporter the log records are sent to.
+	// The exporter must be part of a logs pipeline.
+	Exporter component.ID `mapstructure:"exporter"`
+}
+
+func (c AuditLogsConfig) validate(hostMetadataEnabled bool) error {
+	if !c.Enabled {
+		return nil
+	}
+	if !hostMetadataEnabled {
+		return errNoAuditLogs
+	}
+	if c.Exporter == (component.ID{}) {
+		return errNoAuditLogsID
+	}
+	return nil
+}
+
+// CollectorMetricsConfig defines the configuration for reporting the health of the Collector itself,
+// its uptime and the export failures of the exporter, as Datadog metrics under the `otelcol.` namespace.
+// The metrics are reported for the hostname of the Collector.
+type CollectorMetricsConfig struct {
+	// Enabled enables the Collector metrics.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Interval is the interval at which the Collector metrics are sent.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+func (c CollectorMetricsConfig) validate() error {
+	if c.Enabled && c.Interval <= 0 {
+		return errCollectorInterval
+	}
+	return nil
 }
 
 // LimitedTLSClientSetting is a subset of TLSClientSetting, see LimitedHTTPClientSettings for more details
@@ -362,6 +581,12 @@ type Config struct {
 	// HostMetadata defines the host metadata specific configuration
 	HostMetadata HostMetadataConfig `mapstructure:"host_metadata"`
 
+	// UnresolvedHostname defines the handling of the telemetry whose hostname cannot be resolved.
+	UnresolvedHostname UnresolvedHostnameConfig `mapstructure:"unresolved_hostname"`
+
+	// CollectorMetrics defines the reporting of the Collector's own health as Datadog metrics.
+	CollectorMetrics CollectorMetricsConfig `mapstructure:"collector_metrics"`
+
 	// OnlyMetadata defines whether to only send metadata
 	// This is useful for agent-collector setups, so that
 	// metadata about a host is sent to the backend even
@@ -382,6 +607,14 @@ func (c *Config) logWarnings(logger *zap.Logger) {
 	}
 }
 
+// queueSettings returns the sending queue settings of a signal, which default to the top-level ones.
+func (c *Config) queueSettings(signal *exporterhelper.QueueSettings) exporterhelper.QueueSettings {
+	if signal != nil {
+		return *signal
+	}
+	return c.QueueSettings
+}
+
 var _ component.Config = (*Config)(nil)
 
 // Validate the configuration for errors. This is required by component.Config.
@@ -423,6 +656,38 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err = c.API.Failover.validate(c.API.Site); err != nil {
+		return err
+	}
+
+	if err = c.HostMetadata.AuditLogs.validate(c.HostMetadata.Enabled); err != nil {
+		return err
+	}
+
+	if err = c.UnresolvedHostname.validate(); err != nil {
+		return err
+	}
+
+	if err = c.CollectorMetrics.validate(); err != nil {
+		return err
+	}
+
+	if err = c.Metrics.Batch.validate("metrics"); err != nil {
+		return err
+	}
+
+	if err = c.Logs.Batch.validate("logs"); err != nil {
+		return err
+	}
+
+	if err = c.Traces.Batch.validate(); err != nil {
+		return err
+	}
+
+	if !c.Metrics.Enabled && !c.Traces.Enabled && !c.Logs.Enabled {
+		return errAllSignalsDisabled
+	}
+
 	return nil
 }
 
@@ -511,7 +776,28 @@ func (c *Config) Unmarshal(configMap *confmap.Conf) error {
 	}
 	c.warnings = append(c.warnings, renamingWarnings...)
 
+	// The per-signal sending queues start from the top-level settings, so that only the overridden ones need to be set.
+	for key, queue := range map[string]**exporterhelper.QueueSettings{
+		"metrics::sending_queue": &c.Metrics.SendingQueue,
+		"traces::sending_queue":  &c.Traces.SendingQueue,
+		"logs::sending_queue":    &c.Logs.SendingQueue,
+	} {
+		if !configMap.IsSet(key) {
+			continue
+		}
+		settings := c.QueueSettings
+		sub, err := configMap.Sub(key)
+		if err != nil {
+			return err
+		}
+		if err = sub.Unmarshal(&settings, confmap.WithErrorUnused()); err != nil {
+			return err
+		}
+		*queue = &settings
+	}
+
 	c.API.Key = configopaque.String(strings.TrimSpace(string(c.API.Key)))
+	c.API.Failover.Key = configopaque.String(strings.TrimSpace(string(c.API.Failover.Key)))
 
 	// If an endpoint is not explicitly set, override it based on the site.
 	if !configMap.IsSet("metrics::endpoint") {
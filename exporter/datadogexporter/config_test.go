@@ -5,9 +5,13 @@ package datadogexporter
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
 
 func TestValidate(t *testing.T) {
@@ -39,11 +43,27 @@ func TestValidate(t *testing.T) {
 			},
 			err: errNoMetadata.Error(),
 		},
+		{
+			name: "unresolved hostname attribute missing",
+			cfg: &Config{
+				API:                APIConfig{Key: "notnull"},
+				UnresolvedHostname: UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyUseAttribute},
+			},
+			err: errNoHostnameKey.Error(),
+		},
+		{
+			name: "collector metrics without interval",
+			cfg: &Config{
+				API:              APIConfig{Key: "notnull"},
+				CollectorMetrics: CollectorMetricsConfig{Enabled: true},
+			},
+			err: errCollectorInterval.Error(),
+		},
 		{
 			name: "span name remapping valid",
 			cfg: &Config{
 				API:    APIConfig{Key: "notnull"},
-				Traces: TracesConfig{SpanNameRemappings: map[string]string{"old.opentelemetryspan.name": "updated.name"}},
+				Traces: TracesConfig{Enabled: true, SpanNameRemappings: map[string]string{"old.opentelemetryspan.name": "updated.name"}},
 			},
 		},
 		{
@@ -66,7 +86,7 @@ func TestValidate(t *testing.T) {
 			name: "ignore resources valid",
 			cfg: &Config{
 				API:    APIConfig{Key: "notnull"},
-				Traces: TracesConfig{IgnoreResources: []string{"[123]"}},
+				Traces: TracesConfig{Enabled: true, IgnoreResources: []string{"[123]"}},
 			},
 		},
 		{
@@ -93,7 +113,8 @@ func TestValidate(t *testing.T) {
 		{
 			name: "TLS settings are valid",
 			cfg: &Config{
-				API: APIConfig{Key: "notnull"},
+				API:     APIConfig{Key: "notnull"},
+				Metrics: MetricsConfig{Enabled: true},
 				LimitedHTTPClientSettings: LimitedHTTPClientSettings{
 					TLSSetting: LimitedTLSClientSettings{
 						InsecureSkipVerify: true,
@@ -101,6 +122,131 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "failover is valid",
+			cfg: &Config{
+				API: APIConfig{
+					Key:      "notnull",
+					Site:     "datadoghq.com",
+					Failover: FailoverConfig{Site: "us5.datadoghq.com", FailureThreshold: 5, ProbeInterval: time.Minute},
+				},
+				Logs: LogsConfig{Enabled: true},
+			},
+		},
+		{
+			name: "failover to the primary site",
+			cfg: &Config{
+				API: APIConfig{
+					Key:      "notnull",
+					Site:     "datadoghq.com",
+					Failover: FailoverConfig{Site: "datadoghq.com", FailureThreshold: 5, ProbeInterval: time.Minute},
+				},
+			},
+			err: "api::failover::site must be different from api::site",
+		},
+		{
+			name: "failover without failure threshold",
+			cfg: &Config{
+				API: APIConfig{
+					Key:      "notnull",
+					Site:     "datadoghq.com",
+					Failover: FailoverConfig{Site: "us5.datadoghq.com", ProbeInterval: time.Minute},
+				},
+			},
+			err: "api::failover::failure_threshold must be positive",
+		},
+		{
+			name: "failover without probe interval",
+			cfg: &Config{
+				API: APIConfig{
+					Key:      "notnull",
+					Site:     "datadoghq.com",
+					Failover: FailoverConfig{Site: "us5.datadoghq.com", FailureThreshold: 5},
+				},
+			},
+			err: "api::failover::probe_interval must be positive",
+		},
+		{
+			name: "audit logs are valid",
+			cfg: &Config{
+				API:     APIConfig{Key: "notnull"},
+				Metrics: MetricsConfig{Enabled: true},
+				HostMetadata: HostMetadataConfig{
+					Enabled:   true,
+					AuditLogs: AuditLogsConfig{Enabled: true, Exporter: component.NewIDWithName("file", "audit")},
+				},
+			},
+		},
+		{
+			name: "audit logs without host metadata",
+			cfg: &Config{
+				API: APIConfig{Key: "notnull"},
+				HostMetadata: HostMetadataConfig{
+					AuditLogs: AuditLogsConfig{Enabled: true, Exporter: component.NewIDWithName("file", "audit")},
+				},
+			},
+			err: errNoAuditLogs.Error(),
+		},
+		{
+			name: "audit logs without exporter",
+			cfg: &Config{
+				API: APIConfig{Key: "notnull"},
+				HostMetadata: HostMetadataConfig{
+					Enabled:   true,
+					AuditLogs: AuditLogsConfig{Enabled: true},
+				},
+			},
+			err: errNoAuditLogsID.Error(),
+		},
+		{
+			name: "single signal enabled",
+			cfg: &Config{
+				API:     APIConfig{Key: "notnull"},
+				Metrics: MetricsConfig{Enabled: true},
+				Traces:  TracesConfig{Enabled: false},
+				Logs:    LogsConfig{Enabled: false},
+			},
+		},
+		{
+			name: "all signals disabled",
+			cfg: &Config{
+				API: APIConfig{Key: "notnull"},
+			},
+			err: errAllSignalsDisabled.Error(),
+		},
+		{
+			name: "batch settings are valid",
+			cfg: &Config{
+				API:     APIConfig{Key: "notnull"},
+				Metrics: MetricsConfig{Enabled: true, Batch: BatchConfig{MaxSize: 500}},
+				Traces:  TracesConfig{Batch: TracesBatchConfig{Timeout: time.Second}},
+				Logs:    LogsConfig{Batch: BatchConfig{MaxSize: 1000}},
+			},
+		},
+		{
+			name: "negative metrics batch size",
+			cfg: &Config{
+				API:     APIConfig{Key: "notnull"},
+				Metrics: MetricsConfig{Enabled: true, Batch: BatchConfig{MaxSize: -1}},
+			},
+			err: "metrics::batch::max_size must not be negative",
+		},
+		{
+			name: "negative logs batch size",
+			cfg: &Config{
+				API:  APIConfig{Key: "notnull"},
+				Logs: LogsConfig{Enabled: true, Batch: BatchConfig{MaxSize: -1}},
+			},
+			err: "logs::batch::max_size must not be negative",
+		},
+		{
+			name: "negative traces batch timeout",
+			cfg: &Config{
+				API:    APIConfig{Key: "notnull"},
+				Traces: TracesConfig{Enabled: true, Batch: TracesBatchConfig{Timeout: -time.Second}},
+			},
+			err: errNegativeTracesBatchTimeout.Error(),
+		},
 	}
 	for _, testInstance := range tests {
 		t.Run(testInstance.name, func(t *testing.T) {
@@ -227,6 +373,15 @@ func TestUnmarshal(t *testing.T) {
 			}),
 			err: errEmptyEndpoint.Error(),
 		},
+		{
+			name: "invalid unresolved hostname policy",
+			configMap: confmap.NewFromStringMap(map[string]interface{}{
+				"unresolved_hostname": map[string]interface{}{
+					"policy": "invalid",
+				},
+			}),
+			err: "1 error(s) decoding:\n\n* error decoding 'unresolved_hostname.policy': invalid unresolved hostname policy \"invalid\"",
+		},
 	}
 
 	f := NewFactory()
@@ -242,3 +397,45 @@ func TestUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalSendingQueue(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	err := cfg.Unmarshal(confmap.NewFromStringMap(map[string]interface{}{
+		"sending_queue": map[string]interface{}{
+			"num_consumers": 4,
+		},
+		"traces": map[string]interface{}{
+			"sending_queue": map[string]interface{}{
+				"queue_size": 50,
+			},
+		},
+		"logs": map[string]interface{}{
+			"sending_queue": map[string]interface{}{
+				"enabled": false,
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	queue := exporterhelper.NewDefaultQueueSettings()
+	queue.NumConsumers = 4
+	assert.Nil(t, cfg.Metrics.SendingQueue)
+	assert.Equal(t, queue, cfg.queueSettings(cfg.Metrics.SendingQueue))
+
+	tracesQueue := queue
+	tracesQueue.QueueSize = 50
+	assert.Equal(t, tracesQueue, cfg.queueSettings(cfg.Traces.SendingQueue))
+
+	logsQueue := queue
+	logsQueue.Enabled = false
+	assert.Equal(t, logsQueue, cfg.queueSettings(cfg.Logs.SendingQueue))
+
+	err = cfg.Unmarshal(confmap.NewFromStringMap(map[string]interface{}{
+		"metrics": map[string]interface{}{
+			"sending_queue": map[string]interface{}{
+				"unknown": true,
+			},
+		},
+	}))
+	assert.ErrorContains(t, err, "unknown")
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/trace/agent"
+	"github.com/DataDog/datadog-agent/pkg/trace/api"
 	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes/source"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
@@ -25,7 +26,6 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/hostmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
 var mertricExportNativeClientFeatureGate = featuregate.GlobalRegistry().MustRegister(
@@ -51,6 +51,7 @@ func enableZorkianMetricExport() error {
 
 type factory struct {
 	onceMetadata sync.Once
+	auditLogs    hostmetadata.AuditLogs
 
 	onceProvider   sync.Once
 	sourceProvider source.Provider
@@ -58,6 +59,9 @@ type factory struct {
 
 	wg sync.WaitGroup // waits for agent to exit
 
+	onceCollectorMetrics sync.Once
+	collectorMetrics     *collectorMetrics
+
 	registry *featuregate.Registry
 }
 
@@ -82,7 +86,7 @@ func (f *factory) TraceAgent(ctx context.Context, params exporter.CreateSettings
 }
 
 func newFactoryWithRegistry(registry *featuregate.Registry) exporter.Factory {
-	f := &factory{registry: registry}
+	f := &factory{registry: registry, collectorMetrics: newCollectorMetrics()}
 	return exporter.NewFactory(
 		metadata.Type,
 		f.createDefaultConfig,
@@ -112,9 +116,14 @@ func (f *factory) createDefaultConfig() component.Config {
 
 		API: APIConfig{
 			Site: "datadoghq.com",
+			Failover: FailoverConfig{
+				FailureThreshold: 5,
+				ProbeInterval:    time.Minute,
+			},
 		},
 
 		Metrics: MetricsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://api.datadoghq.com",
 			},
@@ -136,6 +145,7 @@ func (f *factory) createDefaultConfig() component.Config {
 		},
 
 		Traces: TracesConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://trace.agent.datadoghq.com",
 			},
@@ -143,6 +153,7 @@ func (f *factory) createDefaultConfig() component.Config {
 		},
 
 		Logs: LogsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://http-intake.logs.datadoghq.com",
 			},
@@ -152,6 +163,14 @@ func (f *factory) createDefaultConfig() component.Config {
 			Enabled:        true,
 			HostnameSource: HostnameSourceConfigOrSystem,
 		},
+
+		UnresolvedHostname: UnresolvedHostnameConfig{
+			Policy: UnresolvedHostnamePolicyFallbackToCollectorHost,
+		},
+
+		CollectorMetrics: CollectorMetricsConfig{
+			Interval: time.Minute,
+		},
 	}
 }
 
@@ -166,6 +185,26 @@ func checkAndCastConfig(c component.Config, logger *zap.Logger) *Config {
 	return cfg
 }
 
+// startAuditLogs returns a function looking up the exporter of the host metadata audit logs.
+func (f *factory) startAuditLogs(cfg *Config) component.StartFunc {
+	return func(_ context.Context, host component.Host) error {
+		if !cfg.HostMetadata.AuditLogs.Enabled {
+			return nil
+		}
+		return f.auditLogs.Start(host, cfg.HostMetadata.AuditLogs.Exporter)
+	}
+}
+
+// startCollectorMetrics starts reporting the Collector metrics, once across all exporters.
+func (f *factory) startCollectorMetrics(ctx context.Context, set exporter.CreateSettings, cfg *Config, sourceProvider source.Provider) {
+	if !cfg.CollectorMetrics.Enabled {
+		return
+	}
+	f.onceCollectorMetrics.Do(func() {
+		go collectorMetricsPusher(ctx, set, cfg, sourceProvider, f.collectorMetrics)
+	})
+}
+
 // createMetricsExporter creates a metrics exporter based on this config.
 func (f *factory) createMetricsExporter(
 	ctx context.Context,
@@ -173,6 +212,10 @@ func (f *factory) createMetricsExporter(
 	c component.Config,
 ) (exporter.Metrics, error) {
 	cfg := checkAndCastConfig(c, set.TelemetrySettings.Logger)
+	if !cfg.Metrics.Enabled {
+		set.Logger.Info("Metrics are disabled by metrics::enabled, they will be dropped")
+		return exporterhelper.NewMetricsExporter(ctx, set, cfg, func(context.Context, pmetric.Metrics) error { return nil })
+	}
 
 	hostProvider, err := f.SourceProvider(set.TelemetrySettings, cfg.Hostname)
 	if err != nil {
@@ -182,11 +225,6 @@ func (f *factory) createMetricsExporter(
 	ctx, cancel := context.WithCancel(ctx)
 	// cancel() runs on shutdown
 	var pushMetricsFn consumer.ConsumeMetricsFunc
-	traceagent, err := f.TraceAgent(ctx, set, cfg, hostProvider)
-	if err != nil {
-		cancel()
-		return nil, fmt.Errorf("failed to start trace-agent: %w", err)
-	}
 	if cfg.OnlyMetadata {
 		pushMetricsFn = func(_ context.Context, md pmetric.Metrics) error {
 			// only sending metadata use only metrics
@@ -195,19 +233,31 @@ func (f *factory) createMetricsExporter(
 				if md.ResourceMetrics().Len() > 0 {
 					attrs = md.ResourceMetrics().At(0).Resource().Attributes()
 				}
-				go hostmetadata.Pusher(ctx, set, newMetadataConfigfromConfig(cfg), hostProvider, attrs)
+				go hostmetadata.Pusher(ctx, set, newMetadataConfigfromConfig(cfg, &f.auditLogs), hostProvider, attrs)
 			})
 
 			return nil
 		}
 	} else {
-		exp, metricsErr := newMetricsExporter(ctx, set, cfg, &f.onceMetadata, hostProvider, traceagent)
+		// The trace agent only forwards the APM stats computed by other components, which are
+		// dropped when traces are disabled.
+		var statsProcessor api.StatsProcessor
+		if cfg.Traces.Enabled {
+			traceagent, agentErr := f.TraceAgent(ctx, set, cfg, hostProvider)
+			if agentErr != nil {
+				cancel()
+				return nil, fmt.Errorf("failed to start trace-agent: %w", agentErr)
+			}
+			statsProcessor = traceagent
+		}
+		exp, metricsErr := newMetricsExporter(ctx, set, cfg, &f.onceMetadata, &f.auditLogs, hostProvider, statsProcessor)
 		if metricsErr != nil {
 			cancel()    // first cancel context
 			f.wg.Wait() // then wait for shutdown
 			return nil, metricsErr
 		}
-		pushMetricsFn = exp.PushMetricsDataScrubbed
+		pushMetricsFn = countFailures(f.collectorMetrics, "metrics", exp.PushMetricsDataScrubbed)
+		f.startCollectorMetrics(ctx, set, cfg, hostProvider)
 	}
 
 	exporter, err := exporterhelper.NewMetricsExporter(
@@ -219,7 +269,11 @@ func (f *factory) createMetricsExporter(
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0 * time.Second}),
 		// We use our own custom mechanism for retries, since we hit several endpoints.
 		exporterhelper.WithRetry(exporterhelper.RetrySettings{Enabled: false}),
-		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithQueue(cfg.queueSettings(cfg.Metrics.SendingQueue)),
+		exporterhelper.WithStart(f.startAuditLogs(cfg)),
+		// when resource attributes are sent as tags, resources are moved out of the payload while mapped,
+		// and their data points are prepared for the resource tags
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: cfg.UnresolvedHostname.mutatesData() || cfg.Metrics.ExporterConfig.ResourceAttributesAsTags}),
 		exporterhelper.WithShutdown(func(context.Context) error {
 			cancel()
 			return nil
@@ -228,8 +282,7 @@ func (f *factory) createMetricsExporter(
 	if err != nil {
 		return nil, err
 	}
-	return resourcetotelemetry.WrapMetricsExporter(
-		resourcetotelemetry.Settings{Enabled: cfg.Metrics.ExporterConfig.ResourceAttributesAsTags}, exporter), nil
+	return exporter, nil
 }
 
 // createTracesExporter creates a trace exporter based on this config.
@@ -239,6 +292,10 @@ func (f *factory) createTracesExporter(
 	c component.Config,
 ) (exporter.Traces, error) {
 	cfg := checkAndCastConfig(c, set.TelemetrySettings.Logger)
+	if !cfg.Traces.Enabled {
+		set.Logger.Info("Traces are disabled by traces::enabled, they will be dropped")
+		return exporterhelper.NewTracesExporter(ctx, set, cfg, func(context.Context, ptrace.Traces) error { return nil })
+	}
 
 	var (
 		pusher consumer.ConsumeTracesFunc
@@ -251,11 +308,6 @@ func (f *factory) createTracesExporter(
 	}
 	ctx, cancel := context.WithCancel(ctx)
 	// cancel() runs on shutdown
-	traceagent, err := f.TraceAgent(ctx, set, cfg, hostProvider)
-	if err != nil {
-		cancel()
-		return nil, fmt.Errorf("failed to start trace-agent: %w", err)
-	}
 	if cfg.OnlyMetadata {
 		// only host metadata needs to be sent, once.
 		pusher = func(_ context.Context, td ptrace.Traces) error {
@@ -264,7 +316,7 @@ func (f *factory) createTracesExporter(
 				if td.ResourceSpans().Len() > 0 {
 					attrs = td.ResourceSpans().At(0).Resource().Attributes()
 				}
-				go hostmetadata.Pusher(ctx, set, newMetadataConfigfromConfig(cfg), hostProvider, attrs)
+				go hostmetadata.Pusher(ctx, set, newMetadataConfigfromConfig(cfg, &f.auditLogs), hostProvider, attrs)
 			})
 			return nil
 		}
@@ -273,13 +325,19 @@ func (f *factory) createTracesExporter(
 			return nil
 		}
 	} else {
-		tracex, err2 := newTracesExporter(ctx, set, cfg, &f.onceMetadata, hostProvider, traceagent)
+		traceagent, err2 := f.TraceAgent(ctx, set, cfg, hostProvider)
+		if err2 != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to start trace-agent: %w", err2)
+		}
+		tracex, err2 := newTracesExporter(ctx, set, cfg, &f.onceMetadata, &f.auditLogs, hostProvider, traceagent)
 		if err2 != nil {
 			cancel()
 			f.wg.Wait() // then wait for shutdown
 			return nil, err2
 		}
-		pusher = tracex.consumeTraces
+		pusher = countFailures(f.collectorMetrics, "traces", tracex.consumeTraces)
+		f.startCollectorMetrics(ctx, set, cfg, hostProvider)
 		stop = func(context.Context) error {
 			cancel() // first cancel context
 			return nil
@@ -295,7 +353,9 @@ func (f *factory) createTracesExporter(
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0 * time.Second}),
 		// We don't do retries on traces because of deduping concerns on APM Events.
 		exporterhelper.WithRetry(exporterhelper.RetrySettings{Enabled: false}),
-		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithQueue(cfg.queueSettings(cfg.Traces.SendingQueue)),
+		exporterhelper.WithStart(f.startAuditLogs(cfg)),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: cfg.UnresolvedHostname.mutatesData()}),
 		exporterhelper.WithShutdown(stop),
 	)
 }
@@ -307,6 +367,10 @@ func (f *factory) createLogsExporter(
 	c component.Config,
 ) (exporter.Logs, error) {
 	cfg := checkAndCastConfig(c, set.TelemetrySettings.Logger)
+	if !cfg.Logs.Enabled {
+		set.Logger.Info("Logs are disabled by logs::enabled, they will be dropped")
+		return exporterhelper.NewLogsExporter(ctx, set, cfg, func(context.Context, plog.Logs) error { return nil })
+	}
 
 	var pusher consumer.ConsumeLogsFunc
 	hostProvider, err := f.SourceProvider(set.TelemetrySettings, cfg.Hostname)
@@ -320,18 +384,19 @@ func (f *factory) createLogsExporter(
 		pusher = func(_ context.Context, td plog.Logs) error {
 			f.onceMetadata.Do(func() {
 				attrs := pcommon.NewMap()
-				go hostmetadata.Pusher(ctx, set, newMetadataConfigfromConfig(cfg), hostProvider, attrs)
+				go hostmetadata.Pusher(ctx, set, newMetadataConfigfromConfig(cfg, &f.auditLogs), hostProvider, attrs)
 			})
 			return nil
 		}
 	} else {
-		exp, err := newLogsExporter(ctx, set, cfg, &f.onceMetadata, hostProvider)
+		exp, err := newLogsExporter(ctx, set, cfg, &f.onceMetadata, &f.auditLogs, hostProvider)
 		if err != nil {
 			cancel()
 			f.wg.Wait() // then wait for shutdown
 			return nil, err
 		}
-		pusher = exp.consumeLogs
+		pusher = countFailures(f.collectorMetrics, "logs", exp.consumeLogs)
+		f.startCollectorMetrics(ctx, set, cfg, hostProvider)
 	}
 	return exporterhelper.NewLogsExporter(
 		ctx,
@@ -341,7 +406,9 @@ func (f *factory) createLogsExporter(
 		// explicitly disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0 * time.Second}),
 		exporterhelper.WithRetry(cfg.RetrySettings),
-		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithQueue(cfg.queueSettings(cfg.Logs.SendingQueue)),
+		exporterhelper.WithStart(f.startAuditLogs(cfg)),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: cfg.UnresolvedHostname.mutatesData()}),
 		exporterhelper.WithShutdown(func(context.Context) error {
 			cancel()
 			return nil
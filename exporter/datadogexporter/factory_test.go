@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/DataDog/opentelemetry-mapping-go/pkg/inframetadata/payload"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +19,8 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata"
@@ -36,9 +39,14 @@ func TestCreateDefaultConfig(t *testing.T) {
 
 		API: APIConfig{
 			Site: "datadoghq.com",
+			Failover: FailoverConfig{
+				FailureThreshold: 5,
+				ProbeInterval:    time.Minute,
+			},
 		},
 
 		Metrics: MetricsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://api.datadoghq.com",
 			},
@@ -56,12 +64,14 @@ func TestCreateDefaultConfig(t *testing.T) {
 		},
 
 		Traces: TracesConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://trace.agent.datadoghq.com",
 			},
 			IgnoreResources: []string{},
 		},
 		Logs: LogsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://http-intake.logs.datadoghq.com",
 			},
@@ -71,7 +81,9 @@ func TestCreateDefaultConfig(t *testing.T) {
 			Enabled:        true,
 			HostnameSource: HostnameSourceConfigOrSystem,
 		},
-		OnlyMetadata: false,
+		UnresolvedHostname: UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyFallbackToCollectorHost},
+		CollectorMetrics:   CollectorMetricsConfig{Interval: time.Minute},
+		OnlyMetadata:       false,
 	}, cfg, "failed to create default config")
 
 	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
@@ -97,9 +109,14 @@ func TestLoadConfig(t *testing.T) {
 					Key:              "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 					Site:             "datadoghq.com",
 					FailOnInvalidKey: false,
+					Failover: FailoverConfig{
+						FailureThreshold: 5,
+						ProbeInterval:    time.Minute,
+					},
 				},
 
 				Metrics: MetricsConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://api.datadoghq.com",
 					},
@@ -117,12 +134,14 @@ func TestLoadConfig(t *testing.T) {
 				},
 
 				Traces: TracesConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://trace.agent.datadoghq.com",
 					},
 					IgnoreResources: []string{},
 				},
 				Logs: LogsConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://http-intake.logs.datadoghq.com",
 					},
@@ -131,7 +150,9 @@ func TestLoadConfig(t *testing.T) {
 					Enabled:        true,
 					HostnameSource: HostnameSourceConfigOrSystem,
 				},
-				OnlyMetadata: false,
+				UnresolvedHostname: UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyFallbackToCollectorHost},
+				CollectorMetrics:   CollectorMetricsConfig{Interval: time.Minute},
+				OnlyMetadata:       false,
 			},
 		},
 		{
@@ -147,8 +168,15 @@ func TestLoadConfig(t *testing.T) {
 					Key:              "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 					Site:             "datadoghq.eu",
 					FailOnInvalidKey: true,
+					Failover: FailoverConfig{
+						Site:             "us5.datadoghq.com",
+						Key:              "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+						FailureThreshold: 3,
+						ProbeInterval:    30 * time.Second,
+					},
 				},
 				Metrics: MetricsConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://api.datadoghq.eu",
 					},
@@ -165,6 +193,7 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				Traces: TracesConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://trace.agent.datadoghq.eu",
 					},
@@ -176,6 +205,7 @@ func TestLoadConfig(t *testing.T) {
 					IgnoreResources:        []string{},
 				},
 				Logs: LogsConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://http-intake.logs.datadoghq.eu",
 					},
@@ -185,6 +215,8 @@ func TestLoadConfig(t *testing.T) {
 					Enabled:        true,
 					HostnameSource: HostnameSourceConfigOrSystem,
 				},
+				UnresolvedHostname: UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyFallbackToCollectorHost},
+				CollectorMetrics:   CollectorMetricsConfig{Interval: time.Minute},
 			},
 		},
 		{
@@ -200,8 +232,13 @@ func TestLoadConfig(t *testing.T) {
 					Key:              "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 					Site:             "datadoghq.eu",
 					FailOnInvalidKey: false,
+					Failover: FailoverConfig{
+						FailureThreshold: 5,
+						ProbeInterval:    time.Minute,
+					},
 				},
 				Metrics: MetricsConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://api.datadoghq.test",
 					},
@@ -218,6 +255,7 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				Traces: TracesConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://trace.agent.datadoghq.test",
 					},
@@ -228,6 +266,7 @@ func TestLoadConfig(t *testing.T) {
 					IgnoreResources: []string{},
 				},
 				Logs: LogsConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: "https://http-intake.logs.datadoghq.test",
 					},
@@ -237,6 +276,8 @@ func TestLoadConfig(t *testing.T) {
 					HostnameSource: HostnameSourceConfigOrSystem,
 					Tags:           []string{"example:tag"},
 				},
+				UnresolvedHostname: UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyFallbackToCollectorHost},
+				CollectorMetrics:   CollectorMetricsConfig{Interval: time.Minute},
 			},
 		},
 	}
@@ -539,6 +580,38 @@ func TestCreateAPIExporterFailOnInvalidKey(t *testing.T) {
 	})
 }
 
+func TestCreateDisabledExporters(t *testing.T) {
+	// API key validation would fail if any client was created.
+	server := testutil.DatadogServerMock(testutil.ValidateAPIKeyEndpointInvalid)
+	defer server.Close()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.API.Key = "notnull"
+	cfg.API.FailOnInvalidKey = true
+	cfg.Metrics.TCPAddr.Endpoint = server.URL
+	cfg.Metrics.Enabled = false
+	cfg.Traces.Enabled = false
+	cfg.Logs.Enabled = false
+
+	ctx := context.Background()
+	mexp, err := factory.CreateMetricsExporter(ctx, exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	texp, err := factory.CreateTracesExporter(ctx, exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	lexp, err := factory.CreateLogsExporter(ctx, exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	assert.NoError(t, mexp.ConsumeMetrics(ctx, pmetric.NewMetrics()))
+	assert.NoError(t, texp.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.NoError(t, lexp.ConsumeLogs(ctx, plog.NewLogs()))
+	assert.Empty(t, server.MetadataChan)
+
+	assert.NoError(t, mexp.Shutdown(ctx))
+	assert.NoError(t, texp.Shutdown(ctx))
+	assert.NoError(t, lexp.Shutdown(ctx))
+}
+
 func TestCreateAPILogsExporter(t *testing.T) {
 	server := testutil.DatadogLogServerMock()
 	defer server.Close()
@@ -579,8 +652,8 @@ func TestOnlyMetadata(t *testing.T) {
 		QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
 
 		API:          APIConfig{Key: "notnull"},
-		Metrics:      MetricsConfig{TCPAddr: confignet.TCPAddr{Endpoint: server.URL}},
-		Traces:       TracesConfig{TCPAddr: confignet.TCPAddr{Endpoint: server.URL}},
+		Metrics:      MetricsConfig{Enabled: true, TCPAddr: confignet.TCPAddr{Endpoint: server.URL}},
+		Traces:       TracesConfig{Enabled: true, TCPAddr: confignet.TCPAddr{Endpoint: server.URL}},
 		OnlyMetadata: true,
 
 		HostMetadata: HostMetadataConfig{
@@ -8,8 +8,8 @@ import (
 )
 
 // newMetadataConfigfromConfig creates a new metadata pusher config from the main
-func newMetadataConfigfromConfig(cfg *Config) hostmetadata.PusherConfig {
-	return hostmetadata.PusherConfig{
+func newMetadataConfigfromConfig(cfg *Config, auditLogs *hostmetadata.AuditLogs) hostmetadata.PusherConfig {
+	pcfg := hostmetadata.PusherConfig{
 		ConfigHostname:      cfg.Hostname,
 		ConfigTags:          cfg.HostMetadata.Tags,
 		MetricsEndpoint:     cfg.Metrics.Endpoint,
@@ -19,4 +19,8 @@ func newMetadataConfigfromConfig(cfg *Config) hostmetadata.PusherConfig {
 		TimeoutSettings:     cfg.TimeoutSettings,
 		RetrySettings:       cfg.RetrySettings,
 	}
+	if cfg.HostMetadata.AuditLogs.Enabled {
+		pcfg.AuditLogs = auditLogs
+	}
+	return pcfg
 }
@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clientutil // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// FailoverSettings configures the switch from the primary Datadog site to a secondary site.
+type FailoverSettings struct {
+	// PrimarySite is the site the requests are sent to, e.g. datadoghq.com.
+	PrimarySite string
+	// Site is the secondary site the requests are sent to when the primary site is unavailable.
+	Site string
+	// APIKey is the API key of the organization on the secondary site.
+	APIKey string
+	// FailureThreshold is the number of consecutive failed requests after which the secondary site is used.
+	FailureThreshold int
+	// ProbeInterval is the time between two attempts to send a request to the primary site
+	// while the secondary site is used.
+	ProbeInterval time.Duration
+}
+
+// apiKeyHeader is the header holding the API key, as set by the Datadog clients.
+const apiKeyHeader = "DD-API-KEY"
+
+// failoverTransport sends the requests to the secondary site after FailureThreshold
+// consecutive failures of the primary site. While the secondary site is used, a request
+// is sent to the primary site every ProbeInterval, and the transport switches back to the
+// primary site as soon as one of these requests succeeds.
+type failoverTransport struct {
+	next     http.RoundTripper
+	settings FailoverSettings
+	logger   *zap.Logger
+	now      func() time.Time
+
+	mu         sync.Mutex
+	failures   int
+	failedOver bool
+	lastProbe  time.Time
+}
+
+// WithFailover wraps the transport of the client so that requests switch to the secondary
+// site of the settings when the primary site is unavailable. Only requests to hosts of the
+// primary site are switched, requests to custom endpoints are sent as is.
+func WithFailover(client *http.Client, settings FailoverSettings, logger *zap.Logger) *http.Client {
+	if settings.Site == "" {
+		return client
+	}
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client.Transport = &failoverTransport{
+		next:     next,
+		settings: settings,
+		logger:   logger,
+		now:      time.Now,
+	}
+	return client
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.isPrimarySiteHost(req.URL.Hostname()) {
+		return t.next.RoundTrip(req)
+	}
+
+	useSecondary, probe := t.route()
+	if !useSecondary {
+		resp, err := t.next.RoundTrip(req)
+		t.recordPrimary(resp, err)
+		return resp, err
+	}
+
+	// The request can only be sent a second time if its body can be read again
+	if probe && (req.Body == nil || req.GetBody != nil) {
+		resp, err := t.next.RoundTrip(req)
+		if !isFailure(resp, err) {
+			t.switchBack()
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+	}
+
+	secondary, err := t.secondaryRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(secondary)
+}
+
+// route reports whether the request must be sent to the secondary site, and if so
+// whether it must first be sent to the primary site to probe its availability.
+func (t *failoverTransport) route() (useSecondary bool, probe bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.failedOver {
+		return false, false
+	}
+	if now := t.now(); now.Sub(t.lastProbe) >= t.settings.ProbeInterval {
+		t.lastProbe = now
+		return true, true
+	}
+	return true, false
+}
+
+func (t *failoverTransport) recordPrimary(resp *http.Response, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !isFailure(resp, err) {
+		t.failures = 0
+		return
+	}
+	t.failures++
+	if t.failures >= t.settings.FailureThreshold && !t.failedOver {
+		t.failedOver = true
+		t.lastProbe = t.now()
+		t.logger.Warn("Primary Datadog site is unavailable, switching to the failover site",
+			zap.String("site", t.settings.PrimarySite),
+			zap.String("failover_site", t.settings.Site),
+			zap.Int("failures", t.failures))
+	}
+}
+
+func (t *failoverTransport) switchBack() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.failedOver {
+		t.failedOver = false
+		t.failures = 0
+		t.logger.Info("Primary Datadog site is available again, switching back",
+			zap.String("site", t.settings.PrimarySite))
+	}
+}
+
+// secondaryRequest returns a copy of the request addressed to the secondary site.
+func (t *failoverTransport) secondaryRequest(req *http.Request) (*http.Request, error) {
+	secondary := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		secondary.Body = body
+	}
+
+	host := strings.TrimSuffix(req.URL.Hostname(), t.settings.PrimarySite) + t.settings.Site
+	if port := req.URL.Port(); port != "" {
+		host += ":" + port
+	}
+	secondary.URL.Host = host
+	secondary.Host = ""
+
+	if t.settings.APIKey != "" {
+		if secondary.Header.Get(apiKeyHeader) != "" {
+			secondary.Header.Set(apiKeyHeader, t.settings.APIKey)
+		}
+		if query := secondary.URL.Query(); query.Has("api_key") {
+			query.Set("api_key", t.settings.APIKey)
+			secondary.URL.RawQuery = query.Encode()
+		}
+	}
+	return secondary, nil
+}
+
+func (t *failoverTransport) isPrimarySiteHost(host string) bool {
+	return host == t.settings.PrimarySite || strings.HasSuffix(host, "."+t.settings.PrimarySite)
+}
+
+// isFailure reports whether a request failed because the site is unavailable.
+// Rejected payloads and throttling are not failures of the site.
+func isFailure(resp *http.Response, err error) bool {
+	return err != nil || resp.StatusCode >= http.StatusInternalServerError
+}
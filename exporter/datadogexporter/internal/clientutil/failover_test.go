@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clientutil
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// siteTransport answers requests with the status of the site of their host
+type siteTransport struct {
+	down     map[string]bool
+	requests []*http.Request
+	bodies   []string
+}
+
+func (s *siteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.requests = append(s.requests, req)
+	body := ""
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(b)
+	}
+	s.bodies = append(s.bodies, body)
+
+	for site, down := range s.down {
+		if down && req.URL.Hostname() == "api."+site {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: http.NoBody}, nil
+		}
+	}
+	return &http.Response{StatusCode: http.StatusAccepted, Body: http.NoBody}, nil
+}
+
+func (s *siteTransport) lastHost() string {
+	return s.requests[len(s.requests)-1].URL.Host
+}
+
+func newFailoverClient(next *siteTransport) (*http.Client, *failoverTransport) {
+	client := WithFailover(&http.Client{Transport: next}, FailoverSettings{
+		PrimarySite:      "datadoghq.com",
+		Site:             "datadoghq.eu",
+		APIKey:           "secondary",
+		FailureThreshold: 2,
+		ProbeInterval:    time.Minute,
+	}, zap.NewNop())
+	return client, client.Transport.(*failoverTransport)
+}
+
+func post(t *testing.T, client *http.Client, url string) int {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString("payload"))
+	require.NoError(t, err)
+	req.Header.Set("DD-API-KEY", "primary")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestWithFailoverDisabled(t *testing.T) {
+	client := &http.Client{}
+	assert.Same(t, client, WithFailover(client, FailoverSettings{PrimarySite: "datadoghq.com"}, zap.NewNop()))
+	assert.Nil(t, client.Transport)
+}
+
+func TestFailover(t *testing.T) {
+	next := &siteTransport{down: map[string]bool{"datadoghq.com": true}}
+	client, transport := newFailoverClient(next)
+	now := time.Now()
+	transport.now = func() time.Time { return now }
+
+	// Failures below the threshold are returned as is
+	assert.Equal(t, http.StatusServiceUnavailable, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+	assert.Equal(t, "api.datadoghq.com", next.lastHost())
+
+	// Reaching the threshold switches the following requests to the secondary site
+	assert.Equal(t, http.StatusServiceUnavailable, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+	assert.Equal(t, http.StatusAccepted, post(t, client, "https://api.datadoghq.com/api/v2/series?api_key=primary"))
+	last := next.requests[len(next.requests)-1]
+	assert.Equal(t, "api.datadoghq.eu", last.URL.Host)
+	assert.Equal(t, "/api/v2/series", last.URL.Path)
+	assert.Equal(t, "secondary", last.Header.Get("DD-API-KEY"))
+	assert.Equal(t, "secondary", last.URL.Query().Get("api_key"))
+	assert.Equal(t, "payload", next.bodies[len(next.bodies)-1])
+
+	// Requests to other hosts are not switched
+	assert.Equal(t, http.StatusAccepted, post(t, client, "https://intake.example.com/v1/input"))
+	assert.Equal(t, "intake.example.com", next.lastHost())
+
+	// After the probe interval, the primary site is probed and the request is resent to the secondary site
+	now = now.Add(time.Minute)
+	count := len(next.requests)
+	assert.Equal(t, http.StatusAccepted, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+	require.Len(t, next.requests, count+2)
+	assert.Equal(t, "api.datadoghq.com", next.requests[count].URL.Host)
+	assert.Equal(t, "api.datadoghq.eu", next.requests[count+1].URL.Host)
+	assert.Equal(t, "payload", next.bodies[count+1])
+
+	// No probe before the next interval
+	assert.Equal(t, http.StatusAccepted, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+	assert.Equal(t, "api.datadoghq.eu", next.lastHost())
+
+	// A successful probe switches back to the primary site
+	next.down["datadoghq.com"] = false
+	now = now.Add(time.Minute)
+	count = len(next.requests)
+	assert.Equal(t, http.StatusAccepted, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+	require.Len(t, next.requests, count+1)
+	assert.Equal(t, "api.datadoghq.com", next.lastHost())
+	assert.Equal(t, "primary", next.requests[count].Header.Get("DD-API-KEY"))
+
+	assert.Equal(t, http.StatusAccepted, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+	assert.Equal(t, "api.datadoghq.com", next.lastHost())
+}
+
+func TestFailoverResetOnSuccess(t *testing.T) {
+	next := &siteTransport{down: map[string]bool{"datadoghq.com": true}}
+	client, _ := newFailoverClient(next)
+
+	assert.Equal(t, http.StatusServiceUnavailable, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+	next.down["datadoghq.com"] = false
+	assert.Equal(t, http.StatusAccepted, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+	next.down["datadoghq.com"] = true
+	assert.Equal(t, http.StatusServiceUnavailable, post(t, client, "https://api.datadoghq.com/api/v2/series"))
+
+	// The failures were not consecutive
+	assert.Equal(t, "api.datadoghq.com", next.lastHost())
+}
+
+func TestIsFailure(t *testing.T) {
+	assert.True(t, isFailure(nil, errors.New("connection refused")))
+	assert.True(t, isFailure(&http.Response{StatusCode: http.StatusBadGateway}, nil))
+	assert.False(t, isFailure(&http.Response{StatusCode: http.StatusTooManyRequests}, nil))
+	assert.False(t, isFailure(&http.Response{StatusCode: http.StatusForbidden}, nil))
+	assert.False(t, isFailure(&http.Response{StatusCode: http.StatusAccepted}, nil))
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/hostmetadata"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/inframetadata/payload"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+const (
+	auditScopeName     = "datadog.host_metadata"
+	auditSentAttribute = "datadog.host_metadata.sent"
+	auditErrAttribute  = "datadog.host_metadata.error"
+)
+
+// AuditLogs emits the host metadata payloads as log records to a logs exporter,
+// so that what was reported to Datadog about each host can be archived.
+type AuditLogs struct {
+	mu   sync.RWMutex
+	next consumer.Logs
+}
+
+// Start looks up the logs exporter the log records are sent to.
+func (a *AuditLogs) Start(host component.Host, id component.ID) error {
+	exp, ok := host.GetExporters()[component.DataTypeLogs][id] //nolint:staticcheck
+	if !ok {
+		return fmt.Errorf("host metadata audit logs exporter %q is not part of a logs pipeline", id)
+	}
+	next, ok := exp.(consumer.Logs)
+	if !ok {
+		return fmt.Errorf("host metadata audit logs exporter %q is not a logs exporter", id)
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.next = next
+	return nil
+}
+
+// emit sends the payload as a log record, along with the result of pushing it to Datadog.
+func (a *AuditLogs) emit(ctx context.Context, metadata *payload.HostMetadata, pushErr error) error {
+	if a == nil || metadata.Meta.Hostname == "" {
+		// payloads without hostname are never sent to Datadog
+		return nil
+	}
+	a.mu.RLock()
+	next := a.next
+	a.mu.RUnlock()
+	if next == nil {
+		return nil
+	}
+
+	body, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	return next.ConsumeLogs(ctx, auditLog(metadata.Meta.Hostname, body, pushErr, time.Now()))
+}
+
+func auditLog(hostname string, body []byte, pushErr error, now time.Time) plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(conventions.AttributeHostName, hostname)
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(auditScopeName)
+
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(now))
+	lr.Body().SetStr(string(body))
+	lr.Attributes().PutBool(auditSentAttribute, pushErr == nil)
+	if pushErr != nil {
+		lr.SetSeverityNumber(plog.SeverityNumberWarn)
+		lr.Attributes().PutStr(auditErrAttribute, pushErr.Error())
+	} else {
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	}
+	return logs
+}
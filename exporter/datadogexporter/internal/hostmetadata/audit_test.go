@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/inframetadata/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/scrub"
+)
+
+var auditExporterID = component.NewIDWithName("file", "audit")
+
+type auditHost struct {
+	component.Host
+	exporters map[component.DataType]map[component.ID]component.Component
+}
+
+func (h *auditHost) GetExporters() map[component.DataType]map[component.ID]component.Component {
+	return h.exporters
+}
+
+type sinkExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	*consumertest.LogsSink
+}
+
+func newAuditLogs(t *testing.T) (*AuditLogs, *consumertest.LogsSink) {
+	sink := new(consumertest.LogsSink)
+	host := &auditHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[component.DataType]map[component.ID]component.Component{
+			component.DataTypeLogs: {auditExporterID: sinkExporter{LogsSink: sink}},
+		},
+	}
+	auditLogs := &AuditLogs{}
+	require.NoError(t, auditLogs.Start(host, auditExporterID))
+	return auditLogs, sink
+}
+
+func TestAuditLogsStart(t *testing.T) {
+	host := &auditHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[component.DataType]map[component.ID]component.Component{
+			component.DataTypeLogs: {},
+		},
+	}
+	auditLogs := &AuditLogs{}
+	assert.EqualError(t, auditLogs.Start(host, auditExporterID), `host metadata audit logs exporter "file/audit" is not part of a logs pipeline`)
+
+	host.exporters[component.DataTypeLogs][auditExporterID] = struct{ component.Component }{}
+	assert.EqualError(t, auditLogs.Start(host, auditExporterID), `host metadata audit logs exporter "file/audit" is not a logs exporter`)
+}
+
+func TestAuditLogsEmit(t *testing.T) {
+	auditLogs, sink := newAuditLogs(t)
+
+	require.NoError(t, auditLogs.emit(context.Background(), &mockMetadata, nil))
+	require.NoError(t, auditLogs.emit(context.Background(), &mockMetadata, errors.New("'404 Not Found' error")))
+	require.Len(t, sink.AllLogs(), 2)
+
+	expected, err := json.Marshal(mockMetadata)
+	require.NoError(t, err)
+
+	sent := sink.AllLogs()[0].ResourceLogs().At(0)
+	hostname, ok := sent.Resource().Attributes().Get(conventions.AttributeHostName)
+	require.True(t, ok)
+	assert.Equal(t, "hostname", hostname.Str())
+	assert.Equal(t, auditScopeName, sent.ScopeLogs().At(0).Scope().Name())
+	record := sent.ScopeLogs().At(0).LogRecords().At(0)
+	assert.JSONEq(t, string(expected), record.Body().Str())
+	assert.Equal(t, plog.SeverityNumberInfo, record.SeverityNumber())
+	assert.Equal(t, map[string]interface{}{auditSentAttribute: true}, record.Attributes().AsRaw())
+
+	failed := sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberWarn, failed.SeverityNumber())
+	assert.Equal(t, map[string]interface{}{
+		auditSentAttribute: false,
+		auditErrAttribute:  "'404 Not Found' error",
+	}, failed.Attributes().AsRaw())
+}
+
+func TestAuditLogsEmitSkipped(t *testing.T) {
+	// Not started
+	assert.NoError(t, (&AuditLogs{}).emit(context.Background(), &mockMetadata, nil))
+	// Disabled
+	var disabled *AuditLogs
+	assert.NoError(t, disabled.emit(context.Background(), &mockMetadata, nil))
+
+	// Payloads without hostname are not sent to Datadog
+	auditLogs, sink := newAuditLogs(t)
+	require.NoError(t, auditLogs.emit(context.Background(), &payload.HostMetadata{Meta: &payload.Meta{}}, nil))
+	assert.Empty(t, sink.AllLogs())
+}
+
+func TestPushMetadataWithRetryAuditLogs(t *testing.T) {
+	auditLogs, sink := newAuditLogs(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer ts.Close()
+
+	pcfg := PusherConfig{
+		APIKey:          "apikey",
+		MetricsEndpoint: ts.URL,
+		RetrySettings:   exporterhelper.RetrySettings{Enabled: false},
+		AuditLogs:       auditLogs,
+	}
+	retrier := clientutil.NewRetrier(mockExporterCreateSettings.Logger, pcfg.RetrySettings, scrub.NewScrubber())
+	pushMetadataWithRetry(retrier, mockExporterCreateSettings, pcfg, &mockMetadata)
+
+	require.Len(t, sink.AllLogs(), 1)
+	record := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	sent, ok := record.Attributes().Get(auditSentAttribute)
+	require.True(t, ok)
+	assert.True(t, sent.Bool())
+}
@@ -25,4 +25,6 @@ type PusherConfig struct {
 	TimeoutSettings exporterhelper.TimeoutSettings
 	// RetrySettings of exporter.
 	RetrySettings exporterhelper.RetrySettings
+	// AuditLogs emits the pushed payloads as log records when not nil.
+	AuditLogs *AuditLogs
 }
@@ -91,7 +91,8 @@ func fillHostMetadata(params exporter.CreateSettings, pcfg PusherConfig, p sourc
 func pushMetadata(pcfg PusherConfig, params exporter.CreateSettings, metadata *payload.HostMetadata) error {
 	if metadata.Meta.Hostname == "" {
 		// if the hostname is empty, don't send metadata; we don't need it.
-		params.Logger.Debug("Skipping host metadata since the hostname is empty")
+		params.Logger.Warn("Skipping host metadata since the hostname could not be resolved; " +
+			"set the 'hostname' setting or the hostname-like attributes of the first resource, depending on 'host_metadata::hostname_source'")
 		return nil
 	}
 
@@ -133,6 +134,9 @@ func pushMetadataWithRetry(retrier *clientutil.Retrier, params exporter.CreateSe
 		params.Logger.Info("Sent host metadata")
 	}
 
+	if err = pcfg.AuditLogs.emit(context.Background(), hostMetadata, err); err != nil {
+		params.Logger.Warn("Emitting host metadata audit log failed", zap.Error(err))
+	}
 }
 
 // Pusher pushes host metadata payloads periodically to Datadog intake
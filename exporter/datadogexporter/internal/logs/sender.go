@@ -20,6 +20,8 @@ type Sender struct {
 	logger  *zap.Logger
 	api     *datadogV2.LogsApi
 	verbose bool // reports whether payload contents should be dumped when logging at debug level
+
+	maxBatchSize int // maximum number of log items submitted together, unlimited when 0
 }
 
 // logsV2 is the key in datadog ServerConfiguration
@@ -28,7 +30,7 @@ type Sender struct {
 const logsV2 = "v2.LogsApi.SubmitLog"
 
 // NewSender creates a new Sender
-func NewSender(endpoint string, logger *zap.Logger, s exporterhelper.TimeoutSettings, insecureSkipVerify, verbose bool, apiKey string) *Sender {
+func NewSender(endpoint string, logger *zap.Logger, s exporterhelper.TimeoutSettings, insecureSkipVerify, verbose bool, apiKey string, failover clientutil.FailoverSettings, maxBatchSize int) *Sender {
 	cfg := datadog.NewConfiguration()
 	logger.Info("Logs sender initialized", zap.String("endpoint", endpoint))
 	cfg.OperationServers[logsV2] = datadog.ServerConfigurations{
@@ -36,13 +38,15 @@ func NewSender(endpoint string, logger *zap.Logger, s exporterhelper.TimeoutSett
 			URL: endpoint,
 		},
 	}
-	cfg.HTTPClient = clientutil.NewHTTPClient(s, insecureSkipVerify)
+	cfg.HTTPClient = clientutil.WithFailover(clientutil.NewHTTPClient(s, insecureSkipVerify), failover, logger)
 	cfg.AddDefaultHeader("DD-API-KEY", apiKey)
 	apiClient := datadog.NewAPIClient(cfg)
 	return &Sender{
 		api:     datadogV2.NewLogsApi(apiClient),
 		logger:  logger,
 		verbose: verbose,
+
+		maxBatchSize: maxBatchSize,
 	}
 }
 
@@ -58,7 +62,7 @@ func (s *Sender) SubmitLogs(ctx context.Context, payload []datadogV2.HTTPLogItem
 	// Correctly sets apiSubmitLogRequest ddtags field based on tags from translator Transform method
 	for i, p := range payload {
 		tags = p.GetDdtags()
-		if prevtags == tags || i == 0 {
+		if (prevtags == tags || i == 0) && (s.maxBatchSize == 0 || len(batch) < s.maxBatchSize) {
 			// Batches consecutive log items with the same tags to be submitted together
 			batch = append(batch, p)
 			prevtags = tags
@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/zap/zaptest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/testutil"
 )
 
@@ -22,10 +23,11 @@ func TestSubmitLogs(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 
 	tests := []struct {
-		name        string
-		payload     []datadogV2.HTTPLogItem
-		testFn      func(jsonLogs testutil.JSONLogs, call int)
-		numRequests int
+		name         string
+		payload      []datadogV2.HTTPLogItem
+		maxBatchSize int
+		testFn       func(jsonLogs testutil.JSONLogs, call int)
+		numRequests  int
 	}{
 		{
 			name: "same-tags",
@@ -179,6 +181,63 @@ func TestSubmitLogs(t *testing.T) {
 			},
 			numRequests: 2,
 		},
+		{
+			name: "max-batch-size",
+			payload: []datadogV2.HTTPLogItem{{
+				Ddsource: datadog.PtrString("golang"),
+				Ddtags:   datadog.PtrString("tag1:true"),
+				Hostname: datadog.PtrString("hostname"),
+				Message:  "log 1",
+				Service:  datadog.PtrString("server"),
+				UnparsedObject: map[string]interface{}{
+					"ddsource": "golang",
+					"ddtags":   "tag1:true",
+					"hostname": "hostname",
+					"message":  "log 1",
+					"service":  "server",
+				},
+			}, {
+				Ddsource: datadog.PtrString("golang"),
+				Ddtags:   datadog.PtrString("tag1:true"),
+				Hostname: datadog.PtrString("hostname"),
+				Message:  "log 2",
+				Service:  datadog.PtrString("server"),
+				UnparsedObject: map[string]interface{}{
+					"ddsource": "golang",
+					"ddtags":   "tag1:true",
+					"hostname": "hostname",
+					"message":  "log 2",
+					"service":  "server",
+				},
+			}, {
+				Ddsource: datadog.PtrString("golang"),
+				Ddtags:   datadog.PtrString("tag1:true"),
+				Hostname: datadog.PtrString("hostname"),
+				Message:  "log 3",
+				Service:  datadog.PtrString("server"),
+				UnparsedObject: map[string]interface{}{
+					"ddsource": "golang",
+					"ddtags":   "tag1:true",
+					"hostname": "hostname",
+					"message":  "log 3",
+					"service":  "server",
+				},
+			}},
+			maxBatchSize: 2,
+			testFn: func(jsonLogs testutil.JSONLogs, call int) {
+				switch call {
+				case 0:
+					assert.True(t, jsonLogs.HasDDTag("tag1:true"))
+					assert.Len(t, jsonLogs, 2)
+				case 1:
+					assert.True(t, jsonLogs.HasDDTag("tag1:true"))
+					assert.Len(t, jsonLogs, 1)
+				default:
+					t.Fail()
+				}
+			},
+			numRequests: 2,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -191,7 +250,7 @@ func TestSubmitLogs(t *testing.T) {
 				}
 			})
 			defer server.Close()
-			s := NewSender(server.URL, logger, exporterhelper.TimeoutSettings{Timeout: time.Second * 10}, true, true, "")
+			s := NewSender(server.URL, logger, exporterhelper.TimeoutSettings{Timeout: time.Second * 10}, true, true, "", clientutil.FailoverSettings{}, tt.maxBatchSize)
 			if err := s.SubmitLogs(context.Background(), tt.payload); err != nil {
 				t.Fatal(err)
 			}
@@ -20,6 +20,7 @@ var _ metrics.Consumer = (*Consumer)(nil)
 var _ metrics.HostConsumer = (*Consumer)(nil)
 var _ metrics.TagsConsumer = (*Consumer)(nil)
 var _ metrics.APMStatsConsumer = (*Consumer)(nil)
+var _ ResourceTagsConsumer = (*Consumer)(nil)
 
 // Consumer implements metrics.Consumer. It records consumed metrics, sketches and
 // APM stats payloads. It provides them to the caller using the All method.
@@ -29,6 +30,8 @@ type Consumer struct {
 	as        []pb.ClientStatsPayload
 	seenHosts map[string]struct{}
 	seenTags  map[string]struct{}
+
+	resourceTags []string
 }
 
 // NewConsumer creates a new Datadog consumer. It implements metrics.Consumer.
@@ -112,7 +115,7 @@ func (c *Consumer) ConsumeTimeSeries(
 	value float64,
 ) {
 	dt := c.toDataType(typ)
-	met := NewMetric(dims.Name(), dt, timestamp, value, dims.Tags())
+	met := NewMetric(dims.Name(), dt, timestamp, value, withResourceTags(dims.Tags(), c.resourceTags))
 	met.SetResources([]datadogV2.MetricResource{
 		{
 			Name: datadog.PtrString(dims.Host()),
@@ -131,7 +134,7 @@ func (c *Consumer) ConsumeSketch(
 ) {
 	c.sl = append(c.sl, sketches.SketchSeries{
 		Name:     dims.Name(),
-		Tags:     dims.Tags(),
+		Tags:     withResourceTags(dims.Tags(), c.resourceTags),
 		Host:     dims.Host(),
 		Interval: 1,
 		Points: []sketches.SketchPoint{{
@@ -150,3 +153,8 @@ func (c *Consumer) ConsumeHost(host string) {
 func (c *Consumer) ConsumeTag(tag string) {
 	c.seenTags[tag] = struct{}{}
 }
+
+// SetResourceTags implements the ResourceTagsConsumer interface.
+func (c *Consumer) SetResourceTags(tags []string) {
+	c.resourceTags = tags
+}
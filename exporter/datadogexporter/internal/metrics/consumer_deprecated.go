@@ -19,6 +19,7 @@ var _ metrics.Consumer = (*ZorkianConsumer)(nil)
 var _ metrics.HostConsumer = (*ZorkianConsumer)(nil)
 var _ metrics.TagsConsumer = (*ZorkianConsumer)(nil)
 var _ metrics.APMStatsConsumer = (*ZorkianConsumer)(nil)
+var _ ResourceTagsConsumer = (*ZorkianConsumer)(nil)
 
 // ZorkianConsumer implements metrics.Consumer. It records consumed metrics, sketches and
 // APM stats payloads. It provides them to the caller using the All method.
@@ -28,6 +29,8 @@ type ZorkianConsumer struct {
 	as        []pb.ClientStatsPayload
 	seenHosts map[string]struct{}
 	seenTags  map[string]struct{}
+
+	resourceTags []string
 }
 
 // NewZorkianConsumer creates a new ZorkianConsumer. It implements metrics.Consumer.
@@ -104,7 +107,7 @@ func (c *ZorkianConsumer) ConsumeTimeSeries(
 	value float64,
 ) {
 	dt := c.toDataType(typ)
-	met := NewZorkianMetric(dims.Name(), dt, timestamp, value, dims.Tags())
+	met := NewZorkianMetric(dims.Name(), dt, timestamp, value, withResourceTags(dims.Tags(), c.resourceTags))
 	met.SetHost(dims.Host())
 	c.ms = append(c.ms, met)
 }
@@ -118,7 +121,7 @@ func (c *ZorkianConsumer) ConsumeSketch(
 ) {
 	c.sl = append(c.sl, sketches.SketchSeries{
 		Name:     dims.Name(),
-		Tags:     dims.Tags(),
+		Tags:     withResourceTags(dims.Tags(), c.resourceTags),
 		Host:     dims.Host(),
 		Interval: 1,
 		Points: []sketches.SketchPoint{{
@@ -137,3 +140,8 @@ func (c *ZorkianConsumer) ConsumeHost(host string) {
 func (c *ZorkianConsumer) ConsumeTag(tag string) {
 	c.seenTags[tag] = struct{}{}
 }
+
+// SetResourceTags implements the ResourceTagsConsumer interface.
+func (c *ZorkianConsumer) SetResourceTags(tags []string) {
+	c.resourceTags = tags
+}
@@ -0,0 +1,228 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics"
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/metrics"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// DefaultResourceTagsCacheSize is the default maximum number of resources
+// whose tags are kept by a ResourceTagsCache.
+const DefaultResourceTagsCacheSize = 4096
+
+// resourceIDAttribute is the data point attribute holding the ID of the resource of the
+// cumulative metrics while they are mapped. It is left out of the tags of the metrics.
+const resourceIDAttribute = "otel.datadog.resource_id"
+
+var resourceIDTagPrefix = resourceIDAttribute + ":"
+
+// ResourceTagsConsumer is a metrics.Consumer which adds the tags of the resource
+// being mapped to the metrics it consumes.
+type ResourceTagsConsumer interface {
+	metrics.Consumer
+	// SetResourceTags sets the tags added to the metrics consumed from now on.
+	SetResourceTags(tags []string)
+}
+
+// ResourceTagsCache caches the tags computed from the attributes of resources,
+// keyed by the hash of the attributes, so that the tags of a resource are
+// computed once rather than for each batch and data point it comes with.
+type ResourceTagsCache struct {
+	size int
+
+	mu        sync.Mutex
+	resources map[[16]byte]*resourceTags
+}
+
+// resourceTags are the tags of a resource, along with the keys of its attributes and its ID,
+// used to prepare its data points before they are mapped.
+type resourceTags struct {
+	tags []string
+	keys map[string]struct{}
+	id   string
+}
+
+// NewResourceTagsCache creates a cache holding the tags of at most size resources.
+func NewResourceTagsCache(size int) *ResourceTagsCache {
+	return &ResourceTagsCache{
+		size:      size,
+		resources: make(map[[16]byte]*resourceTags),
+	}
+}
+
+// Get returns the tags of the resource with the given attributes.
+// The returned slice is shared and must not be modified.
+func (c *ResourceTagsCache) Get(attrs pcommon.Map) []string {
+	return c.get(attrs).tags
+}
+
+func (c *ResourceTagsCache) get(attrs pcommon.Map) *resourceTags {
+	key := pdatautil.MapHash(attrs)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if rt, ok := c.resources[key]; ok {
+		return rt
+	}
+	if len(c.resources) >= c.size {
+		// The resources of a pipeline rarely change, so when the cache is full it is
+		// cheaper to start over than to track which entries were used last.
+		c.resources = make(map[[16]byte]*resourceTags)
+	}
+	keys := make(map[string]struct{}, attrs.Len())
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		keys[k] = struct{}{}
+		return true
+	})
+	rt := &resourceTags{
+		tags: ResourceAttributesTags(attrs),
+		keys: keys,
+		id:   hex.EncodeToString(key[:]),
+	}
+	c.resources[key] = rt
+	return rt
+}
+
+// Len returns the number of resources whose tags are cached.
+func (c *ResourceTagsCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.resources)
+}
+
+// ResourceAttributesTags converts all the attributes of a resource into tags, the same way
+// the translator converts data point attributes.
+func ResourceAttributesTags(attrs pcommon.Map) []string {
+	tags := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		value := v.AsString()
+		if value == "" {
+			// Tags with an empty value are reported as 'n/a', see the translator.
+			value = "n/a"
+		}
+		tags = append(tags, fmt.Sprintf("%s:%s", k, value))
+		return true
+	})
+	return tags
+}
+
+// MapMetricsPerResource maps md with tr one resource at a time, adding the cached
+// tags of each resource to its metrics. The resources are moved out of md while
+// they are mapped, and moved back afterwards.
+//
+// The translator keeps the previous points of the cumulative metrics to convert them
+// to deltas, keyed by their name, host and data point tags. The ID of the resource is
+// therefore added to the data points of those metrics before they are mapped, so that
+// the resources sharing a host don't share their state.
+func MapMetricsPerResource(ctx context.Context, tr *metrics.Translator, cache *ResourceTagsCache, md pmetric.Metrics, consumer ResourceTagsConsumer) (metrics.Metadata, error) {
+	defer consumer.SetResourceTags(nil)
+
+	var metadata metrics.Metadata
+	seenLanguages := make(map[string]struct{})
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		rt := cache.get(rm.Resource().Attributes())
+		consumer.SetResourceTags(rt.tags)
+		prepareDataPoints(rm, rt)
+
+		single := pmetric.NewMetrics()
+		rm.MoveTo(single.ResourceMetrics().AppendEmpty())
+		meta, err := tr.MapMetrics(ctx, single, consumer)
+		single.ResourceMetrics().At(0).MoveTo(rm)
+		if err != nil {
+			return metadata, err
+		}
+		for _, lang := range meta.Languages {
+			if _, ok := seenLanguages[lang]; !ok {
+				seenLanguages[lang] = struct{}{}
+				metadata.Languages = append(metadata.Languages, lang)
+			}
+		}
+	}
+	return metadata, nil
+}
+
+// prepareDataPoints removes the data point attributes with the key of a resource attribute, which
+// are overridden by the resource tags as resourcetotelemetry does, and adds the ID of the resource
+// to the data points of the metrics whose translation depends on their previous points.
+func prepareDataPoints(rm pmetric.ResourceMetrics, rt *resourceTags) {
+	if len(rt.keys) == 0 {
+		return
+	}
+	isResourceKey := func(k string, _ pcommon.Value) bool {
+		_, ok := rt.keys[k]
+		return ok
+	}
+	prepare := func(attrs pcommon.Map, cumulative bool) {
+		attrs.RemoveIf(isResourceKey)
+		if cumulative {
+			attrs.PutStr(resourceIDAttribute, rt.id)
+		}
+	}
+
+	sms := rm.ScopeMetrics()
+	for i := 0; i < sms.Len(); i++ {
+		ms := sms.At(i).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			m := ms.At(j)
+			switch m.Type() {
+			case pmetric.MetricTypeGauge:
+				dps := m.Gauge().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					prepare(dps.At(k).Attributes(), false)
+				}
+			case pmetric.MetricTypeSum:
+				cumulative := m.Sum().AggregationTemporality() == pmetric.AggregationTemporalityCumulative
+				dps := m.Sum().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					prepare(dps.At(k).Attributes(), cumulative)
+				}
+			case pmetric.MetricTypeHistogram:
+				cumulative := m.Histogram().AggregationTemporality() == pmetric.AggregationTemporalityCumulative
+				dps := m.Histogram().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					prepare(dps.At(k).Attributes(), cumulative)
+				}
+			case pmetric.MetricTypeExponentialHistogram:
+				dps := m.ExponentialHistogram().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					prepare(dps.At(k).Attributes(), false)
+				}
+			case pmetric.MetricTypeSummary:
+				dps := m.Summary().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					prepare(dps.At(k).Attributes(), true)
+				}
+			case pmetric.MetricTypeEmpty:
+			}
+		}
+	}
+}
+
+// withResourceTags returns the tags of a data point along with the resource tags, leaving out
+// the resource ID. The data point tags don't need to be merged with the resource tags, since
+// the data point attributes with the key of a resource attribute are removed before mapping.
+func withResourceTags(tags, resourceTags []string) []string {
+	if len(resourceTags) == 0 {
+		return tags
+	}
+	out := make([]string, 0, len(tags)+len(resourceTags))
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, resourceIDTagPrefix) {
+			out = append(out, tag)
+		}
+	}
+	return append(out, resourceTags...)
+}
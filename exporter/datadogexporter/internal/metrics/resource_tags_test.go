@@ -0,0 +1,272 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes"
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func newResourceAttributesTranslator(tb testing.TB) *metrics.Translator {
+	tr, err := metrics.NewTranslator(zap.NewNop(),
+		metrics.WithNumberMode(metrics.NumberModeRawValue),
+		metrics.WithResourceAttributesAsTags(),
+		metrics.WithFallbackSourceProvider(testProvider("fallbackHostname")),
+	)
+	require.NoError(tb, err)
+	return tr
+}
+
+// newResourceMetrics creates metrics with the given number of resources, each with
+// the given number of gauge data points.
+func newResourceMetrics(resources, points int) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for i := 0; i < resources; i++ {
+		rm := md.ResourceMetrics().AppendEmpty()
+		attrs := rm.Resource().Attributes()
+		attrs.PutStr(attributes.AttributeDatadogHostname, fmt.Sprintf("host-%d", i))
+		attrs.PutStr("service.name", "checkout")
+		attrs.PutStr("deployment.environment", "prod")
+		attrs.PutStr("k8s.pod.name", fmt.Sprintf("checkout-%d", i))
+		attrs.PutStr("k8s.namespace.name", "shop")
+		attrs.PutStr("cloud.region", "eu-west-1")
+		attrs.PutStr("empty", "")
+		ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+		m := ms.AppendEmpty()
+		m.SetName("requests.inflight")
+		dps := m.SetEmptyGauge().DataPoints()
+		for j := 0; j < points; j++ {
+			dp := dps.AppendEmpty()
+			dp.SetIntValue(int64(j))
+			dp.Attributes().PutStr("route", fmt.Sprintf("/route/%d", j))
+		}
+	}
+	return md
+}
+
+func TestResourceAttributesTags(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("service.name", "checkout")
+	attrs.PutInt("port", 8080)
+	attrs.PutStr("empty", "")
+	assert.ElementsMatch(t, []string{"service.name:checkout", "port:8080", "empty:n/a"}, ResourceAttributesTags(attrs))
+}
+
+func TestResourceTagsCache(t *testing.T) {
+	cache := NewResourceTagsCache(2)
+	a := pcommon.NewMap()
+	a.PutStr("service.name", "a")
+	b := pcommon.NewMap()
+	b.PutStr("service.name", "b")
+	c := pcommon.NewMap()
+	c.PutStr("service.name", "c")
+
+	assert.Equal(t, []string{"service.name:a"}, cache.Get(a))
+	assert.Equal(t, []string{"service.name:a"}, cache.Get(a))
+	assert.Equal(t, 1, cache.Len())
+	assert.Equal(t, []string{"service.name:b"}, cache.Get(b))
+	assert.Equal(t, 2, cache.Len())
+
+	// the cache is reset once full
+	assert.Equal(t, []string{"service.name:c"}, cache.Get(c))
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestMapMetricsPerResource(t *testing.T) {
+	md := newResourceMetrics(2, 1)
+	expected := pmetric.NewMetrics()
+	md.CopyTo(expected)
+
+	tr := newResourceAttributesTranslator(t)
+	cache := NewResourceTagsCache(DefaultResourceTagsCacheSize)
+	consumer := NewConsumer()
+	_, err := MapMetricsPerResource(context.Background(), tr, cache, md, consumer)
+	require.NoError(t, err)
+
+	// the payload is left untouched
+	assert.Equal(t, expected, md)
+	assert.Equal(t, 2, cache.Len())
+	assert.Nil(t, consumer.resourceTags)
+
+	require.Len(t, consumer.ms, 2)
+	for i, series := range consumer.ms {
+		assert.Equal(t, fmt.Sprintf("host-%d", i), *series.Resources[0].Name)
+		assert.ElementsMatch(t, []string{
+			"route:/route/0",
+			attributes.AttributeDatadogHostname + ":" + fmt.Sprintf("host-%d", i),
+			"service.name:checkout",
+			"deployment.environment:prod",
+			"k8s.pod.name:" + fmt.Sprintf("checkout-%d", i),
+			"k8s.namespace.name:shop",
+			"cloud.region:eu-west-1",
+			"empty:n/a",
+		}, series.Tags)
+	}
+}
+
+func TestMapMetricsPerResourceZorkian(t *testing.T) {
+	md := newResourceMetrics(1, 2)
+	tr := newResourceAttributesTranslator(t)
+	consumer := NewZorkianConsumer()
+	_, err := MapMetricsPerResource(context.Background(), tr, NewResourceTagsCache(DefaultResourceTagsCacheSize), md, consumer)
+	require.NoError(t, err)
+
+	require.Len(t, consumer.ms, 2)
+	for _, series := range consumer.ms {
+		assert.Contains(t, series.Tags, "service.name:checkout")
+		assert.Contains(t, series.Tags, "k8s.pod.name:checkout-0")
+	}
+}
+
+func TestWithResourceTags(t *testing.T) {
+	assert.Equal(t, []string{"route:/a"}, withResourceTags([]string{"route:/a"}, nil))
+	// the resource ID is left out
+	assert.Equal(t,
+		[]string{"route:/a", "flag", "service.name:checkout", "env:prod"},
+		withResourceTags([]string{"route:/a", resourceIDTagPrefix + "0123", "flag"}, []string{"service.name:checkout", "env:prod"}))
+}
+
+func TestMapMetricsPerResourceOverridesDataPointTags(t *testing.T) {
+	md := newResourceMetrics(1, 1)
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0).Attributes().PutStr("service.name", "worker")
+
+	consumer := NewConsumer()
+	_, err := MapMetricsPerResource(context.Background(), newResourceAttributesTranslator(t), NewResourceTagsCache(DefaultResourceTagsCacheSize), md, consumer)
+	require.NoError(t, err)
+
+	// the resource tags replace the data point tags with the same key
+	require.Len(t, consumer.ms, 1)
+	assert.Contains(t, consumer.ms[0].Tags, "service.name:checkout")
+	assert.NotContains(t, consumer.ms[0].Tags, "service.name:worker")
+}
+
+func TestMapMetricsPerResourceCumulativeSameHost(t *testing.T) {
+	// newCumulativeSum creates metrics with two resources sharing a host, each one with a
+	// cumulative sum with the same name and data point attributes.
+	newCumulativeSum := func(ts pcommon.Timestamp, values ...int64) pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		for i, value := range values {
+			rm := md.ResourceMetrics().AppendEmpty()
+			rm.Resource().Attributes().PutStr(attributes.AttributeDatadogHostname, "host")
+			rm.Resource().Attributes().PutStr("k8s.pod.name", fmt.Sprintf("checkout-%d", i))
+			m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+			m.SetName("requests")
+			sum := m.SetEmptySum()
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			sum.SetIsMonotonic(true)
+			dp := sum.DataPoints().AppendEmpty()
+			dp.Attributes().PutStr("route", "/checkout")
+			dp.SetStartTimestamp(1)
+			dp.SetTimestamp(ts)
+			dp.SetIntValue(value)
+		}
+		return md
+	}
+
+	tr, err := metrics.NewTranslator(zap.NewNop(),
+		metrics.WithResourceAttributesAsTags(),
+		metrics.WithFallbackSourceProvider(testProvider("fallbackHostname")),
+	)
+	require.NoError(t, err)
+	cache := NewResourceTagsCache(DefaultResourceTagsCacheSize)
+
+	_, err = MapMetricsPerResource(context.Background(), tr, cache, newCumulativeSum(10e9, 10, 100), NewConsumer())
+	require.NoError(t, err)
+	consumer := NewConsumer()
+	_, err = MapMetricsPerResource(context.Background(), tr, cache, newCumulativeSum(20e9, 15, 130), NewConsumer())
+	require.NoError(t, err)
+	_, err = MapMetricsPerResource(context.Background(), tr, cache, newCumulativeSum(30e9, 17, 160), consumer)
+	require.NoError(t, err)
+
+	// each resource is converted to deltas from its own previous point
+	values := make(map[string]float64)
+	for _, series := range consumer.ms {
+		require.Equal(t, "requests", series.Metric)
+		assert.Equal(t, "host", *series.Resources[0].Name)
+		pods := 0
+		for _, tag := range series.Tags {
+			if strings.HasPrefix(tag, "k8s.pod.name:") {
+				pods++
+				values[tag] = *series.Points[0].Value
+			}
+		}
+		assert.Equal(t, 1, pods, "the resource tags must be sent once")
+		for _, tag := range series.Tags {
+			assert.False(t, strings.HasPrefix(tag, resourceIDTagPrefix), "the resource ID must not be sent")
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"k8s.pod.name:checkout-0": 2,
+		"k8s.pod.name:checkout-1": 30,
+	}, values)
+}
+
+// copyResourceAttributes adds the resource attributes to every data point, which is
+// how resource attributes were sent as tags before they were cached per resource.
+func copyResourceAttributes(md pmetric.Metrics) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		attrs := rms.At(i).Resource().Attributes()
+		ms := rms.At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			dps := ms.At(j).Gauge().DataPoints()
+			for k := 0; k < dps.Len(); k++ {
+				attrs.Range(func(key string, v pcommon.Value) bool {
+					v.CopyTo(dps.At(k).Attributes().PutEmpty(key))
+					return true
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkResourceAttributesAsTags(b *testing.B) {
+	ctx := context.Background()
+	for _, bb := range []struct {
+		resources int
+		points    int
+	}{
+		{resources: 10, points: 100},
+		{resources: 100, points: 10},
+	} {
+		md := newResourceMetrics(bb.resources, bb.points)
+		name := fmt.Sprintf("%d resources %d points", bb.resources, bb.points)
+
+		b.Run(name+"/data point attributes", func(b *testing.B) {
+			tr := newResourceAttributesTranslator(b)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				batch := pmetric.NewMetrics()
+				md.CopyTo(batch)
+				copyResourceAttributes(batch)
+				_, err := tr.MapMetrics(ctx, batch, NewConsumer())
+				require.NoError(b, err)
+			}
+		})
+
+		b.Run(name+"/cached resource tags", func(b *testing.B) {
+			tr := newResourceAttributesTranslator(b)
+			cache := NewResourceTagsCache(DefaultResourceTagsCacheSize)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				batch := pmetric.NewMetrics()
+				md.CopyTo(batch)
+				_, err := MapMetricsPerResource(ctx, tr, cache, batch, NewConsumer())
+				require.NoError(b, err)
+			}
+		})
+	}
+}
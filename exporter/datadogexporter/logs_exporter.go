@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/hostmetadata"
@@ -28,11 +29,12 @@ type logsExporter struct {
 	scrubber       scrub.Scrubber  // scrubber scrubs sensitive information from error messages
 	sender         *logs.Sender
 	onceMetadata   *sync.Once
+	auditLogs      *hostmetadata.AuditLogs
 	sourceProvider source.Provider
 }
 
 // newLogsExporter creates a new instance of logsExporter
-func newLogsExporter(ctx context.Context, params exporter.CreateSettings, cfg *Config, onceMetadata *sync.Once, sourceProvider source.Provider) (*logsExporter, error) {
+func newLogsExporter(ctx context.Context, params exporter.CreateSettings, cfg *Config, onceMetadata *sync.Once, auditLogs *hostmetadata.AuditLogs, sourceProvider source.Provider) (*logsExporter, error) {
 	// create Datadog client
 	// validation endpoint is provided by Metrics
 	errchan := make(chan error)
@@ -54,7 +56,7 @@ func newLogsExporter(ctx context.Context, params exporter.CreateSettings, cfg *C
 		}
 	}
 
-	s := logs.NewSender(cfg.Logs.TCPAddr.Endpoint, params.Logger, cfg.TimeoutSettings, cfg.LimitedHTTPClientSettings.TLSSetting.InsecureSkipVerify, cfg.Logs.DumpPayloads, string(cfg.API.Key))
+	s := logs.NewSender(cfg.Logs.TCPAddr.Endpoint, params.Logger, cfg.TimeoutSettings, cfg.LimitedHTTPClientSettings.TLSSetting.InsecureSkipVerify, cfg.Logs.DumpPayloads, string(cfg.API.Key), cfg.failoverSettings(), cfg.Logs.Batch.MaxSize)
 
 	return &logsExporter{
 		params:         params,
@@ -62,6 +64,7 @@ func newLogsExporter(ctx context.Context, params exporter.CreateSettings, cfg *C
 		ctx:            ctx,
 		sender:         s,
 		onceMetadata:   onceMetadata,
+		auditLogs:      auditLogs,
 		scrubber:       scrub.NewScrubber(),
 		sourceProvider: sourceProvider,
 	}, nil
@@ -72,6 +75,9 @@ var _ consumer.ConsumeLogsFunc = (*logsExporter)(nil).consumeLogs
 // consumeLogs is implementation of cosumer.ConsumeLogsFunc
 func (exp *logsExporter) consumeLogs(_ context.Context, ld plog.Logs) (err error) {
 	defer func() { err = exp.scrubber.Scrub(err) }()
+	if dropped := exp.cfg.UnresolvedHostname.resolveLogs(ld); dropped > 0 {
+		exp.params.Logger.Debug("Dropped log records with unresolved hostname", zap.Int("log_records", dropped))
+	}
 	if exp.cfg.HostMetadata.Enabled {
 		// start host metadata with resource attributes from
 		// the first payload.
@@ -80,7 +86,7 @@ func (exp *logsExporter) consumeLogs(_ context.Context, ld plog.Logs) (err error
 			if ld.ResourceLogs().Len() > 0 {
 				attrs = ld.ResourceLogs().At(0).Resource().Attributes()
 			}
-			go hostmetadata.Pusher(exp.ctx, exp.params, newMetadataConfigfromConfig(exp.cfg), exp.sourceProvider, attrs)
+			go hostmetadata.Pusher(exp.ctx, exp.params, newMetadataConfigfromConfig(exp.cfg, exp.auditLogs), exp.sourceProvider, attrs)
 		})
 	}
 
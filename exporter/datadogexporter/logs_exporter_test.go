@@ -206,11 +206,13 @@ func TestLogsExporter(t *testing.T) {
 			defer server.Close()
 			cfg := &Config{
 				Metrics: MetricsConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: server.URL,
 					},
 				},
 				Logs: LogsConfig{
+					Enabled: true,
 					TCPAddr: confignet.TCPAddr{
 						Endpoint: server.URL,
 					},
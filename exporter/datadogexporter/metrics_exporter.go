@@ -40,11 +40,14 @@ type metricsExporter struct {
 	scrubber       scrub.Scrubber
 	retrier        *clientutil.Retrier
 	onceMetadata   *sync.Once
+	auditLogs      *hostmetadata.AuditLogs
 	sourceProvider source.Provider
 	// getPushTime returns a Unix time in nanoseconds, representing the time pushing metrics.
 	// It will be overwritten in tests.
 	getPushTime       func() uint64
 	apmStatsProcessor api.StatsProcessor
+	// resourceTags caches the tags of resources when resource attributes are sent as tags.
+	resourceTags *metrics.ResourceTagsCache
 }
 
 // translatorFromConfig creates a new metrics translator from the exporter
@@ -86,7 +89,7 @@ func translatorFromConfig(logger *zap.Logger, cfg *Config, sourceProvider source
 	return otlpmetrics.NewTranslator(logger, options...)
 }
 
-func newMetricsExporter(ctx context.Context, params exporter.CreateSettings, cfg *Config, onceMetadata *sync.Once, sourceProvider source.Provider, apmStatsProcessor api.StatsProcessor) (*metricsExporter, error) {
+func newMetricsExporter(ctx context.Context, params exporter.CreateSettings, cfg *Config, onceMetadata *sync.Once, auditLogs *hostmetadata.AuditLogs, sourceProvider source.Provider, apmStatsProcessor api.StatsProcessor) (*metricsExporter, error) {
 	tr, err := translatorFromConfig(params.Logger, cfg, sourceProvider)
 	if err != nil {
 		return nil, err
@@ -101,10 +104,14 @@ func newMetricsExporter(ctx context.Context, params exporter.CreateSettings, cfg
 		scrubber:          scrubber,
 		retrier:           clientutil.NewRetrier(params.Logger, cfg.RetrySettings, scrubber),
 		onceMetadata:      onceMetadata,
+		auditLogs:         auditLogs,
 		sourceProvider:    sourceProvider,
 		getPushTime:       func() uint64 { return uint64(time.Now().UTC().UnixNano()) },
 		apmStatsProcessor: apmStatsProcessor,
 	}
+	if cfg.Metrics.ExporterConfig.ResourceAttributesAsTags {
+		exporter.resourceTags = metrics.NewResourceTagsCache(metrics.DefaultResourceTagsCacheSize)
+	}
 	errchan := make(chan error)
 	if isMetricExportV2Enabled() {
 		apiClient := clientutil.CreateAPIClient(
@@ -112,12 +119,16 @@ func newMetricsExporter(ctx context.Context, params exporter.CreateSettings, cfg
 			cfg.Metrics.TCPAddr.Endpoint,
 			cfg.TimeoutSettings,
 			cfg.LimitedHTTPClientSettings.TLSSetting.InsecureSkipVerify)
+		clientutil.WithFailover(apiClient.Cfg.HTTPClient, cfg.failoverSettings(), params.Logger)
 		go func() { errchan <- clientutil.ValidateAPIKey(ctx, string(cfg.API.Key), params.Logger, apiClient) }()
 		exporter.metricsAPI = datadogV2.NewMetricsApi(apiClient)
 	} else {
 		client := clientutil.CreateZorkianClient(string(cfg.API.Key), cfg.Metrics.TCPAddr.Endpoint)
 		client.ExtraHeader["User-Agent"] = clientutil.UserAgent(params.BuildInfo)
-		client.HttpClient = clientutil.NewHTTPClient(cfg.TimeoutSettings, cfg.LimitedHTTPClientSettings.TLSSetting.InsecureSkipVerify)
+		client.HttpClient = clientutil.WithFailover(
+			clientutil.NewHTTPClient(cfg.TimeoutSettings, cfg.LimitedHTTPClientSettings.TLSSetting.InsecureSkipVerify),
+			cfg.failoverSettings(),
+			params.Logger)
 		go func() { errchan <- clientutil.ValidateAPIKeyZorkian(params.Logger, client) }()
 		exporter.client = client
 	}
@@ -170,24 +181,35 @@ func (exp *metricsExporter) PushMetricsDataScrubbed(ctx context.Context, md pmet
 }
 
 func (exp *metricsExporter) PushMetricsData(ctx context.Context, md pmetric.Metrics) error {
+	if dropped := exp.cfg.UnresolvedHostname.resolveMetrics(md); dropped > 0 {
+		exp.params.Logger.Debug("Dropped data points with unresolved hostname", zap.Int("data_points", dropped))
+	}
+
 	// Start host metadata with resource attributes from
 	// the first payload.
 	if exp.cfg.HostMetadata.Enabled {
 		exp.onceMetadata.Do(func() {
 			attrs := pcommon.NewMap()
 			if md.ResourceMetrics().Len() > 0 {
-				attrs = md.ResourceMetrics().At(0).Resource().Attributes()
+				// copy the attributes since the payload may be modified while mapped
+				md.ResourceMetrics().At(0).Resource().Attributes().CopyTo(attrs)
 			}
-			go hostmetadata.Pusher(exp.ctx, exp.params, newMetadataConfigfromConfig(exp.cfg), exp.sourceProvider, attrs)
+			go hostmetadata.Pusher(exp.ctx, exp.params, newMetadataConfigfromConfig(exp.cfg, exp.auditLogs), exp.sourceProvider, attrs)
 		})
 	}
-	var consumer otlpmetrics.Consumer
+	var consumer metrics.ResourceTagsConsumer
 	if isMetricExportV2Enabled() {
 		consumer = metrics.NewConsumer()
 	} else {
 		consumer = metrics.NewZorkianConsumer()
 	}
-	metadata, err := exp.tr.MapMetrics(ctx, md, consumer)
+	var metadata otlpmetrics.Metadata
+	var err error
+	if exp.resourceTags != nil {
+		metadata, err = metrics.MapMetricsPerResource(ctx, exp.tr, exp.resourceTags, md, consumer)
+	} else {
+		metadata, err = exp.tr.MapMetrics(ctx, md, consumer)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to map metrics: %w", err)
 	}
@@ -208,12 +230,15 @@ func (exp *metricsExporter) PushMetricsData(ctx context.Context, md pmetric.Metr
 		err = nil
 		if len(ms) > 0 {
 			exp.params.Logger.Debug("exporting native Datadog payload", zap.Any("metric", ms))
-			_, experr := exp.retrier.DoWithRetries(ctx, func(context.Context) error {
-				ctx = clientutil.GetRequestContext(ctx, string(exp.cfg.API.Key))
-				_, httpresp, merr := exp.metricsAPI.SubmitMetrics(ctx, datadogV2.MetricPayload{Series: ms}, *clientutil.GZipSubmitMetricsOptionalParameters)
-				return clientutil.WrapError(merr, httpresp)
-			})
-			err = multierr.Append(err, experr)
+			for _, batch := range splitBatches(ms, exp.cfg.Metrics.Batch.MaxSize) {
+				batch := batch
+				_, experr := exp.retrier.DoWithRetries(ctx, func(context.Context) error {
+					ctx = clientutil.GetRequestContext(ctx, string(exp.cfg.API.Key))
+					_, httpresp, merr := exp.metricsAPI.SubmitMetrics(ctx, datadogV2.MetricPayload{Series: batch}, *clientutil.GZipSubmitMetricsOptionalParameters)
+					return clientutil.WrapError(merr, httpresp)
+				})
+				err = multierr.Append(err, experr)
+			}
 		}
 	} else {
 		var ms []zorkian.Metric
@@ -221,10 +246,13 @@ func (exp *metricsExporter) PushMetricsData(ctx context.Context, md pmetric.Metr
 		err = nil
 		if len(ms) > 0 {
 			exp.params.Logger.Debug("exporting Zorkian Datadog payload", zap.Any("metric", ms))
-			_, experr := exp.retrier.DoWithRetries(ctx, func(context.Context) error {
-				return exp.client.PostMetrics(ms)
-			})
-			err = multierr.Append(err, experr)
+			for _, batch := range splitBatches(ms, exp.cfg.Metrics.Batch.MaxSize) {
+				batch := batch
+				_, experr := exp.retrier.DoWithRetries(ctx, func(context.Context) error {
+					return exp.client.PostMetrics(batch)
+				})
+				err = multierr.Append(err, experr)
+			}
 		}
 	}
 
@@ -236,7 +264,9 @@ func (exp *metricsExporter) PushMetricsData(ctx context.Context, md pmetric.Metr
 		err = multierr.Append(err, experr)
 	}
 
-	if len(sp) > 0 {
+	if len(sp) > 0 && exp.apmStatsProcessor == nil {
+		exp.params.Logger.Debug("Dropped APM stats payloads since traces are disabled", zap.Int("stats_payloads", len(sp)))
+	} else if len(sp) > 0 {
 		exp.params.Logger.Debug("exporting APM stats payloads", zap.Any("stats_payloads", sp))
 		statsv := exp.params.BuildInfo.Command + exp.params.BuildInfo.Version
 		for _, p := range sp {
@@ -246,3 +276,16 @@ func (exp *metricsExporter) PushMetricsData(ctx context.Context, md pmetric.Metr
 
 	return err
 }
+
+// splitBatches splits items into batches of at most maxSize items, or in a single batch when maxSize is 0.
+func splitBatches[T any](items []T, maxSize int) [][]T {
+	if maxSize <= 0 || len(items) <= maxSize {
+		return [][]T{items}
+	}
+	batches := make([][]T, 0, (len(items)+maxSize-1)/maxSize)
+	for len(items) > maxSize {
+		batches = append(batches, items[:maxSize])
+		items = items[maxSize:]
+	}
+	return append(batches, items)
+}
@@ -45,6 +45,7 @@ func TestNewExporter(t *testing.T) {
 			Key: "ddog_32_characters_long_api_key1",
 		},
 		Metrics: MetricsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: server.URL,
 			},
@@ -302,6 +303,7 @@ func Test_metricsExporter_PushMetricsData(t *testing.T) {
 				exportertest.NewNopCreateSettings(),
 				newTestConfig(t, server.URL, tt.hostTags, tt.histogramMode),
 				&once,
+				nil,
 				&testutil.MockSourceProvider{Src: tt.source},
 				&statsRecorder,
 			)
@@ -367,6 +369,7 @@ func TestNewExporter_Zorkian(t *testing.T) {
 			Key: "ddog_32_characters_long_api_key1",
 		},
 		Metrics: MetricsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: server.URL,
 			},
@@ -686,6 +689,7 @@ func Test_metricsExporter_PushMetricsData_Zorkian(t *testing.T) {
 				exportertest.NewNopCreateSettings(),
 				newTestConfig(t, server.URL, tt.hostTags, tt.histogramMode),
 				&once,
+				nil,
 				&testutil.MockSourceProvider{Src: tt.source},
 				&statsRecorder,
 			)
@@ -816,6 +820,7 @@ func newTestConfig(t *testing.T, endpoint string, hostTags []string, histogramMo
 			Tags: hostTags,
 		},
 		Metrics: MetricsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: endpoint,
 			},
@@ -830,3 +835,11 @@ func newTestConfig(t *testing.T, endpoint string, hostTags []string, histogramMo
 		},
 	}
 }
+
+func TestSplitBatches(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, splitBatches(items, 0))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, splitBatches(items, 5))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, splitBatches(items, 2))
+	assert.Equal(t, [][]int{{1}, {2}, {3}, {4}, {5}}, splitBatches(items, 1))
+}
@@ -32,17 +32,18 @@ import (
 type traceExporter struct {
 	params         exporter.CreateSettings
 	cfg            *Config
-	ctx            context.Context       // ctx triggers shutdown upon cancellation
-	client         *zorkian.Client       // client sends runnimg metrics to backend & performs API validation
-	metricsAPI     *datadogV2.MetricsApi // client sends runnimg metrics to backend
-	scrubber       scrub.Scrubber        // scrubber scrubs sensitive information from error messages
-	onceMetadata   *sync.Once            // onceMetadata ensures that metadata is sent only once across all exporters
-	agent          *agent.Agent          // agent processes incoming traces
-	sourceProvider source.Provider       // is able to source the origin of a trace (hostname, container, etc)
-	retrier        *clientutil.Retrier   // retrier handles retries on requests
+	ctx            context.Context         // ctx triggers shutdown upon cancellation
+	client         *zorkian.Client         // client sends runnimg metrics to backend & performs API validation
+	metricsAPI     *datadogV2.MetricsApi   // client sends runnimg metrics to backend
+	scrubber       scrub.Scrubber          // scrubber scrubs sensitive information from error messages
+	onceMetadata   *sync.Once              // onceMetadata ensures that metadata is sent only once across all exporters
+	auditLogs      *hostmetadata.AuditLogs // auditLogs emits the host metadata payloads as logs
+	agent          *agent.Agent            // agent processes incoming traces
+	sourceProvider source.Provider         // is able to source the origin of a trace (hostname, container, etc)
+	retrier        *clientutil.Retrier     // retrier handles retries on requests
 }
 
-func newTracesExporter(ctx context.Context, params exporter.CreateSettings, cfg *Config, onceMetadata *sync.Once, sourceProvider source.Provider, agent *agent.Agent) (*traceExporter, error) {
+func newTracesExporter(ctx context.Context, params exporter.CreateSettings, cfg *Config, onceMetadata *sync.Once, auditLogs *hostmetadata.AuditLogs, sourceProvider source.Provider, agent *agent.Agent) (*traceExporter, error) {
 	scrubber := scrub.NewScrubber()
 	exp := &traceExporter{
 		params:         params,
@@ -50,6 +51,7 @@ func newTracesExporter(ctx context.Context, params exporter.CreateSettings, cfg
 		ctx:            ctx,
 		agent:          agent,
 		onceMetadata:   onceMetadata,
+		auditLogs:      auditLogs,
 		scrubber:       scrubber,
 		sourceProvider: sourceProvider,
 		retrier:        clientutil.NewRetrier(params.Logger, cfg.RetrySettings, scrubber),
@@ -84,6 +86,9 @@ func (exp *traceExporter) consumeTraces(
 	td ptrace.Traces,
 ) (err error) {
 	defer func() { err = exp.scrubber.Scrub(err) }()
+	if dropped := exp.cfg.UnresolvedHostname.resolveTraces(td); dropped > 0 {
+		exp.params.Logger.Debug("Dropped spans with unresolved hostname", zap.Int("spans", dropped))
+	}
 	if exp.cfg.HostMetadata.Enabled {
 		// start host metadata with resource attributes from
 		// the first payload.
@@ -92,7 +97,7 @@ func (exp *traceExporter) consumeTraces(
 			if td.ResourceSpans().Len() > 0 {
 				attrs = td.ResourceSpans().At(0).Resource().Attributes()
 			}
-			go hostmetadata.Pusher(exp.ctx, exp.params, newMetadataConfigfromConfig(exp.cfg), exp.sourceProvider, attrs)
+			go hostmetadata.Pusher(exp.ctx, exp.params, newMetadataConfigfromConfig(exp.cfg, exp.auditLogs), exp.sourceProvider, attrs)
 		})
 	}
 	rspans := td.ResourceSpans()
@@ -174,6 +179,9 @@ func newTraceAgent(ctx context.Context, params exporter.CreateSettings, cfg *Con
 	acfg.SkipSSLValidation = cfg.LimitedHTTPClientSettings.TLSSetting.InsecureSkipVerify
 	acfg.ComputeStatsBySpanKind = cfg.Traces.ComputeStatsBySpanKind
 	acfg.PeerServiceAggregation = cfg.Traces.PeerServiceAggregation
+	if v := cfg.Traces.Batch.Timeout; v > 0 {
+		acfg.TraceWriter.FlushPeriodSeconds = v.Seconds()
+	}
 	if v := cfg.Traces.flushInterval; v > 0 {
 		acfg.TraceWriter.FlushPeriodSeconds = v
 	}
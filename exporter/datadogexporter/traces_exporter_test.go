@@ -133,9 +133,11 @@ func TestTracesSource(t *testing.T) {
 			Hostname: "fallbackHostname",
 		},
 		Metrics: MetricsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{Endpoint: metricsServer.URL},
 		},
 		Traces: TracesConfig{
+			Enabled:         true,
 			TCPAddr:         confignet.TCPAddr{Endpoint: tracesServer.URL},
 			IgnoreResources: []string{},
 		},
@@ -248,11 +250,13 @@ func TestTraceExporter(t *testing.T) {
 			Hostname: "test-host",
 		},
 		Metrics: MetricsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: metricsServer.URL,
 			},
 		},
 		Traces: TracesConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: server.URL,
 			},
@@ -285,6 +289,7 @@ func TestNewTracesExporter(t *testing.T) {
 
 	cfg := &Config{}
 	cfg.API.Key = "ddog_32_characters_long_api_key1"
+	cfg.Traces.Enabled = true
 	cfg.Metrics.TCPAddr.Endpoint = metricsServer.URL
 	params := exportertest.NewNopCreateSettings()
 
@@ -306,9 +311,11 @@ func TestPushTraceData(t *testing.T) {
 			Hostname: "test-host",
 		},
 		Metrics: MetricsConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{Endpoint: server.URL},
 		},
 		Traces: TracesConfig{
+			Enabled: true,
 			TCPAddr: confignet.TCPAddr{Endpoint: server.URL},
 		},
 
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter"
+
+import (
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// mutatesData reports whether the policy modifies the telemetry it is applied to.
+func (c UnresolvedHostnameConfig) mutatesData() bool {
+	return c.Policy == UnresolvedHostnamePolicyDrop || c.Policy == UnresolvedHostnamePolicyUseAttribute
+}
+
+// resolve applies the policy to a resource lacking hostname-like attributes,
+// and reports whether the telemetry of the resource must be dropped.
+func (c UnresolvedHostnameConfig) resolve(res pcommon.Resource) (drop bool) {
+	if !c.mutatesData() {
+		return false
+	}
+	if _, ok := attributes.SourceFromAttrs(res.Attributes()); ok {
+		return false
+	}
+
+	switch c.Policy {
+	case UnresolvedHostnamePolicyDrop:
+		return true
+	case UnresolvedHostnamePolicyUseAttribute:
+		if v, ok := res.Attributes().Get(c.Attribute); ok && v.AsString() != "" {
+			res.Attributes().PutStr(attributes.AttributeDatadogHostname, v.AsString())
+		}
+	case UnresolvedHostnamePolicyFallbackToCollectorHost:
+	}
+	return false
+}
+
+// resolveMetrics applies the policy to the resources of md, and returns the number of dropped data points.
+func (c UnresolvedHostnameConfig) resolveMetrics(md pmetric.Metrics) (dropped int) {
+	if !c.mutatesData() {
+		return 0
+	}
+	count := md.DataPointCount()
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		return c.resolve(rm.Resource())
+	})
+	return count - md.DataPointCount()
+}
+
+// resolveTraces applies the policy to the resources of td, and returns the number of dropped spans.
+func (c UnresolvedHostnameConfig) resolveTraces(td ptrace.Traces) (dropped int) {
+	if !c.mutatesData() {
+		return 0
+	}
+	count := td.SpanCount()
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		return c.resolve(rs.Resource())
+	})
+	return count - td.SpanCount()
+}
+
+// resolveLogs applies the policy to the resources of ld, and returns the number of dropped log records.
+func (c UnresolvedHostnameConfig) resolveLogs(ld plog.Logs) (dropped int) {
+	if !c.mutatesData() {
+		return 0
+	}
+	count := ld.LogRecordCount()
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		return c.resolve(rl.Resource())
+	})
+	return count - ld.LogRecordCount()
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter
+
+import (
+	"testing"
+
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func newUnresolvedHostnameMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, host := range []string{"known", "", ""} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		if host != "" {
+			rm.Resource().Attributes().PutStr("host.name", host)
+		} else {
+			rm.Resource().Attributes().PutStr("custom.host", "node")
+		}
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("test.metric")
+		m.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	}
+	return md
+}
+
+func TestUnresolvedHostname(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       UnresolvedHostnameConfig
+		dropped   int
+		resources int
+		hostnames []string
+	}{
+		{
+			name:      "fallback to collector host",
+			cfg:       UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyFallbackToCollectorHost},
+			resources: 3,
+			hostnames: []string{"", "", ""},
+		},
+		{
+			name:      "drop",
+			cfg:       UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyDrop},
+			dropped:   2,
+			resources: 1,
+			hostnames: []string{""},
+		},
+		{
+			name:      "use attribute",
+			cfg:       UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyUseAttribute, Attribute: "custom.host"},
+			resources: 3,
+			hostnames: []string{"", "node", "node"},
+		},
+		{
+			name:      "use missing attribute",
+			cfg:       UnresolvedHostnameConfig{Policy: UnresolvedHostnamePolicyUseAttribute, Attribute: "missing"},
+			resources: 3,
+			hostnames: []string{"", "", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := newUnresolvedHostnameMetrics()
+			assert.Equal(t, tt.dropped, tt.cfg.resolveMetrics(md))
+			assert.Equal(t, tt.cfg.mutatesData(), tt.cfg.Policy != UnresolvedHostnamePolicyFallbackToCollectorHost)
+
+			rms := md.ResourceMetrics()
+			assert.Equal(t, tt.resources, rms.Len())
+			for i := 0; i < rms.Len(); i++ {
+				hostname := ""
+				if v, ok := rms.At(i).Resource().Attributes().Get(attributes.AttributeDatadogHostname); ok {
+					hostname = v.Str()
+				}
+				assert.Equal(t, tt.hostnames[i], hostname)
+			}
+		})
+	}
+}
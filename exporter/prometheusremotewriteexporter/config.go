@@ -5,6 +5,7 @@ package prometheusremotewriteexporter // import "github.com/open-telemetry/opent
 
 import (
 	"fmt"
+	"net/http"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -42,6 +43,24 @@ type Config struct {
 
 	// CreatedMetric allows customizing creation of _created metrics
 	CreatedMetric *CreatedMetric `mapstructure:"export_created_metric,omitempty"`
+
+	// Tenant allows sending the metrics of each tenant in separate requests
+	// with a header identifying the tenant.
+	Tenant TenantConfig `mapstructure:"tenant"`
+}
+
+// TenantConfig allows to set the tenant of the requests from a resource attribute.
+type TenantConfig struct {
+	// ResourceAttribute is the resource attribute holding the tenant of the metrics.
+	// The tenant header isn't set if empty.
+	ResourceAttribute string `mapstructure:"resource_attribute"`
+
+	// Header is the header identifying the tenant of the requests.
+	Header string `mapstructure:"header"`
+
+	// Default is the tenant of the metrics of the resources without the resource attribute.
+	// The header isn't set for these metrics if empty.
+	Default string `mapstructure:"default"`
 }
 
 type CreatedMetric struct {
@@ -87,6 +106,21 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("remote write consumer number can't be negative")
 	}
 
+	if cfg.Tenant.ResourceAttribute != "" {
+		if cfg.Tenant.Header == "" {
+			return fmt.Errorf("tenant header can't be empty")
+		}
+		if cfg.WAL != nil {
+			return fmt.Errorf("tenant can't be set from a resource attribute when the WAL is enabled")
+		}
+		// the configured headers are set on every request, overriding the tenant header
+		for header := range cfg.HTTPClientSettings.Headers {
+			if http.CanonicalHeaderKey(header) == http.CanonicalHeaderKey(cfg.Tenant.Header) {
+				return fmt.Errorf("tenant header %q can't be set in headers when the tenant is set from a resource attribute", header)
+			}
+		}
+	}
+
 	if cfg.TargetInfo == nil {
 		cfg.TargetInfo = &TargetInfo{
 			Enabled: true,
@@ -76,6 +76,7 @@ func TestLoadConfig(t *testing.T) {
 					Enabled: true,
 				},
 				CreatedMetric: &CreatedMetric{Enabled: true},
+				Tenant:        TenantConfig{Header: "X-Scope-OrgID"},
 			},
 		},
 		{
@@ -86,6 +87,18 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "negative_num_consumers"),
 			errorMessage: "remote write consumer number can't be negative",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "tenant_header_conflict"),
+			errorMessage: `tenant header "x-scope-orgid" can't be set in headers when the tenant is set from a resource attribute`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "tenant_empty_header"),
+			errorMessage: "tenant header can't be empty",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "tenant_wal"),
+			errorMessage: "tenant can't be set from a resource attribute when the WAL is enabled",
+		},
 	}
 
 	for _, tt := range tests {
@@ -132,3 +145,21 @@ func TestDisabledTargetInfo(t *testing.T) {
 
 	assert.False(t, cfg.(*Config).TargetInfo.Enabled)
 }
+
+func TestTenant(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "tenant").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	assert.Equal(t, TenantConfig{
+		ResourceAttribute: "tenant.id",
+		Header:            "X-Scope-OrgID",
+		Default:           "anonymous",
+	}, cfg.(*Config).Tenant)
+}
@@ -14,6 +14,7 @@ type ctxKey int
 
 const (
 	loggerCtxKey ctxKey = iota
+	tenantCtxKey
 )
 
 func contextWithLogger(ctx context.Context, log *zap.Logger) context.Context {
@@ -33,3 +34,14 @@ func loggerFromContext(ctx context.Context) (*zap.Logger, error) {
 
 	return l, nil
 }
+
+func contextWithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantCtxKey, tenant)
+}
+
+// tenantFromContext returns the tenant of the requests, an empty string is returned
+// if the tenant isn't set.
+func tenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantCtxKey).(string)
+	return tenant
+}
@@ -44,6 +44,7 @@ type prwExporter struct {
 
 	wal              *prweWAL
 	exporterSettings prometheusremotewrite.Settings
+	tenant           TenantConfig
 }
 
 // newPRWExporter initializes a new prwExporter instance and sets fields accordingly.
@@ -74,6 +75,7 @@ func newPRWExporter(cfg *Config, set exporter.CreateSettings) (*prwExporter, err
 			DisableTargetInfo:   !cfg.TargetInfo.Enabled,
 			ExportCreatedMetric: cfg.CreatedMetric.Enabled,
 		},
+		tenant: cfg.Tenant,
 	}
 	if cfg.WAL == nil {
 		return prwe, nil
@@ -126,13 +128,55 @@ func (prwe *prwExporter) PushMetrics(ctx context.Context, md pmetric.Metrics) er
 	case <-prwe.closeChan:
 		return errors.New("shutdown has been called")
 	default:
-		tsMap, err := prometheusremotewrite.FromMetrics(md, prwe.exporterSettings)
-		if err != nil {
-			err = consumererror.NewPermanent(err)
+		if prwe.tenant.ResourceAttribute == "" {
+			return prwe.pushMetrics(ctx, md)
 		}
-		// Call export even if a conversion error, since there may be points that were successfully converted.
-		return multierr.Combine(err, prwe.handleExport(ctx, tsMap))
+
+		// The metrics of each tenant are batched and sent separately, and only
+		// the metrics of the tenants which failed are returned to be retried.
+		var errs error
+		failed := pmetric.NewMetrics()
+		for tenant, tenantMetrics := range prwe.groupByTenant(md) {
+			if err := prwe.pushMetrics(contextWithTenant(ctx, tenant), tenantMetrics); err != nil {
+				errs = multierr.Append(errs, err)
+				tenantMetrics.ResourceMetrics().MoveAndAppendTo(failed.ResourceMetrics())
+			}
+		}
+		if errs != nil {
+			return consumererror.NewMetrics(errs, failed)
+		}
+		return nil
+	}
+}
+
+func (prwe *prwExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	tsMap, err := prometheusremotewrite.FromMetrics(md, prwe.exporterSettings)
+	if err != nil {
+		err = consumererror.NewPermanent(err)
+	}
+	// Call export even if a conversion error, since there may be points that were successfully converted.
+	return multierr.Combine(err, prwe.handleExport(ctx, tsMap))
+}
+
+// groupByTenant splits the metrics by the tenant of their resources.
+func (prwe *prwExporter) groupByTenant(md pmetric.Metrics) map[string]pmetric.Metrics {
+	tenants := map[string]pmetric.Metrics{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		tenant := prwe.tenant.Default
+		if v, ok := rm.Resource().Attributes().Get(prwe.tenant.ResourceAttribute); ok && v.AsString() != "" {
+			tenant = v.AsString()
+		}
+
+		tenantMetrics, ok := tenants[tenant]
+		if !ok {
+			tenantMetrics = pmetric.NewMetrics()
+			tenants[tenant] = tenantMetrics
+		}
+		rm.CopyTo(tenantMetrics.ResourceMetrics().AppendEmpty())
 	}
+	return tenants
 }
 
 func validateAndSanitizeExternalLabels(cfg *Config) (map[string]string, error) {
@@ -235,6 +279,9 @@ func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequ
 	req.Header.Set("Content-Type", "application/x-protobuf")
 	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
 	req.Header.Set("User-Agent", prwe.userAgentHeader)
+	if tenant := tenantFromContext(ctx); tenant != "" {
+		req.Header.Set(prwe.tenant.Header, tenant)
+	}
 
 	resp, err := prwe.client.Do(req)
 	if err != nil {
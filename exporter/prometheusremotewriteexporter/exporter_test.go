@@ -5,6 +5,8 @@ package prometheusremotewriteexporter
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -22,6 +24,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/exporter/exportertest"
@@ -717,6 +720,139 @@ func Test_PushMetrics(t *testing.T) {
 	}
 }
 
+func Test_PushMetricsTenants(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for _, tenant := range []string{"team-a", "team-b", "", "team-a"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		if tenant != "" {
+			rm.Resource().Attributes().PutStr("tenant.id", tenant)
+		}
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("requests")
+		dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetIntValue(1)
+		dp.Attributes().PutStr("resource", fmt.Sprint(md.ResourceMetrics().Len()))
+	}
+
+	tests := []struct {
+		name     string
+		tenant   TenantConfig
+		expected map[string]int
+	}{
+		{
+			name:     "default tenant",
+			tenant:   TenantConfig{ResourceAttribute: "tenant.id", Header: "X-Scope-OrgID", Default: "anonymous"},
+			expected: map[string]int{"team-a": 2, "team-b": 1, "anonymous": 1},
+		},
+		{
+			name:     "no default tenant",
+			tenant:   TenantConfig{ResourceAttribute: "tenant.id", Header: "X-Scope-OrgID"},
+			expected: map[string]int{"team-a": 2, "team-b": 1, "": 1},
+		},
+		{
+			name:     "disabled",
+			tenant:   TenantConfig{Header: "X-Scope-OrgID"},
+			expected: map[string]int{"": 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			received := map[string]int{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				dest, err := snappy.Decode(nil, body)
+				require.NoError(t, err)
+				wr := &prompb.WriteRequest{}
+				require.NoError(t, proto.Unmarshal(dest, wr))
+
+				mu.Lock()
+				defer mu.Unlock()
+				for _, ts := range wr.Timeseries {
+					for _, l := range ts.Labels {
+						if l.Name == "__name__" && l.Value == "requests" {
+							received[r.Header.Get("X-Scope-OrgID")]++
+						}
+					}
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.HTTPClientSettings.Endpoint = server.URL
+			cfg.Tenant = tt.tenant
+			require.NoError(t, cfg.Validate())
+
+			prwe, err := newPRWExporter(cfg, exportertest.NewNopCreateSettings())
+			require.NoError(t, err)
+			ctx := context.Background()
+			require.NoError(t, prwe.Start(ctx, componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, prwe.Shutdown(ctx))
+			}()
+
+			require.NoError(t, prwe.PushMetrics(ctx, md))
+			assert.Equal(t, tt.expected, received)
+		})
+	}
+}
+
+func Test_PushMetricsTenantsPartialFailure(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for _, tenant := range []string{"team-a", "team-b", "team-a"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("tenant.id", tenant)
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("requests")
+		dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetIntValue(1)
+		dp.Attributes().PutStr("resource", fmt.Sprint(md.ResourceMetrics().Len()))
+	}
+
+	var mu sync.Mutex
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant := r.Header.Get("X-Scope-OrgID")
+		if tenant == "team-b" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, tenant)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = server.URL
+	cfg.Tenant = TenantConfig{ResourceAttribute: "tenant.id", Header: "X-Scope-OrgID"}
+	require.NoError(t, cfg.Validate())
+
+	prwe, err := newPRWExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, prwe.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, prwe.Shutdown(ctx))
+	}()
+
+	err = prwe.PushMetrics(ctx, md)
+	require.Error(t, err)
+	assert.Equal(t, []string{"team-a"}, received)
+
+	// only the metrics of the tenant which failed are returned
+	var metricsErr consumererror.Metrics
+	require.True(t, errors.As(err, &metricsErr))
+	failed := metricsErr.Data()
+	require.Equal(t, 1, failed.ResourceMetrics().Len())
+	tenant, _ := failed.ResourceMetrics().At(0).Resource().Attributes().Get("tenant.id")
+	assert.Equal(t, "team-b", tenant.Str())
+}
+
 func Test_validateAndSanitizeExternalLabels(t *testing.T) {
 	tests := []struct {
 		name                string
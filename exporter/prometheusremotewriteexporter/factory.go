@@ -100,5 +100,8 @@ func createDefaultConfig() component.Config {
 		CreatedMetric: &CreatedMetric{
 			Enabled: false,
 		},
+		Tenant: TenantConfig{
+			Header: "X-Scope-OrgID",
+		},
 	}
 }
@@ -55,8 +55,16 @@ type Config struct {
 	// Useful if you want to override the source host configured for the source.
 	// Placeholders `%{attr_name}` will be replaced with attribute value for attr_name.
 	SourceHost string `mapstructure:"source_host"`
+	// Ordered list of the providers of the system host name, which is sent as source host
+	// when source_host is not set or resolves to an empty value, and there is no host.name attribute.
+	// Possible values are `fqdn` and `os`. An empty list disables the fallback.
+	SourceHostFallback []HostnameProvider `mapstructure:"source_host_fallback"`
 	// Name of the client
 	Client string `mapstructure:"client"`
+	// StickySessionEnabled keeps the cookies set by the endpoint, e.g. the AWSALB cookie
+	// of a load balancer, and sends them back with the following requests, so that the
+	// requests of the exporter are routed to the same backend.
+	StickySessionEnabled bool `mapstructure:"sticky_session_enabled"`
 }
 
 // CreateDefaultHTTPClientSettings returns default http client settings
@@ -147,6 +155,15 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("unexpected compression encoding: %s", cfg.CompressEncoding)
 	}
 
+	for _, provider := range cfg.SourceHostFallback {
+		switch provider {
+		case FQDNHostnameProvider:
+		case OSHostnameProvider:
+		default:
+			return fmt.Errorf("unexpected source host fallback provider: %s", provider)
+		}
+	}
+
 	if len(cfg.HTTPClientSettings.Endpoint) == 0 {
 		return errors.New("endpoint is not set")
 	}
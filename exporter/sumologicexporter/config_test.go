@@ -57,6 +57,20 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectedErr: "unexpected compression encoding: test_format",
 		},
+		{
+			name: "invalid source host fallback",
+			cfg: &Config{
+				LogFormat:          "json",
+				MetricFormat:       "carbon2",
+				CompressEncoding:   "gzip",
+				SourceHostFallback: []HostnameProvider{"os", "dns"},
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Timeout:  defaultTimeout,
+					Endpoint: "test_endpoint",
+				},
+			},
+			expectedErr: "unexpected source host fallback provider: dns",
+		},
 		{
 			name: "invalid endpoint",
 			cfg: &Config{
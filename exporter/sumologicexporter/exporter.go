@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/http/cookiejar"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
@@ -15,17 +16,21 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 )
 
 type sumologicexporter struct {
 	sources             sourceFormats
+	hostname            *hostnameResolver
 	config              *Config
 	client              *http.Client
 	filter              filter
 	prometheusFormatter prometheusFormatter
 	graphiteFormatter   graphiteFormatter
 	settings            component.TelemetrySettings
+	tracer              trace.Tracer
+	retries             *retryTracker
 }
 
 func initExporter(cfg *Config, settings component.TelemetrySettings) (*sumologicexporter, error) {
@@ -68,10 +73,13 @@ func initExporter(cfg *Config, settings component.TelemetrySettings) (*sumologic
 	se := &sumologicexporter{
 		config:              cfg,
 		sources:             sfs,
+		hostname:            newHostnameResolver(cfg.SourceHostFallback, settings.Logger),
 		filter:              f,
 		prometheusFormatter: pf,
 		graphiteFormatter:   gf,
 		settings:            settings,
+		tracer:              settings.TracerProvider.Tracer(tracerName),
+		retries:             newRetryTracker(),
 	}
 
 	return se, nil
@@ -130,6 +138,12 @@ func (se *sumologicexporter) start(_ context.Context, host component.Host) (err
 		return fmt.Errorf("failed to create HTTP Client: %w", err)
 	}
 
+	if se.config.StickySessionEnabled {
+		if client.Jar, err = cookiejar.New(nil); err != nil {
+			return fmt.Errorf("failed to create cookie jar: %w", err)
+		}
+	}
+
 	se.client = client
 
 	return nil
@@ -156,9 +170,12 @@ func (se *sumologicexporter) pushLogsData(ctx context.Context, ld plog.Logs) err
 		se.client,
 		se.filter,
 		se.sources,
+		se.hostname,
 		c,
 		se.prometheusFormatter,
 		se.graphiteFormatter,
+		se.tracer,
+		se.retries.attempt(ctx),
 	)
 
 	// Iterate over ResourceLogs
@@ -225,6 +242,7 @@ func (se *sumologicexporter) pushLogsData(ctx context.Context, ld plog.Logs) err
 		return consumererror.NewLogs(errs, droppedLogs)
 	}
 
+	se.retries.done(ctx)
 	return nil
 }
 
@@ -249,9 +267,12 @@ func (se *sumologicexporter) pushMetricsData(ctx context.Context, md pmetric.Met
 		se.client,
 		se.filter,
 		se.sources,
+		se.hostname,
 		c,
 		se.prometheusFormatter,
 		se.graphiteFormatter,
+		se.tracer,
+		se.retries.attempt(ctx),
 	)
 
 	// Iterate over ResourceMetrics
@@ -324,5 +345,6 @@ func (se *sumologicexporter) pushMetricsData(ctx context.Context, md pmetric.Met
 		return consumererror.NewMetrics(errs, droppedMetrics)
 	}
 
+	se.retries.done(ctx)
 	return nil
 }
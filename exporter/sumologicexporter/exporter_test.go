@@ -5,16 +5,22 @@ package sumologicexporter
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/plog"
 )
@@ -170,6 +176,68 @@ func TestInvalidHTTPCLient(t *testing.T) {
 	assert.EqualError(t, err, "failed to create HTTP Client: roundTripperException")
 }
 
+func TestStickySession(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if requests.Add(1) == 1 {
+			http.SetCookie(w, &http.Cookie{Name: "AWSALB", Value: "backend-1"})
+			return
+		}
+		cookie, err := req.Cookie("AWSALB")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "backend-1", cookie.Value)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = srv.URL
+	cfg.StickySessionEnabled = true
+	se, err := initExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.NoError(t, se.start(context.Background(), componenttest.NewNopHost()))
+
+	logs := LogRecordsToLogs(exampleLog())
+	require.NoError(t, se.pushLogsData(context.Background(), logs))
+	require.NoError(t, se.pushLogsData(context.Background(), logs))
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestCustomTLSSettings(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, uint16(tls.VersionTLS13), req.TLS.Version)
+	}))
+	defer srv.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = srv.URL
+	cfg.HTTPClientSettings.TLSSetting = configtls.TLSClientSetting{
+		TLSSetting: configtls.TLSSetting{
+			CAPem: configopaque.String(pem.EncodeToMemory(&pem.Block{
+				Type:  "CERTIFICATE",
+				Bytes: srv.Certificate().Raw,
+			})),
+			MinVersion: "1.3",
+		},
+	}
+	se, err := initExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.NoError(t, se.start(context.Background(), componenttest.NewNopHost()))
+
+	assert.NoError(t, se.pushLogsData(context.Background(), LogRecordsToLogs(exampleLog())))
+}
+
+func TestInvalidTLSSettings(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = "https://localhost"
+	cfg.HTTPClientSettings.TLSSetting.MinVersion = "1.0.1"
+	se, err := initExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	err = se.start(context.Background(), componenttest.NewNopHost())
+	assert.ErrorContains(t, err, "failed to create HTTP Client")
+}
+
 func TestPushInvalidCompressor(t *testing.T) {
 	test := prepareSenderTest(t, []func(w http.ResponseWriter, req *http.Request){
 		func(w http.ResponseWriter, req *http.Request) {
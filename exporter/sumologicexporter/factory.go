@@ -39,6 +39,7 @@ func createDefaultConfig() component.Config {
 		SourceCategory:     DefaultSourceCategory,
 		SourceName:         DefaultSourceName,
 		SourceHost:         DefaultSourceHost,
+		SourceHostFallback: []HostnameProvider{FQDNHostnameProvider, OSHostnameProvider},
 		Client:             DefaultClient,
 		GraphiteTemplate:   DefaultGraphiteTemplate,
 
@@ -34,6 +34,7 @@ func TestCreateDefaultConfig(t *testing.T) {
 		SourceCategory:     "",
 		SourceName:         "",
 		SourceHost:         "",
+		SourceHostFallback: []HostnameProvider{"fqdn", "os"},
 		Client:             "otelcol",
 		GraphiteTemplate:   "%{_metric_}",
 
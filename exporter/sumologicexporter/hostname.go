@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sumologicexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
+
+import (
+	"os"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// HostnameProvider represents a provider of the system host name in source_host_fallback
+type HostnameProvider string
+
+const (
+	// FQDNHostnameProvider resolves the fully qualified domain name of the host
+	FQDNHostnameProvider HostnameProvider = "fqdn"
+	// OSHostnameProvider resolves the host name reported by the operating system
+	OSHostnameProvider HostnameProvider = "os"
+)
+
+// getOSHostname is overridden in tests
+var getOSHostname = os.Hostname
+
+// hostnameResolver resolves the system host name once, using the first provider
+// returning a non empty host name.
+type hostnameResolver struct {
+	once      sync.Once
+	providers []HostnameProvider
+	logger    *zap.Logger
+	hostname  string
+}
+
+func newHostnameResolver(providers []HostnameProvider, logger *zap.Logger) *hostnameResolver {
+	if len(providers) == 0 {
+		return nil
+	}
+	return &hostnameResolver{
+		providers: providers,
+		logger:    logger,
+	}
+}
+
+// get returns the cached system host name, or an empty string when the resolver is nil
+// or none of the providers could resolve it.
+func (r *hostnameResolver) get() string {
+	if r == nil {
+		return ""
+	}
+	r.once.Do(func() {
+		for _, provider := range r.providers {
+			hostname, err := resolveHostname(provider)
+			if err != nil {
+				r.logger.Warn("Could not resolve the system host name", zap.String("provider", string(provider)), zap.Error(err))
+				continue
+			}
+			if hostname != "" {
+				r.hostname = hostname
+				return
+			}
+		}
+		r.logger.Warn("None of the providers resolved the system host name, source host is left empty")
+	})
+	return r.hostname
+}
+
+func resolveHostname(provider HostnameProvider) (string, error) {
+	switch provider {
+	case FQDNHostnameProvider:
+		return getSystemFQDN()
+	case OSHostnameProvider:
+		return getOSHostname()
+	}
+	return "", nil
+}
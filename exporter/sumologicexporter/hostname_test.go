@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sumologicexporter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestHostnameResolver(t *testing.T) {
+	origFQDN, origOS := getSystemFQDN, getOSHostname
+	defer func() { getSystemFQDN, getOSHostname = origFQDN, origOS }()
+
+	fqdn := func() (string, error) { return "host.example.com", nil }
+	getOSHostname = func() (string, error) { return "host", nil }
+
+	tests := []struct {
+		name      string
+		providers []HostnameProvider
+		fqdn      func() (string, error)
+		expected  string
+	}{
+		{
+			name:      "fqdn first",
+			providers: []HostnameProvider{FQDNHostnameProvider, OSHostnameProvider},
+			fqdn:      fqdn,
+			expected:  "host.example.com",
+		},
+		{
+			name:      "os first",
+			providers: []HostnameProvider{OSHostnameProvider, FQDNHostnameProvider},
+			fqdn:      fqdn,
+			expected:  "host",
+		},
+		{
+			name:      "fqdn not available",
+			providers: []HostnameProvider{FQDNHostnameProvider, OSHostnameProvider},
+			fqdn:      func() (string, error) { return "", nil },
+			expected:  "host",
+		},
+		{
+			name:      "fqdn error",
+			providers: []HostnameProvider{FQDNHostnameProvider},
+			fqdn:      func() (string, error) { return "", errors.New("timeout") },
+			expected:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getSystemFQDN = tt.fqdn
+			r := newHostnameResolver(tt.providers, zap.NewNop())
+			assert.Equal(t, tt.expected, r.get())
+		})
+	}
+
+	// the host name is resolved once
+	fqdnCalls := 0
+	getSystemFQDN = func() (string, error) {
+		fqdnCalls++
+		return "host.example.com", nil
+	}
+	r := newHostnameResolver([]HostnameProvider{FQDNHostnameProvider}, zap.NewNop())
+	assert.Equal(t, "host.example.com", r.get())
+	assert.Equal(t, "host.example.com", r.get())
+	assert.Equal(t, 1, fqdnCalls)
+}
+
+func TestHostnameResolverDisabled(t *testing.T) {
+	r := newHostnameResolver(nil, zap.NewNop())
+	assert.Nil(t, r)
+	assert.Equal(t, "", r.get())
+}
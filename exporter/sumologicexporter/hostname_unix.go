@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+// +build !windows
+
+package sumologicexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+var hostnamePath = "/bin/hostname"
+
+// getSystemFQDN is overridden in tests
+var getSystemFQDN = func() (string, error) {
+	// Go does not provide a way to get the full hostname
+	// so we make a best-effort by running the hostname binary
+	// if available
+	if _, err := os.Stat(hostnamePath); err != nil {
+		return "", nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, hostnamePath, "-f").Output()
+	return strings.TrimSpace(string(out)), err
+}
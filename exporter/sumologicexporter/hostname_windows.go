@@ -0,0 +1,14 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+// +build windows
+
+package sumologicexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
+
+// getSystemFQDN is overridden in tests
+var getSystemFQDN = func() (string, error) {
+	// Resolving the FQDN requires CGo on Windows, which is not allowed,
+	// so the next provider is used
+	return "", nil
+}
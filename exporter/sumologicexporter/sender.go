@@ -16,6 +16,9 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 )
 
@@ -39,9 +42,13 @@ type sender struct {
 	client              *http.Client
 	filter              filter
 	sources             sourceFormats
+	hostname            *hostnameResolver
 	compressor          compressor
 	prometheusFormatter prometheusFormatter
 	graphiteFormatter   graphiteFormatter
+	tracer              trace.Tracer
+	// retryCount is the number of previous attempts to send the current batch
+	retryCount int
 }
 
 const (
@@ -77,30 +84,78 @@ func newSender(
 	cl *http.Client,
 	f filter,
 	s sourceFormats,
+	h *hostnameResolver,
 	c compressor,
 	pf prometheusFormatter,
 	gf graphiteFormatter,
+	tr trace.Tracer,
+	retryCount int,
 ) *sender {
 	return &sender{
 		config:              cfg,
 		client:              cl,
 		filter:              f,
 		sources:             s,
+		hostname:            h,
 		compressor:          c,
 		prometheusFormatter: pf,
 		graphiteFormatter:   gf,
+		tracer:              tr,
+		retryCount:          retryCount,
 	}
 }
 
-// send sends data to sumologic
+// sourceHost returns the source host of the data with the given metadata, which is the
+// source_host template, or the host.name attribute or the system host name when the
+// template is not set or resolves to an empty value and the fallback is enabled.
+func (s *sender) sourceHost(flds fields) (string, bool) {
+	var host string
+	if s.sources.host.isSet() {
+		host = s.sources.host.format(flds)
+	}
+	if host == "" && s.hostname != nil {
+		if v, ok := flds.orig.Get(conventions.AttributeHostName); ok {
+			host = v.AsString()
+		}
+		if host == "" {
+			host = s.hostname.get()
+		}
+	}
+	return host, host != "" || s.sources.host.isSet()
+}
+
+// send sends data to sumologic within a span describing the request
 func (s *sender) send(ctx context.Context, pipeline PipelineType, body io.Reader, flds fields) error {
+	ctx, span := s.tracer.Start(ctx, sendSpan,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributeEndpoint.String(s.config.HTTPClientSettings.Endpoint),
+			attributePipeline.String(string(pipeline)),
+			attributeRetryCount.Int(s.retryCount),
+		),
+	)
+	defer span.End()
+
+	statusCode, err := s.doSend(ctx, pipeline, body, flds)
+	if statusCode != 0 {
+		span.SetAttributes(attributeStatusCode.Int(statusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// doSend sends data to sumologic and returns the response status code if a response was received
+func (s *sender) doSend(ctx context.Context, pipeline PipelineType, body io.Reader, flds fields) (int, error) {
 	data, err := s.compressor.compress(body)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.HTTPClientSettings.Endpoint, data)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// Add headers
@@ -111,13 +166,13 @@ func (s *sender) send(ctx context.Context, pipeline PipelineType, body io.Reader
 		req.Header.Set(headerContentEncoding, contentEncodingDeflate)
 	case NoCompression:
 	default:
-		return fmt.Errorf("invalid content encoding: %s", s.config.CompressEncoding)
+		return 0, fmt.Errorf("invalid content encoding: %s", s.config.CompressEncoding)
 	}
 
 	req.Header.Add(headerClient, s.config.Client)
 
-	if s.sources.host.isSet() {
-		req.Header.Add(headerHost, s.sources.host.format(flds))
+	if host, ok := s.sourceHost(flds); ok {
+		req.Header.Add(headerHost, host)
 	}
 
 	if s.sources.name.isSet() {
@@ -141,20 +196,20 @@ func (s *sender) send(ctx context.Context, pipeline PipelineType, body io.Reader
 		case GraphiteFormat:
 			req.Header.Add(headerContentType, contentTypeGraphite)
 		default:
-			return fmt.Errorf("unsupported metrics format: %s", s.config.MetricFormat)
+			return 0, fmt.Errorf("unsupported metrics format: %s", s.config.MetricFormat)
 		}
 	default:
-		return errors.New("unexpected pipeline")
+		return 0, errors.New("unexpected pipeline")
 	}
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
-		return fmt.Errorf("error during sending data: %s", resp.Status)
+		return resp.StatusCode, fmt.Errorf("error during sending data: %s", resp.Status)
 	}
-	return nil
+	return resp.StatusCode, nil
 }
 
 // logToText converts LogRecord to a plain text line, returns it and error eventually
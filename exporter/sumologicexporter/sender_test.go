@@ -81,9 +81,12 @@ func prepareSenderTest(t *testing.T, cb []func(w http.ResponseWriter, req *http.
 				category: getTestSourceFormat("source_category"),
 				name:     getTestSourceFormat("source_name"),
 			},
+			nil,
 			c,
 			pf,
 			gf,
+			exp.tracer,
+			0,
 		),
 	}
 }
@@ -446,6 +449,53 @@ func TestOverrideSourceHost(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSourceHostFallback(t *testing.T) {
+	test := prepareSenderTest(t, []func(w http.ResponseWriter, req *http.Request){
+		func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "resource-host", req.Header.Get("X-Sumo-Host"))
+		},
+		func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "system-host", req.Header.Get("X-Sumo-Host"))
+		},
+		func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "system-host", req.Header.Get("X-Sumo-Host"))
+		},
+	})
+	defer func() { test.srv.Close() }()
+
+	test.s.sources.host = getTestSourceFormat("%{key1}")
+	test.s.hostname = &hostnameResolver{hostname: "system-host"}
+	test.s.hostname.once.Do(func() {})
+
+	test.s.logBuffer = exampleLog()
+	_, err := test.s.sendLogs(context.Background(), fieldsFromMap(map[string]string{"host.name": "resource-host"}))
+	assert.NoError(t, err)
+
+	test.s.logBuffer = exampleLog()
+	_, err = test.s.sendLogs(context.Background(), fieldsFromMap(map[string]string{"key2": "value"}))
+	assert.NoError(t, err)
+
+	test.s.sources.host = sourceFormat{}
+	test.s.logBuffer = exampleLog()
+	_, err = test.s.sendLogs(context.Background(), fieldsFromMap(map[string]string{}))
+	assert.NoError(t, err)
+}
+
+func TestSourceHostFallbackDisabled(t *testing.T) {
+	test := prepareSenderTest(t, []func(w http.ResponseWriter, req *http.Request){
+		func(w http.ResponseWriter, req *http.Request) {
+			assert.NotContains(t, req.Header, "X-Sumo-Host")
+		},
+	})
+	defer func() { test.srv.Close() }()
+
+	test.s.sources.host = sourceFormat{}
+	test.s.logBuffer = exampleLog()
+
+	_, err := test.s.sendLogs(context.Background(), fieldsFromMap(map[string]string{"host.name": "resource-host"}))
+	assert.NoError(t, err)
+}
+
 func TestLogsBuffer(t *testing.T) {
 	test := prepareSenderTest(t, []func(w http.ResponseWriter, req *http.Request){})
 	defer func() { test.srv.Close() }()
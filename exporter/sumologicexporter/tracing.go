@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sumologicexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName string = "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
+	sendSpan   string = "sumologic/send"
+
+	attributeEndpoint   = attribute.Key("http.url")
+	attributeStatusCode = attribute.Key("http.status_code")
+	attributePipeline   = attribute.Key("sumologic.pipeline")
+	attributeRetryCount = attribute.Key("sumologic.retry_count")
+
+	// maxTrackedRequests bounds the memory used to track retries of requests
+	// which were eventually dropped by the retry mechanism
+	maxTrackedRequests int = 1024
+)
+
+// retryTracker counts the export attempts of a single batch of data.
+// The exporterhelper retry mechanism reuses the request context for every
+// attempt, so attempts are keyed by the span found in that context.
+type retryTracker struct {
+	mu       sync.Mutex
+	attempts map[trace.SpanID]int
+}
+
+func newRetryTracker() *retryTracker {
+	return &retryTracker{
+		attempts: make(map[trace.SpanID]int),
+	}
+}
+
+// attempt registers a new attempt and returns the number of previous attempts
+func (rt *retryTracker) attempt(ctx context.Context) int {
+	spanID := trace.SpanContextFromContext(ctx).SpanID()
+	if !spanID.IsValid() {
+		return 0
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	retries, ok := rt.attempts[spanID]
+	if !ok && len(rt.attempts) >= maxTrackedRequests {
+		rt.attempts = make(map[trace.SpanID]int)
+	}
+	rt.attempts[spanID] = retries + 1
+	return retries
+}
+
+// done forgets the attempts of a batch which was sent successfully
+func (rt *retryTracker) done(ctx context.Context) {
+	spanID := trace.SpanContextFromContext(ctx).SpanID()
+	if !spanID.IsValid() {
+		return
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	delete(rt.attempts, spanID)
+}
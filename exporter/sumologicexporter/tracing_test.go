@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sumologicexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func contextWithSpanID(id byte) context.Context {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{id},
+	})
+	return trace.ContextWithSpanContext(context.Background(), sc)
+}
+
+func TestRetryTrackerCountsAttempts(t *testing.T) {
+	rt := newRetryTracker()
+	ctx := contextWithSpanID(1)
+
+	assert.Equal(t, 0, rt.attempt(ctx))
+	assert.Equal(t, 1, rt.attempt(ctx))
+	assert.Equal(t, 2, rt.attempt(ctx))
+
+	// Other batches are tracked independently
+	assert.Equal(t, 0, rt.attempt(contextWithSpanID(2)))
+
+	rt.done(ctx)
+	assert.Equal(t, 0, rt.attempt(ctx))
+}
+
+func TestRetryTrackerWithoutSpan(t *testing.T) {
+	rt := newRetryTracker()
+
+	assert.Equal(t, 0, rt.attempt(context.Background()))
+	assert.Equal(t, 0, rt.attempt(context.Background()))
+	assert.Empty(t, rt.attempts)
+}
+
+func TestRetryTrackerIsBounded(t *testing.T) {
+	rt := newRetryTracker()
+	for i := 0; i < maxTrackedRequests+1; i++ {
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{byte(i), byte(i >> 8), 1},
+		})
+		rt.attempt(trace.ContextWithSpanContext(context.Background(), sc))
+	}
+	assert.LessOrEqual(t, len(rt.attempts), maxTrackedRequests)
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthpushextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthpushextension"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+// Config defines configuration for the health push extension.
+type Config struct {
+	// HTTPClientSettings configures the client used to push the status, including
+	// the endpoint, headers, TLS and the authenticator extension to use.
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+
+	// Interval is the time between two pushes of the status.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("'endpoint' config option cannot be empty")
+	}
+	if _, err := url.ParseRequestURI(cfg.Endpoint); err != nil {
+		return fmt.Errorf("enter a valid URL for 'endpoint': %w", err)
+	}
+	if cfg.Interval <= 0 {
+		return errors.New("'interval' must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package healthpushextension implements an extension that periodically
+// pushes the health and status of the collector to an HTTP endpoint.
+package healthpushextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthpushextension"
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthpushextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthpushextension"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.uber.org/zap"
+)
+
+const (
+	statusReady    = "ready"
+	statusNotReady = "not_ready"
+	statusStopping = "stopping"
+)
+
+// healthStatus is the JSON document pushed to the endpoint.
+type healthStatus struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+	UpSince   time.Time `json:"up_since"`
+	Uptime    string    `json:"uptime"`
+	HostName  string    `json:"host_name,omitempty"`
+	Command   string    `json:"command"`
+	Version   string    `json:"version"`
+}
+
+type healthPushExtension struct {
+	config    *Config
+	settings  component.TelemetrySettings
+	buildInfo component.BuildInfo
+	hostName  string
+
+	client    *http.Client
+	ready     atomic.Bool
+	startTime time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ extension.PipelineWatcher = (*healthPushExtension)(nil)
+
+func newHealthPushExtension(cfg *Config, set extension.CreateSettings) *healthPushExtension {
+	hostName, _ := os.Hostname()
+	return &healthPushExtension{
+		config:    cfg,
+		settings:  set.TelemetrySettings,
+		buildInfo: set.BuildInfo,
+		hostName:  hostName,
+	}
+}
+
+func (e *healthPushExtension) Start(_ context.Context, host component.Host) error {
+	client, err := e.config.ToClient(host, e.settings)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP client: %w", err)
+	}
+	e.client = client
+	e.startTime = time.Now()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+
+		ticker := time.NewTicker(e.config.Interval)
+		defer ticker.Stop()
+
+		e.push(ctx, e.currentStatus())
+		for {
+			select {
+			case <-ticker.C:
+				e.push(ctx, e.currentStatus())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the periodic pushes and notifies the endpoint that the collector is stopping.
+func (e *healthPushExtension) Shutdown(ctx context.Context) error {
+	if e.cancel == nil {
+		return nil
+	}
+	e.cancel()
+	e.wg.Wait()
+
+	e.push(ctx, statusStopping)
+	return nil
+}
+
+// Ready is called when all pipelines have been started.
+func (e *healthPushExtension) Ready() error {
+	e.ready.Store(true)
+	return nil
+}
+
+// NotReady is called before the pipelines are shut down.
+func (e *healthPushExtension) NotReady() error {
+	e.ready.Store(false)
+	return nil
+}
+
+func (e *healthPushExtension) currentStatus() string {
+	if e.ready.Load() {
+		return statusReady
+	}
+	return statusNotReady
+}
+
+func (e *healthPushExtension) push(ctx context.Context, status string) {
+	if err := e.send(ctx, status); err != nil {
+		e.settings.Logger.Warn("Failed to push health status", zap.String("endpoint", e.config.Endpoint), zap.Error(err))
+	}
+}
+
+func (e *healthPushExtension) send(ctx context.Context, status string) error {
+	now := time.Now()
+	body, err := json.Marshal(healthStatus{
+		Status:    status,
+		Timestamp: now.UTC(),
+		UpSince:   e.startTime.UTC(),
+		Uptime:    now.Sub(e.startTime).String(),
+		HostName:  e.hostName,
+		Command:   e.buildInfo.Command,
+		Version:   e.buildInfo.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint responded with HTTP status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthpushextension
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+)
+
+type statusRecorder struct {
+	mu       sync.Mutex
+	statuses []healthStatus
+	headers  []http.Header
+}
+
+func (r *statusRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var status healthStatus
+	if err := json.NewDecoder(req.Body).Decode(&status); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statuses = append(r.statuses, status)
+	r.headers = append(r.headers, req.Header.Clone())
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (r *statusRecorder) received() []healthStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]healthStatus{}, r.statuses...)
+}
+
+func TestHealthPush(t *testing.T) {
+	recorder := &statusRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	cfg.Interval = 10 * time.Millisecond
+	cfg.Headers = map[string]configopaque.String{"x-fleet": "production"}
+
+	set := extensiontest.NewNopCreateSettings()
+	set.BuildInfo.Command = "otelcol-contrib"
+	set.BuildInfo.Version = "0.81.0"
+	ext := newHealthPushExtension(cfg, set)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.Eventually(t, func() bool {
+		statuses := recorder.received()
+		return len(statuses) > 0 && statuses[0].Status == statusNotReady
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, ext.Ready())
+	require.Eventually(t, func() bool {
+		statuses := recorder.received()
+		return statuses[len(statuses)-1].Status == statusReady
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, ext.NotReady())
+	require.NoError(t, ext.Shutdown(context.Background()))
+
+	statuses := recorder.received()
+	last := statuses[len(statuses)-1]
+	assert.Equal(t, statusStopping, last.Status)
+	assert.Equal(t, "otelcol-contrib", last.Command)
+	assert.Equal(t, "0.81.0", last.Version)
+	assert.False(t, last.UpSince.IsZero())
+	assert.False(t, last.Timestamp.Before(last.UpSince))
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for _, h := range recorder.headers {
+		assert.Equal(t, "application/json", h.Get("Content-Type"))
+		assert.Equal(t, "production", h.Get("x-fleet"))
+	}
+}
+
+func TestHealthPushEndpointError(t *testing.T) {
+	var calls int
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	ext := newHealthPushExtension(cfg, extensiontest.NewNopCreateSettings())
+	ext.client = http.DefaultClient
+
+	assert.EqualError(t, ext.send(context.Background(), statusReady), "endpoint responded with HTTP status 503")
+
+	// Failed pushes are not fatal
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, ext.Shutdown(context.Background()))
+	mu.Lock()
+	defer mu.Unlock()
+	assert.GreaterOrEqual(t, calls, 2)
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	ext := newHealthPushExtension(cfg, extensiontest.NewNopCreateSettings())
+	assert.NoError(t, ext.Shutdown(context.Background()))
+}
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpdecompressionextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errInvalidMaxDecompressedSize = errors.New("max_decompressed_size must be positive")
+
+// Config defines configuration for the HTTP decompression extension.
+type Config struct {
+	// MaxDecompressedSize is the maximum size in bytes of a request body once decompressed.
+	// It applies to uncompressed request bodies as well.
+	MaxDecompressedSize int64 `mapstructure:"max_decompressed_size"`
+
+	// Encodings lists the accepted values of the Content-Encoding header.
+	// Requests with any other encoding are rejected with 415 Unsupported Media Type.
+	Encodings []string `mapstructure:"encodings"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.MaxDecompressedSize <= 0 {
+		return errInvalidMaxDecompressedSize
+	}
+	for _, encoding := range cfg.Encodings {
+		if _, ok := decoders[encoding]; !ok {
+			return fmt.Errorf("unsupported encoding %q", encoding)
+		}
+	}
+	return nil
+}
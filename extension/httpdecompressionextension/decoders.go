@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpdecompressionextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	encodingGzip    = "gzip"
+	encodingDeflate = "deflate"
+	encodingZstd    = "zstd"
+	encodingSnappy  = "snappy"
+)
+
+var errBodyTooLarge = errors.New("decompressed request body too large")
+
+// decoder returns a reader of the decompressed content of body.
+// maxSize is a hint of the maximum size of the decompressed content.
+type decoder func(body io.Reader, maxSize int64) (io.ReadCloser, error)
+
+var decoders = map[string]decoder{
+	encodingGzip:    decodeGzip,
+	encodingDeflate: decodeDeflate,
+	encodingZstd:    decodeZstd,
+	encodingSnappy:  decodeSnappy,
+}
+
+func decodeGzip(body io.Reader, _ int64) (io.ReadCloser, error) {
+	return gzip.NewReader(body)
+}
+
+// decodeDeflate decodes the "deflate" HTTP content encoding, which is zlib-wrapped.
+func decodeDeflate(body io.Reader, _ int64) (io.ReadCloser, error) {
+	return zlib.NewReader(body)
+}
+
+func decodeZstd(body io.Reader, maxSize int64) (io.ReadCloser, error) {
+	dec, err := zstd.NewReader(body,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(uint64(maxSize)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return dec.IOReadCloser(), nil
+}
+
+// decodeSnappy decodes the snappy block format, e.g. used by Prometheus remote write.
+// The size of the decompressed content is known upfront, so oversized bodies are rejected
+// before being decompressed.
+func decodeSnappy(body io.Reader, maxSize int64) (io.ReadCloser, error) {
+	compressed, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(compressed)) > maxSize {
+		return nil, errBodyTooLarge
+	}
+	size, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snappy content: %w", err)
+	}
+	if int64(size) > maxSize {
+		return nil, errBodyTooLarge
+	}
+	decompressed, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snappy content: %w", err)
+	}
+	return io.NopCloser(bytes.NewReader(decompressed)), nil
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package httpdecompressionextension implements an extension decompressing the
+// request bodies of HTTP receivers, and limiting their decompressed size.
+package httpdecompressionextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpdecompressionextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.uber.org/multierr"
+)
+
+// HTTPServerMiddleware is an extension wrapping the handlers of HTTP servers.
+type HTTPServerMiddleware interface {
+	extension.Extension
+
+	// GetHTTPHandler wraps next with the middleware.
+	GetHTTPHandler(next http.Handler) (http.Handler, error)
+}
+
+// WrapHandler wraps next with the HTTPServerMiddleware extension identified by id.
+// Receivers call it when starting their HTTP server to let users opt into the extension.
+func WrapHandler(host component.Host, id component.ID, next http.Handler) (http.Handler, error) {
+	ext, ok := host.GetExtensions()[id]
+	if !ok {
+		return nil, fmt.Errorf("extension %q not found", id)
+	}
+	middleware, ok := ext.(HTTPServerMiddleware)
+	if !ok {
+		return nil, fmt.Errorf("extension %q is not an HTTP server middleware", id)
+	}
+	return middleware.GetHTTPHandler(next)
+}
+
+// WrapServer wraps the handler of an HTTP server, e.g. built with the confighttp server settings,
+// with the HTTPServerMiddleware extension identified by id. It does nothing when id is nil.
+// The middleware runs before the handlers added by confighttp, so that the request bodies are
+// decompressed before confighttp checks their encoding.
+func WrapServer(host component.Host, id *component.ID, server *http.Server) error {
+	if id == nil {
+		return nil
+	}
+	handler, err := WrapHandler(host, *id, server.Handler)
+	if err != nil {
+		return err
+	}
+	server.Handler = handler
+	return nil
+}
+
+type decompressionExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	maxSize  int64
+	decoders map[string]decoder
+}
+
+var _ HTTPServerMiddleware = (*decompressionExtension)(nil)
+
+func newDecompressionExtension(cfg *Config) *decompressionExtension {
+	ext := &decompressionExtension{
+		maxSize:  cfg.MaxDecompressedSize,
+		decoders: make(map[string]decoder, len(cfg.Encodings)),
+	}
+	for _, encoding := range cfg.Encodings {
+		ext.decoders[encoding] = decoders[encoding]
+	}
+	return ext
+}
+
+func (e *decompressionExtension) GetHTTPHandler(next http.Handler) (http.Handler, error) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+		if encoding == "x-gzip" {
+			encoding = encodingGzip
+		}
+
+		body := r.Body
+		if encoding != "" && encoding != "identity" {
+			decode, ok := e.decoders[encoding]
+			if !ok {
+				http.Error(w, fmt.Sprintf("unsupported Content-Encoding %q", encoding), http.StatusUnsupportedMediaType)
+				return
+			}
+			decompressed, err := decode(r.Body, e.maxSize)
+			if err != nil {
+				statusCode := http.StatusBadRequest
+				if errors.Is(err, errBodyTooLarge) {
+					statusCode = http.StatusRequestEntityTooLarge
+				}
+				http.Error(w, err.Error(), statusCode)
+				return
+			}
+			body = &decompressedBody{ReadCloser: decompressed, compressed: r.Body}
+			r.Header.Del("Content-Encoding")
+			r.Header.Del("Content-Length")
+			r.ContentLength = -1
+		}
+
+		r.Body = http.MaxBytesReader(w, body, e.maxSize)
+		next.ServeHTTP(w, r)
+	}), nil
+}
+
+// decompressedBody closes the compressed request body along with its decoder.
+type decompressedBody struct {
+	io.ReadCloser
+	compressed io.Closer
+}
+
+func (b *decompressedBody) Close() error {
+	return multierr.Combine(b.ReadCloser.Close(), b.compressed.Close())
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpdecompressionextension
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension"
+)
+
+func compress(t *testing.T, encoding string, data []byte) []byte {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case encodingGzip:
+		w = gzip.NewWriter(&buf)
+	case encodingDeflate:
+		w = zlib.NewWriter(&buf)
+	case encodingZstd:
+		var err error
+		w, err = zstd.NewWriter(&buf)
+		require.NoError(t, err)
+	case encodingSnappy:
+		return snappy.Encode(nil, data)
+	default:
+		return data
+	}
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// echoHandler responds with the request body it reads.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	_, _ = w.Write(body)
+})
+
+func TestGetHTTPHandler(t *testing.T) {
+	payload := []byte(strings.Repeat("telemetry ", 100))
+
+	tests := []struct {
+		name       string
+		encoding   string
+		maxSize    int64
+		body       []byte
+		statusCode int
+		expectEcho bool
+	}{
+		{name: "identity", encoding: "", statusCode: http.StatusOK, expectEcho: true},
+		{name: "gzip", encoding: encodingGzip, statusCode: http.StatusOK, expectEcho: true},
+		{name: "x-gzip", encoding: "x-gzip", statusCode: http.StatusOK, expectEcho: true},
+		{name: "deflate", encoding: encodingDeflate, statusCode: http.StatusOK, expectEcho: true},
+		{name: "zstd", encoding: encodingZstd, statusCode: http.StatusOK, expectEcho: true},
+		{name: "snappy", encoding: encodingSnappy, statusCode: http.StatusOK, expectEcho: true},
+		{name: "unsupported", encoding: "br", statusCode: http.StatusUnsupportedMediaType},
+		{name: "invalid gzip", encoding: encodingGzip, body: []byte("not gzip"), statusCode: http.StatusBadRequest},
+		{name: "invalid snappy", encoding: encodingSnappy, body: []byte{0xff}, statusCode: http.StatusBadRequest},
+		{name: "gzip too large", encoding: encodingGzip, maxSize: 100, statusCode: http.StatusBadRequest},
+		{name: "snappy too large", encoding: encodingSnappy, maxSize: 100, statusCode: http.StatusRequestEntityTooLarge},
+		{name: "identity too large", encoding: "", maxSize: 100, statusCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			if tt.maxSize > 0 {
+				cfg.MaxDecompressedSize = tt.maxSize
+			}
+			handler, err := newDecompressionExtension(cfg).GetHTTPHandler(echoHandler)
+			require.NoError(t, err)
+
+			body := tt.body
+			if body == nil {
+				encoding := tt.encoding
+				if encoding == "x-gzip" {
+					encoding = encodingGzip
+				}
+				body = compress(t, encoding, payload)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+			if tt.encoding != "" {
+				req.Header.Set("Content-Encoding", tt.encoding)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.statusCode, w.Code)
+			if tt.expectEcho {
+				assert.Equal(t, payload, w.Body.Bytes())
+				assert.Empty(t, req.Header.Get("Content-Encoding"))
+			}
+		})
+	}
+}
+
+func TestDisabledEncoding(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Encodings = []string{encodingGzip}
+	handler, err := newDecompressionExtension(cfg).GetHTTPHandler(echoHandler)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(compress(t, encodingZstd, []byte("data"))))
+	req.Header.Set("Content-Encoding", encodingZstd)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestWrapHandler(t *testing.T) {
+	id := component.NewID("httpdecompression")
+	nopID := component.NewID("nop")
+	host := extensionsHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{
+			id:    newDecompressionExtension(createDefaultConfig().(*Config)),
+			nopID: extension.Extension(nil),
+		},
+	}
+
+	handler, err := WrapHandler(host, id, echoHandler)
+	require.NoError(t, err)
+	assert.NotNil(t, handler)
+
+	_, err = WrapHandler(host, component.NewIDWithName("httpdecompression", "missing"), echoHandler)
+	assert.EqualError(t, err, `extension "httpdecompression/missing" not found`)
+
+	_, err = WrapHandler(host, nopID, echoHandler)
+	assert.EqualError(t, err, `extension "nop" is not an HTTP server middleware`)
+}
+
+func TestWrapServer(t *testing.T) {
+	id := component.NewID("httpdecompression")
+	host := extensionsHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{
+			id: newDecompressionExtension(createDefaultConfig().(*Config)),
+		},
+	}
+	// like confighttp, the handler of the server rejects the encodings it doesn't know
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "" {
+			http.Error(w, "unsupported Content-Encoding", http.StatusBadRequest)
+			return
+		}
+		echoHandler.ServeHTTP(w, r)
+	})}
+
+	send := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(compress(t, encodingSnappy, []byte("payload"))))
+		req.Header.Set("Content-Encoding", encodingSnappy)
+		rec := httptest.NewRecorder()
+		server.Handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.NoError(t, WrapServer(host, nil, server))
+	assert.Equal(t, http.StatusBadRequest, send().Code)
+
+	require.NoError(t, WrapServer(host, &id, server))
+	rec := send()
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "payload", rec.Body.String())
+
+	missing := component.NewIDWithName("httpdecompression", "missing")
+	assert.EqualError(t, WrapServer(host, &missing, server), `extension "httpdecompression/missing" not found`)
+}
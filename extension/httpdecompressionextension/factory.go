@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpdecompressionextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension/internal/metadata"
+)
+
+// defaultMaxDecompressedSize is the default maximum size of a decompressed request body, 64 MiB.
+const defaultMaxDecompressedSize = 64 << 20
+
+// NewFactory creates a factory for the HTTP decompression extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		MaxDecompressedSize: defaultMaxDecompressedSize,
+		Encodings:           []string{encodingGzip, encodingDeflate, encodingZstd, encodingSnappy},
+	}
+}
+
+func createExtension(_ context.Context, _ extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newDecompressionExtension(cfg.(*Config)), nil
+}
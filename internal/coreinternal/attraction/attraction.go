@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/featuregate"
@@ -24,7 +25,7 @@ var enableSha256Gate = featuregate.GlobalRegistry().MustRegister(
 // Settings specifies the processor settings.
 type Settings struct {
 	// Actions specifies the list of attributes to act on.
-	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, CONVERT}.
+	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, CONVERT, MAP}.
 	// This is a required field.
 	Actions []ActionKeyValue `mapstructure:"actions"`
 }
@@ -64,6 +65,14 @@ type ActionKeyValue struct {
 	// If the value cannot be converted, the original value will be left as-is
 	ConvertedType string `mapstructure:"converted_type"`
 
+	// MappingFile specifies the file of the attribute keys to rename and of the values
+	// to replace for the action MAP, in YAML or, with the .csv extension, in CSV.
+	MappingFile string `mapstructure:"mapping_file"`
+
+	// ReloadInterval specifies how often the MappingFile is checked for changes, to
+	// reload it when it is modified. Defaults to 1 minute.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+
 	// Action specifies the type of action to perform.
 	// The set of values are {INSERT, UPDATE, UPSERT, DELETE, HASH}.
 	// Both lower case and upper case are supported.
@@ -88,6 +97,9 @@ type ActionKeyValue struct {
 	//           'key' to target keys specified in the 'rule'. If a target key
 	//           already exists, it will be overridden.
 	// CONVERT  - converts the type of an existing attribute, if convertable
+	// MAP     - Renames the attributes and replaces their values according to
+	//           the table of the mapping file. The file is reloaded when it is
+	//           modified.
 	// This is a required field.
 	Action Action `mapstructure:"action"`
 }
@@ -142,6 +154,10 @@ const (
 
 	// CONVERT converts the type of an existing attribute, if convertable
 	CONVERT Action = "convert"
+
+	// MAP renames the attributes and replaces their values according to the table
+	// of a mapping file.
+	MAP Action = "map"
 )
 
 type attributeAction struct {
@@ -161,6 +177,8 @@ type attributeAction struct {
 	// and could impact performance.
 	Action         Action
 	AttributeValue *pcommon.Value
+	// Mapping of the MAP action.
+	Mapping *mappingFile
 }
 
 // AttrProc is an attribute processor.
@@ -183,6 +201,11 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 			if a.Key == "" && a.RegexPattern == "" {
 				return nil, fmt.Errorf("error creating AttrProc due to missing required field (at least one of \"key\" and \"pattern\" have to be used) at the %d-th actions", i)
 			}
+		case MAP:
+			// requires `mapping_file` only
+			if a.MappingFile == "" {
+				return nil, fmt.Errorf("error creating AttrProc due to missing required field \"mapping_file\" for action \"%s\" at the %d-th action", a.Action, i)
+			}
 		default:
 			// `key` is a required field
 			if a.Key == "" {
@@ -197,6 +220,10 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 
 		valueSourceCount := a.valueSourceCount()
 
+		if a.Action != MAP && (a.MappingFile != "" || a.ReloadInterval != 0) {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"mapping_file\" or \"reload_interval\" fields. These must not be specified for %d-th action", a.Action, i)
+		}
+
 		switch a.Action {
 		case INSERT, UPDATE, UPSERT:
 			if valueSourceCount == 0 {
@@ -279,6 +306,18 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 				return nil, fmt.Errorf("error creating AttrProc due to invalid value \"%s\" in field \"converted_type\" for action \"%s\" at the %d-th action", a.ConvertedType, a.Action, i)
 			}
 			action.ConvertedType = a.ConvertedType
+		case MAP:
+			if a.Key != "" || valueSourceCount > 0 || a.RegexPattern != "" || a.ConvertedType != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" only uses the \"mapping_file\" and \"reload_interval\" fields. Other fields must not be specified for %d-th action", a.Action, i)
+			}
+			if a.ReloadInterval < 0 {
+				return nil, fmt.Errorf("error creating AttrProc due to negative \"reload_interval\" for action \"%s\" at the %d-th action", a.Action, i)
+			}
+			mapping, err := newMappingFile(a.MappingFile, a.ReloadInterval)
+			if err != nil {
+				return nil, fmt.Errorf("error creating AttrProc. Field \"mapping_file\" could not be loaded at the %d-th actions: %w", i, err)
+			}
+			action.Mapping = mapping
 		default:
 			return nil, fmt.Errorf("error creating AttrProc due to unsupported action %q at the %d-th actions", a.Action, i)
 		}
@@ -342,6 +381,8 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 			extractAttributes(action, attrs)
 		case CONVERT:
 			convertAttribute(logger, action, attrs)
+		case MAP:
+			action.Mapping.get(logger).apply(attrs)
 		}
 	}
 }
@@ -891,6 +891,27 @@ func TestInvalidConfig(t *testing.T) {
 			},
 			errorString: "error creating AttrProc. Field \"pattern\" contains at least one unnamed matcher group at the 0-th actions",
 		},
+		{
+			name: "map without mapping file",
+			actionLists: []ActionKeyValue{
+				{Action: MAP},
+			},
+			errorString: "error creating AttrProc due to missing required field \"mapping_file\" for action \"map\" at the 0-th action",
+		},
+		{
+			name: "map with key",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", MappingFile: "mapping.yaml", Action: MAP},
+			},
+			errorString: "error creating AttrProc. Action \"map\" only uses the \"mapping_file\" and \"reload_interval\" fields. Other fields must not be specified for 0-th action",
+		},
+		{
+			name: "mapping file with another action",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", MappingFile: "mapping.yaml", Action: DELETE},
+			},
+			errorString: "error creating AttrProc. Action \"delete\" does not use the \"mapping_file\" or \"reload_interval\" fields. These must not be specified for 0-th action",
+		},
 	}
 
 	for _, tc := range testcase {
@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attraction // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultMappingReloadInterval is how often the mapping file is checked for changes when
+// no interval is configured.
+const defaultMappingReloadInterval = time.Minute
+
+// attributeMapping is the table of a mapping file: the attribute keys to rename, and the
+// string values to replace for the given keys.
+type attributeMapping struct {
+	Rename map[string]string            `yaml:"rename"`
+	Values map[string]map[string]string `yaml:"values"`
+}
+
+type renamedValue struct {
+	key   string
+	value pcommon.Value
+}
+
+// mappingFile holds the mapping of a file, reloaded when the file is modified.
+type mappingFile struct {
+	path           string
+	reloadInterval time.Duration
+
+	mapping   atomic.Pointer[attributeMapping]
+	modTime   time.Time
+	lastCheck atomic.Int64
+	mu        sync.Mutex
+}
+
+func newMappingFile(path string, reloadInterval time.Duration) (*mappingFile, error) {
+	if reloadInterval == 0 {
+		reloadInterval = defaultMappingReloadInterval
+	}
+	f := &mappingFile{path: path, reloadInterval: reloadInterval}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	m, err := loadMapping(path)
+	if err != nil {
+		return nil, err
+	}
+	f.mapping.Store(m)
+	f.modTime = info.ModTime()
+	f.lastCheck.Store(time.Now().UnixNano())
+	return f, nil
+}
+
+// get returns the mapping, reloading the file first if it was modified since it was
+// last loaded. The previous mapping is kept when the file cannot be loaded.
+func (f *mappingFile) get(logger *zap.Logger) *attributeMapping {
+	now := time.Now().UnixNano()
+	last := f.lastCheck.Load()
+	if now-last < int64(f.reloadInterval) || !f.lastCheck.CompareAndSwap(last, now) {
+		return f.mapping.Load()
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, err := os.Stat(f.path)
+	if err != nil {
+		logger.Warn("Failed to check the attributes mapping file", zap.String("path", f.path), zap.Error(err))
+		return f.mapping.Load()
+	}
+	if info.ModTime().Equal(f.modTime) {
+		return f.mapping.Load()
+	}
+	m, err := loadMapping(f.path)
+	if err != nil {
+		logger.Warn("Failed to reload the attributes mapping file", zap.String("path", f.path), zap.Error(err))
+		return f.mapping.Load()
+	}
+	f.mapping.Store(m)
+	f.modTime = info.ModTime()
+	return m
+}
+
+// loadMapping reads a mapping file, in YAML or, with the .csv extension, in CSV.
+func loadMapping(path string) (*attributeMapping, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	m := &attributeMapping{}
+	if filepath.Ext(path) == ".csv" {
+		err = readCSVMapping(file, m)
+	} else {
+		err = yaml.NewDecoder(file).Decode(m)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the mapping file %q: %w", path, err)
+	}
+	return m, nil
+}
+
+// readCSVMapping reads the rows of a CSV mapping file: a row of two fields renames the
+// key of the first field to the second one, a row of three fields replaces the value
+// of the second field of the key of the first field with the third one.
+func readCSVMapping(r io.Reader, m *attributeMapping) error {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch len(record) {
+		case 2:
+			if m.Rename == nil {
+				m.Rename = map[string]string{}
+			}
+			m.Rename[record[0]] = record[1]
+		case 3:
+			if m.Values == nil {
+				m.Values = map[string]map[string]string{}
+			}
+			if m.Values[record[0]] == nil {
+				m.Values[record[0]] = map[string]string{}
+			}
+			m.Values[record[0]][record[1]] = record[2]
+		default:
+			line, _ := reader.FieldPos(0)
+			return fmt.Errorf("line %d: expected 2 or 3 fields, got %d", line, len(record))
+		}
+	}
+}
+
+// apply renames the attributes, overwriting the attributes with the new keys, then
+// replaces the values of the attributes with the new keys.
+func (m *attributeMapping) apply(attrs pcommon.Map) {
+	// The values are all removed before they are set with their new keys, so that the
+	// renames do not depend on each other.
+	var renamed []renamedValue
+	for from, to := range m.Rename {
+		value, found := attrs.Get(from)
+		if !found || from == to {
+			continue
+		}
+		r := renamedValue{key: to, value: pcommon.NewValueEmpty()}
+		value.CopyTo(r.value)
+		renamed = append(renamed, r)
+		attrs.Remove(from)
+	}
+	for _, r := range renamed {
+		r.value.CopyTo(attrs.PutEmpty(r.key))
+	}
+	for key, values := range m.Values {
+		value, found := attrs.Get(key)
+		if !found || value.Type() != pcommon.ValueTypeStr {
+			continue
+		}
+		if newValue, ok := values[value.Str()]; ok {
+			value.SetStr(newValue)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attraction
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+func writeMappingFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestAttributes_Map(t *testing.T) {
+	yamlFile := writeMappingFile(t, "mapping.yaml", `
+rename:
+  http.method: http.request.method
+  http.status_code: http.response.status_code
+  net.peer.name: server.address
+values:
+  http.request.method:
+    get: GET
+    post: POST
+`)
+	csvFile := writeMappingFile(t, "mapping.csv", `# Renames
+http.method,http.request.method
+http.status_code,http.response.status_code
+net.peer.name,server.address
+# Values
+http.request.method,get,GET
+http.request.method,post,POST
+`)
+
+	testCases := []testCase{
+		{
+			name: "RenameAndMapValues",
+			inputAttributes: map[string]interface{}{
+				"http.method":      "get",
+				"http.status_code": 200,
+				"other":            "value",
+			},
+			expectedAttributes: map[string]interface{}{
+				"http.request.method":       "GET",
+				"http.response.status_code": int64(200),
+				"other":                     "value",
+			},
+		},
+		{
+			name: "RenameOverwritesExistingKey",
+			inputAttributes: map[string]interface{}{
+				"net.peer.name":  "example.com",
+				"server.address": "old",
+			},
+			expectedAttributes: map[string]interface{}{
+				"server.address": "example.com",
+			},
+		},
+		{
+			name: "UnmappedValue",
+			inputAttributes: map[string]interface{}{
+				"http.request.method": "PUT",
+			},
+			expectedAttributes: map[string]interface{}{
+				"http.request.method": "PUT",
+			},
+		},
+		{
+			name:               "NoAttributes",
+			inputAttributes:    map[string]interface{}{},
+			expectedAttributes: map[string]interface{}{},
+		},
+	}
+
+	for _, file := range []string{yamlFile, csvFile} {
+		ap, err := NewAttrProc(&Settings{Actions: []ActionKeyValue{{MappingFile: file, Action: MAP}}})
+		require.NoError(t, err)
+		require.NotNil(t, ap)
+		for _, tt := range testCases {
+			tt.name = filepath.Ext(file) + "/" + tt.name
+			runIndividualTestCase(t, tt, ap)
+		}
+	}
+}
+
+func TestAttributes_MapReload(t *testing.T) {
+	path := writeMappingFile(t, "mapping.yaml", "rename: {a: b}")
+	ap, err := NewAttrProc(&Settings{Actions: []ActionKeyValue{{MappingFile: path, ReloadInterval: time.Nanosecond, Action: MAP}}})
+	require.NoError(t, err)
+
+	process := func() map[string]interface{} {
+		attrs := pcommon.NewMap()
+		attrs.PutStr("a", "value")
+		ap.Process(context.Background(), zap.NewNop(), attrs)
+		return attrs.AsRaw()
+	}
+	assert.Equal(t, map[string]interface{}{"b": "value"}, process())
+
+	require.NoError(t, os.WriteFile(path, []byte("rename: {a: c}"), 0600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	assert.Equal(t, map[string]interface{}{"c": "value"}, process())
+
+	// The previous mapping is kept when the file cannot be loaded
+	require.NoError(t, os.WriteFile(path, []byte("rename: [a"), 0600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	assert.Equal(t, map[string]interface{}{"c": "value"}, process())
+}
+
+func TestAttributes_MapInvalidFile(t *testing.T) {
+	_, err := NewAttrProc(&Settings{Actions: []ActionKeyValue{{MappingFile: filepath.Join(t.TempDir(), "missing.yaml"), Action: MAP}}})
+	assert.ErrorContains(t, err, "error creating AttrProc. Field \"mapping_file\" could not be loaded at the 0-th actions")
+
+	path := writeMappingFile(t, "mapping.csv", "a,b\na,b,c,d\n")
+	_, err = NewAttrProc(&Settings{Actions: []ActionKeyValue{{MappingFile: path, Action: MAP}}})
+	assert.ErrorContains(t, err, "line 2: expected 2 or 3 fields, got 4")
+
+	path = writeMappingFile(t, "mapping.yaml", "rename: [a, b]")
+	_, err = NewAttrProc(&Settings{Actions: []ActionKeyValue{{MappingFile: path, Action: MAP}}})
+	assert.ErrorContains(t, err, "failed to read the mapping file")
+
+	_, err = NewAttrProc(&Settings{Actions: []ActionKeyValue{{MappingFile: path, ReloadInterval: -time.Second, Action: MAP}}})
+	assert.EqualError(t, err, "error creating AttrProc due to negative \"reload_interval\" for action \"map\" at the 0-th action")
+}
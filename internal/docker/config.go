@@ -19,6 +19,14 @@ type Config struct {
 	// A list of filters whose matching images are to be excluded. Supports literals, globs, and regex.
 	ExcludedImages []string `mapstructure:"excluded_images"`
 
+	// A mapping of container label names to the filters their values must match for the
+	// containers to be included. Supports literals, globs, and regex.
+	IncludedLabels map[string]string `mapstructure:"included_labels"`
+
+	// A mapping of container label names to filters whose matching containers are to be
+	// excluded. Supports literals, globs, and regex.
+	ExcludedLabels map[string]string `mapstructure:"excluded_labels"`
+
 	// Docker client API version.
 	DockerAPIVersion float64 `mapstructure:"api_version"`
 }
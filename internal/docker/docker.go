@@ -42,6 +42,8 @@ type Client struct {
 	containers           map[string]Container
 	containersLock       sync.Mutex
 	excludedImageMatcher *stringMatcher
+	includedLabels       labelMatcher
+	excludedLabels       labelMatcher
 	logger               *zap.Logger
 }
 
@@ -62,6 +64,16 @@ func NewDockerClient(config *Config, logger *zap.Logger, opts ...docker.Opt) (*C
 		return nil, fmt.Errorf("could not determine docker client excluded images: %w", err)
 	}
 
+	includedLabels, err := newLabelMatcher(config.IncludedLabels)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine docker client included labels: %w", err)
+	}
+
+	excludedLabels, err := newLabelMatcher(config.ExcludedLabels)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine docker client excluded labels: %w", err)
+	}
+
 	dc := &Client{
 		client:               client,
 		config:               config,
@@ -69,6 +81,8 @@ func NewDockerClient(config *Config, logger *zap.Logger, opts ...docker.Opt) (*C
 		containers:           make(map[string]Container),
 		containersLock:       sync.Mutex{},
 		excludedImageMatcher: excludedImageMatcher,
+		includedLabels:       includedLabels,
+		excludedLabels:       excludedLabels,
 	}
 
 	return dc, nil
@@ -107,11 +121,11 @@ func (dc *Client) LoadContainerList(ctx context.Context) error {
 	for _, c := range containerList {
 		wg.Add(1)
 		go func(container dtypes.Container) {
-			if !dc.shouldBeExcluded(container.Image) {
+			if !dc.ShouldBeExcluded(container.Image, container.Labels) {
 				dc.InspectAndPersistContainer(ctx, container.ID)
 			} else {
 				dc.logger.Debug(
-					"Not monitoring container per ExcludedImages",
+					"Not monitoring container per ExcludedImages or labels",
 					zap.String("image", container.Image),
 					zap.String("id", container.ID),
 				)
@@ -206,6 +220,7 @@ func (dc *Client) ContainerEventLoop(ctx context.Context) {
 		{Key: "type", Value: "container"},
 		{Key: "event", Value: "destroy"},
 		{Key: "event", Value: "die"},
+		{Key: "event", Value: "health_status"},
 		{Key: "event", Value: "pause"},
 		{Key: "event", Value: "rename"},
 		{Key: "event", Value: "stop"},
@@ -289,7 +304,7 @@ func (dc *Client) inspectedContainerIsOfInterest(ctx context.Context, cid string
 			zap.String("id", cid),
 			zap.Error(err),
 		)
-	} else if !dc.shouldBeExcluded(container.Config.Image) {
+	} else if !dc.ShouldBeExcluded(container.Config.Image, container.Config.Labels) {
 		return &container, true
 	}
 	return nil, false
@@ -323,8 +338,15 @@ func (dc *Client) RemoveContainer(cid string) {
 	dc.logger.Debug("Removed container from stores.", zap.String("id", cid))
 }
 
-func (dc *Client) shouldBeExcluded(image string) bool {
-	return dc.excludedImageMatcher != nil && dc.excludedImageMatcher.matches(image)
+// ShouldBeExcluded returns whether a container is excluded by its image or by its labels.
+func (dc *Client) ShouldBeExcluded(image string, labels map[string]string) bool {
+	if dc.excludedImageMatcher != nil && dc.excludedImageMatcher.matches(image) {
+		return true
+	}
+	if !dc.includedLabels.matchesAll(labels) {
+		return true
+	}
+	return dc.excludedLabels.matchesAny(labels)
 }
 
 func ContainerEnvToMap(env []string) map[string]string {
@@ -48,6 +48,40 @@ func TestInvalidExclude(t *testing.T) {
 	assert.Equal(t, "could not determine docker client excluded images: invalid glob item: unexpected end of input", err.Error())
 }
 
+func TestInvalidLabels(t *testing.T) {
+	config := NewDefaultConfig()
+	config.IncludedLabels = map[string]string{"team": "/[/"}
+	cli, err := NewDockerClient(config, zap.NewNop())
+	assert.Nil(t, cli)
+	require.ErrorContains(t, err, "could not determine docker client included labels: invalid filter for label \"team\"")
+
+	config = NewDefaultConfig()
+	config.ExcludedLabels = map[string]string{"team": "["}
+	cli, err = NewDockerClient(config, zap.NewNop())
+	assert.Nil(t, cli)
+	require.ErrorContains(t, err, "could not determine docker client excluded labels: invalid filter for label \"team\"")
+}
+
+func TestShouldBeExcluded(t *testing.T) {
+	config := NewDefaultConfig()
+	config.ExcludedImages = []string{"redis"}
+	config.IncludedLabels = map[string]string{"monitoring": "enabled"}
+	config.ExcludedLabels = map[string]string{"team": "sandbox-*"}
+	cli, err := NewDockerClient(config, zap.NewNop())
+	require.NoError(t, err)
+
+	assert.False(t, cli.ShouldBeExcluded("nginx", map[string]string{"monitoring": "enabled", "team": "web"}))
+	assert.True(t, cli.ShouldBeExcluded("redis", map[string]string{"monitoring": "enabled"}))
+	assert.True(t, cli.ShouldBeExcluded("nginx", map[string]string{"monitoring": "disabled"}))
+	assert.True(t, cli.ShouldBeExcluded("nginx", nil))
+	assert.True(t, cli.ShouldBeExcluded("nginx", map[string]string{"monitoring": "enabled", "team": "sandbox-1"}))
+
+	// all the containers are included by default
+	cli, err = NewDockerClient(NewDefaultConfig(), zap.NewNop())
+	require.NoError(t, err)
+	assert.False(t, cli.ShouldBeExcluded("nginx", nil))
+}
+
 func tmpSock(t *testing.T) (net.Listener, string) {
 	f, err := os.CreateTemp(os.TempDir(), "testsock")
 	if err != nil {
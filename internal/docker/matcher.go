@@ -121,3 +121,39 @@ func (f *stringMatcher) matches(s string) bool {
 
 	return false
 }
+
+// labelMatcher matches the labels of containers against the filters of their values.
+type labelMatcher map[string]*stringMatcher
+
+func newLabelMatcher(filters map[string]string) (labelMatcher, error) {
+	m := make(labelMatcher, len(filters))
+	for label, filter := range filters {
+		matcher, err := newStringMatcher([]string{filter})
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter for label %q: %w", label, err)
+		}
+		m[label] = matcher
+	}
+	return m, nil
+}
+
+// matchesAll returns whether the labels have all the labels of the matcher with matching values.
+func (m labelMatcher) matchesAll(labels map[string]string) bool {
+	for label, matcher := range m {
+		value, ok := labels[label]
+		if !ok || !matcher.matches(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAny returns whether the labels have any of the labels of the matcher with a matching value.
+func (m labelMatcher) matchesAny(labels map[string]string) bool {
+	for label, matcher := range m {
+		if value, ok := labels[label]; ok && matcher.matches(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -120,6 +120,8 @@ func (p *Parser[K]) compareInt64(a int64, b any, op compareOp) bool {
 		return comparePrimitives(a, v, op)
 	case float64:
 		return comparePrimitives(float64(a), v, op)
+	case time.Duration:
+		return comparePrimitives(a, int64(v), op)
 	default:
 		return p.invalidComparison("int to non-numeric value", op)
 	}
@@ -136,6 +138,19 @@ func (p *Parser[K]) compareFloat64(a float64, b any, op compareOp) bool {
 	}
 }
 
+// compareDuration compares durations with each other, and with int64 values as nanoseconds
+// so that they can be compared with the difference of two timestamps.
+func (p *Parser[K]) compareDuration(a time.Duration, b any, op compareOp) bool {
+	switch v := b.(type) {
+	case time.Duration:
+		return comparePrimitives(a, v, op)
+	case int64:
+		return comparePrimitives(int64(a), v, op)
+	default:
+		return p.invalidComparison("duration to non-duration value", op)
+	}
+}
+
 func (p *Parser[K]) compareTime(a time.Time, b any, op compareOp) bool {
 	switch v := b.(type) {
 	case time.Time:
@@ -189,6 +204,8 @@ func (p *Parser[K]) compare(a any, b any, op compareOp) bool {
 		return p.compareByte(v, b, op)
 	case time.Time:
 		return p.compareTime(v, b, op)
+	case time.Duration:
+		return p.compareDuration(v, b, op)
 	default:
 		// If we don't know what type it is, we can't do inequalities yet. So we can fall back to the old behavior where we just
 		// use Go's standard equality.
@@ -6,6 +6,7 @@ package ottl
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"go.opentelemetry.io/collector/component/componenttest"
 )
@@ -24,6 +25,8 @@ var (
 	i64b = int64(2)
 	f64a = float64(1)
 	f64b = float64(2)
+	da   = time.Duration(1)
+	db   = time.Duration(2)
 )
 
 type testA struct {
@@ -94,6 +97,14 @@ func Test_compare(t *testing.T) {
 		{"float64 bytes", f64a, ba, []bool{false, true, false, false, false, false}},
 		{"float64 nil", f64a, nil, []bool{false, true, false, false, false, false}},
 		{"float64 int64", f64a, i64b, []bool{false, true, true, true, false, false}},
+		{"float64 duration", f64a, db, []bool{false, true, false, false, false, false}},
+
+		{"diff durations", da, db, []bool{false, true, true, true, false, false}},
+		{"duration int64", db, i64a, []bool{false, true, false, false, true, true}},
+		{"int64 duration", i64a, da, []bool{true, false, false, true, true, false}},
+		{"duration float64", da, f64a, []bool{false, true, false, false, false, false}},
+		{"duration string", da, sa, []bool{false, true, false, false, false, false}},
+		{"duration nil", da, nil, []bool{false, true, false, false, false, false}},
 
 		{"non-prim, same type, equal", testA{"hi"}, testA{"hi"}, []bool{true, false, false, false, false, false}},
 		{"non-prim, same type, not equal", testA{"hi"}, testA{"byte"}, []bool{false, true, false, false, false, false}},
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ExtractGroupsToMapArguments[K any] struct {
+	Target  ottl.StringGetter[K] `ottlarg:"0"`
+	Pattern string               `ottlarg:"1"`
+}
+
+func NewExtractGroupsToMapFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ExtractGroupsToMap", &ExtractGroupsToMapArguments[K]{}, createExtractGroupsToMapFunction[K])
+}
+
+func createExtractGroupsToMapFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ExtractGroupsToMapArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ExtractGroupsToMapFactory args must be of type *ExtractGroupsToMapArguments[K]")
+	}
+
+	return extractGroupsToMap(args.Target, args.Pattern)
+}
+
+func extractGroupsToMap[K any](target ottl.StringGetter[K], pattern string) (ottl.ExprFunc[K], error) {
+	compiledPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to ExtractGroupsToMap is not a valid regexp pattern: %w", err)
+	}
+	names := compiledPattern.SubexpNames()
+	hasNamedGroup := false
+	for _, name := range names {
+		if name != "" {
+			hasNamedGroup = true
+			break
+		}
+	}
+	if !hasNamedGroup {
+		return nil, errors.New("the pattern supplied to ExtractGroupsToMap must contain at least one named capture group")
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		result := pcommon.NewMap()
+		matches := compiledPattern.FindStringSubmatchIndex(val)
+		if matches == nil {
+			return result, nil
+		}
+		for i, name := range names {
+			// Unnamed groups and optional groups which did not match are skipped
+			if name == "" || matches[2*i] < 0 {
+				continue
+			}
+			result.PutStr(name, val[matches[2*i]:matches[2*i+1]])
+		}
+		return result, nil
+	}, nil
+}
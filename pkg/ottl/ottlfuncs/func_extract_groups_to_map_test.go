@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_extractGroupsToMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		pattern  string
+		expected map[string]interface{}
+	}{
+		{
+			name:    "named groups",
+			target:  `10.0.0.1 - GET /api/users 200`,
+			pattern: `^(?P<client>\S+) - (?P<method>\S+) (?P<path>\S+) (\d+)$`,
+			expected: map[string]interface{}{
+				"client": "10.0.0.1",
+				"method": "GET",
+				"path":   "/api/users",
+			},
+		},
+		{
+			name:    "optional group not matched",
+			target:  `user=alice`,
+			pattern: `user=(?P<user>\w+)( role=(?P<role>\w+))?`,
+			expected: map[string]interface{}{
+				"user": "alice",
+			},
+		},
+		{
+			name:     "no match",
+			target:   `nothing to see`,
+			pattern:  `user=(?P<user>\w+)`,
+			expected: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardStringGetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := extractGroupsToMap[interface{}](target, tt.pattern)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+		})
+	}
+}
+
+func Test_extractGroupsToMap_bad_pattern(t *testing.T) {
+	target := ottl.StandardStringGetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "value", nil
+		},
+	}
+	_, err := extractGroupsToMap[interface{}](target, `(?P<user>\w+`)
+	assert.ErrorContains(t, err, "the pattern supplied to ExtractGroupsToMap is not a valid regexp pattern")
+
+	_, err = extractGroupsToMap[interface{}](target, `user=(\w+)`)
+	assert.EqualError(t, err, "the pattern supplied to ExtractGroupsToMap must contain at least one named capture group")
+}
+
+func Test_extractGroupsToMap_bad_input(t *testing.T) {
+	target := ottl.StandardStringGetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return 1, nil
+		},
+	}
+	exprFunc, err := extractGroupsToMap[interface{}](target, `(?P<value>\w+)`)
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseCSVArguments[K any] struct {
+	Target    ottl.StringGetter[K] `ottlarg:"0"`
+	Header    string               `ottlarg:"1"`
+	Delimiter string               `ottlarg:"2"`
+}
+
+func NewParseCSVFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseCSV", &ParseCSVArguments[K]{}, createParseCSVFunction[K])
+}
+
+func createParseCSVFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseCSVArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ParseCSVFactory args must be of type *ParseCSVArguments[K]")
+	}
+
+	return parseCSV(args.Target, args.Header, args.Delimiter)
+}
+
+// parseCSV returns a `pcommon.Map` of the fields of the target CSV row keyed by the
+// names of the header, both separated by the delimiter.
+func parseCSV[K any](target ottl.StringGetter[K], header string, delimiter string) (ottl.ExprFunc[K], error) {
+	if utf8.RuneCountInString(delimiter) != 1 {
+		return nil, fmt.Errorf("delimiter must be a single character, got %q", delimiter)
+	}
+	comma, _ := utf8.DecodeRuneInString(delimiter)
+	if comma == '"' || comma == '\r' || comma == '\n' || comma == utf8.RuneError {
+		return nil, fmt.Errorf("invalid delimiter %q", delimiter)
+	}
+	names, err := readCSVRow(header, comma)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+	for _, name := range names {
+		if name == "" {
+			return nil, errors.New("invalid header: the names cannot be empty")
+		}
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := readCSVRow(val, comma)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) != len(names) {
+			return nil, fmt.Errorf("wrong number of fields: expected %d, found %d", len(names), len(fields))
+		}
+		result := pcommon.NewMap()
+		result.EnsureCapacity(len(names))
+		for i, name := range names {
+			result.PutStr(name, fields[i])
+		}
+		return result, nil
+	}, nil
+}
+
+// readCSVRow reads the single row of s.
+func readCSVRow(s string, comma rune) ([]string, error) {
+	reader := csv.NewReader(strings.NewReader(s))
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	fields, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CSV row: %w", err)
+	}
+	return fields, nil
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_parseCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		header    string
+		delimiter string
+		expected  map[string]interface{}
+	}{
+		{
+			name:      "comma separated",
+			target:    `2023-07-01,alice,200`,
+			header:    "time,user,status",
+			delimiter: ",",
+			expected: map[string]interface{}{
+				"time":   "2023-07-01",
+				"user":   "alice",
+				"status": "200",
+			},
+		},
+		{
+			name:      "quoted fields",
+			target:    `"GET /a|b"|"say ""hi"""|`,
+			header:    "request|message|empty",
+			delimiter: "|",
+			expected: map[string]interface{}{
+				"request": "GET /a|b",
+				"message": `say "hi"`,
+				"empty":   "",
+			},
+		},
+		{
+			name:      "tab separated",
+			target:    "a\tb",
+			header:    "first\tsecond",
+			delimiter: "\t",
+			expected: map[string]interface{}{
+				"first":  "a",
+				"second": "b",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardStringGetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := parseCSV[interface{}](target, tt.header, tt.delimiter)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+		})
+	}
+}
+
+func Test_parseCSV_bad_arguments(t *testing.T) {
+	target := ottl.StandardStringGetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "a,b", nil
+		},
+	}
+	_, err := parseCSV[interface{}](target, "a,b", "")
+	assert.EqualError(t, err, `delimiter must be a single character, got ""`)
+	_, err = parseCSV[interface{}](target, "a,b", ",,")
+	assert.EqualError(t, err, `delimiter must be a single character, got ",,"`)
+	_, err = parseCSV[interface{}](target, "a,b", `"`)
+	assert.EqualError(t, err, `invalid delimiter "\""`)
+	_, err = parseCSV[interface{}](target, "a,,b", ",")
+	assert.EqualError(t, err, "invalid header: the names cannot be empty")
+}
+
+func Test_parseCSV_bad_input(t *testing.T) {
+	target := ottl.StandardStringGetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "a,b,c", nil
+		},
+	}
+	exprFunc, err := parseCSV[interface{}](target, "first,second", ",")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "wrong number of fields: expected 2, found 3")
+}
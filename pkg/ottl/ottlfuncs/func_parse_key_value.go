@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseKeyValueArguments[K any] struct {
+	Target        ottl.StringGetter[K] `ottlarg:"0"`
+	Delimiter     string               `ottlarg:"1"`
+	PairDelimiter string               `ottlarg:"2"`
+}
+
+func NewParseKeyValueFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseKeyValue", &ParseKeyValueArguments[K]{}, createParseKeyValueFunction[K])
+}
+
+func createParseKeyValueFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseKeyValueArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ParseKeyValueFactory args must be of type *ParseKeyValueArguments[K]")
+	}
+
+	return parseKeyValue(args.Target, args.Delimiter, args.PairDelimiter)
+}
+
+// parseKeyValue returns a `pcommon.Map` of the key-value pairs of the target string, e.g.
+// `k1=v1 k2="v 2"` with the delimiter `=` and the pair delimiter ` `.
+func parseKeyValue[K any](target ottl.StringGetter[K], delimiter string, pairDelimiter string) (ottl.ExprFunc[K], error) {
+	if delimiter == "" {
+		return nil, errors.New("delimiter cannot be empty")
+	}
+	if pairDelimiter == "" {
+		return nil, errors.New("pair delimiter cannot be empty")
+	}
+	if delimiter == pairDelimiter {
+		return nil, errors.New("delimiter and pair delimiter cannot be the same")
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		result := pcommon.NewMap()
+		for _, pair := range splitOutsideQuotes(val, pairDelimiter) {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+			key, value, found := strings.Cut(pair, delimiter)
+			if !found {
+				return nil, fmt.Errorf("cannot split %q into a key and a value with the delimiter %q", pair, delimiter)
+			}
+			result.PutStr(strings.TrimSpace(key), trimQuotes(strings.TrimSpace(value)))
+		}
+		return result, nil
+	}, nil
+}
+
+// splitOutsideQuotes splits s around the separators which are not between double or single quotes.
+func splitOutsideQuotes(s string, sep string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '"' || s[i] == '\'':
+			quote = s[i]
+		case strings.HasPrefix(s[i:], sep):
+			parts = append(parts, s[start:i])
+			i += len(sep) - 1
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// trimQuotes removes the double or single quotes surrounding s.
+func trimQuotes(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
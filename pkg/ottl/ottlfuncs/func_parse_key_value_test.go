@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_parseKeyValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		delimiter     string
+		pairDelimiter string
+		expected      map[string]interface{}
+	}{
+		{
+			name:          "space separated pairs",
+			target:        `name=alice role=admin`,
+			delimiter:     "=",
+			pairDelimiter: " ",
+			expected: map[string]interface{}{
+				"name": "alice",
+				"role": "admin",
+			},
+		},
+		{
+			name:          "quoted values",
+			target:        `msg="request done" path='/api/a b' empty=""`,
+			delimiter:     "=",
+			pairDelimiter: " ",
+			expected: map[string]interface{}{
+				"msg":   "request done",
+				"path":  "/api/a b",
+				"empty": "",
+			},
+		},
+		{
+			name:          "multi-character delimiters",
+			target:        `a: 1 && b: x=y &&  c:`,
+			delimiter:     ":",
+			pairDelimiter: "&&",
+			expected: map[string]interface{}{
+				"a": "1",
+				"b": "x=y",
+				"c": "",
+			},
+		},
+		{
+			name:          "duplicate key",
+			target:        `k=1,k=2`,
+			delimiter:     "=",
+			pairDelimiter: ",",
+			expected: map[string]interface{}{
+				"k": "2",
+			},
+		},
+		{
+			name:          "empty target",
+			target:        ``,
+			delimiter:     "=",
+			pairDelimiter: " ",
+			expected:      map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardStringGetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := parseKeyValue[interface{}](target, tt.delimiter, tt.pairDelimiter)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+		})
+	}
+}
+
+func Test_parseKeyValue_bad_delimiters(t *testing.T) {
+	target := ottl.StandardStringGetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "k=v", nil
+		},
+	}
+	_, err := parseKeyValue[interface{}](target, "", " ")
+	assert.EqualError(t, err, "delimiter cannot be empty")
+	_, err = parseKeyValue[interface{}](target, "=", "")
+	assert.EqualError(t, err, "pair delimiter cannot be empty")
+	_, err = parseKeyValue[interface{}](target, "=", "=")
+	assert.EqualError(t, err, "delimiter and pair delimiter cannot be the same")
+}
+
+func Test_parseKeyValue_bad_input(t *testing.T) {
+	target := ottl.StandardStringGetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "k=v novalue", nil
+		},
+	}
+	exprFunc, err := parseKeyValue[interface{}](target, "=", " ")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, `cannot split "novalue" into a key and a value with the delimiter "="`)
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseLogfmtArguments[K any] struct {
+	Target ottl.StringGetter[K] `ottlarg:"0"`
+}
+
+func NewParseLogfmtFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseLogfmt", &ParseLogfmtArguments[K]{}, createParseLogfmtFunction[K])
+}
+
+func createParseLogfmtFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseLogfmtArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ParseLogfmtFactory args must be of type *ParseLogfmtArguments[K]")
+	}
+
+	return parseLogfmt(args.Target), nil
+}
+
+// parseLogfmt returns a `pcommon.Map` of the pairs of the target logfmt string, e.g.
+// `level=info msg="request done" took=10ms`. The values are strings, the keys without a
+// value have an empty string value.
+func parseLogfmt[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		result := pcommon.NewMap()
+		i := 0
+		for i < len(val) {
+			if val[i] == ' ' || val[i] == '\t' {
+				i++
+				continue
+			}
+
+			start := i
+			for i < len(val) && val[i] != '=' && val[i] != ' ' && val[i] != '\t' {
+				i++
+			}
+			key := val[start:i]
+			if key == "" {
+				return nil, fmt.Errorf("invalid logfmt: missing key at position %d", start)
+			}
+			if i == len(val) || val[i] != '=' {
+				result.PutStr(key, "")
+				continue
+			}
+			i++
+
+			var value string
+			if i < len(val) && val[i] == '"' {
+				start = i
+				for i++; i < len(val) && val[i] != '"'; i++ {
+					if val[i] == '\\' {
+						i++
+					}
+				}
+				if i >= len(val) {
+					return nil, fmt.Errorf("invalid logfmt: unterminated quoted value of key %q", key)
+				}
+				i++
+				value, err = strconv.Unquote(val[start:i])
+				if err != nil {
+					return nil, fmt.Errorf("invalid logfmt: quoted value of key %q: %w", key, err)
+				}
+			} else {
+				start = i
+				for i < len(val) && val[i] != ' ' && val[i] != '\t' {
+					i++
+				}
+				value = val[start:i]
+			}
+			result.PutStr(key, value)
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_parseLogfmt(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected map[string]interface{}
+	}{
+		{
+			name:   "simple values",
+			target: `level=info took=10ms status=200`,
+			expected: map[string]interface{}{
+				"level":  "info",
+				"took":   "10ms",
+				"status": "200",
+			},
+		},
+		{
+			name:   "quoted values",
+			target: `msg="request \"done\"" path="/a b"  err=""`,
+			expected: map[string]interface{}{
+				"msg":  `request "done"`,
+				"path": "/a b",
+				"err":  "",
+			},
+		},
+		{
+			name:   "keys without value",
+			target: `debug level= msg=hi`,
+			expected: map[string]interface{}{
+				"debug": "",
+				"level": "",
+				"msg":   "hi",
+			},
+		},
+		{
+			name:     "empty target",
+			target:   ``,
+			expected: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardStringGetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			result, err := parseLogfmt[interface{}](target)(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+		})
+	}
+}
+
+func Test_parseLogfmt_bad_input(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{
+			name:     "missing key",
+			target:   `level=info =value`,
+			expected: "invalid logfmt: missing key at position 11",
+		},
+		{
+			name:     "unterminated quote",
+			target:   `msg="request done`,
+			expected: `invalid logfmt: unterminated quoted value of key "msg"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardStringGetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			_, err := parseLogfmt[interface{}](target)(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
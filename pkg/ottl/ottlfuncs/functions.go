@@ -37,13 +37,17 @@ func converters[K any]() []ottl.Factory[K] {
 		NewConcatFactory[K](),
 		NewConvertCaseFactory[K](),
 		NewDurationFactory[K](),
+		NewExtractGroupsToMapFactory[K](),
 		NewFnvFactory[K](),
 		NewIntFactory[K](),
 		NewIsMapFactory[K](),
 		NewIsMatchFactory[K](),
 		NewIsStringFactory[K](),
 		NewLogFactory[K](),
+		NewParseCSVFactory[K](),
 		NewParseJSONFactory[K](),
+		NewParseKeyValueFactory[K](),
+		NewParseLogfmtFactory[K](),
 		NewSHA1Factory[K](),
 		NewSHA256Factory[K](),
 		NewSpanIDFactory[K](),
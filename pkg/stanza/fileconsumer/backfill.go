@@ -0,0 +1,289 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/scanner"
+)
+
+const backfillFilesKey = "backfillFiles"
+
+// BackfillConfig configures a one-time read of rotated and archived files
+// when the consumer starts, so that logs written before startup can be recovered.
+type BackfillConfig struct {
+	Include []string `mapstructure:"include,omitempty"`
+	Exclude []string `mapstructure:"exclude,omitempty"`
+}
+
+func (c BackfillConfig) validate() error {
+	if len(c.Include) == 0 {
+		return fmt.Errorf("required argument `include` is empty")
+	}
+	for _, include := range c.Include {
+		if _, err := doublestar.PathMatch(include, "matchstring"); err != nil {
+			return fmt.Errorf("parse include glob: %w", err)
+		}
+	}
+	for _, exclude := range c.Exclude {
+		if _, err := doublestar.PathMatch(exclude, "matchstring"); err != nil {
+			return fmt.Errorf("parse exclude glob: %w", err)
+		}
+	}
+	return nil
+}
+
+// backfillCheckpoint tracks how much of a rotated file has been read. Files are
+// identified by the fingerprint of their decompressed content, so a file keeps
+// its identity when it is renamed or compressed by the rotation tool.
+type backfillCheckpoint struct {
+	Fingerprint *fingerprint.Fingerprint
+	Offset      int64
+	Done        bool
+}
+
+// backfillFiles reads every file matched by the backfill criteria, oldest first,
+// starting from the last checkpointed offset of each file.
+func (m *Manager) backfillFiles(ctx context.Context) {
+	paths, err := m.backfill.FindFiles()
+	if err != nil {
+		m.Errorw("Failed to find backfill files", zap.Error(err))
+	}
+
+	// Files that are actively tailed are not backfilled
+	live := make(map[string]struct{})
+	current, _ := m.finder.FindFiles()
+	for _, path := range current {
+		live[path] = struct{}{}
+	}
+
+	modTimes := make(map[string]int64, len(paths))
+	candidates := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if _, ok := live[path]; ok {
+			continue
+		}
+		info, statErr := os.Stat(path)
+		if statErr != nil {
+			continue
+		}
+		modTimes[path] = info.ModTime().UnixNano()
+		candidates = append(candidates, path)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return modTimes[candidates[i]] < modTimes[candidates[j]]
+	})
+
+	checkpoints, err := m.loadBackfillCheckpoints(ctx)
+	if err != nil {
+		m.Errorw("Failed to load backfill checkpoints", zap.Error(err))
+	}
+
+	// Only checkpoints of files that are still present are retained
+	retained := make([]*backfillCheckpoint, 0, len(candidates))
+	for _, path := range candidates {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		cp := m.backfillFile(ctx, path, checkpoints)
+		if cp == nil {
+			continue
+		}
+		retained = append(retained, cp)
+		m.syncBackfillCheckpoints(ctx, retained)
+	}
+	m.syncBackfillCheckpoints(ctx, retained)
+}
+
+// backfillFile reads a single rotated file and returns its updated checkpoint
+func (m *Manager) backfillFile(ctx context.Context, path string, checkpoints []*backfillCheckpoint) *backfillCheckpoint {
+	rc, err := openBackfillFile(path)
+	if err != nil {
+		m.Errorw("Failed to open backfill file", "path", path, zap.Error(err))
+		return nil
+	}
+	defer func() {
+		if closeErr := rc.Close(); closeErr != nil {
+			m.Debugw("Problem closing backfill file", "path", path, zap.Error(closeErr))
+		}
+	}()
+
+	buf := make([]byte, m.readerFactory.readerConfig.fingerprintSize)
+	n, err := io.ReadFull(rc, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		m.Errorw("Failed to read backfill file", "path", path, zap.Error(err))
+		return nil
+	}
+	if n == 0 {
+		return nil
+	}
+	fp := &fingerprint.Fingerprint{FirstBytes: buf[:n]}
+
+	cp := m.findBackfillCheckpoint(fp, checkpoints)
+	if cp.Done {
+		return cp
+	}
+
+	content := io.MultiReader(bytes.NewReader(buf[:n]), rc)
+	if _, err = io.CopyN(io.Discard, content, cp.Offset); err != nil {
+		m.Errorw("Failed to seek backfill file", "path", path, zap.Error(err))
+		return cp
+	}
+
+	splitFunc, err := m.readerFactory.splitterFactory.Build(m.readerFactory.readerConfig.maxLogSize)
+	if err != nil {
+		m.Errorw("Failed to build splitter", zap.Error(err))
+		return cp
+	}
+	enc, err := m.readerFactory.encodingConfig.Build()
+	if err != nil {
+		m.Errorw("Failed to build encoding", zap.Error(err))
+		return cp
+	}
+
+	m.Infow("Backfilling rotated file", "path", path, "offset", cp.Offset)
+	attrs := m.backfillAttributes(path)
+	s := scanner.New(content, m.readerFactory.readerConfig.maxLogSize, scanner.DefaultBufferSize, cp.Offset, splitFunc)
+	for {
+		select {
+		case <-ctx.Done():
+			return cp
+		default:
+		}
+
+		if ok := s.Scan(); !ok {
+			if err = s.Error(); err != nil {
+				m.Errorw("Failed during backfill scan", "path", path, zap.Error(err))
+				return cp
+			}
+			cp.Done = true
+			return cp
+		}
+
+		var token []byte
+		token, err = enc.Decode(s.Bytes())
+		if err != nil {
+			m.Errorw("Failed to decode backfill token", "path", path, zap.Error(err))
+		} else if err = m.readerFactory.readerConfig.emit(ctx, token, attrs); err != nil {
+			m.Errorw("Failed to process backfill token", "path", path, zap.Error(err))
+		}
+		cp.Offset = s.Pos()
+	}
+}
+
+// findBackfillCheckpoint returns the checkpoint matching the fingerprint. If the file
+// has never been backfilled but was tailed before it was rotated, reading resumes
+// from the offset reached by the tailing reader.
+func (m *Manager) findBackfillCheckpoint(fp *fingerprint.Fingerprint, checkpoints []*backfillCheckpoint) *backfillCheckpoint {
+	for _, cp := range checkpoints {
+		if fp.StartsWith(cp.Fingerprint) {
+			cp.Fingerprint = fp
+			return cp
+		}
+	}
+	for i := len(m.knownFiles) - 1; i >= 0; i-- {
+		if fp.StartsWith(m.knownFiles[i].Fingerprint) {
+			return &backfillCheckpoint{Fingerprint: fp, Offset: m.knownFiles[i].Offset}
+		}
+	}
+	return &backfillCheckpoint{Fingerprint: fp}
+}
+
+func (m *Manager) backfillAttributes(path string) map[string]any {
+	attrs := make(map[string]any, 2)
+	cfg := m.readerFactory.readerConfig
+	if cfg.includeFileName {
+		attrs[logFileName] = filepath.Base(path)
+	}
+	if cfg.includeFilePath {
+		attrs[logFilePath] = path
+	}
+	if cfg.includeFileNameResolved || cfg.includeFilePathResolved {
+		resolved, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			resolved = path
+		}
+		if abs, err := filepath.Abs(resolved); err == nil {
+			resolved = abs
+		}
+		if cfg.includeFileNameResolved {
+			attrs[logFileNameResolved] = filepath.Base(resolved)
+		}
+		if cfg.includeFilePathResolved {
+			attrs[logFilePathResolved] = resolved
+		}
+	}
+	return attrs
+}
+
+func (m *Manager) syncBackfillCheckpoints(ctx context.Context, checkpoints []*backfillCheckpoint) {
+	encoded, err := json.Marshal(checkpoints)
+	if err != nil {
+		m.Errorw("Failed to encode backfill checkpoints", zap.Error(err))
+		return
+	}
+	if err = m.persister.Set(ctx, backfillFilesKey, encoded); err != nil {
+		m.Errorw("Failed to sync backfill checkpoints to database", zap.Error(err))
+	}
+}
+
+func (m *Manager) loadBackfillCheckpoints(ctx context.Context) ([]*backfillCheckpoint, error) {
+	encoded, err := m.persister.Get(ctx, backfillFilesKey)
+	if err != nil || encoded == nil {
+		return nil, err
+	}
+	var checkpoints []*backfillCheckpoint
+	if err = json.Unmarshal(encoded, &checkpoints); err != nil {
+		return nil, fmt.Errorf("decoding backfill checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
+
+type backfillReader struct {
+	io.Reader
+	file *os.File
+}
+
+func (r *backfillReader) Close() error {
+	return r.file.Close()
+}
+
+// openBackfillFile opens a rotated file, transparently decompressing gzip archives
+func openBackfillFile(path string) (io.ReadCloser, error) {
+	file, err := os.Open(path) // #nosec - operator must read in files defined by user
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(file)
+	magic, err := br.Peek(2)
+	if err != nil || magic[0] != 0x1f || magic[1] != 0x8b {
+		return &backfillReader{Reader: br, file: file}, nil
+	}
+
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("open gzip archive: %w", err)
+	}
+	return &backfillReader{Reader: gz, file: file}, nil
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func writeGzip(t *testing.T, path string, s string) {
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, file.Close()) }()
+
+	gz := gzip.NewWriter(file)
+	_, err = gz.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+}
+
+func TestBackfillRotatedFiles(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig()
+	cfg.Include = []string{filepath.Join(tempDir, "app.log")}
+	cfg.StartAt = "end"
+	cfg.Backfill = &BackfillConfig{
+		Include: []string{filepath.Join(tempDir, "app.log.*")},
+	}
+
+	archive := filepath.Join(tempDir, "app.log.2.gz")
+	writeGzip(t, archive, "archived1\narchived2\n")
+	rotated := filepath.Join(tempDir, "app.log.1")
+	require.NoError(t, os.WriteFile(rotated, []byte("rotated1\n"), 0600))
+	live := filepath.Join(tempDir, "app.log")
+	require.NoError(t, os.WriteFile(live, []byte("live1\n"), 0600))
+
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(archive, past, past))
+
+	persister := testutil.NewMockPersister("test")
+	operator, emitCalls := buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+
+	call := waitForEmit(t, emitCalls)
+	require.Equal(t, []byte("archived1"), call.token)
+	require.Equal(t, "app.log.2.gz", call.attrs[logFileName])
+	waitForToken(t, emitCalls, []byte("archived2"))
+	waitForToken(t, emitCalls, []byte("rotated1"))
+	expectNoTokens(t, emitCalls)
+	require.NoError(t, operator.Stop())
+
+	// Rotated files that have been read are not read again after a restart
+	operator, emitCalls = buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+	expectNoTokens(t, emitCalls)
+	require.NoError(t, operator.Stop())
+}
+
+func TestBackfillResumesFromTailedOffset(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig()
+	cfg.Include = []string{filepath.Join(tempDir, "app.log")}
+	cfg.StartAt = "beginning"
+	cfg.Backfill = &BackfillConfig{
+		Include: []string{filepath.Join(tempDir, "app.log.*.gz")},
+	}
+
+	live := filepath.Join(tempDir, "app.log")
+	require.NoError(t, os.WriteFile(live, []byte("line1\n"), 0600))
+
+	persister := testutil.NewMockPersister("test")
+	operator, emitCalls := buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+	waitForToken(t, emitCalls, []byte("line1"))
+	require.NoError(t, operator.Stop())
+
+	// The file is compressed by the rotation tool while the collector is down,
+	// after having grown further.
+	writeGzip(t, filepath.Join(tempDir, "app.log.1.gz"), "line1\nline2\n")
+	require.NoError(t, os.Remove(live))
+
+	operator, emitCalls = buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+	waitForToken(t, emitCalls, []byte("line2"))
+	expectNoTokens(t, emitCalls)
+	require.NoError(t, operator.Stop())
+}
+
+func TestBackfillConfigValidate(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Include = []string{"/var/log/app.log"}
+	cfg.Backfill = &BackfillConfig{}
+	require.ErrorContains(t, cfg.validate(), "invalid config for `backfill`")
+
+	cfg.Backfill.Include = []string{"/var/log/app.log.*"}
+	require.NoError(t, cfg.validate())
+
+	cfg.Backfill.Exclude = []string{"["}
+	require.ErrorContains(t, cfg.validate(), "parse exclude glob")
+}
@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+)
+
+// CheckpointExportVersion is the version of the checkpoint export format
+const CheckpointExportVersion = 1
+
+// Checkpoint is the read state of a single file.
+type Checkpoint struct {
+	Fingerprint     []byte         `json:"fingerprint"`
+	Offset          int64          `json:"offset"`
+	FileAttributes  map[string]any `json:"file_attributes,omitempty"`
+	HeaderFinalized bool           `json:"header_finalized,omitempty"`
+}
+
+// BackfillCheckpoint is the read state of a single rotated file read by the backfill.
+type BackfillCheckpoint struct {
+	Fingerprint []byte `json:"fingerprint"`
+	Offset      int64  `json:"offset"`
+	Done        bool   `json:"done,omitempty"`
+}
+
+// CheckpointExport is a portable set of file checkpoints, which can be moved
+// from one collector to another so that a new node resumes reading where the
+// previous one stopped.
+type CheckpointExport struct {
+	Version             int                  `json:"version"`
+	Checkpoints         []Checkpoint         `json:"checkpoints"`
+	BackfillCheckpoints []BackfillCheckpoint `json:"backfill_checkpoints,omitempty"`
+	// Checksum is the hex encoded SHA-256 of the JSON encoded checkpoints,
+	// followed by the JSON encoded backfill checkpoints when there are some
+	Checksum string `json:"checksum"`
+}
+
+// checkpointRecord mirrors the fields of a Reader which are persisted by the Manager
+type checkpointRecord struct {
+	Fingerprint     *fingerprint.Fingerprint
+	Offset          int64
+	FileAttributes  map[string]any
+	HeaderFinalized bool
+}
+
+// ExportCheckpoints reads the file checkpoints stored by a Manager. The persister must
+// be scoped in the same way as the one passed to Manager.Start, e.g. for a file_input
+// operator with the default ID, operator.NewScopedPersister("file_input", storageClient).
+func ExportCheckpoints(ctx context.Context, persister operator.Persister) (*CheckpointExport, error) {
+	encoded, err := persister.Get(ctx, knownFilesKey)
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoints: %w", err)
+	}
+
+	checkpoints := []Checkpoint{}
+	if encoded != nil {
+		dec := json.NewDecoder(bytes.NewReader(encoded))
+
+		var count int
+		if err = dec.Decode(&count); err != nil {
+			return nil, fmt.Errorf("decoding file count: %w", err)
+		}
+
+		for i := 0; i < count; i++ {
+			var record checkpointRecord
+			if err = dec.Decode(&record); err != nil {
+				return nil, fmt.Errorf("decoding checkpoint %d: %w", i, err)
+			}
+			cp := Checkpoint{
+				Offset:          record.Offset,
+				FileAttributes:  record.FileAttributes,
+				HeaderFinalized: record.HeaderFinalized,
+			}
+			if record.Fingerprint != nil {
+				cp.Fingerprint = record.Fingerprint.FirstBytes
+			}
+			checkpoints = append(checkpoints, cp)
+		}
+	}
+
+	backfillCheckpoints, err := exportBackfillCheckpoints(ctx, persister)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := checkpointsChecksum(checkpoints, backfillCheckpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CheckpointExport{
+		Version:             CheckpointExportVersion,
+		Checkpoints:         checkpoints,
+		BackfillCheckpoints: backfillCheckpoints,
+		Checksum:            checksum,
+	}, nil
+}
+
+func exportBackfillCheckpoints(ctx context.Context, persister operator.Persister) ([]BackfillCheckpoint, error) {
+	encoded, err := persister.Get(ctx, backfillFilesKey)
+	if err != nil {
+		return nil, fmt.Errorf("read backfill checkpoints: %w", err)
+	}
+	if encoded == nil {
+		return nil, nil
+	}
+
+	var records []*backfillCheckpoint
+	if err = json.Unmarshal(encoded, &records); err != nil {
+		return nil, fmt.Errorf("decoding backfill checkpoints: %w", err)
+	}
+
+	checkpoints := make([]BackfillCheckpoint, 0, len(records))
+	for _, record := range records {
+		cp := BackfillCheckpoint{
+			Offset: record.Offset,
+			Done:   record.Done,
+		}
+		if record.Fingerprint != nil {
+			cp.Fingerprint = record.Fingerprint.FirstBytes
+		}
+		checkpoints = append(checkpoints, cp)
+	}
+	return checkpoints, nil
+}
+
+// ImportCheckpoints validates the export and replaces the file and backfill checkpoints
+// stored with the persister. It must be called before the Manager using the persister is started.
+func ImportCheckpoints(ctx context.Context, persister operator.Persister, export *CheckpointExport) error {
+	if err := export.Validate(); err != nil {
+		return fmt.Errorf("invalid checkpoint export: %w", err)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	if err := enc.Encode(len(export.Checkpoints)); err != nil {
+		return fmt.Errorf("encoding file count: %w", err)
+	}
+	for _, cp := range export.Checkpoints {
+		record := checkpointRecord{
+			Fingerprint:     &fingerprint.Fingerprint{FirstBytes: cp.Fingerprint},
+			Offset:          cp.Offset,
+			FileAttributes:  cp.FileAttributes,
+			HeaderFinalized: cp.HeaderFinalized,
+		}
+		if record.FileAttributes == nil {
+			record.FileAttributes = map[string]any{}
+		}
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("encoding checkpoint: %w", err)
+		}
+	}
+
+	if err := persister.Set(ctx, knownFilesKey, buf.Bytes()); err != nil {
+		return fmt.Errorf("write checkpoints: %w", err)
+	}
+	return importBackfillCheckpoints(ctx, persister, export.BackfillCheckpoints)
+}
+
+func importBackfillCheckpoints(ctx context.Context, persister operator.Persister, checkpoints []BackfillCheckpoint) error {
+	if len(checkpoints) == 0 {
+		if err := persister.Delete(ctx, backfillFilesKey); err != nil {
+			return fmt.Errorf("delete backfill checkpoints: %w", err)
+		}
+		return nil
+	}
+
+	records := make([]*backfillCheckpoint, 0, len(checkpoints))
+	for _, cp := range checkpoints {
+		records = append(records, &backfillCheckpoint{
+			Fingerprint: &fingerprint.Fingerprint{FirstBytes: cp.Fingerprint},
+			Offset:      cp.Offset,
+			Done:        cp.Done,
+		})
+	}
+	encoded, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("encoding backfill checkpoints: %w", err)
+	}
+	if err = persister.Set(ctx, backfillFilesKey, encoded); err != nil {
+		return fmt.Errorf("write backfill checkpoints: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the integrity of an export before it is imported
+func (e *CheckpointExport) Validate() error {
+	if e == nil {
+		return errors.New("export is nil")
+	}
+	if e.Version != CheckpointExportVersion {
+		return fmt.Errorf("unsupported version %d, expected %d", e.Version, CheckpointExportVersion)
+	}
+
+	checksum, err := checkpointsChecksum(e.Checkpoints, e.BackfillCheckpoints)
+	if err != nil {
+		return err
+	}
+	if checksum != e.Checksum {
+		return errors.New("checksum mismatch, the checkpoints have been modified or corrupted")
+	}
+
+	for i, cp := range e.Checkpoints {
+		if len(cp.Fingerprint) == 0 {
+			return fmt.Errorf("checkpoint %d: fingerprint is empty", i)
+		}
+		if cp.Offset < 0 {
+			return fmt.Errorf("checkpoint %d: offset must not be negative", i)
+		}
+	}
+	for i, cp := range e.BackfillCheckpoints {
+		if len(cp.Fingerprint) == 0 {
+			return fmt.Errorf("backfill checkpoint %d: fingerprint is empty", i)
+		}
+		if cp.Offset < 0 {
+			return fmt.Errorf("backfill checkpoint %d: offset must not be negative", i)
+		}
+	}
+	return nil
+}
+
+func checkpointsChecksum(checkpoints []Checkpoint, backfillCheckpoints []BackfillCheckpoint) (string, error) {
+	encoded, err := json.Marshal(checkpoints)
+	if err != nil {
+		return "", fmt.Errorf("encoding checkpoints: %w", err)
+	}
+	h := sha256.New()
+	h.Write(encoded)
+	if len(backfillCheckpoints) > 0 {
+		if encoded, err = json.Marshal(backfillCheckpoints); err != nil {
+			return "", fmt.Errorf("encoding backfill checkpoints: %w", err)
+		}
+		h.Write(encoded)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
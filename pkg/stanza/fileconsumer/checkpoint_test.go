@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestCheckpointExportImport(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	oldNode := testutil.NewMockPersister("test")
+	operator, emitCalls := buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(oldNode))
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	require.NoError(t, operator.Stop())
+
+	export, err := ExportCheckpoints(context.Background(), oldNode)
+	require.NoError(t, err)
+	require.Len(t, export.Checkpoints, 1)
+	require.Equal(t, int64(len("testlog1\n")), export.Checkpoints[0].Offset)
+	require.NoError(t, export.Validate())
+
+	// The export survives a round trip through its serialized form
+	encoded, err := json.Marshal(export)
+	require.NoError(t, err)
+	var decoded CheckpointExport
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+
+	newNode := testutil.NewMockPersister("test")
+	require.NoError(t, ImportCheckpoints(context.Background(), newNode, &decoded))
+
+	writeString(t, temp, "testlog2\n")
+	operator, emitCalls = buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(newNode))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+	waitForToken(t, emitCalls, []byte("testlog2"))
+	expectNoTokens(t, emitCalls)
+}
+
+func TestCheckpointExportImportBackfill(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig()
+	cfg.Include = []string{filepath.Join(tempDir, "app.log")}
+	cfg.StartAt = "end"
+	cfg.Backfill = &BackfillConfig{
+		Include: []string{filepath.Join(tempDir, "app.log.*")},
+	}
+
+	writeGzip(t, filepath.Join(tempDir, "app.log.2.gz"), "archived1\n")
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.log.1"), []byte("rotated1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.log"), []byte("live1\n"), 0600))
+
+	oldNode := testutil.NewMockPersister("test")
+	operator, emitCalls := buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(oldNode))
+	waitForToken(t, emitCalls, []byte("archived1"))
+	waitForToken(t, emitCalls, []byte("rotated1"))
+	expectNoTokens(t, emitCalls)
+	require.NoError(t, operator.Stop())
+
+	export, err := ExportCheckpoints(context.Background(), oldNode)
+	require.NoError(t, err)
+	require.Len(t, export.BackfillCheckpoints, 2)
+	for _, cp := range export.BackfillCheckpoints {
+		require.True(t, cp.Done)
+	}
+	require.NoError(t, export.Validate())
+
+	encoded, err := json.Marshal(export)
+	require.NoError(t, err)
+	var decoded CheckpointExport
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+
+	// The backfill checkpoints are covered by the checksum
+	tampered := decoded
+	tampered.BackfillCheckpoints = append([]BackfillCheckpoint{}, decoded.BackfillCheckpoints...)
+	tampered.BackfillCheckpoints[0].Done = false
+	require.ErrorContains(t, tampered.Validate(), "checksum mismatch")
+
+	// The rotated files read by the old node are not read again by the new one
+	newNode := testutil.NewMockPersister("test")
+	require.NoError(t, ImportCheckpoints(context.Background(), newNode, &decoded))
+
+	operator, emitCalls = buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(newNode))
+	expectNoTokens(t, emitCalls)
+	require.NoError(t, operator.Stop())
+
+	reexport, err := ExportCheckpoints(context.Background(), newNode)
+	require.NoError(t, err)
+	require.Equal(t, export.BackfillCheckpoints, reexport.BackfillCheckpoints)
+}
+
+func TestCheckpointExportEmpty(t *testing.T) {
+	export, err := ExportCheckpoints(context.Background(), testutil.NewMockPersister("test"))
+	require.NoError(t, err)
+	require.Empty(t, export.Checkpoints)
+	require.NoError(t, export.Validate())
+}
+
+func TestCheckpointExportValidate(t *testing.T) {
+	newExport := func(checkpoints ...Checkpoint) *CheckpointExport {
+		checksum, err := checkpointsChecksum(checkpoints, nil)
+		require.NoError(t, err)
+		return &CheckpointExport{
+			Version:     CheckpointExportVersion,
+			Checkpoints: checkpoints,
+			Checksum:    checksum,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		export *CheckpointExport
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			export: newExport(Checkpoint{Fingerprint: []byte("first line of a file"), Offset: 10}),
+		},
+		{
+			name:   "nil",
+			errMsg: "export is nil",
+		},
+		{
+			name: "unsupported version",
+			export: func() *CheckpointExport {
+				e := newExport()
+				e.Version = 2
+				return e
+			}(),
+			errMsg: "unsupported version 2",
+		},
+		{
+			name: "tampered",
+			export: func() *CheckpointExport {
+				e := newExport(Checkpoint{Fingerprint: []byte("first line of a file"), Offset: 10})
+				e.Checkpoints[0].Offset = 100
+				return e
+			}(),
+			errMsg: "checksum mismatch",
+		},
+		{
+			name:   "empty fingerprint",
+			export: newExport(Checkpoint{Offset: 10}),
+			errMsg: "checkpoint 0: fingerprint is empty",
+		},
+		{
+			name:   "negative offset",
+			export: newExport(Checkpoint{Fingerprint: []byte("first line of a file"), Offset: -1}),
+			errMsg: "checkpoint 0: offset must not be negative",
+		},
+		{
+			name: "empty backfill fingerprint",
+			export: func() *CheckpointExport {
+				e := newExport()
+				e.BackfillCheckpoints = []BackfillCheckpoint{{Offset: 10}}
+				e.Checksum, _ = checkpointsChecksum(e.Checkpoints, e.BackfillCheckpoints)
+				return e
+			}(),
+			errMsg: "backfill checkpoint 0: fingerprint is empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.export.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errMsg)
+			require.ErrorContains(t, ImportCheckpoints(context.Background(), testutil.NewMockPersister("test"), tc.export), tc.errMsg)
+		})
+	}
+}
@@ -26,7 +26,7 @@ const (
 var allowFileDeletion = featuregate.GlobalRegistry().MustRegister(
 	"filelog.allowFileDeletion",
 	featuregate.StageAlpha,
-	featuregate.WithRegisterDescription("When enabled, allows usage of the `delete_after_read` setting."),
+	featuregate.WithRegisterDescription("When enabled, allows usage of the `delete_after_read` and `move_after_read` settings."),
 	featuregate.WithRegisterReferenceURL("https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/16314"),
 )
 
@@ -68,8 +68,11 @@ type Config struct {
 	MaxConcurrentFiles      int                   `mapstructure:"max_concurrent_files,omitempty"`
 	MaxBatches              int                   `mapstructure:"max_batches,omitempty"`
 	DeleteAfterRead         bool                  `mapstructure:"delete_after_read,omitempty"`
+	MoveAfterRead           string                `mapstructure:"move_after_read,omitempty"`
+	AfterReadIdle           time.Duration         `mapstructure:"after_read_idle,omitempty"`
 	Splitter                helper.SplitterConfig `mapstructure:",squash,omitempty"`
 	Header                  *HeaderConfig         `mapstructure:"header,omitempty"`
+	Backfill                *BackfillConfig       `mapstructure:"backfill,omitempty"`
 }
 
 // Build will build a file input operator from the supplied configuration
@@ -133,6 +136,14 @@ func (c Config) buildManager(logger *zap.SugaredLogger, emit emit.Callback, fact
 		}
 	}
 
+	var backfill *MatchingCriteria
+	if c.Backfill != nil {
+		backfill = &MatchingCriteria{
+			Include: c.Backfill.Include,
+			Exclude: c.Backfill.Exclude,
+		}
+	}
+
 	return &Manager{
 		SugaredLogger: logger.With("component", "fileconsumer"),
 		cancel:        func() {},
@@ -153,11 +164,14 @@ func (c Config) buildManager(logger *zap.SugaredLogger, emit emit.Callback, fact
 			headerSettings:  hs,
 		},
 		finder:          c.MatchingCriteria,
+		backfill:        backfill,
 		roller:          newRoller(),
 		pollInterval:    c.PollInterval,
 		maxBatchFiles:   c.MaxConcurrentFiles / 2,
 		maxBatches:      c.MaxBatches,
 		deleteAfterRead: c.DeleteAfterRead,
+		moveAfterRead:   c.MoveAfterRead,
+		afterReadIdle:   c.AfterReadIdle,
 		knownFiles:      make([]*Reader, 0, 10),
 		seenPaths:       make(map[string]struct{}, 100),
 	}, nil
@@ -168,6 +182,10 @@ func (c Config) validate() error {
 		return fmt.Errorf("`delete_after_read` requires feature gate `%s`", allowFileDeletion.ID())
 	}
 
+	if c.MoveAfterRead != "" && !allowFileDeletion.IsEnabled() {
+		return fmt.Errorf("`move_after_read` requires feature gate `%s`", allowFileDeletion.ID())
+	}
+
 	if c.Header != nil && !AllowHeaderMetadataParsing.IsEnabled() {
 		return fmt.Errorf("`header` requires feature gate `%s`", AllowHeaderMetadataParsing.ID())
 	}
@@ -218,6 +236,22 @@ func (c Config) validate() error {
 		return fmt.Errorf("`delete_after_read` cannot be used with `start_at: end`")
 	}
 
+	if c.MoveAfterRead != "" && c.StartAt == "end" {
+		return fmt.Errorf("`move_after_read` cannot be used with `start_at: end`")
+	}
+
+	if c.DeleteAfterRead && c.MoveAfterRead != "" {
+		return fmt.Errorf("`delete_after_read` and `move_after_read` cannot be used together")
+	}
+
+	if c.AfterReadIdle < 0 {
+		return fmt.Errorf("`after_read_idle` must not be negative")
+	}
+
+	if c.AfterReadIdle > 0 && !c.DeleteAfterRead && c.MoveAfterRead == "" {
+		return fmt.Errorf("`after_read_idle` requires `delete_after_read` or `move_after_read`")
+	}
+
 	if c.Header != nil && c.StartAt == "end" {
 		return fmt.Errorf("`header` cannot be specified with `start_at: end`")
 	}
@@ -237,5 +271,11 @@ func (c Config) validate() error {
 		}
 	}
 
+	if c.Backfill != nil {
+		if err := c.Backfill.validate(); err != nil {
+			return fmt.Errorf("invalid config for `backfill`: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -390,6 +390,16 @@ func TestUnmarshal(t *testing.T) {
 					return newMockOperatorConfig(cfg)
 				}(),
 			},
+			{
+				Name: "move_after_read",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.StartAt = "beginning"
+					cfg.MoveAfterRead = "/var/log/done"
+					cfg.AfterReadIdle = 5 * time.Second
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
 			{
 				Name: "header_config",
 				Expect: func() *mockOperatorConfig {
@@ -546,6 +556,41 @@ func TestBuild(t *testing.T) {
 			require.Error,
 			nil,
 		},
+		{
+			"InvalidStartAtMove",
+			func(f *Config) {
+				f.StartAt = "end"
+				f.MoveAfterRead = "/var/log/done"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidDeleteAndMove",
+			func(f *Config) {
+				f.StartAt = "beginning"
+				f.DeleteAfterRead = true
+				f.MoveAfterRead = "/var/log/done"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidAfterReadIdle",
+			func(f *Config) {
+				f.AfterReadIdle = -time.Second
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"AfterReadIdleWithoutAction",
+			func(f *Config) {
+				f.AfterReadIdle = time.Second
+			},
+			require.Error,
+			nil,
+		},
 		{
 			"InvalidMaxBatches",
 			func(f *Config) {
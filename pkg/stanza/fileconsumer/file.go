@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -35,6 +36,7 @@ type Manager struct {
 
 	readerFactory readerFactory
 	finder        Finder
+	backfill      *MatchingCriteria
 	roller        roller
 	persister     operator.Persister
 
@@ -42,6 +44,8 @@ type Manager struct {
 	maxBatches      int
 	maxBatchFiles   int
 	deleteAfterRead bool
+	moveAfterRead   string
+	afterReadIdle   time.Duration
 
 	knownFiles []*Reader
 	seenPaths  map[string]struct{}
@@ -92,6 +96,12 @@ func (m *Manager) startPoller(ctx context.Context) {
 	m.wg.Add(1)
 	go func() {
 		defer m.wg.Done()
+
+		// Rotated files are read before any new entries of the tailed files
+		if m.backfill != nil {
+			m.backfillFiles(ctx)
+		}
+
 		globTicker := time.NewTicker(m.pollInterval)
 		defer globTicker.Stop()
 
@@ -161,22 +171,20 @@ func (m *Manager) consume(ctx context.Context, paths []string) {
 		go func(r *Reader) {
 			defer wg.Done()
 			r.ReadToEnd(ctx)
-			// Delete a file if deleteAfterRead is enabled and we reached the end of the file
-			if m.deleteAfterRead && r.eof {
+			// Delete or move a file if deleteAfterRead or moveAfterRead is enabled and the file was read
+			if m.afterRead() && m.isFinished(r) {
 				r.Close()
-				if err := os.Remove(r.file.Name()); err != nil {
-					m.Errorf("could not delete %s", r.file.Name())
-				}
+				r.finished = m.finish(r.file.Name())
 			}
 		}(reader)
 	}
 	wg.Wait()
 
 	// Save off any files that were not fully read
-	if m.deleteAfterRead {
+	if m.afterRead() {
 		unfinished := make([]*Reader, 0, len(readers))
 		for _, r := range readers {
-			if !r.eof {
+			if !r.finished {
 				unfinished = append(unfinished, r)
 			}
 		}
@@ -197,6 +205,44 @@ func (m *Manager) consume(ctx context.Context, paths []string) {
 	m.clearCurrentFingerprints()
 }
 
+func (m *Manager) afterRead() bool {
+	return m.deleteAfterRead || m.moveAfterRead != ""
+}
+
+// isFinished returns whether the file was read to the end and, if afterReadIdle
+// is set, was not modified for afterReadIdle, so that files which are still
+// being written are not deleted or moved.
+func (m *Manager) isFinished(r *Reader) bool {
+	if !r.eof {
+		return false
+	}
+	if m.afterReadIdle == 0 {
+		return true
+	}
+	info, err := r.file.Stat()
+	if err != nil {
+		return false
+	}
+	return time.Since(info.ModTime()) >= m.afterReadIdle
+}
+
+// finish deletes the file or moves it to the moveAfterRead directory. It
+// returns false when the file was left in place, so that its offset is kept.
+func (m *Manager) finish(path string) bool {
+	if m.deleteAfterRead {
+		if err := os.Remove(path); err != nil {
+			m.Errorf("could not delete %s", path)
+			return false
+		}
+		return true
+	}
+	if err := os.Rename(path, filepath.Join(m.moveAfterRead, filepath.Base(path))); err != nil {
+		m.Errorw("could not move file", "path", path, "directory", m.moveAfterRead, zap.Error(err))
+		return false
+	}
+	return true
+}
+
 func (m *Manager) makeFingerprint(path string) (*fingerprint.Fingerprint, *os.File) {
 	if _, ok := m.seenPaths[path]; !ok {
 		if m.readerFactory.fromBeginning {
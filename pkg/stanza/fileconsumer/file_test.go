@@ -1354,6 +1354,76 @@ func TestDeleteAfterRead(t *testing.T) {
 	}
 }
 
+func TestMoveAfterRead(t *testing.T) {
+	require.NoError(t, featuregate.GlobalRegistry().Set(allowFileDeletion.ID(), true))
+	defer func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(allowFileDeletion.ID(), false))
+	}()
+
+	tempDir := t.TempDir()
+	doneDir := t.TempDir()
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\ntestlog2\n")
+	require.NoError(t, temp.Close())
+
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.MoveAfterRead = doneDir
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	waitForToken(t, emitCalls, []byte("testlog2"))
+
+	_, err := os.Stat(temp.Name())
+	require.True(t, os.IsNotExist(err))
+	moved, err := os.ReadFile(filepath.Join(doneDir, filepath.Base(temp.Name())))
+	require.NoError(t, err)
+	require.Equal(t, "testlog1\ntestlog2\n", string(moved))
+	require.Empty(t, operator.knownFiles)
+}
+
+func TestDeleteAfterReadIdle(t *testing.T) {
+	require.NoError(t, featuregate.GlobalRegistry().Set(allowFileDeletion.ID(), true))
+	defer func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(allowFileDeletion.ID(), false))
+	}()
+
+	tempDir := t.TempDir()
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.DeleteAfterRead = true
+	cfg.AfterReadIdle = time.Hour
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+
+	// The file is tailed while it is written
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	_, err := os.Stat(temp.Name())
+	require.NoError(t, err)
+
+	writeString(t, temp, "testlog2\n")
+	require.NoError(t, temp.Close())
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog2"))
+	_, err = os.Stat(temp.Name())
+	require.NoError(t, err)
+
+	// The file is deleted once it was not modified for after_read_idle
+	modTime := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(temp.Name(), modTime, modTime))
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+	_, err = os.Stat(temp.Name())
+	require.True(t, os.IsNotExist(err))
+	require.Empty(t, operator.knownFiles)
+}
+
 func TestMaxBatching(t *testing.T) {
 	t.Parallel()
 
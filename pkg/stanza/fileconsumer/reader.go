@@ -46,6 +46,8 @@ type Reader struct {
 	file           *os.File
 	FileAttributes map[string]any
 	eof            bool
+	// finished is set when the file was deleted or moved after it was read
+	finished bool
 
 	HeaderFinalized bool
 	recreateScanner bool
@@ -31,6 +31,9 @@ import (
 const operatorType = "journald_input"
 const waitDuration = 1 * time.Second
 
+// priorityRegex matches a single priority or a range of priorities, given by name or number
+var priorityRegex = regexp.MustCompile(`^(emerg|alert|crit|err|warning|notice|info|debug|[0-7])(\.\.(emerg|alert|crit|err|warning|notice|info|debug|[0-7]))?$`)
+
 func init() {
 	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
 }
@@ -53,13 +56,14 @@ func NewConfigWithID(operatorID string) *Config {
 type Config struct {
 	helper.InputConfig `mapstructure:",squash"`
 
-	Directory *string       `mapstructure:"directory,omitempty"`
-	Files     []string      `mapstructure:"files,omitempty"`
-	StartAt   string        `mapstructure:"start_at,omitempty"`
-	Units     []string      `mapstructure:"units,omitempty"`
-	Priority  string        `mapstructure:"priority,omitempty"`
-	Matches   []MatchConfig `mapstructure:"matches,omitempty"`
-	Grep      string        `mapstructure:"grep,omitempty"`
+	Directory   *string       `mapstructure:"directory,omitempty"`
+	Files       []string      `mapstructure:"files,omitempty"`
+	StartAt     string        `mapstructure:"start_at,omitempty"`
+	Units       []string      `mapstructure:"units,omitempty"`
+	Identifiers []string      `mapstructure:"identifiers,omitempty"`
+	Priority    string        `mapstructure:"priority,omitempty"`
+	Matches     []MatchConfig `mapstructure:"matches,omitempty"`
+	Grep        string        `mapstructure:"grep,omitempty"`
 }
 
 type MatchConfig map[string]string
@@ -79,10 +83,13 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	return &Input{
 		InputOperator: inputOperator,
 		newCmd: func(ctx context.Context, cursor []byte) cmd {
+			// Copy the arguments, so that the cursor of a previous run is not retained
+			cmdArgs := make([]string, len(args), len(args)+2)
+			copy(cmdArgs, args)
 			if cursor != nil {
-				args = append(args, "--after-cursor", string(cursor))
+				cmdArgs = append(cmdArgs, "--after-cursor", string(cursor))
 			}
-			return exec.CommandContext(ctx, "journalctl", args...) // #nosec - ...
+			return exec.CommandContext(ctx, "journalctl", cmdArgs...) // #nosec - ...
 			// journalctl is an executable that is required for this operator to function
 		},
 		json: jsoniter.ConfigFastest,
@@ -113,6 +120,13 @@ func (c Config) buildArgs() ([]string, error) {
 		args = append(args, "--unit", unit)
 	}
 
+	for _, identifier := range c.Identifiers {
+		args = append(args, "--identifier", identifier)
+	}
+
+	if !priorityRegex.MatchString(c.Priority) {
+		return nil, fmt.Errorf("invalid value '%s' for parameter 'priority'", c.Priority)
+	}
 	args = append(args, "--priority", c.Priority)
 
 	if len(c.Grep) > 0 {
@@ -303,10 +317,12 @@ func (operator *Input) Start(persister operator.Persister) error {
 				operator.Warnw("Failed to parse journal entry", zap.Error(err))
 				continue
 			}
+			operator.Write(ctx, entry)
+			// The cursor is persisted once the entry has been written, so that reading
+			// resumes right after the last entry which was emitted
 			if err := operator.persister.Set(ctx, lastReadCursorKey, []byte(cursor)); err != nil {
 				operator.Warnw("Failed to set offset", zap.Error(err))
 			}
-			operator.Write(ctx, entry)
 		}
 	}()
 
@@ -123,6 +123,59 @@ func TestInputJournald(t *testing.T) {
 	}
 }
 
+func TestInputJournaldCursor(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.OutputIDs = []string{"output"}
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.NewMockOperator("output")
+	received := make(chan *entry.Entry, 1)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		received <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+	require.NoError(t, op.SetOutputs([]operator.Operator{mockOutput}))
+
+	cursors := make(chan []byte, 2)
+	op.(*Input).newCmd = func(ctx context.Context, cursor []byte) cmd {
+		cursors <- cursor
+		return &fakeJournaldCmd{}
+	}
+
+	persister := testutil.NewMockPersister("test")
+	assert.EqualError(t, op.Start(persister), "journalctl command exited")
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry to be read")
+	}
+	require.NoError(t, op.Stop())
+	require.Nil(t, <-cursors)
+
+	// After a restart, reading resumes after the last emitted entry
+	assert.EqualError(t, op.Start(persister), "journalctl command exited")
+	defer func() {
+		require.NoError(t, op.Stop())
+	}()
+	expected := "s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36"
+	require.Equal(t, []byte(expected), <-cursors)
+}
+
+func TestNewCmdCursor(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	newCmd := op.(*Input).newCmd
+	first := newCmd(context.Background(), []byte("first")).(*exec.Cmd)
+	second := newCmd(context.Background(), []byte("second")).(*exec.Cmd)
+
+	assert.Equal(t, []string{"--after-cursor", "first"}, first.Args[len(first.Args)-2:])
+	assert.Equal(t, []string{"--after-cursor", "second"}, second.Args[len(second.Args)-2:])
+	assert.NotContains(t, second.Args, "first")
+}
+
 func TestBuildConfig(t *testing.T) {
 	testCases := []struct {
 		Name          string
@@ -190,6 +243,28 @@ func TestBuildConfig(t *testing.T) {
 			},
 			Expected: []string{"--utc", "--output=json", "--follow", "--priority", "info", "--grep", "test_grep"},
 		},
+		{
+			Name: "identifiers",
+			Config: func(cfg *Config) {
+				cfg.Units = []string{"ssh"}
+				cfg.Identifiers = []string{"sshd", "sudo"}
+			},
+			Expected: []string{"--utc", "--output=json", "--follow", "--unit", "ssh", "--identifier", "sshd", "--identifier", "sudo", "--priority", "info"},
+		},
+		{
+			Name: "priority range",
+			Config: func(cfg *Config) {
+				cfg.Priority = "emerg..4"
+			},
+			Expected: []string{"--utc", "--output=json", "--follow", "--priority", "emerg..4"},
+		},
+		{
+			Name: "invalid priority",
+			Config: func(cfg *Config) {
+				cfg.Priority = "verbose"
+			},
+			ExpectedError: "invalid value 'verbose' for parameter 'priority'",
+		},
 	}
 
 	for _, tt := range testCases {
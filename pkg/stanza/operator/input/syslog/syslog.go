@@ -63,7 +63,13 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		tcpInputCfg := tcp.NewConfigWithID(inputBase.ID() + "_internal_tcp")
 		tcpInputCfg.BaseConfig = *c.TCP
 		if syslogParserCfg.EnableOctetCounting {
-			tcpInputCfg.MultiLineBuilder = OctetMultiLineBuilder
+			maxLogSize := int(c.TCP.MaxLogSize)
+			if maxLogSize == 0 {
+				maxLogSize = tcp.DefaultMaxLogSize
+			}
+			tcpInputCfg.MultiLineBuilder = func(_ helper.Encoding) (bufio.SplitFunc, error) {
+				return newOctetFrameSplitFunc(maxLogSize, true), nil
+			}
 		}
 
 		tcpInput, err := tcpInputCfg.Build(logger)
@@ -143,11 +149,16 @@ func (t *Input) SetOutputs(operators []operator.Operator) error {
 	return t.parser.SetOutputs(operators)
 }
 
+// OctetMultiLineBuilder splits octet counted frames, as defined by RFC 6587,
+// which are up to tcp.DefaultMaxLogSize bytes long.
 func OctetMultiLineBuilder(_ helper.Encoding) (bufio.SplitFunc, error) {
-	return newOctetFrameSplitFunc(true), nil
+	return newOctetFrameSplitFunc(tcp.DefaultMaxLogSize, true), nil
 }
 
-func newOctetFrameSplitFunc(flushAtEOF bool) bufio.SplitFunc {
+// newOctetFrameSplitFunc splits octet counted frames. A frame may span several reads,
+// in which case more data is requested until the frame is complete. Frames longer than
+// maxLogSize are truncated, and the remaining bytes are split as if they were not framed.
+func newOctetFrameSplitFunc(maxLogSize int, flushAtEOF bool) bufio.SplitFunc {
 	frameRegex := regexp.MustCompile(`^[1-9]\d*\s`)
 	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
 		frameLoc := frameRegex.FindIndex(data)
@@ -169,14 +180,20 @@ func newOctetFrameSplitFunc(flushAtEOF bool) bufio.SplitFunc {
 		}
 
 		advance = frameMaxIndex + frameLenValue
-		// the limitation here is that we can only line split within a single buffer
-		// the context of buffer length cannot be pass onto the next scan
-		capacity := cap(data)
-		if advance > capacity {
-			return capacity, data, nil
+		if advance <= len(data) {
+			return advance, data[:advance], nil
+		}
+
+		switch {
+		case len(data) >= maxLogSize:
+			// the frame can never fit in the buffer
+			return maxLogSize, data[:maxLogSize], nil
+		case atEOF && flushAtEOF:
+			// the connection was closed before the end of the frame
+			return len(data), data, nil
+		default:
+			// the rest of the frame has not been received yet
+			return 0, nil, nil
 		}
-		token = data[:advance]
-		err = nil
-		return
 	}
 }
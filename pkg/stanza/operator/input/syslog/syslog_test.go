@@ -244,8 +244,19 @@ func TestOctetFramingSplitFunc(t *testing.T) {
 				return newRaw
 			}(),
 			ExpectedTokenized: []string{
-				`5000 ` + string(internal.GeneratedByteSliceOfLength(4091)),
-				`j`,
+				`5000 ` + string(internal.GeneratedByteSliceOfLength(4092)),
+			},
+		},
+		{
+			Name: "FrameAcrossReads",
+			Raw: func() []byte {
+				newRaw := internal.GeneratedByteSliceOfLength(6000)
+				newRaw = append([]byte(`6000 `), newRaw...)
+				return append(newRaw, []byte(`17 my log LOGEND 123`)...)
+			}(),
+			ExpectedTokenized: []string{
+				`6000 ` + string(internal.GeneratedByteSliceOfLength(6000)),
+				`17 my log LOGEND 123`,
 			},
 		},
 	}
@@ -255,3 +266,19 @@ func TestOctetFramingSplitFunc(t *testing.T) {
 		t.Run(tc.Name, tc.RunFunc(splitFunc))
 	}
 }
+
+func TestOctetFramingSplitFuncMaxLogSize(t *testing.T) {
+	tc := internal.TokenizerTestCase{
+		Name: "over max log size",
+		Raw: func() []byte {
+			newRaw := internal.GeneratedByteSliceOfLength(4092)
+			newRaw = append([]byte(`5000 `), newRaw...)
+			return newRaw
+		}(),
+		ExpectedTokenized: []string{
+			`5000 ` + string(internal.GeneratedByteSliceOfLength(4091)),
+			`j`,
+		},
+	}
+	t.Run(tc.Name, tc.RunFunc(newOctetFrameSplitFunc(4096, true)))
+}
@@ -43,6 +43,11 @@ func TestUnmarshal(t *testing.T) {
 						},
 						ClientCAFile: "foo4",
 					}
+					cfg.AllowedClientNames = []string{"client.example.com"}
+					cfg.RateLimit = &RateLimitConfig{
+						MessagesPerSecond: 100,
+						Burst:             10,
+					}
 					return cfg
 				}(),
 			},
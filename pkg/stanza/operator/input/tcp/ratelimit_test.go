@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tcp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterDisabled(t *testing.T) {
+	limiter := newRateLimiter(nil)
+	require.Nil(t, limiter)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, limiter.wait(context.Background()))
+	}
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	limiter := newRateLimiter(&RateLimitConfig{MessagesPerSecond: 1, Burst: 3})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.wait(context.Background()))
+	}
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.wait(ctx), context.DeadlineExceeded)
+}
+
+func TestRateLimiterRate(t *testing.T) {
+	limiter := newRateLimiter(&RateLimitConfig{MessagesPerSecond: 100})
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		require.NoError(t, limiter.wait(context.Background()))
+	}
+	// The first message is not delayed, the next ones are 10ms apart
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -74,9 +75,18 @@ type BaseConfig struct {
 	Multiline                   helper.MultilineConfig      `mapstructure:"multiline,omitempty"`
 	PreserveLeadingWhitespaces  bool                        `mapstructure:"preserve_leading_whitespaces,omitempty"`
 	PreserveTrailingWhitespaces bool                        `mapstructure:"preserve_trailing_whitespaces,omitempty"`
+	AllowedClientNames          []string                    `mapstructure:"allowed_client_names,omitempty"`
+	RateLimit                   *RateLimitConfig            `mapstructure:"rate_limit,omitempty"`
 	MultiLineBuilder            MultiLineBuilderFunc
 }
 
+// RateLimitConfig limits the rate at which messages are read from a single connection.
+// When the limit is reached, reading is paused, so that the sender is slowed down by TCP flow control.
+type RateLimitConfig struct {
+	MessagesPerSecond float64 `mapstructure:"messages_per_second,omitempty"`
+	Burst             int     `mapstructure:"burst,omitempty"`
+}
+
 type MultiLineBuilderFunc func(encoding helper.Encoding) (bufio.SplitFunc, error)
 
 func (c Config) defaultMultilineBuilder(encoding helper.Encoding) (bufio.SplitFunc, error) {
@@ -127,6 +137,19 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, err
 	}
 
+	if len(c.AllowedClientNames) > 0 && (c.TLS == nil || c.TLS.ClientCAFile == "") {
+		return nil, fmt.Errorf("'allowed_client_names' requires 'tls.client_ca_file' to be set")
+	}
+
+	if c.RateLimit != nil {
+		if c.RateLimit.MessagesPerSecond <= 0 {
+			return nil, fmt.Errorf("invalid value for parameter 'rate_limit.messages_per_second', must be greater than 0")
+		}
+		if c.RateLimit.Burst < 0 {
+			return nil, fmt.Errorf("invalid value for parameter 'rate_limit.burst', must not be negative")
+		}
+	}
+
 	var resolver *helper.IPResolver
 	if c.AddAttributes {
 		resolver = helper.NewIPResolver()
@@ -143,7 +166,15 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		backoff: backoff.Backoff{
 			Max: 3 * time.Second,
 		},
-		resolver: resolver,
+		resolver:  resolver,
+		rateLimit: c.RateLimit,
+	}
+
+	if len(c.AllowedClientNames) > 0 {
+		tcpInput.allowedClientNames = make(map[string]struct{}, len(c.AllowedClientNames))
+		for _, name := range c.AllowedClientNames {
+			tcpInput.allowedClientNames[name] = struct{}{}
+		}
 	}
 
 	if c.TLS != nil {
@@ -173,6 +204,9 @@ type Input struct {
 	encoding  helper.Encoding
 	splitFunc bufio.SplitFunc
 	resolver  *helper.IPResolver
+
+	allowedClientNames map[string]struct{}
+	rateLimit          *RateLimitConfig
 }
 
 // Start will start listening for log entries over tcp.
@@ -260,6 +294,13 @@ func (t *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 		defer t.wg.Done()
 		defer cancel()
 
+		if err := t.verifyClient(ctx, conn); err != nil {
+			t.Warnw("Rejected connection", zap.String("remote_addr", conn.RemoteAddr().String()), zap.Error(err))
+			return
+		}
+
+		limiter := newRateLimiter(t.rateLimit)
+
 		if t.OneLogPerPacket {
 			var buf bytes.Buffer
 			_, err := io.Copy(&buf, conn)
@@ -267,6 +308,9 @@ func (t *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 				t.Errorw("IO copy net connection buffer error", zap.Error(err))
 			}
 			log := truncateMaxLog(buf.Bytes(), t.MaxLogSize)
+			if limiter.wait(ctx) != nil {
+				return
+			}
 			t.handleMessage(ctx, conn, log)
 			return
 		}
@@ -279,6 +323,9 @@ func (t *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 		scanner.Split(t.splitFunc)
 
 		for scanner.Scan() {
+			if limiter.wait(ctx) != nil {
+				return
+			}
 			t.handleMessage(ctx, conn, scanner.Bytes())
 		}
 
@@ -288,6 +335,37 @@ func (t *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 	}()
 }
 
+// verifyClient completes the TLS handshake and checks that the client certificate
+// is issued to one of the allowed client names.
+func (t *Input) verifyClient(ctx context.Context, conn net.Conn) error {
+	if len(t.allowedClientNames) == 0 {
+		return nil
+	}
+
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return errors.New("connection is not using TLS")
+	}
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return fmt.Errorf("TLS handshake failed: %w", err)
+	}
+
+	certs := tlsConn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return errors.New("no client certificate")
+	}
+	cert := certs[0]
+	if _, allowed := t.allowedClientNames[cert.Subject.CommonName]; allowed {
+		return nil
+	}
+	for _, name := range cert.DNSNames {
+		if _, allowed := t.allowedClientNames[name]; allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("client certificate %q is not allowed", cert.Subject.CommonName)
+}
+
 func (t *Input) handleMessage(ctx context.Context, conn net.Conn, log []byte) {
 	decoded, err := t.encoding.Decode(log)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -335,6 +336,46 @@ func TestBuild(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"allowed-client-names-without-client-ca",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress:      "10.0.0.1:9000",
+					AllowedClientNames: []string{"client"},
+				},
+			},
+			true,
+		},
+		{
+			"rate-limit-valid",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress: "10.0.0.1:9000",
+					RateLimit:     &RateLimitConfig{MessagesPerSecond: 10, Burst: 5},
+				},
+			},
+			false,
+		},
+		{
+			"rate-limit-zero",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress: "10.0.0.1:9000",
+					RateLimit:     &RateLimitConfig{},
+				},
+			},
+			true,
+		},
+		{
+			"rate-limit-negative-burst",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress: "10.0.0.1:9000",
+					RateLimit:     &RateLimitConfig{MessagesPerSecond: 10, Burst: -1},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -343,6 +384,8 @@ func TestBuild(t *testing.T) {
 			cfg.ListenAddress = tc.inputBody.ListenAddress
 			cfg.MaxLogSize = tc.inputBody.MaxLogSize
 			cfg.TLS = tc.inputBody.TLS
+			cfg.AllowedClientNames = tc.inputBody.AllowedClientNames
+			cfg.RateLimit = tc.inputBody.RateLimit
 			_, err := cfg.Build(testutil.Logger(t))
 			if tc.expectErr {
 				require.Error(t, err)
@@ -368,6 +411,112 @@ func TestTLSTCPInput(t *testing.T) {
 	t.Run("CarriageReturn", tlsInputTest([]byte("message\r\n"), []string{"message"}))
 }
 
+func TestTLSTCPInputAllowedClientNames(t *testing.T) {
+	t.Run("Allowed", mtlsInputTest([]string{"Stanza"}, true))
+	t.Run("Rejected", mtlsInputTest([]string{"other"}, false))
+}
+
+func mtlsInputTest(allowedClientNames []string, expectAccepted bool) func(t *testing.T) {
+	return func(t *testing.T) {
+		tempDir := t.TempDir()
+		certFile := filepath.Join(tempDir, "test.crt")
+		keyFile := filepath.Join(tempDir, "test.key")
+		require.NoError(t, os.WriteFile(certFile, []byte(testTLSCertificate+"\n"), 0600))
+		require.NoError(t, os.WriteFile(keyFile, []byte(testTLSPrivateKey+"\n"), 0600))
+
+		// The self-signed test certificate is used by the client, and is trusted as its own CA
+		cfg := NewConfigWithID("test_id")
+		cfg.ListenAddress = ":0"
+		cfg.TLS = &configtls.TLSServerSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: certFile,
+				KeyFile:  keyFile,
+			},
+			ClientCAFile: certFile,
+		}
+		cfg.AllowedClientNames = allowedClientNames
+
+		op, err := cfg.Build(testutil.Logger(t))
+		require.NoError(t, err)
+
+		mockOutput := testutil.Operator{}
+		tcpInput := op.(*Input)
+		tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+		entryChan := make(chan *entry.Entry, 1)
+		mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			entryChan <- args.Get(1).(*entry.Entry)
+		}).Return(nil)
+
+		require.NoError(t, tcpInput.Start(testutil.NewMockPersister("test")))
+		defer func() {
+			require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+		}()
+
+		clientCert, err := tls.X509KeyPair([]byte(testTLSCertificate), []byte(testTLSPrivateKey))
+		require.NoError(t, err)
+		conn, err := tls.Dial("tcp", tcpInput.listener.Addr().String(), &tls.Config{
+			InsecureSkipVerify: true,
+			Certificates:       []tls.Certificate{clientCert},
+		})
+		require.NoError(t, err)
+		defer conn.Close()
+
+		// Writes may still succeed on a rejected connection, as the server closes it asynchronously
+		_, _ = conn.Write([]byte("message\n"))
+
+		select {
+		case entry := <-entryChan:
+			require.True(t, expectAccepted, "Unexpected entry: %s", entry)
+			require.Equal(t, "message", entry.Body)
+		case <-time.After(time.Second):
+			require.False(t, expectAccepted, "Timed out waiting for message to be written")
+		}
+	}
+}
+
+func TestTCPInputRateLimit(t *testing.T) {
+	cfg := NewConfigWithID("test_id")
+	cfg.ListenAddress = ":0"
+	cfg.RateLimit = &RateLimitConfig{MessagesPerSecond: 20, Burst: 1}
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	tcpInput := op.(*Input)
+	tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	entryChan := make(chan *entry.Entry, 5)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	require.NoError(t, tcpInput.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+	}()
+
+	conn, err := net.Dial("tcp", tcpInput.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	start := time.Now()
+	_, err = conn.Write([]byte("1\n2\n3\n4\n5\n"))
+	require.NoError(t, err)
+
+	for _, expected := range []string{"1", "2", "3", "4", "5"} {
+		select {
+		case entry := <-entryChan:
+			require.Equal(t, expected, entry.Body)
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for message to be written")
+		}
+	}
+	// The first message is read immediately, the others at 50ms intervals
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
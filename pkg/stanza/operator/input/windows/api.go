@@ -24,6 +24,7 @@ var (
 	updateBookmarkProc        SyscallProc = api.NewProc("EvtUpdateBookmark")
 	openPublisherMetadataProc SyscallProc = api.NewProc("EvtOpenPublisherMetadata")
 	formatMessageProc         SyscallProc = api.NewProc("EvtFormatMessage")
+	openSessionProc           SyscallProc = api.NewProc("EvtOpenSession")
 )
 
 // SyscallProc is a syscall procedure.
@@ -53,6 +54,20 @@ const (
 	ErrorInvalidOperation syscall.Errno = 4317
 )
 
+const (
+	// EvtRPCLogin is the login class of a session to a remote computer using RPC.
+	EvtRPCLogin uint32 = 1
+)
+
+// EvtRPCLoginInfo contains the information used to connect to a remote computer (EVT_RPC_LOGIN).
+type EvtRPCLoginInfo struct {
+	Server   *uint16
+	User     *uint16
+	Domain   *uint16
+	Password *uint16
+	Flags    uint32
+}
+
 const (
 	// EvtFormatMessageXML is flag that formats a message as an XML string that contains all event details and message strings.
 	EvtFormatMessageXML uint32 = 9
@@ -147,3 +162,13 @@ func evtFormatMessage(publisherMetadata uintptr, event uintptr, messageID uint32
 
 	return bufferUsed, nil
 }
+
+// evtOpenSession is the direct syscall implementation of EvtOpenSession (https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtopensession)
+func evtOpenSession(loginClass uint32, login *EvtRPCLoginInfo, timeout uint32, flags uint32) (uintptr, error) {
+	handle, _, err := openSessionProc.Call(uintptr(loginClass), uintptr(unsafe.Pointer(login)), uintptr(timeout), uintptr(flags))
+	if err != ErrorSuccess {
+		return 0, err
+	}
+
+	return handle, nil
+}
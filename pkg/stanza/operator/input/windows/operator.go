@@ -12,6 +12,8 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -47,6 +49,18 @@ type Config struct {
 	StartAt            string        `mapstructure:"start_at,omitempty"`
 	PollInterval       time.Duration `mapstructure:"poll_interval,omitempty"`
 	Raw                bool          `mapstructure:"raw,omitempty"`
+	Remote             RemoteConfig  `mapstructure:"remote,omitempty"`
+}
+
+// RemoteConfig is the configuration of a subscription to the event log of a remote computer.
+type RemoteConfig struct {
+	// Server is the name or address of the remote computer, the local computer is used if empty.
+	Server string `mapstructure:"server"`
+	// Username and Password are the credentials of the remote session,
+	// the credentials of the collector are used if empty.
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	Domain   string              `mapstructure:"domain,omitempty"`
 }
 
 // Build will build a windows event log operator.
@@ -68,6 +82,14 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, fmt.Errorf("the `start_at` field must be set to `beginning` or `end`")
 	}
 
+	if c.Remote.Server == "" && (c.Remote.Username != "" || c.Remote.Password != "" || c.Remote.Domain != "") {
+		return nil, fmt.Errorf("the `remote.server` field is required when remote credentials are set")
+	}
+
+	if c.Remote.Username != "" && c.Remote.Password == "" {
+		return nil, fmt.Errorf("the `remote.password` field is required when `remote.username` is set")
+	}
+
 	return &Input{
 		InputOperator: inputOperator,
 		buffer:        NewBuffer(),
@@ -76,6 +98,7 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		startAt:       c.StartAt,
 		pollInterval:  c.PollInterval,
 		raw:           c.Raw,
+		remote:        c.Remote,
 	}, nil
 }
 
@@ -89,6 +112,8 @@ type Input struct {
 	maxReads     int
 	startAt      string
 	raw          bool
+	remote       RemoteConfig
+	session      Session
 	pollInterval time.Duration
 	persister    operator.Persister
 	cancel       context.CancelFunc
@@ -102,22 +127,33 @@ func (e *Input) Start(persister operator.Persister) error {
 
 	e.persister = persister
 
+	e.session = NewSession()
+	if e.remote.Server != "" {
+		if err := e.session.Open(e.remote); err != nil {
+			return fmt.Errorf("failed to open remote session: %w", err)
+		}
+	}
+
 	e.bookmark = NewBookmark()
 	offsetXML, err := e.getBookmarkOffset(ctx)
 	if err != nil {
 		e.Errorf("Failed to open bookmark, continuing without previous bookmark: %s", err)
-		e.persister.Delete(ctx, e.channel)
+		e.persister.Delete(ctx, e.bookmarkKey())
 	}
 
 	if offsetXML != "" {
 		if err := e.bookmark.Open(offsetXML); err != nil {
-			return fmt.Errorf("failed to open bookmark: %w", err)
+			return multierr.Append(fmt.Errorf("failed to open bookmark: %w", err), e.closeSession())
 		}
 	}
 
 	e.subscription = NewSubscription()
-	if err := e.subscription.Open(e.channel, e.startAt, e.bookmark); err != nil {
-		return fmt.Errorf("failed to open subscription: %w", err)
+	if err := e.subscription.Open(e.session.handle, e.channel, e.startAt, e.bookmark); err != nil {
+		err = fmt.Errorf("failed to open subscription: %w", err)
+		if closeErr := e.bookmark.Close(); closeErr != nil {
+			err = multierr.Append(err, fmt.Errorf("failed to close bookmark: %w", closeErr))
+		}
+		return multierr.Append(err, e.closeSession())
 	}
 
 	e.wg.Add(1)
@@ -138,6 +174,14 @@ func (e *Input) Stop() error {
 		return fmt.Errorf("failed to close bookmark: %w", err)
 	}
 
+	return e.closeSession()
+}
+
+// closeSession closes the remote session, if any.
+func (e *Input) closeSession() error {
+	if err := e.session.Close(); err != nil {
+		return fmt.Errorf("failed to close remote session: %w", err)
+	}
 	return nil
 }
 
@@ -209,7 +253,7 @@ func (e *Input) processEvent(ctx context.Context, event Event) {
 	}
 
 	publisher := NewPublisher()
-	if err := publisher.Open(simpleEvent.Provider.Name); err != nil {
+	if err := publisher.Open(e.session.handle, simpleEvent.Provider.Name); err != nil {
 		e.Errorf("Failed to open publisher: %s: writing log entry to pipeline without metadata", err)
 		e.sendEvent(ctx, simpleEvent)
 		return
@@ -255,7 +299,7 @@ func (e *Input) sendEventRaw(ctx context.Context, eventRaw EventRaw) {
 
 // getBookmarkXML will get the bookmark xml from the offsets database.
 func (e *Input) getBookmarkOffset(ctx context.Context) (string, error) {
-	bytes, err := e.persister.Get(ctx, e.channel)
+	bytes, err := e.persister.Get(ctx, e.bookmarkKey())
 	return string(bytes), err
 }
 
@@ -272,8 +316,17 @@ func (e *Input) updateBookmarkOffset(ctx context.Context, event Event) {
 		return
 	}
 
-	if err := e.persister.Set(ctx, e.channel, []byte(bookmarkXML)); err != nil {
+	if err := e.persister.Set(ctx, e.bookmarkKey(), []byte(bookmarkXML)); err != nil {
 		e.Errorf("failed to set offsets: %s", err)
 		return
 	}
 }
+
+// bookmarkKey is the key of the bookmark in the offsets database.
+// Bookmarks of remote computers are stored separately from the ones of the local computer.
+func (e *Input) bookmarkKey() string {
+	if e.remote.Server == "" {
+		return e.channel
+	}
+	return e.remote.Server + "/" + e.channel
+}
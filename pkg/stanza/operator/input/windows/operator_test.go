@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBuildRemote(t *testing.T) {
+	testCases := []struct {
+		name        string
+		remote      RemoteConfig
+		expectedErr string
+	}{
+		{
+			name:   "local",
+			remote: RemoteConfig{},
+		},
+		{
+			name:   "remote with collector credentials",
+			remote: RemoteConfig{Server: "remote"},
+		},
+		{
+			name:   "remote with credentials",
+			remote: RemoteConfig{Server: "remote", Username: "user", Password: "password", Domain: "domain"},
+		},
+		{
+			name:        "credentials without server",
+			remote:      RemoteConfig{Username: "user", Password: "password"},
+			expectedErr: "the `remote.server` field is required when remote credentials are set",
+		},
+		{
+			name:        "username without password",
+			remote:      RemoteConfig{Server: "remote", Username: "user"},
+			expectedErr: "the `remote.password` field is required when `remote.username` is set",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.Channel = "application"
+			cfg.Remote = tc.remote
+
+			op, err := cfg.Build(zap.NewNop().Sugar())
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.remote, op.(*Input).remote)
+		})
+	}
+}
+
+func TestBookmarkKey(t *testing.T) {
+	input := &Input{channel: "application"}
+	require.Equal(t, "application", input.bookmarkKey())
+
+	input.remote = RemoteConfig{Server: "remote"}
+	require.Equal(t, "remote/application", input.bookmarkKey())
+}
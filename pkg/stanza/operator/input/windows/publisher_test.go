@@ -14,7 +14,7 @@ import (
 
 func TestPublisherOpenPreexisting(t *testing.T) {
 	publisher := Publisher{handle: 5}
-	err := publisher.Open("")
+	err := publisher.Open(0, "")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "publisher handle is already open")
 }
@@ -22,7 +22,7 @@ func TestPublisherOpenPreexisting(t *testing.T) {
 func TestPublisherOpenInvalidUTF8(t *testing.T) {
 	publisher := NewPublisher()
 	invalidUTF8 := "\u0000"
-	err := publisher.Open(invalidUTF8)
+	err := publisher.Open(0, invalidUTF8)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to convert provider to utf16")
 }
@@ -31,7 +31,7 @@ func TestPublisherOpenSyscallFailure(t *testing.T) {
 	publisher := NewPublisher()
 	provider := "provider"
 	openPublisherMetadataProc = SimpleMockProc(0, 0, ErrorNotSupported)
-	err := publisher.Open(provider)
+	err := publisher.Open(0, provider)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to open publisher handle")
 }
@@ -40,7 +40,7 @@ func TestPublisherOpenSuccess(t *testing.T) {
 	publisher := NewPublisher()
 	provider := "provider"
 	openPublisherMetadataProc = SimpleMockProc(5, 0, ErrorSuccess)
-	err := publisher.Open(provider)
+	err := publisher.Open(0, provider)
 	require.NoError(t, err)
 	require.Equal(t, uintptr(5), publisher.handle)
 }
@@ -6,6 +6,7 @@ package windows // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"encoding/xml"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
@@ -29,6 +30,7 @@ type EventXML struct {
 	RenderedKeywords []string         `xml:"RenderingInfo>Keywords>Keyword"`
 	Keywords         []string         `xml:"System>Keywords"`
 	EventData        []EventDataEntry `xml:"EventData>Data"`
+	UserData         *UserData        `xml:"UserData"`
 }
 
 // parseTimestamp will parse the timestamp of the event.
@@ -121,6 +123,9 @@ func (e *EventXML) parseBody() map[string]interface{} {
 	if len(details) > 0 {
 		body["details"] = details
 	}
+	if e.UserData != nil {
+		body["user_data"] = parseUserData(e.UserData.Elements)
+	}
 	return body
 }
 
@@ -136,14 +141,34 @@ func (e *EventXML) parseMessage() (string, map[string]interface{}) {
 
 // parse event data entries into a map[string]interface
 // where the key is the Name attribute, and value is the element value
-// entries without Name are ignored
+// entries without Name are keyed by their position, e.g. param1, like the insertion strings of the message
 // see: https://learn.microsoft.com/en-us/windows/win32/wes/eventschema-datafieldtype-complextype
 func parseEventData(entries []EventDataEntry) map[string]interface{} {
 	outputMap := make(map[string]interface{}, len(entries))
 
-	for _, entry := range entries {
+	for i, entry := range entries {
 		if entry.Name != "" {
 			outputMap[entry.Name] = entry.Value
+		} else {
+			outputMap[fmt.Sprintf("param%d", i+1)] = entry.Value
+		}
+	}
+
+	return outputMap
+}
+
+// parse the elements of user data into a map[string]interface
+// where the key is the element name, and value is either the element value
+// or a map of the child elements, so that the provider defined schema is preserved
+// see: https://learn.microsoft.com/en-us/windows/win32/wes/eventschema-userdatatype-complextype
+func parseUserData(elements []XMLElement) map[string]interface{} {
+	outputMap := make(map[string]interface{}, len(elements))
+
+	for _, element := range elements {
+		if len(element.Elements) > 0 {
+			outputMap[element.XMLName.Local] = parseUserData(element.Elements)
+		} else {
+			outputMap[element.XMLName.Local] = strings.TrimSpace(element.Value)
 		}
 	}
 
@@ -181,3 +206,15 @@ type EventDataEntry struct {
 	Name  string `xml:"Name,attr"`
 	Value string `xml:",chardata"`
 }
+
+// UserData is the provider defined data of the event.
+type UserData struct {
+	Elements []XMLElement `xml:",any"`
+}
+
+// XMLElement is an arbitrary xml element.
+type XMLElement struct {
+	XMLName  xml.Name
+	Value    string       `xml:",chardata"`
+	Elements []XMLElement `xml:",any"`
+}
@@ -243,8 +243,46 @@ func TestParseEventData(t *testing.T) {
 	}
 
 	parsed = xmlMixed.parseBody()
-	expectedSlice := map[string]interface{}{"name": "value"}
-	require.Equal(t, expectedSlice, parsed["event_data"])
+	expectedMixed := map[string]interface{}{"name": "value", "param2": "noname"}
+	require.Equal(t, expectedMixed, parsed["event_data"])
+
+	xmlUnnamed := EventXML{
+		EventData: []EventDataEntry{
+			{Value: "first"},
+			{Value: "second"},
+		},
+	}
+
+	parsed = xmlUnnamed.parseBody()
+	expectedUnnamed := map[string]interface{}{"param1": "first", "param2": "second"}
+	require.Equal(t, expectedUnnamed, parsed["event_data"])
+}
+
+func TestParseUserData(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "xmlUserData.xml"))
+	require.NoError(t, err)
+
+	event, err := unmarshalEventXML(data)
+	require.NoError(t, err)
+
+	parsed := event.parseBody()
+	expected := map[string]interface{}{
+		"LogFileCleared": map[string]interface{}{
+			"SubjectUserSid":    "S-1-5-21-1234",
+			"SubjectUserName":   "admin",
+			"SubjectDomainName": "EXAMPLE",
+			"SubjectLogonId":    "0x3e7",
+			"Details": map[string]interface{}{
+				"Reason": "manual",
+			},
+		},
+	}
+	require.Equal(t, expected, parsed["user_data"])
+	require.Equal(t, map[string]interface{}{}, parsed["event_data"])
+
+	noUserData := EventXML{}
+	_, ok := noUserData.parseBody()["user_data"]
+	require.False(t, ok)
 }
 
 func TestInvalidUnmarshal(t *testing.T) {
@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditd // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/auditd"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "auditd_parser"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new auditd parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new auditd parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig:     helper.NewParserConfig(operatorID, operatorType),
+		ForceFlushPeriod: 2 * time.Second,
+		MaxPendingEvents: 1000,
+	}
+}
+
+// Config is the configuration of an auditd parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+
+	// ForceFlushPeriod is the time after which the records of an event are
+	// emitted when its last record was not received.
+	ForceFlushPeriod time.Duration `mapstructure:"force_flush_period"`
+	// MaxPendingEvents is the number of events whose records are buffered,
+	// the oldest event is emitted when it is exceeded.
+	MaxPendingEvents int `mapstructure:"max_pending_events"`
+}
+
+// Build will build an auditd parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ForceFlushPeriod <= 0 {
+		return nil, fmt.Errorf("force_flush_period must be positive")
+	}
+	if c.MaxPendingEvents <= 0 {
+		return nil, fmt.Errorf("max_pending_events must be positive")
+	}
+
+	return &Parser{
+		ParserOperator:   parserOperator,
+		forceFlushPeriod: c.ForceFlushPeriod,
+		maxPendingEvents: c.MaxPendingEvents,
+		pending:          make(map[string]*event),
+		chClose:          make(chan struct{}),
+	}, nil
+}
+
+// Parser is an operator that parses the records of Linux audit events, and
+// reassembles the records of each event into a single entry.
+type Parser struct {
+	helper.ParserOperator
+	forceFlushPeriod time.Duration
+	maxPendingEvents int
+	chClose          chan struct{}
+	wg               sync.WaitGroup
+
+	sync.Mutex
+	pending map[string]*event
+}
+
+// event is an audit event whose last record was not received yet.
+type event struct {
+	// entry is the entry of the first record, which is emitted with the
+	// fields of all the records.
+	entry     *entry.Entry
+	records   []*record
+	firstSeen time.Time
+}
+
+// Start starts the goroutine emitting the events whose last record was not
+// received within the force flush period.
+func (p *Parser) Start(_ operator.Persister) error {
+	p.wg.Add(1)
+	go p.flushLoop()
+	return nil
+}
+
+func (p *Parser) flushLoop() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.forceFlushPeriod / 5)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.Lock()
+			now := time.Now()
+			for id, ev := range p.pending {
+				if now.Sub(ev.firstSeen) < p.forceFlushPeriod {
+					continue
+				}
+				if err := p.flush(context.Background(), id); err != nil {
+					p.Errorf("failed to flush audit event: %s", err)
+				}
+			}
+			p.Unlock()
+		case <-p.chClose:
+			return
+		}
+	}
+}
+
+// Stop emits the pending events.
+func (p *Parser) Stop() error {
+	close(p.chClose)
+	p.wg.Wait()
+
+	p.Lock()
+	defer p.Unlock()
+	for id := range p.pending {
+		if err := p.flush(context.Background(), id); err != nil {
+			p.Errorf("failed to flush audit event: %s", err)
+		}
+	}
+	return nil
+}
+
+// Process parses an entry as an audit record. The entry is only emitted with
+// the last record of its event, the entries of the other records are dropped.
+func (p *Parser) Process(ctx context.Context, e *entry.Entry) error {
+	skip, err := p.Skip(ctx, e)
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+	if skip {
+		p.Write(ctx, e)
+		return nil
+	}
+
+	value, ok := e.Get(p.ParseFrom)
+	if !ok {
+		return p.HandleEntryError(ctx, e, fmt.Errorf("entry is missing the parse_from field %s", p.ParseFrom.String()))
+	}
+	r, err := parseRecord(value)
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	id := r.eventID()
+	ev, ok := p.pending[id]
+	if !ok {
+		if r.typ == eoeType {
+			// the event was flushed before the end of event record
+			return nil
+		}
+		ev = &event{entry: e, firstSeen: time.Now()}
+		p.pending[id] = ev
+	}
+	if r.typ != eoeType {
+		ev.records = append(ev.records, r)
+	}
+
+	if r.isLast() {
+		return p.flush(ctx, id)
+	}
+	if len(p.pending) > p.maxPendingEvents {
+		return p.flush(ctx, p.oldest())
+	}
+	return nil
+}
+
+// flush emits the entry of a pending event with the fields of its records.
+func (p *Parser) flush(ctx context.Context, id string) error {
+	ev := p.pending[id]
+	delete(p.pending, id)
+
+	ev.entry.Timestamp = ev.records[0].timestamp
+	if err := p.ParseWith(ctx, ev.entry, func(interface{}) (interface{}, error) {
+		return ev.parsed(), nil
+	}); err != nil {
+		return err
+	}
+	p.Write(ctx, ev.entry)
+	return nil
+}
+
+// oldest returns the ID of the pending event seen first.
+func (p *Parser) oldest() string {
+	var oldestID string
+	var oldest time.Time
+	for id, ev := range p.pending {
+		if oldestID == "" || ev.firstSeen.Before(oldest) {
+			oldestID, oldest = id, ev.firstSeen
+		}
+	}
+	return oldestID
+}
+
+// parsed returns the fields of the first record of the event, with its type
+// and sequence number, and the other records under the records key.
+func (ev *event) parsed() map[string]interface{} {
+	first := ev.records[0]
+	parsed := make(map[string]interface{}, len(first.fields)+4)
+	for k, v := range first.fields {
+		parsed[k] = v
+	}
+	parsed["type"] = first.typ
+	parsed["sequence"] = first.sequence
+	if first.node != "" {
+		parsed["node"] = first.node
+	}
+
+	if len(ev.records) > 1 {
+		records := make([]interface{}, 0, len(ev.records)-1)
+		for _, r := range ev.records[1:] {
+			fields := make(map[string]interface{}, len(r.fields)+1)
+			for k, v := range r.fields {
+				fields[k] = v
+			}
+			fields["type"] = r.typ
+			records = append(records, fields)
+		}
+		parsed["records"] = records
+	}
+	return parsed
+}
@@ -0,0 +1,406 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+const (
+	syscallRecord   = `type=SYSCALL msg=audit(1364481363.243:24287): arch=c000003e syscall=2 success=no exit=-13 items=1 ppid=2686 pid=3538 auid=1000 uid=1000 tty=pts0 ses=1 comm="cat" exe="/bin/cat" key="sshd_config"`
+	cwdRecord       = `type=CWD msg=audit(1364481363.243:24287): cwd="/home/shadowman"`
+	pathRecord      = `type=PATH msg=audit(1364481363.243:24287): item=0 name="/etc/ssh/sshd_config" inode=409248 mode=0100600 ouid=0 nametype=NORMAL`
+	proctitleRecord = `type=PROCTITLE msg=audit(1364481363.243:24287): proctitle=636174002F6574632F7373682F737368645F636F6E666967`
+	eoeRecord       = `type=EOE msg=audit(1364481363.243:24287): `
+	userLoginRecord = `type=USER_LOGIN msg=audit(1364481363.250:24288): pid=3539 uid=0 auid=1000 ses=2 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=10.0.0.5 addr=10.0.0.5 terminal=ssh res=success'`
+)
+
+var (
+	recordTimestamp = time.Unix(1364481363, 243000000)
+
+	syscallFields = map[string]interface{}{
+		"type":     "SYSCALL",
+		"sequence": "24287",
+		"arch":     "c000003e",
+		"syscall":  "2",
+		"success":  "no",
+		"exit":     "-13",
+		"items":    "1",
+		"ppid":     "2686",
+		"pid":      "3538",
+		"auid":     "1000",
+		"uid":      "1000",
+		"tty":      "pts0",
+		"ses":      "1",
+		"comm":     "cat",
+		"exe":      "/bin/cat",
+		"key":      "sshd_config",
+	}
+)
+
+func newTestParser(t *testing.T, configure func(*Config)) (*Parser, *testutil.FakeOutput) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	configure(cfg)
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+	return op.(*Parser), fake
+}
+
+func with(m map[string]interface{}, kvs ...interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(m)+len(kvs)/2)
+	for k, v := range m {
+		merged[k] = v
+	}
+	for i := 0; i < len(kvs); i += 2 {
+		merged[kvs[i].(string)] = kvs[i+1]
+	}
+	return merged
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("auditd_parser")
+	require.True(t, ok, "expected auditd_parser to be registered")
+	require.Equal(t, "auditd_parser", builder().Type())
+}
+
+func TestBuildErrors(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.ForceFlushPeriod = 0
+	_, err := cfg.Build(testutil.Logger(t))
+	require.EqualError(t, err, "force_flush_period must be positive")
+
+	cfg = NewConfigWithID("test")
+	cfg.MaxPendingEvents = 0
+	_, err = cfg.Build(testutil.Logger(t))
+	require.EqualError(t, err, "max_pending_events must be positive")
+}
+
+func TestParseRecordErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  interface{}
+		errMsg string
+	}{
+		{"invalid-type", []int{}, "type []int cannot be parsed as an audit record"},
+		{"missing-header", "arch=c000003e syscall=2", "missing audit record header"},
+		{"journald-missing-id", map[string]interface{}{"MESSAGE": "SYSCALL arch=c000003e"}, "missing _AUDIT_ID in journald audit entry"},
+		{
+			"journald-invalid-timestamp",
+			map[string]interface{}{"MESSAGE": "SYSCALL arch=c000003e", "_AUDIT_ID": "1", "_AUDIT_TYPE": "1300", "_SOURCE_REALTIME_TIMESTAMP": "now"},
+			"invalid _SOURCE_REALTIME_TIMESTAMP 'now' in journald audit entry",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseRecord(tc.input)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestParseRecord(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  interface{}
+		typ    string
+		number int
+		node   string
+		fields map[string]interface{}
+	}{
+		{
+			"audit-log",
+			cwdRecord,
+			"CWD",
+			1307,
+			"",
+			map[string]interface{}{"cwd": "/home/shadowman"},
+		},
+		{
+			"node",
+			`node=web-1 type=CWD msg=audit(1364481363.243:24287): cwd="/root"`,
+			"CWD",
+			1307,
+			"web-1",
+			map[string]interface{}{"cwd": "/root"},
+		},
+		{
+			"kernel-log",
+			`<5>Oct 16 08:00:00 host kernel: audit: type=1327 audit(1364481363.243:24287): proctitle=2F62696E2F7368002D63006C73`,
+			"PROCTITLE",
+			1327,
+			"",
+			map[string]interface{}{"proctitle": "/bin/sh -c ls"},
+		},
+		{
+			"hex-encoded",
+			`type=EXECVE msg=audit(1364481363.243:24287): argc=3 a0="ls" a1=2D6C2061 a2=4142 key=7061737377640173736864`,
+			"EXECVE",
+			1309,
+			"",
+			map[string]interface{}{"argc": "3", "a0": "ls", "a1": "-l a", "a2": "AB", "key": "passwd,sshd"},
+		},
+		{
+			"not-hex-encoded",
+			`type=SYSCALL msg=audit(1364481363.243:24287): a2=4142 key=(null) comm=abc exe="414243"`,
+			"SYSCALL",
+			1300,
+			"",
+			map[string]interface{}{"a2": "4142", "key": "(null)", "comm": "abc", "exe": "414243"},
+		},
+		{
+			"user-message",
+			userLoginRecord,
+			"USER_LOGIN",
+			1112,
+			"",
+			map[string]interface{}{
+				"pid":      "3539",
+				"uid":      "0",
+				"auid":     "1000",
+				"ses":      "2",
+				"op":       "login",
+				"id":       "1000",
+				"exe":      "/usr/sbin/sshd",
+				"hostname": "10.0.0.5",
+				"addr":     "10.0.0.5",
+				"terminal": "ssh",
+				"res":      "success",
+			},
+		},
+		{
+			"enriched",
+			"type=SYSCALL msg=audit(1364481363.243:24287): arch=c000003e syscall=59 uid=0\x1dARCH=x86_64 SYSCALL=execve UID=\"root\"",
+			"SYSCALL",
+			1300,
+			"",
+			map[string]interface{}{"arch": "c000003e", "syscall": "59", "uid": "0", "ARCH": "x86_64", "SYSCALL": "execve", "UID": "root"},
+		},
+		{
+			"unknown-type",
+			`type=UNKNOWN[1340] msg=audit(1364481363.243:24287): op=test`,
+			"UNKNOWN[1340]",
+			1340,
+			"",
+			map[string]interface{}{"op": "test"},
+		},
+		{
+			"journald",
+			map[string]interface{}{
+				"MESSAGE":                    `SYSCALL arch=c000003e syscall=2 uid=0 exe="/bin/cat"`,
+				"_AUDIT_ID":                  "24287",
+				"_AUDIT_TYPE":                "1300",
+				"_AUDIT_TYPE_NAME":           "SYSCALL",
+				"_SOURCE_REALTIME_TIMESTAMP": "1364481363243000",
+				"_TRANSPORT":                 "audit",
+			},
+			"SYSCALL",
+			1300,
+			"",
+			map[string]interface{}{"arch": "c000003e", "syscall": "2", "uid": "0", "exe": "/bin/cat"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := parseRecord(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.typ, r.typ)
+			require.Equal(t, tc.number, r.number)
+			require.Equal(t, tc.node, r.node)
+			require.Equal(t, "24287", r.sequence)
+			require.True(t, recordTimestamp.Equal(r.timestamp))
+			require.Equal(t, tc.fields, r.fields)
+		})
+	}
+}
+
+func TestParserReassembly(t *testing.T) {
+	cases := []struct {
+		name    string
+		records []string
+	}{
+		{"proctitle", []string{syscallRecord, cwdRecord, pathRecord, proctitleRecord}},
+		{"end-of-event", []string{syscallRecord, cwdRecord, pathRecord, proctitleRecord, eoeRecord}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser, fake := newTestParser(t, func(*Config) {})
+			ots := time.Now()
+
+			for _, r := range tc.records {
+				require.NoError(t, parser.Process(context.Background(), &entry.Entry{Body: r, ObservedTimestamp: ots}))
+			}
+
+			fake.ExpectEntry(t, &entry.Entry{
+				Body:              syscallRecord,
+				Timestamp:         recordTimestamp,
+				ObservedTimestamp: ots,
+				Attributes: with(syscallFields, "records", []interface{}{
+					map[string]interface{}{"type": "CWD", "cwd": "/home/shadowman"},
+					map[string]interface{}{
+						"type":     "PATH",
+						"item":     "0",
+						"name":     "/etc/ssh/sshd_config",
+						"inode":    "409248",
+						"mode":     "0100600",
+						"ouid":     "0",
+						"nametype": "NORMAL",
+					},
+					map[string]interface{}{"type": "PROCTITLE", "proctitle": "cat /etc/ssh/sshd_config"},
+				}),
+			})
+			fake.ExpectNoEntry(t, 100*time.Millisecond)
+			require.Empty(t, parser.pending)
+		})
+	}
+}
+
+func TestParserEndOfEvent(t *testing.T) {
+	parser, fake := newTestParser(t, func(*Config) {})
+
+	for _, r := range []string{syscallRecord, pathRecord, eoeRecord} {
+		require.NoError(t, parser.Process(context.Background(), &entry.Entry{Body: r}))
+	}
+
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, "SYSCALL", e.Attributes["type"])
+		require.Len(t, e.Attributes["records"], 1)
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry")
+	}
+	require.Empty(t, parser.pending)
+}
+
+func TestParserStandaloneRecord(t *testing.T) {
+	parser, fake := newTestParser(t, func(*Config) {})
+	ots := time.Now()
+
+	require.NoError(t, parser.Process(context.Background(), &entry.Entry{Body: userLoginRecord, ObservedTimestamp: ots}))
+	fake.ExpectEntry(t, &entry.Entry{
+		Body:              userLoginRecord,
+		Timestamp:         time.Unix(1364481363, 250000000),
+		ObservedTimestamp: ots,
+		Attributes: map[string]interface{}{
+			"type":     "USER_LOGIN",
+			"sequence": "24288",
+			"pid":      "3539",
+			"uid":      "0",
+			"auid":     "1000",
+			"ses":      "2",
+			"op":       "login",
+			"id":       "1000",
+			"exe":      "/usr/sbin/sshd",
+			"hostname": "10.0.0.5",
+			"addr":     "10.0.0.5",
+			"terminal": "ssh",
+			"res":      "success",
+		},
+	})
+}
+
+func TestParserParseTo(t *testing.T) {
+	parser, fake := newTestParser(t, func(cfg *Config) {
+		cfg.ParseFrom = entry.NewBodyField("message")
+		cfg.ParseTo = entry.RootableField{Field: entry.NewAttributeField("audit")}
+	})
+
+	for _, r := range []string{syscallRecord, proctitleRecord} {
+		require.NoError(t, parser.Process(context.Background(), &entry.Entry{
+			Body:       map[string]interface{}{"message": r},
+			Attributes: map[string]interface{}{"host": "web-1"},
+		}))
+	}
+
+	fake.ExpectEntry(t, &entry.Entry{
+		Body:      map[string]interface{}{"message": syscallRecord},
+		Timestamp: recordTimestamp,
+		Attributes: map[string]interface{}{
+			"host": "web-1",
+			"audit": with(syscallFields, "records", []interface{}{
+				map[string]interface{}{"type": "PROCTITLE", "proctitle": "cat /etc/ssh/sshd_config"},
+			}),
+		},
+	})
+}
+
+func TestParserInvalidRecord(t *testing.T) {
+	parser, fake := newTestParser(t, func(*Config) {})
+
+	e := &entry.Entry{Body: "not an audit record"}
+	require.Error(t, parser.Process(context.Background(), e))
+	fake.ExpectEntry(t, e)
+}
+
+func TestParserMaxPendingEvents(t *testing.T) {
+	parser, fake := newTestParser(t, func(cfg *Config) {
+		cfg.MaxPendingEvents = 1
+	})
+
+	require.NoError(t, parser.Process(context.Background(), &entry.Entry{Body: syscallRecord}))
+	fake.ExpectNoEntry(t, 100*time.Millisecond)
+
+	// the first event is emitted without its other records
+	require.NoError(t, parser.Process(context.Background(), &entry.Entry{
+		Body: `type=SYSCALL msg=audit(1364481364.000:24289): arch=c000003e syscall=59`,
+	}))
+	fake.ExpectEntry(t, &entry.Entry{
+		Body:       syscallRecord,
+		Timestamp:  recordTimestamp,
+		Attributes: syscallFields,
+	})
+	require.Len(t, parser.pending, 1)
+
+	// the records received after the event was emitted are not reassembled
+	require.NoError(t, parser.Process(context.Background(), &entry.Entry{Body: eoeRecord}))
+	fake.ExpectNoEntry(t, 100*time.Millisecond)
+}
+
+func TestParserForceFlush(t *testing.T) {
+	parser, fake := newTestParser(t, func(cfg *Config) {
+		cfg.ForceFlushPeriod = 100 * time.Millisecond
+	})
+	require.NoError(t, parser.Start(nil))
+
+	require.NoError(t, parser.Process(context.Background(), &entry.Entry{Body: syscallRecord}))
+	fake.ExpectNoEntry(t, 50*time.Millisecond)
+
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, syscallFields, e.Attributes)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "The event should be flushed by now")
+	}
+
+	require.NoError(t, parser.Stop())
+}
+
+func TestParserStop(t *testing.T) {
+	parser, fake := newTestParser(t, func(*Config) {})
+	require.NoError(t, parser.Start(nil))
+
+	require.NoError(t, parser.Process(context.Background(), &entry.Entry{Body: syscallRecord}))
+	require.NoError(t, parser.Stop())
+
+	fake.ExpectEntry(t, &entry.Entry{
+		Body:       syscallRecord,
+		Timestamp:  recordTimestamp,
+		Attributes: syscallFields,
+	})
+}
@@ -0,0 +1,265 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditd // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/auditd"
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	eoeType = "EOE"
+
+	// enrichedSeparator separates the raw fields of a record from the fields
+	// translated by auditd with log_format = ENRICHED.
+	enrichedSeparator = "\x1d"
+)
+
+var (
+	// headerPattern matches the header of the records of audit.log and of the
+	// kernel log, e.g. node=host type=SYSCALL msg=audit(1364481363.243:24287):
+	headerPattern = regexp.MustCompile(`(?:node=(\S+)\s+)?type=(\S+)\s+(?:msg=)?audit\((\d+)\.(\d+):(\d+)\):\s*`)
+
+	unknownTypePattern = regexp.MustCompile(`^UNKNOWN\[(\d+)\]$`)
+	execveArgPattern   = regexp.MustCompile(`^a\d+$`)
+)
+
+// encodedFields are the fields whose values are hex encoded by the kernel when
+// they contain spaces, quotes or control characters.
+var encodedFields = map[string]bool{
+	"acct":      true,
+	"cmd":       true,
+	"comm":      true,
+	"cwd":       true,
+	"data":      true,
+	"dir":       true,
+	"exe":       true,
+	"file":      true,
+	"key":       true,
+	"name":      true,
+	"path":      true,
+	"proctitle": true,
+}
+
+// record is a line of an audit event.
+type record struct {
+	typ       string
+	number    int
+	node      string
+	timestamp time.Time
+	sequence  string
+	fields    map[string]interface{}
+}
+
+// eventID identifies the event of the record, the records of an event share
+// their timestamp and sequence number.
+func (r *record) eventID() string {
+	return fmt.Sprintf("%s:%d:%s", r.node, r.timestamp.UnixMilli(), r.sequence)
+}
+
+// parseRecord parses a record of audit.log or of the kernel log, or an audit
+// entry read from journald.
+func parseRecord(value interface{}) (*record, error) {
+	switch m := value.(type) {
+	case string:
+		return parseRecordString(m)
+	case map[string]interface{}:
+		return parseJournaldRecord(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as an audit record", value)
+	}
+}
+
+func parseRecordString(input string) (*record, error) {
+	match := headerPattern.FindStringSubmatchIndex(input)
+	if match == nil {
+		return nil, fmt.Errorf("missing audit record header in '%s'", input)
+	}
+	group := func(i int) string {
+		if match[2*i] < 0 {
+			return ""
+		}
+		return input[match[2*i]:match[2*i+1]]
+	}
+
+	timestamp, err := parseTimestamp(group(3), group(4))
+	if err != nil {
+		return nil, err
+	}
+	r := &record{
+		node:      group(1),
+		timestamp: timestamp,
+		sequence:  group(5),
+	}
+	r.setType(group(2))
+	r.fields = parseFields(r.typ, input[match[1]:])
+	return r, nil
+}
+
+// parseJournaldRecord parses the fields of an audit entry of journald, whose
+// message is the type name followed by the fields of the record.
+func parseJournaldRecord(m map[string]interface{}) (*record, error) {
+	message, ok := m["MESSAGE"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing MESSAGE in journald audit entry")
+	}
+	sequence, ok := m["_AUDIT_ID"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing _AUDIT_ID in journald audit entry")
+	}
+	typ, ok := m["_AUDIT_TYPE_NAME"].(string)
+	if !ok {
+		if typ, ok = m["_AUDIT_TYPE"].(string); !ok {
+			return nil, fmt.Errorf("missing _AUDIT_TYPE in journald audit entry")
+		}
+	}
+	realtime, ok := m["_SOURCE_REALTIME_TIMESTAMP"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing _SOURCE_REALTIME_TIMESTAMP in journald audit entry")
+	}
+	usec, err := strconv.ParseInt(realtime, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid _SOURCE_REALTIME_TIMESTAMP '%s' in journald audit entry", realtime)
+	}
+
+	r := &record{
+		timestamp: time.UnixMicro(usec),
+		sequence:  sequence,
+	}
+	r.setType(typ)
+	r.fields = parseFields(r.typ, message)
+	return r, nil
+}
+
+// setType sets the name and number of the type of the record, which is
+// either a name, a number or UNKNOWN[number].
+func (r *record) setType(typ string) {
+	if n, err := strconv.Atoi(typ); err == nil {
+		r.number = n
+		if name, ok := recordTypes[n]; ok {
+			typ = name
+		}
+	} else if match := unknownTypePattern.FindStringSubmatch(typ); match != nil {
+		r.number, _ = strconv.Atoi(match[1])
+	} else {
+		r.number = recordNumbers[typ]
+	}
+	r.typ = typ
+}
+
+// isLast returns whether the record ends its event. The records whose type
+// number is unknown are standalone events.
+func (r *record) isLast() bool {
+	return r.number == 0 || isLastRecord(r.number)
+}
+
+// parseTimestamp parses the seconds and fraction of seconds of the header.
+func parseTimestamp(seconds, fraction string) (time.Time, error) {
+	sec, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid audit timestamp %s.%s", seconds, fraction)
+	}
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
+	}
+	nsec, err := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid audit timestamp %s.%s", seconds, fraction)
+	}
+	return time.Unix(sec, nsec), nil
+}
+
+// parseFields parses the key=value fields of a record. The values are either
+// unquoted, double quoted or, for the user space messages, single quoted
+// fields which are parsed too. Words which are not fields are ignored.
+func parseFields(typ, input string) map[string]interface{} {
+	fields := make(map[string]interface{})
+	raw, enriched, _ := strings.Cut(input, enrichedSeparator)
+	addFields(fields, typ, raw)
+	addFields(fields, typ, enriched)
+	return fields
+}
+
+func addFields(fields map[string]interface{}, typ, input string) {
+	for {
+		input = strings.TrimLeft(input, " ")
+		if input == "" {
+			return
+		}
+		eq := strings.IndexByte(input, '=')
+		space := strings.IndexByte(input, ' ')
+		if eq <= 0 || (space >= 0 && space < eq) {
+			if space < 0 {
+				return
+			}
+			input = input[space:]
+			continue
+		}
+		key := input[:eq]
+		input = input[eq+1:]
+
+		var value string
+		var quote byte
+		if input != "" && (input[0] == '"' || input[0] == '\'') {
+			quote = input[0]
+			end := strings.IndexByte(input[1:], quote)
+			if end < 0 {
+				value, input = input[1:], ""
+			} else {
+				value, input = input[1:end+1], input[end+2:]
+			}
+		} else {
+			end := strings.IndexByte(input, ' ')
+			if end < 0 {
+				value, input = input, ""
+			} else {
+				value, input = input[:end], input[end:]
+			}
+		}
+
+		if quote == '\'' && key == "msg" && strings.Contains(value, "=") {
+			// the fields of the message of user space records
+			inner := make(map[string]interface{})
+			addFields(inner, typ, value)
+			for k, v := range inner {
+				if _, ok := fields[k]; !ok {
+					fields[k] = v
+				}
+			}
+			continue
+		}
+		if quote == 0 {
+			value = decodeValue(typ, key, value)
+		}
+		fields[key] = value
+	}
+}
+
+// decodeValue decodes the unquoted hex encoded values. The arguments of the
+// proctitle are separated by spaces rather than null characters, and the keys
+// by commas rather than 0x01 characters.
+func decodeValue(typ, key, value string) string {
+	if !encodedFields[key] && (typ != "EXECVE" || !execveArgPattern.MatchString(key)) {
+		return value
+	}
+	if value == "" || len(value)%2 != 0 || strings.ToUpper(value) != value {
+		return value
+	}
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		return value
+	}
+	switch key {
+	case "proctitle":
+		return strings.ReplaceAll(string(decoded), "\x00", " ")
+	case "key":
+		return strings.ReplaceAll(string(decoded), "\x01", ",")
+	default:
+		return string(decoded)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditd // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/auditd"
+
+// recordTypes are the names of the common audit record types, as defined in
+// linux/audit.h and libaudit.h.
+var recordTypes = map[int]string{
+	1005: "USER",
+	1100: "USER_AUTH",
+	1101: "USER_ACCT",
+	1102: "USER_MGMT",
+	1103: "CRED_ACQ",
+	1104: "CRED_DISP",
+	1105: "USER_START",
+	1106: "USER_END",
+	1107: "USER_AVC",
+	1108: "USER_CHAUTHTOK",
+	1109: "USER_ERR",
+	1110: "CRED_REFR",
+	1111: "USYS_CONFIG",
+	1112: "USER_LOGIN",
+	1113: "USER_LOGOUT",
+	1114: "ADD_USER",
+	1115: "DEL_USER",
+	1116: "ADD_GROUP",
+	1117: "DEL_GROUP",
+	1118: "DAC_CHECK",
+	1119: "CHGRP_ID",
+	1120: "TEST",
+	1121: "TRUSTED_APP",
+	1122: "USER_SELINUX_ERR",
+	1123: "USER_CMD",
+	1124: "USER_TTY",
+	1125: "CHUSER_ID",
+	1126: "GRP_AUTH",
+	1127: "SYSTEM_BOOT",
+	1128: "SYSTEM_SHUTDOWN",
+	1129: "SYSTEM_RUNLEVEL",
+	1130: "SERVICE_START",
+	1131: "SERVICE_STOP",
+	1132: "GRP_MGMT",
+	1133: "GRP_CHAUTHTOK",
+	1200: "DAEMON_START",
+	1201: "DAEMON_END",
+	1202: "DAEMON_ABORT",
+	1203: "DAEMON_CONFIG",
+	1204: "DAEMON_RECONFIG",
+	1205: "DAEMON_ROTATE",
+	1206: "DAEMON_RESUME",
+	1207: "DAEMON_ACCEPT",
+	1208: "DAEMON_CLOSE",
+	1209: "DAEMON_ERR",
+	1300: "SYSCALL",
+	1302: "PATH",
+	1303: "IPC",
+	1304: "SOCKETCALL",
+	1305: "CONFIG_CHANGE",
+	1306: "SOCKADDR",
+	1307: "CWD",
+	1309: "EXECVE",
+	1311: "IPC_SET_PERM",
+	1312: "MQ_OPEN",
+	1313: "MQ_SENDRECV",
+	1314: "MQ_NOTIFY",
+	1315: "MQ_GETSETATTR",
+	1316: "KERNEL_OTHER",
+	1317: "FD_PAIR",
+	1318: "OBJ_PID",
+	1319: "TTY",
+	1320: "EOE",
+	1321: "BPRM_FCAPS",
+	1322: "CAPSET",
+	1323: "MMAP",
+	1324: "NETFILTER_PKT",
+	1325: "NETFILTER_CFG",
+	1326: "SECCOMP",
+	1327: "PROCTITLE",
+	1328: "FEATURE_CHANGE",
+	1329: "REPLACE",
+	1330: "KERN_MODULE",
+	1331: "FANOTIFY",
+	1332: "TIME_INJOFFSET",
+	1333: "TIME_ADJNTPVAL",
+	1334: "BPF",
+	1335: "EVENT_LISTENER",
+	1336: "URINGOP",
+	1337: "OPENAT2",
+	1338: "DM_CTRL",
+	1339: "DM_EVENT",
+	1400: "AVC",
+	1401: "SELINUX_ERR",
+	1402: "AVC_PATH",
+	1403: "MAC_POLICY_LOAD",
+	1404: "MAC_STATUS",
+	1405: "MAC_CONFIG_CHANGE",
+	1406: "MAC_UNLBL_ALLOW",
+	1407: "MAC_CIPSOV4_ADD",
+	1408: "MAC_CIPSOV4_DEL",
+	1409: "MAC_MAP_ADD",
+	1410: "MAC_MAP_DEL",
+	1411: "MAC_IPSEC_ADDSA",
+	1412: "MAC_IPSEC_DELSA",
+	1413: "MAC_IPSEC_ADDSPD",
+	1414: "MAC_IPSEC_DELSPD",
+	1415: "MAC_IPSEC_EVENT",
+	1416: "MAC_UNLBL_STCADD",
+	1417: "MAC_UNLBL_STCDEL",
+	1418: "MAC_CALIPSO_ADD",
+	1419: "MAC_CALIPSO_DEL",
+	1700: "ANOM_PROMISCUOUS",
+	1701: "ANOM_ABEND",
+	1702: "ANOM_LINK",
+	1703: "ANOM_CREAT",
+	2000: "KERNEL",
+	2100: "ANOM_LOGIN_FAILURES",
+	2101: "ANOM_LOGIN_TIME",
+	2102: "ANOM_LOGIN_SESSIONS",
+	2103: "ANOM_LOGIN_ACCT",
+	2104: "ANOM_LOGIN_LOCATION",
+	2105: "ANOM_MAX_DAC",
+	2106: "ANOM_MAX_MAC",
+	2107: "ANOM_AMTU_FAIL",
+	2108: "ANOM_RBAC_FAIL",
+	2109: "ANOM_RBAC_INTEGRITY_FAIL",
+	2110: "ANOM_CRYPTO_FAIL",
+	2111: "ANOM_ACCESS_FS",
+	2112: "ANOM_EXEC",
+	2113: "ANOM_MK_EXEC",
+	2114: "ANOM_ADD_ACCT",
+	2115: "ANOM_DEL_ACCT",
+	2116: "ANOM_MOD_ACCT",
+	2117: "ANOM_ROOT_TRANS",
+	2118: "ANOM_LOGIN_SERVICE",
+	2200: "RESP_ANOMALY",
+	2300: "USER_ROLE_CHANGE",
+	2404: "CRYPTO_KEY_USER",
+	2407: "CRYPTO_SESSION",
+	2500: "VIRT_CONTROL",
+	2501: "VIRT_RESOURCE",
+	2502: "VIRT_MACHINE_ID",
+}
+
+// recordNumbers are the numbers of the record types of recordTypes.
+var recordNumbers = func() map[string]int {
+	numbers := make(map[string]int, len(recordTypes))
+	for number, name := range recordTypes {
+		numbers[name] = number
+	}
+	return numbers
+}()
+
+// isLastRecord returns whether a record of the type is the last one of its
+// event, following auparse: the proctitle record ends the records of a
+// syscall, and the user space and some kernel records are standalone.
+func isLastRecord(number int) bool {
+	switch {
+	case number == 1327 || number == 1320: // PROCTITLE, EOE
+		return true
+	case number == 1005 || number == 2000: // USER, KERNEL
+		return true
+	case number > 1006 && number < 1300: // user space and daemon messages
+		return true
+	case number >= 1406 && number <= 1419: // MAC_UNLBL_ALLOW to MAC_CALIPSO_DEL
+		return true
+	case number >= 2100 && number < 3000: // user space anomalies, responses and events
+		return true
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/cef"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "cef_parser"
+
+	cefPrefix   = "CEF:"
+	headerCount = 7
+	labelSuffix = "Label"
+)
+
+// headerFields are the keys of the fields of the CEF header, in order.
+var headerFields = [headerCount]string{
+	"cef_version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"device_event_class_id",
+	"name",
+	"severity",
+}
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new CEF parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new CEF parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a CEF parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+
+	// FullKeyNames replaces the keys of the extension fields by their full name
+	// in the CEF dictionary, e.g. src by sourceAddress.
+	FullKeyNames bool `mapstructure:"full_key_names"`
+
+	// VendorDictionaries renames the keys of the extension fields of the events
+	// of each device vendor.
+	VendorDictionaries map[string]map[string]string `mapstructure:"vendor_dictionaries"`
+}
+
+// Build will build a CEF parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator:     parserOperator,
+		fullKeyNames:       c.FullKeyNames,
+		vendorDictionaries: c.VendorDictionaries,
+	}, nil
+}
+
+// Parser is an operator that parses CEF messages.
+type Parser struct {
+	helper.ParserOperator
+	fullKeyNames       bool
+	vendorDictionaries map[string]map[string]string
+}
+
+// Process will parse an entry for a CEF message.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as a CEF message.
+func (p *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return p.parser(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as CEF", value)
+	}
+}
+
+// parser parses a CEF message, which can be preceded by a syslog header:
+// CEF:Version|Device Vendor|Device Product|Device Version|Device Event Class ID|Name|Severity|Extension
+func (p *Parser) parser(input string) (map[string]interface{}, error) {
+	start := strings.Index(input, cefPrefix)
+	if start < 0 {
+		return nil, fmt.Errorf("parse from field %s is not a CEF message", p.ParseFrom.String())
+	}
+	message := input[start+len(cefPrefix):]
+
+	parsed := make(map[string]interface{})
+	for i := 0; i < headerCount; i++ {
+		end := indexUnescapedPipe(message)
+		if end < 0 {
+			return nil, fmt.Errorf("expected %d header fields in CEF message, got %d", headerCount, i)
+		}
+		parsed[headerFields[i]] = unescapeHeader(message[:end])
+		message = message[end+1:]
+	}
+
+	extension, err := parseExtension(message)
+	if err != nil {
+		return nil, err
+	}
+	vendor, _ := parsed["device_vendor"].(string)
+	for k, v := range p.renameKeys(vendor, extension) {
+		parsed[k] = v
+	}
+	return parsed, nil
+}
+
+// renameKeys names the custom fields after their label, then applies the dictionaries.
+func (p *Parser) renameKeys(vendor string, extension map[string]string) map[string]string {
+	labels := make(map[string]string)
+	for k, label := range extension {
+		base := strings.TrimSuffix(k, labelSuffix)
+		if base == k || label == "" {
+			continue
+		}
+		if _, ok := extension[base]; ok {
+			labels[base] = label
+		}
+	}
+	for base, label := range labels {
+		v := extension[base]
+		delete(extension, base)
+		delete(extension, base+labelSuffix)
+		extension[label] = v
+	}
+
+	renamed := make(map[string]string, len(extension))
+	dictionary := p.vendorDictionaries[vendor]
+	for k, v := range extension {
+		if name, ok := dictionary[k]; ok {
+			k = name
+		} else if name, ok := standardDictionary[k]; ok && p.fullKeyNames {
+			k = name
+		}
+		renamed[k] = v
+	}
+	return renamed
+}
+
+// parseExtension parses the space separated key=value pairs of the extension.
+// The values can contain spaces, and escaped equal signs.
+func parseExtension(extension string) (map[string]string, error) {
+	type pair struct {
+		keyStart int
+		equal    int
+	}
+	var pairs []pair
+	for i := 0; i < len(extension); i++ {
+		switch extension[i] {
+		case '\\':
+			i++
+		case '=':
+			keyStart := strings.LastIndexByte(extension[:i], ' ') + 1
+			if len(pairs) > 0 && keyStart <= pairs[len(pairs)-1].equal {
+				// An unescaped equal sign in a value
+				continue
+			}
+			if keyStart == i {
+				return nil, fmt.Errorf("empty key in CEF extension at position %d", i)
+			}
+			pairs = append(pairs, pair{keyStart: keyStart, equal: i})
+		}
+	}
+
+	if len(pairs) == 0 {
+		if strings.TrimSpace(extension) != "" {
+			return nil, fmt.Errorf("invalid CEF extension: %s", extension)
+		}
+		return map[string]string{}, nil
+	}
+	if strings.TrimSpace(extension[:pairs[0].keyStart]) != "" {
+		return nil, fmt.Errorf("invalid CEF extension: %s", extension)
+	}
+
+	parsed := make(map[string]string, len(pairs))
+	for i, kv := range pairs {
+		end := len(extension)
+		if i+1 < len(pairs) {
+			end = pairs[i+1].keyStart
+		}
+		value := strings.TrimRight(extension[kv.equal+1:end], " ")
+		parsed[extension[kv.keyStart:kv.equal]] = unescapeExtension(value)
+	}
+	return parsed, nil
+}
+
+func indexUnescapedPipe(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '|':
+			return i
+		}
+	}
+	return -1
+}
+
+var (
+	headerUnescaper    = strings.NewReplacer(`\\`, `\`, `\|`, `|`)
+	extensionUnescaper = strings.NewReplacer(`\\`, `\`, `\=`, `=`, `\n`, "\n", `\r`, "\r")
+)
+
+func unescapeHeader(s string) string {
+	return headerUnescaper.Replace(s)
+}
+
+func unescapeExtension(s string) string {
+	return extensionUnescaper.Replace(s)
+}
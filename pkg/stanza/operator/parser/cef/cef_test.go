@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cef
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("cef_parser")
+	require.True(t, ok, "expected cef_parser to be registered")
+	require.Equal(t, "cef_parser", builder().Type())
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	_, err := config.Build(testutil.Logger(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as CEF")
+}
+
+func TestParserErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  string
+		errMsg string
+	}{
+		{"not-cef", "name=stanza", "is not a CEF message"},
+		{"missing-header-fields", "CEF:0|Vendor|Product|1.0|100", "expected 7 header fields in CEF message, got 4"},
+		{"text-before-extension", "CEF:0|Vendor|Product|1.0|100|Name|5|garbage src=10.0.0.1", "invalid CEF extension"},
+		{"no-pairs", "CEF:0|Vendor|Product|1.0|100|Name|5|garbage", "invalid CEF extension"},
+		{"empty-key", "CEF:0|Vendor|Product|1.0|100|Name|5|src=10.0.0.1 =value", "empty key in CEF extension"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTestParser(t).parse(tc.input)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cases := []struct {
+		name      string
+		configure func(*Config)
+		input     string
+		expect    map[string]interface{}
+	}{
+		{
+			"header-only",
+			func(*Config) {},
+			"CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|",
+			map[string]interface{}{
+				"cef_version":           "0",
+				"device_vendor":         "Security",
+				"device_product":        "threatmanager",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "worm successfully stopped",
+				"severity":              "10",
+			},
+		},
+		{
+			"syslog-prefix-and-extension",
+			func(*Config) {},
+			`Sep 19 08:26:10 host CEF:0|Security|threatmanager|1.0|100|detected a \| in message|10|src=10.0.0.1 msg=Detected a threat. No action needed act=blocked a \= b`,
+			map[string]interface{}{
+				"cef_version":           "0",
+				"device_vendor":         "Security",
+				"device_product":        "threatmanager",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "detected a | in message",
+				"severity":              "10",
+				"src":                   "10.0.0.1",
+				"msg":                   "Detected a threat. No action needed",
+				"act":                   "blocked a = b",
+			},
+		},
+		{
+			"escaped-and-unescaped-values",
+			func(*Config) {},
+			`CEF:0|Vendor|Product|1.0|100|Name|5|filePath=C:\\Windows\\System32 msg=line\nbreak request=https://example.com/?a=b`,
+			map[string]interface{}{
+				"cef_version":           "0",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "Name",
+				"severity":              "5",
+				"filePath":              `C:\Windows\System32`,
+				"msg":                   "line\nbreak",
+				"request":               "https://example.com/?a=b",
+			},
+		},
+		{
+			"custom-labels-and-full-key-names",
+			func(c *Config) {
+				c.FullKeyNames = true
+			},
+			"CEF:0|Vendor|Product|1.0|100|Name|5|src=10.0.0.1 cs1=allow-web cs1Label=Rule Name cn1=3",
+			map[string]interface{}{
+				"cef_version":           "0",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "Name",
+				"severity":              "5",
+				"sourceAddress":         "10.0.0.1",
+				"Rule Name":             "allow-web",
+				"deviceCustomNumber1":   "3",
+			},
+		},
+		{
+			"vendor-dictionary",
+			func(c *Config) {
+				c.FullKeyNames = true
+				c.VendorDictionaries = map[string]map[string]string{
+					"Palo Alto Networks": {"PanOSDeviceSN": "device_serial_number", "src": "source_ip"},
+					"Other":              {"dst": "other_destination"},
+				}
+			},
+			"CEF:0|Palo Alto Networks|PAN-OS|10.1|TRAFFIC|end|3|src=10.0.0.1 dst=10.0.0.2 PanOSDeviceSN=0123",
+			map[string]interface{}{
+				"cef_version":           "0",
+				"device_vendor":         "Palo Alto Networks",
+				"device_product":        "PAN-OS",
+				"device_version":        "10.1",
+				"device_event_class_id": "TRAFFIC",
+				"name":                  "end",
+				"severity":              "3",
+				"source_ip":             "10.0.0.1",
+				"destinationAddress":    "10.0.0.2",
+				"device_serial_number":  "0123",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.OutputIDs = []string{"fake"}
+			tc.configure(cfg)
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			ots := time.Now()
+			input := &entry.Entry{Body: tc.input, ObservedTimestamp: ots}
+			expect := &entry.Entry{Body: tc.input, Attributes: tc.expect, ObservedTimestamp: ots}
+
+			require.NoError(t, op.Process(context.Background(), input))
+			fake.ExpectEntry(t, expect)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/cef"
+
+// standardDictionary maps the keys of the extension fields defined by the CEF
+// specification to their full name.
+var standardDictionary = map[string]string{
+	"act":     "deviceAction",
+	"app":     "applicationProtocol",
+	"c6a1":    "deviceCustomIPv6Address1",
+	"c6a2":    "deviceCustomIPv6Address2",
+	"c6a3":    "deviceCustomIPv6Address3",
+	"c6a4":    "deviceCustomIPv6Address4",
+	"cat":     "deviceEventCategory",
+	"cfp1":    "deviceCustomFloatingPoint1",
+	"cfp2":    "deviceCustomFloatingPoint2",
+	"cfp3":    "deviceCustomFloatingPoint3",
+	"cfp4":    "deviceCustomFloatingPoint4",
+	"cn1":     "deviceCustomNumber1",
+	"cn2":     "deviceCustomNumber2",
+	"cn3":     "deviceCustomNumber3",
+	"cnt":     "baseEventCount",
+	"cs1":     "deviceCustomString1",
+	"cs2":     "deviceCustomString2",
+	"cs3":     "deviceCustomString3",
+	"cs4":     "deviceCustomString4",
+	"cs5":     "deviceCustomString5",
+	"cs6":     "deviceCustomString6",
+	"dhost":   "destinationHostName",
+	"dlat":    "destinationGeoLatitude",
+	"dlong":   "destinationGeoLongitude",
+	"dmac":    "destinationMacAddress",
+	"dntdom":  "destinationNtDomain",
+	"dpid":    "destinationProcessId",
+	"dpriv":   "destinationUserPrivileges",
+	"dproc":   "destinationProcessName",
+	"dpt":     "destinationPort",
+	"dst":     "destinationAddress",
+	"dtz":     "deviceTimeZone",
+	"duid":    "destinationUserId",
+	"duser":   "destinationUserName",
+	"dvc":     "deviceAddress",
+	"dvchost": "deviceHostName",
+	"dvcmac":  "deviceMacAddress",
+	"dvcpid":  "deviceProcessId",
+	"end":     "endTime",
+	"fname":   "fileName",
+	"fsize":   "fileSize",
+	"in":      "bytesIn",
+	"msg":     "message",
+	"out":     "bytesOut",
+	"outcome": "eventOutcome",
+	"proto":   "transportProtocol",
+	"request": "requestUrl",
+	"rt":      "deviceReceiptTime",
+	"shost":   "sourceHostName",
+	"slat":    "sourceGeoLatitude",
+	"slong":   "sourceGeoLongitude",
+	"smac":    "sourceMacAddress",
+	"sntdom":  "sourceNtDomain",
+	"spid":    "sourceProcessId",
+	"spriv":   "sourceUserPrivileges",
+	"sproc":   "sourceProcessName",
+	"spt":     "sourcePort",
+	"src":     "sourceAddress",
+	"start":   "startTime",
+	"suid":    "sourceUserId",
+	"suser":   "sourceUserName",
+}
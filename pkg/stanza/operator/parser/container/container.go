@@ -0,0 +1,363 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package container // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/container"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "container"
+
+	// Formats of the log files written by the container runtimes.
+	autoFormat       = "auto"
+	dockerFormat     = "docker"
+	crioFormat       = "crio"
+	containerdFormat = "containerd"
+
+	streamAttribute = "log.iostream"
+	logTagAttribute = "logtag"
+
+	logTagPartial = "P"
+	logTagFull    = "F"
+
+	filePathAttribute = "log.file.path"
+	fileNameAttribute = "log.file.name"
+)
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new container parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new container parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		TransformerConfig: helper.NewTransformerConfig(operatorID, operatorType),
+		ParseFrom:         entry.NewBodyField(),
+		Format:            autoFormat,
+		ForceFlushPeriod:  5 * time.Second,
+	}
+}
+
+// Config is the configuration of a container parser operator.
+type Config struct {
+	helper.TransformerConfig `mapstructure:",squash"`
+
+	// ParseFrom is the field containing the log line written by the container runtime.
+	ParseFrom entry.Field `mapstructure:"parse_from"`
+
+	// Format is the format of the log lines, either auto, docker, crio or containerd.
+	// The format of each line is detected when set to auto.
+	Format string `mapstructure:"format"`
+
+	// ForceFlushPeriod is how long the partial lines of a log are kept waiting
+	// for the rest of the log before being sent as is.
+	ForceFlushPeriod time.Duration `mapstructure:"force_flush_period"`
+
+	// MaxLogSize is the maximum size of a reassembled log, 0 means no limit.
+	MaxLogSize helper.ByteSize `mapstructure:"max_log_size,omitempty"`
+}
+
+// Build will build a container parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	transformer, err := c.TransformerConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.Format {
+	case autoFormat, dockerFormat, crioFormat, containerdFormat:
+	default:
+		return nil, fmt.Errorf("invalid format '%s', must be one of auto, docker, crio or containerd", c.Format)
+	}
+
+	if c.ForceFlushPeriod <= 0 {
+		return nil, fmt.Errorf("force_flush_period must be positive")
+	}
+
+	return &Parser{
+		TransformerOperator: transformer,
+		parseFrom:           c.ParseFrom,
+		format:              c.Format,
+		forceFlushPeriod:    c.ForceFlushPeriod,
+		maxLogSize:          int(c.MaxLogSize),
+		pending:             map[string]*partialLog{},
+		done:                make(chan struct{}),
+	}, nil
+}
+
+// Parser is an operator that parses the log lines written by container runtimes,
+// and reassembles the logs split across several partial lines.
+type Parser struct {
+	helper.TransformerOperator
+	parseFrom        entry.Field
+	format           string
+	forceFlushPeriod time.Duration
+	maxLogSize       int
+
+	mu       sync.Mutex
+	pending  map[string]*partialLog
+	done     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// partialLog is a log whose partial lines have been read, but not its last line.
+type partialLog struct {
+	base  *entry.Entry
+	body  strings.Builder
+	since time.Time
+}
+
+// dockerLine is a line of the json-file logging driver of docker.
+type dockerLine struct {
+	Log    *string `json:"log"`
+	Stream string  `json:"stream"`
+	Time   string  `json:"time"`
+}
+
+func (p *Parser) Start(_ operator.Persister) error {
+	p.wg.Add(1)
+	go p.flushLoop()
+	return nil
+}
+
+func (p *Parser) Stop() error {
+	p.stopOnce.Do(func() { close(p.done) })
+	p.wg.Wait()
+
+	// send the partial logs rather than dropping them
+	p.mu.Lock()
+	flushed := p.flush(func(*partialLog) bool { return true })
+	p.mu.Unlock()
+	for _, e := range flushed {
+		p.Write(context.Background(), e)
+	}
+	return nil
+}
+
+func (p *Parser) flushLoop() {
+	defer p.wg.Done()
+	// check every 1/5 force_flush_period
+	ticker := time.NewTicker(p.forceFlushPeriod / 5)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			p.mu.Lock()
+			flushed := p.flush(func(pl *partialLog) bool { return now.Sub(pl.since) >= p.forceFlushPeriod })
+			p.mu.Unlock()
+			for _, e := range flushed {
+				p.Write(context.Background(), e)
+			}
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// flush removes the partial logs matching the filter and returns their entries.
+// It must be called with the lock held.
+func (p *Parser) flush(filter func(*partialLog) bool) []*entry.Entry {
+	var flushed []*entry.Entry
+	for source, pl := range p.pending {
+		if !filter(pl) {
+			continue
+		}
+		pl.base.Body = pl.body.String()
+		flushed = append(flushed, pl.base)
+		delete(p.pending, source)
+	}
+	return flushed
+}
+
+// Process will parse an entry and send it once all the lines of its log have been read.
+func (p *Parser) Process(ctx context.Context, e *entry.Entry) error {
+	skip, err := p.Skip(ctx, e)
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+	if skip {
+		p.Write(ctx, e)
+		return nil
+	}
+
+	message, partial, err := p.parse(e)
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+
+	if out := p.reassemble(e, message, partial); out != nil {
+		p.Write(ctx, out)
+	}
+	return nil
+}
+
+// parse parses the line of the entry, sets the timestamp and the stream of the entry,
+// and returns its message and whether the line is only a part of the log.
+func (p *Parser) parse(e *entry.Entry) (string, bool, error) {
+	value, ok := e.Get(p.parseFrom)
+	if !ok {
+		return "", false, fmt.Errorf("entry is missing the expected parse_from field")
+	}
+	line, ok := value.(string)
+	if !ok {
+		return "", false, fmt.Errorf("type '%T' cannot be parsed as a container log line", value)
+	}
+
+	format := p.format
+	if format == autoFormat {
+		format = detectFormat(line)
+	}
+
+	var (
+		message, stream, tag string
+		ts                   time.Time
+		err                  error
+	)
+	if format == dockerFormat {
+		message, stream, tag, ts, err = parseDocker(line)
+	} else {
+		message, stream, tag, ts, err = parseCRI(line)
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	p.parseFrom.Delete(e)
+	e.Timestamp = ts
+	if err := e.Set(entry.NewAttributeField(streamAttribute), stream); err != nil {
+		return "", false, err
+	}
+	if err := e.Set(entry.NewAttributeField(logTagAttribute), tag); err != nil {
+		return "", false, err
+	}
+	return message, tag == logTagPartial, nil
+}
+
+// detectFormat detects the format of a line. The lines written by cri-o and containerd
+// share the same format, so a line which isn't written by docker is parsed as containerd.
+func detectFormat(line string) string {
+	if strings.HasPrefix(line, "{") {
+		return dockerFormat
+	}
+	return containerdFormat
+}
+
+// parseDocker parses a line of the docker json-file logging driver:
+// {"log":"message\n","stream":"stdout","time":"2023-06-01T12:00:00.000000000Z"}
+// The lines of the logs split by docker, because they are longer than 16KiB,
+// don't end with a newline.
+func parseDocker(line string) (string, string, string, time.Time, error) {
+	var parsed dockerLine
+	if err := json.Unmarshal([]byte(line), &parsed); err != nil {
+		return "", "", "", time.Time{}, fmt.Errorf("parse docker log line: %w", err)
+	}
+	if parsed.Log == nil {
+		return "", "", "", time.Time{}, fmt.Errorf("parse docker log line: missing log field")
+	}
+	ts, err := time.Parse(time.RFC3339Nano, parsed.Time)
+	if err != nil {
+		return "", "", "", time.Time{}, fmt.Errorf("parse docker log line time: %w", err)
+	}
+
+	message := *parsed.Log
+	tag := logTagPartial
+	if strings.HasSuffix(message, "\n") {
+		message = strings.TrimSuffix(message, "\n")
+		tag = logTagFull
+	}
+	return message, parsed.Stream, tag, ts, nil
+}
+
+// parseCRI parses a line in the CRI logging format used by cri-o and containerd:
+// 2023-06-01T12:00:00.000000000Z stdout F message
+// The tag is P for the partial lines of a log, and F for its last line.
+func parseCRI(line string) (string, string, string, time.Time, error) {
+	parts := strings.SplitN(line, " ", 4)
+	if len(parts) < 3 {
+		return "", "", "", time.Time{}, fmt.Errorf("parse CRI log line: expected a time, a stream and a tag")
+	}
+	ts, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return "", "", "", time.Time{}, fmt.Errorf("parse CRI log line time: %w", err)
+	}
+	stream := parts[1]
+	if stream != "stdout" && stream != "stderr" {
+		return "", "", "", time.Time{}, fmt.Errorf("parse CRI log line: invalid stream '%s'", stream)
+	}
+	// the tag may hold other flags separated by colons after the partial flag
+	tag := strings.SplitN(parts[2], ":", 2)[0]
+	if tag != logTagPartial && tag != logTagFull {
+		return "", "", "", time.Time{}, fmt.Errorf("parse CRI log line: invalid tag '%s'", parts[2])
+	}
+	var message string
+	if len(parts) == 4 {
+		message = parts[3]
+	}
+	return message, stream, tag, ts, nil
+}
+
+// reassemble adds the message of a line to the partial log of its source,
+// and returns the entry to send if the log is complete.
+// The reassembled log keeps the timestamp and the attributes of its first line.
+func (p *Parser) reassemble(e *entry.Entry, message string, partial bool) *entry.Entry {
+	source := sourceOf(e)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pl, ok := p.pending[source]
+	if !ok {
+		if !partial {
+			e.Body = message
+			return e
+		}
+		pl = &partialLog{base: e, since: time.Now()}
+		p.pending[source] = pl
+	}
+	pl.body.WriteString(message)
+
+	if !partial {
+		pl.base.Attributes[logTagAttribute] = logTagFull
+	} else if p.maxLogSize <= 0 || pl.body.Len() < p.maxLogSize {
+		return nil
+	}
+
+	// the log is complete, or too large to wait for the rest of it
+	delete(p.pending, source)
+	pl.base.Body = pl.body.String()
+	return pl.base
+}
+
+// sourceOf returns the file the entry was read from along with its stream, so that
+// the partial lines of the logs of different containers, or of the stdout and stderr
+// of a container, aren't mixed.
+func sourceOf(e *entry.Entry) string {
+	stream, _ := e.Attributes[streamAttribute].(string)
+	if path, ok := e.Attributes[filePathAttribute].(string); ok {
+		return path + "\x00" + stream
+	}
+	if name, ok := e.Attributes[fileNameAttribute].(string); ok {
+		return name + "\x00" + stream
+	}
+	return "\x00" + stream
+}
@@ -0,0 +1,291 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T, configure func(*Config)) (operator.Operator, *testutil.FakeOutput) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	configure(cfg)
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+	require.NoError(t, op.Start(testutil.NewUnscopedMockPersister()))
+	t.Cleanup(func() { require.NoError(t, op.Stop()) })
+	return op, fake
+}
+
+func newEntry(file string, line string) *entry.Entry {
+	e := entry.New()
+	e.Body = line
+	e.Attributes = map[string]interface{}{filePathAttribute: file}
+	return e
+}
+
+func TestBuildErrors(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Format = "podman"
+	_, err := cfg.Build(testutil.Logger(t))
+	require.ErrorContains(t, err, "invalid format 'podman'")
+
+	cfg = NewConfig()
+	cfg.ForceFlushPeriod = 0
+	_, err = cfg.Build(testutil.Logger(t))
+	require.ErrorContains(t, err, "force_flush_period must be positive")
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name      string
+		configure func(*Config)
+		line      string
+		body      string
+		stream    string
+		tag       string
+		timestamp time.Time
+	}{
+		{
+			"docker",
+			func(*Config) {},
+			`{"log":"INFO server started\n","stream":"stdout","time":"2023-06-01T12:00:00.123456789Z"}`,
+			"INFO server started",
+			"stdout",
+			"F",
+			time.Date(2023, time.June, 1, 12, 0, 0, 123456789, time.UTC),
+		},
+		{
+			"crio",
+			func(*Config) {},
+			"2023-06-01T12:00:00.123456789+02:00 stderr F ERROR connection refused",
+			"ERROR connection refused",
+			"stderr",
+			"F",
+			time.Date(2023, time.June, 1, 10, 0, 0, 123456789, time.UTC),
+		},
+		{
+			"containerd",
+			func(*Config) {},
+			"2023-06-01T12:00:00.123456789Z stdout F INFO  two  spaces",
+			"INFO  two  spaces",
+			"stdout",
+			"F",
+			time.Date(2023, time.June, 1, 12, 0, 0, 123456789, time.UTC),
+		},
+		{
+			"containerd-empty-message",
+			func(*Config) {},
+			"2023-06-01T12:00:00Z stdout F",
+			"",
+			"stdout",
+			"F",
+			time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			"explicit-format",
+			func(cfg *Config) { cfg.Format = crioFormat },
+			"2023-06-01T12:00:00Z stdout F {\"level\":\"info\"}",
+			`{"level":"info"}`,
+			"stdout",
+			"F",
+			time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			op, fake := newTestParser(t, tc.configure)
+
+			require.NoError(t, op.Process(context.Background(), newEntry("/var/log/pods/app.log", tc.line)))
+
+			select {
+			case e := <-fake.Received:
+				require.Equal(t, tc.body, e.Body)
+				require.Equal(t, tc.stream, e.Attributes[streamAttribute])
+				require.Equal(t, tc.tag, e.Attributes[logTagAttribute])
+				require.Equal(t, "/var/log/pods/app.log", e.Attributes[filePathAttribute])
+				require.True(t, tc.timestamp.Equal(e.Timestamp), "unexpected timestamp %s", e.Timestamp)
+			case <-time.After(time.Second):
+				require.FailNow(t, "timed out waiting for entry")
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		name      string
+		configure func(*Config)
+		body      interface{}
+		err       string
+	}{
+		{"not-a-string", func(*Config) {}, 42, "type 'int' cannot be parsed as a container log line"},
+		{"invalid-json", func(*Config) {}, `{"log":`, "parse docker log line"},
+		{"docker-missing-log", func(*Config) {}, `{"stream":"stdout","time":"2023-06-01T12:00:00Z"}`, "missing log field"},
+		{"docker-invalid-time", func(*Config) {}, `{"log":"a\n","stream":"stdout","time":"yesterday"}`, "parse docker log line time"},
+		{"cri-too-short", func(*Config) {}, "2023-06-01T12:00:00Z stdout", "expected a time, a stream and a tag"},
+		{"cri-invalid-time", func(*Config) {}, "yesterday stdout F message", "parse CRI log line time"},
+		{"cri-invalid-stream", func(*Config) {}, "2023-06-01T12:00:00Z stdin F message", "invalid stream 'stdin'"},
+		{"cri-invalid-tag", func(*Config) {}, "2023-06-01T12:00:00Z stdout X message", "invalid tag 'X'"},
+		{"docker-format-cri-line", func(cfg *Config) { cfg.Format = dockerFormat }, "2023-06-01T12:00:00Z stdout F message", "parse docker log line"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			op, fake := newTestParser(t, tc.configure)
+
+			e := entry.New()
+			e.Body = tc.body
+			err := op.Process(context.Background(), e)
+			require.ErrorContains(t, err, tc.err)
+
+			// the entry is sent unchanged with on_error: send
+			fake.ExpectBody(t, tc.body)
+		})
+	}
+}
+
+func TestReassemblePartialLines(t *testing.T) {
+	op, fake := newTestParser(t, func(*Config) {})
+	ctx := context.Background()
+
+	lines := []struct {
+		file string
+		line string
+	}{
+		{"a.log", "2023-06-01T12:00:00Z stdout P first "},
+		{"b.log", `{"log":"docker ","stream":"stderr","time":"2023-06-01T12:00:01Z"}`},
+		{"a.log", "2023-06-01T12:00:02Z stdout P second "},
+		{"b.log", `{"log":"partial\n","stream":"stderr","time":"2023-06-01T12:00:03Z"}`},
+		{"a.log", "2023-06-01T12:00:04Z stdout F third"},
+		{"a.log", "2023-06-01T12:00:05Z stdout F single"},
+	}
+	for _, l := range lines {
+		require.NoError(t, op.Process(ctx, newEntry(l.file, l.line)))
+	}
+
+	expected := []struct {
+		file      string
+		body      string
+		timestamp time.Time
+	}{
+		{"b.log", "docker partial", time.Date(2023, time.June, 1, 12, 0, 1, 0, time.UTC)},
+		{"a.log", "first second third", time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)},
+		{"a.log", "single", time.Date(2023, time.June, 1, 12, 0, 5, 0, time.UTC)},
+	}
+	for _, exp := range expected {
+		select {
+		case e := <-fake.Received:
+			require.Equal(t, exp.file, e.Attributes[filePathAttribute])
+			require.Equal(t, exp.body, e.Body)
+			require.Equal(t, logTagFull, e.Attributes[logTagAttribute])
+			require.True(t, exp.timestamp.Equal(e.Timestamp), "unexpected timestamp %s", e.Timestamp)
+		case <-time.After(time.Second):
+			require.FailNow(t, "timed out waiting for entry")
+		}
+	}
+	fake.ExpectNoEntry(t, 100*time.Millisecond)
+}
+
+func TestReassembleInterleavedStreams(t *testing.T) {
+	op, fake := newTestParser(t, func(*Config) {})
+	ctx := context.Background()
+
+	for _, line := range []string{
+		"2023-06-01T12:00:00Z stdout P out ",
+		"2023-06-01T12:00:01Z stderr P err ",
+		"2023-06-01T12:00:02Z stdout F done",
+		"2023-06-01T12:00:03Z stderr F failed",
+	} {
+		require.NoError(t, op.Process(ctx, newEntry("a.log", line)))
+	}
+
+	for _, exp := range []struct {
+		stream string
+		body   string
+	}{
+		{"stdout", "out done"},
+		{"stderr", "err failed"},
+	} {
+		select {
+		case e := <-fake.Received:
+			require.Equal(t, exp.stream, e.Attributes[streamAttribute])
+			require.Equal(t, exp.body, e.Body)
+		case <-time.After(time.Second):
+			require.FailNow(t, "timed out waiting for entry")
+		}
+	}
+	fake.ExpectNoEntry(t, 100*time.Millisecond)
+}
+
+func TestReassembleMaxLogSize(t *testing.T) {
+	op, fake := newTestParser(t, func(cfg *Config) { cfg.MaxLogSize = 10 })
+	ctx := context.Background()
+
+	require.NoError(t, op.Process(ctx, newEntry("a.log", "2023-06-01T12:00:00Z stdout P 12345")))
+	fake.ExpectNoEntry(t, 50*time.Millisecond)
+	require.NoError(t, op.Process(ctx, newEntry("a.log", "2023-06-01T12:00:01Z stdout P 67890")))
+	fake.ExpectBody(t, "1234567890")
+	require.NoError(t, op.Process(ctx, newEntry("a.log", "2023-06-01T12:00:02Z stdout F end")))
+	fake.ExpectBody(t, "end")
+}
+
+func TestReassembleForceFlush(t *testing.T) {
+	op, fake := newTestParser(t, func(cfg *Config) { cfg.ForceFlushPeriod = 100 * time.Millisecond })
+
+	require.NoError(t, op.Process(context.Background(), newEntry("a.log", "2023-06-01T12:00:00Z stdout P never ends")))
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, "never ends", e.Body)
+		require.Equal(t, logTagPartial, e.Attributes[logTagAttribute])
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for entry")
+	}
+}
+
+func TestStopFlushesPartialLines(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+	require.NoError(t, op.Start(testutil.NewUnscopedMockPersister()))
+
+	require.NoError(t, op.Process(context.Background(), newEntry("a.log", "2023-06-01T12:00:00Z stdout P pending")))
+	fake.ExpectNoEntry(t, 50*time.Millisecond)
+	require.NoError(t, op.Stop())
+	fake.ExpectBody(t, "pending")
+	// stopping again is a no-op
+	require.NoError(t, op.Stop())
+	fake.ExpectNoEntry(t, 50*time.Millisecond)
+}
+
+func TestParseFromAttribute(t *testing.T) {
+	op, fake := newTestParser(t, func(cfg *Config) { cfg.ParseFrom = entry.NewAttributeField("line") })
+
+	e := entry.New()
+	e.Attributes = map[string]interface{}{"line": "2023-06-01T12:00:00Z stdout F message"}
+	require.NoError(t, op.Process(context.Background(), e))
+
+	select {
+	case out := <-fake.Received:
+		require.Equal(t, "message", out.Body)
+		require.NotContains(t, out.Attributes, "line")
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for entry")
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/leef"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "leef_parser"
+
+	leefPrefix       = "LEEF:"
+	defaultDelimiter = "\t"
+)
+
+// headerFields are the keys of the fields of the LEEF header, in order.
+var headerFields = []string{
+	"leef_version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"event_id",
+}
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new LEEF parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new LEEF parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a LEEF parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+
+	// VendorDictionaries renames the keys of the event attributes of the events
+	// of each device vendor.
+	VendorDictionaries map[string]map[string]string `mapstructure:"vendor_dictionaries"`
+}
+
+// Build will build a LEEF parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator:     parserOperator,
+		vendorDictionaries: c.VendorDictionaries,
+	}, nil
+}
+
+// Parser is an operator that parses LEEF messages.
+type Parser struct {
+	helper.ParserOperator
+	vendorDictionaries map[string]map[string]string
+}
+
+// Process will parse an entry for a LEEF message.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as a LEEF message.
+func (p *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return p.parser(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as LEEF", value)
+	}
+}
+
+// parser parses a LEEF message, which can be preceded by a syslog header:
+// LEEF:1.0|Vendor|Product|Version|EventID|Attributes
+// LEEF:2.0|Vendor|Product|Version|EventID|Delimiter|Attributes
+func (p *Parser) parser(input string) (map[string]interface{}, error) {
+	start := strings.Index(input, leefPrefix)
+	if start < 0 {
+		return nil, fmt.Errorf("parse from field %s is not a LEEF message", p.ParseFrom.String())
+	}
+
+	fields := strings.SplitN(input[start+len(leefPrefix):], "|", len(headerFields)+1)
+	if len(fields) != len(headerFields)+1 {
+		return nil, fmt.Errorf("expected %d header fields in LEEF message, got %d", len(headerFields), len(fields)-1)
+	}
+	parsed := make(map[string]interface{})
+	for i, key := range headerFields {
+		parsed[key] = fields[i]
+	}
+
+	attributes := fields[len(headerFields)]
+	delimiter := defaultDelimiter
+	if !strings.HasPrefix(fields[0], "1.") {
+		// The delimiter field is only defined for LEEF 2.0
+		var delimiterField string
+		var found bool
+		delimiterField, attributes, found = strings.Cut(attributes, "|")
+		if !found {
+			return nil, fmt.Errorf("missing delimiter field in LEEF %s message", fields[0])
+		}
+		if delimiterField != "" {
+			var err error
+			if delimiter, err = parseDelimiter(delimiterField); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	dictionary := p.vendorDictionaries[fields[1]]
+	for _, attribute := range strings.Split(attributes, delimiter) {
+		if strings.TrimSpace(attribute) == "" {
+			continue
+		}
+		k, v, found := strings.Cut(attribute, "=")
+		if !found || k == "" {
+			return nil, fmt.Errorf("expected '%s' to be a key=value LEEF attribute", attribute)
+		}
+		if name, ok := dictionary[k]; ok {
+			k = name
+		}
+		parsed[k] = v
+	}
+	return parsed, nil
+}
+
+// parseDelimiter parses the delimiter of a LEEF 2.0 message, which is either a
+// single character or its hexadecimal code, e.g. ^ or x5E or 0x5E.
+func parseDelimiter(field string) (string, error) {
+	if len(field) == 1 {
+		return field, nil
+	}
+	hex := strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(field), "0"), "x")
+	code, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || len(hex) == len(field) {
+		return "", fmt.Errorf("invalid LEEF delimiter: %s", field)
+	}
+	return string(rune(code)), nil
+}
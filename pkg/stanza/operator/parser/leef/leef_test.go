@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leef
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("leef_parser")
+	require.True(t, ok, "expected leef_parser to be registered")
+	require.Equal(t, "leef_parser", builder().Type())
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as LEEF")
+}
+
+func TestParserErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  string
+		errMsg string
+	}{
+		{"not-leef", "name=stanza", "is not a LEEF message"},
+		{"missing-header-fields", "LEEF:1.0|Vendor|Product|1.0", "expected 5 header fields in LEEF message, got 3"},
+		{"missing-delimiter", "LEEF:2.0|Vendor|Product|1.0|100|src=10.0.0.1", "missing delimiter field in LEEF 2.0 message"},
+		{"invalid-delimiter", "LEEF:2.0|Vendor|Product|1.0|100|ab|src=10.0.0.1", "invalid LEEF delimiter: ab"},
+		{"invalid-attribute", "LEEF:1.0|Vendor|Product|1.0|100|src=10.0.0.1\tgarbage", "expected 'garbage' to be a key=value LEEF attribute"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTestParser(t).parse(tc.input)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	header := func(version string) map[string]interface{} {
+		return map[string]interface{}{
+			"leef_version":   version,
+			"device_vendor":  "IBM",
+			"device_product": "QRadar",
+			"device_version": "7.5",
+			"event_id":       "Login",
+		}
+	}
+	with := func(m map[string]interface{}, kvs ...string) map[string]interface{} {
+		for i := 0; i < len(kvs); i += 2 {
+			m[kvs[i]] = kvs[i+1]
+		}
+		return m
+	}
+
+	cases := []struct {
+		name      string
+		configure func(*Config)
+		input     string
+		expect    map[string]interface{}
+	}{
+		{
+			"leef-1",
+			func(*Config) {},
+			"LEEF:1.0|IBM|QRadar|7.5|Login|src=10.0.0.1\tusrName=admin\tmsg=a=b",
+			with(header("1.0"), "src", "10.0.0.1", "usrName", "admin", "msg", "a=b"),
+		},
+		{
+			"leef-2-character-delimiter",
+			func(*Config) {},
+			"<13>Sep 19 08:26:10 host LEEF:2.0|IBM|QRadar|7.5|Login|^|src=10.0.0.1^usrName=admin",
+			with(header("2.0"), "src", "10.0.0.1", "usrName", "admin"),
+		},
+		{
+			"leef-2-hex-delimiter",
+			func(*Config) {},
+			"LEEF:2.0|IBM|QRadar|7.5|Login|x7C|src=10.0.0.1|usrName=admin",
+			with(header("2.0"), "src", "10.0.0.1", "usrName", "admin"),
+		},
+		{
+			"leef-2-default-delimiter",
+			func(*Config) {},
+			"LEEF:2.0|IBM|QRadar|7.5|Login||src=10.0.0.1\tusrName=admin\t",
+			with(header("2.0"), "src", "10.0.0.1", "usrName", "admin"),
+		},
+		{
+			"vendor-dictionary",
+			func(c *Config) {
+				c.VendorDictionaries = map[string]map[string]string{
+					"IBM":   {"usrName": "user_name"},
+					"Other": {"src": "other_source"},
+				}
+			},
+			"LEEF:1.0|IBM|QRadar|7.5|Login|src=10.0.0.1\tusrName=admin",
+			with(header("1.0"), "src", "10.0.0.1", "user_name", "admin"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.OutputIDs = []string{"fake"}
+			tc.configure(cfg)
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			ots := time.Now()
+			input := &entry.Entry{Body: tc.input, ObservedTimestamp: ots}
+			expect := &entry.Entry{Body: tc.input, Attributes: tc.expect, ObservedTimestamp: ots}
+
+			require.NoError(t, op.Process(context.Background(), input))
+			fake.ExpectEntry(t, expect)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cardinalitylimiterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cardinalitylimiterprocessor"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// overflowAttribute is the attribute of the series aggregating the data points of the series over the limit.
+const overflowAttribute = "otel.metric.overflow"
+
+// setOverflowAttributes replaces the attributes of the overflow series.
+func setOverflowAttributes(attrs pcommon.Map) {
+	attrs.Clear()
+	attrs.PutBool(overflowAttribute, true)
+}
+
+// lastNumberDataPoint sets dest to the data point with the latest timestamp, as the value of gauges
+// cannot be summed up.
+func lastNumberDataPoint(points []pmetric.NumberDataPoint, dest pmetric.NumberDataPoint) {
+	last := points[0]
+	for _, dp := range points[1:] {
+		if dp.Timestamp() > last.Timestamp() {
+			last = dp
+		}
+	}
+	last.CopyTo(dest)
+	dest.Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+	setOverflowAttributes(dest.Attributes())
+}
+
+// sumNumberDataPoints sets dest to the sum of the data points, with their earliest start timestamp
+// and their latest timestamp. The sum is a double if one of the values is a double.
+func sumNumberDataPoints(points []pmetric.NumberDataPoint, dest pmetric.NumberDataPoint) {
+	var intSum int64
+	var doubleSum float64
+	isDouble := false
+	for i, dp := range points {
+		mergeTimestamps(i == 0, dp.StartTimestamp(), dp.Timestamp(), dest)
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			intSum += dp.IntValue()
+		case pmetric.NumberDataPointValueTypeDouble:
+			isDouble = true
+			doubleSum += dp.DoubleValue()
+		case pmetric.NumberDataPointValueTypeEmpty:
+		}
+	}
+	if isDouble {
+		dest.SetDoubleValue(doubleSum + float64(intSum))
+	} else {
+		dest.SetIntValue(intSum)
+	}
+	setOverflowAttributes(dest.Attributes())
+}
+
+// mergeHistogramDataPoints sets dest to the merge of the data points with the explicit bounds of the
+// first one. The data points with other explicit bounds cannot be merged and are ignored.
+func mergeHistogramDataPoints(points []pmetric.HistogramDataPoint, dest pmetric.HistogramDataPoint) {
+	bounds := points[0].ExplicitBounds()
+	bounds.CopyTo(dest.ExplicitBounds())
+	buckets := make([]uint64, points[0].BucketCounts().Len())
+	merged := 0
+	for _, dp := range points {
+		if !equalBounds(bounds, dp.ExplicitBounds()) || dp.BucketCounts().Len() != len(buckets) {
+			continue
+		}
+		mergeTimestamps(merged == 0, dp.StartTimestamp(), dp.Timestamp(), dest)
+		dest.SetCount(dest.Count() + dp.Count())
+		if dp.HasSum() {
+			dest.SetSum(dest.Sum() + dp.Sum())
+		}
+		if dp.HasMin() && (!dest.HasMin() || dp.Min() < dest.Min()) {
+			dest.SetMin(dp.Min())
+		}
+		if dp.HasMax() && (!dest.HasMax() || dp.Max() > dest.Max()) {
+			dest.SetMax(dp.Max())
+		}
+		for i := range buckets {
+			buckets[i] += dp.BucketCounts().At(i)
+		}
+		merged++
+	}
+	dest.BucketCounts().FromRaw(buckets)
+	setOverflowAttributes(dest.Attributes())
+}
+
+// timestamped is implemented by the data points with a start timestamp.
+type timestamped interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+}
+
+// mergeTimestamps keeps the earliest start timestamp and the latest timestamp in dest.
+func mergeTimestamps(first bool, start, ts pcommon.Timestamp, dest timestamped) {
+	if first || start < dest.StartTimestamp() {
+		dest.SetStartTimestamp(start)
+	}
+	if first || ts > dest.Timestamp() {
+		dest.SetTimestamp(ts)
+	}
+}
+
+func equalBounds(a, b pcommon.Float64Slice) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if a.At(i) != b.At(i) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cardinalitylimiterprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestSumNumberDataPoints(t *testing.T) {
+	a := pmetric.NewNumberDataPoint()
+	a.SetStartTimestamp(5)
+	a.SetTimestamp(10)
+	a.SetIntValue(2)
+	b := pmetric.NewNumberDataPoint()
+	b.SetStartTimestamp(1)
+	b.SetTimestamp(8)
+	b.SetIntValue(3)
+
+	dest := pmetric.NewNumberDataPoint()
+	sumNumberDataPoints([]pmetric.NumberDataPoint{a, b}, dest)
+	assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dest.ValueType())
+	assert.Equal(t, int64(5), dest.IntValue())
+	assert.Equal(t, pcommon.Timestamp(1), dest.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(10), dest.Timestamp())
+
+	// the sum is a double if one of the values is a double
+	b.SetDoubleValue(0.5)
+	sumNumberDataPoints([]pmetric.NumberDataPoint{a, b}, dest)
+	assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dest.ValueType())
+	assert.Equal(t, 2.5, dest.DoubleValue())
+}
+
+func TestMergeHistogramDataPointsBounds(t *testing.T) {
+	newPoint := func(bounds []float64, counts []uint64) pmetric.HistogramDataPoint {
+		dp := pmetric.NewHistogramDataPoint()
+		dp.ExplicitBounds().FromRaw(bounds)
+		dp.BucketCounts().FromRaw(counts)
+		var count uint64
+		for _, c := range counts {
+			count += c
+		}
+		dp.SetCount(count)
+		return dp
+	}
+
+	dest := pmetric.NewHistogramDataPoint()
+	mergeHistogramDataPoints([]pmetric.HistogramDataPoint{
+		newPoint([]float64{1, 10}, []uint64{1, 2, 3}),
+		// the data points with other bounds are ignored
+		newPoint([]float64{5}, []uint64{4, 5}),
+		newPoint([]float64{1, 10}, []uint64{1, 1, 1}),
+	}, dest)
+	assert.Equal(t, []float64{1, 10}, dest.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{2, 3, 4}, dest.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(9), dest.Count())
+	assert.False(t, dest.HasSum())
+	assert.Equal(t, map[string]interface{}{overflowAttribute: true}, dest.Attributes().AsRaw())
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cardinalitylimiterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cardinalitylimiterprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ActionAggregate aggregates the data points of the series over the limit into the overflow series.
+	ActionAggregate = "aggregate"
+	// ActionDrop drops the data points of the series over the limit.
+	ActionDrop = "drop"
+
+	defaultLimit      = 1000
+	defaultExpiration = 10 * time.Minute
+)
+
+// Config defines the configuration for the cardinality limiter processor.
+type Config struct {
+	// Limit is the maximum number of series of each metric of a resource. With the aggregate
+	// action, the overflow series counts towards the limit.
+	Limit int `mapstructure:"limit"`
+
+	// MetricLimits overrides the limit of the metrics, by metric name.
+	MetricLimits map[string]int `mapstructure:"metric_limits"`
+
+	// Expiration is the duration after which the series which were not received are
+	// forgotten, making room for new series.
+	Expiration time.Duration `mapstructure:"expiration"`
+
+	// Action is the action applied to the data points of the series over the limit,
+	// one of `aggregate` or `drop`.
+	Action string `mapstructure:"action"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Limit <= 0 {
+		return errors.New("limit must be positive")
+	}
+	for name, limit := range cfg.MetricLimits {
+		if limit <= 0 {
+			return fmt.Errorf("limit of metric %q must be positive", name)
+		}
+	}
+	if cfg.Expiration <= 0 {
+		return errors.New("expiration must be positive")
+	}
+	switch cfg.Action {
+	case ActionAggregate, ActionDrop:
+	default:
+		return fmt.Errorf("unsupported action %q, must be one of %q or %q", cfg.Action, ActionAggregate, ActionDrop)
+	}
+	return nil
+}
+
+// limit returns the limit of the metric.
+func (cfg *Config) limit(name string) int {
+	if limit, ok := cfg.MetricLimits[name]; ok {
+		return limit
+	}
+	return cfg.Limit
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package cardinalitylimiterprocessor implements a processor which limits the number of
+// series of each metric, aggregating or dropping the data points of the series over the limit.
+package cardinalitylimiterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cardinalitylimiterprocessor"
@@ -0,0 +1,235 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cardinalitylimiterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cardinalitylimiterprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// metricKey identifies a metric of a resource.
+type metricKey struct {
+	resource [16]byte
+	name     string
+}
+
+// metricState holds the series of a metric.
+type metricState struct {
+	// series holds the last time each series under the limit was received, by attribute set hash.
+	series map[[16]byte]time.Time
+	// overflowed holds the last data point of each cumulative series over the limit, by attribute set
+	// hash, as the value of the cumulative overflow series is the sum of their last values.
+	overflowed map[[16]byte]overflowedPoint
+	// limited is set once the limit of the metric was reached.
+	limited bool
+}
+
+// overflowedPoint is the last data point of a cumulative series over the limit.
+type overflowedPoint struct {
+	seen      time.Time
+	number    pmetric.NumberDataPoint
+	histogram pmetric.HistogramDataPoint
+}
+
+// cardinalityLimiterProcessor tracks the series of each metric, and aggregates or drops the data
+// points of the new series once the limit of the metric is reached. The series which are not
+// received for the expiration duration are forgotten, making room for new series.
+type cardinalityLimiterProcessor struct {
+	logger *zap.Logger
+	cfg    *Config
+	now    func() time.Time
+
+	mu        sync.Mutex
+	lastSweep time.Time
+	metrics   map[metricKey]*metricState
+}
+
+func newCardinalityLimiterProcessor(logger *zap.Logger, cfg *Config) *cardinalityLimiterProcessor {
+	return &cardinalityLimiterProcessor{
+		logger:  logger,
+		cfg:     cfg,
+		now:     time.Now,
+		metrics: map[metricKey]*metricState{},
+	}
+}
+
+func (p *cardinalityLimiterProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if now.Sub(p.lastSweep) >= p.cfg.Expiration/2 {
+		p.sweep(now.Add(-p.cfg.Expiration))
+		p.lastSweep = now
+	}
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resource := pdatautil.MapHash(rm.Resource().Attributes())
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				p.limitMetric(metricKey{resource: resource, name: metrics.At(k).Name()}, metrics.At(k), now)
+			}
+		}
+	}
+	return md, nil
+}
+
+// sweep forgets the series which were not received since the given time.
+func (p *cardinalityLimiterProcessor) sweep(before time.Time) {
+	for key, state := range p.metrics {
+		for hash, seen := range state.series {
+			if seen.Before(before) {
+				delete(state.series, hash)
+			}
+		}
+		for hash, point := range state.overflowed {
+			if point.seen.Before(before) {
+				delete(state.overflowed, hash)
+			}
+		}
+		if len(state.series) == 0 && len(state.overflowed) == 0 {
+			delete(p.metrics, key)
+		}
+	}
+}
+
+func (p *cardinalityLimiterProcessor) limitMetric(key metricKey, metric pmetric.Metric, now time.Time) {
+	state, ok := p.metrics[key]
+	if !ok {
+		state = &metricState{
+			series:     map[[16]byte]time.Time{},
+			overflowed: map[[16]byte]overflowedPoint{},
+		}
+		p.metrics[key] = state
+	}
+
+	limit := p.cfg.limit(key.name)
+	aggregate := p.cfg.Action == ActionAggregate
+	capacity := limit
+	if aggregate {
+		// keep room for the overflow series
+		capacity--
+	}
+	// overLimit reports whether the series is over the limit, and records it otherwise. A series
+	// which fits after it overflowed no longer counts in the overflow series.
+	overLimit := func(hash [16]byte) bool {
+		if _, seen := state.series[hash]; seen || len(state.series) < capacity {
+			state.series[hash] = now
+			delete(state.overflowed, hash)
+			return false
+		}
+		if !state.limited {
+			state.limited = true
+			p.logger.Warn("Metric reached its cardinality limit, the action applies to the data points of its new series",
+				zap.String("metric", key.name), zap.Int("limit", limit), zap.String("action", p.cfg.Action))
+		}
+		return true
+	}
+
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		var overflowed []pmetric.NumberDataPoint
+		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			if !overLimit(pdatautil.MapHash(dp.Attributes())) {
+				return false
+			}
+			if aggregate {
+				overflowed = append(overflowed, copyNumberDataPoint(dp))
+			}
+			return true
+		})
+		if len(overflowed) > 0 {
+			lastNumberDataPoint(overflowed, dps.AppendEmpty())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		cumulative := metric.Sum().AggregationTemporality() == pmetric.AggregationTemporalityCumulative
+		var overflowed []pmetric.NumberDataPoint
+		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			hash := pdatautil.MapHash(dp.Attributes())
+			if !overLimit(hash) {
+				return false
+			}
+			if aggregate {
+				point := copyNumberDataPoint(dp)
+				overflowed = append(overflowed, point)
+				if cumulative {
+					state.overflowed[hash] = overflowedPoint{seen: now, number: point}
+				}
+			}
+			return true
+		})
+		if len(overflowed) == 0 {
+			return
+		}
+		if cumulative {
+			overflowed = overflowed[:0]
+			for _, point := range state.overflowed {
+				overflowed = append(overflowed, point.number)
+			}
+		}
+		sumNumberDataPoints(overflowed, dps.AppendEmpty())
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		cumulative := metric.Histogram().AggregationTemporality() == pmetric.AggregationTemporalityCumulative
+		var overflowed []pmetric.HistogramDataPoint
+		dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+			hash := pdatautil.MapHash(dp.Attributes())
+			if !overLimit(hash) {
+				return false
+			}
+			if aggregate {
+				point := pmetric.NewHistogramDataPoint()
+				dp.CopyTo(point)
+				overflowed = append(overflowed, point)
+				if cumulative {
+					state.overflowed[hash] = overflowedPoint{seen: now, histogram: point}
+				}
+			}
+			return true
+		})
+		if len(overflowed) == 0 {
+			return
+		}
+		if cumulative {
+			// the bounds of the data points of this batch are kept
+			first := overflowed[0]
+			overflowed = append(overflowed[:0], first)
+			for _, point := range state.overflowed {
+				if point.histogram != first {
+					overflowed = append(overflowed, point.histogram)
+				}
+			}
+		}
+		mergeHistogramDataPoints(overflowed, dps.AppendEmpty())
+	case pmetric.MetricTypeExponentialHistogram:
+		// the data points of the exponential histograms and of the summaries cannot be
+		// aggregated, they are dropped
+		metric.ExponentialHistogram().DataPoints().RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+			return overLimit(pdatautil.MapHash(dp.Attributes()))
+		})
+	case pmetric.MetricTypeSummary:
+		metric.Summary().DataPoints().RemoveIf(func(dp pmetric.SummaryDataPoint) bool {
+			return overLimit(pdatautil.MapHash(dp.Attributes()))
+		})
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func copyNumberDataPoint(dp pmetric.NumberDataPoint) pmetric.NumberDataPoint {
+	point := pmetric.NewNumberDataPoint()
+	dp.CopyTo(point)
+	return point
+}
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cardinalitylimiterprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func newTestProcessor(limit int, action string) *cardinalityLimiterProcessor {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Limit = limit
+	cfg.Action = action
+	return newCardinalityLimiterProcessor(zap.NewNop(), cfg)
+}
+
+// newMetrics returns metrics with an empty metric of the given pod and name.
+func newMetrics(pod, name string) (pmetric.Metrics, pmetric.Metric) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("k8s.pod.name", pod)
+	metric := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName(name)
+	return md, metric
+}
+
+func addNumberDataPoint(dps pmetric.NumberDataPointSlice, user string, ts int, value int64) {
+	dp := dps.AppendEmpty()
+	dp.Attributes().PutStr("user", user)
+	dp.SetStartTimestamp(1)
+	dp.SetTimestamp(pcommon.Timestamp(ts))
+	dp.SetIntValue(value)
+}
+
+func numberValues(dps pmetric.NumberDataPointSlice) map[string]int64 {
+	out := map[string]int64{}
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if user, ok := dp.Attributes().Get("user"); ok {
+			out[user.Str()] = dp.IntValue()
+		} else if _, ok := dp.Attributes().Get(overflowAttribute); ok {
+			out[overflowAttribute] = dp.IntValue()
+		}
+	}
+	return out
+}
+
+func TestLimitGauge(t *testing.T) {
+	p := newTestProcessor(3, ActionAggregate)
+	md, metric := newMetrics("api-0", "sessions")
+	dps := metric.SetEmptyGauge().DataPoints()
+	addNumberDataPoint(dps, "a", 10, 1)
+	addNumberDataPoint(dps, "b", 10, 2)
+	addNumberDataPoint(dps, "c", 20, 3)
+	addNumberDataPoint(dps, "d", 10, 4)
+
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	// the overflow series counts towards the limit and has the last value
+	assert.Equal(t, map[string]int64{"a": 1, "b": 2, overflowAttribute: 3}, numberValues(dps))
+	overflow := dps.At(2)
+	assert.Equal(t, map[string]interface{}{overflowAttribute: true}, overflow.Attributes().AsRaw())
+	assert.Equal(t, pcommon.Timestamp(20), overflow.Timestamp())
+}
+
+func TestLimitDeltaSum(t *testing.T) {
+	p := newTestProcessor(3, ActionAggregate)
+	// the known series are kept in the next batches
+	for i := 0; i < 2; i++ {
+		md, metric := newMetrics("api-0", "requests")
+		metric.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dps := metric.Sum().DataPoints()
+		addNumberDataPoint(dps, "c", 10, 3)
+		addNumberDataPoint(dps, "a", 10, 1)
+		addNumberDataPoint(dps, "b", 10, 2)
+		addNumberDataPoint(dps, "d", 10, 4)
+
+		_, err := p.processMetrics(context.Background(), md)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"c": 3, "a": 1, overflowAttribute: 6}, numberValues(dps))
+	}
+}
+
+func TestLimitCumulativeSum(t *testing.T) {
+	p := newTestProcessor(2, ActionAggregate)
+
+	md, metric := newMetrics("api-0", "requests")
+	metric.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dps := metric.Sum().DataPoints()
+	addNumberDataPoint(dps, "a", 10, 1)
+	addNumberDataPoint(dps, "b", 10, 2)
+	addNumberDataPoint(dps, "c", 10, 3)
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a": 1, overflowAttribute: 5}, numberValues(dps))
+
+	// the overflow series sums up the last values of all the series over the limit
+	md, metric = newMetrics("api-0", "requests")
+	metric.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dps = metric.Sum().DataPoints()
+	addNumberDataPoint(dps, "c", 20, 10)
+	_, err = p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{overflowAttribute: 12}, numberValues(dps))
+	assert.Equal(t, pcommon.Timestamp(20), dps.At(0).Timestamp())
+}
+
+func TestLimitCumulativeSumAdmitted(t *testing.T) {
+	p := newTestProcessor(2, ActionAggregate)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	process := func(values map[string]int64) map[string]int64 {
+		md, metric := newMetrics("api-0", "requests")
+		metric.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		dps := metric.Sum().DataPoints()
+		for _, user := range []string{"a", "b", "c"} {
+			if value, ok := values[user]; ok {
+				addNumberDataPoint(dps, user, 10, value)
+			}
+		}
+		_, err := p.processMetrics(context.Background(), md)
+		require.NoError(t, err)
+		return numberValues(dps)
+	}
+
+	assert.Equal(t, map[string]int64{"a": 1, overflowAttribute: 5}, process(map[string]int64{"a": 1, "b": 2, "c": 3}))
+	now = now.Add(p.cfg.Expiration / 2)
+	assert.Equal(t, map[string]int64{overflowAttribute: 9}, process(map[string]int64{"b": 4, "c": 5}))
+
+	// once the expired series makes room for it, the series is no longer counted in the overflow series
+	now = now.Add(p.cfg.Expiration/2 + time.Second)
+	assert.Equal(t, map[string]int64{"b": 6, overflowAttribute: 7}, process(map[string]int64{"b": 6, "c": 7}))
+}
+
+func TestLimitHistogram(t *testing.T) {
+	p := newTestProcessor(2, ActionAggregate)
+	md, metric := newMetrics("api-0", "latency")
+	metric.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dps := metric.Histogram().DataPoints()
+	for i, user := range []string{"a", "b", "c"} {
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutStr("user", user)
+		dp.SetTimestamp(pcommon.Timestamp(10 + i))
+		dp.SetCount(uint64(i + 1))
+		dp.SetSum(float64(i + 1))
+		dp.SetMin(float64(i))
+		dp.ExplicitBounds().FromRaw([]float64{1, 10})
+		dp.BucketCounts().FromRaw([]uint64{uint64(i), 1, 0})
+	}
+
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	require.Equal(t, 2, dps.Len())
+	overflow := dps.At(1)
+	assert.Equal(t, map[string]interface{}{overflowAttribute: true}, overflow.Attributes().AsRaw())
+	assert.Equal(t, uint64(5), overflow.Count())
+	assert.Equal(t, 5.0, overflow.Sum())
+	assert.Equal(t, 1.0, overflow.Min())
+	assert.False(t, overflow.HasMax())
+	assert.Equal(t, []float64{1, 10}, overflow.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{3, 2, 0}, overflow.BucketCounts().AsRaw())
+	assert.Equal(t, pcommon.Timestamp(12), overflow.Timestamp())
+}
+
+func TestLimitDrop(t *testing.T) {
+	p := newTestProcessor(2, ActionDrop)
+	md, metric := newMetrics("api-0", "requests")
+	metric.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dps := metric.Sum().DataPoints()
+	addNumberDataPoint(dps, "a", 10, 1)
+	addNumberDataPoint(dps, "b", 10, 2)
+	addNumberDataPoint(dps, "c", 10, 3)
+
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a": 1, "b": 2}, numberValues(dps))
+}
+
+func TestLimitExponentialHistogram(t *testing.T) {
+	// the exponential histograms cannot be aggregated, they are dropped
+	p := newTestProcessor(2, ActionAggregate)
+	md, metric := newMetrics("api-0", "latency")
+	dps := metric.SetEmptyExponentialHistogram().DataPoints()
+	for _, user := range []string{"a", "b", "c"} {
+		dps.AppendEmpty().Attributes().PutStr("user", user)
+	}
+
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, map[string]interface{}{"user": "a"}, dps.At(0).Attributes().AsRaw())
+}
+
+func TestLimitByResourceAndMetric(t *testing.T) {
+	p := newTestProcessor(2, ActionDrop)
+	p.cfg.MetricLimits = map[string]int{"sessions": 3}
+
+	for _, tt := range []struct {
+		pod      string
+		name     string
+		expected map[string]int64
+	}{
+		{pod: "api-0", name: "requests", expected: map[string]int64{"a": 1, "b": 2}},
+		{pod: "api-1", name: "requests", expected: map[string]int64{"a": 1, "b": 2}},
+		{pod: "api-0", name: "sessions", expected: map[string]int64{"a": 1, "b": 2, "c": 3}},
+	} {
+		md, metric := newMetrics(tt.pod, tt.name)
+		dps := metric.SetEmptyGauge().DataPoints()
+		addNumberDataPoint(dps, "a", 10, 1)
+		addNumberDataPoint(dps, "b", 10, 2)
+		addNumberDataPoint(dps, "c", 10, 3)
+		_, err := p.processMetrics(context.Background(), md)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, numberValues(dps), "%s/%s", tt.pod, tt.name)
+	}
+}
+
+func TestExpiration(t *testing.T) {
+	p := newTestProcessor(1, ActionDrop)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	process := func(user string) map[string]int64 {
+		md, metric := newMetrics("api-0", "sessions")
+		dps := metric.SetEmptyGauge().DataPoints()
+		addNumberDataPoint(dps, user, 10, 1)
+		_, err := p.processMetrics(context.Background(), md)
+		require.NoError(t, err)
+		return numberValues(dps)
+	}
+
+	assert.Equal(t, map[string]int64{"a": 1}, process("a"))
+	assert.Equal(t, map[string]int64{}, process("b"))
+
+	// the series received recently are kept
+	now = now.Add(p.cfg.Expiration / 2)
+	assert.Equal(t, map[string]int64{"a": 1}, process("a"))
+	now = now.Add(p.cfg.Expiration / 2)
+	assert.Equal(t, map[string]int64{}, process("b"))
+
+	// the series not received for the expiration duration make room for new series
+	now = now.Add(p.cfg.Expiration)
+	assert.Equal(t, map[string]int64{"b": 1}, process("b"))
+	assert.Len(t, p.metrics, 1)
+}
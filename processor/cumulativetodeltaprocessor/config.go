@@ -31,6 +31,14 @@ type Config struct {
 	// Cannot be used with deprecated Metrics config option.
 	Include MatchMetrics `mapstructure:"include"`
 	Exclude MatchMetrics `mapstructure:"exclude"`
+
+	// MaxStreams is the maximum number of streams whose state is kept. The points of new streams are dropped
+	// once it is reached, until stale streams are removed. Set to 0 to keep the state of all the streams.
+	MaxStreams int `mapstructure:"max_streams"`
+
+	// StorageID is the ID of the storage extension the state is persisted to on shutdown and restored from
+	// on start, so that the first points received after a restart are converted to deltas.
+	StorageID *component.ID `mapstructure:"storage"`
 }
 
 type MatchMetrics struct {
@@ -52,5 +60,8 @@ func (config *Config) Validate() error {
 		(len(config.Exclude.MatchType) > 0 && len(config.Exclude.Metrics) == 0) {
 		return fmt.Errorf("metrics must be supplied if match_type is set")
 	}
+	if config.MaxStreams < 0 {
+		return fmt.Errorf("max_streams must not be negative")
+	}
 	return nil
 }
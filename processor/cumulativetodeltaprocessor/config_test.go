@@ -21,6 +21,7 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	storageID := component.NewID("file_storage")
 	tests := []struct {
 		id           component.ID
 		expected     component.Config
@@ -108,6 +109,18 @@ func TestLoadConfig(t *testing.T) {
 				InitialValue: tracking.InitialValueDrop,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "storage"),
+			expected: &Config{
+				MaxStaleness: time.Hour,
+				MaxStreams:   10000,
+				StorageID:    &storageID,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_max_streams"),
+			errorMessage: "max_streams must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracking // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// persistedStates is the state of the tracker persisted across restarts.
+type persistedStates struct {
+	// SavedAt is the time the states were persisted at.
+	SavedAt pcommon.Timestamp
+	// States holds the previous points of the streams, by identity.
+	States map[string]ValuePoint
+}
+
+// Dump returns the encoded states of the streams, to be loaded by the tracker of the next run.
+func (t *MetricTracker) Dump(now pcommon.Timestamp) ([]byte, error) {
+	ps := persistedStates{
+		SavedAt: now,
+		States:  map[string]ValuePoint{},
+	}
+	t.states.Range(func(key, value interface{}) bool {
+		s := value.(*State)
+		s.Lock()
+		ps.States[key.(string)] = s.PrevPoint
+		s.Unlock()
+		return true
+	})
+
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(ps); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// Load restores the states of the streams dumped by the tracker of the previous run, so that the
+// first points of the streams received after a restart are converted to deltas from the last points
+// received before it. The stale states and the states exceeding the maximum number of streams are
+// not restored.
+//
+// The streams which started between the dump and the start of the tracker were not observed by any
+// tracker, so the start time of the tracker is moved back to the time of the dump: with the auto
+// initial value, their first points are sent rather than dropped.
+func (t *MetricTracker) Load(data []byte, now pcommon.Timestamp) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var ps persistedStates
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&ps); err != nil {
+		return fmt.Errorf("invalid persisted states: %w", err)
+	}
+
+	var staleBefore pcommon.Timestamp
+	if t.maxStaleness > 0 && now > pcommon.Timestamp(t.maxStaleness) {
+		staleBefore = now - pcommon.Timestamp(t.maxStaleness)
+	}
+	for key, point := range ps.States {
+		if point.ObservedTimestamp < staleBefore {
+			continue
+		}
+		if t.maxStreams > 0 && t.streams.Load() >= int64(t.maxStreams) {
+			t.logger.Debug("not restoring all the streams, the maximum number of streams is reached")
+			break
+		}
+		if _, loaded := t.states.LoadOrStore(key, &State{PrevPoint: point}); !loaded {
+			t.streams.Add(1)
+		}
+	}
+
+	if ps.SavedAt != 0 && ps.SavedAt < t.startTime {
+		t.startTime = ps.SavedAt
+	}
+	return nil
+}
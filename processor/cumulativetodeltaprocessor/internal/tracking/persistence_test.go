@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func testIdentity(name string, metricType pmetric.MetricType, startTime pcommon.Timestamp) MetricIdentity {
+	return MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             metricType,
+		MetricIsMonotonic:      true,
+		MetricName:             name,
+		StartTimestamp:         startTime,
+		Attributes:             pcommon.NewMap(),
+		MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+	}
+}
+
+func TestMetricTrackerDumpLoad(t *testing.T) {
+	now := time.Now()
+	start := pcommon.NewTimestampFromTime(now.Add(-time.Hour))
+	sumID := testIdentity("sum", pmetric.MetricTypeSum, start)
+	histogramID := testIdentity("histogram", pmetric.MetricTypeHistogram, start)
+
+	tr := NewMetricTracker(context.Background(), zap.NewNop(), 0, 0, InitialValueKeep)
+	tr.Convert(MetricPoint{Identity: sumID, Value: ValuePoint{
+		ObservedTimestamp: pcommon.NewTimestampFromTime(now),
+		IntValue:          100,
+	}})
+	tr.Convert(MetricPoint{Identity: histogramID, Value: ValuePoint{
+		ObservedTimestamp: pcommon.NewTimestampFromTime(now),
+		HistogramValue:    &HistogramPoint{Count: 10, Sum: 100, Buckets: []uint64{4, 6}},
+	}})
+	data, err := tr.Dump(pcommon.NewTimestampFromTime(now))
+	require.NoError(t, err)
+
+	// The first points after the restart are converted to deltas from the points before it
+	loaded := NewMetricTracker(context.Background(), zap.NewNop(), 0, 0, InitialValueDrop)
+	require.NoError(t, loaded.Load(data, pcommon.NewTimestampFromTime(now.Add(time.Minute))))
+	assert.Equal(t, int64(2), loaded.streams.Load())
+
+	out, valid := loaded.Convert(MetricPoint{Identity: sumID, Value: ValuePoint{
+		ObservedTimestamp: pcommon.NewTimestampFromTime(now.Add(2 * time.Minute)),
+		IntValue:          130,
+	}})
+	require.True(t, valid)
+	assert.Equal(t, int64(30), out.IntValue)
+	assert.Equal(t, pcommon.NewTimestampFromTime(now), out.StartTimestamp)
+
+	out, valid = loaded.Convert(MetricPoint{Identity: histogramID, Value: ValuePoint{
+		ObservedTimestamp: pcommon.NewTimestampFromTime(now.Add(2 * time.Minute)),
+		HistogramValue:    &HistogramPoint{Count: 15, Sum: 160, Buckets: []uint64{5, 10}},
+	}})
+	require.True(t, valid)
+	assert.Equal(t, &HistogramPoint{Count: 5, Sum: 60, Buckets: []uint64{1, 4}}, out.HistogramValue)
+
+	assert.Error(t, loaded.Load([]byte{1, 2, 3}, pcommon.NewTimestampFromTime(now)))
+	assert.NoError(t, loaded.Load(nil, pcommon.NewTimestampFromTime(now)))
+}
+
+func TestMetricTrackerLoadStaleAndMaxStreams(t *testing.T) {
+	now := time.Now()
+	tr := NewMetricTracker(context.Background(), zap.NewNop(), 0, 0, InitialValueKeep)
+	for i, observed := range []time.Time{now.Add(-time.Hour), now, now, now} {
+		tr.Convert(MetricPoint{
+			Identity: testIdentity(string(rune('a'+i)), pmetric.MetricTypeSum, 1),
+			Value:    ValuePoint{ObservedTimestamp: pcommon.NewTimestampFromTime(observed), IntValue: 1},
+		})
+	}
+	data, err := tr.Dump(pcommon.NewTimestampFromTime(now))
+	require.NoError(t, err)
+
+	loaded := NewMetricTracker(context.Background(), zap.NewNop(), 10*time.Minute, 0, InitialValueAuto)
+	require.NoError(t, loaded.Load(data, pcommon.NewTimestampFromTime(now)))
+	assert.Equal(t, int64(3), loaded.streams.Load())
+
+	limited := NewMetricTracker(context.Background(), zap.NewNop(), 0, 2, InitialValueAuto)
+	require.NoError(t, limited.Load(data, pcommon.NewTimestampFromTime(now)))
+	assert.Equal(t, int64(2), limited.streams.Load())
+}
+
+func TestMetricTrackerLoadStartTime(t *testing.T) {
+	tr := NewMetricTracker(context.Background(), zap.NewNop(), 0, 0, InitialValueAuto)
+	savedAt := tr.startTime - pcommon.Timestamp(time.Minute)
+	data, err := tr.Dump(savedAt)
+	require.NoError(t, err)
+
+	loaded := NewMetricTracker(context.Background(), zap.NewNop(), 0, 0, InitialValueAuto)
+	require.NoError(t, loaded.Load(data, loaded.startTime))
+	assert.Equal(t, savedAt, loaded.startTime)
+
+	// A stream which started while the collector was down is not dropped
+	id := testIdentity("sum", pmetric.MetricTypeSum, savedAt+pcommon.Timestamp(time.Second))
+	out, valid := loaded.Convert(MetricPoint{Identity: id, Value: ValuePoint{
+		ObservedTimestamp: savedAt + pcommon.Timestamp(2*time.Minute),
+		IntValue:          10,
+	}})
+	require.True(t, valid)
+	assert.Equal(t, int64(10), out.IntValue)
+}
+
+func TestMetricTrackerMaxStreams(t *testing.T) {
+	tr := NewMetricTracker(context.Background(), zap.NewNop(), 0, 1, InitialValueKeep)
+	first := testIdentity("first", pmetric.MetricTypeSum, 1)
+	second := testIdentity("second", pmetric.MetricTypeSum, 1)
+
+	_, valid := tr.Convert(MetricPoint{Identity: first, Value: ValuePoint{ObservedTimestamp: 2, IntValue: 1}})
+	assert.True(t, valid)
+	_, valid = tr.Convert(MetricPoint{Identity: second, Value: ValuePoint{ObservedTimestamp: 2, IntValue: 1}})
+	assert.False(t, valid)
+	out, valid := tr.Convert(MetricPoint{Identity: first, Value: ValuePoint{ObservedTimestamp: 3, IntValue: 4}})
+	assert.True(t, valid)
+	assert.Equal(t, int64(3), out.IntValue)
+
+	// The removal of the stale streams frees room for new streams
+	tr.removeStale(4)
+	_, valid = tr.Convert(MetricPoint{Identity: second, Value: ValuePoint{ObservedTimestamp: 4, IntValue: 1}})
+	assert.True(t, valid)
+}
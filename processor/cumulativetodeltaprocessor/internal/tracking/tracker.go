@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -71,10 +72,11 @@ type DeltaValue struct {
 	HistogramValue *HistogramPoint
 }
 
-func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration, initalValue InitialValue) *MetricTracker {
+func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration, maxStreams int, initalValue InitialValue) *MetricTracker {
 	t := &MetricTracker{
 		logger:       logger,
 		maxStaleness: maxStaleness,
+		maxStreams:   maxStreams,
 		initialValue: initalValue,
 		startTime:    pcommon.NewTimestampFromTime(time.Now()),
 	}
@@ -87,7 +89,10 @@ func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time
 type MetricTracker struct {
 	logger       *zap.Logger
 	maxStaleness time.Duration
+	// maxStreams is the maximum number of tracked streams, 0 when unlimited
+	maxStreams   int
 	states       sync.Map
+	streams      atomic.Int64
 	initialValue InitialValue
 	startTime    pcommon.Timestamp
 }
@@ -112,9 +117,19 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 	hashableID := b.String()
 	identityBufferPool.Put(b)
 
-	s, ok := t.states.LoadOrStore(hashableID, &State{
-		PrevPoint: metricPoint,
-	})
+	s, ok := t.states.Load(hashableID)
+	if !ok {
+		if t.maxStreams > 0 && t.streams.Load() >= int64(t.maxStreams) {
+			t.logger.Debug("dropping point of untracked stream, the maximum number of streams is reached", zap.Int("max_streams", t.maxStreams))
+			return
+		}
+		s, ok = t.states.LoadOrStore(hashableID, &State{
+			PrevPoint: metricPoint,
+		})
+		if !ok {
+			t.streams.Add(1)
+		}
+	}
 	if !ok {
 		switch metricID.MetricType {
 		case pmetric.MetricTypeHistogram:
@@ -224,7 +239,9 @@ func (t *MetricTracker) removeStale(staleBefore pcommon.Timestamp) {
 		s.Unlock()
 		if lastObserved < staleBefore {
 			t.logger.Debug("removing stale state key", zap.String("key", key.(string)))
-			t.states.Delete(key)
+			if _, loaded := t.states.LoadAndDelete(key); loaded {
+				t.streams.Add(-1)
+			}
 		}
 		return true
 	})
@@ -5,29 +5,46 @@ package cumulativetodeltaprocessor // import "github.com/open-telemetry/opentele
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
 )
 
+// statesKey is the key of the states of the streams in the storage.
+const statesKey = "states"
+
 type cumulativeToDeltaProcessor struct {
 	includeFS       filterset.FilterSet
 	excludeFS       filterset.FilterSet
 	logger          *zap.Logger
 	deltaCalculator *tracking.MetricTracker
 	cancelFunc      context.CancelFunc
+
+	componentID component.ID
+	storageID   *component.ID
+	// client is the client of the storage extension, nil when the state is not persisted
+	client storage.Client
 }
 
-func newCumulativeToDeltaProcessor(config *Config, logger *zap.Logger) *cumulativeToDeltaProcessor {
+func newCumulativeToDeltaProcessor(config *Config, set processor.CreateSettings) *cumulativeToDeltaProcessor {
 	ctx, cancel := context.WithCancel(context.Background())
 	p := &cumulativeToDeltaProcessor{
-		logger:          logger,
-		deltaCalculator: tracking.NewMetricTracker(ctx, logger, config.MaxStaleness, config.InitialValue),
+		logger:          set.Logger,
+		deltaCalculator: tracking.NewMetricTracker(ctx, set.Logger, config.MaxStaleness, config.MaxStreams, config.InitialValue),
 		cancelFunc:      cancel,
+		componentID:     set.ID,
+		storageID:       config.StorageID,
 	}
 	if len(config.Include.Metrics) > 0 {
 		p.includeFS, _ = filterset.CreateFilterSet(config.Include.Metrics, &config.Include.Config)
@@ -106,9 +123,52 @@ func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pme
 	return md, nil
 }
 
-func (ctdp *cumulativeToDeltaProcessor) shutdown(context.Context) error {
+// start restores the state persisted to the storage extension by the previous run, if any.
+func (ctdp *cumulativeToDeltaProcessor) start(ctx context.Context, host component.Host) error {
+	if ctdp.storageID == nil {
+		return nil
+	}
+	client, err := getStorageClient(ctx, host, *ctdp.storageID, ctdp.componentID)
+	if err != nil {
+		return err
+	}
+	ctdp.client = client
+
+	data, err := ctdp.client.Get(ctx, statesKey)
+	if err != nil {
+		return fmt.Errorf("failed to read the persisted state: %w", err)
+	}
+	return ctdp.deltaCalculator.Load(data, pcommon.NewTimestampFromTime(time.Now()))
+}
+
+func (ctdp *cumulativeToDeltaProcessor) shutdown(ctx context.Context) error {
 	ctdp.cancelFunc()
-	return nil
+	if ctdp.client == nil {
+		return nil
+	}
+
+	data, err := ctdp.deltaCalculator.Dump(pcommon.NewTimestampFromTime(time.Now()))
+	if err == nil {
+		err = ctdp.client.Set(ctx, statesKey, data)
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to persist the state: %w", err)
+	}
+	return multierr.Append(err, ctdp.client.Close(ctx))
+}
+
+func getStorageClient(ctx context.Context, host component.Host, storageID component.ID, componentID component.ID) (storage.Client, error) {
+	extension, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindProcessor, componentID, "")
 }
 
 func (ctdp *cumulativeToDeltaProcessor) shouldConvertMetric(metricName string) bool {
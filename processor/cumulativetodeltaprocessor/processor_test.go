@@ -12,7 +12,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor"
@@ -534,6 +536,118 @@ func TestCumulativeToDeltaProcessor(t *testing.T) {
 	}
 }
 
+func TestCumulativeToDeltaProcessorAcrossRestart(t *testing.T) {
+	storageID := component.NewID("test_storage")
+	host := &testStorageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: &testStorageExtension{data: map[string][]byte{}}},
+	}
+	cfg := createDefaultConfig().(*Config)
+	cfg.StorageID = &storageID
+	metrics := func(value float64) pmetric.Metrics {
+		return generateTestSumMetrics(testSumMetric{
+			metricNames:  []string{"metric_1"},
+			metricValues: [][]float64{{value}},
+			isCumulative: []bool{true},
+			isMonotonic:  []bool{true},
+		})
+	}
+
+	next := new(consumertest.MetricsSink)
+	p, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), metrics(100)))
+	require.NoError(t, p.Shutdown(context.Background()))
+	// The first point of the metric is dropped since it started before the processor
+	assert.Equal(t, 0, next.DataPointCount())
+
+	next.Reset()
+	p, err = NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), metrics(150)))
+	require.NoError(t, p.Shutdown(context.Background()))
+	// The first point after the restart is converted to a delta from the point before it
+	require.Equal(t, 1, next.DataPointCount())
+	dp := next.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, 50.0, dp.DoubleValue())
+}
+
+func TestCumulativeToDeltaProcessorStartErrors(t *testing.T) {
+	storageID := component.NewID("test_storage")
+	cfg := createDefaultConfig().(*Config)
+	cfg.StorageID = &storageID
+	p, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.EqualError(t, p.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'test_storage' not found")
+
+	host := &testStorageHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: &struct {
+			component.StartFunc
+			component.ShutdownFunc
+		}{}},
+	}
+	assert.EqualError(t, p.Start(context.Background(), host), "non-storage extension 'test_storage' found")
+}
+
+type testStorageHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testStorageHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+// testStorageExtension keeps the data of its clients in memory, across their restarts.
+type testStorageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	data map[string][]byte
+}
+
+func (e *testStorageExtension) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return &testStorageClient{data: e.data}, nil
+}
+
+type testStorageClient struct {
+	data map[string][]byte
+}
+
+func (c *testStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[key], nil
+}
+
+func (c *testStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *testStorageClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (c *testStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value, _ = c.Get(ctx, op.Key)
+		case storage.Set:
+			_ = c.Set(ctx, op.Key, op.Value)
+		case storage.Delete:
+			_ = c.Delete(ctx, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *testStorageClient) Close(context.Context) error {
+	return nil
+}
+
 func generateTestSumMetrics(tm testSumMetric) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	now := time.Now()
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const defaultMaxStaleness = 5 * time.Minute
+
+// Config defines the configuration for the processor.
+type Config struct {
+	// MaxStaleness is the time a stream is kept after its last point was received. Set to 0 to keep
+	// the streams indefinitely.
+	MaxStaleness time.Duration `mapstructure:"max_staleness"`
+
+	// MaxStreams is the maximum number of streams accumulated. The points of new streams are dropped
+	// once it is reached, until stale streams are removed. Set to 0 to accumulate all the streams.
+	MaxStreams int `mapstructure:"max_streams"`
+
+	// Identity defines which attributes identify the streams.
+	Identity IdentityConfig `mapstructure:"identity"`
+}
+
+// IdentityConfig defines the attributes excluded from the identity of the streams, so that the points
+// sent by several replicas of a source, differing only by those attributes, are accumulated together.
+type IdentityConfig struct {
+	// ExcludeResourceAttributes are the resource attributes removed before accumulating, such as host.name.
+	ExcludeResourceAttributes []string `mapstructure:"exclude_resource_attributes"`
+
+	// ExcludeAttributes are the data point attributes removed before accumulating.
+	ExcludeAttributes []string `mapstructure:"exclude_attributes"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (config *Config) Validate() error {
+	if config.MaxStaleness < 0 {
+		return errors.New("max_staleness must not be negative")
+	}
+	if config.MaxStreams < 0 {
+		return errors.New("max_streams must not be negative")
+	}
+	for _, key := range config.Identity.ExcludeResourceAttributes {
+		if key == "" {
+			return errors.New("identity: exclude_resource_attributes must not hold empty keys")
+		}
+	}
+	for _, key := range config.Identity.ExcludeAttributes {
+		if key == "" {
+			return errors.New("identity: exclude_attributes must not hold empty keys")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				MaxStaleness: 5 * time.Minute,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "identity"),
+			expected: &Config{
+				MaxStaleness: 10 * time.Minute,
+				MaxStreams:   10000,
+				Identity: IdentityConfig{
+					ExcludeResourceAttributes: []string{"host.name", "service.instance.id"},
+					ExcludeAttributes:         []string{"replica"},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_max_staleness"),
+			errorMessage: "max_staleness must not be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_max_streams"),
+			errorMessage: "max_streams must not be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "empty_attribute"),
+			errorMessage: "identity: exclude_attributes must not hold empty keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/obsreport"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/metadata"
+)
+
+const (
+	reasonMaxStreams   = "max_streams"
+	reasonIncompatible = "incompatible"
+)
+
+var (
+	tagMetricKey, _ = tag.NewKey("metric")
+	tagReasonKey, _ = tag.NewKey("reason")
+
+	mStreamsTracked    = stats.Int64("streams_tracked", "Number of streams accumulated", stats.UnitDimensionless)
+	mStreamsEvicted    = stats.Int64("streams_evicted", "Number of streams removed for being stale", stats.UnitDimensionless)
+	mDataPointsDropped = stats.Int64("datapoints_dropped", "Number of delta data points dropped instead of being accumulated", stats.UnitDimensionless)
+	mStreamStaleness   = stats.Int64("stream_staleness", "Time elapsed since the previous point of a stream was received, when receiving its next point", stats.UnitMilliseconds)
+)
+
+// MetricViews returns the metrics views of the processor. The staleness of the streams is tagged with
+// their metric name, and the dropped data points with the reason they were dropped.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mStreamsTracked.Name()),
+			Measure:     mStreamsTracked,
+			Description: mStreamsTracked.Description(),
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mStreamsEvicted.Name()),
+			Measure:     mStreamsEvicted,
+			Description: mStreamsEvicted.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mDataPointsDropped.Name()),
+			Measure:     mDataPointsDropped,
+			Description: mDataPointsDropped.Description(),
+			TagKeys:     []tag.Key{tagReasonKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mStreamStaleness.Name()),
+			Measure:     mStreamStaleness,
+			Description: mStreamStaleness.Description(),
+			TagKeys:     []tag.Key{tagMetricKey},
+			Aggregation: view.Distribution(100, 1000, 5000, 10000, 30000, 60000, 120000, 300000, 600000),
+		},
+	}
+}
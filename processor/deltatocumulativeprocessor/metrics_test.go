@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessorMetrics(t *testing.T) {
+	expectedViewNames := []string{
+		"processor/deltatocumulative/processor_deltatocumulative_streams_tracked",
+		"processor/deltatocumulative/processor_deltatocumulative_streams_evicted",
+		"processor/deltatocumulative/processor_deltatocumulative_datapoints_dropped",
+		"processor/deltatocumulative/processor_deltatocumulative_stream_staleness",
+	}
+
+	views := MetricViews()
+	for i, viewName := range expectedViewNames {
+		assert.Equal(t, viewName, views[i].Name)
+	}
+}
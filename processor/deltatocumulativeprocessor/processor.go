@@ -0,0 +1,331 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const sep = byte(0x1E)
+
+// stream is the accumulated state of a delta stream.
+type stream struct {
+	start pcommon.Timestamp
+	last  pcommon.Timestamp
+	// seen is when the last point of the stream was received
+	seen time.Time
+
+	intValue   int64
+	floatValue float64
+
+	bounds  []float64
+	buckets []uint64
+	count   uint64
+	sum     float64
+	min     float64
+	max     float64
+	hasMin  bool
+	hasMax  bool
+}
+
+// deltaToCumulative accumulates the points of the delta sums and histograms into cumulative points.
+// The streams are identified by their resource, scope, metric and attributes, except for the excluded
+// attributes, which are removed from the telemetry.
+type deltaToCumulative struct {
+	logger *zap.Logger
+	cfg    *Config
+	now    func() time.Time
+
+	mu      sync.Mutex
+	streams map[string]*stream
+
+	startOnce    sync.Once
+	shutdownOnce sync.Once
+	shutdownC    chan struct{}
+	done         chan struct{}
+}
+
+func newDeltaToCumulative(logger *zap.Logger, cfg *Config) *deltaToCumulative {
+	return &deltaToCumulative{
+		logger:    logger,
+		cfg:       cfg,
+		now:       time.Now,
+		streams:   make(map[string]*stream),
+		shutdownC: make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// start starts removing the stale streams.
+func (p *deltaToCumulative) start(context.Context, component.Host) error {
+	if p.cfg.MaxStaleness > 0 {
+		p.startOnce.Do(func() {
+			go p.sweepLoop()
+		})
+	}
+	return nil
+}
+
+func (p *deltaToCumulative) shutdown(ctx context.Context) error {
+	started := true
+	p.startOnce.Do(func() { started = false })
+	p.shutdownOnce.Do(func() { close(p.shutdownC) })
+	if !started {
+		return nil
+	}
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *deltaToCumulative) sweepLoop() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.cfg.MaxStaleness)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.removeStale()
+		case <-p.shutdownC:
+			return
+		}
+	}
+}
+
+// removeStale removes the streams which didn't receive any point for longer than max_staleness.
+func (p *deltaToCumulative) removeStale() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	evicted := 0
+	for key, s := range p.streams {
+		if now.Sub(s.seen) > p.cfg.MaxStaleness {
+			delete(p.streams, key)
+			evicted++
+		}
+	}
+	if evicted > 0 {
+		p.logger.Debug("Removed stale streams", zap.Int("count", evicted))
+	}
+	stats.Record(context.Background(), mStreamsEvicted.M(int64(evicted)), mStreamsTracked.M(int64(len(p.streams))))
+}
+
+func (p *deltaToCumulative) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+
+	var b bytes.Buffer
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		removeAttributes(rm.Resource().Attributes(), p.cfg.Identity.ExcludeResourceAttributes)
+		resourceHash := pdatautil.MapHash(rm.Resource().Attributes())
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				b.Reset()
+				b.Write(resourceHash[:])
+				b.WriteByte(sep)
+				b.WriteString(sm.Scope().Name())
+				b.WriteByte(sep)
+				b.WriteString(sm.Scope().Version())
+				b.WriteByte(sep)
+				b.WriteString(m.Name())
+				b.WriteByte(sep)
+				b.WriteString(m.Unit())
+				b.WriteByte(sep)
+				b.WriteByte(byte('A' + m.Type()))
+
+				switch m.Type() {
+				case pmetric.MetricTypeSum:
+					sum := m.Sum()
+					if sum.AggregationTemporality() != pmetric.AggregationTemporalityDelta {
+						return false
+					}
+					if sum.IsMonotonic() {
+						b.WriteByte('Y')
+					} else {
+						b.WriteByte('N')
+					}
+					base := b.String()
+					sum.DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+						return !p.accumulateNumber(ctx, base, m.Name(), dp, now)
+					})
+					sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+					return sum.DataPoints().Len() == 0
+				case pmetric.MetricTypeHistogram:
+					histogram := m.Histogram()
+					if histogram.AggregationTemporality() != pmetric.AggregationTemporalityDelta {
+						return false
+					}
+					base := b.String()
+					histogram.DataPoints().RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+						return !p.accumulateHistogram(ctx, base, m.Name(), dp, now)
+					})
+					histogram.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+					return histogram.DataPoints().Len() == 0
+				case pmetric.MetricTypeEmpty, pmetric.MetricTypeGauge, pmetric.MetricTypeExponentialHistogram, pmetric.MetricTypeSummary:
+				}
+				return false
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+
+	stats.Record(ctx, mStreamsTracked.M(int64(len(p.streams))))
+	return md, nil
+}
+
+// streamOf returns the stream of a data point, creating it if the maximum number of streams isn't
+// reached. p.mu must be held.
+func (p *deltaToCumulative) streamOf(ctx context.Context, key string, metric string, start, timestamp pcommon.Timestamp, now time.Time) (*stream, bool) {
+	s, ok := p.streams[key]
+	if ok {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tagMetricKey, metric)}, mStreamStaleness.M(now.Sub(s.seen).Milliseconds()))
+		return s, true
+	}
+	if p.cfg.MaxStreams > 0 && len(p.streams) >= p.cfg.MaxStreams {
+		p.logger.Debug("Dropping point of untracked stream, the maximum number of streams is reached",
+			zap.String("metric", metric), zap.Int("max_streams", p.cfg.MaxStreams))
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tagReasonKey, reasonMaxStreams)}, mDataPointsDropped.M(1))
+		return nil, false
+	}
+	if start == 0 {
+		start = timestamp
+	}
+	s = &stream{start: start}
+	p.streams[key] = s
+	return s, true
+}
+
+// accumulateNumber adds the value of the delta point to its stream, and replaces it with the
+// accumulated value. It returns false if the point must be dropped.
+func (p *deltaToCumulative) accumulateNumber(ctx context.Context, base string, metric string, dp pmetric.NumberDataPoint, now time.Time) bool {
+	if dp.Flags().NoRecordedValue() {
+		return false
+	}
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeDouble && math.IsNaN(dp.DoubleValue()) {
+		return false
+	}
+	removeAttributes(dp.Attributes(), p.cfg.Identity.ExcludeAttributes)
+	key := streamKey(base, dp.Attributes(), byte('A'+dp.ValueType()))
+	s, ok := p.streamOf(ctx, key, metric, dp.StartTimestamp(), dp.Timestamp(), now)
+	if !ok {
+		return false
+	}
+
+	s.seen = now
+	if dp.Timestamp() > s.last {
+		s.last = dp.Timestamp()
+	}
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		s.intValue += dp.IntValue()
+		dp.SetIntValue(s.intValue)
+	case pmetric.NumberDataPointValueTypeDouble:
+		s.floatValue += dp.DoubleValue()
+		dp.SetDoubleValue(s.floatValue)
+	case pmetric.NumberDataPointValueTypeEmpty:
+	}
+	dp.SetStartTimestamp(s.start)
+	dp.SetTimestamp(s.last)
+	return true
+}
+
+// accumulateHistogram adds the delta histogram to its stream, and replaces it with the accumulated
+// histogram. It returns false if the point must be dropped.
+func (p *deltaToCumulative) accumulateHistogram(ctx context.Context, base string, metric string, dp pmetric.HistogramDataPoint, now time.Time) bool {
+	if dp.Flags().NoRecordedValue() {
+		return false
+	}
+	removeAttributes(dp.Attributes(), p.cfg.Identity.ExcludeAttributes)
+	key := streamKey(base, dp.Attributes(), 0)
+	s, ok := p.streamOf(ctx, key, metric, dp.StartTimestamp(), dp.Timestamp(), now)
+	if !ok {
+		return false
+	}
+	if s.buckets == nil {
+		s.bounds = dp.ExplicitBounds().AsRaw()
+		s.buckets = make([]uint64, dp.BucketCounts().Len())
+	}
+	if !equalBounds(s.bounds, dp.ExplicitBounds()) || len(s.buckets) != dp.BucketCounts().Len() {
+		p.logger.Debug("Dropping histogram point whose buckets differ from its stream", zap.String("metric", metric))
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tagReasonKey, reasonIncompatible)}, mDataPointsDropped.M(1))
+		return false
+	}
+
+	s.seen = now
+	if dp.Timestamp() > s.last {
+		s.last = dp.Timestamp()
+	}
+	s.count += dp.Count()
+	for i := range s.buckets {
+		s.buckets[i] += dp.BucketCounts().At(i)
+	}
+	if dp.HasSum() {
+		s.sum += dp.Sum()
+		dp.SetSum(s.sum)
+	}
+	if dp.HasMin() && (!s.hasMin || dp.Min() < s.min) {
+		s.min, s.hasMin = dp.Min(), true
+	}
+	if dp.HasMax() && (!s.hasMax || dp.Max() > s.max) {
+		s.max, s.hasMax = dp.Max(), true
+	}
+
+	dp.SetCount(s.count)
+	dp.BucketCounts().FromRaw(s.buckets)
+	if s.hasMin {
+		dp.SetMin(s.min)
+	}
+	if s.hasMax {
+		dp.SetMax(s.max)
+	}
+	dp.SetStartTimestamp(s.start)
+	dp.SetTimestamp(s.last)
+	return true
+}
+
+func streamKey(base string, attrs pcommon.Map, valueType byte) string {
+	attrsHash := pdatautil.MapHash(attrs)
+	b := make([]byte, 0, len(base)+len(attrsHash)+3)
+	b = append(b, base...)
+	b = append(b, sep)
+	b = append(b, attrsHash[:]...)
+	b = append(b, sep, valueType)
+	return string(b)
+}
+
+func removeAttributes(attrs pcommon.Map, keys []string) {
+	for _, key := range keys {
+		attrs.Remove(key)
+	}
+}
+
+func equalBounds(bounds []float64, other pcommon.Float64Slice) bool {
+	if len(bounds) != other.Len() {
+		return false
+	}
+	for i, bound := range bounds {
+		if bound != other.At(i) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap/zaptest"
+)
+
+// newTestProcessor returns a processor whose clock is advanced by the returned function.
+func newTestProcessor(t *testing.T, cfg *Config) (*deltaToCumulative, func(time.Duration)) {
+	require.NoError(t, cfg.Validate())
+	now := time.Unix(1700000000, 0)
+	p := newDeltaToCumulative(zaptest.NewLogger(t), cfg)
+	p.now = func() time.Time { return now }
+	return p, func(d time.Duration) { now = now.Add(d) }
+}
+
+// newDeltaSum returns metrics holding a delta sum sent by a host, with a point per value.
+func newDeltaSum(host string, timestamp int, values ...int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	rm.Resource().Attributes().PutStr("host.name", host)
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	sum := m.SetEmptySum()
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	sum.SetIsMonotonic(true)
+	for _, value := range values {
+		dp := sum.DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("replica", host)
+		dp.SetStartTimestamp(pcommon.Timestamp(timestamp - 10))
+		dp.SetTimestamp(pcommon.Timestamp(timestamp))
+		dp.SetIntValue(value)
+	}
+	return md
+}
+
+func sumPoints(md pmetric.Metrics) []pmetric.NumberDataPoint {
+	var points []pmetric.NumberDataPoint
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				if metrics.At(k).Type() != pmetric.MetricTypeSum {
+					continue
+				}
+				sum := metrics.At(k).Sum()
+				for l := 0; l < sum.DataPoints().Len(); l++ {
+					points = append(points, sum.DataPoints().At(l))
+				}
+			}
+		}
+	}
+	return points
+}
+
+func TestAccumulateSums(t *testing.T) {
+	p, _ := newTestProcessor(t, &Config{})
+
+	md, err := p.processMetrics(context.Background(), newDeltaSum("a", 100, 3, 4))
+	require.NoError(t, err)
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().AggregationTemporality())
+	points := sumPoints(md)
+	require.Len(t, points, 2)
+	assert.Equal(t, int64(3), points[0].IntValue())
+	assert.Equal(t, int64(7), points[1].IntValue())
+	assert.Equal(t, pcommon.Timestamp(90), points[1].StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(100), points[1].Timestamp())
+
+	md, err = p.processMetrics(context.Background(), newDeltaSum("a", 110, 5))
+	require.NoError(t, err)
+	points = sumPoints(md)
+	require.Len(t, points, 1)
+	assert.Equal(t, int64(12), points[0].IntValue())
+	assert.Equal(t, pcommon.Timestamp(90), points[0].StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(110), points[0].Timestamp())
+
+	// the hosts are separate streams
+	md, err = p.processMetrics(context.Background(), newDeltaSum("b", 110, 1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), sumPoints(md)[0].IntValue())
+}
+
+func TestAccumulateAcrossReplicas(t *testing.T) {
+	p, _ := newTestProcessor(t, &Config{
+		Identity: IdentityConfig{
+			ExcludeResourceAttributes: []string{"host.name"},
+			ExcludeAttributes:         []string{"replica"},
+		},
+	})
+
+	md, err := p.processMetrics(context.Background(), newDeltaSum("a", 100, 3))
+	require.NoError(t, err)
+	_, ok := md.ResourceMetrics().At(0).Resource().Attributes().Get("host.name")
+	assert.False(t, ok)
+	assert.Equal(t, 0, sumPoints(md)[0].Attributes().Len())
+
+	// points received out of order from another replica are accumulated in the same stream
+	md, err = p.processMetrics(context.Background(), newDeltaSum("b", 90, 4))
+	require.NoError(t, err)
+	points := sumPoints(md)
+	require.Len(t, points, 1)
+	assert.Equal(t, int64(7), points[0].IntValue())
+	assert.Equal(t, pcommon.Timestamp(90), points[0].StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(100), points[0].Timestamp())
+	assert.Len(t, p.streams, 1)
+}
+
+func TestAccumulateHistograms(t *testing.T) {
+	p, _ := newTestProcessor(t, &Config{})
+
+	newHistogram := func(bounds []float64, buckets []uint64, sum, min, max float64) pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("latency")
+		histogram := m.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp := histogram.DataPoints().AppendEmpty()
+		dp.ExplicitBounds().FromRaw(bounds)
+		dp.BucketCounts().FromRaw(buckets)
+		count := uint64(0)
+		for _, c := range buckets {
+			count += c
+		}
+		dp.SetCount(count)
+		dp.SetSum(sum)
+		dp.SetMin(min)
+		dp.SetMax(max)
+		dp.SetTimestamp(100)
+		return md
+	}
+	point := func(md pmetric.Metrics) pmetric.HistogramDataPoint {
+		return md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0)
+	}
+
+	_, err := p.processMetrics(context.Background(), newHistogram([]float64{1, 10}, []uint64{1, 2, 0}, 12, 0.5, 8))
+	require.NoError(t, err)
+	md, err := p.processMetrics(context.Background(), newHistogram([]float64{1, 10}, []uint64{0, 1, 1}, 20, 2, 15))
+	require.NoError(t, err)
+	dp := point(md)
+	assert.Equal(t, uint64(5), dp.Count())
+	assert.Equal(t, []uint64{1, 3, 1}, dp.BucketCounts().AsRaw())
+	assert.Equal(t, 32.0, dp.Sum())
+	assert.Equal(t, 0.5, dp.Min())
+	assert.Equal(t, 15.0, dp.Max())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().AggregationTemporality())
+
+	// points whose buckets differ from the stream are dropped
+	md, err = p.processMetrics(context.Background(), newHistogram([]float64{1, 5, 10}, []uint64{0, 1, 1, 0}, 9, 2, 7))
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+}
+
+func TestIgnoreOtherMetrics(t *testing.T) {
+	p, _ := newTestProcessor(t, &Config{})
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(5)
+	sum := metrics.AppendEmpty().SetEmptySum()
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.DataPoints().AppendEmpty().SetIntValue(5)
+	expected := pmetric.NewMetrics()
+	md.CopyTo(expected)
+
+	md, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, expected, md)
+	assert.Empty(t, p.streams)
+}
+
+func TestMaxStreams(t *testing.T) {
+	p, _ := newTestProcessor(t, &Config{MaxStreams: 1})
+
+	_, err := p.processMetrics(context.Background(), newDeltaSum("a", 100, 1))
+	require.NoError(t, err)
+	md, err := p.processMetrics(context.Background(), newDeltaSum("b", 100, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+
+	md, err = p.processMetrics(context.Background(), newDeltaSum("a", 110, 1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), sumPoints(md)[0].IntValue())
+}
+
+func TestRemoveStale(t *testing.T) {
+	p, advance := newTestProcessor(t, &Config{MaxStaleness: time.Minute})
+
+	_, err := p.processMetrics(context.Background(), newDeltaSum("a", 100, 1))
+	require.NoError(t, err)
+	advance(40 * time.Second)
+	_, err = p.processMetrics(context.Background(), newDeltaSum("b", 100, 1))
+	require.NoError(t, err)
+
+	advance(30 * time.Second)
+	p.removeStale()
+	assert.Len(t, p.streams, 1)
+
+	// a removed stream starts over
+	md, err := p.processMetrics(context.Background(), newDeltaSum("a", 200, 1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), sumPoints(md)[0].IntValue())
+	assert.Equal(t, pcommon.Timestamp(190), sumPoints(md)[0].StartTimestamp())
+}
@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor"
+
+import (
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const scopeName = "otelcol/eventmetricsprocessor"
+
+// aggregator holds the data points of the metrics, by resource.
+type aggregator struct {
+	metrics     []*metricDesc
+	temporality pmetric.AggregationTemporality
+	// expiration is the duration after which the cumulative data points which didn't aggregate any
+	// event are removed, never when zero.
+	expiration time.Duration
+	// windowStart is the start time of the data points of the delta metrics
+	windowStart pcommon.Timestamp
+
+	resources map[[16]byte]*resourceAggregates
+	// order holds the resources in the order they were received, so that they are emitted in order.
+	order []*resourceAggregates
+}
+
+// resourceAggregates holds the data points of the metrics of a resource.
+type resourceAggregates struct {
+	key      [16]byte
+	resource pcommon.Resource
+	series   map[string]*metricSeries
+}
+
+// metricSeries holds the data points of a metric, by attributes.
+type metricSeries struct {
+	points map[[16]byte]*dataPoint
+	order  []*dataPoint
+}
+
+// dataPoint aggregates the events with the same attributes. The count aggregation only uses the
+// count, the sum aggregation the sum, and the timing aggregation all of the fields.
+type dataPoint struct {
+	key          [16]byte
+	attrs        pcommon.Map
+	startTime    pcommon.Timestamp
+	count        uint64
+	sum          float64
+	min          float64
+	max          float64
+	bucketCounts []uint64
+	// lastSeen is the time of the last event aggregated
+	lastSeen pcommon.Timestamp
+}
+
+func newAggregator(metrics []*metricDesc, temporality pmetric.AggregationTemporality, expiration time.Duration, now pcommon.Timestamp) *aggregator {
+	return &aggregator{
+		metrics:     metrics,
+		temporality: temporality,
+		expiration:  expiration,
+		windowStart: now,
+		resources:   map[[16]byte]*resourceAggregates{},
+	}
+}
+
+// record aggregates an event into the data point of the metric with the given attributes.
+func (a *aggregator) record(resource pcommon.Resource, resourceKey [16]byte, desc *metricDesc, attrs pcommon.Map, value float64, now pcommon.Timestamp) {
+	ra, ok := a.resources[resourceKey]
+	if !ok {
+		ra = &resourceAggregates{key: resourceKey, resource: pcommon.NewResource(), series: map[string]*metricSeries{}}
+		resource.CopyTo(ra.resource)
+		a.resources[resourceKey] = ra
+		a.order = append(a.order, ra)
+	}
+	series, ok := ra.series[desc.name]
+	if !ok {
+		series = &metricSeries{points: map[[16]byte]*dataPoint{}}
+		ra.series[desc.name] = series
+	}
+	key := pdatautil.MapHash(attrs)
+	dp, ok := series.points[key]
+	if !ok {
+		dp = &dataPoint{key: key, attrs: attrs, startTime: now, min: value, max: value}
+		if desc.aggregation == AggregationTiming {
+			dp.bucketCounts = make([]uint64, len(desc.bounds)+1)
+		}
+		series.points[key] = dp
+		series.order = append(series.order, dp)
+	}
+
+	dp.lastSeen = now
+	dp.count++
+	dp.sum += value
+	if desc.aggregation == AggregationTiming {
+		if value < dp.min {
+			dp.min = value
+		}
+		if value > dp.max {
+			dp.max = value
+		}
+		dp.bucketCounts[sort.SearchFloat64s(desc.bounds, value)]++
+	}
+}
+
+// empty returns whether no event was aggregated since the last export.
+func (a *aggregator) empty() bool {
+	return len(a.order) == 0
+}
+
+// export returns the metrics aggregated at the end of a window. The data points of the delta
+// metrics are reset, while the data points of the cumulative metrics keep accumulating until
+// they expire.
+func (a *aggregator) export(now pcommon.Timestamp) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, ra := range a.order {
+		rm := md.ResourceMetrics().AppendEmpty()
+		ra.resource.CopyTo(rm.Resource())
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(scopeName)
+		for _, desc := range a.metrics {
+			series, ok := ra.series[desc.name]
+			if !ok {
+				continue
+			}
+			a.appendMetric(sm.Metrics(), desc, series, now)
+		}
+	}
+
+	if a.temporality == pmetric.AggregationTemporalityDelta {
+		a.resources = map[[16]byte]*resourceAggregates{}
+		a.order = nil
+		a.windowStart = now
+	}
+	return md
+}
+
+// removeExpired removes the cumulative data points which didn't aggregate any event for the
+// expiration duration, and the resources left without data points.
+func (a *aggregator) removeExpired(now pcommon.Timestamp) {
+	if a.temporality != pmetric.AggregationTemporalityCumulative || a.expiration <= 0 {
+		return
+	}
+	before := pcommon.NewTimestampFromTime(now.AsTime().Add(-a.expiration))
+	order := a.order[:0]
+	for _, ra := range a.order {
+		for name, series := range ra.series {
+			points := series.order[:0]
+			for _, point := range series.order {
+				if point.lastSeen < before {
+					delete(series.points, point.key)
+					continue
+				}
+				points = append(points, point)
+			}
+			series.order = points
+			if len(series.order) == 0 {
+				delete(ra.series, name)
+			}
+		}
+		if len(ra.series) == 0 {
+			delete(a.resources, ra.key)
+			continue
+		}
+		order = append(order, ra)
+	}
+	a.order = order
+}
+
+func (a *aggregator) appendMetric(metrics pmetric.MetricSlice, desc *metricDesc, series *metricSeries, now pcommon.Timestamp) {
+	m := metrics.AppendEmpty()
+	m.SetName(desc.name)
+	m.SetDescription(desc.description)
+	m.SetUnit(desc.unit)
+
+	switch desc.aggregation {
+	case AggregationCount, AggregationSum:
+		sum := m.SetEmptySum()
+		sum.SetAggregationTemporality(a.temporality)
+		// the values summed may be negative
+		sum.SetIsMonotonic(desc.aggregation == AggregationCount)
+		dps := sum.DataPoints()
+		dps.EnsureCapacity(len(series.order))
+		for _, point := range series.order {
+			dp := dps.AppendEmpty()
+			point.attrs.CopyTo(dp.Attributes())
+			dp.SetStartTimestamp(a.startTime(point))
+			dp.SetTimestamp(now)
+			if desc.aggregation == AggregationCount {
+				dp.SetIntValue(int64(point.count))
+			} else {
+				dp.SetDoubleValue(point.sum)
+			}
+		}
+	case AggregationTiming:
+		histogram := m.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(a.temporality)
+		dps := histogram.DataPoints()
+		dps.EnsureCapacity(len(series.order))
+		for _, point := range series.order {
+			dp := dps.AppendEmpty()
+			point.attrs.CopyTo(dp.Attributes())
+			dp.SetStartTimestamp(a.startTime(point))
+			dp.SetTimestamp(now)
+			dp.SetCount(point.count)
+			dp.SetSum(point.sum)
+			dp.SetMin(point.min)
+			dp.SetMax(point.max)
+			dp.ExplicitBounds().FromRaw(desc.bounds)
+			dp.BucketCounts().FromRaw(point.bucketCounts)
+		}
+	}
+}
+
+// startTime returns the start time of a data point: the start of the window for the delta
+// metrics, or the time of the first event aggregated for the cumulative metrics.
+func (a *aggregator) startTime(point *dataPoint) pcommon.Timestamp {
+	if a.temporality == pmetric.AggregationTemporalityDelta {
+		return a.windowStart
+	}
+	return point.startTime
+}
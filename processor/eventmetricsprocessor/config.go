@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	delta      = "AGGREGATION_TEMPORALITY_DELTA"
+	cumulative = "AGGREGATION_TEMPORALITY_CUMULATIVE"
+
+	defaultInterval          = 60 * time.Second
+	defaultMetricsExpiration = 5 * time.Minute
+)
+
+const (
+	// AggregationCount counts the events
+	AggregationCount = "count"
+	// AggregationSum sums the values of the events
+	AggregationSum = "sum"
+	// AggregationTiming records the values of the events, e.g. durations, in a histogram
+	AggregationTiming = "timing"
+)
+
+// defaultTimingBuckets are the bounds of the histograms of the timing metrics, in milliseconds.
+var defaultTimingBuckets = []float64{
+	2, 4, 6, 8, 10, 50, 100, 200, 400, 800, 1000, 1400, 2000, 5000, 10_000, 15_000,
+}
+
+// Config defines the configuration for the event metrics processor.
+type Config struct {
+	// MetricsExporter is the ID of the metrics exporter the metrics are sent to. The exporter must
+	// be part of a metrics pipeline.
+	MetricsExporter string `mapstructure:"metrics_exporter"`
+
+	// Interval is the length of the aggregation windows, at the end of which the metrics are sent.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// AggregationTemporality is the temporality of the metrics, either `AGGREGATION_TEMPORALITY_DELTA`
+	// or `AGGREGATION_TEMPORALITY_CUMULATIVE`.
+	AggregationTemporality string `mapstructure:"aggregation_temporality"`
+
+	// MetricsExpiration is the duration after which the cumulative data points which didn't
+	// aggregate any event are no longer exported. Zero means they are never removed.
+	MetricsExpiration time.Duration `mapstructure:"metrics_expiration"`
+
+	// Spans are the metrics aggregated from the spans, by name.
+	Spans map[string]MetricInfo `mapstructure:"spans"`
+
+	// Logs are the metrics aggregated from the log records, by name.
+	Logs map[string]MetricInfo `mapstructure:"logs"`
+
+	// ErrorMode determines how the processor reacts to errors that occur while processing an OTTL condition or expression.
+	// Valid values are `ignore` and `propagate`.
+	// `ignore` means the processor ignores errors returned by conditions and expressions, and continues on to the next event. This is the recommended mode.
+	// `propagate` means the processor returns the error up the pipeline.  This will result in the payload being dropped from the collector.
+	// The default value is `propagate`.
+	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
+}
+
+// MetricInfo defines a metric aggregated from the events.
+type MetricInfo struct {
+	// Description is the description of the metric.
+	Description string `mapstructure:"description"`
+
+	// Unit is the unit of the metric.
+	Unit string `mapstructure:"unit"`
+
+	// Aggregation is the aggregation of the events, one of `count`, `sum` or `timing`.
+	Aggregation string `mapstructure:"aggregation"`
+
+	// Value is the OTTL expression of the value summed or recorded, required by the `sum` and `timing`
+	// aggregations, except for the `timing` of spans which records their duration in milliseconds by default.
+	Value string `mapstructure:"value"`
+
+	// Buckets are the bounds of the histogram of the `timing` aggregation.
+	// See defaultTimingBuckets in config.go for the default value.
+	Buckets []float64 `mapstructure:"buckets"`
+
+	// Conditions are OTTL conditions selecting the events aggregated, all of them when empty.
+	Conditions []string `mapstructure:"conditions"`
+
+	// Dimensions are the attributes of the data points, selected by OTTL expressions.
+	Dimensions []Dimension `mapstructure:"dimensions"`
+}
+
+// Dimension defines an attribute of the data points.
+type Dimension struct {
+	// Name is the name of the attribute.
+	Name string `mapstructure:"name"`
+
+	// Value is the OTTL expression of the value of the attribute.
+	Value string `mapstructure:"value"`
+
+	// Default is the value of the attribute when the expression evaluates to nil. The events are
+	// not aggregated when it is not set.
+	Default *string `mapstructure:"default"`
+}
+
+var _ component.ConfigValidator = (*Config)(nil)
+
+// getAggregationTemporality converts the temporality of the configuration into an AggregationTemporality.
+func (cfg *Config) getAggregationTemporality() pmetric.AggregationTemporality {
+	if cfg.AggregationTemporality == delta {
+		return pmetric.AggregationTemporalityDelta
+	}
+	return pmetric.AggregationTemporalityCumulative
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.MetricsExporter == "" {
+		return errors.New("metrics_exporter must be specified")
+	}
+	if cfg.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if cfg.MetricsExpiration < 0 {
+		return errors.New("metrics_expiration must not be negative")
+	}
+	if cfg.MetricsExpiration > 0 && cfg.MetricsExpiration < cfg.Interval {
+		return errors.New("metrics_expiration must not be shorter than interval")
+	}
+	if cfg.AggregationTemporality != delta && cfg.AggregationTemporality != cumulative {
+		return fmt.Errorf("unsupported aggregation_temporality %q, must be either %q or %q", cfg.AggregationTemporality, delta, cumulative)
+	}
+	if len(cfg.Spans) == 0 && len(cfg.Logs) == 0 {
+		return errors.New("at least one of spans or logs metrics must be specified")
+	}
+
+	for name, info := range cfg.Spans {
+		if err := info.validate(name, true); err != nil {
+			return fmt.Errorf("spans: %w", err)
+		}
+	}
+	for name, info := range cfg.Logs {
+		if err := info.validate(name, false); err != nil {
+			return fmt.Errorf("logs: %w", err)
+		}
+	}
+
+	set := component.TelemetrySettings{Logger: zap.NewNop()}
+	if _, err := newSpanMetricDefs(cfg.Spans, ottl.PropagateError, set); err != nil {
+		return fmt.Errorf("spans: %w", err)
+	}
+	if _, err := newLogMetricDefs(cfg.Logs, ottl.PropagateError, set); err != nil {
+		return fmt.Errorf("logs: %w", err)
+	}
+	return nil
+}
+
+// validate checks the definition of a metric, except its OTTL conditions and expressions.
+func (i *MetricInfo) validate(name string, spans bool) error {
+	if name == "" {
+		return errors.New("metric name missing")
+	}
+	switch i.Aggregation {
+	case AggregationCount:
+		if i.Value != "" {
+			return fmt.Errorf("metric %q: value is not supported by the count aggregation", name)
+		}
+	case AggregationSum:
+		if i.Value == "" {
+			return fmt.Errorf("metric %q: value must be specified for the sum aggregation", name)
+		}
+	case AggregationTiming:
+		if i.Value == "" && !spans {
+			return fmt.Errorf("metric %q: value must be specified for the timing aggregation", name)
+		}
+	default:
+		return fmt.Errorf("metric %q: unsupported aggregation %q, must be one of %q, %q or %q",
+			name, i.Aggregation, AggregationCount, AggregationSum, AggregationTiming)
+	}
+	if len(i.Buckets) > 0 && i.Aggregation != AggregationTiming {
+		return fmt.Errorf("metric %q: buckets are only supported by the timing aggregation", name)
+	}
+	for j := 1; j < len(i.Buckets); j++ {
+		if i.Buckets[j] <= i.Buckets[j-1] {
+			return fmt.Errorf("metric %q: buckets must be sorted in increasing order", name)
+		}
+	}
+
+	names := map[string]struct{}{}
+	for _, dim := range i.Dimensions {
+		if dim.Name == "" {
+			return fmt.Errorf("metric %q: dimension name missing", name)
+		}
+		if _, ok := names[dim.Name]; ok {
+			return fmt.Errorf("metric %q: duplicate dimension %q", name, dim.Name)
+		}
+		names[dim.Name] = struct{}{}
+		if dim.Value == "" {
+			return fmt.Errorf("metric %q: value of dimension %q missing", name, dim.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmetricsprocessor
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	unknown := "unknown"
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				MetricsExporter:        "otlp/metrics",
+				Interval:               30 * time.Second,
+				MetricsExpiration:      10 * time.Minute,
+				AggregationTemporality: delta,
+				Spans: map[string]MetricInfo{
+					"http.server.duration": {
+						Description: "Duration of the HTTP requests.",
+						Unit:        "ms",
+						Aggregation: AggregationTiming,
+						Buckets:     []float64{10, 100, 1000},
+						Conditions:  []string{"kind == SPAN_KIND_SERVER"},
+						Dimensions: []Dimension{
+							{Name: "http.route", Value: `attributes["http.route"]`, Default: &unknown},
+							{Name: "service.name", Value: `resource.attributes["service.name"]`},
+						},
+					},
+				},
+				Logs: map[string]MetricInfo{
+					"payments.count": {
+						Aggregation: AggregationCount,
+						Dimensions:  []Dimension{{Name: "status", Value: `attributes["status"]`}},
+					},
+					"payments.amount": {
+						Unit:        "USD",
+						Aggregation: AggregationSum,
+						Value:       `attributes["amount"]`,
+						Conditions:  []string{`attributes["status"] == "paid"`},
+					},
+				},
+				ErrorMode: ottl.IgnoreError,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_exporter"),
+			errorMessage: "metrics_exporter must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_interval"),
+			errorMessage: "interval must be positive",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_expiration"),
+			errorMessage: "metrics_expiration must not be shorter than interval",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_temporality"),
+			errorMessage: `unsupported aggregation_temporality "AGGREGATION_TEMPORALITY_UNSPECIFIED", must be either "AGGREGATION_TEMPORALITY_DELTA" or "AGGREGATION_TEMPORALITY_CUMULATIVE"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_metrics"),
+			errorMessage: "at least one of spans or logs metrics must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_aggregation"),
+			errorMessage: `spans: metric "calls": unsupported aggregation "average", must be one of "count", "sum" or "timing"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_count_value"),
+			errorMessage: `spans: metric "calls": value is not supported by the count aggregation`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_sum_value"),
+			errorMessage: `logs: metric "bytes": value must be specified for the sum aggregation`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_timing_value"),
+			errorMessage: `logs: metric "duration": value must be specified for the timing aggregation`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_buckets"),
+			errorMessage: `spans: metric "duration": buckets must be sorted in increasing order`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_count_buckets"),
+			errorMessage: `spans: metric "calls": buckets are only supported by the timing aggregation`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_dimension_name"),
+			errorMessage: `spans: metric "calls": dimension name missing`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate_dimension"),
+			errorMessage: `spans: metric "calls": duplicate dimension "operation"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_dimension_value"),
+			errorMessage: `spans: metric "calls": value of dimension "operation" missing`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateExpressions(t *testing.T) {
+	tests := []struct {
+		name   string
+		info   MetricInfo
+		prefix string
+	}{
+		{
+			name:   "condition",
+			info:   MetricInfo{Aggregation: AggregationCount, Conditions: []string{`name ==`}},
+			prefix: `spans: metric "calls": `,
+		},
+		{
+			name:   "value",
+			info:   MetricInfo{Aggregation: AggregationSum, Value: `attributes[`},
+			prefix: `spans: metric "calls": unable to parse value`,
+		},
+		{
+			name:   "dimension",
+			info:   MetricInfo{Aggregation: AggregationCount, Dimensions: []Dimension{{Name: "operation", Value: `unknown_path`}}},
+			prefix: `spans: metric "calls": unable to parse the value of dimension "operation"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MetricsExporter = "otlp"
+			cfg.Spans = map[string]MetricInfo{"calls": tt.info}
+			err := cfg.Validate()
+			require.Error(t, err)
+			assert.True(t, strings.HasPrefix(err.Error(), tt.prefix), err.Error())
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package eventmetricsprocessor implements a processor which aggregates spans and log records
+// into count, sum and timing metrics, with dimensions selected by OTTL expressions, and sends
+// them to a metrics exporter.
+package eventmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor"
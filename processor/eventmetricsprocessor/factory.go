@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor/internal/metadata"
+)
+
+// NewFactory returns a new factory for the event metrics processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithTraces(createTracesProcessor, metadata.TracesStability),
+		processor.WithLogs(createLogsProcessor, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Interval:               defaultInterval,
+		MetricsExpiration:      defaultMetricsExpiration,
+		AggregationTemporality: cumulative,
+		ErrorMode:              ottl.PropagateError,
+	}
+}
+
+func createTracesProcessor(
+	_ context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (processor.Traces, error) {
+	return newTracesProcessor(set.TelemetrySettings, cfg.(*Config), nextConsumer)
+}
+
+func createLogsProcessor(
+	_ context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (processor.Logs, error) {
+	return newLogsProcessor(set.TelemetrySettings, cfg.(*Config), nextConsumer)
+}
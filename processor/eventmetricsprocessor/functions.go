@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// OTTL only parses statements, so the expressions of the values and the dimensions are
+// wrapped into the invocation of functions returning the value of their argument.
+const (
+	valueFunction     = "value"
+	dimensionFunction = "dimension"
+)
+
+type valueArguments[K any] struct {
+	Target ottl.FloatLikeGetter[K] `ottlarg:"0"`
+}
+
+type dimensionArguments[K any] struct {
+	Target ottl.Getter[K] `ottlarg:"0"`
+}
+
+// functions returns the OTTL converters and the functions wrapping the expressions.
+func functions[K any]() map[string]ottl.Factory[K] {
+	m := ottlfuncs.StandardConverters[K]()
+	for _, f := range []ottl.Factory[K]{
+		ottl.NewFactory(valueFunction, &valueArguments[K]{}, createValueFunction[K]),
+		ottl.NewFactory(dimensionFunction, &dimensionArguments[K]{}, createDimensionFunction[K]),
+	} {
+		m[f.Name()] = f
+	}
+	return m
+}
+
+// createValueFunction creates a function returning its argument converted to a float64, or nil.
+func createValueFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*valueArguments[K])
+	if !ok {
+		return nil, fmt.Errorf("valueFactory args must be of type *valueArguments[K]")
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := args.Target.Get(ctx, tCtx)
+		if err != nil || val == nil {
+			return nil, err
+		}
+		return *val, nil
+	}, nil
+}
+
+// createDimensionFunction creates a function returning its argument.
+func createDimensionFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*dimensionArguments[K])
+	if !ok {
+		return nil, fmt.Errorf("dimensionFactory args must be of type *dimensionArguments[K]")
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		return args.Target.Get(ctx, tCtx)
+	}, nil
+}
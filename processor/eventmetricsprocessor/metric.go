@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+// metricDesc describes a metric independently of the events it is aggregated from.
+type metricDesc struct {
+	name        string
+	description string
+	unit        string
+	aggregation string
+	bounds      []float64
+}
+
+// metricDef defines how a metric is aggregated from the events of the context K.
+type metricDef[K any] struct {
+	metricDesc
+	// condition selects the events aggregated, nil when all of them are
+	condition expr.BoolExpr[K]
+	// value is the statement evaluating the value of the events, nil for the count aggregation
+	// and when defaultValue applies
+	value        *ottl.Statement[K]
+	defaultValue func(tCtx K) float64
+	dimensions   []dimensionDef[K]
+}
+
+type dimensionDef[K any] struct {
+	name         string
+	value        *ottl.Statement[K]
+	defaultValue *string
+}
+
+func newSpanMetricDefs(infos map[string]MetricInfo, errorMode ottl.ErrorMode, set component.TelemetrySettings) ([]*metricDef[ottlspan.TransformContext], error) {
+	parser, err := ottlspan.NewParser(functions[ottlspan.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	newCondition := func(conditions []string) (expr.BoolExpr[ottlspan.TransformContext], error) {
+		return filterottl.NewBoolExprForSpan(conditions, filterottl.StandardSpanFuncs(), errorMode, set)
+	}
+	defs, err := newMetricDefs(infos, parser, newCondition)
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range defs {
+		if def.aggregation == AggregationTiming && def.value == nil {
+			def.defaultValue = spanDurationMillis
+		}
+	}
+	return defs, nil
+}
+
+func newLogMetricDefs(infos map[string]MetricInfo, errorMode ottl.ErrorMode, set component.TelemetrySettings) ([]*metricDef[ottllog.TransformContext], error) {
+	parser, err := ottllog.NewParser(functions[ottllog.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	newCondition := func(conditions []string) (expr.BoolExpr[ottllog.TransformContext], error) {
+		return filterottl.NewBoolExprForLog(conditions, filterottl.StandardLogFuncs(), errorMode, set)
+	}
+	return newMetricDefs(infos, parser, newCondition)
+}
+
+// newMetricDefs parses the conditions and the expressions of the metrics, and returns their
+// definitions sorted by name.
+func newMetricDefs[K any](
+	infos map[string]MetricInfo,
+	parser ottl.Parser[K],
+	newCondition func(conditions []string) (expr.BoolExpr[K], error),
+) ([]*metricDef[K], error) {
+	defs := make([]*metricDef[K], 0, len(infos))
+	for name, info := range infos {
+		def := &metricDef[K]{
+			metricDesc: metricDesc{
+				name:        name,
+				description: info.Description,
+				unit:        info.Unit,
+				aggregation: info.Aggregation,
+			},
+		}
+		if info.Aggregation == AggregationTiming {
+			def.bounds = defaultTimingBuckets
+			if len(info.Buckets) > 0 {
+				def.bounds = info.Buckets
+			}
+		}
+
+		var err error
+		if len(info.Conditions) > 0 {
+			if def.condition, err = newCondition(info.Conditions); err != nil {
+				return nil, fmt.Errorf("metric %q: %w", name, err)
+			}
+		}
+		if info.Value != "" {
+			if def.value, err = parser.ParseStatement(fmt.Sprintf("%s(%s)", valueFunction, info.Value)); err != nil {
+				return nil, fmt.Errorf("metric %q: unable to parse value %q: %w", name, info.Value, err)
+			}
+		}
+		for _, dim := range info.Dimensions {
+			var statement *ottl.Statement[K]
+			if statement, err = parser.ParseStatement(fmt.Sprintf("%s(%s)", dimensionFunction, dim.Value)); err != nil {
+				return nil, fmt.Errorf("metric %q: unable to parse the value of dimension %q: %w", name, dim.Name, err)
+			}
+			def.dimensions = append(def.dimensions, dimensionDef[K]{
+				name:         dim.Name,
+				value:        statement,
+				defaultValue: dim.Default,
+			})
+		}
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].name < defs[j].name })
+	return defs, nil
+}
+
+// evaluate returns the attributes of the data point the event is aggregated into, and the value
+// of the event. The event is not aggregated when the boolean is false, because it does not match the
+// conditions, or the value or a dimension without default is nil.
+func (d *metricDef[K]) evaluate(ctx context.Context, tCtx K) (pcommon.Map, float64, bool, error) {
+	if d.condition != nil {
+		match, err := d.condition.Eval(ctx, tCtx)
+		if err != nil || !match {
+			return pcommon.Map{}, 0, false, err
+		}
+	}
+
+	var value float64
+	switch {
+	case d.value != nil:
+		val, _, err := d.value.Execute(ctx, tCtx)
+		if err != nil {
+			return pcommon.Map{}, 0, false, fmt.Errorf("metric %q: failed evaluating the value: %w", d.name, err)
+		}
+		if val == nil {
+			return pcommon.Map{}, 0, false, nil
+		}
+		value = val.(float64)
+	case d.defaultValue != nil:
+		value = d.defaultValue(tCtx)
+	}
+
+	attrs := pcommon.NewMap()
+	attrs.EnsureCapacity(len(d.dimensions))
+	for _, dim := range d.dimensions {
+		val, _, err := dim.value.Execute(ctx, tCtx)
+		if err != nil {
+			return pcommon.Map{}, 0, false, fmt.Errorf("metric %q: failed evaluating dimension %q: %w", d.name, dim.name, err)
+		}
+		if val == nil {
+			if dim.defaultValue == nil {
+				return pcommon.Map{}, 0, false, nil
+			}
+			val = *dim.defaultValue
+		}
+		putDimension(attrs, dim.name, val)
+	}
+	return attrs, value, true, nil
+}
+
+// putDimension puts the value of a dimension, as returned by an OTTL expression, into the attributes.
+func putDimension(attrs pcommon.Map, name string, val interface{}) {
+	switch v := val.(type) {
+	case string:
+		attrs.PutStr(name, v)
+	case bool:
+		attrs.PutBool(name, v)
+	case int64:
+		attrs.PutInt(name, v)
+	case float64:
+		attrs.PutDouble(name, v)
+	case []byte:
+		attrs.PutEmptyBytes(name).FromRaw(v)
+	case pcommon.Map:
+		v.CopyTo(attrs.PutEmptyMap(name))
+	case pcommon.Slice:
+		v.CopyTo(attrs.PutEmptySlice(name))
+	default:
+		attrs.PutStr(name, fmt.Sprint(v))
+	}
+}
+
+// spanDurationMillis is the default value of the timing metrics of the spans.
+func spanDurationMillis(tCtx ottlspan.TransformContext) float64 {
+	span := tCtx.GetSpan()
+	return float64(span.EndTimestamp()-span.StartTimestamp()) / float64(time.Millisecond)
+}
@@ -0,0 +1,252 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/eventmetricsprocessor"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// eventMetricsProcessor aggregates the spans or the log records into metrics, which are sent to
+// the metrics exporter at the end of each window. The events are forwarded unmodified to the next
+// consumer.
+type eventMetricsProcessor struct {
+	logger *zap.Logger
+	cfg    *Config
+
+	spanMetrics []*metricDef[ottlspan.TransformContext]
+	logMetrics  []*metricDef[ottllog.TransformContext]
+	nextTraces  consumer.Traces
+	nextLogs    consumer.Logs
+
+	metricsExporter consumer.Metrics
+
+	mu         sync.Mutex
+	aggregator *aggregator
+
+	startOnce    sync.Once
+	shutdownOnce sync.Once
+	shutdownC    chan struct{}
+	done         chan struct{}
+}
+
+func newTracesProcessor(set component.TelemetrySettings, cfg *Config, next consumer.Traces) (*eventMetricsProcessor, error) {
+	defs, err := newSpanMetricDefs(cfg.Spans, cfg.ErrorMode, set)
+	if err != nil {
+		return nil, err
+	}
+	p := newProcessor(set.Logger, cfg)
+	p.spanMetrics = defs
+	p.nextTraces = next
+	p.aggregator = newAggregator(descs(defs), cfg.getAggregationTemporality(), cfg.MetricsExpiration, pcommon.NewTimestampFromTime(time.Now()))
+	return p, nil
+}
+
+func newLogsProcessor(set component.TelemetrySettings, cfg *Config, next consumer.Logs) (*eventMetricsProcessor, error) {
+	defs, err := newLogMetricDefs(cfg.Logs, cfg.ErrorMode, set)
+	if err != nil {
+		return nil, err
+	}
+	p := newProcessor(set.Logger, cfg)
+	p.logMetrics = defs
+	p.nextLogs = next
+	p.aggregator = newAggregator(descs(defs), cfg.getAggregationTemporality(), cfg.MetricsExpiration, pcommon.NewTimestampFromTime(time.Now()))
+	return p, nil
+}
+
+func newProcessor(logger *zap.Logger, cfg *Config) *eventMetricsProcessor {
+	return &eventMetricsProcessor{
+		logger:    logger,
+		cfg:       cfg,
+		shutdownC: make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+func descs[K any](defs []*metricDef[K]) []*metricDesc {
+	metrics := make([]*metricDesc, len(defs))
+	for i, def := range defs {
+		metrics[i] = &def.metricDesc
+	}
+	return metrics
+}
+
+// Start looks up the metrics exporter among the exporters of the metrics pipelines, and starts
+// the export loop. The exporters are only exposed by the deprecated GetExporters function of the
+// host, the coupling with the exporter is documented in the README.
+func (p *eventMetricsProcessor) Start(_ context.Context, host component.Host) error {
+	var available []string
+	for id, exp := range host.GetExporters()[component.DataTypeMetrics] { //nolint:staticcheck
+		available = append(available, id.String())
+		if id.String() != p.cfg.MetricsExporter {
+			continue
+		}
+		metricsExporter, ok := exp.(consumer.Metrics)
+		if !ok {
+			return fmt.Errorf("the exporter %q isn't a metrics exporter", id.String())
+		}
+		p.metricsExporter = metricsExporter
+	}
+	if p.metricsExporter == nil {
+		return fmt.Errorf("failed to find metrics exporter %q, please configure metrics_exporter from one of: %v", p.cfg.MetricsExporter, available)
+	}
+
+	p.startOnce.Do(func() {
+		go p.exportLoop()
+	})
+	return nil
+}
+
+// Shutdown stops the export loop and exports the metrics of the current window.
+func (p *eventMetricsProcessor) Shutdown(ctx context.Context) error {
+	started := true
+	p.startOnce.Do(func() { started = false })
+	p.shutdownOnce.Do(func() { close(p.shutdownC) })
+	if !started {
+		return nil
+	}
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Capabilities reports that the processor does not mutate the received data.
+func (p *eventMetricsProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces aggregates the spans into the metrics and forwards them to the next consumer.
+func (p *eventMetricsProcessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	var errs error
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	p.mu.Lock()
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resourceKey := pdatautil.MapHash(rs.Resource().Attributes())
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				tCtx := ottlspan.NewTransformContext(spans.At(k), ss.Scope(), rs.Resource())
+				errs = multierr.Append(errs, record(ctx, p.aggregator, p.spanMetrics, tCtx, rs.Resource(), resourceKey, now))
+			}
+		}
+	}
+	p.mu.Unlock()
+
+	if err := p.handleError(errs, "failed aggregating the spans"); err != nil {
+		return err
+	}
+	return p.nextTraces.ConsumeTraces(ctx, td)
+}
+
+// ConsumeLogs aggregates the log records into the metrics and forwards them to the next consumer.
+func (p *eventMetricsProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	var errs error
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	p.mu.Lock()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resourceKey := pdatautil.MapHash(rl.Resource().Attributes())
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			lrs := sl.LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				tCtx := ottllog.NewTransformContext(lrs.At(k), sl.Scope(), rl.Resource())
+				errs = multierr.Append(errs, record(ctx, p.aggregator, p.logMetrics, tCtx, rl.Resource(), resourceKey, now))
+			}
+		}
+	}
+	p.mu.Unlock()
+
+	if err := p.handleError(errs, "failed aggregating the log records"); err != nil {
+		return err
+	}
+	return p.nextLogs.ConsumeLogs(ctx, ld)
+}
+
+// record aggregates an event into the metrics it is selected by.
+func record[K any](ctx context.Context, agg *aggregator, defs []*metricDef[K], tCtx K, resource pcommon.Resource, resourceKey [16]byte, now pcommon.Timestamp) error {
+	var errs error
+	for _, def := range defs {
+		attrs, value, ok, err := def.evaluate(ctx, tCtx)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		if ok {
+			agg.record(resource, resourceKey, &def.metricDesc, attrs, value, now)
+		}
+	}
+	return errs
+}
+
+// handleError logs the errors of the evaluation of the metrics with the ignore error mode, and
+// returns them otherwise.
+func (p *eventMetricsProcessor) handleError(errs error, msg string) error {
+	if errs == nil {
+		return nil
+	}
+	if p.cfg.ErrorMode == ottl.IgnoreError {
+		p.logger.Warn(msg, zap.Error(errs))
+		return nil
+	}
+	return errs
+}
+
+func (p *eventMetricsProcessor) exportLoop() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.export()
+		case <-p.shutdownC:
+			p.export()
+			return
+		}
+	}
+}
+
+// export sends the metrics of the window to the metrics exporter.
+func (p *eventMetricsProcessor) export() {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	p.mu.Lock()
+	p.aggregator.removeExpired(now)
+	if p.aggregator.empty() {
+		p.mu.Unlock()
+		return
+	}
+	md := p.aggregator.export(now)
+	p.mu.Unlock()
+
+	if err := p.metricsExporter.ConsumeMetrics(context.Background(), md); err != nil {
+		p.logger.Warn("Failed to send the aggregated metrics", zap.Error(err), zap.Int("data_points", md.DataPointCount()))
+	}
+}
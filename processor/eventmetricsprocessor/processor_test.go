@@ -0,0 +1,330 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmetricsprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type mockHost struct {
+	component.Host
+	exps map[component.DataType]map[component.ID]component.Component
+}
+
+func (m *mockHost) GetExporters() map[component.DataType]map[component.ID]component.Component {
+	return m.exps
+}
+
+type mockMetricsExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	*consumertest.MetricsSink
+}
+
+func newMockHost(sink *consumertest.MetricsSink) component.Host {
+	return &mockHost{
+		Host: componenttest.NewNopHost(),
+		exps: map[component.DataType]map[component.ID]component.Component{
+			component.DataTypeMetrics: {
+				component.NewID("otlp"): &mockMetricsExporter{MetricsSink: sink},
+			},
+		},
+	}
+}
+
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsExporter = "otlp"
+	cfg.Interval = time.Hour
+	return cfg
+}
+
+func TestStartMissingExporter(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.MetricsExporter = "otlp/missing"
+	cfg.Spans = map[string]MetricInfo{"calls": {Aggregation: AggregationCount}}
+	p, err := newTracesProcessor(componenttest.NewNopTelemetrySettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	err = p.Start(context.Background(), newMockHost(new(consumertest.MetricsSink)))
+	assert.EqualError(t, err, `failed to find metrics exporter "otlp/missing", please configure metrics_exporter from one of: [otlp]`)
+}
+
+func TestConsumeTraces(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.AggregationTemporality = delta
+	cfg.Spans = map[string]MetricInfo{
+		"calls": {
+			Aggregation: AggregationCount,
+			Dimensions:  []Dimension{{Name: "operation", Value: "name"}},
+		},
+		"duration": {
+			Aggregation: AggregationTiming,
+			Buckets:     []float64{10, 100},
+			Conditions:  []string{"kind == SPAN_KIND_SERVER"},
+			Dimensions:  []Dimension{{Name: "http.route", Value: `attributes["http.route"]`}},
+		},
+	}
+	next := new(consumertest.TracesSink)
+	p, err := newTracesProcessor(componenttest.NewNopTelemetrySettings(), cfg, next)
+	require.NoError(t, err)
+	sink := new(consumertest.MetricsSink)
+	require.NoError(t, p.Start(context.Background(), newMockHost(sink)))
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	start := time.Now()
+	for _, s := range []struct {
+		name     string
+		kind     ptrace.SpanKind
+		route    string
+		duration time.Duration
+	}{
+		{name: "GET /cart", kind: ptrace.SpanKindServer, route: "/cart", duration: 5 * time.Millisecond},
+		{name: "GET /cart", kind: ptrace.SpanKindServer, route: "/cart", duration: 50 * time.Millisecond},
+		{name: "SELECT", kind: ptrace.SpanKindClient, duration: 2 * time.Millisecond},
+		// without the dimension of the duration
+		{name: "GET /", kind: ptrace.SpanKindServer, duration: 5 * time.Millisecond},
+	} {
+		span := spans.AppendEmpty()
+		span.SetName(s.name)
+		span.SetKind(s.kind)
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(s.duration)))
+		if s.route != "" {
+			span.Attributes().PutStr("http.route", s.route)
+		}
+	}
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), td))
+	assert.Equal(t, 4, next.SpanCount())
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	rms := sink.AllMetrics()[0].ResourceMetrics()
+	require.Equal(t, 1, rms.Len())
+	assert.Equal(t, map[string]interface{}{"service.name": "checkout"}, rms.At(0).Resource().Attributes().AsRaw())
+	sm := rms.At(0).ScopeMetrics().At(0)
+	assert.Equal(t, scopeName, sm.Scope().Name())
+	require.Equal(t, 2, sm.Metrics().Len())
+
+	calls := sm.Metrics().At(0)
+	assert.Equal(t, "calls", calls.Name())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, calls.Sum().AggregationTemporality())
+	assert.True(t, calls.Sum().IsMonotonic())
+	dps := calls.Sum().DataPoints()
+	require.Equal(t, 3, dps.Len())
+	expected := []struct {
+		operation string
+		count     int64
+	}{{"GET /cart", 2}, {"SELECT", 1}, {"GET /", 1}}
+	for i, e := range expected {
+		assert.Equal(t, map[string]interface{}{"operation": e.operation}, dps.At(i).Attributes().AsRaw())
+		assert.Equal(t, e.count, dps.At(i).IntValue())
+	}
+
+	duration := sm.Metrics().At(1)
+	assert.Equal(t, "duration", duration.Name())
+	require.Equal(t, 1, duration.Histogram().DataPoints().Len())
+	hdp := duration.Histogram().DataPoints().At(0)
+	assert.Equal(t, map[string]interface{}{"http.route": "/cart"}, hdp.Attributes().AsRaw())
+	assert.Equal(t, uint64(2), hdp.Count())
+	assert.Equal(t, 55.0, hdp.Sum())
+	assert.Equal(t, 5.0, hdp.Min())
+	assert.Equal(t, 50.0, hdp.Max())
+	assert.Equal(t, []float64{10, 100}, hdp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{1, 1, 0}, hdp.BucketCounts().AsRaw())
+}
+
+func newPaymentLogs(payments ...map[string]interface{}) plog.Logs {
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, payment := range payments {
+		_ = lrs.AppendEmpty().Attributes().FromRaw(payment)
+	}
+	return ld
+}
+
+func TestConsumeLogsTemporality(t *testing.T) {
+	tests := []struct {
+		temporality string
+		// the totals exported at the end of the second window
+		expected map[string]float64
+	}{
+		{
+			temporality: delta,
+			expected:    map[string]float64{"EUR": 5},
+		},
+		{
+			temporality: cumulative,
+			expected:    map[string]float64{"USD": 22.5, "EUR": 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.temporality, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.AggregationTemporality = tt.temporality
+			cfg.Logs = map[string]MetricInfo{
+				"amount": {
+					Aggregation: AggregationSum,
+					Value:       `attributes["amount"]`,
+					Dimensions:  []Dimension{{Name: "currency", Value: `attributes["currency"]`}},
+				},
+			}
+			p, err := newLogsProcessor(componenttest.NewNopTelemetrySettings(), cfg, consumertest.NewNop())
+			require.NoError(t, err)
+			sink := new(consumertest.MetricsSink)
+			p.metricsExporter = sink
+
+			require.NoError(t, p.ConsumeLogs(context.Background(), newPaymentLogs(
+				map[string]interface{}{"amount": 10, "currency": "USD"},
+				// the strings are converted
+				map[string]interface{}{"amount": "12.5", "currency": "USD"},
+				// without value
+				map[string]interface{}{"currency": "USD"},
+			)))
+			p.export()
+			require.NoError(t, p.ConsumeLogs(context.Background(), newPaymentLogs(
+				map[string]interface{}{"amount": 5.0, "currency": "EUR"},
+			)))
+			p.export()
+
+			require.Len(t, sink.AllMetrics(), 2)
+			first := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum()
+			assert.False(t, first.IsMonotonic())
+			require.Equal(t, 1, first.DataPoints().Len())
+			assert.Equal(t, 22.5, first.DataPoints().At(0).DoubleValue())
+
+			second := sink.AllMetrics()[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum()
+			totals := map[string]float64{}
+			for i := 0; i < second.DataPoints().Len(); i++ {
+				dp := second.DataPoints().At(i)
+				currency, _ := dp.Attributes().Get("currency")
+				totals[currency.Str()] = dp.DoubleValue()
+				assert.LessOrEqual(t, dp.StartTimestamp(), dp.Timestamp())
+			}
+			assert.Equal(t, tt.expected, totals)
+			if tt.temporality == delta {
+				// the second window starts at the end of the first
+				assert.Equal(t, first.DataPoints().At(0).Timestamp(), second.DataPoints().At(0).StartTimestamp())
+			} else {
+				assert.Equal(t, first.DataPoints().At(0).StartTimestamp(), second.DataPoints().At(0).StartTimestamp())
+			}
+		})
+	}
+}
+
+func TestConsumeLogsExpiration(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.MetricsExpiration = 50 * time.Millisecond
+	cfg.Logs = map[string]MetricInfo{
+		"payments": {
+			Aggregation: AggregationCount,
+			Dimensions:  []Dimension{{Name: "currency", Value: `attributes["currency"]`}},
+		},
+	}
+	p, err := newLogsProcessor(componenttest.NewNopTelemetrySettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	sink := new(consumertest.MetricsSink)
+	p.metricsExporter = sink
+
+	currencies := func(md pmetric.Metrics) []string {
+		var currencies []string
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			dps := md.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+			for j := 0; j < dps.Len(); j++ {
+				currency, _ := dps.At(j).Attributes().Get("currency")
+				currencies = append(currencies, currency.Str())
+			}
+		}
+		return currencies
+	}
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newPaymentLogs(map[string]interface{}{"currency": "USD"})))
+	p.export()
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, p.ConsumeLogs(context.Background(), newPaymentLogs(map[string]interface{}{"currency": "EUR"})))
+	p.export()
+	time.Sleep(100 * time.Millisecond)
+	// nothing is exported once all the data points expired
+	p.export()
+
+	require.Len(t, sink.AllMetrics(), 2)
+	assert.Equal(t, []string{"USD"}, currencies(sink.AllMetrics()[0]))
+	// the data points which didn't aggregate any event for metrics_expiration are removed
+	assert.Equal(t, []string{"EUR"}, currencies(sink.AllMetrics()[1]))
+}
+
+func TestConsumeLogsDimensionDefault(t *testing.T) {
+	unknown := "unknown"
+	cfg := newTestConfig()
+	cfg.Logs = map[string]MetricInfo{
+		"payments": {
+			Aggregation: AggregationCount,
+			Dimensions: []Dimension{
+				{Name: "currency", Value: `attributes["currency"]`, Default: &unknown},
+				{Name: "amount", Value: `Int(attributes["amount"])`},
+			},
+		},
+	}
+	p, err := newLogsProcessor(componenttest.NewNopTelemetrySettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	sink := new(consumertest.MetricsSink)
+	p.metricsExporter = sink
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newPaymentLogs(
+		map[string]interface{}{"amount": "10"},
+		// without the dimension without default
+		map[string]interface{}{"currency": "USD"},
+	)))
+	p.export()
+
+	require.Len(t, sink.AllMetrics(), 1)
+	dps := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, map[string]interface{}{"currency": "unknown", "amount": int64(10)}, dps.At(0).Attributes().AsRaw())
+}
+
+func TestConsumeLogsErrorMode(t *testing.T) {
+	for _, errorMode := range []ottl.ErrorMode{ottl.PropagateError, ottl.IgnoreError} {
+		t.Run(string(errorMode), func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ErrorMode = errorMode
+			cfg.Logs = map[string]MetricInfo{
+				"amount": {Aggregation: AggregationSum, Value: `attributes["amount"]`},
+			}
+			next := new(consumertest.LogsSink)
+			p, err := newLogsProcessor(componenttest.NewNopTelemetrySettings(), cfg, next)
+			require.NoError(t, err)
+
+			err = p.ConsumeLogs(context.Background(), newPaymentLogs(
+				map[string]interface{}{"amount": "ten"},
+				map[string]interface{}{"amount": 10},
+			))
+			if errorMode == ottl.PropagateError {
+				assert.ErrorContains(t, err, `metric "amount": failed evaluating the value`)
+				assert.Zero(t, next.LogRecordCount())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 2, next.LogRecordCount())
+		})
+	}
+}
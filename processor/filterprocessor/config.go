@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -92,6 +93,64 @@ type LogFilters struct {
 	// If any condition resolves to true, the log event will be dropped.
 	// Supports `and`, `or`, and `()`
 	LogConditions []string `mapstructure:"log_record"`
+
+	// LimitPerKey keeps at most a number of log records per key and time window, and drops the rest.
+	// It applies to the log records which are not dropped by the other filters.
+	LimitPerKey *LogLimitPerKey `mapstructure:"limit_per_key"`
+}
+
+// These are the fields that users can specify to identify the log records
+// limited together.
+const (
+	keyFieldSeverity                 = "severity"
+	keyFieldBody                     = "body"
+	keyFieldAttributesPrefix         = "attributes."
+	keyFieldResourceAttributesPrefix = "resource.attributes."
+)
+
+// LogLimitPerKey keeps at most Limit log records with the same key per time window.
+type LogLimitPerKey struct {
+	// KeyFields are the fields identifying the log records limited together, among `severity`
+	// (the severity number and text), `body`, `attributes.<key>` and `resource.attributes.<key>`.
+	// All the log records share the same limit if empty.
+	KeyFields []string `mapstructure:"key_fields"`
+
+	// Limit is the maximum number of log records kept per key and time window.
+	Limit int `mapstructure:"limit"`
+
+	// Interval is the length of the time windows. The default value is 1m.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Conditions is a list of OTTL conditions for an ottllog context selecting the log records limited.
+	// If no condition resolves to true, the log record is kept. All the log records are limited if empty.
+	// Supports `and`, `or`, and `()`
+	Conditions []string `mapstructure:"conditions"`
+}
+
+// validate checks that the LogLimitPerKey is valid
+func (l LogLimitPerKey) validate() error {
+	if l.Limit <= 0 {
+		return errors.New("limit_per_key: limit must be positive")
+	}
+	if l.Interval < 0 {
+		return errors.New("limit_per_key: interval must not be negative")
+	}
+	for _, field := range l.KeyFields {
+		switch {
+		case field == keyFieldSeverity, field == keyFieldBody:
+		case strings.HasPrefix(field, keyFieldAttributesPrefix) && len(field) > len(keyFieldAttributesPrefix):
+		case strings.HasPrefix(field, keyFieldResourceAttributesPrefix) && len(field) > len(keyFieldResourceAttributesPrefix):
+		default:
+			return fmt.Errorf("limit_per_key: invalid key field %q, must be one of %q, %q, %q or %q",
+				field, keyFieldSeverity, keyFieldBody, keyFieldAttributesPrefix+"<key>", keyFieldResourceAttributesPrefix+"<key>")
+		}
+	}
+	if l.Conditions != nil {
+		if _, err := filterottl.NewBoolExprForLog(l.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
+			return fmt.Errorf("limit_per_key: %w", err)
+		}
+	}
+	return nil
 }
 
 // LogMatchType specifies the strategy for matching against `plog.Log`s.
@@ -311,5 +370,9 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, cfg.Logs.Exclude.validate())
 	}
 
+	if cfg.Logs.LimitPerKey != nil {
+		errors = multierr.Append(errors, cfg.Logs.LimitPerKey.validate())
+	}
+
 	return errors
 }
@@ -6,6 +6,7 @@ package filterprocessor
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -931,3 +932,76 @@ func TestLoadingConfigOTTL(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadingConfigLimitPerKey(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config_logs_limit_per_key.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id           component.ID
+		expected     *Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, "limit_per_key"),
+			expected: &Config{
+				ErrorMode: ottl.IgnoreError,
+				Logs: LogFilters{
+					LogConditions: []string{`attributes["test"] == "drop"`},
+					LimitPerKey: &LogLimitPerKey{
+						KeyFields:  []string{"resource.attributes.service.name", "severity"},
+						Limit:      100,
+						Interval:   10 * time.Second,
+						Conditions: []string{"severity_number < SEVERITY_NUMBER_INFO"},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "limit_per_key_defaults"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				Logs: LogFilters{
+					LimitPerKey: &LogLimitPerKey{Limit: 10},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_limit"),
+			errorMessage: "limit_per_key: limit must be positive",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_interval"),
+			errorMessage: "limit_per_key: interval must not be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_key_field"),
+			errorMessage: `limit_per_key: invalid key field "trace_id", must be one of "severity", "body", "attributes.<key>" or "resource.attributes.<key>"`,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_conditions"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expected == nil {
+				if tt.errorMessage != "" {
+					assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				} else {
+					assert.Error(t, component.ValidateConfig(cfg))
+				}
+			} else {
+				assert.NoError(t, component.ValidateConfig(cfg))
+				assert.Equal(t, tt.expected, cfg)
+			}
+		})
+	}
+}
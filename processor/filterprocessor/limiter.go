@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+const defaultLimitInterval = time.Minute
+
+// logLimiter keeps at most limit log records with the same key per time window. The windows
+// are fixed: the counts are reset when a record is received after the end of the window.
+type logLimiter struct {
+	keyFields []string
+	limit     int
+	interval  time.Duration
+	// condition selects the limited log records, nil when all of them are
+	condition expr.BoolExpr[ottllog.TransformContext]
+	now       func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	counts      map[string]int
+}
+
+func newLogLimiter(cfg *LogLimitPerKey, errorMode ottl.ErrorMode, set component.TelemetrySettings) (*logLimiter, error) {
+	l := &logLimiter{
+		keyFields: cfg.KeyFields,
+		limit:     cfg.Limit,
+		interval:  cfg.Interval,
+		now:       time.Now,
+		counts:    map[string]int{},
+	}
+	if l.interval == 0 {
+		l.interval = defaultLimitInterval
+	}
+	if cfg.Conditions != nil {
+		var err error
+		if l.condition, err = filterottl.NewBoolExprForLog(cfg.Conditions, filterottl.StandardLogFuncs(), errorMode, set); err != nil {
+			return nil, err
+		}
+	}
+	l.windowStart = l.now()
+	return l, nil
+}
+
+// keep counts the log record against the limit of its key, and returns whether it is within it.
+// The log records which are not selected by the conditions are always kept.
+func (l *logLimiter) keep(ctx context.Context, tCtx ottllog.TransformContext) (bool, error) {
+	if l.condition != nil {
+		match, err := l.condition.Eval(ctx, tCtx)
+		if err != nil || !match {
+			return true, err
+		}
+	}
+	key := l.key(tCtx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now := l.now(); now.Sub(l.windowStart) >= l.interval {
+		l.windowStart = now
+		l.counts = map[string]int{}
+	}
+	l.counts[key]++
+	return l.counts[key] <= l.limit, nil
+}
+
+// key returns the values of the key fields of the log record, separated by null characters.
+// A missing attribute is distinguished from an empty one.
+func (l *logLimiter) key(tCtx ottllog.TransformContext) string {
+	var sb strings.Builder
+	lr := tCtx.GetLogRecord()
+	for _, field := range l.keyFields {
+		switch {
+		case field == keyFieldSeverity:
+			sb.WriteString(strconv.Itoa(int(lr.SeverityNumber())))
+			sb.WriteByte(' ')
+			sb.WriteString(lr.SeverityText())
+		case field == keyFieldBody:
+			sb.WriteString(lr.Body().AsString())
+		case strings.HasPrefix(field, keyFieldAttributesPrefix):
+			if v, ok := lr.Attributes().Get(strings.TrimPrefix(field, keyFieldAttributesPrefix)); ok {
+				sb.WriteByte('=')
+				sb.WriteString(v.AsString())
+			}
+		case strings.HasPrefix(field, keyFieldResourceAttributesPrefix):
+			if v, ok := tCtx.GetResource().Attributes().Get(strings.TrimPrefix(field, keyFieldResourceAttributesPrefix)); ok {
+				sb.WriteByte('=')
+				sb.WriteString(v.AsString())
+			}
+		}
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
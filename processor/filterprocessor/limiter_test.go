@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+type limitedLog struct {
+	service  string
+	severity plog.SeverityNumber
+	body     string
+}
+
+func constructLimitedLogs(logs []limitedLog) plog.Logs {
+	ld := plog.NewLogs()
+	for _, l := range logs {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", l.service)
+		lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.SetSeverityNumber(l.severity)
+		lr.Body().SetStr(l.body)
+	}
+	return ld
+}
+
+func keptBodies(ld plog.Logs) []string {
+	var bodies []string
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				bodies = append(bodies, lrs.At(k).Body().Str())
+			}
+		}
+	}
+	return bodies
+}
+
+func TestFilterLogProcessorLimitPerKey(t *testing.T) {
+	cfg := &Config{
+		ErrorMode: ottl.IgnoreError,
+		Logs: LogFilters{
+			LogConditions: []string{`body == "dropped"`},
+			LimitPerKey: &LogLimitPerKey{
+				KeyFields:  []string{"resource.attributes.service.name", "severity"},
+				Limit:      2,
+				Interval:   time.Minute,
+				Conditions: []string{"severity_number < SEVERITY_NUMBER_INFO"},
+			},
+		},
+	}
+	processor, err := newFilterLogsProcessor(componenttest.NewNopTelemetrySettings(), cfg)
+	require.NoError(t, err)
+	now := time.Now()
+	processor.limiter.now = func() time.Time { return now }
+
+	got, err := processor.processLogs(context.Background(), constructLimitedLogs([]limitedLog{
+		// dropped by the conditions, not counted
+		{service: "cart", severity: plog.SeverityNumberDebug, body: "dropped"},
+		{service: "cart", severity: plog.SeverityNumberDebug, body: "cart 1"},
+		{service: "cart", severity: plog.SeverityNumberDebug, body: "cart 2"},
+		{service: "cart", severity: plog.SeverityNumberDebug, body: "cart 3"},
+		// another severity
+		{service: "cart", severity: plog.SeverityNumberTrace, body: "cart trace"},
+		// another service
+		{service: "checkout", severity: plog.SeverityNumberDebug, body: "checkout 1"},
+		// not limited
+		{service: "cart", severity: plog.SeverityNumberInfo, body: "cart info 1"},
+		{service: "cart", severity: plog.SeverityNumberInfo, body: "cart info 2"},
+		{service: "cart", severity: plog.SeverityNumberInfo, body: "cart info 3"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cart 1", "cart 2", "cart trace", "checkout 1", "cart info 1", "cart info 2", "cart info 3"}, keptBodies(got))
+
+	// the limit is reached until the end of the window
+	now = now.Add(30 * time.Second)
+	_, err = processor.processLogs(context.Background(), constructLimitedLogs([]limitedLog{
+		{service: "cart", severity: plog.SeverityNumberDebug, body: "cart 4"},
+	}))
+	assert.Equal(t, processorhelper.ErrSkipProcessingData, err)
+
+	now = now.Add(30 * time.Second)
+	got, err = processor.processLogs(context.Background(), constructLimitedLogs([]limitedLog{
+		{service: "cart", severity: plog.SeverityNumberDebug, body: "cart 5"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cart 5"}, keptBodies(got))
+}
+
+func TestLogLimiterKey(t *testing.T) {
+	limiter, err := newLogLimiter(&LogLimitPerKey{
+		KeyFields: []string{"attributes.user", "body"},
+		Limit:     1,
+	}, ottl.PropagateError, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	assert.Equal(t, defaultLimitInterval, limiter.interval)
+
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	lrs := sl.LogRecords()
+	withoutUser := lrs.AppendEmpty()
+	withoutUser.Body().SetStr("login")
+	emptyUser := lrs.AppendEmpty()
+	emptyUser.Attributes().PutStr("user", "")
+	emptyUser.Body().SetStr("login")
+
+	var keys []string
+	for i := 0; i < lrs.Len(); i++ {
+		keys = append(keys, limiter.key(ottllog.NewTransformContext(lrs.At(i), sl.Scope(), ld.ResourceLogs().At(0).Resource())))
+	}
+	// a missing attribute is distinguished from an empty one
+	assert.Equal(t, []string{"\x00login\x00", "=\x00login\x00"}, keys)
+}
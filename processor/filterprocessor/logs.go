@@ -22,6 +22,7 @@ import (
 
 type filterLogProcessor struct {
 	skipExpr expr.BoolExpr[ottllog.TransformContext]
+	limiter  *logLimiter
 	logger   *zap.Logger
 }
 
@@ -29,6 +30,13 @@ func newFilterLogsProcessor(set component.TelemetrySettings, cfg *Config) (*filt
 	flp := &filterLogProcessor{
 		logger: set.Logger,
 	}
+	if cfg.Logs.LimitPerKey != nil {
+		limiter, err := newLogLimiter(cfg.Logs.LimitPerKey, cfg.ErrorMode, set)
+		if err != nil {
+			return nil, err
+		}
+		flp.limiter = limiter
+	}
 	if cfg.Logs.LogConditions != nil {
 		skipExpr, err := filterottl.NewBoolExprForLog(cfg.Logs.LogConditions, filterottl.StandardLogFuncs(), cfg.ErrorMode, set)
 		if err != nil {
@@ -57,7 +65,7 @@ func newFilterLogsProcessor(set component.TelemetrySettings, cfg *Config) (*filt
 }
 
 func (flp *filterLogProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
-	if flp.skipExpr == nil {
+	if flp.skipExpr == nil && flp.limiter == nil {
 		return ld, nil
 	}
 
@@ -68,12 +76,26 @@ func (flp *filterLogProcessor) processLogs(ctx context.Context, ld plog.Logs) (p
 			scope := sl.Scope()
 			lrs := sl.LogRecords()
 			lrs.RemoveIf(func(lr plog.LogRecord) bool {
-				skip, err := flp.skipExpr.Eval(ctx, ottllog.NewTransformContext(lr, scope, resource))
-				if err != nil {
-					errors = multierr.Append(errors, err)
-					return false
+				tCtx := ottllog.NewTransformContext(lr, scope, resource)
+				if flp.skipExpr != nil {
+					skip, err := flp.skipExpr.Eval(ctx, tCtx)
+					if err != nil {
+						errors = multierr.Append(errors, err)
+						return false
+					}
+					if skip {
+						return true
+					}
+				}
+				if flp.limiter != nil {
+					keep, err := flp.limiter.keep(ctx, tCtx)
+					if err != nil {
+						errors = multierr.Append(errors, err)
+						return false
+					}
+					return !keep
 				}
-				return skip
+				return false
 			})
 
 			return sl.LogRecords().Len() == 0
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	defaultReloadInterval = time.Minute
+	defaultCacheSize      = 1000
+)
+
+// defaultAttributes are the attributes holding the address of the client of a request,
+// or of the peer of a connection, as defined by the semantic conventions.
+var defaultAttributes = []string{"client.address", "source.address", "net.sock.peer.addr"}
+
+// Config defines the configuration for the geo IP processor.
+type Config struct {
+	// Attributes are the keys of the attributes holding the IP addresses to look up, in order of
+	// precedence: the first attribute holding an IP address is used.
+	Attributes []string `mapstructure:"attributes"`
+
+	// Databases are the paths of the MaxMind DB files the IP addresses are looked up in, such as
+	// a GeoLite2 City and a GeoLite2 ASN database. The records found in all the databases are
+	// merged, the first databases taking precedence.
+	Databases []string `mapstructure:"databases"`
+
+	// ReloadInterval is how often the databases are checked for modifications, the modified
+	// databases being reloaded without restarting the collector. 0 disables the reload.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+
+	// CacheSize is the number of IP addresses whose lookup results are cached. 0 disables the cache.
+	CacheSize int `mapstructure:"cache_size"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if len(cfg.Attributes) == 0 {
+		return errors.New("attributes must not be empty")
+	}
+	if len(cfg.Databases) == 0 {
+		return errors.New("databases must not be empty")
+	}
+	for _, path := range cfg.Databases {
+		if path == "" {
+			return errors.New("database path must not be empty")
+		}
+	}
+	if cfg.ReloadInterval < 0 {
+		return errors.New("reload_interval must not be negative")
+	}
+	if cfg.CacheSize < 0 {
+		return errors.New("cache_size must not be negative")
+	}
+	return nil
+}
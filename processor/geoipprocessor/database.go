@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor"
+
+import (
+	"net/netip"
+	"os"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// The attributes added by the processor, following the layout of the MaxMind GeoIP2 databases.
+const (
+	attributeContinentCode      = "geo.continent.code"
+	attributeCountryISOCode     = "geo.country.iso_code"
+	attributeCountryName        = "geo.country.name"
+	attributeRegionISOCode      = "geo.region.iso_code"
+	attributeRegionName         = "geo.region.name"
+	attributeCityName           = "geo.city.name"
+	attributePostalCode         = "geo.postal_code"
+	attributeLocationLat        = "geo.location.lat"
+	attributeLocationLon        = "geo.location.lon"
+	attributeASNumber           = "as.number"
+	attributeASOrganizationName = "as.organization.name"
+)
+
+// namesLanguage is the language of the names of the locations added as attributes.
+const namesLanguage = "en"
+
+// attribute is an attribute describing an IP address, whose value is a string, an int64 or a float64.
+type attribute struct {
+	key   string
+	value any
+}
+
+// database is a MaxMind DB file loaded in memory, along with the state of the file it was
+// loaded from, to detect its modifications.
+type database struct {
+	path    string
+	reader  *maxminddb.Reader
+	modTime time.Time
+	size    int64
+}
+
+func openDatabase(path string) (*database, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	// the file is read in memory rather than mapped, so that it can be replaced while it is loaded
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := maxminddb.FromBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &database{path: path, reader: reader, modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// lookup returns the record of the network containing the address, or nil if the address
+// isn't part of any network of the database.
+func (db *database) lookup(addr netip.Addr) (map[string]any, error) {
+	var record map[string]any
+	if err := db.reader.Lookup(addr.Unmap().AsSlice(), &record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// modified checks if the file of the database changed since it was loaded.
+func (db *database) modified() (bool, error) {
+	info, err := os.Stat(db.path)
+	if err != nil {
+		return false, err
+	}
+	return !info.ModTime().Equal(db.modTime) || info.Size() != db.size, nil
+}
+
+// recordAttributes returns the attributes found in a record of a GeoIP2 City, Country or ASN
+// database, or of a database following the same layout.
+func recordAttributes(record map[string]any) []attribute {
+	var attrs []attribute
+	addString := func(key string, record map[string]any, path ...string) {
+		if s, ok := stringAt(record, path...); ok {
+			attrs = append(attrs, attribute{key: key, value: s})
+		}
+	}
+	addFloat := func(key string, record map[string]any, path ...string) {
+		if f, ok := floatAt(record, path...); ok {
+			attrs = append(attrs, attribute{key: key, value: f})
+		}
+	}
+
+	addString(attributeContinentCode, record, "continent", "code")
+	addString(attributeCountryISOCode, record, "country", "iso_code")
+	addString(attributeCountryName, record, "country", "names", namesLanguage)
+	if subdivisions, ok := record["subdivisions"].([]any); ok && len(subdivisions) > 0 {
+		if subdivision, isMap := subdivisions[0].(map[string]any); isMap {
+			addString(attributeRegionISOCode, subdivision, "iso_code")
+			addString(attributeRegionName, subdivision, "names", namesLanguage)
+		}
+	}
+	addString(attributeCityName, record, "city", "names", namesLanguage)
+	addString(attributePostalCode, record, "postal", "code")
+	addFloat(attributeLocationLat, record, "location", "latitude")
+	addFloat(attributeLocationLon, record, "location", "longitude")
+	if number, ok := record["autonomous_system_number"].(uint64); ok {
+		attrs = append(attrs, attribute{key: attributeASNumber, value: int64(number)})
+	}
+	addString(attributeASOrganizationName, record, "autonomous_system_organization")
+	return attrs
+}
+
+// valueAt returns the value at the path of nested maps.
+func valueAt(record map[string]any, path ...string) (any, bool) {
+	var value any = record
+	for _, key := range path {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func stringAt(record map[string]any, path ...string) (string, bool) {
+	value, _ := valueAt(record, path...)
+	s, ok := value.(string)
+	return s, ok && s != ""
+}
+
+func floatAt(record map[string]any, path ...string) (float64, bool) {
+	value, _ := valueAt(record, path...)
+	f, ok := value.(float64)
+	return f, ok
+}
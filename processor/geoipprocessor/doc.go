@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package geoipprocessor implements a processor which looks up the IP addresses held by attributes
+// in MaxMind DB files, and adds the geographical location and the autonomous system of the addresses
+// as attributes.
+package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor"
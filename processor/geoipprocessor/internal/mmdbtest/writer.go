@@ -0,0 +1,267 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mmdbtest writes MaxMind DB files to be used in tests.
+package mmdbtest // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/mmdbtest"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/netip"
+	"os"
+	"sort"
+)
+
+// Database describes the content of a MaxMind DB file.
+type Database struct {
+	// IPVersion is either 4 or 6, IPv4 networks are inserted as ::a.b.c.d/(96+bits) in IPv6 databases.
+	IPVersion int
+	// RecordSize is either 24, 28 or 32.
+	RecordSize int
+	// DatabaseType is set in the metadata of the file.
+	DatabaseType string
+	// Networks are the records of the networks, keyed by their prefix such as 192.0.2.0/24.
+	Networks map[string]map[string]any
+}
+
+// Write writes the database to the path.
+func Write(path string, db Database) error {
+	b, err := db.Bytes()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0600)
+}
+
+type node struct {
+	children [2]*node
+	records  [2]int
+	index    int
+}
+
+func newNode() *node {
+	return &node{records: [2]int{-1, -1}}
+}
+
+// Bytes returns the content of the database file.
+func (db Database) Bytes() ([]byte, error) {
+	prefixes := make([]string, 0, len(db.Networks))
+	for prefix := range db.Networks {
+		prefixes = append(prefixes, prefix)
+	}
+	// networks are inserted from the largest to the smallest, so smaller networks take precedence
+	sort.Slice(prefixes, func(i, j int) bool {
+		return netip.MustParsePrefix(prefixes[i]).Bits() < netip.MustParsePrefix(prefixes[j]).Bits()
+	})
+
+	var data bytes.Buffer
+	root := newNode()
+	for _, p := range prefixes {
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			return nil, err
+		}
+		offset := data.Len()
+		if err = encode(&data, db.Networks[p]); err != nil {
+			return nil, err
+		}
+		if err = db.insert(root, prefix, offset); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes := index(root)
+	var out bytes.Buffer
+	for _, n := range nodes {
+		var values [2]uint64
+		for bit := 0; bit < 2; bit++ {
+			switch {
+			case n.children[bit] != nil:
+				values[bit] = uint64(n.children[bit].index)
+			case n.records[bit] >= 0:
+				values[bit] = uint64(len(nodes) + 16 + n.records[bit])
+			default:
+				values[bit] = uint64(len(nodes))
+			}
+		}
+		writeNode(&out, db.RecordSize, values)
+	}
+	out.Write(make([]byte, 16))
+	out.Write(data.Bytes())
+
+	out.WriteString("\xAB\xCD\xEFMaxMind.com")
+	err := encode(&out, map[string]any{
+		"binary_format_major_version": uint16(2),
+		"binary_format_minor_version": uint16(0),
+		"build_epoch":                 uint64(1700000000),
+		"database_type":               db.DatabaseType,
+		"description":                 map[string]any{"en": "Test database"},
+		"ip_version":                  uint16(db.IPVersion),
+		"languages":                   []any{"en"},
+		"node_count":                  uint32(len(nodes)),
+		"record_size":                 uint16(db.RecordSize),
+	})
+	return out.Bytes(), err
+}
+
+func (db Database) insert(root *node, prefix netip.Prefix, offset int) error {
+	addr := prefix.Addr()
+	bits := prefix.Bits()
+	if db.IPVersion == 6 && addr.Is4() {
+		// IPv4 mapped addresses are ::ffff:a.b.c.d, while the databases store them as ::a.b.c.d
+		b := addr.As16()
+		b[10], b[11] = 0, 0
+		addr = netip.AddrFrom16(b)
+		bits += 96
+	}
+	if db.IPVersion == 4 && !addr.Is4() {
+		return fmt.Errorf("cannot insert %s in an IPv4 database", prefix)
+	}
+	if bits == 0 {
+		return fmt.Errorf("cannot insert %s, the network must not be empty", prefix)
+	}
+
+	ip := addr.AsSlice()
+	n := root
+	for i := 0; i < bits; i++ {
+		bit := (ip[i/8] >> (7 - i%8)) & 1
+		if i == bits-1 {
+			n.records[bit] = offset
+			// a smaller network previously split from this one is replaced
+			n.children[bit] = nil
+			return nil
+		}
+		if n.children[bit] == nil {
+			n.children[bit] = newNode()
+			// the smaller networks are inserted last, the records of the
+			// network they are part of are kept for the rest of it
+			if n.records[bit] >= 0 {
+				n.children[bit].records = [2]int{n.records[bit], n.records[bit]}
+				n.records[bit] = -1
+			}
+		}
+		n = n.children[bit]
+	}
+	return nil
+}
+
+// index numbers the nodes in breadth-first order, the root node being the first one.
+func index(root *node) []*node {
+	nodes := []*node{root}
+	for i := 0; i < len(nodes); i++ {
+		nodes[i].index = i
+		for _, child := range nodes[i].children {
+			if child != nil {
+				nodes = append(nodes, child)
+			}
+		}
+	}
+	return nodes
+}
+
+func writeNode(out *bytes.Buffer, recordSize int, values [2]uint64) {
+	switch recordSize {
+	case 24:
+		for _, v := range values {
+			out.Write([]byte{byte(v >> 16), byte(v >> 8), byte(v)})
+		}
+	case 28:
+		out.Write([]byte{byte(values[0] >> 16), byte(values[0] >> 8), byte(values[0])})
+		out.WriteByte(byte(values[0]>>24)<<4 | byte(values[1]>>24)&0x0f)
+		out.Write([]byte{byte(values[1] >> 16), byte(values[1] >> 8), byte(values[1])})
+	default:
+		for _, v := range values {
+			_ = binary.Write(out, binary.BigEndian, uint32(v))
+		}
+	}
+}
+
+// encode writes the value in the data section format, supporting the Go types
+// returned by the reader, as well as the sized unsigned integers.
+func encode(out *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case string:
+		writeControl(out, 2, len(v))
+		out.WriteString(v)
+	case float64:
+		writeControl(out, 3, 8)
+		_ = binary.Write(out, binary.BigEndian, math.Float64bits(v))
+	case []byte:
+		writeControl(out, 4, len(v))
+		out.Write(v)
+	case uint16:
+		writeUint(out, 5, uint64(v))
+	case uint32:
+		writeUint(out, 6, uint64(v))
+	case uint64:
+		writeUint(out, 9, v)
+	case int32:
+		writeControl(out, 8, 4)
+		_ = binary.Write(out, binary.BigEndian, v)
+	case bool:
+		size := 0
+		if v {
+			size = 1
+		}
+		writeControl(out, 14, size)
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		writeControl(out, 7, len(v))
+		for _, k := range keys {
+			if err := encode(out, k); err != nil {
+				return err
+			}
+			if err := encode(out, v[k]); err != nil {
+				return err
+			}
+		}
+	case []any:
+		writeControl(out, 11, len(v))
+		for _, item := range v {
+			if err := encode(out, item); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type %T", value)
+	}
+	return nil
+}
+
+func writeUint(out *bytes.Buffer, typ int, v uint64) {
+	var b []byte
+	for ; v > 0; v >>= 8 {
+		b = append([]byte{byte(v)}, b...)
+	}
+	writeControl(out, typ, len(b))
+	out.Write(b)
+}
+
+func writeControl(out *bytes.Buffer, typ int, size int) {
+	var sizeBytes []byte
+	switch {
+	case size < 29:
+	case size < 285:
+		sizeBytes = []byte{byte(size - 29)}
+		size = 29
+	case size < 65821:
+		sizeBytes = []byte{byte((size - 285) >> 8), byte(size - 285)}
+		size = 30
+	default:
+		sizeBytes = []byte{byte((size - 65821) >> 16), byte((size - 65821) >> 8), byte(size - 65821)}
+		size = 31
+	}
+	if typ > 7 {
+		out.WriteByte(byte(size))
+		out.WriteByte(byte(typ - 7))
+	} else {
+		out.WriteByte(byte(typ<<5 | size))
+	}
+	out.Write(sizeBytes)
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mmdbtest
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	for _, ipVersion := range []int{4, 6} {
+		for _, recordSize := range []int{24, 28, 32} {
+			ipVersion, recordSize := ipVersion, recordSize
+			t.Run(fmt.Sprintf("ipv%d_%d", ipVersion, recordSize), func(t *testing.T) {
+				db := Database{
+					IPVersion:    ipVersion,
+					RecordSize:   recordSize,
+					DatabaseType: "GeoLite2-City",
+					Networks: map[string]map[string]any{
+						"192.0.2.0/24":   {"country": map[string]any{"iso_code": "FR"}},
+						"192.0.2.128/25": {"country": map[string]any{"iso_code": "DE"}},
+					},
+				}
+				if ipVersion == 6 {
+					db.Networks["2001:db8::/32"] = map[string]any{"country": map[string]any{"iso_code": "US"}}
+				}
+				path := filepath.Join(t.TempDir(), "test.mmdb")
+				require.NoError(t, Write(path, db))
+
+				r, err := maxminddb.Open(path)
+				require.NoError(t, err)
+				defer func() {
+					assert.NoError(t, r.Close())
+				}()
+				require.NoError(t, r.Verify())
+				assert.Equal(t, "GeoLite2-City", r.Metadata.DatabaseType)
+				assert.Equal(t, uint(ipVersion), r.Metadata.IPVersion)
+				assert.Equal(t, uint(recordSize), r.Metadata.RecordSize)
+				assert.Equal(t, []string{"en"}, r.Metadata.Languages)
+
+				for ip, expected := range map[string]map[string]any{
+					"192.0.2.1":        {"country": map[string]any{"iso_code": "FR"}},
+					"192.0.2.200":      {"country": map[string]any{"iso_code": "DE"}},
+					"::ffff:192.0.2.1": {"country": map[string]any{"iso_code": "FR"}},
+					"198.51.100.1":     nil,
+				} {
+					var record map[string]any
+					assert.NoError(t, r.Lookup(net.ParseIP(ip), &record))
+					assert.Equal(t, expected, record, ip)
+				}
+
+				var record map[string]any
+				err = r.Lookup(net.ParseIP("2001:db8::1"), &record)
+				if ipVersion == 4 {
+					assert.Error(t, err, "Must not look up IPv6 addresses in an IPv4 database")
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, map[string]any{"country": map[string]any{"iso_code": "US"}}, record)
+				}
+			})
+		}
+	}
+}
+
+func TestWriteTypes(t *testing.T) {
+	b, err := Database{
+		IPVersion:  4,
+		RecordSize: 24,
+		Networks: map[string]map[string]any{
+			"192.0.2.0/24": {
+				"string":  "Paris",
+				"double":  1.5,
+				"bytes":   []byte{1, 2},
+				"uint16":  uint16(256),
+				"uint32":  uint32(65536),
+				"uint64":  uint64(42),
+				"boolean": true,
+				"array":   []any{"a", "b"},
+			},
+		},
+	}.Bytes()
+	require.NoError(t, err)
+
+	r, err := maxminddb.FromBytes(b)
+	require.NoError(t, err)
+	var record map[string]any
+	require.NoError(t, r.Lookup(net.ParseIP("192.0.2.1"), &record))
+	assert.Equal(t, map[string]any{
+		"string":  "Paris",
+		"double":  1.5,
+		"bytes":   []byte{1, 2},
+		"uint16":  uint64(256),
+		"uint32":  uint64(65536),
+		"uint64":  uint64(42),
+		"boolean": true,
+		"array":   []any{"a", "b"},
+	}, record)
+}
+
+func TestInvalidDatabase(t *testing.T) {
+	_, err := maxminddb.FromBytes([]byte("not a database"))
+	assert.Error(t, err)
+
+	b, err := Database{
+		IPVersion:  4,
+		RecordSize: 24,
+		Networks:   map[string]map[string]any{"192.0.2.0/24": {"city": "Paris"}},
+	}.Bytes()
+	require.NoError(t, err)
+	// the data section is truncated, leaving a search tree larger than the file
+	_, err = maxminddb.FromBytes(append(b[:10:10], b[len(b)-200:]...))
+	assert.Error(t, err)
+}
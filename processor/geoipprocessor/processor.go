@@ -0,0 +1,279 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor"
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+// geoIP adds the attributes describing the IP addresses held by the configured attributes, as found
+// in the databases. The databases are reloaded when their files are modified, and the lookups are
+// cached until then.
+type geoIP struct {
+	logger *zap.Logger
+	cfg    *Config
+
+	mu        sync.RWMutex
+	databases []*database
+	// cache maps the looked up addresses to their attributes, it is nil when disabled
+	cache *lru.Cache
+
+	startOnce    sync.Once
+	shutdownOnce sync.Once
+	shutdownC    chan struct{}
+	done         chan struct{}
+}
+
+func newGeoIP(logger *zap.Logger, cfg *Config) *geoIP {
+	return &geoIP{
+		logger:    logger,
+		cfg:       cfg,
+		shutdownC: make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// start loads the databases, and starts checking them for modifications.
+func (p *geoIP) start(context.Context, component.Host) error {
+	databases := make([]*database, 0, len(p.cfg.Databases))
+	for _, path := range p.cfg.Databases {
+		db, err := openDatabase(path)
+		if err != nil {
+			return err
+		}
+		p.logger.Info("Loaded geo IP database",
+			zap.String("path", path), zap.String("type", db.reader.Metadata.DatabaseType))
+		databases = append(databases, db)
+	}
+	cache, err := p.newCache()
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.databases, p.cache = databases, cache
+	p.mu.Unlock()
+
+	if p.cfg.ReloadInterval > 0 {
+		p.startOnce.Do(func() {
+			go p.reloadLoop()
+		})
+	}
+	return nil
+}
+
+// shutdown stops checking the databases for modifications.
+func (p *geoIP) shutdown(ctx context.Context) error {
+	started := true
+	p.startOnce.Do(func() { started = false })
+	p.shutdownOnce.Do(func() { close(p.shutdownC) })
+	if !started {
+		return nil
+	}
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *geoIP) newCache() (*lru.Cache, error) {
+	if p.cfg.CacheSize == 0 {
+		return nil, nil
+	}
+	return lru.New(p.cfg.CacheSize)
+}
+
+func (p *geoIP) reloadLoop() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.cfg.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.reload()
+		case <-p.shutdownC:
+			return
+		}
+	}
+}
+
+// reload replaces the databases whose files were modified, and resets the cache if any was replaced.
+// The databases which cannot be reloaded are kept until their files are modified again.
+func (p *geoIP) reload() {
+	p.mu.RLock()
+	databases := append([]*database(nil), p.databases...)
+	p.mu.RUnlock()
+
+	reloaded := false
+	for i, db := range databases {
+		modified, err := db.modified()
+		if err != nil {
+			p.logger.Warn("Failed to check geo IP database", zap.String("path", db.path), zap.Error(err))
+			continue
+		}
+		if !modified {
+			continue
+		}
+		newDB, err := openDatabase(db.path)
+		if err != nil {
+			p.logger.Warn("Failed to reload geo IP database", zap.String("path", db.path), zap.Error(err))
+			continue
+		}
+		p.logger.Info("Reloaded geo IP database", zap.String("path", db.path))
+		databases[i] = newDB
+		reloaded = true
+	}
+	if !reloaded {
+		return
+	}
+
+	cache, err := p.newCache()
+	if err != nil {
+		p.logger.Warn("Failed to reset geo IP cache", zap.Error(err))
+		return
+	}
+	p.mu.Lock()
+	p.databases, p.cache = databases, cache
+	p.mu.Unlock()
+}
+
+// lookup returns the attributes describing the address.
+func (p *geoIP) lookup(addr netip.Addr) []attribute {
+	p.mu.RLock()
+	databases, cache := p.databases, p.cache
+	p.mu.RUnlock()
+
+	if cache != nil {
+		if attrs, ok := cache.Get(addr); ok {
+			return attrs.([]attribute)
+		}
+	}
+
+	var attrs []attribute
+	found := make(map[string]struct{})
+	for _, db := range databases {
+		record, err := db.lookup(addr)
+		if err != nil {
+			p.logger.Debug("Failed to look up address", zap.String("address", addr.String()), zap.String("path", db.path), zap.Error(err))
+			continue
+		}
+		// the attributes of the first databases take precedence
+		for _, attr := range recordAttributes(record) {
+			if _, ok := found[attr.key]; !ok {
+				found[attr.key] = struct{}{}
+				attrs = append(attrs, attr)
+			}
+		}
+	}
+
+	if cache != nil {
+		cache.Add(addr, attrs)
+	}
+	return attrs
+}
+
+// enrich adds the attributes describing the IP address held by the first configured attribute
+// holding one.
+func (p *geoIP) enrich(attrs pcommon.Map) {
+	for _, key := range p.cfg.Attributes {
+		value, ok := attrs.Get(key)
+		if !ok || value.Type() != pcommon.ValueTypeStr {
+			continue
+		}
+		addr, err := netip.ParseAddr(value.Str())
+		if err != nil {
+			continue
+		}
+		for _, attr := range p.lookup(addr) {
+			switch v := attr.value.(type) {
+			case string:
+				attrs.PutStr(attr.key, v)
+			case int64:
+				attrs.PutInt(attr.key, v)
+			case float64:
+				attrs.PutDouble(attr.key, v)
+			}
+		}
+		return
+	}
+}
+
+func (p *geoIP) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		p.enrich(rs.Resource().Attributes())
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				p.enrich(spans.At(k).Attributes())
+			}
+		}
+	}
+	return td, nil
+}
+
+func (p *geoIP) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		p.enrich(rl.Resource().Attributes())
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			records := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				p.enrich(records.At(k).Attributes())
+			}
+		}
+	}
+	return ld, nil
+}
+
+func (p *geoIP) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		p.enrich(rm.Resource().Attributes())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				p.processMetric(metrics.At(k))
+			}
+		}
+	}
+	return md, nil
+}
+
+func (p *geoIP) processMetric(m pmetric.Metric) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+			p.enrich(m.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+			p.enrich(m.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < m.Histogram().DataPoints().Len(); i++ {
+			p.enrich(m.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < m.ExponentialHistogram().DataPoints().Len(); i++ {
+			p.enrich(m.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < m.Summary().DataPoints().Len(); i++ {
+			p.enrich(m.Summary().DataPoints().At(i).Attributes())
+		}
+	}
+}
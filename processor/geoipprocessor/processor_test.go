@@ -0,0 +1,228 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package geoipprocessor
+
+import (
+	"context"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/mmdbtest"
+)
+
+func cityRecord(countryCode, countryName, city string) map[string]any {
+	return map[string]any{
+		"continent": map[string]any{"code": "EU"},
+		"country": map[string]any{
+			"iso_code": countryCode,
+			"names":    map[string]any{"en": countryName, "fr": countryName},
+		},
+		"subdivisions": []any{
+			map[string]any{"iso_code": "IDF", "names": map[string]any{"en": "Ile-de-France"}},
+		},
+		"city":     map[string]any{"names": map[string]any{"en": city}},
+		"postal":   map[string]any{"code": "75001"},
+		"location": map[string]any{"latitude": 48.8566, "longitude": 2.3522},
+	}
+}
+
+func writeDatabase(t *testing.T, path string, networks map[string]map[string]any) {
+	require.NoError(t, mmdbtest.Write(path, mmdbtest.Database{
+		IPVersion:    6,
+		RecordSize:   28,
+		DatabaseType: "GeoLite2-City",
+		Networks:     networks,
+	}))
+}
+
+func writeTestDatabase(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "GeoLite2-City.mmdb")
+	writeDatabase(t, path, map[string]map[string]any{
+		"192.0.2.0/24":  cityRecord("FR", "France", "Paris"),
+		"2001:db8::/32": {"country": map[string]any{"iso_code": "US"}},
+	})
+	return path
+}
+
+func writeTestASNDatabase(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "GeoLite2-ASN.mmdb")
+	writeDatabase(t, path, map[string]map[string]any{
+		"192.0.2.0/24": {
+			"autonomous_system_number":       uint32(64496),
+			"autonomous_system_organization": "Example",
+		},
+	})
+	return path
+}
+
+var expectedParis = map[string]any{
+	"geo.continent.code":   "EU",
+	"geo.country.iso_code": "FR",
+	"geo.country.name":     "France",
+	"geo.region.iso_code":  "IDF",
+	"geo.region.name":      "Ile-de-France",
+	"geo.city.name":        "Paris",
+	"geo.postal_code":      "75001",
+	"geo.location.lat":     48.8566,
+	"geo.location.lon":     2.3522,
+}
+
+func newTestGeoIP(t *testing.T, cfg *Config) *geoIP {
+	p := newGeoIP(zaptest.NewLogger(t), cfg)
+	require.NoError(t, p.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, p.shutdown(context.Background()))
+	})
+	return p
+}
+
+func newTestConfig(databases ...string) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Databases = databases
+	return cfg
+}
+
+func withAttributes(attrs map[string]any, extra map[string]any) map[string]any {
+	result := make(map[string]any, len(attrs)+len(extra))
+	for k, v := range attrs {
+		result[k] = v
+	}
+	for k, v := range extra {
+		result[k] = v
+	}
+	return result
+}
+
+func TestProcessTraces(t *testing.T) {
+	p := newTestGeoIP(t, newTestConfig(writeTestDatabase(t)))
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("client.address", "192.0.2.10")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	fallback := spans.AppendEmpty()
+	fallback.Attributes().PutStr("client.address", "unknown")
+	fallback.Attributes().PutStr("source.address", "2001:db8::1")
+	notFound := spans.AppendEmpty()
+	notFound.Attributes().PutStr("client.address", "198.51.100.1")
+	notConfigured := spans.AppendEmpty()
+	notConfigured.Attributes().PutStr("http.client_ip", "192.0.2.10")
+
+	_, err := p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+
+	assert.Equal(t, withAttributes(expectedParis, map[string]any{"client.address": "192.0.2.10"}),
+		rs.Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]any{
+		"client.address":       "unknown",
+		"source.address":       "2001:db8::1",
+		"geo.country.iso_code": "US",
+	}, fallback.Attributes().AsRaw(), "Must use the first attribute holding an IP address")
+	assert.Equal(t, map[string]any{"client.address": "198.51.100.1"}, notFound.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"http.client_ip": "192.0.2.10"}, notConfigured.Attributes().AsRaw())
+}
+
+func TestProcessLogs(t *testing.T) {
+	cfg := newTestConfig(writeTestDatabase(t), writeTestASNDatabase(t))
+	cfg.Attributes = []string{"http.client_ip"}
+	p := newTestGeoIP(t, cfg)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	record := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	record.Attributes().PutStr("http.client_ip", "192.0.2.10")
+
+	_, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	assert.Equal(t, withAttributes(expectedParis, map[string]any{
+		"http.client_ip":       "192.0.2.10",
+		"as.number":            int64(64496),
+		"as.organization.name": "Example",
+	}), record.Attributes().AsRaw(), "Must merge the records of all the databases")
+	assert.Equal(t, map[string]any{}, rl.Resource().Attributes().AsRaw())
+}
+
+func TestProcessMetrics(t *testing.T) {
+	p := newTestGeoIP(t, newTestConfig(writeTestDatabase(t)))
+
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("http.server.request.count")
+	dp := m.SetEmptySum().DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("client.address", "192.0.2.10")
+
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+
+	assert.Equal(t, withAttributes(expectedParis, map[string]any{"client.address": "192.0.2.10"}),
+		dp.Attributes().AsRaw())
+}
+
+func TestCacheAndReload(t *testing.T) {
+	path := writeTestDatabase(t)
+	cfg := newTestConfig(path)
+	cfg.ReloadInterval = 0
+	p := newTestGeoIP(t, cfg)
+
+	lookupCountry := func() any {
+		for _, attr := range p.lookup(netip.MustParseAddr("192.0.2.10")) {
+			if attr.key == attributeCountryISOCode {
+				return attr.value
+			}
+		}
+		return nil
+	}
+	assert.Equal(t, "FR", lookupCountry())
+
+	writeDatabase(t, path, map[string]map[string]any{
+		"192.0.2.0/24": {"country": map[string]any{"iso_code": "DE"}},
+	})
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(path, future, future))
+	assert.Equal(t, "FR", lookupCountry(), "Must return the cached lookup until the database is reloaded")
+
+	p.reload()
+	assert.Equal(t, "DE", lookupCountry(), "Must reset the cache when reloading the database")
+
+	require.NoError(t, os.WriteFile(path, []byte("corrupted"), 0600))
+	p.reload()
+	assert.Equal(t, "DE", lookupCountry(), "Must keep the database when it cannot be reloaded")
+}
+
+func TestReloadLoop(t *testing.T) {
+	path := writeTestDatabase(t)
+	cfg := newTestConfig(path)
+	cfg.ReloadInterval = 10 * time.Millisecond
+	cfg.CacheSize = 0
+	p := newTestGeoIP(t, cfg)
+
+	writeDatabase(t, path, map[string]map[string]any{
+		"192.0.2.0/24": {"country": map[string]any{"iso_code": "DE"}},
+	})
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(path, future, future))
+
+	assert.Eventually(t, func() bool {
+		ld := plog.NewLogs()
+		attrs := ld.ResourceLogs().AppendEmpty().Resource().Attributes()
+		attrs.PutStr("client.address", "192.0.2.10")
+		if _, err := p.processLogs(context.Background(), ld); err != nil {
+			return false
+		}
+		country, ok := attrs.Get(attributeCountryISOCode)
+		return ok && country.Str() == "DE"
+	}, 5*time.Second, 10*time.Millisecond)
+}
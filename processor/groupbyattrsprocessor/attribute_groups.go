@@ -42,28 +42,31 @@ func (tg *tracesGroup) findOrCreateResourceSpans(originResource pcommon.Resource
 type metricsGroup struct {
 	metrics        pmetric.Metrics
 	resourceHashes [][16]byte
+	// dataPoints holds the grouped data points, to detect the data points colliding with them.
+	dataPoints map[dataPointIdentity]groupedDataPoint
 }
 
 func newMetricsGroup() *metricsGroup {
-	return &metricsGroup{metrics: pmetric.NewMetrics()}
+	return &metricsGroup{metrics: pmetric.NewMetrics(), dataPoints: map[dataPointIdentity]groupedDataPoint{}}
 }
 
-// findOrCreateResourceMetrics searches for a Resource with matching attributes and returns it. If nothing is found, it is being created
-func (mg *metricsGroup) findOrCreateResourceMetrics(originResource pcommon.Resource, requiredAttributes pcommon.Map) pmetric.ResourceMetrics {
+// findOrCreateResourceMetrics searches for a Resource with matching attributes and returns it, with the hash of its attributes.
+// If nothing is found, it is being created
+func (mg *metricsGroup) findOrCreateResourceMetrics(originResource pcommon.Resource, requiredAttributes pcommon.Map) (pmetric.ResourceMetrics, [16]byte) {
 	referenceResource := buildReferenceResource(originResource, requiredAttributes)
 	referenceResourceHash := pdatautil.MapHash(referenceResource.Attributes())
 
 	rms := mg.metrics.ResourceMetrics()
 	for i := 0; i < rms.Len(); i++ {
 		if mg.resourceHashes[i] == referenceResourceHash {
-			return rms.At(i)
+			return rms.At(i), referenceResourceHash
 		}
 	}
 
 	rm := mg.metrics.ResourceMetrics().AppendEmpty()
 	referenceResource.MoveTo(rm.Resource())
 	mg.resourceHashes = append(mg.resourceHashes, referenceResourceHash)
-	return rm
+	return rm, referenceResourceHash
 
 }
 
@@ -3,10 +3,58 @@
 
 package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
 
+import (
+	"errors"
+	"fmt"
+)
+
+// MetricConflictStrategy defines how metric data points colliding after grouping are handled.
+type MetricConflictStrategy string
+
+const (
+	// KeepAll keeps all the colliding data points, as distinct data points of the same metric.
+	KeepAll MetricConflictStrategy = "keep_all"
+	// KeepFirst keeps the first data point, and drops the data points colliding with it.
+	KeepFirst MetricConflictStrategy = "keep_first"
+	// Sum adds the values of the colliding data points into the first one.
+	Sum MetricConflictStrategy = "sum"
+	// SuffixAttribute keeps all the colliding data points, telling them apart with an attribute
+	// holding the collision index.
+	SuffixAttribute MetricConflictStrategy = "suffix_attribute"
+)
+
 // Config is the configuration for the processor.
 type Config struct {
 
 	// GroupByKeys describes the attribute names that are going to be used for grouping.
 	// Empty value is allowed, since processor in such case can compact data
 	GroupByKeys []string `mapstructure:"keys"`
+
+	// MetricConflicts defines how the metric data points colliding after grouping are handled.
+	MetricConflicts MetricConflictsConfig `mapstructure:"metric_conflicts"`
+}
+
+// MetricConflictsConfig defines how the metric data points colliding after grouping are handled.
+// Data points collide when they belong to the same metric (name and type) of the same Resource and
+// InstrumentationLibrary, and have the same attributes and timestamp.
+type MetricConflictsConfig struct {
+	// Strategy is one of keep_all, keep_first, sum or suffix_attribute.
+	Strategy MetricConflictStrategy `mapstructure:"strategy"`
+
+	// Attribute is the attribute set to the collision index of the data points, with the suffix_attribute strategy.
+	Attribute string `mapstructure:"attribute"`
+}
+
+// Validate checks whether the configuration is valid.
+func (cfg *Config) Validate() error {
+	switch cfg.MetricConflicts.Strategy {
+	case KeepAll, KeepFirst, Sum:
+	case SuffixAttribute:
+		if cfg.MetricConflicts.Attribute == "" {
+			return errors.New("metric_conflicts::attribute must be specified with the suffix_attribute strategy")
+		}
+	default:
+		return fmt.Errorf("unsupported metric_conflicts::strategy %q", cfg.MetricConflicts.Strategy)
+	}
+	return nil
 }
@@ -19,21 +19,58 @@ func TestLoadingConfig(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		id       component.ID
-		expected component.Config
+		id           component.ID
+		expected     component.Config
+		errorMessage string
 	}{
 		{
 			id: component.NewIDWithName(metadata.Type, "grouping"),
 			expected: &Config{
 				GroupByKeys: []string{"key1", "key2"},
+				MetricConflicts: MetricConflictsConfig{
+					Strategy:  KeepAll,
+					Attribute: "groupbyattrs.collision",
+				},
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "compaction"),
 			expected: &Config{
 				GroupByKeys: []string{},
+				MetricConflicts: MetricConflictsConfig{
+					Strategy:  KeepAll,
+					Attribute: "groupbyattrs.collision",
+				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sum"),
+			expected: &Config{
+				GroupByKeys: []string{"host.name"},
+				MetricConflicts: MetricConflictsConfig{
+					Strategy:  Sum,
+					Attribute: "groupbyattrs.collision",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "suffix"),
+			expected: &Config{
+				GroupByKeys: []string{"host.name"},
+				MetricConflicts: MetricConflictsConfig{
+					Strategy:  SuffixAttribute,
+					Attribute: "source.index",
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_strategy"),
+			errorMessage: `unsupported metric_conflicts::strategy "average"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_attribute"),
+			errorMessage: "metric_conflicts::attribute must be specified with the suffix_attribute strategy",
+		},
 	}
 
 	for _, tt := range tests {
@@ -48,6 +85,10 @@ func TestLoadingConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
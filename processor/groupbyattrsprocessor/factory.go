@@ -42,6 +42,10 @@ func NewFactory() processor.Factory {
 func createDefaultConfig() component.Config {
 	return &Config{
 		GroupByKeys: []string{},
+		MetricConflicts: MetricConflictsConfig{
+			Strategy:  KeepAll,
+			Attribute: "groupbyattrs.collision",
+		},
 	}
 }
 
@@ -111,6 +115,8 @@ func createMetricsProcessor(
 
 	oCfg := cfg.(*Config)
 	gap := createGroupByAttrsProcessor(set.Logger, oCfg.GroupByKeys)
+	gap.conflictStrategy = oCfg.MetricConflicts.Strategy
+	gap.conflictAttribute = oCfg.MetricConflicts.Attribute
 
 	return processorhelper.NewMetricsProcessor(
 		ctx,
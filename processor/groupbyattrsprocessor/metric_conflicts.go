@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
+
+import (
+	"context"
+	"math"
+
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// metricIdentity identifies a grouped metric.
+type metricIdentity struct {
+	resource     [16]byte
+	scopeName    string
+	scopeVersion string
+	name         string
+	metricType   pmetric.MetricType
+}
+
+// dataPointIdentity identifies a grouped data point. Data points with the same identity collide.
+type dataPointIdentity struct {
+	metric     metricIdentity
+	attributes [16]byte
+	timestamp  pcommon.Timestamp
+}
+
+// groupedDataPoint is a data point appended to a grouped metric.
+type groupedDataPoint struct {
+	// index is the index of the data point in the data points of the grouped metric.
+	index int
+	// collisions is the number of data points which collided with it.
+	collisions int64
+}
+
+// findCollision records the data point about to be appended at the given index of the data points of the grouped metric.
+// It returns the index of the data point it collides with, and true if the data point must not be appended.
+// With the suffix_attribute strategy, the collision index is set to the attributes of the colliding data point instead.
+func (gap *groupByAttrsProcessor) findCollision(
+	ctx context.Context,
+	mg *metricsGroup,
+	metric metricIdentity,
+	attributes pcommon.Map,
+	timestamp pcommon.Timestamp,
+	index int,
+) (int, bool) {
+	id := dataPointIdentity{metric: metric, attributes: pdatautil.MapHash(attributes), timestamp: timestamp}
+	existing, found := mg.dataPoints[id]
+	if !found {
+		mg.dataPoints[id] = groupedDataPoint{index: index}
+		return 0, false
+	}
+
+	stats.Record(ctx, mNumMetricCollisions.M(1))
+	existing.collisions++
+	mg.dataPoints[id] = existing
+
+	switch gap.conflictStrategy {
+	case KeepFirst, Sum:
+		return existing.index, true
+	case SuffixAttribute:
+		attributes.PutInt(gap.conflictAttribute, existing.collisions)
+		id.attributes = pdatautil.MapHash(attributes)
+		if _, found = mg.dataPoints[id]; !found {
+			mg.dataPoints[id] = groupedDataPoint{index: index}
+		}
+	case KeepAll:
+	}
+	return 0, false
+}
+
+func (gap *groupByAttrsProcessor) appendNumberDataPoint(
+	ctx context.Context,
+	mg *metricsGroup,
+	metric metricIdentity,
+	dataPoint pmetric.NumberDataPoint,
+	dest pmetric.NumberDataPointSlice,
+) {
+	if index, collides := gap.findCollision(ctx, mg, metric, dataPoint.Attributes(), dataPoint.Timestamp(), dest.Len()); collides {
+		if gap.conflictStrategy == Sum {
+			sumNumberDataPoints(dest.At(index), dataPoint)
+		}
+		return
+	}
+	dataPoint.CopyTo(dest.AppendEmpty())
+}
+
+func (gap *groupByAttrsProcessor) appendHistogramDataPoint(
+	ctx context.Context,
+	mg *metricsGroup,
+	metric metricIdentity,
+	dataPoint pmetric.HistogramDataPoint,
+	dest pmetric.HistogramDataPointSlice,
+) {
+	if index, collides := gap.findCollision(ctx, mg, metric, dataPoint.Attributes(), dataPoint.Timestamp(), dest.Len()); collides {
+		if gap.conflictStrategy == Sum {
+			sumHistogramDataPoints(dest.At(index), dataPoint)
+		}
+		return
+	}
+	dataPoint.CopyTo(dest.AppendEmpty())
+}
+
+// appendExponentialHistogramDataPoint appends the data point, exponential histograms are not summed:
+// the first data point is kept with the sum strategy.
+func (gap *groupByAttrsProcessor) appendExponentialHistogramDataPoint(
+	ctx context.Context,
+	mg *metricsGroup,
+	metric metricIdentity,
+	dataPoint pmetric.ExponentialHistogramDataPoint,
+	dest pmetric.ExponentialHistogramDataPointSlice,
+) {
+	if _, collides := gap.findCollision(ctx, mg, metric, dataPoint.Attributes(), dataPoint.Timestamp(), dest.Len()); collides {
+		return
+	}
+	dataPoint.CopyTo(dest.AppendEmpty())
+}
+
+// appendSummaryDataPoint appends the data point, summaries are not summed:
+// the first data point is kept with the sum strategy.
+func (gap *groupByAttrsProcessor) appendSummaryDataPoint(
+	ctx context.Context,
+	mg *metricsGroup,
+	metric metricIdentity,
+	dataPoint pmetric.SummaryDataPoint,
+	dest pmetric.SummaryDataPointSlice,
+) {
+	if _, collides := gap.findCollision(ctx, mg, metric, dataPoint.Attributes(), dataPoint.Timestamp(), dest.Len()); collides {
+		return
+	}
+	dataPoint.CopyTo(dest.AppendEmpty())
+}
+
+// sumNumberDataPoints adds the value of src to dest. The value is a double unless both are integers.
+func sumNumberDataPoints(dest, src pmetric.NumberDataPoint) {
+	if dest.ValueType() == pmetric.NumberDataPointValueTypeInt && src.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		dest.SetIntValue(dest.IntValue() + src.IntValue())
+	} else {
+		dest.SetDoubleValue(numberValue(dest) + numberValue(src))
+	}
+	src.Exemplars().MoveAndAppendTo(dest.Exemplars())
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		return float64(dp.IntValue())
+	case pmetric.NumberDataPointValueTypeDouble:
+		return dp.DoubleValue()
+	case pmetric.NumberDataPointValueTypeEmpty:
+	}
+	return 0
+}
+
+// sumHistogramDataPoints adds src to dest if they have the same bucket boundaries, otherwise src is dropped.
+func sumHistogramDataPoints(dest, src pmetric.HistogramDataPoint) {
+	if !equalBounds(dest.ExplicitBounds(), src.ExplicitBounds()) || dest.BucketCounts().Len() != src.BucketCounts().Len() {
+		return
+	}
+
+	dest.SetCount(dest.Count() + src.Count())
+	for i := 0; i < dest.BucketCounts().Len(); i++ {
+		dest.BucketCounts().SetAt(i, dest.BucketCounts().At(i)+src.BucketCounts().At(i))
+	}
+	// The sum, min and max are only known if they are known for both data points.
+	if dest.HasSum() && src.HasSum() {
+		dest.SetSum(dest.Sum() + src.Sum())
+	} else {
+		dest.RemoveSum()
+	}
+	if dest.HasMin() && src.HasMin() {
+		dest.SetMin(math.Min(dest.Min(), src.Min()))
+	} else {
+		dest.RemoveMin()
+	}
+	if dest.HasMax() && src.HasMax() {
+		dest.SetMax(math.Max(dest.Max(), src.Max()))
+	} else {
+		dest.RemoveMax()
+	}
+	src.Exemplars().MoveAndAppendTo(dest.Exemplars())
+}
+
+func equalBounds(a, b pcommon.Float64Slice) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if a.At(i) != b.At(i) {
+			return false
+		}
+	}
+	return true
+}
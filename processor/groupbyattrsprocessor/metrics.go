@@ -23,6 +23,7 @@ var (
 	mNumGroupedMetrics    = stats.Int64("num_grouped_metrics", "Number of metrics that had attributes grouped", stats.UnitDimensionless)
 	mNumNonGroupedMetrics = stats.Int64("num_non_grouped_metrics", "Number of metrics that did not have attributes grouped", stats.UnitDimensionless)
 	mDistMetricGroups     = stats.Int64("metric_groups", "Distribution of groups extracted for metrics", stats.UnitDimensionless)
+	mNumMetricCollisions  = stats.Int64("num_metric_collisions", "Number of metric data points colliding with another data point after grouping", stats.UnitDimensionless)
 )
 
 // MetricViews return the metrics views according to given telemetry level.
@@ -86,5 +87,11 @@ func MetricViews() []*view.View {
 			Description: mDistMetricGroups.Description(),
 			Aggregation: distributionGroups,
 		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mNumMetricCollisions.Name()),
+			Measure:     mNumMetricCollisions,
+			Description: mNumMetricCollisions.Description(),
+			Aggregation: view.Sum(),
+		},
 	}
 }
@@ -17,6 +17,10 @@ func TestProcessorMetrics(t *testing.T) {
 		"processor/groupbyattrs/num_grouped_logs",
 		"processor/groupbyattrs/num_non_grouped_logs",
 		"processor/groupbyattrs/log_groups",
+		"processor/groupbyattrs/num_grouped_metrics",
+		"processor/groupbyattrs/num_non_grouped_metrics",
+		"processor/groupbyattrs/metric_groups",
+		"processor/groupbyattrs/num_metric_collisions",
 	}
 
 	views := MetricViews()
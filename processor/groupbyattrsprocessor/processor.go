@@ -17,6 +17,10 @@ import (
 type groupByAttrsProcessor struct {
 	logger      *zap.Logger
 	groupByKeys []string
+
+	// conflictStrategy and conflictAttribute define how metric data points colliding after grouping are handled.
+	conflictStrategy  MetricConflictStrategy
+	conflictAttribute string
 }
 
 // ProcessTraces process traces and groups traces by attribute.
@@ -115,36 +119,36 @@ func (gap *groupByAttrsProcessor) processMetrics(ctx context.Context, md pmetric
 				case pmetric.MetricTypeGauge:
 					for pointIndex := 0; pointIndex < metric.Gauge().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Gauge().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
-						dataPoint.CopyTo(groupedMetric.Gauge().DataPoints().AppendEmpty())
+						groupedMetric, id := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						gap.appendNumberDataPoint(ctx, mg, id, dataPoint, groupedMetric.Gauge().DataPoints())
 					}
 
 				case pmetric.MetricTypeSum:
 					for pointIndex := 0; pointIndex < metric.Sum().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Sum().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
-						dataPoint.CopyTo(groupedMetric.Sum().DataPoints().AppendEmpty())
+						groupedMetric, id := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						gap.appendNumberDataPoint(ctx, mg, id, dataPoint, groupedMetric.Sum().DataPoints())
 					}
 
 				case pmetric.MetricTypeSummary:
 					for pointIndex := 0; pointIndex < metric.Summary().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Summary().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
-						dataPoint.CopyTo(groupedMetric.Summary().DataPoints().AppendEmpty())
+						groupedMetric, id := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						gap.appendSummaryDataPoint(ctx, mg, id, dataPoint, groupedMetric.Summary().DataPoints())
 					}
 
 				case pmetric.MetricTypeHistogram:
 					for pointIndex := 0; pointIndex < metric.Histogram().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Histogram().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
-						dataPoint.CopyTo(groupedMetric.Histogram().DataPoints().AppendEmpty())
+						groupedMetric, id := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						gap.appendHistogramDataPoint(ctx, mg, id, dataPoint, groupedMetric.Histogram().DataPoints())
 					}
 
 				case pmetric.MetricTypeExponentialHistogram:
 					for pointIndex := 0; pointIndex < metric.ExponentialHistogram().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.ExponentialHistogram().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
-						dataPoint.CopyTo(groupedMetric.ExponentialHistogram().DataPoints().AppendEmpty())
+						groupedMetric, id := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						gap.appendExponentialHistogramDataPoint(ctx, mg, id, dataPoint, groupedMetric.ExponentialHistogram().DataPoints())
 					}
 
 				case pmetric.MetricTypeEmpty:
@@ -229,7 +233,7 @@ func getMetricInInstrumentationLibrary(ilm pmetric.ScopeMetrics, searchedMetric
 	return metric
 }
 
-// Returns the Metric in the appropriate Resource matching with the specified Attributes
+// Returns the Metric in the appropriate Resource matching with the specified Attributes, and its identity
 func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	ctx context.Context,
 	mg *metricsGroup,
@@ -237,7 +241,7 @@ func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	ilm pmetric.ScopeMetrics,
 	metric pmetric.Metric,
 	attributes pcommon.Map,
-) pmetric.Metric {
+) (pmetric.Metric, metricIdentity) {
 
 	toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(attributes)
 	if toBeGrouped {
@@ -250,12 +254,19 @@ func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	}
 
 	// Get the ResourceMetrics matching with these attributes
-	groupedResourceMetrics := mg.findOrCreateResourceMetrics(originResourceMetrics.Resource(), requiredAttributes)
+	groupedResourceMetrics, resourceHash := mg.findOrCreateResourceMetrics(originResourceMetrics.Resource(), requiredAttributes)
 
 	// Get the corresponding instrumentation library
 	groupedInstrumentationLibrary := matchingScopeMetrics(groupedResourceMetrics, ilm.Scope())
 
 	// Return the metric in this resource
-	return getMetricInInstrumentationLibrary(groupedInstrumentationLibrary, metric)
+	id := metricIdentity{
+		resource:     resourceHash,
+		scopeName:    ilm.Scope().Name(),
+		scopeVersion: ilm.Scope().Version(),
+		name:         metric.Name(),
+		metricType:   metric.Type(),
+	}
+	return getMetricInInstrumentationLibrary(groupedInstrumentationLibrary, metric), id
 
 }
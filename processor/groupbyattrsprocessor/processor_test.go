@@ -802,6 +802,126 @@ func retrieveMetric(metrics pmetric.MetricSlice, name string, metricType pmetric
 	return pmetric.Metric{}, false
 }
 
+func TestMetricConflicts(t *testing.T) {
+	ts := pcommon.NewTimestampFromTime(time.Unix(1689674400, 0))
+
+	metrics := pmetric.NewMetrics()
+	// Two identical resources, e.g. from two requests, whose data points collide once grouped by host.name
+	for i := 0; i < 2; i++ {
+		rm := metrics.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("source", "prom")
+		ilm := rm.ScopeMetrics().AppendEmpty()
+
+		requests := ilm.Metrics().AppendEmpty()
+		requests.SetName("requests")
+		requests.SetEmptySum().SetIsMonotonic(true)
+		dp := requests.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("host.name", "host-A")
+		dp.Attributes().PutStr("id", "eth0")
+		dp.SetTimestamp(ts)
+		dp.SetIntValue(2)
+		// A later data point of the same series does not collide
+		dp = requests.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("host.name", "host-A")
+		dp.Attributes().PutStr("id", "eth0")
+		dp.SetTimestamp(ts + 1)
+		dp.SetIntValue(3)
+
+		latency := ilm.Metrics().AppendEmpty()
+		latency.SetName("latency")
+		latency.SetEmptyHistogram()
+		hdp := latency.Histogram().DataPoints().AppendEmpty()
+		hdp.Attributes().PutStr("host.name", "host-A")
+		hdp.SetTimestamp(ts)
+		hdp.SetCount(3)
+		hdp.SetSum(6)
+		hdp.SetMin(1)
+		hdp.SetMax(3)
+		hdp.ExplicitBounds().FromRaw([]float64{2})
+		hdp.BucketCounts().FromRaw([]uint64{1, 2})
+	}
+
+	tests := []struct {
+		strategy MetricConflictStrategy
+		check    func(t *testing.T, requests, latency pmetric.Metric)
+	}{
+		{
+			strategy: KeepAll,
+			check: func(t *testing.T, requests, latency pmetric.Metric) {
+				assert.Equal(t, 4, requests.Sum().DataPoints().Len())
+				assert.Equal(t, 2, latency.Histogram().DataPoints().Len())
+			},
+		},
+		{
+			strategy: KeepFirst,
+			check: func(t *testing.T, requests, latency pmetric.Metric) {
+				dps := requests.Sum().DataPoints()
+				assert.Equal(t, 2, dps.Len())
+				assert.Equal(t, int64(2), dps.At(0).IntValue())
+				assert.Equal(t, int64(3), dps.At(1).IntValue())
+				assert.Equal(t, 1, latency.Histogram().DataPoints().Len())
+				assert.Equal(t, uint64(3), latency.Histogram().DataPoints().At(0).Count())
+			},
+		},
+		{
+			strategy: Sum,
+			check: func(t *testing.T, requests, latency pmetric.Metric) {
+				dps := requests.Sum().DataPoints()
+				assert.Equal(t, 2, dps.Len())
+				assert.Equal(t, int64(4), dps.At(0).IntValue())
+				assert.Equal(t, int64(6), dps.At(1).IntValue())
+				assert.Equal(t, map[string]interface{}{"id": "eth0"}, dps.At(0).Attributes().AsRaw())
+
+				hdps := latency.Histogram().DataPoints()
+				assert.Equal(t, 1, hdps.Len())
+				assert.Equal(t, uint64(6), hdps.At(0).Count())
+				assert.Equal(t, 12.0, hdps.At(0).Sum())
+				assert.Equal(t, 1.0, hdps.At(0).Min())
+				assert.Equal(t, 3.0, hdps.At(0).Max())
+				assert.Equal(t, []uint64{2, 4}, hdps.At(0).BucketCounts().AsRaw())
+			},
+		},
+		{
+			strategy: SuffixAttribute,
+			check: func(t *testing.T, requests, latency pmetric.Metric) {
+				dps := requests.Sum().DataPoints()
+				assert.Equal(t, 4, dps.Len())
+				assert.Equal(t, map[string]interface{}{"id": "eth0"}, dps.At(0).Attributes().AsRaw())
+				assert.Equal(t, map[string]interface{}{"id": "eth0"}, dps.At(1).Attributes().AsRaw())
+				assert.Equal(t, map[string]interface{}{"id": "eth0", "collision": int64(1)}, dps.At(2).Attributes().AsRaw())
+				assert.Equal(t, map[string]interface{}{"id": "eth0", "collision": int64(1)}, dps.At(3).Attributes().AsRaw())
+
+				hdps := latency.Histogram().DataPoints()
+				assert.Equal(t, 2, hdps.Len())
+				assert.Equal(t, map[string]interface{}{"collision": int64(1)}, hdps.At(1).Attributes().AsRaw())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			gap := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"})
+			gap.conflictStrategy = tt.strategy
+			gap.conflictAttribute = "collision"
+
+			input := pmetric.NewMetrics()
+			metrics.CopyTo(input)
+			processedMetrics, err := gap.processMetrics(context.Background(), input)
+			assert.NoError(t, err)
+
+			assert.Equal(t, 1, processedMetrics.ResourceMetrics().Len())
+			hostA, foundHostA := retrieveHostResource(processedMetrics.ResourceMetrics(), "host-A")
+			assert.True(t, foundHostA)
+			assert.Equal(t, 1, hostA.ScopeMetrics().Len())
+			requests, foundRequests := retrieveMetric(hostA.ScopeMetrics().At(0).Metrics(), "requests", pmetric.MetricTypeSum)
+			assert.True(t, foundRequests)
+			latency, foundLatency := retrieveMetric(hostA.ScopeMetrics().At(0).Metrics(), "latency", pmetric.MetricTypeHistogram)
+			assert.True(t, foundLatency)
+			tt.check(t, requests, latency)
+		})
+	}
+}
+
 func TestCompacting(t *testing.T) {
 	spans := someSpans(attrMap, 10, 10)
 	logs := someLogs(attrMap, 10, 10)
@@ -5,6 +5,8 @@ package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"time"
+
+	"go.opentelemetry.io/collector/component"
 )
 
 // Config is the configuration for the processor.
@@ -33,4 +35,9 @@ type Config struct {
 	// Default: false.
 	// Not yet implemented, and an error will be returned when this option is used.
 	StoreOnDisk bool `mapstructure:"store_on_disk"`
+
+	// StorageID is the ID of the storage extension the traces evicted from memory are spilled to, when more than
+	// NumTraces traces are in flight. They are released from it once the wait duration expires.
+	// Default: not set, the evicted traces are discarded.
+	StorageID *component.ID `mapstructure:"storage"`
 }
@@ -97,6 +97,7 @@ func newEventMachine(logger *zap.Logger, bufferSize int, numWorkers int, numTrac
 		em.workers[i] = &eventMachineWorker{
 			machine: em,
 			buffer:  newRingBuffer(numTraces / numWorkers),
+			spilled: make(map[pcommon.TraceID]int),
 			events:  make(chan event, bufferSize/numWorkers),
 		}
 	}
@@ -307,6 +308,10 @@ type eventMachineWorker struct {
 	// the ring buffer holds the IDs for all the in-flight traces
 	buffer *ringBuffer
 
+	// the IDs of the in-flight traces evicted from the ring buffer and spilled to the storage extension,
+	// with the number of batches of spans spilled for each of them
+	spilled map[pcommon.TraceID]int
+
 	events chan event
 }
 
@@ -73,5 +73,7 @@ func createTracesProcessor(
 	// the only supported storage for now
 	st = newMemoryStorage()
 
-	return newGroupByTraceProcessor(params.Logger, st, nextConsumer, *oCfg), nil
+	p := newGroupByTraceProcessor(params.Logger, st, nextConsumer, *oCfg)
+	p.id = params.ID
+	return p, nil
 }
@@ -13,14 +13,17 @@ import (
 )
 
 var (
-	mNumTracesConf      = stats.Int64("processor_groupbytrace_conf_num_traces", "Maximum number of traces to hold in the internal storage", stats.UnitDimensionless)
-	mNumEventsInQueue   = stats.Int64("processor_groupbytrace_num_events_in_queue", "Number of events currently in the queue", stats.UnitDimensionless)
-	mNumTracesInMemory  = stats.Int64("processor_groupbytrace_num_traces_in_memory", "Number of traces currently in the in-memory storage", stats.UnitDimensionless)
-	mTracesEvicted      = stats.Int64("processor_groupbytrace_traces_evicted", "Traces evicted from the internal buffer", stats.UnitDimensionless)
-	mReleasedSpans      = stats.Int64("processor_groupbytrace_spans_released", "Spans released to the next consumer", stats.UnitDimensionless)
-	mReleasedTraces     = stats.Int64("processor_groupbytrace_traces_released", "Traces released to the next consumer", stats.UnitDimensionless)
-	mIncompleteReleases = stats.Int64("processor_groupbytrace_incomplete_releases", "Releases that are suspected to have been incomplete", stats.UnitDimensionless)
-	mEventLatency       = stats.Int64("processor_groupbytrace_event_latency", "How long the queue events are taking to be processed", stats.UnitMilliseconds)
+	mNumTracesConf        = stats.Int64("processor_groupbytrace_conf_num_traces", "Maximum number of traces to hold in the internal storage", stats.UnitDimensionless)
+	mNumEventsInQueue     = stats.Int64("processor_groupbytrace_num_events_in_queue", "Number of events currently in the queue", stats.UnitDimensionless)
+	mNumTracesInMemory    = stats.Int64("processor_groupbytrace_num_traces_in_memory", "Number of traces currently in the in-memory storage", stats.UnitDimensionless)
+	mTracesEvicted        = stats.Int64("processor_groupbytrace_traces_evicted", "Traces evicted from the internal buffer", stats.UnitDimensionless)
+	mReleasedSpans        = stats.Int64("processor_groupbytrace_spans_released", "Spans released to the next consumer", stats.UnitDimensionless)
+	mReleasedTraces       = stats.Int64("processor_groupbytrace_traces_released", "Traces released to the next consumer", stats.UnitDimensionless)
+	mIncompleteReleases   = stats.Int64("processor_groupbytrace_incomplete_releases", "Releases that are suspected to have been incomplete", stats.UnitDimensionless)
+	mEventLatency         = stats.Int64("processor_groupbytrace_event_latency", "How long the queue events are taking to be processed", stats.UnitMilliseconds)
+	mTracesSpilled        = stats.Int64("processor_groupbytrace_traces_spilled", "Traces spilled from memory to the storage extension", stats.UnitDimensionless)
+	mSpansSpilled         = stats.Int64("processor_groupbytrace_spans_spilled", "Spans spilled from memory to the storage extension", stats.UnitDimensionless)
+	mSpillRecoveryLatency = stats.Int64("processor_groupbytrace_spill_recovery_latency", "How long the spilled traces are taking to be read back from the storage extension", stats.UnitMilliseconds)
 )
 
 // MetricViews return the metrics views according to given telemetry level.
@@ -78,5 +81,23 @@ func MetricViews() []*view.View {
 			},
 			Aggregation: view.Distribution(0, 5, 10, 20, 50, 100, 200, 500, 1000),
 		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mTracesSpilled.Name()),
+			Measure:     mTracesSpilled,
+			Description: mTracesSpilled.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mSpansSpilled.Name()),
+			Measure:     mSpansSpilled,
+			Description: mSpansSpilled.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mSpillRecoveryLatency.Name()),
+			Measure:     mSpillRecoveryLatency,
+			Description: mSpillRecoveryLatency.Description(),
+			Aggregation: view.Distribution(0, 5, 10, 20, 50, 100, 200, 500, 1000),
+		},
 	}
 }
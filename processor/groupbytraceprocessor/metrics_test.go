@@ -19,6 +19,9 @@ func TestProcessorMetrics(t *testing.T) {
 		"processor/groupbytrace/processor_groupbytrace_traces_released",
 		"processor/groupbytrace/processor_groupbytrace_incomplete_releases",
 		"processor/groupbytrace/processor_groupbytrace_event_latency",
+		"processor/groupbytrace/processor_groupbytrace_traces_spilled",
+		"processor/groupbytrace/processor_groupbytrace_spans_spilled",
+		"processor/groupbytrace/processor_groupbytrace_spill_recovery_latency",
 	}
 
 	views := MetricViews()
@@ -31,8 +31,10 @@ import (
 // ConsumeTraces -> eventMachine.consume(trace) -> event(traceReceived) -> onTraceReceived -> AfterFunc(duration, event(traceExpired)) -> onTraceExpired
 // async markAsReleased -> event(traceReleased) -> onTraceReleased -> nextConsumer
 // Each worker in the eventMachine also uses a ring buffer to hold the in-flight trace IDs, so that we don't hold more than the given maximum number
-// of traces in memory/storage. Items that are evicted from the buffer are discarded without warning.
+// of traces in memory/storage. Items that are evicted from the buffer are discarded without warning, unless a storage
+// extension is configured: they are then spilled to it, and read back from it once their duration expires.
 type groupByTraceProcessor struct {
+	id           component.ID
 	nextConsumer consumer.Traces
 	config       Config
 	logger       *zap.Logger
@@ -42,6 +44,9 @@ type groupByTraceProcessor struct {
 
 	// the trace storage
 	st storage
+
+	// the storage of the traces evicted from the trace storage, nil when no storage extension is configured
+	spill *spillStorage
 }
 
 var _ processor.Traces = (*groupByTraceProcessor)(nil)
@@ -83,7 +88,15 @@ func (sp *groupByTraceProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (sp *groupByTraceProcessor) Start(context.Context, component.Host) error {
+func (sp *groupByTraceProcessor) Start(ctx context.Context, host component.Host) error {
+	if sp.config.StorageID != nil {
+		client, err := getStorageClient(ctx, host, *sp.config.StorageID, sp.id)
+		if err != nil {
+			return err
+		}
+		sp.spill = newSpillStorage(client)
+	}
+
 	// start these metrics, as it might take a while for them to receive their first event
 	stats.Record(context.Background(), mTracesEvicted.M(0))
 	stats.Record(context.Background(), mIncompleteReleases.M(0))
@@ -94,9 +107,20 @@ func (sp *groupByTraceProcessor) Start(context.Context, component.Host) error {
 }
 
 // Shutdown is invoked during service shutdown.
-func (sp *groupByTraceProcessor) Shutdown(_ context.Context) error {
+func (sp *groupByTraceProcessor) Shutdown(ctx context.Context) error {
 	sp.eventMachine.shutdown()
-	return sp.st.shutdown()
+
+	var errs error
+	if sp.spill != nil {
+		spilled := make(map[pcommon.TraceID]int)
+		for _, worker := range sp.eventMachine.workers {
+			for traceID, batches := range worker.spilled {
+				spilled[traceID] = batches
+			}
+		}
+		errs = sp.spill.shutdown(ctx, spilled)
+	}
+	return multierr.Append(errs, sp.st.shutdown())
 }
 
 func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eventMachineWorker) error {
@@ -113,12 +137,31 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 		return nil
 	}
 
+	if batches, spilled := worker.spilled[traceID]; spilled {
+		sp.logger.Debug("trace is already in spill storage")
+
+		// it was evicted from memory, add the spans to the trace in the spill storage as a new batch
+		if err := sp.spill.append(traceID, batches, trace.td); err != nil {
+			return fmt.Errorf("couldn't add spans to spilled trace: %w", err)
+		}
+		worker.spilled[traceID] = batches + 1
+		stats.Record(context.Background(), mSpansSpilled.M(int64(trace.td.SpanCount())))
+		return nil
+	}
+
 	// at this point, we determined that we haven't seen the trace yet, so, record the
 	// traceID in the map and the spans to the storage
 
 	// place the trace ID in the buffer, and check if an item had to be evicted
 	evicted := worker.buffer.put(traceID)
-	if !evicted.IsEmpty() {
+	if !evicted.IsEmpty() && sp.spill != nil {
+		// move from the storage to the spill storage, where it waits for its expiration
+		if err := sp.spillTrace(evicted, worker); err != nil {
+			stats.Record(context.Background(), mTracesEvicted.M(1))
+
+			sp.logger.Error("trace evicted: couldn't spill it to the storage extension", zap.Stringer("traceID", evicted), zap.Error(err))
+		}
+	} else if !evicted.IsEmpty() {
 		// delete from the storage
 		worker.fire(event{
 			typ:     traceRemoved,
@@ -151,6 +194,20 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 func (sp *groupByTraceProcessor) onTraceExpired(traceID pcommon.TraceID, worker *eventMachineWorker) error {
 	sp.logger.Debug("processing expired", zap.Stringer("traceID", traceID))
 
+	if batches, spilled := worker.spilled[traceID]; spilled {
+		delete(worker.spilled, traceID)
+
+		// this might block, but we don't need to wait
+		sp.logger.Debug("releasing the spilled trace", zap.Stringer("traceID", traceID))
+		go func() {
+			if err := sp.releaseSpilled(traceID, batches, worker.fire); err != nil {
+				sp.logger.Error("couldn't release the spilled trace", zap.Stringer("traceID", traceID), zap.Error(err))
+			}
+		}()
+
+		return nil
+	}
+
 	if !worker.buffer.contains(traceID) {
 		// we likely received multiple batches with spans for the same trace
 		// and released this trace already
@@ -198,6 +255,57 @@ func (sp *groupByTraceProcessor) markAsReleased(traceID pcommon.TraceID, fire fu
 	return nil
 }
 
+// spillTrace moves the trace evicted from the buffer from the storage to the spill storage.
+func (sp *groupByTraceProcessor) spillTrace(traceID pcommon.TraceID, worker *eventMachineWorker) error {
+	rss, err := sp.st.delete(traceID)
+	if err != nil {
+		return fmt.Errorf("couldn't delete trace %q from the storage: %w", traceID, err)
+	}
+
+	if rss == nil {
+		return fmt.Errorf("trace %q not found at the storage", traceID)
+	}
+
+	trace := ptrace.NewTraces()
+	for _, rs := range rss {
+		rs.MoveTo(trace.ResourceSpans().AppendEmpty())
+	}
+	spanCount := trace.SpanCount()
+	if err = sp.spill.append(traceID, 0, trace); err != nil {
+		return fmt.Errorf("couldn't add trace %q to the spill storage: %w", traceID, err)
+	}
+
+	worker.spilled[traceID] = 1
+	stats.Record(context.Background(),
+		mTracesSpilled.M(1),
+		mSpansSpilled.M(int64(spanCount)),
+	)
+	sp.logger.Debug("trace spilled to the storage extension", zap.Stringer("traceID", traceID))
+	return nil
+}
+
+// releaseSpilled reads the batches of the expired trace back from the spill storage, and releases it.
+func (sp *groupByTraceProcessor) releaseSpilled(traceID pcommon.TraceID, batches int, fire func(...event)) error {
+	start := time.Now()
+	// #take is a potentially blocking operation
+	trace, err := sp.spill.take(traceID, batches)
+	if err != nil {
+		return fmt.Errorf("couldn't retrieve trace %q from the spill storage: %w", traceID, err)
+	}
+
+	if trace == nil {
+		return fmt.Errorf("the trace %q couldn't be found at the spill storage", traceID)
+	}
+	stats.Record(context.Background(), mSpillRecoveryLatency.M(time.Since(start).Milliseconds()))
+
+	// the trace is already out of the storage, there is nothing to remove
+	fire(event{
+		typ:     traceReleased,
+		payload: trace,
+	})
+	return nil
+}
+
 func (sp *groupByTraceProcessor) onTraceReleased(rss []ptrace.ResourceSpans) error {
 	trace := ptrace.NewTraces()
 	for _, rs := range rss {
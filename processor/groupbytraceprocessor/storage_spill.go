@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+)
+
+// spillStorage holds the traces evicted from the in-memory storage in a storage extension, until
+// their wait duration expires. Unlike the in-memory storage, it isn't bounded by the number of traces.
+//
+// Each batch of spans of a trace is stored under its own key, made of the trace ID and of the
+// sequence number of the batch, so that adding spans to a trace doesn't rewrite it. The batches
+// are merged when the trace is taken out of the storage.
+type spillStorage struct {
+	client      storage.Client
+	marshaler   ptrace.ProtoMarshaler
+	unmarshaler ptrace.ProtoUnmarshaler
+}
+
+func newSpillStorage(client storage.Client) *spillStorage {
+	return &spillStorage{client: client}
+}
+
+// append stores the spans as the batch with the given sequence number of the trace with the given ID.
+func (st *spillStorage) append(traceID pcommon.TraceID, seq int, td ptrace.Traces) error {
+	data, err := st.marshaler.MarshalTraces(td)
+	if err != nil {
+		return err
+	}
+	return st.client.Set(context.Background(), batchKey(traceID, seq), data)
+}
+
+// take removes the given number of batches of the trace with the given ID from the storage,
+// returning their spans, or nil in case none of them can be found.
+func (st *spillStorage) take(traceID pcommon.TraceID, batches int) ([]ptrace.ResourceSpans, error) {
+	ctx := context.Background()
+	gets := make([]storage.Operation, batches)
+	for seq := range gets {
+		gets[seq] = storage.GetOperation(batchKey(traceID, seq))
+	}
+	if err := st.client.Batch(ctx, gets...); err != nil {
+		return nil, err
+	}
+
+	var result []ptrace.ResourceSpans
+	for seq, op := range gets {
+		if op.Value == nil {
+			continue
+		}
+		trace, err := st.unmarshaler.UnmarshalTraces(op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch %d of spilled trace %q: %w", seq, traceID, err)
+		}
+		for i := 0; i < trace.ResourceSpans().Len(); i++ {
+			result = append(result, trace.ResourceSpans().At(i))
+		}
+	}
+	if err := st.delete(ctx, traceID, batches); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (st *spillStorage) delete(ctx context.Context, traceID pcommon.TraceID, batches int) error {
+	deletes := make([]storage.Operation, batches)
+	for seq := range deletes {
+		deletes[seq] = storage.DeleteOperation(batchKey(traceID, seq))
+	}
+	return st.client.Batch(ctx, deletes...)
+}
+
+// shutdown removes the traces still in the storage, given with their number of batches, which are
+// discarded like the traces still in memory, and closes the client.
+func (st *spillStorage) shutdown(ctx context.Context, spilled map[pcommon.TraceID]int) error {
+	var errs error
+	for traceID, batches := range spilled {
+		errs = multierr.Append(errs, st.delete(ctx, traceID, batches))
+	}
+	return multierr.Append(errs, st.client.Close(ctx))
+}
+
+func batchKey(traceID pcommon.TraceID, seq int) string {
+	return traceID.String() + "/" + strconv.Itoa(seq)
+}
+
+func getStorageClient(ctx context.Context, host component.Host, storageID component.ID, componentID component.ID) (storage.Client, error) {
+	extension, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindProcessor, componentID, "")
+}
@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/zap"
+)
+
+func TestEvictedTraceIsSpilled(t *testing.T) {
+	// prepare
+	storageID := component.NewID("test_storage")
+	ext := &testStorageExtension{client: &testStorageClient{data: map[string][]byte{}}}
+	host := &testStorageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: ext},
+	}
+	config := Config{
+		WaitDuration: 100 * time.Millisecond,
+		NumTraces:    1,
+		NumWorkers:   1,
+		StorageID:    &storageID,
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	received := map[pcommon.TraceID]int{}
+	next := &mockProcessor{
+		onTraces: func(_ context.Context, td ptrace.Traces) error {
+			traceID := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID()
+			received[traceID] = td.SpanCount()
+			wg.Done()
+			return nil
+		},
+	}
+
+	p := newGroupByTraceProcessor(zap.NewNop(), newMemoryStorage(), next, config)
+	ctx := context.Background()
+	require.NoError(t, p.Start(ctx, host))
+	defer func() {
+		assert.NoError(t, p.Shutdown(ctx))
+	}()
+
+	// test
+	first := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	second := pcommon.TraceID([16]byte{2, 3, 4, 5})
+	require.NoError(t, p.ConsumeTraces(ctx, simpleTracesWithID(first)))
+	// the first trace is evicted from memory by the second one, and spilled
+	require.NoError(t, p.ConsumeTraces(ctx, simpleTracesWithID(second)))
+	// the spans of the first trace are added to it in the spill storage
+	require.NoError(t, p.ConsumeTraces(ctx, simpleTracesWithID(first)))
+
+	wg.Wait()
+
+	// verify
+	assert.Equal(t, map[pcommon.TraceID]int{first: 2, second: 1}, received)
+	assert.Empty(t, ext.client.keys())
+}
+
+func TestSpilledTracesAreRemovedOnShutdown(t *testing.T) {
+	// prepare
+	storageID := component.NewID("test_storage")
+	ext := &testStorageExtension{client: &testStorageClient{data: map[string][]byte{}}}
+	host := &testStorageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: ext},
+	}
+	config := Config{
+		WaitDuration: time.Hour,
+		NumTraces:    1,
+		NumWorkers:   1,
+		StorageID:    &storageID,
+	}
+	p := newGroupByTraceProcessor(zap.NewNop(), newMemoryStorage(), &mockProcessor{}, config)
+	ctx := context.Background()
+	require.NoError(t, p.Start(ctx, host))
+
+	// test
+	require.NoError(t, p.ConsumeTraces(ctx, simpleTracesWithID(pcommon.TraceID([16]byte{1, 2, 3, 4}))))
+	require.NoError(t, p.ConsumeTraces(ctx, simpleTracesWithID(pcommon.TraceID([16]byte{2, 3, 4, 5}))))
+	assert.Eventually(t, func() bool {
+		return len(ext.client.keys()) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, p.Shutdown(ctx))
+
+	// verify
+	assert.Empty(t, ext.client.keys())
+}
+
+func TestSpillStorageBatches(t *testing.T) {
+	client := &testStorageClient{data: map[string][]byte{}}
+	st := newSpillStorage(client)
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+
+	for seq, name := range []string{"first", "second", "third"} {
+		td := simpleTracesWithID(traceID)
+		td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetName(name)
+		require.NoError(t, st.append(traceID, seq, td))
+	}
+	// each batch is stored under its own key
+	assert.ElementsMatch(t, []string{
+		traceID.String() + "/0",
+		traceID.String() + "/1",
+		traceID.String() + "/2",
+	}, client.keys())
+
+	rss, err := st.take(traceID, 3)
+	require.NoError(t, err)
+	var names []string
+	for _, rs := range rss {
+		names = append(names, rs.ScopeSpans().At(0).Spans().At(0).Name())
+	}
+	assert.Equal(t, []string{"first", "second", "third"}, names)
+	assert.Empty(t, client.keys())
+
+	rss, err = st.take(traceID, 3)
+	require.NoError(t, err)
+	assert.Nil(t, rss)
+}
+
+func TestStartWithInvalidStorage(t *testing.T) {
+	storageID := component.NewID("test_storage")
+	c := createDefaultConfig().(*Config)
+	c.StorageID = &storageID
+	p, err := createTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), c, &mockProcessor{})
+	require.NoError(t, err)
+	assert.EqualError(t, p.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'test_storage' not found")
+
+	host := &testStorageHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: &struct {
+			component.StartFunc
+			component.ShutdownFunc
+		}{}},
+	}
+	assert.EqualError(t, p.Start(context.Background(), host), "non-storage extension 'test_storage' found")
+}
+
+type testStorageHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testStorageHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+type testStorageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	client *testStorageClient
+}
+
+func (e *testStorageExtension) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return e.client, nil
+}
+
+type testStorageClient struct {
+	sync.Mutex
+	data map[string][]byte
+}
+
+func (c *testStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.data[key], nil
+}
+
+func (c *testStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.Lock()
+	defer c.Unlock()
+	c.data[key] = value
+	return nil
+}
+
+func (c *testStorageClient) Delete(_ context.Context, key string) error {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.data, key)
+	return nil
+}
+
+func (c *testStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value, _ = c.Get(ctx, op.Key)
+		case storage.Set:
+			_ = c.Set(ctx, op.Key, op.Value)
+		case storage.Delete:
+			_ = c.Delete(ctx, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *testStorageClient) Close(context.Context) error {
+	return nil
+}
+
+func (c *testStorageClient) keys() []string {
+	c.Lock()
+	defer c.Unlock()
+	var keys []string
+	for key := range c.data {
+		keys = append(keys, key)
+	}
+	return keys
+}
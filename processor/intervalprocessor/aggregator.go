@@ -0,0 +1,397 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor"
+
+import (
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// aggregator holds the data points aggregated within the current interval, by resource, scope
+// and metric. They are kept in the order they were received in, so that they are exported in order.
+type aggregator struct {
+	gauge    Policy
+	deltaSum Policy
+
+	resources map[[16]byte]*resourceAggregates
+	order     []*resourceAggregates
+}
+
+type resourceAggregates struct {
+	resource pcommon.Resource
+	scopes   map[string]*scopeAggregates
+	order    []*scopeAggregates
+}
+
+type scopeAggregates struct {
+	scope   pcommon.InstrumentationScope
+	metrics map[string]*metricAggregates
+	order   []*metricAggregates
+}
+
+// metricAggregates holds the aggregated data points of a metric, by attributes.
+type metricAggregates struct {
+	// metric holds the aggregated data points
+	metric pmetric.Metric
+	policy Policy
+	// points holds the index of the aggregated data points, by attributes
+	points map[[16]byte]int
+	// counts holds the number of data points aggregated into each aggregated data point
+	counts []int
+}
+
+// dataPoint is implemented by the data points of all the metric types.
+type dataPoint[T any] interface {
+	Attributes() pcommon.Map
+	StartTimestamp() pcommon.Timestamp
+	Timestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	CopyTo(T)
+}
+
+// dataPointSlice is implemented by the data point slices of all the metric types.
+type dataPointSlice[T any] interface {
+	Len() int
+	At(int) T
+	AppendEmpty() T
+}
+
+func newAggregator(gauge, deltaSum Policy) *aggregator {
+	return &aggregator{
+		gauge:     gauge,
+		deltaSum:  deltaSum,
+		resources: map[[16]byte]*resourceAggregates{},
+	}
+}
+
+// aggregate aggregates the data points of the metric, and removes them from it. The data points
+// which cannot be aggregated are kept, and it returns whether there are none: the exponential
+// histograms and the histograms with different bounds of delta temporality, and the metrics
+// without temporality.
+func (a *aggregator) aggregate(resource pcommon.Resource, resourceKey [16]byte, scope pcommon.InstrumentationScope, metric pmetric.Metric) bool {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		if dps.Len() == 0 {
+			return false
+		}
+		ma := a.metricAggregates(resource, resourceKey, scope, metric, a.gauge)
+		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			ma.aggregateNumber(ma.metric.Gauge().DataPoints(), dp, false)
+			return true
+		})
+		return true
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		delta := metric.Sum().AggregationTemporality() == pmetric.AggregationTemporalityDelta
+		if dps.Len() == 0 || !hasTemporality(metric.Sum().AggregationTemporality()) {
+			return false
+		}
+		policy := PolicyLast
+		if delta {
+			policy = a.deltaSum
+		}
+		ma := a.metricAggregates(resource, resourceKey, scope, metric, policy)
+		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			ma.aggregateNumber(ma.metric.Sum().DataPoints(), dp, delta)
+			return true
+		})
+		return true
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		delta := metric.Histogram().AggregationTemporality() == pmetric.AggregationTemporalityDelta
+		if dps.Len() == 0 || !hasTemporality(metric.Histogram().AggregationTemporality()) {
+			return false
+		}
+		policy := PolicyLast
+		if delta {
+			policy = PolicySum
+		}
+		ma := a.metricAggregates(resource, resourceKey, scope, metric, policy)
+		dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+			return ma.aggregateHistogram(ma.metric.Histogram().DataPoints(), dp, delta)
+		})
+		return dps.Len() == 0
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		if dps.Len() == 0 || metric.ExponentialHistogram().AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+			return false
+		}
+		ma := a.metricAggregates(resource, resourceKey, scope, metric, PolicyLast)
+		dps.RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+			aggregateLast[pmetric.ExponentialHistogramDataPoint](ma, ma.metric.ExponentialHistogram().DataPoints(), dp, false)
+			return true
+		})
+		return true
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		if dps.Len() == 0 {
+			return false
+		}
+		ma := a.metricAggregates(resource, resourceKey, scope, metric, PolicyLast)
+		dps.RemoveIf(func(dp pmetric.SummaryDataPoint) bool {
+			aggregateLast[pmetric.SummaryDataPoint](ma, ma.metric.Summary().DataPoints(), dp, false)
+			return true
+		})
+		return true
+	}
+	return false
+}
+
+func hasTemporality(temporality pmetric.AggregationTemporality) bool {
+	return temporality == pmetric.AggregationTemporalityDelta || temporality == pmetric.AggregationTemporalityCumulative
+}
+
+// metricAggregates returns the aggregates of the metric, created if they do not exist.
+func (a *aggregator) metricAggregates(resource pcommon.Resource, resourceKey [16]byte, scope pcommon.InstrumentationScope, metric pmetric.Metric, policy Policy) *metricAggregates {
+	ra, ok := a.resources[resourceKey]
+	if !ok {
+		ra = &resourceAggregates{resource: pcommon.NewResource(), scopes: map[string]*scopeAggregates{}}
+		resource.CopyTo(ra.resource)
+		a.resources[resourceKey] = ra
+		a.order = append(a.order, ra)
+	}
+
+	key := scopeKey(scope)
+	sa, ok := ra.scopes[key]
+	if !ok {
+		sa = &scopeAggregates{scope: pcommon.NewInstrumentationScope(), metrics: map[string]*metricAggregates{}}
+		scope.CopyTo(sa.scope)
+		ra.scopes[key] = sa
+		ra.order = append(ra.order, sa)
+	}
+
+	key = metricKey(metric)
+	ma, ok := sa.metrics[key]
+	if !ok {
+		ma = &metricAggregates{metric: pmetric.NewMetric(), policy: policy, points: map[[16]byte]int{}}
+		copyMetricDetails(metric, ma.metric)
+		sa.metrics[key] = ma
+		sa.order = append(sa.order, ma)
+	}
+	return ma
+}
+
+func scopeKey(scope pcommon.InstrumentationScope) string {
+	attrsHash := pdatautil.MapHash(scope.Attributes())
+	return strings.Join([]string{scope.Name(), scope.Version(), string(attrsHash[:])}, "\x00")
+}
+
+// metricKey identifies the metrics whose data points can be aggregated together.
+func metricKey(metric pmetric.Metric) string {
+	key := []string{metric.Name(), metric.Unit(), metric.Type().String()}
+	switch metric.Type() {
+	case pmetric.MetricTypeSum:
+		key = append(key, metric.Sum().AggregationTemporality().String(), strconv.FormatBool(metric.Sum().IsMonotonic()))
+	case pmetric.MetricTypeHistogram:
+		key = append(key, metric.Histogram().AggregationTemporality().String())
+	case pmetric.MetricTypeExponentialHistogram:
+		key = append(key, metric.ExponentialHistogram().AggregationTemporality().String())
+	}
+	return strings.Join(key, "\x00")
+}
+
+func copyMetricDetails(from, to pmetric.Metric) {
+	to.SetName(from.Name())
+	to.SetDescription(from.Description())
+	to.SetUnit(from.Unit())
+	switch from.Type() {
+	case pmetric.MetricTypeGauge:
+		to.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		to.SetEmptySum().SetAggregationTemporality(from.Sum().AggregationTemporality())
+		to.Sum().SetIsMonotonic(from.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		to.SetEmptyHistogram().SetAggregationTemporality(from.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		to.SetEmptyExponentialHistogram().SetAggregationTemporality(from.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		to.SetEmptySummary()
+	}
+}
+
+// find returns the index of the aggregated data point with the same attributes as the data point,
+// appending a copy of the data point if there is none. The boolean is true when it was appended.
+func find[T dataPoint[T]](ma *metricAggregates, dps dataPointSlice[T], dp T) (int, bool) {
+	key := pdatautil.MapHash(dp.Attributes())
+	if i, ok := ma.points[key]; ok {
+		return i, false
+	}
+	i := dps.Len()
+	dp.CopyTo(dps.AppendEmpty())
+	ma.points[key] = i
+	ma.counts = append(ma.counts, 1)
+	return i, true
+}
+
+// aggregateLast replaces the aggregated data point by the data point if it is more recent. The start
+// time of the aggregated delta data point is kept, as it covers all of the data points aggregated.
+func aggregateLast[T dataPoint[T]](ma *metricAggregates, dps dataPointSlice[T], dp T, delta bool) {
+	i, added := find(ma, dps, dp)
+	if added {
+		return
+	}
+	ma.counts[i]++
+	agg := dps.At(i)
+	if dp.Timestamp() < agg.Timestamp() {
+		return
+	}
+	start := agg.StartTimestamp()
+	dp.CopyTo(agg)
+	if delta {
+		agg.SetStartTimestamp(start)
+	}
+}
+
+func (ma *metricAggregates) aggregateNumber(dps pmetric.NumberDataPointSlice, dp pmetric.NumberDataPoint, delta bool) {
+	if ma.policy == PolicyLast {
+		aggregateLast[pmetric.NumberDataPoint](ma, dps, dp, delta)
+		return
+	}
+
+	i, added := find[pmetric.NumberDataPoint](ma, dps, dp)
+	agg := dps.At(i)
+	if added {
+		if ma.policy == PolicyAvg {
+			// the values are summed up, and divided by their count on export
+			agg.SetDoubleValue(numberValue(dp))
+		}
+		return
+	}
+	ma.counts[i]++
+	if dp.Timestamp() > agg.Timestamp() {
+		agg.SetTimestamp(dp.Timestamp())
+	}
+
+	switch ma.policy {
+	case PolicySum, PolicyAvg:
+		if agg.ValueType() == pmetric.NumberDataPointValueTypeInt && dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+			agg.SetIntValue(agg.IntValue() + dp.IntValue())
+		} else {
+			agg.SetDoubleValue(numberValue(agg) + numberValue(dp))
+		}
+	case PolicyMin:
+		if numberValue(dp) < numberValue(agg) {
+			setNumberValue(agg, dp)
+		}
+	case PolicyMax:
+		if numberValue(dp) > numberValue(agg) {
+			setNumberValue(agg, dp)
+		}
+	}
+}
+
+// aggregateHistogram aggregates the data point, and returns whether it could: the data points of
+// delta histograms are merged, which is only possible when they have the same bounds.
+func (ma *metricAggregates) aggregateHistogram(dps pmetric.HistogramDataPointSlice, dp pmetric.HistogramDataPoint, delta bool) bool {
+	if !delta {
+		aggregateLast[pmetric.HistogramDataPoint](ma, dps, dp, false)
+		return true
+	}
+
+	i, added := find[pmetric.HistogramDataPoint](ma, dps, dp)
+	if added {
+		return true
+	}
+	agg := dps.At(i)
+	if !sameBuckets(agg, dp) {
+		return false
+	}
+	ma.counts[i]++
+	if dp.Timestamp() > agg.Timestamp() {
+		agg.SetTimestamp(dp.Timestamp())
+	}
+
+	agg.SetCount(agg.Count() + dp.Count())
+	if agg.HasSum() && dp.HasSum() {
+		agg.SetSum(agg.Sum() + dp.Sum())
+	}
+	if dp.HasMin() && (!agg.HasMin() || dp.Min() < agg.Min()) {
+		agg.SetMin(dp.Min())
+	}
+	if dp.HasMax() && (!agg.HasMax() || dp.Max() > agg.Max()) {
+		agg.SetMax(dp.Max())
+	}
+	for j := 0; j < agg.BucketCounts().Len(); j++ {
+		agg.BucketCounts().SetAt(j, agg.BucketCounts().At(j)+dp.BucketCounts().At(j))
+	}
+	return true
+}
+
+func sameBuckets(a, b pmetric.HistogramDataPoint) bool {
+	if a.ExplicitBounds().Len() != b.ExplicitBounds().Len() || a.BucketCounts().Len() != b.BucketCounts().Len() {
+		return false
+	}
+	for i := 0; i < a.ExplicitBounds().Len(); i++ {
+		if a.ExplicitBounds().At(i) != b.ExplicitBounds().At(i) {
+			return false
+		}
+	}
+	return true
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
+
+func setNumberValue(dest, src pmetric.NumberDataPoint) {
+	if src.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		dest.SetIntValue(src.IntValue())
+	} else {
+		dest.SetDoubleValue(src.DoubleValue())
+	}
+}
+
+// empty returns whether no data point was aggregated since the last export.
+func (a *aggregator) empty() bool {
+	return len(a.order) == 0
+}
+
+// export returns the aggregated data points, and resets the aggregator for the next interval.
+func (a *aggregator) export() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, ra := range a.order {
+		rm := md.ResourceMetrics().AppendEmpty()
+		ra.resource.CopyTo(rm.Resource())
+		for _, sa := range ra.order {
+			sm := rm.ScopeMetrics().AppendEmpty()
+			sa.scope.CopyTo(sm.Scope())
+			for _, ma := range sa.order {
+				ma.finish()
+				ma.metric.MoveTo(sm.Metrics().AppendEmpty())
+			}
+		}
+	}
+
+	a.resources = map[[16]byte]*resourceAggregates{}
+	a.order = nil
+	return md
+}
+
+// finish divides the sums of the values of the averaged data points by their count.
+func (ma *metricAggregates) finish() {
+	if ma.policy != PolicyAvg {
+		return
+	}
+	var dps pmetric.NumberDataPointSlice
+	switch ma.metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps = ma.metric.Gauge().DataPoints()
+	case pmetric.MetricTypeSum:
+		dps = ma.metric.Sum().DataPoints()
+	default:
+		return
+	}
+	for i := 0; i < dps.Len(); i++ {
+		dps.At(i).SetDoubleValue(dps.At(i).DoubleValue() / float64(ma.counts[i]))
+	}
+}
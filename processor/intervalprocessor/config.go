@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const defaultInterval = 60 * time.Second
+
+// Policy is the aggregation of the data points of a series received within an interval.
+type Policy string
+
+const (
+	// PolicyLast keeps the latest data point.
+	PolicyLast Policy = "last"
+	// PolicyAvg averages the values of the data points.
+	PolicyAvg Policy = "avg"
+	// PolicySum sums the values of the data points.
+	PolicySum Policy = "sum"
+	// PolicyMin keeps the minimum value of the data points.
+	PolicyMin Policy = "min"
+	// PolicyMax keeps the maximum value of the data points.
+	PolicyMax Policy = "max"
+)
+
+var policies = []Policy{PolicyLast, PolicyAvg, PolicySum, PolicyMin, PolicyMax}
+
+func (p Policy) isValid() bool {
+	for _, policy := range policies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// Config defines the configuration for the interval processor.
+type Config struct {
+	// Interval is the length of the intervals, at the end of which a single data point is exported
+	// per series.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Gauge is the policy aggregating the data points of the gauges, `last` by default.
+	Gauge Policy `mapstructure:"gauge"`
+
+	// DeltaSum is the policy aggregating the data points of the delta sums, `sum` by default.
+	// The data points of the cumulative sums are always aggregated with the `last` policy.
+	DeltaSum Policy `mapstructure:"delta_sum"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if !cfg.Gauge.isValid() {
+		return fmt.Errorf("unsupported gauge policy %q, must be one of %q", cfg.Gauge, policies)
+	}
+	if !cfg.DeltaSum.isValid() {
+		return fmt.Errorf("unsupported delta_sum policy %q, must be one of %q", cfg.DeltaSum, policies)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// intervalProcessor aggregates the data points of each series received within an interval, which
+// are sent to the next consumer at the end of the interval. The data points which cannot be
+// aggregated are forwarded immediately.
+type intervalProcessor struct {
+	logger *zap.Logger
+	cfg    *Config
+	next   consumer.Metrics
+
+	mu         sync.Mutex
+	aggregator *aggregator
+
+	startOnce    sync.Once
+	shutdownOnce sync.Once
+	shutdownC    chan struct{}
+	done         chan struct{}
+}
+
+func newProcessor(logger *zap.Logger, cfg *Config, next consumer.Metrics) *intervalProcessor {
+	return &intervalProcessor{
+		logger:     logger,
+		cfg:        cfg,
+		next:       next,
+		aggregator: newAggregator(cfg.Gauge, cfg.DeltaSum),
+		shutdownC:  make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start starts the export loop.
+func (p *intervalProcessor) Start(context.Context, component.Host) error {
+	p.startOnce.Do(func() {
+		go p.exportLoop()
+	})
+	return nil
+}
+
+// Shutdown stops the export loop and exports the data points of the current interval.
+func (p *intervalProcessor) Shutdown(ctx context.Context) error {
+	started := true
+	p.startOnce.Do(func() { started = false })
+	p.shutdownOnce.Do(func() { close(p.shutdownC) })
+	if !started {
+		return nil
+	}
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Capabilities reports that the processor mutates the received data, as the aggregated data points
+// are removed from it.
+func (p *intervalProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+// ConsumeMetrics aggregates the data points, and forwards the ones which cannot be aggregated to
+// the next consumer.
+func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	p.mu.Lock()
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		resourceKey := pdatautil.MapHash(rm.Resource().Attributes())
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				return p.aggregator.aggregate(rm.Resource(), resourceKey, sm.Scope(), m)
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+	p.mu.Unlock()
+
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return p.next.ConsumeMetrics(ctx, md)
+}
+
+func (p *intervalProcessor) exportLoop() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.export()
+		case <-p.shutdownC:
+			p.export()
+			return
+		}
+	}
+}
+
+// export sends the data points aggregated within the interval to the next consumer.
+func (p *intervalProcessor) export() {
+	p.mu.Lock()
+	if p.aggregator.empty() {
+		p.mu.Unlock()
+		return
+	}
+	md := p.aggregator.export()
+	p.mu.Unlock()
+
+	if err := p.next.ConsumeMetrics(context.Background(), md); err != nil {
+		p.logger.Warn("Failed to send the aggregated metrics", zap.Error(err), zap.Int("data_points", md.DataPointCount()))
+	}
+}
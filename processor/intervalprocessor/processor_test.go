@@ -0,0 +1,224 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func newTestProcessor(cfg *Config) (*intervalProcessor, *consumertest.MetricsSink) {
+	sink := new(consumertest.MetricsSink)
+	if cfg == nil {
+		cfg = createDefaultConfig().(*Config)
+	}
+	return newProcessor(zap.NewNop(), cfg, sink), sink
+}
+
+// newTestMetrics returns metrics with a single metric of the resource "service.name: api".
+func newTestMetrics(init func(m pmetric.Metric)) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "api")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("scope")
+	init(sm.Metrics().AppendEmpty())
+	return md
+}
+
+func addNumberDataPoint(dps pmetric.NumberDataPointSlice, host string, ts int, value float64) {
+	dp := dps.AppendEmpty()
+	dp.Attributes().PutStr("host", host)
+	dp.SetStartTimestamp(pcommon.Timestamp(ts - 1))
+	dp.SetTimestamp(pcommon.Timestamp(ts))
+	dp.SetDoubleValue(value)
+}
+
+func gaugeValues(t *testing.T, md pmetric.Metrics) map[string]float64 {
+	require.Equal(t, 1, md.MetricCount())
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	values := map[string]float64{}
+	for i := 0; i < dps.Len(); i++ {
+		host, _ := dps.At(i).Attributes().Get("host")
+		values[host.Str()] = dps.At(i).DoubleValue()
+	}
+	return values
+}
+
+func TestGaugePolicies(t *testing.T) {
+	tests := []struct {
+		policy   Policy
+		expected map[string]float64
+	}{
+		{policy: PolicyLast, expected: map[string]float64{"a": 3, "b": 5}},
+		{policy: PolicyAvg, expected: map[string]float64{"a": 2.5, "b": 5}},
+		{policy: PolicySum, expected: map[string]float64{"a": 10, "b": 5}},
+		{policy: PolicyMin, expected: map[string]float64{"a": 1, "b": 5}},
+		{policy: PolicyMax, expected: map[string]float64{"a": 4, "b": 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Gauge = tt.policy
+			p, sink := newTestProcessor(cfg)
+
+			// the third data point is the most recent one
+			points := []struct {
+				ts    int
+				value float64
+			}{{10, 2}, {20, 4}, {40, 3}, {30, 1}}
+			for _, point := range points {
+				require.NoError(t, p.ConsumeMetrics(context.Background(), newTestMetrics(func(m pmetric.Metric) {
+					m.SetName("cpu")
+					addNumberDataPoint(m.SetEmptyGauge().DataPoints(), "a", point.ts, point.value)
+				})))
+			}
+			require.NoError(t, p.ConsumeMetrics(context.Background(), newTestMetrics(func(m pmetric.Metric) {
+				m.SetName("cpu")
+				addNumberDataPoint(m.SetEmptyGauge().DataPoints(), "b", 10, 5)
+			})))
+			assert.Empty(t, sink.AllMetrics())
+
+			p.export()
+			require.Len(t, sink.AllMetrics(), 1)
+			assert.Equal(t, tt.expected, gaugeValues(t, sink.AllMetrics()[0]))
+
+			// the aggregates are reset at the end of the interval
+			p.export()
+			assert.Len(t, sink.AllMetrics(), 1)
+		})
+	}
+}
+
+func TestSums(t *testing.T) {
+	p, sink := newTestProcessor(nil)
+
+	for _, ts := range []int{10, 20} {
+		require.NoError(t, p.ConsumeMetrics(context.Background(), newTestMetrics(func(m pmetric.Metric) {
+			m.SetName("requests")
+			sum := m.SetEmptySum()
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			sum.SetIsMonotonic(true)
+			dp := sum.DataPoints().AppendEmpty()
+			dp.SetStartTimestamp(pcommon.Timestamp(ts - 10))
+			dp.SetTimestamp(pcommon.Timestamp(ts))
+			dp.SetIntValue(int64(ts))
+		})))
+		require.NoError(t, p.ConsumeMetrics(context.Background(), newTestMetrics(func(m pmetric.Metric) {
+			m.SetName("requests.total")
+			sum := m.SetEmptySum()
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			dp := sum.DataPoints().AppendEmpty()
+			dp.SetStartTimestamp(1)
+			dp.SetTimestamp(pcommon.Timestamp(ts))
+			dp.SetIntValue(int64(ts))
+		})))
+	}
+	p.export()
+
+	require.Len(t, sink.AllMetrics(), 1)
+	metrics := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	delta := metrics.At(0)
+	assert.Equal(t, "requests", delta.Name())
+	assert.True(t, delta.Sum().IsMonotonic())
+	require.Equal(t, 1, delta.Sum().DataPoints().Len())
+	dp := delta.Sum().DataPoints().At(0)
+	assert.Equal(t, int64(30), dp.IntValue())
+	assert.Equal(t, pcommon.Timestamp(0), dp.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(20), dp.Timestamp())
+
+	cumulative := metrics.At(1)
+	assert.Equal(t, "requests.total", cumulative.Name())
+	require.Equal(t, 1, cumulative.Sum().DataPoints().Len())
+	dp = cumulative.Sum().DataPoints().At(0)
+	assert.Equal(t, int64(20), dp.IntValue())
+	assert.Equal(t, pcommon.Timestamp(1), dp.StartTimestamp())
+}
+
+func newDeltaHistogram(ts int, bounds []float64, counts []uint64) pmetric.Metrics {
+	return newTestMetrics(func(m pmetric.Metric) {
+		m.SetName("latency")
+		histogram := m.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp := histogram.DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(ts))
+		dp.ExplicitBounds().FromRaw(bounds)
+		dp.BucketCounts().FromRaw(counts)
+		var count uint64
+		for _, c := range counts {
+			count += c
+		}
+		dp.SetCount(count)
+		dp.SetSum(float64(ts))
+		dp.SetMin(float64(ts))
+		dp.SetMax(float64(ts))
+	})
+}
+
+func TestDeltaHistograms(t *testing.T) {
+	p, sink := newTestProcessor(nil)
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newDeltaHistogram(10, []float64{1, 10}, []uint64{1, 2, 3})))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newDeltaHistogram(20, []float64{1, 10}, []uint64{4, 5, 6})))
+	assert.Empty(t, sink.AllMetrics())
+
+	// the data points with other bounds cannot be merged, and are forwarded immediately
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newDeltaHistogram(30, []float64{5}, []uint64{1, 1})))
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, 1, sink.AllMetrics()[0].DataPointCount())
+
+	p.export()
+	require.Len(t, sink.AllMetrics(), 2)
+	dp := sink.AllMetrics()[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0)
+	assert.Equal(t, []uint64{5, 7, 9}, dp.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(21), dp.Count())
+	assert.Equal(t, float64(30), dp.Sum())
+	assert.Equal(t, float64(10), dp.Min())
+	assert.Equal(t, float64(20), dp.Max())
+	assert.Equal(t, pcommon.Timestamp(20), dp.Timestamp())
+}
+
+func TestPassThrough(t *testing.T) {
+	p, sink := newTestProcessor(nil)
+
+	md := newTestMetrics(func(m pmetric.Metric) {
+		m.SetName("latency")
+		histogram := m.SetEmptyExponentialHistogram()
+		histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		histogram.DataPoints().AppendEmpty().SetCount(1)
+	})
+	require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, 1, sink.AllMetrics()[0].DataPointCount())
+	assert.True(t, p.aggregator.empty())
+}
+
+func TestShutdownExports(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Interval = time.Hour
+	p, sink := newTestProcessor(cfg)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newTestMetrics(func(m pmetric.Metric) {
+		m.SetName("cpu")
+		addNumberDataPoint(m.SetEmptyGauge().DataPoints(), "a", 10, 1)
+	})))
+	assert.Empty(t, sink.AllMetrics())
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, map[string]float64{"a": 1}, gaugeValues(t, sink.AllMetrics()[0]))
+}
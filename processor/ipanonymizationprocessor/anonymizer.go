@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipanonymizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ipanonymizationprocessor"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"net/netip"
+)
+
+// anonymizer rewrites a single IP address.
+type anonymizer interface {
+	anonymize(addr netip.Addr) netip.Addr
+}
+
+// truncateAnonymizer keeps the network prefix of an address and zeroes out the remaining bits.
+type truncateAnonymizer struct {
+	ipv4Bits int
+	ipv6Bits int
+}
+
+func (a truncateAnonymizer) anonymize(addr netip.Addr) netip.Addr {
+	bits := a.ipv6Bits
+	if addr.Is4() {
+		bits = a.ipv4Bits
+	}
+	prefix, err := addr.WithZone("").Prefix(bits)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return prefix.Addr()
+}
+
+// pseudonymizeAnonymizer replaces an address with an address of the same family derived from a
+// keyed hash. The same input always produces the same output, so addresses can still be correlated.
+type pseudonymizeAnonymizer struct {
+	key []byte
+}
+
+func (a pseudonymizeAnonymizer) anonymize(addr netip.Addr) netip.Addr {
+	mac := hmac.New(sha256.New, a.key)
+	_, _ = mac.Write(addr.WithZone("").AsSlice())
+	sum := mac.Sum(nil)
+
+	if addr.Is4() {
+		var b [4]byte
+		copy(b[:], sum)
+		return netip.AddrFrom4(b)
+	}
+	var b [16]byte
+	copy(b[:], sum)
+	return netip.AddrFrom16(b)
+}
+
+// anonymizeString anonymizes the value if it is an IP address. The second return value
+// reports whether the value was an IP address.
+func anonymizeString(a anonymizer, value string) (string, bool) {
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return value, false
+	}
+	addr = addr.Unmap()
+	anonymized := a.anonymize(addr)
+	if !anonymized.IsValid() {
+		return value, false
+	}
+	return anonymized.String(), true
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipanonymizationprocessor
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateAnonymizer(t *testing.T) {
+	a := truncateAnonymizer{ipv4Bits: 24, ipv6Bits: 64}
+
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+		ok       bool
+	}{
+		{name: "ipv4", value: "192.168.1.77", expected: "192.168.1.0", ok: true},
+		{name: "ipv6", value: "2001:db8:1:2:3:4:5:6", expected: "2001:db8:1:2::", ok: true},
+		{name: "ipv4-mapped ipv6", value: "::ffff:10.1.2.3", expected: "10.1.2.0", ok: true},
+		{name: "ipv6 with zone", value: "fe80::1%eth0", expected: "fe80::", ok: true},
+		{name: "not an address", value: "localhost", expected: "localhost", ok: false},
+		{name: "address with port", value: "10.0.0.1:80", expected: "10.0.0.1:80", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, ok := anonymizeString(a, tt.value)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestPseudonymizeAnonymizer(t *testing.T) {
+	a := pseudonymizeAnonymizer{key: []byte("secret")}
+
+	v4, ok := anonymizeString(a, "192.168.1.77")
+	assert.True(t, ok)
+	assert.NotEqual(t, "192.168.1.77", v4)
+	assert.True(t, netip.MustParseAddr(v4).Is4())
+
+	// The same address always maps to the same pseudonym
+	again, _ := anonymizeString(a, "192.168.1.77")
+	assert.Equal(t, v4, again)
+
+	v6, ok := anonymizeString(a, "2001:db8::1")
+	assert.True(t, ok)
+	assert.True(t, netip.MustParseAddr(v6).Is6())
+
+	// A different key produces a different pseudonym
+	other, _ := anonymizeString(pseudonymizeAnonymizer{key: []byte("other")}, "192.168.1.77")
+	assert.NotEqual(t, v4, other)
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipanonymizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ipanonymizationprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+const (
+	// MethodTruncate zeroes out the host part of an address
+	MethodTruncate = "truncate"
+	// MethodPseudonymize replaces an address with a keyed hash of the same family
+	MethodPseudonymize = "pseudonymize"
+
+	defaultIPv4PrefixLength = 24
+	defaultIPv6PrefixLength = 64
+)
+
+// Config defines the configuration for the IP anonymization processor.
+type Config struct {
+	// Attributes is the list of attribute keys whose values are anonymized.
+	Attributes []string `mapstructure:"attributes"`
+
+	// AttributePatterns is a list of regular expressions. Values of attributes
+	// whose key matches any of the expressions are anonymized.
+	AttributePatterns []string `mapstructure:"attribute_patterns"`
+
+	// Method is the anonymization method, either `truncate` or `pseudonymize`.
+	Method string `mapstructure:"method"`
+
+	// IPv4PrefixLength is the number of leading bits kept by the `truncate` method for IPv4 addresses.
+	IPv4PrefixLength int `mapstructure:"ipv4_prefix_length"`
+
+	// IPv6PrefixLength is the number of leading bits kept by the `truncate` method for IPv6 addresses.
+	IPv6PrefixLength int `mapstructure:"ipv6_prefix_length"`
+
+	// Key is the secret used by the `pseudonymize` method.
+	Key configopaque.String `mapstructure:"key"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if len(cfg.Attributes) == 0 && len(cfg.AttributePatterns) == 0 {
+		return errors.New("at least one of attributes or attribute_patterns must be specified")
+	}
+
+	for _, pattern := range cfg.AttributePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid attribute pattern %q: %w", pattern, err)
+		}
+	}
+
+	switch cfg.Method {
+	case MethodTruncate:
+		if cfg.IPv4PrefixLength < 0 || cfg.IPv4PrefixLength > 32 {
+			return fmt.Errorf("ipv4_prefix_length must be between 0 and 32, got %d", cfg.IPv4PrefixLength)
+		}
+		if cfg.IPv6PrefixLength < 0 || cfg.IPv6PrefixLength > 128 {
+			return fmt.Errorf("ipv6_prefix_length must be between 0 and 128, got %d", cfg.IPv6PrefixLength)
+		}
+	case MethodPseudonymize:
+		if cfg.Key == "" {
+			return errors.New("key must be specified when method is pseudonymize")
+		}
+	default:
+		return fmt.Errorf("unsupported method %q, must be one of %q or %q", cfg.Method, MethodTruncate, MethodPseudonymize)
+	}
+
+	return nil
+}
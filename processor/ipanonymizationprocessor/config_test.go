@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipanonymizationprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/ipanonymizationprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Attributes:        []string{"client.address", "net.sock.peer.addr"},
+				AttributePatterns: []string{`.*\.ip$`},
+				Method:            MethodTruncate,
+				IPv4PrefixLength:  16,
+				IPv6PrefixLength:  48,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "pseudonymize"),
+			expected: &Config{
+				Attributes:       []string{"client.address"},
+				Method:           MethodPseudonymize,
+				IPv4PrefixLength: defaultIPv4PrefixLength,
+				IPv6PrefixLength: defaultIPv6PrefixLength,
+				Key:              "my-secret-key",
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_attributes"),
+			errorMessage: "at least one of attributes or attribute_patterns must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_method"),
+			errorMessage: `unsupported method "scramble", must be one of "truncate" or "pseudonymize"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_prefix"),
+			errorMessage: "ipv4_prefix_length must be between 0 and 32, got 33",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_key"),
+			errorMessage: "key must be specified when method is pseudonymize",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_pattern"),
+			errorMessage: `invalid attribute pattern "("`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
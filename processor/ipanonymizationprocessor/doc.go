@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package ipanonymizationprocessor implements a processor which anonymizes
+// IP addresses found in the attributes of traces, metrics and logs.
+package ipanonymizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ipanonymizationprocessor"
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipanonymizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ipanonymizationprocessor"
+
+import (
+	"context"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type ipAnonymizationProcessor struct {
+	keys       map[string]struct{}
+	patterns   []*regexp.Regexp
+	anonymizer anonymizer
+}
+
+func newIPAnonymizationProcessor(cfg *Config) *ipAnonymizationProcessor {
+	keys := make(map[string]struct{}, len(cfg.Attributes))
+	for _, key := range cfg.Attributes {
+		keys[key] = struct{}{}
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(cfg.AttributePatterns))
+	for _, pattern := range cfg.AttributePatterns {
+		// Patterns have already been validated
+		patterns = append(patterns, regexp.MustCompile(pattern))
+	}
+
+	var a anonymizer
+	switch cfg.Method {
+	case MethodPseudonymize:
+		a = pseudonymizeAnonymizer{key: []byte(cfg.Key)}
+	default:
+		a = truncateAnonymizer{ipv4Bits: cfg.IPv4PrefixLength, ipv6Bits: cfg.IPv6PrefixLength}
+	}
+
+	return &ipAnonymizationProcessor{
+		keys:       keys,
+		patterns:   patterns,
+		anonymizer: a,
+	}
+}
+
+func (p *ipAnonymizationProcessor) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		p.processAttributes(rs.Resource().Attributes())
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				p.processAttributes(span.Attributes())
+				events := span.Events()
+				for e := 0; e < events.Len(); e++ {
+					p.processAttributes(events.At(e).Attributes())
+				}
+				links := span.Links()
+				for l := 0; l < links.Len(); l++ {
+					p.processAttributes(links.At(l).Attributes())
+				}
+			}
+		}
+	}
+	return td, nil
+}
+
+func (p *ipAnonymizationProcessor) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		p.processAttributes(rl.Resource().Attributes())
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				p.processAttributes(lrs.At(k).Attributes())
+			}
+		}
+	}
+	return ld, nil
+}
+
+func (p *ipAnonymizationProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		p.processAttributes(rm.Resource().Attributes())
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				p.processMetric(metrics.At(k))
+			}
+		}
+	}
+	return md, nil
+}
+
+func (p *ipAnonymizationProcessor) processMetric(m pmetric.Metric) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := m.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.processAttributes(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := m.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.processAttributes(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := m.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.processAttributes(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := m.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.processAttributes(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := m.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.processAttributes(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+// processAttributes anonymizes the string and string slice values of matching attributes
+func (p *ipAnonymizationProcessor) processAttributes(attrs pcommon.Map) {
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		if !p.matches(k) {
+			return true
+		}
+		if v.Type() == pcommon.ValueTypeStr {
+			if anonymized, ok := anonymizeString(p.anonymizer, v.Str()); ok {
+				v.SetStr(anonymized)
+			}
+			return true
+		}
+		if v.Type() == pcommon.ValueTypeSlice {
+			s := v.Slice()
+			for i := 0; i < s.Len(); i++ {
+				elem := s.At(i)
+				if elem.Type() != pcommon.ValueTypeStr {
+					continue
+				}
+				if anonymized, ok := anonymizeString(p.anonymizer, elem.Str()); ok {
+					elem.SetStr(anonymized)
+				}
+			}
+		}
+		return true
+	})
+}
+
+func (p *ipAnonymizationProcessor) matches(key string) bool {
+	if _, ok := p.keys[key]; ok {
+		return true
+	}
+	for _, re := range p.patterns {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipanonymizationprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Attributes = []string{"client.address"}
+	cfg.AttributePatterns = []string{`\.ip$`}
+	return cfg
+}
+
+func TestProcessTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("host.ip", "10.1.2.3")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.Attributes().PutStr("client.address", "192.168.1.77")
+	span.Attributes().PutStr("server.address", "192.168.1.78")
+	span.Events().AppendEmpty().Attributes().PutStr("client.address", "2001:db8:1:2:3:4:5:6")
+	span.Links().AppendEmpty().Attributes().PutStr("peer.ip", "172.16.5.4")
+
+	p := newIPAnonymizationProcessor(newTestConfig())
+	td, err := p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+
+	rs = td.ResourceSpans().At(0)
+	assert.Equal(t, "10.1.2.0", rs.Resource().Attributes().AsRaw()["host.ip"])
+	span = rs.ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "192.168.1.0", span.Attributes().AsRaw()["client.address"])
+	assert.Equal(t, "192.168.1.78", span.Attributes().AsRaw()["server.address"])
+	assert.Equal(t, "2001:db8:1:2::", span.Events().At(0).Attributes().AsRaw()["client.address"])
+	assert.Equal(t, "172.16.5.0", span.Links().At(0).Attributes().AsRaw()["peer.ip"])
+}
+
+func TestProcessLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Attributes().PutStr("client.address", "192.168.1.77")
+	forwarded := lr.Attributes().PutEmptySlice("forwarded.ip")
+	forwarded.AppendEmpty().SetStr("10.0.0.1")
+	forwarded.AppendEmpty().SetStr("unknown")
+	forwarded.AppendEmpty().SetInt(1)
+
+	p := newIPAnonymizationProcessor(newTestConfig())
+	ld, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	attrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()
+	assert.Equal(t, "192.168.1.0", attrs["client.address"])
+	assert.Equal(t, []any{"10.0.0.0", "unknown", int64(1)}, attrs["forwarded.ip"])
+}
+
+func TestProcessMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("client.address", "192.168.1.77")
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("client.address", "192.168.1.77")
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr("client.address", "192.168.1.77")
+	ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty().Attributes().PutStr("client.address", "192.168.1.77")
+	ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty().Attributes().PutStr("client.address", "192.168.1.77")
+
+	p := newIPAnonymizationProcessor(newTestConfig())
+	md, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+
+	ms = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, "192.168.1.0", ms.At(0).Gauge().DataPoints().At(0).Attributes().AsRaw()["client.address"])
+	assert.Equal(t, "192.168.1.0", ms.At(1).Sum().DataPoints().At(0).Attributes().AsRaw()["client.address"])
+	assert.Equal(t, "192.168.1.0", ms.At(2).Histogram().DataPoints().At(0).Attributes().AsRaw()["client.address"])
+	assert.Equal(t, "192.168.1.0", ms.At(3).ExponentialHistogram().DataPoints().At(0).Attributes().AsRaw()["client.address"])
+	assert.Equal(t, "192.168.1.0", ms.At(4).Summary().DataPoints().At(0).Attributes().AsRaw()["client.address"])
+}
+
+func TestProcessPseudonymize(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Method = MethodPseudonymize
+	cfg.Key = "secret"
+
+	newLogs := func() plog.Logs {
+		ld := plog.NewLogs()
+		lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.Attributes().PutStr("client.address", "192.168.1.77")
+		return ld
+	}
+
+	p := newIPAnonymizationProcessor(cfg)
+	first, err := p.processLogs(context.Background(), newLogs())
+	require.NoError(t, err)
+	second, err := p.processLogs(context.Background(), newLogs())
+	require.NoError(t, err)
+
+	v1 := first.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()["client.address"]
+	v2 := second.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()["client.address"]
+	assert.NotEqual(t, "192.168.1.77", v1)
+	assert.Equal(t, v1, v2)
+}
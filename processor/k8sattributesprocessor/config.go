@@ -4,7 +4,11 @@
 package k8sattributesprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor"
 
 import (
+	"errors"
 	"fmt"
+	"time"
+
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
@@ -35,6 +39,15 @@ type Config struct {
 	// Exclude section allows to define names of pod that should be
 	// ignored while tagging.
 	Exclude ExcludeConfig `mapstructure:"exclude"`
+
+	// OwnerLookup section allows to resolve the chain of owners of pods,
+	// e.g. Pod -> ReplicaSet -> Deployment -> Application, without
+	// watching all the ReplicaSets of the cluster.
+	OwnerLookup OwnerLookupConfig `mapstructure:"owner_lookup"`
+
+	// Informer section configures how the kubernetes objects are watched
+	// and the memory the pods cached from them may use.
+	Informer InformerConfig `mapstructure:"informer"`
 }
 
 func (cfg *Config) Validate() error {
@@ -48,6 +61,26 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if err := cfg.OwnerLookup.Validate(); err != nil {
+		return err
+	}
+
+	if !cfg.OwnerLookup.Enabled {
+		for _, field := range cfg.Extract.Metadata {
+			switch field {
+			case metadataWorkloadKind, metadataWorkloadName, metadataWorkloadUID, conventions.AttributeK8SCronJobUID:
+				return fmt.Errorf("extracting %q requires owner_lookup to be enabled", field)
+			}
+		}
+		for _, fields := range [][]FieldExtractConfig{cfg.Extract.Labels, cfg.Extract.Annotations} {
+			for _, field := range fields {
+				if field.From == kube.MetadataFromWorkload {
+					return errors.New("extracting labels or annotations from the workload requires owner_lookup to be enabled")
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -62,10 +95,11 @@ type ExtractConfig struct {
 	//   k8s.node.name, k8s.namespace.name, k8s.pod.start_time,
 	//   k8s.replicaset.name, k8s.replicaset.uid,
 	//   k8s.daemonset.name, k8s.daemonset.uid,
-	//   k8s.job.name, k8s.job.uid, k8s.cronjob.name,
+	//   k8s.job.name, k8s.job.uid, k8s.cronjob.name, k8s.cronjob.uid,
 	//   k8s.statefulset.name, k8s.statefulset.uid,
 	//   k8s.container.name, container.image.name,
-	//   container.image.tag, container.id
+	//   container.image.tag, container.id,
+	//   k8s.workload.kind, k8s.workload.name, k8s.workload.uid
 	//
 	// Specifying anything other than these values will result in an error.
 	// By default, the following fields are extracted and added to spans, metrics and logs as attributes:
@@ -100,6 +134,7 @@ type FieldExtractConfig struct {
 	// When not specified, a default tag name will be used of the format:
 	//   - k8s.pod.annotations.<annotation key>
 	//   - k8s.pod.labels.<label key>
+	// or, depending on From, k8s.namespace.<annotations|labels>.<key> or k8s.workload.<annotations|labels>.<key>.
 	// For example, if tag_name is not specified and the key is git_sha,
 	// then the attribute name will be `k8s.pod.annotations.git_sha`.
 	// When key_regex is present, tag_name supports back reference to both named capturing and positioned capturing.
@@ -147,7 +182,8 @@ type FieldExtractConfig struct {
 	Regex string `mapstructure:"regex"`
 
 	// From represents the source of the labels/annotations.
-	// Allowed values are "pod" and "namespace". The default is pod.
+	// Allowed values are "pod", "namespace" and "workload". The default is pod.
+	// The workload is the last owner resolved with owner_lookup, which must be enabled.
 	From string `mapstructure:"from"`
 }
 
@@ -226,6 +262,55 @@ type PodAssociationConfig struct {
 	Sources []PodAssociationSourceConfig `mapstructure:"sources"`
 }
 
+// InformerConfig configures how the kubernetes objects are watched.
+type InformerConfig struct {
+	// MetadataOnly watches namespaces and ReplicaSets with metadata-only requests to the
+	// API server, so that their specs and statuses are neither transferred nor decoded.
+	// Default: false
+	MetadataOnly bool `mapstructure:"metadata_only"`
+
+	// MemoryLimitMiB is the estimated size of the table of pods above which the pods pending
+	// deletion are evicted and new pods are not added. 0 disables the limit. Default: 0
+	MemoryLimitMiB uint32 `mapstructure:"memory_limit_mib"`
+}
+
+// OwnerLookupConfig configures the resolution of the chain of owners of pods.
+type OwnerLookupConfig struct {
+	// Enabled resolves the owners of pods with metadata-only requests to the API server,
+	// cached by owner, instead of watching all the ReplicaSets of the cluster. It is required
+	// to extract k8s.workload.kind, k8s.workload.name, k8s.workload.uid, k8s.cronjob.uid
+	// and the labels and annotations of the workload. When enabled, k8s.cronjob.name is
+	// resolved from the owner of the Job instead of being parsed from the Job name.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxDepth is the maximum number of owners resolved above a pod. The last
+	// resolved owner is the workload of the pod. Default: 3
+	MaxDepth int `mapstructure:"max_depth"`
+
+	// CacheTTL is the time an owner is cached before its metadata is fetched again.
+	// Default: 10m
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+
+	// MaxCacheEntries is the maximum number of owners kept in the cache. Default: 10000
+	MaxCacheEntries int `mapstructure:"max_cache_entries"`
+}
+
+func (cfg *OwnerLookupConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.MaxDepth <= 0 {
+		return errors.New("owner_lookup.max_depth must be positive")
+	}
+	if cfg.CacheTTL <= 0 {
+		return errors.New("owner_lookup.cache_ttl must be positive")
+	}
+	if cfg.MaxCacheEntries <= 0 {
+		return errors.New("owner_lookup.max_cache_entries must be positive")
+	}
+	return nil
+}
+
 // ExcludeConfig represent a list of Pods to exclude
 type ExcludeConfig struct {
 	Pods []ExcludePodConfig `mapstructure:"pods"`
@@ -6,6 +6,7 @@ package k8sattributesprocessor
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,11 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
 				Exclude:   ExcludeConfig{Pods: []ExcludePodConfig{{Name: "jaeger-agent"}, {Name: "jaeger-collector"}}},
+				OwnerLookup: OwnerLookupConfig{
+					MaxDepth:        3,
+					CacheTTL:        10 * time.Minute,
+					MaxCacheEntries: 10000,
+				},
 			},
 		},
 		{
@@ -100,6 +106,11 @@ func TestLoadConfig(t *testing.T) {
 						{Name: "jaeger-collector"},
 					},
 				},
+				OwnerLookup: OwnerLookupConfig{
+					MaxDepth:        3,
+					CacheTTL:        10 * time.Minute,
+					MaxCacheEntries: 10000,
+				},
 			},
 		},
 		{
@@ -121,6 +132,42 @@ func TestLoadConfig(t *testing.T) {
 						{Name: "jaeger-collector"},
 					},
 				},
+				OwnerLookup: OwnerLookupConfig{
+					MaxDepth:        3,
+					CacheTTL:        10 * time.Minute,
+					MaxCacheEntries: 10000,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "5"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig},
+				Extract: ExtractConfig{
+					Metadata: []string{"k8s.pod.name", "k8s.deployment.name", "k8s.workload.kind", "k8s.workload.name", "k8s.workload.uid", "k8s.cronjob.name", "k8s.cronjob.uid"},
+					Labels: []FieldExtractConfig{
+						{Key: "app.kubernetes.io/part-of", From: kube.MetadataFromWorkload},
+					},
+					Annotations: []FieldExtractConfig{
+						{KeyRegex: `team\.example\.com/.*`, From: kube.MetadataFromWorkload},
+					},
+				},
+				Exclude: ExcludeConfig{
+					Pods: []ExcludePodConfig{
+						{Name: "jaeger-agent"},
+						{Name: "jaeger-collector"},
+					},
+				},
+				OwnerLookup: OwnerLookupConfig{
+					Enabled:         true,
+					MaxDepth:        4,
+					CacheTTL:        30 * time.Minute,
+					MaxCacheEntries: 50000,
+				},
+				Informer: InformerConfig{
+					MetadataOnly:   true,
+					MemoryLimitMiB: 256,
+				},
 			},
 		},
 	}
@@ -142,3 +189,63 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateOwnerLookup(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *Config
+		expectedErr string
+	}{
+		{
+			name: "disabled",
+			cfg:  &Config{},
+		},
+		{
+			name:        "max_depth",
+			cfg:         &Config{OwnerLookup: OwnerLookupConfig{Enabled: true, CacheTTL: time.Minute, MaxCacheEntries: 1}},
+			expectedErr: "owner_lookup.max_depth must be positive",
+		},
+		{
+			name:        "cache_ttl",
+			cfg:         &Config{OwnerLookup: OwnerLookupConfig{Enabled: true, MaxDepth: 1, MaxCacheEntries: 1}},
+			expectedErr: "owner_lookup.cache_ttl must be positive",
+		},
+		{
+			name:        "max_cache_entries",
+			cfg:         &Config{OwnerLookup: OwnerLookupConfig{Enabled: true, MaxDepth: 1, CacheTTL: time.Minute}},
+			expectedErr: "owner_lookup.max_cache_entries must be positive",
+		},
+		{
+			name: "workload_without_owner_lookup",
+			cfg: &Config{
+				Extract: ExtractConfig{Metadata: []string{"k8s.pod.name", "k8s.workload.name"}},
+			},
+			expectedErr: `extracting "k8s.workload.name" requires owner_lookup to be enabled`,
+		},
+		{
+			name: "cronjob_uid_without_owner_lookup",
+			cfg: &Config{
+				Extract: ExtractConfig{Metadata: []string{"k8s.cronjob.name", "k8s.cronjob.uid"}},
+			},
+			expectedErr: `extracting "k8s.cronjob.uid" requires owner_lookup to be enabled`,
+		},
+		{
+			name: "workload_labels_without_owner_lookup",
+			cfg: &Config{
+				Extract: ExtractConfig{Annotations: []FieldExtractConfig{{Key: "team", From: "workload"}}},
+			},
+			expectedErr: "extracting labels or annotations from the workload requires owner_lookup to be enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.APIConfig = k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount}
+			err := tt.cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
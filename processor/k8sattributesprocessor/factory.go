@@ -6,6 +6,7 @@ package k8sattributesprocessor // import "github.com/open-telemetry/opentelemetr
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -21,6 +22,14 @@ var kubeClientProvider = kube.ClientProvider(nil)
 var consumerCapabilities = consumer.Capabilities{MutatesData: true}
 var defaultExcludes = ExcludeConfig{Pods: []ExcludePodConfig{{Name: "jaeger-agent"}, {Name: "jaeger-collector"}}}
 
+const (
+	// defaultOwnerLookupMaxDepth resolves owners up to the parent of a Deployment,
+	// e.g. Pod -> ReplicaSet -> Deployment -> Application.
+	defaultOwnerLookupMaxDepth        = 3
+	defaultOwnerLookupCacheTTL        = 10 * time.Minute
+	defaultOwnerLookupMaxCacheEntries = 10000
+)
+
 // NewFactory returns a new factory for the k8s processor.
 func NewFactory() processor.Factory {
 	return processor.NewFactory(
@@ -36,6 +45,11 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
 		Exclude:   defaultExcludes,
+		OwnerLookup: OwnerLookupConfig{
+			MaxDepth:        defaultOwnerLookupMaxDepth,
+			CacheTTL:        defaultOwnerLookupCacheTTL,
+			MaxCacheEntries: defaultOwnerLookupMaxCacheEntries,
+		},
 	}
 }
 
@@ -189,6 +203,10 @@ func createProcessorOpts(cfg component.Config) []option {
 
 	opts = append(opts, withExcludes(oCfg.Exclude))
 
+	opts = append(opts, withOwnerLookup(oCfg.OwnerLookup))
+
+	opts = append(opts, withInformers(oCfg.Informer))
+
 	return opts
 }
 
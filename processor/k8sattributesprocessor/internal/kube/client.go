@@ -4,6 +4,7 @@
 package kube // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -48,6 +50,10 @@ type WatchClient struct {
 	deleteQueue        []deleteRequest
 	stopCh             chan struct{}
 
+	// The estimated memory used by the pod table, guarded by m.
+	podTableBytes   int64
+	memoryLimitOnce sync.Once
+
 	// A map containing Pod related data, used to associate them with resources.
 	// Key can be either an IP address or Pod UID
 	Pods         map[PodIdentifier]*Pod
@@ -55,6 +61,8 @@ type WatchClient struct {
 	Filters      Filters
 	Associations []Association
 	Exclude      Excludes
+	OwnerLookup  OwnerLookup
+	Informers    Informers
 
 	// A map containing Namespace related data, used to associate them with resources.
 	// Key is namespace name
@@ -63,8 +71,22 @@ type WatchClient struct {
 	// A map containing ReplicaSets related data, used to associate them with resources.
 	// Key is replicaset uid
 	ReplicaSets map[string]*ReplicaSet
+
+	// Used to resolve the owners of pods when OwnerLookup is enabled.
+	ownerGetter OwnerMetadataGetter
+	ownerCache  *ownerCache
 }
 
+// The estimated sizes of the structures of the pod table, besides the strings they hold.
+const (
+	// podEntryOverhead accounts for a map entry and its PodIdentifier key.
+	podEntryOverhead = 256
+	podOverhead      = 256
+	// mapEntryOverhead accounts for a map entry of two strings.
+	mapEntryOverhead  = 48
+	containerOverhead = 128
+)
+
 // Extract replicaset name from the pod name. Pod name is created using
 // format: [deployment-name]-[Random-String-For-ReplicaSet]
 var rRegex = regexp.MustCompile(`^(.*)-[0-9a-zA-Z]+$`)
@@ -74,13 +96,15 @@ var rRegex = regexp.MustCompile(`^(.*)-[0-9a-zA-Z]+$`)
 var cronJobRegex = regexp.MustCompile(`^(.*)-[0-9]+$`)
 
 // New initializes a new k8s Client.
-func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes, newClientSet APIClientsetProvider, newInformer InformerProvider, newNamespaceInformer InformerProviderNamespace, newReplicaSetInformer InformerProviderReplicaSet) (Client, error) {
+func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes, ownerLookup OwnerLookup, informers Informers, newClientSet APIClientsetProvider, newInformer InformerProvider, newNamespaceInformer InformerProviderNamespace, newReplicaSetInformer InformerProviderReplicaSet, newOwnerGetter OwnerMetadataGetterProvider) (Client, error) {
 	c := &WatchClient{
 		logger:          logger,
 		Rules:           rules,
 		Filters:         filters,
 		Associations:    associations,
 		Exclude:         exclude,
+		OwnerLookup:     ownerLookup,
+		Informers:       informers,
 		replicasetRegex: rRegex,
 		cronJobRegex:    cronJobRegex,
 		stopCh:          make(chan struct{}),
@@ -113,6 +137,26 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 		newInformer = newSharedInformer
 	}
 
+	if informers.MetadataOnly &&
+		((c.extractNamespaceLabelsAnnotations() && newNamespaceInformer == nil) ||
+			(c.watchReplicaSets() && newReplicaSetInformer == nil)) {
+		var metadataClient metadata.Interface
+		metadataClient, err = newMetadataClient(apiCfg)
+		if err != nil {
+			return nil, err
+		}
+		if newNamespaceInformer == nil {
+			newNamespaceInformer = func(kubernetes.Interface) cache.SharedInformer {
+				return newMetadataSharedInformer(metadataClient, namespacesResource, "")
+			}
+		}
+		if newReplicaSetInformer == nil {
+			newReplicaSetInformer = func(_ kubernetes.Interface, namespace string) cache.SharedInformer {
+				return newMetadataSharedInformer(metadataClient, replicaSetsResource, namespace)
+			}
+		}
+	}
+
 	if newNamespaceInformer == nil {
 		newNamespaceInformer = newNamespaceSharedInformer
 	}
@@ -134,28 +178,34 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 
 	if c.extractNamespaceLabelsAnnotations() {
 		c.namespaceInformer = newNamespaceInformer(c.kc)
+		err = c.namespaceInformer.SetTransform(transformNamespace)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		c.namespaceInformer = NewNoOpInformer(c.kc)
 	}
 
-	if rules.DeploymentName || rules.DeploymentUID {
+	if c.watchReplicaSets() {
 		if newReplicaSetInformer == nil {
 			newReplicaSetInformer = newReplicaSetSharedInformer
 		}
 		c.replicasetInformer = newReplicaSetInformer(c.kc, c.Filters.Namespace)
-		err = c.replicasetInformer.SetTransform(
-			func(object interface{}) (interface{}, error) {
-				originalReplicaset, success := object.(*apps_v1.ReplicaSet)
-				if !success { // means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
-					return object, nil
-				}
+		err = c.replicasetInformer.SetTransform(transformReplicaSet)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-				return removeUnnecessaryReplicaSetData(originalReplicaset), nil
-			},
-		)
+	if ownerLookup.Enabled {
+		if newOwnerGetter == nil {
+			newOwnerGetter = newMetadataOwnerGetter
+		}
+		c.ownerGetter, err = newOwnerGetter(apiCfg, c.kc)
 		if err != nil {
 			return nil, err
 		}
+		c.ownerCache = newOwnerCache(ownerLookup.CacheTTL, ownerLookup.MaxCacheEntries)
 	}
 
 	return c, err
@@ -183,7 +233,7 @@ func (c *WatchClient) Start() {
 	}
 	go c.namespaceInformer.Run(c.stopCh)
 
-	if c.Rules.DeploymentName || c.Rules.DeploymentUID {
+	if c.watchReplicaSets() {
 		_, err = c.replicasetInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.handleReplicaSetAdd,
 			UpdateFunc: c.handleReplicaSetUpdate,
@@ -295,12 +345,13 @@ func (c *WatchClient) deleteLoop(interval time.Duration, gracePeriod time.Durati
 					// Sanity check: make sure we are deleting the same pod
 					// and the underlying state (ip<>pod mapping) has not changed.
 					if p.Name == d.podName {
-						delete(c.Pods, d.id)
+						c.deletePod(d.id)
 					}
 				}
 			}
 			podTableSize := len(c.Pods)
 			observability.RecordPodTableSize(int64(podTableSize))
+			observability.RecordPodTableBytes(c.podTableBytes)
 			c.m.Unlock()
 
 		case <-c.stopCh:
@@ -413,7 +464,8 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 					tags[conventions.AttributeK8SStatefulSetName] = ref.Name
 				}
 			case "Job":
-				if c.Rules.CronJobName {
+				// With the owner lookup, the CronJob is resolved from the owner of the Job.
+				if c.Rules.CronJobName && !c.OwnerLookup.Enabled {
 					parts := c.cronJobRegex.FindStringSubmatch(ref.Name)
 					if len(parts) == 2 {
 						tags[conventions.AttributeK8SCronJobName] = parts[1]
@@ -429,6 +481,46 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 		}
 	}
 
+	if c.OwnerLookup.Enabled && c.Rules.IncludesOwnerChainMetadata() {
+		owners := c.resolveOwners(pod)
+		for _, owner := range owners {
+			switch owner.Kind {
+			case "Deployment":
+				if c.Rules.DeploymentName {
+					tags[conventions.AttributeK8SDeploymentName] = owner.Name
+				}
+				if c.Rules.DeploymentUID {
+					tags[conventions.AttributeK8SDeploymentUID] = owner.UID
+				}
+			case "CronJob":
+				if c.Rules.CronJobName {
+					tags[conventions.AttributeK8SCronJobName] = owner.Name
+				}
+				if c.Rules.CronJobUID {
+					tags[conventions.AttributeK8SCronJobUID] = owner.UID
+				}
+			}
+		}
+		if len(owners) > 0 {
+			workload := owners[len(owners)-1]
+			if c.Rules.WorkloadKind {
+				tags[tagWorkloadKind] = workload.Kind
+			}
+			if c.Rules.WorkloadName {
+				tags[tagWorkloadName] = workload.Name
+			}
+			if c.Rules.WorkloadUID {
+				tags[tagWorkloadUID] = workload.UID
+			}
+			for _, r := range c.Rules.Labels {
+				r.extractFromWorkloadMetadata(workload.Labels, tags, "k8s.workload.labels.%s")
+			}
+			for _, r := range c.Rules.Annotations {
+				r.extractFromWorkloadMetadata(workload.Annotations, tags, "k8s.workload.annotations.%s")
+			}
+		}
+	}
+
 	if c.Rules.Node {
 		tags[tagNodeName] = pod.Spec.NodeName
 	}
@@ -443,6 +535,28 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 	return tags
 }
 
+// transformNamespace turns the namespaces watched with metadata-only requests into
+// namespaces, so that they are handled the same way.
+func transformNamespace(object interface{}) (interface{}, error) {
+	if partial, ok := object.(*meta_v1.PartialObjectMetadata); ok {
+		return &api_v1.Namespace{ObjectMeta: partial.ObjectMeta}, nil
+	}
+	return object, nil
+}
+
+// transformReplicaSet removes the data of ReplicaSets that is not needed, turning the
+// ReplicaSets watched with metadata-only requests into ReplicaSets.
+func transformReplicaSet(object interface{}) (interface{}, error) {
+	switch replicaset := object.(type) {
+	case *apps_v1.ReplicaSet:
+		return removeUnnecessaryReplicaSetData(replicaset), nil
+	case *meta_v1.PartialObjectMetadata:
+		return removeUnnecessaryReplicaSetData(&apps_v1.ReplicaSet{ObjectMeta: replicaset.ObjectMeta}), nil
+	}
+	// means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
+	return object, nil
+}
+
 // This function removes all data from the Pod except what is required by extraction rules and pod association
 func removeUnnecessaryPodData(pod *api_v1.Pod, rules ExtractionRules) *api_v1.Pod {
 
@@ -620,6 +734,7 @@ func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 			newPod.Containers = c.extractPodContainersAttributes(pod)
 		}
 	}
+	newPod.size = estimatePodSize(newPod)
 
 	return newPod
 }
@@ -702,11 +817,21 @@ func (c *WatchClient) getIdentifiersFromAssoc(pod *Pod) []PodIdentifier {
 
 func (c *WatchClient) addOrUpdatePod(pod *api_v1.Pod) {
 	newPod := c.podFromAPI(pod)
+	ids := c.getIdentifiersFromAssoc(newPod)
 
 	c.m.Lock()
 	defer c.m.Unlock()
 
-	for _, id := range c.getIdentifiersFromAssoc(newPod) {
+	if !c.reservePodTableBytes(newPod, ids) {
+		observability.RecordPodRejected()
+		c.memoryLimitOnce.Do(func() {
+			c.logger.Warn("the pod table reached its memory limit, new pods are not added",
+				zap.Int64("memory_limit", c.Informers.MemoryLimit))
+		})
+		return
+	}
+
+	for _, id := range ids {
 		// compare initial scheduled timestamp for existing pod and new pod with same identifier
 		// and only replace old pod if scheduled time of new pod is newer or equal.
 		// This should fix the case where scheduler has assigned the same attributes (like IP address)
@@ -716,8 +841,103 @@ func (c *WatchClient) addOrUpdatePod(pod *api_v1.Pod) {
 				continue
 			}
 		}
-		c.Pods[id] = newPod
+		c.setPod(id, newPod)
+	}
+	observability.RecordPodTableBytes(c.podTableBytes)
+}
+
+// reservePodTableBytes checks whether the pod fits in the memory limit of the pod table,
+// evicting the pods pending deletion, oldest first, to make room for it. The updates of
+// the pods already in the table are always accepted. c.m must be held.
+func (c *WatchClient) reservePodTableBytes(pod *Pod, ids []PodIdentifier) bool {
+	if c.Informers.MemoryLimit <= 0 {
+		return true
+	}
+	var size int64
+	seen := make(map[PodIdentifier]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := c.Pods[id]; ok {
+			return true
+		}
+		if !seen[id] {
+			seen[id] = true
+			size += podEntrySize(id, pod)
+		}
+	}
+	if c.podTableBytes+size <= c.Informers.MemoryLimit {
+		return true
+	}
+
+	c.deleteMut.Lock()
+	var evicted, cutoff int
+	for i, d := range c.deleteQueue {
+		if c.podTableBytes+size <= c.Informers.MemoryLimit {
+			break
+		}
+		if p, ok := c.Pods[d.id]; ok && p.Name == d.podName {
+			c.deletePod(d.id)
+			evicted++
+		}
+		cutoff = i + 1
+	}
+	c.deleteQueue = c.deleteQueue[cutoff:]
+	c.deleteMut.Unlock()
+
+	if evicted > 0 {
+		observability.RecordPodsEvicted(int64(evicted))
+		observability.RecordPodTableSize(int64(len(c.Pods)))
+	}
+	return c.podTableBytes+size <= c.Informers.MemoryLimit
+}
+
+// setPod adds the pod to the pod table, keeping track of its estimated size. c.m must be held.
+func (c *WatchClient) setPod(id PodIdentifier, pod *Pod) {
+	if p, ok := c.Pods[id]; ok {
+		c.podTableBytes -= podEntrySize(id, p)
+	}
+	c.Pods[id] = pod
+	c.podTableBytes += podEntrySize(id, pod)
+}
+
+// deletePod removes the pod from the pod table, keeping track of its estimated size. c.m must be held.
+func (c *WatchClient) deletePod(id PodIdentifier) {
+	if p, ok := c.Pods[id]; ok {
+		c.podTableBytes -= podEntrySize(id, p)
+		delete(c.Pods, id)
+	}
+}
+
+// podEntrySize returns the estimated size of an entry of the pod table. The pods associated
+// with several identifiers are accounted for each of them, so the estimate errs on the high side.
+func podEntrySize(id PodIdentifier, pod *Pod) int64 {
+	size := podEntryOverhead + pod.size
+	for _, attr := range id {
+		size += int64(len(attr.Source.From) + len(attr.Source.Name) + len(attr.Value))
+	}
+	return size
+}
+
+// estimatePodSize returns the estimated memory used by the pod, its attributes and its containers.
+func estimatePodSize(pod *Pod) int64 {
+	size := podOverhead + int64(len(pod.Name)+len(pod.Address)+len(pod.PodUID)+len(pod.Namespace))
+	for k, v := range pod.Attributes {
+		size += mapEntryOverhead + int64(len(k)+len(v))
+	}
+	for _, container := range pod.Containers.ByID {
+		size += mapEntryOverhead + estimateContainerSize(container)
 	}
+	for _, container := range pod.Containers.ByName {
+		size += mapEntryOverhead + estimateContainerSize(container)
+	}
+	return size
+}
+
+func estimateContainerSize(container *Container) int64 {
+	size := containerOverhead + int64(len(container.Name)+len(container.ImageName)+len(container.ImageTag))
+	for _, status := range container.Statuses {
+		size += mapEntryOverhead + int64(len(status.ContainerID))
+	}
+	return size
 }
 
 func (c *WatchClient) forgetPod(pod *api_v1.Pod) {
@@ -894,6 +1114,61 @@ func removeUnnecessaryReplicaSetData(replicaset *apps_v1.ReplicaSet) *apps_v1.Re
 	return &transformedReplicaset
 }
 
+// watchReplicaSets determines whether the ReplicaSets are watched to find the Deployment of pods.
+func (c *WatchClient) watchReplicaSets() bool {
+	return (c.Rules.DeploymentName || c.Rules.DeploymentUID) && !c.OwnerLookup.Enabled
+}
+
+// resolveOwners returns the chain of controllers of the pod, from its direct owner up to
+// at most OwnerLookup.MaxDepth owners. The last owner is the workload of the pod.
+func (c *WatchClient) resolveOwners(pod *api_v1.Pod) []Owner {
+	var owners []Owner
+	withLabelsAnnotations := c.Rules.IncludesWorkloadLabelsAnnotations()
+	ref := meta_v1.GetControllerOfNoCopy(pod)
+	for ref != nil && len(owners) < c.OwnerLookup.MaxDepth {
+		owner := Owner{Kind: ref.Kind, Name: ref.Name, UID: string(ref.UID)}
+		// The last owner is only fetched for its labels and annotations.
+		if len(owners)+1 == c.OwnerLookup.MaxDepth && !withLabelsAnnotations {
+			owners = append(owners, owner)
+			break
+		}
+		info := c.getOwnerInfo(pod.Namespace, *ref)
+		owner.Labels, owner.Annotations = info.labels, info.annotations
+		owners = append(owners, owner)
+		ref = info.controller
+	}
+	return owners
+}
+
+// getOwnerInfo returns the controller of the object referenced by ref, and its labels and
+// annotations when they are extracted, fetching its metadata when it is not cached.
+func (c *WatchClient) getOwnerInfo(namespace string, ref meta_v1.OwnerReference) ownerInfo {
+	if info, ok := c.ownerCache.get(ref.UID, time.Now()); ok {
+		return info
+	}
+	observability.RecordOwnerLookupMiss()
+
+	ctx, cancel := context.WithTimeout(context.Background(), ownerLookupTimeout)
+	defer cancel()
+	var info ownerInfo
+	owner, err := c.ownerGetter.Get(ctx, namespace, ref)
+	if err != nil {
+		// The failure is cached as well so that the API server is not queried
+		// for every pod of the owner until the entry expires.
+		c.logger.Debug("failed to fetch the owner of a pod",
+			zap.String("kind", ref.Kind), zap.String("name", ref.Name), zap.Error(err))
+	} else if owner.UID == ref.UID {
+		info.controller = meta_v1.GetControllerOf(owner)
+		// The labels and annotations are only kept in the cache when they are extracted.
+		if c.Rules.IncludesWorkloadLabelsAnnotations() {
+			info.labels, info.annotations = owner.Labels, owner.Annotations
+		}
+	}
+	c.ownerCache.set(ref.UID, info, time.Now())
+	observability.RecordOwnerCacheSize(int64(c.ownerCache.len()))
+	return info
+}
+
 func (c *WatchClient) getReplicaSet(uid string) (*ReplicaSet, bool) {
 	c.m.RLock()
 	replicaset, ok := c.ReplicaSets[uid]
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
@@ -116,12 +117,12 @@ func namespaceAddAndUpdateTest(t *testing.T, c *WatchClient, handler func(obj in
 }
 
 func TestDefaultClientset(t *testing.T) {
-	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, nil, nil, nil)
+	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, OwnerLookup{}, Informers{}, nil, nil, nil, nil, nil)
 	assert.Error(t, err)
 	assert.Equal(t, "invalid authType for kubernetes: ", err.Error())
 	assert.Nil(t, c)
 
-	c, err = New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, nil, nil, nil)
+	c, err = New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, OwnerLookup{}, Informers{}, newFakeAPIClientset, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, c)
 }
@@ -134,10 +135,13 @@ func TestBadFilters(t *testing.T) {
 		Filters{Fields: []FieldFilter{{Op: selection.Exists}}},
 		[]Association{},
 		Excludes{},
+		OwnerLookup{},
+		Informers{},
 		newFakeAPIClientset,
 		NewFakeInformer,
 		NewFakeNamespaceInformer,
 		NewFakeReplicaSetInformer,
+		nil,
 	)
 	assert.Error(t, err)
 	assert.Nil(t, c)
@@ -174,7 +178,7 @@ func TestConstructorErrors(t *testing.T) {
 			gotAPIConfig = c
 			return nil, fmt.Errorf("error creating k8s client")
 		}
-		c, err := New(zap.NewNop(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, NewFakeInformer, NewFakeNamespaceInformer, nil)
+		c, err := New(zap.NewNop(), apiCfg, er, ff, []Association{}, Excludes{}, OwnerLookup{}, Informers{}, clientProvider, NewFakeInformer, NewFakeNamespaceInformer, nil, nil)
 		assert.Nil(t, c)
 		assert.Error(t, err)
 		assert.Equal(t, "error creating k8s client", err.Error())
@@ -518,6 +522,80 @@ func TestDeleteLoop(t *testing.T) {
 	<-c.stopCh
 }
 
+func TestPodTableMemoryLimit(t *testing.T) {
+	c, _ := newTestClient(t)
+	newPod := func(name, ip string) *api_v1.Pod {
+		pod := &api_v1.Pod{}
+		pod.Name = name
+		pod.Status.PodIP = ip
+		return pod
+	}
+
+	c.handlePodAdd(newPod("podA", "1.1.1.1"))
+	podBytes := c.podTableBytes
+	assert.Greater(t, podBytes, int64(0))
+
+	c.Informers.MemoryLimit = 2 * podBytes
+	c.handlePodAdd(newPod("podB", "2.2.2.2"))
+	assert.Equal(t, 4, len(c.Pods))
+	assert.Equal(t, 2*podBytes, c.podTableBytes)
+
+	// the new pods are rejected once the limit is reached
+	c.handlePodAdd(newPod("podC", "3.3.3.3"))
+	assert.Equal(t, 4, len(c.Pods))
+	_, ok := c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "3.3.3.3"))
+	assert.False(t, ok)
+
+	// the pods already in the table are still updated
+	c.handlePodUpdate(nil, newPod("podA", "1.1.1.1"))
+	assert.Equal(t, 2*podBytes, c.podTableBytes)
+
+	// the pods pending deletion are evicted to make room for new pods
+	c.handlePodDelete(newPod("podA", "1.1.1.1"))
+	c.handlePodAdd(newPod("podC", "3.3.3.3"))
+	assert.Equal(t, 4, len(c.Pods))
+	assert.Equal(t, 2*podBytes, c.podTableBytes)
+	_, ok = c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1"))
+	assert.False(t, ok)
+	got, ok := c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "3.3.3.3"))
+	assert.True(t, ok)
+	assert.Equal(t, "podC", got.Name)
+	assert.Empty(t, c.deleteQueue)
+}
+
+func TestTransformMetadataOnly(t *testing.T) {
+	isController := true
+	objectMeta := meta_v1.ObjectMeta{
+		Name:          "deployment-aaa",
+		Namespace:     "namespaceA",
+		UID:           "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+		Labels:        map[string]string{"label1": "lv1"},
+		ManagedFields: []meta_v1.ManagedFieldsEntry{{Manager: "kube-controller-manager"}},
+		OwnerReferences: []meta_v1.OwnerReference{
+			{Kind: "Deployment", Name: "deployment", UID: "ffffffff-gggg-hhhh-iiii-jjjjjjjjjjj", Controller: &isController},
+		},
+	}
+
+	obj, err := transformNamespace(&meta_v1.PartialObjectMetadata{ObjectMeta: objectMeta})
+	require.NoError(t, err)
+	assert.Equal(t, &api_v1.Namespace{ObjectMeta: objectMeta}, obj)
+
+	obj, err = transformReplicaSet(&meta_v1.PartialObjectMetadata{ObjectMeta: objectMeta})
+	require.NoError(t, err)
+	replicaset, ok := obj.(*apps_v1.ReplicaSet)
+	require.True(t, ok)
+	assert.Equal(t, "deployment-aaa", replicaset.Name)
+	assert.Equal(t, objectMeta.OwnerReferences, replicaset.OwnerReferences)
+	assert.Empty(t, replicaset.Labels)
+	assert.Empty(t, replicaset.ManagedFields)
+
+	// the other objects are left as is
+	deleted := cache.DeletedFinalStateUnknown{Key: "namespaceA/deployment-aaa"}
+	obj, err = transformReplicaSet(deleted)
+	require.NoError(t, err)
+	assert.Equal(t, deleted, obj)
+}
+
 func TestGetIgnoredPod(t *testing.T) {
 	c, _ := newTestClient(t)
 	pod := &api_v1.Pod{}
@@ -1767,7 +1845,7 @@ func newTestClientWithRulesAndFilters(t *testing.T, f Filters) (*WatchClient, *o
 			},
 		},
 	}
-	c, err := New(logger, k8sconfig.APIConfig{}, ExtractionRules{}, f, associations, exclude, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer, NewFakeReplicaSetInformer)
+	c, err := New(logger, k8sconfig.APIConfig{}, ExtractionRules{}, f, associations, exclude, OwnerLookup{}, Informers{}, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer, NewFakeReplicaSetInformer, nil)
 	require.NoError(t, err)
 	return c.(*WatchClient), logs
 }
@@ -12,9 +12,18 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+var (
+	namespacesResource  = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	replicaSetsResource = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
 )
 
 // InformerProvider defines a function type that returns a new SharedInformer. It is used to
@@ -126,3 +135,42 @@ func replicasetWatchFuncWithSelectors(client kubernetes.Interface, namespace str
 		return client.AppsV1().ReplicaSets(namespace).Watch(context.Background(), opts)
 	}
 }
+
+// newMetadataClient returns a client of the metadata API, which only transfers the metadata of objects.
+func newMetadataClient(apiCfg k8sconfig.APIConfig) (metadata.Interface, error) {
+	restCfg, err := k8sconfig.CreateRestConfig(apiCfg)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.NewForConfig(restCfg)
+}
+
+// newMetadataSharedInformer returns an informer watching only the metadata of the objects of
+// the resource, which is all that is needed from namespaces and ReplicaSets.
+func newMetadataSharedInformer(
+	client metadata.Interface,
+	resource schema.GroupVersionResource,
+	namespace string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  metadataListFunc(client, resource, namespace),
+			WatchFunc: metadataWatchFunc(client, resource, namespace),
+		},
+		&metav1.PartialObjectMetadata{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func metadataListFunc(client metadata.Interface, resource schema.GroupVersionResource, namespace string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		return client.Resource(resource).Namespace(namespace).List(context.Background(), opts)
+	}
+}
+
+func metadataWatchFunc(client metadata.Interface, resource schema.GroupVersionResource, namespace string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		return client.Resource(resource).Namespace(namespace).Watch(context.Background(), opts)
+	}
+}
@@ -22,10 +22,16 @@ const (
 	tagNodeName             = "k8s.node.name"
 	tagStartTime            = "k8s.pod.start_time"
 	tagHostName             = "k8s.pod.hostname"
+	tagWorkloadKind         = "k8s.workload.kind"
+	tagWorkloadName         = "k8s.workload.name"
+	tagWorkloadUID          = "k8s.workload.uid"
 	// MetadataFromPod is used to specify to extract metadata/labels/annotations from pod
 	MetadataFromPod = "pod"
 	// MetadataFromNamespace is used to specify to extract metadata/labels/annotations from namespace
-	MetadataFromNamespace  = "namespace"
+	MetadataFromNamespace = "namespace"
+	// MetadataFromWorkload is used to specify to extract labels/annotations from the workload
+	// of the pod, the last owner resolved with OwnerLookup
+	MetadataFromWorkload   = "workload"
 	PodIdentifierMaxLength = 4
 
 	ResourceSource   = "resource_attribute"
@@ -92,7 +98,7 @@ type Client interface {
 }
 
 // ClientProvider defines a func type that returns a new Client.
-type ClientProvider func(*zap.Logger, k8sconfig.APIConfig, ExtractionRules, Filters, []Association, Excludes, APIClientsetProvider, InformerProvider, InformerProviderNamespace, InformerProviderReplicaSet) (Client, error)
+type ClientProvider func(*zap.Logger, k8sconfig.APIConfig, ExtractionRules, Filters, []Association, Excludes, OwnerLookup, Informers, APIClientsetProvider, InformerProvider, InformerProviderNamespace, InformerProviderReplicaSet, OwnerMetadataGetterProvider) (Client, error)
 
 // APIClientsetProvider defines a func type that initializes and return a new kubernetes
 // Clientset object.
@@ -113,6 +119,9 @@ type Pod struct {
 	Containers PodContainers
 
 	DeletedAt time.Time
+
+	// size is the estimated memory used by the pod, accounted in the memory limit of the pod table.
+	size int64
 }
 
 // PodContainers specifies a list of pod containers. It is not safe for concurrent use.
@@ -147,6 +156,17 @@ type Namespace struct {
 	DeletedAt    time.Time
 }
 
+// Informers configures how the kubernetes objects are watched and the memory the pods
+// cached from them may use.
+type Informers struct {
+	// MetadataOnly watches namespaces and ReplicaSets with metadata-only requests, so that
+	// their specs and statuses are neither transferred nor decoded.
+	MetadataOnly bool
+	// MemoryLimit is the estimated size in bytes of the pod table above which the pods
+	// pending deletion are evicted and new pods are not added. Zero disables the limit.
+	MemoryLimit int64
+}
+
 type deleteRequest struct {
 	// id is identifier (IP address or Pod UID) of pod to remove from pods map
 	id PodIdentifier
@@ -183,6 +203,7 @@ type FieldFilter struct {
 // from pods and added to the spans as tags.
 type ExtractionRules struct {
 	CronJobName        bool
+	CronJobUID         bool
 	DeploymentName     bool
 	DeploymentUID      bool
 	DaemonSetUID       bool
@@ -197,6 +218,9 @@ type ExtractionRules struct {
 	ReplicaSetName     bool
 	StatefulSetUID     bool
 	StatefulSetName    bool
+	WorkloadKind       bool
+	WorkloadName       bool
+	WorkloadUID        bool
 	Node               bool
 	StartTime          bool
 	ContainerName      bool
@@ -212,6 +236,7 @@ type ExtractionRules struct {
 func (rules *ExtractionRules) IncludesOwnerMetadata() bool {
 	rulesNeedingOwnerMetadata := []bool{
 		rules.CronJobName,
+		rules.CronJobUID,
 		rules.DeploymentName,
 		rules.DeploymentUID,
 		rules.DaemonSetUID,
@@ -222,12 +247,44 @@ func (rules *ExtractionRules) IncludesOwnerMetadata() bool {
 		rules.ReplicaSetName,
 		rules.StatefulSetUID,
 		rules.StatefulSetName,
+		rules.WorkloadKind,
+		rules.WorkloadName,
+		rules.WorkloadUID,
 	}
 	for _, ruleEnabled := range rulesNeedingOwnerMetadata {
 		if ruleEnabled {
 			return true
 		}
 	}
+	return rules.IncludesWorkloadLabelsAnnotations()
+}
+
+// IncludesOwnerChainMetadata determines whether the ExtractionRules include metadata about
+// the owners of the Pod Owners, which requires resolving the chain of owners.
+func (rules *ExtractionRules) IncludesOwnerChainMetadata() bool {
+	return rules.CronJobName ||
+		rules.CronJobUID ||
+		rules.DeploymentName ||
+		rules.DeploymentUID ||
+		rules.WorkloadKind ||
+		rules.WorkloadName ||
+		rules.WorkloadUID ||
+		rules.IncludesWorkloadLabelsAnnotations()
+}
+
+// IncludesWorkloadLabelsAnnotations determines whether the ExtractionRules include labels or
+// annotations of the workload of the Pod, which requires fetching the metadata of its owners.
+func (rules *ExtractionRules) IncludesWorkloadLabelsAnnotations() bool {
+	for _, r := range rules.Labels {
+		if r.From == MetadataFromWorkload {
+			return true
+		}
+	}
+	for _, r := range rules.Annotations {
+		if r.From == MetadataFromWorkload {
+			return true
+		}
+	}
 	return false
 }
 
@@ -245,9 +302,10 @@ type FieldExtractionRule struct {
 	// Full value is extracted when no regexp is provided.
 	Regex *regexp.Regexp
 	// From determines the kubernetes object the field should be retrieved from.
-	// Currently only two values are supported,
+	// Currently only three values are supported,
 	//  - pod
 	//  - namespace
+	//  - workload
 	From string
 }
 
@@ -264,6 +322,12 @@ func (r *FieldExtractionRule) extractFromNamespaceMetadata(metadata map[string]s
 	}
 }
 
+func (r *FieldExtractionRule) extractFromWorkloadMetadata(metadata map[string]string, tags map[string]string, formatter string) {
+	if r.From == MetadataFromWorkload {
+		r.extractFromMetadata(metadata, tags, formatter)
+	}
+}
+
 func (r *FieldExtractionRule) extractFromMetadata(metadata map[string]string, tags map[string]string, formatter string) {
 	if r.KeyRegex != nil {
 		for k, v := range metadata {
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kube // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// ownerLookupTimeout is the timeout of a request fetching the metadata of an owner.
+const ownerLookupTimeout = 10 * time.Second
+
+// OwnerLookup configures the resolution of the chain of owners of pods.
+type OwnerLookup struct {
+	// Enabled resolves the owners of pods with metadata-only requests, cached
+	// by owner UID, instead of watching all the ReplicaSets of the cluster.
+	Enabled bool
+	// MaxDepth is the maximum number of owners resolved above a pod.
+	MaxDepth int
+	// CacheTTL is the time an owner is cached before its metadata is fetched again.
+	CacheTTL time.Duration
+	// MaxCacheEntries is the maximum number of owners kept in the cache.
+	MaxCacheEntries int
+}
+
+// Owner represents an object in the chain of owners of a pod.
+type Owner struct {
+	Kind string
+	Name string
+	UID  string
+	// Labels and Annotations are only set when the extraction rules include labels or
+	// annotations of the workload.
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// OwnerMetadataGetter fetches the metadata of the object referenced by an owner reference.
+type OwnerMetadataGetter interface {
+	Get(ctx context.Context, namespace string, ref meta_v1.OwnerReference) (*meta_v1.PartialObjectMetadata, error)
+}
+
+// OwnerMetadataGetterProvider defines a func type that initializes and returns a new
+// OwnerMetadataGetter.
+type OwnerMetadataGetterProvider func(k8sconfig.APIConfig, kubernetes.Interface) (OwnerMetadataGetter, error)
+
+// metadataOwnerGetter fetches owners of any kind, including custom resources, with the
+// metadata API, so that only their metadata is transferred.
+type metadataOwnerGetter struct {
+	client metadata.Interface
+	mapper *restmapper.DeferredDiscoveryRESTMapper
+}
+
+func newMetadataOwnerGetter(apiCfg k8sconfig.APIConfig, kc kubernetes.Interface) (OwnerMetadataGetter, error) {
+	client, err := newMetadataClient(apiCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &metadataOwnerGetter{
+		client: client,
+		mapper: restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(kc.Discovery())),
+	}, nil
+}
+
+func (g *metadataOwnerGetter) Get(ctx context.Context, namespace string, ref meta_v1.OwnerReference) (*meta_v1.PartialObjectMetadata, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: ref.Kind}
+	mapping, err := g.mapper.RESTMapping(gk, gv.Version)
+	if meta.IsNoMatchError(err) {
+		// The resource may have been installed after the discovery information was cached.
+		g.mapper.Reset()
+		mapping, err = g.mapper.RESTMapping(gk, gv.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return g.client.Resource(mapping.Resource).Get(ctx, ref.Name, meta_v1.GetOptions{})
+	}
+	return g.client.Resource(mapping.Resource).Namespace(namespace).Get(ctx, ref.Name, meta_v1.GetOptions{})
+}
+
+// ownerInfo holds the controller of an owner, nil when the owner has no controller or
+// could not be fetched, and its labels and annotations when they are extracted.
+type ownerInfo struct {
+	controller  *meta_v1.OwnerReference
+	labels      map[string]string
+	annotations map[string]string
+}
+
+type ownerCacheEntry struct {
+	info    ownerInfo
+	expires time.Time
+}
+
+// ownerCache caches the metadata of owners by owner UID. It holds at most maxEntries
+// entries: once full, the expired entries are evicted, then arbitrary ones if needed.
+type ownerCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[types.UID]ownerCacheEntry
+}
+
+func newOwnerCache(ttl time.Duration, maxEntries int) *ownerCache {
+	return &ownerCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    map[types.UID]ownerCacheEntry{},
+	}
+}
+
+func (oc *ownerCache) get(uid types.UID, now time.Time) (ownerInfo, bool) {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+	entry, ok := oc.entries[uid]
+	if !ok || now.After(entry.expires) {
+		return ownerInfo{}, false
+	}
+	return entry.info, true
+}
+
+func (oc *ownerCache) set(uid types.UID, info ownerInfo, now time.Time) {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+	if _, ok := oc.entries[uid]; !ok && len(oc.entries) >= oc.maxEntries {
+		oc.evict(now)
+	}
+	oc.entries[uid] = ownerCacheEntry{info: info, expires: now.Add(oc.ttl)}
+}
+
+// evict removes the expired entries. If none has expired, it removes a tenth of the
+// entries so that the eviction cost is amortized over the next insertions.
+func (oc *ownerCache) evict(now time.Time) {
+	for uid, entry := range oc.entries {
+		if now.After(entry.expires) {
+			delete(oc.entries, uid)
+		}
+	}
+	for uid := range oc.entries {
+		if len(oc.entries) < oc.maxEntries-oc.maxEntries/10 {
+			break
+		}
+		delete(oc.entries, uid)
+	}
+}
+
+func (oc *ownerCache) len() int {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+	return len(oc.entries)
+}
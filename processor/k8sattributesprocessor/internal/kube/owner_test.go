@@ -0,0 +1,338 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	api_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// fakeOwnerGetter returns the owners it holds by UID and counts the requests.
+type fakeOwnerGetter struct {
+	owners   map[types.UID]*meta_v1.PartialObjectMetadata
+	requests int
+}
+
+func (f *fakeOwnerGetter) Get(_ context.Context, _ string, ref meta_v1.OwnerReference) (*meta_v1.PartialObjectMetadata, error) {
+	f.requests++
+	owner, ok := f.owners[ref.UID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return owner, nil
+}
+
+func controllerRef(kind, name, uid string) meta_v1.OwnerReference {
+	isController := true
+	return meta_v1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       kind,
+		Name:       name,
+		UID:        types.UID(uid),
+		Controller: &isController,
+	}
+}
+
+func ownerMetadata(name, uid string, owners ...meta_v1.OwnerReference) *meta_v1.PartialObjectMetadata {
+	return &meta_v1.PartialObjectMetadata{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            name,
+			Namespace:       "ns1",
+			UID:             types.UID(uid),
+			OwnerReferences: owners,
+		},
+	}
+}
+
+func newTestClientWithOwnerLookup(t *testing.T, rules ExtractionRules, ownerLookup OwnerLookup, getter OwnerMetadataGetter) *WatchClient {
+	c, err := New(
+		zap.NewNop(),
+		k8sconfig.APIConfig{},
+		rules,
+		Filters{},
+		[]Association{},
+		Excludes{},
+		ownerLookup,
+		Informers{},
+		newFakeAPIClientset,
+		NewFakeInformer,
+		NewFakeNamespaceInformer,
+		NewFakeReplicaSetInformer,
+		func(k8sconfig.APIConfig, kubernetes.Interface) (OwnerMetadataGetter, error) {
+			return getter, nil
+		},
+	)
+	require.NoError(t, err)
+	return c.(*WatchClient)
+}
+
+func TestOwnerLookupExtractionRules(t *testing.T) {
+	application := controllerRef("Application", "auth", "application-uid")
+	application.APIVersion = "argoproj.io/v1alpha1"
+	getter := &fakeOwnerGetter{owners: map[types.UID]*meta_v1.PartialObjectMetadata{
+		"rs-uid":         ownerMetadata("auth-service-66f5996c7c", "rs-uid", controllerRef("Deployment", "auth-service", "deployment-uid")),
+		"deployment-uid": ownerMetadata("auth-service", "deployment-uid", application),
+	}}
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            "auth-service-66f5996c7c-xyz3",
+			Namespace:       "ns1",
+			UID:             "pod-uid",
+			OwnerReferences: []meta_v1.OwnerReference{controllerRef("ReplicaSet", "auth-service-66f5996c7c", "rs-uid")},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		maxDepth   int
+		rules      ExtractionRules
+		attributes map[string]string
+	}{{
+		name:     "deployment",
+		maxDepth: 3,
+		rules:    ExtractionRules{DeploymentName: true, DeploymentUID: true},
+		attributes: map[string]string{
+			"k8s.deployment.name": "auth-service",
+			"k8s.deployment.uid":  "deployment-uid",
+		},
+	}, {
+		name:     "workload",
+		maxDepth: 3,
+		rules:    ExtractionRules{DeploymentName: true, WorkloadKind: true, WorkloadName: true, WorkloadUID: true},
+		attributes: map[string]string{
+			"k8s.deployment.name": "auth-service",
+			"k8s.workload.kind":   "Application",
+			"k8s.workload.name":   "auth",
+			"k8s.workload.uid":    "application-uid",
+		},
+	}, {
+		name:     "limited_depth",
+		maxDepth: 2,
+		rules:    ExtractionRules{DeploymentName: true, WorkloadKind: true, WorkloadName: true},
+		attributes: map[string]string{
+			"k8s.deployment.name": "auth-service",
+			"k8s.workload.kind":   "Deployment",
+			"k8s.workload.name":   "auth-service",
+		},
+	}, {
+		name:     "direct_owner_only",
+		maxDepth: 1,
+		rules:    ExtractionRules{DeploymentName: true, WorkloadKind: true, WorkloadName: true},
+		attributes: map[string]string{
+			"k8s.workload.kind": "ReplicaSet",
+			"k8s.workload.name": "auth-service-66f5996c7c",
+		},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClientWithOwnerLookup(t, tc.rules, OwnerLookup{
+				Enabled:         true,
+				MaxDepth:        tc.maxDepth,
+				CacheTTL:        time.Minute,
+				MaxCacheEntries: 10,
+			}, getter)
+			assert.Nil(t, c.replicasetInformer)
+			assert.Equal(t, tc.attributes, c.extractPodAttributes(pod))
+		})
+	}
+}
+
+func TestOwnerLookupCronJob(t *testing.T) {
+	cronJob := controllerRef("CronJob", "backup", "cronjob-uid")
+	cronJob.APIVersion = "batch/v1"
+	getter := &fakeOwnerGetter{owners: map[types.UID]*meta_v1.PartialObjectMetadata{
+		"job-uid":        ownerMetadata("backup-28175460", "job-uid", cronJob),
+		"manual-job-uid": ownerMetadata("restore-28175460", "manual-job-uid"),
+	}}
+	c := newTestClientWithOwnerLookup(t, ExtractionRules{CronJobName: true, CronJobUID: true, JobName: true}, OwnerLookup{
+		Enabled:         true,
+		MaxDepth:        2,
+		CacheTTL:        time.Minute,
+		MaxCacheEntries: 10,
+	}, getter)
+
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            "backup-28175460-abcde",
+			Namespace:       "ns1",
+			OwnerReferences: []meta_v1.OwnerReference{controllerRef("Job", "backup-28175460", "job-uid")},
+		},
+	}
+	assert.Equal(t, map[string]string{
+		"k8s.job.name":     "backup-28175460",
+		"k8s.cronjob.name": "backup",
+		"k8s.cronjob.uid":  "cronjob-uid",
+	}, c.extractPodAttributes(pod))
+
+	// a Job that is not created by a CronJob is not mistaken for one because of its name
+	pod = &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            "restore-28175460-abcde",
+			Namespace:       "ns1",
+			OwnerReferences: []meta_v1.OwnerReference{controllerRef("Job", "restore-28175460", "manual-job-uid")},
+		},
+	}
+	assert.Equal(t, map[string]string{"k8s.job.name": "restore-28175460"}, c.extractPodAttributes(pod))
+}
+
+func TestOwnerLookupWorkloadLabelsAnnotations(t *testing.T) {
+	deployment := ownerMetadata("auth-service", "deployment-uid")
+	deployment.Labels = map[string]string{"app.kubernetes.io/part-of": "shop", "tier": "backend"}
+	deployment.Annotations = map[string]string{"team.example.com/owner": "identity", "team.example.com/slack": "#identity"}
+	getter := &fakeOwnerGetter{owners: map[types.UID]*meta_v1.PartialObjectMetadata{
+		"rs-uid":         ownerMetadata("auth-service-66f5996c7c", "rs-uid", controllerRef("Deployment", "auth-service", "deployment-uid")),
+		"deployment-uid": deployment,
+	}}
+	rules := ExtractionRules{
+		Labels: []FieldExtractionRule{
+			{Name: "k8s.workload.labels.app.kubernetes.io/part-of", Key: "app.kubernetes.io/part-of", From: MetadataFromWorkload},
+			{Name: "tier", Key: "tier", From: MetadataFromPod},
+		},
+		Annotations: []FieldExtractionRule{
+			{KeyRegex: regexp.MustCompile(`^(?:team\.example\.com/.*)$`), From: MetadataFromWorkload},
+		},
+	}
+	assert.True(t, rules.IncludesOwnerChainMetadata())
+	c := newTestClientWithOwnerLookup(t, rules, OwnerLookup{
+		Enabled:         true,
+		MaxDepth:        2,
+		CacheTTL:        time.Minute,
+		MaxCacheEntries: 10,
+	}, getter)
+
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            "auth-service-66f5996c7c-xyz3",
+			Namespace:       "ns1",
+			OwnerReferences: []meta_v1.OwnerReference{controllerRef("ReplicaSet", "auth-service-66f5996c7c", "rs-uid")},
+		},
+	}
+	assert.Equal(t, map[string]string{
+		"k8s.workload.labels.app.kubernetes.io/part-of":   "shop",
+		"k8s.workload.annotations.team.example.com/owner": "identity",
+		"k8s.workload.annotations.team.example.com/slack": "#identity",
+	}, c.extractPodAttributes(pod))
+	// the last owner is fetched for its labels and annotations
+	assert.Equal(t, 2, getter.requests)
+}
+
+func TestOwnerLookupCachesOwners(t *testing.T) {
+	getter := &fakeOwnerGetter{owners: map[types.UID]*meta_v1.PartialObjectMetadata{
+		"rs-uid": ownerMetadata("auth-service-66f5996c7c", "rs-uid", controllerRef("Deployment", "auth-service", "deployment-uid")),
+	}}
+	c := newTestClientWithOwnerLookup(t, ExtractionRules{WorkloadName: true}, OwnerLookup{
+		Enabled:         true,
+		MaxDepth:        2,
+		CacheTTL:        time.Minute,
+		MaxCacheEntries: 10,
+	}, getter)
+
+	for _, name := range []string{"pod-a", "pod-b", "pod-c"} {
+		pod := &api_v1.Pod{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:            name,
+				Namespace:       "ns1",
+				OwnerReferences: []meta_v1.OwnerReference{controllerRef("ReplicaSet", "auth-service-66f5996c7c", "rs-uid")},
+			},
+		}
+		assert.Equal(t, map[string]string{"k8s.workload.name": "auth-service"}, c.extractPodAttributes(pod))
+	}
+	assert.Equal(t, 1, getter.requests)
+
+	// failed lookups are cached as well
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            "pod-d",
+			Namespace:       "ns1",
+			OwnerReferences: []meta_v1.OwnerReference{controllerRef("ReplicaSet", "deleted", "deleted-uid")},
+		},
+	}
+	assert.Equal(t, map[string]string{"k8s.workload.name": "deleted"}, c.extractPodAttributes(pod))
+	assert.Equal(t, map[string]string{"k8s.workload.name": "deleted"}, c.extractPodAttributes(pod))
+	assert.Equal(t, 2, getter.requests)
+}
+
+func TestOwnerLookupIgnoresRecreatedOwner(t *testing.T) {
+	getter := &fakeOwnerGetter{owners: map[types.UID]*meta_v1.PartialObjectMetadata{
+		// the ReplicaSet was recreated with the same name and a new UID
+		"old-rs-uid": ownerMetadata("auth-service-66f5996c7c", "new-rs-uid", controllerRef("Deployment", "auth-service", "deployment-uid")),
+	}}
+	c := newTestClientWithOwnerLookup(t, ExtractionRules{DeploymentName: true}, OwnerLookup{
+		Enabled:         true,
+		MaxDepth:        2,
+		CacheTTL:        time.Minute,
+		MaxCacheEntries: 10,
+	}, getter)
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            "pod-a",
+			Namespace:       "ns1",
+			OwnerReferences: []meta_v1.OwnerReference{controllerRef("ReplicaSet", "auth-service-66f5996c7c", "old-rs-uid")},
+		},
+	}
+	assert.Empty(t, c.extractPodAttributes(pod))
+}
+
+func TestOwnerCache(t *testing.T) {
+	now := time.Now()
+	cache := newOwnerCache(time.Minute, 10)
+	deployment := controllerRef("Deployment", "auth-service", "deployment-uid")
+
+	_, ok := cache.get("rs-uid", now)
+	assert.False(t, ok)
+
+	cache.set("rs-uid", ownerInfo{controller: &deployment, labels: map[string]string{"app": "auth"}}, now)
+	info, ok := cache.get("rs-uid", now.Add(30*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, &deployment, info.controller)
+	assert.Equal(t, map[string]string{"app": "auth"}, info.labels)
+
+	cache.set("orphan-uid", ownerInfo{}, now)
+	info, ok = cache.get("orphan-uid", now)
+	assert.True(t, ok)
+	assert.Nil(t, info.controller)
+
+	_, ok = cache.get("rs-uid", now.Add(2*time.Minute))
+	assert.False(t, ok)
+}
+
+func TestOwnerCacheEviction(t *testing.T) {
+	now := time.Now()
+	cache := newOwnerCache(time.Minute, 10)
+	for i := 0; i < 5; i++ {
+		cache.set(types.UID(fmt.Sprint(i)), ownerInfo{}, now)
+	}
+	for i := 5; i < 10; i++ {
+		cache.set(types.UID(fmt.Sprint(i)), ownerInfo{}, now.Add(time.Minute))
+	}
+	assert.Equal(t, 10, cache.len())
+
+	// the expired entries are evicted first
+	cache.set("k", ownerInfo{}, now.Add(90*time.Second))
+	assert.Equal(t, 6, cache.len())
+	for i := 5; i < 10; i++ {
+		_, ok := cache.get(types.UID(fmt.Sprint(i)), now.Add(90*time.Second))
+		assert.True(t, ok)
+	}
+
+	// without expired entries, the cache never grows beyond its limit
+	for i := 0; i < 100; i++ {
+		cache.set(types.UID(fmt.Sprint("new-", i)), ownerInfo{}, now.Add(90*time.Second))
+		assert.LessOrEqual(t, cache.len(), 10)
+	}
+}
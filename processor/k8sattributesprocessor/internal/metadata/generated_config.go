@@ -14,6 +14,7 @@ type ResourceAttributesConfig struct {
 	ContainerImageTag  ResourceAttributeConfig `mapstructure:"container.image.tag"`
 	K8sContainerName   ResourceAttributeConfig `mapstructure:"k8s.container.name"`
 	K8sCronjobName     ResourceAttributeConfig `mapstructure:"k8s.cronjob.name"`
+	K8sCronjobUID      ResourceAttributeConfig `mapstructure:"k8s.cronjob.uid"`
 	K8sDaemonsetName   ResourceAttributeConfig `mapstructure:"k8s.daemonset.name"`
 	K8sDaemonsetUID    ResourceAttributeConfig `mapstructure:"k8s.daemonset.uid"`
 	K8sDeploymentName  ResourceAttributeConfig `mapstructure:"k8s.deployment.name"`
@@ -30,6 +31,9 @@ type ResourceAttributesConfig struct {
 	K8sReplicasetUID   ResourceAttributeConfig `mapstructure:"k8s.replicaset.uid"`
 	K8sStatefulsetName ResourceAttributeConfig `mapstructure:"k8s.statefulset.name"`
 	K8sStatefulsetUID  ResourceAttributeConfig `mapstructure:"k8s.statefulset.uid"`
+	K8sWorkloadKind    ResourceAttributeConfig `mapstructure:"k8s.workload.kind"`
+	K8sWorkloadName    ResourceAttributeConfig `mapstructure:"k8s.workload.name"`
+	K8sWorkloadUID     ResourceAttributeConfig `mapstructure:"k8s.workload.uid"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
@@ -49,6 +53,9 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sCronjobName: ResourceAttributeConfig{
 			Enabled: false,
 		},
+		K8sCronjobUID: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sDaemonsetName: ResourceAttributeConfig{
 			Enabled: false,
 		},
@@ -97,5 +104,14 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sStatefulsetUID: ResourceAttributeConfig{
 			Enabled: false,
 		},
+		K8sWorkloadKind: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sWorkloadName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sWorkloadUID: ResourceAttributeConfig{
+			Enabled: false,
+		},
 	}
 }
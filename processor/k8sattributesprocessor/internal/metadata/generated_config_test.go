@@ -30,6 +30,7 @@ func TestResourceAttributesConfig(t *testing.T) {
 				ContainerImageTag:  ResourceAttributeConfig{Enabled: true},
 				K8sContainerName:   ResourceAttributeConfig{Enabled: true},
 				K8sCronjobName:     ResourceAttributeConfig{Enabled: true},
+				K8sCronjobUID:      ResourceAttributeConfig{Enabled: true},
 				K8sDaemonsetName:   ResourceAttributeConfig{Enabled: true},
 				K8sDaemonsetUID:    ResourceAttributeConfig{Enabled: true},
 				K8sDeploymentName:  ResourceAttributeConfig{Enabled: true},
@@ -46,6 +47,9 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sReplicasetUID:   ResourceAttributeConfig{Enabled: true},
 				K8sStatefulsetName: ResourceAttributeConfig{Enabled: true},
 				K8sStatefulsetUID:  ResourceAttributeConfig{Enabled: true},
+				K8sWorkloadKind:    ResourceAttributeConfig{Enabled: true},
+				K8sWorkloadName:    ResourceAttributeConfig{Enabled: true},
+				K8sWorkloadUID:     ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
@@ -56,6 +60,7 @@ func TestResourceAttributesConfig(t *testing.T) {
 				ContainerImageTag:  ResourceAttributeConfig{Enabled: false},
 				K8sContainerName:   ResourceAttributeConfig{Enabled: false},
 				K8sCronjobName:     ResourceAttributeConfig{Enabled: false},
+				K8sCronjobUID:      ResourceAttributeConfig{Enabled: false},
 				K8sDaemonsetName:   ResourceAttributeConfig{Enabled: false},
 				K8sDaemonsetUID:    ResourceAttributeConfig{Enabled: false},
 				K8sDeploymentName:  ResourceAttributeConfig{Enabled: false},
@@ -72,6 +77,9 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sReplicasetUID:   ResourceAttributeConfig{Enabled: false},
 				K8sStatefulsetName: ResourceAttributeConfig{Enabled: false},
 				K8sStatefulsetUID:  ResourceAttributeConfig{Enabled: false},
+				K8sWorkloadKind:    ResourceAttributeConfig{Enabled: false},
+				K8sWorkloadName:    ResourceAttributeConfig{Enabled: false},
+				K8sWorkloadUID:     ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
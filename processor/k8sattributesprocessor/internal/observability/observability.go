@@ -23,6 +23,11 @@ func init() {
 		viewNamespacesAdded,
 		viewNamespacesUpdated,
 		viewNamespacesDeleted,
+		viewOwnerLookupMiss,
+		viewOwnerCacheSize,
+		viewPodTableBytes,
+		viewPodsEvicted,
+		viewPodsRejected,
 	)
 }
 
@@ -38,6 +43,11 @@ var (
 	mReplicaSetsUpdated = stats.Int64("otelsvc/k8s/replicaset_updated", "Number of ReplicaSet update events received", "1")
 	mReplicaSetsAdded   = stats.Int64("otelsvc/k8s/replicaset_added", "Number of ReplicaSet add events received", "1")
 	mReplicaSetsDeleted = stats.Int64("otelsvc/k8s/replicaset_deleted", "Number of ReplicaSet delete events received", "1")
+	mOwnerLookupMiss    = stats.Int64("otelsvc/k8s/owner_lookup_miss", "Number of times the owner of a pod was not cached and was fetched.", "1")
+	mOwnerCacheSize     = stats.Int64("otelsvc/k8s/owner_cache_size", "Size of the cache containing the owners of pods", "1")
+	mPodTableBytes      = stats.Int64("otelsvc/k8s/pod_table_bytes", "Estimated memory used by the table containing pod info", "By")
+	mPodsEvicted        = stats.Int64("otelsvc/k8s/pod_evicted", "Number of pods pending deletion evicted from the pod table to stay within its memory limit", "1")
+	mPodsRejected       = stats.Int64("otelsvc/k8s/pod_rejected", "Number of pods not added to the pod table because of its memory limit", "1")
 )
 
 var viewPodsUpdated = &view.View{
@@ -96,6 +106,41 @@ var viewNamespacesDeleted = &view.View{
 	Aggregation: view.Sum(),
 }
 
+var viewOwnerLookupMiss = &view.View{
+	Name:        mOwnerLookupMiss.Name(),
+	Description: mOwnerLookupMiss.Description(),
+	Measure:     mOwnerLookupMiss,
+	Aggregation: view.Sum(),
+}
+
+var viewOwnerCacheSize = &view.View{
+	Name:        mOwnerCacheSize.Name(),
+	Description: mOwnerCacheSize.Description(),
+	Measure:     mOwnerCacheSize,
+	Aggregation: view.LastValue(),
+}
+
+var viewPodTableBytes = &view.View{
+	Name:        mPodTableBytes.Name(),
+	Description: mPodTableBytes.Description(),
+	Measure:     mPodTableBytes,
+	Aggregation: view.LastValue(),
+}
+
+var viewPodsEvicted = &view.View{
+	Name:        mPodsEvicted.Name(),
+	Description: mPodsEvicted.Description(),
+	Measure:     mPodsEvicted,
+	Aggregation: view.Sum(),
+}
+
+var viewPodsRejected = &view.View{
+	Name:        mPodsRejected.Name(),
+	Description: mPodsRejected.Description(),
+	Measure:     mPodsRejected,
+	Aggregation: view.Sum(),
+}
+
 // RecordPodUpdated increments the metric that records pod update events received.
 func RecordPodUpdated() {
 	stats.Record(context.Background(), mPodsUpdated.M(int64(1)))
@@ -150,3 +195,28 @@ func RecordReplicaSetAdded() {
 func RecordReplicaSetDeleted() {
 	stats.Record(context.Background(), mReplicaSetsDeleted.M(int64(1)))
 }
+
+// RecordOwnerLookupMiss increments the metric that records owner lookups missing the cache.
+func RecordOwnerLookupMiss() {
+	stats.Record(context.Background(), mOwnerLookupMiss.M(int64(1)))
+}
+
+// RecordOwnerCacheSize store size of owner cache in WatchClient
+func RecordOwnerCacheSize(ownerCacheSize int64) {
+	stats.Record(context.Background(), mOwnerCacheSize.M(ownerCacheSize))
+}
+
+// RecordPodTableBytes store the estimated memory used by the pod table in WatchClient
+func RecordPodTableBytes(podTableBytes int64) {
+	stats.Record(context.Background(), mPodTableBytes.M(podTableBytes))
+}
+
+// RecordPodsEvicted increments the metric that records pods evicted because of the memory limit.
+func RecordPodsEvicted(count int64) {
+	stats.Record(context.Background(), mPodsEvicted.M(count))
+}
+
+// RecordPodRejected increments the metric that records pods rejected because of the memory limit.
+func RecordPodRejected() {
+	stats.Record(context.Background(), mPodsRejected.M(int64(1)))
+}
@@ -88,6 +88,26 @@ func TestMetrics(t *testing.T) {
 			"otelsvc/k8s/namespace_deleted",
 			RecordNamespaceDeleted,
 		},
+		{
+			"otelsvc/k8s/owner_lookup_miss",
+			RecordOwnerLookupMiss,
+		},
+		{
+			"otelsvc/k8s/owner_cache_size",
+			func() { RecordOwnerCacheSize(1) },
+		},
+		{
+			"otelsvc/k8s/pod_table_bytes",
+			func() { RecordPodTableBytes(1024) },
+		},
+		{
+			"otelsvc/k8s/pod_evicted",
+			func() { RecordPodsEvicted(2) },
+		},
+		{
+			"otelsvc/k8s/pod_rejected",
+			RecordPodRejected,
+		},
 	}
 
 	var (
@@ -23,6 +23,9 @@ const (
 	filterOPDoesNotExist = "does-not-exist"
 	metadataPodStartTime = "k8s.pod.start_time"
 	specPodHostName      = "k8s.pod.hostname"
+	metadataWorkloadKind = "k8s.workload.kind"
+	metadataWorkloadName = "k8s.workload.name"
+	metadataWorkloadUID  = "k8s.workload.uid"
 )
 
 // option represents a configuration option that can be passes.
@@ -65,6 +68,9 @@ func enabledAttributes() (attributes []string) {
 	if defaultConfig.K8sCronjobName.Enabled {
 		attributes = append(attributes, conventions.AttributeK8SCronJobName)
 	}
+	if defaultConfig.K8sCronjobUID.Enabled {
+		attributes = append(attributes, conventions.AttributeK8SCronJobUID)
+	}
 	if defaultConfig.K8sDaemonsetName.Enabled {
 		attributes = append(attributes, conventions.AttributeK8SDaemonSetName)
 	}
@@ -113,6 +119,15 @@ func enabledAttributes() (attributes []string) {
 	if defaultConfig.K8sStatefulsetUID.Enabled {
 		attributes = append(attributes, conventions.AttributeK8SStatefulSetUID)
 	}
+	if defaultConfig.K8sWorkloadKind.Enabled {
+		attributes = append(attributes, metadataWorkloadKind)
+	}
+	if defaultConfig.K8sWorkloadName.Enabled {
+		attributes = append(attributes, metadataWorkloadName)
+	}
+	if defaultConfig.K8sWorkloadUID.Enabled {
+		attributes = append(attributes, metadataWorkloadUID)
+	}
 	return
 }
 
@@ -151,6 +166,12 @@ func withExtractMetadata(fields ...string) option {
 				p.rules.StatefulSetName = true
 			case conventions.AttributeK8SStatefulSetUID:
 				p.rules.StatefulSetUID = true
+			case metadataWorkloadKind:
+				p.rules.WorkloadKind = true
+			case metadataWorkloadName:
+				p.rules.WorkloadName = true
+			case metadataWorkloadUID:
+				p.rules.WorkloadUID = true
 			case conventions.AttributeK8SContainerName:
 				p.rules.ContainerName = true
 			case conventions.AttributeK8SJobName:
@@ -159,6 +180,8 @@ func withExtractMetadata(fields ...string) option {
 				p.rules.JobUID = true
 			case conventions.AttributeK8SCronJobName:
 				p.rules.CronJobName = true
+			case conventions.AttributeK8SCronJobUID:
+				p.rules.CronJobUID = true
 			case conventions.AttributeK8SNodeName:
 				p.rules.Node = true
 			case conventions.AttributeContainerID:
@@ -210,8 +233,10 @@ func extractFieldRules(fieldType string, fields ...FieldExtractConfig) ([]kube.F
 			a.From = kube.MetadataFromPod
 		case kube.MetadataFromNamespace:
 			a.From = kube.MetadataFromNamespace
+		case kube.MetadataFromWorkload:
+			a.From = kube.MetadataFromWorkload
 		default:
-			return rules, fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace", a.From)
+			return rules, fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, workload", a.From)
 		}
 
 		if name == "" && a.Key != "" {
@@ -220,6 +245,8 @@ func extractFieldRules(fieldType string, fields ...FieldExtractConfig) ([]kube.F
 				name = fmt.Sprintf("k8s.pod.%s.%s", fieldType, a.Key)
 			} else if a.From == kube.MetadataFromNamespace {
 				name = fmt.Sprintf("k8s.namespace.%s.%s", fieldType, a.Key)
+			} else if a.From == kube.MetadataFromWorkload {
+				name = fmt.Sprintf("k8s.workload.%s.%s", fieldType, a.Key)
 			}
 		}
 
@@ -385,3 +412,27 @@ func withExcludes(podExclude ExcludeConfig) option {
 		return nil
 	}
 }
+
+// withOwnerLookup allows specifying how the chain of owners of pods is resolved.
+func withOwnerLookup(cfg OwnerLookupConfig) option {
+	return func(p *kubernetesprocessor) error {
+		p.ownerLookup = kube.OwnerLookup{
+			Enabled:         cfg.Enabled,
+			MaxDepth:        cfg.MaxDepth,
+			CacheTTL:        cfg.CacheTTL,
+			MaxCacheEntries: cfg.MaxCacheEntries,
+		}
+		return nil
+	}
+}
+
+// withInformers allows specifying how the kubernetes objects are watched.
+func withInformers(cfg InformerConfig) option {
+	return func(p *kubernetesprocessor) error {
+		p.informers = kube.Informers{
+			MetadataOnly: cfg.MetadataOnly,
+			MemoryLimit:  int64(cfg.MemoryLimitMiB) * 1024 * 1024,
+		}
+		return nil
+	}
+}
@@ -6,6 +6,7 @@ package k8sattributesprocessor
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -252,6 +253,23 @@ func TestWithExtractLabels(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"basic-workload",
+			[]FieldExtractConfig{
+				{
+					Key:  "app.kubernetes.io/part-of",
+					From: kube.MetadataFromWorkload,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name: "k8s.workload.labels.app.kubernetes.io/part-of",
+					Key:  "app.kubernetes.io/part-of",
+					From: kube.MetadataFromWorkload,
+				},
+			},
+			"",
+		},
 		{
 			"basic-pod-keyregex",
 			[]FieldExtractConfig{
@@ -329,6 +347,19 @@ func TestWithExtractMetadata(t *testing.T) {
 	assert.False(t, p.rules.StartTime)
 	assert.False(t, p.rules.DeploymentName)
 	assert.False(t, p.rules.Node)
+
+	p = &kubernetesprocessor{}
+	assert.NoError(t, withExtractMetadata("k8s.workload.kind", "k8s.workload.name", "k8s.workload.uid")(p))
+	assert.True(t, p.rules.WorkloadKind)
+	assert.True(t, p.rules.WorkloadName)
+	assert.True(t, p.rules.WorkloadUID)
+	assert.False(t, p.rules.DeploymentName)
+
+	p = &kubernetesprocessor{}
+	assert.NoError(t, withExtractMetadata(conventions.AttributeK8SCronJobName, conventions.AttributeK8SCronJobUID)(p))
+	assert.True(t, p.rules.CronJobName)
+	assert.True(t, p.rules.CronJobUID)
+	assert.False(t, p.rules.JobName)
 }
 
 func TestWithFilterLabels(t *testing.T) {
@@ -794,3 +825,25 @@ func TestWithExcludes(t *testing.T) {
 		})
 	}
 }
+
+func TestWithOwnerLookup(t *testing.T) {
+	p := &kubernetesprocessor{}
+	assert.NoError(t, withOwnerLookup(OwnerLookupConfig{
+		Enabled:         true,
+		MaxDepth:        3,
+		CacheTTL:        time.Minute,
+		MaxCacheEntries: 100,
+	})(p))
+	assert.Equal(t, kube.OwnerLookup{
+		Enabled:         true,
+		MaxDepth:        3,
+		CacheTTL:        time.Minute,
+		MaxCacheEntries: 100,
+	}, p.ownerLookup)
+}
+
+func TestWithInformers(t *testing.T) {
+	p := &kubernetesprocessor{}
+	assert.NoError(t, withInformers(InformerConfig{MetadataOnly: true, MemoryLimitMiB: 64})(p))
+	assert.Equal(t, kube.Informers{MetadataOnly: true, MemoryLimit: 64 * 1024 * 1024}, p.informers)
+}
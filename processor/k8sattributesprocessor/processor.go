@@ -33,6 +33,8 @@ type kubernetesprocessor struct {
 	filters         kube.Filters
 	podAssociations []kube.Association
 	podIgnore       kube.Excludes
+	ownerLookup     kube.OwnerLookup
+	informers       kube.Informers
 }
 
 func (kp *kubernetesprocessor) initKubeClient(logger *zap.Logger, kubeClient kube.ClientProvider) error {
@@ -40,7 +42,7 @@ func (kp *kubernetesprocessor) initKubeClient(logger *zap.Logger, kubeClient kub
 		kubeClient = kube.New
 	}
 	if !kp.passthroughMode {
-		kc, err := kubeClient(logger, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, nil, nil, nil)
+		kc, err := kubeClient(logger, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, kp.ownerLookup, kp.informers, nil, nil, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// MatchFieldBody compares the bodies of the log records.
+	MatchFieldBody = "body"
+	// MatchFieldSeverity compares the severity numbers and texts of the log records.
+	MatchFieldSeverity = "severity"
+	// MatchFieldAttributes compares all the attributes of the log records.
+	MatchFieldAttributes = "attributes"
+	// matchFieldAttributePrefix compares a single attribute of the log records.
+	matchFieldAttributePrefix = "attributes."
+
+	defaultInterval       = 10 * time.Second
+	defaultMaxEntries     = 10000
+	defaultCountAttribute = "dedup.count"
+)
+
+// Config defines the configuration for the log deduplication processor.
+type Config struct {
+	// Interval is the time window in which the identical log records are aggregated.
+	// The aggregated records are emitted at the end of the window.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// MatchFields are the fields compared to find the identical log records, among
+	// `body`, `severity`, `attributes` and `attributes.<key>`. The log records of
+	// different resources or instrumentation scopes are never aggregated.
+	MatchFields []string `mapstructure:"match_fields"`
+
+	// FuzzyBody replaces the numbers, UUIDs and hexadecimal identifiers of the body
+	// before it is compared, so that the records differing only by them are aggregated.
+	FuzzyBody bool `mapstructure:"fuzzy_body"`
+
+	// CountAttribute is the attribute holding the number of aggregated records.
+	CountAttribute string `mapstructure:"count_attribute"`
+
+	// MaxEntries is the maximum number of distinct records held in a window. Once reached,
+	// the aggregated records are emitted before the end of the window.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if len(cfg.MatchFields) == 0 {
+		return errors.New("match_fields must not be empty")
+	}
+	for _, field := range cfg.MatchFields {
+		switch {
+		case field == MatchFieldBody, field == MatchFieldSeverity, field == MatchFieldAttributes:
+		case strings.HasPrefix(field, matchFieldAttributePrefix) && len(field) > len(matchFieldAttributePrefix):
+		default:
+			return fmt.Errorf("unsupported match field %q, must be one of %q, %q, %q or %q",
+				field, MatchFieldBody, MatchFieldSeverity, MatchFieldAttributes, matchFieldAttributePrefix+"<key>")
+		}
+	}
+	if cfg.CountAttribute == "" {
+		return errors.New("count_attribute must not be empty")
+	}
+	if cfg.MaxEntries <= 0 {
+		return errors.New("max_entries must be positive")
+	}
+	return nil
+}
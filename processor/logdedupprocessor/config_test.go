@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Interval:       30 * time.Second,
+				MatchFields:    []string{"body", "attributes.k8s.pod.name"},
+				FuzzyBody:      true,
+				CountAttribute: "log.repeat_count",
+				MaxEntries:     5000,
+			},
+		},
+		{
+			id:       component.NewIDWithName(metadata.Type, "defaults"),
+			expected: createDefaultConfig(),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_interval"),
+			errorMessage: "interval must be positive",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_match_fields"),
+			errorMessage: "match_fields must not be empty",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_match_field"),
+			errorMessage: `unsupported match field "trace_id", must be one of "body", "severity", "attributes" or "attributes.<key>"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_count_attribute"),
+			errorMessage: "count_attribute must not be empty",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_max_entries"),
+			errorMessage: "max_entries must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
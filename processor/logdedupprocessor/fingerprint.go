@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// The variable parts of the bodies replaced before they are compared, in order.
+var (
+	uuidRegex   = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	hexRegex    = regexp.MustCompile(`\b(?:0[xX][0-9a-fA-F]+|[0-9a-fA-F]{8,})\b`)
+	numberRegex = regexp.MustCompile(`\d+(?:\.\d+)?`)
+)
+
+// fingerprint identifies the log records aggregated together.
+type fingerprint [16]byte
+
+// fingerprinter computes the fingerprints of log records from the configured match fields.
+type fingerprinter struct {
+	body          bool
+	severity      bool
+	attributes    bool
+	attributeKeys []string
+	fuzzyBody     bool
+}
+
+func newFingerprinter(cfg *Config) *fingerprinter {
+	f := &fingerprinter{fuzzyBody: cfg.FuzzyBody}
+	for _, field := range cfg.MatchFields {
+		switch field {
+		case MatchFieldBody:
+			f.body = true
+		case MatchFieldSeverity:
+			f.severity = true
+		case MatchFieldAttributes:
+			f.attributes = true
+		default:
+			f.attributeKeys = append(f.attributeKeys, strings.TrimPrefix(field, matchFieldAttributePrefix))
+		}
+	}
+	return f
+}
+
+// fingerprint returns the fingerprint of the log record of the given resource and scope.
+func (f *fingerprinter) fingerprint(resource, scope [16]byte, lr plog.LogRecord) fingerprint {
+	h := fnv.New128a()
+	_, _ = h.Write(resource[:])
+	_, _ = h.Write(scope[:])
+	if f.severity {
+		_ = binary.Write(h, binary.LittleEndian, int32(lr.SeverityNumber()))
+		writeString(h, lr.SeverityText())
+	}
+	if f.body {
+		writeValue(h, lr.Body(), f.fuzzyBody)
+	}
+	if f.attributes {
+		attrs := pdatautil.MapHash(lr.Attributes())
+		_, _ = h.Write(attrs[:])
+	}
+	for _, key := range f.attributeKeys {
+		if v, ok := lr.Attributes().Get(key); ok {
+			_, _ = h.Write([]byte{1})
+			writeValue(h, v, false)
+		} else {
+			_, _ = h.Write([]byte{0})
+		}
+	}
+	var fp fingerprint
+	h.Sum(fp[:0])
+	return fp
+}
+
+// scopeHash returns the hash identifying an instrumentation scope.
+func scopeHash(scope pcommon.InstrumentationScope) [16]byte {
+	h := fnv.New128a()
+	writeString(h, scope.Name())
+	writeString(h, scope.Version())
+	attrs := pdatautil.MapHash(scope.Attributes())
+	_, _ = h.Write(attrs[:])
+	var sum [16]byte
+	h.Sum(sum[:0])
+	return sum
+}
+
+// normalizeBody replaces the UUIDs, hexadecimal identifiers and numbers of the body with placeholders.
+func normalizeBody(body string) string {
+	body = uuidRegex.ReplaceAllString(body, "<uuid>")
+	body = hexRegex.ReplaceAllString(body, "<hex>")
+	return numberRegex.ReplaceAllString(body, "<num>")
+}
+
+func writeValue(h hash.Hash, v pcommon.Value, fuzzy bool) {
+	_, _ = h.Write([]byte{byte(v.Type())})
+	s := v.AsString()
+	if fuzzy {
+		s = normalizeBody(s)
+	}
+	writeString(h, s)
+}
+
+// writeString writes the length of the string before it, so that the consecutive strings
+// cannot be confused with each other.
+func writeString(h hash.Hash, s string) {
+	_ = binary.Write(h, binary.LittleEndian, uint64(len(s)))
+	_, _ = h.Write([]byte(s))
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestNormalizeBody(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected string
+	}{
+		{
+			body:     "connection refused after 3 retries",
+			expected: "connection refused after <num> retries",
+		},
+		{
+			body:     "request 6f1c2a9e-0b7d-4c4e-9a51-2b8e3f0d1c7a failed in 12.5ms",
+			expected: "request <uuid> failed in <num>ms",
+		},
+		{
+			body:     "segfault at 0x7ffd3a2b in container 3f9a1b2c4d5e",
+			expected: "segfault at <hex> in container <hex>",
+		},
+		{
+			body:     "panic: nil pointer dereference",
+			expected: "panic: nil pointer dereference",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.body, func(t *testing.T) {
+			assert.Equal(t, tt.expected, normalizeBody(tt.body))
+		})
+	}
+}
+
+func newRecord(body string, severity plog.SeverityNumber, attrs map[string]interface{}) plog.LogRecord {
+	lr := plog.NewLogRecord()
+	lr.Body().SetStr(body)
+	lr.SetSeverityNumber(severity)
+	_ = lr.Attributes().FromRaw(attrs)
+	return lr
+}
+
+func TestFingerprint(t *testing.T) {
+	var resource, otherResource, scope [16]byte
+	otherResource[0] = 1
+
+	base := newRecord("restarting in 5s", plog.SeverityNumberError, map[string]interface{}{"pod": "a", "attempt": 1})
+	tests := []struct {
+		name        string
+		matchFields []string
+		fuzzyBody   bool
+		resource    [16]byte
+		record      plog.LogRecord
+		other       plog.LogRecord
+		equal       bool
+	}{
+		{
+			name:        "identical",
+			matchFields: []string{MatchFieldBody, MatchFieldSeverity, MatchFieldAttributes},
+			other:       newRecord("restarting in 5s", plog.SeverityNumberError, map[string]interface{}{"attempt": 1, "pod": "a"}),
+			equal:       true,
+		},
+		{
+			name:        "other resource",
+			matchFields: []string{MatchFieldBody},
+			resource:    otherResource,
+			other:       newRecord("restarting in 5s", plog.SeverityNumberError, nil),
+		},
+		{
+			name:        "other body",
+			matchFields: []string{MatchFieldBody},
+			other:       newRecord("restarting in 10s", plog.SeverityNumberError, nil),
+		},
+		{
+			name:        "fuzzy body",
+			matchFields: []string{MatchFieldBody},
+			fuzzyBody:   true,
+			other:       newRecord("restarting in 10s", plog.SeverityNumberError, nil),
+			equal:       true,
+		},
+		{
+			name:        "other severity",
+			matchFields: []string{MatchFieldBody, MatchFieldSeverity},
+			other:       newRecord("restarting in 5s", plog.SeverityNumberWarn, nil),
+		},
+		{
+			name:        "severity ignored",
+			matchFields: []string{MatchFieldBody},
+			other:       newRecord("restarting in 5s", plog.SeverityNumberWarn, nil),
+			equal:       true,
+		},
+		{
+			name:        "other attributes",
+			matchFields: []string{MatchFieldAttributes},
+			other:       newRecord("restarting in 5s", plog.SeverityNumberError, map[string]interface{}{"pod": "a", "attempt": 2}),
+		},
+		{
+			name:        "selected attribute",
+			matchFields: []string{MatchFieldBody, "attributes.pod"},
+			other:       newRecord("restarting in 5s", plog.SeverityNumberError, map[string]interface{}{"pod": "a", "attempt": 2}),
+			equal:       true,
+		},
+		{
+			name:        "missing attribute",
+			matchFields: []string{"attributes.pod"},
+			record:      newRecord("restarting in 5s", plog.SeverityNumberError, nil),
+			other:       newRecord("restarting in 5s", plog.SeverityNumberError, map[string]interface{}{"pod": ""}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFingerprinter(&Config{MatchFields: tt.matchFields, FuzzyBody: tt.fuzzyBody})
+			record := base
+			if tt.record != (plog.LogRecord{}) {
+				record = tt.record
+			}
+			fp := f.fingerprint(resource, scope, record)
+			otherFP := f.fingerprint(tt.resource, scope, tt.other)
+			if tt.equal {
+				assert.Equal(t, fp, otherFP)
+			} else {
+				assert.NotEqual(t, fp, otherFP)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// groupKey identifies the resource and the instrumentation scope of log records.
+type groupKey struct {
+	resource [16]byte
+	scope    [16]byte
+}
+
+// group holds the aggregated log records of a resource and an instrumentation scope.
+type group struct {
+	key               groupKey
+	resource          pcommon.Resource
+	resourceSchemaURL string
+	scope             pcommon.InstrumentationScope
+	scopeSchemaURL    string
+	entries           []*entry
+}
+
+// entry is an aggregated log record: the first record received and the number of
+// identical records received in the window, including the first one.
+type entry struct {
+	record plog.LogRecord
+	count  int64
+}
+
+// dedupProcessor aggregates the identical log records received within a window and
+// emits them to the next consumer at the end of the window.
+type dedupProcessor struct {
+	logger      *zap.Logger
+	cfg         *Config
+	fingerprint *fingerprinter
+	next        consumer.Logs
+
+	mu      sync.Mutex
+	entries map[fingerprint]*entry
+	groups  map[groupKey]*group
+	// order holds the groups in the order they were received, so that they are emitted in order.
+	order []*group
+
+	startOnce    sync.Once
+	shutdownOnce sync.Once
+	shutdownC    chan struct{}
+	done         chan struct{}
+}
+
+func newDedupProcessor(logger *zap.Logger, cfg *Config, next consumer.Logs) *dedupProcessor {
+	return &dedupProcessor{
+		logger:      logger,
+		cfg:         cfg,
+		fingerprint: newFingerprinter(cfg),
+		next:        next,
+		entries:     map[fingerprint]*entry{},
+		groups:      map[groupKey]*group{},
+		shutdownC:   make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+func (p *dedupProcessor) Start(context.Context, component.Host) error {
+	p.startOnce.Do(func() {
+		go p.flushLoop()
+	})
+	return nil
+}
+
+// Shutdown stops the flush loop and emits the aggregated records.
+func (p *dedupProcessor) Shutdown(ctx context.Context) error {
+	started := true
+	p.startOnce.Do(func() { started = false })
+	p.shutdownOnce.Do(func() { close(p.shutdownC) })
+	if !started {
+		p.flush()
+		return nil
+	}
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Capabilities reports that the processor does not mutate the received data, which is copied.
+func (p *dedupProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeLogs aggregates the log records with the records received earlier in the window.
+// When the maximum number of distinct records is reached, the aggregated records are
+// emitted before the end of the window.
+func (p *dedupProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	var full []plog.Logs
+
+	p.mu.Lock()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resourceKey := pdatautil.MapHash(rl.Resource().Attributes())
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			key := groupKey{resource: resourceKey, scope: scopeHash(sl.Scope())}
+			lrs := sl.LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lr := lrs.At(k)
+				fp := p.fingerprint.fingerprint(key.resource, key.scope, lr)
+				if e, ok := p.entries[fp]; ok {
+					e.count++
+					continue
+				}
+				if len(p.entries) >= p.cfg.MaxEntries {
+					full = append(full, p.drain())
+				}
+				p.add(fp, key, rl, sl, lr)
+			}
+		}
+	}
+	p.mu.Unlock()
+
+	var errs error
+	for _, logs := range full {
+		errs = multierr.Append(errs, p.next.ConsumeLogs(ctx, logs))
+	}
+	return errs
+}
+
+// add adds a new aggregated record, p.mu must be held.
+func (p *dedupProcessor) add(fp fingerprint, key groupKey, rl plog.ResourceLogs, sl plog.ScopeLogs, lr plog.LogRecord) {
+	g, ok := p.groups[key]
+	if !ok {
+		g = &group{
+			key:               key,
+			resource:          pcommon.NewResource(),
+			resourceSchemaURL: rl.SchemaUrl(),
+			scope:             pcommon.NewInstrumentationScope(),
+			scopeSchemaURL:    sl.SchemaUrl(),
+		}
+		rl.Resource().CopyTo(g.resource)
+		sl.Scope().CopyTo(g.scope)
+		p.groups[key] = g
+		p.order = append(p.order, g)
+	}
+	e := &entry{record: plog.NewLogRecord(), count: 1}
+	lr.CopyTo(e.record)
+	g.entries = append(g.entries, e)
+	p.entries[fp] = e
+}
+
+// drain returns the aggregated records with their count and starts a new window, p.mu must be held.
+func (p *dedupProcessor) drain() plog.Logs {
+	ld := plog.NewLogs()
+	resources := map[[16]byte]plog.ResourceLogs{}
+	for _, g := range p.order {
+		rl, ok := resources[g.key.resource]
+		if !ok {
+			rl = ld.ResourceLogs().AppendEmpty()
+			g.resource.CopyTo(rl.Resource())
+			rl.SetSchemaUrl(g.resourceSchemaURL)
+			resources[g.key.resource] = rl
+		}
+		sl := rl.ScopeLogs().AppendEmpty()
+		g.scope.CopyTo(sl.Scope())
+		sl.SetSchemaUrl(g.scopeSchemaURL)
+		lrs := sl.LogRecords()
+		lrs.EnsureCapacity(len(g.entries))
+		for _, e := range g.entries {
+			lr := lrs.AppendEmpty()
+			e.record.CopyTo(lr)
+			lr.Attributes().PutInt(p.cfg.CountAttribute, e.count)
+		}
+	}
+	p.entries = map[fingerprint]*entry{}
+	p.groups = map[groupKey]*group{}
+	p.order = nil
+	return ld
+}
+
+func (p *dedupProcessor) flushLoop() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.flush()
+		case <-p.shutdownC:
+			p.flush()
+			return
+		}
+	}
+}
+
+// flush emits the aggregated records to the next consumer.
+func (p *dedupProcessor) flush() {
+	p.mu.Lock()
+	if len(p.entries) == 0 {
+		p.mu.Unlock()
+		return
+	}
+	ld := p.drain()
+	p.mu.Unlock()
+
+	if err := p.next.ConsumeLogs(context.Background(), ld); err != nil {
+		p.logger.Warn("Failed to send the aggregated log records", zap.Error(err), zap.Int("log_records", ld.LogRecordCount()))
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Interval = time.Hour
+	return cfg
+}
+
+// newLogs returns logs with a record for each body, all of the given pod.
+func newLogs(pod string, bodies ...string) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("k8s.pod.name", pod)
+	lrs := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range bodies {
+		lr := lrs.AppendEmpty()
+		lr.Body().SetStr(body)
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+	}
+	return ld
+}
+
+// counts returns the count attribute of the emitted records by pod and body.
+func counts(t *testing.T, sink *consumertest.LogsSink) map[string]map[string]int64 {
+	out := map[string]map[string]int64{}
+	for _, ld := range sink.AllLogs() {
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			rl := ld.ResourceLogs().At(i)
+			pod, ok := rl.Resource().Attributes().Get("k8s.pod.name")
+			require.True(t, ok)
+			if out[pod.Str()] == nil {
+				out[pod.Str()] = map[string]int64{}
+			}
+			lrs := rl.ScopeLogs().At(0).LogRecords()
+			for j := 0; j < lrs.Len(); j++ {
+				count, ok := lrs.At(j).Attributes().Get(defaultCountAttribute)
+				require.True(t, ok)
+				out[pod.Str()][lrs.At(j).Body().Str()] += count.Int()
+			}
+		}
+	}
+	return out
+}
+
+func TestConsumeLogs(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newDedupProcessor(zap.NewNop(), newTestConfig(), sink)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs("api-0", "crash", "crash", "restarting")))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs("api-0", "crash")))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs("api-1", "crash")))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 3, sink.LogRecordCount())
+	assert.Equal(t, map[string]map[string]int64{
+		"api-0": {"crash": 3, "restarting": 1},
+		"api-1": {"crash": 1},
+	}, counts(t, sink))
+
+	// the records of a resource are emitted together, in the order they were received
+	ld := sink.AllLogs()[0]
+	require.Equal(t, 2, ld.ResourceLogs().Len())
+	lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+	assert.Equal(t, "crash", lrs.At(0).Body().Str())
+	assert.Equal(t, "restarting", lrs.At(1).Body().Str())
+}
+
+func TestConsumeLogsFuzzyBody(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	cfg := newTestConfig()
+	cfg.FuzzyBody = true
+	p := newDedupProcessor(zap.NewNop(), cfg, sink)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs("api-0", "restart 1 of 5", "restart 2 of 5", "restart 3 of 5")))
+	require.NoError(t, p.Shutdown(context.Background()))
+	// the first record is emitted
+	assert.Equal(t, map[string]map[string]int64{"api-0": {"restart 1 of 5": 3}}, counts(t, sink))
+}
+
+func TestConsumeLogsMaxEntries(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	cfg := newTestConfig()
+	cfg.MaxEntries = 2
+	p := newDedupProcessor(zap.NewNop(), cfg, sink)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs("api-0", "a", "b", "a", "c")))
+	// the window is emitted early when a third distinct record is received
+	assert.Equal(t, map[string]map[string]int64{"api-0": {"a": 2, "b": 1}}, counts(t, sink))
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, map[string]map[string]int64{"api-0": {"a": 2, "b": 1, "c": 1}}, counts(t, sink))
+}
+
+func TestConsumeLogsMaxEntriesError(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.MaxEntries = 1
+	p := newDedupProcessor(zap.NewNop(), cfg, consumertest.NewErr(errors.New("unavailable")))
+
+	assert.EqualError(t, p.ConsumeLogs(context.Background(), newLogs("api-0", "a", "b")), "unavailable")
+}
+
+func TestFlushInterval(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	cfg := newTestConfig()
+	cfg.Interval = 10 * time.Millisecond
+	p := newDedupProcessor(zap.NewNop(), cfg, sink)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs("api-0", "crash", "crash")))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, time.Millisecond)
+	assert.Equal(t, map[string]map[string]int64{"api-0": {"crash": 2}}, counts(t, sink))
+
+	// a new window starts after the records are emitted
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs("api-0", "crash")))
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 2, sink.LogRecordCount())
+	assert.Equal(t, map[string]map[string]int64{"api-0": {"crash": 3}}, counts(t, sink))
+}
+
+func TestConsumeLogsDoesNotMutate(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newDedupProcessor(zap.NewNop(), newTestConfig(), sink)
+
+	ld := newLogs("api-0", "crash")
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 0, ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Len())
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// overflowAttribute is the attribute of the series aggregating the data points exceeding the cardinality limit.
+const overflowAttribute = "otel.overflow"
+
+// cardinalityLimiter tracks the attribute sets of each metric within a window,
+// and moves the data points of the attribute sets over the limit to the overflow series.
+type cardinalityLimiter struct {
+	limit  int
+	window time.Duration
+	now    func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	// series holds the attribute set hashes of each metric, keyed by metric identity.
+	series map[metricIdentity]map[[16]byte]struct{}
+}
+
+// metricIdentity identifies a metric of a resource.
+type metricIdentity struct {
+	resource [16]byte
+	name     string
+}
+
+// newCardinalityLimiter returns a limiter, or nil if the limit is disabled.
+func newCardinalityLimiter(cfg CardinalityLimitConfig) *cardinalityLimiter {
+	if cfg.Limit <= 0 {
+		return nil
+	}
+	return &cardinalityLimiter{
+		limit:  cfg.Limit,
+		window: cfg.Window,
+		now:    time.Now,
+		series: map[metricIdentity]map[[16]byte]struct{}{},
+	}
+}
+
+// limitMetrics applies the cardinality limit to every metric of md. A nil limiter is a no-op.
+func (cl *cardinalityLimiter) limitMetrics(md pmetric.Metrics) {
+	if cl == nil {
+		return
+	}
+
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	if now := cl.now(); now.Sub(cl.windowStart) >= cl.window {
+		cl.windowStart = now
+		cl.series = map[metricIdentity]map[[16]byte]struct{}{}
+	}
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resource := pdatautil.MapHash(rm.Resource().Attributes())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				cl.limitMetric(metricIdentity{resource: resource, name: metrics.At(k).Name()}, metrics.At(k))
+			}
+		}
+	}
+}
+
+func (cl *cardinalityLimiter) limitMetric(id metricIdentity, metric pmetric.Metric) {
+	// the quantiles of summaries cannot be aggregated
+	if metric.Type() == pmetric.MetricTypeSummary {
+		return
+	}
+
+	seen, ok := cl.series[id]
+	if !ok {
+		seen = map[[16]byte]struct{}{}
+		cl.series[id] = seen
+	}
+
+	overflow := false
+	rangeDataPointAttributes(metric, func(attrs pcommon.Map) bool {
+		hash := pdatautil.MapHash(attrs)
+		if _, ok := seen[hash]; ok {
+			return true
+		}
+		// keep room for the overflow series
+		if len(seen) < cl.limit-1 {
+			seen[hash] = struct{}{}
+			return true
+		}
+		attrs.Clear()
+		attrs.PutStr(overflowAttribute, "true")
+		overflow = true
+		return true
+	})
+	if !overflow {
+		return
+	}
+
+	// the data points of the overflow series sharing a timestamp are summed up
+	newMetric := pmetric.NewMetric()
+	copyMetricDetails(metric, newMetric)
+	ag := groupDataPoints(metric, aggGroups{})
+	mergeDataPoints(newMetric, Sum, ag)
+	newMetric.MoveTo(metric)
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricstransformprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+// sumMetrics returns metrics with a cumulative sum data point of value 1 for each of the provided label values.
+func sumMetrics(host string, values ...string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("host.name", host)
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	m.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	for _, v := range values {
+		dp := m.Sum().DataPoints().AppendEmpty()
+		dp.SetTimestamp(1)
+		dp.SetIntValue(1)
+		if v == overflowAttribute {
+			dp.Attributes().PutStr(overflowAttribute, "true")
+			continue
+		}
+		dp.Attributes().PutStr("path", v)
+	}
+	return md
+}
+
+func TestCardinalityLimiterDisabled(t *testing.T) {
+	assert.Nil(t, newCardinalityLimiter(CardinalityLimitConfig{Window: time.Minute}))
+
+	var cl *cardinalityLimiter
+	md := sumMetrics("host", "a", "b", "c")
+	cl.limitMetrics(md)
+	require.NoError(t, pmetrictest.CompareMetrics(sumMetrics("host", "a", "b", "c"), md))
+}
+
+func TestCardinalityLimiter(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cl := newCardinalityLimiter(CardinalityLimitConfig{Limit: 3, Window: time.Minute})
+	cl.now = func() time.Time { return now }
+
+	md := sumMetrics("host", "a", "b", "c", "d")
+	cl.limitMetrics(md)
+	expected := sumMetrics("host", "a", "b", overflowAttribute)
+	expected.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(2).SetIntValue(2)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, md, pmetrictest.IgnoreMetricDataPointsOrder()))
+
+	// the series seen within the window are still accepted, the new ones overflow
+	now = now.Add(30 * time.Second)
+	md = sumMetrics("host", "b", "e")
+	cl.limitMetrics(md)
+	require.NoError(t, pmetrictest.CompareMetrics(sumMetrics("host", "b", overflowAttribute), md, pmetrictest.IgnoreMetricDataPointsOrder()))
+
+	// the limit applies to each resource
+	md = sumMetrics("other", "c", "d")
+	cl.limitMetrics(md)
+	require.NoError(t, pmetrictest.CompareMetrics(sumMetrics("other", "c", "d"), md, pmetrictest.IgnoreMetricDataPointsOrder()))
+
+	// the series are forgotten once the window elapsed
+	now = now.Add(30 * time.Second)
+	md = sumMetrics("host", "e", "f")
+	cl.limitMetrics(md)
+	require.NoError(t, pmetrictest.CompareMetrics(sumMetrics("host", "e", "f"), md, pmetrictest.IgnoreMetricDataPointsOrder()))
+}
+
+func TestCardinalityLimiterHistogram(t *testing.T) {
+	cl := newCardinalityLimiter(CardinalityLimitConfig{Limit: 1, Window: time.Minute})
+
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for _, v := range []string{"a", "b"} {
+		dp := m.Histogram().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("path", v)
+		dp.SetTimestamp(1)
+		dp.SetCount(3)
+		dp.SetSum(6)
+		dp.ExplicitBounds().FromRaw([]float64{1, 2})
+		dp.BucketCounts().FromRaw([]uint64{1, 1, 1})
+	}
+	cl.limitMetrics(md)
+
+	require.Equal(t, 1, m.Histogram().DataPoints().Len())
+	dp := m.Histogram().DataPoints().At(0)
+	assert.Equal(t, map[string]interface{}{overflowAttribute: "true"}, dp.Attributes().AsRaw())
+	assert.Equal(t, uint64(6), dp.Count())
+	assert.Equal(t, float64(12), dp.Sum())
+	assert.Equal(t, []uint64{2, 2, 2}, dp.BucketCounts().AsRaw())
+}
+
+func TestCardinalityLimiterSummary(t *testing.T) {
+	cl := newCardinalityLimiter(CardinalityLimitConfig{Limit: 1, Window: time.Minute})
+
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetEmptySummary()
+	m.Summary().DataPoints().AppendEmpty().Attributes().PutStr("path", "a")
+	m.Summary().DataPoints().AppendEmpty().Attributes().PutStr("path", "b")
+	expected := pmetric.NewMetrics()
+	md.CopyTo(expected)
+
+	cl.limitMetrics(md)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, md))
+}
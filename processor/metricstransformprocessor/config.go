@@ -3,6 +3,8 @@
 
 package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
 
+import "time"
+
 const (
 	// IncludeFieldName is the mapstructure field name for Include field
 	IncludeFieldName = "include"
@@ -36,6 +38,18 @@ const (
 
 	// SubmatchCaseFieldName is the mapstructure field name for SubmatchCase field
 	SubmatchCaseFieldName = "submatch_case"
+
+	// CardinalityLimitFieldName is the mapstructure field name for CardinalityLimit field
+	CardinalityLimitFieldName = "cardinality_limit"
+
+	// OperandMetricFieldName is the mapstructure field name for OperandMetric field
+	OperandMetricFieldName = "operand_metric"
+
+	// OperatorFieldName is the mapstructure field name for Operator field
+	OperatorFieldName = "operator"
+
+	// ValueRegexpFieldName is the mapstructure field name for ValueRegexp field
+	ValueRegexpFieldName = "value_regexp"
 )
 
 // Config defines configuration for Resource processor.
@@ -43,6 +57,22 @@ type Config struct {
 
 	// Transform specifies a list of transforms on metrics with each transform focusing on one metric.
 	Transforms []Transform `mapstructure:"transforms"`
+
+	// CardinalityLimit limits the number of attribute sets of each metric within a window.
+	CardinalityLimit CardinalityLimitConfig `mapstructure:"cardinality_limit"`
+}
+
+// CardinalityLimitConfig defines the number of distinct attribute sets each metric can have within a window.
+// Once the limit is reached, the data points of new attribute sets are aggregated into a single overflow series
+// with the `otel.overflow="true"` attribute. As for the cardinality limits of the SDKs, the overflow series
+// counts towards the limit.
+type CardinalityLimitConfig struct {
+	// Limit is the maximum number of series of each metric, including the overflow series.
+	// The limit is disabled when 0.
+	Limit int `mapstructure:"limit"`
+
+	// Window is the duration after which the series of all the metrics are forgotten.
+	Window time.Duration `mapstructure:"window"`
 }
 
 // Transform defines the transformation applied to the specific metric
@@ -80,6 +110,14 @@ type Transform struct {
 	// SubmatchCase specifies what case to use for label values created from regexp submatches.
 	SubmatchCase SubmatchCase `mapstructure:"submatch_case"`
 
+	// OperandMetric specifies the name of the metric used as the second operand of the calculation.
+	// REQUIRED only if Action is CALCULATE.
+	OperandMetric string `mapstructure:"operand_metric"`
+
+	// Operator specifies the arithmetic operation applied to the matched metric and the operand metric.
+	// REQUIRED only if Action is CALCULATE.
+	Operator Operator `mapstructure:"operator"`
+
 	// Operations contains a list of operations that will be performed on the resulting metric(s).
 	Operations []Operation `mapstructure:"operations"`
 }
@@ -123,6 +161,11 @@ type Operation struct {
 	// ValueActions is a list of renaming actions for label values.
 	ValueActions []ValueAction `mapstructure:"value_actions"`
 
+	// ValueRegexp is a regular expression matching the whole label values to rewrite to NewValue,
+	// which can reference the capturing groups of the regular expression, e.g. $1.
+	// The ValueActions take precedence over it.
+	ValueRegexp string `mapstructure:"value_regexp"`
+
 	// Scale is a scalar to multiply the values with.
 	Scale float64 `mapstructure:"experimental_scale"`
 
@@ -154,9 +197,13 @@ const (
 
 	// Group groups mutiple metrics matching the predicate into multiple ResourceMetrics messages
 	Group ConfigAction = "group"
+
+	// Calculate inserts a new metric computed from the data points of a metric and of the operand metric
+	// with the same attributes.
+	Calculate ConfigAction = "calculate"
 )
 
-var actions = []ConfigAction{Insert, Update, Combine, Group}
+var actions = []ConfigAction{Insert, Update, Combine, Group, Calculate}
 
 func (ca ConfigAction) isValid() bool {
 	for _, configAction := range actions {
@@ -245,6 +292,29 @@ func (at AggregationType) isValid() bool {
 	return false
 }
 
+// Operator is the enum to capture the arithmetic operations of the calculate action.
+type Operator string
+
+const (
+	// Ratio divides the values of the metric by the values of the operand metric.
+	Ratio Operator = "ratio"
+
+	// Difference subtracts the values of the operand metric from the values of the metric.
+	Difference Operator = "difference"
+)
+
+var operators = []Operator{Ratio, Difference}
+
+func (o Operator) isValid() bool {
+	for _, operator := range operators {
+		if o == operator {
+			return true
+		}
+	}
+
+	return false
+}
+
 // MatchType is the enum to capture the two types of matching metric(s) that should have operations applied to them.
 type MatchType string
 
@@ -6,6 +6,7 @@ package metricstransformprocessor
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,62 @@ func TestLoadConfig(t *testing.T) {
 						NewName: "new_name",
 					},
 				},
+				CardinalityLimit: CardinalityLimitConfig{
+					Window: time.Minute,
+				},
+			},
+		},
+		{
+			configFile: "config_full.yaml",
+			id:         component.NewIDWithName(metadata.Type, "cardinality_limit"),
+			expected: &Config{
+				Transforms: []Transform{
+					{
+						MetricIncludeFilter: FilterConfig{
+							Include: "name",
+						},
+						Action:  "update",
+						NewName: "new_name",
+					},
+				},
+				CardinalityLimit: CardinalityLimitConfig{
+					Limit:  2000,
+					Window: 5 * time.Minute,
+				},
+			},
+		},
+		{
+			configFile: "config_full.yaml",
+			id:         component.NewIDWithName(metadata.Type, "calculate"),
+			expected: &Config{
+				Transforms: []Transform{
+					{
+						MetricIncludeFilter: FilterConfig{
+							Include: "http.server.errors",
+						},
+						Action:        "calculate",
+						NewName:       "http.server.error_ratio",
+						OperandMetric: "http.server.requests",
+						Operator:      "ratio",
+					},
+					{
+						MetricIncludeFilter: FilterConfig{
+							Include: "http.server.requests",
+						},
+						Action: "update",
+						Operations: []Operation{
+							{
+								Action:      "update_label",
+								Label:       "http.route",
+								ValueRegexp: `^/api/v(\d+)/.*$`,
+								NewValue:    "api_v$1",
+							},
+						},
+					},
+				},
+				CardinalityLimit: CardinalityLimitConfig{
+					Window: time.Minute,
+				},
 			},
 		},
 		{
@@ -140,6 +197,9 @@ func TestLoadConfig(t *testing.T) {
 						GroupResourceLabels: map[string]string{"metric_group": "2"},
 					},
 				},
+				CardinalityLimit: CardinalityLimitConfig{
+					Window: time.Minute,
+				},
 			},
 		},
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -28,7 +29,11 @@ func NewFactory() processor.Factory {
 }
 
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		CardinalityLimit: CardinalityLimitConfig{
+			Window: time.Minute,
+		},
+	}
 }
 
 func createMetricsProcessor(
@@ -46,7 +51,7 @@ func createMetricsProcessor(
 	if err != nil {
 		return nil, err
 	}
-	metricsProcessor := newMetricsTransformProcessor(set.Logger, hCfg)
+	metricsProcessor := newMetricsTransformProcessor(set.Logger, hCfg, newCardinalityLimiter(oCfg.CardinalityLimit))
 
 	return processorhelper.NewMetricsProcessor(
 		ctx,
@@ -60,6 +65,13 @@ func createMetricsProcessor(
 // validateConfiguration validates the input configuration has all of the required fields for the processor
 // An error is returned if there are any invalid inputs.
 func validateConfiguration(config *Config) error {
+	if config.CardinalityLimit.Limit < 0 {
+		return fmt.Errorf("%q: limit must not be negative", CardinalityLimitFieldName)
+	}
+	if config.CardinalityLimit.Limit > 0 && config.CardinalityLimit.Window <= 0 {
+		return fmt.Errorf("%q: window must be positive", CardinalityLimitFieldName)
+	}
+
 	for _, transform := range config.Transforms {
 		if transform.MetricIncludeFilter.Include == "" {
 			return fmt.Errorf("missing required field %q", IncludeFieldName)
@@ -88,6 +100,18 @@ func validateConfiguration(config *Config) error {
 			return fmt.Errorf("missing required field %q while %q is %v", GroupResourceLabelsFieldName, ActionFieldName, Group)
 		}
 
+		if transform.Action == Calculate && transform.NewName == "" {
+			return fmt.Errorf("missing required field %q while %q is %v", NewNameFieldName, ActionFieldName, Calculate)
+		}
+
+		if transform.Action == Calculate && transform.OperandMetric == "" {
+			return fmt.Errorf("missing required field %q while %q is %v", OperandMetricFieldName, ActionFieldName, Calculate)
+		}
+
+		if transform.Action == Calculate && !transform.Operator.isValid() {
+			return fmt.Errorf("%q must be in %q", OperatorFieldName, operators)
+		}
+
 		if transform.AggregationType != "" && !transform.AggregationType.isValid() {
 			return fmt.Errorf("%q must be in %q", AggregationTypeFieldName, aggregationTypes)
 		}
@@ -110,6 +134,14 @@ func validateConfiguration(config *Config) error {
 			if op.Action == AddLabel && op.NewValue == "" {
 				return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, NewValueFieldName, ActionFieldName, AddLabel)
 			}
+			if op.ValueRegexp != "" {
+				if op.Action != UpdateLabel {
+					return fmt.Errorf("operation %v: %q is only supported while %q is %v", i+1, ValueRegexpFieldName, ActionFieldName, UpdateLabel)
+				}
+				if _, err := regexp.Compile(op.ValueRegexp); err != nil {
+					return fmt.Errorf("operation %v: %q, %w", i+1, ValueRegexpFieldName, err)
+				}
+			}
 			if op.Action == ScaleValue && op.Scale == 0 {
 				return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, ScaleFieldName, ActionFieldName, ScaleValue)
 			}
@@ -142,6 +174,8 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 			NewName:             t.NewName,
 			GroupResourceLabels: t.GroupResourceLabels,
 			AggregationType:     t.AggregationType,
+			OperandMetric:       t.OperandMetric,
+			Operator:            t.Operator,
 			Operations:          make([]internalOperation, len(t.Operations)),
 		}
 
@@ -154,6 +188,9 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 			if len(op.ValueActions) > 0 {
 				mtpOp.valueActionsMapping = createLabelValueMapping(op.ValueActions, version)
 			}
+			if op.ValueRegexp != "" {
+				mtpOp.valueRegexp = regexp.MustCompile("^(?:" + op.ValueRegexp + ")$")
+			}
 			if op.Action == AggregateLabels {
 				mtpOp.labelSetMap = sliceToSet(op.LabelSet)
 			} else if op.Action == AggregateLabelValues {
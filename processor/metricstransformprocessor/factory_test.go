@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,7 +28,11 @@ func TestType(t *testing.T) {
 func TestCreateDefaultConfig(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
-	assert.Equal(t, cfg, &Config{})
+	assert.Equal(t, cfg, &Config{
+		CardinalityLimit: CardinalityLimitConfig{
+			Window: time.Minute,
+		},
+	})
 	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
 }
 
@@ -103,6 +108,21 @@ func TestCreateProcessors(t *testing.T) {
 			succeed:      false,
 			errorMessage: fmt.Sprintf("%q must be in %q", SubmatchCaseFieldName, submatchCases),
 		},
+		{
+			configName:   "config_invalid_operator.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("%q must be in %q", OperatorFieldName, operators),
+		},
+		{
+			configName:   "config_invalid_value_regexp.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: %q, error parsing regexp: missing closing ]: `[\\da`", 1, ValueRegexpFieldName),
+		},
+		{
+			configName:   "config_invalid_cardinality_limit.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("%q: window must be positive", CardinalityLimitFieldName),
+		},
 	}
 
 	for _, tt := range tests {
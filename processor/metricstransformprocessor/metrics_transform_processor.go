@@ -16,6 +16,7 @@ type metricsTransformProcessor struct {
 	transforms               []internalTransform
 	logger                   *zap.Logger
 	otlpDataModelGateEnabled bool
+	cardinalityLimiter       *cardinalityLimiter
 }
 
 type internalTransform struct {
@@ -25,6 +26,8 @@ type internalTransform struct {
 	GroupResourceLabels map[string]string
 	AggregationType     AggregationType
 	SubmatchCase        SubmatchCase
+	OperandMetric       string
+	Operator            Operator
 	Operations          []internalOperation
 }
 
@@ -33,6 +36,8 @@ type internalOperation struct {
 	valueActionsMapping map[string]string
 	labelSetMap         map[string]bool
 	aggregatedValuesSet map[string]bool
+	// valueRegexp is the ValueRegexp anchored to match the whole label values
+	valueRegexp *regexp.Regexp
 }
 
 type internalFilter interface {
@@ -72,10 +77,11 @@ func (f internalFilterRegexp) getSubexpNames() []string {
 	return f.include.SubexpNames()
 }
 
-func newMetricsTransformProcessor(logger *zap.Logger, internalTransforms []internalTransform) *metricsTransformProcessor {
+func newMetricsTransformProcessor(logger *zap.Logger, internalTransforms []internalTransform, limiter *cardinalityLimiter) *metricsTransformProcessor {
 	return &metricsTransformProcessor{
-		transforms: internalTransforms,
-		logger:     logger,
+		transforms:         internalTransforms,
+		logger:             logger,
+		cardinalityLimiter: limiter,
 	}
 }
 
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// calculateMetrics inserts a metric calculated from each metric matching the filter of the transform and
+// the operand metric. Only the metrics present before the calculation are considered.
+func calculateMetrics(metrics pmetric.MetricSlice, transform internalTransform) {
+	mLen := metrics.Len()
+	operand, ok := findMetric(metrics, mLen, transform.OperandMetric)
+	if !ok {
+		return
+	}
+	for i := 0; i < mLen; i++ {
+		metric := transform.MetricIncludeFilter.extractMatchedMetric(metrics.At(i))
+		if metric == (pmetric.Metric{}) {
+			continue
+		}
+		newMetric := calculate(metric, operand, transform.Operator)
+		if countDataPoints(newMetric) == 0 {
+			continue
+		}
+		if transformMetric(newMetric, transform) {
+			newMetric.MoveTo(metrics.AppendEmpty())
+		}
+	}
+}
+
+// findMetric returns the first metric named name among the first n metrics of the slice.
+func findMetric(metrics pmetric.MetricSlice, n int, name string) (pmetric.Metric, bool) {
+	for i := 0; i < n; i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i), true
+		}
+	}
+	return pmetric.Metric{}, false
+}
+
+// calculate returns a gauge, named as the metric, with a double data point for each data point of the metric
+// having a data point of the operand with the same attributes. The start and end timestamps are those of
+// the data points of the metric. Only gauges and sums can be calculated, and data points with a zero
+// denominator are dropped from ratios.
+func calculate(metric, operand pmetric.Metric, operator Operator) pmetric.Metric {
+	newMetric := pmetric.NewMetric()
+	newMetric.SetName(metric.Name())
+	newMetric.SetUnit(calculatedUnit(metric.Unit(), operand.Unit(), operator))
+	gauge := newMetric.SetEmptyGauge()
+
+	dps, ok := numberDataPoints(metric)
+	if !ok {
+		return newMetric
+	}
+	operandDps, ok := numberDataPoints(operand)
+	if !ok {
+		return newMetric
+	}
+
+	operandValues := make(map[[16]byte]float64, operandDps.Len())
+	for i := 0; i < operandDps.Len(); i++ {
+		dp := operandDps.At(i)
+		operandValues[pdatautil.MapHash(dp.Attributes())] = numberValue(dp)
+	}
+
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		operandValue, found := operandValues[pdatautil.MapHash(dp.Attributes())]
+		if !found {
+			continue
+		}
+
+		var value float64
+		switch operator {
+		case Ratio:
+			if operandValue == 0 {
+				continue
+			}
+			value = numberValue(dp) / operandValue
+		case Difference:
+			value = numberValue(dp) - operandValue
+		}
+
+		newDp := gauge.DataPoints().AppendEmpty()
+		dp.Attributes().CopyTo(newDp.Attributes())
+		newDp.SetStartTimestamp(dp.StartTimestamp())
+		newDp.SetTimestamp(dp.Timestamp())
+		newDp.SetDoubleValue(value)
+	}
+	return newMetric
+}
+
+// calculatedUnit returns the unit of a ratio of metrics with the same units as "1", and as "<unit>/<operand unit>"
+// otherwise. Differences keep the unit of the metric.
+func calculatedUnit(unit, operandUnit string, operator Operator) string {
+	if operator != Ratio {
+		return unit
+	}
+	if unit == operandUnit {
+		return "1"
+	}
+	return unit + "/" + operandUnit
+}
+
+func numberDataPoints(metric pmetric.Metric) (pmetric.NumberDataPointSlice, bool) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints(), true
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints(), true
+	}
+	return pmetric.NumberDataPointSlice{}, false
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
@@ -273,6 +273,8 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetr
 							newMetric.MoveTo(metrics.AppendEmpty())
 						}
 					}
+				case Calculate:
+					calculateMetrics(metrics, transform)
 				case Update:
 					metrics.RemoveIf(func(metric pmetric.Metric) bool {
 						if !transform.MetricIncludeFilter.matchMetric(metric) {
@@ -293,6 +295,8 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetr
 
 	groupedRMs.MoveAndAppendTo(rms)
 
+	mtp.cardinalityLimiter.limitMetrics(md)
+
 	return md, nil
 }
 
@@ -452,7 +456,7 @@ func copyMetricDetails(from, to pmetric.Metric) {
 	case pmetric.MetricTypeHistogram:
 		to.SetEmptyHistogram().SetAggregationTemporality(from.Histogram().AggregationTemporality())
 	case pmetric.MetricTypeExponentialHistogram:
-		to.SetEmptyExponentialHistogram().SetAggregationTemporality(from.Histogram().AggregationTemporality())
+		to.SetEmptyExponentialHistogram().SetAggregationTemporality(from.ExponentialHistogram().AggregationTemporality())
 	case pmetric.MetricTypeSummary:
 		to.SetEmptySummary()
 	}
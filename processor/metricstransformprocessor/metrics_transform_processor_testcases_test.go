@@ -156,6 +156,40 @@ var (
 					addIntDatapoint(1, 2, 3, "label1-value2").build(),
 			},
 		},
+		{
+			name: "metric_label_value_update_with_value_regexp",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterStrict{include: "metric1"},
+					Action:              Update,
+					Operations: []internalOperation{
+						{
+							configOperation: Operation{
+								Action:   UpdateLabel,
+								Label:    "label1",
+								NewValue: "new/$1-${name}",
+							},
+							valueActionsMapping: map[string]string{
+								"value1-a": "new/value1-a",
+							},
+							valueRegexp: regexp.MustCompile("^(?:(value[0-9])-(?P<name>[a-z]))$"),
+						},
+					},
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "metric1", "label1").
+					addIntDatapoint(1, 2, 3, "value1-a").
+					addIntDatapoint(1, 2, 3, "value2-b").
+					addIntDatapoint(1, 2, 3, "value3-bc").build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "metric1", "label1").
+					addIntDatapoint(1, 2, 3, "new/value1-a").
+					addIntDatapoint(1, 2, 3, "new/value2-b").
+					addIntDatapoint(1, 2, 3, "value3-bc").build(),
+			},
+		},
 		{
 			name: "metric_label_update_label_and_label_value",
 			transforms: []internalTransform{
@@ -1719,5 +1753,96 @@ var (
 			},
 			out: []pmetric.Metric{},
 		},
+		// CALCULATE
+		{
+			name: "calculate_ratio",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterStrict{include: "errors"},
+					Action:              Calculate,
+					NewName:             "error_ratio",
+					OperandMetric:       "requests",
+					Operator:            Ratio,
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "errors", "route").setUnit("{request}").
+					addIntDatapoint(1, 2, 1, "/a").
+					addIntDatapoint(1, 2, 3, "/b").
+					addIntDatapoint(1, 2, 1, "/c").
+					addIntDatapoint(1, 2, 1, "/d").build(),
+				metricBuilder(pmetric.MetricTypeSum, "requests", "route").setUnit("{request}").
+					addIntDatapoint(1, 2, 4, "/a").
+					addDoubleDatapoint(1, 2, 6, "/b").
+					addIntDatapoint(1, 2, 0, "/c").build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "errors", "route").setUnit("{request}").
+					addIntDatapoint(1, 2, 1, "/a").
+					addIntDatapoint(1, 2, 3, "/b").
+					addIntDatapoint(1, 2, 1, "/c").
+					addIntDatapoint(1, 2, 1, "/d").build(),
+				metricBuilder(pmetric.MetricTypeSum, "requests", "route").setUnit("{request}").
+					addIntDatapoint(1, 2, 4, "/a").
+					addDoubleDatapoint(1, 2, 6, "/b").
+					addIntDatapoint(1, 2, 0, "/c").build(),
+				metricBuilder(pmetric.MetricTypeGauge, "error_ratio", "route").setUnit("1").
+					addDoubleDatapoint(1, 2, 0.25, "/a").
+					addDoubleDatapoint(1, 2, 0.5, "/b").build(),
+			},
+		},
+		{
+			name: "calculate_difference_with_operations",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterStrict{include: "memory.total"},
+					Action:              Calculate,
+					NewName:             "memory.available",
+					OperandMetric:       "memory.used",
+					Operator:            Difference,
+					Operations: []internalOperation{
+						{
+							configOperation: Operation{
+								Action:   AddLabel,
+								NewLabel: "source",
+								NewValue: "calculated",
+							},
+						},
+					},
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "memory.total").setUnit("By").
+					addIntDatapoint(1, 2, 100).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "memory.used").setUnit("By").
+					addIntDatapoint(1, 2, 40).build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "memory.total").setUnit("By").
+					addIntDatapoint(1, 2, 100).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "memory.used").setUnit("By").
+					addIntDatapoint(1, 2, 40).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "memory.available", "source").setUnit("By").
+					addDoubleDatapoint(1, 2, 60, "calculated").build(),
+			},
+		},
+		{
+			name: "calculate_missing_operand",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterStrict{include: "errors"},
+					Action:              Calculate,
+					NewName:             "error_ratio",
+					OperandMetric:       "requests",
+					Operator:            Ratio,
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "errors").addIntDatapoint(1, 2, 1).build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "errors").addIntDatapoint(1, 2, 1).build(),
+			},
+		},
 	}
 )
@@ -21,6 +21,7 @@ func updateLabelOp(metric pmetric.Metric, mtpOp internalOperation, f internalFil
 		if !ok {
 			return true
 		}
+		value := attrVal.Str()
 
 		if op.NewLabel != "" {
 			attrVal.CopyTo(attrs.PutEmpty(op.NewLabel))
@@ -28,8 +29,12 @@ func updateLabelOp(metric pmetric.Metric, mtpOp internalOperation, f internalFil
 			attrKey = op.NewLabel
 		}
 
-		if newValue, ok := mtpOp.valueActionsMapping[attrVal.Str()]; ok {
+		if newValue, ok := mtpOp.valueActionsMapping[value]; ok {
 			attrs.PutStr(attrKey, newValue)
+		} else if mtpOp.valueRegexp != nil {
+			if submatches := mtpOp.valueRegexp.FindStringSubmatchIndex(value); submatches != nil {
+				attrs.PutStr(attrKey, string(mtpOp.valueRegexp.ExpandString([]byte{}, op.NewValue, value, submatches)))
+			}
 		}
 		return true
 	})
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pacingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/pacingprocessor"
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OverflowPolicy defines what happens to the items received when the buffer is full.
+type OverflowPolicy string
+
+const (
+	// OverflowPolicyBlock blocks the caller until there is room in the buffer.
+	OverflowPolicyBlock OverflowPolicy = "block"
+	// OverflowPolicyDropNewest drops the received items.
+	OverflowPolicyDropNewest OverflowPolicy = "drop_newest"
+	// OverflowPolicyDropOldest drops the oldest buffered items to make room for the received ones.
+	OverflowPolicyDropOldest OverflowPolicy = "drop_oldest"
+
+	defaultItemsPerSecond = 1000
+	defaultBufferSize     = 100_000
+)
+
+// Config defines the configuration for the pacing processor.
+type Config struct {
+	// ItemsPerSecond is the maximum rate at which the items (spans, metric data points or
+	// log records) are released to the next consumer.
+	ItemsPerSecond float64 `mapstructure:"items_per_second"`
+
+	// Burst is the number of items which can be released above the rate after an idle period.
+	// By default there is no burst and the items are strictly paced.
+	Burst int `mapstructure:"burst"`
+
+	// BufferSize is the maximum number of items held by the processor before they are released.
+	BufferSize int `mapstructure:"buffer_size"`
+
+	// OverflowPolicy is the policy applied when the buffer is full, one of
+	// `block`, `drop_newest` or `drop_oldest`.
+	OverflowPolicy OverflowPolicy `mapstructure:"overflow_policy"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.ItemsPerSecond <= 0 {
+		return errors.New("items_per_second must be positive")
+	}
+	if cfg.Burst < 0 {
+		return errors.New("burst must not be negative")
+	}
+	if cfg.BufferSize <= 0 {
+		return errors.New("buffer_size must be positive")
+	}
+	switch cfg.OverflowPolicy {
+	case OverflowPolicyBlock, OverflowPolicyDropNewest, OverflowPolicyDropOldest:
+	default:
+		return fmt.Errorf("unsupported overflow_policy %q, must be one of %q, %q or %q",
+			cfg.OverflowPolicy, OverflowPolicyBlock, OverflowPolicyDropNewest, OverflowPolicyDropOldest)
+	}
+	return nil
+}
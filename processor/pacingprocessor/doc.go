@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package pacingprocessor implements a processor which buffers the received
+// telemetry and releases it at a maximum rate, smoothing bursty sources.
+package pacingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/pacingprocessor"
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pacingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/pacingprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/pacingprocessor/internal/metadata"
+)
+
+// NewFactory returns a new factory for the pacing processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithTraces(createTracesProcessor, metadata.TracesStability),
+		processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability),
+		processor.WithLogs(createLogsProcessor, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ItemsPerSecond: defaultItemsPerSecond,
+		BufferSize:     defaultBufferSize,
+		OverflowPolicy: OverflowPolicyBlock,
+	}
+}
+
+func createTracesProcessor(
+	_ context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (processor.Traces, error) {
+	return &tracesProcessor{
+		pacingProcessor: newPacingProcessor(set.Logger, cfg.(*Config), ptrace.Traces.SpanCount, nextConsumer.ConsumeTraces),
+	}, nil
+}
+
+func createMetricsProcessor(
+	_ context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (processor.Metrics, error) {
+	return &metricsProcessor{
+		pacingProcessor: newPacingProcessor(set.Logger, cfg.(*Config), pmetric.Metrics.DataPointCount, nextConsumer.ConsumeMetrics),
+	}, nil
+}
+
+func createLogsProcessor(
+	_ context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (processor.Logs, error) {
+	return &logsProcessor{
+		pacingProcessor: newPacingProcessor(set.Logger, cfg.(*Config), plog.Logs.LogRecordCount, nextConsumer.ConsumeLogs),
+	}, nil
+}
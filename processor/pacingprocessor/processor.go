@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pacingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/pacingprocessor"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+var errShutdown = errors.New("pacing processor is shutting down")
+
+// batch is a piece of telemetry waiting in the buffer, with its number of items.
+type batch[T any] struct {
+	data  T
+	items int
+}
+
+// pacingProcessor buffers the received telemetry and releases it to the next consumer
+// at the configured rate. The release times are scheduled as with the generic cell rate
+// algorithm: every batch delays the next release by its number of items divided by the
+// rate, and the schedule may lag behind the current time by up to burst / rate after an
+// idle period.
+type pacingProcessor[T any] struct {
+	logger *zap.Logger
+	cfg    *Config
+	count  func(T) int
+	next   func(context.Context, T) error
+
+	mu       sync.Mutex
+	buffer   []batch[T]
+	buffered int  // number of items in buffer
+	stopped  bool // set once the buffer is flushed on shutdown
+	// released is closed and replaced when items leave the buffer, to wake up the blocked callers.
+	released chan struct{}
+
+	available   chan struct{} // signals the release loop that the buffer is not empty
+	nextRelease time.Time     // the earliest time of the next release
+
+	startOnce    sync.Once
+	shutdownOnce sync.Once
+	shutdownC    chan struct{}
+	done         chan struct{}
+}
+
+func newPacingProcessor[T any](logger *zap.Logger, cfg *Config, count func(T) int, next func(context.Context, T) error) *pacingProcessor[T] {
+	return &pacingProcessor[T]{
+		logger:    logger,
+		cfg:       cfg,
+		count:     count,
+		next:      next,
+		released:  make(chan struct{}),
+		available: make(chan struct{}, 1),
+		shutdownC: make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+func (p *pacingProcessor[T]) Start(context.Context, component.Host) error {
+	p.startOnce.Do(func() {
+		go p.releaseLoop()
+	})
+	return nil
+}
+
+// Shutdown stops the release loop, the buffered items are sent to the next consumer without pacing.
+func (p *pacingProcessor[T]) Shutdown(ctx context.Context) error {
+	started := true
+	p.startOnce.Do(func() { started = false })
+	p.shutdownOnce.Do(func() { close(p.shutdownC) })
+	if !started {
+		p.flush()
+		return nil
+	}
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Capabilities reports that the processor mutates data, since it keeps the data after returning.
+func (p *pacingProcessor[T]) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+// consume adds the data to the buffer, applying the overflow policy when the buffer is full.
+// A batch larger than the buffer is accepted when the buffer is empty.
+func (p *pacingProcessor[T]) consume(ctx context.Context, data T) error {
+	items := p.count(data)
+	if items == 0 {
+		return nil
+	}
+	for {
+		p.mu.Lock()
+		if p.stopped {
+			p.mu.Unlock()
+			return errShutdown
+		}
+		if p.buffered+items <= p.cfg.BufferSize || p.buffered == 0 {
+			p.push(data, items)
+			p.mu.Unlock()
+			return nil
+		}
+
+		switch p.cfg.OverflowPolicy {
+		case OverflowPolicyDropNewest:
+			p.mu.Unlock()
+			p.logger.Warn("Dropping items, the buffer is full", zap.Int("dropped_items", items))
+			return nil
+		case OverflowPolicyDropOldest:
+			dropped := 0
+			for len(p.buffer) > 0 && p.buffered+items > p.cfg.BufferSize {
+				dropped += p.pop().items
+			}
+			p.push(data, items)
+			p.mu.Unlock()
+			p.logger.Warn("Dropping the oldest items, the buffer is full", zap.Int("dropped_items", dropped))
+			return nil
+		case OverflowPolicyBlock:
+		}
+
+		released := p.released
+		p.mu.Unlock()
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-p.shutdownC:
+			return errShutdown
+		}
+	}
+}
+
+// push appends a batch to the buffer, p.mu must be held.
+func (p *pacingProcessor[T]) push(data T, items int) {
+	p.buffer = append(p.buffer, batch[T]{data: data, items: items})
+	p.buffered += items
+	select {
+	case p.available <- struct{}{}:
+	default:
+	}
+}
+
+// pop removes the oldest batch from the buffer, p.mu must be held and the buffer must not be empty.
+func (p *pacingProcessor[T]) pop() batch[T] {
+	b := p.buffer[0]
+	var zero batch[T]
+	p.buffer[0] = zero
+	p.buffer = p.buffer[1:]
+	p.buffered -= b.items
+	close(p.released)
+	p.released = make(chan struct{})
+	return b
+}
+
+// reserve returns the time at which a batch of items can be released, and schedules the next release.
+func (p *pacingProcessor[T]) reserve(now time.Time, items int) time.Time {
+	interval := func(n int) time.Duration {
+		return time.Duration(float64(n) / p.cfg.ItemsPerSecond * float64(time.Second))
+	}
+	release := p.nextRelease
+	if earliest := now.Add(-interval(p.cfg.Burst)); release.Before(earliest) {
+		release = earliest
+	}
+	p.nextRelease = release.Add(interval(items))
+	if release.Before(now) {
+		return now
+	}
+	return release
+}
+
+func (p *pacingProcessor[T]) releaseLoop() {
+	defer close(p.done)
+	for {
+		select {
+		case <-p.available:
+		case <-p.shutdownC:
+			p.flush()
+			return
+		}
+
+		for {
+			p.mu.Lock()
+			if len(p.buffer) == 0 {
+				p.mu.Unlock()
+				break
+			}
+			b := p.pop()
+			p.mu.Unlock()
+
+			if wait := time.Until(p.reserve(time.Now(), b.items)); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-timer.C:
+				case <-p.shutdownC:
+					timer.Stop()
+					p.send(b)
+					p.flush()
+					return
+				}
+			}
+			p.send(b)
+		}
+	}
+}
+
+// flush sends all the buffered items to the next consumer.
+func (p *pacingProcessor[T]) flush() {
+	p.mu.Lock()
+	buffer := p.buffer
+	p.buffer = nil
+	p.buffered = 0
+	p.stopped = true
+	p.mu.Unlock()
+	for _, b := range buffer {
+		p.send(b)
+	}
+}
+
+func (p *pacingProcessor[T]) send(b batch[T]) {
+	if err := p.next(context.Background(), b.data); err != nil {
+		p.logger.Warn("Sender failed", zap.Error(err), zap.Int("items", b.items))
+	}
+}
+
+type tracesProcessor struct {
+	*pacingProcessor[ptrace.Traces]
+}
+
+func (p *tracesProcessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return p.consume(ctx, td)
+}
+
+type metricsProcessor struct {
+	*pacingProcessor[pmetric.Metrics]
+}
+
+func (p *metricsProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return p.consume(ctx, md)
+}
+
+type logsProcessor struct {
+	*pacingProcessor[plog.Logs]
+}
+
+func (p *logsProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return p.consume(ctx, ld)
+}
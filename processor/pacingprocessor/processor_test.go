@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pacingprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func newLogs(records int, body string) plog.Logs {
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < records; i++ {
+		lrs.AppendEmpty().Body().SetStr(body)
+	}
+	return ld
+}
+
+func newTestProcessor(cfg *Config, sink *consumertest.LogsSink) *logsProcessor {
+	return &logsProcessor{
+		pacingProcessor: newPacingProcessor(zap.NewNop(), cfg, plog.Logs.LogRecordCount, sink.ConsumeLogs),
+	}
+}
+
+func bodies(sink *consumertest.LogsSink) []string {
+	var out []string
+	for _, ld := range sink.AllLogs() {
+		out = append(out, ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	}
+	return out
+}
+
+func TestReserve(t *testing.T) {
+	p := newTestProcessor(&Config{ItemsPerSecond: 10, Burst: 20}, new(consumertest.LogsSink))
+	now := time.Now()
+
+	// the burst is available after an idle period
+	assert.Equal(t, now, p.reserve(now, 10))
+	assert.Equal(t, now, p.reserve(now, 10))
+	// then the items are paced at the rate
+	assert.Equal(t, now, p.reserve(now, 10))
+	assert.Equal(t, now.Add(time.Second), p.reserve(now, 5))
+	assert.Equal(t, now.Add(1500*time.Millisecond), p.reserve(now, 5))
+
+	// the burst builds up again while idle
+	later := now.Add(10 * time.Second)
+	assert.Equal(t, later, p.reserve(later, 30))
+	assert.Equal(t, later.Add(time.Second), p.reserve(later, 1))
+}
+
+func TestPacing(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newTestProcessor(&Config{ItemsPerSecond: 100, BufferSize: 1000, OverflowPolicy: OverflowPolicyBlock}, sink)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(10, "batch")))
+	}
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 50 }, 5*time.Second, time.Millisecond)
+	// the first batch is released right away, then every batch of 10 items waits 100ms
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestOverflowDropNewest(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newTestProcessor(&Config{ItemsPerSecond: 1, BufferSize: 20, OverflowPolicy: OverflowPolicyDropNewest}, sink)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(10, "first")))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(10, "second")))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(10, "third")))
+	assert.Equal(t, 20, p.buffered)
+
+	// the buffered items are flushed on shutdown
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, []string{"first", "second"}, bodies(sink))
+}
+
+func TestOverflowDropOldest(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newTestProcessor(&Config{ItemsPerSecond: 1, BufferSize: 20, OverflowPolicy: OverflowPolicyDropOldest}, sink)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(10, "first")))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(10, "second")))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(10, "third")))
+	assert.Equal(t, 20, p.buffered)
+
+	// a batch larger than the buffer replaces all the buffered items
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(30, "large")))
+	assert.Equal(t, 30, p.buffered)
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, []string{"large"}, bodies(sink))
+}
+
+func TestOverflowBlock(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newTestProcessor(&Config{ItemsPerSecond: 1000, BufferSize: 10, OverflowPolicy: OverflowPolicyBlock}, sink)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(10, "first")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, p.ConsumeLogs(ctx, newLogs(10, "timed out")), context.DeadlineExceeded)
+
+	errs := make(chan error)
+	go func() {
+		errs <- p.ConsumeLogs(context.Background(), newLogs(10, "second"))
+	}()
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, <-errs)
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 20 }, 5*time.Second, time.Millisecond)
+	assert.Equal(t, []string{"first", "second"}, bodies(sink))
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestShutdown(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newTestProcessor(&Config{ItemsPerSecond: 1, BufferSize: 10, OverflowPolicy: OverflowPolicyBlock}, sink)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(1, "log")))
+	}
+	// the items waiting for their release are sent without pacing
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 3, sink.LogRecordCount())
+
+	assert.ErrorIs(t, p.ConsumeLogs(context.Background(), newLogs(1, "log")), errShutdown)
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piimaskingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piimaskingprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	// ActionMask replaces the detected values with `****`
+	ActionMask = "mask"
+	// ActionHash replaces the detected values with their keyed hash, so that they can still be correlated
+	ActionHash = "hash"
+	// ActionDrop removes the attributes, the log bodies and the elements of their maps and slices
+	// containing a detected value
+	ActionDrop = "drop"
+)
+
+// Config defines the configuration for the PII masking processor.
+type Config struct {
+	// Detectors is the list of the built-in detectors enabled, among `credit_card`,
+	// `email`, `ip_address`, `us_ssn` and `uk_nino`.
+	Detectors []string `mapstructure:"detectors"`
+
+	// CustomRules are the detectors defined by regular expressions.
+	CustomRules []CustomRule `mapstructure:"custom_rules"`
+
+	// Action is the action applied to the detected values, one of `mask`, `hash` or `drop`.
+	Action string `mapstructure:"action"`
+
+	// HashKey is the secret used by the `hash` action.
+	HashKey configopaque.String `mapstructure:"hash_key"`
+
+	// Traces is the policy applied to the span attributes.
+	Traces SignalConfig `mapstructure:"traces"`
+
+	// Metrics is the policy applied to the data point attributes.
+	Metrics SignalConfig `mapstructure:"metrics"`
+
+	// Logs is the policy applied to the log bodies and attributes.
+	Logs SignalConfig `mapstructure:"logs"`
+
+	// ErrorMode determines how the processor reacts to errors that occur while processing an OTTL condition.
+	// Valid values are `ignore` and `propagate`.
+	// `ignore` means the processor ignores errors returned by conditions and continues on to the next condition. This is the recommended mode.
+	// `propagate` means the processor returns the error up the pipeline.  This will result in the payload being dropped from the collector.
+	// The default value is `propagate`.
+	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
+}
+
+// CustomRule is a detector defined by a regular expression.
+type CustomRule struct {
+	// Name identifies the rule in the signal policies and in the telemetry of the processor.
+	Name string `mapstructure:"name"`
+
+	// Pattern is the regular expression matching the values to mask, hash or drop.
+	Pattern string `mapstructure:"pattern"`
+}
+
+// SignalConfig is the policy applied to a signal.
+type SignalConfig struct {
+	// Action overrides the action applied to the detected values of the signal.
+	Action string `mapstructure:"action"`
+
+	// Detectors restricts the detectors applied to the signal, by name, among the enabled
+	// built-in detectors and the custom rules. All of them are applied when empty.
+	Detectors []string `mapstructure:"detectors"`
+
+	// Conditions are OTTL conditions selecting the spans, the data points or the log records
+	// the policy applies to. The policy applies to all of them when empty.
+	Conditions []string `mapstructure:"conditions"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if len(cfg.Detectors) == 0 && len(cfg.CustomRules) == 0 {
+		return errors.New("at least one of detectors or custom_rules must be specified")
+	}
+
+	enabled := map[string]struct{}{}
+	for _, name := range cfg.Detectors {
+		if _, ok := builtinDetectors[name]; !ok {
+			return fmt.Errorf("unsupported detector %q, must be one of %q, %q, %q, %q or %q",
+				name, DetectorCreditCard, DetectorEmail, DetectorIPAddress, DetectorUSSSN, DetectorUKNINO)
+		}
+		enabled[name] = struct{}{}
+	}
+	for _, rule := range cfg.CustomRules {
+		if rule.Name == "" {
+			return errors.New("custom rule name must not be empty")
+		}
+		if _, ok := enabled[rule.Name]; ok {
+			return fmt.Errorf("duplicate detector name %q", rule.Name)
+		}
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern of custom rule %q: %w", rule.Name, err)
+		}
+		enabled[rule.Name] = struct{}{}
+	}
+
+	if err := validateAction(cfg.Action); err != nil {
+		return err
+	}
+	hash := cfg.Action == ActionHash
+	signals := []struct {
+		name string
+		cfg  SignalConfig
+	}{
+		{name: "traces", cfg: cfg.Traces},
+		{name: "metrics", cfg: cfg.Metrics},
+		{name: "logs", cfg: cfg.Logs},
+	}
+	for _, signal := range signals {
+		if signal.cfg.Action != "" {
+			if err := validateAction(signal.cfg.Action); err != nil {
+				return fmt.Errorf("%s: %w", signal.name, err)
+			}
+			hash = hash || signal.cfg.Action == ActionHash
+		}
+		for _, name := range signal.cfg.Detectors {
+			if _, ok := enabled[name]; !ok {
+				return fmt.Errorf("%s: detector %q is not enabled", signal.name, name)
+			}
+		}
+	}
+	if hash && cfg.HashKey == "" {
+		return errors.New("hash_key must be specified when the action is hash")
+	}
+
+	return cfg.validateConditions()
+}
+
+func (cfg *Config) validateConditions() error {
+	set := component.TelemetrySettings{Logger: zap.NewNop()}
+	if len(cfg.Traces.Conditions) > 0 {
+		if _, err := filterottl.NewBoolExprForSpan(cfg.Traces.Conditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, set); err != nil {
+			return fmt.Errorf("traces: %w", err)
+		}
+	}
+	if len(cfg.Metrics.Conditions) > 0 {
+		if _, err := filterottl.NewBoolExprForDataPoint(cfg.Metrics.Conditions, filterottl.StandardDataPointFuncs(), ottl.PropagateError, set); err != nil {
+			return fmt.Errorf("metrics: %w", err)
+		}
+	}
+	if len(cfg.Logs.Conditions) > 0 {
+		if _, err := filterottl.NewBoolExprForLog(cfg.Logs.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, set); err != nil {
+			return fmt.Errorf("logs: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateAction(action string) error {
+	switch action {
+	case ActionMask, ActionHash, ActionDrop:
+		return nil
+	default:
+		return fmt.Errorf("unsupported action %q, must be one of %q, %q or %q", action, ActionMask, ActionHash, ActionDrop)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piimaskingprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piimaskingprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Detectors:   []string{DetectorCreditCard, DetectorEmail, DetectorUSSSN},
+				CustomRules: []CustomRule{{Name: "employee_id", Pattern: `EMP-\d{6}`}},
+				Action:      ActionMask,
+				HashKey:     "secret",
+				Traces: SignalConfig{
+					Action:    ActionHash,
+					Detectors: []string{DetectorEmail, "employee_id"},
+				},
+				Metrics: SignalConfig{
+					Action: ActionDrop,
+				},
+				Logs: SignalConfig{
+					Conditions: []string{`attributes["sensitive"] == true`},
+				},
+				ErrorMode: ottl.IgnoreError,
+			},
+		},
+		{
+			id:       component.NewIDWithName(metadata.Type, "defaults"),
+			expected: createDefaultConfig(),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_detector"),
+			errorMessage: `unsupported detector "passport", must be one of "credit_card", "email", "ip_address", "us_ssn" or "uk_nino"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_custom_rule_name"),
+			errorMessage: "custom rule name must not be empty",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_duplicate_detector"),
+			errorMessage: `duplicate detector name "email"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_custom_rule_pattern"),
+			errorMessage: "invalid pattern of custom rule \"employee_id\": error parsing regexp: missing closing ): `EMP-(\\d{6}`",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_action"),
+			errorMessage: `unsupported action "encrypt", must be one of "mask", "hash" or "drop"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_signal_action"),
+			errorMessage: `logs: unsupported action "encrypt", must be one of "mask", "hash" or "drop"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_signal_detector"),
+			errorMessage: `traces: detector "credit_card" is not enabled`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_hash_key"),
+			errorMessage: "hash_key must be specified when the action is hash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Detectors = nil
+	assert.EqualError(t, cfg.Validate(), "at least one of detectors or custom_rules must be specified")
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.Logs.Conditions = []string{`attributes["sensitive"] ==`}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "logs: ")
+}
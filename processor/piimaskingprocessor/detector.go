@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piimaskingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piimaskingprocessor"
+
+import (
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+const (
+	// DetectorCreditCard detects the payment card numbers passing the Luhn check
+	DetectorCreditCard = "credit_card"
+	// DetectorEmail detects the email addresses
+	DetectorEmail = "email"
+	// DetectorIPAddress detects the IPv4 and IPv6 addresses
+	DetectorIPAddress = "ip_address"
+	// DetectorUSSSN detects the US social security numbers
+	DetectorUSSSN = "us_ssn"
+	// DetectorUKNINO detects the UK national insurance numbers
+	DetectorUKNINO = "uk_nino"
+)
+
+// detector finds the values to mask, hash or drop in strings.
+type detector struct {
+	name  string
+	regex *regexp.Regexp
+	// valid filters out the false positives of the regular expression, all the matches are
+	// detected when nil.
+	valid func(match string) bool
+}
+
+// builtinDetectors are the detectors which can be enabled by name.
+var builtinDetectors = map[string]*detector{
+	DetectorCreditCard: {
+		name: DetectorCreditCard,
+		// 4 digit groups, the 4-6-5 groups of 15 digit numbers, or consecutive digits
+		regex: regexp.MustCompile(`\b(?:\d{4}[ -]?){3}\d{1,7}\b|\b\d{4}[ -]?\d{6}[ -]?\d{5}\b|\b\d{13,19}\b`),
+		valid: validCreditCard,
+	},
+	DetectorEmail: {
+		name:  DetectorEmail,
+		regex: regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9-]+(?:\.[a-zA-Z0-9-]+)*\.[a-zA-Z]{2,}`),
+	},
+	DetectorIPAddress: {
+		name:  DetectorIPAddress,
+		regex: regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b|(?:[0-9a-fA-F]{0,4}:){2,7}[0-9a-fA-F]{0,4}`),
+		valid: validIPAddress,
+	},
+	DetectorUSSSN: {
+		name:  DetectorUSSSN,
+		regex: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`),
+		valid: validUSSSN,
+	},
+	DetectorUKNINO: {
+		name:  DetectorUKNINO,
+		regex: regexp.MustCompile(`\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]\b`),
+		valid: validUKNINO,
+	},
+}
+
+// validCreditCard checks the number of digits and the Luhn checksum of the card number.
+func validCreditCard(match string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(match)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// validIPAddress filters out the version numbers and the times matched as addresses.
+func validIPAddress(match string) bool {
+	_, err := netip.ParseAddr(match)
+	return err == nil
+}
+
+// validUSSSN filters out the numbers which are never assigned: the area 000, 666 or 900-999,
+// the group 00 or the serial 0000.
+func validUSSSN(match string) bool {
+	area, group, serial := match[0:3], match[4:6], match[7:11]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// validUKNINO filters out the prefixes which are never allocated.
+func validUKNINO(match string) bool {
+	switch match[0:2] {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return false
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piimaskingprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinDetectors(t *testing.T) {
+	tests := []struct {
+		detector string
+		input    string
+		expected string
+	}{
+		{
+			detector: DetectorCreditCard,
+			input:    "paid with 4111 1111 1111 1111 today",
+			expected: "paid with **** today",
+		},
+		{
+			detector: DetectorCreditCard,
+			input:    "order 12 card 4111111111111111",
+			expected: "order 12 card ****",
+		},
+		{
+			detector: DetectorCreditCard,
+			input:    "amex 3782-822463-10005",
+			expected: "amex ****",
+		},
+		{
+			// the Luhn check fails
+			detector: DetectorCreditCard,
+			input:    "order 4111 1111 1111 1112",
+			expected: "order 4111 1111 1111 1112",
+		},
+		{
+			detector: DetectorEmail,
+			input:    "sent to jane.doe+billing@mail.example.co.uk.",
+			expected: "sent to ****.",
+		},
+		{
+			detector: DetectorIPAddress,
+			input:    "client 10.1.2.3 and 2001:db8::1 connected",
+			expected: "client **** and **** connected",
+		},
+		{
+			// neither versions nor times are addresses
+			detector: DetectorIPAddress,
+			input:    "version 1.2.3.400 at 12:30:45",
+			expected: "version 1.2.3.400 at 12:30:45",
+		},
+		{
+			detector: DetectorUSSSN,
+			input:    "ssn=123-45-6789",
+			expected: "ssn=****",
+		},
+		{
+			detector: DetectorUSSSN,
+			input:    "ssn=666-45-6789 or 123-00-6789",
+			expected: "ssn=666-45-6789 or 123-00-6789",
+		},
+		{
+			detector: DetectorUKNINO,
+			input:    "nino AB 12 34 56 C",
+			expected: "nino ****",
+		},
+		{
+			detector: DetectorUKNINO,
+			input:    "nino GB123456A",
+			expected: "nino GB123456A",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.detector+"/"+tt.input, func(t *testing.T) {
+			p := &policy{detectors: []*detector{builtinDetectors[tt.detector]}, action: ActionMask}
+			counts := map[string]int64{}
+			actual, detected := p.processString(tt.input, counts)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expected != tt.input, detected)
+		})
+	}
+}
+
+func TestValidCreditCard(t *testing.T) {
+	assert.True(t, validCreditCard("4012888888881881"))
+	assert.True(t, validCreditCard("5555-5555-5555-4444"))
+	assert.False(t, validCreditCard("5555-5555-5555-4445"))
+	assert.False(t, validCreditCard("123456789012"))
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package piimaskingprocessor implements a processor which detects personally identifiable
+// information, e.g. credit card numbers or email addresses, in log bodies, span attributes
+// and metric attributes, and masks, hashes or drops it.
+package piimaskingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piimaskingprocessor"
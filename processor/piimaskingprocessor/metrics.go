@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piimaskingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piimaskingprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/obsreport"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piimaskingprocessor/internal/metadata"
+)
+
+var (
+	tagSignalKey, _   = tag.NewKey("signal")
+	tagDetectorKey, _ = tag.NewKey("detector")
+	tagActionKey, _   = tag.NewKey("action")
+
+	mDetectedValues = stats.Int64("detected_values", "Number of values detected as personally identifiable information, and masked, hashed or dropped", stats.UnitDimensionless)
+)
+
+// MetricViews returns the metrics views of the processor.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mDetectedValues.Name()),
+			Measure:     mDetectedValues,
+			Description: mDetectedValues.Description(),
+			TagKeys:     []tag.Key{tagSignalKey, tagDetectorKey, tagActionKey},
+			Aggregation: view.Sum(),
+		},
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piimaskingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piimaskingprocessor"
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// maskedValue replaces the detected values with the mask action.
+const maskedValue = "****"
+
+// policy applies the detectors and the action of a signal to the values.
+type policy struct {
+	signal    string
+	detectors []*detector
+	action    string
+	hashKey   []byte
+}
+
+func newPolicy(signal string, cfg *Config, signalCfg SignalConfig) *policy {
+	// the built-in detectors apply before the custom rules, in the configured order
+	var detectors []*detector
+	for _, name := range cfg.Detectors {
+		detectors = append(detectors, builtinDetectors[name])
+	}
+	for _, rule := range cfg.CustomRules {
+		// Patterns have already been validated
+		detectors = append(detectors, &detector{name: rule.Name, regex: regexp.MustCompile(rule.Pattern)})
+	}
+
+	if len(signalCfg.Detectors) > 0 {
+		selected := make(map[string]struct{}, len(signalCfg.Detectors))
+		for _, name := range signalCfg.Detectors {
+			selected[name] = struct{}{}
+		}
+		filtered := detectors[:0]
+		for _, d := range detectors {
+			if _, ok := selected[d.name]; ok {
+				filtered = append(filtered, d)
+			}
+		}
+		detectors = filtered
+	}
+
+	action := cfg.Action
+	if signalCfg.Action != "" {
+		action = signalCfg.Action
+	}
+	return &policy{
+		signal:    signal,
+		detectors: detectors,
+		action:    action,
+		hashKey:   []byte(cfg.HashKey),
+	}
+}
+
+// processMap applies the policy to the values of the map, counting the detected values by detector.
+func (p *policy) processMap(m pcommon.Map, counts map[string]int64) {
+	m.RemoveIf(func(_ string, v pcommon.Value) bool {
+		return p.processValue(v, counts)
+	})
+}
+
+// processValue applies the policy to the string values of v, recursively. It reports whether
+// v contains a detected value and must be dropped.
+func (p *policy) processValue(v pcommon.Value, counts map[string]int64) bool {
+	switch v.Type() {
+	case pcommon.ValueTypeStr:
+		s, detected := p.processString(v.Str(), counts)
+		if !detected {
+			return false
+		}
+		if p.action == ActionDrop {
+			return true
+		}
+		v.SetStr(s)
+	case pcommon.ValueTypeMap:
+		p.processMap(v.Map(), counts)
+	case pcommon.ValueTypeSlice:
+		v.Slice().RemoveIf(func(e pcommon.Value) bool {
+			return p.processValue(e, counts)
+		})
+	case pcommon.ValueTypeEmpty, pcommon.ValueTypeInt, pcommon.ValueTypeDouble, pcommon.ValueTypeBool, pcommon.ValueTypeBytes:
+	}
+	return false
+}
+
+// processString replaces the detected values of s. The second return value reports whether
+// a value was detected.
+func (p *policy) processString(s string, counts map[string]int64) (string, bool) {
+	detected := false
+	for _, d := range p.detectors {
+		s = d.regex.ReplaceAllStringFunc(s, func(match string) string {
+			if d.valid != nil && !d.valid(match) {
+				return match
+			}
+			detected = true
+			counts[d.name]++
+			return p.replace(match)
+		})
+	}
+	return s, detected
+}
+
+func (p *policy) replace(match string) string {
+	switch p.action {
+	case ActionHash:
+		mac := hmac.New(sha256.New, p.hashKey)
+		_, _ = mac.Write([]byte(match))
+		return hex.EncodeToString(mac.Sum(nil)[:16])
+	case ActionMask:
+		return maskedValue
+	default:
+		// the values containing a match are dropped
+		return match
+	}
+}
+
+// record records the number of detected values by detector.
+func (p *policy) record(ctx context.Context, counts map[string]int64) {
+	for name, count := range counts {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{
+			tag.Upsert(tagSignalKey, p.signal),
+			tag.Upsert(tagDetectorKey, name),
+			tag.Upsert(tagActionKey, p.action),
+		}, mDetectedValues.M(count))
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piimaskingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piimaskingprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+type piiMaskingProcessor struct {
+	logger *zap.Logger
+	policy *policy
+	// the conditions selecting the items the policy applies to, nil when it applies to all of them
+	spanExpr      expr.BoolExpr[ottlspan.TransformContext]
+	dataPointExpr expr.BoolExpr[ottldatapoint.TransformContext]
+	logExpr       expr.BoolExpr[ottllog.TransformContext]
+}
+
+func newTracesProcessor(set component.TelemetrySettings, cfg *Config) (*piiMaskingProcessor, error) {
+	p := &piiMaskingProcessor{logger: set.Logger, policy: newPolicy("traces", cfg, cfg.Traces)}
+	if len(cfg.Traces.Conditions) > 0 {
+		var err error
+		if p.spanExpr, err = filterottl.NewBoolExprForSpan(cfg.Traces.Conditions, filterottl.StandardSpanFuncs(), cfg.ErrorMode, set); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func newMetricsProcessor(set component.TelemetrySettings, cfg *Config) (*piiMaskingProcessor, error) {
+	p := &piiMaskingProcessor{logger: set.Logger, policy: newPolicy("metrics", cfg, cfg.Metrics)}
+	if len(cfg.Metrics.Conditions) > 0 {
+		var err error
+		if p.dataPointExpr, err = filterottl.NewBoolExprForDataPoint(cfg.Metrics.Conditions, filterottl.StandardDataPointFuncs(), cfg.ErrorMode, set); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func newLogsProcessor(set component.TelemetrySettings, cfg *Config) (*piiMaskingProcessor, error) {
+	p := &piiMaskingProcessor{logger: set.Logger, policy: newPolicy("logs", cfg, cfg.Logs)}
+	if len(cfg.Logs.Conditions) > 0 {
+		var err error
+		if p.logExpr, err = filterottl.NewBoolExprForLog(cfg.Logs.Conditions, filterottl.StandardLogFuncs(), cfg.ErrorMode, set); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// processTraces applies the policy to the attributes of the spans.
+func (p *piiMaskingProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	var errs error
+	counts := map[string]int64{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				if p.spanExpr != nil {
+					match, err := p.spanExpr.Eval(ctx, ottlspan.NewTransformContext(span, ss.Scope(), rs.Resource()))
+					if err != nil {
+						errs = multierr.Append(errs, err)
+						continue
+					}
+					if !match {
+						continue
+					}
+				}
+				p.policy.processMap(span.Attributes(), counts)
+			}
+		}
+	}
+	p.policy.record(ctx, counts)
+
+	if errs != nil {
+		p.logger.Error("failed processing traces", zap.Error(errs))
+		return td, errs
+	}
+	return td, nil
+}
+
+// processMetrics applies the policy to the attributes of the data points.
+func (p *piiMaskingProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	var errs error
+	counts := map[string]int64{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			metrics := sm.Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				// processDataPoint applies the policy to a data point matching the conditions
+				processDataPoint := func(dp interface{}, attrs pcommon.Map) {
+					if p.dataPointExpr != nil {
+						match, err := p.dataPointExpr.Eval(ctx, ottldatapoint.NewTransformContext(dp, metric, metrics, sm.Scope(), rm.Resource()))
+						if err != nil {
+							errs = multierr.Append(errs, err)
+							return
+						}
+						if !match {
+							return
+						}
+					}
+					p.policy.processMap(attrs, counts)
+				}
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					dps := metric.Gauge().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						processDataPoint(dps.At(l), dps.At(l).Attributes())
+					}
+				case pmetric.MetricTypeSum:
+					dps := metric.Sum().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						processDataPoint(dps.At(l), dps.At(l).Attributes())
+					}
+				case pmetric.MetricTypeHistogram:
+					dps := metric.Histogram().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						processDataPoint(dps.At(l), dps.At(l).Attributes())
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					dps := metric.ExponentialHistogram().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						processDataPoint(dps.At(l), dps.At(l).Attributes())
+					}
+				case pmetric.MetricTypeSummary:
+					dps := metric.Summary().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						processDataPoint(dps.At(l), dps.At(l).Attributes())
+					}
+				case pmetric.MetricTypeEmpty:
+				}
+			}
+		}
+	}
+	p.policy.record(ctx, counts)
+
+	if errs != nil {
+		p.logger.Error("failed processing metrics", zap.Error(errs))
+		return md, errs
+	}
+	return md, nil
+}
+
+// processLogs applies the policy to the bodies and the attributes of the log records. The bodies
+// containing a detected value are removed with the drop action.
+func (p *piiMaskingProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	var errs error
+	counts := map[string]int64{}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			lrs := sl.LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lr := lrs.At(k)
+				if p.logExpr != nil {
+					match, err := p.logExpr.Eval(ctx, ottllog.NewTransformContext(lr, sl.Scope(), rl.Resource()))
+					if err != nil {
+						errs = multierr.Append(errs, err)
+						continue
+					}
+					if !match {
+						continue
+					}
+				}
+				if p.policy.processValue(lr.Body(), counts) {
+					pcommon.NewValueEmpty().CopyTo(lr.Body())
+				}
+				p.policy.processMap(lr.Attributes(), counts)
+			}
+		}
+	}
+	p.policy.record(ctx, counts)
+
+	if errs != nil {
+		p.logger.Error("failed processing logs", zap.Error(errs))
+		return ld, errs
+	}
+	return ld, nil
+}
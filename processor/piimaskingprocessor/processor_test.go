@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piimaskingprocessor
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CustomRules = []CustomRule{{Name: "employee_id", Pattern: `EMP-\d{6}`}}
+	cfg.HashKey = "secret"
+	return cfg
+}
+
+func TestProcessTraces(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Traces = SignalConfig{
+		Action:     ActionHash,
+		Detectors:  []string{DetectorEmail},
+		Conditions: []string{`name == "checkout"`},
+	}
+	p, err := newTracesProcessor(componenttest.NewNopTelemetrySettings(), cfg)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	for _, name := range []string{"checkout", "login"} {
+		span := spans.AppendEmpty()
+		span.SetName(name)
+		span.Attributes().PutStr("user", "jane@example.com")
+		span.Attributes().PutStr("employee", "EMP-123456")
+	}
+
+	_, err = p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	_, _ = mac.Write([]byte("jane@example.com"))
+	hashed := hex.EncodeToString(mac.Sum(nil)[:16])
+	// only the email detector applies to the spans
+	assert.Equal(t, map[string]interface{}{"user": hashed, "employee": "EMP-123456"}, spans.At(0).Attributes().AsRaw())
+	// the span does not match the conditions
+	assert.Equal(t, map[string]interface{}{"user": "jane@example.com", "employee": "EMP-123456"}, spans.At(1).Attributes().AsRaw())
+}
+
+func TestProcessLogs(t *testing.T) {
+	p, err := newLogsProcessor(componenttest.NewNopTelemetrySettings(), newTestConfig())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lr := lrs.AppendEmpty()
+	lr.Body().SetStr("payment by EMP-123456 from 10.0.0.1 failed")
+	lr.Attributes().PutInt("status", 402)
+	lr = lrs.AppendEmpty()
+	require.NoError(t, lr.Body().FromRaw(map[string]interface{}{
+		"msg":    "signup",
+		"emails": []interface{}{"jane@example.com", "none"},
+	}))
+
+	_, err = p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, "payment by **** from **** failed", lrs.At(0).Body().Str())
+	assert.Equal(t, map[string]interface{}{"status": int64(402)}, lrs.At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"msg":    "signup",
+		"emails": []interface{}{"****", "none"},
+	}, lrs.At(1).Body().AsRaw())
+}
+
+func TestProcessLogsDrop(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Logs.Action = ActionDrop
+	p, err := newLogsProcessor(componenttest.NewNopTelemetrySettings(), cfg)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lr := lrs.AppendEmpty()
+	lr.Body().SetStr("card 4111 1111 1111 1111 declined")
+	lr.Attributes().PutStr("customer", "jane@example.com")
+	lr.Attributes().PutStr("country", "FR")
+	lr = lrs.AppendEmpty()
+	require.NoError(t, lr.Body().FromRaw(map[string]interface{}{
+		"msg":    "signup",
+		"emails": []interface{}{"jane@example.com", "none"},
+	}))
+
+	_, err = p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Nil(t, lrs.At(0).Body().AsRaw())
+	assert.Equal(t, map[string]interface{}{"country": "FR"}, lrs.At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"msg":    "signup",
+		"emails": []interface{}{"none"},
+	}, lrs.At(1).Body().AsRaw())
+}
+
+func TestProcessMetrics(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Metrics = SignalConfig{
+		Action:     ActionDrop,
+		Conditions: []string{`metric.name == "logins"`},
+	}
+	p, err := newMetricsProcessor(componenttest.NewNopTelemetrySettings(), cfg)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, name := range []string{"logins", "signups"} {
+		metric := metrics.AppendEmpty()
+		metric.SetName(name)
+		dp := metric.SetEmptySum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("user", "jane@example.com")
+		dp.Attributes().PutStr("method", "password")
+	}
+
+	_, err = p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"method": "password"}, metrics.At(0).Sum().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"user": "jane@example.com", "method": "password"}, metrics.At(1).Sum().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestDetectedValuesTelemetry(t *testing.T) {
+	views := MetricViews()
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	p, err := newLogsProcessor(componenttest.NewNopTelemetrySettings(), newTestConfig())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().Body().SetStr("jane@example.com and john@example.com")
+	lrs.AppendEmpty().Body().SetStr("EMP-123456")
+
+	emailBefore := sumForDetector(t, views[0].Name, DetectorEmail)
+	employeeBefore := sumForDetector(t, views[0].Name, "employee_id")
+	_, err = p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, sumForDetector(t, views[0].Name, DetectorEmail)-emailBefore)
+	assert.Equal(t, 1.0, sumForDetector(t, views[0].Name, "employee_id")-employeeBefore)
+}
+
+// sumForDetector returns the number of values detected in the logs by the detector with the mask action.
+// The tags of the rows are sorted by key.
+func sumForDetector(t *testing.T, viewName, detector string) float64 {
+	rows, err := view.RetrieveData(viewName)
+	require.NoError(t, err)
+	tags := []tag.Tag{
+		{Key: tagActionKey, Value: ActionMask},
+		{Key: tagDetectorKey, Value: detector},
+		{Key: tagSignalKey, Value: "logs"},
+	}
+	for _, row := range rows {
+		if assert.ObjectsAreEqual(tags, row.Tags) {
+			return row.Data.(*view.SumData).Value
+		}
+	}
+	return 0
+}
@@ -23,6 +23,24 @@ var validAttributeSource = map[AttributeSource]bool{
 	recordAttributeSource:  true,
 }
 
+type SamplerMode string
+
+const (
+	// hashSeedSamplerMode samples the traces by hashing their trace ID with the hash seed.
+	hashSeedSamplerMode = SamplerMode("hash_seed")
+	// consistentSamplerMode samples the traces according to the OpenTelemetry consistent probability
+	// sampling, comparing the randomness of their trace ID with a threshold recorded in
+	// their trace state.
+	consistentSamplerMode = SamplerMode("consistent")
+
+	defaultSamplerMode = hashSeedSamplerMode
+)
+
+var validSamplerModes = map[SamplerMode]bool{
+	hashSeedSamplerMode:   true,
+	consistentSamplerMode: true,
+}
+
 // Config has the configuration guiding the sampler processor.
 type Config struct {
 
@@ -35,6 +53,10 @@ type Config struct {
 	// different sampling rates, configuring different seeds avoids that.
 	HashSeed uint32 `mapstructure:"hash_seed"`
 
+	// SamplerMode (traces only) defines how the traces are sampled. The allowed values are `hash_seed`
+	// and `consistent`. Default is `hash_seed`. The hash seed is not used in `consistent` mode.
+	SamplerMode SamplerMode `mapstructure:"mode"`
+
 	// AttributeSource (logs only) defines where to look for the attribute in from_attribute. The allowed values are
 	// `traceID` or `record`. Default is `traceID`.
 	AttributeSource `mapstructure:"attribute_source"`
@@ -58,5 +80,8 @@ func (cfg *Config) Validate() error {
 	if cfg.AttributeSource != "" && !validAttributeSource[cfg.AttributeSource] {
 		return fmt.Errorf("invalid attribute source: %v. Expected: %v or %v", cfg.AttributeSource, traceIDAttributeSource, recordAttributeSource)
 	}
+	if cfg.SamplerMode != "" && !validSamplerModes[cfg.SamplerMode] {
+		return fmt.Errorf("invalid sampler mode: %v. Expected: %v or %v", cfg.SamplerMode, hashSeedSamplerMode, consistentSamplerMode)
+	}
 	return nil
 }
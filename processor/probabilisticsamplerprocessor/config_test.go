@@ -28,6 +28,15 @@ func TestLoadConfig(t *testing.T) {
 				SamplingPercentage: 15.3,
 				HashSeed:           22,
 				AttributeSource:    "traceID",
+				SamplerMode:        "hash_seed",
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "consistent"),
+			expected: &Config{
+				SamplingPercentage: 25,
+				AttributeSource:    "traceID",
+				SamplerMode:        "consistent",
 			},
 		},
 		{
@@ -38,6 +47,7 @@ func TestLoadConfig(t *testing.T) {
 				AttributeSource:    "record",
 				FromAttribute:      "foo",
 				SamplingPriority:   "bar",
+				SamplerMode:        "hash_seed",
 			},
 		},
 	}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package probabilisticsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor"
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// The consistent probability sampling compares the randomness of a trace, 56 random bits
+// of its trace ID or the explicit "rv" value of its trace state, with a rejection threshold
+// derived from the sampling probability. The threshold the trace is sampled with is recorded
+// as the "th" value of the trace state so the samplers downstream compose with it, and the
+// adjusted count of the spans, 2^56 / (2^56 - threshold), can be derived from it.
+// See https://github.com/open-telemetry/oteps/blob/main/text/trace/0235-sampling-threshold-in-trace-state.md
+const (
+	randomnessBits     = 56
+	maxThreshold       = uint64(1) << randomnessBits
+	thresholdHexDigits = randomnessBits / 4
+
+	otTraceStateKey  = "ot"
+	thresholdSubKey  = "th"
+	randomnessSubKey = "rv"
+)
+
+// probabilityThreshold returns the rejection threshold of a sampling percentage: the
+// traces with a randomness lower than the threshold are not sampled.
+func probabilityThreshold(percentage float32) uint64 {
+	if percentage >= 100 {
+		return 0
+	}
+	if percentage <= 0 {
+		return maxThreshold
+	}
+	threshold := uint64((1 - float64(percentage)/100) * float64(maxThreshold))
+	if threshold >= maxThreshold {
+		return maxThreshold - 1
+	}
+	return threshold
+}
+
+// formatThreshold encodes a threshold as up to 14 hexadecimal digits without trailing zeros.
+func formatThreshold(threshold uint64) string {
+	if threshold == 0 {
+		return "0"
+	}
+	return strings.TrimRight(fmt.Sprintf("%0*x", thresholdHexDigits, threshold), "0")
+}
+
+// parseThreshold decodes a threshold of up to 14 hexadecimal digits, the missing trailing
+// digits being zeros.
+func parseThreshold(s string) (uint64, error) {
+	if s == "" || len(s) > thresholdHexDigits {
+		return 0, fmt.Errorf("invalid threshold %q", s)
+	}
+	threshold, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid threshold %q: %w", s, err)
+	}
+	return threshold << (4 * (thresholdHexDigits - len(s))), nil
+}
+
+func parseRandomness(s string) (uint64, error) {
+	if len(s) != thresholdHexDigits {
+		return 0, fmt.Errorf("invalid randomness %q", s)
+	}
+	return strconv.ParseUint(s, 16, 64)
+}
+
+// consistentSample decides whether a span is sampled with the given threshold, or with the
+// threshold of its trace state when it was sampled with a lower probability upstream. The
+// threshold of sampled spans is recorded in their trace state.
+func consistentSample(span ptrace.Span, threshold uint64) bool {
+	otValue, others := splitTraceState(span.TraceState().AsRaw())
+	fields := splitOTValue(otValue)
+
+	var randomness uint64
+	hasRandomness := false
+	for _, f := range fields {
+		switch f[0] {
+		case thresholdSubKey:
+			if th, err := parseThreshold(f[1]); err == nil && th > threshold {
+				threshold = th
+			}
+		case randomnessSubKey:
+			if rv, err := parseRandomness(f[1]); err == nil {
+				randomness = rv
+				hasRandomness = true
+			}
+		}
+	}
+	if !hasRandomness {
+		tid := span.TraceID()
+		randomness = binary.BigEndian.Uint64(tid[8:]) & (maxThreshold - 1)
+	}
+
+	if threshold >= maxThreshold || randomness < threshold {
+		return false
+	}
+
+	fields = setOTField(fields, thresholdSubKey, formatThreshold(threshold))
+	span.TraceState().FromRaw(joinTraceState(joinOTValue(fields), others))
+	return true
+}
+
+// splitTraceState returns the value of the OpenTelemetry entry of a W3C trace state, and
+// the other entries.
+func splitTraceState(traceState string) (string, []string) {
+	var otValue string
+	var others []string
+	for _, entry := range strings.Split(traceState, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.HasPrefix(entry, otTraceStateKey+"=") {
+			otValue = strings.TrimPrefix(entry, otTraceStateKey+"=")
+			continue
+		}
+		others = append(others, entry)
+	}
+	return otValue, others
+}
+
+// joinTraceState puts the updated OpenTelemetry entry first, as required by the W3C trace
+// context for the modified entries.
+func joinTraceState(otValue string, others []string) string {
+	return strings.Join(append([]string{otTraceStateKey + "=" + otValue}, others...), ",")
+}
+
+// splitOTValue returns the sub-keys and values of the OpenTelemetry trace state entry,
+// e.g. "th:c;rv:0123456789abcd", in their order.
+func splitOTValue(otValue string) [][2]string {
+	var fields [][2]string
+	for _, field := range strings.Split(otValue, ";") {
+		key, value, found := strings.Cut(field, ":")
+		if !found || key == "" {
+			continue
+		}
+		fields = append(fields, [2]string{key, value})
+	}
+	return fields
+}
+
+func joinOTValue(fields [][2]string) string {
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		parts = append(parts, f[0]+":"+f[1])
+	}
+	return strings.Join(parts, ";")
+}
+
+func setOTField(fields [][2]string, key, value string) [][2]string {
+	for i := range fields {
+		if fields[i][0] == key {
+			fields[i][1] = value
+			return fields
+		}
+	}
+	return append([][2]string{{key, value}}, fields...)
+}
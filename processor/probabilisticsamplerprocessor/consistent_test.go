@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package probabilisticsamplerprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func TestProbabilityThreshold(t *testing.T) {
+	tests := []struct {
+		percentage float32
+		threshold  uint64
+		encoded    string
+	}{
+		{percentage: 100, threshold: 0, encoded: "0"},
+		{percentage: 150, threshold: 0, encoded: "0"},
+		{percentage: 50, threshold: 0x80000000000000, encoded: "8"},
+		{percentage: 25, threshold: 0xc0000000000000, encoded: "c"},
+		{percentage: 12.5, threshold: 0xe0000000000000, encoded: "e"},
+		{percentage: 0, threshold: maxThreshold},
+	}
+	for _, tt := range tests {
+		threshold := probabilityThreshold(tt.percentage)
+		assert.Equal(t, tt.threshold, threshold, "percentage %v", tt.percentage)
+		if tt.encoded == "" {
+			continue
+		}
+		assert.Equal(t, tt.encoded, formatThreshold(threshold))
+		parsed, err := parseThreshold(tt.encoded)
+		require.NoError(t, err)
+		assert.Equal(t, threshold, parsed)
+	}
+
+	_, err := parseThreshold("")
+	assert.Error(t, err)
+	_, err = parseThreshold("0123456789abcde")
+	assert.Error(t, err)
+	_, err = parseThreshold("xyz")
+	assert.Error(t, err)
+}
+
+func TestConsistentSample(t *testing.T) {
+	// The randomness of the trace ID is its 7 last bytes: 0xa0000000000000
+	traceID := pcommon.TraceID([16]byte{0, 1, 2, 3, 4, 5, 6, 7, 0xff, 0xa0})
+
+	tests := []struct {
+		name       string
+		traceState string
+		percentage float32
+		sampled    bool
+		expected   string
+	}{
+		{
+			name:       "sampled with the trace ID randomness",
+			percentage: 50,
+			sampled:    true,
+			expected:   "ot=th:8",
+		},
+		{
+			name:       "not sampled with the trace ID randomness",
+			percentage: 25,
+		},
+		{
+			name:       "sampled with the explicit randomness",
+			traceState: "congo=t61,ot=rv:f0000000000000",
+			percentage: 25,
+			sampled:    true,
+			expected:   "ot=th:c;rv:f0000000000000,congo=t61",
+		},
+		{
+			name:       "not sampled with the explicit randomness",
+			traceState: "ot=rv:10000000000000",
+			percentage: 50,
+		},
+		{
+			name:       "higher upstream threshold kept",
+			traceState: "ot=th:9;rv:98000000000000",
+			percentage: 50,
+			sampled:    true,
+			expected:   "ot=th:9;rv:98000000000000",
+		},
+		{
+			name:       "lower upstream threshold raised",
+			traceState: "ot=th:4",
+			percentage: 50,
+			sampled:    true,
+			expected:   "ot=th:8",
+		},
+		{
+			name:       "higher upstream threshold rejects",
+			traceState: "ot=th:c",
+			percentage: 50,
+		},
+		{
+			name:       "invalid upstream values ignored",
+			traceState: "ot=th:xyz;rv:1",
+			percentage: 50,
+			sampled:    true,
+			expected:   "ot=th:8;rv:1",
+		},
+		{
+			name:       "nothing sampled",
+			percentage: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			span := ptrace.NewSpan()
+			span.SetTraceID(traceID)
+			span.TraceState().FromRaw(tt.traceState)
+
+			sampled := consistentSample(span, probabilityThreshold(tt.percentage))
+			assert.Equal(t, tt.sampled, sampled)
+			if tt.sampled {
+				assert.Equal(t, tt.expected, span.TraceState().AsRaw())
+			} else {
+				assert.Equal(t, tt.traceState, span.TraceState().AsRaw())
+			}
+		})
+	}
+}
+
+func Test_tracesamplerprocessor_ConsistentMode(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	cfg := &Config{
+		SamplingPercentage: 50,
+		SamplerMode:        consistentSamplerMode,
+	}
+	tsp, err := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetTraceID(pcommon.TraceID([16]byte{15: 1, 9: 0xc0}))
+	spans.AppendEmpty().SetTraceID(pcommon.TraceID([16]byte{15: 2, 9: 0x40}))
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), td))
+
+	require.Len(t, sink.AllTraces(), 1)
+	sampled := sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	require.Equal(t, 1, sampled.Len())
+	assert.Equal(t, pcommon.TraceID([16]byte{15: 1, 9: 0xc0}), sampled.At(0).TraceID())
+	assert.Equal(t, "ot=th:8", sampled.At(0).TraceState().AsRaw())
+}
@@ -44,6 +44,8 @@ const (
 type traceSamplerProcessor struct {
 	scaledSamplingRate uint32
 	hashSeed           uint32
+	samplerMode        SamplerMode
+	threshold          uint64
 	logger             *zap.Logger
 }
 
@@ -54,6 +56,8 @@ func newTracesProcessor(ctx context.Context, set processor.CreateSettings, cfg *
 		// Adjust sampling percentage on private so recalculations are avoided.
 		scaledSamplingRate: uint32(cfg.SamplingPercentage * percentageScaleFactor),
 		hashSeed:           cfg.HashSeed,
+		samplerMode:        cfg.SamplerMode,
+		threshold:          probabilityThreshold(cfg.SamplingPercentage),
 		logger:             set.Logger,
 	}
 
@@ -89,16 +93,23 @@ func (tsp *traceSamplerProcessor) processTraces(ctx context.Context, td ptrace.T
 					statCountTracesSampled.M(int64(1)),
 				)
 
-				// If one assumes random trace ids hashing may seems avoidable, however, traces can be coming from sources
-				// with various different criteria to generate trace id and perhaps were already sampled without hashing.
-				// Hashing here prevents bias due to such systems.
-				tidBytes := s.TraceID()
-				sampled := sp == mustSampleSpan ||
-					computeHash(tidBytes[:], tsp.hashSeed)&bitMaskHashBuckets < tsp.scaledSamplingRate
+				var sampled bool
+				policy := "trace_id_hash"
+				if tsp.samplerMode == consistentSamplerMode {
+					policy = "trace_state_threshold"
+					sampled = sp == mustSampleSpan || consistentSample(s, tsp.threshold)
+				} else {
+					// If one assumes random trace ids hashing may seems avoidable, however, traces can be coming from sources
+					// with various different criteria to generate trace id and perhaps were already sampled without hashing.
+					// Hashing here prevents bias due to such systems.
+					tidBytes := s.TraceID()
+					sampled = sp == mustSampleSpan ||
+						computeHash(tidBytes[:], tsp.hashSeed)&bitMaskHashBuckets < tsp.scaledSamplingRate
+				}
 
 				_ = stats.RecordWithTags(
 					ctx,
-					[]tag.Mutator{tag.Upsert(tagPolicyKey, "trace_id_hash"), tag.Upsert(tagSampledKey, strconv.FormatBool(sampled))},
+					[]tag.Mutator{tag.Upsert(tagPolicyKey, policy), tag.Upsert(tagSampledKey, strconv.FormatBool(sampled))},
 					statCountTracesSampled.M(int64(1)),
 				)
 				return !sampled
@@ -119,6 +119,26 @@ func Test_tracesamplerprocessor_SamplingPercentageRange(t *testing.T) {
 			numTracesPerBatch: 1,
 			acceptableDelta:   0.0,
 		},
+		{
+			name: "consistent_sampling_small",
+			cfg: &Config{
+				SamplingPercentage: 5,
+				SamplerMode:        consistentSamplerMode,
+			},
+			numBatches:        1e5,
+			numTracesPerBatch: 2,
+			acceptableDelta:   0.1,
+		},
+		{
+			name: "consistent_sampling_medium",
+			cfg: &Config{
+				SamplingPercentage: 50.0,
+				SamplerMode:        consistentSamplerMode,
+			},
+			numBatches:        1e5,
+			numTracesPerBatch: 4,
+			acceptableDelta:   0.2,
+		},
 	}
 	const testSvcName = "test-svc"
 	for _, tt := range tests {
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Unit is the unit in which the rates are expressed.
+type Unit string
+
+const (
+	// UnitRecords counts the spans, metric data points or log records.
+	UnitRecords Unit = "records"
+	// UnitBytes counts the size of the telemetry encoded as OTLP protobuf.
+	UnitBytes Unit = "bytes"
+)
+
+// Behavior is what happens to the telemetry exceeding a limit.
+type Behavior string
+
+const (
+	// BehaviorDrop drops the telemetry.
+	BehaviorDrop Behavior = "drop"
+	// BehaviorDelay holds the telemetry until it fits the limits, as long as it is within the
+	// maximum delay and the deadline of the request, and drops it otherwise.
+	BehaviorDelay Behavior = "delay"
+)
+
+const (
+	defaultMaxDelay = time.Second
+	defaultMaxKeys  = 1000
+)
+
+// LimitConfig defines a token bucket.
+type LimitConfig struct {
+	// Rate is the number of units per second the bucket is refilled with.
+	Rate float64 `mapstructure:"rate"`
+
+	// Burst is the capacity of the bucket, which is the number of units that can be accepted at
+	// once after an idle period. It defaults to the rate.
+	Burst float64 `mapstructure:"burst"`
+}
+
+// PerKeyConfig defines a limit applied separately to each value of a resource attribute.
+type PerKeyConfig struct {
+	LimitConfig `mapstructure:",squash"`
+
+	// Key is the resource attribute whose values are limited separately, such as a tenant.
+	// The resources without the attribute share the limit of the empty value.
+	Key string `mapstructure:"key"`
+
+	// Overrides are the limits of specific values of the key, replacing the default limit.
+	Overrides map[string]LimitConfig `mapstructure:"overrides"`
+
+	// MaxKeys is the maximum number of values tracked separately, 1000 by default. Once reached,
+	// the values which aren't tracked share a single limit.
+	MaxKeys int `mapstructure:"max_keys"`
+}
+
+// Config defines the configuration for the rate limit processor.
+type Config struct {
+	// Unit is the unit in which the rates are expressed, either `records` or `bytes`.
+	Unit Unit `mapstructure:"unit"`
+
+	// Global is the limit shared by all the telemetry going through the processor.
+	Global *LimitConfig `mapstructure:"global"`
+
+	// PerKey is the limit applied separately to each value of a resource attribute.
+	PerKey *PerKeyConfig `mapstructure:"per_key"`
+
+	// Behavior is what happens to the telemetry exceeding a limit, either `drop` or `delay`.
+	Behavior Behavior `mapstructure:"behavior"`
+
+	// MaxDelay is the maximum time the telemetry of a request is held with the `delay` behavior.
+	MaxDelay time.Duration `mapstructure:"max_delay"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	switch cfg.Unit {
+	case UnitRecords, UnitBytes:
+	default:
+		return fmt.Errorf("unsupported unit %q, must be one of %q or %q", cfg.Unit, UnitRecords, UnitBytes)
+	}
+	switch cfg.Behavior {
+	case BehaviorDrop:
+	case BehaviorDelay:
+		if cfg.MaxDelay <= 0 {
+			return errors.New("max_delay must be positive with the delay behavior")
+		}
+	default:
+		return fmt.Errorf("unsupported behavior %q, must be one of %q or %q", cfg.Behavior, BehaviorDrop, BehaviorDelay)
+	}
+	if cfg.Global == nil && cfg.PerKey == nil {
+		return errors.New("at least one of global or per_key must be set")
+	}
+	if cfg.Global != nil {
+		if err := cfg.Global.validate(); err != nil {
+			return fmt.Errorf("global: %w", err)
+		}
+	}
+	if cfg.PerKey != nil {
+		if err := cfg.PerKey.validate(); err != nil {
+			return fmt.Errorf("per_key: %w", err)
+		}
+	}
+	return nil
+}
+
+func (cfg *LimitConfig) validate() error {
+	if cfg.Rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if cfg.Burst < 0 {
+		return errors.New("burst must not be negative")
+	}
+	return nil
+}
+
+func (cfg *PerKeyConfig) validate() error {
+	if cfg.Key == "" {
+		return errors.New("key must be set")
+	}
+	if err := cfg.LimitConfig.validate(); err != nil {
+		return err
+	}
+	for value, limit := range cfg.Overrides {
+		limit := limit
+		if err := limit.validate(); err != nil {
+			return fmt.Errorf("overrides %q: %w", value, err)
+		}
+	}
+	if cfg.MaxKeys < 0 {
+		return errors.New("max_keys must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Unit:     UnitRecords,
+				Global:   &LimitConfig{Rate: 1000},
+				Behavior: BehaviorDrop,
+				MaxDelay: time.Second,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "per_key"),
+			expected: &Config{
+				Unit:   UnitBytes,
+				Global: &LimitConfig{Rate: 1048576, Burst: 2097152},
+				PerKey: &PerKeyConfig{
+					LimitConfig: LimitConfig{Rate: 65536},
+					Key:         "tenant.id",
+					Overrides: map[string]LimitConfig{
+						"premium": {Rate: 262144, Burst: 524288},
+					},
+					MaxKeys: 100,
+				},
+				Behavior: BehaviorDelay,
+				MaxDelay: 5 * time.Second,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_limit"),
+			errorMessage: "at least one of global or per_key must be set",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_unit"),
+			errorMessage: `unsupported unit "spans", must be one of "records" or "bytes"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_behavior"),
+			errorMessage: `unsupported behavior "block", must be one of "drop" or "delay"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_max_delay"),
+			errorMessage: "max_delay must be positive with the delay behavior",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_rate"),
+			errorMessage: "global: rate must be positive",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_key"),
+			errorMessage: "per_key: key must be set",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_override"),
+			errorMessage: `per_key: overrides "premium": burst must not be negative`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_max_keys"),
+			errorMessage: "per_key: max_keys must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
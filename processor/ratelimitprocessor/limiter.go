@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	limitGlobal = "global"
+	limitPerKey = "per_key"
+
+	// overflowKey identifies the limit shared by the keys which aren't tracked separately.
+	overflowKey = "_overflow"
+)
+
+// bucket is a token bucket, whose tokens may go below zero when it accepts telemetry larger
+// than the available tokens: the following telemetry has to wait until the debt is refilled.
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(cfg LimitConfig, now time.Time) *bucket {
+	burst := cfg.Burst
+	if burst == 0 {
+		burst = cfg.Rate
+	}
+	return &bucket{rate: cfg.Rate, burst: burst, tokens: burst, last: now}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// wait returns how long until the bucket holds enough tokens to accept the cost. Telemetry larger
+// than the burst is accepted once the bucket is full.
+func (b *bucket) wait(now time.Time, cost float64) time.Duration {
+	b.refill(now)
+	need := math.Min(cost, b.burst)
+	if b.tokens >= need {
+		return 0
+	}
+	return time.Duration((need - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *bucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.burst
+}
+
+// reservation is the outcome of a request for tokens.
+type reservation struct {
+	// ok is set when the tokens were taken, to be used after the wait.
+	ok   bool
+	wait time.Duration
+	// limit is the limit which delayed or rejected the request.
+	limit string
+	// key is the value of the key the request was accounted to.
+	key string
+
+	cost    float64
+	buckets []*bucket
+}
+
+// limiter holds the global bucket and the buckets of the keys.
+type limiter struct {
+	cfg *Config
+	now func() time.Time
+
+	mu       sync.Mutex
+	global   *bucket
+	keys     map[string]*bucket
+	overflow *bucket
+}
+
+func newLimiter(cfg *Config) *limiter {
+	l := &limiter{
+		cfg:  cfg,
+		now:  time.Now,
+		keys: make(map[string]*bucket),
+	}
+	now := l.now()
+	if cfg.Global != nil {
+		l.global = newBucket(*cfg.Global, now)
+	}
+	if cfg.PerKey != nil {
+		l.overflow = newBucket(cfg.PerKey.LimitConfig, now)
+	}
+	return l
+}
+
+// reserve takes the tokens for the cost from the global bucket and from the bucket of the key,
+// if they are available within maxWait. Otherwise no token is taken.
+func (l *limiter) reserve(key string, cost float64, maxWait time.Duration) reservation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+
+	var r reservation
+	var buckets []*bucket
+	if l.global != nil {
+		r.wait, r.limit = l.global.wait(now, cost), limitGlobal
+		buckets = append(buckets, l.global)
+	}
+	if l.cfg.PerKey != nil {
+		var b *bucket
+		b, r.key = l.bucket(key, now)
+		if wait := b.wait(now, cost); wait > r.wait || r.limit == "" {
+			r.wait, r.limit = wait, limitPerKey
+		}
+		buckets = append(buckets, b)
+	}
+	if r.wait > maxWait {
+		return r
+	}
+	for _, b := range buckets {
+		b.tokens -= cost
+	}
+	r.ok = true
+	r.cost, r.buckets = cost, buckets
+	if r.wait == 0 {
+		r.limit = ""
+	}
+	return r
+}
+
+// release gives back the tokens taken by a reservation whose telemetry isn't forwarded.
+func (l *limiter) release(r reservation) {
+	if !r.ok {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for _, b := range r.buckets {
+		b.refill(now)
+		b.tokens = math.Min(b.burst, b.tokens+r.cost)
+	}
+}
+
+// bucket returns the bucket of the key, creating it if the maximum number of keys isn't reached,
+// along with the key it is accounted to. l.mu must be held.
+func (l *limiter) bucket(key string, now time.Time) (*bucket, string) {
+	if b, ok := l.keys[key]; ok {
+		return b, key
+	}
+	maxKeys := l.cfg.PerKey.MaxKeys
+	if maxKeys == 0 {
+		maxKeys = defaultMaxKeys
+	}
+	if len(l.keys) >= maxKeys {
+		// a full bucket is equivalent to a new one, and can be dropped
+		for k, b := range l.keys {
+			if b.full(now) {
+				delete(l.keys, k)
+			}
+		}
+	}
+	if len(l.keys) >= maxKeys {
+		return l.overflow, overflowKey
+	}
+
+	limit := l.cfg.PerKey.LimitConfig
+	if override, ok := l.cfg.PerKey.Overrides[key]; ok {
+		limit = override
+	}
+	b := newBucket(limit, now)
+	l.keys[key] = b
+	return b, key
+}
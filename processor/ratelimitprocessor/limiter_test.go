@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestLimiter returns a limiter whose clock is advanced by the returned function.
+func newTestLimiter(cfg *Config) (*limiter, func(time.Duration)) {
+	now := time.Unix(1700000000, 0)
+	l := newLimiter(cfg)
+	l.now = func() time.Time { return now }
+	for _, b := range []*bucket{l.global, l.overflow} {
+		if b != nil {
+			b.last = now
+		}
+	}
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestLimiterGlobal(t *testing.T) {
+	l, advance := newTestLimiter(&Config{Global: &LimitConfig{Rate: 10, Burst: 20}})
+
+	r := l.reserve("", 20, 0)
+	assert.True(t, r.ok)
+	assert.Empty(t, r.limit)
+
+	r = l.reserve("", 1, 0)
+	assert.False(t, r.ok)
+	assert.Equal(t, limitGlobal, r.limit)
+	assert.Equal(t, 100*time.Millisecond, r.wait)
+
+	advance(500 * time.Millisecond)
+	assert.True(t, l.reserve("", 5, 0).ok)
+	assert.False(t, l.reserve("", 1, 0).ok)
+
+	// the bucket isn't filled beyond the burst
+	advance(time.Hour)
+	assert.True(t, l.reserve("", 20, 0).ok)
+	assert.False(t, l.reserve("", 1, 0).ok)
+}
+
+func TestLimiterDelay(t *testing.T) {
+	l, advance := newTestLimiter(&Config{Global: &LimitConfig{Rate: 10}})
+
+	assert.True(t, l.reserve("", 10, 0).ok)
+
+	r := l.reserve("", 5, time.Second)
+	assert.True(t, r.ok)
+	assert.Equal(t, limitGlobal, r.limit)
+	assert.Equal(t, 500*time.Millisecond, r.wait)
+
+	// the tokens of the delayed reservation are taken
+	r = l.reserve("", 5, 500*time.Millisecond)
+	assert.False(t, r.ok)
+	assert.Equal(t, time.Second, r.wait)
+
+	advance(time.Second)
+	r = l.reserve("", 5, 0)
+	assert.True(t, r.ok)
+}
+
+func TestLimiterLargerThanBurst(t *testing.T) {
+	l, advance := newTestLimiter(&Config{Global: &LimitConfig{Rate: 10}})
+
+	// a full bucket accepts a cost larger than its burst, and is in debt afterwards
+	assert.True(t, l.reserve("", 30, 0).ok)
+	r := l.reserve("", 1, time.Minute)
+	assert.True(t, r.ok)
+	assert.Equal(t, 2100*time.Millisecond, r.wait)
+
+	advance(3 * time.Second)
+	assert.True(t, l.reserve("", 1, 0).ok)
+}
+
+func TestLimiterPerKey(t *testing.T) {
+	l, advance := newTestLimiter(&Config{
+		Global: &LimitConfig{Rate: 100},
+		PerKey: &PerKeyConfig{
+			LimitConfig: LimitConfig{Rate: 10},
+			Key:         "tenant",
+			Overrides:   map[string]LimitConfig{"premium": {Rate: 50}},
+		},
+	})
+
+	r := l.reserve("a", 10, 0)
+	assert.True(t, r.ok)
+	assert.Equal(t, "a", r.key)
+	r = l.reserve("a", 1, 0)
+	assert.False(t, r.ok)
+	assert.Equal(t, limitPerKey, r.limit)
+
+	assert.True(t, l.reserve("b", 10, 0).ok)
+	assert.True(t, l.reserve("premium", 50, 0).ok)
+	assert.False(t, l.reserve("premium", 1, 0).ok)
+
+	// the global limit applies to all the keys
+	assert.True(t, l.reserve("c", 10, 0).ok)
+	assert.True(t, l.reserve("d", 10, 0).ok)
+	assert.True(t, l.reserve("e", 10, 0).ok)
+	r = l.reserve("f", 10, 0)
+	assert.False(t, r.ok)
+	assert.Equal(t, limitGlobal, r.limit)
+
+	advance(time.Second)
+	assert.True(t, l.reserve("a", 10, 0).ok)
+}
+
+func TestLimiterRelease(t *testing.T) {
+	l, _ := newTestLimiter(&Config{
+		Global: &LimitConfig{Rate: 10},
+		PerKey: &PerKeyConfig{LimitConfig: LimitConfig{Rate: 5}},
+	})
+
+	r := l.reserve("a", 4, 0)
+	assert.True(t, r.ok)
+
+	// the rejected reservations hold no token
+	rejected := l.reserve("a", 4, 0)
+	assert.False(t, rejected.ok)
+	l.release(rejected)
+	assert.Equal(t, 6.0, l.global.tokens)
+
+	l.release(r)
+	assert.Equal(t, 10.0, l.global.tokens)
+	assert.Equal(t, 5.0, l.keys["a"].tokens)
+}
+
+func TestLimiterMaxKeys(t *testing.T) {
+	l, advance := newTestLimiter(&Config{
+		PerKey: &PerKeyConfig{
+			LimitConfig: LimitConfig{Rate: 10},
+			Key:         "tenant",
+			MaxKeys:     2,
+		},
+	})
+
+	assert.Equal(t, "a", l.reserve("a", 10, 0).key)
+	assert.Equal(t, "b", l.reserve("b", 10, 0).key)
+
+	// the keys beyond the maximum share the overflow limit
+	r := l.reserve("c", 10, 0)
+	assert.True(t, r.ok)
+	assert.Equal(t, overflowKey, r.key)
+	r = l.reserve("d", 1, 0)
+	assert.False(t, r.ok)
+	assert.Equal(t, overflowKey, r.key)
+
+	// full buckets are evicted to make room for new keys
+	advance(time.Second)
+	r = l.reserve("c", 1, 0)
+	assert.True(t, r.ok)
+	assert.Equal(t, "c", r.key)
+	assert.Len(t, l.keys, 1)
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/obsreport"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor/internal/metadata"
+)
+
+var (
+	tagKeyKey, _   = tag.NewKey("key")
+	tagLimitKey, _ = tag.NewKey("limit")
+
+	mRecordsAccepted = stats.Int64("records_accepted", "Number of records accepted, including the delayed ones", stats.UnitDimensionless)
+	mRecordsDropped  = stats.Int64("records_dropped", "Number of records dropped for exceeding a limit", stats.UnitDimensionless)
+	mRecordsDelayed  = stats.Int64("records_delayed", "Number of records delayed to fit a limit", stats.UnitDimensionless)
+	mBytesAccepted   = stats.Int64("bytes_accepted", "Size of the records accepted, when the limits are in bytes", stats.UnitBytes)
+	mBytesDropped    = stats.Int64("bytes_dropped", "Size of the records dropped, when the limits are in bytes", stats.UnitBytes)
+	mThrottleDelay   = stats.Int64("throttle_delay", "Time the delayed records were held", stats.UnitMilliseconds)
+)
+
+// MetricViews returns the metrics views of the processor. The key tag is the value of the
+// per_key attribute, or `_overflow` for the values which aren't tracked separately.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mRecordsAccepted.Name()),
+			Measure:     mRecordsAccepted,
+			Description: mRecordsAccepted.Description(),
+			TagKeys:     []tag.Key{tagKeyKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mRecordsDropped.Name()),
+			Measure:     mRecordsDropped,
+			Description: mRecordsDropped.Description(),
+			TagKeys:     []tag.Key{tagKeyKey, tagLimitKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mRecordsDelayed.Name()),
+			Measure:     mRecordsDelayed,
+			Description: mRecordsDelayed.Description(),
+			TagKeys:     []tag.Key{tagKeyKey, tagLimitKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mBytesAccepted.Name()),
+			Measure:     mBytesAccepted,
+			Description: mBytesAccepted.Description(),
+			TagKeys:     []tag.Key{tagKeyKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mBytesDropped.Name()),
+			Measure:     mBytesDropped,
+			Description: mBytesDropped.Description(),
+			TagKeys:     []tag.Key{tagKeyKey, tagLimitKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mThrottleDelay.Name()),
+			Measure:     mThrottleDelay,
+			Description: mThrottleDelay.Description(),
+			TagKeys:     []tag.Key{tagKeyKey, tagLimitKey},
+			Aggregation: view.Distribution(1, 5, 10, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000),
+		},
+	}
+}
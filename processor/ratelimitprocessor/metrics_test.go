@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessorMetrics(t *testing.T) {
+	expectedViewNames := []string{
+		"processor/ratelimit/processor_ratelimit_records_accepted",
+		"processor/ratelimit/processor_ratelimit_records_dropped",
+		"processor/ratelimit/processor_ratelimit_records_delayed",
+		"processor/ratelimit/processor_ratelimit_bytes_accepted",
+		"processor/ratelimit/processor_ratelimit_bytes_dropped",
+		"processor/ratelimit/processor_ratelimit_throttle_delay",
+	}
+
+	views := MetricViews()
+	for i, viewName := range expectedViewNames {
+		assert.Equal(t, viewName, views[i].Name)
+	}
+}
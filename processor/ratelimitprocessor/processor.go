@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+)
+
+// rateLimiter admits the telemetry of each resource according to the limits, removing the
+// telemetry exceeding them, or holding it until it fits with the delay behavior.
+type rateLimiter struct {
+	logger  *zap.Logger
+	cfg     *Config
+	limiter *limiter
+
+	tracesSizer  ptrace.ProtoMarshaler
+	metricsSizer pmetric.ProtoMarshaler
+	logsSizer    plog.ProtoMarshaler
+
+	shutdownOnce sync.Once
+	// shutdownC releases the delayed telemetry when the processor shuts down.
+	shutdownC chan struct{}
+}
+
+func newRateLimiter(logger *zap.Logger, cfg *Config) *rateLimiter {
+	return &rateLimiter{
+		logger:    logger,
+		cfg:       cfg,
+		limiter:   newLimiter(cfg),
+		shutdownC: make(chan struct{}),
+	}
+}
+
+func (p *rateLimiter) shutdown(context.Context) error {
+	p.shutdownOnce.Do(func() { close(p.shutdownC) })
+	return nil
+}
+
+// deadline returns the time until which the telemetry of a request may be delayed: max_delay from
+// now, or the deadline of the context if it is earlier. It is zero without the delay behavior.
+// The deadline is shared by all the resources of the request, so that the request isn't delayed
+// by more than max_delay overall.
+func (p *rateLimiter) deadline(ctx context.Context) time.Time {
+	if p.cfg.Behavior != BehaviorDelay {
+		return time.Time{}
+	}
+	deadline := time.Now().Add(p.cfg.MaxDelay)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	return deadline
+}
+
+// release gives back the tokens of the reservations of a request which isn't forwarded.
+func (p *rateLimiter) release(reservations []reservation) {
+	for _, r := range reservations {
+		p.limiter.release(r)
+	}
+}
+
+// admit reserves the tokens for the telemetry of a resource, and waits for them until the deadline
+// with the delay behavior. It returns false if the telemetry must be dropped, and an error if the
+// context is done while waiting. The reservation must be released if the telemetry isn't forwarded.
+func (p *rateLimiter) admit(ctx context.Context, deadline time.Time, resource pcommon.Resource, records int, size func() int) (reservation, bool, error) {
+	if records == 0 {
+		return reservation{}, true, nil
+	}
+	var key string
+	if p.cfg.PerKey != nil {
+		if value, ok := resource.Attributes().Get(p.cfg.PerKey.Key); ok {
+			key = value.AsString()
+		}
+	}
+	cost, bytes := float64(records), 0
+	if p.cfg.Unit == UnitBytes {
+		bytes = size()
+		cost = float64(bytes)
+	}
+
+	var maxWait time.Duration
+	if !deadline.IsZero() {
+		maxWait = time.Until(deadline)
+		if maxWait < 0 {
+			maxWait = 0
+		}
+	}
+
+	r := p.limiter.reserve(key, cost, maxWait)
+	keyTag := tag.Upsert(tagKeyKey, r.key)
+	if !r.ok {
+		limitTag := tag.Upsert(tagLimitKey, r.limit)
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{keyTag, limitTag}, mRecordsDropped.M(int64(records)))
+		if p.cfg.Unit == UnitBytes {
+			_ = stats.RecordWithTags(ctx, []tag.Mutator{keyTag, limitTag}, mBytesDropped.M(int64(bytes)))
+		}
+		p.logger.Debug("Dropping records exceeding the limit",
+			zap.String("key", r.key), zap.String("limit", r.limit), zap.Int("records", records))
+		return r, false, nil
+	}
+
+	if r.wait > 0 {
+		limitTag := tag.Upsert(tagLimitKey, r.limit)
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{keyTag, limitTag}, mRecordsDelayed.M(int64(records)), mThrottleDelay.M(r.wait.Milliseconds()))
+		timer := time.NewTimer(r.wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-p.shutdownC:
+			// the delayed telemetry is released to not be lost
+		case <-ctx.Done():
+			return r, false, ctx.Err()
+		}
+	}
+
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{keyTag}, mRecordsAccepted.M(int64(records)))
+	if p.cfg.Unit == UnitBytes {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{keyTag}, mBytesAccepted.M(int64(bytes)))
+	}
+	return r, true, nil
+}
+
+func (p *rateLimiter) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	deadline := p.deadline(ctx)
+	var reservations []reservation
+	var err error
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		if err != nil {
+			return false
+		}
+		records := 0
+		for i := 0; i < rs.ScopeSpans().Len(); i++ {
+			records += rs.ScopeSpans().At(i).Spans().Len()
+		}
+		var r reservation
+		var admitted bool
+		r, admitted, err = p.admit(ctx, deadline, rs.Resource(), records, func() int {
+			single := ptrace.NewTraces()
+			rs.CopyTo(single.ResourceSpans().AppendEmpty())
+			return p.tracesSizer.TracesSize(single)
+		})
+		reservations = append(reservations, r)
+		return err == nil && !admitted
+	})
+	if err != nil {
+		p.release(reservations)
+		return td, err
+	}
+	if td.ResourceSpans().Len() == 0 {
+		return td, processorhelper.ErrSkipProcessingData
+	}
+	return td, nil
+}
+
+func (p *rateLimiter) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	deadline := p.deadline(ctx)
+	var reservations []reservation
+	var err error
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		if err != nil {
+			return false
+		}
+		var r reservation
+		var admitted bool
+		r, admitted, err = p.admit(ctx, deadline, rm.Resource(), dataPointCount(rm), func() int {
+			single := pmetric.NewMetrics()
+			rm.CopyTo(single.ResourceMetrics().AppendEmpty())
+			return p.metricsSizer.MetricsSize(single)
+		})
+		reservations = append(reservations, r)
+		return err == nil && !admitted
+	})
+	if err != nil {
+		p.release(reservations)
+		return md, err
+	}
+	if md.ResourceMetrics().Len() == 0 {
+		return md, processorhelper.ErrSkipProcessingData
+	}
+	return md, nil
+}
+
+func (p *rateLimiter) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	deadline := p.deadline(ctx)
+	var reservations []reservation
+	var err error
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		if err != nil {
+			return false
+		}
+		records := 0
+		for i := 0; i < rl.ScopeLogs().Len(); i++ {
+			records += rl.ScopeLogs().At(i).LogRecords().Len()
+		}
+		var r reservation
+		var admitted bool
+		r, admitted, err = p.admit(ctx, deadline, rl.Resource(), records, func() int {
+			single := plog.NewLogs()
+			rl.CopyTo(single.ResourceLogs().AppendEmpty())
+			return p.logsSizer.LogsSize(single)
+		})
+		reservations = append(reservations, r)
+		return err == nil && !admitted
+	})
+	if err != nil {
+		p.release(reservations)
+		return ld, err
+	}
+	if ld.ResourceLogs().Len() == 0 {
+		return ld, processorhelper.ErrSkipProcessingData
+	}
+	return ld, nil
+}
+
+func dataPointCount(rm pmetric.ResourceMetrics) int {
+	count := 0
+	for i := 0; i < rm.ScopeMetrics().Len(); i++ {
+		metrics := rm.ScopeMetrics().At(i).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			m := metrics.At(j)
+			switch m.Type() {
+			case pmetric.MetricTypeGauge:
+				count += m.Gauge().DataPoints().Len()
+			case pmetric.MetricTypeSum:
+				count += m.Sum().DataPoints().Len()
+			case pmetric.MetricTypeHistogram:
+				count += m.Histogram().DataPoints().Len()
+			case pmetric.MetricTypeExponentialHistogram:
+				count += m.ExponentialHistogram().DataPoints().Len()
+			case pmetric.MetricTypeSummary:
+				count += m.Summary().DataPoints().Len()
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap/zaptest"
+)
+
+// newTestRateLimiter returns a rate limiter whose buckets never refill by themselves, the delays
+// still being waited for.
+func newTestRateLimiter(t *testing.T, cfg *Config) *rateLimiter {
+	if cfg.Unit == "" {
+		cfg.Unit = UnitRecords
+	}
+	if cfg.Behavior == "" {
+		cfg.Behavior = BehaviorDrop
+	}
+	require.NoError(t, cfg.Validate())
+	p := newRateLimiter(zaptest.NewLogger(t), cfg)
+	p.limiter, _ = newTestLimiter(cfg)
+	t.Cleanup(func() { assert.NoError(t, p.shutdown(context.Background())) })
+	return p
+}
+
+// newTraces returns traces with a resource per tenant, holding the given number of spans.
+func newTraces(tenants []string, spans int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	for _, tenant := range tenants {
+		rs := td.ResourceSpans().AppendEmpty()
+		if tenant != "" {
+			rs.Resource().Attributes().PutStr("tenant.id", tenant)
+		}
+		ss := rs.ScopeSpans().AppendEmpty()
+		for i := 0; i < spans; i++ {
+			ss.Spans().AppendEmpty().SetName("span")
+		}
+	}
+	return td
+}
+
+func tenants(td ptrace.Traces) []string {
+	var result []string
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		var tenant string
+		if value, ok := td.ResourceSpans().At(i).Resource().Attributes().Get("tenant.id"); ok {
+			tenant = value.Str()
+		}
+		result = append(result, tenant)
+	}
+	return result
+}
+
+func TestProcessTracesDrop(t *testing.T) {
+	p := newTestRateLimiter(t, &Config{Global: &LimitConfig{Rate: 5}})
+
+	td, err := p.processTraces(context.Background(), newTraces([]string{"a", "b", "c"}, 2))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tenants(td))
+
+	_, err = p.processTraces(context.Background(), newTraces([]string{"a"}, 1))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+}
+
+func TestProcessTracesPerKey(t *testing.T) {
+	p := newTestRateLimiter(t, &Config{
+		PerKey: &PerKeyConfig{
+			LimitConfig: LimitConfig{Rate: 2},
+			Key:         "tenant.id",
+			Overrides:   map[string]LimitConfig{"premium": {Rate: 4}},
+		},
+	})
+
+	td, err := p.processTraces(context.Background(), newTraces([]string{"a", "a", "b", "", "premium", "premium"}, 2))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "", "premium", "premium"}, tenants(td))
+}
+
+func TestProcessLogs(t *testing.T) {
+	p := newTestRateLimiter(t, &Config{Global: &LimitConfig{Rate: 3}})
+
+	ld := plog.NewLogs()
+	for i := 0; i < 3; i++ {
+		sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+		sl.LogRecords().AppendEmpty()
+		sl.LogRecords().AppendEmpty()
+	}
+
+	ld, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ld.ResourceLogs().Len())
+}
+
+func TestProcessMetrics(t *testing.T) {
+	p := newTestRateLimiter(t, &Config{Global: &LimitConfig{Rate: 4}})
+
+	md := pmetric.NewMetrics()
+	for i := 0; i < 3; i++ {
+		metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		gauge := metrics.AppendEmpty().SetEmptyGauge()
+		gauge.DataPoints().AppendEmpty().SetIntValue(1)
+		gauge.DataPoints().AppendEmpty().SetIntValue(2)
+		metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	}
+	assert.Equal(t, 3, dataPointCount(md.ResourceMetrics().At(0)))
+
+	md, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, 1, md.ResourceMetrics().Len())
+}
+
+func TestProcessBytes(t *testing.T) {
+	marshaler := &ptrace.ProtoMarshaler{}
+	size := marshaler.TracesSize(newTraces([]string{"a"}, 10))
+	p := newTestRateLimiter(t, &Config{
+		Unit:   UnitBytes,
+		Global: &LimitConfig{Rate: float64(size)},
+	})
+
+	td, err := p.processTraces(context.Background(), newTraces([]string{"a", "b"}, 10))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, tenants(td))
+}
+
+func TestProcessDelay(t *testing.T) {
+	p := newTestRateLimiter(t, &Config{
+		Global:   &LimitConfig{Rate: 10},
+		Behavior: BehaviorDelay,
+		MaxDelay: 1050 * time.Millisecond,
+	})
+
+	start := time.Now()
+	td, err := p.processTraces(context.Background(), newTraces([]string{"a", "b"}, 10))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tenants(td))
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+
+	// the records which cannot fit within the maximum delay are dropped right away
+	start = time.Now()
+	_, err = p.processTraces(context.Background(), newTraces([]string{"c"}, 1))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestProcessDelayDeadline(t *testing.T) {
+	p := newTestRateLimiter(t, &Config{
+		Global:   &LimitConfig{Rate: 10},
+		Behavior: BehaviorDelay,
+		MaxDelay: time.Minute,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	td, err := p.processTraces(ctx, newTraces([]string{"a", "b"}, 10))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, tenants(td))
+}
+
+func TestProcessDelayCanceled(t *testing.T) {
+	p := newTestRateLimiter(t, &Config{
+		Global:   &LimitConfig{Rate: 10},
+		Behavior: BehaviorDelay,
+		MaxDelay: time.Minute,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	_, err := p.processTraces(ctx, newTraces([]string{"a", "b"}, 10))
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// the tokens of the request which isn't forwarded are given back
+	assert.Equal(t, 10.0, p.limiter.global.tokens)
+}
+
+func TestProcessDelaySharedDeadline(t *testing.T) {
+	cfg := &Config{
+		Unit:     UnitRecords,
+		Global:   &LimitConfig{Rate: 10},
+		Behavior: BehaviorDelay,
+		MaxDelay: 1500 * time.Millisecond,
+	}
+	require.NoError(t, cfg.Validate())
+	p := newRateLimiter(zaptest.NewLogger(t), cfg)
+
+	// each resource waits a second for its tokens, the third one would exceed the maximum delay
+	// of the request
+	start := time.Now()
+	td, err := p.processTraces(context.Background(), newTraces([]string{"a", "b", "c"}, 10))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tenants(td))
+	assert.Less(t, time.Since(start), 1500*time.Millisecond)
+}
+
+func TestProcessDelayShutdown(t *testing.T) {
+	p := newTestRateLimiter(t, &Config{
+		Global:   &LimitConfig{Rate: 10},
+		Behavior: BehaviorDelay,
+		MaxDelay: time.Minute,
+	})
+
+	time.AfterFunc(10*time.Millisecond, func() { assert.NoError(t, p.shutdown(context.Background())) })
+	td, err := p.processTraces(context.Background(), newTraces([]string{"a", "b"}, 10))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tenants(td))
+}
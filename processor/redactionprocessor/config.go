@@ -3,6 +3,12 @@
 
 package redactionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
 
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
 type Config struct {
 
 	// AllowAllKeys is a flag to allow all span attribute keys. Setting this
@@ -24,10 +30,49 @@ type Config struct {
 	// allowed span attributes. Values that match are masked
 	BlockedValues []string `mapstructure:"blocked_values"`
 
+	// AllowedValues is a list of regular expressions for allowing values of
+	// allowed span attributes. Values that match are not masked, even if
+	// they match one of the BlockedValues
+	AllowedValues []string `mapstructure:"allowed_values"`
+
+	// Hash replaces the values of the redacted attributes, and the masked
+	// parts of the values, by their hash instead of removing and masking them
+	Hash HashConfig `mapstructure:"hash"`
+
 	// Summary controls the verbosity level of the diagnostic attributes that
 	// the processor adds to the spans when it redacts or masks other
 	// attributes. In some contexts a list of redacted attributes leaks
 	// information, while it is valuable when integrating and testing a new
 	// configuration. Possible values are `debug`, `info`, and `silent`.
 	Summary string `mapstructure:"summary"`
+
+	// DryRun leaves the attributes unchanged. The processor only adds the
+	// summary attributes and records the telemetry of what it would have
+	// redacted or masked, so that a configuration can be validated against
+	// production traffic before it is enforced.
+	DryRun bool `mapstructure:"dry_run"`
+}
+
+// HashConfig configures the hashing of the redacted attributes and of the
+// masked values.
+type HashConfig struct {
+	// Enabled hashes the values instead of removing and masking them. The
+	// hash is the hex encoded SHA-256 of the salted value.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Salt is prepended to the values before they are hashed, so that the
+	// hashes of guessable values can't be precomputed.
+	Salt configopaque.String `mapstructure:"salt"`
+
+	// HMAC computes the HMAC-SHA-256 of the values keyed with the salt
+	// instead of the SHA-256 of the salted values.
+	HMAC bool `mapstructure:"hmac"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Hash.HMAC && cfg.Hash.Salt == "" {
+		return errors.New("hash: salt must be set when hmac is enabled")
+	}
+	return nil
 }
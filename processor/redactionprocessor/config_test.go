@@ -19,8 +19,9 @@ func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		id       component.ID
-		expected component.Config
+		id           component.ID
+		expected     component.Config
+		errorMessage string
 	}{
 		{
 			id: component.NewIDWithName(metadata.Type, ""),
@@ -36,6 +37,19 @@ func TestLoadConfig(t *testing.T) {
 			id:       component.NewIDWithName(metadata.Type, "empty"),
 			expected: createDefaultConfig(),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "hash"),
+			expected: &Config{
+				AllowAllKeys:  true,
+				BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+				AllowedValues: []string{"4111111111111111"},
+				Hash:          HashConfig{Enabled: true, Salt: "secret", HMAC: true},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_hmac"),
+			errorMessage: "hash: salt must be set when hmac is enabled",
+		},
 	}
 
 	for _, tt := range tests {
@@ -50,6 +64,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
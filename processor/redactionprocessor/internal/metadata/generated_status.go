@@ -8,5 +8,6 @@ import (
 
 const (
 	Type            = "redaction"
+	LogsStability   = component.StabilityLevelAlpha
 	TracesStability = component.StabilityLevelBeta
 )
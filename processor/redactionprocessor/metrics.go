@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redactionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/obsreport"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor/internal/metadata"
+)
+
+var (
+	tagKeyKey, _     = tag.NewKey("key")
+	tagPatternKey, _ = tag.NewKey("pattern")
+	tagDryRunKey, _  = tag.NewKey("dry_run")
+
+	mRedactedKeys = stats.Int64("redacted_keys", "Number of attributes redacted, or which would have been redacted in dry-run mode", stats.UnitDimensionless)
+	mMaskedValues = stats.Int64("masked_values", "Number of attribute values masked, or which would have been masked in dry-run mode", stats.UnitDimensionless)
+	mIgnoredKeys  = stats.Int64("ignored_keys", "Number of attributes not redacted because their key is ignored", stats.UnitDimensionless)
+)
+
+// MetricViews returns the metrics views of the processor. The key and pattern
+// tags are only set when the summary is debug.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mRedactedKeys.Name()),
+			Measure:     mRedactedKeys,
+			Description: mRedactedKeys.Description(),
+			TagKeys:     []tag.Key{tagKeyKey, tagDryRunKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mMaskedValues.Name()),
+			Measure:     mMaskedValues,
+			Description: mMaskedValues.Description(),
+			TagKeys:     []tag.Key{tagKeyKey, tagPatternKey, tagDryRunKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mIgnoredKeys.Name()),
+			Measure:     mIgnoredKeys,
+			Description: mIgnoredKeys.Description(),
+			TagKeys:     []tag.Key{tagDryRunKey},
+			Aggregation: view.Sum(),
+		},
+	}
+}
@@ -5,17 +5,29 @@ package redactionprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
 
-const attrValuesSeparator = ","
+const (
+	attrValuesSeparator = ","
+	// bodyKey identifies the log body in the summary and the telemetry of the
+	// masked values
+	bodyKey = "body"
+)
 
 type redaction struct {
 	// Attribute keys allowed in a span
@@ -24,6 +36,8 @@ type redaction struct {
 	ignoreList map[string]string
 	// Attribute values blocked in a span
 	blockRegexList map[string]*regexp.Regexp
+	// Attribute values allowed in a span, even if they match a blocked value
+	allowRegexList map[string]*regexp.Regexp
 	// Redaction processor configuration
 	config *Config
 	// Logger
@@ -34,16 +48,21 @@ type redaction struct {
 func newRedaction(ctx context.Context, config *Config, logger *zap.Logger) (*redaction, error) {
 	allowList := makeAllowList(config)
 	ignoreList := makeIgnoreList(config)
-	blockRegexList, err := makeBlockRegexList(ctx, config)
+	blockRegexList, err := makeRegexList(ctx, config.BlockedValues, "block")
 	if err != nil {
 		// TODO: Placeholder for an error metric in the next PR
 		return nil, fmt.Errorf("failed to process block list: %w", err)
 	}
+	allowRegexList, err := makeRegexList(ctx, config.AllowedValues, "allow")
+	if err != nil {
+		return nil, fmt.Errorf("failed to process allowed values: %w", err)
+	}
 
 	return &redaction{
 		allowList:      allowList,
 		ignoreList:     ignoreList,
 		blockRegexList: blockRegexList,
+		allowRegexList: allowRegexList,
 		config:         config,
 		logger:         logger,
 	}, nil
@@ -75,13 +94,49 @@ func (s *redaction) processResourceSpan(ctx context.Context, rs ptrace.ResourceS
 
 			// Attributes can also be part of span
 			s.processAttrs(ctx, spanAttrs)
+
+			// and of its events
+			for l := 0; l < span.Events().Len(); l++ {
+				s.processAttrs(ctx, span.Events().At(l).Attributes())
+			}
 		}
 	}
 }
 
-// processAttrs redacts the attributes of a resource span or a span
-func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
-	// TODO: Use the context for recording metrics
+// processLogs implements ProcessLogsFunc. It processes the incoming data
+// and returns the data to be sent to the next component
+func (s *redaction) processLogs(ctx context.Context, logs plog.Logs) (plog.Logs, error) {
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		rl := logs.ResourceLogs().At(i)
+		s.processResourceLog(ctx, rl)
+	}
+	return logs, nil
+}
+
+// processResourceLog processes the resource and all of its log records: their
+// attributes, and the blocked values of their string bodies
+func (s *redaction) processResourceLog(ctx context.Context, rl plog.ResourceLogs) {
+	s.processAttrs(ctx, rl.Resource().Attributes())
+
+	for j := 0; j < rl.ScopeLogs().Len(); j++ {
+		sl := rl.ScopeLogs().At(j)
+		for k := 0; k < sl.LogRecords().Len(); k++ {
+			lr := sl.LogRecords().At(k)
+			s.processAttrs(ctx, lr.Attributes())
+
+			if lr.Body().Type() != pcommon.ValueTypeStr {
+				continue
+			}
+			blocked := s.maskValue(ctx, bodyKey, lr.Body())
+			s.addMetaAttrs(blocked, lr.Attributes(), maskedValues, maskedValueCount)
+		}
+	}
+}
+
+// processAttrs redacts the attributes of a resource span or a span. In dry-run
+// mode, the attributes are left unchanged, and only the summary of what would
+// have been redacted is recorded.
+func (s *redaction) processAttrs(ctx context.Context, attributes pcommon.Map) {
 	var toDelete []string
 	var toBlock []string
 	var ignoring []string
@@ -98,6 +153,7 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 		// don't delete or redact the attribute if it should be ignored
 		if _, ignored := s.ignoreList[k]; ignored {
 			ignoring = append(ignoring, k)
+			s.record(ctx, mIgnoredKeys)
 			// Skip to the next attribute
 			return true
 		}
@@ -106,28 +162,27 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 		if !s.config.AllowAllKeys {
 			if _, allowed := s.allowList[k]; !allowed {
 				toDelete = append(toDelete, k)
+				s.record(ctx, mRedactedKeys, tag.Upsert(tagKeyKey, k))
 				// Skip to the next attribute
 				return true
 			}
 		}
 
 		// Mask any blocked values for the other attributes
-		strVal := value.Str()
-		for _, compiledRE := range s.blockRegexList {
-			match := compiledRE.MatchString(strVal)
-			if match {
-				toBlock = append(toBlock, k)
-
-				maskedValue := compiledRE.ReplaceAllString(strVal, "****")
-				value.SetStr(maskedValue)
-			}
-		}
+		toBlock = append(toBlock, s.maskValue(ctx, k, value)...)
 		return true
 	})
 
-	// Delete the attributes on the redaction list
-	for _, k := range toDelete {
-		attributes.Remove(k)
+	// Delete, or hash, the attributes on the redaction list
+	if !s.config.DryRun {
+		for _, k := range toDelete {
+			if !s.config.Hash.Enabled {
+				attributes.Remove(k)
+				continue
+			}
+			value, _ := attributes.Get(k)
+			value.SetStr(s.hash(value.AsString()))
+		}
 	}
 	// Add diagnostic information to the span
 	s.addMetaAttrs(toDelete, attributes, redactedKeys, redactedKeyCount)
@@ -135,6 +190,52 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	s.addMetaAttrs(ignoring, attributes, "", ignoredKeyCount)
 }
 
+// maskValue masks the parts of a string value matching the blocked values,
+// unless the value matches one of the allowed values. The blocked parts are
+// replaced by their hash when hashing is enabled. It returns the key once for
+// each blocked value matched.
+func (s *redaction) maskValue(ctx context.Context, key string, value pcommon.Value) []string {
+	var blocked []string
+	strVal := value.Str()
+	for _, compiledRE := range s.allowRegexList {
+		if compiledRE.MatchString(strVal) {
+			return nil
+		}
+	}
+	for pattern, compiledRE := range s.blockRegexList {
+		match := compiledRE.MatchString(strVal)
+		if match {
+			blocked = append(blocked, key)
+			s.record(ctx, mMaskedValues, tag.Upsert(tagKeyKey, key), tag.Upsert(tagPatternKey, pattern))
+			if s.config.DryRun {
+				continue
+			}
+
+			var maskedValue string
+			if s.config.Hash.Enabled {
+				maskedValue = compiledRE.ReplaceAllStringFunc(strVal, s.hash)
+			} else {
+				maskedValue = compiledRE.ReplaceAllString(strVal, "****")
+			}
+			value.SetStr(maskedValue)
+		}
+	}
+	return blocked
+}
+
+// hash returns the hex encoded SHA-256 hash of the salted value, or its
+// HMAC-SHA-256 keyed with the salt
+func (s *redaction) hash(value string) string {
+	h := sha256.New()
+	if s.config.Hash.HMAC {
+		h = hmac.New(sha256.New, []byte(s.config.Hash.Salt))
+	} else {
+		_, _ = h.Write([]byte(s.config.Hash.Salt))
+	}
+	_, _ = h.Write([]byte(value))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // addMetaAttrs adds diagnostic information about redacted or masked attribute keys
 func (s *redaction) addMetaAttrs(redactedAttrs []string, attributes pcommon.Map, valuesAttr, countAttr string) {
 	redactedCount := int64(len(redactedAttrs))
@@ -158,6 +259,21 @@ func (s *redaction) addMetaAttrs(redactedAttrs []string, attributes pcommon.Map,
 	}
 }
 
+// record records a measurement of the redaction telemetry according to the
+// summary level: the tags identifying the keys and patterns are only kept when
+// the summary is debug, and nothing is recorded when the summary is silent.
+func (s *redaction) record(ctx context.Context, measure *stats.Int64Measure, mutators ...tag.Mutator) {
+	switch s.config.Summary {
+	case debug:
+	case info:
+		mutators = nil
+	default:
+		return
+	}
+	mutators = append(mutators, tag.Upsert(tagDryRunKey, strconv.FormatBool(s.config.DryRun)))
+	_ = stats.RecordWithTags(ctx, mutators, measure.M(1))
+}
+
 const (
 	debug            = "debug"
 	info             = "info"
@@ -202,16 +318,16 @@ func makeIgnoreList(c *Config) map[string]string {
 	return ignoreList
 }
 
-// makeBlockRegexList precompiles all the blocked regex patterns
-func makeBlockRegexList(_ context.Context, config *Config) (map[string]*regexp.Regexp, error) {
-	blockRegexList := make(map[string]*regexp.Regexp, len(config.BlockedValues))
-	for _, pattern := range config.BlockedValues {
+// makeRegexList precompiles all the regex patterns of the block or allow list
+func makeRegexList(_ context.Context, patterns []string, list string) (map[string]*regexp.Regexp, error) {
+	regexList := make(map[string]*regexp.Regexp, len(patterns))
+	for _, pattern := range patterns {
 		re, err := regexp.Compile(pattern)
 		if err != nil {
 			// TODO: Placeholder for an error metric in the next PR
-			return nil, fmt.Errorf("error compiling regex in block list: %w", err)
+			return nil, fmt.Errorf("error compiling regex in %s list: %w", list, err)
 		}
-		blockRegexList[pattern] = re
+		regexList[pattern] = re
 	}
-	return blockRegexList, nil
+	return regexList, nil
 }
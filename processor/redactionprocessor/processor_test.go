@@ -5,13 +5,20 @@ package redactionprocessor
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap/zaptest"
 )
@@ -103,7 +110,114 @@ func TestAllowAllKeysMaskValues(t *testing.T) {
 	assert.Equal(t, "placeholder ****", value.Str())
 }
 
-// TODO: Test redaction with metric tags in a metrics PR
+// TestDryRun validates that the processor leaves the attributes unchanged in
+// dry-run mode, while summarizing what it would have redacted and masked
+func TestDryRun(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"id", "name"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "debug",
+		DryRun:        true,
+	}
+	allowed := map[string]pcommon.Value{
+		"id": pcommon.NewValueInt(5),
+	}
+	masked := map[string]pcommon.Value{
+		"name": pcommon.NewValueStr("placeholder 4111111111111111"),
+	}
+	redacted := map[string]pcommon.Value{
+		"credit_card": pcommon.NewValueStr("4111111111111111"),
+	}
+
+	outTraces := runTest(t, allowed, redacted, masked, nil, config)
+
+	attr := outTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+	for _, values := range []map[string]pcommon.Value{allowed, masked, redacted} {
+		for k, v := range values {
+			val, ok := attr.Get(k)
+			assert.True(t, ok)
+			assert.Equal(t, v.AsRaw(), val.AsRaw())
+		}
+	}
+	val, ok := attr.Get(redactedKeys)
+	assert.True(t, ok)
+	assert.Equal(t, "credit_card", val.Str())
+	val, ok = attr.Get(redactedKeyCount)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), val.Int())
+	val, ok = attr.Get(maskedValues)
+	assert.True(t, ok)
+	assert.Equal(t, "name", val.Str())
+	val, ok = attr.Get(maskedValueCount)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), val.Int())
+}
+
+// TestRedactTelemetry validates that the processor records the redacted and
+// masked attributes, tagged with their key and pattern when set to full debug output
+func TestRedactTelemetry(t *testing.T) {
+	views := MetricViews()
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	tests := []struct {
+		summary     string
+		dryRun      bool
+		expectedKey string
+	}{
+		{summary: "debug", dryRun: true, expectedKey: "telemetry_card"},
+		{summary: "info", dryRun: false, expectedKey: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.summary, func(t *testing.T) {
+			config := &Config{
+				AllowedKeys:   []string{"telemetry_name"},
+				BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+				Summary:       tt.summary,
+				DryRun:        tt.dryRun,
+			}
+			masked := map[string]pcommon.Value{
+				"telemetry_name": pcommon.NewValueStr("placeholder 4111111111111111"),
+			}
+			redacted := map[string]pcommon.Value{
+				"telemetry_card": pcommon.NewValueStr("4111111111111111"),
+			}
+			redactedTags := []tag.Tag{{Key: tagDryRunKey, Value: strconv.FormatBool(tt.dryRun)}}
+			maskedTags := []tag.Tag{{Key: tagDryRunKey, Value: strconv.FormatBool(tt.dryRun)}}
+			if tt.expectedKey != "" {
+				redactedTags = append(redactedTags, tag.Tag{Key: tagKeyKey, Value: tt.expectedKey})
+				maskedTags = append(maskedTags,
+					tag.Tag{Key: tagKeyKey, Value: "telemetry_name"},
+					tag.Tag{Key: tagPatternKey, Value: "4[0-9]{12}(?:[0-9]{3})?"})
+			}
+			redactedBefore := sumForTags(t, views[0].Name, redactedTags)
+			maskedBefore := sumForTags(t, views[1].Name, maskedTags)
+
+			runTest(t, nil, redacted, masked, nil, config)
+
+			assert.Equal(t, 1.0, sumForTags(t, views[0].Name, redactedTags)-redactedBefore)
+			assert.Equal(t, 1.0, sumForTags(t, views[1].Name, maskedTags)-maskedBefore)
+		})
+	}
+}
+
+// sumForTags returns the value of the row of the view with exactly the given tags
+func sumForTags(t *testing.T, viewName string, tags []tag.Tag) float64 {
+	rows, err := view.RetrieveData(viewName)
+	require.NoError(t, err)
+	for _, row := range rows {
+		if assert.ObjectsAreEqualValues(sortedTags(tags), sortedTags(row.Tags)) {
+			return row.Data.(*view.SumData).Value
+		}
+	}
+	return 0
+}
+
+func sortedTags(tags []tag.Tag) []tag.Tag {
+	sorted := append([]tag.Tag(nil), tags...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key.Name() < sorted[j].Key.Name() })
+	return sorted
+}
 
 // TestRedactSummaryDebug validates that the processor writes a verbose summary
 // of any attributes it deleted to the new redaction.redacted.keys and
@@ -367,6 +481,148 @@ func TestProcessAttrsAppliedTwice(t *testing.T) {
 	assert.Equal(t, int64(2), val.Int())
 }
 
+// TestHashValues validates that the processor hashes the values of the
+// redacted attributes and the masked parts of the values when hashing is
+// enabled, instead of removing and masking them
+func TestHashValues(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"name"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Hash:          HashConfig{Enabled: true, Salt: "salt"},
+	}
+	masked := map[string]pcommon.Value{
+		"name": pcommon.NewValueStr("placeholder 4111111111111111"),
+	}
+	redacted := map[string]pcommon.Value{
+		"id": pcommon.NewValueInt(5),
+	}
+
+	outTraces := runTest(t, nil, redacted, masked, nil, config)
+
+	attr := outTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+	idValue, ok := attr.Get("id")
+	require.True(t, ok)
+	assert.Equal(t, sha256Hex("salt5"), idValue.Str())
+	nameValue, _ := attr.Get("name")
+	assert.Equal(t, "placeholder "+sha256Hex("salt4111111111111111"), nameValue.Str())
+}
+
+// TestHashValuesHMAC validates that the processor computes the HMAC of the
+// values keyed with the salt when enabled
+func TestHashValuesHMAC(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:  true,
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Hash:          HashConfig{Enabled: true, Salt: "key", HMAC: true},
+	}
+	masked := map[string]pcommon.Value{
+		"credit_card": pcommon.NewValueStr("4111111111111111"),
+	}
+
+	outTraces := runTest(t, nil, nil, masked, nil, config)
+
+	mac := hmac.New(sha256.New, []byte("key"))
+	_, _ = mac.Write([]byte("4111111111111111"))
+	attr := outTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+	value, _ := attr.Get("credit_card")
+	assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), value.Str())
+}
+
+func sha256Hex(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// TestAllowedValues validates that the processor does not mask the values
+// matching an allowed value, even if they match a blocked value
+func TestAllowedValues(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:  true,
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		AllowedValues: []string{"^4111111111111111$"},
+		Summary:       "debug",
+	}
+	allowed := map[string]pcommon.Value{
+		"test_card": pcommon.NewValueStr("4111111111111111"),
+	}
+	masked := map[string]pcommon.Value{
+		"credit_card": pcommon.NewValueStr("4222222222222"),
+	}
+
+	outTraces := runTest(t, allowed, nil, masked, nil, config)
+
+	attr := outTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+	value, _ := attr.Get("test_card")
+	assert.Equal(t, "4111111111111111", value.Str())
+	value, _ = attr.Get("credit_card")
+	assert.Equal(t, "****", value.Str())
+	value, _ = attr.Get(maskedValues)
+	assert.Equal(t, "credit_card", value.Str())
+}
+
+func TestInvalidAllowedValues(t *testing.T) {
+	_, err := newRedaction(context.Background(), &Config{AllowedValues: []string{"("}}, zaptest.NewLogger(t))
+	assert.ErrorContains(t, err, "error compiling regex in allow list")
+}
+
+// TestSpanEvents validates that the processor redacts the attributes of the
+// span events like the span attributes
+func TestSpanEvents(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"message"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+	}
+	inBatch := ptrace.NewTraces()
+	span := inBatch.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	event := span.Events().AppendEmpty()
+	event.Attributes().PutStr("message", "paid with 4111111111111111")
+	event.Attributes().PutStr("email", "user@example.com")
+
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	_, err = processor.processTraces(context.Background(), inBatch)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"message": "paid with ****"}, event.Attributes().AsRaw())
+}
+
+// TestProcessLogs validates that the processor redacts the attributes of the
+// resources and log records, and masks the blocked values of the string log
+// bodies
+func TestProcessLogs(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"service.name", "message"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "debug",
+	}
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "payments")
+	rl.Resource().Attributes().PutStr("host.ip", "10.0.0.1")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	lr := records.AppendEmpty()
+	lr.Body().SetStr("card 4111111111111111 charged")
+	lr.Attributes().PutStr("message", "paid with 4111111111111111")
+	mapBody := records.AppendEmpty()
+	mapBody.Body().SetEmptyMap().PutStr("card", "4111111111111111")
+
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	_, err = processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	resourceAttrs := rl.Resource().Attributes()
+	_, ok := resourceAttrs.Get("host.ip")
+	assert.False(t, ok)
+	assert.Equal(t, "card **** charged", lr.Body().Str())
+	assert.Equal(t, map[string]interface{}{
+		"message":        "paid with ****",
+		maskedValues:     "body,message",
+		maskedValueCount: int64(2),
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"card": "4111111111111111"}, mapBody.Body().Map().AsRaw())
+}
+
 // runTest transforms the test input data and passes it through the processor
 func runTest(
 	t *testing.T,
@@ -18,6 +18,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/ec2"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/lambda"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/custom"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
@@ -51,6 +52,13 @@ func TestLoadConfig(t *testing.T) {
 		ResourceAttributes: system.CreateDefaultConfig().ResourceAttributes,
 	}
 
+	customConfig := detectorCreateDefaultConfig()
+	customConfig.CustomConfig = custom.Config{
+		Command:  []string{"/usr/local/bin/site-metadata", "--json"},
+		Timeout:  time.Second,
+		CacheTTL: 10 * time.Minute,
+	}
+
 	resourceAttributesConfig := detectorCreateDefaultConfig()
 	ec2ResourceAttributesConfig := ec2.CreateDefaultConfig()
 	ec2ResourceAttributesConfig.ResourceAttributes.HostName.Enabled = false
@@ -130,6 +138,33 @@ func TestLoadConfig(t *testing.T) {
 				DetectorConfig:     resourceAttributesConfig,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				Detectors:          []string{"env", "custom"},
+				HTTPClientSettings: cfg,
+				Override:           false,
+				DetectorConfig:     customConfig,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "custom_invalid"),
+			errorMessage: "only one of endpoint or command can be set",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "refresh"),
+			expected: &Config{
+				Detectors:          []string{"env", "ec2"},
+				HTTPClientSettings: cfg,
+				Override:           false,
+				DetectorConfig:     detectorCreateDefaultConfig(),
+				RefreshInterval:    5 * time.Minute,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "refresh_invalid"),
+			errorMessage: "refresh_interval must not be negative",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "invalid"),
 			errorMessage: "hostname_sources contains invalid value: \"invalid_source\"",
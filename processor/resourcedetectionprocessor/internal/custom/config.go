@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package custom // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/custom"
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	defaultTimeout  = 5 * time.Second
+	defaultCacheTTL = 5 * time.Minute
+)
+
+// Config defines user-specified configurations unique to the custom detector.
+// Exactly one of Endpoint or Command must be set when the detector is used.
+type Config struct {
+	// Endpoint is the URL of a local HTTP endpoint returning the resource
+	// attributes as a JSON object.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Command is a command, and its arguments, writing the resource attributes
+	// as a JSON object to its standard output.
+	Command []string `mapstructure:"command"`
+
+	// Timeout is the maximum duration of the request or of the command. (**default**: `5s`)
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// CacheTTL is how long the detected attributes are reused, by the processors
+	// created with the same endpoint or command, before they are fetched again.
+	// The attributes are not cached when set to 0. (**default**: `5m`)
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+// Validate config
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint != "" && len(cfg.Command) > 0 {
+		return errors.New("only one of endpoint or command can be set")
+	}
+	if cfg.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	if cfg.CacheTTL < 0 {
+		return errors.New("cache_ttl must not be negative")
+	}
+	return nil
+}
+
+func CreateDefaultConfig() Config {
+	return Config{
+		Timeout:  defaultTimeout,
+		CacheTTL: defaultCacheTTL,
+	}
+}
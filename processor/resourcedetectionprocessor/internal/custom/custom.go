@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package custom provides a detector that loads site-specific resource
+// information, e.g. the rack or the datacenter of the host, as a JSON object
+// returned by a local HTTP endpoint or by a command.
+package custom // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/custom"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+const (
+	// TypeStr is type of detector.
+	TypeStr = "custom"
+
+	// maxResponseSize limits the size of the JSON object read from the endpoint.
+	maxResponseSize = 1 << 20
+)
+
+var _ internal.Detector = (*detector)(nil)
+
+// cache holds the attributes detected by all the custom detectors, by source,
+// so that the processors sharing an endpoint or a command fetch them once.
+var cache = &attributesCache{entries: map[string]cacheEntry{}}
+
+type detector struct {
+	endpoint string
+	command  []string
+	timeout  time.Duration
+	cacheTTL time.Duration
+	client   *http.Client
+}
+
+// NewDetector creates a new custom resource detector
+func NewDetector(_ processor.CreateSettings, dcfg internal.DetectorConfig) (internal.Detector, error) {
+	cfg := dcfg.(Config)
+	if cfg.Endpoint == "" && len(cfg.Command) == 0 {
+		return nil, errors.New("one of endpoint or command must be set")
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &detector{
+		endpoint: cfg.Endpoint,
+		command:  cfg.Command,
+		timeout:  timeout,
+		cacheTTL: cfg.CacheTTL,
+		client:   &http.Client{},
+	}, nil
+}
+
+// Detect returns a resource with the attributes of the endpoint or of the command
+func (d *detector) Detect(ctx context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	res := pcommon.NewResource()
+	key := d.cacheKey()
+
+	attrs, ok := cache.get(key, time.Now())
+	if !ok {
+		if attrs, err = d.fetch(ctx); err != nil {
+			return res, "", err
+		}
+		if d.cacheTTL > 0 {
+			cache.put(key, attrs, time.Now().Add(d.cacheTTL))
+		}
+	}
+
+	if err = res.Attributes().FromRaw(attrs); err != nil {
+		return res, "", err
+	}
+	return res, "", nil
+}
+
+func (d *detector) fetch(ctx context.Context) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	var data []byte
+	var err error
+	if d.endpoint != "" {
+		data, err = d.fetchEndpoint(ctx)
+	} else {
+		data, err = d.runCommand(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseAttributes(data)
+}
+
+func (d *detector) cacheKey() string {
+	if d.endpoint != "" {
+		return "endpoint:" + d.endpoint
+	}
+	return "command:" + strings.Join(d.command, "\x00")
+}
+
+func (d *detector) fetchEndpoint(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the request to %q: %w", d.endpoint, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %q: %w", d.endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to query %q: unexpected status %q", d.endpoint, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of %q: %w", d.endpoint, err)
+	}
+	return data, nil
+}
+
+func (d *detector) runCommand(ctx context.Context) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, d.command[0], d.command[1:]...) // #nosec G204
+	cmd.Stderr = &stderr
+	data, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("failed to run %q: %w: %s", d.command[0], err, msg)
+		}
+		return nil, fmt.Errorf("failed to run %q: %w", d.command[0], err)
+	}
+	return data, nil
+}
+
+// parseAttributes decodes a JSON object, keeping its integer numbers as integers.
+func parseAttributes(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var attrs map[string]interface{}
+	if err := decoder.Decode(&attrs); err != nil {
+		return nil, fmt.Errorf("failed to decode the resource attributes: %w", err)
+	}
+	for k, v := range attrs {
+		attrs[k] = convertNumbers(v)
+	}
+	return attrs, nil
+}
+
+func convertNumbers(v interface{}) interface{} {
+	switch value := v.(type) {
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		f, _ := value.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range value {
+			value[k] = convertNumbers(e)
+		}
+	case []interface{}:
+		for i, e := range value {
+			value[i] = convertNumbers(e)
+		}
+	}
+	return v
+}
+
+type cacheEntry struct {
+	attrs  map[string]interface{}
+	expiry time.Time
+}
+
+type attributesCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func (c *attributesCache) get(key string, now time.Time) (map[string]interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expiry) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.attrs, true
+}
+
+func (c *attributesCache) put(key string, attrs map[string]interface{}, expiry time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cacheEntry{attrs: attrs, expiry: expiry}
+}
@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package custom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func TestNewDetector(t *testing.T) {
+	_, err := NewDetector(processortest.NewNopCreateSettings(), CreateDefaultConfig())
+	assert.EqualError(t, err, "one of endpoint or command must be set")
+
+	cfg := CreateDefaultConfig()
+	cfg.Endpoint = "http://localhost:8080"
+	d, err := NewDetector(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestValidate(t *testing.T) {
+	cfg := CreateDefaultConfig()
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Endpoint = "http://localhost:8080"
+	cfg.Command = []string{"cat"}
+	assert.EqualError(t, cfg.Validate(), "only one of endpoint or command can be set")
+
+	cfg = CreateDefaultConfig()
+	cfg.Timeout = -time.Second
+	assert.EqualError(t, cfg.Validate(), "timeout must not be negative")
+
+	cfg = CreateDefaultConfig()
+	cfg.CacheTTL = -time.Second
+	assert.EqualError(t, cfg.Validate(), "cache_ttl must not be negative")
+}
+
+func TestDetectEndpoint(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"datacenter": "dc1", "rack": 12, "cost_center": "eng", "weight": 0.5, "gpu": true, "zones": ["a", "b"]}`))
+	}))
+	defer srv.Close()
+
+	// The test name keeps the endpoint out of the cache of the other tests
+	cfg := CreateDefaultConfig()
+	cfg.Endpoint = srv.URL + "/" + t.Name()
+	d, err := NewDetector(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	expected := map[string]interface{}{
+		"datacenter":  "dc1",
+		"rack":        int64(12),
+		"cost_center": "eng",
+		"weight":      0.5,
+		"gpu":         true,
+		"zones":       []interface{}{"a", "b"},
+	}
+	res, schemaURL, err := d.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "", schemaURL)
+	assert.Equal(t, expected, res.Attributes().AsRaw())
+
+	// The attributes are cached for the detectors of the same endpoint
+	d, err = NewDetector(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	res, _, err = d.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, expected, res.Attributes().AsRaw())
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestDetectEndpointNoCache(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"rack": "r1"}`))
+	}))
+	defer srv.Close()
+
+	cfg := CreateDefaultConfig()
+	cfg.Endpoint = srv.URL + "/" + t.Name()
+	cfg.CacheTTL = 0
+	d, err := NewDetector(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		res, _, detectErr := d.Detect(context.Background())
+		require.NoError(t, detectErr)
+		assert.Equal(t, map[string]interface{}{"rack": "r1"}, res.Attributes().AsRaw())
+	}
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestDetectEndpointErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		timeout     time.Duration
+		errContains string
+	}{
+		{
+			name: "unexpected status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			errContains: "unexpected status \"500 Internal Server Error\"",
+		},
+		{
+			name: "invalid JSON",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`["rack"]`))
+			},
+			errContains: "failed to decode the resource attributes",
+		},
+		{
+			name: "timeout",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			},
+			timeout:     10 * time.Millisecond,
+			errContains: "context deadline exceeded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(tt.handler)
+			defer srv.Close()
+
+			cfg := CreateDefaultConfig()
+			cfg.Endpoint = srv.URL + "/" + t.Name()
+			cfg.Timeout = tt.timeout
+			d, err := NewDetector(processortest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+			res, _, err := d.Detect(context.Background())
+			assert.ErrorContains(t, err, tt.errContains)
+			assert.Equal(t, 0, res.Attributes().Len())
+		})
+	}
+}
+
+func TestDetectCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands require a POSIX shell")
+	}
+
+	cfg := CreateDefaultConfig()
+	cfg.Command = []string{"sh", "-c", `echo '{"datacenter": "dc2", "rack": 7}'`}
+	d, err := NewDetector(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	res, _, err := d.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"datacenter": "dc2", "rack": int64(7)}, res.Attributes().AsRaw())
+
+	cfg.Command = []string{"sh", "-c", "echo 'no metadata' >&2; exit 3"}
+	d, err = NewDetector(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	_, _, err = d.Detect(context.Background())
+	assert.EqualError(t, err, "failed to run \"sh\": exit status 3: no metadata")
+
+	cfg.Command = []string{"sleep", "1"}
+	cfg.Timeout = 10 * time.Millisecond
+	d, err = NewDetector(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	_, _, err = d.Detect(context.Background())
+	assert.ErrorContains(t, err, "failed to run \"sleep\"")
+}
@@ -7,14 +7,20 @@ package internal // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
 )
 
 type DetectorType string
@@ -88,11 +94,23 @@ type ResourceProvider struct {
 	detectedResource *resourceResult
 	once             sync.Once
 	attributesToKeep map[string]struct{}
+	// lock guards detectedResource once it was detected, as it is replaced by Refresh.
+	lock sync.Mutex
+	// current is the last resource detected, read by the processors sharing the provider.
+	current atomic.Pointer[resourceResult]
+
+	// refreshLock guards the refresh loop, which is shared by the processors of all the
+	// signals using the provider.
+	refreshLock   sync.Mutex
+	refreshUsers  int
+	refreshCancel context.CancelFunc
+	refreshDone   chan struct{}
 }
 
 type resourceResult struct {
 	resource  pcommon.Resource
 	schemaURL string
+	hash      [16]byte
 	err       error
 }
 
@@ -110,24 +128,121 @@ func (p *ResourceProvider) Get(ctx context.Context, client *http.Client) (resour
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, client.Timeout)
 		defer cancel()
-		p.detectResource(ctx)
+		result, _ := p.detectResource(ctx, p.logger.Info)
+		p.lock.Lock()
+		p.detectedResource = result
+		p.current.Store(result)
+		p.lock.Unlock()
 	})
 
+	p.lock.Lock()
+	defer p.lock.Unlock()
 	return p.detectedResource.resource, p.detectedResource.schemaURL, p.detectedResource.err
 }
 
-func (p *ResourceProvider) detectResource(ctx context.Context) {
-	p.detectedResource = &resourceResult{}
+// Refresh runs the detectors again, and returns the detected resource with whether its
+// attributes changed since the previous detection. When a detector fails, the previous
+// resource is kept and the error is returned.
+func (p *ResourceProvider) Refresh(ctx context.Context, client *http.Client) (resource pcommon.Resource, schemaURL string, changed bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.Timeout)
+	defer cancel()
+	result, err := p.detectResource(ctx, p.logger.Debug)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	previous := p.detectedResource
+	if err != nil {
+		if previous == nil {
+			return pcommon.NewResource(), "", false, err
+		}
+		return previous.resource, previous.schemaURL, false, err
+	}
+	p.detectedResource = result
+	p.current.Store(result)
+	if previous == nil || previous.hash == result.hash {
+		return result.resource, result.schemaURL, false, nil
+	}
+
+	added, removed, updated := diffAttributes(previous.resource.Attributes(), result.resource.Attributes())
+	p.logger.Info("detected resource information changed",
+		zap.String("previous_hash", hex.EncodeToString(previous.hash[:])),
+		zap.String("hash", hex.EncodeToString(result.hash[:])),
+		zap.Strings("added", added),
+		zap.Strings("removed", removed),
+		zap.Strings("updated", updated))
+	return result.resource, result.schemaURL, true, nil
+}
+
+// Current returns the last resource detected by Get or Refresh.
+func (p *ResourceProvider) Current() (resource pcommon.Resource, schemaURL string) {
+	result := p.current.Load()
+	if result == nil {
+		return pcommon.NewResource(), ""
+	}
+	return result.resource, result.schemaURL
+}
+
+// StartRefresh detects the resource again at every interval, until StopRefresh is called as
+// many times as StartRefresh. A single refresh loop runs however many processors share the
+// provider, and onRefresh is called after each detection with whether the resource changed.
+func (p *ResourceProvider) StartRefresh(ctx context.Context, client *http.Client, interval time.Duration, onRefresh func(ctx context.Context, changed bool, err error)) {
+	p.refreshLock.Lock()
+	defer p.refreshLock.Unlock()
+	p.refreshUsers++
+	if p.refreshUsers > 1 {
+		return
+	}
+
+	ctx, p.refreshCancel = context.WithCancel(ctx)
+	p.refreshDone = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_, _, changed, err := p.Refresh(ctx, client)
+				onRefresh(ctx, changed, err)
+			}
+		}
+	}(p.refreshDone)
+}
+
+// StopRefresh stops the refresh loop once it is stopped by all the processors which started it.
+func (p *ResourceProvider) StopRefresh() {
+	p.refreshLock.Lock()
+	defer p.refreshLock.Unlock()
+	if p.refreshUsers == 0 {
+		return
+	}
+	p.refreshUsers--
+	if p.refreshUsers > 0 {
+		return
+	}
+	p.refreshCancel()
+	<-p.refreshDone
+}
+
+// detectResource runs the detectors, merging the resources of the successful ones. The
+// errors of the failed detectors are logged and returned. The detected resource is logged
+// with log, at info level for the initial detection and debug level for the refreshes.
+func (p *ResourceProvider) detectResource(ctx context.Context, log func(msg string, fields ...zap.Field)) (*resourceResult, error) {
+	result := &resourceResult{}
 
 	res := pcommon.NewResource()
 	mergedSchemaURL := ""
 
-	p.logger.Info("began detecting resource information")
+	log("began detecting resource information")
 
+	var errs error
 	for _, detector := range p.detectors {
 		r, schemaURL, err := detector.Detect(ctx)
 		if err != nil {
 			p.logger.Warn("failed to detect resource", zap.Error(err))
+			errs = multierr.Append(errs, err)
 		} else {
 			mergedSchemaURL = MergeSchemaURL(mergedSchemaURL, schemaURL)
 			MergeResource(res, r, false)
@@ -136,13 +251,40 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 
 	droppedAttributes := filterAttributes(res.Attributes(), p.attributesToKeep)
 
-	p.logger.Info("detected resource information", zap.Any("resource", res.Attributes().AsRaw()))
+	log("detected resource information", zap.Any("resource", res.Attributes().AsRaw()))
 	if len(droppedAttributes) > 0 {
-		p.logger.Info("dropped resource information", zap.Strings("resource keys", droppedAttributes))
+		log("dropped resource information", zap.Strings("resource keys", droppedAttributes))
 	}
 
-	p.detectedResource.resource = res
-	p.detectedResource.schemaURL = mergedSchemaURL
+	result.resource = res
+	result.schemaURL = mergedSchemaURL
+	result.hash = pdatautil.MapHash(res.Attributes())
+	return result, errs
+}
+
+// diffAttributes returns the sorted keys of the attributes added, removed and updated
+// from one map to the other.
+func diffAttributes(from, to pcommon.Map) (added, removed, updated []string) {
+	to.Range(func(k string, v pcommon.Value) bool {
+		previous, found := from.Get(k)
+		switch {
+		case !found:
+			added = append(added, k)
+		case previous.Type() != v.Type() || previous.AsString() != v.AsString():
+			updated = append(updated, k)
+		}
+		return true
+	})
+	from.Range(func(k string, _ pcommon.Value) bool {
+		if _, found := to.Get(k); !found {
+			removed = append(removed, k)
+		}
+		return true
+	})
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(updated)
+	return added, removed, updated
 }
 
 func MergeSchemaURL(currentSchemaURL string, newSchemaURL string) string {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 type MockDetector struct {
@@ -144,6 +146,81 @@ func TestDetectResource_Error(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDetectResource_Refresh(t *testing.T) {
+	newResource := func(attrs map[string]any) pcommon.Resource {
+		res := pcommon.NewResource()
+		require.NoError(t, res.Attributes().FromRaw(attrs))
+		return res
+	}
+	md := &MockDetector{}
+	md.On("Detect").Return(newResource(map[string]any{"a": "1", "b": "2", "c": "3"}), nil).Twice()
+	md.On("Detect").Return(newResource(map[string]any{"a": "1", "b": "22", "d": "4"}), nil).Once()
+	md.On("Detect").Return(pcommon.NewResource(), errors.New("err1")).Once()
+
+	core, logs := observer.New(zap.InfoLevel)
+	p := NewResourceProvider(zap.New(core), time.Second, nil, md)
+	client := &http.Client{Timeout: time.Second}
+
+	got, _, err := p.Get(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "1", "b": "2", "c": "3"}, got.Attributes().AsRaw())
+
+	got, _, changed, err := p.Refresh(context.Background(), client)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, map[string]any{"a": "1", "b": "2", "c": "3"}, got.Attributes().AsRaw())
+
+	got, _, changed, err = p.Refresh(context.Background(), client)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, map[string]any{"a": "1", "b": "22", "d": "4"}, got.Attributes().AsRaw())
+	changes := logs.FilterMessage("detected resource information changed").All()
+	require.Len(t, changes, 1)
+	fields := changes[0].ContextMap()
+	assert.NotEqual(t, fields["previous_hash"], fields["hash"])
+	assert.Equal(t, []any{"d"}, fields["added"])
+	assert.Equal(t, []any{"c"}, fields["removed"])
+	assert.Equal(t, []any{"b"}, fields["updated"])
+	// The refreshed resources are only logged at debug level
+	assert.Len(t, logs.FilterMessage("detected resource information").All(), 1)
+
+	// The previous resource is kept when a detector fails
+	got, _, changed, err = p.Refresh(context.Background(), client)
+	assert.EqualError(t, err, "err1")
+	assert.False(t, changed)
+	assert.Equal(t, map[string]any{"a": "1", "b": "22", "d": "4"}, got.Attributes().AsRaw())
+	got, _, err = p.Get(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "1", "b": "22", "d": "4"}, got.Attributes().AsRaw())
+	md.AssertNumberOfCalls(t, "Detect", 4)
+}
+
+func TestStartRefresh(t *testing.T) {
+	md := &MockDetector{}
+	md.On("Detect").Return(pcommon.NewResource(), nil)
+
+	p := NewResourceProvider(zap.NewNop(), time.Second, nil, md)
+	_, _, err := p.Get(context.Background(), http.DefaultClient)
+	require.NoError(t, err)
+
+	var refreshes atomic.Int32
+	onRefresh := func(context.Context, bool, error) { refreshes.Add(1) }
+	// The processors of all the signals share a single refresh loop
+	for i := 0; i < 3; i++ {
+		p.StartRefresh(context.Background(), http.DefaultClient, 50*time.Millisecond, onRefresh)
+	}
+	assert.Eventually(t, func() bool { return refreshes.Load() >= 2 }, 5*time.Second, 10*time.Millisecond)
+	p.StopRefresh()
+	p.StopRefresh()
+	p.StopRefresh()
+
+	md.AssertNumberOfCalls(t, "Detect", 1+int(refreshes.Load()))
+	// The loop is stopped with the last processor
+	calls := len(md.Calls)
+	time.Sleep(150 * time.Millisecond)
+	assert.Len(t, md.Calls, calls)
+}
+
 func TestMergeResource(t *testing.T) {
 	for _, tt := range []struct {
 		name       string
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcedetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/obsreport"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/metadata"
+)
+
+var (
+	tagProcessorKey, _ = tag.NewKey("processor")
+
+	mResourceChanges = stats.Int64("resource_changes", "Number of times the resource attributes changed when the resource was detected again", stats.UnitDimensionless)
+	mRefreshFailures = stats.Int64("refresh_failures", "Number of times the resource could not be detected again, the previous resource being kept", stats.UnitDimensionless)
+)
+
+// MetricViews returns the metrics views of the processor.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mResourceChanges.Name()),
+			Measure:     mResourceChanges,
+			Description: mResourceChanges.Description(),
+			TagKeys:     []tag.Key{tagProcessorKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mRefreshFailures.Name()),
+			Measure:     mRefreshFailures,
+			Description: mRefreshFailures.Description(),
+			TagKeys:     []tag.Key{tagProcessorKey},
+			Aggregation: view.Sum(),
+		},
+	}
+}
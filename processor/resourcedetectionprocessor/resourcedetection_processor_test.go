@@ -263,6 +263,45 @@ func TestResourceProcessor(t *testing.T) {
 	}
 }
 
+func TestResourceProcessorRefresh(t *testing.T) {
+	factory := &factory{providers: map[component.ID]*internal.ResourceProvider{}}
+
+	md := &MockDetector{}
+	first := pcommon.NewResource()
+	first.Attributes().PutStr("host.name", "node-1")
+	second := pcommon.NewResource()
+	second.Attributes().PutStr("host.name", "node-2")
+	md.On("Detect").Return(first, nil).Once()
+	md.On("Detect").Return(second, nil)
+	factory.resourceProviderFactory = internal.NewProviderFactory(
+		map[internal.DetectorType]internal.DetectorFactory{"mock": func(processor.CreateSettings, internal.DetectorConfig) (internal.Detector, error) {
+			return md, nil
+		}})
+
+	cfg := &Config{
+		Override:           true,
+		Detectors:          []string{"mock"},
+		HTTPClientSettings: confighttp.HTTPClientSettings{Timeout: time.Second},
+		RefreshInterval:    100 * time.Millisecond,
+	}
+	sink := new(consumertest.TracesSink)
+	rtp, err := factory.createTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rtp.Start(context.Background(), componenttest.NewNopHost()))
+
+	hostName := func() any {
+		td := ptrace.NewTraces()
+		td.ResourceSpans().AppendEmpty()
+		require.NoError(t, rtp.ConsumeTraces(context.Background(), td))
+		traces := sink.AllTraces()
+		return traces[len(traces)-1].ResourceSpans().At(0).Resource().Attributes().AsRaw()["host.name"]
+	}
+	assert.Equal(t, "node-1", hostName())
+	assert.Eventually(t, func() bool { return hostName() == "node-2" }, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, rtp.Shutdown(context.Background()))
+}
+
 func benchmarkConsumeTraces(b *testing.B, cfg *Config) {
 	factory := NewFactory()
 	sink := new(consumertest.TracesSink)
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	schema "go.opentelemetry.io/otel/schema/v1.0"
+	"go.uber.org/zap"
+)
+
+// defaultRetryInterval is how long a schema file that failed to be fetched
+// is considered unavailable before it is fetched again.
+const defaultRetryInterval = time.Minute
+
+type target struct {
+	schemaURL string
+	version   *Version
+}
+
+// entry is a schema file cached by the manager, ready is closed once
+// the schema file has been fetched and the other fields are set.
+type entry struct {
+	ready       chan struct{}
+	translation *Translation
+	err         error
+	fetchedAt   time.Time
+}
+
+func (e *entry) done() bool {
+	select {
+	case <-e.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// Manager resolves the translation of a schema URL to the target of its family,
+// fetching the schema files once and caching them for later use.
+type Manager struct {
+	log           *zap.Logger
+	provider      Provider
+	targets       map[string]target
+	retryInterval time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// NewManager creates a manager translating the schema families of the targets
+// to the version set by each target.
+func NewManager(log *zap.Logger, provider Provider, targets []string) (*Manager, error) {
+	m := &Manager{
+		log:           log,
+		provider:      provider,
+		targets:       make(map[string]target, len(targets)),
+		retryInterval: defaultRetryInterval,
+		entries:       make(map[string]*entry),
+	}
+	for _, schemaURL := range targets {
+		family, version, err := GetFamilyAndVersion(schemaURL)
+		if err != nil {
+			return nil, err
+		}
+		m.targets[family] = target{schemaURL: schemaURL, version: version}
+	}
+	return m, nil
+}
+
+// RequestTranslation returns the translation to apply to the signals published
+// with the schema URL, along with the version they are published with.
+// It returns false if the signals don't need to, or can't, be translated.
+func (m *Manager) RequestTranslation(ctx context.Context, schemaURL string) (*Translation, *Version, bool) {
+	if schemaURL == "" {
+		return nil, nil, false
+	}
+	family, version, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		m.log.Debug("Ignoring invalid schema url", zap.String("schema-url", schemaURL), zap.Error(err))
+		return nil, nil, false
+	}
+	tgt, ok := m.targets[family]
+	if !ok || version.Equal(tgt.version) {
+		return nil, nil, false
+	}
+	tr, err := m.get(ctx, schemaFileURL(schemaURL, version, tgt), tgt.schemaURL)
+	if err != nil {
+		m.log.Debug("Unable to translate schema url", zap.String("schema-url", schemaURL), zap.Error(err))
+		return nil, nil, false
+	}
+	if !tr.SupportedVersion(version) {
+		m.log.Debug("Schema version not supported by the schema file", zap.String("schema-url", schemaURL))
+		return nil, nil, false
+	}
+	return tr, version, true
+}
+
+// Prefetch fetches the schema file required to translate the schema URL,
+// so that the signals published with it aren't held up by the download.
+func (m *Manager) Prefetch(ctx context.Context, schemaURL string) error {
+	family, version, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		return err
+	}
+	tgt, ok := m.targets[family]
+	if !ok {
+		return fmt.Errorf("no target defined for schema family %q", family)
+	}
+	_, err = m.get(ctx, schemaFileURL(schemaURL, version, tgt), tgt.schemaURL)
+	return err
+}
+
+// get returns the translation defined by the schema file, fetching it if it isn't cached
+// or if the previous attempt failed more than the retry interval ago.
+// Concurrent calls for the same schema file wait on a single fetch.
+func (m *Manager) get(ctx context.Context, fileURL, targetURL string) (*Translation, error) {
+	m.mu.Lock()
+	e, ok := m.entries[fileURL]
+	if !ok || (e.done() && e.err != nil && time.Since(e.fetchedAt) >= m.retryInterval) {
+		e = &entry{ready: make(chan struct{})}
+		m.entries[fileURL] = e
+		m.mu.Unlock()
+
+		e.translation, e.err = m.fetch(ctx, fileURL, targetURL)
+		e.fetchedAt = time.Now()
+		close(e.ready)
+		return e.translation, e.err
+	}
+	m.mu.Unlock()
+
+	select {
+	case <-e.ready:
+		return e.translation, e.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (m *Manager) fetch(ctx context.Context, fileURL, targetURL string) (*Translation, error) {
+	m.log.Info("Fetching remote schema url", zap.String("schema-url", fileURL))
+	rc, err := m.provider.Lookup(ctx, fileURL)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	content, err := schema.Parse(rc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema file %q: %w", fileURL, err)
+	}
+	return NewTranslation(targetURL, content)
+}
+
+// schemaFileURL returns the URL of the schema file that defines both the version and the target,
+// which is the most recent of the two since a schema file includes all the previous versions.
+func schemaFileURL(schemaURL string, version *Version, tgt target) string {
+	if version.GreaterThan(tgt.version) {
+		return schemaURL
+	}
+	return tgt.schemaURL
+}
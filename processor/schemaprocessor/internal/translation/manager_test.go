@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type testProvider struct {
+	mu      sync.Mutex
+	lookups map[string]int
+	files   map[string]string
+}
+
+var _ Provider = (*testProvider)(nil)
+
+func newTestProvider(files map[string]string) *testProvider {
+	return &testProvider{lookups: make(map[string]int), files: files}
+}
+
+func (tp *testProvider) Lookup(_ context.Context, schemaURL string) (io.ReadCloser, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	tp.lookups[schemaURL]++
+	path, ok := tp.files[schemaURL]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return os.Open(path)
+}
+
+func (tp *testProvider) count(schemaURL string) int {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return tp.lookups[schemaURL]
+}
+
+func TestManagerRequestTranslation(t *testing.T) {
+	t.Parallel()
+
+	provider := newTestProvider(map[string]string{
+		"https://example.com/schemas/1.2.0": filepath.Join("testdata", "schema.yaml"),
+	})
+	m, err := NewManager(zaptest.NewLogger(t), provider, []string{"https://example.com/schemas/1.1.0"})
+	require.NoError(t, err, "Must not error when creating manager")
+
+	for _, schemaURL := range []string{"", "invalid", "https://other.com/schemas/1.0.0", "https://example.com/schemas/1.1.0"} {
+		_, _, ok := m.RequestTranslation(context.Background(), schemaURL)
+		assert.False(t, ok, "Must not translate %q", schemaURL)
+	}
+
+	tr, ver, ok := m.RequestTranslation(context.Background(), "https://example.com/schemas/1.2.0")
+	require.True(t, ok, "Must translate a newer version")
+	assert.Equal(t, &Version{1, 2, 0}, ver)
+	assert.Equal(t, "https://example.com/schemas/1.1.0", tr.TargetSchemaURL())
+
+	_, _, ok = m.RequestTranslation(context.Background(), "https://example.com/schemas/1.2.0")
+	assert.True(t, ok)
+	assert.Equal(t, 1, provider.count("https://example.com/schemas/1.2.0"), "Must cache the schema file")
+
+	_, _, ok = m.RequestTranslation(context.Background(), "https://example.com/schemas/1.0.0")
+	assert.False(t, ok, "Must not translate when the target schema file can't be fetched")
+	_, _, ok = m.RequestTranslation(context.Background(), "https://example.com/schemas/1.0.0")
+	assert.False(t, ok)
+	assert.Equal(t, 1, provider.count("https://example.com/schemas/1.1.0"), "Must not fetch a failed schema file before the retry interval")
+}
+
+func TestManagerRetry(t *testing.T) {
+	t.Parallel()
+
+	provider := newTestProvider(map[string]string{})
+	m, err := NewManager(zaptest.NewLogger(t), provider, []string{"https://example.com/schemas/1.2.0"})
+	require.NoError(t, err, "Must not error when creating manager")
+	m.retryInterval = time.Millisecond
+
+	assert.Error(t, m.Prefetch(context.Background(), "https://example.com/schemas/1.0.0"))
+
+	provider.mu.Lock()
+	provider.files["https://example.com/schemas/1.2.0"] = filepath.Join("testdata", "schema.yaml")
+	provider.mu.Unlock()
+
+	assert.Eventually(t, func() bool {
+		_, _, ok := m.RequestTranslation(context.Background(), "https://example.com/schemas/1.0.0")
+		return ok
+	}, time.Second, 10*time.Millisecond, "Must fetch the schema file again after the retry interval")
+}
+
+func TestManagerPrefetch(t *testing.T) {
+	t.Parallel()
+
+	provider := newTestProvider(map[string]string{
+		"https://example.com/schemas/1.2.0": filepath.Join("testdata", "schema.yaml"),
+	})
+	m, err := NewManager(zaptest.NewLogger(t), provider, []string{"https://example.com/schemas/1.0.0"})
+	require.NoError(t, err, "Must not error when creating manager")
+
+	assert.Error(t, m.Prefetch(context.Background(), "https://other.com/schemas/1.0.0"), "Must error for a family without target")
+	assert.NoError(t, m.Prefetch(context.Background(), "https://example.com/schemas/1.2.0"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, ok := m.RequestTranslation(context.Background(), "https://example.com/schemas/1.2.0")
+			assert.True(t, ok)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, provider.count("https://example.com/schemas/1.2.0"), "Must only fetch the schema file once")
+}
@@ -21,6 +21,7 @@ type RevisionV1 struct {
 	eventAttrsOnName *migrate.ConditionalAttributeSetSlice
 	metricsAttrs     *migrate.ConditionalAttributeSetSlice
 	metricNames      *migrate.SignalNameChangeSlice
+	logs             *migrate.AttributeChangeSetSlice
 }
 
 // NewRevision processes the VersionDef and assigns the version to this revision
@@ -39,6 +40,7 @@ func NewRevision(ver *Version, def ast.VersionDef) *RevisionV1 {
 		eventAttrsOnName: newSpanEventConditionalNames(def.SpanEvents),
 		metricsAttrs:     newMetricConditionalSlice(def.Metrics),
 		metricNames:      newMetricNameSignalSlice(def.Metrics),
+		logs:             newLogsAttributeChangeSetSlice(def.Logs),
 	}
 }
 
@@ -112,3 +114,13 @@ func newMetricNameSignalSlice(metrics ast.Metrics) *migrate.SignalNameChangeSlic
 	}
 	return migrate.NewSignalNameChangeSlice(values...)
 }
+
+func newLogsAttributeChangeSetSlice(logs ast.Logs) *migrate.AttributeChangeSetSlice {
+	values := make([]*migrate.AttributeChangeSet, 0, 10)
+	for _, ch := range logs.Changes {
+		if renamed := ch.RenameAttributes; renamed != nil {
+			values = append(values, migrate.NewAttributeChangeSet(renamed.AttributeMap))
+		}
+	}
+	return migrate.NewAttributeChangeSetSlice(values...)
+}
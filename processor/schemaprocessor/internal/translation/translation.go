@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/schema/v1.0/ast"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/alias"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
+)
+
+// Translation converts the signals of a schema family from any of the versions
+// defined by a schema file to the target version.
+type Translation struct {
+	targetSchemaURL string
+	target          *Version
+	// revisions are sorted by increasing version
+	revisions []*RevisionV1
+}
+
+// NewTranslation creates a translation to the target schema URL
+// using the revisions defined by the schema file.
+func NewTranslation(targetSchemaURL string, schema *ast.Schema) (*Translation, error) {
+	_, target, err := GetFamilyAndVersion(targetSchemaURL)
+	if err != nil {
+		return nil, err
+	}
+	t := &Translation{
+		targetSchemaURL: targetSchemaURL,
+		target:          target,
+		revisions:       make([]*RevisionV1, 0, len(schema.Versions)),
+	}
+	for v, def := range schema.Versions {
+		ver, err := NewVersion(string(v))
+		if err != nil {
+			return nil, fmt.Errorf("schema version %q: %w", v, err)
+		}
+		t.revisions = append(t.revisions, NewRevision(ver, def))
+	}
+	sort.Slice(t.revisions, func(i, j int) bool {
+		return t.revisions[i].ver.LessThan(t.revisions[j].ver)
+	})
+	return t, nil
+}
+
+// TargetSchemaURL returns the schema URL the signals are translated to.
+func (t *Translation) TargetSchemaURL() string {
+	return t.targetSchemaURL
+}
+
+// SupportedVersion checks that both the version and the target version
+// are covered by the schema file.
+func (t *Translation) SupportedVersion(v *Version) bool {
+	if len(t.revisions) == 0 {
+		return false
+	}
+	latest := t.revisions[len(t.revisions)-1].ver
+	return !v.GreaterThan(latest) && !t.target.GreaterThan(latest)
+}
+
+// ApplyResourceChanges translates the attributes of the resource from the version.
+func (t *Translation) ApplyResourceChanges(resource pcommon.Resource, from *Version) error {
+	return t.iterate(from, func(rev *RevisionV1, ss migrate.StateSelector) error {
+		return steps(ss,
+			func() error { return changeAttributes(rev.all, ss, resource.Attributes()) },
+			func() error { return changeAttributes(rev.resource, ss, resource.Attributes()) },
+		)
+	})
+}
+
+// ApplyScopeSpanChanges translates the spans and their events from the version.
+func (t *Translation) ApplyScopeSpanChanges(spans ptrace.ScopeSpans, from *Version) error {
+	return t.iterate(from, func(rev *RevisionV1, ss migrate.StateSelector) error {
+		var errs error
+		for i := 0; i < spans.Spans().Len(); i++ {
+			span := spans.Spans().At(i)
+			errs = multierr.Append(errs, steps(ss,
+				func() error { return changeAttributes(rev.all, ss, span.Attributes()) },
+				func() error { return changeConditionalAttributes(rev.spans, ss, span.Attributes(), span.Name()) },
+			))
+			for j := 0; j < span.Events().Len(); j++ {
+				event := span.Events().At(j)
+				errs = multierr.Append(errs, steps(ss,
+					func() error { return changeAttributes(rev.all, ss, event.Attributes()) },
+					func() error {
+						return changeConditionalAttributes(rev.eventAttrsOnSpan, ss, event.Attributes(), span.Name())
+					},
+					func() error {
+						return changeConditionalAttributes(rev.eventAttrsOnName, ss, event.Attributes(), event.Name())
+					},
+					func() error { return changeName(rev.eventNames, ss, event) },
+				))
+			}
+		}
+		return errs
+	})
+}
+
+// ApplyScopeMetricChanges translates the metrics and the attributes of their data points from the version.
+func (t *Translation) ApplyScopeMetricChanges(metrics pmetric.ScopeMetrics, from *Version) error {
+	return t.iterate(from, func(rev *RevisionV1, ss migrate.StateSelector) error {
+		var errs error
+		for i := 0; i < metrics.Metrics().Len(); i++ {
+			metric := metrics.Metrics().At(i)
+			errs = multierr.Append(errs, steps(ss,
+				func() error {
+					var errs error
+					for _, attrs := range dataPointAttributes(metric) {
+						errs = multierr.Append(errs, changeAttributes(rev.all, ss, attrs))
+						errs = multierr.Append(errs, changeConditionalAttributes(rev.metricsAttrs, ss, attrs, metric.Name()))
+					}
+					return errs
+				},
+				func() error { return changeName(rev.metricNames, ss, metric) },
+			))
+		}
+		return errs
+	})
+}
+
+// ApplyScopeLogChanges translates the attributes of the log records from the version.
+func (t *Translation) ApplyScopeLogChanges(logs plog.ScopeLogs, from *Version) error {
+	return t.iterate(from, func(rev *RevisionV1, ss migrate.StateSelector) error {
+		var errs error
+		for i := 0; i < logs.LogRecords().Len(); i++ {
+			record := logs.LogRecords().At(i)
+			errs = multierr.Append(errs, steps(ss,
+				func() error { return changeAttributes(rev.all, ss, record.Attributes()) },
+				func() error { return changeAttributes(rev.logs, ss, record.Attributes()) },
+			))
+		}
+		return errs
+	})
+}
+
+// iterate calls fn with the revisions required to translate from the version to the target:
+// the revisions newer than the version up to the target are applied in increasing order when
+// upgrading, and the revisions newer than the target up to the version are rolled back in
+// decreasing order when downgrading.
+func (t *Translation) iterate(from *Version, fn func(rev *RevisionV1, ss migrate.StateSelector) error) error {
+	var errs error
+	if from.LessThan(t.target) {
+		for _, rev := range t.revisions {
+			if rev.ver.GreaterThan(from) && !rev.ver.GreaterThan(t.target) {
+				errs = multierr.Append(errs, fn(rev, migrate.StateSelectorApply))
+			}
+		}
+		return errs
+	}
+	for i := len(t.revisions) - 1; i >= 0; i-- {
+		rev := t.revisions[i]
+		if !rev.ver.GreaterThan(from) && rev.ver.GreaterThan(t.target) {
+			errs = multierr.Append(errs, fn(rev, migrate.StateSelectorRollback))
+		}
+	}
+	return errs
+}
+
+// steps runs the changes of a revision in order when applying it,
+// and in reverse order when rolling it back.
+func steps(ss migrate.StateSelector, changes ...func() error) error {
+	var errs error
+	for i := range changes {
+		change := changes[i]
+		if ss == migrate.StateSelectorRollback {
+			change = changes[len(changes)-1-i]
+		}
+		errs = multierr.Append(errs, change())
+	}
+	return errs
+}
+
+func changeAttributes(changes *migrate.AttributeChangeSetSlice, ss migrate.StateSelector, attrs pcommon.Map) error {
+	if ss == migrate.StateSelectorRollback {
+		return changes.Rollback(attrs)
+	}
+	return changes.Apply(attrs)
+}
+
+func changeConditionalAttributes(changes *migrate.ConditionalAttributeSetSlice, ss migrate.StateSelector, attrs pcommon.Map, values ...string) error {
+	if ss == migrate.StateSelectorRollback {
+		return changes.Rollback(attrs, values...)
+	}
+	return changes.Apply(attrs, values...)
+}
+
+func changeName(changes *migrate.SignalNameChangeSlice, ss migrate.StateSelector, signal alias.NamedSignal) error {
+	if ss == migrate.StateSelectorRollback {
+		changes.Rollback(signal)
+	} else {
+		changes.Apply(signal)
+	}
+	return nil
+}
+
+func dataPointAttributes(metric pmetric.Metric) []pcommon.Map {
+	var attrs []pcommon.Map
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Summary().DataPoints().At(i).Attributes())
+		}
+	}
+	return attrs
+}
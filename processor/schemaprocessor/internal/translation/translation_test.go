@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	schema "go.opentelemetry.io/otel/schema/v1.0"
+)
+
+func newTestTranslation(t *testing.T, targetSchemaURL string) *Translation {
+	f, err := os.Open(filepath.Join("testdata", "schema.yaml"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	content, err := schema.Parse(f)
+	require.NoError(t, err, "Must be a valid schema file")
+
+	tr, err := NewTranslation(targetSchemaURL, content)
+	require.NoError(t, err, "Must not error when creating translation")
+	return tr
+}
+
+func TestTranslationSupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t, "https://example.com/schemas/1.1.0")
+	assert.Equal(t, "https://example.com/schemas/1.1.0", tr.TargetSchemaURL())
+	assert.True(t, tr.SupportedVersion(&Version{1, 0, 0}))
+	assert.True(t, tr.SupportedVersion(&Version{1, 2, 0}))
+	assert.False(t, tr.SupportedVersion(&Version{1, 3, 0}))
+
+	tr = newTestTranslation(t, "https://example.com/schemas/1.4.0")
+	assert.False(t, tr.SupportedVersion(&Version{1, 0, 0}), "Must not support a target missing from the schema file")
+}
+
+func TestTranslationResource(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		target string
+		from   *Version
+		in     map[string]any
+		expect map[string]any
+	}{
+		{
+			name:   "upgrade",
+			target: "https://example.com/schemas/1.2.0",
+			from:   &Version{1, 0, 0},
+			in:     map[string]any{"host.id": "a", "deployment.environment": "prod"},
+			expect: map[string]any{"host.identifier": "a", "deployment.env": "prod"},
+		},
+		{
+			name:   "partial upgrade",
+			target: "https://example.com/schemas/1.1.0",
+			from:   &Version{1, 0, 0},
+			in:     map[string]any{"host.id": "a", "deployment.environment": "prod"},
+			expect: map[string]any{"host.identifier": "a", "deployment.environment": "prod"},
+		},
+		{
+			name:   "downgrade",
+			target: "https://example.com/schemas/1.0.0",
+			from:   &Version{1, 2, 0},
+			in:     map[string]any{"host.identifier": "a", "deployment.env": "prod"},
+			expect: map[string]any{"host.id": "a", "deployment.environment": "prod"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := newTestTranslation(t, tc.target)
+			resource := pcommon.NewResource()
+			require.NoError(t, resource.Attributes().FromRaw(tc.in))
+
+			assert.NoError(t, tr.ApplyResourceChanges(resource, tc.from))
+			assert.Equal(t, tc.expect, resource.Attributes().AsRaw())
+		})
+	}
+}
+
+func TestTranslationSpans(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t, "https://example.com/schemas/1.2.0")
+	spans := ptrace.NewScopeSpans()
+	span := spans.Spans().AppendEmpty()
+	span.SetName("query")
+	span.Attributes().PutStr("db.statement", "SELECT 1")
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.Attributes().PutStr("deployment.environment", "prod")
+	other := spans.Spans().AppendEmpty()
+	other.SetName("other")
+	other.Attributes().PutStr("db.statement", "SELECT 1")
+
+	assert.NoError(t, tr.ApplyScopeSpanChanges(spans, &Version{1, 1, 0}))
+	assert.Equal(t, map[string]any{"db.query": "SELECT 1"}, span.Attributes().AsRaw())
+	assert.Equal(t, "error", event.Name())
+	assert.Equal(t, map[string]any{"deployment.env": "prod"}, event.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"db.statement": "SELECT 1"}, other.Attributes().AsRaw(), "Must only rename the attributes of the matching spans")
+
+	tr = newTestTranslation(t, "https://example.com/schemas/1.1.0")
+	assert.NoError(t, tr.ApplyScopeSpanChanges(spans, &Version{1, 2, 0}))
+	assert.Equal(t, map[string]any{"db.statement": "SELECT 1"}, span.Attributes().AsRaw())
+	assert.Equal(t, "exception", event.Name())
+	assert.Equal(t, map[string]any{"deployment.environment": "prod"}, event.Attributes().AsRaw())
+}
+
+func TestTranslationMetrics(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t, "https://example.com/schemas/1.2.0")
+	metrics := pmetric.NewScopeMetrics()
+	usage := metrics.Metrics().AppendEmpty()
+	usage.SetName("process.cpu.usage")
+	usage.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("deployment.environment", "prod")
+	cpu := metrics.Metrics().AppendEmpty()
+	cpu.SetName("system.cpu.time")
+	cpu.SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("state", "idle")
+
+	assert.NoError(t, tr.ApplyScopeMetricChanges(metrics, &Version{1, 0, 0}))
+	assert.Equal(t, "process.cpu.utilization", usage.Name())
+	assert.Equal(t, map[string]any{"deployment.env": "prod"}, usage.Gauge().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, "system.cpu.time", cpu.Name())
+	assert.Equal(t, map[string]any{"status": "idle"}, cpu.Sum().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestTranslationLogs(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t, "https://example.com/schemas/1.2.0")
+	logs := plog.NewScopeLogs()
+	record := logs.LogRecords().AppendEmpty()
+	record.Attributes().PutStr("log.level", "warn")
+	record.Attributes().PutStr("log.severity", "info")
+
+	assert.Error(t, tr.ApplyScopeLogChanges(logs, &Version{1, 1, 0}), "Must report renaming to an existing attribute")
+	assert.Equal(t, map[string]any{"log.severity": "warn"}, record.Attributes().AsRaw(), "Must give priority to the renamed attribute")
+}
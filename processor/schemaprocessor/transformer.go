@@ -8,16 +8,26 @@ import (
 	"errors"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 )
 
 type transformer struct {
-	targets []string
-	log     *zap.Logger
+	targets    []string
+	prefetch   []string
+	httpClient confighttp.HTTPClientSettings
+	settings   component.TelemetrySettings
+	log        *zap.Logger
+
+	// manager is only set once the transformer is started,
+	// signals are passed through unchanged until then.
+	manager *translation.Manager
 }
 
 func newTransformer(
@@ -30,28 +40,117 @@ func newTransformer(
 		return nil, errors.New("invalid configuration provided")
 	}
 	return &transformer{
-		log:     set.Logger,
-		targets: cfg.Targets,
+		log:        set.Logger,
+		settings:   set.TelemetrySettings,
+		targets:    cfg.Targets,
+		prefetch:   cfg.Prefetch,
+		httpClient: cfg.HTTPClientSettings,
 	}, nil
 }
 
-func (t transformer) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+func (t *transformer) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resourceSchemaURL := rl.SchemaUrl()
+		if tr, ver, ok := t.requestTranslation(ctx, resourceSchemaURL); ok {
+			t.logConflicts(tr.ApplyResourceChanges(rl.Resource(), ver), resourceSchemaURL)
+			rl.SetSchemaUrl(tr.TargetSchemaURL())
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			schemaURL := scopeSchemaURL(sl.SchemaUrl(), resourceSchemaURL)
+			if tr, ver, ok := t.requestTranslation(ctx, schemaURL); ok {
+				t.logConflicts(tr.ApplyScopeLogChanges(sl, ver), schemaURL)
+				sl.SetSchemaUrl(tr.TargetSchemaURL())
+			}
+		}
+	}
 	return ld, nil
 }
 
-func (t transformer) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (t *transformer) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceSchemaURL := rm.SchemaUrl()
+		if tr, ver, ok := t.requestTranslation(ctx, resourceSchemaURL); ok {
+			t.logConflicts(tr.ApplyResourceChanges(rm.Resource(), ver), resourceSchemaURL)
+			rm.SetSchemaUrl(tr.TargetSchemaURL())
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			schemaURL := scopeSchemaURL(sm.SchemaUrl(), resourceSchemaURL)
+			if tr, ver, ok := t.requestTranslation(ctx, schemaURL); ok {
+				t.logConflicts(tr.ApplyScopeMetricChanges(sm, ver), schemaURL)
+				sm.SetSchemaUrl(tr.TargetSchemaURL())
+			}
+		}
+	}
 	return md, nil
 }
 
-func (t transformer) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+func (t *transformer) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		resourceSchemaURL := rs.SchemaUrl()
+		if tr, ver, ok := t.requestTranslation(ctx, resourceSchemaURL); ok {
+			t.logConflicts(tr.ApplyResourceChanges(rs.Resource(), ver), resourceSchemaURL)
+			rs.SetSchemaUrl(tr.TargetSchemaURL())
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			schemaURL := scopeSchemaURL(ss.SchemaUrl(), resourceSchemaURL)
+			if tr, ver, ok := t.requestTranslation(ctx, schemaURL); ok {
+				t.logConflicts(tr.ApplyScopeSpanChanges(ss, ver), schemaURL)
+				ss.SetSchemaUrl(tr.TargetSchemaURL())
+			}
+		}
+	}
 	return td, nil
 }
 
 // start will load the remote file definition if it isn't already cached
 // and resolve the schema translation file
-func (t *transformer) start(_ context.Context, _ component.Host) error {
-	for _, target := range t.targets {
-		t.log.Info("Fetching remote schema url", zap.String("schema-url", target))
+func (t *transformer) start(ctx context.Context, host component.Host) error {
+	client, err := t.httpClient.ToClient(host, t.settings)
+	if err != nil {
+		return err
+	}
+	manager, err := translation.NewManager(t.log, translation.NewHTTPProvider(client), t.targets)
+	if err != nil {
+		return err
+	}
+	t.manager = manager
+
+	// Failing to fetch a schema file must not prevent the collector from starting,
+	// the schema file is fetched again once signals using it are received.
+	for _, schemaURL := range append(append([]string{}, t.targets...), t.prefetch...) {
+		if err = manager.Prefetch(ctx, schemaURL); err != nil {
+			t.log.Warn("Failed to prefetch schema url", zap.String("schema-url", schemaURL), zap.Error(err))
+		}
 	}
 	return nil
 }
+
+func (t *transformer) requestTranslation(ctx context.Context, schemaURL string) (*translation.Translation, *translation.Version, bool) {
+	if t.manager == nil {
+		return nil, nil, false
+	}
+	return t.manager.RequestTranslation(ctx, schemaURL)
+}
+
+// logConflicts reports the changes that couldn't be applied, such as renaming
+// an attribute to a name that is already in use, these are left unchanged.
+func (t *transformer) logConflicts(err error, schemaURL string) {
+	if err != nil {
+		t.log.Debug("Conflicts found while translating signals", zap.String("schema-url", schemaURL), zap.Error(err))
+	}
+}
+
+// scopeSchemaURL returns the schema URL of the scope, which defaults
+// to the one of the resource when it isn't set.
+func scopeSchemaURL(scopeURL, resourceURL string) string {
+	if scopeURL != "" {
+		return scopeURL
+	}
+	return resourceURL
+}
@@ -6,11 +6,15 @@ package schemaprocessor
 import (
 	"context"
 	_ "embed"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -86,3 +90,68 @@ func TestTransformerProcessing(t *testing.T) {
 		assert.Equal(t, in, out, "Must return the same data (subject to change)")
 	})
 }
+
+const testSchemaFile = `
+file_format: 1.0.0
+schema_url: %[1]s/schemas/1.1.0
+versions:
+  1.1.0:
+    resources:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              host.id: host.identifier
+    metrics:
+      changes:
+        - rename_metrics:
+            process.cpu.usage: process.cpu.utilization
+  1.0.0:
+`
+
+func TestTransformerTranslation(t *testing.T) {
+	t.Parallel()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/schemas/1.1.0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, testSchemaFile, server.URL)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.Targets = []string{server.URL + "/schemas/1.1.0"}
+	trans, err := newTransformer(context.Background(), cfg, processor.CreateSettings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err, "Must not error when creating transformer")
+	require.NoError(t, trans.start(context.Background(), componenttest.NewNopHost()))
+
+	in := pmetric.NewMetrics()
+	translated := in.ResourceMetrics().AppendEmpty()
+	translated.SetSchemaUrl(server.URL + "/schemas/1.0.0")
+	translated.Resource().Attributes().PutStr("host.id", "host")
+	translated.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("process.cpu.usage")
+	other := in.ResourceMetrics().AppendEmpty()
+	other.SetSchemaUrl("https://example.com/schemas/1.0.0")
+	other.Resource().Attributes().PutStr("host.id", "host")
+	other.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("process.cpu.usage")
+
+	out, err := trans.processMetrics(context.Background(), in)
+	require.NoError(t, err, "Must not error when processing metrics")
+
+	rm := out.ResourceMetrics().At(0)
+	assert.Equal(t, server.URL+"/schemas/1.1.0", rm.SchemaUrl())
+	assert.Equal(t, map[string]any{"host.identifier": "host"}, rm.Resource().Attributes().AsRaw())
+	assert.Equal(t, server.URL+"/schemas/1.1.0", rm.ScopeMetrics().At(0).SchemaUrl())
+	assert.Equal(t, "process.cpu.utilization", rm.ScopeMetrics().At(0).Metrics().At(0).Name())
+
+	rm = out.ResourceMetrics().At(1)
+	assert.Equal(t, "https://example.com/schemas/1.0.0", rm.SchemaUrl(), "Must not translate a family without target")
+	assert.Equal(t, map[string]any{"host.id": "host"}, rm.Resource().Attributes().AsRaw())
+	assert.Equal(t, "process.cpu.usage", rm.ScopeMetrics().At(0).Metrics().At(0).Name())
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package semconvvalidatorprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvvalidatorprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// ActionTag lists the violations in an attribute and leaves the offending attributes unchanged
+	ActionTag = "tag"
+	// ActionFix renames deprecated attributes to their replacement and tags the remaining violations
+	ActionFix = "fix"
+	// ActionDrop removes the offending attributes
+	ActionDrop = "drop"
+
+	defaultSemconvVersion      = "1.21.0"
+	defaultViolationsAttribute = "semconv.violations"
+)
+
+// Config defines the configuration for the semantic convention validator processor.
+type Config struct {
+	// SemconvVersion is the version of the semantic conventions the attributes are validated against.
+	SemconvVersion string `mapstructure:"semconv_version"`
+
+	// Action is applied to the offending attributes, one of `tag`, `fix` or `drop`.
+	Action string `mapstructure:"action"`
+
+	// Checks toggles the individual validations.
+	Checks ChecksConfig `mapstructure:"checks"`
+
+	// AllowedPrefixes is a list of attribute key prefixes, such as the namespace of an
+	// organization, which are never reported as unknown.
+	AllowedPrefixes []string `mapstructure:"allowed_prefixes"`
+
+	// ViolationsAttribute is the key of the attribute listing the violations found by the `tag` and `fix` actions.
+	ViolationsAttribute string `mapstructure:"violations_attribute"`
+}
+
+// ChecksConfig toggles the individual validations.
+type ChecksConfig struct {
+	// UnknownAttributes reports attributes which are not defined by the semantic conventions.
+	UnknownAttributes bool `mapstructure:"unknown_attributes"`
+
+	// AttributeTypes reports attributes whose value type differs from the one defined by the semantic conventions.
+	AttributeTypes bool `mapstructure:"attribute_types"`
+
+	// DeprecatedAttributes reports attributes which are deprecated in the semantic conventions.
+	DeprecatedAttributes bool `mapstructure:"deprecated_attributes"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if _, ok := registries[cfg.SemconvVersion]; !ok {
+		return fmt.Errorf("unsupported semconv_version %q, must be one of %s", cfg.SemconvVersion, strings.Join(supportedVersions(), ", "))
+	}
+
+	switch cfg.Action {
+	case ActionTag, ActionFix:
+		if cfg.ViolationsAttribute == "" {
+			return fmt.Errorf("violations_attribute must be specified when action is %s", cfg.Action)
+		}
+	case ActionDrop:
+	default:
+		return fmt.Errorf("unsupported action %q, must be one of %q, %q or %q", cfg.Action, ActionTag, ActionFix, ActionDrop)
+	}
+
+	for _, prefix := range cfg.AllowedPrefixes {
+		if prefix == "" {
+			return errors.New("allowed_prefixes must not contain an empty prefix")
+		}
+	}
+
+	return nil
+}
+
+func supportedVersions() []string {
+	versions := make([]string, 0, len(registries))
+	for version := range registries {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package semconvvalidatorprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvvalidatorprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "fix"),
+			expected: &Config{
+				SemconvVersion: "1.18.0",
+				Action:         ActionFix,
+				Checks: ChecksConfig{
+					UnknownAttributes:    false,
+					AttributeTypes:       true,
+					DeprecatedAttributes: true,
+				},
+				AllowedPrefixes:     []string{"acme.", "app."},
+				ViolationsAttribute: "acme.semconv.violations",
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "drop"),
+			expected: &Config{
+				SemconvVersion: defaultSemconvVersion,
+				Action:         ActionDrop,
+				Checks: ChecksConfig{
+					UnknownAttributes:    true,
+					AttributeTypes:       true,
+					DeprecatedAttributes: true,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_version"),
+			errorMessage: `unsupported semconv_version "0.1.0", must be one of 1.18.0, 1.21.0`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_action"),
+			errorMessage: `unsupported action "quarantine", must be one of "tag", "fix" or "drop"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_violations_attribute"),
+			errorMessage: "violations_attribute must be specified when action is tag",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "empty_prefix"),
+			errorMessage: "allowed_prefixes must not contain an empty prefix",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
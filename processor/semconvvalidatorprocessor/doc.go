@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package semconvvalidatorprocessor implements a processor which validates the
+// attributes of traces, metrics and logs against the semantic conventions.
+package semconvvalidatorprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvvalidatorprocessor"
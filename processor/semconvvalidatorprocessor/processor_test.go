@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package semconvvalidatorprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Action = ActionFix
+	return cfg
+}
+
+func TestProcessTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	rs.Resource().Attributes().PutStr("region", "eu")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.Attributes().PutStr("http.url", "https://example.com")
+	span.Events().AppendEmpty().Attributes().PutInt("exception.message", 1)
+
+	p := newSemconvValidatorProcessor(newTestConfig())
+	td, err := p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+
+	rs = td.ResourceSpans().At(0)
+	assert.Equal(t, map[string]any{
+		"service.name":       "checkout",
+		"region":             "eu",
+		"semconv.violations": []any{"unknown:region"},
+	}, rs.Resource().Attributes().AsRaw())
+	span = rs.ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, map[string]any{"url.full": "https://example.com"}, span.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{
+		"exception.message":  int64(1),
+		"semconv.violations": []any{"type:exception.message"},
+	}, span.Events().At(0).Attributes().AsRaw())
+}
+
+func TestProcessLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Attributes().PutStr("http.user_agent", "curl/8.1.2")
+
+	p := newSemconvValidatorProcessor(newTestConfig())
+	ld, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	attrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()
+	assert.Equal(t, map[string]any{"user_agent.original": "curl/8.1.2"}, attrs)
+}
+
+func TestProcessMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("net.host.port", "8080")
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("net.host.port", "8080")
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr("net.host.port", "8080")
+	ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty().Attributes().PutStr("net.host.port", "8080")
+	ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty().Attributes().PutStr("net.host.port", "8080")
+
+	cfg := newTestConfig()
+	cfg.Action = ActionDrop
+	p := newSemconvValidatorProcessor(cfg)
+	md, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+
+	ms = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, 0, ms.At(0).Gauge().DataPoints().At(0).Attributes().Len())
+	assert.Equal(t, 0, ms.At(1).Sum().DataPoints().At(0).Attributes().Len())
+	assert.Equal(t, 0, ms.At(2).Histogram().DataPoints().At(0).Attributes().Len())
+	assert.Equal(t, 0, ms.At(3).ExponentialHistogram().DataPoints().At(0).Attributes().Len())
+	assert.Equal(t, 0, ms.At(4).Summary().DataPoints().At(0).Attributes().Len())
+}
@@ -0,0 +1,386 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package semconvvalidatorprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvvalidatorprocessor"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// attributeType is the type of an attribute value as defined by the semantic conventions.
+type attributeType int
+
+const (
+	typeString attributeType = iota
+	typeInt
+	typeDouble
+	typeBool
+	typeStringSlice
+)
+
+// matches reports whether the value is of the attribute type.
+func (t attributeType) matches(v pcommon.Value) bool {
+	switch t {
+	case typeString:
+		return v.Type() == pcommon.ValueTypeStr
+	case typeInt:
+		return v.Type() == pcommon.ValueTypeInt
+	case typeDouble:
+		return v.Type() == pcommon.ValueTypeDouble
+	case typeBool:
+		return v.Type() == pcommon.ValueTypeBool
+	case typeStringSlice:
+		if v.Type() != pcommon.ValueTypeSlice {
+			return false
+		}
+		s := v.Slice()
+		for i := 0; i < s.Len(); i++ {
+			if s.At(i).Type() != pcommon.ValueTypeStr {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// registry holds the attributes defined by a version of the semantic conventions.
+type registry struct {
+	attributes map[string]attributeType
+	// templates maps the prefix of the template attributes, such as http.request.header.<key>,
+	// to their type.
+	templates map[string]attributeType
+	// deprecated maps the deprecated attributes to their replacement,
+	// which is empty if there is no direct replacement.
+	deprecated map[string]string
+}
+
+// lookup returns the type of an attribute, which is either defined by the registry
+// or matches one of its templates.
+func (r *registry) lookup(key string) (attributeType, bool) {
+	if t, ok := r.attributes[key]; ok {
+		return t, true
+	}
+	for prefix, t := range r.templates {
+		if len(key) > len(prefix) && strings.HasPrefix(key, prefix) {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// next derives the registry of a later version of the semantic conventions.
+// The renamed attributes are deprecated in favor of their new name and the
+// removed ones are deprecated without replacement. Attributes deprecated by
+// earlier versions follow the renames and removals of their replacement.
+func (r *registry) next(added map[string]attributeType, addedTemplates map[string]attributeType, renamed map[string]string, removed []string) *registry {
+	n := &registry{
+		attributes: make(map[string]attributeType, len(r.attributes)+len(added)),
+		templates:  make(map[string]attributeType, len(r.templates)+len(addedTemplates)),
+		deprecated: make(map[string]string, len(r.deprecated)+len(renamed)+len(removed)),
+	}
+	removedSet := make(map[string]struct{}, len(removed))
+	for _, k := range removed {
+		removedSet[k] = struct{}{}
+	}
+	for k, t := range r.attributes {
+		n.attributes[k] = t
+	}
+	for prefix, t := range r.templates {
+		n.templates[prefix] = t
+	}
+	for prefix, t := range addedTemplates {
+		n.templates[prefix] = t
+	}
+	for k, replacement := range r.deprecated {
+		if renamedTo, ok := renamed[replacement]; ok {
+			replacement = renamedTo
+		}
+		if _, ok := removedSet[replacement]; ok {
+			replacement = ""
+		}
+		n.deprecated[k] = replacement
+	}
+	for k, t := range added {
+		n.attributes[k] = t
+	}
+	for k, replacement := range renamed {
+		delete(n.attributes, k)
+		n.deprecated[k] = replacement
+	}
+	for _, k := range removed {
+		delete(n.attributes, k)
+		n.deprecated[k] = ""
+	}
+	return n
+}
+
+// registries holds the supported versions of the semantic conventions. They cover
+// the general, resource, trace and log attribute namespaces; the attributes of
+// instrumentation specific metrics are not included.
+var registries = map[string]*registry{
+	"1.18.0": semconv1180,
+	"1.21.0": semconv1210,
+}
+
+var semconv1180 = &registry{
+	attributes: map[string]attributeType{
+		// Resource
+		"service.name":                typeString,
+		"service.namespace":           typeString,
+		"service.instance.id":         typeString,
+		"service.version":             typeString,
+		"telemetry.sdk.name":          typeString,
+		"telemetry.sdk.language":      typeString,
+		"telemetry.sdk.version":       typeString,
+		"telemetry.auto.version":      typeString,
+		"deployment.environment":      typeString,
+		"host.id":                     typeString,
+		"host.name":                   typeString,
+		"host.type":                   typeString,
+		"host.arch":                   typeString,
+		"host.image.name":             typeString,
+		"host.image.id":               typeString,
+		"host.image.version":          typeString,
+		"os.type":                     typeString,
+		"os.description":              typeString,
+		"os.name":                     typeString,
+		"os.version":                  typeString,
+		"process.pid":                 typeInt,
+		"process.parent_pid":          typeInt,
+		"process.executable.name":     typeString,
+		"process.executable.path":     typeString,
+		"process.command":             typeString,
+		"process.command_line":        typeString,
+		"process.command_args":        typeStringSlice,
+		"process.owner":               typeString,
+		"process.runtime.name":        typeString,
+		"process.runtime.version":     typeString,
+		"process.runtime.description": typeString,
+		"container.name":              typeString,
+		"container.id":                typeString,
+		"container.runtime":           typeString,
+		"container.image.name":        typeString,
+		"container.image.tag":         typeString,
+		"k8s.cluster.name":            typeString,
+		"k8s.node.name":               typeString,
+		"k8s.node.uid":                typeString,
+		"k8s.namespace.name":          typeString,
+		"k8s.pod.uid":                 typeString,
+		"k8s.pod.name":                typeString,
+		"k8s.container.name":          typeString,
+		"k8s.container.restart_count": typeInt,
+		"k8s.replicaset.uid":          typeString,
+		"k8s.replicaset.name":         typeString,
+		"k8s.deployment.uid":          typeString,
+		"k8s.deployment.name":         typeString,
+		"k8s.statefulset.uid":         typeString,
+		"k8s.statefulset.name":        typeString,
+		"k8s.daemonset.uid":           typeString,
+		"k8s.daemonset.name":          typeString,
+		"k8s.job.uid":                 typeString,
+		"k8s.job.name":                typeString,
+		"k8s.cronjob.uid":             typeString,
+		"k8s.cronjob.name":            typeString,
+		"cloud.provider":              typeString,
+		"cloud.account.id":            typeString,
+		"cloud.region":                typeString,
+		"cloud.availability_zone":     typeString,
+		"cloud.platform":              typeString,
+		"faas.name":                   typeString,
+		"faas.id":                     typeString,
+		"faas.version":                typeString,
+		"faas.instance":               typeString,
+		"faas.max_memory":             typeInt,
+		// Trace and log
+		"otel.scope.name":                      typeString,
+		"otel.scope.version":                   typeString,
+		"otel.status_code":                     typeString,
+		"otel.status_description":              typeString,
+		"peer.service":                         typeString,
+		"enduser.id":                           typeString,
+		"enduser.role":                         typeString,
+		"enduser.scope":                        typeString,
+		"thread.id":                            typeInt,
+		"thread.name":                          typeString,
+		"code.function":                        typeString,
+		"code.namespace":                       typeString,
+		"code.filepath":                        typeString,
+		"code.lineno":                          typeInt,
+		"code.column":                          typeInt,
+		"exception.type":                       typeString,
+		"exception.message":                    typeString,
+		"exception.stacktrace":                 typeString,
+		"exception.escaped":                    typeBool,
+		"event.domain":                         typeString,
+		"event.name":                           typeString,
+		"net.transport":                        typeString,
+		"net.app.protocol.name":                typeString,
+		"net.app.protocol.version":             typeString,
+		"net.sock.peer.name":                   typeString,
+		"net.sock.peer.addr":                   typeString,
+		"net.sock.peer.port":                   typeInt,
+		"net.sock.family":                      typeString,
+		"net.peer.name":                        typeString,
+		"net.peer.port":                        typeInt,
+		"net.host.name":                        typeString,
+		"net.host.port":                        typeInt,
+		"net.sock.host.addr":                   typeString,
+		"net.sock.host.port":                   typeInt,
+		"net.host.connection.type":             typeString,
+		"net.host.connection.subtype":          typeString,
+		"net.host.carrier.name":                typeString,
+		"net.host.carrier.mcc":                 typeString,
+		"net.host.carrier.mnc":                 typeString,
+		"net.host.carrier.icc":                 typeString,
+		"http.method":                          typeString,
+		"http.status_code":                     typeInt,
+		"http.flavor":                          typeString,
+		"http.user_agent":                      typeString,
+		"http.request_content_length":          typeInt,
+		"http.response_content_length":         typeInt,
+		"http.url":                             typeString,
+		"http.resend_count":                    typeInt,
+		"http.scheme":                          typeString,
+		"http.target":                          typeString,
+		"http.route":                           typeString,
+		"http.client_ip":                       typeString,
+		"db.system":                            typeString,
+		"db.connection_string":                 typeString,
+		"db.user":                              typeString,
+		"db.jdbc.driver_classname":             typeString,
+		"db.name":                              typeString,
+		"db.statement":                         typeString,
+		"db.operation":                         typeString,
+		"db.redis.database_index":              typeInt,
+		"db.mongodb.collection":                typeString,
+		"db.sql.table":                         typeString,
+		"messaging.system":                     typeString,
+		"messaging.operation":                  typeString,
+		"messaging.batch.message_count":        typeInt,
+		"messaging.message.id":                 typeString,
+		"messaging.message.conversation_id":    typeString,
+		"messaging.message.payload_size_bytes": typeInt,
+		"messaging.destination.name":           typeString,
+		"messaging.destination.template":       typeString,
+		"messaging.destination.temporary":      typeBool,
+		"messaging.destination.anonymous":      typeBool,
+		"rpc.system":                           typeString,
+		"rpc.service":                          typeString,
+		"rpc.method":                           typeString,
+		"rpc.grpc.status_code":                 typeInt,
+		"rpc.jsonrpc.version":                  typeString,
+		"rpc.jsonrpc.request_id":               typeString,
+		"rpc.jsonrpc.error_code":               typeInt,
+		"rpc.jsonrpc.error_message":            typeString,
+		"message.type":                         typeString,
+		"message.id":                           typeInt,
+		"message.compressed_size":              typeInt,
+		"message.uncompressed_size":            typeInt,
+		"faas.trigger":                         typeString,
+		"faas.invocation_id":                   typeString,
+		"faas.coldstart":                       typeBool,
+	},
+	templates: map[string]attributeType{
+		"http.request.header.":  typeStringSlice,
+		"http.response.header.": typeStringSlice,
+	},
+	deprecated: map[string]string{
+		"http.host":                            "",
+		"http.server_name":                     "net.host.name",
+		"net.peer.ip":                          "net.sock.peer.addr",
+		"net.host.ip":                          "net.sock.host.addr",
+		"db.cassandra.keyspace":                "db.name",
+		"db.hbase.namespace":                   "db.name",
+		"messaging.destination":                "messaging.destination.name",
+		"messaging.temp_destination":           "messaging.destination.temporary",
+		"messaging.protocol":                   "net.app.protocol.name",
+		"messaging.protocol_version":           "net.app.protocol.version",
+		"messaging.url":                        "",
+		"messaging.message_id":                 "messaging.message.id",
+		"messaging.conversation_id":            "messaging.message.conversation_id",
+		"messaging.message_payload_size_bytes": "messaging.message.payload_size_bytes",
+		"faas.execution":                       "faas.invocation_id",
+	},
+}
+
+var semconv1210 = semconv1180.next(
+	map[string]attributeType{
+		"url.full":                        typeString,
+		"url.scheme":                      typeString,
+		"url.path":                        typeString,
+		"url.query":                       typeString,
+		"url.fragment":                    typeString,
+		"user_agent.original":             typeString,
+		"client.address":                  typeString,
+		"client.port":                     typeInt,
+		"client.socket.address":           typeString,
+		"client.socket.port":              typeInt,
+		"server.address":                  typeString,
+		"server.port":                     typeInt,
+		"server.socket.address":           typeString,
+		"server.socket.port":              typeInt,
+		"server.socket.domain":            typeString,
+		"network.transport":               typeString,
+		"network.type":                    typeString,
+		"network.protocol.name":           typeString,
+		"network.protocol.version":        typeString,
+		"network.connection.type":         typeString,
+		"network.connection.subtype":      typeString,
+		"network.carrier.name":            typeString,
+		"network.carrier.mcc":             typeString,
+		"network.carrier.mnc":             typeString,
+		"network.carrier.icc":             typeString,
+		"http.request.body.size":          typeInt,
+		"http.response.body.size":         typeInt,
+		"messaging.message.body.size":     typeInt,
+		"messaging.message.envelope.size": typeInt,
+		"messaging.client_id":             typeString,
+		"log.iostream":                    typeString,
+		"log.file.name":                   typeString,
+		"log.file.path":                   typeString,
+		"log.file.name_resolved":          typeString,
+		"log.file.path_resolved":          typeString,
+	},
+	map[string]attributeType{
+		"rpc.grpc.request.metadata.":  typeStringSlice,
+		"rpc.grpc.response.metadata.": typeStringSlice,
+	},
+	map[string]string{
+		"http.url":                             "url.full",
+		"http.scheme":                          "url.scheme",
+		"http.user_agent":                      "user_agent.original",
+		"http.client_ip":                       "client.address",
+		"http.request_content_length":          "http.request.body.size",
+		"http.response_content_length":         "http.response.body.size",
+		"http.flavor":                          "network.protocol.version",
+		"net.peer.name":                        "server.address",
+		"net.peer.port":                        "server.port",
+		"net.host.name":                        "server.address",
+		"net.host.port":                        "server.port",
+		"net.transport":                        "network.transport",
+		"net.app.protocol.name":                "network.protocol.name",
+		"net.app.protocol.version":             "network.protocol.version",
+		"net.host.connection.type":             "network.connection.type",
+		"net.host.connection.subtype":          "network.connection.subtype",
+		"net.host.carrier.name":                "network.carrier.name",
+		"net.host.carrier.mcc":                 "network.carrier.mcc",
+		"net.host.carrier.mnc":                 "network.carrier.mnc",
+		"net.host.carrier.icc":                 "network.carrier.icc",
+		"messaging.message.payload_size_bytes": "messaging.message.body.size",
+	},
+	// The target was split into url.path and url.query, and the socket attributes
+	// moved to the client or the server namespace depending on the span kind,
+	// so these have no direct replacement.
+	[]string{
+		"http.target",
+		"net.sock.peer.name",
+		"net.sock.peer.addr",
+		"net.sock.peer.port",
+		"net.sock.host.addr",
+		"net.sock.host.port",
+		"net.sock.family",
+	},
+)
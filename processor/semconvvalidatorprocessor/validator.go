@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package semconvvalidatorprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvvalidatorprocessor"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	violationUnknown    = "unknown"
+	violationType       = "type"
+	violationDeprecated = "deprecated"
+)
+
+// violation is an attribute breaking the semantic conventions.
+type violation struct {
+	kind string
+	key  string
+	// replacement is the attribute replacing a deprecated one, if any.
+	replacement string
+}
+
+func (v violation) String() string {
+	return v.kind + ":" + v.key
+}
+
+type validator struct {
+	registry            *registry
+	checks              ChecksConfig
+	action              string
+	allowedPrefixes     []string
+	violationsAttribute string
+}
+
+func newValidator(cfg *Config) *validator {
+	return &validator{
+		// The version has already been validated
+		registry:            registries[cfg.SemconvVersion],
+		checks:              cfg.Checks,
+		action:              cfg.Action,
+		allowedPrefixes:     cfg.AllowedPrefixes,
+		violationsAttribute: cfg.ViolationsAttribute,
+	}
+}
+
+// validate checks the attributes against the registry and applies the action to the offending ones.
+func (v *validator) validate(attrs pcommon.Map) {
+	violations := v.findViolations(attrs)
+	if len(violations) == 0 {
+		return
+	}
+
+	switch v.action {
+	case ActionDrop:
+		for _, vl := range violations {
+			attrs.Remove(vl.key)
+		}
+	case ActionFix:
+		remaining := violations[:0]
+		for _, vl := range violations {
+			if vl.kind != violationDeprecated || vl.replacement == "" {
+				remaining = append(remaining, vl)
+				continue
+			}
+			// The replacement is kept as is when both attributes are set
+			if _, exists := attrs.Get(vl.replacement); !exists {
+				dest := attrs.PutEmpty(vl.replacement)
+				if src, ok := attrs.Get(vl.key); ok {
+					src.CopyTo(dest)
+				}
+			}
+			attrs.Remove(vl.key)
+		}
+		v.tag(attrs, remaining)
+	default:
+		v.tag(attrs, violations)
+	}
+}
+
+func (v *validator) findViolations(attrs pcommon.Map) []violation {
+	var violations []violation
+	attrs.Range(func(k string, val pcommon.Value) bool {
+		if k == v.violationsAttribute {
+			return true
+		}
+		if replacement, ok := v.registry.deprecated[k]; ok {
+			if v.checks.DeprecatedAttributes {
+				violations = append(violations, violation{kind: violationDeprecated, key: k, replacement: replacement})
+			}
+			return true
+		}
+		t, ok := v.registry.lookup(k)
+		if !ok {
+			if v.checks.UnknownAttributes && !v.allowed(k) {
+				violations = append(violations, violation{kind: violationUnknown, key: k})
+			}
+			return true
+		}
+		if v.checks.AttributeTypes && !t.matches(val) {
+			violations = append(violations, violation{kind: violationType, key: k})
+		}
+		return true
+	})
+	return violations
+}
+
+func (v *validator) allowed(key string) bool {
+	for _, prefix := range v.allowedPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// tag lists the violations in the violations attribute, replacing the previous list if any.
+func (v *validator) tag(attrs pcommon.Map, violations []violation) {
+	if len(violations) == 0 {
+		return
+	}
+	s := attrs.PutEmptySlice(v.violationsAttribute)
+	s.EnsureCapacity(len(violations))
+	for _, vl := range violations {
+		s.AppendEmpty().SetStr(vl.String())
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package semconvvalidatorprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func newTestAttributes(t *testing.T) pcommon.Map {
+	attrs := pcommon.NewMap()
+	require.NoError(t, attrs.FromRaw(map[string]any{
+		"http.method":      "GET",
+		"http.status_code": "200",
+		"http.url":         "https://example.com/orders?id=1",
+		"http.target":      "/orders?id=1",
+		"url.full":         "https://example.com/orders",
+		"net.peer.name":    "example.com",
+		"acme.team":        "checkout",
+		"custom":           true,
+	}))
+	return attrs
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mutate   func(cfg *Config)
+		expected map[string]any
+	}{
+		{
+			name:   "tag",
+			mutate: func(cfg *Config) {},
+			expected: map[string]any{
+				"http.method":      "GET",
+				"http.status_code": "200",
+				"http.url":         "https://example.com/orders?id=1",
+				"http.target":      "/orders?id=1",
+				"url.full":         "https://example.com/orders",
+				"net.peer.name":    "example.com",
+				"acme.team":        "checkout",
+				"custom":           true,
+				"semconv.violations": []any{
+					"type:http.status_code",
+					"deprecated:http.url",
+					"deprecated:http.target",
+					"deprecated:net.peer.name",
+					"unknown:acme.team",
+					"unknown:custom",
+				},
+			},
+		},
+		{
+			name: "fix",
+			mutate: func(cfg *Config) {
+				cfg.Action = ActionFix
+				cfg.AllowedPrefixes = []string{"acme."}
+			},
+			expected: map[string]any{
+				"http.method":      "GET",
+				"http.status_code": "200",
+				"url.full":         "https://example.com/orders",
+				"server.address":   "example.com",
+				"http.target":      "/orders?id=1",
+				"acme.team":        "checkout",
+				"custom":           true,
+				"semconv.violations": []any{
+					"type:http.status_code",
+					"deprecated:http.target",
+					"unknown:custom",
+				},
+			},
+		},
+		{
+			name: "drop",
+			mutate: func(cfg *Config) {
+				cfg.Action = ActionDrop
+				cfg.Checks.UnknownAttributes = false
+			},
+			expected: map[string]any{
+				"http.method": "GET",
+				"url.full":    "https://example.com/orders",
+				"acme.team":   "checkout",
+				"custom":      true,
+			},
+		},
+		{
+			name: "older version",
+			mutate: func(cfg *Config) {
+				cfg.SemconvVersion = "1.18.0"
+				cfg.Checks.AttributeTypes = false
+				cfg.AllowedPrefixes = []string{"acme.", "custom"}
+			},
+			expected: map[string]any{
+				"http.method":        "GET",
+				"http.status_code":   "200",
+				"http.url":           "https://example.com/orders?id=1",
+				"http.target":        "/orders?id=1",
+				"url.full":           "https://example.com/orders",
+				"net.peer.name":      "example.com",
+				"acme.team":          "checkout",
+				"custom":             true,
+				"semconv.violations": []any{"unknown:url.full"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tc.mutate(cfg)
+			require.NoError(t, cfg.Validate())
+
+			attrs := newTestAttributes(t)
+			newValidator(cfg).validate(attrs)
+			actual := attrs.AsRaw()
+			if violations, ok := actual["semconv.violations"].([]any); ok {
+				assert.ElementsMatch(t, tc.expected["semconv.violations"], violations)
+				delete(actual, "semconv.violations")
+				delete(tc.expected, "semconv.violations")
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestValidateSkipsViolationsAttribute(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	attrs := pcommon.NewMap()
+	attrs.PutStr("custom", "value")
+
+	v := newValidator(cfg)
+	v.validate(attrs)
+	v.validate(attrs)
+
+	violations, ok := attrs.Get("semconv.violations")
+	require.True(t, ok)
+	assert.Equal(t, []any{"unknown:custom"}, violations.Slice().AsRaw())
+}
+
+func TestRegistryNext(t *testing.T) {
+	// http.server_name was deprecated in favor of net.host.name, which was renamed to server.address
+	assert.Equal(t, "server.address", semconv1210.deprecated["http.server_name"])
+	// net.peer.ip was deprecated in favor of net.sock.peer.addr, which has no direct replacement
+	assert.Equal(t, "", semconv1210.deprecated["net.peer.ip"])
+	assert.Equal(t, "url.full", semconv1210.deprecated["http.url"])
+	_, ok := semconv1210.attributes["http.url"]
+	assert.False(t, ok)
+	_, ok = semconv1180.attributes["http.url"]
+	assert.True(t, ok)
+}
+
+func TestValidateTemplateAttributes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	attrs := pcommon.NewMap()
+	require.NoError(t, attrs.FromRaw(map[string]any{
+		"http.request.header.content-type":  []any{"application/json"},
+		"http.response.header.x-request-id": "abc",
+		"rpc.grpc.request.metadata.tenant":  []any{"acme"},
+		"http.request.header.":              []any{"empty key"},
+	}))
+
+	newValidator(cfg).validate(attrs)
+	violations, ok := attrs.Get("semconv.violations")
+	require.True(t, ok)
+	assert.ElementsMatch(t, []any{
+		"type:http.response.header.x-request-id",
+		"unknown:http.request.header.",
+	}, violations.Slice().AsRaw())
+
+	// the gRPC metadata templates were added after 1.18.0
+	cfg.SemconvVersion = "1.18.0"
+	attrs.Remove("semconv.violations")
+	newValidator(cfg).validate(attrs)
+	violations, ok = attrs.Get("semconv.violations")
+	require.True(t, ok)
+	assert.ElementsMatch(t, []any{
+		"type:http.response.header.x-request-id",
+		"unknown:http.request.header.",
+		"unknown:rpc.grpc.request.metadata.tenant",
+	}, violations.Slice().AsRaw())
+}
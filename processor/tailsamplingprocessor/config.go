@@ -4,8 +4,11 @@
 package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
 
 import (
+	"errors"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
@@ -33,6 +36,9 @@ const (
 	Composite PolicyType = "composite"
 	// And allows defining a And policy, combining the other policies in one
 	And PolicyType = "and"
+	// ServiceBudget samples the traces selected by the other policies within a spans per second
+	// budget shared by the services.
+	ServiceBudget PolicyType = "service_budget"
 	// SpanCount sample traces that are have more spans per Trace than a given threshold.
 	SpanCount PolicyType = "span_count"
 	// TraceState sample traces with specified values by the given key
@@ -87,6 +93,11 @@ type AndSubPolicyCfg struct {
 	sharedPolicyCfg `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 }
 
+// ServiceBudgetSubPolicyCfg holds the common configuration to all policies under service budget policy.
+type ServiceBudgetSubPolicyCfg struct {
+	sharedPolicyCfg `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+}
+
 type TraceStateCfg struct {
 	// Tag that the filter is going to be matching against.
 	Key string `mapstructure:"key"`
@@ -113,6 +124,29 @@ type RateAllocationCfg struct {
 	Percent int64  `mapstructure:"percent"`
 }
 
+// ServiceBudgetCfg holds the configurable settings to create a service budget
+// sampling policy evaluator.
+type ServiceBudgetCfg struct {
+	// MaxTotalSpansPerSecond is the budget of spans sampled each second, shared by the services.
+	MaxTotalSpansPerSecond int64 `mapstructure:"max_total_spans_per_second"`
+	// ServiceAttribute is the resource attribute identifying the service of a trace. Defaults to service.name.
+	ServiceAttribute string `mapstructure:"service_attribute"`
+	// DefaultWeight is the weight of the services without a configured weight. Defaults to 1.
+	DefaultWeight int64 `mapstructure:"default_weight"`
+	// Weights are the weights of the services, each active service is guaranteed a share of the
+	// budget in proportion to its weight.
+	Weights []ServiceWeightCfg `mapstructure:"weights"`
+	// SubPolicyCfg are the policies selecting the traces to sample within the budget.
+	// All the traces are selected if there are none.
+	SubPolicyCfg []ServiceBudgetSubPolicyCfg `mapstructure:"service_budget_sub_policy"`
+}
+
+// ServiceWeightCfg used within service budget policy
+type ServiceWeightCfg struct {
+	Service string `mapstructure:"service"`
+	Weight  int64  `mapstructure:"weight"`
+}
+
 // PolicyCfg holds the common configuration to all policies.
 type PolicyCfg struct {
 	sharedPolicyCfg `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
@@ -121,6 +155,8 @@ type PolicyCfg struct {
 	CompositeCfg CompositeCfg `mapstructure:"composite"`
 	// Configs for defining and policy
 	AndCfg AndCfg `mapstructure:"and"`
+	// Configs for defining service budget policy
+	ServiceBudgetCfg ServiceBudgetCfg `mapstructure:"service_budget"`
 }
 
 // LatencyCfg holds the configurable settings to create a latency filter sampling policy
@@ -212,6 +248,19 @@ type OTTLConditionCfg struct {
 	SpanEventConditions []string       `mapstructure:"spanevent"`
 }
 
+// DecisionCacheCfg holds the configurable settings of the cache of the final sampling
+// decisions, which gives the spans arriving after their trace is decided the same decision.
+type DecisionCacheCfg struct {
+	// StorageID is the ID of the storage extension the decisions are persisted to, so that
+	// they are kept across restarts of the collector. The cache is disabled when not set.
+	StorageID *component.ID `mapstructure:"storage"`
+	// TTL is how long a decision is kept after it is made.
+	TTL time.Duration `mapstructure:"ttl"`
+	// MaxDecisions is the maximum number of decisions kept, the oldest ones are evicted
+	// first when it is reached.
+	MaxDecisions int `mapstructure:"max_decisions"`
+}
+
 // Config holds the configuration for tail-based sampling.
 type Config struct {
 	// DecisionWait is the desired wait time from the arrival of the first span of
@@ -226,4 +275,20 @@ type Config struct {
 	// PolicyCfgs sets the tail-based sampling policy which makes a sampling decision
 	// for a given trace when requested.
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
+	// DecisionCache configures the cache of the final sampling decisions.
+	DecisionCache DecisionCacheCfg `mapstructure:"decision_cache"`
+}
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.DecisionCache.StorageID == nil {
+		return nil
+	}
+	if cfg.DecisionCache.TTL <= 0 {
+		return errors.New("decision_cache: ttl must be positive")
+	}
+	if cfg.DecisionCache.MaxDecisions <= 0 {
+		return errors.New("decision_cache: max_decisions must be positive")
+	}
+	return nil
 }
@@ -20,6 +20,8 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	storageID := component.NewID("file_storage")
+
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "tail_sampling_config.yaml"))
 	require.NoError(t, err)
 
@@ -36,6 +38,11 @@ func TestLoadConfig(t *testing.T) {
 			DecisionWait:            10 * time.Second,
 			NumTraces:               100,
 			ExpectedNewTracesPerSec: 10,
+			DecisionCache: DecisionCacheCfg{
+				StorageID:    &storageID,
+				TTL:          2 * time.Minute,
+				MaxDecisions: 1000,
+			},
 			PolicyCfgs: []PolicyCfg{
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
@@ -183,6 +190,66 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name: "service-budget-policy-1",
+						Type: ServiceBudget,
+					},
+					ServiceBudgetCfg: ServiceBudgetCfg{
+						MaxTotalSpansPerSecond: 1000,
+						DefaultWeight:          1,
+						Weights:                []ServiceWeightCfg{{Service: "checkout", Weight: 3}},
+						SubPolicyCfg: []ServiceBudgetSubPolicyCfg{
+							{
+								sharedPolicyCfg: sharedPolicyCfg{
+									Name:             "test-service-budget-policy-1",
+									Type:             Probabilistic,
+									ProbabilisticCfg: ProbabilisticCfg{SamplingPercentage: 10},
+								},
+							},
+						},
+					},
+				},
 			},
 		})
 }
+
+func TestValidateDecisionCache(t *testing.T) {
+	storageID := component.NewID("file_storage")
+	tests := []struct {
+		name         string
+		cache        DecisionCacheCfg
+		errorMessage string
+	}{
+		{
+			name:  "disabled",
+			cache: DecisionCacheCfg{},
+		},
+		{
+			name:  "valid",
+			cache: DecisionCacheCfg{StorageID: &storageID, TTL: time.Minute, MaxDecisions: 10},
+		},
+		{
+			name:         "zero ttl",
+			cache:        DecisionCacheCfg{StorageID: &storageID, MaxDecisions: 10},
+			errorMessage: "decision_cache: ttl must be positive",
+		},
+		{
+			name:         "negative max decisions",
+			cache:        DecisionCacheCfg{StorageID: &storageID, TTL: time.Minute, MaxDecisions: -1},
+			errorMessage: "decision_cache: max_decisions must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.DecisionCache = tt.cache
+			err := cfg.Validate()
+			if tt.errorMessage == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errorMessage)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+const (
+	decisionCacheKey = "decisions"
+
+	// decisionEntrySize is the size of a persisted decision: the trace ID, the decision
+	// and the expiry in nanoseconds since the epoch.
+	decisionEntrySize = 16 + 1 + 8
+)
+
+type cachedDecision struct {
+	decision sampling.Decision
+	expiry   time.Time
+}
+
+type queuedDecision struct {
+	id     pcommon.TraceID
+	expiry time.Time
+}
+
+// decisionCache keeps the final decisions of the traces for a fixed time after they are
+// made, up to a maximum number of decisions. The decisions are persisted to a storage
+// extension on shutdown only and read back on start, so the spans arriving after a restart
+// of the collector get the decision of their trace rather than being evaluated again.
+type decisionCache struct {
+	storageID    component.ID
+	ttl          time.Duration
+	maxDecisions int
+	client       storage.Client
+
+	mu        sync.Mutex
+	decisions map[pcommon.TraceID]cachedDecision
+	// queue holds the decisions in the order they are added, which is the order they
+	// expire in since they share the same TTL.
+	queue []queuedDecision
+}
+
+func newDecisionCache(storageID component.ID, ttl time.Duration, maxDecisions int) *decisionCache {
+	return &decisionCache{
+		storageID:    storageID,
+		ttl:          ttl,
+		maxDecisions: maxDecisions,
+		client:       storage.NewNopClient(),
+		decisions:    make(map[pcommon.TraceID]cachedDecision),
+	}
+}
+
+// start gets the storage client and loads the decisions persisted before the restart.
+func (c *decisionCache) start(ctx context.Context, host component.Host, componentID component.ID) error {
+	client, err := getStorageClient(ctx, host, c.storageID, componentID)
+	if err != nil {
+		return err
+	}
+	c.client = client
+
+	data, err := c.client.Get(ctx, decisionCacheKey)
+	if err != nil {
+		return fmt.Errorf("failed to read the sampling decisions: %w", err)
+	}
+	return c.load(data, time.Now())
+}
+
+// shutdown persists the decisions which have not expired and closes the storage client.
+func (c *decisionCache) shutdown(ctx context.Context) error {
+	err := c.client.Set(ctx, decisionCacheKey, c.dump(time.Now()))
+	if err != nil {
+		err = fmt.Errorf("failed to persist the sampling decisions: %w", err)
+	}
+	return multierr.Append(err, c.client.Close(ctx))
+}
+
+// add records the final decision of a trace.
+func (c *decisionCache) add(id pcommon.TraceID, decision sampling.Decision, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeExpired(now)
+	expiry := now.Add(c.ttl)
+	c.decisions[id] = cachedDecision{decision: decision, expiry: expiry}
+	c.queue = append(c.queue, queuedDecision{id: id, expiry: expiry})
+	c.removeOldest()
+}
+
+// get returns the final decision of a trace if it is known and has not expired.
+func (c *decisionCache) get(id pcommon.TraceID, now time.Time) (sampling.Decision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d, ok := c.decisions[id]
+	if !ok || !now.Before(d.expiry) {
+		return sampling.Unspecified, false
+	}
+	return d.decision, true
+}
+
+func (c *decisionCache) removeExpired(now time.Time) {
+	for len(c.queue) > 0 && !now.Before(c.queue[0].expiry) {
+		q := c.queue[0]
+		c.queue = c.queue[1:]
+		// The trace may have been decided again since, only its last decision is kept.
+		if d, ok := c.decisions[q.id]; ok && d.expiry.Equal(q.expiry) {
+			delete(c.decisions, q.id)
+		}
+	}
+}
+
+// removeOldest removes the oldest decisions until at most maxDecisions are kept.
+func (c *decisionCache) removeOldest() {
+	for len(c.decisions) > c.maxDecisions && len(c.queue) > 0 {
+		q := c.queue[0]
+		c.queue = c.queue[1:]
+		if d, ok := c.decisions[q.id]; ok && d.expiry.Equal(q.expiry) {
+			delete(c.decisions, q.id)
+		}
+	}
+}
+
+func (c *decisionCache) dump(now time.Time) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeExpired(now)
+
+	data := make([]byte, 0, len(c.queue)*decisionEntrySize)
+	for _, q := range c.queue {
+		d, ok := c.decisions[q.id]
+		if !ok || !d.expiry.Equal(q.expiry) {
+			continue
+		}
+		data = append(data, q.id[:]...)
+		data = append(data, byte(d.decision))
+		data = binary.BigEndian.AppendUint64(data, uint64(d.expiry.UnixNano()))
+	}
+	return data
+}
+
+func (c *decisionCache) load(data []byte, now time.Time) error {
+	if len(data)%decisionEntrySize != 0 {
+		return fmt.Errorf("invalid persisted sampling decisions of %d bytes", len(data))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for ; len(data) > 0; data = data[decisionEntrySize:] {
+		var id pcommon.TraceID
+		copy(id[:], data[:16])
+		d := cachedDecision{
+			decision: sampling.Decision(data[16]),
+			expiry:   time.Unix(0, int64(binary.BigEndian.Uint64(data[17:decisionEntrySize]))),
+		}
+		if !now.Before(d.expiry) {
+			continue
+		}
+		c.decisions[id] = d
+		c.queue = append(c.queue, queuedDecision{id: id, expiry: d.expiry})
+	}
+	c.removeOldest()
+	return nil
+}
+
+func getStorageClient(ctx context.Context, host component.Host, storageID component.ID, componentID component.ID) (storage.Client, error) {
+	extension, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindProcessor, componentID, "")
+}
@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+var testStorageID = component.NewID("test_storage")
+
+func TestDecisionCacheExpiry(t *testing.T) {
+	c := newDecisionCache(testStorageID, time.Minute, 100)
+	now := time.Now()
+	sampled := pcommon.TraceID([16]byte{1})
+	notSampled := pcommon.TraceID([16]byte{2})
+
+	c.add(sampled, sampling.Sampled, now)
+	c.add(notSampled, sampling.NotSampled, now.Add(30*time.Second))
+
+	decision, ok := c.get(sampled, now.Add(59*time.Second))
+	require.True(t, ok)
+	assert.Equal(t, sampling.Sampled, decision)
+	decision, ok = c.get(notSampled, now.Add(59*time.Second))
+	require.True(t, ok)
+	assert.Equal(t, sampling.NotSampled, decision)
+
+	_, ok = c.get(sampled, now.Add(time.Minute))
+	assert.False(t, ok)
+	_, ok = c.get(pcommon.TraceID([16]byte{3}), now)
+	assert.False(t, ok)
+
+	// The expired decisions are removed when new ones are added
+	c.add(pcommon.TraceID([16]byte{3}), sampling.Sampled, now.Add(time.Minute))
+	assert.Len(t, c.decisions, 2)
+	assert.Len(t, c.queue, 2)
+}
+
+func TestDecisionCacheDumpLoad(t *testing.T) {
+	c := newDecisionCache(testStorageID, time.Minute, 100)
+	now := time.Now()
+	c.add(pcommon.TraceID([16]byte{1}), sampling.Sampled, now)
+	c.add(pcommon.TraceID([16]byte{2}), sampling.NotSampled, now.Add(10*time.Second))
+	c.add(pcommon.TraceID([16]byte{3}), sampling.Sampled, now.Add(20*time.Second))
+	data := c.dump(now.Add(5 * time.Second))
+	assert.Len(t, data, 3*decisionEntrySize)
+
+	loaded := newDecisionCache(testStorageID, time.Minute, 100)
+	require.NoError(t, loaded.load(data, now.Add(65*time.Second)))
+	_, ok := loaded.get(pcommon.TraceID([16]byte{1}), now.Add(65*time.Second))
+	assert.False(t, ok)
+	decision, ok := loaded.get(pcommon.TraceID([16]byte{2}), now.Add(65*time.Second))
+	require.True(t, ok)
+	assert.Equal(t, sampling.NotSampled, decision)
+	decision, ok = loaded.get(pcommon.TraceID([16]byte{3}), now.Add(65*time.Second))
+	require.True(t, ok)
+	assert.Equal(t, sampling.Sampled, decision)
+	assert.Len(t, loaded.queue, 2)
+
+	assert.Error(t, loaded.load([]byte{1, 2, 3}, now))
+}
+
+func TestDecisionCacheMaxDecisions(t *testing.T) {
+	c := newDecisionCache(testStorageID, time.Minute, 2)
+	now := time.Now()
+	c.add(pcommon.TraceID([16]byte{1}), sampling.Sampled, now)
+	c.add(pcommon.TraceID([16]byte{2}), sampling.Sampled, now.Add(time.Second))
+	c.add(pcommon.TraceID([16]byte{3}), sampling.NotSampled, now.Add(2*time.Second))
+
+	// the oldest decision is evicted
+	_, ok := c.get(pcommon.TraceID([16]byte{1}), now.Add(2*time.Second))
+	assert.False(t, ok)
+	_, ok = c.get(pcommon.TraceID([16]byte{2}), now.Add(2*time.Second))
+	assert.True(t, ok)
+	_, ok = c.get(pcommon.TraceID([16]byte{3}), now.Add(2*time.Second))
+	assert.True(t, ok)
+	assert.Len(t, c.decisions, 2)
+
+	// the decisions loaded beyond the maximum are evicted as well
+	loaded := newDecisionCache(testStorageID, time.Minute, 1)
+	require.NoError(t, loaded.load(c.dump(now.Add(2*time.Second)), now.Add(2*time.Second)))
+	assert.Len(t, loaded.decisions, 1)
+	_, ok = loaded.get(pcommon.TraceID([16]byte{3}), now.Add(2*time.Second))
+	assert.True(t, ok)
+}
+
+func TestDecisionCacheStartErrors(t *testing.T) {
+	c := newDecisionCache(testStorageID, time.Minute, 100)
+	err := c.start(context.Background(), componenttest.NewNopHost(), component.NewID(metadata.Type))
+	assert.EqualError(t, err, "storage extension 'test_storage' not found")
+
+	nonStorage := &struct {
+		component.StartFunc
+		component.ShutdownFunc
+	}{}
+	host := &testStorageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{testStorageID: nonStorage},
+	}
+	err = c.start(context.Background(), host, component.NewID(metadata.Type))
+	assert.EqualError(t, err, "non-storage extension 'test_storage' found")
+}
+
+func TestDecisionCacheAcrossRestart(t *testing.T) {
+	host := &testStorageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{testStorageID: &testStorageExtension{data: map[string][]byte{}}},
+	}
+	sampledID := pcommon.TraceID([16]byte{1})
+	notSampledID := pcommon.TraceID([16]byte{2})
+
+	msp := new(consumertest.TracesSink)
+	mpe := &mockPolicyEvaluator{}
+	tsp := newTestDecisionCacheProcessor(msp, mpe)
+	require.NoError(t, tsp.Start(context.Background(), host))
+
+	mpe.NextDecision = sampling.Sampled
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(sampledID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+	mpe.NextDecision = sampling.NotSampled
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(notSampledID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+	require.Equal(t, 2, mpe.EvaluationCount)
+	require.Equal(t, 1, msp.SpanCount())
+	require.NoError(t, tsp.Shutdown(context.Background()))
+
+	// The late spans get the decisions made before the restart
+	msp.Reset()
+	mpe = &mockPolicyEvaluator{}
+	tsp = newTestDecisionCacheProcessor(msp, mpe)
+	require.NoError(t, tsp.Start(context.Background(), host))
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(sampledID)))
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(notSampledID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+
+	assert.Equal(t, 0, mpe.EvaluationCount)
+	assert.Equal(t, uint64(0), tsp.numTracesOnMap.Load())
+	require.Len(t, msp.AllTraces(), 1)
+	assert.Equal(t, sampledID, msp.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID())
+	require.NoError(t, tsp.Shutdown(context.Background()))
+}
+
+func newTestDecisionCacheProcessor(msp *consumertest.TracesSink, mpe *mockPolicyEvaluator) *tailSamplingSpanProcessor {
+	const maxSize = 100
+	return &tailSamplingSpanProcessor{
+		ctx:             context.Background(),
+		nextConsumer:    msp,
+		maxNumTraces:    maxSize,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(1),
+		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:      make(chan pcommon.TraceID, maxSize),
+		policyTicker:    &manualTTicker{},
+		tickerFrequency: 100 * time.Millisecond,
+		numTracesOnMap:  &atomic.Uint64{},
+		id:              component.NewID(metadata.Type),
+		decisionCache:   newDecisionCache(testStorageID, time.Minute, 100),
+	}
+}
+
+type testStorageHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testStorageHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+// testStorageExtension keeps the data of its clients in memory, across their restarts.
+type testStorageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	data map[string][]byte
+}
+
+func (e *testStorageExtension) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return &testStorageClient{data: e.data}, nil
+}
+
+type testStorageClient struct {
+	data map[string][]byte
+}
+
+func (c *testStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[key], nil
+}
+
+func (c *testStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *testStorageClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (c *testStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value, _ = c.Get(ctx, op.Key)
+		case storage.Set:
+			_ = c.Set(ctx, op.Key, op.Value)
+		case storage.Delete:
+			_ = c.Delete(ctx, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *testStorageClient) Close(context.Context) error {
+	return nil
+}
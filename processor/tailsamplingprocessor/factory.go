@@ -38,6 +38,10 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		DecisionWait: 30 * time.Second,
 		NumTraces:    50000,
+		DecisionCache: DecisionCacheCfg{
+			TTL:          5 * time.Minute,
+			MaxDecisions: 100000,
+		},
 	}
 }
 
@@ -48,5 +52,5 @@ func createTracesProcessor(
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
 	tCfg := cfg.(*Config)
-	return newTracesProcessor(ctx, params.TelemetrySettings, nextConsumer, *tCfg)
+	return newTracesProcessor(ctx, params, nextConsumer, *tCfg)
 }
@@ -6,6 +6,7 @@ package sampling
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -99,6 +100,40 @@ func TestEvaluate_OTTL(t *testing.T) {
 	}
 }
 
+func TestEvaluate_OTTLSpanDuration(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	filter, err := NewOTTLConditionFilter(componenttest.NewNopTelemetrySettings(), []string{
+		`attributes["http.status_code"] >= 500 and end_time_unix_nano - start_time_unix_nano > Duration("2s")`,
+	}, nil, ottl.PropagateError)
+	assert.NoError(t, err)
+
+	cases := []struct {
+		Desc       string
+		StatusCode int64
+		Duration   time.Duration
+		Decision   Decision
+	}{
+		{"slow server error", 503, 3 * time.Second, Sampled},
+		{"fast server error", 503, time.Second, NotSampled},
+		{"slow success", 200, 3 * time.Second, NotSampled},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			traces := ptrace.NewTraces()
+			span := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+			start := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+			span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+			span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(c.Duration)))
+			span.Attributes().PutInt("http.status_code", c.StatusCode)
+
+			decision, err := filter.Evaluate(context.Background(), traceID, &TraceData{ReceivedBatches: traces})
+			assert.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
 type spanWithAttributes struct {
 	SpanAttributes      map[string]string
 	SpanEventAttributes map[string]string
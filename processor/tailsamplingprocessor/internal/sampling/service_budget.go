@@ -0,0 +1,251 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+const defaultServiceAttribute = "service.name"
+
+// serviceUsage tracks the spans of a service in the current and previous second.
+type serviceUsage struct {
+	// weight of the service in the allocation of the budget
+	weight int64
+
+	// spans of the traces of the service which were candidates to sampling in this period
+	demandSPS int64
+
+	// spans of the traces of the service which were candidates to sampling in the previous period
+	prevDemandSPS int64
+
+	// spans that the service sampled in this period within its share of the budget
+	sampledSPS int64
+}
+
+// ServiceBudget evaluator and its internal data
+type ServiceBudget struct {
+	// the subpolicies selecting the traces which are candidates to sampling
+	subpolicies []PolicyEvaluator
+
+	// maximum total spans per second that must be sampled
+	maxTotalSPS int64
+
+	// resource attribute holding the service of a trace
+	serviceAttribute string
+
+	// configured weights of the services, and weight of the other services
+	weights       map[string]int64
+	defaultWeight int64
+
+	// usage of the services active in the current or previous second
+	services map[string]*serviceUsage
+
+	// sum of the weights of the active services
+	totalWeight int64
+
+	// total spans sampled in this period
+	sampledSPS int64
+
+	// spans of the budget left unused by the services in the previous period, which the
+	// services over their share can sample in this period, and the part already sampled
+	spilloverSPS int64
+	spilledSPS   int64
+
+	// current unix timestamp second
+	currentSecond int64
+
+	// The time provider (can be different from clock for testing purposes)
+	timeProvider TimeProvider
+
+	logger *zap.Logger
+}
+
+var _ PolicyEvaluator = (*ServiceBudget)(nil)
+
+// ServiceBudgetParams defines the budget of a service budget policy and how it is shared
+// between the services.
+type ServiceBudgetParams struct {
+	// MaxTotalSpansPerSecond is the budget shared by the services.
+	MaxTotalSpansPerSecond int64
+	// ServiceAttribute is the resource attribute holding the service, service.name by default.
+	ServiceAttribute string
+	// Weights are the weights of the services in the allocation of the budget.
+	Weights map[string]int64
+	// DefaultWeight is the weight of the services without a configured weight, 1 by default.
+	DefaultWeight int64
+	// SubPolicies select the traces which are candidates to sampling, all the traces are
+	// candidates if there are none.
+	SubPolicies []PolicyEvaluator
+}
+
+// NewServiceBudget creates a policy evaluator that samples the traces within a spans per second
+// budget, allocated to the services in proportion to their weights.
+func NewServiceBudget(logger *zap.Logger, params ServiceBudgetParams, timeProvider TimeProvider) PolicyEvaluator {
+	serviceAttribute := params.ServiceAttribute
+	if serviceAttribute == "" {
+		serviceAttribute = defaultServiceAttribute
+	}
+	defaultWeight := params.DefaultWeight
+	if defaultWeight <= 0 {
+		defaultWeight = 1
+	}
+	weights := make(map[string]int64, len(params.Weights))
+	for service, weight := range params.Weights {
+		if weight < 0 {
+			weight = 0
+		}
+		weights[service] = weight
+	}
+
+	return &ServiceBudget{
+		subpolicies:      params.SubPolicies,
+		maxTotalSPS:      params.MaxTotalSpansPerSecond,
+		serviceAttribute: serviceAttribute,
+		weights:          weights,
+		defaultWeight:    defaultWeight,
+		services:         make(map[string]*serviceUsage),
+		timeProvider:     timeProvider,
+		logger:           logger,
+	}
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (s *ServiceBudget) Evaluate(ctx context.Context, traceID pcommon.TraceID, trace *TraceData) (Decision, error) {
+	// Like the composite policy, the spans sampled are counted during each 1 second time period.
+	// Each service active in the current or previous second is guaranteed a share of the budget
+	// in proportion to its weight. A service over its share can also sample the spans the other
+	// services left unused in the previous second, so the budget is not wasted when some services
+	// are quiet, while a chatty service cannot take the share of the others.
+
+	s.rollOver()
+
+	candidate, err := s.isCandidate(ctx, traceID, trace)
+	if err != nil || !candidate {
+		return NotSampled, err
+	}
+
+	usage := s.usage(s.traceService(trace))
+	spanCount := trace.SpanCount.Load()
+	usage.demandSPS += spanCount
+
+	if s.sampledSPS+spanCount > s.maxTotalSPS {
+		return NotSampled, nil
+	}
+	if usage.sampledSPS+spanCount <= s.share(usage) {
+		usage.sampledSPS += spanCount
+		s.sampledSPS += spanCount
+		return Sampled, nil
+	}
+	if s.spilledSPS+spanCount <= s.spilloverSPS {
+		s.spilledSPS += spanCount
+		s.sampledSPS += spanCount
+		return Sampled, nil
+	}
+
+	// Like the composite policy, the counters are not updated so that a smaller trace can
+	// still be sampled later in the second.
+	return NotSampled, nil
+}
+
+// rollOver resets the counters at the beginning of each second, computes the budget left
+// unused in the previous second and forgets the services inactive for two seconds.
+func (s *ServiceBudget) rollOver() {
+	currSecond := s.timeProvider.getCurSecond()
+	if s.currentSecond == currSecond {
+		return
+	}
+	// Without sampling in the previous second, e.g. at startup, there is no demand to compare
+	// the shares with, and the whole budget can be spilled.
+	consecutive := currSecond == s.currentSecond+1
+	s.currentSecond = currSecond
+
+	var reservedSPS int64
+	for service, usage := range s.services {
+		if consecutive {
+			usage.prevDemandSPS = usage.demandSPS
+		} else {
+			usage.prevDemandSPS = 0
+		}
+		usage.demandSPS = 0
+		usage.sampledSPS = 0
+		if usage.prevDemandSPS == 0 {
+			delete(s.services, service)
+			s.totalWeight -= usage.weight
+		}
+	}
+	for _, usage := range s.services {
+		reservedSPS += min64(usage.prevDemandSPS, s.share(usage))
+	}
+
+	s.sampledSPS = 0
+	s.spilledSPS = 0
+	s.spilloverSPS = s.maxTotalSPS - reservedSPS
+	if s.spilloverSPS < 0 {
+		s.spilloverSPS = 0
+	}
+}
+
+// isCandidate returns true when one of the subpolicies samples the trace.
+func (s *ServiceBudget) isCandidate(ctx context.Context, traceID pcommon.TraceID, trace *TraceData) (bool, error) {
+	if len(s.subpolicies) == 0 {
+		return true, nil
+	}
+	for _, sub := range s.subpolicies {
+		decision, err := sub.Evaluate(ctx, traceID, trace)
+		if err != nil {
+			return false, err
+		}
+		if decision == Sampled || decision == InvertSampled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// usage returns the usage of the service, which becomes active if it was not.
+func (s *ServiceBudget) usage(service string) *serviceUsage {
+	if usage, ok := s.services[service]; ok {
+		return usage
+	}
+	weight, ok := s.weights[service]
+	if !ok {
+		weight = s.defaultWeight
+	}
+	usage := &serviceUsage{weight: weight}
+	s.services[service] = usage
+	s.totalWeight += weight
+	return usage
+}
+
+// share returns the part of the budget guaranteed to the service.
+func (s *ServiceBudget) share(usage *serviceUsage) int64 {
+	if s.totalWeight == 0 {
+		return 0
+	}
+	return s.maxTotalSPS * usage.weight / s.totalWeight
+}
+
+// traceService returns the service of the first resource of the trace holding the service attribute.
+func (s *ServiceBudget) traceService(trace *TraceData) string {
+	trace.Lock()
+	defer trace.Unlock()
+	batches := trace.ReceivedBatches
+	for i := 0; i < batches.ResourceSpans().Len(); i++ {
+		if v, ok := batches.ResourceSpans().At(i).Resource().Attributes().Get(s.serviceAttribute); ok {
+			return v.AsString()
+		}
+	}
+	return ""
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampling
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func newServiceTrace(service string) *TraceData {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", service)
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	spanCount := &atomic.Int64{}
+	spanCount.Store(1)
+	return &TraceData{
+		ReceivedBatches: traces,
+		SpanCount:       spanCount,
+	}
+}
+
+// evaluateServiceTraces evaluates the given number of traces of the service and returns the number sampled.
+func evaluateServiceTraces(t *testing.T, s PolicyEvaluator, service string, count int) int {
+	sampled := 0
+	for i := 0; i < count; i++ {
+		decision, err := s.Evaluate(context.Background(), traceID, newServiceTrace(service))
+		require.NoError(t, err)
+		if decision == Sampled {
+			sampled++
+		}
+	}
+	return sampled
+}
+
+func TestServiceBudgetShares(t *testing.T) {
+	s := NewServiceBudget(zap.NewNop(), ServiceBudgetParams{
+		MaxTotalSpansPerSecond: 10,
+		Weights:                map[string]int64{"chatty": 3},
+	}, FakeTimeProvider{second: 1})
+
+	// Without demand in the previous second the whole budget can be used by a single service
+	assert.Equal(t, 10, evaluateServiceTraces(t, s, "chatty", 20))
+	assert.Equal(t, 0, evaluateServiceTraces(t, s, "quiet", 1))
+
+	// The shares are 10*3/4=7 for chatty and 10*1/4=2 for quiet. With the demand of the
+	// previous second, 7+1 spans are reserved and 2 can be spilled over.
+	s.(*ServiceBudget).timeProvider = FakeTimeProvider{second: 2}
+	assert.Equal(t, 9, evaluateServiceTraces(t, s, "chatty", 20))
+	assert.Equal(t, 1, evaluateServiceTraces(t, s, "quiet", 5))
+
+	// With the demand of the quiet service in the previous second its whole share is
+	// reserved, the chatty service cannot take it.
+	s.(*ServiceBudget).timeProvider = FakeTimeProvider{second: 3}
+	assert.Equal(t, 8, evaluateServiceTraces(t, s, "chatty", 20))
+	assert.Equal(t, 2, evaluateServiceTraces(t, s, "quiet", 5))
+}
+
+func TestServiceBudgetInactiveServices(t *testing.T) {
+	s := NewServiceBudget(zap.NewNop(), ServiceBudgetParams{
+		MaxTotalSpansPerSecond: 10,
+	}, FakeTimeProvider{second: 1})
+	budget := s.(*ServiceBudget)
+
+	assert.Equal(t, 5, evaluateServiceTraces(t, s, "a", 5))
+	assert.Equal(t, 5, evaluateServiceTraces(t, s, "b", 5))
+	assert.Len(t, budget.services, 2)
+	assert.Equal(t, int64(2), budget.totalWeight)
+
+	// After a second without traces the services are no longer active
+	budget.timeProvider = FakeTimeProvider{second: 3}
+	assert.Equal(t, 10, evaluateServiceTraces(t, s, "a", 20))
+	assert.Len(t, budget.services, 1)
+	assert.Equal(t, int64(1), budget.totalWeight)
+}
+
+func TestServiceBudgetSubPolicies(t *testing.T) {
+	s := NewServiceBudget(zap.NewNop(), ServiceBudgetParams{
+		MaxTotalSpansPerSecond: 10,
+		SubPolicies: []PolicyEvaluator{
+			NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", 0, 100),
+		},
+	}, FakeTimeProvider{second: 1})
+
+	// The traces not selected by a subpolicy are not sampled nor counted
+	assert.Equal(t, 0, evaluateServiceTraces(t, s, "a", 5))
+	assert.Empty(t, s.(*ServiceBudget).services)
+
+	trace := newServiceTrace("a")
+	trace.ReceivedBatches.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().PutInt("tag", 50)
+	decision, err := s.Evaluate(context.Background(), traceID, trace)
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+}
+
+func TestServiceBudgetServiceAttribute(t *testing.T) {
+	s := NewServiceBudget(zap.NewNop(), ServiceBudgetParams{
+		MaxTotalSpansPerSecond: 10,
+		ServiceAttribute:       "k8s.namespace.name",
+	}, FakeTimeProvider{second: 1})
+	budget := s.(*ServiceBudget)
+
+	trace := newServiceTrace("a")
+	trace.ReceivedBatches.ResourceSpans().At(0).Resource().Attributes().PutStr("k8s.namespace.name", "shop")
+	decision, err := s.Evaluate(context.Background(), traceID, trace)
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+	assert.Contains(t, budget.services, "shop")
+
+	// The traces without the attribute share the budget of an unnamed service
+	assert.Equal(t, 1, evaluateServiceTraces(t, s, "a", 1))
+	assert.Contains(t, budget.services, "")
+}
@@ -50,6 +50,8 @@ type tailSamplingSpanProcessor struct {
 	decisionBatcher idbatcher.Batcher
 	deleteChan      chan pcommon.TraceID
 	numTracesOnMap  *atomic.Uint64
+	id              component.ID
+	decisionCache   *decisionCache
 }
 
 const (
@@ -58,7 +60,7 @@ const (
 
 // newTracesProcessor returns a processor.TracesProcessor that will perform tail sampling according to the given
 // configuration.
-func newTracesProcessor(ctx context.Context, settings component.TelemetrySettings, nextConsumer consumer.Traces, cfg Config) (processor.Traces, error) {
+func newTracesProcessor(ctx context.Context, set processor.CreateSettings, nextConsumer consumer.Traces, cfg Config) (processor.Traces, error) {
 	if nextConsumer == nil {
 		return nil, component.ErrNilNextConsumer
 	}
@@ -69,6 +71,7 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 		return nil, err
 	}
 
+	settings := set.TelemetrySettings
 	var policies []*policy
 	for i := range cfg.PolicyCfgs {
 		policyCfg := &cfg.PolicyCfgs[i]
@@ -97,6 +100,10 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 		policies:        policies,
 		tickerFrequency: time.Second,
 		numTracesOnMap:  &atomic.Uint64{},
+		id:              set.ID,
+	}
+	if cfg.DecisionCache.StorageID != nil {
+		tsp.decisionCache = newDecisionCache(*cfg.DecisionCache.StorageID, cfg.DecisionCache.TTL, cfg.DecisionCache.MaxDecisions)
 	}
 
 	tsp.policyTicker = &timeutils.PolicyTicker{OnTickFunc: tsp.samplingPolicyOnTick}
@@ -111,6 +118,8 @@ func getPolicyEvaluator(settings component.TelemetrySettings, cfg *PolicyCfg) (s
 		return getNewCompositePolicy(settings, &cfg.CompositeCfg)
 	case And:
 		return getNewAndPolicy(settings, &cfg.AndCfg)
+	case ServiceBudget:
+		return getNewServiceBudgetPolicy(settings, &cfg.ServiceBudgetCfg)
 	default:
 		return getSharedPolicyEvaluator(settings, &cfg.sharedPolicyCfg)
 	}
@@ -185,6 +194,10 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 		trace.ReceivedBatches = ptrace.NewTraces()
 		trace.Unlock()
 
+		if tsp.decisionCache != nil {
+			tsp.decisionCache.add(id, decision, trace.DecisionTime)
+		}
+
 		if decision == sampling.Sampled {
 			_ = tsp.nextConsumer.ConsumeTraces(policy.ctx, allSpans)
 		}
@@ -325,6 +338,12 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 			initialDecisions[i] = sampling.Pending
 		}
 		d, loaded := tsp.idToTrace.Load(id)
+		if !loaded && tsp.decisionCache != nil {
+			if decision, ok := tsp.decisionCache.get(id, time.Now()); ok {
+				tsp.applyCachedDecision(decision, resourceSpans, spans)
+				continue
+			}
+		}
 		if !loaded {
 			spanCount := &atomic.Int64{}
 			spanCount.Store(lenSpans)
@@ -388,20 +407,48 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 	stats.Record(tsp.ctx, statNewTraceIDReceivedCount.M(newTraceIDs))
 }
 
+// applyCachedDecision forwards or drops the spans of a trace decided before it was
+// dropped from the map, or before the collector restarted.
+func (tsp *tailSamplingSpanProcessor) applyCachedDecision(decision sampling.Decision, resourceSpans ptrace.ResourceSpans, spans []*ptrace.Span) {
+	switch decision {
+	case sampling.Sampled:
+		traceTd := ptrace.NewTraces()
+		appendToTraces(traceTd, resourceSpans, spans)
+		if err := tsp.nextConsumer.ConsumeTraces(tsp.ctx, traceTd); err != nil {
+			tsp.logger.Warn(
+				"Error sending late arrived spans to destination",
+				zap.Error(err))
+		}
+	case sampling.NotSampled:
+		// The spans are dropped like the rest of the trace
+	default:
+		tsp.logger.Warn("Encountered unexpected cached sampling decision",
+			zap.Int("decision", int(decision)))
+	}
+}
+
 func (tsp *tailSamplingSpanProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
 // Start is invoked during service startup.
-func (tsp *tailSamplingSpanProcessor) Start(context.Context, component.Host) error {
+func (tsp *tailSamplingSpanProcessor) Start(ctx context.Context, host component.Host) error {
+	if tsp.decisionCache != nil {
+		if err := tsp.decisionCache.start(ctx, host, tsp.id); err != nil {
+			return err
+		}
+	}
 	tsp.policyTicker.Start(tsp.tickerFrequency)
 	return nil
 }
 
 // Shutdown is invoked during service shutdown.
-func (tsp *tailSamplingSpanProcessor) Shutdown(context.Context) error {
+func (tsp *tailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
 	tsp.decisionBatcher.Stop()
 	tsp.policyTicker.Stop()
+	if tsp.decisionCache != nil {
+		return tsp.decisionCache.shutdown(ctx)
+	}
 	return nil
 }
 
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+func getNewServiceBudgetPolicy(settings component.TelemetrySettings, config *ServiceBudgetCfg) (sampling.PolicyEvaluator, error) {
+	if config.MaxTotalSpansPerSecond <= 0 {
+		return nil, fmt.Errorf("service budget max_total_spans_per_second must be positive, got %d", config.MaxTotalSpansPerSecond)
+	}
+
+	weights := make(map[string]int64, len(config.Weights))
+	for _, w := range config.Weights {
+		if w.Weight < 0 {
+			return nil, fmt.Errorf("service budget weight of service %q cannot be negative", w.Service)
+		}
+		weights[w.Service] = w.Weight
+	}
+
+	var subPolicyEvaluators []sampling.PolicyEvaluator
+	for i := range config.SubPolicyCfg {
+		policy, err := getServiceBudgetSubPolicyEvaluator(settings, &config.SubPolicyCfg[i])
+		if err != nil {
+			return nil, err
+		}
+		subPolicyEvaluators = append(subPolicyEvaluators, policy)
+	}
+
+	return sampling.NewServiceBudget(settings.Logger, sampling.ServiceBudgetParams{
+		MaxTotalSpansPerSecond: config.MaxTotalSpansPerSecond,
+		ServiceAttribute:       config.ServiceAttribute,
+		Weights:                weights,
+		DefaultWeight:          config.DefaultWeight,
+		SubPolicies:            subPolicyEvaluators,
+	}, sampling.MonotonicClock{}), nil
+}
+
+// Return instance of service budget sub-policy
+func getServiceBudgetSubPolicyEvaluator(settings component.TelemetrySettings, cfg *ServiceBudgetSubPolicyCfg) (sampling.PolicyEvaluator, error) {
+	return getSharedPolicyEvaluator(settings, &cfg.sharedPolicyCfg)
+}
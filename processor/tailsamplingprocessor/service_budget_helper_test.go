@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+func TestServiceBudgetHelper(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		actual, err := getNewServiceBudgetPolicy(componenttest.NewNopTelemetrySettings(), &ServiceBudgetCfg{
+			MaxTotalSpansPerSecond: 100,
+			Weights:                []ServiceWeightCfg{{Service: "checkout", Weight: 3}},
+			SubPolicyCfg: []ServiceBudgetSubPolicyCfg{
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name:       "test-service-budget-policy-1",
+						Type:       Latency,
+						LatencyCfg: LatencyCfg{ThresholdMs: 100},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		expected := sampling.NewServiceBudget(zap.NewNop(), sampling.ServiceBudgetParams{
+			MaxTotalSpansPerSecond: 100,
+			Weights:                map[string]int64{"checkout": 3},
+			SubPolicies: []sampling.PolicyEvaluator{
+				sampling.NewLatency(componenttest.NewNopTelemetrySettings(), 100),
+			},
+		}, sampling.MonotonicClock{})
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("invalid budget", func(t *testing.T) {
+		_, err := getNewServiceBudgetPolicy(componenttest.NewNopTelemetrySettings(), &ServiceBudgetCfg{})
+		require.EqualError(t, err, "service budget max_total_spans_per_second must be positive, got 0")
+	})
+
+	t.Run("negative weight", func(t *testing.T) {
+		_, err := getNewServiceBudgetPolicy(componenttest.NewNopTelemetrySettings(), &ServiceBudgetCfg{
+			MaxTotalSpansPerSecond: 100,
+			Weights:                []ServiceWeightCfg{{Service: "checkout", Weight: -1}},
+		})
+		require.EqualError(t, err, `service budget weight of service "checkout" cannot be negative`)
+	})
+
+	t.Run("unsupported sampling policy type", func(t *testing.T) {
+		_, err := getNewServiceBudgetPolicy(componenttest.NewNopTelemetrySettings(), &ServiceBudgetCfg{
+			MaxTotalSpansPerSecond: 100,
+			SubPolicyCfg: []ServiceBudgetSubPolicyCfg{
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name: "test-service-budget-policy-2",
+						Type: ServiceBudget, // nested service budget is not allowed
+					},
+				},
+			},
+		})
+		require.EqualError(t, err, "unknown sampling policy type service_budget")
+	})
+}
@@ -4,6 +4,8 @@
 package transformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -24,18 +26,37 @@ type Config struct {
 	// The default value is `propagate`.
 	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
 
+	// Store configures the values the statements set with the set_store_value function.
+	Store StoreConfig `mapstructure:"store"`
+
 	TraceStatements  []common.ContextStatements `mapstructure:"trace_statements"`
 	MetricStatements []common.ContextStatements `mapstructure:"metric_statements"`
 	LogStatements    []common.ContextStatements `mapstructure:"log_statements"`
 }
 
+// StoreConfig configures the values the statements of a processor set in the stores.
+type StoreConfig struct {
+	// MaxEntries is the maximum number of values the processor keeps in each store it sets values in.
+	// When a new key is set in a full store, the value expiring first is evicted.
+	// The default value is 10000, and 0 does not limit the number of values.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
 var _ component.Config = (*Config)(nil)
 
 func (c *Config) Validate() error {
 	var errors error
 
+	if c.Store.MaxEntries < 0 {
+		return fmt.Errorf("store max_entries must not be negative, got %d", c.Store.MaxEntries)
+	}
+
+	// The statements are parsed with stores which are not shared with any processor.
+	stores := common.NewStoreRegistry().NewStores(c.Store.MaxEntries)
+	defer stores.Release()
+
 	if len(c.TraceStatements) > 0 {
-		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithSpanParser(traces.SpanFunctions()), common.WithSpanEventParser(traces.SpanEventFunctions()))
+		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, stores, common.WithSpanParser(traces.SpanFunctions(stores)), common.WithSpanEventParser(traces.SpanEventFunctions(stores)))
 		if err != nil {
 			return err
 		}
@@ -48,7 +69,7 @@ func (c *Config) Validate() error {
 	}
 
 	if len(c.MetricStatements) > 0 {
-		pc, err := common.NewMetricParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithMetricParser(metrics.MetricFunctions()), common.WithDataPointParser(metrics.DataPointFunctions()))
+		pc, err := common.NewMetricParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, stores, common.WithMetricParser(metrics.MetricFunctions(stores)), common.WithDataPointParser(metrics.DataPointFunctions(stores)))
 		if err != nil {
 			return err
 		}
@@ -61,7 +82,7 @@ func (c *Config) Validate() error {
 	}
 
 	if len(c.LogStatements) > 0 {
-		pc, err := common.NewLogParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithLogParser(logs.LogFunctions()))
+		pc, err := common.NewLogParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, stores, common.WithLogParser(logs.LogFunctions(stores)))
 		if err != nil {
 			return err
 		}
@@ -29,6 +29,9 @@ func TestLoadConfig(t *testing.T) {
 			id: component.NewIDWithName(metadata.Type, ""),
 			expected: &Config{
 				ErrorMode: ottl.PropagateError,
+				Store: StoreConfig{
+					MaxEntries: defaultStoreMaxEntries,
+				},
 				TraceStatements: []common.ContextStatements{
 					{
 						Context: "span",
@@ -80,6 +83,9 @@ func TestLoadConfig(t *testing.T) {
 			id: component.NewIDWithName(metadata.Type, "ignore_errors"),
 			expected: &Config{
 				ErrorMode: ottl.IgnoreError,
+				Store: StoreConfig{
+					MaxEntries: 100,
+				},
 				TraceStatements: []common.ContextStatements{
 					{
 						Context: "resource",
@@ -114,6 +120,9 @@ func TestLoadConfig(t *testing.T) {
 			id:       component.NewIDWithName(metadata.Type, "bad_syntax_multi_signal"),
 			errorLen: 3,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "negative_store_max_entries"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
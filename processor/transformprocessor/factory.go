@@ -22,84 +22,116 @@ import (
 
 var processorCapabilities = consumer.Capabilities{MutatesData: true}
 
+// defaultStoreMaxEntries is the default maximum number of values a processor keeps in each store.
+const defaultStoreMaxEntries = 10000
+
 func NewFactory() processor.Factory {
+	// The stores are shared by the processors created by the factory.
+	registry := common.NewStoreRegistry()
 	return processor.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		processor.WithLogs(createLogsProcessor, metadata.LogsStability),
-		processor.WithTraces(createTracesProcessor, metadata.TracesStability),
-		processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability),
+		processor.WithLogs(createLogsProcessor(registry), metadata.LogsStability),
+		processor.WithTraces(createTracesProcessor(registry), metadata.TracesStability),
+		processor.WithMetrics(createMetricsProcessor(registry), metadata.MetricsStability),
 	)
 }
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		ErrorMode:        ottl.PropagateError,
+		ErrorMode: ottl.PropagateError,
+		Store: StoreConfig{
+			MaxEntries: defaultStoreMaxEntries,
+		},
 		TraceStatements:  []common.ContextStatements{},
 		MetricStatements: []common.ContextStatements{},
 		LogStatements:    []common.ContextStatements{},
 	}
 }
 
-func createLogsProcessor(
-	ctx context.Context,
-	set processor.CreateSettings,
-	cfg component.Config,
-	nextConsumer consumer.Logs,
-) (processor.Logs, error) {
-	oCfg := cfg.(*Config)
+func createLogsProcessor(registry *common.StoreRegistry) processor.CreateLogsFunc {
+	return func(
+		ctx context.Context,
+		set processor.CreateSettings,
+		cfg component.Config,
+		nextConsumer consumer.Logs,
+	) (processor.Logs, error) {
+		oCfg := cfg.(*Config)
 
-	proc, err := logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, set.TelemetrySettings)
-	if err != nil {
-		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		stores := registry.NewStores(oCfg.Store.MaxEntries)
+		proc, err := logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, set.TelemetrySettings, stores)
+		if err != nil {
+			stores.Release()
+			return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		}
+		return processorhelper.NewLogsProcessor(
+			ctx,
+			set,
+			cfg,
+			nextConsumer,
+			proc.ProcessLogs,
+			processorhelper.WithCapabilities(processorCapabilities),
+			processorhelper.WithShutdown(func(context.Context) error {
+				stores.Release()
+				return nil
+			}))
 	}
-	return processorhelper.NewLogsProcessor(
-		ctx,
-		set,
-		cfg,
-		nextConsumer,
-		proc.ProcessLogs,
-		processorhelper.WithCapabilities(processorCapabilities))
 }
 
-func createTracesProcessor(
-	ctx context.Context,
-	set processor.CreateSettings,
-	cfg component.Config,
-	nextConsumer consumer.Traces,
-) (processor.Traces, error) {
-	oCfg := cfg.(*Config)
+func createTracesProcessor(registry *common.StoreRegistry) processor.CreateTracesFunc {
+	return func(
+		ctx context.Context,
+		set processor.CreateSettings,
+		cfg component.Config,
+		nextConsumer consumer.Traces,
+	) (processor.Traces, error) {
+		oCfg := cfg.(*Config)
 
-	proc, err := traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, set.TelemetrySettings)
-	if err != nil {
-		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		stores := registry.NewStores(oCfg.Store.MaxEntries)
+		proc, err := traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, set.TelemetrySettings, stores)
+		if err != nil {
+			stores.Release()
+			return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		}
+		return processorhelper.NewTracesProcessor(
+			ctx,
+			set,
+			cfg,
+			nextConsumer,
+			proc.ProcessTraces,
+			processorhelper.WithCapabilities(processorCapabilities),
+			processorhelper.WithShutdown(func(context.Context) error {
+				stores.Release()
+				return nil
+			}))
 	}
-	return processorhelper.NewTracesProcessor(
-		ctx,
-		set,
-		cfg,
-		nextConsumer,
-		proc.ProcessTraces,
-		processorhelper.WithCapabilities(processorCapabilities))
 }
 
-func createMetricsProcessor(
-	ctx context.Context,
-	set processor.CreateSettings,
-	cfg component.Config,
-	nextConsumer consumer.Metrics,
-) (processor.Metrics, error) {
-	oCfg := cfg.(*Config)
+func createMetricsProcessor(registry *common.StoreRegistry) processor.CreateMetricsFunc {
+	return func(
+		ctx context.Context,
+		set processor.CreateSettings,
+		cfg component.Config,
+		nextConsumer consumer.Metrics,
+	) (processor.Metrics, error) {
+		oCfg := cfg.(*Config)
 
-	proc, err := metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, set.TelemetrySettings)
-	if err != nil {
-		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		stores := registry.NewStores(oCfg.Store.MaxEntries)
+		proc, err := metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, set.TelemetrySettings, stores)
+		if err != nil {
+			stores.Release()
+			return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		}
+		return processorhelper.NewMetricsProcessor(
+			ctx,
+			set,
+			cfg,
+			nextConsumer,
+			proc.ProcessMetrics,
+			processorhelper.WithCapabilities(processorCapabilities),
+			processorhelper.WithShutdown(func(context.Context) error {
+				stores.Release()
+				return nil
+			}))
 	}
-	return processorhelper.NewMetricsProcessor(
-		ctx,
-		set,
-		cfg,
-		nextConsumer,
-		proc.ProcessMetrics,
-		processorhelper.WithCapabilities(processorCapabilities))
 }
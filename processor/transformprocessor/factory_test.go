@@ -30,7 +30,10 @@ func TestFactory_CreateDefaultConfig(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
 	assert.Equal(t, cfg, &Config{
-		ErrorMode:        ottl.PropagateError,
+		ErrorMode: ottl.PropagateError,
+		Store: StoreConfig{
+			MaxEntries: defaultStoreMaxEntries,
+		},
 		TraceStatements:  []common.ContextStatements{},
 		MetricStatements: []common.ContextStatements{},
 		LogStatements:    []common.ContextStatements{},
@@ -189,3 +192,56 @@ func TestFactoryCreateLogsProcessor_InvalidActions(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, ap)
 }
+
+func TestFactoryCreateProcessors_SharedStore(t *testing.T) {
+	factory := NewFactory()
+	tracesCfg := factory.CreateDefaultConfig().(*Config)
+	tracesCfg.TraceStatements = []common.ContextStatements{
+		{
+			Context: "resource",
+			Statements: []string{
+				`set_store_value("factory_test_versions", attributes["service.name"], attributes["service.version"], Duration("10m"))`,
+			},
+		},
+	}
+	logsCfg := factory.CreateDefaultConfig().(*Config)
+	logsCfg.LogStatements = []common.ContextStatements{
+		{
+			Context: "resource",
+			Statements: []string{
+				`set(attributes["service.version"], StoreValue("factory_test_versions", attributes["service.name"]))`,
+			},
+		},
+	}
+	tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), tracesCfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	lp, err := factory.CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), logsCfg, consumertest.NewNop())
+	assert.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("service.name", "checkout")
+	td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.version", "1.2.3")
+	assert.NoError(t, tp.ConsumeTraces(context.Background(), td))
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("service.name", "checkout")
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("service.name", "cart")
+	assert.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+
+	val, ok := ld.ResourceLogs().At(0).Resource().Attributes().Get("service.version")
+	assert.True(t, ok)
+	assert.Equal(t, "1.2.3", val.Str())
+	_, ok = ld.ResourceLogs().At(1).Resource().Attributes().Get("service.version")
+	assert.False(t, ok)
+
+	// The store is removed along with its values once the processors using it are shut down
+	assert.NoError(t, tp.Shutdown(context.Background()))
+	assert.NoError(t, lp.Shutdown(context.Background()))
+	lp, err = factory.CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), logsCfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	ld = plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("service.name", "checkout")
+	assert.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+	_, ok = ld.ResourceLogs().At(0).Resource().Attributes().Get("service.version")
+	assert.False(t, ok)
+}
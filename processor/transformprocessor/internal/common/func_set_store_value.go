@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type setStoreValueArguments[K any] struct {
+	Store string                   `ottlarg:"0"`
+	Key   ottl.StringLikeGetter[K] `ottlarg:"1"`
+	Value ottl.Getter[K]           `ottlarg:"2"`
+	TTL   ottl.Getter[K]           `ottlarg:"3"`
+}
+
+func newSetStoreValueFactory[K any](stores *Stores) ottl.Factory[K] {
+	return ottl.NewFactory("set_store_value", &setStoreValueArguments[K]{}, createSetStoreValueFunction[K](stores))
+}
+
+func createSetStoreValueFunction[K any](stores *Stores) ottl.CreateFunctionFunc[K] {
+	return func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		args, ok := oArgs.(*setStoreValueArguments[K])
+
+		if !ok {
+			return nil, fmt.Errorf("SetStoreValueFactory args must be of type *SetStoreValueArguments[K]")
+		}
+
+		return setStoreValue(stores, args.Store, args.Key, args.Value, args.TTL)
+	}
+}
+
+func setStoreValue[K any](stores *Stores, name string, key ottl.StringLikeGetter[K], value ottl.Getter[K], ttl ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	if name == "" {
+		return nil, errors.New("store name cannot be empty")
+	}
+	s := stores.get(name)
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		k, err := key.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		// Values are only stored with a key
+		if k == nil {
+			return nil, nil
+		}
+		val, err := value.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+		t, err := ttl.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		d, ok := t.(time.Duration)
+		if !ok {
+			return nil, fmt.Errorf("expected a time.Duration TTL but got %T", t)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("TTL must be positive, got %v", d)
+		}
+		s.set(*k, val, d, stores.maxEntries)
+		return nil, nil
+	}, nil
+}
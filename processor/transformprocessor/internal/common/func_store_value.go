@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type storeValueArguments[K any] struct {
+	Store string                   `ottlarg:"0"`
+	Key   ottl.StringLikeGetter[K] `ottlarg:"1"`
+}
+
+func newStoreValueFactory[K any](stores *Stores) ottl.Factory[K] {
+	return ottl.NewFactory("StoreValue", &storeValueArguments[K]{}, createStoreValueFunction[K](stores))
+}
+
+func createStoreValueFunction[K any](stores *Stores) ottl.CreateFunctionFunc[K] {
+	return func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		args, ok := oArgs.(*storeValueArguments[K])
+
+		if !ok {
+			return nil, fmt.Errorf("StoreValueFactory args must be of type *StoreValueArguments[K]")
+		}
+
+		return storeValue(stores, args.Store, args.Key)
+	}
+}
+
+func storeValue[K any](stores *Stores, name string, key ottl.StringLikeGetter[K]) (ottl.ExprFunc[K], error) {
+	if name == "" {
+		return nil, errors.New("store name cannot be empty")
+	}
+	s := stores.get(name)
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		k, err := key.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if k == nil {
+			return nil, nil
+		}
+		if val, ok := s.get(*k); ok {
+			return val, nil
+		}
+		return nil, nil
+	}, nil
+}
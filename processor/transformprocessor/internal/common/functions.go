@@ -10,10 +10,25 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
-func ResourceFunctions() map[string]ottl.Factory[ottlresource.TransformContext] {
-	return ottlfuncs.StandardFuncs[ottlresource.TransformContext]()
+func ResourceFunctions(stores *Stores) map[string]ottl.Factory[ottlresource.TransformContext] {
+	return WithStoreFunctions(ottlfuncs.StandardFuncs[ottlresource.TransformContext](), stores)
 }
 
-func ScopeFunctions() map[string]ottl.Factory[ottlscope.TransformContext] {
-	return ottlfuncs.StandardFuncs[ottlscope.TransformContext]()
+func ScopeFunctions(stores *Stores) map[string]ottl.Factory[ottlscope.TransformContext] {
+	return WithStoreFunctions(ottlfuncs.StandardFuncs[ottlscope.TransformContext](), stores)
+}
+
+// WithStoreFunctions adds the functions setting and reading the values of the stores shared
+// by the transform processors to the given functions, for any context.
+func WithStoreFunctions[K any](functions map[string]ottl.Factory[K], stores *Stores) map[string]ottl.Factory[K] {
+	storeFunctions := ottl.CreateFactoryMap[K](
+		newSetStoreValueFactory[K](stores),
+		newStoreValueFactory[K](stores),
+	)
+
+	for k, v := range storeFunctions {
+		functions[k] = v
+	}
+
+	return functions
 }
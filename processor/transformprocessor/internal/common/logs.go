@@ -71,12 +71,12 @@ func WithLogErrorMode(errorMode ottl.ErrorMode) LogParserCollectionOption {
 	}
 }
 
-func NewLogParserCollection(settings component.TelemetrySettings, options ...LogParserCollectionOption) (*LogParserCollection, error) {
-	rp, err := ottlresource.NewParser(ResourceFunctions(), settings)
+func NewLogParserCollection(settings component.TelemetrySettings, stores *Stores, options ...LogParserCollectionOption) (*LogParserCollection, error) {
+	rp, err := ottlresource.NewParser(ResourceFunctions(stores), settings)
 	if err != nil {
 		return nil, err
 	}
-	sp, err := ottlscope.NewParser(ScopeFunctions(), settings)
+	sp, err := ottlscope.NewParser(ScopeFunctions(stores), settings)
 	if err != nil {
 		return nil, err
 	}
@@ -171,12 +171,12 @@ func WithMetricErrorMode(errorMode ottl.ErrorMode) MetricParserCollectionOption
 	}
 }
 
-func NewMetricParserCollection(settings component.TelemetrySettings, options ...MetricParserCollectionOption) (*MetricParserCollection, error) {
-	rp, err := ottlresource.NewParser(ResourceFunctions(), settings)
+func NewMetricParserCollection(settings component.TelemetrySettings, stores *Stores, options ...MetricParserCollectionOption) (*MetricParserCollection, error) {
+	rp, err := ottlresource.NewParser(ResourceFunctions(stores), settings)
 	if err != nil {
 		return nil, err
 	}
-	sp, err := ottlscope.NewParser(ScopeFunctions(), settings)
+	sp, err := ottlscope.NewParser(ScopeFunctions(stores), settings)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// StoreRegistry holds the keyed stores shared by the transform processors created by a factory, so
+// the values set by the statements of a pipeline can be read by the statements of another one.
+// A store is kept as long as a processor whose statements use it has not been shut down.
+type StoreRegistry struct {
+	mu     sync.Mutex
+	stores map[string]*registeredStore
+}
+
+type registeredStore struct {
+	*store
+	refs int
+}
+
+func NewStoreRegistry() *StoreRegistry {
+	return &StoreRegistry{stores: map[string]*registeredStore{}}
+}
+
+// NewStores returns the stores of a processor, in which its statements set at most maxEntries values.
+// A maxEntries of 0 does not limit the number of values.
+func (r *StoreRegistry) NewStores(maxEntries int) *Stores {
+	return &Stores{
+		registry:   r,
+		maxEntries: maxEntries,
+		acquired:   map[string]*store{},
+	}
+}
+
+// acquire returns the store with the given name, creating it if it does not exist.
+func (r *StoreRegistry) acquire(name string) *store {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.stores[name]
+	if !ok {
+		s = &registeredStore{store: newStore(time.Now)}
+		r.stores[name] = s
+	}
+	s.refs++
+	return s.store
+}
+
+// release removes the store with the given name once it is no longer used.
+func (r *StoreRegistry) release(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.stores[name]
+	if !ok {
+		return
+	}
+	s.refs--
+	if s.refs <= 0 {
+		delete(r.stores, name)
+	}
+}
+
+func (r *StoreRegistry) len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.stores)
+}
+
+// Stores are the stores used by the statements of a processor. They are acquired from
+// the registry as the statements are parsed, and released when the processor is shut down.
+type Stores struct {
+	registry   *StoreRegistry
+	maxEntries int
+
+	mu       sync.Mutex
+	acquired map[string]*store
+}
+
+func (s *Stores) get(name string) *store {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.acquired[name]
+	if !ok {
+		st = s.registry.acquire(name)
+		s.acquired[name] = st
+	}
+	return st
+}
+
+// Release releases the stores of the processor. The stores no longer used by any
+// processor are removed along with their values.
+func (s *Stores) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name := range s.acquired {
+		s.registry.release(name)
+	}
+	s.acquired = map[string]*store{}
+}
+
+type storeEntry struct {
+	key    string
+	value  interface{}
+	expiry time.Time
+	// index is the position of the entry in the expiry heap of the store.
+	index int
+}
+
+// expiryHeap orders the entries of a store by expiry, the entry expiring first being at the top.
+type expiryHeap []*storeEntry
+
+func (h expiryHeap) Len() int           { return len(h) }
+func (h expiryHeap) Less(i, j int) bool { return h[i].expiry.Before(h[j].expiry) }
+
+func (h expiryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *expiryHeap) Push(x interface{}) {
+	entry := x.(*storeEntry)
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *expiryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return entry
+}
+
+// store is an in-memory map of values which expire after the TTL they are set with.
+// The expired values are removed whenever a value is set or read.
+type store struct {
+	mu       sync.Mutex
+	entries  map[string]*storeEntry
+	expiries expiryHeap
+	now      func() time.Time
+}
+
+func newStore(now func() time.Time) *store {
+	return &store{
+		entries: map[string]*storeEntry{},
+		now:     now,
+	}
+}
+
+// set sets the value of the key. When the store already holds maxEntries values and the key
+// is not one of them, the value expiring first is evicted. A maxEntries of 0 does not limit the values.
+func (s *store) set(key string, value interface{}, ttl time.Duration, maxEntries int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.removeExpired(now)
+	if entry, ok := s.entries[key]; ok {
+		entry.value = copyStoreValue(value)
+		entry.expiry = now.Add(ttl)
+		heap.Fix(&s.expiries, entry.index)
+		return
+	}
+	for maxEntries > 0 && len(s.entries) >= maxEntries {
+		s.remove(s.expiries[0])
+	}
+	entry := &storeEntry{key: key, value: copyStoreValue(value), expiry: now.Add(ttl)}
+	heap.Push(&s.expiries, entry)
+	s.entries[key] = entry
+}
+
+func (s *store) get(key string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeExpired(s.now())
+	entry, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return copyStoreValue(entry.value), true
+}
+
+func (s *store) removeExpired(now time.Time) {
+	for len(s.expiries) > 0 && !now.Before(s.expiries[0].expiry) {
+		s.remove(s.expiries[0])
+	}
+}
+
+func (s *store) remove(entry *storeEntry) {
+	heap.Remove(&s.expiries, entry.index)
+	delete(s.entries, entry.key)
+}
+
+func (s *store) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
+
+// copyStoreValue copies the values referencing the telemetry they are read from, or stored
+// values which could be modified by the statements they are returned to.
+func copyStoreValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case pcommon.Map:
+		m := pcommon.NewMap()
+		v.CopyTo(m)
+		return m
+	case pcommon.Slice:
+		sl := pcommon.NewSlice()
+		v.CopyTo(sl)
+		return sl
+	case pcommon.Value:
+		val := pcommon.NewValueEmpty()
+		v.CopyTo(val)
+		return val
+	case []byte:
+		return append([]byte(nil), v...)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_store(t *testing.T) {
+	now := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	s := newStore(func() time.Time { return now })
+
+	s.set("a", "1", 10*time.Second, 0)
+	s.set("b", "2", 2*time.Minute, 0)
+	s.set("c", "3", time.Minute, 0)
+	val, ok := s.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "1", val)
+
+	// Reading a value removes the expired values
+	now = now.Add(10 * time.Second)
+	_, ok = s.get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 2, s.len())
+	val, ok = s.get("b")
+	assert.True(t, ok)
+	assert.Equal(t, "2", val)
+
+	// Setting a key again renews its expiry
+	s.set("c", "4", 2*time.Minute, 0)
+	now = now.Add(time.Minute)
+	val, ok = s.get("c")
+	assert.True(t, ok)
+	assert.Equal(t, "4", val)
+
+	// Setting a value removes the expired values
+	now = now.Add(50 * time.Second)
+	s.set("d", "5", time.Minute, 0)
+	assert.Equal(t, 2, s.len())
+	_, ok = s.get("b")
+	assert.False(t, ok)
+	_, ok = s.get("c")
+	assert.True(t, ok)
+	_, ok = s.get("d")
+	assert.True(t, ok)
+}
+
+func Test_store_maxEntries(t *testing.T) {
+	now := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	s := newStore(func() time.Time { return now })
+
+	s.set("a", "1", 3*time.Minute, 3)
+	s.set("b", "2", time.Minute, 3)
+	s.set("c", "3", 2*time.Minute, 3)
+
+	// Setting a new key in a full store evicts the value expiring first
+	s.set("d", "4", time.Minute, 3)
+	assert.Equal(t, 3, s.len())
+	_, ok := s.get("b")
+	assert.False(t, ok)
+
+	// Setting a key the store holds evicts nothing
+	s.set("a", "5", time.Second, 3)
+	assert.Equal(t, 3, s.len())
+	val, ok := s.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "5", val)
+
+	s.set("e", "6", time.Minute, 3)
+	assert.Equal(t, 3, s.len())
+	_, ok = s.get("a")
+	assert.False(t, ok)
+	for _, key := range []string{"c", "d", "e"} {
+		_, ok = s.get(key)
+		assert.True(t, ok, key)
+	}
+
+	// A lower maximum evicts as many values as needed
+	s.set("f", "7", time.Minute, 1)
+	assert.Equal(t, 1, s.len())
+	_, ok = s.get("f")
+	assert.True(t, ok)
+}
+
+func Test_StoreRegistry(t *testing.T) {
+	registry := NewStoreRegistry()
+	traces := registry.NewStores(0)
+	logs := registry.NewStores(0)
+
+	traces.get("versions").set("checkout", "1.2.3", time.Minute, traces.maxEntries)
+	traces.get("owners")
+	val, ok := logs.get("versions").get("checkout")
+	assert.True(t, ok)
+	assert.Equal(t, "1.2.3", val)
+	assert.Equal(t, 2, registry.len())
+
+	// A store is removed once no processor uses it
+	traces.Release()
+	assert.Equal(t, 1, registry.len())
+	val, ok = logs.get("versions").get("checkout")
+	assert.True(t, ok)
+	assert.Equal(t, "1.2.3", val)
+
+	logs.Release()
+	assert.Equal(t, 0, registry.len())
+	_, ok = registry.NewStores(0).get("versions").get("checkout")
+	assert.False(t, ok)
+}
+
+func Test_store_copy(t *testing.T) {
+	s := newStore(time.Now)
+
+	m := pcommon.NewMap()
+	m.PutStr("k", "v")
+	s.set("map", m, time.Minute, 0)
+	m.PutStr("k", "changed")
+
+	val, ok := s.get("map")
+	require.True(t, ok)
+	stored, ok := val.(pcommon.Map)
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"k": "v"}, stored.AsRaw())
+
+	stored.PutStr("k", "changed")
+	val, ok = s.get("map")
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"k": "v"}, val.(pcommon.Map).AsRaw())
+}
+
+func Test_setStoreValue_storeValue(t *testing.T) {
+	stores := NewStoreRegistry().NewStores(0)
+	key := ottl.StandardStringLikeGetter[pcommon.Map]{
+		Getter: func(_ context.Context, tCtx pcommon.Map) (interface{}, error) {
+			if v, ok := tCtx.Get("service.name"); ok {
+				return v.Str(), nil
+			}
+			return nil, nil
+		},
+	}
+	value := ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(_ context.Context, tCtx pcommon.Map) (interface{}, error) {
+			if v, ok := tCtx.Get("service.version"); ok {
+				return v.Str(), nil
+			}
+			return nil, nil
+		},
+	}
+	ttl := func(d interface{}) ottl.Getter[pcommon.Map] {
+		return ottl.StandardGetSetter[pcommon.Map]{
+			Getter: func(context.Context, pcommon.Map) (interface{}, error) {
+				return d, nil
+			},
+		}
+	}
+
+	set, err := setStoreValue[pcommon.Map](stores, "versions", key, value, ttl(time.Minute))
+	require.NoError(t, err)
+	lookup, err := storeValue[pcommon.Map](stores, "versions", key)
+	require.NoError(t, err)
+
+	span := pcommon.NewMap()
+	span.PutStr("service.name", "checkout")
+	span.PutStr("service.version", "1.2.3")
+	_, err = set(context.Background(), span)
+	require.NoError(t, err)
+
+	log := pcommon.NewMap()
+	log.PutStr("service.name", "checkout")
+	val, err := lookup(context.Background(), log)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3", val)
+
+	log.PutStr("service.name", "cart")
+	val, err = lookup(context.Background(), log)
+	require.NoError(t, err)
+	assert.Nil(t, val)
+
+	// Nothing is set without a key or a value
+	_, err = set(context.Background(), pcommon.NewMap())
+	require.NoError(t, err)
+	assert.Equal(t, 1, stores.get("versions").len())
+
+	invalidTTL, err := setStoreValue[pcommon.Map](stores, "versions", key, value, ttl("10m"))
+	require.NoError(t, err)
+	_, err = invalidTTL(context.Background(), span)
+	assert.EqualError(t, err, "expected a time.Duration TTL but got string")
+
+	negativeTTL, err := setStoreValue[pcommon.Map](stores, "versions", key, value, ttl(-time.Minute))
+	require.NoError(t, err)
+	_, err = negativeTTL(context.Background(), span)
+	assert.EqualError(t, err, "TTL must be positive, got -1m0s")
+
+	_, err = storeValue[pcommon.Map](stores, "", key)
+	assert.EqualError(t, err, "store name cannot be empty")
+}
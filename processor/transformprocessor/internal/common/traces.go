@@ -118,12 +118,12 @@ func WithTraceErrorMode(errorMode ottl.ErrorMode) TraceParserCollectionOption {
 	}
 }
 
-func NewTraceParserCollection(settings component.TelemetrySettings, options ...TraceParserCollectionOption) (*TraceParserCollection, error) {
-	rp, err := ottlresource.NewParser(ResourceFunctions(), settings)
+func NewTraceParserCollection(settings component.TelemetrySettings, stores *Stores, options ...TraceParserCollectionOption) (*TraceParserCollection, error) {
+	rp, err := ottlresource.NewParser(ResourceFunctions(stores), settings)
 	if err != nil {
 		return nil, err
 	}
-	sp, err := ottlscope.NewParser(ScopeFunctions(), settings)
+	sp, err := ottlscope.NewParser(ScopeFunctions(stores), settings)
 	if err != nil {
 		return nil, err
 	}
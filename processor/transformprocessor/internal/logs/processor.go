@@ -21,8 +21,8 @@ type Processor struct {
 	logger   *zap.Logger
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings) (*Processor, error) {
-	pc, err := common.NewLogParserCollection(settings, common.WithLogParser(LogFunctions()), common.WithLogErrorMode(errorMode))
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, stores *common.Stores) (*Processor, error) {
+	pc, err := common.NewLogParserCollection(settings, stores, common.WithLogParser(LogFunctions(stores)), common.WithLogErrorMode(errorMode))
 	if err != nil {
 		return nil, err
 	}
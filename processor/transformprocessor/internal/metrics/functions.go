@@ -8,10 +8,11 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
-func DataPointFunctions() map[string]ottl.Factory[ottldatapoint.TransformContext] {
-	functions := ottlfuncs.StandardFuncs[ottldatapoint.TransformContext]()
+func DataPointFunctions(stores *common.Stores) map[string]ottl.Factory[ottldatapoint.TransformContext] {
+	functions := common.WithStoreFunctions(ottlfuncs.StandardFuncs[ottldatapoint.TransformContext](), stores)
 
 	datapointFunctions := ottl.CreateFactoryMap[ottldatapoint.TransformContext](
 		newConvertSumToGaugeFactory(),
@@ -27,6 +28,6 @@ func DataPointFunctions() map[string]ottl.Factory[ottldatapoint.TransformContext
 	return functions
 }
 
-func MetricFunctions() map[string]ottl.Factory[ottlmetric.TransformContext] {
-	return ottlfuncs.StandardFuncs[ottlmetric.TransformContext]()
+func MetricFunctions(stores *common.Stores) map[string]ottl.Factory[ottlmetric.TransformContext] {
+	return common.WithStoreFunctions(ottlfuncs.StandardFuncs[ottlmetric.TransformContext](), stores)
 }
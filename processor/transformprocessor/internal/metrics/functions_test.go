@@ -12,16 +12,17 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
 func Test_DataPointFunctions(t *testing.T) {
-	expected := ottlfuncs.StandardFuncs[ottldatapoint.TransformContext]()
+	expected := common.WithStoreFunctions(ottlfuncs.StandardFuncs[ottldatapoint.TransformContext](), nil)
 	expected["convert_sum_to_gauge"] = newConvertSumToGaugeFactory()
 	expected["convert_gauge_to_sum"] = newConvertGaugeToSumFactory()
 	expected["convert_summary_sum_val_to_sum"] = newConvertSummarySumValToSumFactory()
 	expected["convert_summary_count_val_to_sum"] = newConvertSummaryCountValToSumFactory()
 
-	actual := DataPointFunctions()
+	actual := DataPointFunctions(nil)
 
 	require.Equal(t, len(expected), len(actual))
 	for k := range actual {
@@ -30,8 +31,8 @@ func Test_DataPointFunctions(t *testing.T) {
 }
 
 func Test_MetricFunctions(t *testing.T) {
-	expected := ottlfuncs.StandardFuncs[ottlmetric.TransformContext]()
-	actual := MetricFunctions()
+	expected := common.WithStoreFunctions(ottlfuncs.StandardFuncs[ottlmetric.TransformContext](), nil)
+	actual := MetricFunctions(nil)
 	require.Equal(t, len(expected), len(actual))
 	for k := range actual {
 		assert.Contains(t, expected, k)
@@ -21,8 +21,8 @@ type Processor struct {
 	logger   *zap.Logger
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings) (*Processor, error) {
-	pc, err := common.NewMetricParserCollection(settings, common.WithMetricParser(MetricFunctions()), common.WithDataPointParser(DataPointFunctions()), common.WithMetricErrorMode(errorMode))
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, stores *common.Stores) (*Processor, error) {
+	pc, err := common.NewMetricParserCollection(settings, stores, common.WithMetricParser(MetricFunctions(stores)), common.WithDataPointParser(DataPointFunctions(stores)), common.WithMetricErrorMode(errorMode))
 	if err != nil {
 		return nil, err
 	}
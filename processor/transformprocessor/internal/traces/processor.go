@@ -21,8 +21,8 @@ type Processor struct {
 	logger   *zap.Logger
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings) (*Processor, error) {
-	pc, err := common.NewTraceParserCollection(settings, common.WithSpanParser(SpanFunctions()), common.WithSpanEventParser(SpanEventFunctions()), common.WithTraceErrorMode(errorMode))
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, stores *common.Stores) (*Processor, error) {
+	pc, err := common.NewTraceParserCollection(settings, stores, common.WithSpanParser(SpanFunctions(stores)), common.WithSpanEventParser(SpanEventFunctions(stores)), common.WithTraceErrorMode(errorMode))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/multierr"
+)
+
+const (
+	firehoseKey = "firehose"
+	kinesisKey  = "kinesis"
+
+	startingPositionLatest      = "latest"
+	startingPositionTrimHorizon = "trim_horizon"
+)
+
+// Config defines the configuration of the CloudWatch Logs subscription receiver.
+type Config struct {
+	// Firehose configures the HTTP endpoint the Firehose delivery stream
+	// of the subscription delivers the records to.
+	Firehose *FirehoseConfig `mapstructure:"firehose"`
+	// Kinesis configures the Kinesis data stream of the subscription
+	// the records are read from.
+	Kinesis *KinesisConfig `mapstructure:"kinesis"`
+}
+
+// FirehoseConfig is the configuration of the Firehose HTTP endpoint.
+type FirehoseConfig struct {
+	// HTTPServerSettings is used to set up the Firehose delivery
+	// endpoint. The Firehose delivery stream expects an HTTPS
+	// endpoint, so TLSSettings must be used to enable that.
+	confighttp.HTTPServerSettings `mapstructure:",squash"`
+	// AccessKey is checked against the one received with each request.
+	// This can be set when creating or updating the Firehose delivery
+	// stream.
+	AccessKey configopaque.String `mapstructure:"access_key"`
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies.
+	Decompression *component.ID `mapstructure:"decompression"`
+}
+
+// KinesisConfig is the configuration of the Kinesis data stream consumer.
+type KinesisConfig struct {
+	Region   string `mapstructure:"region"`
+	Profile  string `mapstructure:"profile"`
+	Endpoint string `mapstructure:"endpoint"`
+	// StreamName is the name of the Kinesis data stream.
+	StreamName string `mapstructure:"stream_name"`
+	// StartingPosition is the position the shards are read from when the receiver starts,
+	// either latest or trim_horizon.
+	StartingPosition string `mapstructure:"starting_position"`
+	// PollInterval is the time between two reads of a shard which has no new records.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// MaxRecordsPerRequest is the maximum number of records read at once from a shard.
+	MaxRecordsPerRequest int64 `mapstructure:"max_records_per_request"`
+}
+
+var (
+	errNoSource               = errors.New("must specify at least one of firehose or kinesis")
+	errNoFirehoseEndpoint     = errors.New("must specify firehose::endpoint")
+	errNoRegion               = errors.New("no kinesis::region was specified")
+	errNoStreamName           = errors.New("no kinesis::stream_name was specified")
+	errInvalidPollInterval    = errors.New("kinesis::poll_interval must be greater than or equal to one second")
+	errInvalidMaxRecordsLimit = errors.New("kinesis::max_records_per_request must be between 1 and 10000")
+)
+
+var _ confmap.Unmarshaler = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid
+func (c *Config) Validate() error {
+	if c.Firehose == nil && c.Kinesis == nil {
+		return errNoSource
+	}
+	var errs error
+	if c.Firehose != nil && c.Firehose.Endpoint == "" {
+		errs = multierr.Append(errs, errNoFirehoseEndpoint)
+	}
+	if c.Kinesis != nil {
+		errs = multierr.Append(errs, c.Kinesis.validate())
+	}
+	return errs
+}
+
+func (c *KinesisConfig) validate() error {
+	var errs error
+	if c.Region == "" {
+		errs = multierr.Append(errs, errNoRegion)
+	}
+	if c.StreamName == "" {
+		errs = multierr.Append(errs, errNoStreamName)
+	}
+	switch c.StartingPosition {
+	case startingPositionLatest, startingPositionTrimHorizon:
+	default:
+		errs = multierr.Append(errs, fmt.Errorf("invalid kinesis::starting_position %q, must be %s or %s",
+			c.StartingPosition, startingPositionLatest, startingPositionTrimHorizon))
+	}
+	if c.PollInterval < time.Second {
+		errs = multierr.Append(errs, errInvalidPollInterval)
+	}
+	if c.MaxRecordsPerRequest < 1 || c.MaxRecordsPerRequest > 10000 {
+		errs = multierr.Append(errs, errInvalidMaxRecordsLimit)
+	}
+	return errs
+}
+
+// Unmarshal a confmap.Conf into the config struct.
+func (c *Config) Unmarshal(conf *confmap.Conf) error {
+	err := conf.Unmarshal(c, confmap.WithErrorUnused())
+	if err != nil {
+		return err
+	}
+
+	if !conf.IsSet(firehoseKey) {
+		c.Firehose = nil
+	}
+
+	if !conf.IsSet(kinesisKey) {
+		c.Kinesis = nil
+	}
+
+	return nil
+}
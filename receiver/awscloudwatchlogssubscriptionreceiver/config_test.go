@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, "firehose"),
+			expected: &Config{
+				Firehose: &FirehoseConfig{
+					HTTPServerSettings: confighttp.HTTPServerSettings{Endpoint: defaultFirehoseEndpoint},
+					AccessKey:          "secret",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "kinesis"),
+			expected: &Config{
+				Kinesis: &KinesisConfig{
+					Region:               "us-east-1",
+					StreamName:           "cloudwatch-logs",
+					StartingPosition:     startingPositionTrimHorizon,
+					PollInterval:         defaultPollInterval,
+					MaxRecordsPerRequest: defaultMaxRecordsPerRequest,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "both"),
+			expected: &Config{
+				Firehose: &FirehoseConfig{
+					HTTPServerSettings: confighttp.HTTPServerSettings{Endpoint: "0.0.0.0:8443"},
+				},
+				Kinesis: &KinesisConfig{
+					Region:               "eu-west-1",
+					Profile:              "collector",
+					StreamName:           "cloudwatch-logs",
+					StartingPosition:     startingPositionLatest,
+					PollInterval:         5 * time.Second,
+					MaxRecordsPerRequest: 500,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected error
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, "none"),
+			expected: errNoSource,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_kinesis"),
+			expected: multierr.Combine(
+				errNoRegion,
+				errNoStreamName,
+				errors.New(`invalid kinesis::starting_position "at_timestamp", must be latest or trim_horizon`),
+				errInvalidPollInterval,
+				errInvalidMaxRecordsLimit,
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.EqualError(t, component.ValidateConfig(cfg), tt.expected.Error())
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package awscloudwatchlogssubscriptionreceiver implements a receiver for the
+// CloudWatch Logs subscription payloads delivered by Firehose or Kinesis.
+package awscloudwatchlogssubscriptionreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver"
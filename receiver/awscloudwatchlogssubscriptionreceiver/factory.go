@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver/internal/metadata"
+)
+
+const (
+	defaultFirehoseEndpoint     = "0.0.0.0:4433"
+	defaultPollInterval         = time.Second
+	defaultMaxRecordsPerRequest = 1000
+)
+
+// NewFactory creates a factory for the CloudWatch Logs subscription receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Firehose: &FirehoseConfig{
+			HTTPServerSettings: confighttp.HTTPServerSettings{
+				Endpoint: defaultFirehoseEndpoint,
+			},
+		},
+		Kinesis: &KinesisConfig{
+			StartingPosition:     startingPositionLatest,
+			PollInterval:         defaultPollInterval,
+			MaxRecordsPerRequest: defaultMaxRecordsPerRequest,
+		},
+	}
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	return newSubscriptionReceiver(cfg.(*Config), set, nextConsumer), nil
+}
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver"
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+const (
+	headerFirehoseRequestID        = "X-Amz-Firehose-Request-Id"
+	headerFirehoseAccessKey        = "X-Amz-Firehose-Access-Key"
+	headerFirehoseCommonAttributes = "X-Amz-Firehose-Common-Attributes"
+	headerContentType              = "Content-Type"
+)
+
+var (
+	errInvalidAccessKey         = errors.New("invalid firehose access key")
+	errInHeaderMissingRequestID = errors.New("missing request id in header")
+	errInBodyDiffRequestID      = errors.New("different request id in body")
+)
+
+// firehoseRequest is the format of the body of the Firehose requests.
+// See https://docs.aws.amazon.com/firehose/latest/dev/httpdeliveryrequestresponse.html
+type firehoseRequest struct {
+	RequestID string `json:"requestId"`
+	Timestamp int64  `json:"timestamp"`
+	Records   []struct {
+		// Data is a base64 encoded record.
+		Data string `json:"data"`
+	} `json:"records"`
+}
+
+// firehoseResponse is the expected body of the responses to the Firehose requests.
+type firehoseResponse struct {
+	RequestID    string `json:"requestId"`
+	Timestamp    int64  `json:"timestamp"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+type firehoseCommonAttributes struct {
+	CommonAttributes map[string]string `json:"commonAttributes"`
+}
+
+// ServeHTTP receives the Firehose requests, and sends the log events of their records
+// to the next consumer.
+func (r *subscriptionReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	requestID := req.Header.Get(headerFirehoseRequestID)
+	if requestID == "" {
+		r.sendResponse(w, requestID, http.StatusBadRequest, errInHeaderMissingRequestID)
+		return
+	}
+	if accessKey := req.Header.Get(headerFirehoseAccessKey); r.config.Firehose.AccessKey != "" && accessKey != string(r.config.Firehose.AccessKey) {
+		r.sendResponse(w, requestID, http.StatusUnauthorized, errInvalidAccessKey)
+		return
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		r.sendResponse(w, requestID, http.StatusBadRequest, err)
+		return
+	}
+	var fr firehoseRequest
+	if err = json.Unmarshal(body, &fr); err != nil {
+		r.sendResponse(w, requestID, http.StatusBadRequest, err)
+		return
+	}
+	if fr.RequestID != requestID {
+		r.sendResponse(w, requestID, http.StatusBadRequest, errInBodyDiffRequestID)
+		return
+	}
+
+	commonAttributes, err := getCommonAttributes(req)
+	if err != nil {
+		r.settings.Logger.Error("Unable to get common attributes from request header. Will not attach attributes.", zap.Error(err))
+	}
+
+	logs := plog.NewLogs()
+	observedTime := pcommon.NewTimestampFromTime(time.Now())
+	for index, record := range fr.Records {
+		if record.Data == "" {
+			continue
+		}
+		var decoded []byte
+		if decoded, err = base64.StdEncoding.DecodeString(record.Data); err != nil {
+			r.sendResponse(w, requestID, http.StatusBadRequest, fmt.Errorf("unable to base64 decode the record at index %d: %w", index, err))
+			return
+		}
+		var msg *subscriptionMessage
+		if msg, err = unmarshalRecord(decoded); err != nil {
+			r.sendResponse(w, requestID, http.StatusBadRequest, fmt.Errorf("invalid record at index %d: %w", index, err))
+			return
+		}
+		appendLogs(logs, msg, commonAttributes[attributeRegion], observedTime)
+	}
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		attrs := logs.ResourceLogs().At(i).Resource().Attributes()
+		for k, v := range commonAttributes {
+			attrs.PutStr(k, v)
+		}
+	}
+
+	if err = r.consume(req.Context(), logs); err != nil {
+		statusCode := http.StatusServiceUnavailable
+		if consumererror.IsPermanent(err) {
+			statusCode = http.StatusBadRequest
+		}
+		r.settings.Logger.Error("Unable to consume records", zap.Error(err))
+		r.sendResponse(w, requestID, statusCode, err)
+		return
+	}
+	r.sendResponse(w, requestID, http.StatusOK, nil)
+}
+
+func getCommonAttributes(req *http.Request) (map[string]string, error) {
+	header := req.Header.Get(headerFirehoseCommonAttributes)
+	if header == "" {
+		return nil, nil
+	}
+	var fca firehoseCommonAttributes
+	if err := json.Unmarshal([]byte(header), &fca); err != nil {
+		return nil, err
+	}
+	return fca.CommonAttributes, nil
+}
+
+// sendResponse writes a response to Firehose in the expected format.
+func (r *subscriptionReceiver) sendResponse(w http.ResponseWriter, requestID string, statusCode int, err error) {
+	body := firehoseResponse{
+		RequestID: requestID,
+		Timestamp: time.Now().UnixMilli(),
+	}
+	if err != nil {
+		body.ErrorMessage = err.Error()
+	}
+	payload, _ := json.Marshal(body)
+	w.Header().Set(headerContentType, "application/json")
+	w.WriteHeader(statusCode)
+	if _, err = w.Write(payload); err != nil {
+		r.settings.Logger.Error("Failed to send response", zap.Error(err))
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+const testRequestID = "request-id"
+
+func newFirehoseRequest(t *testing.T, requestID string, records ...[]byte) *http.Request {
+	fr := firehoseRequest{RequestID: requestID}
+	for _, record := range records {
+		fr.Records = append(fr.Records, struct {
+			Data string `json:"data"`
+		}{Data: base64.StdEncoding.EncodeToString(record)})
+	}
+	body, err := json.Marshal(fr)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(headerFirehoseRequestID, testRequestID)
+	return req
+}
+
+func newFirehoseReceiver(accessKey string, next consumer.Logs) *subscriptionReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Kinesis = nil
+	cfg.Firehose.AccessKey = configopaque.String(accessKey)
+	return newSubscriptionReceiver(cfg, receivertest.NewNopCreateSettings(), next)
+}
+
+func TestFirehoseServeHTTP(t *testing.T) {
+	control := subscriptionMessage{MessageType: messageTypeControl, LogGroup: "group", LogStream: "stream"}
+
+	testCases := map[string]struct {
+		accessKey      string
+		request        func(t *testing.T) *http.Request
+		consumeErr     error
+		wantStatusCode int
+		wantErr        string
+		wantRecords    int
+	}{
+		"valid": {
+			request: func(t *testing.T) *http.Request {
+				req := newFirehoseRequest(t, testRequestID, compressedRecord(t, testMessage), compressedRecord(t, control))
+				req.Header.Set(headerFirehoseCommonAttributes, `{"commonAttributes":{"aws.region":"us-east-1"}}`)
+				return req
+			},
+			wantStatusCode: http.StatusOK,
+			wantRecords:    2,
+		},
+		"missing request id": {
+			request: func(t *testing.T) *http.Request {
+				req := newFirehoseRequest(t, testRequestID)
+				req.Header.Del(headerFirehoseRequestID)
+				return req
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantErr:        errInHeaderMissingRequestID.Error(),
+		},
+		"different request id": {
+			request: func(t *testing.T) *http.Request {
+				return newFirehoseRequest(t, "other")
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantErr:        errInBodyDiffRequestID.Error(),
+		},
+		"invalid access key": {
+			accessKey: "secret",
+			request: func(t *testing.T) *http.Request {
+				req := newFirehoseRequest(t, testRequestID)
+				req.Header.Set(headerFirehoseAccessKey, "other")
+				return req
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantErr:        errInvalidAccessKey.Error(),
+		},
+		"invalid record": {
+			request: func(t *testing.T) *http.Request {
+				return newFirehoseRequest(t, testRequestID, []byte("not json"))
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantErr:        "invalid record at index 0: unable to decode record: invalid character 'o' in literal null (expecting 'u')",
+		},
+		"consumer error": {
+			request: func(t *testing.T) *http.Request {
+				return newFirehoseRequest(t, testRequestID, compressedRecord(t, testMessage))
+			},
+			consumeErr:     errors.New("refused"),
+			wantStatusCode: http.StatusServiceUnavailable,
+			wantErr:        "refused",
+		},
+		"permanent consumer error": {
+			request: func(t *testing.T) *http.Request {
+				return newFirehoseRequest(t, testRequestID, compressedRecord(t, testMessage))
+			},
+			consumeErr:     consumererror.NewPermanent(errors.New("invalid")),
+			wantStatusCode: http.StatusBadRequest,
+			wantErr:        "Permanent error: invalid",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sink := new(consumertest.LogsSink)
+			var next consumer.Logs = sink
+			if testCase.consumeErr != nil {
+				next = consumertest.NewErr(testCase.consumeErr)
+			}
+			r := newFirehoseReceiver(testCase.accessKey, next)
+
+			req := testCase.request(t)
+			got := httptest.NewRecorder()
+			r.ServeHTTP(got, req)
+			require.Equal(t, testCase.wantStatusCode, got.Code)
+
+			var response firehoseResponse
+			require.NoError(t, json.Unmarshal(got.Body.Bytes(), &response))
+			assert.Equal(t, req.Header.Get(headerFirehoseRequestID), response.RequestID)
+			assert.Equal(t, testCase.wantErr, response.ErrorMessage)
+			assert.Equal(t, testCase.wantRecords, sink.LogRecordCount())
+
+			if testCase.wantRecords > 0 {
+				region, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeRegion)
+				require.True(t, ok)
+				assert.Equal(t, "us-east-1", region.Str())
+			}
+		})
+	}
+}
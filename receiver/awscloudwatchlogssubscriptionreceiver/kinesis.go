@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+type kinesisClient interface {
+	ListShardsWithContext(ctx aws.Context, input *kinesis.ListShardsInput, opts ...request.Option) (*kinesis.ListShardsOutput, error)
+	GetShardIteratorWithContext(ctx aws.Context, input *kinesis.GetShardIteratorInput, opts ...request.Option) (*kinesis.GetShardIteratorOutput, error)
+	GetRecordsWithContext(ctx aws.Context, input *kinesis.GetRecordsInput, opts ...request.Option) (*kinesis.GetRecordsOutput, error)
+}
+
+func newKinesisClient(cfg *KinesisConfig) (kinesisClient, error) {
+	awsConfig := aws.NewConfig().WithRegion(cfg.Region)
+	if cfg.Endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(cfg.Endpoint)
+	}
+	options := session.Options{
+		Config: *awsConfig,
+	}
+	if cfg.Profile != "" {
+		options.Profile = cfg.Profile
+	}
+	s, err := session.NewSessionWithOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	return kinesis.New(s), nil
+}
+
+// startKinesis starts reading every shard of the stream.
+func (r *subscriptionReceiver) startKinesis(ctx context.Context) error {
+	if r.kinesisClient == nil {
+		client, err := newKinesisClient(r.config.Kinesis)
+		if err != nil {
+			return fmt.Errorf("unable to create kinesis client: %w", err)
+		}
+		r.kinesisClient = client
+	}
+
+	shards, err := r.listShards(ctx)
+	if err != nil {
+		return err
+	}
+	for _, shardID := range shards {
+		r.wg.Add(1)
+		go r.readShard(ctx, shardID)
+	}
+	return nil
+}
+
+func (r *subscriptionReceiver) listShards(ctx context.Context) ([]string, error) {
+	var shards []string
+	input := &kinesis.ListShardsInput{StreamName: aws.String(r.config.Kinesis.StreamName)}
+	for {
+		output, err := r.kinesisClient.ListShardsWithContext(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the shards of kinesis stream %s: %w", r.config.Kinesis.StreamName, err)
+		}
+		for _, shard := range output.Shards {
+			shards = append(shards, aws.StringValue(shard.ShardId))
+		}
+		if output.NextToken == nil {
+			return shards, nil
+		}
+		// the stream name must not be set along with the next token
+		input = &kinesis.ListShardsInput{NextToken: output.NextToken}
+	}
+}
+
+func (r *subscriptionReceiver) shardIterator(ctx context.Context, shardID string, afterSequenceNumber string) (*string, error) {
+	input := &kinesis.GetShardIteratorInput{
+		StreamName: aws.String(r.config.Kinesis.StreamName),
+		ShardId:    aws.String(shardID),
+	}
+	switch {
+	case afterSequenceNumber != "":
+		input.ShardIteratorType = aws.String(kinesis.ShardIteratorTypeAfterSequenceNumber)
+		input.StartingSequenceNumber = aws.String(afterSequenceNumber)
+	case r.config.Kinesis.StartingPosition == startingPositionTrimHorizon:
+		input.ShardIteratorType = aws.String(kinesis.ShardIteratorTypeTrimHorizon)
+	default:
+		input.ShardIteratorType = aws.String(kinesis.ShardIteratorTypeLatest)
+	}
+	output, err := r.kinesisClient.GetShardIteratorWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return output.ShardIterator, nil
+}
+
+// readShard reads the records of the shard until it is closed or the receiver is shut down.
+func (r *subscriptionReceiver) readShard(ctx context.Context, shardID string) {
+	defer r.wg.Done()
+	logger := r.settings.Logger.With(zap.String("shard_id", shardID))
+
+	var (
+		iterator     *string
+		lastSequence string
+		err          error
+	)
+	for {
+		if iterator == nil {
+			if iterator, err = r.shardIterator(ctx, shardID, lastSequence); err != nil {
+				logger.Error("Unable to get kinesis shard iterator", zap.Error(err))
+				if !r.wait(ctx) {
+					return
+				}
+				continue
+			}
+			if iterator == nil {
+				return
+			}
+		}
+
+		var output *kinesis.GetRecordsOutput
+		output, err = r.kinesisClient.GetRecordsWithContext(ctx, &kinesis.GetRecordsInput{
+			ShardIterator: iterator,
+			Limit:         aws.Int64(r.config.Kinesis.MaxRecordsPerRequest),
+		})
+		if err != nil {
+			var aerr awserr.Error
+			if errors.As(err, &aerr) && aerr.Code() == kinesis.ErrCodeExpiredIteratorException {
+				iterator = nil
+				continue
+			}
+			logger.Error("Unable to get kinesis records", zap.Error(err))
+			if !r.wait(ctx) {
+				return
+			}
+			continue
+		}
+
+		if len(output.Records) > 0 {
+			r.consumeKinesisRecords(ctx, logger, output.Records)
+			lastSequence = aws.StringValue(output.Records[len(output.Records)-1].SequenceNumber)
+		}
+
+		// the shard is closed once its iterator is nil
+		if output.NextShardIterator == nil {
+			logger.Debug("Kinesis shard is closed")
+			return
+		}
+		iterator = output.NextShardIterator
+
+		if len(output.Records) == 0 || aws.Int64Value(output.MillisBehindLatest) == 0 {
+			if !r.wait(ctx) {
+				return
+			}
+		}
+	}
+}
+
+func (r *subscriptionReceiver) consumeKinesisRecords(ctx context.Context, logger *zap.Logger, records []*kinesis.Record) {
+	logs := plog.NewLogs()
+	observedTime := pcommon.NewTimestampFromTime(time.Now())
+	for _, record := range records {
+		msg, err := unmarshalRecord(record.Data)
+		if err != nil {
+			logger.Error("Invalid kinesis record", zap.String("sequence_number", aws.StringValue(record.SequenceNumber)), zap.Error(err))
+			continue
+		}
+		appendLogs(logs, msg, r.config.Kinesis.Region, observedTime)
+	}
+	if err := r.consume(ctx, logs); err != nil {
+		logger.Error("Unable to consume kinesis records", zap.Error(err))
+	}
+}
+
+// wait waits for the poll interval, and reports whether the receiver is still running.
+func (r *subscriptionReceiver) wait(ctx context.Context) bool {
+	timer := time.NewTimer(r.config.Kinesis.PollInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
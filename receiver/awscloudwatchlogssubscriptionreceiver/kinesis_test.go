@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+// mockKinesisClient serves the records of its shards, one record per request.
+type mockKinesisClient struct {
+	mu        sync.Mutex
+	shards    map[string][][]byte
+	iterators []*kinesis.GetShardIteratorInput
+	expired   bool
+}
+
+func (m *mockKinesisClient) ListShardsWithContext(_ aws.Context, input *kinesis.ListShardsInput, _ ...request.Option) (*kinesis.ListShardsOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// the second page is requested with the next token only
+	if input.NextToken != nil {
+		return &kinesis.ListShardsOutput{Shards: []*kinesis.Shard{{ShardId: aws.String("shard-2")}}}, nil
+	}
+	return &kinesis.ListShardsOutput{
+		Shards:    []*kinesis.Shard{{ShardId: aws.String("shard-1")}},
+		NextToken: aws.String("next"),
+	}, nil
+}
+
+func (m *mockKinesisClient) GetShardIteratorWithContext(_ aws.Context, input *kinesis.GetShardIteratorInput, _ ...request.Option) (*kinesis.GetShardIteratorOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.iterators = append(m.iterators, input)
+	position := "0"
+	if input.StartingSequenceNumber != nil {
+		position = *input.StartingSequenceNumber
+	}
+	return &kinesis.GetShardIteratorOutput{ShardIterator: aws.String(*input.ShardId + "/" + position)}, nil
+}
+
+func (m *mockKinesisClient) GetRecordsWithContext(_ aws.Context, input *kinesis.GetRecordsInput, _ ...request.Option) (*kinesis.GetRecordsOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var shardID string
+	var position int
+	for id := range m.shards {
+		for i := 0; i <= len(m.shards[id]); i++ {
+			if *input.ShardIterator == id+"/"+string(rune('0'+i)) {
+				shardID, position = id, i
+			}
+		}
+	}
+	if m.expired && position == 1 {
+		m.expired = false
+		return nil, awserr.New(kinesis.ErrCodeExpiredIteratorException, "expired", nil)
+	}
+
+	records := m.shards[shardID]
+	if position == len(records) {
+		// the shard is closed once all its records have been read
+		return &kinesis.GetRecordsOutput{MillisBehindLatest: aws.Int64(0)}, nil
+	}
+	return &kinesis.GetRecordsOutput{
+		Records: []*kinesis.Record{{
+			Data:           records[position],
+			SequenceNumber: aws.String(string(rune('0' + position + 1))),
+		}},
+		NextShardIterator:  aws.String(shardID + "/" + string(rune('0'+position+1))),
+		MillisBehindLatest: aws.Int64(1000),
+	}, nil
+}
+
+func TestKinesisReceiver(t *testing.T) {
+	client := &mockKinesisClient{
+		shards: map[string][][]byte{
+			"shard-1": {compressedRecord(t, testMessage), compressedRecord(t, testMessage)},
+			"shard-2": {[]byte("invalid"), compressedRecord(t, testMessage)},
+		},
+		expired: true,
+	}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Firehose = nil
+	cfg.Kinesis.Region = "us-east-1"
+	cfg.Kinesis.StreamName = "stream"
+	cfg.Kinesis.StartingPosition = startingPositionTrimHorizon
+	cfg.Kinesis.PollInterval = 10 * time.Millisecond
+
+	sink := new(consumertest.LogsSink)
+	r := newSubscriptionReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	r.kinesisClient = client
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	// 3 valid records of 2 log events each
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 6
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	region, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeRegion)
+	require.True(t, ok)
+	assert.Equal(t, "us-east-1", region.Str())
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	var afterSequence int
+	for _, input := range client.iterators {
+		assert.Equal(t, "stream", *input.StreamName)
+		if input.StartingSequenceNumber != nil {
+			afterSequence++
+			assert.Equal(t, kinesis.ShardIteratorTypeAfterSequenceNumber, *input.ShardIteratorType)
+		} else {
+			assert.Equal(t, kinesis.ShardIteratorTypeTrimHorizon, *input.ShardIteratorType)
+		}
+	}
+	// the expired iterator is requested again after the last read record
+	assert.Equal(t, 3, len(client.iterators))
+	assert.Equal(t, 1, afterSequence)
+}
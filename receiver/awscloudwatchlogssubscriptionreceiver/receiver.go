@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
+)
+
+// subscriptionReceiver receives the CloudWatch Logs subscription payloads from
+// a Firehose delivery stream and/or a Kinesis data stream.
+type subscriptionReceiver struct {
+	settings     receiver.CreateSettings
+	config       *Config
+	nextConsumer consumer.Logs
+
+	server        *http.Server
+	kinesisClient kinesisClient
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ receiver.Logs = (*subscriptionReceiver)(nil)
+var _ http.Handler = (*subscriptionReceiver)(nil)
+
+func newSubscriptionReceiver(cfg *Config, set receiver.CreateSettings, nextConsumer consumer.Logs) *subscriptionReceiver {
+	return &subscriptionReceiver{
+		settings:     set,
+		config:       cfg,
+		nextConsumer: nextConsumer,
+	}
+}
+
+// Start starts the Firehose HTTP server and the Kinesis shard readers.
+func (r *subscriptionReceiver) Start(_ context.Context, host component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	if r.config.Firehose != nil {
+		if err := r.startFirehose(host); err != nil {
+			return err
+		}
+	}
+	if r.config.Kinesis != nil {
+		if err := r.startKinesis(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *subscriptionReceiver) startFirehose(host component.Host) error {
+	var err error
+	r.server, err = r.config.Firehose.ToServer(host, r.settings.TelemetrySettings, r)
+	if err != nil {
+		return err
+	}
+	if err = httpdecompressionextension.WrapServer(host, r.config.Firehose.Decompression, r.server); err != nil {
+		return err
+	}
+	listener, err := r.config.Firehose.ToListener()
+	if err != nil {
+		return err
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if errHTTP := r.server.Serve(listener); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the HTTP server and the shard readers.
+func (r *subscriptionReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	var err error
+	if r.server != nil {
+		err = r.server.Close()
+	}
+	r.wg.Wait()
+	return err
+}
+
+func (r *subscriptionReceiver) consume(ctx context.Context, logs plog.Logs) error {
+	if logs.LogRecordCount() == 0 {
+		return nil
+	}
+	return r.nextConsumer.ConsumeLogs(ctx, logs)
+}
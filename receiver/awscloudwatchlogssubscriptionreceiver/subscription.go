@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchlogssubscriptionreceiver"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+const (
+	messageTypeData    = "DATA_MESSAGE"
+	messageTypeControl = "CONTROL_MESSAGE"
+
+	attributeRegion    = "aws.region"
+	attributeLogGroup  = "cloudwatch.log.group.name"
+	attributeLogStream = "cloudwatch.log.stream"
+	attributeEventID   = "id"
+)
+
+var errEmptyRecord = errors.New("empty record")
+
+// subscriptionMessage is the payload CloudWatch Logs delivers to the destination of
+// a subscription filter, once decompressed.
+type subscriptionMessage struct {
+	MessageType         string     `json:"messageType"`
+	Owner               string     `json:"owner"`
+	LogGroup            string     `json:"logGroup"`
+	LogStream           string     `json:"logStream"`
+	SubscriptionFilters []string   `json:"subscriptionFilters"`
+	LogEvents           []logEvent `json:"logEvents"`
+}
+
+type logEvent struct {
+	ID        string `json:"id"`
+	Timestamp int64  `json:"timestamp"`
+	Message   string `json:"message"`
+}
+
+// unmarshalRecord decompresses and decodes the record of a subscription. CloudWatch Logs
+// compresses the records with gzip, uncompressed records are also accepted for the
+// delivery streams decompressing them.
+func unmarshalRecord(record []byte) (*subscriptionMessage, error) {
+	if len(record) == 0 {
+		return nil, errEmptyRecord
+	}
+	if len(record) > 1 && record[0] == 0x1f && record[1] == 0x8b {
+		gz, err := gzip.NewReader(bytes.NewReader(record))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decompress record: %w", err)
+		}
+		defer gz.Close()
+		if record, err = io.ReadAll(gz); err != nil {
+			return nil, fmt.Errorf("unable to decompress record: %w", err)
+		}
+	}
+
+	var msg subscriptionMessage
+	if err := json.Unmarshal(record, &msg); err != nil {
+		return nil, fmt.Errorf("unable to decode record: %w", err)
+	}
+	switch msg.MessageType {
+	case messageTypeData, messageTypeControl:
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unsupported message type %q", msg.MessageType)
+	}
+}
+
+// appendLogs appends the log events of a data message to the logs, under a resource
+// identifying its log group and stream. Control messages, sent by CloudWatch Logs to
+// check that the destination is reachable, are ignored.
+func appendLogs(logs plog.Logs, msg *subscriptionMessage, region string, observedTime pcommon.Timestamp) {
+	if msg.MessageType != messageTypeData || len(msg.LogEvents) == 0 {
+		return
+	}
+
+	rl := logs.ResourceLogs().AppendEmpty()
+	resourceAttributes := rl.Resource().Attributes()
+	resourceAttributes.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAWS)
+	if msg.Owner != "" {
+		resourceAttributes.PutStr(conventions.AttributeCloudAccountID, msg.Owner)
+	}
+	if region != "" {
+		resourceAttributes.PutStr(attributeRegion, region)
+	}
+	resourceAttributes.PutStr(attributeLogGroup, msg.LogGroup)
+	resourceAttributes.PutStr(attributeLogStream, msg.LogStream)
+
+	logRecords := rl.ScopeLogs().AppendEmpty().LogRecords()
+	logRecords.EnsureCapacity(len(msg.LogEvents))
+	for _, e := range msg.LogEvents {
+		logRecord := logRecords.AppendEmpty()
+		logRecord.SetObservedTimestamp(observedTime)
+		logRecord.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(e.Timestamp)))
+		logRecord.Body().SetStr(e.Message)
+		logRecord.Attributes().PutStr(attributeEventID, e.ID)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogssubscriptionreceiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+var testMessage = subscriptionMessage{
+	MessageType:         messageTypeData,
+	Owner:               "123456789012",
+	LogGroup:            "/aws/lambda/function",
+	LogStream:           "2023/07/18/[$LATEST]abcdef",
+	SubscriptionFilters: []string{"all"},
+	LogEvents: []logEvent{
+		{ID: "1", Timestamp: 1689696000000, Message: "START RequestId: 1"},
+		{ID: "2", Timestamp: 1689696000500, Message: "END RequestId: 1"},
+	},
+}
+
+func compressedRecord(t *testing.T, msg subscriptionMessage) []byte {
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestUnmarshalRecord(t *testing.T) {
+	msg, err := unmarshalRecord(compressedRecord(t, testMessage))
+	require.NoError(t, err)
+	assert.Equal(t, testMessage, *msg)
+
+	// uncompressed records
+	data, err := json.Marshal(testMessage)
+	require.NoError(t, err)
+	msg, err = unmarshalRecord(data)
+	require.NoError(t, err)
+	assert.Equal(t, testMessage, *msg)
+
+	_, err = unmarshalRecord(nil)
+	assert.ErrorIs(t, err, errEmptyRecord)
+
+	_, err = unmarshalRecord([]byte{0x1f, 0x8b, 0x00})
+	assert.ErrorContains(t, err, "unable to decompress record")
+
+	_, err = unmarshalRecord([]byte("not json"))
+	assert.ErrorContains(t, err, "unable to decode record")
+
+	_, err = unmarshalRecord([]byte(`{"messageType":"OTHER"}`))
+	assert.EqualError(t, err, `unsupported message type "OTHER"`)
+}
+
+func TestAppendLogs(t *testing.T) {
+	observedTime := pcommon.NewTimestampFromTime(time.Now())
+	logs := plog.NewLogs()
+	appendLogs(logs, &subscriptionMessage{MessageType: messageTypeControl, LogEvents: testMessage.LogEvents}, "", observedTime)
+	assert.Equal(t, 0, logs.ResourceLogs().Len())
+
+	appendLogs(logs, &testMessage, "us-east-1", observedTime)
+	require.Equal(t, 1, logs.ResourceLogs().Len())
+	rl := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":   "aws",
+		"cloud.account.id": "123456789012",
+		attributeRegion:    "us-east-1",
+		attributeLogGroup:  "/aws/lambda/function",
+		attributeLogStream: "2023/07/18/[$LATEST]abcdef",
+	}, rl.Resource().Attributes().AsRaw())
+
+	records := rl.ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	for i, e := range testMessage.LogEvents {
+		lr := records.At(i)
+		assert.Equal(t, e.Message, lr.Body().Str())
+		assert.Equal(t, pcommon.NewTimestampFromTime(time.UnixMilli(e.Timestamp)), lr.Timestamp())
+		assert.Equal(t, observedTime, lr.ObservedTimestamp())
+		assert.Equal(t, map[string]interface{}{attributeEventID: e.ID}, lr.Attributes().AsRaw())
+	}
+}
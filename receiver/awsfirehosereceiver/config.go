@@ -6,6 +6,7 @@ package awsfirehosereceiver // import "github.com/open-telemetry/opentelemetry-c
 import (
 	"errors"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 )
 
@@ -21,6 +22,8 @@ type Config struct {
 	// This can be set when creating or updating the Firehose delivery
 	// stream.
 	AccessKey string `mapstructure:"access_key"`
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies.
+	Decompression *component.ID `mapstructure:"decompression"`
 }
 
 // Validate checks that the endpoint and record type exist and
@@ -18,6 +18,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 )
 
 const (
@@ -115,6 +117,9 @@ func (fmr *firehoseReceiver) Start(_ context.Context, host component.Host) error
 	if err != nil {
 		return err
 	}
+	if err = httpdecompressionextension.WrapServer(host, fmr.config.Decompression, fmr.server); err != nil {
+		return err
+	}
 
 	var listener net.Listener
 	listener, err = fmr.config.HTTPServerSettings.ToListener()
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// Encoding is the format of the content of the S3 objects.
+type Encoding string
+
+const (
+	// OtlpJSON objects contain OTLP JSON encoded traces, metrics or logs, as written by the awss3exporter.
+	OtlpJSON Encoding = "otlp_json"
+	// JSONLines objects contain a JSON encoded log per line.
+	JSONLines Encoding = "json_lines"
+	// ELB objects contain Elastic Load Balancing access logs.
+	ELB Encoding = "elb"
+
+	sqsKey = "sqs"
+)
+
+// S3DownloaderConfig contains the configuration of the bucket the objects are read from.
+type S3DownloaderConfig struct {
+	Region   string `mapstructure:"region"`
+	S3Bucket string `mapstructure:"s3_bucket"`
+	// S3Prefix restricts the objects read to the keys with the prefix.
+	S3Prefix string `mapstructure:"s3_prefix"`
+	// Endpoint is a custom endpoint of the S3 API, e.g. of an S3 compatible storage.
+	Endpoint string `mapstructure:"endpoint"`
+	// S3ForcePathStyle addresses the bucket in the path of the requests instead of the host name.
+	S3ForcePathStyle bool `mapstructure:"s3_force_path_style"`
+}
+
+// SQSConfig contains the configuration of the queue receiving the notifications
+// of the objects created in the bucket.
+type SQSConfig struct {
+	QueueURL string `mapstructure:"queue_url"`
+	// MaxNumberOfMessages is the maximum number of notifications received at once, up to 10.
+	MaxNumberOfMessages int64 `mapstructure:"max_number_of_messages"`
+}
+
+// Config contains the main configuration options of the S3 receiver.
+type Config struct {
+	S3Downloader S3DownloaderConfig `mapstructure:"s3downloader"`
+	Encoding     Encoding           `mapstructure:"encoding"`
+
+	// PollInterval is the time between two listings of the objects of the bucket.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// StartTime is the time after which the objects must have been modified to be read.
+	// The objects modified after the receiver starts are read when it is not set.
+	StartTime time.Time `mapstructure:"start_time"`
+
+	// SQS reads the objects notified to an SQS queue instead of listing the objects of the bucket.
+	SQS *SQSConfig `mapstructure:"sqs"`
+}
+
+var (
+	errNoRegion              = errors.New("s3downloader::region is required")
+	errNoBucket              = errors.New("s3downloader::s3_bucket is required when sqs is not set")
+	errInvalidPollInterval   = errors.New("poll_interval must be positive")
+	errNoQueueURL            = errors.New("sqs::queue_url is required")
+	errInvalidNumberMessages = errors.New("sqs::max_number_of_messages must be between 1 and 10")
+)
+
+var _ confmap.Unmarshaler = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid
+func (c *Config) Validate() error {
+	if c.S3Downloader.Region == "" {
+		return errNoRegion
+	}
+	switch c.Encoding {
+	case OtlpJSON, JSONLines, ELB:
+	default:
+		return fmt.Errorf("unknown encoding %q", c.Encoding)
+	}
+	if c.SQS != nil {
+		if c.SQS.QueueURL == "" {
+			return errNoQueueURL
+		}
+		if c.SQS.MaxNumberOfMessages < 1 || c.SQS.MaxNumberOfMessages > 10 {
+			return errInvalidNumberMessages
+		}
+		return nil
+	}
+	if c.S3Downloader.S3Bucket == "" {
+		return errNoBucket
+	}
+	if c.PollInterval <= 0 {
+		return errInvalidPollInterval
+	}
+	return nil
+}
+
+// Unmarshal a confmap.Conf into the config struct.
+func (c *Config) Unmarshal(conf *confmap.Conf) error {
+	err := conf.Unmarshal(c, confmap.WithErrorUnused())
+	if err != nil {
+		return err
+	}
+
+	if !conf.IsSet(sqsKey) {
+		c.SQS = nil
+	}
+
+	return nil
+}
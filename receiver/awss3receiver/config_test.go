@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr error
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				S3Downloader: S3DownloaderConfig{Region: defaultRegion},
+				Encoding:     OtlpJSON,
+				PollInterval: defaultPollInterval,
+			},
+			expectedErr: errNoBucket,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "polling"),
+			expected: &Config{
+				S3Downloader: S3DownloaderConfig{
+					Region:   "eu-west-1",
+					S3Bucket: "archive",
+					S3Prefix: "traces/",
+				},
+				Encoding:     OtlpJSON,
+				PollInterval: 5 * time.Minute,
+				StartTime:    time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sqs"),
+			expected: &Config{
+				S3Downloader: S3DownloaderConfig{
+					Region:           defaultRegion,
+					S3Bucket:         "access-logs",
+					Endpoint:         "http://localhost:4566",
+					S3ForcePathStyle: true,
+				},
+				Encoding:     ELB,
+				PollInterval: defaultPollInterval,
+				SQS: &SQSConfig{
+					QueueURL:            "https://sqs.us-east-1.amazonaws.com/123456789012/access-logs",
+					MaxNumberOfMessages: defaultMaxNumberOfMessages,
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_encoding"),
+			expectedErr: errors.New(`unknown encoding "csv"`),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "no_bucket"),
+			expectedErr: errNoBucket,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_messages"),
+			expectedErr: errInvalidNumberMessages,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, component.ValidateConfig(cfg))
+			}
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, cfg)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+		err  error
+	}{
+		{
+			name: "no region",
+			cfg:  &Config{S3Downloader: S3DownloaderConfig{S3Bucket: "archive"}, Encoding: OtlpJSON, PollInterval: time.Minute},
+			err:  errNoRegion,
+		},
+		{
+			name: "invalid poll interval",
+			cfg:  &Config{S3Downloader: S3DownloaderConfig{Region: "us-east-1", S3Bucket: "archive"}, Encoding: JSONLines},
+			err:  errInvalidPollInterval,
+		},
+		{
+			name: "no queue url",
+			cfg:  &Config{S3Downloader: S3DownloaderConfig{Region: "us-east-1"}, Encoding: OtlpJSON, SQS: &SQSConfig{MaxNumberOfMessages: 1}},
+			err:  errNoQueueURL,
+		},
+		{
+			name: "sqs without bucket",
+			cfg:  &Config{S3Downloader: S3DownloaderConfig{Region: "us-east-1"}, Encoding: OtlpJSON, SQS: &SQSConfig{QueueURL: "queue", MaxNumberOfMessages: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
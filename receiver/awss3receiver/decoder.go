@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+const (
+	attributeBucket = "aws.s3.bucket"
+	attributeKey    = "aws.s3.key"
+
+	// maxLineSize is the maximum size of a line of the json_lines and elb objects.
+	maxLineSize = 1024 * 1024
+)
+
+// s3Object identifies an object of a bucket.
+type s3Object struct {
+	bucket string
+	key    string
+}
+
+// decompress returns the content of gzip compressed objects, and the other objects as is.
+func decompress(content []byte) ([]byte, error) {
+	if len(content) < 2 || content[0] != 0x1f || content[1] != 0x8b {
+		return content, nil
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	return io.ReadAll(gz)
+}
+
+// decodeLines decodes the logs of the json_lines and elb objects, one log record per line.
+func decodeLines(obj s3Object, content []byte, encoding Encoding) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	attrs := rl.Resource().Attributes()
+	attrs.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAWS)
+	attrs.PutStr(attributeBucket, obj.bucket)
+	attrs.PutStr(attributeKey, obj.key)
+	logRecords := rl.ScopeLogs().AppendEmpty().LogRecords()
+
+	observedTime := pcommon.NewTimestampFromTime(time.Now())
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		lr := logRecords.AppendEmpty()
+		lr.SetObservedTimestamp(observedTime)
+		var err error
+		switch encoding {
+		case JSONLines:
+			err = decodeJSONLine(line, lr)
+		case ELB:
+			err = decodeELBLine(line, lr)
+		case OtlpJSON:
+			err = fmt.Errorf("encoding %q is not line based", encoding)
+		}
+		if err != nil {
+			return plog.Logs{}, fmt.Errorf("invalid line %d: %w", lineNumber, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return plog.Logs{}, err
+	}
+	return logs, nil
+}
+
+func decodeJSONLine(line string, lr plog.LogRecord) error {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &body); err != nil {
+		return err
+	}
+	return lr.Body().SetEmptyMap().FromRaw(body)
+}
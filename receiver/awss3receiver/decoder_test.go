@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecompress(t *testing.T) {
+	content := []byte(`{"message":"hello"}`)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	decompressed, err := decompress(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, content, decompressed)
+
+	decompressed, err = decompress(content)
+	require.NoError(t, err)
+	assert.Equal(t, content, decompressed)
+}
+
+func TestDecodeJSONLines(t *testing.T) {
+	obj := s3Object{bucket: "archive", key: "logs/app.json"}
+	content := []byte("{\"level\":\"info\",\"message\":\"started\"}\n\n{\"level\":\"error\",\"count\":2}\n")
+
+	logs, err := decodeLines(obj, content, JSONLines)
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	rl := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider": "aws",
+		"aws.s3.bucket":  "archive",
+		"aws.s3.key":     "logs/app.json",
+	}, rl.Resource().Attributes().AsRaw())
+	records := rl.ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]interface{}{"level": "info", "message": "started"}, records.At(0).Body().Map().AsRaw())
+	assert.Equal(t, map[string]interface{}{"level": "error", "count": float64(2)}, records.At(1).Body().Map().AsRaw())
+	assert.NotZero(t, records.At(0).ObservedTimestamp())
+}
+
+func TestDecodeInvalidLine(t *testing.T) {
+	obj := s3Object{bucket: "archive", key: "logs/app.json"}
+	_, err := decodeLines(obj, []byte("{\"message\":\"started\"}\nnot json\n"), JSONLines)
+	assert.ErrorContains(t, err, "invalid line 2")
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const elbAttributePrefix = "aws.elb."
+
+// The fields of the access logs of the Application Load Balancers, and of the Classic Load Balancers.
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/application/load-balancer-access-logs.html
+// and https://docs.aws.amazon.com/elasticloadbalancing/latest/classic/access-log-collection.html
+var (
+	albFields = []string{
+		"type", "time", "elb", "client", "target", "request_processing_time", "target_processing_time",
+		"response_processing_time", "elb_status_code", "target_status_code", "received_bytes", "sent_bytes",
+		"request", "user_agent", "ssl_cipher", "ssl_protocol", "target_group_arn", "trace_id", "domain_name",
+		"chosen_cert_arn", "matched_rule_priority", "request_creation_time", "actions_executed", "redirect_url",
+		"error_reason", "target_list", "target_status_code_list", "classification", "classification_reason",
+	}
+	classicFields = []string{
+		"time", "elb", "client", "backend", "request_processing_time", "backend_processing_time",
+		"response_processing_time", "elb_status_code", "backend_status_code", "received_bytes", "sent_bytes",
+		"request", "user_agent", "ssl_cipher", "ssl_protocol",
+	}
+)
+
+var errUnterminatedQuote = errors.New("unterminated quoted field")
+
+// decodeELBLine parses an access log line into the attributes of the log record, using
+// the field names of the AWS documentation. The timestamp of the log record is the time
+// field, and its body is the line.
+func decodeELBLine(line string, lr plog.LogRecord) error {
+	values, err := splitELBLine(line)
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return errors.New("empty access log")
+	}
+
+	fields := albFields
+	if _, err = time.Parse(time.RFC3339Nano, values[0]); err == nil {
+		fields = classicFields
+	}
+	if len(values) < len(classicFields) {
+		return fmt.Errorf("expected at least %d fields in access log, got %d", len(classicFields), len(values))
+	}
+
+	lr.Body().SetStr(line)
+	attrs := lr.Attributes()
+	for i, value := range values {
+		// fields added by AWS after these ones are ignored
+		if i >= len(fields) {
+			break
+		}
+		switch {
+		case fields[i] == "time":
+			ts, parseErr := time.Parse(time.RFC3339Nano, value)
+			if parseErr != nil {
+				return fmt.Errorf("invalid time %q: %w", value, parseErr)
+			}
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		case value != "-" && value != "":
+			attrs.PutStr(elbAttributePrefix+fields[i], value)
+		}
+	}
+	return nil
+}
+
+// splitELBLine splits an access log line on spaces, except within double quotes.
+func splitELBLine(line string) ([]string, error) {
+	var values []string
+	for line = strings.TrimLeft(line, " "); line != ""; line = strings.TrimLeft(line, " ") {
+		if line[0] == '"' {
+			end := strings.IndexByte(line[1:], '"')
+			if end < 0 {
+				return nil, errUnterminatedQuote
+			}
+			values = append(values, line[1:end+1])
+			line = line[end+2:]
+			continue
+		}
+		end := strings.IndexByte(line, ' ')
+		if end < 0 {
+			end = len(line)
+		}
+		values = append(values, line[:end])
+		line = line[end:]
+	}
+	return values, nil
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestDecodeELBLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		timestamp time.Time
+		attrs     map[string]interface{}
+	}{
+		{
+			name:      "application load balancer",
+			line:      `http 2018-07-02T22:23:00.186641Z app/my-loadbalancer/50dc6c495c0c9188 192.168.131.39:2817 10.0.0.1:80 0.000 0.001 0.000 200 200 34 366 "GET http://www.example.com:80/ HTTP/1.1" "curl/7.46.0" - - arn:aws:elasticloadbalancing:us-east-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067 "Root=1-58337262-36d228ad5d99923122bbe354" "-" "-" 0 2018-07-02T22:22:48.364000Z "forward" "-" "-" "10.0.0.1:80" "200" "-" "-"`,
+			timestamp: time.Date(2018, 7, 2, 22, 23, 0, 186641000, time.UTC),
+			attrs: map[string]interface{}{
+				"aws.elb.type":                     "http",
+				"aws.elb.elb":                      "app/my-loadbalancer/50dc6c495c0c9188",
+				"aws.elb.client":                   "192.168.131.39:2817",
+				"aws.elb.target":                   "10.0.0.1:80",
+				"aws.elb.request_processing_time":  "0.000",
+				"aws.elb.target_processing_time":   "0.001",
+				"aws.elb.response_processing_time": "0.000",
+				"aws.elb.elb_status_code":          "200",
+				"aws.elb.target_status_code":       "200",
+				"aws.elb.received_bytes":           "34",
+				"aws.elb.sent_bytes":               "366",
+				"aws.elb.request":                  "GET http://www.example.com:80/ HTTP/1.1",
+				"aws.elb.user_agent":               "curl/7.46.0",
+				"aws.elb.target_group_arn":         "arn:aws:elasticloadbalancing:us-east-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067",
+				"aws.elb.trace_id":                 "Root=1-58337262-36d228ad5d99923122bbe354",
+				"aws.elb.matched_rule_priority":    "0",
+				"aws.elb.request_creation_time":    "2018-07-02T22:22:48.364000Z",
+				"aws.elb.actions_executed":         "forward",
+				"aws.elb.target_list":              "10.0.0.1:80",
+				"aws.elb.target_status_code_list":  "200",
+			},
+		},
+		{
+			name:      "classic load balancer",
+			line:      `2015-05-13T23:39:43.945958Z my-loadbalancer 192.168.131.39:2817 10.0.0.1:80 0.000073 0.001048 0.000057 200 200 0 29 "GET http://www.example.com:80/ HTTP/1.1" "curl/7.38.0" - -`,
+			timestamp: time.Date(2015, 5, 13, 23, 39, 43, 945958000, time.UTC),
+			attrs: map[string]interface{}{
+				"aws.elb.elb":                      "my-loadbalancer",
+				"aws.elb.client":                   "192.168.131.39:2817",
+				"aws.elb.backend":                  "10.0.0.1:80",
+				"aws.elb.request_processing_time":  "0.000073",
+				"aws.elb.backend_processing_time":  "0.001048",
+				"aws.elb.response_processing_time": "0.000057",
+				"aws.elb.elb_status_code":          "200",
+				"aws.elb.backend_status_code":      "200",
+				"aws.elb.received_bytes":           "0",
+				"aws.elb.sent_bytes":               "29",
+				"aws.elb.request":                  "GET http://www.example.com:80/ HTTP/1.1",
+				"aws.elb.user_agent":               "curl/7.38.0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			require.NoError(t, decodeELBLine(tt.line, lr))
+			assert.Equal(t, tt.line, lr.Body().Str())
+			assert.Equal(t, tt.timestamp, lr.Timestamp().AsTime())
+			assert.Equal(t, tt.attrs, lr.Attributes().AsRaw())
+		})
+	}
+}
+
+func TestDecodeInvalidELBLine(t *testing.T) {
+	lr := plog.NewLogRecord()
+	assert.ErrorIs(t, decodeELBLine(`http 2018-07-02T22:23:00.186641Z "GET http://www.example.com:80/`, lr), errUnterminatedQuote)
+	assert.EqualError(t, decodeELBLine("http 2018-07-02T22:23:00.186641Z app/my-loadbalancer", lr), "expected at least 15 fields in access log, got 3")
+	assert.ErrorContains(t, decodeELBLine(`http yesterday app/my-loadbalancer 192.168.131.39:2817 10.0.0.1:80 0.000 0.001 0.000 200 200 34 366 "GET / HTTP/1.1" "curl/7.46.0" - -`, lr), `invalid time "yesterday"`)
+}
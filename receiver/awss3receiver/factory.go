@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver/internal/metadata"
+)
+
+const (
+	defaultRegion       = "us-east-1"
+	defaultPollInterval = time.Minute
+
+	defaultMaxNumberOfMessages = 10
+)
+
+// NewFactory creates a factory for the S3 receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithTraces(createTracesReceiver, metadata.TracesStability),
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		S3Downloader: S3DownloaderConfig{
+			Region: defaultRegion,
+		},
+		Encoding:     OtlpJSON,
+		PollInterval: defaultPollInterval,
+		SQS: &SQSConfig{
+			MaxNumberOfMessages: defaultMaxNumberOfMessages,
+		},
+	}
+}
+
+func createTracesReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (receiver.Traces, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	rCfg := cfg.(*Config)
+	if rCfg.Encoding != OtlpJSON {
+		return nil, fmt.Errorf("encoding %q only supports logs", rCfg.Encoding)
+	}
+	r := getOrCreateReceiver(set, rCfg)
+	r.Unwrap().(*s3Receiver).nextTraces = nextConsumer
+	return r, nil
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	rCfg := cfg.(*Config)
+	if rCfg.Encoding != OtlpJSON {
+		return nil, fmt.Errorf("encoding %q only supports logs", rCfg.Encoding)
+	}
+	r := getOrCreateReceiver(set, rCfg)
+	r.Unwrap().(*s3Receiver).nextMetrics = nextConsumer
+	return r, nil
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := getOrCreateReceiver(set, cfg.(*Config))
+	r.Unwrap().(*s3Receiver).nextLogs = nextConsumer
+	return r, nil
+}
+
+func getOrCreateReceiver(set receiver.CreateSettings, cfg *Config) *sharedcomponent.SharedComponent {
+	return receivers.GetOrAdd(cfg, func() component.Component {
+		return newS3Receiver(set, cfg)
+	})
+}
+
+// This is the map of already created S3 receivers for particular configurations.
+// The receivers created for the same configuration in pipelines of different signals
+// share the same receiver, so that each object is downloaded once.
+var receivers = sharedcomponent.NewSharedComponents()
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestValidConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "archive"
+	cfg.SQS = nil
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestCreateReceivers(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "archive"
+	cfg.SQS = nil
+
+	tr, err := createTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	mr, err := createMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	lr, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	// the receivers of the same configuration share the downloads
+	assert.Same(t, tr, mr)
+	assert.Same(t, tr, lr)
+	r := lr.(interface{ Unwrap() component.Component }).Unwrap().(*s3Receiver)
+	assert.NotNil(t, r.nextTraces)
+	assert.NotNil(t, r.nextMetrics)
+	assert.NotNil(t, r.nextLogs)
+
+	_, err = createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, nil)
+	assert.ErrorIs(t, err, component.ErrNilNextConsumer)
+}
+
+func TestCreateReceiversLineEncoding(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "access-logs"
+	cfg.Encoding = ELB
+	cfg.SQS = nil
+
+	_, err := createTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.EqualError(t, err, `encoding "elb" only supports logs`)
+	_, err = createMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.EqualError(t, err, `encoding "elb" only supports logs`)
+	lr, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.NotNil(t, lr)
+}
@@ -0,0 +1,243 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+type s3Client interface {
+	ListObjectsV2PagesWithContext(ctx aws.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, opts ...request.Option) error
+	GetObjectWithContext(ctx aws.Context, input *s3.GetObjectInput, opts ...request.Option) (*s3.GetObjectOutput, error)
+}
+
+type sqsClient interface {
+	ReceiveMessageWithContext(ctx aws.Context, input *sqs.ReceiveMessageInput, opts ...request.Option) (*sqs.ReceiveMessageOutput, error)
+	DeleteMessageWithContext(ctx aws.Context, input *sqs.DeleteMessageInput, opts ...request.Option) (*sqs.DeleteMessageOutput, error)
+}
+
+// s3Receiver reads the objects of a bucket, either by listing them periodically
+// or when they are notified to an SQS queue, and sends their content to the consumers.
+type s3Receiver struct {
+	settings receiver.CreateSettings
+	config   *Config
+
+	nextTraces  consumer.Traces
+	nextMetrics consumer.Metrics
+	nextLogs    consumer.Logs
+
+	s3Client  s3Client
+	sqsClient sqsClient
+
+	// lastModified is the modification time of the last object read while polling,
+	// and read are the keys of the objects read which were modified at that time.
+	lastModified time.Time
+	read         map[string]struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newS3Receiver(set receiver.CreateSettings, cfg *Config) *s3Receiver {
+	return &s3Receiver{
+		settings: set,
+		config:   cfg,
+		read:     map[string]struct{}{},
+	}
+}
+
+func (r *s3Receiver) Start(_ context.Context, _ component.Host) error {
+	if r.s3Client == nil {
+		awsConfig := aws.NewConfig().
+			WithRegion(r.config.S3Downloader.Region).
+			WithS3ForcePathStyle(r.config.S3Downloader.S3ForcePathStyle)
+		if r.config.S3Downloader.Endpoint != "" {
+			awsConfig = awsConfig.WithEndpoint(r.config.S3Downloader.Endpoint)
+		}
+		sess, err := session.NewSession(awsConfig)
+		if err != nil {
+			return fmt.Errorf("unable to create AWS session: %w", err)
+		}
+		r.s3Client = s3.New(sess)
+		if r.config.SQS != nil {
+			r.sqsClient = sqs.New(sess)
+		}
+	}
+
+	r.lastModified = r.config.StartTime
+	if r.lastModified.IsZero() {
+		r.lastModified = time.Now()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if r.config.SQS != nil {
+			r.receiveNotifications(ctx)
+		} else {
+			r.startPolling(ctx)
+		}
+	}()
+	return nil
+}
+
+func (r *s3Receiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *s3Receiver) startPolling(ctx context.Context) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := r.poll(ctx); err != nil {
+			r.settings.Logger.Error("Unable to read the objects of the bucket", zap.String("bucket", r.config.S3Downloader.S3Bucket), zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll reads the objects modified since the last object read, in the order of their
+// modification time. The objects which cannot be consumed are read again on the next poll.
+func (r *s3Receiver) poll(ctx context.Context) error {
+	var objects []*s3.Object
+	input := &s3.ListObjectsV2Input{Bucket: aws.String(r.config.S3Downloader.S3Bucket)}
+	if r.config.S3Downloader.S3Prefix != "" {
+		input.Prefix = aws.String(r.config.S3Downloader.S3Prefix)
+	}
+	err := r.s3Client.ListObjectsV2PagesWithContext(ctx, input, func(output *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range output.Contents {
+			modified := aws.TimeValue(obj.LastModified)
+			if _, ok := r.read[aws.StringValue(obj.Key)]; modified.Before(r.lastModified) || (modified.Equal(r.lastModified) && ok) {
+				continue
+			}
+			objects = append(objects, obj)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		ti, tj := aws.TimeValue(objects[i].LastModified), aws.TimeValue(objects[j].LastModified)
+		if ti.Equal(tj) {
+			return aws.StringValue(objects[i].Key) < aws.StringValue(objects[j].Key)
+		}
+		return ti.Before(tj)
+	})
+	for _, obj := range objects {
+		key := aws.StringValue(obj.Key)
+		if err = r.readObject(ctx, s3Object{bucket: r.config.S3Downloader.S3Bucket, key: key}); err != nil && !consumererror.IsPermanent(err) {
+			return err
+		}
+		if modified := aws.TimeValue(obj.LastModified); modified.After(r.lastModified) {
+			r.lastModified = modified
+			r.read = map[string]struct{}{}
+		}
+		r.read[key] = struct{}{}
+	}
+	return nil
+}
+
+// readObject downloads the object and sends its content to the consumers. Objects
+// whose content cannot be decoded are logged and reported as permanent errors.
+func (r *s3Receiver) readObject(ctx context.Context, obj s3Object) error {
+	output, err := r.s3Client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(obj.bucket),
+		Key:    aws.String(obj.key),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get object %s: %w", obj.key, err)
+	}
+	defer output.Body.Close()
+	content, err := io.ReadAll(output.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read object %s: %w", obj.key, err)
+	}
+
+	err = r.consume(ctx, obj, content)
+	if consumererror.IsPermanent(err) {
+		r.settings.Logger.Error("Unable to decode object", zap.String("bucket", obj.bucket), zap.String("key", obj.key), zap.Error(err))
+	}
+	return err
+}
+
+func (r *s3Receiver) consume(ctx context.Context, obj s3Object, content []byte) error {
+	content, err := decompress(content)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	if r.config.Encoding != OtlpJSON {
+		logs, decodeErr := decodeLines(obj, content, r.config.Encoding)
+		if decodeErr != nil {
+			return consumererror.NewPermanent(decodeErr)
+		}
+		if logs.LogRecordCount() == 0 || r.nextLogs == nil {
+			return nil
+		}
+		return r.nextLogs.ConsumeLogs(ctx, logs)
+	}
+
+	// The OTLP JSON objects contain a single signal, the other signals are empty once decoded
+	var errs error
+	if r.nextTraces != nil {
+		traces, decodeErr := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(content)
+		if decodeErr != nil {
+			return consumererror.NewPermanent(decodeErr)
+		}
+		if traces.SpanCount() > 0 {
+			errs = multierr.Append(errs, r.nextTraces.ConsumeTraces(ctx, traces))
+		}
+	}
+	if r.nextMetrics != nil {
+		metrics, decodeErr := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(content)
+		if decodeErr != nil {
+			return consumererror.NewPermanent(decodeErr)
+		}
+		if metrics.DataPointCount() > 0 {
+			errs = multierr.Append(errs, r.nextMetrics.ConsumeMetrics(ctx, metrics))
+		}
+	}
+	if r.nextLogs != nil {
+		logs, decodeErr := (&plog.JSONUnmarshaler{}).UnmarshalLogs(content)
+		if decodeErr != nil {
+			return consumererror.NewPermanent(decodeErr)
+		}
+		if logs.LogRecordCount() > 0 {
+			errs = multierr.Append(errs, r.nextLogs.ConsumeLogs(ctx, logs))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+type mockObject struct {
+	content      []byte
+	lastModified time.Time
+}
+
+type mockS3Client struct {
+	mu      sync.Mutex
+	objects map[string]mockObject
+	gets    []string
+}
+
+func (m *mockS3Client) put(key string, content []byte, lastModified time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = mockObject{content: content, lastModified: lastModified}
+}
+
+func (m *mockS3Client) ListObjectsV2PagesWithContext(_ aws.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	output := &s3.ListObjectsV2Output{}
+	for key, obj := range m.objects {
+		if input.Prefix != nil && !bytes.HasPrefix([]byte(key), []byte(*input.Prefix)) {
+			continue
+		}
+		output.Contents = append(output.Contents, &s3.Object{Key: aws.String(key), LastModified: aws.Time(obj.lastModified)})
+	}
+	fn(output, true)
+	return nil
+}
+
+func (m *mockS3Client) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	obj, ok := m.objects[aws.StringValue(input.Key)]
+	if !ok {
+		return nil, errors.New("NoSuchKey")
+	}
+	m.gets = append(m.gets, aws.StringValue(input.Key))
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(obj.content))}, nil
+}
+
+func tracesContent(t *testing.T, spanName string) []byte {
+	traces := ptrace.NewTraces()
+	traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(spanName)
+	content, err := (&ptrace.JSONMarshaler{}).MarshalTraces(traces)
+	require.NoError(t, err)
+	return content
+}
+
+func TestPoll(t *testing.T) {
+	start := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	client := &mockS3Client{objects: map[string]mockObject{}}
+	client.put("traces/old.json", tracesContent(t, "old"), start.Add(-time.Hour))
+	client.put("traces/b.json", tracesContent(t, "b"), start.Add(time.Minute))
+	client.put("traces/a.json", tracesContent(t, "a"), start.Add(time.Minute))
+	client.put("traces/first.json", tracesContent(t, "first"), start)
+	client.put("traces/invalid.json", []byte("not json"), start.Add(time.Second))
+	client.put("metrics/other.json", tracesContent(t, "other"), start.Add(time.Minute))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "archive"
+	cfg.S3Downloader.S3Prefix = "traces/"
+	cfg.StartTime = start
+	cfg.SQS = nil
+	sink := &consumertest.TracesSink{}
+	r := newS3Receiver(receivertest.NewNopCreateSettings(), cfg)
+	r.s3Client = client
+	r.nextTraces = sink
+	r.lastModified = start
+
+	require.NoError(t, r.poll(context.Background()))
+	assert.Equal(t, []string{"traces/first.json", "traces/invalid.json", "traces/a.json", "traces/b.json"}, client.gets)
+	require.Len(t, sink.AllTraces(), 3)
+	assert.Equal(t, "first", sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+
+	// only the new objects are read on the next poll, including the ones modified at the same time
+	client.put("traces/c.json", tracesContent(t, "c"), start.Add(time.Minute))
+	client.put("traces/d.json", tracesContent(t, "d"), start.Add(2*time.Minute))
+	require.NoError(t, r.poll(context.Background()))
+	assert.Equal(t, []string{"traces/c.json", "traces/d.json"}, client.gets[4:])
+	assert.Len(t, sink.AllTraces(), 5)
+}
+
+func TestPollConsumerError(t *testing.T) {
+	start := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	client := &mockS3Client{objects: map[string]mockObject{}}
+	client.put("a.json", tracesContent(t, "a"), start.Add(time.Minute))
+	client.put("b.json", tracesContent(t, "b"), start.Add(2*time.Minute))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "archive"
+	cfg.SQS = nil
+	r := newS3Receiver(receivertest.NewNopCreateSettings(), cfg)
+	r.s3Client = client
+	r.nextTraces = consumertest.NewErr(errors.New("unavailable"))
+	r.lastModified = start
+
+	assert.EqualError(t, r.poll(context.Background()), "unavailable")
+	assert.Equal(t, []string{"a.json"}, client.gets)
+
+	// the object is read again once the consumer is available
+	sink := &consumertest.TracesSink{}
+	r.nextTraces = sink
+	require.NoError(t, r.poll(context.Background()))
+	assert.Equal(t, []string{"a.json", "a.json", "b.json"}, client.gets)
+	assert.Len(t, sink.AllTraces(), 2)
+}
+
+func TestStartShutdown(t *testing.T) {
+	client := &mockS3Client{objects: map[string]mockObject{}}
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "archive"
+	cfg.SQS = nil
+	r := newS3Receiver(receivertest.NewNopCreateSettings(), cfg)
+	r.s3Client = client
+	r.nextTraces = consumertest.NewNop()
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.False(t, r.lastModified.IsZero())
+	require.NoError(t, r.Shutdown(context.Background()))
+}
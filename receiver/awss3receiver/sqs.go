@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+)
+
+const (
+	// waitTimeSeconds is the maximum duration of the long polling of the queue.
+	waitTimeSeconds = 20
+
+	objectCreatedPrefix = "ObjectCreated:"
+	testEvent           = "s3:TestEvent"
+)
+
+// notification is an S3 event notification, see
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+type notification struct {
+	Event   string `json:"Event"`
+	Records []struct {
+		EventName string `json:"eventName"`
+		S3        struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key string `json:"key"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+// snsMessage is the envelope of the notifications sent to the queue through an SNS topic.
+type snsMessage struct {
+	Type    string `json:"Type"`
+	Message string `json:"Message"`
+}
+
+func (r *s3Receiver) receiveNotifications(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		output, err := r.sqsClient.ReceiveMessageWithContext(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            aws.String(r.config.SQS.QueueURL),
+			MaxNumberOfMessages: aws.Int64(r.config.SQS.MaxNumberOfMessages),
+			WaitTimeSeconds:     aws.Int64(waitTimeSeconds),
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			r.settings.Logger.Error("Unable to receive messages from the queue", zap.String("queue_url", r.config.SQS.QueueURL), zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.config.PollInterval):
+			}
+			continue
+		}
+
+		for _, message := range output.Messages {
+			if err = r.handleMessage(ctx, message); err != nil {
+				// The message is received again once its visibility timeout expires
+				r.settings.Logger.Error("Unable to process notification", zap.String("message_id", aws.StringValue(message.MessageId)), zap.Error(err))
+				continue
+			}
+			if _, err = r.sqsClient.DeleteMessageWithContext(ctx, &sqs.DeleteMessageInput{
+				QueueUrl:      aws.String(r.config.SQS.QueueURL),
+				ReceiptHandle: message.ReceiptHandle,
+			}); err != nil {
+				r.settings.Logger.Error("Unable to delete message from the queue", zap.String("message_id", aws.StringValue(message.MessageId)), zap.Error(err))
+			}
+		}
+	}
+}
+
+// handleMessage reads the objects created according to the message. It returns an
+// error if any of them has to be read again.
+func (r *s3Receiver) handleMessage(ctx context.Context, message *sqs.Message) error {
+	objects, err := parseNotification(aws.StringValue(message.Body))
+	if err != nil {
+		// The message will never be processed, so it is not received again
+		r.settings.Logger.Warn("Ignoring invalid notification", zap.String("message_id", aws.StringValue(message.MessageId)), zap.Error(err))
+		return nil
+	}
+	for _, obj := range objects {
+		if !r.matches(obj) {
+			continue
+		}
+		if err = r.readObject(ctx, obj); err != nil && !consumererror.IsPermanent(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// matches returns whether the object is in the bucket and under the prefix of the configuration.
+func (r *s3Receiver) matches(obj s3Object) bool {
+	if bucket := r.config.S3Downloader.S3Bucket; bucket != "" && obj.bucket != bucket {
+		return false
+	}
+	return strings.HasPrefix(obj.key, r.config.S3Downloader.S3Prefix)
+}
+
+// parseNotification returns the objects created according to an S3 event
+// notification, which can be wrapped in an SNS message.
+func parseNotification(body string) ([]s3Object, error) {
+	var envelope snsMessage
+	if err := json.Unmarshal([]byte(body), &envelope); err == nil && envelope.Type == "Notification" {
+		body = envelope.Message
+	}
+
+	var n notification
+	if err := json.Unmarshal([]byte(body), &n); err != nil {
+		return nil, fmt.Errorf("invalid S3 event notification: %w", err)
+	}
+	if n.Event == testEvent {
+		return nil, nil
+	}
+
+	var objects []s3Object
+	for _, record := range n.Records {
+		if !strings.HasPrefix(record.EventName, objectCreatedPrefix) {
+			continue
+		}
+		// The keys are URL encoded, with spaces replaced by plus signs
+		key, err := url.QueryUnescape(record.S3.Object.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid object key %q: %w", record.S3.Object.Key, err)
+		}
+		objects = append(objects, s3Object{bucket: record.S3.Bucket.Name, key: key})
+	}
+	return objects, nil
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+const objectCreatedNotification = `{"Records":[
+	{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"archive"},"object":{"key":"traces/2023/07/batch+1.json"}}},
+	{"eventName":"ObjectRemoved:Delete","s3":{"bucket":{"name":"archive"},"object":{"key":"traces/removed.json"}}},
+	{"eventName":"ObjectCreated:CompleteMultipartUpload","s3":{"bucket":{"name":"archive"},"object":{"key":"traces/caf%C3%A9.json"}}}
+]}`
+
+func TestParseNotification(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []s3Object
+		err      string
+	}{
+		{
+			name: "object created",
+			body: objectCreatedNotification,
+			expected: []s3Object{
+				{bucket: "archive", key: "traces/2023/07/batch 1.json"},
+				{bucket: "archive", key: "traces/café.json"},
+			},
+		},
+		{
+			name:     "sns",
+			body:     `{"Type":"Notification","Message":"{\"Records\":[{\"eventName\":\"ObjectCreated:Put\",\"s3\":{\"bucket\":{\"name\":\"archive\"},\"object\":{\"key\":\"a.json\"}}}]}"}`,
+			expected: []s3Object{{bucket: "archive", key: "a.json"}},
+		},
+		{
+			name: "test event",
+			body: `{"Service":"Amazon S3","Event":"s3:TestEvent","Bucket":"archive"}`,
+		},
+		{
+			name: "invalid",
+			body: "not json",
+			err:  "invalid S3 event notification",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects, err := parseNotification(tt.body)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, objects)
+		})
+	}
+}
+
+type mockSQSClient struct {
+	messages chan *sqs.Message
+	deleted  chan string
+}
+
+func (m *mockSQSClient) ReceiveMessageWithContext(ctx aws.Context, _ *sqs.ReceiveMessageInput, _ ...request.Option) (*sqs.ReceiveMessageOutput, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case message := <-m.messages:
+		return &sqs.ReceiveMessageOutput{Messages: []*sqs.Message{message}}, nil
+	}
+}
+
+func (m *mockSQSClient) DeleteMessageWithContext(_ aws.Context, input *sqs.DeleteMessageInput, _ ...request.Option) (*sqs.DeleteMessageOutput, error) {
+	m.deleted <- aws.StringValue(input.ReceiptHandle)
+	return &sqs.DeleteMessageOutput{}, nil
+}
+
+func TestReceiveNotifications(t *testing.T) {
+	s3Client := &mockS3Client{objects: map[string]mockObject{}}
+	s3Client.put("traces/2023/07/batch 1.json", tracesContent(t, "batch"), time.Now())
+	s3Client.put("traces/café.json", tracesContent(t, "café"), time.Now())
+	sqsClient := &mockSQSClient{messages: make(chan *sqs.Message, 2), deleted: make(chan string, 2)}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "archive"
+	cfg.SQS.QueueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/archive"
+	sink := &consumertest.TracesSink{}
+	r := newS3Receiver(receivertest.NewNopCreateSettings(), cfg)
+	r.s3Client = s3Client
+	r.sqsClient = sqsClient
+	r.nextTraces = sink
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.receiveNotifications(ctx)
+		close(done)
+	}()
+
+	sqsClient.messages <- &sqs.Message{MessageId: aws.String("1"), ReceiptHandle: aws.String("handle-1"), Body: aws.String(objectCreatedNotification)}
+	assert.Equal(t, "handle-1", <-sqsClient.deleted)
+	assert.Len(t, sink.AllTraces(), 2)
+
+	// the message of an object which cannot be consumed is not deleted
+	r.nextTraces = consumertest.NewErr(errors.New("unavailable"))
+	sqsClient.messages <- &sqs.Message{MessageId: aws.String("2"), ReceiptHandle: aws.String("handle-2"), Body: aws.String(objectCreatedNotification)}
+	sqsClient.messages <- &sqs.Message{MessageId: aws.String("3"), ReceiptHandle: aws.String("handle-3"), Body: aws.String(`{"Event":"s3:TestEvent"}`)}
+	assert.Equal(t, "handle-3", <-sqsClient.deleted)
+
+	cancel()
+	<-done
+}
+
+func TestMatches(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "archive"
+	cfg.S3Downloader.S3Prefix = "traces/"
+	r := newS3Receiver(receivertest.NewNopCreateSettings(), cfg)
+
+	assert.True(t, r.matches(s3Object{bucket: "archive", key: "traces/a.json"}))
+	assert.False(t, r.matches(s3Object{bucket: "archive", key: "logs/a.json"}))
+	assert.False(t, r.matches(s3Object{bucket: "other", key: "traces/a.json"}))
+}
@@ -84,29 +84,52 @@ func newAzureResourceLogsUnmarshaler(buildInfo component.BuildInfo, logger *zap.
 func (r azureResourceLogsUnmarshaler) UnmarshalLogs(event *eventhub.Event) (plog.Logs, error) {
 
 	l := plog.NewLogs()
+	err := r.appendLogs(event.Data, l)
+	return l, err
+}
+
+// appendLogs decodes the JSON-encoded Azure log records of data,
+// and appends them to l. The log records are grouped by Azure
+// resource ID and category, both pulled into resource attributes.
+func (r azureResourceLogsUnmarshaler) appendLogs(data []byte, l plog.Logs) error {
 
 	var azureLogs azureLogRecords
-	decoder := jsoniter.NewDecoder(bytes.NewReader(event.Data))
+	decoder := jsoniter.NewDecoder(bytes.NewReader(data))
 	err := decoder.Decode(&azureLogs)
 	if err != nil {
-		return l, err
+		return err
 	}
 
-	resourceLogs := l.ResourceLogs().AppendEmpty()
-	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
-	scopeLogs.Scope().SetName(receiverScopeName)
-	scopeLogs.Scope().SetVersion(r.buildInfo.Version)
-	logRecords := scopeLogs.LogRecords()
+	type resourceKey struct {
+		resourceID string
+		category   string
+	}
+	logRecordsByResource := map[resourceKey]plog.LogRecordSlice{}
 
-	resourceID := ""
 	for _, azureLog := range azureLogs.Records {
-		resourceID = azureLog.ResourceID
 		nanos, err := asTimestamp(azureLog.Time)
 		if err != nil {
 			r.logger.Warn("Invalid Timestamp", zap.String("time", azureLog.Time))
 			continue
 		}
 
+		key := resourceKey{resourceID: azureLog.ResourceID, category: azureLog.Category}
+		logRecords, ok := logRecordsByResource[key]
+		if !ok {
+			resourceLogs := l.ResourceLogs().AppendEmpty()
+			if key.resourceID != "" {
+				resourceLogs.Resource().Attributes().PutStr(azureResourceID, key.resourceID)
+			}
+			if key.category != "" {
+				resourceLogs.Resource().Attributes().PutStr(azureCategory, key.category)
+			}
+			scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+			scopeLogs.Scope().SetName(receiverScopeName)
+			scopeLogs.Scope().SetVersion(r.buildInfo.Version)
+			logRecords = scopeLogs.LogRecords()
+			logRecordsByResource[key] = logRecords
+		}
+
 		lr := logRecords.AppendEmpty()
 
 		lr.SetTimestamp(nanos)
@@ -118,18 +141,11 @@ func (r azureResourceLogsUnmarshaler) UnmarshalLogs(event *eventhub.Event) (plog
 		}
 
 		if err := lr.Attributes().FromRaw(extractRawAttributes(azureLog)); err != nil {
-			return l, err
-		}
-
-		// The Azure resource ID will be pulled into a common resource attribute.
-		// This implementation assumes that a single log message from Azure will
-		// contain ONLY logs from a single resource.
-		if resourceID != "" {
-			resourceLogs.Resource().Attributes().PutStr(azureResourceID, resourceID)
+			return err
 		}
 	}
 
-	return l, nil
+	return nil
 }
 
 // asTimestamp will parse an ISO8601 string into an OpenTelemetry
@@ -229,11 +229,13 @@ func TestUnmarshalLogs(t *testing.T) {
 	scopeLogs.Scope().SetVersion(testBuildInfo.Version)
 	lr := scopeLogs.LogRecords().AppendEmpty()
 	resourceLogs.Resource().Attributes().PutStr(azureResourceID, "/RESOURCE_ID")
+	resourceLogs.Resource().Attributes().PutStr(azureCategory, "AuditEvent")
 	minimumLogRecord.CopyTo(lr)
 
 	expectedMinimum2 := plog.NewLogs()
 	resourceLogs = expectedMinimum2.ResourceLogs().AppendEmpty()
 	resourceLogs.Resource().Attributes().PutStr(azureResourceID, "/RESOURCE_ID")
+	resourceLogs.Resource().Attributes().PutStr(azureCategory, "AuditEvent")
 	scopeLogs = resourceLogs.ScopeLogs().AppendEmpty()
 	scopeLogs.Scope().SetName("otelcol/azureeventhubreceiver")
 	scopeLogs.Scope().SetVersion(testBuildInfo.Version)
@@ -246,12 +248,30 @@ func TestUnmarshalLogs(t *testing.T) {
 	expectedMaximum := plog.NewLogs()
 	resourceLogs = expectedMaximum.ResourceLogs().AppendEmpty()
 	resourceLogs.Resource().Attributes().PutStr(azureResourceID, "/RESOURCE_ID")
+	resourceLogs.Resource().Attributes().PutStr(azureCategory, "AuditEvent")
 	scopeLogs = resourceLogs.ScopeLogs().AppendEmpty()
 	scopeLogs.Scope().SetName("otelcol/azureeventhubreceiver")
 	scopeLogs.Scope().SetVersion(testBuildInfo.Version)
 	lr = scopeLogs.LogRecords().AppendEmpty()
 	maximumLogRecord.CopyTo(lr)
 
+	expectedMultipleResources := plog.NewLogs()
+	for _, resource := range []struct{ id, category string }{
+		{id: "/RESOURCE_ID", category: "AuditEvent"},
+		{id: "/OTHER_RESOURCE_ID", category: "AuditEvent"},
+		{id: "/RESOURCE_ID", category: "AzurePolicyEvaluationDetails"},
+	} {
+		resourceLogs = expectedMultipleResources.ResourceLogs().AppendEmpty()
+		resourceLogs.Resource().Attributes().PutStr(azureResourceID, resource.id)
+		resourceLogs.Resource().Attributes().PutStr(azureCategory, resource.category)
+		scopeLogs = resourceLogs.ScopeLogs().AppendEmpty()
+		scopeLogs.Scope().SetName("otelcol/azureeventhubreceiver")
+		scopeLogs.Scope().SetVersion(testBuildInfo.Version)
+		lr = scopeLogs.LogRecords().AppendEmpty()
+		minimumLogRecord.CopyTo(lr)
+		lr.Attributes().PutStr(azureCategory, resource.category)
+	}
+
 	tests := []struct {
 		file     string
 		expected plog.Logs
@@ -268,6 +288,10 @@ func TestUnmarshalLogs(t *testing.T) {
 			file:     "log-maximum.json",
 			expected: expectedMaximum,
 		},
+		{
+			file:     "log-multiple-resources.json",
+			expected: expectedMultipleResources,
+		},
 	}
 
 	sut := newAzureResourceLogsUnmarshaler(testBuildInfo, nil)
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/linkedin/goavro/v2"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+// captureEnqueuedTimeLayout is the layout of the EnqueuedTimeUtc field of the Event Hubs Capture records.
+const captureEnqueuedTimeLayout = "1/2/2006 3:04:05 PM"
+
+// captureLogsUnmarshaler decodes the Avro files written by Event Hubs Capture:
+// https://learn.microsoft.com/en-us/azure/event-hubs/event-hubs-capture-overview
+// The bodies of the captured events holding Azure resource logs, as sent by
+// the diagnostic settings, are decoded like with the "azure" format. The other
+// bodies are kept as is, like with the "raw" format.
+type captureLogsUnmarshaler struct {
+	resourceLogsUnmarshaler azureResourceLogsUnmarshaler
+	logger                  *zap.Logger
+}
+
+func newCaptureLogsUnmarshaler(buildInfo component.BuildInfo, logger *zap.Logger) eventLogsUnmarshaler {
+
+	return captureLogsUnmarshaler{
+		resourceLogsUnmarshaler: azureResourceLogsUnmarshaler{
+			buildInfo: buildInfo,
+			logger:    logger,
+		},
+		logger: logger,
+	}
+}
+
+// UnmarshalLogs decodes the Avro object container file of the event data.
+func (c captureLogsUnmarshaler) UnmarshalLogs(event *eventhub.Event) (plog.Logs, error) {
+
+	return c.unmarshalCapture(bytes.NewReader(event.Data))
+}
+
+func (c captureLogsUnmarshaler) unmarshalCapture(r io.Reader) (plog.Logs, error) {
+
+	l := plog.NewLogs()
+
+	ocf, err := goavro.NewOCFReader(r)
+	if err != nil {
+		return l, fmt.Errorf("invalid capture file: %w", err)
+	}
+
+	var rawLogRecords plog.LogRecordSlice
+	for ocf.Scan() {
+		datum, readErr := ocf.Read()
+		if readErr != nil {
+			return l, fmt.Errorf("invalid capture record: %w", readErr)
+		}
+		record, ok := datum.(map[string]interface{})
+		if !ok {
+			return l, fmt.Errorf("unexpected capture record of type %T", datum)
+		}
+
+		body := captureBody(record["Body"])
+		if len(body) == 0 {
+			continue
+		}
+
+		// the diagnostic settings send the resource logs as JSON objects with a records array
+		resourceLogs := plog.NewLogs()
+		if err = c.resourceLogsUnmarshaler.appendLogs(body, resourceLogs); err == nil && resourceLogs.LogRecordCount() > 0 {
+			resourceLogs.ResourceLogs().MoveAndAppendTo(l.ResourceLogs())
+			continue
+		}
+
+		if rawLogRecords == (plog.LogRecordSlice{}) {
+			rawLogRecords = l.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		}
+		lr := rawLogRecords.AppendEmpty()
+		lr.Body().SetEmptyBytes().FromRaw(body)
+		if enqueuedTime, ok := record["EnqueuedTimeUtc"].(string); ok {
+			if ts, parseErr := time.Parse(captureEnqueuedTimeLayout, enqueuedTime); parseErr == nil {
+				lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+			} else {
+				c.logger.Warn("Invalid Timestamp", zap.String("time", enqueuedTime))
+			}
+		}
+		if properties, ok := record["Properties"].(map[string]interface{}); ok {
+			for k, v := range properties {
+				if err = lr.Attributes().PutEmpty(k).FromRaw(captureValue(v)); err != nil {
+					return l, err
+				}
+			}
+		}
+	}
+	if err = ocf.Err(); err != nil {
+		return l, fmt.Errorf("invalid capture file: %w", err)
+	}
+
+	return l, nil
+}
+
+// captureBody returns the bytes of the Body field, which is a nullable union.
+func captureBody(body interface{}) []byte {
+	switch v := body.(type) {
+	case []byte:
+		return v
+	case map[string]interface{}:
+		b, _ := v["bytes"].([]byte)
+		return b
+	default:
+		return nil
+	}
+}
+
+// captureValue unwraps the Avro union values of the Properties field.
+func captureValue(value interface{}) interface{} {
+	union, ok := value.(map[string]interface{})
+	if !ok || len(union) != 1 {
+		return value
+	}
+	for _, v := range union {
+		return v
+	}
+	return value
+}
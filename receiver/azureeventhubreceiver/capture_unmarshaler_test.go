@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+)
+
+// captureSchema is the schema of the Avro files written by Event Hubs Capture.
+const captureSchema = `{
+	"type": "record",
+	"name": "EventData",
+	"namespace": "Microsoft.ServiceBus.Messaging",
+	"fields": [
+		{"name": "SequenceNumber", "type": "long"},
+		{"name": "Offset", "type": "string"},
+		{"name": "EnqueuedTimeUtc", "type": "string"},
+		{"name": "SystemProperties", "type": {"type": "map", "values": ["long", "double", "string", "bytes"]}},
+		{"name": "Properties", "type": {"type": "map", "values": ["long", "double", "string", "bytes", "null"]}},
+		{"name": "Body", "type": ["null", "bytes"]}
+	]
+}`
+
+func captureFile(t *testing.T, bodies ...[]byte) []byte {
+	var buf bytes.Buffer
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{W: &buf, Schema: captureSchema})
+	require.NoError(t, err)
+
+	records := make([]interface{}, 0, len(bodies))
+	for i, body := range bodies {
+		records = append(records, map[string]interface{}{
+			"SequenceNumber":   int64(i),
+			"Offset":           "4294967296",
+			"EnqueuedTimeUtc":  "11/11/2022 4:48:27 AM",
+			"SystemProperties": map[string]interface{}{"x-opt-sequence-number": goavro.Union("long", int64(i))},
+			"Properties":       map[string]interface{}{"source": goavro.Union("string", "app"), "empty": goavro.Union("null", nil)},
+			"Body":             goavro.Union("bytes", body),
+		})
+	}
+	require.NoError(t, w.Append(records))
+	return buf.Bytes()
+}
+
+func TestCaptureUnmarshalLogs(t *testing.T) {
+	resourceLogs, err := os.ReadFile(filepath.Join("testdata", "log-minimum.json"))
+	require.NoError(t, err)
+
+	expected := plog.NewLogs()
+	rl := expected.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(azureResourceID, "/RESOURCE_ID")
+	rl.Resource().Attributes().PutStr(azureCategory, "AuditEvent")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("otelcol/azureeventhubreceiver")
+	sl.Scope().SetVersion(testBuildInfo.Version)
+	minimumLogRecord.CopyTo(sl.LogRecords().AppendEmpty())
+
+	lr := expected.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Body().SetEmptyBytes().FromRaw([]byte("plain text"))
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2022, 11, 11, 4, 48, 27, 0, time.UTC)))
+	lr.Attributes().PutStr("source", "app")
+	lr.Attributes().PutEmpty("empty")
+
+	sut := newCaptureLogsUnmarshaler(testBuildInfo, zap.NewNop())
+	logs, err := sut.UnmarshalLogs(&eventhub.Event{
+		Data: captureFile(t, resourceLogs, []byte("plain text"), nil),
+	})
+	require.NoError(t, err)
+	assert.NoError(t, plogtest.CompareLogs(expected, logs))
+}
+
+func TestCaptureUnmarshalLogsInvalidFile(t *testing.T) {
+	sut := newCaptureLogsUnmarshaler(testBuildInfo, zap.NewNop())
+	_, err := sut.UnmarshalLogs(&eventhub.Event{Data: []byte(`{"records": []}`)})
+	assert.ErrorContains(t, err, "invalid capture file")
+}
@@ -17,10 +17,11 @@ const (
 	defaultLogFormat logFormat = ""
 	rawLogFormat     logFormat = "raw"
 	azureLogFormat   logFormat = "azure"
+	avroLogFormat    logFormat = "avro"
 )
 
 var (
-	validFormats         = []logFormat{defaultLogFormat, rawLogFormat, azureLogFormat}
+	validFormats         = []logFormat{defaultLogFormat, rawLogFormat, azureLogFormat, avroLogFormat}
 	errMissingConnection = errors.New("missing connection")
 )
 
@@ -57,7 +57,7 @@ func TestInvalidConnectionString(t *testing.T) {
 }
 
 func TestIsValidFormat(t *testing.T) {
-	for _, format := range []logFormat{defaultLogFormat, rawLogFormat, azureLogFormat} {
+	for _, format := range []logFormat{defaultLogFormat, rawLogFormat, azureLogFormat, avroLogFormat} {
 		assert.True(t, isValidFormat(string(format)))
 	}
 	assert.False(t, isValidFormat("invalid-format"))
@@ -6,6 +6,7 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -97,16 +98,20 @@ func (f *eventhubReceiverFactory) getReceiver(
 		var metricsUnmarshaler eventMetricsUnmarshaler
 		switch receiverType {
 		case component.DataTypeLogs:
-			if logFormat(receiverConfig.Format) == rawLogFormat {
+			switch logFormat(receiverConfig.Format) {
+			case rawLogFormat:
 				logsUnmarshaler = newRawLogsUnmarshaler(settings.Logger)
-			} else {
+			case avroLogFormat:
+				logsUnmarshaler = newCaptureLogsUnmarshaler(settings.BuildInfo, settings.Logger)
+			case defaultLogFormat, azureLogFormat:
 				logsUnmarshaler = newAzureResourceLogsUnmarshaler(settings.BuildInfo, settings.Logger)
 			}
 		case component.DataTypeMetrics:
-			if logFormat(receiverConfig.Format) == rawLogFormat {
+			switch logFormat(receiverConfig.Format) {
+			case rawLogFormat, avroLogFormat:
 				metricsUnmarshaler = nil
-				err = errors.New("raw format not supported for Metrics")
-			} else {
+				err = fmt.Errorf("%s format not supported for Metrics", receiverConfig.Format)
+			case defaultLogFormat, azureLogFormat:
 				metricsUnmarshaler = newAzureResourceMetricsUnmarshaler(settings.BuildInfo, settings.Logger)
 			}
 		case component.DataTypeTraces:
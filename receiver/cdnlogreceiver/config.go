@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cdnlogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cdnlogreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.uber.org/multierr"
+)
+
+// Config holds all the parameters to start an HTTPS server CDN log delivery services can push logs to.
+type Config struct {
+	// Endpoint is the address the receiver listens on, in the 'host:port' form.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// TLS configures the certificate served to the CDN, which only delivers logs over HTTPS.
+	TLS *configtls.TLSServerSetting `mapstructure:"tls"`
+
+	// TokenHeader is the request header carrying the token.
+	TokenHeader string `mapstructure:"token_header"`
+
+	// Token is the value the CDN is configured to send in TokenHeader.
+	// Requests are not validated if empty.
+	Token configopaque.String `mapstructure:"token"`
+
+	// TimestampField is the field holding the time of the request, in seconds since the Unix epoch.
+	TimestampField string `mapstructure:"timestamp_field"`
+
+	// StatusCodeField is the field holding the HTTP status code of the response, used to set the severity.
+	StatusCodeField string `mapstructure:"status_code_field"`
+
+	// FieldMapping maps the fields of the log lines to the log record attributes they are copied to.
+	// A field mapped to an empty attribute name is not copied.
+	FieldMapping map[string]string `mapstructure:"field_mapping"`
+
+	// MaxRequestBodySize is the maximum size in bytes of a decompressed request body.
+	MaxRequestBodySize int64 `mapstructure:"max_request_body_size"`
+}
+
+var (
+	errNoEndpoint           = errors.New("an endpoint must be specified")
+	errNoTLS                = errors.New("tls must be configured")
+	errNoCert               = errors.New("tls was configured, but no cert file was specified")
+	errNoKey                = errors.New("tls was configured, but no key file was specified")
+	errNoTokenHeader        = errors.New("token_header must be specified when a token is configured")
+	errInvalidMaxBodySize   = errors.New("max_request_body_size must be greater than 0")
+	errNoTimestampField     = errors.New("timestamp_field must be specified")
+	errEmptyFieldMappingKey = errors.New("field_mapping must not contain an empty field name")
+)
+
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return errNoEndpoint
+	}
+
+	if c.TLS == nil {
+		return errNoTLS
+	}
+
+	var errs error
+	_, _, err := net.SplitHostPort(c.Endpoint)
+	if err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to split endpoint into 'host:port' pair: %w", err))
+	}
+
+	if c.TLS.CertFile == "" {
+		errs = multierr.Append(errs, errNoCert)
+	}
+
+	if c.TLS.KeyFile == "" {
+		errs = multierr.Append(errs, errNoKey)
+	}
+
+	if c.Token != "" && c.TokenHeader == "" {
+		errs = multierr.Append(errs, errNoTokenHeader)
+	}
+
+	if c.TimestampField == "" {
+		errs = multierr.Append(errs, errNoTimestampField)
+	}
+
+	if c.MaxRequestBodySize <= 0 {
+		errs = multierr.Append(errs, errInvalidMaxBodySize)
+	}
+
+	if _, ok := c.FieldMapping[""]; ok {
+		errs = multierr.Append(errs, errEmptyFieldMappingKey)
+	}
+
+	return errs
+}
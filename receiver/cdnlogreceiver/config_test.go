@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cdnlogreceiver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cdnlogreceiver/internal/metadata"
+)
+
+func validConfig() Config {
+	cfg := *createDefaultConfig().(*Config)
+	cfg.Endpoint = "0.0.0.0:9999"
+	cfg.TLS = &configtls.TLSServerSetting{
+		TLSSetting: configtls.TLSSetting{
+			CertFile: "some_cert_file",
+			KeyFile:  "some_key_file",
+		},
+	}
+	return cfg
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name        string
+		mutate      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name:   "Valid config",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			name:        "Missing endpoint",
+			mutate:      func(cfg *Config) { cfg.Endpoint = "" },
+			expectedErr: errNoEndpoint.Error(),
+		},
+		{
+			name:        "Invalid endpoint",
+			mutate:      func(cfg *Config) { cfg.Endpoint = "9999" },
+			expectedErr: "failed to split endpoint into 'host:port' pair",
+		},
+		{
+			name:        "Missing TLS",
+			mutate:      func(cfg *Config) { cfg.TLS = nil },
+			expectedErr: errNoTLS.Error(),
+		},
+		{
+			name:        "TLS config missing key",
+			mutate:      func(cfg *Config) { cfg.TLS.KeyFile = "" },
+			expectedErr: errNoKey.Error(),
+		},
+		{
+			name:        "TLS config missing cert",
+			mutate:      func(cfg *Config) { cfg.TLS.CertFile = "" },
+			expectedErr: errNoCert.Error(),
+		},
+		{
+			name: "Token without header",
+			mutate: func(cfg *Config) {
+				cfg.Token = "abc123"
+				cfg.TokenHeader = ""
+			},
+			expectedErr: errNoTokenHeader.Error(),
+		},
+		{
+			name:        "Missing timestamp field",
+			mutate:      func(cfg *Config) { cfg.TimestampField = "" },
+			expectedErr: errNoTimestampField.Error(),
+		},
+		{
+			name:        "Invalid max request body size",
+			mutate:      func(cfg *Config) { cfg.MaxRequestBodySize = 0 },
+			expectedErr: errInvalidMaxBodySize.Error(),
+		},
+		{
+			name:        "Empty field name",
+			mutate:      func(cfg *Config) { cfg.FieldMapping[""] = "http.method" },
+			expectedErr: errEmptyFieldMappingKey.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := validConfig()
+			tc.mutate(&cfg)
+			err := cfg.Validate()
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tls := &configtls.TLSServerSetting{
+		TLSSetting: configtls.TLSSetting{
+			CertFile: "some_cert_file",
+			KeyFile:  "some_key_file",
+		},
+	}
+	customFieldMapping := defaultFieldMapping()
+	customFieldMapping["UA"] = ""
+	customFieldMapping["queryStr"] = "url.query"
+
+	cases := []struct {
+		name           string
+		expectedConfig component.Config
+	}{
+		{
+			name: "",
+			expectedConfig: &Config{
+				Endpoint:           "0.0.0.0:12345",
+				TLS:                tls,
+				TokenHeader:        defaultTokenHeader,
+				Token:              "1234567890abcdef1234567890abcdef",
+				TimestampField:     defaultTimestampField,
+				StatusCodeField:    defaultStatusCodeField,
+				FieldMapping:       defaultFieldMapping(),
+				MaxRequestBodySize: defaultMaxRequestBodySize,
+			},
+		},
+		{
+			name: "custom",
+			expectedConfig: &Config{
+				Endpoint:           "0.0.0.0:12345",
+				TLS:                tls,
+				TokenHeader:        "Authorization",
+				Token:              "Bearer 1234567890abcdef1234567890abcdef",
+				TimestampField:     "start",
+				StatusCodeField:    "status",
+				FieldMapping:       customFieldMapping,
+				MaxRequestBodySize: 1048576,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			loaded, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(loaded, cfg))
+			require.Equal(t, tc.expectedConfig, cfg)
+			require.NoError(t, component.ValidateConfig(cfg))
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package cdnlogreceiver implements a receiver accepting the logs pushed over HTTPS by CDN log delivery services.
+package cdnlogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cdnlogreceiver"
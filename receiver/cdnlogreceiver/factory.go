@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cdnlogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cdnlogreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cdnlogreceiver/internal/metadata"
+)
+
+const (
+	defaultTokenHeader        = "X-Auth-Token"
+	defaultTimestampField     = "reqTimeSec"
+	defaultStatusCodeField    = "statusCode"
+	defaultMaxRequestBodySize = 20 * 1024 * 1024
+)
+
+// NewFactory returns the component factory for the cdnlogreceiver
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+	)
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg := rConf.(*Config)
+	return newLogsReceiver(params, cfg, consumer)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TLS:                &configtls.TLSServerSetting{},
+		TokenHeader:        defaultTokenHeader,
+		TimestampField:     defaultTimestampField,
+		StatusCodeField:    defaultStatusCodeField,
+		FieldMapping:       defaultFieldMapping(),
+		MaxRequestBodySize: defaultMaxRequestBodySize,
+	}
+}
+
+// defaultFieldMapping maps the fields of Akamai DataStream 2 logs to the HTTP semantic conventions.
+func defaultFieldMapping() map[string]string {
+	return map[string]string{
+		"reqMethod":     conventions.AttributeHTTPMethod,
+		"statusCode":    conventions.AttributeHTTPStatusCode,
+		"reqHost":       conventions.AttributeNetHostName,
+		"reqPort":       conventions.AttributeNetHostPort,
+		"reqPath":       conventions.AttributeHTTPTarget,
+		"UA":            conventions.AttributeHTTPUserAgent,
+		"cliIP":         conventions.AttributeHTTPClientIP,
+		"rspContentLen": conventions.AttributeHTTPResponseContentLength,
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cdnlogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cdnlogreceiver"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	rcvr "go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cdnlogreceiver/internal/metadata"
+)
+
+const receiverScopeName = "otelcol/" + metadata.Type
+
+// intAttributes are the attributes the semantic conventions define as integers.
+// CDNs commonly send every field as a string, so the values mapped to them are converted.
+var intAttributes = map[string]bool{
+	conventions.AttributeHTTPStatusCode:            true,
+	conventions.AttributeNetHostPort:               true,
+	conventions.AttributeHTTPResponseContentLength: true,
+	conventions.AttributeHTTPRequestContentLength:  true,
+}
+
+type logsReceiver struct {
+	logger   *zap.Logger
+	cfg      *Config
+	server   *http.Server
+	consumer consumer.Logs
+	wg       *sync.WaitGroup
+}
+
+func newLogsReceiver(params rcvr.CreateSettings, cfg *Config, consumer consumer.Logs) (*logsReceiver, error) {
+	recv := &logsReceiver{
+		cfg:      cfg,
+		consumer: consumer,
+		logger:   params.Logger,
+		wg:       &sync.WaitGroup{},
+	}
+
+	tlsConfig, err := recv.cfg.TLS.LoadTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	recv.server = &http.Server{
+		TLSConfig:         tlsConfig,
+		Handler:           http.HandlerFunc(recv.handleRequest),
+		ReadHeaderTimeout: 20 * time.Second,
+	}
+	return recv, nil
+}
+
+func (l *logsReceiver) Start(ctx context.Context, host component.Host) error {
+	l.logger.Debug("starting receiver HTTPS server")
+	// l.server.ServeTLS is used over l.server.ListenAndServeTLS
+	// so that errors binding to the network interface are returned on start.
+	var lc net.ListenConfig
+	listener, err := lc.Listen(ctx, "tcp", l.cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		if err := l.server.ServeTLS(listener, l.cfg.TLS.CertFile, l.cfg.TLS.KeyFile); err != http.ErrServerClosed {
+			l.logger.Error("ServeTLS failed", zap.Error(err))
+			host.ReportFatalError(err)
+		}
+	}()
+	return nil
+}
+
+func (l *logsReceiver) Shutdown(ctx context.Context) error {
+	l.logger.Debug("Shutting down server")
+	if err := l.server.Shutdown(ctx); err != nil {
+		return err
+	}
+	l.wg.Wait()
+	return nil
+}
+
+func (l *logsReceiver) handleRequest(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if l.cfg.Token != "" {
+		token := req.Header.Get(l.cfg.TokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(l.cfg.Token)) != 1 {
+			rw.WriteHeader(http.StatusUnauthorized)
+			l.logger.Debug("Got payload with a missing or invalid token, dropping...", zap.String("remote", req.RemoteAddr))
+			return
+		}
+	}
+
+	body := req.Body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		reader, err := gzip.NewReader(req.Body)
+		if err != nil {
+			rw.WriteHeader(http.StatusUnprocessableEntity)
+			l.logger.Debug("Got payload with gzip, but failed to read", zap.Error(err))
+			return
+		}
+		defer reader.Close()
+		body = reader
+	}
+
+	// Reading one byte more than allowed tells apart bodies exceeding the limit,
+	// which also protects against highly compressed payloads.
+	payload, err := io.ReadAll(io.LimitReader(body, l.cfg.MaxRequestBodySize+1))
+	if err != nil {
+		rw.WriteHeader(http.StatusUnprocessableEntity)
+		l.logger.Debug("Failed to read logs payload", zap.Error(err), zap.String("remote", req.RemoteAddr))
+		return
+	}
+	if int64(len(payload)) > l.cfg.MaxRequestBodySize {
+		rw.WriteHeader(http.StatusRequestEntityTooLarge)
+		l.logger.Warn("Got payload exceeding max_request_body_size, dropping...", zap.Int64("max_request_body_size", l.cfg.MaxRequestBodySize))
+		return
+	}
+
+	logs, err := parsePayload(payload)
+	if err != nil {
+		rw.WriteHeader(http.StatusUnprocessableEntity)
+		l.logger.Error("Failed to parse CDN logs payload", zap.Error(err))
+		return
+	}
+
+	if len(logs) > 0 {
+		if err := l.consumer.ConsumeLogs(req.Context(), l.processLogs(pcommon.NewTimestampFromTime(time.Now()), logs)); err != nil {
+			rw.WriteHeader(http.StatusInternalServerError)
+			l.logger.Error("Failed to consume CDN logs", zap.Error(err))
+			return
+		}
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+// parsePayload parses either newline delimited JSON objects or a JSON array of objects.
+func parsePayload(payload []byte) ([]map[string]interface{}, error) {
+	payload = bytes.TrimSpace(payload)
+	var logs []map[string]interface{}
+	if bytes.HasPrefix(payload, []byte("[")) {
+		err := json.Unmarshal(payload, &logs)
+		return logs, err
+	}
+	for _, line := range bytes.Split(payload, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var log map[string]interface{}
+		if err := json.Unmarshal(line, &log); err != nil {
+			return logs, err
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+func (l *logsReceiver) processLogs(now pcommon.Timestamp, logs []map[string]interface{}) plog.Logs {
+	pLogs := plog.NewLogs()
+	scopeLogs := pLogs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName(receiverScopeName)
+	logRecords := scopeLogs.LogRecords()
+	logRecords.EnsureCapacity(len(logs))
+
+	for _, log := range logs {
+		logRecord := logRecords.AppendEmpty()
+		logRecord.SetObservedTimestamp(now)
+
+		if v, ok := log[l.cfg.TimestampField]; ok {
+			ts, err := parseEpochSeconds(v)
+			if err != nil {
+				l.logger.Warn(fmt.Sprintf("unable to parse %s", l.cfg.TimestampField), zap.Error(err), zap.Any("value", v))
+			} else {
+				logRecord.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+			}
+		}
+
+		if v, ok := log[l.cfg.StatusCodeField]; ok {
+			if statusCode, ok := toInt(v); ok {
+				if sev := severityFromStatusCode(statusCode); sev != plog.SeverityNumberUnspecified {
+					logRecord.SetSeverityNumber(sev)
+					logRecord.SetSeverityText(sev.String())
+				}
+			} else {
+				l.logger.Warn(fmt.Sprintf("unable to parse %s", l.cfg.StatusCodeField), zap.Any("value", v))
+			}
+		}
+
+		attrs := logRecord.Attributes()
+		for field, attribute := range l.cfg.FieldMapping {
+			v, ok := log[field]
+			if !ok || attribute == "" {
+				continue
+			}
+			if intAttributes[attribute] {
+				if intV, ok := toInt(v); ok {
+					attrs.PutInt(attribute, intV)
+					continue
+				}
+			}
+			switch v := v.(type) {
+			case string:
+				// CDNs commonly send "-" for fields without a value.
+				if v != "" && v != "-" {
+					attrs.PutStr(attribute, v)
+				}
+			case float64:
+				attrs.PutDouble(attribute, v)
+			case bool:
+				attrs.PutBool(attribute, v)
+			default:
+				l.logger.Warn("unable to translate field to attribute, unsupported type", zap.String("field", field), zap.Any("value", v), zap.String("type", fmt.Sprintf("%T", v)))
+			}
+		}
+
+		if err := logRecord.Body().SetEmptyMap().FromRaw(log); err != nil {
+			l.logger.Warn("unable to set body", zap.Error(err))
+		}
+	}
+
+	return pLogs
+}
+
+// toInt converts a JSON number or a string holding an integer to an int64.
+func toInt(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case string:
+		intV, err := strconv.ParseInt(v, 10, 64)
+		return intV, err == nil
+	case float64:
+		return int64(v), v == float64(int64(v))
+	default:
+		return 0, false
+	}
+}
+
+// parseEpochSeconds parses a number of seconds since the Unix epoch with an optional fraction,
+// sent either as a JSON number or as a string like "1689935400.123".
+func parseEpochSeconds(v interface{}) (time.Time, error) {
+	var value string
+	switch v := v.(type) {
+	case string:
+		value = v
+	case float64:
+		value = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return time.Time{}, fmt.Errorf("unsupported type %T", v)
+	}
+
+	secs, frac, _ := strings.Cut(value, ".")
+	sec, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if frac == "" {
+		return time.Unix(sec, 0), nil
+	}
+	// The fraction is truncated or right padded to nanoseconds.
+	if len(frac) > 9 {
+		frac = frac[:9]
+	}
+	nsec, err := strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(sec, int64(nsec)), nil
+}
+
+// severityFromStatusCode translates HTTP status code to OpenTelemetry severity number.
+func severityFromStatusCode(statusCode int64) plog.SeverityNumber {
+	switch {
+	case statusCode < 100:
+		return plog.SeverityNumberUnspecified
+	case statusCode < 300:
+		return plog.SeverityNumberInfo
+	case statusCode < 400:
+		return plog.SeverityNumberInfo2
+	case statusCode < 500:
+		return plog.SeverityNumberWarn
+	case statusCode < 600:
+		return plog.SeverityNumberError
+	default:
+		return plog.SeverityNumberUnspecified
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cdnlogreceiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestProcessLogs(t *testing.T) {
+	payload, err := os.ReadFile(filepath.Join("testdata", "sample-payloads", "datastream2.ndjson"))
+	require.NoError(t, err)
+	rawLogs, err := parsePayload(payload)
+	require.NoError(t, err)
+	require.Len(t, rawLogs, 2)
+
+	cfg := createDefaultConfig().(*Config)
+	recv := newReceiver(t, cfg, &consumertest.LogsSink{})
+	now := pcommon.NewTimestampFromTime(time.Now())
+	logs := recv.processLogs(now, rawLogs)
+
+	require.Equal(t, 1, logs.ResourceLogs().Len())
+	scopeLogs := logs.ResourceLogs().At(0).ScopeLogs().At(0)
+	assert.Equal(t, receiverScopeName, scopeLogs.Scope().Name())
+	require.Equal(t, 2, scopeLogs.LogRecords().Len())
+
+	lr := scopeLogs.LogRecords().At(0)
+	assert.Equal(t, now, lr.ObservedTimestamp())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(1689935400, 123000000)), lr.Timestamp())
+	assert.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+	assert.Equal(t, map[string]interface{}{
+		"http.method":                  "GET",
+		"http.status_code":             int64(206),
+		"net.host.name":                "test.hostname.net",
+		"net.host.port":                int64(443),
+		"http.target":                  "/path1/path2/file.ext",
+		"http.user_agent":              "Mozilla/5.0",
+		"http.client_ip":               "128.147.28.68",
+		"http.response_content_length": int64(5000),
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, rawLogs[0], lr.Body().Map().AsRaw())
+
+	lr = scopeLogs.LogRecords().At(1)
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(1689935401, 0)), lr.Timestamp())
+	assert.Equal(t, plog.SeverityNumberError, lr.SeverityNumber())
+	assert.Equal(t, plog.SeverityNumberError.String(), lr.SeverityText())
+	// the "-" placeholder of the response content length is not copied
+	_, ok := lr.Attributes().Get("http.response_content_length")
+	assert.False(t, ok)
+}
+
+func TestProcessLogsFieldMapping(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.FieldMapping["UA"] = ""
+	cfg.FieldMapping["cached"] = "cdn.cached"
+	cfg.FieldMapping["ratio"] = "cdn.compression_ratio"
+	recv := newReceiver(t, cfg, &consumertest.LogsSink{})
+
+	logs := recv.processLogs(pcommon.NewTimestampFromTime(time.Now()), []map[string]interface{}{{
+		"UA":         "curl/8.1.2",
+		"statusCode": float64(404),
+		"reqTimeSec": 1689935400.5,
+		"cached":     true,
+		"ratio":      2.5,
+	}})
+
+	lr := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(1689935400, 500000000)), lr.Timestamp())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, map[string]interface{}{
+		"http.status_code":      int64(404),
+		"cdn.cached":            true,
+		"cdn.compression_ratio": 2.5,
+	}, lr.Attributes().AsRaw())
+}
+
+func TestParsePayload(t *testing.T) {
+	testCases := []struct {
+		name        string
+		payload     string
+		expected    []map[string]interface{}
+		expectedErr bool
+	}{
+		{
+			name:     "ndjson",
+			payload:  "{\"a\":\"1\"}\n\n{\"a\":\"2\"}\r\n",
+			expected: []map[string]interface{}{{"a": "1"}, {"a": "2"}},
+		},
+		{
+			name:     "json array",
+			payload:  ` [{"a":"1"},{"a":"2"}]`,
+			expected: []map[string]interface{}{{"a": "1"}, {"a": "2"}},
+		},
+		{
+			name:    "empty",
+			payload: "",
+		},
+		{
+			name:        "invalid",
+			payload:     `{"a":"1"`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := parsePayload([]byte(tc.payload))
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, logs)
+		})
+	}
+}
+
+func TestParseEpochSeconds(t *testing.T) {
+	testCases := []struct {
+		value       interface{}
+		expected    time.Time
+		expectedErr bool
+	}{
+		{value: "1689935400", expected: time.Unix(1689935400, 0)},
+		{value: "1689935400.1", expected: time.Unix(1689935400, 100000000)},
+		{value: "1689935400.123456789123", expected: time.Unix(1689935400, 123456789)},
+		{value: float64(1689935400.25), expected: time.Unix(1689935400, 250000000)},
+		{value: "yesterday", expectedErr: true},
+		{value: "1689935400.x", expectedErr: true},
+		{value: true, expectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.value), func(t *testing.T) {
+			ts, err := parseEpochSeconds(tc.value)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Equal(ts), "expected %s, got %s", tc.expected, ts)
+		})
+	}
+}
+
+func TestSeverityParsing(t *testing.T) {
+	testCases := []struct {
+		statusCode int64
+		s          plog.SeverityNumber
+	}{
+		{statusCode: 0, s: plog.SeverityNumberUnspecified},
+		{statusCode: 200, s: plog.SeverityNumberInfo},
+		{statusCode: 304, s: plog.SeverityNumberInfo2},
+		{statusCode: 404, s: plog.SeverityNumberWarn},
+		{statusCode: 503, s: plog.SeverityNumberError},
+		{statusCode: 999, s: plog.SeverityNumberUnspecified},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%d -> %s", tc.statusCode, tc.s.String()), func(t *testing.T) {
+			require.Equal(t, tc.s, severityFromStatusCode(tc.statusCode))
+		})
+	}
+}
+
+func TestHandleRequest(t *testing.T) {
+	const line = `{"cliIP":"127.0.0.1","reqTimeSec":"1689935400","statusCode":"200"}`
+
+	testCases := []struct {
+		name               string
+		method             string
+		body               []byte
+		headers            map[string]string
+		expectedStatusCode int
+		expectedLogs       int
+		consumerFailure    bool
+	}{
+		{
+			name:               "No token provided",
+			body:               []byte(line),
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Invalid token",
+			body:               []byte(line),
+			headers:            map[string]string{"X-Auth-Token": "abc124"},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Invalid method",
+			method:             http.MethodGet,
+			headers:            map[string]string{"X-Auth-Token": "abc123"},
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:               "Invalid payload",
+			body:               []byte(`{"cliIP":"127.0.0.1"`),
+			headers:            map[string]string{"X-Auth-Token": "abc123"},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Consumer fails",
+			body:               []byte(line),
+			headers:            map[string]string{"X-Auth-Token": "abc123"},
+			consumerFailure:    true,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:               "Request succeeds",
+			body:               []byte(line + "\n" + line),
+			headers:            map[string]string{"X-Auth-Token": "abc123"},
+			expectedStatusCode: http.StatusOK,
+			expectedLogs:       2,
+		},
+		{
+			name:               "Request succeeds with gzip",
+			body:               gzipped(t, line),
+			headers:            map[string]string{"X-Auth-Token": "abc123", "Content-Encoding": "gzip"},
+			expectedStatusCode: http.StatusOK,
+			expectedLogs:       1,
+		},
+		{
+			name:               "Request fails to unzip gzip",
+			body:               []byte("thisisnotvalidzippedcontent"),
+			headers:            map[string]string{"X-Auth-Token": "abc123", "Content-Encoding": "gzip"},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Decompressed payload too large",
+			body:               gzipped(t, line+string(bytes.Repeat([]byte(" "), 1024))),
+			headers:            map[string]string{"X-Auth-Token": "abc123", "Content-Encoding": "gzip"},
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:               "Empty payload",
+			headers:            map[string]string{"X-Auth-Token": "abc123"},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var next consumer.Logs
+			if tc.consumerFailure {
+				next = consumertest.NewErr(errors.New("consumer failed"))
+			} else {
+				next = &consumertest.LogsSink{}
+			}
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "localhost:0"
+			cfg.Token = "abc123"
+			cfg.MaxRequestBodySize = 1024
+			r := newReceiver(t, cfg, next)
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/", bytes.NewReader(tc.body))
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			r.handleRequest(rec, req)
+
+			assert.Equal(t, tc.expectedStatusCode, rec.Code, "Status codes are not equal")
+			if sink, ok := next.(*consumertest.LogsSink); ok {
+				assert.Equal(t, tc.expectedLogs, sink.LogRecordCount())
+			}
+		})
+	}
+}
+
+func TestStartShutdown(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:0"
+	cfg.TLS = &configtls.TLSServerSetting{
+		TLSSetting: configtls.TLSSetting{
+			CertFile: filepath.Join("testdata", "cert", "server.crt"),
+			KeyFile:  filepath.Join("testdata", "cert", "server.key"),
+		},
+	}
+	r := newReceiver(t, cfg, &consumertest.LogsSink{})
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func gzipped(t *testing.T, message string) []byte {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	_, err := w.Write([]byte(message))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return b.Bytes()
+}
+
+func newReceiver(t *testing.T, cfg *Config, nextConsumer consumer.Logs) *logsReceiver {
+	set := receivertest.NewNopCreateSettings()
+	set.Logger = zaptest.NewLogger(t)
+	r, err := newLogsReceiver(set, cfg, nextConsumer)
+	require.NoError(t, err)
+	return r
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ciwebhookreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/multierr"
+)
+
+var (
+	errMissingEndpoint = errors.New("missing receiver server endpoint from config")
+	errNoProvider      = errors.New("at least one of github or gitlab must be configured")
+)
+
+// Config defines configuration for the CI webhook receiver.
+type Config struct {
+	confighttp.HTTPServerSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// HealthPath is the path of the health check endpoint.
+	HealthPath string `mapstructure:"health_path"`
+
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies.
+	Decompression *component.ID `mapstructure:"decompression"`
+
+	// GitHub configures the endpoint receiving GitHub Actions webhooks.
+	GitHub *GitHubConfig `mapstructure:"github"`
+
+	// GitLab configures the endpoint receiving GitLab CI webhooks.
+	GitLab *GitLabConfig `mapstructure:"gitlab"`
+}
+
+// GitHubConfig defines configuration for GitHub Actions webhooks.
+type GitHubConfig struct {
+	// Path is the path the GitHub webhook delivers events to.
+	Path string `mapstructure:"path"`
+
+	// Secret is the webhook secret, used to verify the X-Hub-Signature-256 header.
+	// Deliveries are not verified if empty.
+	Secret configopaque.String `mapstructure:"secret"`
+}
+
+// GitLabConfig defines configuration for GitLab CI webhooks.
+type GitLabConfig struct {
+	// Path is the path the GitLab webhook delivers events to.
+	Path string `mapstructure:"path"`
+
+	// Token is the webhook secret token, compared with the X-Gitlab-Token header.
+	// Deliveries are not verified if empty.
+	Token configopaque.String `mapstructure:"token"`
+}
+
+var _ confmap.Unmarshaler = (*Config)(nil)
+
+// Unmarshal a confmap.Conf into the config struct, enabling the providers whose section is present.
+func (cfg *Config) Unmarshal(conf *confmap.Conf) error {
+	if err := conf.Unmarshal(cfg, confmap.WithErrorUnused()); err != nil {
+		return err
+	}
+
+	if conf.IsSet("github") && cfg.GitHub == nil {
+		cfg.GitHub = &GitHubConfig{}
+	}
+	if cfg.GitHub != nil && cfg.GitHub.Path == "" {
+		cfg.GitHub.Path = defaultGitHubPath
+	}
+
+	if conf.IsSet("gitlab") && cfg.GitLab == nil {
+		cfg.GitLab = &GitLabConfig{}
+	}
+	if cfg.GitLab != nil && cfg.GitLab.Path == "" {
+		cfg.GitLab.Path = defaultGitLabPath
+	}
+	return nil
+}
+
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.Endpoint == "" {
+		errs = multierr.Append(errs, errMissingEndpoint)
+	}
+	if cfg.GitHub == nil && cfg.GitLab == nil {
+		errs = multierr.Append(errs, errNoProvider)
+	}
+
+	paths := map[string]string{}
+	checkPath := func(name, path string) {
+		if !strings.HasPrefix(path, "/") {
+			errs = multierr.Append(errs, fmt.Errorf("%s: path %q must start with '/'", name, path))
+			return
+		}
+		if other, ok := paths[path]; ok {
+			errs = multierr.Append(errs, fmt.Errorf("%s: path %q is already used by %s", name, path, other))
+			return
+		}
+		paths[path] = name
+	}
+	checkPath("health_path", cfg.HealthPath)
+	if cfg.GitHub != nil {
+		checkPath("github", cfg.GitHub.Path)
+	}
+	if cfg.GitLab != nil {
+		checkPath("gitlab", cfg.GitLab.Path)
+	}
+	return errs
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ciwebhookreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:          component.NewIDWithName(metadata.Type, ""),
+			expectedErr: errNoProvider.Error(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "github"),
+			expected: &Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{Endpoint: "localhost:8088"},
+				HealthPath:         defaultHealthPath,
+				GitHub:             &GitHubConfig{Path: defaultGitHubPath},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all"),
+			expected: &Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{Endpoint: "localhost:9000"},
+				HealthPath:         "/healthz",
+				GitHub:             &GitHubConfig{Path: "/events/github", Secret: "github-secret"},
+				GitLab:             &GitLabConfig{Path: "/events/gitlab", Token: "gitlab-token"},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "conflict"),
+			expectedErr: `github: path "/health" is already used by health_path`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ""
+	cfg.GitLab = &GitLabConfig{Path: "gitlab"}
+	assert.EqualError(t, cfg.Validate(), "missing receiver server endpoint from config; gitlab: path \"gitlab\" must start with '/'")
+}
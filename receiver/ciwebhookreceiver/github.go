@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ciwebhookreceiver"
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	githubProvider = "github"
+
+	githubEventPing        = "ping"
+	githubEventWorkflowRun = "workflow_run"
+	githubEventWorkflowJob = "workflow_job"
+
+	githubActionCompleted = "completed"
+)
+
+type githubRepository struct {
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+type githubWorkflowRunEvent struct {
+	Action      string            `json:"action"`
+	WorkflowRun githubWorkflowRun `json:"workflow_run"`
+	Repository  githubRepository  `json:"repository"`
+}
+
+type githubWorkflowRun struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	RunNumber    int64     `json:"run_number"`
+	RunAttempt   int64     `json:"run_attempt"`
+	Event        string    `json:"event"`
+	Conclusion   string    `json:"conclusion"`
+	HeadBranch   string    `json:"head_branch"`
+	HeadSHA      string    `json:"head_sha"`
+	HTMLURL      string    `json:"html_url"`
+	RunStartedAt time.Time `json:"run_started_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type githubWorkflowJobEvent struct {
+	Action      string            `json:"action"`
+	WorkflowJob githubWorkflowJob `json:"workflow_job"`
+	Repository  githubRepository  `json:"repository"`
+}
+
+type githubWorkflowJob struct {
+	ID           int64        `json:"id"`
+	RunID        int64        `json:"run_id"`
+	RunAttempt   int64        `json:"run_attempt"`
+	Name         string       `json:"name"`
+	WorkflowName string       `json:"workflow_name"`
+	Conclusion   string       `json:"conclusion"`
+	HeadBranch   string       `json:"head_branch"`
+	HeadSHA      string       `json:"head_sha"`
+	HTMLURL      string       `json:"html_url"`
+	RunnerName   string       `json:"runner_name"`
+	Labels       []string     `json:"labels"`
+	StartedAt    time.Time    `json:"started_at"`
+	CompletedAt  time.Time    `json:"completed_at"`
+	Steps        []githubStep `json:"steps"`
+}
+
+type githubStep struct {
+	Name        string    `json:"name"`
+	Number      int64     `json:"number"`
+	Conclusion  string    `json:"conclusion"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// githubToTraces converts a GitHub webhook delivery into traces. Only completed
+// workflow runs and jobs are converted; other deliveries result in empty traces.
+func githubToTraces(event string, body []byte) (ptrace.Traces, error) {
+	switch event {
+	case githubEventWorkflowRun:
+		var e githubWorkflowRunEvent
+		if err := json.Unmarshal(body, &e); err != nil {
+			return ptrace.Traces{}, fmt.Errorf("failed to decode %s event: %w", event, err)
+		}
+		if e.Action != githubActionCompleted {
+			return ptrace.NewTraces(), nil
+		}
+		return githubWorkflowRunToTraces(e), nil
+	case githubEventWorkflowJob:
+		var e githubWorkflowJobEvent
+		if err := json.Unmarshal(body, &e); err != nil {
+			return ptrace.Traces{}, fmt.Errorf("failed to decode %s event: %w", event, err)
+		}
+		if e.Action != githubActionCompleted {
+			return ptrace.NewTraces(), nil
+		}
+		return githubWorkflowJobToTraces(e), nil
+	default:
+		return ptrace.NewTraces(), nil
+	}
+}
+
+func githubTraceID(repository string, runID, runAttempt int64) pcommon.TraceID {
+	return newTraceID(githubProvider, repository, runID, runAttempt)
+}
+
+func githubRunSpanID(repository string, runID, runAttempt int64) pcommon.SpanID {
+	return newSpanID(githubProvider, repository, runID, runAttempt)
+}
+
+func githubWorkflowRunToTraces(e githubWorkflowRunEvent) ptrace.Traces {
+	run := e.WorkflowRun
+	td, spans := newTraces(githubProvider, e.Repository.FullName, e.Repository.HTMLURL)
+	span := appendSpan(spans, run.Name,
+		githubTraceID(e.Repository.FullName, run.ID, run.RunAttempt),
+		githubRunSpanID(e.Repository.FullName, run.ID, run.RunAttempt),
+		pcommon.NewSpanIDEmpty(),
+		run.RunStartedAt, run.UpdatedAt)
+	setGitHubStatus(span, run.Conclusion)
+
+	attrs := span.Attributes()
+	attrs.PutInt(attributeCIPipelineID, run.ID)
+	putStr(attrs, attributeCIPipelineName, run.Name)
+	putStr(attrs, attributeCIPipelineURL, run.HTMLURL)
+	putStr(attrs, attributeCIPipelineTrigger, run.Event)
+	attrs.PutInt(attributeCIPipelineRunNumber, run.RunNumber)
+	attrs.PutInt(attributeCIPipelineAttempt, run.RunAttempt)
+	putStr(attrs, attributeVCSRef, run.HeadBranch)
+	putStr(attrs, attributeVCSRevision, run.HeadSHA)
+	return td
+}
+
+func githubWorkflowJobToTraces(e githubWorkflowJobEvent) ptrace.Traces {
+	job := e.WorkflowJob
+	td, spans := newTraces(githubProvider, e.Repository.FullName, e.Repository.HTMLURL)
+	traceID := githubTraceID(e.Repository.FullName, job.RunID, job.RunAttempt)
+	jobSpanID := newSpanID(githubProvider, e.Repository.FullName, job.ID)
+
+	span := appendSpan(spans, job.Name, traceID, jobSpanID,
+		githubRunSpanID(e.Repository.FullName, job.RunID, job.RunAttempt),
+		job.StartedAt, job.CompletedAt)
+	setGitHubStatus(span, job.Conclusion)
+
+	attrs := span.Attributes()
+	attrs.PutInt(attributeCIPipelineID, job.RunID)
+	putStr(attrs, attributeCIPipelineName, job.WorkflowName)
+	attrs.PutInt(attributeCIPipelineAttempt, job.RunAttempt)
+	attrs.PutInt(attributeCIJobID, job.ID)
+	putStr(attrs, attributeCIJobName, job.Name)
+	putStr(attrs, attributeCIJobURL, job.HTMLURL)
+	putStr(attrs, attributeCIRunnerName, job.RunnerName)
+	putStrSlice(attrs, attributeCIRunnerLabels, job.Labels)
+	putStr(attrs, attributeVCSRef, job.HeadBranch)
+	putStr(attrs, attributeVCSRevision, job.HeadSHA)
+
+	for _, step := range job.Steps {
+		// Skipped steps never start.
+		if step.StartedAt.IsZero() {
+			continue
+		}
+		stepSpan := appendSpan(spans, step.Name, traceID,
+			newSpanID(githubProvider, e.Repository.FullName, job.ID, step.Number),
+			jobSpanID, step.StartedAt, step.CompletedAt)
+		setGitHubStatus(stepSpan, step.Conclusion)
+		stepAttrs := stepSpan.Attributes()
+		putStr(stepAttrs, attributeCIStepName, step.Name)
+		stepAttrs.PutInt(attributeCIStepNumber, step.Number)
+	}
+	return td
+}
+
+// setGitHubStatus sets the status of span from the conclusion of a workflow run, job or step.
+func setGitHubStatus(span ptrace.Span, conclusion string) {
+	putStr(span.Attributes(), attributeCIConclusion, conclusion)
+	switch conclusion {
+	case "success":
+		span.Status().SetCode(ptrace.StatusCodeOk)
+	case "failure", "timed_out", "startup_failure":
+		span.Status().SetCode(ptrace.StatusCodeError)
+		span.Status().SetMessage(conclusion)
+	}
+}
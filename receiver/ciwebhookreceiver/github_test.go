@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestGitHubWorkflowRun(t *testing.T) {
+	body, err := os.ReadFile(filepath.Join("testdata", "github_workflow_run.json"))
+	require.NoError(t, err)
+
+	td, err := githubToTraces(githubEventWorkflowRun, body)
+	require.NoError(t, err)
+	require.Equal(t, 1, td.SpanCount())
+
+	rs := td.ResourceSpans().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"service.name":       "octo-org/octo-repo",
+		"ci.provider":        "github",
+		"vcs.repository.url": "https://github.com/octo-org/octo-repo",
+	}, rs.Resource().Attributes().AsRaw())
+
+	span := rs.ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "build-and-test", span.Name())
+	assert.Equal(t, githubTraceID("octo-org/octo-repo", 5587431522, 1), span.TraceID())
+	assert.True(t, span.ParentSpanID().IsEmpty())
+	assert.Equal(t, time.Date(2023, 7, 18, 10, 0, 0, 0, time.UTC), span.StartTimestamp().AsTime())
+	assert.Equal(t, time.Date(2023, 7, 18, 10, 12, 30, 0, time.UTC), span.EndTimestamp().AsTime())
+	assert.Equal(t, ptrace.StatusCodeError, span.Status().Code())
+	assert.Equal(t, map[string]interface{}{
+		"ci.pipeline.id":          int64(5587431522),
+		"ci.pipeline.name":        "build-and-test",
+		"ci.pipeline.url":         "https://github.com/octo-org/octo-repo/actions/runs/5587431522",
+		"ci.pipeline.trigger":     "push",
+		"ci.pipeline.run.number":  int64(412),
+		"ci.pipeline.run.attempt": int64(1),
+		"ci.conclusion":           "failure",
+		"vcs.ref":                 "main",
+		"vcs.revision":            "8f2d8b1c0bcbf84e2a1bd39d6f1c3d4f5e2b7a90",
+	}, span.Attributes().AsRaw())
+}
+
+func TestGitHubWorkflowJob(t *testing.T) {
+	body, err := os.ReadFile(filepath.Join("testdata", "github_workflow_job.json"))
+	require.NoError(t, err)
+
+	td, err := githubToTraces(githubEventWorkflowJob, body)
+	require.NoError(t, err)
+	// The skipped step is not converted.
+	require.Equal(t, 3, td.SpanCount())
+
+	spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	traceID := githubTraceID("octo-org/octo-repo", 5587431522, 1)
+
+	job := spans.At(0)
+	assert.Equal(t, "unit-tests", job.Name())
+	assert.Equal(t, traceID, job.TraceID())
+	assert.Equal(t, githubRunSpanID("octo-org/octo-repo", 5587431522, 1), job.ParentSpanID())
+	assert.Equal(t, ptrace.StatusCodeError, job.Status().Code())
+	labels, ok := job.Attributes().Get("ci.runner.labels")
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{"ubuntu-latest"}, labels.Slice().AsRaw())
+
+	for i, want := range []struct {
+		name   string
+		number int64
+		code   ptrace.StatusCode
+	}{
+		{name: "Set up job", number: 1, code: ptrace.StatusCodeOk},
+		{name: "Run tests", number: 2, code: ptrace.StatusCodeError},
+	} {
+		step := spans.At(i + 1)
+		assert.Equal(t, want.name, step.Name())
+		assert.Equal(t, traceID, step.TraceID())
+		assert.Equal(t, job.SpanID(), step.ParentSpanID())
+		assert.Equal(t, want.code, step.Status().Code())
+		number, ok := step.Attributes().Get("ci.step.number")
+		require.True(t, ok)
+		assert.Equal(t, want.number, number.Int())
+	}
+}
+
+func TestGitHubIgnoredDeliveries(t *testing.T) {
+	td, err := githubToTraces(githubEventWorkflowJob, []byte(`{"action": "in_progress"}`))
+	require.NoError(t, err)
+	assert.Equal(t, 0, td.SpanCount())
+
+	td, err = githubToTraces("push", []byte(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, 0, td.SpanCount())
+
+	_, err = githubToTraces(githubEventWorkflowRun, []byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestGitHubSignature(t *testing.T) {
+	body := []byte(`Hello, World!`)
+	// Example from the GitHub documentation on validating webhook deliveries.
+	signature := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+
+	assert.True(t, validGitHubSignature("It's a Secret to Everybody", signature, body))
+	assert.False(t, validGitHubSignature("another secret", signature, body))
+	assert.False(t, validGitHubSignature("It's a Secret to Everybody", "sha1=757107ea", body))
+	assert.False(t, validGitHubSignature("It's a Secret to Everybody", "sha256=zz", body))
+}
+
+func TestIDsAreStable(t *testing.T) {
+	assert.Equal(t, newTraceID("github", "a", 1), newTraceID("github", "a", 1))
+	assert.NotEqual(t, newTraceID("github", "a", 1), newTraceID("github", "a", 2))
+	assert.NotEqual(t, newSpanID("github", "a1"), newSpanID("github", "a", 1))
+	assert.NotEqual(t, pcommon.NewTraceIDEmpty(), newTraceID())
+}
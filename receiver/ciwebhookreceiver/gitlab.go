@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ciwebhookreceiver"
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	gitlabProvider = "gitlab"
+
+	gitlabEventPipeline = "Pipeline Hook"
+	gitlabEventJob      = "Job Hook"
+
+	// gitlabTimeLayout is the layout of the timestamps of pipeline events;
+	// job events use RFC 3339.
+	gitlabTimeLayout = "2006-01-02 15:04:05 MST"
+)
+
+// gitlabTime is a timestamp of a GitLab event, which may be null.
+type gitlabTime struct {
+	time.Time
+}
+
+func (t *gitlabTime) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var err error
+	for _, layout := range []string{time.RFC3339Nano, gitlabTimeLayout} {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, *s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return err
+}
+
+type gitlabProject struct {
+	ID                int64  `json:"id"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+}
+
+type gitlabPipelineEvent struct {
+	ObjectAttributes gitlabPipeline `json:"object_attributes"`
+	Project          gitlabProject  `json:"project"`
+}
+
+type gitlabPipeline struct {
+	ID         int64      `json:"id"`
+	IID        int64      `json:"iid"`
+	Name       string     `json:"name"`
+	Ref        string     `json:"ref"`
+	SHA        string     `json:"sha"`
+	Status     string     `json:"status"`
+	Source     string     `json:"source"`
+	URL        string     `json:"url"`
+	CreatedAt  gitlabTime `json:"created_at"`
+	FinishedAt gitlabTime `json:"finished_at"`
+}
+
+type gitlabJobEvent struct {
+	BuildID         int64         `json:"build_id"`
+	BuildName       string        `json:"build_name"`
+	BuildStage      string        `json:"build_stage"`
+	BuildStatus     string        `json:"build_status"`
+	BuildCreatedAt  gitlabTime    `json:"build_created_at"`
+	BuildStartedAt  gitlabTime    `json:"build_started_at"`
+	BuildFinishedAt gitlabTime    `json:"build_finished_at"`
+	PipelineID      int64         `json:"pipeline_id"`
+	Ref             string        `json:"ref"`
+	SHA             string        `json:"sha"`
+	Runner          *gitlabRunner `json:"runner"`
+	Project         gitlabProject `json:"project"`
+}
+
+type gitlabRunner struct {
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// gitlabToTraces converts a GitLab webhook delivery into traces. Only finished
+// pipelines and jobs are converted; other deliveries result in empty traces.
+func gitlabToTraces(event string, body []byte) (ptrace.Traces, error) {
+	switch event {
+	case gitlabEventPipeline:
+		var e gitlabPipelineEvent
+		if err := json.Unmarshal(body, &e); err != nil {
+			return ptrace.Traces{}, fmt.Errorf("failed to decode %s event: %w", event, err)
+		}
+		if !gitlabFinished(e.ObjectAttributes.Status) || e.ObjectAttributes.FinishedAt.IsZero() {
+			return ptrace.NewTraces(), nil
+		}
+		return gitlabPipelineToTraces(e), nil
+	case gitlabEventJob:
+		var e gitlabJobEvent
+		if err := json.Unmarshal(body, &e); err != nil {
+			return ptrace.Traces{}, fmt.Errorf("failed to decode %s event: %w", event, err)
+		}
+		if !gitlabFinished(e.BuildStatus) || e.BuildFinishedAt.IsZero() {
+			return ptrace.NewTraces(), nil
+		}
+		return gitlabJobToTraces(e), nil
+	default:
+		return ptrace.NewTraces(), nil
+	}
+}
+
+func gitlabFinished(status string) bool {
+	return status == "success" || status == "failed" || status == "canceled"
+}
+
+func gitlabTraceID(projectID, pipelineID int64) pcommon.TraceID {
+	return newTraceID(gitlabProvider, projectID, pipelineID)
+}
+
+func gitlabPipelineSpanID(projectID, pipelineID int64) pcommon.SpanID {
+	return newSpanID(gitlabProvider, projectID, pipelineID)
+}
+
+func gitlabPipelineToTraces(e gitlabPipelineEvent) ptrace.Traces {
+	pipeline := e.ObjectAttributes
+	name := pipeline.Name
+	if name == "" {
+		name = fmt.Sprintf("pipeline #%d", pipeline.IID)
+	}
+
+	td, spans := newTraces(gitlabProvider, e.Project.PathWithNamespace, e.Project.WebURL)
+	span := appendSpan(spans, name,
+		gitlabTraceID(e.Project.ID, pipeline.ID),
+		gitlabPipelineSpanID(e.Project.ID, pipeline.ID),
+		pcommon.NewSpanIDEmpty(),
+		pipeline.CreatedAt.Time, pipeline.FinishedAt.Time)
+	setGitLabStatus(span, pipeline.Status)
+
+	attrs := span.Attributes()
+	attrs.PutInt(attributeCIPipelineID, pipeline.ID)
+	putStr(attrs, attributeCIPipelineName, pipeline.Name)
+	putStr(attrs, attributeCIPipelineURL, pipeline.URL)
+	putStr(attrs, attributeCIPipelineTrigger, pipeline.Source)
+	attrs.PutInt(attributeCIPipelineRunNumber, pipeline.IID)
+	putStr(attrs, attributeVCSRef, pipeline.Ref)
+	putStr(attrs, attributeVCSRevision, pipeline.SHA)
+	return td
+}
+
+func gitlabJobToTraces(e gitlabJobEvent) ptrace.Traces {
+	start := e.BuildStartedAt.Time
+	if start.IsZero() {
+		// Jobs canceled before being picked up by a runner never start.
+		start = e.BuildCreatedAt.Time
+	}
+
+	td, spans := newTraces(gitlabProvider, e.Project.PathWithNamespace, e.Project.WebURL)
+	span := appendSpan(spans, e.BuildName,
+		gitlabTraceID(e.Project.ID, e.PipelineID),
+		newSpanID(gitlabProvider, e.Project.ID, e.PipelineID, e.BuildID),
+		gitlabPipelineSpanID(e.Project.ID, e.PipelineID),
+		start, e.BuildFinishedAt.Time)
+	setGitLabStatus(span, e.BuildStatus)
+
+	attrs := span.Attributes()
+	attrs.PutInt(attributeCIPipelineID, e.PipelineID)
+	attrs.PutInt(attributeCIJobID, e.BuildID)
+	putStr(attrs, attributeCIJobName, e.BuildName)
+	putStr(attrs, attributeCIJobStage, e.BuildStage)
+	if e.Project.WebURL != "" {
+		attrs.PutStr(attributeCIJobURL, fmt.Sprintf("%s/-/jobs/%d", e.Project.WebURL, e.BuildID))
+	}
+	if e.Runner != nil {
+		putStr(attrs, attributeCIRunnerName, e.Runner.Description)
+		putStrSlice(attrs, attributeCIRunnerLabels, e.Runner.Tags)
+	}
+	putStr(attrs, attributeVCSRef, e.Ref)
+	putStr(attrs, attributeVCSRevision, e.SHA)
+	return td
+}
+
+// setGitLabStatus sets the status of span from the status of a pipeline or job.
+func setGitLabStatus(span ptrace.Span, status string) {
+	putStr(span.Attributes(), attributeCIConclusion, status)
+	switch status {
+	case "success":
+		span.Status().SetCode(ptrace.StatusCodeOk)
+	case "failed":
+		span.Status().SetCode(ptrace.StatusCodeError)
+		span.Status().SetMessage(status)
+	}
+}
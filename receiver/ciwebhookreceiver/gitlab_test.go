@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestGitLabPipeline(t *testing.T) {
+	body, err := os.ReadFile(filepath.Join("testdata", "gitlab_pipeline.json"))
+	require.NoError(t, err)
+
+	td, err := gitlabToTraces(gitlabEventPipeline, body)
+	require.NoError(t, err)
+	require.Equal(t, 1, td.SpanCount())
+
+	rs := td.ResourceSpans().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"service.name":       "gitlab-org/gitlab-test",
+		"ci.provider":        "gitlab",
+		"vcs.repository.url": "https://gitlab.example.com/gitlab-org/gitlab-test",
+	}, rs.Resource().Attributes().AsRaw())
+
+	span := rs.ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "pipeline #3", span.Name())
+	assert.Equal(t, gitlabTraceID(1, 31), span.TraceID())
+	assert.Equal(t, gitlabPipelineSpanID(1, 31), span.SpanID())
+	assert.True(t, span.ParentSpanID().IsEmpty())
+	assert.Equal(t, time.Date(2023, 7, 18, 10, 0, 0, 0, time.UTC), span.StartTimestamp().AsTime().UTC())
+	assert.Equal(t, time.Date(2023, 7, 18, 10, 5, 0, 0, time.UTC), span.EndTimestamp().AsTime().UTC())
+	assert.Equal(t, ptrace.StatusCodeOk, span.Status().Code())
+	assert.Equal(t, map[string]interface{}{
+		"ci.pipeline.id":         int64(31),
+		"ci.pipeline.url":        "https://gitlab.example.com/gitlab-org/gitlab-test/-/pipelines/31",
+		"ci.pipeline.trigger":    "merge_request_event",
+		"ci.pipeline.run.number": int64(3),
+		"ci.conclusion":          "success",
+		"vcs.ref":                "main",
+		"vcs.revision":           "bcbb5ec396a2c0f828686f14fac9b80b780504f2",
+	}, span.Attributes().AsRaw())
+}
+
+func TestGitLabJob(t *testing.T) {
+	body, err := os.ReadFile(filepath.Join("testdata", "gitlab_job.json"))
+	require.NoError(t, err)
+
+	td, err := gitlabToTraces(gitlabEventJob, body)
+	require.NoError(t, err)
+	require.Equal(t, 1, td.SpanCount())
+
+	span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "test", span.Name())
+	assert.Equal(t, gitlabTraceID(1, 31), span.TraceID())
+	assert.Equal(t, gitlabPipelineSpanID(1, 31), span.ParentSpanID())
+	assert.Equal(t, time.Date(2023, 7, 18, 10, 0, 30, 0, time.UTC), span.StartTimestamp().AsTime().UTC())
+	assert.Equal(t, time.Date(2023, 7, 18, 10, 4, 0, 0, time.UTC), span.EndTimestamp().AsTime().UTC())
+	assert.Equal(t, ptrace.StatusCodeError, span.Status().Code())
+	assert.Equal(t, map[string]interface{}{
+		"ci.pipeline.id":   int64(31),
+		"ci.job.id":        int64(1977),
+		"ci.job.name":      "test",
+		"ci.job.stage":     "test",
+		"ci.job.url":       "https://gitlab.example.com/gitlab-org/gitlab-test/-/jobs/1977",
+		"ci.runner.name":   "shared-runners-manager-6.gitlab.com",
+		"ci.runner.labels": []interface{}{"linux", "docker"},
+		"ci.conclusion":    "failed",
+		"vcs.ref":          "main",
+		"vcs.revision":     "bcbb5ec396a2c0f828686f14fac9b80b780504f2",
+	}, span.Attributes().AsRaw())
+}
+
+func TestGitLabIgnoredDeliveries(t *testing.T) {
+	td, err := gitlabToTraces(gitlabEventJob, []byte(`{"build_status": "running", "build_finished_at": null}`))
+	require.NoError(t, err)
+	assert.Equal(t, 0, td.SpanCount())
+
+	td, err = gitlabToTraces("Push Hook", []byte(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, 0, td.SpanCount())
+
+	_, err = gitlabToTraces(gitlabEventPipeline, []byte(`{"object_attributes": {"created_at": "yesterday"}}`))
+	assert.Error(t, err)
+}
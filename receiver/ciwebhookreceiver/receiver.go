@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ciwebhookreceiver"
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ciwebhookreceiver/internal/metadata"
+)
+
+const (
+	// maxBodySize is the maximum size of a delivery, matching the limit GitHub puts on its payloads.
+	maxBodySize = 25 << 20
+
+	githubEventHeader     = "X-GitHub-Event"
+	githubSignatureHeader = "X-Hub-Signature-256"
+	gitlabEventHeader     = "X-Gitlab-Event"
+	gitlabTokenHeader     = "X-Gitlab-Token"
+)
+
+var (
+	errNilTracesConsumer = errors.New("missing a traces consumer")
+	errInvalidSignature  = errors.New("invalid webhook signature")
+	errInvalidToken      = errors.New("invalid webhook token")
+)
+
+type ciWebhookReceiver struct {
+	settings   receiver.CreateSettings
+	cfg        *Config
+	consumer   consumer.Traces
+	server     *http.Server
+	shutdownWG sync.WaitGroup
+	obsrecv    *obsreport.Receiver
+}
+
+func newTracesReceiver(params receiver.CreateSettings, cfg *Config, consumer consumer.Traces) (receiver.Traces, error) {
+	if consumer == nil {
+		return nil, errNilTracesConsumer
+	}
+
+	transport := "http"
+	if cfg.TLSSetting != nil {
+		transport = "https"
+	}
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             params.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ciWebhookReceiver{
+		settings: params,
+		cfg:      cfg,
+		consumer: consumer,
+		obsrecv:  obsrecv,
+	}, nil
+}
+
+func (r *ciWebhookReceiver) Start(_ context.Context, host component.Host) error {
+	ln, err := r.cfg.ToListener()
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(r.cfg.HealthPath, r.handleHealthCheck)
+	if r.cfg.GitHub != nil {
+		mux.HandleFunc(r.cfg.GitHub.Path, r.handleGitHub)
+	}
+	if r.cfg.GitLab != nil {
+		mux.HandleFunc(r.cfg.GitLab.Path, r.handleGitLab)
+	}
+
+	r.server, err = r.cfg.ToServer(host, r.settings.TelemetrySettings, mux)
+	if err != nil {
+		return err
+	}
+	if err = httpdecompressionextension.WrapServer(host, r.cfg.Decompression, r.server); err != nil {
+		return err
+	}
+
+	r.shutdownWG.Add(1)
+	go func() {
+		defer r.shutdownWG.Done()
+		if errHTTP := r.server.Serve(ln); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	return nil
+}
+
+func (r *ciWebhookReceiver) Shutdown(context.Context) error {
+	if r.server == nil {
+		return nil
+	}
+	err := r.server.Close()
+	r.shutdownWG.Wait()
+	return err
+}
+
+func (r *ciWebhookReceiver) handleHealthCheck(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *ciWebhookReceiver) handleGitHub(w http.ResponseWriter, req *http.Request) {
+	body, ok := r.readBody(w, req)
+	if !ok {
+		return
+	}
+	if secret := string(r.cfg.GitHub.Secret); secret != "" && !validGitHubSignature(secret, req.Header.Get(githubSignatureHeader), body) {
+		r.fail(w, http.StatusUnauthorized, errInvalidSignature)
+		return
+	}
+
+	event := req.Header.Get(githubEventHeader)
+	if event == githubEventPing {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	td, err := githubToTraces(event, body)
+	r.consume(req.Context(), w, event, td, err)
+}
+
+func (r *ciWebhookReceiver) handleGitLab(w http.ResponseWriter, req *http.Request) {
+	if token := string(r.cfg.GitLab.Token); token != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(req.Header.Get(gitlabTokenHeader))) != 1 {
+		r.fail(w, http.StatusUnauthorized, errInvalidToken)
+		return
+	}
+	body, ok := r.readBody(w, req)
+	if !ok {
+		return
+	}
+
+	event := req.Header.Get(gitlabEventHeader)
+	td, err := gitlabToTraces(event, body)
+	r.consume(req.Context(), w, event, td, err)
+}
+
+// readBody reads the body of a delivery, writing the error response if it cannot be read.
+func (r *ciWebhookReceiver) readBody(w http.ResponseWriter, req *http.Request) ([]byte, bool) {
+	if req.Method != http.MethodPost {
+		r.fail(w, http.StatusMethodNotAllowed, errors.New("invalid method, only POST is supported"))
+		return nil, false
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBodySize))
+	if err != nil {
+		r.fail(w, http.StatusBadRequest, err)
+		return nil, false
+	}
+	return body, true
+}
+
+func (r *ciWebhookReceiver) consume(ctx context.Context, w http.ResponseWriter, event string, td ptrace.Traces, err error) {
+	if err != nil {
+		r.fail(w, http.StatusBadRequest, err)
+		return
+	}
+	spanCount := td.SpanCount()
+	if spanCount == 0 {
+		r.settings.Logger.Debug("Ignoring webhook delivery without finished pipeline or job", zap.String("event", event))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	ctx = r.obsrecv.StartTracesOp(ctx)
+	err = r.consumer.ConsumeTraces(ctx, td)
+	r.obsrecv.EndTracesOp(ctx, metadata.Type, spanCount, err)
+	if err != nil {
+		r.fail(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *ciWebhookReceiver) fail(w http.ResponseWriter, statusCode int, err error) {
+	r.settings.Logger.Debug("Failed to handle webhook delivery", zap.Int("http_status_code", statusCode), zap.Error(err))
+	http.Error(w, err.Error(), statusCode)
+}
+
+// validGitHubSignature reports whether signature is the HMAC of body keyed with secret, as sent by GitHub.
+func validGitHubSignature(secret, signature string, body []byte) bool {
+	if !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	sum, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(sum, mac.Sum(nil))
+}
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func newTestReceiver(t *testing.T, next consumer.Traces) *ciWebhookReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.GitHub = &GitHubConfig{Path: defaultGitHubPath, Secret: "It's a Secret to Everybody"}
+	cfg.GitLab = &GitLabConfig{Path: defaultGitLabPath, Token: "gitlab-token"}
+	r, err := newTracesReceiver(receivertest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	return r.(*ciWebhookReceiver)
+}
+
+func githubSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandleGitHub(t *testing.T) {
+	body, err := os.ReadFile(filepath.Join("testdata", "github_workflow_run.json"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		event     string
+		body      []byte
+		signature bool
+		consumer  consumer.Traces
+		status    int
+		spans     int
+	}{
+		{
+			name:      "workflow run",
+			event:     githubEventWorkflowRun,
+			body:      body,
+			signature: true,
+			status:    http.StatusOK,
+			spans:     1,
+		},
+		{
+			name:   "missing signature",
+			event:  githubEventWorkflowRun,
+			body:   body,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:      "ping",
+			event:     githubEventPing,
+			body:      []byte(`{"zen": "Keep it logically awesome."}`),
+			signature: true,
+			status:    http.StatusOK,
+		},
+		{
+			name:      "malformed",
+			event:     githubEventWorkflowRun,
+			body:      []byte(`{`),
+			signature: true,
+			status:    http.StatusBadRequest,
+		},
+		{
+			name:      "consumer error",
+			event:     githubEventWorkflowRun,
+			body:      body,
+			signature: true,
+			consumer:  consumertest.NewErr(errors.New("consumer error")),
+			status:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := new(consumertest.TracesSink)
+			var next consumer.Traces = sink
+			if tt.consumer != nil {
+				next = tt.consumer
+			}
+			r := newTestReceiver(t, next)
+
+			req := httptest.NewRequest(http.MethodPost, defaultGitHubPath, bytes.NewReader(tt.body))
+			req.Header.Set(githubEventHeader, tt.event)
+			if tt.signature {
+				req.Header.Set(githubSignatureHeader, githubSignature(string(r.cfg.GitHub.Secret), tt.body))
+			}
+			w := httptest.NewRecorder()
+			r.handleGitHub(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Equal(t, tt.spans, sink.SpanCount())
+		})
+	}
+}
+
+func TestHandleGitLab(t *testing.T) {
+	body, err := os.ReadFile(filepath.Join("testdata", "gitlab_job.json"))
+	require.NoError(t, err)
+
+	sink := new(consumertest.TracesSink)
+	r := newTestReceiver(t, sink)
+
+	req := httptest.NewRequest(http.MethodPost, defaultGitLabPath, bytes.NewReader(body))
+	req.Header.Set(gitlabEventHeader, gitlabEventJob)
+	w := httptest.NewRecorder()
+	r.handleGitLab(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, 0, sink.SpanCount())
+
+	req = httptest.NewRequest(http.MethodPost, defaultGitLabPath, bytes.NewReader(body))
+	req.Header.Set(gitlabEventHeader, gitlabEventJob)
+	req.Header.Set(gitlabTokenHeader, "gitlab-token")
+	w = httptest.NewRecorder()
+	r.handleGitLab(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, sink.SpanCount())
+
+	req = httptest.NewRequest(http.MethodGet, defaultGitLabPath, nil)
+	req.Header.Set(gitlabTokenHeader, "gitlab-token")
+	w = httptest.NewRecorder()
+	r.handleGitLab(w, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestStartShutdown(t *testing.T) {
+	r := newTestReceiver(t, consumertest.NewNop())
+	r.cfg.Endpoint = "localhost:0"
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
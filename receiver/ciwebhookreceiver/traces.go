@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ciwebhookreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ciwebhookreceiver"
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ciwebhookreceiver/internal/metadata"
+)
+
+const (
+	scopeName = "otelcol/" + metadata.Type + "receiver"
+
+	attributeCIProvider          = "ci.provider"
+	attributeCIPipelineID        = "ci.pipeline.id"
+	attributeCIPipelineName      = "ci.pipeline.name"
+	attributeCIPipelineURL       = "ci.pipeline.url"
+	attributeCIPipelineTrigger   = "ci.pipeline.trigger"
+	attributeCIPipelineRunNumber = "ci.pipeline.run.number"
+	attributeCIPipelineAttempt   = "ci.pipeline.run.attempt"
+	attributeCIJobID             = "ci.job.id"
+	attributeCIJobName           = "ci.job.name"
+	attributeCIJobStage          = "ci.job.stage"
+	attributeCIJobURL            = "ci.job.url"
+	attributeCIStepName          = "ci.step.name"
+	attributeCIStepNumber        = "ci.step.number"
+	attributeCIRunnerName        = "ci.runner.name"
+	attributeCIRunnerLabels      = "ci.runner.labels"
+	attributeCIConclusion        = "ci.conclusion"
+	attributeVCSRepositoryURL    = "vcs.repository.url"
+	attributeVCSRef              = "vcs.ref"
+	attributeVCSRevision         = "vcs.revision"
+)
+
+// newTraceID derives a trace ID from the parts identifying a pipeline run, so that
+// the spans of all the events of a run share it regardless of their delivery order.
+func newTraceID(parts ...interface{}) pcommon.TraceID {
+	var id pcommon.TraceID
+	h := hashParts(parts)
+	copy(id[:], h[:])
+	return id
+}
+
+// newSpanID derives a span ID from the parts identifying a pipeline, job or step.
+func newSpanID(parts ...interface{}) pcommon.SpanID {
+	var id pcommon.SpanID
+	h := hashParts(parts)
+	copy(id[:], h[:])
+	return id
+}
+
+func hashParts(parts []interface{}) [sha256.Size]byte {
+	strs := make([]string, len(parts))
+	for i, p := range parts {
+		strs[i] = fmt.Sprint(p)
+	}
+	return sha256.Sum256([]byte(strings.Join(strs, "\x00")))
+}
+
+// newTraces creates the traces holding the spans of a single repository.
+func newTraces(provider, repository, repositoryURL string) (ptrace.Traces, ptrace.SpanSlice) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	attrs := rs.Resource().Attributes()
+	attrs.PutStr(conventions.AttributeServiceName, repository)
+	attrs.PutStr(attributeCIProvider, provider)
+	if repositoryURL != "" {
+		attrs.PutStr(attributeVCSRepositoryURL, repositoryURL)
+	}
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName(scopeName)
+	return td, ss.Spans()
+}
+
+// appendSpan appends a span to spans, with its identifiers and timestamps set.
+func appendSpan(spans ptrace.SpanSlice, name string, traceID pcommon.TraceID, spanID, parentID pcommon.SpanID, start, end time.Time) ptrace.Span {
+	span := spans.AppendEmpty()
+	span.SetName(name)
+	span.SetKind(ptrace.SpanKindInternal)
+	span.SetTraceID(traceID)
+	span.SetSpanID(spanID)
+	span.SetParentSpanID(parentID)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(end))
+	return span
+}
+
+// putStr sets the attribute key to value, unless value is empty.
+func putStr(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
+
+// putStrSlice sets the attribute key to values, unless values is empty.
+func putStrSlice(attrs pcommon.Map, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	slice := attrs.PutEmptySlice(key)
+	slice.EnsureCapacity(len(values))
+	for _, v := range values {
+		slice.AppendEmpty().SetStr(v)
+	}
+}
@@ -127,9 +127,18 @@ func TestLoadConfig(t *testing.T) {
 					},
 					Secret:         "1234567890abcdef1234567890abcdef",
 					TimestampField: "EdgeStartTimestamp",
+					// The configured attributes are merged with the default ones.
 					Attributes: map[string]string{
-						"ClientIP":         "http_request.client_ip",
-						"ClientRequestURI": "http_request.uri",
+						"ClientRequestMethod":    "http.method",
+						"ClientRequestScheme":    "http.scheme",
+						"ClientRequestHost":      "net.host.name",
+						"ClientRequestURI":       "http_request.uri",
+						"ClientRequestUserAgent": "http.user_agent",
+						"ClientRequestBytes":     "http.request_content_length",
+						"ClientIP":               "http_request.client_ip",
+						"ClientSrcPort":          "net.sock.peer.port",
+						"EdgeResponseStatus":     "http.status_code",
+						"EdgeResponseBytes":      "http.response_content_length",
 					},
 				},
 			},
@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver/internal/metadata"
 )
@@ -38,6 +39,23 @@ func createDefaultConfig() component.Config {
 		Logs: LogsConfig{
 			TimestampField: defaultTimestampField,
 			TLS:            &configtls.TLSServerSetting{},
+			Attributes:     defaultAttributes(),
 		},
 	}
 }
+
+// defaultAttributes maps the fields of the Cloudflare http_requests dataset to the HTTP semantic conventions.
+func defaultAttributes() map[string]string {
+	return map[string]string{
+		"ClientRequestMethod":    conventions.AttributeHTTPMethod,
+		"ClientRequestScheme":    conventions.AttributeHTTPScheme,
+		"ClientRequestHost":      conventions.AttributeNetHostName,
+		"ClientRequestURI":       conventions.AttributeHTTPTarget,
+		"ClientRequestUserAgent": conventions.AttributeHTTPUserAgent,
+		"ClientRequestBytes":     conventions.AttributeHTTPRequestContentLength,
+		"ClientIP":               conventions.AttributeHTTPClientIP,
+		"ClientSrcPort":          conventions.AttributeNetSockPeerPort,
+		"EdgeResponseStatus":     conventions.AttributeHTTPStatusCode,
+		"EdgeResponseBytes":      conventions.AttributeHTTPResponseContentLength,
+	}
+}
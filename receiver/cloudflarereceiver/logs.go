@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	rcvr "go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver/internal/metadata"
@@ -38,6 +40,15 @@ type logsReceiver struct {
 const secretHeaderName = "X-CF-Secret"
 const receiverScopeName = "otelcol/" + metadata.Type
 
+// intAttributes are the attributes the semantic conventions define as integers.
+// The JSON numbers and numeric strings mapped to them are converted.
+var intAttributes = map[string]bool{
+	conventions.AttributeHTTPStatusCode:            true,
+	conventions.AttributeNetSockPeerPort:           true,
+	conventions.AttributeHTTPRequestContentLength:  true,
+	conventions.AttributeHTTPResponseContentLength: true,
+}
+
 func newLogsReceiver(params rcvr.CreateSettings, cfg *Config, consumer consumer.Logs) (*logsReceiver, error) {
 	recv := &logsReceiver{
 		cfg:      &cfg.Logs,
@@ -117,7 +128,7 @@ func (l *logsReceiver) handleRequest(rw http.ResponseWriter, req *http.Request)
 			rw.WriteHeader(http.StatusUnauthorized)
 			l.logger.Debug("Got payload with no Secret when it was specified in config, dropping...")
 			return
-		} else if secretHeader != l.cfg.Secret {
+		} else if subtle.ConstantTimeCompare([]byte(secretHeader), []byte(l.cfg.Secret)) != 1 {
 			rw.WriteHeader(http.StatusUnauthorized)
 			l.logger.Debug("Got payload with invalid Secret, dropping...")
 			return
@@ -252,7 +263,13 @@ func (l *logsReceiver) processLogs(now pcommon.Timestamp, logs []map[string]inte
 
 			attrs := logRecord.Attributes()
 			for field, attribute := range l.cfg.Attributes {
-				if v, ok := log[field]; ok {
+				if v, ok := log[field]; ok && attribute != "" {
+					if intAttributes[attribute] {
+						if intV, ok := toInt(v); ok {
+							attrs.PutInt(attribute, intV)
+							continue
+						}
+					}
 					switch v := v.(type) {
 					case string:
 						attrs.PutStr(attribute, v)
@@ -280,6 +297,19 @@ func (l *logsReceiver) processLogs(now pcommon.Timestamp, logs []map[string]inte
 	return pLogs
 }
 
+// toInt converts a JSON number or a string holding an integer to an int64.
+func toInt(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case string:
+		intV, err := strconv.ParseInt(v, 10, 64)
+		return intV, err == nil
+	case float64:
+		return int64(v), v == float64(int64(v))
+	default:
+		return 0, false
+	}
+}
+
 // severityFromStatusCode translates HTTP status code to OpenTelemetry severity number.
 func severityFromStatusCode(statusCode int64) plog.SeverityNumber {
 	switch {
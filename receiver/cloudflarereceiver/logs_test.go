@@ -350,3 +350,36 @@ func newReceiver(t *testing.T, cfg *Config, nextConsumer consumer.Logs) *logsRec
 	require.NoError(t, err)
 	return r
 }
+
+func TestDefaultAttributes(t *testing.T) {
+	recv := newReceiver(t, &Config{
+		Logs: LogsConfig{
+			Endpoint:       "localhost:0",
+			TLS:            &configtls.TLSServerSetting{},
+			TimestampField: "EdgeStartTimestamp",
+			Attributes:     defaultAttributes(),
+		},
+	},
+		&consumertest.LogsSink{},
+	)
+
+	payload := `{"ClientIP":"47.35.104.49","ClientRequestBytes":2667,"ClientRequestHost":"www.theburritobot2.com","ClientRequestMethod":"GET","ClientRequestScheme":"https","ClientRequestURI":"/product/66VCHSJNUP","ClientRequestUserAgent":"curl/7.88.1","ClientSrcPort":"49358","EdgeResponseBytes":2301,"EdgeResponseStatus":401,"EdgeStartTimestamp":"2023-03-03T05:29:06Z"}`
+	rawLogs, err := parsePayload([]byte(payload))
+	require.NoError(t, err)
+	logs := recv.processLogs(pcommon.NewTimestampFromTime(time.Now()), rawLogs)
+	require.Equal(t, 1, logs.LogRecordCount())
+
+	attrs := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	require.Equal(t, map[string]interface{}{
+		"http.method":                  "GET",
+		"http.scheme":                  "https",
+		"net.host.name":                "www.theburritobot2.com",
+		"http.target":                  "/product/66VCHSJNUP",
+		"http.user_agent":              "curl/7.88.1",
+		"http.request_content_length":  int64(2667),
+		"http.client_ip":               "47.35.104.49",
+		"net.sock.peer.port":           int64(49358),
+		"http.status_code":             int64(401),
+		"http.response_content_length": int64(2301),
+	}, attrs.AsRaw())
+}
@@ -7,6 +7,7 @@ package datadogreceiver // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 )
 
@@ -14,4 +15,6 @@ type Config struct {
 	confighttp.HTTPServerSettings `mapstructure:",squash"`
 	// ReadTimeout of the http server
 	ReadTimeout time.Duration `mapstructure:"read_timeout"`
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies.
+	Decompression *component.ID `mapstructure:"decompression"`
 }
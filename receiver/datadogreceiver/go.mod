@@ -4,6 +4,7 @@ go 1.19
 
 require (
 	github.com/DataDog/datadog-agent/pkg/trace v0.47.0-rc.2
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension v0.81.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent v0.81.0
 	github.com/stretchr/testify v1.8.4
 	github.com/vmihailenco/msgpack/v4 v4.3.12
@@ -71,6 +72,8 @@ require (
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent => ../../internal/sharedcomponent
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension => ../../extension/httpdecompressionextension
+
 retract (
 	v0.76.2
 	v0.76.1
@@ -14,6 +14,8 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 )
 
 type datadogReceiver struct {
@@ -61,6 +63,9 @@ func (ddr *datadogReceiver) Start(_ context.Context, host component.Host) error
 	if err != nil {
 		return fmt.Errorf("failed to create server definition: %w", err)
 	}
+	if err = httpdecompressionextension.WrapServer(host, ddr.config.Decompression, ddr.server); err != nil {
+		return err
+	}
 	hln, err := ddr.config.HTTPServerSettings.ToListener()
 	if err != nil {
 		return fmt.Errorf("failed to create datadog listener: %w", err)
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dcgmreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dcgmreceiver"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Labels set by dcgm-exporter on the series of a device.
+const (
+	labelGPU           = "gpu"
+	labelUUID          = "UUID"
+	labelDevice        = "device"
+	labelModelName     = "modelName"
+	labelHostname      = "Hostname"
+	labelDriverVersion = "DCGM_FI_DRIVER_VERSION"
+	labelMIGProfile    = "GPU_I_PROFILE"
+	labelMIGInstanceID = "GPU_I_ID"
+	labelNamespace     = "namespace"
+	labelPod           = "pod"
+	labelContainer     = "container"
+	labelXID           = "xid"
+)
+
+// xidErrorsCountField is the field added by dcgm-exporter counting the XID
+// errors of a device by XID.
+const xidErrorsCountField = "DCGM_EXP_XID_ERRORS_COUNT"
+
+// device is a GPU, or a MIG instance of a GPU, and the last value of the DCGM
+// fields exported for it.
+type device struct {
+	uuid          string
+	index         string
+	name          string
+	model         string
+	hostname      string
+	driverVersion string
+	migInstanceID string
+	migProfile    string
+	namespace     string
+	pod           string
+	container     string
+
+	fields    map[string]float64
+	xidErrors map[string]float64
+}
+
+func newDevice(labels map[string]string) *device {
+	return &device{
+		uuid:          labels[labelUUID],
+		index:         labels[labelGPU],
+		name:          labels[labelDevice],
+		model:         labels[labelModelName],
+		hostname:      labels[labelHostname],
+		driverVersion: labels[labelDriverVersion],
+		migInstanceID: labels[labelMIGInstanceID],
+		migProfile:    labels[labelMIGProfile],
+		namespace:     labels[labelNamespace],
+		pod:           labels[labelPod],
+		container:     labels[labelContainer],
+		fields:        map[string]float64{},
+		xidErrors:     map[string]float64{},
+	}
+}
+
+// key identifies the device. A GPU shared by several pods is reported once
+// per pod by dcgm-exporter.
+func (d *device) key() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s", d.index, d.uuid, d.migInstanceID, d.namespace, d.pod, d.container)
+}
+
+// dcgmClient reads the metrics endpoint of dcgm-exporter.
+type dcgmClient struct {
+	client   *http.Client
+	endpoint string
+}
+
+// devices returns the devices reported by dcgm-exporter, in a stable order.
+func (c *dcgmClient) devices(ctx context.Context) ([]*device, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.FmtText))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("request to %s failed with status %d", c.endpoint, resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the metrics of dcgm-exporter: %w", err)
+	}
+	return parseDevices(families), nil
+}
+
+func parseDevices(families map[string]*dto.MetricFamily) []*device {
+	devices := map[string]*device{}
+	for name, family := range families {
+		for _, metric := range family.GetMetric() {
+			value, ok := metricValue(family.GetType(), metric)
+			if !ok {
+				continue
+			}
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			d := newDevice(labels)
+			if existing, found := devices[d.key()]; found {
+				d = existing
+			} else {
+				devices[d.key()] = d
+			}
+			if name == xidErrorsCountField {
+				d.xidErrors[labels[labelXID]] = value
+				continue
+			}
+			d.fields[name] = value
+		}
+	}
+
+	sorted := make([]*device, 0, len(devices))
+	for _, d := range devices {
+		sorted = append(sorted, d)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].key() < sorted[j].key()
+	})
+	return sorted
+}
+
+// metricValue returns the value of a sample, samples without a value are
+// reported as NaN.
+func metricValue(metricType dto.MetricType, metric *dto.Metric) (float64, bool) {
+	var value float64
+	switch metricType {
+	case dto.MetricType_GAUGE:
+		value = metric.GetGauge().GetValue()
+	case dto.MetricType_COUNTER:
+		value = metric.GetCounter().GetValue()
+	case dto.MetricType_UNTYPED:
+		value = metric.GetUntyped().GetValue()
+	default:
+		return 0, false
+	}
+	return value, !math.IsNaN(value)
+}
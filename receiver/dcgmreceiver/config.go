@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dcgmreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dcgmreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dcgmreceiver/internal/metadata"
+)
+
+var errMissingEndpoint = errors.New("endpoint must be specified")
+
+// Config defines the configuration for the DCGM receiver.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	// HTTPClientSettings configures the connection to the metrics endpoint of dcgm-exporter.
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	MetricsBuilderConfig          metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+// Validate checks if the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errMissingEndpoint
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint '%s': %w", cfg.Endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid endpoint '%s': scheme must be http or https", cfg.Endpoint)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid endpoint '%s': missing host", cfg.Endpoint)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dcgmreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dcgmreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	require.Equal(t, "http://dcgm-exporter.gpu-monitoring:9400/metrics", cfg.Endpoint)
+	require.Equal(t, time.Minute, cfg.CollectionInterval)
+	require.Equal(t, 5*time.Second, cfg.Timeout)
+	require.True(t, cfg.MetricsBuilderConfig.Metrics.DcgmGpuNvlinkErrors.Enabled)
+	require.True(t, cfg.MetricsBuilderConfig.ResourceAttributes.DcgmDriverVersion.Enabled)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		endpoint    string
+		expectedErr string
+	}{
+		{
+			desc:     "valid",
+			endpoint: defaultEndpoint,
+		},
+		{
+			desc:        "missing endpoint",
+			expectedErr: errMissingEndpoint.Error(),
+		},
+		{
+			desc:        "unparsable endpoint",
+			endpoint:    "http://localhost:port/metrics",
+			expectedErr: "invalid endpoint 'http://localhost:port/metrics'",
+		},
+		{
+			desc:        "invalid scheme",
+			endpoint:    "tcp://localhost:9400",
+			expectedErr: "invalid endpoint 'tcp://localhost:9400': scheme must be http or https",
+		},
+		{
+			desc:        "missing host",
+			endpoint:    "http:///metrics",
+			expectedErr: "invalid endpoint 'http:///metrics': missing host",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = tc.endpoint
+			err := component.ValidateConfig(cfg)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package dcgmreceiver scrapes the telemetry of NVIDIA GPUs collected by the
+// Data Center GPU Manager (DCGM) from the metrics endpoint of dcgm-exporter.
+package dcgmreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dcgmreceiver"
@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dcgmreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dcgmreceiver"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dcgmreceiver/internal/metadata"
+)
+
+// DCGM fields exported by dcgm-exporter, see
+// https://docs.nvidia.com/datacenter/dcgm/latest/dcgm-api/dcgm-api-field-ids.html
+const (
+	fieldGPUUtil              = "DCGM_FI_DEV_GPU_UTIL"
+	fieldMemCopyUtil          = "DCGM_FI_DEV_MEM_COPY_UTIL"
+	fieldSMClock              = "DCGM_FI_DEV_SM_CLOCK"
+	fieldMemClock             = "DCGM_FI_DEV_MEM_CLOCK"
+	fieldGPUTemp              = "DCGM_FI_DEV_GPU_TEMP"
+	fieldPowerUsage           = "DCGM_FI_DEV_POWER_USAGE"
+	fieldTotalEnergy          = "DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION"
+	fieldFBUsed               = "DCGM_FI_DEV_FB_USED"
+	fieldFBFree               = "DCGM_FI_DEV_FB_FREE"
+	fieldFBReserved           = "DCGM_FI_DEV_FB_RESERVED"
+	fieldXIDErrors            = "DCGM_FI_DEV_XID_ERRORS"
+	fieldNVLinkCRCFlitErrors  = "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL"
+	fieldNVLinkCRCDataErrors  = "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_TOTAL"
+	fieldNVLinkReplayErrors   = "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_TOTAL"
+	fieldNVLinkRecoveryErrors = "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_TOTAL"
+	fieldSMActive             = "DCGM_FI_PROF_SM_ACTIVE"
+	fieldSMOccupancy          = "DCGM_FI_PROF_SM_OCCUPANCY"
+	fieldTensorActive         = "DCGM_FI_PROF_PIPE_TENSOR_ACTIVE"
+	fieldDRAMActive           = "DCGM_FI_PROF_DRAM_ACTIVE"
+	fieldPCIeTxBytes          = "DCGM_FI_PROF_PCIE_TX_BYTES"
+	fieldPCIeRxBytes          = "DCGM_FI_PROF_PCIE_RX_BYTES"
+	fieldNVLinkTxBytes        = "DCGM_FI_PROF_NVLINK_TX_BYTES"
+	fieldNVLinkRxBytes        = "DCGM_FI_PROF_NVLINK_RX_BYTES"
+)
+
+const mebibyte = 1024 * 1024
+
+var errClientNotInit = errors.New("client not initialized")
+
+type dcgmScraper struct {
+	settings component.TelemetrySettings
+	cfg      *Config
+	client   *dcgmClient
+	mb       *metadata.MetricsBuilder
+}
+
+func newDCGMScraper(settings receiver.CreateSettings, cfg *Config) *dcgmScraper {
+	return &dcgmScraper{
+		settings: settings.TelemetrySettings,
+		cfg:      cfg,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+	}
+}
+
+func (s *dcgmScraper) start(_ context.Context, host component.Host) error {
+	httpClient, err := s.cfg.ToClient(host, s.settings)
+	if err != nil {
+		return err
+	}
+	s.client = &dcgmClient{client: httpClient, endpoint: s.cfg.Endpoint}
+	return nil
+}
+
+func (s *dcgmScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.client == nil {
+		return pmetric.NewMetrics(), errClientNotInit
+	}
+
+	devices, err := s.client.devices(ctx)
+	if err != nil {
+		s.settings.Logger.Error("failed to read the metrics of dcgm-exporter", zap.Error(err))
+		return pmetric.NewMetrics(), err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for _, d := range devices {
+		s.recordDevice(now, d)
+		s.mb.EmitForResource(resourceOptions(d)...)
+	}
+	return s.mb.Emit(), nil
+}
+
+func (s *dcgmScraper) recordDevice(now pcommon.Timestamp, d *device) {
+	if v, ok := d.fields[fieldGPUUtil]; ok {
+		s.mb.RecordDcgmGpuUtilizationDataPoint(now, v/100)
+	}
+	if v, ok := d.fields[fieldSMActive]; ok {
+		s.mb.RecordDcgmGpuSmActiveDataPoint(now, v)
+	}
+	if v, ok := d.fields[fieldSMOccupancy]; ok {
+		s.mb.RecordDcgmGpuSmOccupancyDataPoint(now, v)
+	}
+	if v, ok := d.fields[fieldTensorActive]; ok {
+		s.mb.RecordDcgmGpuTensorCoreActiveDataPoint(now, v)
+	}
+	// DRAM_ACTIVE is sampled by the profiling module and is more accurate than MEM_COPY_UTIL.
+	if v, ok := d.fields[fieldDRAMActive]; ok {
+		s.mb.RecordDcgmGpuMemoryBandwidthUtilizationDataPoint(now, v)
+	} else if copyUtil, found := d.fields[fieldMemCopyUtil]; found {
+		s.mb.RecordDcgmGpuMemoryBandwidthUtilizationDataPoint(now, copyUtil/100)
+	}
+
+	for field, state := range map[string]metadata.AttributeMemoryState{
+		fieldFBUsed:     metadata.AttributeMemoryStateUsed,
+		fieldFBFree:     metadata.AttributeMemoryStateFree,
+		fieldFBReserved: metadata.AttributeMemoryStateReserved,
+	} {
+		if v, ok := d.fields[field]; ok {
+			s.mb.RecordDcgmGpuMemoryUsageDataPoint(now, int64(v)*mebibyte, state)
+		}
+	}
+
+	if v, ok := d.fields[fieldNVLinkTxBytes]; ok {
+		s.mb.RecordDcgmGpuNvlinkThroughputDataPoint(now, v, metadata.AttributeDirectionTransmit)
+	}
+	if v, ok := d.fields[fieldNVLinkRxBytes]; ok {
+		s.mb.RecordDcgmGpuNvlinkThroughputDataPoint(now, v, metadata.AttributeDirectionReceive)
+	}
+	for field, errorType := range map[string]metadata.AttributeNvlinkErrorType{
+		fieldNVLinkCRCFlitErrors:  metadata.AttributeNvlinkErrorTypeCrcFlit,
+		fieldNVLinkCRCDataErrors:  metadata.AttributeNvlinkErrorTypeCrcData,
+		fieldNVLinkReplayErrors:   metadata.AttributeNvlinkErrorTypeReplay,
+		fieldNVLinkRecoveryErrors: metadata.AttributeNvlinkErrorTypeRecovery,
+	} {
+		if v, ok := d.fields[field]; ok {
+			s.mb.RecordDcgmGpuNvlinkErrorsDataPoint(now, int64(v), errorType)
+		}
+	}
+	if v, ok := d.fields[fieldPCIeTxBytes]; ok {
+		s.mb.RecordDcgmGpuPcieThroughputDataPoint(now, v, metadata.AttributeDirectionTransmit)
+	}
+	if v, ok := d.fields[fieldPCIeRxBytes]; ok {
+		s.mb.RecordDcgmGpuPcieThroughputDataPoint(now, v, metadata.AttributeDirectionReceive)
+	}
+
+	if v, ok := d.fields[fieldSMClock]; ok {
+		s.mb.RecordDcgmGpuClockFrequencyDataPoint(now, int64(v), metadata.AttributeClockSm)
+	}
+	if v, ok := d.fields[fieldMemClock]; ok {
+		s.mb.RecordDcgmGpuClockFrequencyDataPoint(now, int64(v), metadata.AttributeClockMemory)
+	}
+	if v, ok := d.fields[fieldGPUTemp]; ok {
+		s.mb.RecordDcgmGpuTemperatureDataPoint(now, v)
+	}
+	if v, ok := d.fields[fieldPowerUsage]; ok {
+		s.mb.RecordDcgmGpuPowerUsageDataPoint(now, v)
+	}
+	// the energy is reported in millijoules
+	if v, ok := d.fields[fieldTotalEnergy]; ok {
+		s.mb.RecordDcgmGpuEnergyConsumptionDataPoint(now, v/1000)
+	}
+
+	if v, ok := d.fields[fieldXIDErrors]; ok {
+		s.mb.RecordDcgmGpuXidErrorLastDataPoint(now, int64(v))
+	}
+	for xid, count := range d.xidErrors {
+		s.mb.RecordDcgmGpuXidErrorCountDataPoint(now, int64(count), xid)
+	}
+}
+
+// resourceOptions identifies the device. The MIG and pod attributes are only
+// set for MIG instances and GPUs allocated to pods respectively.
+func resourceOptions(d *device) []metadata.ResourceMetricsOption {
+	options := []metadata.ResourceMetricsOption{
+		metadata.WithDcgmGpuUUID(d.uuid),
+		metadata.WithDcgmGpuIndex(d.index),
+		metadata.WithDcgmGpuModel(d.model),
+		metadata.WithDcgmGpuDevice(d.name),
+		metadata.WithDcgmDriverVersion(d.driverVersion),
+		metadata.WithHostName(d.hostname),
+	}
+	if d.migInstanceID != "" {
+		options = append(options,
+			metadata.WithDcgmGpuMigInstanceID(d.migInstanceID),
+			metadata.WithDcgmGpuMigProfile(d.migProfile),
+		)
+	}
+	if d.pod != "" {
+		options = append(options,
+			metadata.WithK8sNamespaceName(d.namespace),
+			metadata.WithK8sPodName(d.pod),
+			metadata.WithK8sContainerName(d.container),
+		)
+	}
+	return options
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dcgmreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+func newMockServer(t *testing.T, file string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/metrics" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", "scraper", file))
+		require.NoError(t, err)
+		rw.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, err = rw.Write(body)
+		require.NoError(t, err)
+	}))
+}
+
+func newTestScraper(t *testing.T, cfg *Config, endpoint string) *dcgmScraper {
+	cfg.Endpoint = endpoint
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newDCGMScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	return scraper
+}
+
+func TestScraper(t *testing.T) {
+	server := newMockServer(t, "metrics.txt")
+	defer server.Close()
+
+	scraper := newTestScraper(t, createDefaultConfig().(*Config), server.URL+"/metrics")
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreResourceMetricsOrder(), pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
+
+func TestScraperOptionalResourceAttributes(t *testing.T) {
+	server := newMockServer(t, "metrics.txt")
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.ResourceAttributes.DcgmGpuDevice.Enabled = true
+	cfg.MetricsBuilderConfig.ResourceAttributes.DcgmDriverVersion.Enabled = true
+	scraper := newTestScraper(t, cfg, server.URL+"/metrics")
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, 4, actualMetrics.ResourceMetrics().Len())
+	for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+		attrs := actualMetrics.ResourceMetrics().At(i).Resource().Attributes()
+		driverVersion, ok := attrs.Get("dcgm.driver.version")
+		require.True(t, ok)
+		require.Equal(t, "535.104.05", driverVersion.Str())
+		_, ok = attrs.Get("dcgm.gpu.device")
+		require.True(t, ok)
+	}
+}
+
+func TestScraperErrorStatus(t *testing.T) {
+	server := newMockServer(t, "metrics.txt")
+	defer server.Close()
+
+	scraper := newTestScraper(t, createDefaultConfig().(*Config), server.URL+"/dcgm")
+	_, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "request to "+server.URL+"/dcgm failed with status 404")
+}
+
+func TestScraperInvalidMetrics(t *testing.T) {
+	server := newMockServer(t, "invalid.txt")
+	defer server.Close()
+
+	scraper := newTestScraper(t, createDefaultConfig().(*Config), server.URL+"/metrics")
+	_, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, "failed to parse the metrics of dcgm-exporter")
+}
+
+func TestScraperNotStarted(t *testing.T) {
+	scraper := newDCGMScraper(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config))
+	_, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInit)
+}
+
+func TestScraperFailedStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "https://localhost:9400/metrics"
+	cfg.TLSSetting = configtls.TLSClientSetting{
+		TLSSetting: configtls.TLSSetting{
+			CAFile: "/non/existent",
+		},
+	}
+	scraper := newDCGMScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.Error(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+}
@@ -42,6 +42,17 @@ type Config struct {
 	// A list of filters whose matching images are to be excluded.  Supports literals, globs, and regex.
 	ExcludedImages []string `mapstructure:"excluded_images"`
 
+	// A mapping of container label names to the filters their values must match for the containers
+	// to be monitored.  E.g. `com.example.monitoring: enabled`.  Supports literals, globs, and regex.
+	IncludedLabels map[string]string `mapstructure:"included_labels"`
+
+	// A mapping of container label names to filters whose matching containers are to be excluded.
+	// Supports literals, globs, and regex.
+	ExcludedLabels map[string]string `mapstructure:"excluded_labels"`
+
+	// The container events reported as logs by the logs receiver.  Default is start, stop, oom and die.
+	Events []string `mapstructure:"events"`
+
 	// Docker client API version. Default is 1.22
 	DockerAPIVersion float64 `mapstructure:"api_version"`
 
@@ -59,5 +70,10 @@ func (config Config) Validate() error {
 	if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
 		return fmt.Errorf("api_version must be at least %v", minimalRequiredDockerAPIVersion)
 	}
+	for _, event := range config.Events {
+		if event == "" {
+			return errors.New("events must not contain empty event names")
+		}
+	}
 	return nil
 }
@@ -46,6 +46,13 @@ func TestLoadConfig(t *testing.T) {
 					"undesired-container",
 					"another-*-container",
 				},
+				IncludedLabels: map[string]string{
+					"com.example.monitoring": "enabled",
+				},
+				ExcludedLabels: map[string]string{
+					"com.example.team": "/^sandbox-.*/",
+				},
+				Events: []string{"start", "die", "health_status"},
 
 				ContainerLabelsToMetricLabels: map[string]string{
 					"my.container.label":       "my-metric-label",
@@ -99,4 +106,7 @@ func TestValidateErrors(t *testing.T) {
 
 	cfg = &Config{ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: 1 * time.Second}, Endpoint: "someEndpoint", DockerAPIVersion: 1.21}
 	assert.Equal(t, "api_version must be at least 1.22", component.ValidateConfig(cfg).Error())
+
+	cfg = &Config{ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: 1 * time.Second}, Endpoint: "someEndpoint", DockerAPIVersion: 1.22, Events: []string{"start", ""}}
+	assert.Equal(t, "events must not contain empty event names", component.ValidateConfig(cfg).Error())
 }
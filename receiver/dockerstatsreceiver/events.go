@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	devents "github.com/docker/docker/api/types/events"
+	dfilters "github.com/docker/docker/api/types/filters"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	rcvr "go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+)
+
+const (
+	eventsScopeName = "otelcol/dockerstatsreceiver"
+
+	attributeEventDomain   = "event.domain"
+	attributeEventName     = "event.name"
+	attributeExitCode      = "container.exit_code"
+	eventDomain            = "docker"
+	eventsRetryDelay       = 3 * time.Second
+	actorAttributeImage    = "image"
+	actorAttributeName     = "name"
+	actorAttributeExitCode = "exitCode"
+)
+
+// eventsReceiver reports the lifecycle events of the containers as logs.
+type eventsReceiver struct {
+	config   *Config
+	settings rcvr.CreateSettings
+	consumer consumer.Logs
+	client   *docker.Client
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func newEventsReceiver(set rcvr.CreateSettings, config *Config, consumer consumer.Logs) *eventsReceiver {
+	return &eventsReceiver{
+		config:   config,
+		settings: set,
+		consumer: consumer,
+	}
+}
+
+func (r *eventsReceiver) Start(_ context.Context, _ component.Host) error {
+	var err error
+	r.client, err = newDockerClient(r.config, r.settings.Logger)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	go r.eventLoop(ctx)
+	return nil
+}
+
+func (r *eventsReceiver) Shutdown(context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	<-r.done
+	return nil
+}
+
+// eventLoop watches the events of the containers until the context is canceled,
+// resuming after the last received event when the connection to the daemon is lost.
+func (r *eventsReceiver) eventLoop(ctx context.Context) {
+	defer close(r.done)
+
+	args := []dfilters.KeyValuePair{{Key: "type", Value: "container"}}
+	for _, event := range r.config.Events {
+		args = append(args, dfilters.KeyValuePair{Key: "event", Value: event})
+	}
+	filters := dfilters.NewArgs(args...)
+	lastTime := time.Now()
+
+	for {
+		options := dtypes.EventsOptions{
+			Filters: filters,
+			Since:   lastTime.Format(time.RFC3339Nano),
+		}
+		eventCh, errCh := r.client.Events(ctx, options)
+
+	READ:
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventCh:
+				r.consumeEvent(ctx, event)
+				if event.TimeNano > lastTime.UnixNano() {
+					lastTime = time.Unix(0, event.TimeNano)
+				}
+			case err := <-errCh:
+				if ctx.Err() != nil {
+					return
+				}
+				r.settings.Logger.Error("Error watching docker container events", zap.Error(err))
+				break READ
+			}
+		}
+
+		select {
+		case <-time.After(eventsRetryDelay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *eventsReceiver) consumeEvent(ctx context.Context, event devents.Message) {
+	attrs := event.Actor.Attributes
+	if r.client.ShouldBeExcluded(attrs[actorAttributeImage], attrs) {
+		return
+	}
+
+	if err := r.consumer.ConsumeLogs(ctx, r.eventToLogs(event)); err != nil {
+		r.settings.Logger.Error("Failed to consume docker container event", zap.String("id", event.Actor.ID), zap.Error(err))
+	}
+}
+
+func (r *eventsReceiver) eventToLogs(event devents.Message) plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	attrs := event.Actor.Attributes
+
+	resource := rl.Resource().Attributes()
+	resource.PutStr("container.runtime", "docker")
+	resource.PutStr("container.id", event.Actor.ID)
+	if name := attrs[actorAttributeName]; name != "" {
+		resource.PutStr("container.name", name)
+	}
+	if image := attrs[actorAttributeImage]; image != "" {
+		resource.PutStr("container.image.name", image)
+	}
+	// the attributes of the events hold the labels of the containers
+	for k, label := range r.config.ContainerLabelsToMetricLabels {
+		if v := attrs[k]; v != "" {
+			resource.PutStr(label, v)
+		}
+	}
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(eventsScopeName)
+	record := sl.LogRecords().AppendEmpty()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, event.TimeNano)))
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	record.Body().SetStr(event.Action)
+
+	// the action of some events holds details after a colon, e.g. health_status: healthy
+	name := strings.SplitN(event.Action, ":", 2)[0]
+	record.Attributes().PutStr(attributeEventDomain, eventDomain)
+	record.Attributes().PutStr(attributeEventName, "container."+name)
+
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+	switch name {
+	case "oom":
+		record.SetSeverityNumber(plog.SeverityNumberError)
+	case "die":
+		if exitCode, err := strconv.ParseInt(attrs[actorAttributeExitCode], 10, 64); err == nil {
+			record.Attributes().PutInt(attributeExitCode, exitCode)
+			if exitCode != 0 {
+				record.SetSeverityNumber(plog.SeverityNumberWarn)
+			}
+		}
+	}
+	record.SetSeverityText(record.SeverityNumber().String())
+	return logs
+}
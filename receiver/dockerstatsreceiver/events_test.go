@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+// +build !windows
+
+package dockerstatsreceiver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	devents "github.com/docker/docker/api/types/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestEventToLogs(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ContainerLabelsToMetricLabels = map[string]string{"container.label": "container-metric-label"}
+	r := newEventsReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+
+	logs := r.eventToLogs(devents.Message{
+		Action: "die",
+		Actor: devents.Actor{
+			ID: "89d28931fd8b",
+			Attributes: map[string]string{
+				"image":           "nginx:1.25",
+				"name":            "web",
+				"exitCode":        "137",
+				"container.label": "frontend",
+			},
+		},
+		TimeNano: 1685620803000000000,
+	})
+
+	rl := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"container.runtime":      "docker",
+		"container.id":           "89d28931fd8b",
+		"container.name":         "web",
+		"container.image.name":   "nginx:1.25",
+		"container-metric-label": "frontend",
+	}, rl.Resource().Attributes().AsRaw())
+
+	record := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "die", record.Body().Str())
+	assert.Equal(t, time.Unix(0, 1685620803000000000).UTC(), record.Timestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberWarn, record.SeverityNumber())
+	assert.Equal(t, "Warn", record.SeverityText())
+	assert.Equal(t, map[string]interface{}{
+		"event.domain":        "docker",
+		"event.name":          "container.die",
+		"container.exit_code": int64(137),
+	}, record.Attributes().AsRaw())
+}
+
+func TestEventToLogsSeverity(t *testing.T) {
+	r := newEventsReceiver(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config), consumertest.NewNop())
+
+	testCases := []struct {
+		action   string
+		exitCode string
+		name     string
+		severity plog.SeverityNumber
+	}{
+		{action: "start", name: "container.start", severity: plog.SeverityNumberInfo},
+		{action: "die", exitCode: "0", name: "container.die", severity: plog.SeverityNumberInfo},
+		{action: "oom", name: "container.oom", severity: plog.SeverityNumberError},
+		{action: "health_status: unhealthy", name: "container.health_status", severity: plog.SeverityNumberInfo},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.action, func(t *testing.T) {
+			logs := r.eventToLogs(devents.Message{
+				Action: tc.action,
+				Actor:  devents.Actor{ID: "89d28931fd8b", Attributes: map[string]string{"exitCode": tc.exitCode}},
+			})
+			record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			name, ok := record.Attributes().Get("event.name")
+			require.True(t, ok)
+			assert.Equal(t, tc.name, name.Str())
+			assert.Equal(t, tc.severity, record.SeverityNumber())
+		})
+	}
+}
+
+func TestEventsReceiver(t *testing.T) {
+	mockDockerEngine, err := dockerMockServer(&map[string]string{
+		"/v1.23/events": filepath.Join(mockFolder, "events", "events.json"),
+	})
+	require.NoError(t, err)
+	defer mockDockerEngine.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = mockDockerEngine.URL
+	cfg.ExcludedLabels = map[string]string{"team": "sandbox"}
+	sink := new(consumertest.LogsSink)
+	r := newEventsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 3 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	var names []string
+	for _, logs := range sink.AllLogs() {
+		record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+		name, _ := record.Attributes().Get("event.name")
+		names = append(names, name.Str())
+	}
+	// the event of the excluded container is dropped
+	assert.Equal(t, []string{"container.start", "container.oom", "container.die"}, names)
+}
+
+func TestEventsReceiverShutdownWithoutStart(t *testing.T) {
+	r := newEventsReceiver(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config), consumertest.NewNop())
+	require.NoError(t, r.Shutdown(context.Background()))
+}
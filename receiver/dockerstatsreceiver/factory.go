@@ -28,7 +28,8 @@ func NewFactory() rcvr.Factory {
 	return rcvr.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		rcvr.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		rcvr.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		rcvr.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -39,6 +40,7 @@ func createDefaultConfig() component.Config {
 		Endpoint:                  "unix:///var/run/docker.sock",
 		Timeout:                   5 * time.Second,
 		DockerAPIVersion:          defaultDockerAPIVersion,
+		Events:                    []string{"start", "stop", "oom", "die"},
 		MetricsBuilderConfig:      metadata.DefaultMetricsBuilderConfig(),
 	}
 }
@@ -59,3 +61,12 @@ func createMetricsReceiver(
 
 	return scraperhelper.NewScraperControllerReceiver(&dsr.config.ScraperControllerSettings, params, consumer, scraperhelper.AddScraper(scrp))
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params rcvr.CreateSettings,
+	config component.Config,
+	consumer consumer.Logs,
+) (rcvr.Logs, error) {
+	return newEventsReceiver(params, config.(*Config), consumer), nil
+}
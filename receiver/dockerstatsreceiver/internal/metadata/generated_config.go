@@ -43,6 +43,7 @@ type MetricsConfig struct {
 	ContainerCPUUsageTotal                     MetricConfig `mapstructure:"container.cpu.usage.total"`
 	ContainerCPUUsageUsermode                  MetricConfig `mapstructure:"container.cpu.usage.usermode"`
 	ContainerCPUUtilization                    MetricConfig `mapstructure:"container.cpu.utilization"`
+	ContainerHealthStatus                      MetricConfig `mapstructure:"container.health.status"`
 	ContainerMemoryActiveAnon                  MetricConfig `mapstructure:"container.memory.active_anon"`
 	ContainerMemoryActiveFile                  MetricConfig `mapstructure:"container.memory.active_file"`
 	ContainerMemoryAnon                        MetricConfig `mapstructure:"container.memory.anon"`
@@ -150,6 +151,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerCPUUtilization: MetricConfig{
 			Enabled: false,
 		},
+		ContainerHealthStatus: MetricConfig{
+			Enabled: true,
+		},
 		ContainerMemoryActiveAnon: MetricConfig{
 			Enabled: false,
 		},
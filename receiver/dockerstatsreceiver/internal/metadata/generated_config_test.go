@@ -44,6 +44,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerCPUUsageTotal:                     MetricConfig{Enabled: true},
 					ContainerCPUUsageUsermode:                  MetricConfig{Enabled: true},
 					ContainerCPUUtilization:                    MetricConfig{Enabled: true},
+					ContainerHealthStatus:                      MetricConfig{Enabled: true},
 					ContainerMemoryActiveAnon:                  MetricConfig{Enabled: true},
 					ContainerMemoryActiveFile:                  MetricConfig{Enabled: true},
 					ContainerMemoryAnon:                        MetricConfig{Enabled: true},
@@ -127,6 +128,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerCPUUsageTotal:                     MetricConfig{Enabled: false},
 					ContainerCPUUsageUsermode:                  MetricConfig{Enabled: false},
 					ContainerCPUUtilization:                    MetricConfig{Enabled: false},
+					ContainerHealthStatus:                      MetricConfig{Enabled: false},
 					ContainerMemoryActiveAnon:                  MetricConfig{Enabled: false},
 					ContainerMemoryActiveFile:                  MetricConfig{Enabled: false},
 					ContainerMemoryAnon:                        MetricConfig{Enabled: false},
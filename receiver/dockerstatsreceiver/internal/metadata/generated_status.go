@@ -9,4 +9,5 @@ import (
 const (
 	Type             = "docker_stats"
 	MetricsStability = component.StabilityLevelAlpha
+	LogsStability    = component.StabilityLevelDevelopment
 )
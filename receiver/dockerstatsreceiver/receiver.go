@@ -19,6 +19,7 @@ import (
 	rcvr "go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
@@ -52,13 +53,21 @@ func newReceiver(set rcvr.CreateSettings, config *Config) *receiver {
 	}
 }
 
-func (r *receiver) start(ctx context.Context, _ component.Host) error {
-	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
+// newDockerClient creates a client of the docker daemon monitoring the containers selected by the config.
+func newDockerClient(config *Config, logger *zap.Logger) (*docker.Client, error) {
+	dConfig, err := docker.NewConfig(config.Endpoint, config.Timeout, config.ExcludedImages, config.DockerAPIVersion)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	dConfig.IncludedLabels = config.IncludedLabels
+	dConfig.ExcludedLabels = config.ExcludedLabels
+
+	return docker.NewDockerClient(dConfig, logger)
+}
 
-	r.client, err = docker.NewDockerClient(dConfig, r.settings.Logger)
+func (r *receiver) start(ctx context.Context, _ component.Host) error {
+	var err error
+	r.client, err = newDockerClient(r.config, r.settings.Logger)
 	if err != nil {
 		return err
 	}
@@ -120,6 +129,7 @@ func (r *receiver) recordContainerStats(now pcommon.Timestamp, containerStats *d
 	r.recordBlkioMetrics(now, &containerStats.BlkioStats)
 	r.recordNetworkMetrics(now, &containerStats.Networks)
 	r.recordPidsMetrics(now, &containerStats.PidsStats)
+	r.recordHealthMetrics(now, container.State)
 	if err := r.recordBaseMetrics(now, container.ContainerJSONBase); err != nil {
 		errs = multierr.Append(errs, err)
 	}
@@ -290,3 +300,21 @@ func (r *receiver) recordBaseMetrics(now pcommon.Timestamp, base *types.Containe
 	}
 	return nil
 }
+
+func (r *receiver) recordHealthMetrics(now pcommon.Timestamp, state *types.ContainerState) {
+	// the health is only reported for the containers with a health check.
+	if state == nil || state.Health == nil {
+		return
+	}
+	current, ok := metadata.MapAttributeHealthStatus[state.Health.Status]
+	if !ok {
+		return
+	}
+	for _, status := range metadata.MapAttributeHealthStatus {
+		var val int64
+		if status == current {
+			val = 1
+		}
+		r.mb.RecordContainerHealthStatusDataPoint(now, val, status)
+	}
+}
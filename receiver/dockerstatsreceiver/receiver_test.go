@@ -52,6 +52,7 @@ var (
 		ContainerCPUUsageSystem:                    metricEnabled,
 		ContainerCPUUsageTotal:                     metricEnabled,
 		ContainerCPUUsageUsermode:                  metricEnabled,
+		ContainerHealthStatus:                      metricEnabled,
 		ContainerMemoryActiveAnon:                  metricEnabled,
 		ContainerMemoryActiveFile:                  metricEnabled,
 		ContainerMemoryCache:                       metricEnabled,
@@ -343,6 +344,95 @@ func TestRecordBaseMetrics(t *testing.T) {
 	})
 }
 
+func TestRecordHealthMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{
+		ContainerHealthStatus: metricEnabled,
+	}
+	r := newReceiver(receivertest.NewNopCreateSettings(), cfg)
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	t.Run("healthy", func(t *testing.T) {
+		r.recordHealthMetrics(now, &types.ContainerState{Health: &types.Health{Status: types.Healthy}})
+		m := r.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+		assert.Equal(t, "container.health.status", m.Name())
+		dps := m.Gauge().DataPoints()
+		require.Equal(t, 3, dps.Len())
+		values := map[string]int64{}
+		for i := 0; i < dps.Len(); i++ {
+			status, ok := dps.At(i).Attributes().Get("health_status")
+			require.True(t, ok)
+			values[status.Str()] = dps.At(i).IntValue()
+		}
+		assert.Equal(t, map[string]int64{"starting": 0, "healthy": 1, "unhealthy": 0}, values)
+	})
+
+	t.Run("no health check", func(t *testing.T) {
+		r.recordHealthMetrics(now, &types.ContainerState{})
+		r.recordHealthMetrics(now, &types.ContainerState{Health: &types.Health{Status: types.NoHealthcheck}})
+		assert.Equal(t, 0, r.mb.Emit().ResourceMetrics().Len())
+	})
+}
+
+func TestScrapeV2LabelFilters(t *testing.T) {
+	containerIDs := []string{
+		"89d28931fd8b95c8806343a532e9e76bf0a0b76ee8f19452b8f75dee1ebcebb7",
+		"a359c0fc87c546b42d2ad32db7c978627f1d89b49cb3827a7b19ba97a1febcce",
+	}
+	mockDockerEngine, err := dockerMockServer(&map[string]string{
+		"/v1.23/containers/json":                          filepath.Join(mockFolder, "two_containers", "containers.json"),
+		"/v1.23/containers/" + containerIDs[0] + "/json":  filepath.Join(mockFolder, "two_containers", "container1.json"),
+		"/v1.23/containers/" + containerIDs[1] + "/json":  filepath.Join(mockFolder, "two_containers", "container2.json"),
+		"/v1.23/containers/" + containerIDs[0] + "/stats": filepath.Join(mockFolder, "two_containers", "stats1.json"),
+		"/v1.23/containers/" + containerIDs[1] + "/stats": filepath.Join(mockFolder, "two_containers", "stats2.json"),
+	})
+	require.NoError(t, err)
+	defer mockDockerEngine.Close()
+
+	testCases := []struct {
+		desc           string
+		includedLabels map[string]string
+		excludedLabels map[string]string
+		expectedNames  []string
+	}{
+		{
+			desc:           "included",
+			includedLabels: map[string]string{"container.label": "container-label"},
+			expectedNames:  []string{"pensive_aryabhata"},
+		},
+		{
+			desc:           "excluded",
+			excludedLabels: map[string]string{"container.label": "*2"},
+			expectedNames:  []string{"pensive_aryabhata"},
+		},
+		{
+			desc:           "missing label",
+			includedLabels: map[string]string{"other.label": "*"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := newTestConfigBuilder().withEndpoint(mockDockerEngine.URL).build()
+			cfg.IncludedLabels = tc.includedLabels
+			cfg.ExcludedLabels = tc.excludedLabels
+			receiver := newReceiver(receivertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, receiver.start(context.Background(), componenttest.NewNopHost()))
+
+			actualMetrics, err := receiver.scrapeV2(context.Background())
+			require.NoError(t, err)
+
+			var names []string
+			for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+				name, ok := actualMetrics.ResourceMetrics().At(i).Resource().Attributes().Get("container.name")
+				require.True(t, ok)
+				names = append(names, name.Str())
+			}
+			assert.Equal(t, tc.expectedNames, names)
+		})
+	}
+}
+
 func dockerMockServer(urlToFile *map[string]string) (*httptest.Server, error) {
 	urlToFileContents := make(map[string][]byte, len(*urlToFile))
 	for urlPath, filePath := range *urlToFile {
@@ -26,6 +26,18 @@ type Collector struct {
 	obsrecv      *obsreport.Receiver
 }
 
+// ackEvent is an event with the `chunk` option, which is acknowledged once its
+// log records were consumed so that clients can resend the events which were
+// not.
+type ackEvent struct {
+	Event
+	done chan error
+}
+
+func newAckEvent(e Event) *ackEvent {
+	return &ackEvent{Event: e, done: make(chan error, 1)}
+}
+
 func newCollector(eventCh <-chan Event, next consumer.Logs, logger *zap.Logger, obsrecv *obsreport.Receiver) *Collector {
 	return &Collector{
 		nextConsumer: next,
@@ -48,27 +60,41 @@ func (c *Collector) processEvents(ctx context.Context) {
 			out := plog.NewLogs()
 			rls := out.ResourceLogs().AppendEmpty()
 			logSlice := rls.ScopeLogs().AppendEmpty().LogRecords()
-			e.LogRecords().MoveAndAppendTo(logSlice)
+			acks := appendEvent(logSlice, e, nil)
 
 			// Pull out anything waiting on the eventCh to get better
 			// efficiency on LogResource allocations.
-			c.fillBufferUntilChanEmpty(logSlice)
+			acks = c.fillBufferUntilChanEmpty(logSlice, acks)
 
 			stats.Record(context.Background(), observ.RecordsGenerated.M(int64(out.LogRecordCount())))
 			obsCtx := c.obsrecv.StartLogsOp(ctx)
 			err := c.nextConsumer.ConsumeLogs(obsCtx, out)
 			c.obsrecv.EndLogsOp(obsCtx, "fluent", out.LogRecordCount(), err)
+
+			for _, a := range acks {
+				a.done <- err
+			}
 		}
 	}
 }
 
-func (c *Collector) fillBufferUntilChanEmpty(dest plog.LogRecordSlice) {
+func (c *Collector) fillBufferUntilChanEmpty(dest plog.LogRecordSlice, acks []*ackEvent) []*ackEvent {
 	for {
 		select {
 		case e := <-c.eventCh:
-			e.LogRecords().MoveAndAppendTo(dest)
+			acks = appendEvent(dest, e, acks)
 		default:
-			return
+			return acks
 		}
 	}
 }
+
+// appendEvent moves the log records of the event to dest, and returns acks
+// with the event if it must be acknowledged.
+func appendEvent(dest plog.LogRecordSlice, e Event, acks []*ackEvent) []*ackEvent {
+	e.LogRecords().MoveAndAppendTo(dest)
+	if a, ok := e.(*ackEvent); ok {
+		acks = append(acks, a)
+	}
+	return acks
+}
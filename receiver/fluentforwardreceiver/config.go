@@ -3,6 +3,14 @@
 
 package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
 
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
 // Config defines configuration for the fluentforward receiver.
 type Config struct {
 
@@ -10,4 +18,53 @@ type Config struct {
 	// of the form `<ip addr>:<port>` (TCP) or `unix://<socket_path>` (Unix
 	// domain socket).
 	ListenAddress string `mapstructure:"endpoint"`
+
+	// TLSSetting configures the receiver to accept TLS connections only. The
+	// heartbeats sent over UDP are not encrypted.
+	TLSSetting *configtls.TLSServerSetting `mapstructure:"tls,omitempty"`
+
+	// Security enables the handshake of the Forward protocol, clients must
+	// then authenticate with the shared key before sending events.
+	Security *SecurityConfig `mapstructure:"security,omitempty"`
+}
+
+// SecurityConfig defines the authentication of the clients during the
+// handshake of the Forward protocol.
+type SecurityConfig struct {
+	// SelfHostname is the hostname sent to the clients, it defaults to the
+	// hostname of the host.
+	SelfHostname string `mapstructure:"self_hostname"`
+
+	// SharedKey is the key shared by the clients and the receiver.
+	SharedKey configopaque.String `mapstructure:"shared_key"`
+
+	// Users are the users allowed to send events. When set, clients must
+	// authenticate with the username and password of one of the users in
+	// addition to the shared key.
+	Users []UserConfig `mapstructure:"users"`
+}
+
+// UserConfig defines a user allowed to send events.
+type UserConfig struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+}
+
+// Validate checks the receiver configuration is valid.
+func (c *Config) Validate() error {
+	if c.Security == nil {
+		return nil
+	}
+	if c.Security.SharedKey == "" {
+		return errors.New("security.shared_key must be specified")
+	}
+	for i, user := range c.Security.Users {
+		if user.Username == "" {
+			return fmt.Errorf("security.users[%d].username must be specified", i)
+		}
+		if user.Password == "" {
+			return fmt.Errorf("security.users[%d].password must be specified", i)
+		}
+	}
+	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 )
 
@@ -17,13 +18,91 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 
-	factory := NewFactory()
-	cfg := factory.CreateDefaultConfig()
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewID("fluentforward"),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName("fluentforward", "secure"),
+			expected: &Config{
+				ListenAddress: "0.0.0.0:24224",
+				TLSSetting: &configtls.TLSServerSetting{
+					TLSSetting: configtls.TLSSetting{
+						CertFile: "/etc/otelcol/fluent.crt",
+						KeyFile:  "/etc/otelcol/fluent.key",
+					},
+				},
+				Security: &SecurityConfig{
+					SelfHostname: "otelcol",
+					SharedKey:    "secret",
+					Users: []UserConfig{
+						{Username: "fluent", Password: "bit"},
+					},
+				},
+			},
+		},
+	}
 
-	sub, err := cm.Sub(component.NewID("fluentforward").String())
-	require.NoError(t, err)
-	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		security    *SecurityConfig
+		expectedErr string
+	}{
+		{
+			name: "no security",
+		},
+		{
+			name:        "missing shared key",
+			security:    &SecurityConfig{},
+			expectedErr: "security.shared_key must be specified",
+		},
+		{
+			name: "missing username",
+			security: &SecurityConfig{
+				SharedKey: "secret",
+				Users:     []UserConfig{{Password: "bit"}},
+			},
+			expectedErr: "security.users[0].username must be specified",
+		},
+		{
+			name: "missing password",
+			security: &SecurityConfig{
+				SharedKey: "secret",
+				Users:     []UserConfig{{Username: "fluent"}},
+			},
+			expectedErr: "security.users[0].password must be specified",
+		},
+	}
 
-	assert.NoError(t, component.ValidateConfig(cfg))
-	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{ListenAddress: "127.0.0.1:0", Security: tt.security}
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
 }
@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// The time allowed to clients to complete the handshake.
+const handshakeTimeout = 10 * time.Second
+
+// handshaker authenticates the clients with the handshake of the Forward
+// protocol, see
+// https://github.com/fluent/fluentd/wiki/Forward-Protocol-Specification-v1#handshake-messages.
+type handshaker struct {
+	selfHostname string
+	sharedKey    string
+	// users maps the usernames to the passwords, the clients are not
+	// authenticated as users when it is empty.
+	users map[string]string
+}
+
+func newHandshaker(cfg *SecurityConfig) (*handshaker, error) {
+	selfHostname := cfg.SelfHostname
+	if selfHostname == "" {
+		var err error
+		selfHostname, err = os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the hostname: %w", err)
+		}
+	}
+
+	users := make(map[string]string, len(cfg.Users))
+	for _, user := range cfg.Users {
+		users[user.Username] = string(user.Password)
+	}
+	return &handshaker{
+		selfHostname: selfHostname,
+		sharedKey:    string(cfg.SharedKey),
+		users:        users,
+	}, nil
+}
+
+// ping is the message sent by the client to authenticate.
+type ping struct {
+	clientHostname string
+	sharedKeySalt  string
+	sharedKeyHash  string
+	username       string
+	passwordHash   string
+}
+
+// handshake sends the HELO message to the client, reads its PING message and
+// replies with the PONG message. It returns an error when the client could
+// not be authenticated.
+func (h *handshaker) handshake(conn net.Conn, reader *msgp.Reader) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+
+	nonce, err := randomSalt()
+	if err != nil {
+		return err
+	}
+	var authSalt string
+	if len(h.users) > 0 {
+		if authSalt, err = randomSalt(); err != nil {
+			return err
+		}
+	}
+
+	writer := msgp.NewWriter(conn)
+	if err = writeHelo(writer, nonce, authSalt); err != nil {
+		return fmt.Errorf("failed to send HELO: %w", err)
+	}
+
+	p, err := readPing(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read PING: %w", err)
+	}
+
+	reason := h.authenticate(p, nonce, authSalt)
+	if err = h.writePong(writer, p, nonce, reason); err != nil {
+		return fmt.Errorf("failed to send PONG: %w", err)
+	}
+	if reason != "" {
+		return errors.New(reason)
+	}
+	return conn.SetDeadline(time.Time{})
+}
+
+// authenticate returns the reason why the client could not be authenticated,
+// or an empty string if it was.
+func (h *handshaker) authenticate(p *ping, nonce, authSalt string) string {
+	expected := digest(p.sharedKeySalt, p.clientHostname, nonce, h.sharedKey)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(p.sharedKeyHash)) != 1 {
+		return "shared_key mismatch"
+	}
+	if len(h.users) == 0 {
+		return ""
+	}
+	password, ok := h.users[p.username]
+	if !ok {
+		return "username/password mismatch"
+	}
+	expected = digest(authSalt, p.username, password)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(p.passwordHash)) != 1 {
+		return "username/password mismatch"
+	}
+	return ""
+}
+
+// writeHelo writes the message ["HELO", {"nonce": nonce, "auth": authSalt,
+// "keepalive": true}].
+func writeHelo(writer *msgp.Writer, nonce, authSalt string) error {
+	if err := writer.WriteArrayHeader(2); err != nil {
+		return err
+	}
+	if err := writer.WriteString("HELO"); err != nil {
+		return err
+	}
+	if err := writer.WriteMapStrIntf(map[string]interface{}{
+		"nonce":     nonce,
+		"auth":      authSalt,
+		"keepalive": true,
+	}); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// writePong writes the message ["PONG", authResult, reason, selfHostname,
+// sharedKeyHash], the hash lets the client authenticate the receiver.
+func (h *handshaker) writePong(writer *msgp.Writer, p *ping, nonce, reason string) error {
+	var sharedKeyHash string
+	if reason == "" {
+		sharedKeyHash = digest(p.sharedKeySalt, h.selfHostname, nonce, h.sharedKey)
+	}
+	if err := writer.WriteArrayHeader(5); err != nil {
+		return err
+	}
+	if err := writer.WriteString("PONG"); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(reason == ""); err != nil {
+		return err
+	}
+	if err := writer.WriteString(reason); err != nil {
+		return err
+	}
+	if err := writer.WriteString(h.selfHostname); err != nil {
+		return err
+	}
+	if err := writer.WriteString(sharedKeyHash); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// readPing reads the message ["PING", clientHostname, sharedKeySalt,
+// sharedKeyHash, username, passwordHash].
+func readPing(reader *msgp.Reader) (*ping, error) {
+	size, err := reader.ReadArrayHeader()
+	if err != nil {
+		return nil, err
+	}
+	if size != 6 {
+		return nil, fmt.Errorf("expected 6 elements, got %d", size)
+	}
+	fields := make([]string, size)
+	for i := range fields {
+		if fields[i], err = readStringOrBytes(reader); err != nil {
+			return nil, err
+		}
+	}
+	if fields[0] != "PING" {
+		return nil, fmt.Errorf("unexpected message type %q", fields[0])
+	}
+	return &ping{
+		clientHostname: fields[1],
+		sharedKeySalt:  fields[2],
+		sharedKeyHash:  fields[3],
+		username:       fields[4],
+		passwordHash:   fields[5],
+	}, nil
+}
+
+// readStringOrBytes reads a string which clients may encode as a string or as
+// binary data.
+func readStringOrBytes(reader *msgp.Reader) (string, error) {
+	v, err := reader.ReadIntf()
+	if err != nil {
+		return "", err
+	}
+	switch s := v.(type) {
+	case string:
+		return s, nil
+	case []byte:
+		return string(s), nil
+	default:
+		return "", fmt.Errorf("expected a string, got %T", v)
+	}
+}
+
+// digest returns the hex encoded SHA-512 hash of the concatenated values.
+func digest(values ...string) string {
+	h := sha512.New()
+	for _, v := range values {
+		h.Write([]byte(v))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func randomSalt() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
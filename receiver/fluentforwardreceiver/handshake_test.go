@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fluentforwardreceiver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+)
+
+const (
+	testSharedKey      = "secret"
+	testClientHostname = "fluent-bit"
+)
+
+func setupSecureServer(t *testing.T, security *SecurityConfig) func() net.Conn {
+	connect, _, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security:      security,
+	})
+	t.Cleanup(cancel)
+	return connect
+}
+
+// readHelo reads the HELO message and returns its nonce and auth salt.
+func readHelo(t *testing.T, reader *msgp.Reader) (string, string) {
+	size, err := reader.ReadArrayHeader()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, size)
+	typ, err := reader.ReadString()
+	require.NoError(t, err)
+	require.Equal(t, "HELO", typ)
+	options := map[string]interface{}{}
+	require.NoError(t, reader.ReadMapStrIntf(options))
+	require.Equal(t, true, options["keepalive"])
+	return options["nonce"].(string), options["auth"].(string)
+}
+
+func writePing(t *testing.T, conn net.Conn, nonce, authSalt, sharedKey, username, password string) {
+	const salt = "0123456789abcdef"
+	var passwordHash string
+	if username != "" {
+		passwordHash = digest(authSalt, username, password)
+	}
+
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 6)
+	b = msgp.AppendString(b, "PING")
+	b = msgp.AppendString(b, testClientHostname)
+	b = msgp.AppendString(b, salt)
+	b = msgp.AppendString(b, digest(salt, testClientHostname, nonce, sharedKey))
+	b = msgp.AppendString(b, username)
+	b = msgp.AppendString(b, passwordHash)
+	_, err := conn.Write(b)
+	require.NoError(t, err)
+}
+
+// readPong reads the PONG message and returns its fields.
+func readPong(t *testing.T, reader *msgp.Reader) []interface{} {
+	size, err := reader.ReadArrayHeader()
+	require.NoError(t, err)
+	require.EqualValues(t, 5, size)
+	pong := make([]interface{}, size)
+	for i := range pong {
+		pong[i], err = reader.ReadIntf()
+		require.NoError(t, err)
+	}
+	require.Equal(t, "PONG", pong[0])
+	return pong
+}
+
+func TestHandshake(t *testing.T) {
+	connect := setupSecureServer(t, &SecurityConfig{
+		SelfHostname: "otelcol",
+		SharedKey:    testSharedKey,
+	})
+
+	conn := connect()
+	defer conn.Close()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	reader := msgp.NewReader(conn)
+
+	nonce, authSalt := readHelo(t, reader)
+	require.NotEmpty(t, nonce)
+	require.Empty(t, authSalt)
+
+	writePing(t, conn, nonce, authSalt, testSharedKey, "", "")
+	pong := readPong(t, reader)
+	require.Equal(t, true, pong[1])
+	require.Equal(t, "", pong[2])
+	require.Equal(t, "otelcol", pong[3])
+	require.Equal(t, digest("0123456789abcdef", "otelcol", nonce, testSharedKey), pong[4])
+
+	// Events are accepted once the client is authenticated.
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 4)
+	b = msgp.AppendString(b, "my-tag")
+	b = msgp.AppendInt(b, 5000)
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "a")
+	b = msgp.AppendFloat64(b, 5.0)
+	b = msgp.AppendMapStrStr(b, map[string]string{"chunk": "abcdef"})
+	_, err := conn.Write(b)
+	require.NoError(t, err)
+
+	resp := map[string]interface{}{}
+	require.NoError(t, reader.ReadMapStrIntf(resp))
+	require.Equal(t, "abcdef", resp["ack"])
+}
+
+func TestHandshakeSharedKeyMismatch(t *testing.T) {
+	connect := setupSecureServer(t, &SecurityConfig{
+		SelfHostname: "otelcol",
+		SharedKey:    testSharedKey,
+	})
+
+	conn := connect()
+	defer conn.Close()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	reader := msgp.NewReader(conn)
+
+	nonce, authSalt := readHelo(t, reader)
+	writePing(t, conn, nonce, authSalt, "wrong", "", "")
+	pong := readPong(t, reader)
+	require.Equal(t, false, pong[1])
+	require.Equal(t, "shared_key mismatch", pong[2])
+	require.Equal(t, "", pong[4])
+
+	waitForConnectionClose(t, conn)
+}
+
+func TestHandshakeUsers(t *testing.T) {
+	connect := setupSecureServer(t, &SecurityConfig{
+		SelfHostname: "otelcol",
+		SharedKey:    testSharedKey,
+		Users: []UserConfig{
+			{Username: "fluent", Password: "bit"},
+		},
+	})
+
+	tests := []struct {
+		name           string
+		username       string
+		password       string
+		expectedResult bool
+		expectedReason string
+	}{
+		{
+			name:           "valid",
+			username:       "fluent",
+			password:       "bit",
+			expectedResult: true,
+		},
+		{
+			name:           "wrong password",
+			username:       "fluent",
+			password:       "wrong",
+			expectedReason: "username/password mismatch",
+		},
+		{
+			name:           "unknown user",
+			username:       "unknown",
+			password:       "bit",
+			expectedReason: "username/password mismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := connect()
+			defer conn.Close()
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+			reader := msgp.NewReader(conn)
+
+			nonce, authSalt := readHelo(t, reader)
+			require.NotEmpty(t, authSalt)
+
+			writePing(t, conn, nonce, authSalt, testSharedKey, tt.username, tt.password)
+			pong := readPong(t, reader)
+			require.Equal(t, tt.expectedResult, pong[1])
+			require.Equal(t, tt.expectedReason, pong[2])
+		})
+	}
+}
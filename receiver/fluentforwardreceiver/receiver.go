@@ -5,6 +5,7 @@ package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"strings"
 
@@ -40,7 +41,14 @@ func newFluentReceiver(set receiver.CreateSettings, conf *Config, next consumer.
 	eventCh := make(chan Event, eventChannelLength)
 	collector := newCollector(eventCh, next, set.Logger, obsrecv)
 
-	server := newServer(eventCh, set.Logger)
+	var handshaker *handshaker
+	if conf.Security != nil {
+		handshaker, err = newHandshaker(conf.Security)
+		if err != nil {
+			return nil, err
+		}
+	}
+	server := newServer(eventCh, set.Logger, handshaker)
 
 	return &fluentReceiver{
 		collector: collector,
@@ -74,6 +82,19 @@ func (r *fluentReceiver) Start(ctx context.Context, _ component.Host) error {
 		return err
 	}
 
+	if r.conf.TLSSetting != nil {
+		var tlsConfig *tls.Config
+		tlsConfig, err = r.conf.TLSSetting.LoadTLSConfig()
+		if err != nil {
+			listener.Close()
+			if udpListener != nil {
+				udpListener.Close()
+			}
+			return err
+		}
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
 	r.listener = listener
 
 	r.server.Start(receiverCtx, listener)
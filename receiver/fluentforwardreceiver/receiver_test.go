@@ -5,6 +5,8 @@ package fluentforwardreceiver
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tinylib/msgp/msgp"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -26,6 +29,12 @@ import (
 )
 
 func setupServer(t *testing.T) (func() net.Conn, *consumertest.LogsSink, *observer.ObservedLogs, context.CancelFunc) {
+	return setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+	})
+}
+
+func setupServerWithConfig(t *testing.T, conf *Config) (func() net.Conn, *consumertest.LogsSink, *observer.ObservedLogs, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	next := new(consumertest.LogsSink)
@@ -35,10 +44,6 @@ func setupServer(t *testing.T) (func() net.Conn, *consumertest.LogsSink, *observ
 	set := receivertest.NewNopCreateSettings()
 	set.Logger = logger
 
-	conf := &Config{
-		ListenAddress: "127.0.0.1:0",
-	}
-
 	receiver, err := newFluentReceiver(set, conf, next)
 	require.NoError(t, err)
 	require.NoError(t, receiver.Start(ctx, nil))
@@ -199,6 +204,79 @@ func TestEventAcknowledgment(t *testing.T) {
 	require.Equal(t, chunkValue, resp["ack"])
 }
 
+func TestEventAcknowledgmentConsumerError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf := &Config{
+		ListenAddress: "127.0.0.1:0",
+	}
+
+	receiver, err := newFluentReceiver(receivertest.NewNopCreateSettings(), conf, consumertest.NewErr(errors.New("consumer error")))
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(ctx, nil))
+	defer func() { require.NoError(t, receiver.Shutdown(ctx)) }()
+
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 4)
+	b = msgp.AppendString(b, "my-tag")
+	b = msgp.AppendInt(b, 5000)
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "a")
+	b = msgp.AppendFloat64(b, 5.0)
+	b = msgp.AppendMapStrStr(b, map[string]string{"chunk": "abcdef01234576789"})
+
+	conn, err := net.Dial("tcp", receiver.(*fluentReceiver).listener.Addr().String())
+	require.NoError(t, err)
+	_, err = conn.Write(b)
+	require.NoError(t, err)
+
+	// The chunk is not acknowledged so that the client resends it.
+	waitForConnectionClose(t, conn)
+}
+
+func TestTLS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	next := new(consumertest.LogsSink)
+	conf := &Config{
+		ListenAddress: "127.0.0.1:0",
+		TLSSetting: &configtls.TLSServerSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: filepath.Join("testdata", "server.crt"),
+				KeyFile:  filepath.Join("testdata", "server.key"),
+			},
+		},
+	}
+
+	receiver, err := newFluentReceiver(receivertest.NewNopCreateSettings(), conf, next)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(ctx, nil))
+	defer func() { require.NoError(t, receiver.Shutdown(ctx)) }()
+
+	clientTLS, err := configtls.TLSClientSetting{
+		TLSSetting: configtls.TLSSetting{
+			CAFile: filepath.Join("testdata", "server.crt"),
+		},
+		ServerName: "localhost",
+	}.LoadTLSConfig()
+	require.NoError(t, err)
+
+	conn, err := tls.Dial("tcp", receiver.(*fluentReceiver).listener.Addr().String(), clientTLS)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	eventBytes := parseHexDump("testdata/message-event")
+	n, err := conn.Write(eventBytes)
+	require.NoError(t, err)
+	require.Equal(t, len(eventBytes), n)
+
+	require.Eventually(t, func() bool {
+		return next.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestForwardPackedEvent(t *testing.T) {
 	connect, next, _, cancel := setupServer(t)
 	defer cancel()
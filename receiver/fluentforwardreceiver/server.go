@@ -24,14 +24,16 @@ import (
 const readBufferSize = 10 * 1024
 
 type server struct {
-	outCh  chan<- Event
-	logger *zap.Logger
+	outCh      chan<- Event
+	logger     *zap.Logger
+	handshaker *handshaker
 }
 
-func newServer(outCh chan<- Event, logger *zap.Logger) *server {
+func newServer(outCh chan<- Event, logger *zap.Logger, handshaker *handshaker) *server {
 	return &server{
-		outCh:  outCh,
-		logger: logger,
+		outCh:      outCh,
+		logger:     logger,
+		handshaker: handshaker,
 	}
 }
 
@@ -80,6 +82,13 @@ func (s *server) handleConnections(ctx context.Context, listener net.Listener) {
 func (s *server) handleConn(ctx context.Context, conn net.Conn) error {
 	reader := msgp.NewReaderSize(conn, readBufferSize)
 
+	if s.handshaker != nil {
+		if err := s.handshaker.handshake(conn, reader); err != nil {
+			s.logger.Warn("Failed to authenticate client", zap.String("remoteAddr", conn.RemoteAddr().String()), zap.Error(err))
+			return err
+		}
+	}
+
 	for {
 		mode, err := DetermineNextEventMode(reader.R)
 		if err != nil {
@@ -110,17 +119,29 @@ func (s *server) handleConn(ctx context.Context, conn net.Conn) error {
 
 		stats.Record(ctx, observ.EventsParsed.M(1))
 
-		s.outCh <- event
+		if event.Chunk() == "" {
+			s.outCh <- event
+			continue
+		}
 
-		// We must acknowledge the 'chunk' option if given. We could do this in
-		// another goroutine if it is too much of a bottleneck to reading
-		// messages -- this is the only thing that sends data back to the
-		// client.
-		if event.Chunk() != "" {
-			err := msgp.Encode(conn, AckResponse{Ack: event.Chunk()})
-			if err != nil {
-				return fmt.Errorf("failed to acknowledge chunk %s: %w", event.Chunk(), err)
-			}
+		// We must acknowledge the 'chunk' option if given. The event is only
+		// acknowledged once its log records were consumed, so that the client
+		// resends it if they were not. We could do this in another goroutine
+		// if it is too much of a bottleneck to reading messages -- this is
+		// the only thing that sends data back to the client.
+		ack := newAckEvent(event)
+		s.outCh <- ack
+		select {
+		case err = <-ack.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to consume chunk %s: %w", event.Chunk(), err)
+		}
+		err = msgp.Encode(conn, AckResponse{Ack: event.Chunk()})
+		if err != nil {
+			return fmt.Errorf("failed to acknowledge chunk %s: %w", event.Chunk(), err)
 		}
 	}
 }
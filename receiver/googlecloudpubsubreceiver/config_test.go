@@ -88,6 +88,8 @@ func TestTraceConfigValidation(t *testing.T) {
 	assert.Error(t, c.validateForTrace())
 	c.Encoding = "raw_json"
 	assert.Error(t, c.validateForTrace())
+	c.Encoding = "cloud_logging"
+	assert.Error(t, c.validateForTrace())
 
 	c.Encoding = "otlp_proto_trace"
 	assert.NoError(t, c.validateForTrace())
@@ -107,6 +109,8 @@ func TestMetricConfigValidation(t *testing.T) {
 	assert.Error(t, c.validateForMetric())
 	c.Encoding = "raw_json"
 	assert.Error(t, c.validateForMetric())
+	c.Encoding = "cloud_logging"
+	assert.Error(t, c.validateForMetric())
 
 	c.Encoding = "otlp_proto_metric"
 	assert.NoError(t, c.validateForMetric())
@@ -127,6 +131,8 @@ func TestLogConfigValidation(t *testing.T) {
 	assert.NoError(t, c.validateForLog())
 	c.Encoding = "raw_json"
 	assert.NoError(t, c.validateForLog())
+	c.Encoding = "cloud_logging"
+	assert.NoError(t, c.validateForLog())
 	c.Encoding = "otlp_proto_log"
 	assert.NoError(t, c.validateForLog())
 }
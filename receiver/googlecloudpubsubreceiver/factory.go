@@ -18,6 +18,7 @@ import (
 const (
 	reportTransport      = "pubsub"
 	reportFormatProtobuf = "protobuf"
+	reportFormatJSON     = "json"
 )
 
 func NewFactory() receiver.Factory {
@@ -0,0 +1,282 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudpubsubreceiver/internal"
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+)
+
+const (
+	attributeLogName      = "gcp.log_name"
+	attributeResourceType = "gcp.resource_type"
+	attributeOperationID  = "gcp.operation.id"
+	attributeLogUID       = "log.record.uid"
+	resourceLabelPrefix   = "gcp."
+	tracePrefix           = "/traces/"
+)
+
+// LogEntry is the JSON representation of a Cloud Logging LogEntry, as exported to Pub/Sub
+// by a log sink. See https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry
+type LogEntry struct {
+	LogName          string                 `json:"logName"`
+	Resource         monitoredResource      `json:"resource"`
+	Timestamp        string                 `json:"timestamp"`
+	ReceiveTimestamp string                 `json:"receiveTimestamp"`
+	Severity         string                 `json:"severity"`
+	InsertID         string                 `json:"insertId"`
+	HTTPRequest      *httpRequest           `json:"httpRequest"`
+	Labels           map[string]string      `json:"labels"`
+	Operation        *operation             `json:"operation"`
+	Trace            string                 `json:"trace"`
+	SpanID           string                 `json:"spanId"`
+	TraceSampled     bool                   `json:"traceSampled"`
+	SourceLocation   *sourceLocation        `json:"sourceLocation"`
+	TextPayload      *string                `json:"textPayload"`
+	JSONPayload      map[string]interface{} `json:"jsonPayload"`
+	ProtoPayload     map[string]interface{} `json:"protoPayload"`
+}
+
+type monitoredResource struct {
+	Type   string            `json:"type"`
+	Labels map[string]string `json:"labels"`
+}
+
+type httpRequest struct {
+	RequestMethod string `json:"requestMethod"`
+	RequestURL    string `json:"requestUrl"`
+	Status        int64  `json:"status"`
+	UserAgent     string `json:"userAgent"`
+	RemoteIP      string `json:"remoteIp"`
+}
+
+type operation struct {
+	ID string `json:"id"`
+}
+
+type sourceLocation struct {
+	File     string `json:"file"`
+	Line     string `json:"line"`
+	Function string `json:"function"`
+}
+
+// severities maps the LogSeverity of Cloud Logging to the severity numbers of OpenTelemetry.
+// See https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry#logseverity
+var severities = map[string]plog.SeverityNumber{
+	"DEFAULT":   plog.SeverityNumberUnspecified,
+	"DEBUG":     plog.SeverityNumberDebug,
+	"INFO":      plog.SeverityNumberInfo,
+	"NOTICE":    plog.SeverityNumberInfo2,
+	"WARNING":   plog.SeverityNumberWarn,
+	"ERROR":     plog.SeverityNumberError,
+	"CRITICAL":  plog.SeverityNumberFatal,
+	"ALERT":     plog.SeverityNumberFatal2,
+	"EMERGENCY": plog.SeverityNumberFatal4,
+}
+
+// resourceMappings maps the labels of the monitored resource types to the OpenTelemetry
+// resource attributes, the labels of the other types are prefixed by gcp.
+var resourceMappings = map[string]struct {
+	platform string
+	labels   map[string]string
+}{
+	"gce_instance": {
+		platform: conventions.AttributeCloudPlatformGCPComputeEngine,
+		labels: map[string]string{
+			"instance_id": conventions.AttributeHostID,
+			"zone":        conventions.AttributeCloudAvailabilityZone,
+		},
+	},
+	"k8s_container": {
+		platform: conventions.AttributeCloudPlatformGCPKubernetesEngine,
+		labels: map[string]string{
+			"location":       conventions.AttributeCloudRegion,
+			"cluster_name":   conventions.AttributeK8SClusterName,
+			"namespace_name": conventions.AttributeK8SNamespaceName,
+			"pod_name":       conventions.AttributeK8SPodName,
+			"container_name": conventions.AttributeK8SContainerName,
+		},
+	},
+	"k8s_pod": {
+		platform: conventions.AttributeCloudPlatformGCPKubernetesEngine,
+		labels: map[string]string{
+			"location":       conventions.AttributeCloudRegion,
+			"cluster_name":   conventions.AttributeK8SClusterName,
+			"namespace_name": conventions.AttributeK8SNamespaceName,
+			"pod_name":       conventions.AttributeK8SPodName,
+		},
+	},
+	"k8s_node": {
+		platform: conventions.AttributeCloudPlatformGCPKubernetesEngine,
+		labels: map[string]string{
+			"location":     conventions.AttributeCloudRegion,
+			"cluster_name": conventions.AttributeK8SClusterName,
+			"node_name":    conventions.AttributeK8SNodeName,
+		},
+	},
+	"cloud_run_revision": {
+		platform: conventions.AttributeCloudPlatformGCPCloudRun,
+		labels: map[string]string{
+			"location":      conventions.AttributeCloudRegion,
+			"service_name":  conventions.AttributeFaaSName,
+			"revision_name": conventions.AttributeFaaSVersion,
+		},
+	},
+	"cloud_function": {
+		platform: conventions.AttributeCloudPlatformGCPCloudFunctions,
+		labels: map[string]string{
+			"region":        conventions.AttributeCloudRegion,
+			"function_name": conventions.AttributeFaaSName,
+		},
+	},
+}
+
+// TranslateLogEntry decodes a Cloud Logging LogEntry into its resource and a log record.
+func TranslateLogEntry(data []byte) (pcommon.Resource, plog.LogRecord, error) {
+	res := pcommon.NewResource()
+	lr := plog.NewLogRecord()
+
+	var entry LogEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return res, lr, fmt.Errorf("invalid LogEntry: %w", err)
+	}
+
+	translateResource(entry.Resource, res.Attributes())
+
+	if entry.Timestamp != "" {
+		ts, err := time.Parse(time.RFC3339Nano, entry.Timestamp)
+		if err != nil {
+			return res, lr, fmt.Errorf("invalid timestamp: %w", err)
+		}
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	}
+	if entry.ReceiveTimestamp != "" {
+		ts, err := time.Parse(time.RFC3339Nano, entry.ReceiveTimestamp)
+		if err != nil {
+			return res, lr, fmt.Errorf("invalid receiveTimestamp: %w", err)
+		}
+		lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(ts))
+	}
+
+	if entry.Severity != "" {
+		lr.SetSeverityText(entry.Severity)
+		lr.SetSeverityNumber(severities[entry.Severity])
+	}
+
+	if err := translateTrace(entry, lr); err != nil {
+		return res, lr, err
+	}
+
+	attrs := lr.Attributes()
+	for k, v := range entry.Labels {
+		attrs.PutStr(k, v)
+	}
+	if entry.LogName != "" {
+		attrs.PutStr(attributeLogName, entry.LogName)
+	}
+	if entry.InsertID != "" {
+		attrs.PutStr(attributeLogUID, entry.InsertID)
+	}
+	if entry.Operation != nil && entry.Operation.ID != "" {
+		attrs.PutStr(attributeOperationID, entry.Operation.ID)
+	}
+	if r := entry.HTTPRequest; r != nil {
+		putNonEmpty(attrs, conventions.AttributeHTTPMethod, r.RequestMethod)
+		putNonEmpty(attrs, conventions.AttributeHTTPURL, r.RequestURL)
+		putNonEmpty(attrs, conventions.AttributeHTTPUserAgent, r.UserAgent)
+		putNonEmpty(attrs, conventions.AttributeHTTPClientIP, r.RemoteIP)
+		if r.Status != 0 {
+			attrs.PutInt(conventions.AttributeHTTPStatusCode, r.Status)
+		}
+	}
+	if l := entry.SourceLocation; l != nil {
+		putNonEmpty(attrs, conventions.AttributeCodeFilepath, l.File)
+		putNonEmpty(attrs, conventions.AttributeCodeFunction, l.Function)
+		// the line is an int64 encoded as a string in JSON
+		if line, err := strconv.ParseInt(l.Line, 10, 64); err == nil {
+			attrs.PutInt(conventions.AttributeCodeLineNumber, line)
+		}
+	}
+
+	var err error
+	switch {
+	case entry.TextPayload != nil:
+		lr.Body().SetStr(*entry.TextPayload)
+	case entry.JSONPayload != nil:
+		err = lr.Body().SetEmptyMap().FromRaw(entry.JSONPayload)
+	case entry.ProtoPayload != nil:
+		err = lr.Body().SetEmptyMap().FromRaw(entry.ProtoPayload)
+	}
+	return res, lr, err
+}
+
+func translateResource(resource monitoredResource, attrs pcommon.Map) {
+	attrs.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderGCP)
+	if resource.Type == "" {
+		return
+	}
+	attrs.PutStr(attributeResourceType, resource.Type)
+
+	mapping, known := resourceMappings[resource.Type]
+	if known {
+		attrs.PutStr(conventions.AttributeCloudPlatform, mapping.platform)
+	}
+	for k, v := range resource.Labels {
+		switch name, ok := mapping.labels[k]; {
+		case k == "project_id":
+			attrs.PutStr(conventions.AttributeCloudAccountID, v)
+		case ok:
+			attrs.PutStr(name, v)
+		default:
+			attrs.PutStr(resourceLabelPrefix+k, v)
+		}
+	}
+}
+
+// translateTrace sets the trace context of the log record, the trace being
+// the resource name of the trace: projects/<project_id>/traces/<trace_id>.
+func translateTrace(entry LogEntry, lr plog.LogRecord) error {
+	if entry.Trace != "" {
+		traceID := entry.Trace
+		if i := strings.LastIndex(traceID, tracePrefix); i >= 0 {
+			traceID = traceID[i+len(tracePrefix):]
+		}
+		var tid pcommon.TraceID
+		if len(traceID) != hex.EncodedLen(len(tid)) {
+			return fmt.Errorf("invalid trace: %s", entry.Trace)
+		}
+		if _, err := hex.Decode(tid[:], []byte(traceID)); err != nil {
+			return fmt.Errorf("invalid trace: %s", entry.Trace)
+		}
+		lr.SetTraceID(tid)
+	}
+	if entry.SpanID != "" {
+		var sid pcommon.SpanID
+		if len(entry.SpanID) != hex.EncodedLen(len(sid)) {
+			return fmt.Errorf("invalid spanId: %s", entry.SpanID)
+		}
+		if _, err := hex.Decode(sid[:], []byte(entry.SpanID)); err != nil {
+			return fmt.Errorf("invalid spanId: %s", entry.SpanID)
+		}
+		lr.SetSpanID(sid)
+	}
+	if entry.TraceSampled {
+		lr.SetFlags(plog.DefaultLogRecordFlags.WithIsSampled(true))
+	}
+	return nil
+}
+
+func putNonEmpty(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
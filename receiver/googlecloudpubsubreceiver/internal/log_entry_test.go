@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestTranslateLogEntry(t *testing.T) {
+	data := []byte(`{
+		"insertId": "65j8vtf1d2bpy",
+		"logName": "projects/my-project/logs/stdout",
+		"resource": {
+			"type": "k8s_container",
+			"labels": {
+				"project_id": "my-project",
+				"location": "europe-west1",
+				"cluster_name": "prod",
+				"namespace_name": "default",
+				"pod_name": "frontend-6d9f8",
+				"container_name": "frontend"
+			}
+		},
+		"timestamp": "2023-07-01T12:00:00.123456789Z",
+		"receiveTimestamp": "2023-07-01T12:00:01Z",
+		"severity": "WARNING",
+		"labels": {"compute.googleapis.com/resource_name": "node-1"},
+		"trace": "projects/my-project/traces/4bf92f3577b34da6a3ce929d0e0e4736",
+		"spanId": "00f067aa0ba902b7",
+		"traceSampled": true,
+		"httpRequest": {"requestMethod": "GET", "requestUrl": "https://example.com/", "status": 503, "userAgent": "curl/8.0", "remoteIp": "10.0.0.1"},
+		"sourceLocation": {"file": "main.go", "line": "42", "function": "main.handle"},
+		"jsonPayload": {"message": "upstream unavailable", "retries": 3}
+	}`)
+
+	res, lr, err := TranslateLogEntry(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":     "gcp",
+		"cloud.platform":     "gcp_kubernetes_engine",
+		"cloud.account.id":   "my-project",
+		"cloud.region":       "europe-west1",
+		"gcp.resource_type":  "k8s_container",
+		"k8s.cluster.name":   "prod",
+		"k8s.namespace.name": "default",
+		"k8s.pod.name":       "frontend-6d9f8",
+		"k8s.container.name": "frontend",
+	}, res.Attributes().AsRaw())
+
+	assert.Equal(t, time.Date(2023, 7, 1, 12, 0, 0, 123456789, time.UTC), lr.Timestamp().AsTime())
+	assert.Equal(t, time.Date(2023, 7, 1, 12, 0, 1, 0, time.UTC), lr.ObservedTimestamp().AsTime())
+	assert.Equal(t, "WARNING", lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, pcommon.TraceID([16]byte{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}), lr.TraceID())
+	assert.Equal(t, pcommon.SpanID([8]byte{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}), lr.SpanID())
+	assert.True(t, lr.Flags().IsSampled())
+	assert.Equal(t, map[string]interface{}{
+		"compute.googleapis.com/resource_name": "node-1",
+		"gcp.log_name":                         "projects/my-project/logs/stdout",
+		"log.record.uid":                       "65j8vtf1d2bpy",
+		"http.method":                          "GET",
+		"http.url":                             "https://example.com/",
+		"http.status_code":                     int64(503),
+		"http.user_agent":                      "curl/8.0",
+		"http.client_ip":                       "10.0.0.1",
+		"code.filepath":                        "main.go",
+		"code.lineno":                          int64(42),
+		"code.function":                        "main.handle",
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"message": "upstream unavailable", "retries": float64(3)}, lr.Body().Map().AsRaw())
+}
+
+func TestTranslateLogEntryPayloads(t *testing.T) {
+	res, lr, err := TranslateLogEntry([]byte(`{"resource":{"type":"pubsub_topic","labels":{"project_id":"my-project","topic_id":"events"}},"severity":"NOTICE","textPayload":"hello"}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":    "gcp",
+		"cloud.account.id":  "my-project",
+		"gcp.resource_type": "pubsub_topic",
+		"gcp.topic_id":      "events",
+	}, res.Attributes().AsRaw())
+	assert.Equal(t, "hello", lr.Body().Str())
+	assert.Equal(t, plog.SeverityNumberInfo2, lr.SeverityNumber())
+
+	_, lr, err = TranslateLogEntry([]byte(`{"protoPayload":{"@type":"type.googleapis.com/google.cloud.audit.AuditLog","methodName":"SetIamPolicy"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"@type": "type.googleapis.com/google.cloud.audit.AuditLog", "methodName": "SetIamPolicy"}, lr.Body().Map().AsRaw())
+}
+
+func TestTranslateInvalidLogEntry(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{name: "not json", data: "not json", err: "invalid LogEntry"},
+		{name: "invalid timestamp", data: `{"timestamp":"yesterday"}`, err: "invalid timestamp"},
+		{name: "invalid trace", data: `{"trace":"projects/my-project/traces/123"}`, err: "invalid trace: projects/my-project/traces/123"},
+		{name: "invalid span", data: `{"spanId":"zzzzzzzzzzzzzzzz"}`, err: "invalid spanId: zzzzzzzzzzzzzzzz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := TranslateLogEntry([]byte(tt.data))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
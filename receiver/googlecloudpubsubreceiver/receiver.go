@@ -55,8 +55,12 @@ const (
 	otlpProtoMetric          = iota
 	otlpProtoLog             = iota
 	rawTextLog               = iota
+	cloudLoggingLog          = iota
 )
 
+// cloudLoggingTimestampAttribute is set on the messages published by the Cloud Logging sinks
+const cloudLoggingTimestampAttribute = "logging.googleapis.com/timestamp"
+
 type compression int
 
 const (
@@ -195,6 +199,26 @@ func (receiver *pubsubReceiver) handleLog(ctx context.Context, payload []byte, c
 	return nil
 }
 
+func (receiver *pubsubReceiver) handleCloudLoggingLog(ctx context.Context, payload []byte, compression compression) error {
+	payload, err := decompress(payload, compression)
+	if err != nil {
+		return err
+	}
+	resource, lr, err := internal.TranslateLogEntry(payload)
+	if err != nil {
+		return err
+	}
+	out := plog.NewLogs()
+	rl := out.ResourceLogs().AppendEmpty()
+	resource.MoveTo(rl.Resource())
+	lr.MoveTo(rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty())
+
+	ctx = receiver.obsrecv.StartLogsOp(ctx)
+	err = receiver.logsConsumer.ConsumeLogs(ctx, out)
+	receiver.obsrecv.EndLogsOp(ctx, reportFormatJSON, 1, err)
+	return nil
+}
+
 func (receiver *pubsubReceiver) detectEncoding(attributes map[string]string) (encoding, compression) {
 	otlpEncoding := unknown
 	otlpCompression := uncompressed
@@ -224,6 +248,15 @@ func (receiver *pubsubReceiver) detectEncoding(attributes map[string]string) (en
 			otlpEncoding = otlpProtoLog
 		case "raw_text":
 			otlpEncoding = rawTextLog
+		case "cloud_logging":
+			otlpEncoding = cloudLoggingLog
+		}
+	}
+
+	// the Cloud Logging entries are only detected when no encoding is configured
+	if otlpEncoding == unknown && receiver.config.Encoding == "" {
+		if _, ok := attributes[cloudLoggingTimestampAttribute]; ok {
+			otlpEncoding = cloudLoggingLog
 		}
 	}
 
@@ -267,6 +300,10 @@ func (receiver *pubsubReceiver) createReceiverHandler(ctx context.Context) error
 				}
 			case rawTextLog:
 				return receiver.handleLogStrings(ctx, message)
+			case cloudLoggingLog:
+				if receiver.logsConsumer != nil {
+					return receiver.handleCloudLoggingLog(ctx, payload, compression)
+				}
 			case unknown:
 				return errors.New("unknown encoding")
 			}
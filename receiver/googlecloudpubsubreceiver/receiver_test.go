@@ -153,6 +153,61 @@ func TestReceiver(t *testing.T) {
 		return len(logSink.AllLogs()) == 1
 	}, time.Second, 10*time.Millisecond)
 
+	// Test a Cloud Logging log entry
+	logSink.Reset()
+	srv.Publish("projects/my-project/topics/otlp", testdata.CreateCloudLoggingExport(), map[string]string{
+		"logging.googleapis.com/timestamp": "2023-07-01T00:00:00Z",
+	})
+	assert.Eventually(t, func() bool {
+		return len(logSink.AllLogs()) == 1
+	}, time.Second, 10*time.Millisecond)
+	resourceAttrs := logSink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes()
+	accountID, _ := resourceAttrs.Get("cloud.account.id")
+	assert.Equal(t, "my-project", accountID.Str())
+
 	assert.Nil(t, receiver.Shutdown(ctx))
 	assert.Nil(t, receiver.Shutdown(ctx))
 }
+
+func TestDetectEncoding(t *testing.T) {
+	cloudLoggingAttributes := map[string]string{
+		"logging.googleapis.com/timestamp": "2023-07-01T00:00:00Z",
+	}
+	tests := []struct {
+		name       string
+		encoding   string
+		attributes map[string]string
+		expected   encoding
+	}{
+		{
+			name:       "cloud logging detected",
+			attributes: cloudLoggingAttributes,
+			expected:   cloudLoggingLog,
+		},
+		{
+			name:       "explicit encoding wins over cloud logging detection",
+			encoding:   "raw_text",
+			attributes: cloudLoggingAttributes,
+			expected:   rawTextLog,
+		},
+		{
+			name:       "explicit cloud logging encoding",
+			encoding:   "cloud_logging",
+			attributes: map[string]string{},
+			expected:   cloudLoggingLog,
+		},
+		{
+			name:       "unknown",
+			attributes: map[string]string{},
+			expected:   unknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := &pubsubReceiver{config: &Config{Encoding: tt.encoding}}
+			otlpEncoding, otlpCompression := receiver.detectEncoding(tt.attributes)
+			assert.Equal(t, tt.expected, otlpEncoding)
+			assert.Equal(t, uncompressed, otlpCompression)
+		})
+	}
+}
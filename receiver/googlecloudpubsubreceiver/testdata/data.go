@@ -61,3 +61,7 @@ func CreateGZippedLogExport() []byte {
 func CreateTextExport() []byte {
 	return []byte("this is text")
 }
+
+func CreateCloudLoggingExport() []byte {
+	return []byte(`{"insertId":"1","logName":"projects/my-project/logs/stdout","resource":{"type":"k8s_container","labels":{"project_id":"my-project"}},"textPayload":"test","timestamp":"2023-07-01T00:00:00Z"}`)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/connectionsscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
@@ -56,6 +57,14 @@ func TestLoadConfig(t *testing.T) {
 			InitialDelay:       time.Second,
 		},
 		Scrapers: map[string]internal.Config{
+			connectionsscraper.TypeStr: (func() internal.Config {
+				cfg := (&connectionsscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*connectionsscraper.Config).Include = connectionsscraper.MatchConfig{
+					Names:  []string{"nginx", "java"},
+					Config: filterset.Config{MatchType: "strict"},
+				}
+				return cfg
+			})(),
 			cpuscraper.TypeStr:  (&cpuscraper.Factory{}).CreateDefaultConfig(),
 			diskscraper.TypeStr: (&diskscraper.Factory{}).CreateDefaultConfig(),
 			loadscraper.TypeStr: (func() internal.Config {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/connectionsscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
@@ -29,15 +30,16 @@ import (
 // This file implements Factory for HostMetrics receiver.
 var (
 	scraperFactories = map[string]internal.ScraperFactory{
-		cpuscraper.TypeStr:        &cpuscraper.Factory{},
-		diskscraper.TypeStr:       &diskscraper.Factory{},
-		loadscraper.TypeStr:       &loadscraper.Factory{},
-		filesystemscraper.TypeStr: &filesystemscraper.Factory{},
-		memoryscraper.TypeStr:     &memoryscraper.Factory{},
-		networkscraper.TypeStr:    &networkscraper.Factory{},
-		pagingscraper.TypeStr:     &pagingscraper.Factory{},
-		processesscraper.TypeStr:  &processesscraper.Factory{},
-		processscraper.TypeStr:    &processscraper.Factory{},
+		connectionsscraper.TypeStr: &connectionsscraper.Factory{},
+		cpuscraper.TypeStr:         &cpuscraper.Factory{},
+		diskscraper.TypeStr:        &diskscraper.Factory{},
+		loadscraper.TypeStr:        &loadscraper.Factory{},
+		filesystemscraper.TypeStr:  &filesystemscraper.Factory{},
+		memoryscraper.TypeStr:      &memoryscraper.Factory{},
+		networkscraper.TypeStr:     &networkscraper.Factory{},
+		pagingscraper.TypeStr:      &pagingscraper.Factory{},
+		processesscraper.TypeStr:   &processesscraper.Factory{},
+		processscraper.TypeStr:     &processscraper.Factory{},
 	}
 )
 
@@ -24,6 +24,7 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/connectionsscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
@@ -72,15 +73,16 @@ var systemSpecificMetrics = map[string][]string{
 }
 
 var factories = map[string]internal.ScraperFactory{
-	cpuscraper.TypeStr:        &cpuscraper.Factory{},
-	diskscraper.TypeStr:       &diskscraper.Factory{},
-	filesystemscraper.TypeStr: &filesystemscraper.Factory{},
-	loadscraper.TypeStr:       &loadscraper.Factory{},
-	memoryscraper.TypeStr:     &memoryscraper.Factory{},
-	networkscraper.TypeStr:    &networkscraper.Factory{},
-	pagingscraper.TypeStr:     &pagingscraper.Factory{},
-	processesscraper.TypeStr:  &processesscraper.Factory{},
-	processscraper.TypeStr:    &processscraper.Factory{},
+	connectionsscraper.TypeStr: &connectionsscraper.Factory{},
+	cpuscraper.TypeStr:         &cpuscraper.Factory{},
+	diskscraper.TypeStr:        &diskscraper.Factory{},
+	filesystemscraper.TypeStr:  &filesystemscraper.Factory{},
+	loadscraper.TypeStr:        &loadscraper.Factory{},
+	memoryscraper.TypeStr:      &memoryscraper.Factory{},
+	networkscraper.TypeStr:     &networkscraper.Factory{},
+	pagingscraper.TypeStr:      &pagingscraper.Factory{},
+	processesscraper.TypeStr:   &processesscraper.Factory{},
+	processscraper.TypeStr:     &processscraper.Factory{},
 }
 
 type testEnv struct {
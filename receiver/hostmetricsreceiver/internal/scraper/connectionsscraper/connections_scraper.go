@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connectionsscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/connectionsscraper"
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/net"
+	"github.com/shirou/gopsutil/v3/process"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/connectionsscraper/internal/metadata"
+)
+
+const (
+	connectionsMetricsLen = 1
+
+	// otherProcessName is reported for the connections of processes which are not included
+	otherProcessName = "other"
+	// unknownProcessName is reported when the owner of a connection cannot be resolved,
+	// e.g. when the collector is not allowed to inspect the process
+	unknownProcessName = "unknown"
+	// noState is reported for the connections of connectionless protocols
+	noState = "NONE"
+)
+
+type connectionKey struct {
+	protocol    metadata.AttributeProtocol
+	state       string
+	processName string
+}
+
+// scraper for Connections Metrics
+type scraper struct {
+	settings  receiver.CreateSettings
+	config    *Config
+	mb        *metadata.MetricsBuilder
+	includeFS filterset.FilterSet
+
+	// for mocking
+	bootTime    func() (uint64, error)
+	connections func(string) ([]net.ConnectionStat, error)
+	processName func(context.Context, int32) (string, error)
+}
+
+// newConnectionsScraper creates a scraper counting the connections by owning process
+func newConnectionsScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) (*scraper, error) {
+	scraper := &scraper{
+		settings:    settings,
+		config:      cfg,
+		bootTime:    host.BootTime,
+		connections: net.Connections,
+		processName: getProcessName,
+	}
+
+	if len(cfg.Include.Names) > 0 {
+		var err error
+		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Names, &cfg.Include.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating process include filters: %w", err)
+		}
+	}
+
+	return scraper, nil
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	connections, err := s.connections("inet")
+	if err != nil {
+		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(fmt.Errorf("failed to read connections: %w", err), connectionsMetricsLen)
+	}
+
+	for key, count := range s.countConnections(ctx, connections) {
+		s.mb.RecordSystemNetworkProcessConnectionsDataPoint(now, count, key.protocol, key.state, key.processName)
+	}
+	return s.mb.Emit(), nil
+}
+
+// countConnections groups the connections by protocol, state and owning process
+func (s *scraper) countConnections(ctx context.Context, connections []net.ConnectionStat) map[connectionKey]int64 {
+	counts := make(map[connectionKey]int64)
+	// Process names are cached for the duration of a scrape, a process usually owns many connections
+	names := make(map[int32]string)
+
+	for _, conn := range connections {
+		var protocol metadata.AttributeProtocol
+		switch conn.Type {
+		case syscall.SOCK_STREAM:
+			protocol = metadata.AttributeProtocolTcp
+		case syscall.SOCK_DGRAM:
+			protocol = metadata.AttributeProtocolUdp
+		default:
+			continue
+		}
+
+		state := conn.Status
+		if state == "" {
+			state = noState
+		}
+
+		name, ok := names[conn.Pid]
+		if !ok {
+			name = s.resolveProcessName(ctx, conn.Pid)
+			names[conn.Pid] = name
+		}
+
+		counts[connectionKey{protocol: protocol, state: state, processName: name}]++
+	}
+	return counts
+}
+
+func (s *scraper) resolveProcessName(ctx context.Context, pid int32) string {
+	if pid <= 0 {
+		return unknownProcessName
+	}
+	name, err := s.processName(ctx, pid)
+	if err != nil || name == "" {
+		// The process may have exited since the connections were listed
+		return unknownProcessName
+	}
+	if s.includeFS != nil && !s.includeFS.Matches(name) {
+		return otherProcessName
+	}
+	return name
+}
+
+func getProcessName(ctx context.Context, pid int32) (string, error) {
+	proc, err := process.NewProcessWithContext(ctx, pid)
+	if err != nil {
+		return "", err
+	}
+	return proc.NameWithContext(ctx)
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connectionsscraper
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/net"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/connectionsscraper/internal/metadata"
+)
+
+var testConnections = []net.ConnectionStat{
+	{Type: syscall.SOCK_STREAM, Status: "ESTABLISHED", Pid: 10},
+	{Type: syscall.SOCK_STREAM, Status: "ESTABLISHED", Pid: 10},
+	{Type: syscall.SOCK_STREAM, Status: "LISTEN", Pid: 10},
+	{Type: syscall.SOCK_STREAM, Status: "CLOSE_WAIT", Pid: 20},
+	{Type: syscall.SOCK_DGRAM, Status: "", Pid: 30},
+	{Type: syscall.SOCK_STREAM, Status: "TIME_WAIT", Pid: 0},
+	{Type: syscall.SOCK_STREAM, Status: "ESTABLISHED", Pid: 40},
+}
+
+var testProcessNames = map[int32]string{
+	10: "nginx",
+	20: "java",
+	30: "dnsmasq",
+}
+
+func testProcessName(_ context.Context, pid int32) (string, error) {
+	if name, ok := testProcessNames[pid]; ok {
+		return name, nil
+	}
+	return "", errors.New("process not found")
+}
+
+func TestScrape(t *testing.T) {
+	testCases := []struct {
+		name     string
+		include  MatchConfig
+		expected map[connectionKey]int64
+	}{
+		{
+			name: "All processes",
+			expected: map[connectionKey]int64{
+				{metadata.AttributeProtocolTcp, "ESTABLISHED", "nginx"}:   2,
+				{metadata.AttributeProtocolTcp, "LISTEN", "nginx"}:        1,
+				{metadata.AttributeProtocolTcp, "CLOSE_WAIT", "java"}:     1,
+				{metadata.AttributeProtocolUdp, "NONE", "dnsmasq"}:        1,
+				{metadata.AttributeProtocolTcp, "TIME_WAIT", "unknown"}:   1,
+				{metadata.AttributeProtocolTcp, "ESTABLISHED", "unknown"}: 1,
+			},
+		},
+		{
+			name:    "Included processes",
+			include: MatchConfig{Config: filterset.Config{MatchType: filterset.Regexp}, Names: []string{"^nginx$", "^java"}},
+			expected: map[connectionKey]int64{
+				{metadata.AttributeProtocolTcp, "ESTABLISHED", "nginx"}:   2,
+				{metadata.AttributeProtocolTcp, "LISTEN", "nginx"}:        1,
+				{metadata.AttributeProtocolTcp, "CLOSE_WAIT", "java"}:     1,
+				{metadata.AttributeProtocolUdp, "NONE", "other"}:          1,
+				{metadata.AttributeProtocolTcp, "TIME_WAIT", "unknown"}:   1,
+				{metadata.AttributeProtocolTcp, "ESTABLISHED", "unknown"}: 1,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				Include:              test.include,
+			}
+			scraper, err := newConnectionsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+			scraper.bootTime = func() (uint64, error) { return 100, nil }
+			scraper.connections = func(kind string) ([]net.ConnectionStat, error) {
+				assert.Equal(t, "inet", kind)
+				return testConnections, nil
+			}
+			scraper.processName = testProcessName
+
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			require.Equal(t, 1, md.MetricCount())
+			metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+			assert.Equal(t, "system.network.process.connections", metric.Name())
+
+			actual := make(map[connectionKey]int64)
+			dps := metric.Sum().DataPoints()
+			for i := 0; i < dps.Len(); i++ {
+				dp := dps.At(i)
+				assert.Equal(t, pcommon.Timestamp(100*1e9), dp.StartTimestamp())
+				protocol, _ := dp.Attributes().Get("protocol")
+				state, _ := dp.Attributes().Get("state")
+				processName, _ := dp.Attributes().Get("process.name")
+				actual[connectionKey{metadata.MapAttributeProtocol[protocol.Str()], state.Str(), processName.Str()}] = dp.IntValue()
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestScrapeProcessNameCached(t *testing.T) {
+	scraper, err := newConnectionsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{})
+	require.NoError(t, err)
+
+	lookups := 0
+	scraper.processName = func(ctx context.Context, pid int32) (string, error) {
+		lookups++
+		return testProcessName(ctx, pid)
+	}
+	scraper.countConnections(context.Background(), testConnections)
+	// pid 0 is never looked up
+	assert.Equal(t, 4, lookups)
+}
+
+func TestScrapeError(t *testing.T) {
+	scraper, err := newConnectionsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	})
+	require.NoError(t, err)
+	scraper.connections = func(string) ([]net.ConnectionStat, error) { return nil, errors.New("err1") }
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read connections: err1")
+
+	isPartial := scrapererror.IsPartialScrapeError(err)
+	assert.True(t, isPartial)
+	if isPartial {
+		var scraperErr scrapererror.PartialScrapeError
+		require.ErrorAs(t, err, &scraperErr)
+		assert.Equal(t, connectionsMetricsLen, scraperErr.Failed)
+	}
+}
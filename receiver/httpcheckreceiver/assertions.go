@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
+)
+
+// assertions are the compiled assertionsConfig.
+type assertions struct {
+	statusCode   int
+	bodyContains []string
+	bodyMatches  []*regexp.Regexp
+	json         []jsonAssertionConfig
+}
+
+func newAssertions(cfg assertionsConfig) (*assertions, error) {
+	a := &assertions{
+		statusCode:   cfg.StatusCode,
+		bodyContains: cfg.BodyContains,
+		json:         cfg.JSON,
+	}
+	for _, expr := range cfg.BodyMatches {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		a.bodyMatches = append(a.bodyMatches, re)
+	}
+	return a, nil
+}
+
+func (a *assertions) needBody() bool {
+	return len(a.bodyContains) > 0 || len(a.bodyMatches) > 0 || a.needJSON()
+}
+
+func (a *assertions) needJSON() bool {
+	return len(a.json) > 0
+}
+
+// check returns whether the assertions of every configured type passed, doc
+// is the decoded JSON body or nil.
+func (a *assertions) check(statusCode int, body []byte, doc any) map[metadata.AttributeAssertionType]bool {
+	results := map[metadata.AttributeAssertionType]bool{}
+	if a.statusCode != 0 {
+		results[metadata.AttributeAssertionTypeStatusCode] = statusCode == a.statusCode
+	}
+	if len(a.bodyContains) > 0 {
+		passed := true
+		for _, s := range a.bodyContains {
+			passed = passed && bytes.Contains(body, []byte(s))
+		}
+		results[metadata.AttributeAssertionTypeBodyContains] = passed
+	}
+	if len(a.bodyMatches) > 0 {
+		passed := true
+		for _, re := range a.bodyMatches {
+			passed = passed && re.Match(body)
+		}
+		results[metadata.AttributeAssertionTypeBodyMatches] = passed
+	}
+	if len(a.json) > 0 {
+		passed := true
+		for _, assertion := range a.json {
+			value, ok := lookupJSON(doc, assertion.Path)
+			passed = passed && ok && value == assertion.Value
+		}
+		results[metadata.AttributeAssertionTypeJson] = passed
+	}
+	return results
+}
+
+func decodeJSON(body []byte) (any, error) {
+	var doc any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// lookupJSON returns the value at a path of object keys and array indexes
+// separated by dots, e.g. data.items.0.id, optionally prefixed by $. Strings
+// are returned as is and other values as JSON.
+func lookupJSON(doc any, path string) (string, bool) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	value := doc
+	if path != "" {
+		for _, key := range strings.Split(path, ".") {
+			switch v := value.(type) {
+			case map[string]any:
+				var ok bool
+				if value, ok = v[key]; !ok {
+					return "", false
+				}
+			case []any:
+				i, err := strconv.Atoi(key)
+				if err != nil || i < 0 || i >= len(v) {
+					return "", false
+				}
+				value = v[i]
+			default:
+				return "", false
+			}
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	default:
+		if doc == nil {
+			return "", false
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+}
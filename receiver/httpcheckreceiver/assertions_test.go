@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
+)
+
+func TestLookupJSON(t *testing.T) {
+	doc, err := decodeJSON([]byte(`{"data":{"id":42,"ratio":0.5,"name":"otel","tags":["a","b"],"ok":true,"none":null}}`))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		path     string
+		expected string
+		ok       bool
+	}{
+		{path: "data.id", expected: "42", ok: true},
+		{path: "$.data.ratio", expected: "0.5", ok: true},
+		{path: "data.name", expected: "otel", ok: true},
+		{path: "data.tags.1", expected: "b", ok: true},
+		{path: "data.tags", expected: `["a","b"]`, ok: true},
+		{path: "data.ok", expected: "true", ok: true},
+		{path: "data.none", expected: "null", ok: true},
+		{path: "data.tags.2"},
+		{path: "data.tags.name"},
+		{path: "data.name.first"},
+		{path: "missing"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			value, ok := lookupJSON(doc, tc.path)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+
+	_, ok := lookupJSON(nil, "data.id")
+	assert.False(t, ok)
+}
+
+func TestAssertionsCheck(t *testing.T) {
+	a, err := newAssertions(assertionsConfig{
+		StatusCode:   200,
+		BodyContains: []string{`"status"`, "up"},
+		BodyMatches:  []string{`"version":\s*"1\.`, "^{"},
+		JSON:         []jsonAssertionConfig{{Path: "status", Value: "up"}, {Path: "checks.0.ok", Value: "true"}},
+	})
+	require.NoError(t, err)
+
+	body := []byte(`{"status":"up","version": "1.2.0","checks":[{"ok":true}]}`)
+	doc, err := decodeJSON(body)
+	require.NoError(t, err)
+	assert.Equal(t, map[metadata.AttributeAssertionType]bool{
+		metadata.AttributeAssertionTypeStatusCode:   true,
+		metadata.AttributeAssertionTypeBodyContains: true,
+		metadata.AttributeAssertionTypeBodyMatches:  true,
+		metadata.AttributeAssertionTypeJson:         true,
+	}, a.check(200, body, doc))
+
+	body = []byte(`{"status":"down","version": "2.0.0","checks":[{"ok":false}]}`)
+	doc, err = decodeJSON(body)
+	require.NoError(t, err)
+	assert.Equal(t, map[metadata.AttributeAssertionType]bool{
+		metadata.AttributeAssertionTypeStatusCode:   false,
+		metadata.AttributeAssertionTypeBodyContains: false,
+		metadata.AttributeAssertionTypeBodyMatches:  false,
+		metadata.AttributeAssertionTypeJson:         false,
+	}, a.check(503, body, doc))
+
+	// the JSON assertions fail on bodies which are not JSON
+	assert.False(t, a.check(200, []byte("up"), nil)[metadata.AttributeAssertionTypeJson])
+}
+
+func TestNoAssertions(t *testing.T) {
+	a, err := newAssertions(assertionsConfig{})
+	require.NoError(t, err)
+	assert.False(t, a.needBody())
+	assert.Empty(t, a.check(500, nil, nil))
+}
@@ -6,7 +6,9 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
+	"regexp"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -19,6 +21,8 @@ import (
 var (
 	errMissingEndpoint = errors.New(`"endpoint" must be specified`)
 	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>[:<port>]`)
+	errMissingName     = errors.New(`"name" must be specified for multi-step checks`)
+	errInvalidWindow   = errors.New(`"percentile_window" must not be negative`)
 )
 
 // Config defines the configuration for the various elements of the receiver agent.
@@ -26,11 +30,83 @@ type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig           `mapstructure:",squash"`
 	Targets                                 []*targetConfig `mapstructure:"targets"`
+	// PercentileWindow is the number of most recent durations of every URL
+	// the percentiles of the response times are computed over, 0 for the
+	// default window.
+	PercentileWindow int `mapstructure:"percentile_window"`
 }
 
 type targetConfig struct {
 	confighttp.HTTPClientSettings `mapstructure:",squash"`
-	Method                        string `mapstructure:"method"`
+	Method                        string           `mapstructure:"method"`
+	Body                          string           `mapstructure:"body"`
+	Assertions                    assertionsConfig `mapstructure:"assertions"`
+	// Name identifies a multi-step check, it is required when Steps is set.
+	Name  string        `mapstructure:"name"`
+	Steps []*stepConfig `mapstructure:"steps"`
+	// Resolve maps host names to the IP addresses connected to instead of
+	// the ones returned by DNS.
+	Resolve map[string]string `mapstructure:"resolve"`
+}
+
+// stepConfig is a request of a multi-step check. The endpoint, headers and
+// body may reference the variables extracted by the previous steps as {{name}}.
+type stepConfig struct {
+	Name string `mapstructure:"name"`
+	// Endpoint is either absolute or relative to the endpoint of the target.
+	Endpoint   string            `mapstructure:"endpoint"`
+	Method     string            `mapstructure:"method"`
+	Headers    map[string]string `mapstructure:"headers"`
+	Body       string            `mapstructure:"body"`
+	Assertions assertionsConfig  `mapstructure:"assertions"`
+	// Extract maps variable names to the path of a value in the JSON
+	// response, e.g. data.items.0.id.
+	Extract map[string]string `mapstructure:"extract"`
+}
+
+// assertionsConfig are the checks made on a response, all the configured
+// assertions must pass for a check to succeed.
+type assertionsConfig struct {
+	StatusCode   int                   `mapstructure:"status_code"`
+	BodyContains []string              `mapstructure:"body_contains"`
+	BodyMatches  []string              `mapstructure:"body_matches"`
+	JSON         []jsonAssertionConfig `mapstructure:"json"`
+}
+
+type jsonAssertionConfig struct {
+	Path  string `mapstructure:"path"`
+	Value string `mapstructure:"value"`
+}
+
+// Validate validates the assertions by checking that the expressions are valid
+func (cfg *assertionsConfig) Validate() error {
+	var err error
+
+	for _, expr := range cfg.BodyMatches {
+		if _, compileErr := regexp.Compile(expr); compileErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid body_matches expression %q: %w", expr, compileErr))
+		}
+	}
+	for _, assertion := range cfg.JSON {
+		if assertion.Path == "" {
+			err = multierr.Append(err, errors.New(`"path" must be specified for json assertions`))
+		}
+	}
+
+	return err
+}
+
+// Validate validates the step by checking for invalid fields
+func (cfg *stepConfig) Validate() error {
+	err := cfg.Assertions.Validate()
+
+	for name, path := range cfg.Extract {
+		if path == "" {
+			err = multierr.Append(err, fmt.Errorf("the path of the variable %q must be specified", name))
+		}
+	}
+
+	return err
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -46,6 +122,24 @@ func (cfg *targetConfig) Validate() error {
 		}
 	}
 
+	err = multierr.Append(err, cfg.Assertions.Validate())
+
+	if len(cfg.Steps) > 0 && cfg.Name == "" {
+		err = multierr.Append(err, errMissingName)
+	}
+	for _, step := range cfg.Steps {
+		err = multierr.Append(err, step.Validate())
+	}
+
+	for host, ip := range cfg.Resolve {
+		if net.ParseIP(ip) == nil {
+			err = multierr.Append(err, fmt.Errorf("invalid IP address %q to resolve %q to", ip, host))
+		}
+	}
+	if len(cfg.Resolve) > 0 && (cfg.Auth != nil || cfg.Compression != "") {
+		err = multierr.Append(err, errResolveTransport)
+	}
+
 	return err
 }
 
@@ -57,6 +151,10 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, errors.New("no targets configured"))
 	}
 
+	if cfg.PercentileWindow < 0 {
+		err = multierr.Append(err, errInvalidWindow)
+	}
+
 	for _, target := range cfg.Targets {
 		err = multierr.Append(err, target.Validate())
 	}
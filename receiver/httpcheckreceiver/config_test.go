@@ -4,6 +4,7 @@
 package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -81,6 +82,107 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%w: %s", errInvalidEndpoint, `parse "www.opentelemetry.io/docs": invalid URI for request`),
 			),
 		},
+		{
+			desc: "multi-step check without name",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint: "https://opentelemetry.io",
+						},
+						Steps: []*stepConfig{{Endpoint: "/docs"}},
+					},
+				},
+			},
+			expectedErr: multierr.Combine(
+				errMissingName,
+			),
+		},
+		{
+			desc: "invalid assertions",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint: "https://opentelemetry.io",
+						},
+						Name: "docs",
+						Steps: []*stepConfig{
+							{
+								Endpoint: "/docs",
+								Assertions: assertionsConfig{
+									BodyMatches: []string{"("},
+									JSON:        []jsonAssertionConfig{{Value: "ok"}},
+								},
+								Extract: map[string]string{"id": ""},
+							},
+						},
+					},
+				},
+			},
+			expectedErr: multierr.Combine(
+				errors.New("invalid body_matches expression \"(\": error parsing regexp: missing closing ): `(`"),
+				errors.New(`"path" must be specified for json assertions`),
+				errors.New(`the path of the variable "id" must be specified`),
+			),
+		},
+		{
+			desc: "invalid resolve",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint:    "https://opentelemetry.io",
+							Compression: "gzip",
+						},
+						Resolve: map[string]string{"opentelemetry.io": "localhost"},
+					},
+				},
+			},
+			expectedErr: multierr.Combine(
+				errors.New(`invalid IP address "localhost" to resolve "opentelemetry.io" to`),
+				errResolveTransport,
+			),
+		},
+		{
+			desc: "negative percentile window",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint: "https://opentelemetry.io",
+						},
+					},
+				},
+				PercentileWindow: -1,
+			},
+			expectedErr: multierr.Combine(
+				errInvalidWindow,
+			),
+		},
+		{
+			desc: "valid multi-step config",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint: "https://opentelemetry.io",
+						},
+						Name: "docs",
+						Steps: []*stepConfig{
+							{
+								Endpoint:   "/docs",
+								Assertions: assertionsConfig{StatusCode: 200, BodyMatches: []string{"Open(Telemetry)?"}},
+								Extract:    map[string]string{"id": "data.id"},
+							},
+						},
+						Resolve: map[string]string{"opentelemetry.io": "127.0.0.1"},
+					},
+				},
+				PercentileWindow: 10,
+			},
+			expectedErr: nil,
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
@@ -34,6 +34,7 @@ func createDefaultConfig() component.Config {
 		ScraperControllerSettings: cfg,
 		MetricsBuilderConfig:      metadata.DefaultMetricsBuilderConfig(),
 		Targets:                   []*targetConfig{},
+		PercentileWindow:          defaultPercentileWindow,
 	}
 }
 
@@ -41,6 +41,7 @@ func TestNewFactory(t *testing.T) {
 					},
 					MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 					Targets:              []*targetConfig{},
+					PercentileWindow:     defaultPercentileWindow,
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
@@ -25,22 +25,38 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for httpcheck metrics.
 type MetricsConfig struct {
-	HttpcheckDuration MetricConfig `mapstructure:"httpcheck.duration"`
-	HttpcheckError    MetricConfig `mapstructure:"httpcheck.error"`
-	HttpcheckStatus   MetricConfig `mapstructure:"httpcheck.status"`
+	HttpcheckAssertionStatus     MetricConfig `mapstructure:"httpcheck.assertion.status"`
+	HttpcheckDuration            MetricConfig `mapstructure:"httpcheck.duration"`
+	HttpcheckDurationPercentile  MetricConfig `mapstructure:"httpcheck.duration.percentile"`
+	HttpcheckError               MetricConfig `mapstructure:"httpcheck.error"`
+	HttpcheckStatus              MetricConfig `mapstructure:"httpcheck.status"`
+	HttpcheckTransactionDuration MetricConfig `mapstructure:"httpcheck.transaction.duration"`
+	HttpcheckTransactionStatus   MetricConfig `mapstructure:"httpcheck.transaction.status"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		HttpcheckAssertionStatus: MetricConfig{
+			Enabled: true,
+		},
 		HttpcheckDuration: MetricConfig{
 			Enabled: true,
 		},
+		HttpcheckDurationPercentile: MetricConfig{
+			Enabled: false,
+		},
 		HttpcheckError: MetricConfig{
 			Enabled: true,
 		},
 		HttpcheckStatus: MetricConfig{
 			Enabled: true,
 		},
+		HttpcheckTransactionDuration: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckTransactionStatus: MetricConfig{
+			Enabled: true,
+		},
 	}
 }
 
@@ -26,9 +26,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					HttpcheckDuration: MetricConfig{Enabled: true},
-					HttpcheckError:    MetricConfig{Enabled: true},
-					HttpcheckStatus:   MetricConfig{Enabled: true},
+					HttpcheckAssertionStatus:     MetricConfig{Enabled: true},
+					HttpcheckDuration:            MetricConfig{Enabled: true},
+					HttpcheckDurationPercentile:  MetricConfig{Enabled: true},
+					HttpcheckError:               MetricConfig{Enabled: true},
+					HttpcheckStatus:              MetricConfig{Enabled: true},
+					HttpcheckTransactionDuration: MetricConfig{Enabled: true},
+					HttpcheckTransactionStatus:   MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -36,9 +40,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					HttpcheckDuration: MetricConfig{Enabled: false},
-					HttpcheckError:    MetricConfig{Enabled: false},
-					HttpcheckStatus:   MetricConfig{Enabled: false},
+					HttpcheckAssertionStatus:     MetricConfig{Enabled: false},
+					HttpcheckDuration:            MetricConfig{Enabled: false},
+					HttpcheckDurationPercentile:  MetricConfig{Enabled: false},
+					HttpcheckError:               MetricConfig{Enabled: false},
+					HttpcheckStatus:              MetricConfig{Enabled: false},
+					HttpcheckTransactionDuration: MetricConfig{Enabled: false},
+					HttpcheckTransactionStatus:   MetricConfig{Enabled: false},
 				},
 			},
 		},
@@ -11,6 +11,94 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeAssertionType specifies the a value assertion.type attribute.
+type AttributeAssertionType int
+
+const (
+	_ AttributeAssertionType = iota
+	AttributeAssertionTypeStatusCode
+	AttributeAssertionTypeBodyContains
+	AttributeAssertionTypeBodyMatches
+	AttributeAssertionTypeJson
+)
+
+// String returns the string representation of the AttributeAssertionType.
+func (av AttributeAssertionType) String() string {
+	switch av {
+	case AttributeAssertionTypeStatusCode:
+		return "status_code"
+	case AttributeAssertionTypeBodyContains:
+		return "body_contains"
+	case AttributeAssertionTypeBodyMatches:
+		return "body_matches"
+	case AttributeAssertionTypeJson:
+		return "json"
+	}
+	return ""
+}
+
+// MapAttributeAssertionType is a helper map of string to AttributeAssertionType attribute value.
+var MapAttributeAssertionType = map[string]AttributeAssertionType{
+	"status_code":   AttributeAssertionTypeStatusCode,
+	"body_contains": AttributeAssertionTypeBodyContains,
+	"body_matches":  AttributeAssertionTypeBodyMatches,
+	"json":          AttributeAssertionTypeJson,
+}
+
+type metricHttpcheckAssertionStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.assertion.status metric with initial data.
+func (m *metricHttpcheckAssertionStatus) init() {
+	m.data.SetName("httpcheck.assertion.status")
+	m.data.SetDescription("1 if all the assertions of the type passed on the HTTP response, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckAssertionStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string, assertionTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+	dp.Attributes().PutStr("assertion.type", assertionTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckAssertionStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckAssertionStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckAssertionStatus(cfg MetricConfig) metricHttpcheckAssertionStatus {
+	m := metricHttpcheckAssertionStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHttpcheckDuration struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -62,6 +150,58 @@ func newMetricHttpcheckDuration(cfg MetricConfig) metricHttpcheckDuration {
 	return m
 }
 
+type metricHttpcheckDurationPercentile struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.duration.percentile metric with initial data.
+func (m *metricHttpcheckDurationPercentile) init() {
+	m.data.SetName("httpcheck.duration.percentile")
+	m.data.SetDescription("Percentile of the durations of the HTTP checks of the URL, over the most recent checks.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckDurationPercentile) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string, percentileAttributeValue int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+	dp.Attributes().PutInt("percentile", percentileAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckDurationPercentile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckDurationPercentile) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckDurationPercentile(cfg MetricConfig) metricHttpcheckDurationPercentile {
+	m := metricHttpcheckDurationPercentile{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHttpcheckError struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -172,17 +312,125 @@ func newMetricHttpcheckStatus(cfg MetricConfig) metricHttpcheckStatus {
 	return m
 }
 
+type metricHttpcheckTransactionDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.duration metric with initial data.
+func (m *metricHttpcheckTransactionDuration) init() {
+	m.data.SetName("httpcheck.transaction.duration")
+	m.data.SetDescription("Measures the duration of all the steps of the multi-step check.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionDuration(cfg MetricConfig) metricHttpcheckTransactionDuration {
+	m := metricHttpcheckTransactionDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTransactionStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.status metric with initial data.
+func (m *metricHttpcheckTransactionStatus) init() {
+	m.data.SetName("httpcheck.transaction.status")
+	m.data.SetDescription("1 if all the steps of the multi-step check succeeded, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionStatus(cfg MetricConfig) metricHttpcheckTransactionStatus {
+	m := metricHttpcheckTransactionStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	startTime               pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity         int                 // maximum observed number of metrics per resource.
-	resourceCapacity        int                 // maximum observed number of resource attributes.
-	metricsBuffer           pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo               component.BuildInfo // contains version information
-	metricHttpcheckDuration metricHttpcheckDuration
-	metricHttpcheckError    metricHttpcheckError
-	metricHttpcheckStatus   metricHttpcheckStatus
+	startTime                          pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                    int                 // maximum observed number of metrics per resource.
+	resourceCapacity                   int                 // maximum observed number of resource attributes.
+	metricsBuffer                      pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                          component.BuildInfo // contains version information
+	metricHttpcheckAssertionStatus     metricHttpcheckAssertionStatus
+	metricHttpcheckDuration            metricHttpcheckDuration
+	metricHttpcheckDurationPercentile  metricHttpcheckDurationPercentile
+	metricHttpcheckError               metricHttpcheckError
+	metricHttpcheckStatus              metricHttpcheckStatus
+	metricHttpcheckTransactionDuration metricHttpcheckTransactionDuration
+	metricHttpcheckTransactionStatus   metricHttpcheckTransactionStatus
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -197,12 +445,16 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:               pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:           pmetric.NewMetrics(),
-		buildInfo:               settings.BuildInfo,
-		metricHttpcheckDuration: newMetricHttpcheckDuration(mbc.Metrics.HttpcheckDuration),
-		metricHttpcheckError:    newMetricHttpcheckError(mbc.Metrics.HttpcheckError),
-		metricHttpcheckStatus:   newMetricHttpcheckStatus(mbc.Metrics.HttpcheckStatus),
+		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                      pmetric.NewMetrics(),
+		buildInfo:                          settings.BuildInfo,
+		metricHttpcheckAssertionStatus:     newMetricHttpcheckAssertionStatus(mbc.Metrics.HttpcheckAssertionStatus),
+		metricHttpcheckDuration:            newMetricHttpcheckDuration(mbc.Metrics.HttpcheckDuration),
+		metricHttpcheckDurationPercentile:  newMetricHttpcheckDurationPercentile(mbc.Metrics.HttpcheckDurationPercentile),
+		metricHttpcheckError:               newMetricHttpcheckError(mbc.Metrics.HttpcheckError),
+		metricHttpcheckStatus:              newMetricHttpcheckStatus(mbc.Metrics.HttpcheckStatus),
+		metricHttpcheckTransactionDuration: newMetricHttpcheckTransactionDuration(mbc.Metrics.HttpcheckTransactionDuration),
+		metricHttpcheckTransactionStatus:   newMetricHttpcheckTransactionStatus(mbc.Metrics.HttpcheckTransactionStatus),
 	}
 	for _, op := range options {
 		op(mb)
@@ -255,9 +507,13 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/httpcheckreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricHttpcheckAssertionStatus.emit(ils.Metrics())
 	mb.metricHttpcheckDuration.emit(ils.Metrics())
+	mb.metricHttpcheckDurationPercentile.emit(ils.Metrics())
 	mb.metricHttpcheckError.emit(ils.Metrics())
 	mb.metricHttpcheckStatus.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionDuration.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionStatus.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -278,11 +534,21 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordHttpcheckAssertionStatusDataPoint adds a data point to httpcheck.assertion.status metric.
+func (mb *MetricsBuilder) RecordHttpcheckAssertionStatusDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, assertionTypeAttributeValue AttributeAssertionType) {
+	mb.metricHttpcheckAssertionStatus.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, assertionTypeAttributeValue.String())
+}
+
 // RecordHttpcheckDurationDataPoint adds a data point to httpcheck.duration metric.
 func (mb *MetricsBuilder) RecordHttpcheckDurationDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
 	mb.metricHttpcheckDuration.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
 }
 
+// RecordHttpcheckDurationPercentileDataPoint adds a data point to httpcheck.duration.percentile metric.
+func (mb *MetricsBuilder) RecordHttpcheckDurationPercentileDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, percentileAttributeValue int64) {
+	mb.metricHttpcheckDurationPercentile.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, percentileAttributeValue)
+}
+
 // RecordHttpcheckErrorDataPoint adds a data point to httpcheck.error metric.
 func (mb *MetricsBuilder) RecordHttpcheckErrorDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, errorMessageAttributeValue string) {
 	mb.metricHttpcheckError.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, errorMessageAttributeValue)
@@ -293,6 +559,16 @@ func (mb *MetricsBuilder) RecordHttpcheckStatusDataPoint(ts pcommon.Timestamp, v
 	mb.metricHttpcheckStatus.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpStatusCodeAttributeValue, httpMethodAttributeValue, httpStatusClassAttributeValue)
 }
 
+// RecordHttpcheckTransactionDurationDataPoint adds a data point to httpcheck.transaction.duration metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionDurationDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	mb.metricHttpcheckTransactionDuration.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue)
+}
+
+// RecordHttpcheckTransactionStatusDataPoint adds a data point to httpcheck.transaction.status metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionStatusDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	mb.metricHttpcheckTransactionStatus.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"sort"
+)
+
+const defaultPercentileWindow = 100
+
+// percentiles are the percentiles of the response times reported.
+var percentiles = []int64{50, 90, 99}
+
+// durationWindow keeps the most recent durations of a URL, in milliseconds.
+type durationWindow struct {
+	durations []int64
+	next      int
+}
+
+func newDurationWindow(size int) *durationWindow {
+	return &durationWindow{durations: make([]int64, 0, size)}
+}
+
+func (w *durationWindow) add(d int64) {
+	if len(w.durations) < cap(w.durations) {
+		w.durations = append(w.durations, d)
+		return
+	}
+	w.durations[w.next] = d
+	w.next = (w.next + 1) % len(w.durations)
+}
+
+// percentile returns the p-th percentile of the durations using the nearest
+// rank method.
+func (w *durationWindow) percentile(p int64) int64 {
+	if len(w.durations) == 0 {
+		return 0
+	}
+	sorted := make([]int64, len(w.durations))
+	copy(sorted, w.durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int((p*int64(len(sorted)) + 99) / 100)
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
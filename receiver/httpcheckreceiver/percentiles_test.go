@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationWindow(t *testing.T) {
+	w := newDurationWindow(10)
+	assert.Equal(t, int64(0), w.percentile(50))
+
+	for d := int64(1); d <= 10; d++ {
+		w.add(d)
+	}
+	assert.Equal(t, int64(5), w.percentile(50))
+	assert.Equal(t, int64(9), w.percentile(90))
+	assert.Equal(t, int64(10), w.percentile(99))
+
+	// the oldest durations are replaced once the window is full
+	for d := int64(101); d <= 105; d++ {
+		w.add(d)
+	}
+	assert.Len(t, w.durations, 10)
+	assert.Equal(t, int64(10), w.percentile(50))
+	assert.Equal(t, int64(6), w.percentile(10))
+	assert.Equal(t, int64(105), w.percentile(99))
+}
@@ -26,20 +26,36 @@ var (
 )
 
 type httpcheckScraper struct {
-	clients  []*http.Client
-	cfg      *Config
-	settings component.TelemetrySettings
-	mb       *metadata.MetricsBuilder
+	clients      []*http.Client
+	transactions []*transaction
+	durations    map[string]*durationWindow
+	cfg          *Config
+	settings     component.TelemetrySettings
+	mb           *metadata.MetricsBuilder
 }
 
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (h *httpcheckScraper) start(_ context.Context, host component.Host) (err error) {
 	for _, target := range h.cfg.Targets {
-		client, clentErr := target.ToClient(host, h.settings)
+		tx, txErr := newTransaction(target)
+		if txErr != nil {
+			err = multierr.Append(err, txErr)
+			continue
+		}
+
+		// the headers are set on the requests, with the ones of the steps
+		settings := target.HTTPClientSettings
+		settings.Headers = nil
+		if len(target.Resolve) > 0 {
+			settings.CustomRoundTripper = withResolve(target.Resolve)
+		}
+		client, clentErr := settings.ToClient(host, h.settings)
 		if clentErr != nil {
 			err = multierr.Append(err, clentErr)
+			continue
 		}
 		h.clients = append(h.clients, client)
+		h.transactions = append(h.transactions, tx)
 	}
 	return
 }
@@ -55,38 +71,37 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	var mux sync.Mutex
 
 	for idx, client := range h.clients {
-		go func(targetClient *http.Client, targetIndex int) {
+		go func(targetClient *http.Client, tx *transaction) {
 			defer wg.Done()
 
 			now := pcommon.NewTimestampFromTime(time.Now())
 
-			req, err := http.NewRequestWithContext(ctx, h.cfg.Targets[targetIndex].Method, h.cfg.Targets[targetIndex].Endpoint, http.NoBody)
+			results, err := tx.run(ctx, targetClient)
 			if err != nil {
 				h.settings.Logger.Error("failed to create request", zap.Error(err))
-				return
 			}
 
-			start := time.Now()
-			resp, err := targetClient.Do(req)
 			mux.Lock()
-			h.mb.RecordHttpcheckDurationDataPoint(now, time.Since(start).Milliseconds(), h.cfg.Targets[targetIndex].Endpoint)
-
-			statusCode := 0
-			if err != nil {
-				h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint, err.Error())
-			} else {
-				statusCode = resp.StatusCode
+			defer mux.Unlock()
+			var total time.Duration
+			succeeded := err == nil
+			for _, result := range results {
+				h.recordStep(now, result)
+				total += result.duration
+				succeeded = succeeded && result.succeeded()
 			}
-
-			for class, intVal := range httpResponseClasses {
-				if statusCode/100 == intVal {
-					h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint, int64(statusCode), req.Method, class)
-				} else {
-					h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), h.cfg.Targets[targetIndex].Endpoint, int64(statusCode), req.Method, class)
-				}
+			if tx.name == "" {
+				return
+			}
+			// the steps not sent after a failure count as failed
+			succeeded = succeeded && len(results) == len(tx.steps)
+			h.mb.RecordHttpcheckTransactionDurationDataPoint(now, total.Milliseconds(), tx.name)
+			if succeeded {
+				h.mb.RecordHttpcheckTransactionStatusDataPoint(now, int64(1), tx.name)
+			} else {
+				h.mb.RecordHttpcheckTransactionStatusDataPoint(now, int64(0), tx.name)
 			}
-			mux.Unlock()
-		}(client, idx)
+		}(client, h.transactions[idx])
 	}
 
 	wg.Wait()
@@ -94,10 +109,56 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	return h.mb.Emit(), nil
 }
 
+// recordStep records the metrics of a request, it must be called with the lock held.
+func (h *httpcheckScraper) recordStep(now pcommon.Timestamp, result stepResult) {
+	h.mb.RecordHttpcheckDurationDataPoint(now, result.duration.Milliseconds(), result.url)
+	h.recordPercentiles(now, result)
+
+	if result.err != nil {
+		h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), result.url, result.err.Error())
+	}
+
+	for class, intVal := range httpResponseClasses {
+		if result.statusCode/100 == intVal {
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), result.url, int64(result.statusCode), result.method, class)
+		} else {
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), result.url, int64(result.statusCode), result.method, class)
+		}
+	}
+
+	for assertionType, passed := range result.assertions {
+		if passed {
+			h.mb.RecordHttpcheckAssertionStatusDataPoint(now, int64(1), result.url, assertionType)
+		} else {
+			h.mb.RecordHttpcheckAssertionStatusDataPoint(now, int64(0), result.url, assertionType)
+		}
+	}
+}
+
+func (h *httpcheckScraper) recordPercentiles(now pcommon.Timestamp, result stepResult) {
+	if !h.cfg.Metrics.HttpcheckDurationPercentile.Enabled {
+		return
+	}
+	window, ok := h.durations[result.url]
+	if !ok {
+		size := h.cfg.PercentileWindow
+		if size <= 0 {
+			size = defaultPercentileWindow
+		}
+		window = newDurationWindow(size)
+		h.durations[result.url] = window
+	}
+	window.add(result.duration.Milliseconds())
+	for _, p := range percentiles {
+		h.mb.RecordHttpcheckDurationPercentileDataPoint(now, window.percentile(p), result.url, p)
+	}
+}
+
 func newScraper(conf *Config, settings receiver.CreateSettings) *httpcheckScraper {
 	return &httpcheckScraper{
-		cfg:      conf,
-		settings: settings.TelemetrySettings,
-		mb:       metadata.NewMetricsBuilder(conf.MetricsBuilderConfig, settings),
+		cfg:       conf,
+		settings:  settings.TelemetrySettings,
+		mb:        metadata.NewMetricsBuilder(conf.MetricsBuilderConfig, settings),
+		durations: map[string]*durationWindow{},
 	}
 }
@@ -5,11 +5,15 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -233,3 +237,211 @@ func TestScraperMultipleTargets(t *testing.T) {
 		pmetrictest.IgnoreTimestamp(),
 	))
 }
+
+// newMockTransactionServer serves a login followed by the fetch of an item,
+// which requires the token and the cookie returned by the login.
+func newMockTransactionServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		if req.Method != http.MethodPost || string(body) != `{"user":"otel"}` {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		http.SetCookie(rw, &http.Cookie{Name: "session", Value: "s1"})
+		require.NoError(t, json.NewEncoder(rw).Encode(map[string]string{"token": "abc123"}))
+	})
+	authorized := func(req *http.Request) bool {
+		cookie, err := req.Cookie("session")
+		return err == nil && cookie.Value == "s1" && req.Header.Get("Authorization") == "Bearer abc123"
+	}
+	mux.HandleFunc("/items", func(rw http.ResponseWriter, req *http.Request) {
+		if !authorized(req) {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := rw.Write([]byte(`{"items":[{"id":7,"name":"widget"}]}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/items/7", func(rw http.ResponseWriter, req *http.Request) {
+		if !authorized(req) {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := rw.Write([]byte(`widget in stock`))
+		require.NoError(t, err)
+	})
+	return httptest.NewServer(mux)
+}
+
+func newTransactionTarget(endpoint string) *targetConfig {
+	return &targetConfig{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: endpoint,
+		},
+		Name: "checkout",
+		Steps: []*stepConfig{
+			{
+				Name:     "login",
+				Endpoint: "/login",
+				Method:   http.MethodPost,
+				Body:     `{"user":"otel"}`,
+				Extract:  map[string]string{"token": "token"},
+			},
+			{
+				Name:       "list",
+				Endpoint:   "/items",
+				Headers:    map[string]string{"Authorization": "Bearer {{token}}"},
+				Assertions: assertionsConfig{JSON: []jsonAssertionConfig{{Path: "items.0.name", Value: "widget"}}},
+				Extract:    map[string]string{"item_id": "items.0.id"},
+			},
+			{
+				Name:     "fetch",
+				Endpoint: "/items/{{item_id}}",
+				Headers:  map[string]string{"Authorization": "Bearer {{token}}"},
+				Assertions: assertionsConfig{
+					StatusCode:   http.StatusOK,
+					BodyContains: []string{"widget"},
+					BodyMatches:  []string{"^widget in (stock|transit)$"},
+				},
+			},
+		},
+	}
+}
+
+func TestScraperMultiStep(t *testing.T) {
+	ms := newMockTransactionServer(t)
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{newTransactionTarget(ms.URL)}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	goldenPath := filepath.Join("testdata", "expected_metrics", "multi_step.yaml")
+	expectedMetrics, err := golden.ReadMetrics(goldenPath)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreMetricAttributeValue("http.url"),
+		pmetrictest.IgnoreMetricValues("httpcheck.duration", "httpcheck.transaction.duration"),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp(),
+	))
+}
+
+func TestScraperMultiStepFailedAssertion(t *testing.T) {
+	ms := newMockTransactionServer(t)
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	target := newTransactionTarget(ms.URL)
+	target.Steps[1].Assertions.JSON[0].Value = "gadget"
+	cfg.Targets = []*targetConfig{target}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// the last step is not sent once an assertion failed
+	metrics := metricsByName(actualMetrics)
+	assert.Equal(t, 10, metrics["httpcheck.status"].Sum().DataPoints().Len())
+	assertions := metrics["httpcheck.assertion.status"].Sum().DataPoints()
+	require.Equal(t, 1, assertions.Len())
+	assert.Equal(t, int64(0), assertions.At(0).IntValue())
+	status := metrics["httpcheck.transaction.status"].Sum().DataPoints()
+	require.Equal(t, 1, status.Len())
+	assert.Equal(t, int64(0), status.At(0).IntValue())
+}
+
+func TestScraperResolve(t *testing.T) {
+	ms := newMockServer(t, 200)
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: strings.Replace(ms.URL, "127.0.0.1", "app.example.invalid", 1),
+		},
+		Resolve: map[string]string{"app.example.invalid": "127.0.0.1"},
+	}}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_golden.yaml")
+	expectedMetrics, err := golden.ReadMetrics(goldenPath)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreMetricAttributeValue("http.url"),
+		pmetrictest.IgnoreMetricValues("httpcheck.duration"),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp(),
+	))
+}
+
+func TestScraperResolveWithCompression(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint:    "http://app.example.invalid",
+			Compression: "gzip",
+		},
+		Resolve: map[string]string{"app.example.invalid": "127.0.0.1"},
+	}}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.ErrorIs(t, scraper.start(context.Background(), componenttest.NewNopHost()), errResolveTransport)
+}
+
+func TestScraperDurationPercentiles(t *testing.T) {
+	ms := newMockServer(t, 200)
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.HttpcheckDurationPercentile.Enabled = true
+	cfg.Targets = []*targetConfig{{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ms.URL,
+		},
+	}}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	for i := 0; i < 3; i++ {
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		dps := metricsByName(actualMetrics)["httpcheck.duration.percentile"].Gauge().DataPoints()
+		require.Equal(t, len(percentiles), dps.Len())
+		for j := 0; j < dps.Len(); j++ {
+			p, ok := dps.At(j).Attributes().Get("percentile")
+			require.True(t, ok)
+			assert.Equal(t, percentiles[j], p.Int())
+		}
+	}
+	assert.Len(t, scraper.durations[ms.URL].durations, 3)
+}
+
+func metricsByName(md pmetric.Metrics) map[string]pmetric.Metric {
+	metrics := map[string]pmetric.Metric{}
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	return metrics
+}
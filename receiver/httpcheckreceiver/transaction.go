@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
+)
+
+// maxBodySize is the maximum size of the response bodies read for the
+// assertions and the extraction of variables.
+const maxBodySize = 4 << 20
+
+var (
+	errResolveTransport = errors.New(`"resolve" cannot be combined with "auth" or "compression"`)
+	variablePattern     = regexp.MustCompile(`{{\s*([\w.-]+)\s*}}`)
+)
+
+// transaction is the sequence of requests sent to a target on every scrape.
+// A target without steps is a transaction of a single request.
+type transaction struct {
+	// name is only set for multi-step checks.
+	name    string
+	headers map[string]string
+	steps   []*step
+}
+
+type step struct {
+	method     string
+	endpoint   string
+	headers    map[string]string
+	body       string
+	assertions *assertions
+	extract    map[string]string
+}
+
+// stepResult is the outcome of a request, url is the endpoint of the step
+// before the variables are replaced so that it does not vary between scrapes.
+type stepResult struct {
+	url        string
+	method     string
+	statusCode int
+	duration   time.Duration
+	err        error
+	assertions map[metadata.AttributeAssertionType]bool
+}
+
+func newTransaction(target *targetConfig) (*transaction, error) {
+	tx := &transaction{
+		name:    target.Name,
+		headers: make(map[string]string, len(target.Headers)),
+	}
+	for k, v := range target.Headers {
+		tx.headers[k] = string(v)
+	}
+
+	if len(target.Steps) == 0 {
+		a, err := newAssertions(target.Assertions)
+		if err != nil {
+			return nil, err
+		}
+		tx.steps = []*step{{
+			method:     target.Method,
+			endpoint:   target.Endpoint,
+			body:       target.Body,
+			assertions: a,
+		}}
+		return tx, nil
+	}
+
+	base, err := url.Parse(target.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	for _, sc := range target.Steps {
+		a, assertionsErr := newAssertions(sc.Assertions)
+		if assertionsErr != nil {
+			return nil, assertionsErr
+		}
+		endpoint := target.Endpoint
+		if sc.Endpoint != "" {
+			ref, parseErr := url.Parse(sc.Endpoint)
+			if parseErr != nil {
+				return nil, fmt.Errorf("invalid endpoint of step %q: %w", sc.Name, parseErr)
+			}
+			// url.Parse escapes the braces of the variables, they are restored
+			// so that the variables can be replaced at every scrape
+			endpoint = strings.NewReplacer("%7B", "{", "%7D", "}").Replace(base.ResolveReference(ref).String())
+		}
+		tx.steps = append(tx.steps, &step{
+			method:     sc.Method,
+			endpoint:   endpoint,
+			headers:    sc.Headers,
+			body:       sc.Body,
+			assertions: a,
+			extract:    sc.Extract,
+		})
+	}
+	return tx, nil
+}
+
+// run sends the requests of the steps in order until one of them fails, and
+// returns the results of the steps sent. The cookies set by a step are sent
+// by the following ones.
+func (tx *transaction) run(ctx context.Context, client *http.Client) ([]stepResult, error) {
+	if len(tx.steps) > 1 {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+		c := *client
+		c.Jar = jar
+		client = &c
+	}
+
+	vars := map[string]string{}
+	results := make([]stepResult, 0, len(tx.steps))
+	for _, s := range tx.steps {
+		result, err := s.run(ctx, client, tx.headers, vars)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+		if !result.succeeded() {
+			break
+		}
+	}
+	return results, nil
+}
+
+// run sends the request of the step, an error is only returned if the
+// request could not be created.
+func (s *step) run(ctx context.Context, client *http.Client, headers map[string]string, vars map[string]string) (stepResult, error) {
+	var body io.Reader = http.NoBody
+	if s.body != "" {
+		body = strings.NewReader(expand(s.body, vars))
+	}
+	req, err := http.NewRequestWithContext(ctx, s.method, expand(s.endpoint, vars), body)
+	if err != nil {
+		return stepResult{}, err
+	}
+	for k, v := range headers {
+		setHeader(req, k, v)
+	}
+	for k, v := range s.headers {
+		setHeader(req, k, expand(v, vars))
+	}
+
+	result := stepResult{url: s.endpoint, method: req.Method}
+	start := time.Now()
+	resp, err := client.Do(req)
+	result.duration = time.Since(start)
+	if err != nil {
+		result.err = err
+		return result, nil
+	}
+	defer resp.Body.Close()
+	result.statusCode = resp.StatusCode
+
+	if !s.assertions.needBody() && len(s.extract) == 0 {
+		// drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return result, nil
+	}
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		result.err = err
+		return result, nil
+	}
+
+	var doc any
+	if s.assertions.needJSON() || len(s.extract) > 0 {
+		// the JSON assertions fail on bodies which are not JSON
+		doc, _ = decodeJSON(respBody)
+	}
+	result.assertions = s.assertions.check(resp.StatusCode, respBody, doc)
+
+	for name, path := range s.extract {
+		value, ok := lookupJSON(doc, path)
+		if !ok {
+			result.err = fmt.Errorf("failed to extract %q: no value at %q", name, path)
+			return result, nil
+		}
+		vars[name] = value
+	}
+	return result, nil
+}
+
+// succeeded returns whether the request was sent and all the assertions
+// passed. Without a status code assertion, any status below 400 succeeds.
+func (r stepResult) succeeded() bool {
+	if r.err != nil {
+		return false
+	}
+	if _, ok := r.assertions[metadata.AttributeAssertionTypeStatusCode]; !ok && r.statusCode >= 400 {
+		return false
+	}
+	for _, passed := range r.assertions {
+		if !passed {
+			return false
+		}
+	}
+	return true
+}
+
+func setHeader(req *http.Request, key, value string) {
+	if strings.EqualFold(key, "host") {
+		req.Host = value
+		return
+	}
+	req.Header.Set(key, value)
+}
+
+// expand replaces the {{name}} references to variables, the references to
+// unknown variables are left as is.
+func expand(s string, vars map[string]string) string {
+	if len(vars) == 0 {
+		return s
+	}
+	return variablePattern.ReplaceAllStringFunc(s, func(ref string) string {
+		if v, ok := vars[variablePattern.FindStringSubmatch(ref)[1]]; ok {
+			return v
+		}
+		return ref
+	})
+}
+
+// withResolve returns a round tripper which connects to the IP addresses
+// configured for the host names instead of resolving them.
+func withResolve(hosts map[string]string) func(http.RoundTripper) (http.RoundTripper, error) {
+	return func(next http.RoundTripper) (http.RoundTripper, error) {
+		transport, ok := next.(*http.Transport)
+		if !ok {
+			return nil, errResolveTransport
+		}
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+		transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			if host, port, err := net.SplitHostPort(addr); err == nil {
+				if ip, found := hosts[host]; found {
+					addr = net.JoinHostPort(ip, port)
+				}
+			}
+			return dialer.DialContext(ctx, network, addr)
+		}
+		return transport, nil
+	}
+}
@@ -4,10 +4,13 @@
 package influxdbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/influxdbreceiver"
 
 import (
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 )
 
 // Config defines configuration for the InfluxDB receiver.
 type Config struct {
 	confighttp.HTTPServerSettings `mapstructure:",squash"`
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies.
+	Decompression *component.ID `mapstructure:"decompression"`
 }
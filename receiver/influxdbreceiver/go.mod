@@ -8,6 +8,7 @@ require (
 	github.com/influxdata/influxdb-observability/influx2otel v0.5.4
 	github.com/influxdata/influxdb1-client v0.0.0-20220302092344-a9ab5670611c
 	github.com/influxdata/line-protocol/v2 v2.2.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension v0.81.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/common v0.81.0
 	github.com/stretchr/testify v1.8.4
 	go.opentelemetry.io/collector v0.81.0
@@ -83,3 +84,5 @@ retract (
 )
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil => ../../pkg/pdatautil
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension => ../../extension/httpdecompressionextension
@@ -21,12 +21,14 @@ import (
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/sanitize"
 )
 
 type metricsReceiver struct {
 	nextConsumer       consumer.Metrics
 	httpServerSettings *confighttp.HTTPServerSettings
+	decompression      *component.ID
 	converter          *influx2otel.LineProtocolToOtelMetrics
 
 	server *http.Server
@@ -57,6 +59,7 @@ func newMetricsReceiver(config *Config, settings receiver.CreateSettings, nextCo
 	return &metricsReceiver{
 		nextConsumer:       nextConsumer,
 		httpServerSettings: &config.HTTPServerSettings,
+		decompression:      config.Decompression,
 		converter:          converter,
 		logger:             influxLogger,
 		obsrecv:            obsrecv,
@@ -79,6 +82,9 @@ func (r *metricsReceiver) Start(_ context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	if err = httpdecompressionextension.WrapServer(host, r.decompression, r.server); err != nil {
+		return err
+	}
 	go func() {
 		defer r.wg.Done()
 		if errHTTP := r.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
@@ -28,8 +28,14 @@ const (
 
 // RemoteSamplingConfig defines config key for remote sampling fetch endpoint
 type RemoteSamplingConfig struct {
-	HostEndpoint                  string        `mapstructure:"host_endpoint"`
-	StrategyFile                  string        `mapstructure:"strategy_file"`
+	// HostEndpoint is the endpoint of the HTTP server serving the sampling
+	// strategies to the Jaeger clients, as the Jaeger agent does on port 5778.
+	HostEndpoint string `mapstructure:"host_endpoint"`
+	// StrategyFile is the path or URL of the file defining the sampling
+	// strategies, in the format of the Jaeger strategies file.
+	StrategyFile string `mapstructure:"strategy_file"`
+	// StrategyFileReloadInterval is the interval at which the strategy file
+	// is reloaded, it is not reloaded when zero.
 	StrategyFileReloadInterval    time.Duration `mapstructure:"strategy_file_reload_interval"`
 	configgrpc.GRPCClientSettings `mapstructure:",squash"`
 }
@@ -70,6 +76,9 @@ func DefaultServerConfigUDP() ServerConfigUDP {
 type Config struct {
 	Protocols      `mapstructure:"protocols"`
 	RemoteSampling *RemoteSamplingConfig `mapstructure:"remote_sampling"`
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies
+	// of the `thrift_http` protocol.
+	Decompression *component.ID `mapstructure:"decompression"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -109,8 +118,10 @@ func (cfg *Config) Validate() error {
 	}
 
 	if cfg.RemoteSampling != nil {
-		if err := checkPortFromEndpoint(cfg.RemoteSampling.HostEndpoint); err != nil {
-			return fmt.Errorf("invalid port number for the Remote Sampling endpoint: %w", err)
+		if len(cfg.RemoteSampling.HostEndpoint) != 0 {
+			if err := checkPortFromEndpoint(cfg.RemoteSampling.HostEndpoint); err != nil {
+				return fmt.Errorf("invalid port number for the Remote Sampling endpoint: %w", err)
+			}
 		}
 
 		if len(cfg.RemoteSampling.StrategyFile) != 0 && cfg.GRPC == nil {
@@ -289,3 +289,11 @@ func TestInvalidConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoteSamplingWithoutStrategyFile(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RemoteSampling = &RemoteSamplingConfig{
+		HostEndpoint: "localhost:5778",
+	}
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
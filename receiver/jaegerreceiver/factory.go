@@ -87,6 +87,7 @@ func createTracesReceiver(
 
 	if rCfg.Protocols.ThriftHTTP != nil {
 		config.CollectorHTTPSettings = *rCfg.ThriftHTTP
+		config.CollectorHTTPDecompression = rCfg.Decompression
 	}
 
 	if rCfg.Protocols.ThriftBinary != nil {
@@ -97,6 +98,12 @@ func createTracesReceiver(
 		config.AgentCompactThrift = *rCfg.ThriftCompact
 	}
 
+	if rCfg.RemoteSampling != nil {
+		config.AgentHTTPEndpoint = rCfg.RemoteSampling.HostEndpoint
+		config.RemoteSamplingStrategyFile = rCfg.RemoteSampling.StrategyFile
+		config.RemoteSamplingStrategyFileReloadInterval = rCfg.RemoteSampling.StrategyFileReloadInterval
+	}
+
 	// Create the receiver.
 	return newJaegerReceiver(set.ID, &config, nextConsumer, set)
 }
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jaegerreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jaegerreceiver"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jaegertracing/jaeger/cmd/agent/app/configmanager"
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/static"
+	"github.com/jaegertracing/jaeger/thrift-gen/baggage"
+)
+
+var _ configmanager.ClientConfigManager = (*strategyConfigManager)(nil)
+
+// strategyConfigManager serves the sampling strategies of a strategy store to
+// the Jaeger clients and agents. Baggage restrictions are not supported.
+type strategyConfigManager struct {
+	strategystore.StrategyStore
+}
+
+func (strategyConfigManager) GetBaggageRestrictions(_ context.Context, _ string) ([]*baggage.BaggageRestriction, error) {
+	return nil, errNotImplemented
+}
+
+// startSamplingStore loads the sampling strategies of the strategy file, which
+// is either a local file or a URL. The strategies are reloaded periodically
+// when a reload interval is set, and the last strategies loaded are kept when
+// the file cannot be read.
+func (jr *jReceiver) startSamplingStore() error {
+	if jr.config == nil || jr.config.RemoteSamplingStrategyFile == "" {
+		return nil
+	}
+
+	store, err := static.NewStrategyStore(static.Options{
+		StrategiesFile: jr.config.RemoteSamplingStrategyFile,
+		ReloadInterval: jr.config.RemoteSamplingStrategyFileReloadInterval,
+	}, jr.settings.Logger)
+	if err != nil {
+		return fmt.Errorf("failed to load the sampling strategies: %w", err)
+	}
+	jr.samplingStore = store
+	return nil
+}
+
+// stopSamplingStore stops the periodic reload of the sampling strategies.
+func (jr *jReceiver) stopSamplingStore() {
+	// The static strategy store can be closed, although the StrategyStore
+	// interface does not say so.
+	if closer, ok := jr.samplingStore.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
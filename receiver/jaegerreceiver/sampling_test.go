@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jaegerreceiver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configgrpc"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func startSamplingReceiver(t *testing.T, config *configuration) api_v2.SamplingManagerClient {
+	config.CollectorGRPCServerSettings = configgrpc.GRPCServerSettings{NetAddr: confignet.NetAddr{
+		Endpoint:  testutil.GetAvailableLocalAddress(t),
+		Transport: "tcp",
+	}}
+
+	jr, err := newJaegerReceiver(jaegerReceiver, config, new(consumertest.TracesSink), receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, jr.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, jr.Shutdown(context.Background())) })
+
+	conn, err := grpc.Dial(config.CollectorGRPCServerSettings.NetAddr.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return api_v2.NewSamplingManagerClient(conn)
+}
+
+func TestSamplingStrategyFile(t *testing.T) {
+	cl := startSamplingReceiver(t, &configuration{
+		RemoteSamplingStrategyFile: filepath.Join("testdata", "strategies.json"),
+	})
+
+	resp, err := cl.GetSamplingStrategy(context.Background(), &api_v2.SamplingStrategyParameters{
+		ServiceName: "foo",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, api_v2.SamplingStrategyType_PROBABILISTIC, resp.StrategyType)
+	assert.Equal(t, 0.8, resp.ProbabilisticSampling.SamplingRate)
+	require.NotNil(t, resp.OperationSampling)
+	require.Len(t, resp.OperationSampling.PerOperationStrategies, 2)
+	assert.Equal(t, "op1", resp.OperationSampling.PerOperationStrategies[0].Operation)
+	assert.Equal(t, 0.2, resp.OperationSampling.PerOperationStrategies[0].ProbabilisticSampling.SamplingRate)
+	assert.Equal(t, "op2", resp.OperationSampling.PerOperationStrategies[1].Operation)
+	assert.Equal(t, 0.4, resp.OperationSampling.PerOperationStrategies[1].ProbabilisticSampling.SamplingRate)
+
+	resp, err = cl.GetSamplingStrategy(context.Background(), &api_v2.SamplingStrategyParameters{
+		ServiceName: "bar",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, api_v2.SamplingStrategyType_RATE_LIMITING, resp.StrategyType)
+	assert.EqualValues(t, 5, resp.RateLimitingSampling.MaxTracesPerSecond)
+
+	// Services without a strategy of their own get the default strategy.
+	resp, err = cl.GetSamplingStrategy(context.Background(), &api_v2.SamplingStrategyParameters{
+		ServiceName: "unknown",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, api_v2.SamplingStrategyType_PROBABILISTIC, resp.StrategyType)
+	assert.Equal(t, 0.5, resp.ProbabilisticSampling.SamplingRate)
+}
+
+func TestSamplingStrategyFileInvalid(t *testing.T) {
+	config := &configuration{
+		RemoteSamplingStrategyFile: filepath.Join("testdata", "does-not-exist.json"),
+	}
+	jr, err := newJaegerReceiver(jaegerReceiver, config, new(consumertest.TracesSink), receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	assert.Error(t, jr.Start(context.Background(), componenttest.NewNopHost()))
+}
+
+func TestSamplingStrategyFileReload(t *testing.T) {
+	strategyFile := filepath.Join(t.TempDir(), "strategies.json")
+	writeStrategies := func(rate float64) {
+		content := fmt.Sprintf(`{"service_strategies":[{"service":"foo","type":"probabilistic","param":%v}]}`, rate)
+		require.NoError(t, os.WriteFile(strategyFile, []byte(content), 0600))
+	}
+	writeStrategies(0.8)
+
+	cl := startSamplingReceiver(t, &configuration{
+		RemoteSamplingStrategyFile:               strategyFile,
+		RemoteSamplingStrategyFileReloadInterval: 10 * time.Millisecond,
+	})
+
+	samplingRate := func() float64 {
+		resp, err := cl.GetSamplingStrategy(context.Background(), &api_v2.SamplingStrategyParameters{
+			ServiceName: "foo",
+		})
+		require.NoError(t, err)
+		return resp.ProbabilisticSampling.SamplingRate
+	}
+	assert.Equal(t, 0.8, samplingRate())
+
+	writeStrategies(0.3)
+	assert.Eventually(t, func() bool {
+		return samplingRate() == 0.3
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSamplingAgentHTTP(t *testing.T) {
+	agentEndpoint := testutil.GetAvailableLocalAddress(t)
+	startSamplingReceiver(t, &configuration{
+		AgentHTTPEndpoint:          agentEndpoint,
+		RemoteSamplingStrategyFile: filepath.Join("testdata", "strategies.json"),
+	})
+
+	var body []byte
+	require.Eventually(t, func() bool {
+		resp, err := http.Get(fmt.Sprintf("http://%s/sampling?service=bar", agentEndpoint))
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		body, err = io.ReadAll(resp.Body)
+		return err == nil && resp.StatusCode == http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Contains(t, string(body), `"maxTracesPerSecond":5`)
+}
@@ -12,6 +12,7 @@ import (
 	"mime"
 	"net/http"
 	"sync"
+	"time"
 
 	apacheThrift "github.com/apache/thrift/lib/go/thrift"
 	"github.com/gorilla/mux"
@@ -20,7 +21,10 @@ import (
 	"github.com/jaegertracing/jaeger/cmd/agent/app/processors"
 	"github.com/jaegertracing/jaeger/cmd/agent/app/servers"
 	"github.com/jaegertracing/jaeger/cmd/agent/app/servers/thriftudp"
+	collectorsampling "github.com/jaegertracing/jaeger/cmd/collector/app/sampling"
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
 	"github.com/jaegertracing/jaeger/model"
+	"github.com/jaegertracing/jaeger/pkg/clientcfg/clientcfghttp"
 	"github.com/jaegertracing/jaeger/pkg/metrics"
 	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
 	"github.com/jaegertracing/jaeger/thrift-gen/agent"
@@ -37,6 +41,7 @@ import (
 	"go.uber.org/multierr"
 	"google.golang.org/grpc"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 	jaegertranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
 )
 
@@ -44,11 +49,15 @@ import (
 // the Jaeger receiver will use.
 type configuration struct {
 	CollectorHTTPSettings       confighttp.HTTPServerSettings
+	CollectorHTTPDecompression  *component.ID
 	CollectorGRPCServerSettings configgrpc.GRPCServerSettings
 
 	AgentCompactThrift ProtocolUDP
 	AgentBinaryThrift  ProtocolUDP
 	AgentHTTPEndpoint  string
+
+	RemoteSamplingStrategyFile               string
+	RemoteSamplingStrategyFileReloadInterval time.Duration
 }
 
 // Receiver type is used to receive spans that were originally intended to be sent to Jaeger.
@@ -65,6 +74,8 @@ type jReceiver struct {
 	agentProcessors []processors.Processor
 	agentServer     *http.Server
 
+	samplingStore strategystore.StrategyStore
+
 	goroutines sync.WaitGroup
 
 	settings receiver.CreateSettings
@@ -126,6 +137,10 @@ func newJaegerReceiver(
 }
 
 func (jr *jReceiver) Start(_ context.Context, host component.Host) error {
+	if err := jr.startSamplingStore(); err != nil {
+		return err
+	}
+
 	if err := jr.startAgent(host); err != nil {
 		return err
 	}
@@ -155,6 +170,7 @@ func (jr *jReceiver) Shutdown(ctx context.Context) error {
 	}
 
 	jr.goroutines.Wait()
+	jr.stopSamplingStore()
 	return errs
 }
 
@@ -185,6 +201,15 @@ func (notImplementedConfigManager) GetBaggageRestrictions(_ context.Context, _ s
 	return nil, errNotImplemented
 }
 
+// clientConfigManager returns the manager serving the sampling strategies to
+// the Jaeger clients and agents.
+func (jr *jReceiver) clientConfigManager() configmanager.ClientConfigManager {
+	if jr.samplingStore != nil {
+		return &strategyConfigManager{StrategyStore: jr.samplingStore}
+	}
+	return &notImplementedConfigManager{}
+}
+
 type agentHandler struct {
 	nextConsumer consumer.Traces
 	obsrecv      *obsreport.Receiver
@@ -278,7 +303,7 @@ func (jr *jReceiver) startAgent(host component.Host) error {
 	}
 
 	if jr.config.AgentHTTPEndpoint != "" {
-		jr.agentServer = httpserver.NewHTTPServer(jr.config.AgentHTTPEndpoint, &notImplementedConfigManager{}, metrics.NullFactory, jr.settings.Logger)
+		jr.agentServer = httpserver.NewHTTPServer(jr.config.AgentHTTPEndpoint, jr.clientConfigManager(), metrics.NullFactory, jr.settings.Logger)
 
 		jr.goroutines.Add(1)
 		go func() {
@@ -383,10 +408,20 @@ func (jr *jReceiver) startCollector(host component.Host) error {
 
 		nr := mux.NewRouter()
 		nr.HandleFunc("/api/traces", jr.HandleThriftHTTPBatch).Methods(http.MethodPost)
+		if jr.samplingStore != nil {
+			clientcfghttp.NewHTTPHandler(clientcfghttp.HTTPHandlerParams{
+				ConfigManager:  jr.clientConfigManager(),
+				MetricsFactory: metrics.NullFactory,
+				BasePath:       "/api",
+			}).RegisterRoutes(nr)
+		}
 		jr.collectorServer, err = jr.config.CollectorHTTPSettings.ToServer(host, jr.settings.TelemetrySettings, nr)
 		if err != nil {
 			return err
 		}
+		if err = httpdecompressionextension.WrapServer(host, jr.config.CollectorHTTPDecompression, jr.collectorServer); err != nil {
+			return err
+		}
 
 		jr.goroutines.Add(1)
 		go func() {
@@ -410,6 +445,9 @@ func (jr *jReceiver) startCollector(host component.Host) error {
 		}
 
 		api_v2.RegisterCollectorServiceServer(jr.grpc, jr)
+		if jr.samplingStore != nil {
+			api_v2.RegisterSamplingManagerServer(jr.grpc, collectorsampling.NewGRPCHandler(jr.samplingStore))
+		}
 
 		jr.goroutines.Add(1)
 		go func() {
@@ -9,20 +9,34 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"sort"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/jolokia"
+)
+
+const (
+	// modeGatherer runs the JMX Metric Gatherer JAR in a child JRE process.
+	modeGatherer = "gatherer"
+	// modeJolokia reads the MBeans through the HTTP API of a Jolokia agent.
+	modeJolokia = "jolokia"
 )
 
 type Config struct {
+	// How the MBeans are read. Should be one of `"gatherer"` (default) or `"jolokia"`.
+	Mode string `mapstructure:"mode"`
 	// The path for the JMX Metric Gatherer uber JAR (/opt/opentelemetry-java-contrib-jmx-metrics.jar by default).
 	JARPath string `mapstructure:"jar_path"`
 	// The Service URL or host:port for the target coerced to one of form: service:jmx:rmi:///jndi/rmi://<host>:<port>/jmxrmi.
+	// The URL of the Jolokia agent in jolokia mode, e.g. http://<host>:8778/jolokia.
 	Endpoint string `mapstructure:"endpoint"`
 	// The target system for the metric gatherer whose built in groovy script to run.
 	TargetSystem string `mapstructure:"target_system"`
@@ -31,9 +45,9 @@ type Config struct {
 	CollectionInterval time.Duration `mapstructure:"collection_interval"`
 	// The exporter settings for
 	OTLPExporterConfig otlpExporterConfig `mapstructure:"otlp"`
-	// The JMX username
+	// The JMX username, or the username of the Jolokia agent in jolokia mode
 	Username string `mapstructure:"username"`
-	// The JMX password
+	// The JMX password, or the password of the Jolokia agent in jolokia mode
 	Password string `mapstructure:"password"`
 	// The keystore path for SSL
 	KeystorePath string `mapstructure:"keystore_path"`
@@ -60,6 +74,8 @@ type Config struct {
 	// Log level used by the JMX metric gatherer. Should be one of:
 	// `"trace"`, `"debug"`, `"info"`, `"warn"`, `"error"`, `"off"`
 	LogLevel string `mapstructure:"log_level"`
+	// The settings of the jolokia mode
+	Jolokia jolokiaConfig `mapstructure:"jolokia"`
 }
 
 // We don't embed the existing OTLP Exporter config as most fields are unsupported
@@ -72,6 +88,38 @@ type otlpExporterConfig struct {
 	Headers map[string]string `mapstructure:"headers"`
 }
 
+type jolokiaConfig struct {
+	// The timeout of the requests to the Jolokia agent (10 seconds by default).
+	Timeout time.Duration `mapstructure:"timeout"`
+	// The TLS settings of the connection to the Jolokia agent.
+	TLSSetting configtls.TLSClientSetting `mapstructure:"tls"`
+	// The MBeans to read in addition to the ones of the target systems.
+	MBeans []mbeanConfig `mapstructure:"mbeans"`
+}
+
+// mbeanConfig reads metrics from the attributes of the MBeans matching an object name.
+type mbeanConfig struct {
+	// The object name or pattern of the MBeans, e.g. kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=*
+	ObjectName string `mapstructure:"object_name"`
+	// Map of data point attributes to the key properties of the object name they are set to, e.g. topic: topic
+	Attributes map[string]string `mapstructure:"attributes"`
+	// The metrics read from the attributes of the MBeans
+	Metrics []mbeanMetricConfig `mapstructure:"metrics"`
+}
+
+type mbeanMetricConfig struct {
+	// The name of the metric
+	Name string `mapstructure:"name"`
+	// The MBean attribute, or the path to an item of a composite attribute, e.g. HeapMemoryUsage/used
+	Attribute string `mapstructure:"attribute"`
+	// The type of the metric. Should be one of `"gauge"` (default) or `"sum"` for cumulative monotonic sums.
+	Type string `mapstructure:"type"`
+	// The unit of the metric
+	Unit string `mapstructure:"unit"`
+	// The description of the metric
+	Description string `mapstructure:"description"`
+}
+
 func (oec otlpExporterConfig) headersToString() string {
 	// sort for reliable testing
 	headers := make([]string, 0, len(oec.Headers))
@@ -215,6 +263,17 @@ func initAdditionalTargetSystems() {
 }
 
 func (c *Config) Validate() error {
+	switch c.Mode {
+	case "", modeGatherer:
+		return c.validateGatherer()
+	case modeJolokia:
+		return c.validateJolokia()
+	default:
+		return fmt.Errorf("`mode` must be one of '%s', '%s'", modeGatherer, modeJolokia)
+	}
+}
+
+func (c *Config) validateGatherer() error {
 	var missingFields []string
 	if c.JARPath == "" {
 		missingFields = append(missingFields, "`jar_path`")
@@ -265,6 +324,65 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func (c *Config) validateJolokia() error {
+	if c.Endpoint == "" {
+		return errors.New("missing required field(s): `endpoint`")
+	}
+	if c.TargetSystem == "" && len(c.Jolokia.MBeans) == 0 {
+		return errors.New("missing required field(s): `target_system` or `jolokia.mbeans`")
+	}
+
+	u, err := url.Parse(c.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("`endpoint` must be the http or https URL of the Jolokia agent in jolokia mode: %s", c.Endpoint)
+	}
+
+	if c.CollectionInterval < 0 {
+		return fmt.Errorf("`interval` must be positive: %vms", c.CollectionInterval.Milliseconds())
+	}
+
+	if c.Jolokia.Timeout < 0 {
+		return fmt.Errorf("`jolokia.timeout` must be positive: %vms", c.Jolokia.Timeout.Milliseconds())
+	}
+
+	if c.TargetSystem != "" {
+		for _, system := range strings.Split(c.TargetSystem, ",") {
+			if _, ok := jolokiaTargetSystems[strings.ToLower(system)]; !ok {
+				return fmt.Errorf("`target_system` list may only be a subset of %s in jolokia mode", listTargetSystems(jolokiaTargetSystems))
+			}
+		}
+	}
+
+	for i, mbean := range c.Jolokia.MBeans {
+		if err = mbean.validate(); err != nil {
+			return fmt.Errorf("invalid `jolokia.mbeans[%d]`: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (m mbeanConfig) validate() error {
+	if m.ObjectName == "" {
+		return errors.New("missing required field `object_name`")
+	}
+	if _, err := jolokia.ParseObjectName(m.ObjectName); err != nil {
+		return err
+	}
+	if len(m.Metrics) == 0 {
+		return errors.New("missing required field `metrics`")
+	}
+	for _, metric := range m.Metrics {
+		if metric.Name == "" || metric.Attribute == "" {
+			return errors.New("`name` and `attribute` are required for every metric")
+		}
+		if metric.Type != "" && metric.Type != metricTypeGauge && metric.Type != metricTypeSum {
+			return fmt.Errorf("`type` of metric %s must be one of '%s', '%s'", metric.Name, metricTypeGauge, metricTypeSum)
+		}
+	}
+	return nil
+}
+
 func listKeys(presenceMap map[string]struct{}) string {
 	list := make([]string, 0, len(presenceMap))
 	for k := range presenceMap {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
@@ -197,6 +198,126 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "jolokia"),
+			expected: &Config{
+				Mode:               "jolokia",
+				Endpoint:           "http://myjolokiahost:8778/jolokia",
+				TargetSystem:       "jvm,kafka",
+				Username:           "myusername",
+				Password:           "mypassword",
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+				Jolokia: jolokiaConfig{
+					Timeout: 5 * time.Second,
+					TLSSetting: configtls.TLSClientSetting{
+						InsecureSkipVerify: true,
+					},
+					MBeans: []mbeanConfig{
+						{
+							ObjectName: "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=*",
+							Attributes: map[string]string{"topic": "topic"},
+							Metrics: []mbeanMetricConfig{
+								{
+									Name:        "kafka.topic.message.count",
+									Attribute:   "Count",
+									Type:        "sum",
+									Unit:        "{messages}",
+									Description: "The number of messages received by the topic",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "jolokiainvalidendpoint"),
+			expectedErr: "`endpoint` must be the http or https URL of the Jolokia agent in jolokia mode: myjolokiahost:8778",
+			expected: &Config{
+				Mode:               "jolokia",
+				Endpoint:           "myjolokiahost:8778",
+				TargetSystem:       "jvm",
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "jolokiainvalidtargetsystem"),
+			expectedErr: "`target_system` list may only be a subset of 'activemq', 'cassandra', 'jvm', 'kafka' in jolokia mode",
+			expected: &Config{
+				Mode:               "jolokia",
+				Endpoint:           "http://myjolokiahost:8778/jolokia",
+				TargetSystem:       "jvm,tomcat",
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "jolokiainvalidmbean"),
+			expectedErr: "invalid `jolokia.mbeans[0]`: `type` of metric kafka.message.count must be one of 'gauge', 'sum'",
+			expected: &Config{
+				Mode:               "jolokia",
+				Endpoint:           "http://myjolokiahost:8778/jolokia",
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+				Jolokia: jolokiaConfig{
+					MBeans: []mbeanConfig{
+						{
+							ObjectName: "kafka.server:type=BrokerTopicMetrics",
+							Metrics: []mbeanMetricConfig{
+								{
+									Name:      "kafka.message.count",
+									Attribute: "Count",
+									Type:      "counter",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidmode"),
+			expectedErr: "`mode` must be one of 'gatherer', 'jolokia'",
+			expected: &Config{
+				Mode:               "jmxmp",
+				Endpoint:           "myendpoint:55555",
+				TargetSystem:       "jvm",
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
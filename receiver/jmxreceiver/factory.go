@@ -46,5 +46,8 @@ func createReceiver(
 	consumer consumer.Metrics,
 ) (receiver.Metrics, error) {
 	jmxConfig := cfg.(*Config)
+	if jmxConfig.Mode == modeJolokia {
+		return newJolokiaReceiver(params, jmxConfig, consumer)
+	}
 	return newJMXMetricReceiver(params, jmxConfig, consumer), nil
 }
@@ -34,3 +34,18 @@ func TestWithValidConfig(t *testing.T) {
 	assert.Same(t, receiver.logger, params.Logger)
 	assert.Same(t, receiver.config, cfg)
 }
+
+func TestWithJolokiaConfig(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Mode = modeJolokia
+	cfg.Endpoint = "http://myjolokiahost:8778/jolokia"
+	cfg.TargetSystem = "jvm"
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	r, err := f.CreateMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	_, isGatherer := r.(*jmxMetricReceiver)
+	assert.False(t, isGatherer)
+}
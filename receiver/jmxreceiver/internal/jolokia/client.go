@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jolokia // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/jolokia"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrNotFound is returned for the reads of MBeans which are not registered.
+var ErrNotFound = errors.New("mbean not found")
+
+// ReadRequest reads attributes of the MBeans matching an object name or pattern.
+type ReadRequest struct {
+	MBean      string
+	Attributes []string
+}
+
+// ReadResult holds the attributes read for every MBean matching the object name
+// of a ReadRequest, by object name.
+type ReadResult struct {
+	Values map[string]map[string]any
+	Err    error
+}
+
+type request struct {
+	Type      string          `json:"type"`
+	MBean     string          `json:"mbean"`
+	Attribute []string        `json:"attribute,omitempty"`
+	Config    map[string]bool `json:"config,omitempty"`
+}
+
+type response struct {
+	Status int             `json:"status"`
+	Value  json.RawMessage `json:"value"`
+	Error  string          `json:"error"`
+}
+
+// Client reads MBean attributes through the HTTP API of a Jolokia agent.
+// See https://jolokia.org/reference/html/protocol.html
+type Client struct {
+	client   *http.Client
+	endpoint string
+	username string
+	password string
+}
+
+// NewClient creates a client for the Jolokia agent at endpoint, e.g.
+// http://localhost:8778/jolokia. Basic authentication is used if username
+// is not empty.
+func NewClient(client *http.Client, endpoint, username, password string) *Client {
+	return &Client{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/") + "/",
+		username: username,
+		password: password,
+	}
+}
+
+// Read sends the requests in a single bulk request. The results are in the
+// same order as the requests, an error is only returned if the bulk request
+// failed as a whole.
+func (c *Client) Read(ctx context.Context, requests []ReadRequest) ([]ReadResult, error) {
+	body := make([]request, 0, len(requests))
+	for _, r := range requests {
+		body = append(body, request{
+			Type:      "read",
+			MBean:     r.MBean,
+			Attribute: r.Attributes,
+			// skip the attributes missing on some of the MBeans matching a pattern
+			Config: map[string]bool{"ignoreErrors": true},
+		})
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("request to %s failed with status %d", c.endpoint, resp.StatusCode)
+	}
+
+	var responses []response
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err = decoder.Decode(&responses); err != nil {
+		return nil, fmt.Errorf("failed to decode the response of the Jolokia agent: %w", err)
+	}
+	if len(responses) != len(requests) {
+		return nil, fmt.Errorf("the Jolokia agent returned %d responses for %d requests", len(responses), len(requests))
+	}
+
+	results := make([]ReadResult, 0, len(requests))
+	for i, r := range responses {
+		var result ReadResult
+		result.Values, result.Err = r.values(requests[i])
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// values returns the attributes by object name. The value of a read depends on
+// whether the object name is a pattern and on the number of attributes read.
+func (r response) values(req ReadRequest) (map[string]map[string]any, error) {
+	switch r.Status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("failed to read %s: %w", req.MBean, ErrNotFound)
+	default:
+		return nil, fmt.Errorf("failed to read %s: %s", req.MBean, r.Error)
+	}
+
+	if IsPattern(req.MBean) {
+		values := map[string]map[string]any{}
+		if err := unmarshal(r.Value, &values); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", req.MBean, err)
+		}
+		return values, nil
+	}
+
+	if len(req.Attributes) == 1 {
+		var value any
+		if err := unmarshal(r.Value, &value); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", req.MBean, err)
+		}
+		return map[string]map[string]any{req.MBean: {req.Attributes[0]: value}}, nil
+	}
+
+	attributes := map[string]any{}
+	if err := unmarshal(r.Value, &attributes); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", req.MBean, err)
+	}
+	return map[string]map[string]any{req.MBean: attributes}, nil
+}
+
+func unmarshal(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jolokia
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/jolokia/", req.URL.Path)
+		user, pass, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "monitoring", user)
+		assert.Equal(t, "secret", pass)
+
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[
+			{"type":"read","mbean":"java.lang:type=Threading","attribute":["ThreadCount"],"config":{"ignoreErrors":true}},
+			{"type":"read","mbean":"java.lang:type=Memory","attribute":["HeapMemoryUsage","NonHeapMemoryUsage"],"config":{"ignoreErrors":true}},
+			{"type":"read","mbean":"java.lang:type=GarbageCollector,name=*","attribute":["CollectionCount"],"config":{"ignoreErrors":true}},
+			{"type":"read","mbean":"java.lang:type=Compilation","attribute":["TotalCompilationTime"],"config":{"ignoreErrors":true}},
+			{"type":"read","mbean":"java.lang:type=Runtime","attribute":["Uptime"],"config":{"ignoreErrors":true}}
+		]`, string(body))
+
+		_, err = rw.Write([]byte(`[
+			{"status":200,"value":37},
+			{"status":200,"value":{"HeapMemoryUsage":{"used":104857600},"NonHeapMemoryUsage":{"used":52428800}}},
+			{"status":200,"value":{"java.lang:name=G1 Young Generation,type=GarbageCollector":{"CollectionCount":42}}},
+			{"status":404,"error":"javax.management.InstanceNotFoundException : java.lang:type=Compilation"},
+			{"status":403,"error":"java.lang.Exception : Reading attribute Uptime is forbidden"}
+		]`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client(), server.URL+"/jolokia", "monitoring", "secret")
+	results, err := client.Read(context.Background(), []ReadRequest{
+		{MBean: "java.lang:type=Threading", Attributes: []string{"ThreadCount"}},
+		{MBean: "java.lang:type=Memory", Attributes: []string{"HeapMemoryUsage", "NonHeapMemoryUsage"}},
+		{MBean: "java.lang:type=GarbageCollector,name=*", Attributes: []string{"CollectionCount"}},
+		{MBean: "java.lang:type=Compilation", Attributes: []string{"TotalCompilationTime"}},
+		{MBean: "java.lang:type=Runtime", Attributes: []string{"Uptime"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, map[string]map[string]any{
+		"java.lang:type=Threading": {"ThreadCount": json.Number("37")},
+	}, results[0].Values)
+
+	require.NoError(t, results[1].Err)
+	assert.Equal(t, map[string]map[string]any{
+		"java.lang:type=Memory": {
+			"HeapMemoryUsage":    map[string]any{"used": json.Number("104857600")},
+			"NonHeapMemoryUsage": map[string]any{"used": json.Number("52428800")},
+		},
+	}, results[1].Values)
+
+	require.NoError(t, results[2].Err)
+	assert.Equal(t, map[string]map[string]any{
+		"java.lang:name=G1 Young Generation,type=GarbageCollector": {"CollectionCount": json.Number("42")},
+	}, results[2].Values)
+
+	assert.ErrorIs(t, results[3].Err, ErrNotFound)
+	assert.EqualError(t, results[4].Err, "failed to read java.lang:type=Runtime: java.lang.Exception : Reading attribute Uptime is forbidden")
+}
+
+func TestReadErrors(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		status      int
+		body        string
+		expectedErr string
+	}{
+		{
+			desc:        "error status",
+			status:      http.StatusUnauthorized,
+			expectedErr: "failed with status 401",
+		},
+		{
+			desc:        "invalid body",
+			status:      http.StatusOK,
+			body:        `{"status":200}`,
+			expectedErr: "failed to decode the response of the Jolokia agent",
+		},
+		{
+			desc:        "missing responses",
+			status:      http.StatusOK,
+			body:        `[]`,
+			expectedErr: "the Jolokia agent returned 0 responses for 1 requests",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				rw.WriteHeader(tc.status)
+				_, err := rw.Write([]byte(tc.body))
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.Client(), server.URL, "", "")
+			_, err := client.Read(context.Background(), []ReadRequest{
+				{MBean: "java.lang:type=Threading", Attributes: []string{"ThreadCount"}},
+			})
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
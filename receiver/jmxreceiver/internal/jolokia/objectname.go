@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jolokia // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/jolokia"
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ObjectName is a parsed JMX object name, e.g.
+// kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=orders
+type ObjectName struct {
+	Domain     string
+	Properties map[string]string
+}
+
+// ParseObjectName parses an object name or pattern. Quoted values are
+// unquoted, the wildcard of property list patterns is ignored.
+func ParseObjectName(name string) (ObjectName, error) {
+	domain, properties, found := strings.Cut(name, ":")
+	if !found || domain == "" {
+		return ObjectName{}, fmt.Errorf("invalid object name %q: missing domain", name)
+	}
+	objectName := ObjectName{Domain: domain, Properties: map[string]string{}}
+	for _, property := range splitProperties(properties) {
+		if property == "*" {
+			continue
+		}
+		key, value, ok := strings.Cut(property, "=")
+		if !ok || key == "" || value == "" {
+			return ObjectName{}, fmt.Errorf("invalid object name %q: invalid key property %q", name, property)
+		}
+		objectName.Properties[key] = unquote(value)
+	}
+	if len(objectName.Properties) == 0 && !IsPattern(name) {
+		return ObjectName{}, fmt.Errorf("invalid object name %q: missing key properties", name)
+	}
+	return objectName, nil
+}
+
+// IsPattern returns whether name is an object name pattern matching several
+// MBeans.
+func IsPattern(name string) bool {
+	return strings.ContainsAny(name, "*?")
+}
+
+// splitProperties splits the key properties on the commas outside of quoted values.
+func splitProperties(properties string) []string {
+	var (
+		parts  []string
+		quoted bool
+		start  int
+	)
+	for i := 0; i < len(properties); i++ {
+		switch properties[i] {
+		case '\\':
+			if quoted {
+				i++
+			}
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				parts = append(parts, properties[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, properties[start:])
+}
+
+func unquote(value string) string {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return value
+	}
+	var b strings.Builder
+	for i := 1; i < len(value)-1; i++ {
+		if value[i] == '\\' && i+1 < len(value)-1 {
+			i++
+			if value[i] == 'n' {
+				b.WriteByte('\n')
+				continue
+			}
+		}
+		b.WriteByte(value[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jolokia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseObjectName(t *testing.T) {
+	testCases := []struct {
+		name        string
+		expected    ObjectName
+		expectedErr string
+	}{
+		{
+			name: "java.lang:type=Memory",
+			expected: ObjectName{
+				Domain:     "java.lang",
+				Properties: map[string]string{"type": "Memory"},
+			},
+		},
+		{
+			name: "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=*",
+			expected: ObjectName{
+				Domain:     "kafka.server",
+				Properties: map[string]string{"type": "BrokerTopicMetrics", "name": "MessagesInPerSec", "topic": "*"},
+			},
+		},
+		{
+			name: `org.apache.cassandra.db:type=Tables,keyspace=system,table="peers, v2",*`,
+			expected: ObjectName{
+				Domain:     "org.apache.cassandra.db",
+				Properties: map[string]string{"type": "Tables", "keyspace": "system", "table": "peers, v2"},
+			},
+		},
+		{
+			name: "java.lang:*",
+			expected: ObjectName{
+				Domain:     "java.lang",
+				Properties: map[string]string{},
+			},
+		},
+		{
+			name:        "type=Memory",
+			expectedErr: `invalid object name "type=Memory": missing domain`,
+		},
+		{
+			name:        "java.lang:type",
+			expectedErr: `invalid object name "java.lang:type": invalid key property "type"`,
+		},
+		{
+			name:        "java.lang:",
+			expectedErr: `invalid object name "java.lang:": invalid key property ""`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objectName, err := ParseObjectName(tc.name)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, objectName)
+		})
+	}
+}
+
+func TestIsPattern(t *testing.T) {
+	assert.True(t, IsPattern("java.lang:type=GarbageCollector,name=*"))
+	assert.True(t, IsPattern("java.lang:type=Memory,*"))
+	assert.True(t, IsPattern("kafka.*:type=KafkaController,name=ActiveControllerCount"))
+	assert.False(t, IsPattern("java.lang:type=Memory"))
+}
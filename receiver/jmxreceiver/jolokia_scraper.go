@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jmxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/jolokia"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/metadata"
+)
+
+const (
+	metricTypeGauge = "gauge"
+	metricTypeSum   = "sum"
+
+	defaultJolokiaTimeout = 10 * time.Second
+)
+
+var errClientNotInit = errors.New("client not initialized")
+
+// jolokiaScraper reads the MBeans through a Jolokia agent, without the JMX
+// Metric Gatherer JAR and a JRE.
+type jolokiaScraper struct {
+	settings  receiver.CreateSettings
+	config    *Config
+	mbeans    []mbeanConfig
+	client    *jolokia.Client
+	startTime pcommon.Timestamp
+}
+
+func newJolokiaReceiver(
+	params receiver.CreateSettings,
+	config *Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	js := &jolokiaScraper{
+		settings: params,
+		config:   config,
+		mbeans:   jolokiaMBeans(config),
+	}
+	scraper, err := scraperhelper.NewScraper(metadata.Type, js.scrape, scraperhelper.WithStart(js.start))
+	if err != nil {
+		return nil, err
+	}
+
+	scs := scraperhelper.NewDefaultScraperControllerSettings(metadata.Type)
+	scs.CollectionInterval = config.CollectionInterval
+	return scraperhelper.NewScraperControllerReceiver(&scs, params, nextConsumer, scraperhelper.AddScraper(scraper))
+}
+
+func (s *jolokiaScraper) start(_ context.Context, host component.Host) error {
+	timeout := s.config.Jolokia.Timeout
+	if timeout == 0 {
+		timeout = defaultJolokiaTimeout
+	}
+	httpSettings := confighttp.HTTPClientSettings{
+		Endpoint:   s.config.Endpoint,
+		Timeout:    timeout,
+		TLSSetting: s.config.Jolokia.TLSSetting,
+	}
+	httpClient, err := httpSettings.ToClient(host, s.settings.TelemetrySettings)
+	if err != nil {
+		return err
+	}
+	s.client = jolokia.NewClient(httpClient, s.config.Endpoint, s.config.Username, s.config.Password)
+	s.startTime = pcommon.NewTimestampFromTime(time.Now())
+	return nil
+}
+
+func (s *jolokiaScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.client == nil {
+		return pmetric.NewMetrics(), errClientNotInit
+	}
+
+	requests := make([]jolokia.ReadRequest, 0, len(s.mbeans))
+	for _, mbean := range s.mbeans {
+		requests = append(requests, jolokia.ReadRequest{MBean: mbean.ObjectName, Attributes: mbean.attributeNames()})
+	}
+	results, err := s.client.Read(ctx, requests)
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	for k, v := range s.config.ResourceAttributes {
+		rm.Resource().Attributes().PutStr(k, v)
+	}
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("otelcol/jmxreceiver")
+	sm.Scope().SetVersion(s.settings.BuildInfo.Version)
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	metrics := map[string]pmetric.Metric{}
+	var errs error
+	failed := 0
+	for i, result := range results {
+		mbean := s.mbeans[i]
+		if result.Err != nil {
+			if errors.Is(result.Err, jolokia.ErrNotFound) {
+				// MBeans may only be registered once used, e.g. the ones of a feature which is disabled
+				s.settings.Logger.Debug("No MBean registered", zap.String("object_name", mbean.ObjectName))
+				continue
+			}
+			errs = multierr.Append(errs, result.Err)
+			failed += len(mbean.Metrics)
+			continue
+		}
+
+		// sorted for a stable order of the data points
+		objectNames := make([]string, 0, len(result.Values))
+		for name := range result.Values {
+			objectNames = append(objectNames, name)
+		}
+		sort.Strings(objectNames)
+
+		for _, name := range objectNames {
+			objectName, parseErr := jolokia.ParseObjectName(name)
+			if parseErr != nil {
+				errs = multierr.Append(errs, parseErr)
+				failed += len(mbean.Metrics)
+				continue
+			}
+			for _, mc := range mbean.Metrics {
+				value, ok := attributeValue(result.Values[name], mc.Attribute)
+				if !ok {
+					continue
+				}
+				metric, ok := metrics[mc.Name]
+				if !ok {
+					metric = newMetric(sm, mc)
+					metrics[mc.Name] = metric
+				}
+				s.recordDataPoint(metric, now, value, mbean, objectName)
+			}
+		}
+	}
+
+	if errs != nil {
+		return md, scrapererror.NewPartialScrapeError(errs, failed)
+	}
+	return md, nil
+}
+
+func (s *jolokiaScraper) recordDataPoint(metric pmetric.Metric, now pcommon.Timestamp, value json.Number, mbean mbeanConfig, objectName jolokia.ObjectName) {
+	var dps pmetric.NumberDataPointSlice
+	if metric.Type() == pmetric.MetricTypeSum {
+		dps = metric.Sum().DataPoints()
+	} else {
+		dps = metric.Gauge().DataPoints()
+	}
+
+	dp := dps.AppendEmpty()
+	dp.SetStartTimestamp(s.startTime)
+	dp.SetTimestamp(now)
+	if i, err := value.Int64(); err == nil {
+		dp.SetIntValue(i)
+	} else if f, floatErr := value.Float64(); floatErr == nil {
+		dp.SetDoubleValue(f)
+	}
+	for attribute, key := range mbean.Attributes {
+		if v, ok := objectName.Properties[key]; ok {
+			dp.Attributes().PutStr(attribute, v)
+		}
+	}
+}
+
+func newMetric(sm pmetric.ScopeMetrics, mc mbeanMetricConfig) pmetric.Metric {
+	metric := sm.Metrics().AppendEmpty()
+	metric.SetName(mc.Name)
+	metric.SetUnit(mc.Unit)
+	metric.SetDescription(mc.Description)
+	if mc.Type == metricTypeSum {
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	} else {
+		metric.SetEmptyGauge()
+	}
+	return metric
+}
+
+// attributeValue returns the numeric value of an attribute, or of an item of a
+// composite attribute when path is of the form attribute/item.
+func attributeValue(attributes map[string]any, path string) (json.Number, bool) {
+	var value any = attributes
+	for _, part := range strings.Split(path, "/") {
+		composite, ok := value.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if value, ok = composite[part]; !ok {
+			return "", false
+		}
+	}
+	number, ok := value.(json.Number)
+	return number, ok
+}
+
+// attributeNames returns the attributes read from the MBeans, composite
+// attributes are read as a whole.
+func (m mbeanConfig) attributeNames() []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, metric := range m.Metrics {
+		name, _, _ := strings.Cut(metric.Attribute, "/")
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
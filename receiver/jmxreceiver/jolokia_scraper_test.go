@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jmxreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+// newMockJolokia answers the bulk read requests with the responses of
+// testdata/jolokia/responses.json, and a 404 status for the other MBeans.
+func newMockJolokia(t *testing.T, overrides map[string]json.RawMessage) *httptest.Server {
+	data, err := os.ReadFile(filepath.Join("testdata", "jolokia", "responses.json"))
+	require.NoError(t, err)
+	responses := map[string]json.RawMessage{}
+	require.NoError(t, json.Unmarshal(data, &responses))
+	for mbean, response := range overrides {
+		responses[mbean] = response
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if user, pass, ok := req.BasicAuth(); !ok || user != "monitoring" || pass != "secret" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var requests []struct {
+			MBean string `json:"mbean"`
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&requests))
+
+		body := make([]json.RawMessage, 0, len(requests))
+		for _, r := range requests {
+			response, ok := responses[r.MBean]
+			if !ok {
+				response = json.RawMessage(`{"status":404,"error_type":"javax.management.InstanceNotFoundException","error":"javax.management.InstanceNotFoundException : ` + r.MBean + `"}`)
+			}
+			body = append(body, response)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(rw).Encode(body))
+	}))
+}
+
+func newTestJolokiaConfig(endpoint string) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Mode = modeJolokia
+	cfg.Endpoint = endpoint
+	cfg.TargetSystem = "jvm"
+	cfg.Username = "monitoring"
+	cfg.Password = "secret"
+	cfg.ResourceAttributes = map[string]string{"service.name": "kafka-0"}
+	cfg.Jolokia.MBeans = []mbeanConfig{
+		{
+			ObjectName: "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=*",
+			Attributes: map[string]string{"topic": "topic"},
+			Metrics: []mbeanMetricConfig{
+				{
+					Name:        "kafka.topic.message.count",
+					Attribute:   "Count",
+					Type:        metricTypeSum,
+					Unit:        "{messages}",
+					Description: "The number of messages received by the topic",
+				},
+				{
+					Name:        "kafka.topic.message.rate",
+					Attribute:   "OneMinuteRate",
+					Unit:        "{messages}/s",
+					Description: "The rate of messages received by the topic over the last minute",
+				},
+			},
+		},
+	}
+	return cfg
+}
+
+func newTestJolokiaScraper(t *testing.T, cfg *Config) *jolokiaScraper {
+	require.NoError(t, cfg.Validate())
+	scraper := &jolokiaScraper{
+		settings: receivertest.NewNopCreateSettings(),
+		config:   cfg,
+		mbeans:   jolokiaMBeans(cfg),
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	return scraper
+}
+
+func TestJolokiaScraper(t *testing.T) {
+	server := newMockJolokia(t, nil)
+	defer server.Close()
+
+	scraper := newTestJolokiaScraper(t, newTestJolokiaConfig(server.URL+"/jolokia"))
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "jolokia", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
+
+func TestJolokiaScraperPartialErrors(t *testing.T) {
+	server := newMockJolokia(t, map[string]json.RawMessage{
+		"java.lang:type=Threading": json.RawMessage(`{"status":403,"error_type":"java.lang.Exception","error":"java.lang.Exception : Reading attribute ThreadCount is forbidden"}`),
+	})
+	defer server.Close()
+
+	scraper := newTestJolokiaScraper(t, newTestJolokiaConfig(server.URL+"/jolokia"))
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.ErrorContains(t, err, "failed to read java.lang:type=Threading: java.lang.Exception : Reading attribute ThreadCount is forbidden")
+
+	// the other MBeans are still read
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 13, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		require.NotEqual(t, "jvm.threads.count", metrics.At(i).Name())
+	}
+}
+
+func TestJolokiaScraperUnauthorized(t *testing.T) {
+	server := newMockJolokia(t, nil)
+	defer server.Close()
+
+	cfg := newTestJolokiaConfig(server.URL + "/jolokia")
+	cfg.Password = "wrong"
+	scraper := newTestJolokiaScraper(t, cfg)
+	_, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "request to "+server.URL+"/jolokia/ failed with status 401")
+}
+
+func TestJolokiaScraperNotStarted(t *testing.T) {
+	scraper := &jolokiaScraper{config: newTestJolokiaConfig("http://localhost:8778/jolokia")}
+	_, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInit)
+}
+
+func TestJolokiaScraperFailedStart(t *testing.T) {
+	cfg := newTestJolokiaConfig("https://localhost:8778/jolokia")
+	cfg.Jolokia.TLSSetting = configtls.TLSClientSetting{
+		TLSSetting: configtls.TLSSetting{
+			CAFile: "/non/existent",
+		},
+	}
+	scraper := &jolokiaScraper{
+		settings: receivertest.NewNopCreateSettings(),
+		config:   cfg,
+	}
+	require.Error(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+}
+
+func TestAttributeNames(t *testing.T) {
+	mbean := jolokiaTargetSystems["jvm"][2]
+	require.Equal(t, "java.lang:type=Memory", mbean.ObjectName)
+	require.Equal(t, []string{"HeapMemoryUsage", "NonHeapMemoryUsage"}, mbean.attributeNames())
+}
@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jmxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver"
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// jolokiaTargetSystems are the MBeans read for the target systems supported in
+// jolokia mode. The metrics are named after the ones of the JMX Metric Gatherer
+// scripts, only the metrics whose attributes are key properties of the object
+// names are supported.
+var jolokiaTargetSystems = map[string][]mbeanConfig{
+	"activemq": {
+		{
+			ObjectName: "org.apache.activemq:type=Broker,brokerName=*,destinationType=*,destinationName=*",
+			Attributes: map[string]string{"broker": "brokerName", "destination": "destinationName"},
+			Metrics: []mbeanMetricConfig{
+				{Name: "activemq.producer.count", Attribute: "ProducerCount", Unit: "{producers}", Description: "The number of producers attached to this broker"},
+				{Name: "activemq.consumer.count", Attribute: "ConsumerCount", Unit: "{consumers}", Description: "The number of consumers subscribed to this broker"},
+				{Name: "activemq.message.current", Attribute: "QueueSize", Unit: "{messages}", Description: "The current number of messages waiting to be consumed"},
+				{Name: "activemq.message.expired", Attribute: "ExpiredCount", Type: metricTypeSum, Unit: "{messages}", Description: "The total number of messages not delivered because they expired"},
+				{Name: "activemq.message.enqueued", Attribute: "EnqueueCount", Type: metricTypeSum, Unit: "{messages}", Description: "The total number of messages received by the broker"},
+				{Name: "activemq.message.dequeued", Attribute: "DequeueCount", Type: metricTypeSum, Unit: "{messages}", Description: "The total number of messages delivered by the broker"},
+				{Name: "activemq.message.wait_time.avg", Attribute: "AverageEnqueueTime", Unit: "ms", Description: "The average time a message was held on a destination"},
+			},
+		},
+		{
+			ObjectName: "org.apache.activemq:type=Broker,brokerName=*",
+			Attributes: map[string]string{"broker": "brokerName"},
+			Metrics: []mbeanMetricConfig{
+				{Name: "activemq.connection.count", Attribute: "CurrentConnectionsCount", Unit: "{connections}", Description: "The total number of current connections"},
+				{Name: "activemq.memory.usage", Attribute: "MemoryPercentUsage", Unit: "%", Description: "The percentage of configured memory used"},
+				{Name: "activemq.disk.store_usage", Attribute: "StorePercentUsage", Unit: "%", Description: "The percentage of configured disk used for persistent messages"},
+				{Name: "activemq.disk.temp_usage", Attribute: "TempPercentUsage", Unit: "%", Description: "The percentage of configured disk used for non-persistent messages"},
+			},
+		},
+	},
+	"cassandra": {
+		{
+			ObjectName: "org.apache.cassandra.metrics:type=ClientRequest,scope=Read,name=Latency",
+			Metrics: []mbeanMetricConfig{
+				{Name: "cassandra.client.request.read.latency.50p", Attribute: "50thPercentile", Unit: "us", Description: "Token range read request latency - 50th percentile"},
+				{Name: "cassandra.client.request.read.latency.99p", Attribute: "99thPercentile", Unit: "us", Description: "Token range read request latency - 99th percentile"},
+				{Name: "cassandra.client.request.read.latency.max", Attribute: "Max", Unit: "us", Description: "Maximum token range read request latency"},
+			},
+		},
+		{
+			ObjectName: "org.apache.cassandra.metrics:type=ClientRequest,scope=Write,name=Latency",
+			Metrics: []mbeanMetricConfig{
+				{Name: "cassandra.client.request.write.latency.50p", Attribute: "50thPercentile", Unit: "us", Description: "Regular write request latency - 50th percentile"},
+				{Name: "cassandra.client.request.write.latency.99p", Attribute: "99thPercentile", Unit: "us", Description: "Regular write request latency - 99th percentile"},
+				{Name: "cassandra.client.request.write.latency.max", Attribute: "Max", Unit: "us", Description: "Maximum regular write request latency"},
+			},
+		},
+		{
+			ObjectName: "org.apache.cassandra.metrics:type=ClientRequest,scope=*,name=Latency",
+			Attributes: map[string]string{"operation": "scope"},
+			Metrics: []mbeanMetricConfig{
+				{Name: "cassandra.client.request.count", Attribute: "Count", Type: metricTypeSum, Unit: "{requests}", Description: "Number of requests by operation"},
+			},
+		},
+		{
+			ObjectName: "org.apache.cassandra.metrics:type=Compaction,name=PendingTasks",
+			Metrics: []mbeanMetricConfig{
+				{Name: "cassandra.compaction.tasks.pending", Attribute: "Value", Unit: "{tasks}", Description: "Estimated number of compactions remaining to perform"},
+			},
+		},
+		{
+			ObjectName: "org.apache.cassandra.metrics:type=Compaction,name=CompletedTasks",
+			Metrics: []mbeanMetricConfig{
+				{Name: "cassandra.compaction.tasks.completed", Attribute: "Value", Type: metricTypeSum, Unit: "{tasks}", Description: "Number of completed compactions since server [re]start"},
+			},
+		},
+		{
+			ObjectName: "org.apache.cassandra.metrics:type=Storage,name=Load",
+			Metrics: []mbeanMetricConfig{
+				{Name: "cassandra.storage.load.count", Attribute: "Count", Unit: "By", Description: "Size of the on disk data size this node manages"},
+			},
+		},
+		{
+			ObjectName: "org.apache.cassandra.metrics:type=Storage,name=TotalHints",
+			Metrics: []mbeanMetricConfig{
+				{Name: "cassandra.storage.total_hints.count", Attribute: "Count", Type: metricTypeSum, Unit: "{hints}", Description: "Number of hint messages written to this node since [re]start"},
+			},
+		},
+		{
+			ObjectName: "org.apache.cassandra.metrics:type=Storage,name=TotalHintsInProgress",
+			Metrics: []mbeanMetricConfig{
+				{Name: "cassandra.storage.total_hints.in_progress.count", Attribute: "Count", Unit: "{hints}", Description: "Number of hints attempting to be sent currently"},
+			},
+		},
+	},
+	"jvm": {
+		{
+			ObjectName: "java.lang:type=ClassLoading",
+			Metrics: []mbeanMetricConfig{
+				{Name: "jvm.classes.loaded", Attribute: "LoadedClassCount", Unit: "{classes}", Description: "The number of loaded classes"},
+			},
+		},
+		{
+			ObjectName: "java.lang:type=GarbageCollector,name=*",
+			Attributes: map[string]string{"name": "name"},
+			Metrics: []mbeanMetricConfig{
+				{Name: "jvm.gc.collections.count", Attribute: "CollectionCount", Type: metricTypeSum, Unit: "{collections}", Description: "The total number of garbage collections that have occurred"},
+				{Name: "jvm.gc.collections.elapsed", Attribute: "CollectionTime", Type: metricTypeSum, Unit: "ms", Description: "The approximate accumulated collection elapsed time"},
+			},
+		},
+		{
+			ObjectName: "java.lang:type=Memory",
+			Metrics: []mbeanMetricConfig{
+				{Name: "jvm.memory.heap.init", Attribute: "HeapMemoryUsage/init", Unit: "By", Description: "The initial amount of memory that the JVM requests from the operating system for the heap"},
+				{Name: "jvm.memory.heap.used", Attribute: "HeapMemoryUsage/used", Unit: "By", Description: "The current heap memory usage"},
+				{Name: "jvm.memory.heap.committed", Attribute: "HeapMemoryUsage/committed", Unit: "By", Description: "The amount of memory that is guaranteed to be available for the heap"},
+				{Name: "jvm.memory.heap.max", Attribute: "HeapMemoryUsage/max", Unit: "By", Description: "The maximum amount of memory can be used for the heap"},
+				{Name: "jvm.memory.nonheap.init", Attribute: "NonHeapMemoryUsage/init", Unit: "By", Description: "The initial amount of memory that the JVM requests from the operating system for non-heap purposes"},
+				{Name: "jvm.memory.nonheap.used", Attribute: "NonHeapMemoryUsage/used", Unit: "By", Description: "The current non-heap memory usage"},
+				{Name: "jvm.memory.nonheap.committed", Attribute: "NonHeapMemoryUsage/committed", Unit: "By", Description: "The amount of memory that is guaranteed to be available for non-heap purposes"},
+				{Name: "jvm.memory.nonheap.max", Attribute: "NonHeapMemoryUsage/max", Unit: "By", Description: "The maximum amount of memory can be used for non-heap purposes"},
+			},
+		},
+		{
+			ObjectName: "java.lang:type=MemoryPool,name=*",
+			Attributes: map[string]string{"name": "name"},
+			Metrics: []mbeanMetricConfig{
+				{Name: "jvm.memory.pool.init", Attribute: "Usage/init", Unit: "By", Description: "The initial memory requested for the memory pool"},
+				{Name: "jvm.memory.pool.used", Attribute: "Usage/used", Unit: "By", Description: "The current memory pool memory usage"},
+				{Name: "jvm.memory.pool.committed", Attribute: "Usage/committed", Unit: "By", Description: "The amount of memory that is guaranteed to be available for the memory pool"},
+				{Name: "jvm.memory.pool.max", Attribute: "Usage/max", Unit: "By", Description: "The maximum amount of memory can be used for the memory pool"},
+			},
+		},
+		{
+			ObjectName: "java.lang:type=Threading",
+			Metrics: []mbeanMetricConfig{
+				{Name: "jvm.threads.count", Attribute: "ThreadCount", Unit: "{threads}", Description: "The current number of threads"},
+			},
+		},
+	},
+	"kafka": {
+		{
+			ObjectName: "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.message.count", Attribute: "Count", Type: metricTypeSum, Unit: "{messages}", Description: "The number of messages received by the broker"},
+			},
+		},
+		{
+			ObjectName: "kafka.network:type=RequestChannel,name=RequestQueueSize",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.request.queue", Attribute: "Value", Unit: "{requests}", Description: "Size of the request queue"},
+			},
+		},
+		{
+			ObjectName: "kafka.server:type=DelayedOperationPurgatory,name=PurgatorySize,delayedOperation=*",
+			Attributes: map[string]string{"type": "delayedOperation"},
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.purgatory.size", Attribute: "Value", Unit: "{requests}", Description: "The number of requests waiting in purgatory"},
+			},
+		},
+		{
+			ObjectName: "kafka.server:type=ReplicaManager,name=PartitionCount",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.partition.count", Attribute: "Value", Unit: "{partitions}", Description: "The number of partitions on the broker"},
+			},
+		},
+		{
+			ObjectName: "kafka.controller:type=KafkaController,name=OfflinePartitionsCount",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.partition.offline", Attribute: "Value", Unit: "{partitions}", Description: "The number of partitions offline"},
+			},
+		},
+		{
+			ObjectName: "kafka.server:type=ReplicaManager,name=UnderReplicatedPartitions",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.partition.under_replicated", Attribute: "Value", Unit: "{partitions}", Description: "The number of under replicated partitions"},
+			},
+		},
+		{
+			ObjectName: "kafka.server:type=ReplicaFetcherManager,name=MaxLag,clientId=Replica",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.max.lag", Attribute: "Value", Unit: "{messages}", Description: "The max lag in messages between follower and leader replicas"},
+			},
+		},
+		{
+			ObjectName: "kafka.controller:type=KafkaController,name=ActiveControllerCount",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.controller.active.count", Attribute: "Value", Unit: "{controllers}", Description: "The number of controllers active on the broker"},
+			},
+		},
+		{
+			ObjectName: "kafka.controller:type=ControllerStats,name=LeaderElectionRateAndTimeMs",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.leader.election.rate", Attribute: "Count", Type: metricTypeSum, Unit: "{elections}", Description: "The leader election count"},
+			},
+		},
+		{
+			ObjectName: "kafka.controller:type=ControllerStats,name=UncleanLeaderElectionsPerSec",
+			Metrics: []mbeanMetricConfig{
+				{Name: "kafka.unclean.election.rate", Attribute: "Count", Type: metricTypeSum, Unit: "{elections}", Description: "Unclean leader election count - increasing indicates broker failures"},
+			},
+		},
+	},
+}
+
+// jolokiaMBeans returns the MBeans read for the target systems and the
+// additional MBeans of the configuration.
+func jolokiaMBeans(cfg *Config) []mbeanConfig {
+	var mbeans []mbeanConfig
+	if cfg.TargetSystem != "" {
+		for _, system := range strings.Split(cfg.TargetSystem, ",") {
+			mbeans = append(mbeans, jolokiaTargetSystems[strings.ToLower(system)]...)
+		}
+	}
+	return append(mbeans, cfg.Jolokia.MBeans...)
+}
+
+func listTargetSystems(targetSystems map[string][]mbeanConfig) string {
+	list := make([]string, 0, len(targetSystems))
+	for k := range targetSystems {
+		list = append(list, fmt.Sprintf("'%s'", k))
+	}
+	sort.Strings(list)
+	return strings.Join(list, ", ")
+}
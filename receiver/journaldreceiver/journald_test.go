@@ -65,6 +65,7 @@ func testdataConfigYaml() *JournaldConfig {
 		InputConfig: func() journald.Config {
 			c := journald.NewConfig()
 			c.Units = []string{"ssh"}
+			c.Identifiers = []string{"sshd"}
 			c.Priority = "info"
 			dir := "/run/log/journal"
 			c.Directory = &dir
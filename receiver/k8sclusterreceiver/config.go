@@ -4,10 +4,14 @@
 package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 )
 
 // Config defines configuration for kubernetes cluster receiver.
@@ -28,6 +32,41 @@ type Config struct {
 
 	// Whether OpenShift supprot should be enabled or not.
 	Distribution string `mapstructure:"distribution"`
+
+	// Custom resources to watch, along with the metrics and resource attributes extracted from them.
+	CustomResources []CustomResourceConfig `mapstructure:"custom_resources"`
+}
+
+// CustomResourceConfig defines a kind of custom resource to watch, e.g. the Rollouts of Argo
+// or the Certificates of cert-manager.
+type CustomResourceConfig struct {
+	Group   string `mapstructure:"group"`
+	Version string `mapstructure:"version"`
+	Kind    string `mapstructure:"kind"`
+
+	// Metrics extracted from the objects of the kind, reported as gauges.
+	Metrics []CustomResourceMetricConfig `mapstructure:"metrics"`
+	// Resource attributes extracted from the objects of the kind.
+	Attributes []CustomResourceAttributeConfig `mapstructure:"attributes"`
+}
+
+// CustomResourceMetricConfig defines a metric extracted from a custom resource.
+type CustomResourceMetricConfig struct {
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+	// JSONPath expression of the value of the metric, e.g. `.status.readyReplicas`.
+	// Numbers and booleans are used as is, strings are either mapped with ValueMapping or parsed.
+	Path string `mapstructure:"path"`
+	// Values of the metric for the strings found at Path, e.g. `True: 1` for the status of a condition.
+	ValueMapping map[string]float64 `mapstructure:"value_mapping"`
+}
+
+// CustomResourceAttributeConfig defines a resource attribute extracted from a custom resource.
+type CustomResourceAttributeConfig struct {
+	Name string `mapstructure:"name"`
+	// JSONPath expression of the value of the attribute, e.g. `.spec.issuerRef.name`.
+	Path string `mapstructure:"path"`
 }
 
 func (cfg *Config) Validate() error {
@@ -37,5 +76,50 @@ func (cfg *Config) Validate() error {
 	default:
 		return fmt.Errorf("\"%s\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", cfg.Distribution)
 	}
+	for _, cr := range cfg.CustomResources {
+		if _, err := cr.resource(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// resource returns the custom resource to watch, with its JSONPath expressions parsed.
+func (cr CustomResourceConfig) resource() (customresource.Resource, error) {
+	res := customresource.Resource{
+		GVK: schema.GroupVersionKind{Group: cr.Group, Version: cr.Version, Kind: cr.Kind},
+	}
+	if cr.Version == "" || cr.Kind == "" {
+		return res, errors.New("custom_resources: version and kind must be set")
+	}
+	if len(cr.Metrics) == 0 {
+		return res, fmt.Errorf("custom_resources: no metrics defined for %q", res.GVK.String())
+	}
+	for _, m := range cr.Metrics {
+		if m.Name == "" || m.Path == "" {
+			return res, fmt.Errorf("custom_resources: name and path must be set for the metrics of %q", res.GVK.String())
+		}
+		path, err := customresource.ParsePath(m.Name, m.Path)
+		if err != nil {
+			return res, fmt.Errorf("custom_resources: invalid path of metric %q: %w", m.Name, err)
+		}
+		res.Metrics = append(res.Metrics, customresource.Metric{
+			Name:         m.Name,
+			Description:  m.Description,
+			Unit:         m.Unit,
+			Path:         path,
+			ValueMapping: m.ValueMapping,
+		})
+	}
+	for _, a := range cr.Attributes {
+		if a.Name == "" || a.Path == "" {
+			return res, fmt.Errorf("custom_resources: name and path must be set for the attributes of %q", res.GVK.String())
+		}
+		path, err := customresource.ParsePath(a.Name, a.Path)
+		if err != nil {
+			return res, fmt.Errorf("custom_resources: invalid path of attribute %q: %w", a.Name, err)
+		}
+		res.Attributes = append(res.Attributes, customresource.Attribute{Name: a.Name, Path: path})
+	}
+	return res, nil
+}
@@ -56,6 +56,32 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom_resources"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.CustomResources = []CustomResourceConfig{
+					{
+						Group:   "cert-manager.io",
+						Version: "v1",
+						Kind:    "Certificate",
+						Metrics: []CustomResourceMetricConfig{
+							{
+								Name:         "certmanager.certificate.ready",
+								Description:  "Whether the certificate is ready.",
+								Unit:         "1",
+								Path:         `.status.conditions[?(@.type=="Ready")].status`,
+								ValueMapping: map[string]float64{"True": 1, "False": 0},
+							},
+						},
+						Attributes: []CustomResourceAttributeConfig{
+							{Name: "certmanager.issuer.name", Path: ".spec.issuerRef.name"},
+						},
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,3 +119,48 @@ func TestInvalidConfig(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, "\"wrong\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", err.Error())
 }
+
+func TestInvalidCustomResourcesConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		cr          CustomResourceConfig
+		expectedErr string
+	}{
+		{
+			name:        "missing_kind",
+			cr:          CustomResourceConfig{Group: "argoproj.io", Version: "v1alpha1"},
+			expectedErr: "custom_resources: version and kind must be set",
+		},
+		{
+			name:        "no_metrics",
+			cr:          CustomResourceConfig{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"},
+			expectedErr: `custom_resources: no metrics defined for "argoproj.io/v1alpha1, Kind=Rollout"`,
+		},
+		{
+			name: "missing_metric_path",
+			cr: CustomResourceConfig{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout",
+				Metrics: []CustomResourceMetricConfig{{Name: "argo.rollout.replicas"}}},
+			expectedErr: `custom_resources: name and path must be set for the metrics of "argoproj.io/v1alpha1, Kind=Rollout"`,
+		},
+		{
+			name: "invalid_metric_path",
+			cr: CustomResourceConfig{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout",
+				Metrics: []CustomResourceMetricConfig{{Name: "argo.rollout.replicas", Path: ".status["}}},
+			expectedErr: `custom_resources: invalid path of metric "argo.rollout.replicas"`,
+		},
+		{
+			name: "invalid_attribute_path",
+			cr: CustomResourceConfig{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout",
+				Metrics:    []CustomResourceMetricConfig{{Name: "argo.rollout.replicas", Path: ".status.replicas"}},
+				Attributes: []CustomResourceAttributeConfig{{Name: "argo.rollout.strategy", Path: ".spec.strategy["}}},
+			expectedErr: `custom_resources: invalid path of attribute "argo.rollout.strategy"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.CustomResources = []CustomResourceConfig{tt.cr}
+			assert.ErrorContains(t, component.ValidateConfig(cfg), tt.expectedErr)
+		})
+	}
+}
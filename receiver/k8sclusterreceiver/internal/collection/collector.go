@@ -18,6 +18,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -27,6 +28,7 @@ import (
 	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/clusterresourcequota"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/cronjob"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/demonset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/hpa"
@@ -54,6 +56,7 @@ type DataCollector struct {
 	metadataStore            *metadata.Store
 	nodeConditionsToReport   []string
 	allocatableTypesToReport []string
+	customResources          map[schema.GroupVersionKind]customresource.Resource
 }
 
 // NewDataCollector returns a DataCollector.
@@ -66,6 +69,7 @@ func NewDataCollector(set receiver.CreateSettings, nodeConditionsToReport, alloc
 		metadataStore:            &metadata.Store{},
 		nodeConditionsToReport:   nodeConditionsToReport,
 		allocatableTypesToReport: allocatableTypesToReport,
+		customResources:          map[schema.GroupVersionKind]customresource.Resource{},
 	}
 }
 
@@ -74,6 +78,11 @@ func (dc *DataCollector) SetupMetadataStore(gvk schema.GroupVersionKind, store c
 	dc.metadataStore.Setup(gvk, store)
 }
 
+// SetupCustomResource registers the metrics to extract from the objects of a custom resource kind.
+func (dc *DataCollector) SetupCustomResource(res customresource.Resource) {
+	dc.customResources[res.GVK] = res
+}
+
 func (dc *DataCollector) RemoveFromMetricsStore(obj interface{}) {
 	if err := dc.metricsStore.remove(obj.(runtime.Object)); err != nil {
 		dc.settings.TelemetrySettings.Logger.Error(
@@ -133,6 +142,12 @@ func (dc *DataCollector) SyncMetrics(obj interface{}) {
 		md = hpa.GetMetricsBeta(dc.settings, o)
 	case *quotav1.ClusterResourceQuota:
 		md = ocsToMetrics(clusterresourcequota.GetMetrics(o))
+	case *unstructured.Unstructured:
+		res, ok := dc.customResources[o.GroupVersionKind()]
+		if !ok {
+			return
+		}
+		md = customresource.GetMetrics(dc.settings, res, o)
 	default:
 		return
 	}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	scopeName = "otelcol/k8sclusterreceiver"
+
+	// Resource attribute keys of the custom resources.
+	AttributeGroup = "k8s.custom_resource.group"
+	AttributeKind  = "k8s.custom_resource.kind"
+	AttributeName  = "k8s.custom_resource.name"
+	AttributeUID   = "k8s.custom_resource.uid"
+)
+
+// Resource is a kind of custom resource to watch, along with the metrics
+// and the resource attributes extracted from its objects.
+type Resource struct {
+	GVK        schema.GroupVersionKind
+	Metrics    []Metric
+	Attributes []Attribute
+}
+
+// Metric is a gauge whose value is extracted from the objects with a JSONPath expression.
+type Metric struct {
+	Name        string
+	Description string
+	Unit        string
+	Path        *jsonpath.JSONPath
+	// ValueMapping maps the string values found at Path, e.g. the status of a condition, to metric values.
+	ValueMapping map[string]float64
+}
+
+// Attribute is a resource attribute whose value is extracted from the objects with a JSONPath expression.
+type Attribute struct {
+	Name string
+	Path *jsonpath.JSONPath
+}
+
+// ParsePath parses a JSONPath expression, the enclosing braces are optional.
+func ParsePath(name, expr string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+	p := jsonpath.New(name).AllowMissingKeys(true)
+	if err := p.Parse(expr); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// GetMetrics returns the metrics of a custom resource object. The metrics whose
+// path doesn't match any value are skipped.
+func GetMetrics(set receiver.CreateSettings, res Resource, obj *unstructured.Unstructured) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	attrs := rm.Resource().Attributes()
+	attrs.PutStr(AttributeGroup, res.GVK.Group)
+	attrs.PutStr(AttributeKind, res.GVK.Kind)
+	attrs.PutStr(AttributeName, obj.GetName())
+	attrs.PutStr(AttributeUID, string(obj.GetUID()))
+	if ns := obj.GetNamespace(); ns != "" {
+		attrs.PutStr(conventions.AttributeK8SNamespaceName, ns)
+	}
+	for _, a := range res.Attributes {
+		values, err := a.Path.FindResults(obj.Object)
+		if err != nil {
+			set.Logger.Debug("failed to extract custom resource attribute", zap.String("attribute", a.Name), zap.Error(err))
+			continue
+		}
+		if v, ok := firstValue(values); ok {
+			attrs.PutStr(a.Name, fmt.Sprint(v))
+		}
+	}
+
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	sm.Scope().SetVersion(set.BuildInfo.Version)
+	ts := pcommon.NewTimestampFromTime(time.Now())
+	for _, m := range res.Metrics {
+		values, err := m.Path.FindResults(obj.Object)
+		if err != nil {
+			set.Logger.Debug("failed to extract custom resource metric", zap.String("metric", m.Name), zap.Error(err))
+			continue
+		}
+		v, ok := firstValue(values)
+		if !ok {
+			continue
+		}
+		value, err := m.toFloat(v)
+		if err != nil {
+			set.Logger.Debug("invalid custom resource metric value", zap.String("metric", m.Name), zap.Error(err))
+			continue
+		}
+		metric := sm.Metrics().AppendEmpty()
+		metric.SetName(m.Name)
+		metric.SetDescription(m.Description)
+		metric.SetUnit(m.Unit)
+		dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(ts)
+		dp.SetDoubleValue(value)
+	}
+	return md
+}
+
+// firstValue returns the first value found by a JSONPath expression.
+func firstValue(results [][]reflect.Value) (interface{}, bool) {
+	for _, r := range results {
+		for _, v := range r {
+			if v.IsValid() && v.CanInterface() {
+				return v.Interface(), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (m Metric) toFloat(v interface{}) (float64, error) {
+	switch value := v.(type) {
+	case int64:
+		return float64(value), nil
+	case float64:
+		return value, nil
+	case bool:
+		if value {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		if mapped, ok := m.ValueMapping[value]; ok {
+			return mapped, nil
+		}
+		return strconv.ParseFloat(value, 64)
+	default:
+		return 0, fmt.Errorf("unsupported value type %T", v)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newPathMetric(t *testing.T, expr string) Metric {
+	path, err := ParsePath(expr, expr)
+	require.NoError(t, err)
+	return Metric{Name: expr, Path: path}
+}
+
+func TestParsePath(t *testing.T) {
+	_, err := ParsePath("replicas", ".status.replicas")
+	assert.NoError(t, err)
+	_, err = ParsePath("replicas", "{.status.replicas}")
+	assert.NoError(t, err)
+	_, err = ParsePath("replicas", ".status[")
+	assert.Error(t, err)
+}
+
+func TestGetMetrics(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]interface{}{
+			"name":      "example-com",
+			"namespace": "default",
+			"uid":       "uid-1",
+		},
+		"spec": map[string]interface{}{
+			"issuerRef": map[string]interface{}{"name": "letsencrypt"},
+		},
+		"status": map[string]interface{}{
+			"revision": int64(3),
+			"ratio":    0.5,
+			"renewal":  true,
+			"expiry":   "1700000000",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Issuing", "status": "False"},
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+
+	ready := newPathMetric(t, `.status.conditions[?(@.type=="Ready")].status`)
+	ready.Name = "certmanager.certificate.ready"
+	ready.Unit = "1"
+	ready.ValueMapping = map[string]float64{"True": 1, "False": 0}
+	issuerPath, err := ParsePath("issuer", ".spec.issuerRef.name")
+	require.NoError(t, err)
+
+	res := Resource{
+		GVK: schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"},
+		Metrics: []Metric{
+			ready,
+			newPathMetric(t, ".status.revision"),
+			newPathMetric(t, ".status.ratio"),
+			newPathMetric(t, ".status.renewal"),
+			newPathMetric(t, ".status.expiry"),
+			// missing values and the values which aren't numbers are skipped
+			newPathMetric(t, ".status.missing"),
+			newPathMetric(t, ".spec.issuerRef.name"),
+			newPathMetric(t, ".spec.issuerRef"),
+		},
+		Attributes: []Attribute{{Name: "certmanager.issuer", Path: issuerPath}},
+	}
+
+	md := GetMetrics(receivertest.NewNopCreateSettings(), res, obj)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]interface{}{
+		AttributeGroup:       "cert-manager.io",
+		AttributeKind:        "Certificate",
+		AttributeName:        "example-com",
+		AttributeUID:         "uid-1",
+		"k8s.namespace.name": "default",
+		"certmanager.issuer": "letsencrypt",
+	}, rm.Resource().Attributes().AsRaw())
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	assert.Equal(t, scopeName, rm.ScopeMetrics().At(0).Scope().Name())
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	values := map[string]float64{}
+	for i := 0; i < metrics.Len(); i++ {
+		values[metrics.At(i).Name()] = metrics.At(i).Gauge().DataPoints().At(0).DoubleValue()
+	}
+	assert.Equal(t, map[string]float64{
+		"certmanager.certificate.ready": 1,
+		".status.revision":              3,
+		".status.ratio":                 0.5,
+		".status.renewal":               1,
+		".status.expiry":                1700000000,
+	}, values)
+	assert.Equal(t, "1", metrics.At(0).Unit())
+}
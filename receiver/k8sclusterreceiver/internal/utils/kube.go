@@ -16,12 +16,14 @@ import (
 // GetUIDForObject returns the UID for a Kubernetes object.
 func GetUIDForObject(obj runtime.Object) (types.UID, error) {
 	var key types.UID
-	oma, ok := obj.(metav1.ObjectMetaAccessor)
-	if !ok || oma.GetObjectMeta() == nil {
-		return key, errors.New("kubernetes object is not of the expected form")
+	if oma, ok := obj.(metav1.ObjectMetaAccessor); ok && oma.GetObjectMeta() != nil {
+		return oma.GetObjectMeta().GetUID(), nil
 	}
-	key = oma.GetObjectMeta().GetUID()
-	return key, nil
+	// Unstructured objects, e.g. the custom resources, implement the accessors of the object meta directly.
+	if o, ok := obj.(metav1.Object); ok {
+		return o.GetUID(), nil
+	}
+	return key, errors.New("kubernetes object is not of the expected form")
 }
 
 // FindOwnerWithKind returns the OwnerReference of the matching kind from
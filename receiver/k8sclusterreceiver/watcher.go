@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -16,7 +17,10 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -37,6 +41,7 @@ type sharedInformer interface {
 type resourceWatcher struct {
 	client              kubernetes.Interface
 	osQuotaClient       quotaclientset.Interface
+	dynamicClient       dynamic.Interface
 	informerFactories   []sharedInformer
 	dataCollector       *collection.DataCollector
 	logger              *zap.Logger
@@ -49,6 +54,7 @@ type resourceWatcher struct {
 	// For mocking.
 	makeClient               func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
 	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
+	makeDynamicClient        func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
 }
 
 type metadataConsumer func(metadata []*experimentalmetricmetadata.MetadataUpdate) error
@@ -64,6 +70,7 @@ func newResourceWatcher(set receiver.CreateSettings, cfg *Config) *resourceWatch
 		config:                   cfg,
 		makeClient:               k8sconfig.MakeClient,
 		makeOpenShiftQuotaClient: k8sconfig.MakeOpenShiftQuotaClient,
+		makeDynamicClient:        k8sconfig.MakeDynamicClient,
 	}
 }
 
@@ -81,6 +88,13 @@ func (rw *resourceWatcher) initialize() error {
 		}
 	}
 
+	if len(rw.config.CustomResources) > 0 {
+		rw.dynamicClient, err = rw.makeDynamicClient(rw.config.APIConfig)
+		if err != nil {
+			return fmt.Errorf("Failed to create dynamic client: %w", err)
+		}
+	}
+
 	err = rw.prepareSharedInformerFactory()
 	if err != nil {
 		return err
@@ -137,25 +151,73 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 	}
 	rw.informerFactories = append(rw.informerFactories, factory)
 
+	if rw.dynamicClient != nil {
+		if err := rw.setupCustomResourceInformers(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setupCustomResourceInformers sets up the informers of the custom resources
+// supported by the server, with a dynamic shared informer factory.
+func (rw *resourceWatcher) setupCustomResourceInformers() error {
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(rw.dynamicClient, 0)
+	for _, cr := range rw.config.CustomResources {
+		res, err := cr.resource()
+		if err != nil {
+			return err
+		}
+		apiResource, err := rw.findAPIResource(res.GVK)
+		if err != nil {
+			return err
+		}
+		if apiResource == nil {
+			rw.logger.Warn("Server doesn't support the custom resource",
+				zap.String("group version kind", res.GVK.String()))
+			continue
+		}
+		rw.dataCollector.SetupCustomResource(res)
+		rw.setupInformer(res.GVK, factory.ForResource(res.GVK.GroupVersion().WithResource(apiResource.Name)).Informer())
+	}
+	rw.informerFactories = append(rw.informerFactories, dynamicSharedInformer{factory})
+	return nil
+}
+
+// dynamicSharedInformer adapts a dynamic shared informer factory to the sharedInformer interface.
+type dynamicSharedInformer struct {
+	dynamicinformer.DynamicSharedInformerFactory
+}
+
+func (d dynamicSharedInformer) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	d.DynamicSharedInformerFactory.WaitForCacheSync(stopCh)
 	return nil
 }
 
 func (rw *resourceWatcher) isKindSupported(gvk schema.GroupVersionKind) (bool, error) {
+	r, err := rw.findAPIResource(gvk)
+	return r != nil, err
+}
+
+// findAPIResource returns the API resource of a group version kind, or nil if it isn't supported by the server.
+func (rw *resourceWatcher) findAPIResource(gvk schema.GroupVersionKind) (*metav1.APIResource, error) {
 	resources, err := rw.client.Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
 		if apierrors.IsNotFound(err) { // if the discovery endpoint isn't present, assume group version is not supported
 			rw.logger.Debug("Group version is not supported", zap.String("group", gvk.GroupVersion().String()))
-			return false, nil
+			return nil, nil
 		}
-		return false, fmt.Errorf("failed to fetch group version details: %w", err)
+		return nil, fmt.Errorf("failed to fetch group version details: %w", err)
 	}
 
-	for _, r := range resources.APIResources {
-		if r.Kind == gvk.Kind {
-			return true, nil
+	for i, r := range resources.APIResources {
+		// skip the subresources, e.g. deployments/status, which have the kind of their resource
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			return &resources.APIResources[i], nil
 		}
 	}
-	return false, nil
+	return nil, nil
 }
 
 func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, factory informers.SharedInformerFactory) {
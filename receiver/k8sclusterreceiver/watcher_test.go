@@ -4,7 +4,9 @@
 package k8sclusterreceiver
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,7 +15,10 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -220,3 +225,78 @@ func TestSetupInformerForKind(t *testing.T) {
 	assert.Equal(t, 1, logs.Len())
 	assert.Equal(t, "Could not setup an informer for provided group version kind", logs.All()[0].Entry.Message)
 }
+
+func TestSetupCustomResourceInformers(t *testing.T) {
+	rollout := schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+	client := newFakeClientWithAllResources()
+	client.Resources = append(client.Resources, &metav1.APIResourceList{
+		GroupVersion: "argoproj.io/v1alpha1",
+		APIResources: []metav1.APIResource{
+			{Name: "rollouts/status", Kind: "Rollout"},
+			{Name: "rollouts", Kind: "Rollout"},
+		},
+	})
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata": map[string]interface{}{
+			"name":      "web",
+			"namespace": "default",
+			"uid":       "rollout-uid",
+		},
+		"status": map[string]interface{}{
+			"phase":         "Healthy",
+			"readyReplicas": int64(3),
+		},
+	}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{rollout.GroupVersion().WithResource("rollouts"): "RolloutList"}, obj)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.CustomResources = []CustomResourceConfig{
+		{
+			Group:   "argoproj.io",
+			Version: "v1alpha1",
+			Kind:    "Rollout",
+			Metrics: []CustomResourceMetricConfig{
+				{Name: "argo.rollout.ready_replicas", Path: ".status.readyReplicas"},
+				{Name: "argo.rollout.healthy", Path: ".status.phase", ValueMapping: map[string]float64{"Healthy": 1}},
+			},
+		},
+		{
+			Group:   "cert-manager.io",
+			Version: "v1",
+			Kind:    "Certificate",
+			Metrics: []CustomResourceMetricConfig{{Name: "certmanager.certificate.revision", Path: ".status.revision"}},
+		},
+	}
+
+	obs, logs := observer.New(zap.WarnLevel)
+	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), cfg)
+	rw.logger = zap.New(obs)
+	rw.client = client
+	rw.dynamicClient = dynamicClient
+	rw.initialSyncDone.Store(true)
+	require.NoError(t, rw.setupCustomResourceInformers())
+
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "Server doesn't support the custom resource", logs.All()[0].Message)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.Len(t, rw.informerFactories, 1)
+	rw.startWatchingResources(ctx, rw.informerFactories[0])
+
+	require.Eventually(t, func() bool {
+		return rw.dataCollector.CollectMetricData(time.Now()).DataPointCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	md := rw.dataCollector.CollectMetricData(time.Now())
+	rm := md.ResourceMetrics().At(0)
+	name, _ := rm.Resource().Attributes().Get("k8s.custom_resource.name")
+	assert.Equal(t, "web", name.Str())
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, "argo.rollout.ready_replicas", metrics.At(0).Name())
+	assert.Equal(t, 3.0, metrics.At(0).Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, "argo.rollout.healthy", metrics.At(1).Name())
+	assert.Equal(t, 1.0, metrics.At(1).Gauge().DataPoints().At(0).DoubleValue())
+}
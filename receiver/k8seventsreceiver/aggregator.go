@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// aggregationKey identifies the repeated occurrences of an event.
+type aggregationKey struct {
+	namespace string
+	kind      string
+	name      string
+	uid       types.UID
+	reason    string
+	eventType string
+}
+
+// aggregatedEvent holds the occurrences of an event seen within a window.
+type aggregatedEvent struct {
+	event *corev1.Event // the latest occurrence
+	count int64
+	first time.Time
+	last  time.Time
+}
+
+// eventAggregator aggregates the events with the same reason and involved object,
+// so that an event storm results in a single log record per window.
+type eventAggregator struct {
+	mu     sync.Mutex
+	events map[aggregationKey]*aggregatedEvent
+	// order keeps the events in the order they were first seen.
+	order []aggregationKey
+}
+
+func newEventAggregator() *eventAggregator {
+	return &eventAggregator{
+		events: make(map[aggregationKey]*aggregatedEvent),
+	}
+}
+
+// add records an occurrence of ev.
+func (a *eventAggregator) add(ev *corev1.Event) {
+	key := aggregationKey{
+		namespace: ev.InvolvedObject.Namespace,
+		kind:      ev.InvolvedObject.Kind,
+		name:      ev.InvolvedObject.Name,
+		uid:       ev.InvolvedObject.UID,
+		reason:    ev.Reason,
+		eventType: ev.Type,
+	}
+	ts := getEventTimestamp(ev)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	agg, ok := a.events[key]
+	if !ok {
+		a.events[key] = &aggregatedEvent{event: ev, count: 1, first: ts, last: ts}
+		a.order = append(a.order, key)
+		return
+	}
+	agg.event = ev
+	agg.count++
+	if ts.Before(agg.first) {
+		agg.first = ts
+	}
+	if ts.After(agg.last) {
+		agg.last = ts
+	}
+}
+
+// flush returns the log records of the events aggregated since the last flush,
+// along with their number, and resets the aggregator.
+func (a *eventAggregator) flush(logger *zap.Logger) (plog.Logs, int) {
+	a.mu.Lock()
+	events, order := a.events, a.order
+	a.events = make(map[aggregationKey]*aggregatedEvent)
+	a.order = nil
+	a.mu.Unlock()
+
+	ld := plog.NewLogs()
+	for _, key := range order {
+		agg := events[key]
+		aggregatedEventToLogData(logger, agg).ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+	}
+	return ld, len(order)
+}
@@ -4,6 +4,9 @@
 package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
 
 import (
+	"errors"
+	"time"
+
 	k8s "k8s.io/client-go/kubernetes"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -16,11 +19,27 @@ type Config struct {
 	// List of ‘namespaces’ to collect events from.
 	Namespaces []string `mapstructure:"namespaces"`
 
+	// Aggregation configures the aggregation of repeated events.
+	Aggregation AggregationConfig `mapstructure:"aggregation"`
+
 	// For mocking
 	makeClient func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
 }
 
+// AggregationConfig defines how repeated events are aggregated.
+type AggregationConfig struct {
+	// Enabled aggregates the events with the same reason and involved object
+	// seen within a window into a single log record.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Window is the duration over which repeated events are aggregated.
+	Window time.Duration `mapstructure:"window"`
+}
+
 func (cfg *Config) Validate() error {
+	if cfg.Aggregation.Enabled && cfg.Aggregation.Window <= 0 {
+		return errors.New("aggregation window must be positive")
+	}
 	return cfg.APIConfig.Validate()
 }
 
@@ -4,8 +4,10 @@
 package k8seventsreceiver
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,8 +40,16 @@ func TestLoadConfig(t *testing.T) {
 				APIConfig: k8sconfig.APIConfig{
 					AuthType: k8sconfig.AuthTypeServiceAccount,
 				},
+				Aggregation: AggregationConfig{
+					Enabled: true,
+					Window:  30 * time.Second,
+				},
 			},
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_aggregation_window"),
+			expectedErr: errors.New("aggregation window must be positive"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -51,6 +61,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
+			if tt.expectedErr != nil {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr.Error())
+				return
+			}
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
@@ -5,6 +5,7 @@ package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -14,6 +15,8 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver/internal/metadata"
 )
 
+const defaultAggregationWindow = time.Minute
+
 // NewFactory creates a factory for k8s_cluster receiver.
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
@@ -27,6 +30,9 @@ func createDefaultConfig() component.Config {
 		APIConfig: k8sconfig.APIConfig{
 			AuthType: k8sconfig.AuthTypeServiceAccount,
 		},
+		Aggregation: AggregationConfig{
+			Window: defaultAggregationWindow,
+		},
 	}
 }
 
@@ -5,6 +5,7 @@ package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -81,3 +82,17 @@ func k8sEventToLogData(logger *zap.Logger, ev *corev1.Event) plog.Logs {
 
 	return ld
 }
+
+// aggregatedEventToLogData converts the latest occurrence of an aggregated event to plog.Logs,
+// reporting the number of occurrences and the timestamps of the first and last ones.
+func aggregatedEventToLogData(logger *zap.Logger, agg *aggregatedEvent) plog.Logs {
+	ld := k8sEventToLogData(logger, agg.event)
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(agg.last))
+
+	attrs := lr.Attributes()
+	attrs.PutInt("k8s.event.count", agg.count)
+	attrs.PutStr("k8s.event.first_timestamp", agg.first.Format(time.RFC3339Nano))
+	attrs.PutStr("k8s.event.last_timestamp", agg.last.Format(time.RFC3339Nano))
+	return ld
+}
@@ -5,6 +5,7 @@ package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -29,6 +30,8 @@ type k8seventsReceiver struct {
 	ctx             context.Context
 	cancel          context.CancelFunc
 	obsrecv         *obsreport.Receiver
+	aggregator      *eventAggregator
+	wg              sync.WaitGroup
 }
 
 // newReceiver creates the Kubernetes events receiver with the given configuration.
@@ -49,14 +52,18 @@ func newReceiver(
 		return nil, err
 	}
 
-	return &k8seventsReceiver{
+	kr := &k8seventsReceiver{
 		settings:     set,
 		config:       config,
 		client:       client,
 		logsConsumer: consumer,
 		startTime:    time.Now(),
 		obsrecv:      obsrecv,
-	}, nil
+	}
+	if config.Aggregation.Enabled {
+		kr.aggregator = newEventAggregator()
+	}
+	return kr, nil
 }
 
 func (kr *k8seventsReceiver) Start(ctx context.Context, _ component.Host) error {
@@ -71,15 +78,25 @@ func (kr *k8seventsReceiver) Start(ctx context.Context, _ component.Host) error
 		}
 	}
 
+	if kr.aggregator != nil {
+		kr.wg.Add(1)
+		go kr.flushAggregatedEvents()
+	}
+
 	return nil
 }
 
-func (kr *k8seventsReceiver) Shutdown(context.Context) error {
+func (kr *k8seventsReceiver) Shutdown(ctx context.Context) error {
 	// Stop watching all the namespaces by closing all the stopper channels.
 	for _, stopperChan := range kr.stopperChanList {
 		close(stopperChan)
 	}
 	kr.cancel()
+	kr.wg.Wait()
+	if kr.aggregator != nil {
+		// Emit the events aggregated since the last window rather than losing them.
+		kr.emitAggregatedEvents(ctx)
+	}
 	return nil
 }
 
@@ -102,13 +119,46 @@ func (kr *k8seventsReceiver) startWatch(ns string) {
 }
 
 func (kr *k8seventsReceiver) handleEvent(ev *corev1.Event) {
-	if kr.allowEvent(ev) {
-		ld := k8sEventToLogData(kr.settings.Logger, ev)
+	if !kr.allowEvent(ev) {
+		return
+	}
+	if kr.aggregator != nil {
+		kr.aggregator.add(ev)
+		return
+	}
+
+	ld := k8sEventToLogData(kr.settings.Logger, ev)
+
+	ctx := kr.obsrecv.StartLogsOp(kr.ctx)
+	consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
+	kr.obsrecv.EndLogsOp(ctx, metadata.Type, 1, consumerErr)
+}
+
+// flushAggregatedEvents emits the aggregated events at the end of each window,
+// until the receiver is shut down.
+func (kr *k8seventsReceiver) flushAggregatedEvents() {
+	defer kr.wg.Done()
+	ticker := time.NewTicker(kr.config.Aggregation.Window)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-kr.ctx.Done():
+			return
+		case <-ticker.C:
+			kr.emitAggregatedEvents(kr.ctx)
+		}
+	}
+}
 
-		ctx := kr.obsrecv.StartLogsOp(kr.ctx)
-		consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
-		kr.obsrecv.EndLogsOp(ctx, metadata.Type, 1, consumerErr)
+// emitAggregatedEvents sends one log record per event aggregated since the last call.
+func (kr *k8seventsReceiver) emitAggregatedEvents(ctx context.Context) {
+	ld, count := kr.aggregator.flush(kr.settings.Logger)
+	if count == 0 {
+		return
 	}
+	ctx = kr.obsrecv.StartLogsOp(ctx)
+	consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
+	kr.obsrecv.EndLogsOp(ctx, metadata.Type, count, consumerErr)
 }
 
 // startWatchingNamespace creates an informer and starts
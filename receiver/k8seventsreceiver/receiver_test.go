@@ -158,3 +158,75 @@ func getEvent() *corev1.Event {
 		},
 	}
 }
+
+func TestAggregateEvents(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Aggregation.Enabled = true
+	client := fake.NewSimpleClientset()
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		receivertest.NewNopCreateSettings(),
+		rCfg,
+		sink,
+		client,
+	)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = context.Background()
+
+	first := time.Now().Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		k8sEvent := getEvent()
+		k8sEvent.FirstTimestamp = v1.Time{Time: first.Add(time.Duration(i) * time.Second)}
+		recv.handleEvent(k8sEvent)
+	}
+	other := getEvent()
+	other.FirstTimestamp = v1.Time{Time: first}
+	other.Reason = "testing_event_2"
+	recv.handleEvent(other)
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	recv.emitAggregatedEvents(context.Background())
+	require.Equal(t, 2, sink.LogRecordCount())
+
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	count, ok := lr.Attributes().Get("k8s.event.count")
+	require.True(t, ok)
+	assert.Equal(t, int64(3), count.Int())
+	firstTimestamp, ok := lr.Attributes().Get("k8s.event.first_timestamp")
+	require.True(t, ok)
+	assert.Equal(t, first.Format(time.RFC3339Nano), firstTimestamp.Str())
+	lastTimestamp, ok := lr.Attributes().Get("k8s.event.last_timestamp")
+	require.True(t, ok)
+	assert.Equal(t, first.Add(2*time.Second).Format(time.RFC3339Nano), lastTimestamp.Str())
+	assert.Equal(t, first.Add(2*time.Second).UnixNano(), lr.Timestamp().AsTime().UnixNano())
+
+	lr = sink.AllLogs()[0].ResourceLogs().At(1).ScopeLogs().At(0).LogRecords().At(0)
+	reason, ok := lr.Attributes().Get("k8s.event.reason")
+	require.True(t, ok)
+	assert.Equal(t, "testing_event_2", reason.Str())
+
+	// nothing is emitted when no event was seen in the window
+	recv.emitAggregatedEvents(context.Background())
+	assert.Len(t, sink.AllLogs(), 1)
+}
+
+func TestAggregatedEventsFlushedOnShutdown(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Aggregation.Enabled = true
+	rCfg.Aggregation.Window = time.Hour
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		receivertest.NewNopCreateSettings(),
+		rCfg,
+		sink,
+		fake.NewSimpleClientset(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	r.(*k8seventsReceiver).handleEvent(getEvent())
+	assert.Equal(t, 0, sink.LogRecordCount())
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -40,7 +42,10 @@ type K8sObjectsConfig struct {
 	FieldSelector   string        `mapstructure:"field_selector"`
 	Interval        time.Duration `mapstructure:"interval"`
 	ResourceVersion string        `mapstructure:"resource_version"`
-	gvr             *schema.GroupVersionResource
+	// DiffMode emits only the fields changed by the modifications of the watched objects,
+	// instead of the full objects. It is only supported in watch mode.
+	DiffMode bool `mapstructure:"diff_mode"`
+	gvr      *schema.GroupVersionResource
 }
 
 type Config struct {
@@ -83,6 +88,17 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("invalid mode: %v", object.Mode)
 		}
 
+		if _, err := labels.Parse(object.LabelSelector); err != nil {
+			return fmt.Errorf("invalid label_selector for %v: %w", object.Name, err)
+		}
+		if _, err := fields.ParseSelector(object.FieldSelector); err != nil {
+			return fmt.Errorf("invalid field_selector for %v: %w", object.Name, err)
+		}
+
+		if object.DiffMode && object.Mode != WatchMode {
+			return fmt.Errorf("diff_mode is only supported in watch mode, %v is in %v mode", object.Name, object.Mode)
+		}
+
 		if object.Mode == PullMode && object.Interval == 0 {
 			object.Interval = defaultPullInterval
 		}
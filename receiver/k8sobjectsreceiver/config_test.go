@@ -62,6 +62,17 @@ func TestLoadConfig(t *testing.T) {
 				Resource: "events",
 			},
 		},
+		{
+			Name:            "pods",
+			Mode:            WatchMode,
+			ResourceVersion: "1",
+			DiffMode:        true,
+			gvr: &schema.GroupVersionResource{
+				Group:    "",
+				Version:  "v1",
+				Resource: "pods",
+			},
+		},
 	}
 	assert.EqualValues(t, expected, cfg.Objects)
 
@@ -87,6 +98,42 @@ func TestValidConfigs(t *testing.T) {
 
 }
 
+func TestInvalidObjectConfigs(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid_config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          string
+		expectedErr string
+	}{
+		{
+			id:          "k8sobjects/invalid_label_selector",
+			expectedErr: "invalid label_selector for pods",
+		},
+		{
+			id:          "k8sobjects/invalid_field_selector",
+			expectedErr: "invalid field_selector for pods",
+		},
+		{
+			id:          "k8sobjects/diff_mode_in_pull_mode",
+			expectedErr: "diff_mode is only supported in watch mode, pods is in pull mode",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			sub, err := cm.Sub(tt.id)
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			cfg.makeDiscoveryClient = getMockDiscoveryClient
+			assert.ErrorContains(t, component.ValidateConfig(cfg), tt.expectedErr)
+		})
+	}
+}
+
 func TestValidateResourceConflict(t *testing.T) {
 	t.Parallel()
 
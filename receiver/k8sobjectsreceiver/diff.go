@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver"
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// ignoredDiffPaths are the fields which change with every version of an object.
+var ignoredDiffPaths = map[string]bool{
+	"metadata.resourceVersion": true,
+	"metadata.managedFields":   true,
+}
+
+// fieldChange is a field which was added, removed or changed between two versions of an object.
+type fieldChange struct {
+	path     string
+	oldValue interface{}
+	newValue interface{}
+	hasOld   bool
+	hasNew   bool
+}
+
+// asMap returns the change as the raw value of a log attribute.
+func (c fieldChange) asMap() map[string]interface{} {
+	m := map[string]interface{}{"path": c.path}
+	if c.hasOld {
+		m["old"] = c.oldValue
+	}
+	if c.hasNew {
+		m["new"] = c.newValue
+	}
+	return m
+}
+
+// diffTracker keeps the last seen version of the watched objects, to compute their changes.
+type diffTracker struct {
+	objects map[types.UID]map[string]interface{}
+}
+
+func newDiffTracker() *diffTracker {
+	return &diffTracker{objects: make(map[types.UID]map[string]interface{})}
+}
+
+// changes returns the changes of the object of a watch event since its previous version.
+// It returns false when the event must be emitted in full, i.e. for the events other than
+// modifications, for the modifications of objects whose previous version is unknown, and
+// when the diff mode is disabled (nil tracker).
+func (t *diffTracker) changes(event *watch.Event) ([]fieldChange, bool) {
+	if t == nil {
+		return nil, false
+	}
+	obj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false
+	}
+	uid := obj.GetUID()
+	switch event.Type {
+	case watch.Added:
+		t.objects[uid] = obj.Object
+	case watch.Modified:
+		previous, found := t.objects[uid]
+		t.objects[uid] = obj.Object
+		if found {
+			return diffObjects(previous, obj.Object), true
+		}
+	case watch.Deleted:
+		delete(t.objects, uid)
+	case watch.Bookmark, watch.Error:
+	}
+	return nil, false
+}
+
+// diffObjects returns the changes between two versions of an object, sorted by path.
+// The maps are compared field by field, the other values as a whole.
+func diffObjects(oldObj, newObj map[string]interface{}) []fieldChange {
+	var changes []fieldChange
+	diffMaps(nil, oldObj, newObj, &changes)
+	sort.Slice(changes, func(i, j int) bool { return changes[i].path < changes[j].path })
+	return changes
+}
+
+func diffMaps(path []string, oldMap, newMap map[string]interface{}, changes *[]fieldChange) {
+	for k, oldValue := range oldMap {
+		p := append(path[:len(path):len(path)], k)
+		if ignoredDiffPaths[joinPath(p)] {
+			continue
+		}
+		newValue, ok := newMap[k]
+		if !ok {
+			*changes = append(*changes, fieldChange{path: joinPath(p), oldValue: oldValue, hasOld: true})
+			continue
+		}
+		oldChild, oldIsMap := oldValue.(map[string]interface{})
+		newChild, newIsMap := newValue.(map[string]interface{})
+		if oldIsMap && newIsMap {
+			diffMaps(p, oldChild, newChild, changes)
+			continue
+		}
+		if !reflect.DeepEqual(oldValue, newValue) {
+			*changes = append(*changes, fieldChange{path: joinPath(p), oldValue: oldValue, newValue: newValue, hasOld: true, hasNew: true})
+		}
+	}
+	for k, newValue := range newMap {
+		if _, ok := oldMap[k]; ok {
+			continue
+		}
+		p := append(path[:len(path):len(path)], k)
+		if ignoredDiffPaths[joinPath(p)] {
+			continue
+		}
+		*changes = append(*changes, fieldChange{path: joinPath(p), newValue: newValue, hasNew: true})
+	}
+}
+
+// joinPath joins the keys of a field with dots, the keys containing dots
+// (e.g. the keys of the labels) are enclosed in brackets.
+func joinPath(path []string) string {
+	var sb strings.Builder
+	for i, k := range path {
+		switch {
+		case strings.Contains(k, "."):
+			sb.WriteString("[" + k + "]")
+		case i > 0:
+			sb.WriteString("." + k)
+		default:
+			sb.WriteString(k)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobjectsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestDiffObjects(t *testing.T) {
+	oldObj := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "web",
+			"resourceVersion": "1",
+			"labels": map[string]interface{}{
+				"app.kubernetes.io/name": "web",
+				"tier":                   "frontend",
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"paused":   true,
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{"Available"},
+		},
+	}
+	newObj := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "web",
+			"resourceVersion": "2",
+			"labels": map[string]interface{}{
+				"app.kubernetes.io/name": "web-v2",
+				"tier":                   "frontend",
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"strategy": "RollingUpdate",
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{"Available", "Progressing"},
+		},
+	}
+
+	assert.Equal(t, []fieldChange{
+		{path: "metadata.labels[app.kubernetes.io/name]", oldValue: "web", newValue: "web-v2", hasOld: true, hasNew: true},
+		{path: "spec.paused", oldValue: true, hasOld: true},
+		{path: "spec.replicas", oldValue: int64(1), newValue: int64(3), hasOld: true, hasNew: true},
+		{path: "spec.strategy", newValue: "RollingUpdate", hasNew: true},
+		{path: "status.conditions", oldValue: []interface{}{"Available"}, newValue: []interface{}{"Available", "Progressing"}, hasOld: true, hasNew: true},
+	}, diffObjects(oldObj, newObj))
+
+	assert.Empty(t, diffObjects(oldObj, oldObj))
+}
+
+func TestDiffTracker(t *testing.T) {
+	newObject := func(uid, replicas string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": replicas},
+		}}
+		obj.SetUID(types.UID("uid-" + uid))
+		return obj
+	}
+
+	var disabled *diffTracker
+	_, isDiff := disabled.changes(&watch.Event{Type: watch.Modified, Object: newObject("1", "1")})
+	assert.False(t, isDiff)
+
+	tracker := newDiffTracker()
+	// the previous version of the object is unknown
+	_, isDiff = tracker.changes(&watch.Event{Type: watch.Modified, Object: newObject("1", "1")})
+	assert.False(t, isDiff)
+
+	changes, isDiff := tracker.changes(&watch.Event{Type: watch.Modified, Object: newObject("1", "2")})
+	assert.True(t, isDiff)
+	assert.Equal(t, []fieldChange{{path: "spec.replicas", oldValue: "1", newValue: "2", hasOld: true, hasNew: true}}, changes)
+
+	_, isDiff = tracker.changes(&watch.Event{Type: watch.Added, Object: newObject("2", "1")})
+	assert.False(t, isDiff)
+	changes, isDiff = tracker.changes(&watch.Event{Type: watch.Modified, Object: newObject("2", "1")})
+	assert.True(t, isDiff)
+	assert.Empty(t, changes)
+
+	_, isDiff = tracker.changes(&watch.Event{Type: watch.Deleted, Object: newObject("2", "1")})
+	assert.False(t, isDiff)
+	assert.Len(t, tracker.objects, 1)
+
+	assert.Equal(t, map[string]interface{}{"path": "spec.paused", "old": true},
+		fieldChange{path: "spec.paused", oldValue: true, hasOld: true}.asMap())
+}
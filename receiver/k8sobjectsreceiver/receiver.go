@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -159,6 +160,11 @@ func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjects
 		return
 	}
 
+	var tracker *diffTracker
+	if config.DiffMode {
+		tracker = newDiffTracker()
+	}
+
 	res := watch.ResultChan()
 	for {
 		select {
@@ -167,7 +173,15 @@ func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjects
 				kr.setting.Logger.Warn("Watch channel closed unexpectedly", zap.String("resource", config.gvr.String()))
 				return
 			}
-			logs := watchObjectsToLogData(&data, time.Now(), config)
+			var logs plog.Logs
+			if changes, isDiff := tracker.changes(&data); isDiff {
+				if len(changes) == 0 {
+					continue
+				}
+				logs = watchObjectChangesToLogData(&data, changes, time.Now(), config)
+			} else {
+				logs = watchObjectsToLogData(&data, time.Now(), config)
+			}
 
 			obsCtx := kr.obsrecv.StartLogsOp(ctx)
 			err := kr.consumer.ConsumeLogs(obsCtx, logs)
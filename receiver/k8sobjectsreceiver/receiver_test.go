@@ -139,3 +139,80 @@ func TestWatchObject(t *testing.T) {
 
 	assert.NoError(t, r.Shutdown(ctx))
 }
+
+func TestWatchObjectDiffMode(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+			DiffMode:   true,
+		},
+	}
+
+	err := rCfg.Validate()
+	require.NoError(t, err)
+
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(
+		receivertest.NewNopCreateSettings(),
+		rCfg,
+		consumer,
+	)
+
+	ctx := context.Background()
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	require.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
+
+	time.Sleep(time.Millisecond * 100)
+
+	pod := generatePod("pod1", "default", map[string]interface{}{
+		"environment": "production",
+	})
+	pod.SetUID("pod1-uid")
+	mockClient.createPods(pod)
+	time.Sleep(time.Millisecond * 100)
+	require.Len(t, consumer.Logs(), 1)
+
+	// the modifications without any change besides the resource version are skipped
+	pod.SetResourceVersion("2")
+	mockClient.updatePods(pod)
+	time.Sleep(time.Millisecond * 100)
+	require.Len(t, consumer.Logs(), 1)
+
+	pod.SetLabels(map[string]string{"environment": "test"})
+	mockClient.updatePods(pod)
+	time.Sleep(time.Millisecond * 100)
+	require.Len(t, consumer.Logs(), 2)
+
+	record := consumer.Logs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	changes, ok := record.Attributes().Get("k8s.object.changes")
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"path": "metadata.labels.environment", "old": "production", "new": "test"},
+	}, changes.Slice().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"type": "MODIFIED",
+		"object": map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pods",
+			"metadata": map[string]interface{}{
+				"name":            "pod1",
+				"namespace":       "default",
+				"uid":             "pod1-uid",
+				"resourceVersion": "2",
+			},
+		},
+	}, record.Body().Map().AsRaw())
+
+	assert.NoError(t, r.Shutdown(ctx))
+}
@@ -26,14 +26,49 @@ func watchObjectsToLogData(event *watch.Event, observedAt time.Time, config *K8s
 		}},
 	}
 
-	return unstructuredListToLogData(&ul, observedAt, config, func(attrs pcommon.Map) {
+	return unstructuredListToLogData(&ul, observedAt, config, eventNameUpdater(udata))
+}
+
+// watchObjectChangesToLogData returns the changes of a modified object. The body holds the
+// reference of the object, and the changes are in the k8s.object.changes attribute.
+func watchObjectChangesToLogData(event *watch.Event, changes []fieldChange, observedAt time.Time, config *K8sObjectsConfig) plog.Logs {
+	udata := event.Object.(*unstructured.Unstructured)
+	ul := unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{{
+			Object: map[string]interface{}{
+				"type": string(event.Type),
+				"object": map[string]interface{}{
+					"apiVersion": udata.GetAPIVersion(),
+					"kind":       udata.GetKind(),
+					"metadata": map[string]interface{}{
+						"name":            udata.GetName(),
+						"namespace":       udata.GetNamespace(),
+						"uid":             string(udata.GetUID()),
+						"resourceVersion": udata.GetResourceVersion(),
+					},
+				},
+			},
+		}},
+	}
+
+	return unstructuredListToLogData(&ul, observedAt, config, eventNameUpdater(udata), func(attrs pcommon.Map) {
+		changesSlice := attrs.PutEmptySlice("k8s.object.changes")
+		for _, c := range changes {
+			//nolint:errcheck
+			changesSlice.AppendEmpty().SetEmptyMap().FromRaw(c.asMap())
+		}
+	})
+}
+
+func eventNameUpdater(udata *unstructured.Unstructured) attrUpdaterFunc {
+	return func(attrs pcommon.Map) {
 		objectMeta := udata.Object["metadata"].(map[string]interface{})
 		name := objectMeta["name"].(string)
 		if name != "" {
 			attrs.PutStr("event.domain", "k8s")
 			attrs.PutStr("event.name", name)
 		}
-	})
+	}
 }
 
 func pullObjectsToLogData(event *unstructured.UnstructuredList, observedAt time.Time, config *K8sObjectsConfig) plog.Logs {
@@ -90,26 +90,20 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	var scrapeError error
 	// partitionIds in matchedTopics
 	topicPartitions := map[string][]int32{}
-	// currentOffset for each partition in matchedTopics
-	topicPartitionOffset := map[string]map[int32]int64{}
 	for topic := range matchedTopics {
-		topicPartitionOffset[topic] = map[int32]int64{}
 		partitions, err := s.client.Partitions(topic)
 		if err != nil {
 			scrapeError = multierr.Append(scrapeError, err)
 			continue
 		}
-		for _, p := range partitions {
-			var offset int64
-			offset, err = s.client.GetOffset(topic, p, sarama.OffsetNewest)
-			if err != nil {
-				scrapeError = multierr.Append(scrapeError, err)
-				continue
-			}
-			topicPartitions[topic] = append(topicPartitions[topic], p)
-			topicPartitionOffset[topic][p] = offset
-		}
+		topicPartitions[topic] = partitions
 	}
+	leaders, leaderErr := groupByLeader(s.client, topicPartitions)
+	scrapeError = multierr.Append(scrapeError, leaderErr)
+	// currentOffset for each partition in matchedTopics
+	topicPartitionOffset, offsetErr := fetchNewestOffsets(leaders, s.kafkaVersion())
+	scrapeError = multierr.Append(scrapeError, offsetErr)
+
 	consumerGroups, listErr := s.clusterAdmin.DescribeConsumerGroups(matchedGrpIds)
 	if listErr != nil {
 		return pmetric.Metrics{}, listErr
@@ -126,6 +120,8 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 			continue
 		}
 
+		// committed offset of each partition the group lags behind on
+		laggingOffsets := map[string]map[int32]int64{}
 		for topic, partitions := range groupOffsetFetchResponse.Blocks {
 			// tracking matchedTopics consumed by this group
 			// by checking if any of the blocks has an offset
@@ -142,7 +138,7 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 				for partition, block := range partitions {
 					consumerOffset := block.Offset
 					offsetSum += consumerOffset
-					s.mb.RecordKafkaConsumerGroupOffsetDataPoint(now, offsetSum, group.GroupId, topic, int64(partition))
+					s.mb.RecordKafkaConsumerGroupOffsetDataPoint(now, consumerOffset, group.GroupId, topic, int64(partition))
 
 					// default -1 to indicate no lag measured.
 					var consumerLag int64 = -1
@@ -154,16 +150,55 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 						}
 					}
 					s.mb.RecordKafkaConsumerGroupLagDataPoint(now, consumerLag, group.GroupId, topic, int64(partition))
+
+					switch {
+					case consumerLag == 0:
+						s.mb.RecordKafkaConsumerGroupTimeLagDataPoint(now, 0, group.GroupId, topic, int64(partition))
+					case consumerLag > 0:
+						if _, ok := laggingOffsets[topic]; !ok {
+							laggingOffsets[topic] = map[int32]int64{}
+						}
+						laggingOffsets[topic][partition] = consumerOffset
+					}
 				}
 				s.mb.RecordKafkaConsumerGroupOffsetSumDataPoint(now, offsetSum, group.GroupId, topic)
 				s.mb.RecordKafkaConsumerGroupLagSumDataPoint(now, lagSum, group.GroupId, topic)
 			}
 		}
+
+		if s.config.Metrics.KafkaConsumerGroupTimeLag.Enabled && len(laggingOffsets) > 0 {
+			scrapeError = multierr.Append(scrapeError, s.recordTimeLag(now, group.GroupId, leaders, laggingOffsets))
+		}
 	}
 
 	return s.mb.Emit(), scrapeError
 }
 
+// recordTimeLag records the time elapsed since the message at the committed
+// offset of every lagging partition was written, fetching these messages with
+// a single request to each leader.
+func (s *consumerScraper) recordTimeLag(now pcommon.Timestamp, groupID string, leaders map[int32]*leaderPartitions, committedOffsets map[string]map[int32]int64) error {
+	timestamps, err := fetchTimestamps(leaders, committedOffsets, s.kafkaVersion())
+	for topic, partitions := range timestamps {
+		for partition, timestamp := range partitions {
+			timeLag := now.AsTime().Sub(timestamp)
+			if timeLag < 0 {
+				// clocks of the producer and the collector are not synchronized
+				timeLag = 0
+			}
+			s.mb.RecordKafkaConsumerGroupTimeLagDataPoint(now, timeLag.Seconds(), groupID, topic, int64(partition))
+		}
+	}
+	return err
+}
+
+func (s *consumerScraper) kafkaVersion() sarama.KafkaVersion {
+	if s.saramaConfig == nil {
+		return sarama.DefaultVersion
+	}
+	return s.saramaConfig.Version
+}
+
 func createConsumerScraper(_ context.Context, cfg Config, saramaConfig *sarama.Config,
 	settings receiver.CreateSettings) (scraperhelper.Scraper, error) {
 	groupFilter, err := regexp.Compile(cfg.GroupMatch)
@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -132,6 +134,7 @@ func TestConsumerScraper_createScraper_handles_invalid_group_match(t *testing.T)
 }
 
 func TestConsumerScraper_scrape(t *testing.T) {
+	getPartitionLeader = mockGetPartitionLeader
 	filter := regexp.MustCompile(defaultGroupMatch)
 	cs := consumerScraper{
 		client:       newMockClient(),
@@ -178,6 +181,7 @@ func TestConsumerScraper_scrape_handlesListConsumerGroupError(t *testing.T) {
 }
 
 func TestConsumerScraper_scrape_handlesDescribeConsumerError(t *testing.T) {
+	getPartitionLeader = mockGetPartitionLeader
 	filter := regexp.MustCompile(defaultGroupMatch)
 	clusterAdmin := newMockClusterAdmin()
 	clusterAdmin.consumerGroupDescriptions = nil
@@ -193,6 +197,7 @@ func TestConsumerScraper_scrape_handlesDescribeConsumerError(t *testing.T) {
 }
 
 func TestConsumerScraper_scrape_handlesOffsetPartialError(t *testing.T) {
+	getPartitionLeader = mockGetPartitionLeader
 	filter := regexp.MustCompile(defaultGroupMatch)
 	clusterAdmin := newMockClusterAdmin()
 	client := newMockClient()
@@ -211,6 +216,7 @@ func TestConsumerScraper_scrape_handlesOffsetPartialError(t *testing.T) {
 }
 
 func TestConsumerScraper_scrape_handlesPartitionPartialError(t *testing.T) {
+	getPartitionLeader = mockGetPartitionLeader
 	filter := regexp.MustCompile(defaultGroupMatch)
 	clusterAdmin := newMockClusterAdmin()
 	client := newMockClient()
@@ -227,3 +233,90 @@ func TestConsumerScraper_scrape_handlesPartitionPartialError(t *testing.T) {
 	_, err := cs.scrape(context.Background())
 	assert.Error(t, err)
 }
+
+func TestConsumerScraper_scrape_timeLag(t *testing.T) {
+	getPartitionLeader = mockGetPartitionLeader
+	filter := regexp.MustCompile(defaultGroupMatch)
+	client := newMockClient()
+	client.offset = 3
+	client.timestamp = time.Now().Add(-time.Minute)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.KafkaConsumerGroupTimeLag.Enabled = true
+	cs := consumerScraper{
+		client:       client,
+		settings:     receivertest.NewNopCreateSettings(),
+		clusterAdmin: newMockClusterAdmin(),
+		topicFilter:  filter,
+		groupFilter:  filter,
+		config:       *cfg,
+	}
+	require.NoError(t, cs.start(context.Background(), componenttest.NewNopHost()))
+	md, err := cs.scrape(context.Background())
+	require.NoError(t, err)
+
+	offset := findConsumerGroupDataPoint(t, md, "kafka.consumer_group.offset")
+	assert.Equal(t, int64(1), offset.IntValue())
+	lag := findConsumerGroupDataPoint(t, md, "kafka.consumer_group.lag")
+	assert.Equal(t, int64(2), lag.IntValue())
+	// the message at the committed offset was written a second after timestamp
+	timeLag := findConsumerGroupDataPoint(t, md, "kafka.consumer_group.time_lag")
+	assert.InDelta(t, 59, timeLag.DoubleValue(), 5)
+}
+
+func TestConsumerScraper_scrape_timeLagCaughtUp(t *testing.T) {
+	getPartitionLeader = mockGetPartitionLeader
+	filter := regexp.MustCompile(defaultGroupMatch)
+	// no timestamp makes fetching messages fail, none should be fetched
+	client := newMockClient()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.KafkaConsumerGroupTimeLag.Enabled = true
+	cs := consumerScraper{
+		client:       client,
+		settings:     receivertest.NewNopCreateSettings(),
+		clusterAdmin: newMockClusterAdmin(),
+		topicFilter:  filter,
+		groupFilter:  filter,
+		config:       *cfg,
+	}
+	require.NoError(t, cs.start(context.Background(), componenttest.NewNopHost()))
+	md, err := cs.scrape(context.Background())
+	require.NoError(t, err)
+
+	timeLag := findConsumerGroupDataPoint(t, md, "kafka.consumer_group.time_lag")
+	assert.Equal(t, float64(0), timeLag.DoubleValue())
+}
+
+func TestConsumerScraper_scrape_handlesFetchError(t *testing.T) {
+	getPartitionLeader = mockGetPartitionLeader
+	filter := regexp.MustCompile(defaultGroupMatch)
+	client := newMockClient()
+	client.offset = 3
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.KafkaConsumerGroupTimeLag.Enabled = true
+	cs := consumerScraper{
+		client:       client,
+		settings:     receivertest.NewNopCreateSettings(),
+		clusterAdmin: newMockClusterAdmin(),
+		topicFilter:  filter,
+		groupFilter:  filter,
+		config:       *cfg,
+	}
+	require.NoError(t, cs.start(context.Background(), componenttest.NewNopHost()))
+	md, err := cs.scrape(context.Background())
+	assert.Error(t, err)
+
+	lag := findConsumerGroupDataPoint(t, md, "kafka.consumer_group.lag")
+	assert.Equal(t, int64(2), lag.IntValue())
+}
+
+func findConsumerGroupDataPoint(t *testing.T, md pmetric.Metrics, name string) pmetric.NumberDataPoint {
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			require.Equal(t, 1, metrics.At(i).Gauge().DataPoints().Len())
+			return metrics.At(i).Gauge().DataPoints().At(0)
+		}
+	}
+	require.Failf(t, "metric not found", "%s", name)
+	return pmetric.NumberDataPoint{}
+}
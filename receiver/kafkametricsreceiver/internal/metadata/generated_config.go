@@ -31,6 +31,7 @@ type MetricsConfig struct {
 	KafkaConsumerGroupMembers    MetricConfig `mapstructure:"kafka.consumer_group.members"`
 	KafkaConsumerGroupOffset     MetricConfig `mapstructure:"kafka.consumer_group.offset"`
 	KafkaConsumerGroupOffsetSum  MetricConfig `mapstructure:"kafka.consumer_group.offset_sum"`
+	KafkaConsumerGroupTimeLag    MetricConfig `mapstructure:"kafka.consumer_group.time_lag"`
 	KafkaPartitionCurrentOffset  MetricConfig `mapstructure:"kafka.partition.current_offset"`
 	KafkaPartitionOldestOffset   MetricConfig `mapstructure:"kafka.partition.oldest_offset"`
 	KafkaPartitionReplicas       MetricConfig `mapstructure:"kafka.partition.replicas"`
@@ -58,6 +59,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		KafkaConsumerGroupOffsetSum: MetricConfig{
 			Enabled: true,
 		},
+		KafkaConsumerGroupTimeLag: MetricConfig{
+			Enabled: false,
+		},
 		KafkaPartitionCurrentOffset: MetricConfig{
 			Enabled: true,
 		},
@@ -32,6 +32,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaConsumerGroupMembers:    MetricConfig{Enabled: true},
 					KafkaConsumerGroupOffset:     MetricConfig{Enabled: true},
 					KafkaConsumerGroupOffsetSum:  MetricConfig{Enabled: true},
+					KafkaConsumerGroupTimeLag:    MetricConfig{Enabled: true},
 					KafkaPartitionCurrentOffset:  MetricConfig{Enabled: true},
 					KafkaPartitionOldestOffset:   MetricConfig{Enabled: true},
 					KafkaPartitionReplicas:       MetricConfig{Enabled: true},
@@ -50,6 +51,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaConsumerGroupMembers:    MetricConfig{Enabled: false},
 					KafkaConsumerGroupOffset:     MetricConfig{Enabled: false},
 					KafkaConsumerGroupOffsetSum:  MetricConfig{Enabled: false},
+					KafkaConsumerGroupTimeLag:    MetricConfig{Enabled: false},
 					KafkaPartitionCurrentOffset:  MetricConfig{Enabled: false},
 					KafkaPartitionOldestOffset:   MetricConfig{Enabled: false},
 					KafkaPartitionReplicas:       MetricConfig{Enabled: false},
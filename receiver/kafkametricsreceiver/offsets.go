@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkametricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/multierr"
+)
+
+const (
+	// fetchMaxWait is the time the broker may wait for messages to be available,
+	// in milliseconds. Only partitions with a lag are fetched so messages are
+	// always available right away.
+	fetchMaxWait = 500
+	// fetchPartitionMaxBytes is the amount of data returned per partition. Only
+	// the first message is needed, the broker returns the whole first batch
+	// even if it is larger.
+	fetchPartitionMaxBytes = 32 * 1024
+)
+
+// partitionLeader is the subset of *sarama.Broker used to send requests to the
+// leader of partitions.
+type partitionLeader interface {
+	ID() int32
+	GetAvailableOffsets(request *sarama.OffsetRequest) (*sarama.OffsetResponse, error)
+	Fetch(request *sarama.FetchRequest) (*sarama.FetchResponse, error)
+}
+
+var getPartitionLeader = func(client sarama.Client, topic string, partition int32) (partitionLeader, error) {
+	return client.Leader(topic, partition)
+}
+
+// leaderPartitions holds the partitions of every topic led by a broker.
+type leaderPartitions struct {
+	leader     partitionLeader
+	partitions map[string][]int32
+}
+
+// groupByLeader groups the partitions by leader, so that a single request is
+// sent to every broker instead of a request per partition.
+func groupByLeader(client sarama.Client, topicPartitions map[string][]int32) (map[int32]*leaderPartitions, error) {
+	var errs error
+	leaders := map[int32]*leaderPartitions{}
+	for topic, partitions := range topicPartitions {
+		for _, partition := range partitions {
+			leader, err := getPartitionLeader(client, topic, partition)
+			if err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("failed to get leader of partition %d of topic %s: %w", partition, topic, err))
+				continue
+			}
+			lp, ok := leaders[leader.ID()]
+			if !ok {
+				lp = &leaderPartitions{leader: leader, partitions: map[string][]int32{}}
+				leaders[leader.ID()] = lp
+			}
+			lp.partitions[topic] = append(lp.partitions[topic], partition)
+		}
+	}
+	return leaders, errs
+}
+
+// fetchNewestOffsets returns the offset of the next message written to every
+// partition, sending a single ListOffsets request to each leader.
+func fetchNewestOffsets(leaders map[int32]*leaderPartitions, version sarama.KafkaVersion) (map[string]map[int32]int64, error) {
+	var errs error
+	offsets := map[string]map[int32]int64{}
+	for _, lp := range leaders {
+		request := &sarama.OffsetRequest{}
+		if version.IsAtLeast(sarama.V0_10_1_0) {
+			request.Version = 1
+		}
+		for topic, partitions := range lp.partitions {
+			for _, partition := range partitions {
+				request.AddBlock(topic, partition, sarama.OffsetNewest, 1)
+			}
+		}
+		response, err := lp.leader.GetAvailableOffsets(request)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to list offsets of broker %d: %w", lp.leader.ID(), err))
+			continue
+		}
+		for topic, partitions := range lp.partitions {
+			for _, partition := range partitions {
+				block := response.GetBlock(topic, partition)
+				switch {
+				case block == nil:
+					errs = multierr.Append(errs, fmt.Errorf("no offset returned for partition %d of topic %s", partition, topic))
+				case block.Err != sarama.ErrNoError:
+					errs = multierr.Append(errs, fmt.Errorf("failed to list offset of partition %d of topic %s: %w", partition, topic, block.Err))
+				default:
+					offset := block.Offset
+					if len(block.Offsets) > 0 {
+						offset = block.Offsets[0]
+					}
+					if _, ok := offsets[topic]; !ok {
+						offsets[topic] = map[int32]int64{}
+					}
+					offsets[topic][partition] = offset
+				}
+			}
+		}
+	}
+	return offsets, errs
+}
+
+// fetchTimestamps returns the timestamp of the message at the given offset of
+// every partition, sending a single Fetch request to each leader. Partitions
+// without a timestamp, such as on brokers older than 0.10, are left out.
+func fetchTimestamps(leaders map[int32]*leaderPartitions, offsets map[string]map[int32]int64, version sarama.KafkaVersion) (map[string]map[int32]time.Time, error) {
+	var errs error
+	timestamps := map[string]map[int32]time.Time{}
+	for _, lp := range leaders {
+		request := newFetchRequest(version)
+		requested := map[string][]int32{}
+		for topic, partitions := range lp.partitions {
+			for _, partition := range partitions {
+				if offset, ok := offsets[topic][partition]; ok {
+					request.AddBlock(topic, partition, offset, fetchPartitionMaxBytes, -1)
+					requested[topic] = append(requested[topic], partition)
+				}
+			}
+		}
+		if len(requested) == 0 {
+			continue
+		}
+		response, err := lp.leader.Fetch(request)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to fetch messages from broker %d: %w", lp.leader.ID(), err))
+			continue
+		}
+		for topic, partitions := range requested {
+			for _, partition := range partitions {
+				block := response.GetBlock(topic, partition)
+				switch {
+				case block == nil:
+					errs = multierr.Append(errs, fmt.Errorf("no messages returned for partition %d of topic %s", partition, topic))
+				case block.Err != sarama.ErrNoError:
+					errs = multierr.Append(errs, fmt.Errorf("failed to fetch messages of partition %d of topic %s: %w", partition, topic, block.Err))
+				default:
+					if timestamp, ok := messageTimestamp(block, offsets[topic][partition]); ok {
+						if _, ok = timestamps[topic]; !ok {
+							timestamps[topic] = map[int32]time.Time{}
+						}
+						timestamps[topic][partition] = timestamp
+					}
+				}
+			}
+		}
+	}
+	return timestamps, errs
+}
+
+func newFetchRequest(version sarama.KafkaVersion) *sarama.FetchRequest {
+	request := &sarama.FetchRequest{
+		MinBytes:    1,
+		MaxWaitTime: fetchMaxWait,
+	}
+	switch {
+	case version.IsAtLeast(sarama.V0_11_0_0):
+		request.Version = 4
+		request.MaxBytes = sarama.MaxResponseSize
+	case version.IsAtLeast(sarama.V0_10_1_0):
+		request.Version = 3
+		request.MaxBytes = sarama.MaxResponseSize
+	case version.IsAtLeast(sarama.V0_10_0_0):
+		request.Version = 2
+	}
+	return request
+}
+
+// messageTimestamp returns the timestamp of the first message of the block
+// whose offset is at least offset. This is the time the message was appended
+// to the log for topics with message.timestamp.type=LogAppendTime, and the time
+// it was created by the producer otherwise.
+func messageTimestamp(block *sarama.FetchResponseBlock, offset int64) (time.Time, bool) {
+	for _, records := range block.RecordsSet {
+		if batch := records.RecordBatch; batch != nil && !batch.Control {
+			for _, record := range batch.Records {
+				if batch.FirstOffset+record.OffsetDelta < offset {
+					continue
+				}
+				if batch.LogAppendTime {
+					return batch.MaxTimestamp, true
+				}
+				return batch.FirstTimestamp.Add(record.TimestampDelta), true
+			}
+		}
+		if records.MsgSet != nil {
+			for _, msgBlock := range records.MsgSet.Messages {
+				messages := msgBlock.Messages()
+				for _, msg := range messages {
+					msgOffset := msg.Offset
+					if msg.Msg.Version >= 1 {
+						// offsets of compressed messages are relative to the wrapper message
+						msgOffset += msgBlock.Offset - messages[len(messages)-1].Offset
+					}
+					if msgOffset < offset {
+						continue
+					}
+					if msg.Msg.Timestamp.IsZero() {
+						return time.Time{}, false
+					}
+					return msg.Msg.Timestamp, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
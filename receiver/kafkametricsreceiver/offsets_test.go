@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkametricsreceiver
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupByLeader(t *testing.T) {
+	getPartitionLeader = func(_ sarama.Client, _ string, partition int32) (partitionLeader, error) {
+		if partition == 3 {
+			return nil, fmt.Errorf("mock leader error")
+		}
+		return &mockPartitionLeader{}, nil
+	}
+	leaders, err := groupByLeader(newMockClient(), map[string][]int32{
+		testTopic: {0, 1, 2, 3},
+	})
+	assert.Error(t, err)
+	require.Len(t, leaders, 1)
+	assert.ElementsMatch(t, []int32{0, 1, 2}, leaders[1].partitions[testTopic])
+}
+
+func TestFetchNewestOffsets(t *testing.T) {
+	leaders := map[int32]*leaderPartitions{
+		1: {
+			leader:     &mockPartitionLeader{offset: 5},
+			partitions: map[string][]int32{testTopic: {testPartition}},
+		},
+	}
+	offsets, err := fetchNewestOffsets(leaders, sarama.V2_0_0_0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[int32]int64{testTopic: {testPartition: 5}}, offsets)
+}
+
+func TestFetchNewestOffsets_handlesErrors(t *testing.T) {
+	leaders := map[int32]*leaderPartitions{
+		1: {
+			leader:     &mockPartitionLeader{offset: -1},
+			partitions: map[string][]int32{testTopic: {testPartition}},
+		},
+	}
+	offsets, err := fetchNewestOffsets(leaders, sarama.V2_0_0_0)
+	assert.Error(t, err)
+	assert.Empty(t, offsets)
+
+	// the leader does not return an offset for partitions it does not lead
+	leaders[1].leader = &mockPartitionLeader{offset: 5}
+	leaders[1].partitions = map[string][]int32{testTopic: {testPartition, 2}}
+	offsets, err = fetchNewestOffsets(leaders, sarama.V2_0_0_0)
+	assert.Error(t, err)
+	assert.Equal(t, map[string]map[int32]int64{testTopic: {testPartition: 5}}, offsets)
+}
+
+func TestFetchTimestamps(t *testing.T) {
+	timestamp := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	leaders := map[int32]*leaderPartitions{
+		1: {
+			leader:     &mockPartitionLeader{offset: 5, timestamp: timestamp},
+			partitions: map[string][]int32{testTopic: {testPartition}},
+		},
+		2: {
+			// not fetched as no offset is requested for its partitions
+			leader:     &mockPartitionLeader{offset: 5},
+			partitions: map[string][]int32{"other_topic": {0}},
+		},
+	}
+	timestamps, err := fetchTimestamps(leaders, map[string]map[int32]int64{testTopic: {testPartition: 2}}, sarama.V2_0_0_0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[int32]time.Time{testTopic: {testPartition: timestamp.Add(2 * time.Second)}}, timestamps)
+}
+
+func TestMessageTimestamp(t *testing.T) {
+	timestamp := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	records := []*sarama.Record{
+		{OffsetDelta: 0},
+		{OffsetDelta: 1, TimestampDelta: time.Second},
+		{OffsetDelta: 3, TimestampDelta: 2 * time.Second},
+	}
+	tests := []struct {
+		name      string
+		records   []*sarama.Records
+		offset    int64
+		timestamp time.Time
+		ok        bool
+	}{
+		{
+			name: "create time",
+			records: []*sarama.Records{
+				{RecordBatch: &sarama.RecordBatch{FirstOffset: 10, FirstTimestamp: timestamp, Records: records}},
+			},
+			offset:    11,
+			timestamp: timestamp.Add(time.Second),
+			ok:        true,
+		},
+		{
+			name: "compacted offset",
+			records: []*sarama.Records{
+				{RecordBatch: &sarama.RecordBatch{FirstOffset: 10, FirstTimestamp: timestamp, Records: records}},
+			},
+			offset:    12,
+			timestamp: timestamp.Add(2 * time.Second),
+			ok:        true,
+		},
+		{
+			name: "log append time",
+			records: []*sarama.Records{
+				{RecordBatch: &sarama.RecordBatch{
+					FirstOffset:    10,
+					FirstTimestamp: timestamp,
+					MaxTimestamp:   timestamp.Add(time.Minute),
+					LogAppendTime:  true,
+					Records:        records,
+				}},
+			},
+			offset:    10,
+			timestamp: timestamp.Add(time.Minute),
+			ok:        true,
+		},
+		{
+			name: "control batch",
+			records: []*sarama.Records{
+				{RecordBatch: &sarama.RecordBatch{FirstOffset: 10, Control: true, Records: records[:1]}},
+				{RecordBatch: &sarama.RecordBatch{FirstOffset: 11, FirstTimestamp: timestamp, Records: records[:1]}},
+			},
+			offset:    10,
+			timestamp: timestamp,
+			ok:        true,
+		},
+		{
+			name: "message set",
+			records: []*sarama.Records{
+				{MsgSet: &sarama.MessageSet{Messages: []*sarama.MessageBlock{
+					{Offset: 10, Msg: &sarama.Message{Version: 1, Timestamp: timestamp}},
+					{Offset: 11, Msg: &sarama.Message{Version: 1, Timestamp: timestamp.Add(time.Second)}},
+				}}},
+			},
+			offset:    11,
+			timestamp: timestamp.Add(time.Second),
+			ok:        true,
+		},
+		{
+			name: "message without timestamp",
+			records: []*sarama.Records{
+				{MsgSet: &sarama.MessageSet{Messages: []*sarama.MessageBlock{
+					{Offset: 10, Msg: &sarama.Message{}},
+				}}},
+			},
+			offset: 10,
+		},
+		{
+			name: "no message",
+			records: []*sarama.Records{
+				{RecordBatch: &sarama.RecordBatch{FirstOffset: 10, FirstTimestamp: timestamp, Records: records}},
+			},
+			offset: 14,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := messageTimestamp(&sarama.FetchResponseBlock{RecordsSet: test.records}, test.offset)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.timestamp, got)
+		})
+	}
+}
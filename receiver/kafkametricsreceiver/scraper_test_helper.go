@@ -5,6 +5,7 @@ package kafkametricsreceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/mock"
@@ -44,6 +45,7 @@ type mockSaramaClient struct {
 	topics         []string
 	partitions     []int32
 	offset         int64
+	timestamp      time.Time
 	replicas       []int32
 	inSyncReplicas []int32
 }
@@ -115,6 +117,51 @@ func newMockClient() *mockSaramaClient {
 	return client
 }
 
+func mockGetPartitionLeader(client sarama.Client, _ string, _ int32) (partitionLeader, error) {
+	c := client.(*mockSaramaClient)
+	return &mockPartitionLeader{offset: c.offset, timestamp: c.timestamp}, nil
+}
+
+// mockPartitionLeader leads testPartition of testTopic, which holds messages
+// from offset 0 up to offset, written a second apart from timestamp.
+type mockPartitionLeader struct {
+	offset    int64
+	timestamp time.Time
+}
+
+func (l *mockPartitionLeader) ID() int32 {
+	return 1
+}
+
+func (l *mockPartitionLeader) GetAvailableOffsets(*sarama.OffsetRequest) (*sarama.OffsetResponse, error) {
+	if l.offset == -1 {
+		return nil, fmt.Errorf("mock offset error")
+	}
+	response := &sarama.OffsetResponse{}
+	response.AddTopicPartition(testTopic, testPartition, l.offset)
+	return response, nil
+}
+
+func (l *mockPartitionLeader) Fetch(*sarama.FetchRequest) (*sarama.FetchResponse, error) {
+	if l.timestamp.IsZero() {
+		return nil, fmt.Errorf("mock fetch error")
+	}
+	batch := &sarama.RecordBatch{FirstTimestamp: l.timestamp}
+	for i := int64(0); i < l.offset; i++ {
+		batch.Records = append(batch.Records, &sarama.Record{
+			OffsetDelta:    i,
+			TimestampDelta: time.Duration(i) * time.Second,
+		})
+	}
+	return &sarama.FetchResponse{
+		Blocks: map[string]map[int32]*sarama.FetchResponseBlock{
+			testTopic: {
+				testPartition: {RecordsSet: []*sarama.Records{{RecordBatch: batch}}},
+			},
+		},
+	}, nil
+}
+
 type mockClusterAdmin struct {
 	mock.Mock
 	sarama.ClusterAdmin
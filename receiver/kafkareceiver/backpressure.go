@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"context"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+)
+
+// partitionPauser stops and restarts the fetching of messages of partitions,
+// it is implemented by sarama.ConsumerGroup.
+type partitionPauser interface {
+	Pause(partitions map[string][]int32)
+	Resume(partitions map[string][]int32)
+}
+
+// backpressure pauses the partition of a message refused by the next consumer
+// until the message is accepted, so that no more messages are fetched from the
+// partition while the pipeline is under pressure.
+type backpressure struct {
+	id     component.ID
+	pauser partitionPauser
+	logger *zap.Logger
+
+	initialInterval time.Duration
+	maxInterval     time.Duration
+}
+
+// newBackpressure returns nil if backpressure is disabled, in which case the
+// errors of the next consumer are returned as is.
+func newBackpressure(config Backpressure, id component.ID, pauser partitionPauser, logger *zap.Logger) *backpressure {
+	if !config.Enabled {
+		return nil
+	}
+	return &backpressure{
+		id:              id,
+		pauser:          pauser,
+		logger:          logger,
+		initialInterval: config.InitialInterval,
+		maxInterval:     config.MaxInterval,
+	}
+}
+
+// retry is called after consume failed with err. Unless the error is permanent,
+// the partition is paused and consume is called again with an exponential backoff
+// until it succeeds, fails with a permanent error or the session is done.
+func (b *backpressure) retry(ctx context.Context, claim sarama.ConsumerGroupClaim, err error, consume func() error) error {
+	if b == nil || err == nil || consumererror.IsPermanent(err) {
+		return err
+	}
+
+	partitions := map[string][]int32{claim.Topic(): {claim.Partition()}}
+	statsTags := []tag.Mutator{tag.Upsert(tagInstanceName, b.id.String())}
+
+	b.pauser.Pause(partitions)
+	_ = stats.RecordWithTags(ctx, statsTags, statPartitionPaused.M(1))
+	b.logger.Warn("Next consumer refused data, pausing partition",
+		zap.String("topic", claim.Topic()),
+		zap.Int32("partition", claim.Partition()),
+		zap.Error(err))
+	defer func() {
+		b.pauser.Resume(partitions)
+		_ = stats.RecordWithTags(ctx, statsTags, statPartitionResumed.M(1))
+		b.logger.Info("Resuming partition",
+			zap.String("topic", claim.Topic()),
+			zap.Int32("partition", claim.Partition()))
+	}()
+
+	interval := b.initialInterval
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return err
+		}
+
+		if err = consume(); err == nil || consumererror.IsPermanent(err) {
+			return err
+		}
+		b.logger.Debug("Next consumer still refuses data", zap.Error(err))
+
+		interval *= 2
+		if interval > b.maxInterval {
+			interval = b.maxInterval
+		}
+		timer.Reset(interval)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+)
+
+type testPauser struct {
+	mu      sync.Mutex
+	paused  []map[string][]int32
+	resumed []map[string][]int32
+}
+
+func (p *testPauser) Pause(partitions map[string][]int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.paused = append(p.paused, partitions)
+}
+
+func (p *testPauser) Resume(partitions map[string][]int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.resumed = append(p.resumed, partitions)
+}
+
+func newTestBackpressure(pauser partitionPauser) *backpressure {
+	return newBackpressure(Backpressure{
+		Enabled:         true,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     5 * time.Millisecond,
+	}, component.NewID("kafka"), pauser, zap.NewNop())
+}
+
+func TestNewBackpressureDisabled(t *testing.T) {
+	assert.Nil(t, newBackpressure(Backpressure{}, component.NewID("kafka"), &testPauser{}, zap.NewNop()))
+
+	var b *backpressure
+	consumeErr := errors.New("refused")
+	err := b.retry(context.Background(), testConsumerGroupClaim{}, consumeErr, func() error {
+		t.Fatal("consume must not be retried when backpressure is disabled")
+		return nil
+	})
+	assert.Equal(t, consumeErr, err)
+}
+
+func TestBackpressureRetryUntilAccepted(t *testing.T) {
+	pauser := &testPauser{}
+	b := newTestBackpressure(pauser)
+
+	attempts := 0
+	err := b.retry(context.Background(), testConsumerGroupClaim{}, errors.New("refused"), func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("refused")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	expected := []map[string][]int32{{testTopic: {testPartition}}}
+	assert.Equal(t, expected, pauser.paused)
+	assert.Equal(t, expected, pauser.resumed)
+}
+
+func TestBackpressurePermanentError(t *testing.T) {
+	pauser := &testPauser{}
+	b := newTestBackpressure(pauser)
+
+	permanent := consumererror.NewPermanent(errors.New("bad data"))
+	err := b.retry(context.Background(), testConsumerGroupClaim{}, permanent, func() error {
+		t.Fatal("permanent errors must not be retried")
+		return nil
+	})
+	assert.Equal(t, permanent, err)
+	assert.Empty(t, pauser.paused)
+
+	// A permanent error returned by a retry stops the retries
+	attempts := 0
+	err = b.retry(context.Background(), testConsumerGroupClaim{}, errors.New("refused"), func() error {
+		attempts++
+		return permanent
+	})
+	assert.Equal(t, permanent, err)
+	assert.Equal(t, 1, attempts)
+	assert.Len(t, pauser.resumed, 1)
+}
+
+func TestBackpressureSessionDone(t *testing.T) {
+	pauser := &testPauser{}
+	b := newTestBackpressure(pauser)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	consumeErr := errors.New("refused")
+	err := b.retry(ctx, testConsumerGroupClaim{}, consumeErr, func() error {
+		cancel()
+		return consumeErr
+	})
+	assert.Equal(t, consumeErr, err)
+	assert.Len(t, pauser.paused, 1)
+	assert.Len(t, pauser.resumed, 1)
+}
+
+type flakyTracesConsumer struct {
+	failures int
+	sink     *consumertest.TracesSink
+}
+
+func (f *flakyTracesConsumer) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{}
+}
+
+func (f *flakyTracesConsumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("data refused due to high memory usage")
+	}
+	return f.sink.ConsumeTraces(ctx, td)
+}
+
+func TestTracesConsumerGroupHandler_backpressure(t *testing.T) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
+	require.NoError(t, err)
+	pauser := &testPauser{}
+	sink := new(consumertest.TracesSink)
+	c := tracesConsumerGroupHandler{
+		unmarshaler:  newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
+		logger:       zap.NewNop(),
+		ready:        make(chan bool),
+		nextConsumer: &flakyTracesConsumer{failures: 2, sink: sink},
+		obsrecv:      obsrecv,
+		backpressure: newTestBackpressure(pauser),
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	groupClaim := &testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+	go func() {
+		assert.NoError(t, c.ConsumeClaim(testConsumerGroupSession{ctx: context.Background()}, groupClaim))
+		wg.Done()
+	}()
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	marshaler := &ptrace.ProtoMarshaler{}
+	bts, err := marshaler.MarshalTraces(td)
+	require.NoError(t, err)
+	groupClaim.messageChan <- &sarama.ConsumerMessage{Value: bts}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	assert.Equal(t, 1, sink.SpanCount())
+	assert.Len(t, pauser.paused, 1)
+	assert.Len(t, pauser.resumed, 1)
+}
@@ -4,6 +4,7 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -31,6 +32,20 @@ type MessageMarking struct {
 	OnError bool `mapstructure:"on_error"`
 }
 
+// Backpressure controls how the receiver reacts when the next consumer refuses data,
+// e.g. because the memory limiter is triggered.
+type Backpressure struct {
+	// If true, the partition of a message which could not be consumed is paused and
+	// the message is retried with an exponential backoff until it is accepted, instead
+	// of restarting the consumer group session (default disabled).
+	// Permanent errors are not retried.
+	Enabled bool `mapstructure:"enabled"`
+	// The delay before the first retry (default 100ms)
+	InitialInterval time.Duration `mapstructure:"initial_interval"`
+	// The upper bound of the delay between retries (default 30s)
+	MaxInterval time.Duration `mapstructure:"max_interval"`
+}
+
 // Config defines configuration for Kafka receiver.
 type Config struct {
 	// The list of kafka brokers (default localhost:9092)
@@ -60,6 +75,9 @@ type Config struct {
 
 	// Controls the way the messages are marked as consumed
 	MessageMarking MessageMarking `mapstructure:"message_marking"`
+
+	// Controls the pausing of partitions when the next consumer refuses data
+	Backpressure Backpressure `mapstructure:"backpressure"`
 }
 
 const (
@@ -71,5 +89,13 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.Backpressure.Enabled {
+		if cfg.Backpressure.InitialInterval <= 0 {
+			return errors.New("backpressure::initial_interval must be positive")
+		}
+		if cfg.Backpressure.MaxInterval < cfg.Backpressure.InitialInterval {
+			return errors.New("backpressure::max_interval must not be lower than backpressure::initial_interval")
+		}
+	}
 	return nil
 }
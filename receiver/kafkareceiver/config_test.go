@@ -58,6 +58,10 @@ func TestLoadConfig(t *testing.T) {
 					Enable:   true,
 					Interval: 1 * time.Second,
 				},
+				Backpressure: Backpressure{
+					InitialInterval: 100 * time.Millisecond,
+					MaxInterval:     30 * time.Second,
+				},
 			},
 		},
 		{
@@ -90,6 +94,11 @@ func TestLoadConfig(t *testing.T) {
 					Enable:   true,
 					Interval: 1 * time.Second,
 				},
+				Backpressure: Backpressure{
+					Enabled:         true,
+					InitialInterval: time.Second,
+					MaxInterval:     time.Minute,
+				},
 			},
 		},
 	}
@@ -108,3 +117,42 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBackpressure(t *testing.T) {
+	tests := []struct {
+		name         string
+		backpressure Backpressure
+		expectedErr  string
+	}{
+		{
+			name:         "disabled",
+			backpressure: Backpressure{},
+		},
+		{
+			name:         "valid",
+			backpressure: Backpressure{Enabled: true, InitialInterval: time.Second, MaxInterval: time.Second},
+		},
+		{
+			name:         "missing initial interval",
+			backpressure: Backpressure{Enabled: true, MaxInterval: time.Second},
+			expectedErr:  "backpressure::initial_interval must be positive",
+		},
+		{
+			name:         "max interval lower than initial interval",
+			backpressure: Backpressure{Enabled: true, InitialInterval: time.Second, MaxInterval: time.Millisecond},
+			expectedErr:  "backpressure::max_interval must not be lower than backpressure::initial_interval",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Backpressure = tt.backpressure
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -35,6 +35,9 @@ const (
 	defaultAutoCommitEnable = true
 	// default from sarama.NewConfig()
 	defaultAutoCommitInterval = 1 * time.Second
+
+	defaultBackpressureInitialInterval = 100 * time.Millisecond
+	defaultBackpressureMaxInterval     = 30 * time.Second
 )
 
 // FactoryOption applies changes to kafkaExporterFactory.
@@ -111,6 +114,11 @@ func createDefaultConfig() component.Config {
 			After:   false,
 			OnError: false,
 		},
+		Backpressure: Backpressure{
+			Enabled:         false,
+			InitialInterval: defaultBackpressureInitialInterval,
+			MaxInterval:     defaultBackpressureMaxInterval,
+		},
 	}
 }
 
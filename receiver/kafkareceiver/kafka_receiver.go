@@ -40,6 +40,7 @@ type kafkaTracesConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	backpressure      Backpressure
 }
 
 // kafkaMetricsConsumer uses sarama to consume and handle messages from kafka.
@@ -54,6 +55,7 @@ type kafkaMetricsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	backpressure      Backpressure
 }
 
 // kafkaLogsConsumer uses sarama to consume and handle messages from kafka.
@@ -68,6 +70,7 @@ type kafkaLogsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	backpressure      Backpressure
 }
 
 var _ receiver.Traces = (*kafkaTracesConsumer)(nil)
@@ -114,6 +117,7 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshalers
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		backpressure:      config.Backpressure,
 	}, nil
 }
 
@@ -136,6 +140,7 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, host component.Host) erro
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		backpressure:      newBackpressure(c.backpressure, c.settings.ID, c.consumerGroup, c.settings.Logger),
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, consumerGroup); err != nil {
@@ -207,6 +212,7 @@ func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		backpressure:      config.Backpressure,
 	}, nil
 }
 
@@ -229,6 +235,7 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, host component.Host) err
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		backpressure:      newBackpressure(c.backpressure, c.settings.ID, c.consumerGroup, c.settings.Logger),
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, metricsConsumerGroup); err != nil {
@@ -300,6 +307,7 @@ func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers ma
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		backpressure:      config.Backpressure,
 	}, nil
 }
 
@@ -350,6 +358,7 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, host component.Host) error
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		backpressure:      newBackpressure(c.backpressure, c.settings.ID, c.consumerGroup, c.settings.Logger),
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, logsConsumerGroup); err != nil {
@@ -394,6 +403,7 @@ type tracesConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	backpressure      *backpressure
 }
 
 type metricsConsumerGroupHandler struct {
@@ -409,6 +419,7 @@ type metricsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	backpressure      *backpressure
 }
 
 type logsConsumerGroupHandler struct {
@@ -424,6 +435,7 @@ type logsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	backpressure      *backpressure
 }
 
 var _ sarama.ConsumerGroupHandler = (*tracesConsumerGroupHandler)(nil)
@@ -483,6 +495,12 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 			spanCount := traces.SpanCount()
 			err = c.nextConsumer.ConsumeTraces(session.Context(), traces)
 			c.obsrecv.EndTracesOp(ctx, c.unmarshaler.Encoding(), spanCount, err)
+			err = c.backpressure.retry(session.Context(), claim, err, func() error {
+				retryCtx := c.obsrecv.StartTracesOp(session.Context())
+				retryErr := c.nextConsumer.ConsumeTraces(session.Context(), traces)
+				c.obsrecv.EndTracesOp(retryCtx, c.unmarshaler.Encoding(), spanCount, retryErr)
+				return retryErr
+			})
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
@@ -558,6 +576,12 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 			dataPointCount := metrics.DataPointCount()
 			err = c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
 			c.obsrecv.EndMetricsOp(ctx, c.unmarshaler.Encoding(), dataPointCount, err)
+			err = c.backpressure.retry(session.Context(), claim, err, func() error {
+				retryCtx := c.obsrecv.StartMetricsOp(session.Context())
+				retryErr := c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
+				c.obsrecv.EndMetricsOp(retryCtx, c.unmarshaler.Encoding(), dataPointCount, retryErr)
+				return retryErr
+			})
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
@@ -638,6 +662,12 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 			err = c.nextConsumer.ConsumeLogs(session.Context(), logs)
 			// TODO
 			c.obsrecv.EndLogsOp(ctx, c.unmarshaler.Encoding(), logs.LogRecordCount(), err)
+			err = c.backpressure.retry(session.Context(), claim, err, func() error {
+				retryCtx := c.obsrecv.StartLogsOp(session.Context())
+				retryErr := c.nextConsumer.ConsumeLogs(session.Context(), logs)
+				c.obsrecv.EndLogsOp(retryCtx, c.unmarshaler.Encoding(), logs.LogRecordCount(), retryErr)
+				return retryErr
+			})
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
@@ -18,6 +18,9 @@ var (
 
 	statPartitionStart = stats.Int64("kafka_receiver_partition_start", "Number of started partitions", stats.UnitDimensionless)
 	statPartitionClose = stats.Int64("kafka_receiver_partition_close", "Number of finished partitions", stats.UnitDimensionless)
+
+	statPartitionPaused  = stats.Int64("kafka_receiver_partition_paused", "Number of times a partition was paused because of backpressure", stats.UnitDimensionless)
+	statPartitionResumed = stats.Int64("kafka_receiver_partition_resumed", "Number of times a partition was resumed after backpressure cleared", stats.UnitDimensionless)
 )
 
 // MetricViews return metric views for Kafka receiver.
@@ -64,11 +67,29 @@ func MetricViews() []*view.View {
 		Aggregation: view.Sum(),
 	}
 
+	countPartitionPaused := &view.View{
+		Name:        statPartitionPaused.Name(),
+		Measure:     statPartitionPaused,
+		Description: statPartitionPaused.Description(),
+		TagKeys:     tagKeys,
+		Aggregation: view.Sum(),
+	}
+
+	countPartitionResumed := &view.View{
+		Name:        statPartitionResumed.Name(),
+		Measure:     statPartitionResumed,
+		Description: statPartitionResumed.Description(),
+		TagKeys:     tagKeys,
+		Aggregation: view.Sum(),
+	}
+
 	return []*view.View{
 		countMessages,
 		lastValueOffset,
 		lastValueOffsetLag,
 		countPartitionStart,
 		countPartitionClose,
+		countPartitionPaused,
+		countPartitionResumed,
 	}
 }
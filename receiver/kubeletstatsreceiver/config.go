@@ -6,11 +6,14 @@ package kubeletstatsreceiver // import "github.com/open-telemetry/opentelemetry-
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -21,6 +24,28 @@ import (
 
 var _ component.Config = (*Config)(nil)
 
+// StatsSource is the source of the stats of the pods and containers.
+type StatsSource string
+
+const (
+	// StatsSourceSummary is the summary API of the kubelet.
+	StatsSourceSummary StatsSource = "summary"
+	// StatsSourceCRI is the stats API of the container runtime (CRI).
+	StatsSourceCRI StatsSource = "cri"
+	// StatsSourceAuto is the summary API of the kubelet, falling back to the stats API
+	// of the container runtime when the summary API is unavailable.
+	StatsSourceAuto StatsSource = "auto"
+)
+
+// CRIConfig is the configuration of the connection to the container runtime.
+type CRIConfig struct {
+	// Endpoint is the address of the CRI runtime service, e.g. unix:///run/containerd/containerd.sock
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Timeout is the maximum amount of time to wait for the stats of the container runtime.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 
@@ -40,10 +65,41 @@ type Config struct {
 	// Configuration of the Kubernetes API client.
 	K8sAPIConfig *k8sconfig.APIConfig `mapstructure:"k8s_api_config"`
 
+	// StatsSource is the source of the stats: "summary" for the summary API of the kubelet,
+	// "cri" for the stats API of the container runtime, or "auto" to fall back to the stats
+	// API of the container runtime when the summary API of the kubelet is unavailable.
+	// The container runtime doesn't report the stats of the node and of the volumes.
+	StatsSource StatsSource `mapstructure:"stats_source"`
+
+	// CRI is the configuration of the connection to the container runtime,
+	// used when stats_source is "cri" or "auto".
+	CRI CRIConfig `mapstructure:"cri"`
+
 	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
 
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	if err := cfg.APIConfig.Validate(); err != nil {
+		return err
+	}
+
+	switch cfg.StatsSource {
+	case "", StatsSourceSummary:
+	case StatsSourceCRI, StatsSourceAuto:
+		if cfg.CRI.Endpoint == "" {
+			return errors.New("cri.endpoint must be set when stats_source is cri or auto")
+		}
+		if cfg.CRI.Timeout <= 0 {
+			return errors.New("cri.timeout must be positive when stats_source is cri or auto")
+		}
+	default:
+		return fmt.Errorf("invalid stats_source %q, must be one of summary, cri or auto", cfg.StatsSource)
+	}
+	return nil
+}
+
 // getReceiverOptions returns scraperOptions is the config is valid,
 // otherwise it will return an error.
 func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
@@ -65,11 +121,22 @@ func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
 		}
 	}
 
+	var criConn *grpc.ClientConn
+	if cfg.StatsSource == StatsSourceCRI || cfg.StatsSource == StatsSourceAuto {
+		criConn, err = grpc.Dial(cfg.CRI.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CRI client: %w", err)
+		}
+	}
+
 	return &scraperOptions{
 		collectionInterval:    cfg.CollectionInterval,
 		extraMetadataLabels:   cfg.ExtraMetadataLabels,
 		metricGroupsToCollect: mgs,
 		k8sAPIClient:          k8sAPIClient,
+		statsSource:           cfg.StatsSource,
+		criConn:               criConn,
+		criTimeout:            cfg.CRI.Timeout,
 	}, nil
 }
 
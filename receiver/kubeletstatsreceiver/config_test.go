@@ -53,6 +53,8 @@ func TestLoadConfig(t *testing.T) {
 					kubelet.NodeMetricGroup,
 				},
 				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				StatsSource:          StatsSourceSummary,
+				CRI:                  defaultCRIConfig,
 			},
 		},
 		{
@@ -82,6 +84,8 @@ func TestLoadConfig(t *testing.T) {
 					kubelet.NodeMetricGroup,
 				},
 				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				StatsSource:          StatsSourceSummary,
+				CRI:                  defaultCRIConfig,
 			},
 		},
 		{
@@ -103,6 +107,8 @@ func TestLoadConfig(t *testing.T) {
 					kubelet.NodeMetricGroup,
 				},
 				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				StatsSource:          StatsSourceSummary,
+				CRI:                  defaultCRIConfig,
 			},
 		},
 		{
@@ -127,6 +133,8 @@ func TestLoadConfig(t *testing.T) {
 					kubelet.NodeMetricGroup,
 				},
 				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				StatsSource:          StatsSourceSummary,
+				CRI:                  defaultCRIConfig,
 			},
 		},
 		{
@@ -147,6 +155,8 @@ func TestLoadConfig(t *testing.T) {
 					kubelet.VolumeMetricGroup,
 				},
 				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				StatsSource:          StatsSourceSummary,
+				CRI:                  defaultCRIConfig,
 			},
 		},
 		{
@@ -171,6 +181,33 @@ func TestLoadConfig(t *testing.T) {
 				},
 				K8sAPIConfig:         &k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig},
 				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				StatsSource:          StatsSourceSummary,
+				CRI:                  defaultCRIConfig,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "cri"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					CollectionInterval: duration,
+					InitialDelay:       time.Second,
+				},
+				ClientConfig: kube.ClientConfig{
+					APIConfig: k8sconfig.APIConfig{
+						AuthType: "serviceAccount",
+					},
+				},
+				MetricGroupsToCollect: []kubelet.MetricGroup{
+					kubelet.ContainerMetricGroup,
+					kubelet.PodMetricGroup,
+					kubelet.NodeMetricGroup,
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				StatsSource:          StatsSourceAuto,
+				CRI: CRIConfig{
+					Endpoint: "unix:///run/crio/crio.sock",
+					Timeout:  10 * time.Second,
+				},
 			},
 		},
 	}
@@ -195,6 +232,7 @@ func TestGetReceiverOptions(t *testing.T) {
 		extraMetadataLabels   []kubelet.MetadataLabel
 		metricGroupsToCollect []kubelet.MetricGroup
 		k8sAPIConfig          *k8sconfig.APIConfig
+		statsSource           StatsSource
 	}
 	tests := []struct {
 		name    string
@@ -263,6 +301,7 @@ func TestGetReceiverOptions(t *testing.T) {
 				ExtraMetadataLabels:   tt.fields.extraMetadataLabels,
 				MetricGroupsToCollect: tt.fields.metricGroupsToCollect,
 				K8sAPIConfig:          tt.fields.k8sAPIConfig,
+				StatsSource:           tt.fields.statsSource,
 			}
 			got, err := cfg.getReceiverOptions()
 			if tt.wantErr {
@@ -274,3 +313,57 @@ func TestGetReceiverOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		mutate       func(cfg *Config)
+		errorMessage string
+	}{
+		{
+			name:   "default",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			name: "cri",
+			mutate: func(cfg *Config) {
+				cfg.StatsSource = StatsSourceCRI
+			},
+		},
+		{
+			name: "invalid stats source",
+			mutate: func(cfg *Config) {
+				cfg.StatsSource = "cadvisor"
+			},
+			errorMessage: `invalid stats_source "cadvisor", must be one of summary, cri or auto`,
+		},
+		{
+			name: "missing cri endpoint",
+			mutate: func(cfg *Config) {
+				cfg.StatsSource = StatsSourceCRI
+				cfg.CRI.Endpoint = ""
+			},
+			errorMessage: "cri.endpoint must be set when stats_source is cri or auto",
+		},
+		{
+			name: "zero cri timeout",
+			mutate: func(cfg *Config) {
+				cfg.StatsSource = StatsSourceAuto
+				cfg.CRI.Timeout = 0
+			},
+			errorMessage: "cri.timeout must be positive when stats_source is cri or auto",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.mutate(cfg)
+			err := component.ValidateConfig(cfg)
+			if tt.errorMessage == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errorMessage)
+		})
+	}
+}
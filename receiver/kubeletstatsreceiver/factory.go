@@ -23,6 +23,11 @@ const (
 	metricGroupsConfig = "metric_groups"
 )
 
+var defaultCRIConfig = CRIConfig{
+	Endpoint: "unix:///run/containerd/containerd.sock",
+	Timeout:  5 * time.Second,
+}
+
 var defaultMetricGroups = []kubelet.MetricGroup{
 	kubelet.ContainerMetricGroup,
 	kubelet.PodMetricGroup,
@@ -49,6 +54,8 @@ func createDefaultConfig() component.Config {
 			},
 		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		StatsSource:          StatsSourceSummary,
+		CRI:                  defaultCRIConfig,
 	}
 }
 
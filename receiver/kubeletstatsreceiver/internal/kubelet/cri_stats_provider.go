@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+// CRIStatsProvider builds a stats.Summary from the stats API of the container runtime,
+// for when the summary API of the kubelet isn't available. The runtime doesn't report
+// the stats of the node and of the volumes, so the summary only holds the pods and
+// their containers.
+type CRIStatsProvider struct {
+	client  runtimeapi.RuntimeServiceClient
+	timeout time.Duration
+}
+
+func NewCRIStatsProvider(client runtimeapi.RuntimeServiceClient, timeout time.Duration) *CRIStatsProvider {
+	return &CRIStatsProvider{client: client, timeout: timeout}
+}
+
+// StatsSummary calls the ListPodSandbox, ListPodSandboxStats, ListContainers and
+// ListContainerStats CRI methods and returns their results as a stats.Summary struct.
+func (p *CRIStatsProvider) StatsSummary(ctx context.Context) (*stats.Summary, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	sandboxes, err := p.client.ListPodSandbox(ctx, &runtimeapi.ListPodSandboxRequest{
+		Filter: &runtimeapi.PodSandboxFilter{State: &runtimeapi.PodSandboxStateValue{State: runtimeapi.PodSandboxState_SANDBOX_READY}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the pod sandboxes: %w", err)
+	}
+	sandboxStats, err := p.client.ListPodSandboxStats(ctx, &runtimeapi.ListPodSandboxStatsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the pod sandbox stats: %w", err)
+	}
+	containers, err := p.client.ListContainers(ctx, &runtimeapi.ListContainersRequest{
+		Filter: &runtimeapi.ContainerFilter{State: &runtimeapi.ContainerStateValue{State: runtimeapi.ContainerState_CONTAINER_RUNNING}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the containers: %w", err)
+	}
+	containerStats, err := p.client.ListContainerStats(ctx, &runtimeapi.ListContainerStatsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the container stats: %w", err)
+	}
+
+	pods := make(map[string]*stats.PodStats, len(sandboxes.Items))
+	summary := &stats.Summary{}
+	for _, sandbox := range sandboxes.Items {
+		if sandbox.Metadata == nil {
+			continue
+		}
+		pods[sandbox.Id] = &stats.PodStats{
+			PodRef: stats.PodReference{
+				Name:      sandbox.Metadata.Name,
+				Namespace: sandbox.Metadata.Namespace,
+				UID:       sandbox.Metadata.Uid,
+			},
+			StartTime: metav1.NewTime(time.Unix(0, sandbox.CreatedAt)),
+		}
+	}
+
+	for _, s := range sandboxStats.Stats {
+		if s.Attributes == nil || s.Linux == nil {
+			continue
+		}
+		pod, ok := pods[s.Attributes.Id]
+		if !ok {
+			continue
+		}
+		pod.CPU = criCPUStats(s.Linux.Cpu)
+		pod.Memory = criMemoryStats(s.Linux.Memory)
+		pod.Network = criNetworkStats(s.Linux.Network)
+	}
+
+	containersByID := make(map[string]*runtimeapi.Container, len(containers.Containers))
+	for _, c := range containers.Containers {
+		containersByID[c.Id] = c
+	}
+	for _, s := range containerStats.Stats {
+		if s.Attributes == nil || s.Attributes.Metadata == nil {
+			continue
+		}
+		c, ok := containersByID[s.Attributes.Id]
+		if !ok {
+			continue
+		}
+		pod, ok := pods[c.PodSandboxId]
+		if !ok {
+			continue
+		}
+		pod.Containers = append(pod.Containers, stats.ContainerStats{
+			Name:      s.Attributes.Metadata.Name,
+			StartTime: metav1.NewTime(time.Unix(0, c.CreatedAt)),
+			CPU:       criCPUStats(s.Cpu),
+			Memory:    criMemoryStats(s.Memory),
+			Rootfs:    criFsStats(s.WritableLayer),
+		})
+	}
+
+	for _, sandbox := range sandboxes.Items {
+		if pod, ok := pods[sandbox.Id]; ok {
+			summary.Pods = append(summary.Pods, *pod)
+		}
+	}
+	return summary, nil
+}
+
+func criCPUStats(s *runtimeapi.CpuUsage) *stats.CPUStats {
+	if s == nil {
+		return nil
+	}
+	return &stats.CPUStats{
+		Time:                 metav1.NewTime(time.Unix(0, s.Timestamp)),
+		UsageNanoCores:       criValue(s.UsageNanoCores),
+		UsageCoreNanoSeconds: criValue(s.UsageCoreNanoSeconds),
+	}
+}
+
+func criMemoryStats(s *runtimeapi.MemoryUsage) *stats.MemoryStats {
+	if s == nil {
+		return nil
+	}
+	return &stats.MemoryStats{
+		Time:            metav1.NewTime(time.Unix(0, s.Timestamp)),
+		AvailableBytes:  criValue(s.AvailableBytes),
+		UsageBytes:      criValue(s.UsageBytes),
+		WorkingSetBytes: criValue(s.WorkingSetBytes),
+		RSSBytes:        criValue(s.RssBytes),
+		PageFaults:      criValue(s.PageFaults),
+		MajorPageFaults: criValue(s.MajorPageFaults),
+	}
+}
+
+func criNetworkStats(s *runtimeapi.NetworkUsage) *stats.NetworkStats {
+	if s == nil || s.DefaultInterface == nil {
+		return nil
+	}
+	network := &stats.NetworkStats{
+		Time:           metav1.NewTime(time.Unix(0, s.Timestamp)),
+		InterfaceStats: criInterfaceStats(s.DefaultInterface),
+	}
+	for _, iface := range s.Interfaces {
+		network.Interfaces = append(network.Interfaces, criInterfaceStats(iface))
+	}
+	return network
+}
+
+func criInterfaceStats(s *runtimeapi.NetworkInterfaceUsage) stats.InterfaceStats {
+	return stats.InterfaceStats{
+		Name:     s.Name,
+		RxBytes:  criValue(s.RxBytes),
+		RxErrors: criValue(s.RxErrors),
+		TxBytes:  criValue(s.TxBytes),
+		TxErrors: criValue(s.TxErrors),
+	}
+}
+
+func criFsStats(s *runtimeapi.FilesystemUsage) *stats.FsStats {
+	if s == nil {
+		return nil
+	}
+	return &stats.FsStats{
+		Time:       metav1.NewTime(time.Unix(0, s.Timestamp)),
+		UsedBytes:  criValue(s.UsedBytes),
+		InodesUsed: criValue(s.InodesUsed),
+	}
+}
+
+func criValue(v *runtimeapi.UInt64Value) *uint64 {
+	if v == nil {
+		return nil
+	}
+	value := v.Value
+	return &value
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+type fakeRuntimeClient struct {
+	runtimeapi.RuntimeServiceClient
+	statsErr error
+}
+
+func (f *fakeRuntimeClient) ListPodSandbox(context.Context, *runtimeapi.ListPodSandboxRequest, ...grpc.CallOption) (*runtimeapi.ListPodSandboxResponse, error) {
+	return &runtimeapi.ListPodSandboxResponse{Items: []*runtimeapi.PodSandbox{
+		{
+			Id:        "sandbox-1",
+			Metadata:  &runtimeapi.PodSandboxMetadata{Name: "pod-1", Namespace: "default", Uid: "uid-1"},
+			CreatedAt: time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC).UnixNano(),
+		},
+		{
+			Id:       "sandbox-2",
+			Metadata: &runtimeapi.PodSandboxMetadata{Name: "pod-2", Namespace: "kube-system", Uid: "uid-2"},
+		},
+	}}, nil
+}
+
+func (f *fakeRuntimeClient) ListPodSandboxStats(context.Context, *runtimeapi.ListPodSandboxStatsRequest, ...grpc.CallOption) (*runtimeapi.ListPodSandboxStatsResponse, error) {
+	if f.statsErr != nil {
+		return nil, f.statsErr
+	}
+	return &runtimeapi.ListPodSandboxStatsResponse{Stats: []*runtimeapi.PodSandboxStats{
+		{
+			Attributes: &runtimeapi.PodSandboxAttributes{Id: "sandbox-1"},
+			Linux: &runtimeapi.LinuxPodSandboxStats{
+				Cpu:    &runtimeapi.CpuUsage{UsageCoreNanoSeconds: &runtimeapi.UInt64Value{Value: 2000}},
+				Memory: &runtimeapi.MemoryUsage{WorkingSetBytes: &runtimeapi.UInt64Value{Value: 1024}},
+				Network: &runtimeapi.NetworkUsage{DefaultInterface: &runtimeapi.NetworkInterfaceUsage{
+					Name:    "eth0",
+					RxBytes: &runtimeapi.UInt64Value{Value: 10},
+					TxBytes: &runtimeapi.UInt64Value{Value: 20},
+				}},
+			},
+		},
+		// the stats of the sandboxes which aren't ready are ignored
+		{
+			Attributes: &runtimeapi.PodSandboxAttributes{Id: "sandbox-3"},
+			Linux:      &runtimeapi.LinuxPodSandboxStats{},
+		},
+	}}, nil
+}
+
+func (f *fakeRuntimeClient) ListContainers(context.Context, *runtimeapi.ListContainersRequest, ...grpc.CallOption) (*runtimeapi.ListContainersResponse, error) {
+	return &runtimeapi.ListContainersResponse{Containers: []*runtimeapi.Container{
+		{Id: "container-1", PodSandboxId: "sandbox-1"},
+		{Id: "container-2", PodSandboxId: "sandbox-2"},
+	}}, nil
+}
+
+func (f *fakeRuntimeClient) ListContainerStats(context.Context, *runtimeapi.ListContainerStatsRequest, ...grpc.CallOption) (*runtimeapi.ListContainerStatsResponse, error) {
+	return &runtimeapi.ListContainerStatsResponse{Stats: []*runtimeapi.ContainerStats{
+		{
+			Attributes:    &runtimeapi.ContainerAttributes{Id: "container-1", Metadata: &runtimeapi.ContainerMetadata{Name: "app"}},
+			Cpu:           &runtimeapi.CpuUsage{UsageNanoCores: &runtimeapi.UInt64Value{Value: 500}},
+			WritableLayer: &runtimeapi.FilesystemUsage{UsedBytes: &runtimeapi.UInt64Value{Value: 4096}},
+		},
+		{
+			Attributes: &runtimeapi.ContainerAttributes{Id: "container-2", Metadata: &runtimeapi.ContainerMetadata{Name: "dns"}},
+		},
+		// the stats of the containers which aren't running are ignored
+		{
+			Attributes: &runtimeapi.ContainerAttributes{Id: "container-3", Metadata: &runtimeapi.ContainerMetadata{Name: "exited"}},
+		},
+	}}, nil
+}
+
+func TestCRIStatsSummary(t *testing.T) {
+	p := NewCRIStatsProvider(&fakeRuntimeClient{}, time.Second)
+	summary, err := p.StatsSummary(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, summary.Pods, 2)
+	pod := summary.Pods[0]
+	assert.Equal(t, "pod-1", pod.PodRef.Name)
+	assert.Equal(t, "default", pod.PodRef.Namespace)
+	assert.Equal(t, "uid-1", pod.PodRef.UID)
+	assert.True(t, pod.StartTime.Time.Equal(time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, uint64(2000), *pod.CPU.UsageCoreNanoSeconds)
+	assert.Nil(t, pod.CPU.UsageNanoCores)
+	assert.Equal(t, uint64(1024), *pod.Memory.WorkingSetBytes)
+	assert.Equal(t, "eth0", pod.Network.Name)
+	assert.Equal(t, uint64(10), *pod.Network.RxBytes)
+	assert.Equal(t, uint64(20), *pod.Network.TxBytes)
+
+	require.Len(t, pod.Containers, 1)
+	assert.Equal(t, "app", pod.Containers[0].Name)
+	assert.Equal(t, uint64(500), *pod.Containers[0].CPU.UsageNanoCores)
+	assert.Nil(t, pod.Containers[0].Memory)
+	assert.Equal(t, uint64(4096), *pod.Containers[0].Rootfs.UsedBytes)
+
+	// the pods without stats are still reported, with their containers
+	assert.Equal(t, "pod-2", summary.Pods[1].PodRef.Name)
+	assert.Nil(t, summary.Pods[1].CPU)
+	require.Len(t, summary.Pods[1].Containers, 1)
+	assert.Equal(t, "dns", summary.Pods[1].Containers[0].Name)
+}
+
+func TestCRIStatsSummaryError(t *testing.T) {
+	p := NewCRIStatsProvider(&fakeRuntimeClient{statsErr: errors.New("unimplemented")}, time.Second)
+	_, err := p.StatsSummary(context.Background())
+	assert.EqualError(t, err, "failed to list the pod sandbox stats: unimplemented")
+}
@@ -12,9 +12,12 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
@@ -25,10 +28,16 @@ type scraperOptions struct {
 	extraMetadataLabels   []kubelet.MetadataLabel
 	metricGroupsToCollect map[kubelet.MetricGroup]bool
 	k8sAPIClient          kubernetes.Interface
+	statsSource           StatsSource
+	criConn               *grpc.ClientConn
+	criTimeout            time.Duration
 }
 
 type kubletScraper struct {
 	statsProvider         *kubelet.StatsProvider
+	statsSource           StatsSource
+	criConn               *grpc.ClientConn
+	criStatsProvider      *kubelet.CRIStatsProvider
 	metadataProvider      *kubelet.MetadataProvider
 	logger                *zap.Logger
 	extraMetadataLabels   []kubelet.MetadataLabel
@@ -46,6 +55,8 @@ func newKubletScraper(
 ) (scraperhelper.Scraper, error) {
 	ks := &kubletScraper{
 		statsProvider:         kubelet.NewStatsProvider(restClient),
+		statsSource:           rOptions.statsSource,
+		criConn:               rOptions.criConn,
 		metadataProvider:      kubelet.NewMetadataProvider(restClient),
 		logger:                set.Logger,
 		extraMetadataLabels:   rOptions.extraMetadataLabels,
@@ -59,13 +70,22 @@ func newKubletScraper(
 			OtherMetricsBuilder:     metadata.NewMetricsBuilder(metricsConfig, set),
 		},
 	}
-	return scraperhelper.NewScraper(metadata.Type, ks.scrape)
+	if rOptions.criConn != nil {
+		ks.criStatsProvider = kubelet.NewCRIStatsProvider(runtimeapi.NewRuntimeServiceClient(rOptions.criConn), rOptions.criTimeout)
+	}
+	return scraperhelper.NewScraper(metadata.Type, ks.scrape, scraperhelper.WithShutdown(ks.shutdown))
+}
+
+func (r *kubletScraper) shutdown(context.Context) error {
+	if r.criConn != nil {
+		return r.criConn.Close()
+	}
+	return nil
 }
 
-func (r *kubletScraper) scrape(context.Context) (pmetric.Metrics, error) {
-	summary, err := r.statsProvider.StatsSummary()
+func (r *kubletScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	summary, err := r.statsSummary(ctx)
 	if err != nil {
-		r.logger.Error("call to /stats/summary endpoint failed", zap.Error(err))
 		return pmetric.Metrics{}, err
 	}
 
@@ -88,6 +108,28 @@ func (r *kubletScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	return md, nil
 }
 
+// statsSummary returns the stats of the configured source.
+func (r *kubletScraper) statsSummary(ctx context.Context) (*stats.Summary, error) {
+	if r.statsSource != StatsSourceCRI {
+		summary, err := r.statsProvider.StatsSummary()
+		if err == nil {
+			return summary, nil
+		}
+		if r.statsSource != StatsSourceAuto {
+			r.logger.Error("call to /stats/summary endpoint failed", zap.Error(err))
+			return nil, err
+		}
+		r.logger.Debug("call to /stats/summary endpoint failed, falling back to the CRI stats API", zap.Error(err))
+	}
+
+	summary, err := r.criStatsProvider.StatsSummary(ctx)
+	if err != nil {
+		r.logger.Error("call to the CRI stats API failed", zap.Error(err))
+		return nil, err
+	}
+	return summary, nil
+}
+
 func (r *kubletScraper) detailedPVCLabelsSetter() func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
 	return func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
 		if r.k8sAPIClient == nil {
@@ -6,17 +6,22 @@ package kubeletstatsreceiver
 import (
 	"context"
 	"errors"
+	"net"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
@@ -485,3 +490,115 @@ func (f *fakeRestClient) Pods() ([]byte, error) {
 	}
 	return os.ReadFile("testdata/pods.json")
 }
+
+type fakeRuntimeServer struct {
+	*runtimeapi.UnimplementedRuntimeServiceServer
+}
+
+func (fakeRuntimeServer) ListPodSandbox(context.Context, *runtimeapi.ListPodSandboxRequest) (*runtimeapi.ListPodSandboxResponse, error) {
+	return &runtimeapi.ListPodSandboxResponse{Items: []*runtimeapi.PodSandbox{{
+		Id:       "sandbox",
+		Metadata: &runtimeapi.PodSandboxMetadata{Name: "pod", Namespace: "default", Uid: "uid"},
+	}}}, nil
+}
+
+func (fakeRuntimeServer) ListPodSandboxStats(context.Context, *runtimeapi.ListPodSandboxStatsRequest) (*runtimeapi.ListPodSandboxStatsResponse, error) {
+	return &runtimeapi.ListPodSandboxStatsResponse{Stats: []*runtimeapi.PodSandboxStats{{
+		Attributes: &runtimeapi.PodSandboxAttributes{Id: "sandbox"},
+		Linux: &runtimeapi.LinuxPodSandboxStats{
+			Memory: &runtimeapi.MemoryUsage{WorkingSetBytes: &runtimeapi.UInt64Value{Value: 1024}},
+		},
+	}}}, nil
+}
+
+func (fakeRuntimeServer) ListContainers(context.Context, *runtimeapi.ListContainersRequest) (*runtimeapi.ListContainersResponse, error) {
+	return &runtimeapi.ListContainersResponse{Containers: []*runtimeapi.Container{{Id: "container", PodSandboxId: "sandbox"}}}, nil
+}
+
+func (fakeRuntimeServer) ListContainerStats(context.Context, *runtimeapi.ListContainerStatsRequest) (*runtimeapi.ListContainerStatsResponse, error) {
+	return &runtimeapi.ListContainerStatsResponse{Stats: []*runtimeapi.ContainerStats{{
+		Attributes: &runtimeapi.ContainerAttributes{Id: "container", Metadata: &runtimeapi.ContainerMetadata{Name: "app"}},
+		Cpu:        &runtimeapi.CpuUsage{UsageCoreNanoSeconds: &runtimeapi.UInt64Value{Value: 2e9}},
+	}}}, nil
+}
+
+func newFakeRuntimeServer(t *testing.T) string {
+	socket := filepath.Join(t.TempDir(), "cri.sock")
+	lis, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	runtimeapi.RegisterRuntimeServiceServer(srv, fakeRuntimeServer{})
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	return "unix://" + socket
+}
+
+func TestScraperCRIStats(t *testing.T) {
+	endpoint := newFakeRuntimeServer(t)
+
+	tests := []struct {
+		name             string
+		statsSource      StatsSource
+		statsSummaryFail bool
+		dataPoints       int
+	}{
+		{
+			name:        "cri",
+			statsSource: StatsSourceCRI,
+			dataPoints:  2,
+		},
+		{
+			name:             "auto_fallback",
+			statsSource:      StatsSourceAuto,
+			statsSummaryFail: true,
+			dataPoints:       2,
+		},
+		{
+			name:        "auto_summary",
+			statsSource: StatsSourceAuto,
+			dataPoints:  numContainers*containerMetrics + numPods*podMetrics + numNodes*nodeMetrics,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.StatsSource = tt.statsSource
+			cfg.CRI.Endpoint = endpoint
+			cfg.MetricGroupsToCollect = defaultMetricGroups
+			options, err := cfg.getReceiverOptions()
+			require.NoError(t, err)
+
+			r, err := newKubletScraper(
+				&fakeRestClient{statsSummaryFail: tt.statsSummaryFail},
+				receivertest.NewNopCreateSettings(),
+				options,
+				metadata.DefaultMetricsBuilderConfig(),
+			)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+			md, err := r.Scrape(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.dataPoints, md.DataPointCount())
+			if tt.statsSource == StatsSourceAuto && !tt.statsSummaryFail {
+				return
+			}
+
+			names := map[string]string{}
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				rm := md.ResourceMetrics().At(i)
+				name, _ := rm.Resource().Attributes().Get("k8s.pod.name")
+				assert.Equal(t, "pod", name.Str())
+				ms := rm.ScopeMetrics().At(0).Metrics()
+				for j := 0; j < ms.Len(); j++ {
+					container, _ := rm.Resource().Attributes().Get("k8s.container.name")
+					names[ms.At(j).Name()] = container.Str()
+				}
+			}
+			assert.Equal(t, map[string]string{
+				"k8s.pod.memory.working_set": "",
+				"container.cpu.time":         "app",
+			}, names)
+		})
+	}
+}
@@ -29,6 +29,9 @@ type Config struct {
 	// Protocols is the configuration for the supported protocols, currently gRPC and HTTP (Proto and JSON).
 	Protocols     `mapstructure:"protocols"`
 	KeepTimestamp bool `mapstructure:"use_incoming_timestamp"`
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies
+	// of the `http` protocol.
+	Decompression *component.ID `mapstructure:"decompression"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -20,6 +20,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/lokireceiver/internal"
 )
@@ -99,6 +100,9 @@ func (r *lokiReceiver) startProtocolsServers(host component.Host) error {
 		if err != nil {
 			return fmt.Errorf("failed create http server error: %w", err)
 		}
+		if err = httpdecompressionextension.WrapServer(host, r.conf.Decompression, r.serverHTTP); err != nil {
+			return err
+		}
 		err = r.startHTTPServer(host)
 		if err != nil {
 			return fmt.Errorf("failed to start http server error: %w", err)
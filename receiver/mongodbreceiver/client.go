@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
@@ -26,6 +27,8 @@ type client interface {
 	DBStats(ctx context.Context, DBName string) (bson.M, error)
 	TopStats(ctx context.Context) (bson.M, error)
 	IndexStats(ctx context.Context, DBName, collectionName string) ([]bson.M, error)
+	CollStats(ctx context.Context, DBName, collectionName string) (bson.M, error)
+	CurrentOp(ctx context.Context, threshold time.Duration, limit int) ([]bson.M, error)
 }
 
 // mongodbClient is a mongodb metric scraper client
@@ -104,6 +107,58 @@ func (c *mongodbClient) IndexStats(ctx context.Context, database, collectionName
 	return indexStats, nil
 }
 
+// CollStats returns the storage stats of a collection using the $collStats aggregation stage,
+// which unlike the collStats command is also available on Atlas shared tiers
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/operator/aggregation/collStats/
+func (c *mongodbClient) CollStats(ctx context.Context, database, collectionName string) (bson.M, error) {
+	collection := c.Client.Database(database).Collection(collectionName)
+	pipeline := mongo.Pipeline{
+		bson.D{primitive.E{Key: "$collStats", Value: bson.M{"storageStats": bson.M{}}}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var collStats []bson.M
+	if err = cursor.All(ctx, &collStats); err != nil {
+		return nil, err
+	}
+	if len(collStats) == 0 {
+		return nil, fmt.Errorf("no stats returned for collection %s.%s", database, collectionName)
+	}
+	return collStats[0], nil
+}
+
+// CurrentOp returns the active operations running for at least the given threshold, the longest running first
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/operator/aggregation/currentOp/
+func (c *mongodbClient) CurrentOp(ctx context.Context, threshold time.Duration, limit int) ([]bson.M, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{primitive.E{Key: "$currentOp", Value: bson.M{"allUsers": true}}},
+		bson.D{primitive.E{Key: "$match", Value: bson.M{
+			"active":            true,
+			"microsecs_running": bson.M{"$gte": threshold.Microseconds()},
+		}}},
+		bson.D{primitive.E{Key: "$sort", Value: bson.M{"microsecs_running": -1}}},
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.D{primitive.E{Key: "$limit", Value: limit}})
+	}
+
+	cursor, err := c.Client.Database("admin").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var operations []bson.M
+	if err = cursor.All(ctx, &operations); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
 // GetVersion returns a result of the version of mongo the client is connected to so adjustments in collection protocol can
 // be determined
 func (c *mongodbClient) GetVersion(ctx context.Context) (*version.Version, error) {
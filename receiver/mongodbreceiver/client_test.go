@@ -8,6 +8,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/mock"
@@ -68,6 +69,16 @@ func (fc *fakeClient) IndexStats(ctx context.Context, dbName, collectionName str
 	return args.Get(0).([]bson.M), args.Error(1)
 }
 
+func (fc *fakeClient) CollStats(ctx context.Context, dbName, collectionName string) (bson.M, error) {
+	args := fc.Called(ctx, dbName, collectionName)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) CurrentOp(ctx context.Context, threshold time.Duration, limit int) ([]bson.M, error) {
+	args := fc.Called(ctx, threshold, limit)
+	return args.Get(0).([]bson.M), args.Error(1)
+}
+
 func TestListDatabaseNames(t *testing.T) {
 	mont := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mont.Close()
@@ -284,6 +295,10 @@ func loadIndexStatsAsMap(collectionName string) ([]bson.M, error) {
 	return indexStats, nil
 }
 
+func loadCollStatsAsMap() (bson.M, error) {
+	return loadTestFileAsMap("./testdata/ordersCollStats.json")
+}
+
 func loadBuildInfo() (bson.D, error) {
 	return loadTestFile("./testdata/buildInfo.json")
 }
@@ -24,11 +24,31 @@ type Config struct {
 	configtls.TLSClientSetting              `mapstructure:"tls,omitempty"`
 	// MetricsBuilderConfig defines which metrics/attributes to enable for the scraper
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
-	Hosts                         []confignet.NetAddr `mapstructure:"hosts"`
-	Username                      string              `mapstructure:"username"`
-	Password                      configopaque.String `mapstructure:"password"`
-	ReplicaSet                    string              `mapstructure:"replica_set,omitempty"`
-	Timeout                       time.Duration       `mapstructure:"timeout"`
+	Hosts                         []confignet.NetAddr   `mapstructure:"hosts"`
+	Username                      string                `mapstructure:"username"`
+	Password                      configopaque.String   `mapstructure:"password"`
+	ReplicaSet                    string                `mapstructure:"replica_set,omitempty"`
+	Timeout                       time.Duration         `mapstructure:"timeout"`
+	CollectionStats               CollectionStatsConfig `mapstructure:"collection_stats"`
+	SlowOperations                SlowOperationsConfig  `mapstructure:"slow_operations"`
+}
+
+// CollectionStatsConfig limits the databases whose collections are queried with $collStats.
+type CollectionStatsConfig struct {
+	// Databases is the list of databases to collect collection level stats for.
+	// All databases are included if empty.
+	Databases []string `mapstructure:"databases"`
+}
+
+// SlowOperationsConfig configures the sampling of in progress operations with $currentOp.
+type SlowOperationsConfig struct {
+	// Threshold is the minimum running time of an operation to be reported as slow.
+	Threshold time.Duration `mapstructure:"threshold"`
+	// Limit is the maximum number of slow operations sampled per scrape.
+	Limit int `mapstructure:"limit"`
+	// Databases is the list of databases to report slow operations for.
+	// All databases are included if empty.
+	Databases []string `mapstructure:"databases"`
 }
 
 func (c *Config) Validate() error {
@@ -49,6 +69,13 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password provided without user"))
 	}
 
+	if c.SlowOperations.Threshold < 0 {
+		err = multierr.Append(err, errors.New("slow_operations threshold must not be negative"))
+	}
+	if c.SlowOperations.Limit < 0 {
+		err = multierr.Append(err, errors.New("slow_operations limit must not be negative"))
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(); tlsErr != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
@@ -84,6 +111,20 @@ func (c *Config) ClientOptions() *options.ClientOptions {
 	return clientOptions
 }
 
+// includesDatabase reports whether the database matches the include list,
+// an empty list matching every database.
+func includesDatabase(databases []string, name string) bool {
+	if len(databases) == 0 {
+		return true
+	}
+	for _, db := range databases {
+		if db == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Config) hostlist() []string {
 	var hosts []string
 	for _, ep := range c.Hosts {
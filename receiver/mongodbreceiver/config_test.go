@@ -98,6 +98,43 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateSlowOperations(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cfg      SlowOperationsConfig
+		expected error
+	}{
+		{
+			desc: "default",
+			cfg:  SlowOperationsConfig{Threshold: defaultSlowOperationsThreshold, Limit: defaultSlowOperationsLimit},
+		},
+		{
+			desc:     "negative threshold",
+			cfg:      SlowOperationsConfig{Threshold: -time.Second, Limit: defaultSlowOperationsLimit},
+			expected: errors.New("slow_operations threshold must not be negative"),
+		},
+		{
+			desc:     "negative limit",
+			cfg:      SlowOperationsConfig{Threshold: defaultSlowOperationsThreshold, Limit: -1},
+			expected: errors.New("slow_operations limit must not be negative"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &Config{
+				Hosts:          []confignet.NetAddr{{Endpoint: "localhost:27017"}},
+				SlowOperations: tc.cfg,
+			}
+			err := component.ValidateConfig(cfg)
+			if tc.expected == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expected.Error())
+			}
+		})
+	}
+}
+
 func TestBadTLSConfigs(t *testing.T) {
 	testCases := []struct {
 		desc        string
@@ -212,6 +249,12 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otel"
 	expected.Password = "${env:MONGO_PASSWORD}"
 	expected.CollectionInterval = time.Minute
+	expected.CollectionStats.Databases = []string{"orders"}
+	expected.SlowOperations = SlowOperationsConfig{
+		Threshold: 250 * time.Millisecond,
+		Limit:     20,
+		Databases: []string{"orders", "inventory"},
+	}
 
 	require.Equal(t, expected, cfg)
 }
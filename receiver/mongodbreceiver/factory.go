@@ -17,6 +17,11 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver/internal/metadata"
 )
 
+const (
+	defaultSlowOperationsThreshold = 100 * time.Millisecond
+	defaultSlowOperationsLimit     = 100
+)
+
 // NewFactory creates a factory for mongodb receiver.
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
@@ -36,6 +41,10 @@ func createDefaultConfig() component.Config {
 		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 		TLSClientSetting:     configtls.TLSClientSetting{},
+		SlowOperations: SlowOperationsConfig{
+			Threshold: defaultSlowOperationsThreshold,
+			Limit:     defaultSlowOperationsLimit,
+		},
 	}
 }
 
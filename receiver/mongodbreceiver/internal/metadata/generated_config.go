@@ -25,36 +25,43 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for mongodb metrics.
 type MetricsConfig struct {
-	MongodbCacheOperations        MetricConfig `mapstructure:"mongodb.cache.operations"`
-	MongodbCollectionCount        MetricConfig `mapstructure:"mongodb.collection.count"`
-	MongodbConnectionCount        MetricConfig `mapstructure:"mongodb.connection.count"`
-	MongodbCursorCount            MetricConfig `mapstructure:"mongodb.cursor.count"`
-	MongodbCursorTimeoutCount     MetricConfig `mapstructure:"mongodb.cursor.timeout.count"`
-	MongodbDataSize               MetricConfig `mapstructure:"mongodb.data.size"`
-	MongodbDatabaseCount          MetricConfig `mapstructure:"mongodb.database.count"`
-	MongodbDocumentOperationCount MetricConfig `mapstructure:"mongodb.document.operation.count"`
-	MongodbExtentCount            MetricConfig `mapstructure:"mongodb.extent.count"`
-	MongodbGlobalLockTime         MetricConfig `mapstructure:"mongodb.global_lock.time"`
-	MongodbHealth                 MetricConfig `mapstructure:"mongodb.health"`
-	MongodbIndexAccessCount       MetricConfig `mapstructure:"mongodb.index.access.count"`
-	MongodbIndexCount             MetricConfig `mapstructure:"mongodb.index.count"`
-	MongodbIndexSize              MetricConfig `mapstructure:"mongodb.index.size"`
-	MongodbLockAcquireCount       MetricConfig `mapstructure:"mongodb.lock.acquire.count"`
-	MongodbLockAcquireTime        MetricConfig `mapstructure:"mongodb.lock.acquire.time"`
-	MongodbLockAcquireWaitCount   MetricConfig `mapstructure:"mongodb.lock.acquire.wait_count"`
-	MongodbLockDeadlockCount      MetricConfig `mapstructure:"mongodb.lock.deadlock.count"`
-	MongodbMemoryUsage            MetricConfig `mapstructure:"mongodb.memory.usage"`
-	MongodbNetworkIoReceive       MetricConfig `mapstructure:"mongodb.network.io.receive"`
-	MongodbNetworkIoTransmit      MetricConfig `mapstructure:"mongodb.network.io.transmit"`
-	MongodbNetworkRequestCount    MetricConfig `mapstructure:"mongodb.network.request.count"`
-	MongodbObjectCount            MetricConfig `mapstructure:"mongodb.object.count"`
-	MongodbOperationCount         MetricConfig `mapstructure:"mongodb.operation.count"`
-	MongodbOperationLatencyTime   MetricConfig `mapstructure:"mongodb.operation.latency.time"`
-	MongodbOperationReplCount     MetricConfig `mapstructure:"mongodb.operation.repl.count"`
-	MongodbOperationTime          MetricConfig `mapstructure:"mongodb.operation.time"`
-	MongodbSessionCount           MetricConfig `mapstructure:"mongodb.session.count"`
-	MongodbStorageSize            MetricConfig `mapstructure:"mongodb.storage.size"`
-	MongodbUptime                 MetricConfig `mapstructure:"mongodb.uptime"`
+	MongodbCacheOperations            MetricConfig `mapstructure:"mongodb.cache.operations"`
+	MongodbCollectionCount            MetricConfig `mapstructure:"mongodb.collection.count"`
+	MongodbCollectionDocumentCount    MetricConfig `mapstructure:"mongodb.collection.document.count"`
+	MongodbCollectionIndexAccessCount MetricConfig `mapstructure:"mongodb.collection.index.access.count"`
+	MongodbCollectionIndexSize        MetricConfig `mapstructure:"mongodb.collection.index.size"`
+	MongodbCollectionSize             MetricConfig `mapstructure:"mongodb.collection.size"`
+	MongodbCollectionStorageSize      MetricConfig `mapstructure:"mongodb.collection.storage.size"`
+	MongodbConnectionCount            MetricConfig `mapstructure:"mongodb.connection.count"`
+	MongodbCursorCount                MetricConfig `mapstructure:"mongodb.cursor.count"`
+	MongodbCursorTimeoutCount         MetricConfig `mapstructure:"mongodb.cursor.timeout.count"`
+	MongodbDataSize                   MetricConfig `mapstructure:"mongodb.data.size"`
+	MongodbDatabaseCount              MetricConfig `mapstructure:"mongodb.database.count"`
+	MongodbDocumentOperationCount     MetricConfig `mapstructure:"mongodb.document.operation.count"`
+	MongodbExtentCount                MetricConfig `mapstructure:"mongodb.extent.count"`
+	MongodbGlobalLockTime             MetricConfig `mapstructure:"mongodb.global_lock.time"`
+	MongodbHealth                     MetricConfig `mapstructure:"mongodb.health"`
+	MongodbIndexAccessCount           MetricConfig `mapstructure:"mongodb.index.access.count"`
+	MongodbIndexCount                 MetricConfig `mapstructure:"mongodb.index.count"`
+	MongodbIndexSize                  MetricConfig `mapstructure:"mongodb.index.size"`
+	MongodbLockAcquireCount           MetricConfig `mapstructure:"mongodb.lock.acquire.count"`
+	MongodbLockAcquireTime            MetricConfig `mapstructure:"mongodb.lock.acquire.time"`
+	MongodbLockAcquireWaitCount       MetricConfig `mapstructure:"mongodb.lock.acquire.wait_count"`
+	MongodbLockDeadlockCount          MetricConfig `mapstructure:"mongodb.lock.deadlock.count"`
+	MongodbMemoryUsage                MetricConfig `mapstructure:"mongodb.memory.usage"`
+	MongodbNetworkIoReceive           MetricConfig `mapstructure:"mongodb.network.io.receive"`
+	MongodbNetworkIoTransmit          MetricConfig `mapstructure:"mongodb.network.io.transmit"`
+	MongodbNetworkRequestCount        MetricConfig `mapstructure:"mongodb.network.request.count"`
+	MongodbObjectCount                MetricConfig `mapstructure:"mongodb.object.count"`
+	MongodbOperationCount             MetricConfig `mapstructure:"mongodb.operation.count"`
+	MongodbOperationLatencyTime       MetricConfig `mapstructure:"mongodb.operation.latency.time"`
+	MongodbOperationReplCount         MetricConfig `mapstructure:"mongodb.operation.repl.count"`
+	MongodbOperationSlowCount         MetricConfig `mapstructure:"mongodb.operation.slow.count"`
+	MongodbOperationSlowMaxTime       MetricConfig `mapstructure:"mongodb.operation.slow.max_time"`
+	MongodbOperationTime              MetricConfig `mapstructure:"mongodb.operation.time"`
+	MongodbSessionCount               MetricConfig `mapstructure:"mongodb.session.count"`
+	MongodbStorageSize                MetricConfig `mapstructure:"mongodb.storage.size"`
+	MongodbUptime                     MetricConfig `mapstructure:"mongodb.uptime"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -65,6 +72,21 @@ func DefaultMetricsConfig() MetricsConfig {
 		MongodbCollectionCount: MetricConfig{
 			Enabled: true,
 		},
+		MongodbCollectionDocumentCount: MetricConfig{
+			Enabled: false,
+		},
+		MongodbCollectionIndexAccessCount: MetricConfig{
+			Enabled: false,
+		},
+		MongodbCollectionIndexSize: MetricConfig{
+			Enabled: false,
+		},
+		MongodbCollectionSize: MetricConfig{
+			Enabled: false,
+		},
+		MongodbCollectionStorageSize: MetricConfig{
+			Enabled: false,
+		},
 		MongodbConnectionCount: MetricConfig{
 			Enabled: true,
 		},
@@ -137,6 +159,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		MongodbOperationReplCount: MetricConfig{
 			Enabled: false,
 		},
+		MongodbOperationSlowCount: MetricConfig{
+			Enabled: false,
+		},
+		MongodbOperationSlowMaxTime: MetricConfig{
+			Enabled: false,
+		},
 		MongodbOperationTime: MetricConfig{
 			Enabled: true,
 		},
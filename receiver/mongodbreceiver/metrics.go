@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
@@ -493,6 +494,119 @@ func (s *mongodbScraper) recordIndexAccess(now pcommon.Timestamp, documents []bs
 	s.mb.RecordMongodbIndexAccessCountDataPoint(now, indexAccessTotal, dbName, collectionName)
 }
 
+func (s *mongodbScraper) recordCollectionIndexAccess(now pcommon.Timestamp, documents []bson.M, dbName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.collection.index.access.count"
+	for _, doc := range documents {
+		indexName, ok := doc["name"].(string)
+		if !ok {
+			err := errors.New("could not find index name")
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, fmt.Sprintf("%s, %s", dbName, collectionName), err))
+			continue
+		}
+		metricAttributes := fmt.Sprintf("%s, %s, %s", dbName, collectionName, indexName)
+		val, err := collectMetric(doc, []string{"accesses", "ops"})
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, metricAttributes, err))
+			continue
+		}
+		s.mb.RecordMongodbCollectionIndexAccessCountDataPoint(now, val, dbName, collectionName, indexName)
+	}
+}
+
+// CollStats
+func (s *mongodbScraper) recordCollectionSize(now pcommon.Timestamp, doc bson.M, dbName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	metricPath := []string{"storageStats", "size"}
+	metricName := "mongodb.collection.size"
+	val, err := collectMetric(doc, metricPath)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, fmt.Sprintf("%s, %s", dbName, collectionName), err))
+		return
+	}
+	s.mb.RecordMongodbCollectionSizeDataPoint(now, val, dbName, collectionName)
+}
+
+func (s *mongodbScraper) recordCollectionStorageSize(now pcommon.Timestamp, doc bson.M, dbName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	metricPath := []string{"storageStats", "storageSize"}
+	metricName := "mongodb.collection.storage.size"
+	val, err := collectMetric(doc, metricPath)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, fmt.Sprintf("%s, %s", dbName, collectionName), err))
+		return
+	}
+	s.mb.RecordMongodbCollectionStorageSizeDataPoint(now, val, dbName, collectionName)
+}
+
+func (s *mongodbScraper) recordCollectionDocumentCount(now pcommon.Timestamp, doc bson.M, dbName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	metricPath := []string{"storageStats", "count"}
+	metricName := "mongodb.collection.document.count"
+	val, err := collectMetric(doc, metricPath)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, fmt.Sprintf("%s, %s", dbName, collectionName), err))
+		return
+	}
+	s.mb.RecordMongodbCollectionDocumentCountDataPoint(now, val, dbName, collectionName)
+}
+
+func (s *mongodbScraper) recordCollectionIndexSizes(now pcommon.Timestamp, doc bson.M, dbName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.collection.index.size"
+	indexSizes, err := dig(doc, []string{"storageStats", "indexSizes"})
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, fmt.Sprintf("%s, %s", dbName, collectionName), err))
+		return
+	}
+	indexSizesDoc, ok := indexSizes.(bson.M)
+	if !ok {
+		err = errors.New("could not parse index sizes")
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, fmt.Sprintf("%s, %s", dbName, collectionName), err))
+		return
+	}
+	for indexName, size := range indexSizesDoc {
+		val, err := parseInt(size)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, fmt.Sprintf("%s, %s, %s", dbName, collectionName, indexName), err))
+			continue
+		}
+		s.mb.RecordMongodbCollectionIndexSizeDataPoint(now, val, dbName, collectionName, indexName)
+	}
+}
+
+// CurrentOp
+type slowOperationKey struct {
+	database  string
+	operation string
+}
+
+func (s *mongodbScraper) recordSlowOperations(now pcommon.Timestamp, operations []bson.M, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.operation.slow.max_time"
+	counts := map[slowOperationKey]int64{}
+	maxTimes := map[slowOperationKey]int64{}
+	for _, operation := range operations {
+		// the namespace of an operation is "<database>.<collection>"
+		namespace, _ := operation["ns"].(string)
+		dbName, _, _ := strings.Cut(namespace, ".")
+		if dbName == "" || !includesDatabase(s.config.SlowOperations.Databases, dbName) {
+			continue
+		}
+		opType, _ := operation["op"].(string)
+		key := slowOperationKey{database: dbName, operation: opType}
+
+		running, err := collectMetric(operation, []string{"microsecs_running"})
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, fmt.Sprintf("%s, %s", dbName, opType), err))
+			continue
+		}
+		counts[key]++
+		if running > maxTimes[key] {
+			maxTimes[key] = running
+		}
+	}
+
+	for key, count := range counts {
+		s.mb.RecordMongodbOperationSlowCountDataPoint(now, count, key.database, key.operation)
+		s.mb.RecordMongodbOperationSlowMaxTimeDataPoint(now, maxTimes[key], key.database, key.operation)
+	}
+}
+
 // Top Stats
 func (s *mongodbScraper) recordOperationTime(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
 	metricName := "mongodb.operation.time"
@@ -86,6 +86,7 @@ func (s *mongodbScraper) collectMetrics(ctx context.Context, errs *scrapererror.
 	s.mb.RecordMongodbDatabaseCountDataPoint(now, int64(len(dbNames)))
 	s.collectAdminDatabase(ctx, now, errs)
 	s.collectTopStats(ctx, now, errs)
+	s.collectSlowOperations(ctx, now, errs)
 
 	for _, dbName := range dbNames {
 		s.collectDatabase(ctx, now, dbName, errs)
@@ -97,6 +98,7 @@ func (s *mongodbScraper) collectMetrics(ctx context.Context, errs *scrapererror.
 
 		for _, collectionName := range collectionNames {
 			s.collectIndexStats(ctx, now, dbName, collectionName, errs)
+			s.collectCollectionStats(ctx, now, dbName, collectionName, errs)
 		}
 	}
 }
@@ -152,6 +154,43 @@ func (s *mongodbScraper) collectIndexStats(ctx context.Context, now pcommon.Time
 	s.mb.EmitForResource()
 }
 
+func (s *mongodbScraper) collectCollectionStats(ctx context.Context, now pcommon.Timestamp, databaseName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	if !s.collectionStatsEnabled() || !includesDatabase(s.config.CollectionStats.Databases, databaseName) {
+		return
+	}
+	collStats, err := s.client.CollStats(ctx, databaseName, collectionName)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch collection stats metrics: %w", err))
+		return
+	}
+	s.recordCollectionStats(now, collStats, databaseName, collectionName, errs)
+	s.mb.EmitForResource()
+}
+
+func (s *mongodbScraper) collectSlowOperations(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	metrics := s.config.MetricsBuilderConfig.Metrics
+	if !metrics.MongodbOperationSlowCount.Enabled && !metrics.MongodbOperationSlowMaxTime.Enabled {
+		return
+	}
+	operations, err := s.client.CurrentOp(ctx, s.config.SlowOperations.Threshold, s.config.SlowOperations.Limit)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch current operations: %w", err))
+		return
+	}
+	s.recordSlowOperations(now, operations, errs)
+	s.mb.EmitForResource()
+}
+
+// collectionStatsEnabled reports whether any metric requiring $collStats is enabled,
+// so that collections are not queried needlessly.
+func (s *mongodbScraper) collectionStatsEnabled() bool {
+	metrics := s.config.MetricsBuilderConfig.Metrics
+	return metrics.MongodbCollectionSize.Enabled ||
+		metrics.MongodbCollectionStorageSize.Enabled ||
+		metrics.MongodbCollectionDocumentCount.Enabled ||
+		metrics.MongodbCollectionIndexSize.Enabled
+}
+
 func (s *mongodbScraper) recordDBStats(now pcommon.Timestamp, doc bson.M, dbName string, errs *scrapererror.ScrapeErrors) {
 	s.recordCollections(now, doc, dbName, errs)
 	s.recordDataSize(now, doc, dbName, errs)
@@ -188,4 +227,15 @@ func (s *mongodbScraper) recordAdminStats(now pcommon.Timestamp, document bson.M
 
 func (s *mongodbScraper) recordIndexStats(now pcommon.Timestamp, indexStats []bson.M, databaseName string, collectionName string, errs *scrapererror.ScrapeErrors) {
 	s.recordIndexAccess(now, indexStats, databaseName, collectionName, errs)
+	if s.config.MetricsBuilderConfig.Metrics.MongodbCollectionIndexAccessCount.Enabled &&
+		includesDatabase(s.config.CollectionStats.Databases, databaseName) {
+		s.recordCollectionIndexAccess(now, indexStats, databaseName, collectionName, errs)
+	}
+}
+
+func (s *mongodbScraper) recordCollectionStats(now pcommon.Timestamp, doc bson.M, databaseName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	s.recordCollectionSize(now, doc, databaseName, collectionName, errs)
+	s.recordCollectionStorageSize(now, doc, databaseName, collectionName, errs)
+	s.recordCollectionDocumentCount(now, doc, databaseName, collectionName, errs)
+	s.recordCollectionIndexSizes(now, doc, databaseName, collectionName, errs)
 }
@@ -18,6 +18,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
@@ -374,3 +375,114 @@ func TestTopMetricsAggregation(t *testing.T) {
 		require.EqualValues(t, expectedCommandValues, actualOperationTimeValues["commands"])
 	})
 }
+
+func TestScraperCollectionStatsAndSlowOperations(t *testing.T) {
+	fc := &fakeClient{}
+	adminStatus, err := loadAdminStatusAsMap()
+	require.NoError(t, err)
+	ss, err := loadServerStatusAsMap()
+	require.NoError(t, err)
+	dbStats, err := loadDBStatsAsMap()
+	require.NoError(t, err)
+	topStats, err := loadTopAsMap()
+	require.NoError(t, err)
+	ordersIndexStats, err := loadIndexStatsAsMap("orders")
+	require.NoError(t, err)
+	productsIndexStats, err := loadIndexStatsAsMap("products")
+	require.NoError(t, err)
+	ordersCollStats, err := loadCollStatsAsMap()
+	require.NoError(t, err)
+	currentOps := []bson.M{
+		{"ns": "fakedatabase.orders", "op": "query", "microsecs_running": int64(250000)},
+		{"ns": "fakedatabase.orders", "op": "query", "microsecs_running": int64(150000)},
+		{"ns": "fakedatabase.products", "op": "update", "microsecs_running": int64(120000)},
+		{"ns": "excludeddatabase.logs", "op": "query", "microsecs_running": int64(900000)},
+		{"ns": "", "op": "none", "microsecs_running": int64(500000)},
+	}
+	mongo40, err := version.NewVersion("4.0")
+	require.NoError(t, err)
+
+	fc.On("GetVersion", mock.Anything).Return(mongo40, nil)
+	fc.On("ListDatabaseNames", mock.Anything, mock.Anything, mock.Anything).Return([]string{"fakedatabase", "excludeddatabase"}, nil)
+	fc.On("ServerStatus", mock.Anything, "admin").Return(adminStatus, nil)
+	fc.On("ServerStatus", mock.Anything, mock.Anything).Return(ss, nil)
+	fc.On("DBStats", mock.Anything, mock.Anything).Return(dbStats, nil)
+	fc.On("TopStats", mock.Anything).Return(topStats, nil)
+	fc.On("CurrentOp", mock.Anything, 100*time.Millisecond, 100).Return(currentOps, nil)
+	fc.On("ListCollectionNames", mock.Anything, "fakedatabase").Return([]string{"orders"}, nil)
+	fc.On("ListCollectionNames", mock.Anything, "excludeddatabase").Return([]string{"logs"}, nil)
+	fc.On("IndexStats", mock.Anything, "fakedatabase", "orders").Return(ordersIndexStats, nil)
+	fc.On("IndexStats", mock.Anything, "excludeddatabase", "logs").Return(productsIndexStats, nil)
+	fc.On("CollStats", mock.Anything, "fakedatabase", "orders").Return(ordersCollStats, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.CollectionStats.Databases = []string{"fakedatabase"}
+	cfg.SlowOperations.Databases = []string{"fakedatabase"}
+	cfg.MetricsBuilderConfig.Metrics.MongodbCollectionSize.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.MongodbCollectionStorageSize.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.MongodbCollectionDocumentCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.MongodbCollectionIndexSize.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.MongodbCollectionIndexAccessCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.MongodbOperationSlowCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.MongodbOperationSlowMaxTime.Enabled = true
+
+	scraper := newMongodbScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.client = fc
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	fc.AssertNotCalled(t, "CollStats", mock.Anything, "excludeddatabase", mock.Anything)
+
+	expected := map[string]int64{
+		"mongodb.collection.size{fakedatabase,orders}":                                 14800,
+		"mongodb.collection.storage.size{fakedatabase,orders}":                         20480,
+		"mongodb.collection.document.count{fakedatabase,orders}":                       150,
+		"mongodb.collection.index.size{fakedatabase,orders,_id_}":                      20480,
+		"mongodb.collection.index.size{fakedatabase,orders,item_1_quantity_1}":         20480,
+		"mongodb.collection.index.size{fakedatabase,orders,type_1_item_1}":             20480,
+		"mongodb.collection.index.access.count{fakedatabase,orders,_id_}":              0,
+		"mongodb.collection.index.access.count{fakedatabase,orders,item_1_quantity_1}": 1,
+		"mongodb.collection.index.access.count{fakedatabase,orders,type_1_item_1}":     1,
+		"mongodb.operation.slow.count{fakedatabase,query}":                             2,
+		"mongodb.operation.slow.count{fakedatabase,update}":                            1,
+		"mongodb.operation.slow.max_time{fakedatabase,query}":                          250000,
+		"mongodb.operation.slow.max_time{fakedatabase,update}":                         120000,
+	}
+	require.Equal(t, expected, collectDataPoints(actualMetrics, func(name string) bool {
+		return strings.HasPrefix(name, "mongodb.collection.") && name != "mongodb.collection.count" ||
+			strings.HasPrefix(name, "mongodb.operation.slow.")
+	}))
+}
+
+// collectDataPoints flattens the int data points of the selected metrics into
+// "<metric>{<attribute values in order>}" keys.
+func collectDataPoints(metrics pmetric.Metrics, include func(name string) bool) map[string]int64 {
+	values := map[string]int64{}
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				if !include(m.Name()) {
+					continue
+				}
+				var dps pmetric.NumberDataPointSlice
+				if m.Type() == pmetric.MetricTypeGauge {
+					dps = m.Gauge().DataPoints()
+				} else {
+					dps = m.Sum().DataPoints()
+				}
+				for l := 0; l < dps.Len(); l++ {
+					var attrs []string
+					dps.At(l).Attributes().Range(func(_ string, v pcommon.Value) bool {
+						attrs = append(attrs, v.AsString())
+						return true
+					})
+					values[m.Name()+"{"+strings.Join(attrs, ",")+"}"] = dps.At(l).IntValue()
+				}
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.uber.org/zap"
+)
+
+// messageHandler handles a message received on topic.
+type messageHandler func(topic string, payload []byte)
+
+// client subscribes to the topics of the receiver, and resubscribes on reconnection.
+type client interface {
+	start() error
+	stop(ctx context.Context) error
+}
+
+func newClient(cfg *Config, clientID string, tlsCfg *tls.Config, logger *zap.Logger, handler messageHandler) (client, error) {
+	filters := make(map[string]byte, len(cfg.Topics))
+	for _, topic := range cfg.Topics {
+		filters[topic.filter()] = topic.QoS
+	}
+
+	if cfg.ProtocolVersion == ProtocolVersion5 {
+		return newClientV5(cfg, clientID, tlsCfg, logger, filters, handler)
+	}
+	return newClientV3(cfg, clientID, tlsCfg, logger, filters, handler), nil
+}
+
+// clientV3 is an MQTT 3.1.1 client.
+type clientV3 struct {
+	client mqtt.Client
+}
+
+func newClientV3(cfg *Config, clientID string, tlsCfg *tls.Config, logger *zap.Logger, filters map[string]byte, handler messageHandler) *clientV3 {
+	opts := mqtt.NewClientOptions().
+		AddBroker(cfg.Endpoint).
+		SetClientID(clientID).
+		SetUsername(cfg.Username).
+		SetPassword(string(cfg.Password)).
+		SetTLSConfig(tlsCfg).
+		SetKeepAlive(cfg.KeepAlive).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetOnConnectHandler(func(c mqtt.Client) {
+			logger.Info("Connected to MQTT broker, subscribing to topics")
+			token := c.SubscribeMultiple(filters, func(_ mqtt.Client, msg mqtt.Message) {
+				handler(msg.Topic(), msg.Payload())
+			})
+			go func() {
+				if token.Wait(); token.Error() != nil {
+					logger.Error("Failed to subscribe to topics", zap.Error(token.Error()))
+				}
+			}()
+		}).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			logger.Warn("Connection to MQTT broker lost, reconnecting", zap.Error(err))
+		})
+	return &clientV3{client: mqtt.NewClient(opts)}
+}
+
+func (c *clientV3) start() error {
+	// Connection attempts are retried in the background until the client is stopped.
+	c.client.Connect()
+	return nil
+}
+
+func (c *clientV3) stop(context.Context) error {
+	c.client.Disconnect(250)
+	return nil
+}
+
+// clientV5 is an MQTT 5 client.
+type clientV5 struct {
+	cfg    autopaho.ClientConfig
+	cancel context.CancelFunc
+	conn   *autopaho.ConnectionManager
+}
+
+func newClientV5(cfg *Config, clientID string, tlsCfg *tls.Config, logger *zap.Logger, filters map[string]byte, handler messageHandler) (*clientV5, error) {
+	broker, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+
+	subscriptions := make(map[string]paho.SubscribeOptions, len(filters))
+	for filter, qos := range filters {
+		subscriptions[filter] = paho.SubscribeOptions{QoS: qos}
+	}
+
+	clientCfg := autopaho.ClientConfig{
+		BrokerUrls:     []*url.URL{broker},
+		TlsCfg:         tlsCfg,
+		KeepAlive:      uint16(cfg.KeepAlive.Seconds()),
+		ConnectTimeout: cfg.ConnectTimeout,
+		OnConnectionUp: func(cm *autopaho.ConnectionManager, _ *paho.Connack) {
+			logger.Info("Connected to MQTT broker, subscribing to topics")
+			if _, subErr := cm.Subscribe(context.Background(), &paho.Subscribe{Subscriptions: subscriptions}); subErr != nil {
+				logger.Error("Failed to subscribe to topics", zap.Error(subErr))
+			}
+		},
+		OnConnectError: func(connErr error) {
+			logger.Warn("Failed to connect to MQTT broker, retrying", zap.Error(connErr))
+		},
+		ClientConfig: paho.ClientConfig{
+			ClientID: clientID,
+			Router: paho.NewSingleHandlerRouter(func(p *paho.Publish) {
+				handler(p.Topic, p.Payload)
+			}),
+			OnClientError: func(clientErr error) {
+				logger.Warn("Connection to MQTT broker lost, reconnecting", zap.Error(clientErr))
+			},
+		},
+	}
+	if cfg.Username != "" {
+		clientCfg.SetUsernamePassword(cfg.Username, []byte(cfg.Password))
+	}
+	return &clientV5{cfg: clientCfg}, nil
+}
+
+func (c *clientV5) start() error {
+	// The connection outlives the context passed to Start, and is retried in the background until the client is stopped.
+	ctx, cancel := context.WithCancel(context.Background())
+	conn, err := autopaho.NewConnection(ctx, c.cfg)
+	if err != nil {
+		cancel()
+		return err
+	}
+	c.cancel = cancel
+	c.conn = conn
+	return nil
+}
+
+func (c *clientV5) stop(ctx context.Context) error {
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Disconnect(ctx)
+	c.cancel()
+	return err
+}
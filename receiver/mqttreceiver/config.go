@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/multierr"
+)
+
+const (
+	// ProtocolVersion311 is MQTT 3.1.1.
+	ProtocolVersion311 = "3.1.1"
+	// ProtocolVersion5 is MQTT 5.
+	ProtocolVersion5 = "5"
+
+	// SignalLogs decodes the messages of a topic into logs.
+	SignalLogs = "logs"
+	// SignalMetrics decodes the messages of a topic into metrics.
+	SignalMetrics = "metrics"
+
+	// EncodingOTLPJSON decodes messages as OTLP/JSON.
+	EncodingOTLPJSON = "otlp_json"
+	// EncodingJSON decodes messages as arbitrary JSON, mapped to telemetry as configured.
+	EncodingJSON = "json"
+
+	// MetricTypeGauge maps a JSON field to a gauge.
+	MetricTypeGauge = "gauge"
+	// MetricTypeSum maps a JSON field to a cumulative monotonic sum.
+	MetricTypeSum = "sum"
+)
+
+var (
+	errMissingEndpoint = errors.New("endpoint must be specified")
+	errMissingTopics   = errors.New("at least one topic must be specified")
+)
+
+// Config defines configuration for the MQTT receiver.
+type Config struct {
+	// Endpoint is the URL of the broker, e.g. tcp://localhost:1883 or ssl://localhost:8883.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// ProtocolVersion is the MQTT version used to connect to the broker, 3.1.1 or 5.
+	ProtocolVersion string `mapstructure:"protocol_version"`
+
+	// ClientID identifies the receiver to the broker. A random one is generated if empty.
+	ClientID string `mapstructure:"client_id"`
+
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+
+	// TLSSetting configures TLS for the ssl, tls and mqtts endpoint schemes.
+	TLSSetting configtls.TLSClientSetting `mapstructure:"tls"`
+
+	// KeepAlive is the interval of the keep alive messages sent to the broker.
+	KeepAlive time.Duration `mapstructure:"keep_alive"`
+
+	// ConnectTimeout is the timeout of connection attempts. Connections are retried until the receiver is shut down.
+	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+
+	// Topics lists the topics subscribed to.
+	Topics []TopicConfig `mapstructure:"topics"`
+}
+
+// TopicConfig defines the subscription to a topic, and how its messages are decoded.
+type TopicConfig struct {
+	// Topic is the topic filter, which may contain the + and # wildcards.
+	Topic string `mapstructure:"topic"`
+
+	// SharedGroup subscribes to the topic as part of a shared subscription group,
+	// so that messages are load balanced among the collectors of the group.
+	SharedGroup string `mapstructure:"shared_group"`
+
+	// QoS is the maximum quality of service of the subscription, 0, 1 or 2.
+	QoS byte `mapstructure:"qos"`
+
+	// Signal is the telemetry signal the messages are decoded into, logs or metrics.
+	Signal string `mapstructure:"signal"`
+
+	// Encoding is the encoding of the messages, otlp_json or json.
+	Encoding string `mapstructure:"encoding"`
+
+	// JSON maps JSON messages to telemetry, if Encoding is json.
+	JSON JSONConfig `mapstructure:"json"`
+}
+
+// JSONConfig maps the fields of JSON messages to telemetry. Messages may hold an object, or an array of objects.
+// Fields are referenced with their dot-separated path, e.g. sensor.temperature.
+type JSONConfig struct {
+	// TimestampField holds the time of the record, either as Unix seconds or as RFC 3339.
+	// The time the message is received is used if empty or absent.
+	TimestampField string `mapstructure:"timestamp_field"`
+
+	// Attributes maps attribute names to the fields holding their value.
+	Attributes map[string]string `mapstructure:"attributes"`
+
+	// BodyField holds the body of log records. The whole object is the body if empty.
+	BodyField string `mapstructure:"body_field"`
+
+	// SeverityField holds the severity text of log records.
+	SeverityField string `mapstructure:"severity_field"`
+
+	// Metrics lists the metrics extracted from each object.
+	Metrics []JSONMetricConfig `mapstructure:"metrics"`
+}
+
+// JSONMetricConfig maps a numeric or boolean field of JSON messages to a metric.
+type JSONMetricConfig struct {
+	Name        string `mapstructure:"name"`
+	Field       string `mapstructure:"field"`
+	Type        string `mapstructure:"type"`
+	Unit        string `mapstructure:"unit"`
+	Description string `mapstructure:"description"`
+}
+
+var _ confmap.Unmarshaler = (*Config)(nil)
+
+// Unmarshal a confmap.Conf into the config struct, setting the defaults of the topics.
+func (cfg *Config) Unmarshal(conf *confmap.Conf) error {
+	if err := conf.Unmarshal(cfg, confmap.WithErrorUnused()); err != nil {
+		return err
+	}
+	for i := range cfg.Topics {
+		topic := &cfg.Topics[i]
+		if topic.Signal == "" {
+			topic.Signal = SignalLogs
+		}
+		if topic.Encoding == "" {
+			topic.Encoding = EncodingOTLPJSON
+		}
+		for j := range topic.JSON.Metrics {
+			if topic.JSON.Metrics[j].Type == "" {
+				topic.JSON.Metrics[j].Type = MetricTypeGauge
+			}
+		}
+	}
+	return nil
+}
+
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.Endpoint == "" {
+		errs = multierr.Append(errs, errMissingEndpoint)
+	} else if _, err := url.Parse(cfg.Endpoint); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid endpoint: %w", err))
+	}
+	if cfg.ProtocolVersion != ProtocolVersion311 && cfg.ProtocolVersion != ProtocolVersion5 {
+		errs = multierr.Append(errs, fmt.Errorf("unsupported protocol_version %q, must be %q or %q", cfg.ProtocolVersion, ProtocolVersion311, ProtocolVersion5))
+	}
+	if cfg.KeepAlive < time.Second {
+		errs = multierr.Append(errs, errors.New("keep_alive must be at least 1s"))
+	}
+	if len(cfg.Topics) == 0 {
+		errs = multierr.Append(errs, errMissingTopics)
+	}
+	for i, topic := range cfg.Topics {
+		if err := topic.validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("topics[%d]: %w", i, err))
+		}
+	}
+	return errs
+}
+
+func (cfg *TopicConfig) validate() error {
+	var errs error
+	if cfg.Topic == "" {
+		errs = multierr.Append(errs, errors.New("topic must be specified"))
+	}
+	if cfg.QoS > 2 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid qos %d, must be 0, 1 or 2", cfg.QoS))
+	}
+	if cfg.Signal != SignalLogs && cfg.Signal != SignalMetrics {
+		errs = multierr.Append(errs, fmt.Errorf("unsupported signal %q, must be %q or %q", cfg.Signal, SignalLogs, SignalMetrics))
+	}
+	switch cfg.Encoding {
+	case EncodingOTLPJSON:
+	case EncodingJSON:
+		if cfg.Signal == SignalMetrics && len(cfg.JSON.Metrics) == 0 {
+			errs = multierr.Append(errs, errors.New("json::metrics must be specified to decode metrics"))
+		}
+		for _, m := range cfg.JSON.Metrics {
+			if m.Name == "" || m.Field == "" {
+				errs = multierr.Append(errs, errors.New("json::metrics must have a name and a field"))
+			}
+			if m.Type != MetricTypeGauge && m.Type != MetricTypeSum {
+				errs = multierr.Append(errs, fmt.Errorf("unsupported type %q of metric %q, must be %q or %q", m.Type, m.Name, MetricTypeGauge, MetricTypeSum))
+			}
+		}
+	default:
+		errs = multierr.Append(errs, fmt.Errorf("unsupported encoding %q, must be %q or %q", cfg.Encoding, EncodingOTLPJSON, EncodingJSON))
+	}
+	return errs
+}
+
+// filter returns the topic filter subscribed to.
+func (cfg *TopicConfig) filter() string {
+	if cfg.SharedGroup != "" {
+		return "$share/" + cfg.SharedGroup + "/" + cfg.Topic
+	}
+	return cfg.Topic
+}
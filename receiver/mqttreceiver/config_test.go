@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:          component.NewID(metadata.Type),
+			expectedErr: "endpoint must be specified; at least one topic must be specified",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "otlp"),
+			expected: &Config{
+				Endpoint:        "tcp://localhost:1883",
+				ProtocolVersion: ProtocolVersion311,
+				KeepAlive:       defaultKeepAlive,
+				ConnectTimeout:  defaultConnectTimeout,
+				Topics: []TopicConfig{
+					{Topic: "otlp/logs", Signal: SignalLogs, Encoding: EncodingOTLPJSON},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all"),
+			expected: &Config{
+				Endpoint:        "ssl://broker.example.com:8883",
+				ProtocolVersion: ProtocolVersion5,
+				ClientID:        "collector-1",
+				Username:        "collector",
+				Password:        "secret",
+				TLSSetting: configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{CAFile: "ca.pem"},
+				},
+				KeepAlive:      time.Minute,
+				ConnectTimeout: 5 * time.Second,
+				Topics: []TopicConfig{
+					{
+						Topic:       "sensors/+/telemetry",
+						SharedGroup: "collectors",
+						QoS:         1,
+						Signal:      SignalMetrics,
+						Encoding:    EncodingJSON,
+						JSON: JSONConfig{
+							TimestampField: "ts",
+							Attributes:     map[string]string{"device.id": "device"},
+							Metrics: []JSONMetricConfig{
+								{Name: "temperature", Field: "readings.temperature", Type: MetricTypeGauge, Unit: "Cel"},
+								{Name: "uptime", Field: "uptime", Type: MetricTypeSum, Unit: "s"},
+							},
+						},
+					},
+					{
+						Topic:    "sensors/+/events",
+						Signal:   SignalLogs,
+						Encoding: EncodingJSON,
+						JSON: JSONConfig{
+							BodyField:     "message",
+							SeverityField: "level",
+						},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid"),
+			expectedErr: `unsupported protocol_version "4", must be "3.1.1" or "5"; ` +
+				`topics[0]: invalid qos 3, must be 0, 1 or 2; ` +
+				`unsupported signal "traces", must be "logs" or "metrics"; ` +
+				`unsupported encoding "xml", must be "otlp_json" or "json"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestTopicFilter(t *testing.T) {
+	assert.Equal(t, "sensors/#", (&TopicConfig{Topic: "sensors/#"}).filter())
+	assert.Equal(t, "$share/collectors/sensors/#", (&TopicConfig{Topic: "sensors/#", SharedGroup: "collectors"}).filter())
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// attributeMQTTTopic holds the topic the message of a JSON-decoded record was received on.
+const attributeMQTTTopic = "mqtt.topic"
+
+// parseJSONObjects parses a message holding a JSON object, or an array of JSON objects.
+func parseJSONObjects(payload []byte) ([]map[string]interface{}, error) {
+	var v interface{}
+	if err := json.Unmarshal(payload, &v); err != nil {
+		return nil, err
+	}
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return []map[string]interface{}{t}, nil
+	case []interface{}:
+		objs := make([]map[string]interface{}, 0, len(t))
+		for i, elem := range t {
+			obj, ok := elem.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("element %d of the array is not a JSON object", i)
+			}
+			objs = append(objs, obj)
+		}
+		return objs, nil
+	default:
+		return nil, errors.New("message is neither a JSON object nor an array of JSON objects")
+	}
+}
+
+// lookup returns the value of the field with the dot-separated path in obj.
+func lookup(obj map[string]interface{}, path string) (interface{}, bool) {
+	var v interface{} = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// setValue sets dest to the JSON value v, using integers for integral numbers.
+func setValue(dest pcommon.Value, v interface{}) error {
+	if f, ok := v.(float64); ok && f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+		dest.SetInt(int64(f))
+		return nil
+	}
+	return dest.FromRaw(v)
+}
+
+type jsonDecoder struct {
+	cfg JSONConfig
+}
+
+func (d *jsonDecoder) timestamp(obj map[string]interface{}, received time.Time) (pcommon.Timestamp, error) {
+	if d.cfg.TimestampField == "" {
+		return pcommon.NewTimestampFromTime(received), nil
+	}
+	v, ok := lookup(obj, d.cfg.TimestampField)
+	if !ok {
+		return pcommon.NewTimestampFromTime(received), nil
+	}
+	switch t := v.(type) {
+	case float64:
+		sec, frac := math.Modf(t)
+		return pcommon.NewTimestampFromTime(time.Unix(int64(sec), int64(frac*1e9))), nil
+	case string:
+		ts, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return 0, fmt.Errorf("invalid timestamp: %w", err)
+		}
+		return pcommon.NewTimestampFromTime(ts), nil
+	default:
+		return 0, fmt.Errorf("invalid timestamp: unsupported type %T", v)
+	}
+}
+
+func (d *jsonDecoder) putAttributes(attrs pcommon.Map, obj map[string]interface{}, topic string) error {
+	attrs.PutStr(attributeMQTTTopic, topic)
+	for name, path := range d.cfg.Attributes {
+		v, ok := lookup(obj, path)
+		if !ok || v == nil {
+			continue
+		}
+		if err := setValue(attrs.PutEmpty(name), v); err != nil {
+			return fmt.Errorf("invalid value of attribute %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (d *jsonDecoder) decodeLogs(topic string, payload []byte, received time.Time) (plog.Logs, error) {
+	objs, err := parseJSONObjects(payload)
+	if err != nil {
+		return plog.Logs{}, err
+	}
+
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+	records := sl.LogRecords()
+	records.EnsureCapacity(len(objs))
+	for _, obj := range objs {
+		lr := records.AppendEmpty()
+		var ts pcommon.Timestamp
+		if ts, err = d.timestamp(obj, received); err != nil {
+			return plog.Logs{}, err
+		}
+		lr.SetTimestamp(ts)
+		lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(received))
+
+		if d.cfg.BodyField == "" {
+			if err = lr.Body().SetEmptyMap().FromRaw(obj); err != nil {
+				return plog.Logs{}, err
+			}
+		} else if v, ok := lookup(obj, d.cfg.BodyField); ok {
+			if err = setValue(lr.Body(), v); err != nil {
+				return plog.Logs{}, err
+			}
+		}
+		if d.cfg.SeverityField != "" {
+			if v, ok := lookup(obj, d.cfg.SeverityField); ok && v != nil {
+				lr.SetSeverityText(fmt.Sprint(v))
+			}
+		}
+		if err = d.putAttributes(lr.Attributes(), obj, topic); err != nil {
+			return plog.Logs{}, err
+		}
+	}
+	return ld, nil
+}
+
+func (d *jsonDecoder) decodeMetrics(topic string, payload []byte, received time.Time) (pmetric.Metrics, error) {
+	objs, err := parseJSONObjects(payload)
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+
+	md := pmetric.NewMetrics()
+	sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	for _, mcfg := range d.cfg.Metrics {
+		m := pmetric.NewMetric()
+		m.SetName(mcfg.Name)
+		m.SetUnit(mcfg.Unit)
+		m.SetDescription(mcfg.Description)
+		var dps pmetric.NumberDataPointSlice
+		if mcfg.Type == MetricTypeSum {
+			sum := m.SetEmptySum()
+			sum.SetIsMonotonic(true)
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			dps = sum.DataPoints()
+		} else {
+			dps = m.SetEmptyGauge().DataPoints()
+		}
+
+		for _, obj := range objs {
+			v, ok := lookup(obj, mcfg.Field)
+			if !ok {
+				continue
+			}
+			var value float64
+			switch t := v.(type) {
+			case float64:
+				value = t
+			case bool:
+				if t {
+					value = 1
+				}
+			default:
+				continue
+			}
+
+			var ts pcommon.Timestamp
+			if ts, err = d.timestamp(obj, received); err != nil {
+				return pmetric.Metrics{}, err
+			}
+			dp := dps.AppendEmpty()
+			dp.SetTimestamp(ts)
+			dp.SetDoubleValue(value)
+			if err = d.putAttributes(dp.Attributes(), obj, topic); err != nil {
+				return pmetric.Metrics{}, err
+			}
+		}
+		if dps.Len() > 0 {
+			m.MoveTo(sm.Metrics().AppendEmpty())
+		}
+	}
+	return md, nil
+}
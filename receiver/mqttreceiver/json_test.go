@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+var received = time.Date(2023, 7, 18, 10, 0, 0, 0, time.UTC)
+
+func TestDecodeLogs(t *testing.T) {
+	d := &jsonDecoder{cfg: JSONConfig{
+		TimestampField: "ts",
+		BodyField:      "message",
+		SeverityField:  "level",
+		Attributes:     map[string]string{"device.id": "device.id", "device.firmware": "device.firmware", "missing": "missing"},
+	}}
+
+	payload := `[
+		{"ts": 1689674400.5, "message": "door opened", "level": "info", "device": {"id": 42, "firmware": "1.2.3"}},
+		{"ts": "2023-07-18T10:01:00Z", "message": "door closed", "device": {"id": 43}}
+	]`
+	ld, err := d.decodeLogs("sensors/door/events", []byte(payload), received)
+	require.NoError(t, err)
+	require.Equal(t, 2, ld.LogRecordCount())
+
+	records := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	lr := records.At(0)
+	assert.Equal(t, time.Date(2023, 7, 18, 10, 0, 0, 5e8, time.UTC), lr.Timestamp().AsTime())
+	assert.Equal(t, received, lr.ObservedTimestamp().AsTime())
+	assert.Equal(t, "door opened", lr.Body().Str())
+	assert.Equal(t, "info", lr.SeverityText())
+	assert.Equal(t, map[string]interface{}{
+		"mqtt.topic":      "sensors/door/events",
+		"device.id":       int64(42),
+		"device.firmware": "1.2.3",
+	}, lr.Attributes().AsRaw())
+
+	lr = records.At(1)
+	assert.Equal(t, time.Date(2023, 7, 18, 10, 1, 0, 0, time.UTC), lr.Timestamp().AsTime())
+	assert.Empty(t, lr.SeverityText())
+}
+
+func TestDecodeLogsWholeBody(t *testing.T) {
+	d := &jsonDecoder{}
+	ld, err := d.decodeLogs("events", []byte(`{"message": "hello", "count": 2}`), received)
+	require.NoError(t, err)
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, received, lr.Timestamp().AsTime())
+	assert.Equal(t, map[string]interface{}{"message": "hello", "count": float64(2)}, lr.Body().Map().AsRaw())
+}
+
+func TestDecodeMetrics(t *testing.T) {
+	d := &jsonDecoder{cfg: JSONConfig{
+		Attributes: map[string]string{"device.id": "device"},
+		Metrics: []JSONMetricConfig{
+			{Name: "temperature", Field: "readings.temperature", Type: MetricTypeGauge, Unit: "Cel"},
+			{Name: "door.open", Field: "open", Type: MetricTypeGauge},
+			{Name: "uptime", Field: "uptime", Type: MetricTypeSum, Unit: "s"},
+			{Name: "absent", Field: "absent", Type: MetricTypeGauge},
+		},
+	}}
+
+	payload := `{"device": "d1", "readings": {"temperature": 21.5}, "open": true, "uptime": 3600}`
+	md, err := d.decodeMetrics("sensors/d1/telemetry", []byte(payload), received)
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+
+	temperature := metrics.At(0)
+	assert.Equal(t, "temperature", temperature.Name())
+	assert.Equal(t, "Cel", temperature.Unit())
+	require.Equal(t, pmetric.MetricTypeGauge, temperature.Type())
+	dp := temperature.Gauge().DataPoints().At(0)
+	assert.Equal(t, 21.5, dp.DoubleValue())
+	assert.Equal(t, received, dp.Timestamp().AsTime())
+	assert.Equal(t, map[string]interface{}{"mqtt.topic": "sensors/d1/telemetry", "device.id": "d1"}, dp.Attributes().AsRaw())
+
+	assert.Equal(t, 1.0, metrics.At(1).Gauge().DataPoints().At(0).DoubleValue())
+
+	uptime := metrics.At(2)
+	require.Equal(t, pmetric.MetricTypeSum, uptime.Type())
+	assert.True(t, uptime.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, uptime.Sum().AggregationTemporality())
+	assert.Equal(t, 3600.0, uptime.Sum().DataPoints().At(0).DoubleValue())
+}
+
+func TestDecodeErrors(t *testing.T) {
+	d := &jsonDecoder{cfg: JSONConfig{TimestampField: "ts"}}
+	for _, payload := range []string{
+		`not json`,
+		`"a string"`,
+		`[{"a": 1}, 2]`,
+		`{"ts": "yesterday"}`,
+		`{"ts": true}`,
+	} {
+		_, err := d.decodeLogs("topic", []byte(payload), received)
+		assert.Error(t, err, payload)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	obj := map[string]interface{}{"a": map[string]interface{}{"b": "c"}, "d": "e"}
+	v, ok := lookup(obj, "a.b")
+	assert.True(t, ok)
+	assert.Equal(t, "c", v)
+	_, ok = lookup(obj, "a.x")
+	assert.False(t, ok)
+	_, ok = lookup(obj, "d.e")
+	assert.False(t, ok)
+}
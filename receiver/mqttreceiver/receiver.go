@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+const (
+	scopeName = "otelcol/" + metadata.Type + "receiver"
+	transport = "mqtt"
+)
+
+type topicDecoder struct {
+	cfg  TopicConfig
+	json *jsonDecoder
+}
+
+type mqttReceiver struct {
+	settings    receiver.CreateSettings
+	cfg         *Config
+	obsrecv     *obsreport.Receiver
+	topics      []topicDecoder
+	nextLogs    consumer.Logs
+	nextMetrics consumer.Metrics
+	client      client
+}
+
+func newMQTTReceiver(set receiver.CreateSettings, cfg *Config) (*mqttReceiver, error) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]topicDecoder, len(cfg.Topics))
+	for i, topic := range cfg.Topics {
+		topics[i] = topicDecoder{cfg: topic, json: &jsonDecoder{cfg: topic.JSON}}
+	}
+	return &mqttReceiver{
+		settings: set,
+		cfg:      cfg,
+		obsrecv:  obsrecv,
+		topics:   topics,
+	}, nil
+}
+
+func (r *mqttReceiver) Start(_ context.Context, _ component.Host) error {
+	tlsCfg, err := r.cfg.TLSSetting.LoadTLSConfig()
+	if err != nil {
+		return err
+	}
+	clientID := r.cfg.ClientID
+	if clientID == "" {
+		if clientID, err = randomClientID(); err != nil {
+			return err
+		}
+	}
+	r.client, err = newClient(r.cfg, clientID, tlsCfg, r.settings.Logger, r.handleMessage)
+	if err != nil {
+		return err
+	}
+	return r.client.start()
+}
+
+func (r *mqttReceiver) Shutdown(ctx context.Context) error {
+	if r.client == nil {
+		return nil
+	}
+	return r.client.stop(ctx)
+}
+
+func (r *mqttReceiver) handleMessage(topic string, payload []byte) {
+	decoder := r.match(topic)
+	if decoder == nil {
+		r.settings.Logger.Debug("Ignoring message of unexpected topic", zap.String("topic", topic))
+		return
+	}
+
+	received := time.Now()
+	switch decoder.cfg.Signal {
+	case SignalLogs:
+		if r.nextLogs == nil {
+			r.settings.Logger.Debug("Ignoring logs message, the receiver is not part of a logs pipeline", zap.String("topic", topic))
+			return
+		}
+		ctx := r.obsrecv.StartLogsOp(context.Background())
+		ld, err := decoder.decodeLogs(topic, payload, received)
+		if err != nil {
+			r.settings.Logger.Error("Failed to decode message", zap.String("topic", topic), zap.Error(err))
+			r.obsrecv.EndLogsOp(ctx, decoder.cfg.Encoding, 0, err)
+			return
+		}
+		err = r.nextLogs.ConsumeLogs(ctx, ld)
+		r.obsrecv.EndLogsOp(ctx, decoder.cfg.Encoding, ld.LogRecordCount(), err)
+	case SignalMetrics:
+		if r.nextMetrics == nil {
+			r.settings.Logger.Debug("Ignoring metrics message, the receiver is not part of a metrics pipeline", zap.String("topic", topic))
+			return
+		}
+		ctx := r.obsrecv.StartMetricsOp(context.Background())
+		md, err := decoder.decodeMetrics(topic, payload, received)
+		if err != nil {
+			r.settings.Logger.Error("Failed to decode message", zap.String("topic", topic), zap.Error(err))
+			r.obsrecv.EndMetricsOp(ctx, decoder.cfg.Encoding, 0, err)
+			return
+		}
+		err = r.nextMetrics.ConsumeMetrics(ctx, md)
+		r.obsrecv.EndMetricsOp(ctx, decoder.cfg.Encoding, md.DataPointCount(), err)
+	}
+}
+
+// match returns the decoder of the first topic whose filter matches topic.
+func (r *mqttReceiver) match(topic string) *topicDecoder {
+	for i := range r.topics {
+		if matchTopic(r.topics[i].cfg.Topic, topic) {
+			return &r.topics[i]
+		}
+	}
+	return nil
+}
+
+func (d *topicDecoder) decodeLogs(topic string, payload []byte, received time.Time) (plog.Logs, error) {
+	if d.cfg.Encoding == EncodingJSON {
+		return d.json.decodeLogs(topic, payload, received)
+	}
+	return (&plog.JSONUnmarshaler{}).UnmarshalLogs(payload)
+}
+
+func (d *topicDecoder) decodeMetrics(topic string, payload []byte, received time.Time) (pmetric.Metrics, error) {
+	if d.cfg.Encoding == EncodingJSON {
+		return d.json.decodeMetrics(topic, payload, received)
+	}
+	return (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(payload)
+}
+
+// matchTopic reports whether topic matches the MQTT topic filter, which may contain wildcards.
+func matchTopic(filter, topic string) bool {
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+	// Wildcards at the first level do not match topics starting with $, e.g. $SYS.
+	if strings.HasPrefix(topic, "$") && (filterLevels[0] == "+" || filterLevels[0] == "#") {
+		return false
+	}
+	for i, level := range filterLevels {
+		if level == "#" {
+			return true
+		}
+		if i >= len(topicLevels) || (level != "+" && level != topicLevels[i]) {
+			return false
+		}
+	}
+	return len(filterLevels) == len(topicLevels)
+}
+
+func randomClientID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "otelcol-" + hex.EncodeToString(b), nil
+}
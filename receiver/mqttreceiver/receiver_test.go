@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestMatchTopic(t *testing.T) {
+	tests := []struct {
+		filter string
+		topic  string
+		match  bool
+	}{
+		{filter: "sensors/temp", topic: "sensors/temp", match: true},
+		{filter: "sensors/temp", topic: "sensors/humidity", match: false},
+		{filter: "sensors/+/temp", topic: "sensors/d1/temp", match: true},
+		{filter: "sensors/+/temp", topic: "sensors/d1/x/temp", match: false},
+		{filter: "sensors/#", topic: "sensors", match: true},
+		{filter: "sensors/#", topic: "sensors/d1/temp", match: true},
+		{filter: "sensors/+", topic: "sensors", match: false},
+		{filter: "#", topic: "$SYS/broker/uptime", match: false},
+		{filter: "+/broker/uptime", topic: "$SYS/broker/uptime", match: false},
+		{filter: "$SYS/#", topic: "$SYS/broker/uptime", match: true},
+		{filter: "sensors/temp", topic: "sensors/temp/x", match: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, matchTopic(tt.filter, tt.topic), "filter %q topic %q", tt.filter, tt.topic)
+	}
+}
+
+func newTestReceiver(t *testing.T, topics ...TopicConfig) *mqttReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "tcp://localhost:1883"
+	cfg.Topics = topics
+	r, err := newMQTTReceiver(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	return r
+}
+
+func TestHandleMessage(t *testing.T) {
+	r := newTestReceiver(t,
+		TopicConfig{Topic: "otlp/logs", Signal: SignalLogs, Encoding: EncodingOTLPJSON},
+		TopicConfig{Topic: "sensors/+/telemetry", Signal: SignalMetrics, Encoding: EncodingJSON, JSON: JSONConfig{
+			Metrics: []JSONMetricConfig{{Name: "temperature", Field: "temperature", Type: MetricTypeGauge}},
+		}},
+	)
+	logsSink := new(consumertest.LogsSink)
+	metricsSink := new(consumertest.MetricsSink)
+	r.nextLogs = logsSink
+	r.nextMetrics = metricsSink
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+	payload, err := (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+
+	r.handleMessage("otlp/logs", payload)
+	require.Equal(t, 1, logsSink.LogRecordCount())
+	assert.Equal(t, ld, logsSink.AllLogs()[0])
+
+	r.handleMessage("sensors/d1/telemetry", []byte(`{"temperature": 21.5}`))
+	assert.Equal(t, 1, metricsSink.DataPointCount())
+
+	// Unmatched topics and undecodable messages are dropped.
+	r.handleMessage("other", payload)
+	r.handleMessage("otlp/logs", []byte(`{`))
+	assert.Equal(t, 1, logsSink.LogRecordCount())
+}
+
+func TestHandleMessageWithoutPipeline(t *testing.T) {
+	r := newTestReceiver(t, TopicConfig{Topic: "#", Signal: SignalMetrics, Encoding: EncodingOTLPJSON})
+	r.nextLogs = new(consumertest.LogsSink)
+	// Metrics messages are dropped if the receiver is only part of logs pipelines.
+	r.handleMessage("metrics", []byte(`{}`))
+}
+
+func TestStartShutdown(t *testing.T) {
+	for _, version := range []string{ProtocolVersion311, ProtocolVersion5} {
+		t.Run(version, func(t *testing.T) {
+			r := newTestReceiver(t, TopicConfig{Topic: "#", Signal: SignalLogs, Encoding: EncodingOTLPJSON})
+			r.cfg.ProtocolVersion = version
+			// The broker is unreachable, connections are retried in the background.
+			r.cfg.Endpoint = "tcp://localhost:1"
+			require.NoError(t, r.Start(context.Background(), nil))
+			assert.NoError(t, r.Shutdown(context.Background()))
+		})
+	}
+}
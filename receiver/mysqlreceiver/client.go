@@ -18,11 +18,13 @@ type client interface {
 	getVersion() (string, error)
 	getGlobalStats() (map[string]string, error)
 	getInnodbStats() (map[string]string, error)
+	getInnodbBufferPoolStats() (innodbBufferPoolStats, error)
 	getTableIoWaitsStats() ([]TableIoWaitsStats, error)
 	getIndexIoWaitsStats() ([]IndexIoWaitsStats, error)
 	getStatementEventsStats() ([]StatementEventStats, error)
 	getTableLockWaitEventStats() ([]tableLockWaitEventStats, error)
 	getReplicaStatusStats() ([]ReplicaStatusStats, error)
+	getWaitEventStats() ([]waitEventStats, error)
 	Close() error
 }
 
@@ -32,6 +34,7 @@ type mySQLClient struct {
 	statementEventsDigestTextLimit int
 	statementEventsLimit           int
 	statementEventsTimeLimit       time.Duration
+	waitEventsLimit                int
 }
 
 type IoWaitsStats struct {
@@ -98,6 +101,17 @@ type tableLockWaitEventStats struct {
 	sumTimerWriteExternal         int64
 }
 
+type innodbBufferPoolStats struct {
+	pagesMadeYoung    int64
+	pagesNotMadeYoung int64
+}
+
+type waitEventStats struct {
+	name         string
+	countStar    int64
+	sumTimerWait int64
+}
+
 type ReplicaStatusStats struct {
 	replicaIOState            string
 	sourceHost                string
@@ -179,6 +193,7 @@ func newMySQLClient(conf *Config) client {
 		statementEventsDigestTextLimit: conf.StatementEvents.DigestTextLimit,
 		statementEventsLimit:           conf.StatementEvents.Limit,
 		statementEventsTimeLimit:       conf.StatementEvents.TimeLimit,
+		waitEventsLimit:                conf.WaitEvents.Limit,
 	}
 }
 
@@ -215,6 +230,16 @@ func (c *mySQLClient) getInnodbStats() (map[string]string, error) {
 	return Query(*c, query)
 }
 
+// getInnodbBufferPoolStats queries the db for the page aging counters of the buffer pool LRU list,
+// summed across all buffer pool instances.
+func (c *mySQLClient) getInnodbBufferPoolStats() (innodbBufferPoolStats, error) {
+	query := "SELECT ifnull(SUM(PAGES_MADE_YOUNG), 0), ifnull(SUM(PAGES_NOT_MADE_YOUNG), 0) " +
+		"FROM information_schema.INNODB_BUFFER_POOL_STATS;"
+	var s innodbBufferPoolStats
+	err := c.client.QueryRow(query).Scan(&s.pagesMadeYoung, &s.pagesNotMadeYoung)
+	return s, err
+}
+
 // getTableIoWaitsStats queries the db for table_io_waits metrics.
 func (c *mySQLClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 	query := "SELECT OBJECT_SCHEMA, OBJECT_NAME, " +
@@ -341,17 +366,48 @@ func (c *mySQLClient) getTableLockWaitEventStats() ([]tableLockWaitEventStats, e
 	return stats, nil
 }
 
+// getWaitEventStats queries the db for the wait events with the highest total wait time.
+func (c *mySQLClient) getWaitEventStats() ([]waitEventStats, error) {
+	query := fmt.Sprintf("SELECT EVENT_NAME, COUNT_STAR, SUM_TIMER_WAIT "+
+		"FROM performance_schema.events_waits_summary_global_by_event_name "+
+		"WHERE COUNT_STAR > 0 AND EVENT_NAME != 'idle' "+
+		"ORDER BY SUM_TIMER_WAIT DESC "+
+		"LIMIT %d",
+		c.waitEventsLimit)
+
+	rows, err := c.client.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []waitEventStats
+	for rows.Next() {
+		var s waitEventStats
+		if err := rows.Scan(&s.name, &s.countStar, &s.sumTimerWait); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
+// replicaStatusColumnReplacer maps the column names of SHOW SLAVE STATUS
+// to the ones of SHOW REPLICA STATUS.
+var replicaStatusColumnReplacer = strings.NewReplacer("master", "source", "slave", "replica")
+
 func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 	version, err := c.getVersion()
 	if err != nil {
 		return nil, err
 	}
 
+	// SHOW REPLICA STATUS was introduced in 8.0.22, older versions only support the deprecated statement.
+	query := "SHOW REPLICA STATUS"
 	if version < "8.0.22" {
-		return nil, nil
+		query = "SHOW SLAVE STATUS"
 	}
-
-	query := "SHOW REPLICA STATUS"
 	rows, err := c.client.Query(query)
 
 	if err != nil {
@@ -369,7 +425,7 @@ func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 		var s ReplicaStatusStats
 		dest := []interface{}{}
 		for _, col := range cols {
-			switch strings.ToLower(col) {
+			switch replicaStatusColumnReplacer.Replace(strings.ToLower(col)) {
 			case "replica_io_state":
 				dest = append(dest, &s.replicaIOState)
 			case "source_host":
@@ -491,7 +547,8 @@ func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 			case "network_namespace":
 				dest = append(dest, &s.networkNamespace)
 			default:
-				return nil, fmt.Errorf("unknown column name %s for replica status", col)
+				// columns added by newer versions are not needed for the metrics.
+				dest = append(dest, new(sql.RawBytes))
 			}
 		}
 		err := rows.Scan(dest...)
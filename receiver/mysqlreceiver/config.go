@@ -17,6 +17,7 @@ const (
 	defaultStatementEventsDigestTextLimit = 120
 	defaultStatementEventsLimit           = 250
 	defaultStatementEventsTimeLimit       = 24 * time.Hour
+	defaultWaitEventsLimit                = 100
 )
 
 type Config struct {
@@ -28,6 +29,8 @@ type Config struct {
 	confignet.NetAddr                       `mapstructure:",squash"`
 	MetricsBuilderConfig                    metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	StatementEvents                         StatementEventsConfig         `mapstructure:"statement_events"`
+	WaitEvents                              WaitEventsConfig              `mapstructure:"wait_events"`
+	QueryGroups                             QueryGroupsConfig             `mapstructure:"query_groups"`
 }
 
 type StatementEventsConfig struct {
@@ -35,3 +38,19 @@ type StatementEventsConfig struct {
 	Limit           int           `mapstructure:"limit"`
 	TimeLimit       time.Duration `mapstructure:"time_limit"`
 }
+
+type WaitEventsConfig struct {
+	Limit int `mapstructure:"limit"`
+}
+
+// QueryGroupsConfig toggles the groups of queries run on every scrape.
+// A disabled group is never queried, regardless of which of its metrics are enabled.
+type QueryGroupsConfig struct {
+	InnodbBufferPool bool `mapstructure:"innodb_buffer_pool"`
+	TableIoWaits     bool `mapstructure:"table_io_waits"`
+	IndexIoWaits     bool `mapstructure:"index_io_waits"`
+	StatementEvents  bool `mapstructure:"statement_events"`
+	TableLockWaits   bool `mapstructure:"table_lock_waits"`
+	WaitEvents       bool `mapstructure:"wait_events"`
+	ReplicaStatus    bool `mapstructure:"replica_status"`
+}
@@ -32,6 +32,8 @@ func TestLoadConfig(t *testing.T) {
 	expected.Password = "${env:MYSQL_PASSWORD}"
 	expected.Database = "otel"
 	expected.CollectionInterval = 10 * time.Second
+	expected.WaitEvents.Limit = 50
+	expected.QueryGroups.TableLockWaits = false
 
 	require.Equal(t, expected, cfg)
 }
@@ -40,6 +40,18 @@ func createDefaultConfig() component.Config {
 			Limit:           defaultStatementEventsLimit,
 			TimeLimit:       defaultStatementEventsTimeLimit,
 		},
+		WaitEvents: WaitEventsConfig{
+			Limit: defaultWaitEventsLimit,
+		},
+		QueryGroups: QueryGroupsConfig{
+			InnodbBufferPool: true,
+			TableIoWaits:     true,
+			IndexIoWaits:     true,
+			StatementEvents:  true,
+			TableLockWaits:   true,
+			WaitEvents:       true,
+			ReplicaStatus:    true,
+		},
 	}
 }
 
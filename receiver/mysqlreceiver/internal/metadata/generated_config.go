@@ -28,6 +28,7 @@ type MetricsConfig struct {
 	MysqlBufferPoolDataPages     MetricConfig `mapstructure:"mysql.buffer_pool.data_pages"`
 	MysqlBufferPoolLimit         MetricConfig `mapstructure:"mysql.buffer_pool.limit"`
 	MysqlBufferPoolOperations    MetricConfig `mapstructure:"mysql.buffer_pool.operations"`
+	MysqlBufferPoolPageAging     MetricConfig `mapstructure:"mysql.buffer_pool.page_aging"`
 	MysqlBufferPoolPageFlushes   MetricConfig `mapstructure:"mysql.buffer_pool.page_flushes"`
 	MysqlBufferPoolPages         MetricConfig `mapstructure:"mysql.buffer_pool.pages"`
 	MysqlBufferPoolUsage         MetricConfig `mapstructure:"mysql.buffer_pool.usage"`
@@ -52,6 +53,7 @@ type MetricsConfig struct {
 	MysqlQueryCount              MetricConfig `mapstructure:"mysql.query.count"`
 	MysqlQuerySlowCount          MetricConfig `mapstructure:"mysql.query.slow.count"`
 	MysqlReplicaSQLDelay         MetricConfig `mapstructure:"mysql.replica.sql_delay"`
+	MysqlReplicaThreadRunning    MetricConfig `mapstructure:"mysql.replica.thread.running"`
 	MysqlReplicaTimeBehindSource MetricConfig `mapstructure:"mysql.replica.time_behind_source"`
 	MysqlRowLocks                MetricConfig `mapstructure:"mysql.row_locks"`
 	MysqlRowOperations           MetricConfig `mapstructure:"mysql.row_operations"`
@@ -68,6 +70,8 @@ type MetricsConfig struct {
 	MysqlThreads                 MetricConfig `mapstructure:"mysql.threads"`
 	MysqlTmpResources            MetricConfig `mapstructure:"mysql.tmp_resources"`
 	MysqlUptime                  MetricConfig `mapstructure:"mysql.uptime"`
+	MysqlWaitEventCount          MetricConfig `mapstructure:"mysql.wait_event.count"`
+	MysqlWaitEventTime           MetricConfig `mapstructure:"mysql.wait_event.time"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -81,6 +85,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		MysqlBufferPoolOperations: MetricConfig{
 			Enabled: true,
 		},
+		MysqlBufferPoolPageAging: MetricConfig{
+			Enabled: false,
+		},
 		MysqlBufferPoolPageFlushes: MetricConfig{
 			Enabled: true,
 		},
@@ -153,6 +160,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		MysqlReplicaSQLDelay: MetricConfig{
 			Enabled: false,
 		},
+		MysqlReplicaThreadRunning: MetricConfig{
+			Enabled: false,
+		},
 		MysqlReplicaTimeBehindSource: MetricConfig{
 			Enabled: false,
 		},
@@ -201,6 +211,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		MysqlUptime: MetricConfig{
 			Enabled: true,
 		},
+		MysqlWaitEventCount: MetricConfig{
+			Enabled: false,
+		},
+		MysqlWaitEventTime: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
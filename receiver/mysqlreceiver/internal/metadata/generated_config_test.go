@@ -29,6 +29,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlBufferPoolDataPages:     MetricConfig{Enabled: true},
 					MysqlBufferPoolLimit:         MetricConfig{Enabled: true},
 					MysqlBufferPoolOperations:    MetricConfig{Enabled: true},
+					MysqlBufferPoolPageAging:     MetricConfig{Enabled: true},
 					MysqlBufferPoolPageFlushes:   MetricConfig{Enabled: true},
 					MysqlBufferPoolPages:         MetricConfig{Enabled: true},
 					MysqlBufferPoolUsage:         MetricConfig{Enabled: true},
@@ -53,6 +54,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlQueryCount:              MetricConfig{Enabled: true},
 					MysqlQuerySlowCount:          MetricConfig{Enabled: true},
 					MysqlReplicaSQLDelay:         MetricConfig{Enabled: true},
+					MysqlReplicaThreadRunning:    MetricConfig{Enabled: true},
 					MysqlReplicaTimeBehindSource: MetricConfig{Enabled: true},
 					MysqlRowLocks:                MetricConfig{Enabled: true},
 					MysqlRowOperations:           MetricConfig{Enabled: true},
@@ -69,6 +71,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlThreads:                 MetricConfig{Enabled: true},
 					MysqlTmpResources:            MetricConfig{Enabled: true},
 					MysqlUptime:                  MetricConfig{Enabled: true},
+					MysqlWaitEventCount:          MetricConfig{Enabled: true},
+					MysqlWaitEventTime:           MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					MysqlInstanceEndpoint: ResourceAttributeConfig{Enabled: true},
@@ -82,6 +86,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlBufferPoolDataPages:     MetricConfig{Enabled: false},
 					MysqlBufferPoolLimit:         MetricConfig{Enabled: false},
 					MysqlBufferPoolOperations:    MetricConfig{Enabled: false},
+					MysqlBufferPoolPageAging:     MetricConfig{Enabled: false},
 					MysqlBufferPoolPageFlushes:   MetricConfig{Enabled: false},
 					MysqlBufferPoolPages:         MetricConfig{Enabled: false},
 					MysqlBufferPoolUsage:         MetricConfig{Enabled: false},
@@ -106,6 +111,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlQueryCount:              MetricConfig{Enabled: false},
 					MysqlQuerySlowCount:          MetricConfig{Enabled: false},
 					MysqlReplicaSQLDelay:         MetricConfig{Enabled: false},
+					MysqlReplicaThreadRunning:    MetricConfig{Enabled: false},
 					MysqlReplicaTimeBehindSource: MetricConfig{Enabled: false},
 					MysqlRowLocks:                MetricConfig{Enabled: false},
 					MysqlRowOperations:           MetricConfig{Enabled: false},
@@ -122,6 +128,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlThreads:                 MetricConfig{Enabled: false},
 					MysqlTmpResources:            MetricConfig{Enabled: false},
 					MysqlUptime:                  MetricConfig{Enabled: false},
+					MysqlWaitEventCount:          MetricConfig{Enabled: false},
+					MysqlWaitEventTime:           MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					MysqlInstanceEndpoint: ResourceAttributeConfig{Enabled: false},
@@ -85,6 +85,32 @@ var MapAttributeBufferPoolOperations = map[string]AttributeBufferPoolOperations{
 	"write_requests":     AttributeBufferPoolOperationsWriteRequests,
 }
 
+// AttributeBufferPoolPageAging specifies the a value buffer_pool_page_aging attribute.
+type AttributeBufferPoolPageAging int
+
+const (
+	_ AttributeBufferPoolPageAging = iota
+	AttributeBufferPoolPageAgingMadeYoung
+	AttributeBufferPoolPageAgingNotMadeYoung
+)
+
+// String returns the string representation of the AttributeBufferPoolPageAging.
+func (av AttributeBufferPoolPageAging) String() string {
+	switch av {
+	case AttributeBufferPoolPageAgingMadeYoung:
+		return "made_young"
+	case AttributeBufferPoolPageAgingNotMadeYoung:
+		return "not_made_young"
+	}
+	return ""
+}
+
+// MapAttributeBufferPoolPageAging is a helper map of string to AttributeBufferPoolPageAging attribute value.
+var MapAttributeBufferPoolPageAging = map[string]AttributeBufferPoolPageAging{
+	"made_young":     AttributeBufferPoolPageAgingMadeYoung,
+	"not_made_young": AttributeBufferPoolPageAgingNotMadeYoung,
+}
+
 // AttributeBufferPoolPages specifies the a value buffer_pool_pages attribute.
 type AttributeBufferPoolPages int
 
@@ -787,6 +813,32 @@ var MapAttributeReadLockType = map[string]AttributeReadLockType{
 	"external":          AttributeReadLockTypeExternal,
 }
 
+// AttributeReplicaThread specifies the a value replica_thread attribute.
+type AttributeReplicaThread int
+
+const (
+	_ AttributeReplicaThread = iota
+	AttributeReplicaThreadIo
+	AttributeReplicaThreadSQL
+)
+
+// String returns the string representation of the AttributeReplicaThread.
+func (av AttributeReplicaThread) String() string {
+	switch av {
+	case AttributeReplicaThreadIo:
+		return "io"
+	case AttributeReplicaThreadSQL:
+		return "sql"
+	}
+	return ""
+}
+
+// MapAttributeReplicaThread is a helper map of string to AttributeReplicaThread attribute value.
+var MapAttributeReplicaThread = map[string]AttributeReplicaThread{
+	"io":  AttributeReplicaThreadIo,
+	"sql": AttributeReplicaThreadSQL,
+}
+
 // AttributeRowLocks specifies the a value row_locks attribute.
 type AttributeRowLocks int
 
@@ -1140,6 +1192,59 @@ func newMetricMysqlBufferPoolOperations(cfg MetricConfig) metricMysqlBufferPoolO
 	return m
 }
 
+type metricMysqlBufferPoolPageAging struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.buffer_pool.page_aging metric with initial data.
+func (m *metricMysqlBufferPoolPageAging) init() {
+	m.data.SetName("mysql.buffer_pool.page_aging")
+	m.data.SetDescription("The number of pages made young or not made young in the InnoDB buffer pool LRU list.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlBufferPoolPageAging) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, bufferPoolPageAgingAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("kind", bufferPoolPageAgingAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlBufferPoolPageAging) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlBufferPoolPageAging) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlBufferPoolPageAging(cfg MetricConfig) metricMysqlBufferPoolPageAging {
+	m := metricMysqlBufferPoolPageAging{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlBufferPoolPageFlushes struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2406,6 +2511,59 @@ func newMetricMysqlReplicaSQLDelay(cfg MetricConfig) metricMysqlReplicaSQLDelay
 	return m
 }
 
+type metricMysqlReplicaThreadRunning struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.replica.thread.running metric with initial data.
+func (m *metricMysqlReplicaThreadRunning) init() {
+	m.data.SetName("mysql.replica.thread.running")
+	m.data.SetDescription("Whether the replication thread is running (1) or not (0).")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlReplicaThreadRunning) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaThreadAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("thread", replicaThreadAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlReplicaThreadRunning) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlReplicaThreadRunning) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlReplicaThreadRunning(cfg MetricConfig) metricMysqlReplicaThreadRunning {
+	m := metricMysqlReplicaThreadRunning{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlReplicaTimeBehindSource struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -3267,6 +3425,112 @@ func newMetricMysqlUptime(cfg MetricConfig) metricMysqlUptime {
 	return m
 }
 
+type metricMysqlWaitEventCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.wait_event.count metric with initial data.
+func (m *metricMysqlWaitEventCount) init() {
+	m.data.SetName("mysql.wait_event.count")
+	m.data.SetDescription("The total count of instrumented wait events.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlWaitEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, waitEventNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("event", waitEventNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlWaitEventCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlWaitEventCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlWaitEventCount(cfg MetricConfig) metricMysqlWaitEventCount {
+	m := metricMysqlWaitEventCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlWaitEventTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.wait_event.time metric with initial data.
+func (m *metricMysqlWaitEventTime) init() {
+	m.data.SetName("mysql.wait_event.time")
+	m.data.SetDescription("The total wait time of instrumented wait events.")
+	m.data.SetUnit("ns")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlWaitEventTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, waitEventNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("event", waitEventNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlWaitEventTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlWaitEventTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlWaitEventTime(cfg MetricConfig) metricMysqlWaitEventTime {
+	m := metricMysqlWaitEventTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -3279,6 +3543,7 @@ type MetricsBuilder struct {
 	metricMysqlBufferPoolDataPages     metricMysqlBufferPoolDataPages
 	metricMysqlBufferPoolLimit         metricMysqlBufferPoolLimit
 	metricMysqlBufferPoolOperations    metricMysqlBufferPoolOperations
+	metricMysqlBufferPoolPageAging     metricMysqlBufferPoolPageAging
 	metricMysqlBufferPoolPageFlushes   metricMysqlBufferPoolPageFlushes
 	metricMysqlBufferPoolPages         metricMysqlBufferPoolPages
 	metricMysqlBufferPoolUsage         metricMysqlBufferPoolUsage
@@ -3303,6 +3568,7 @@ type MetricsBuilder struct {
 	metricMysqlQueryCount              metricMysqlQueryCount
 	metricMysqlQuerySlowCount          metricMysqlQuerySlowCount
 	metricMysqlReplicaSQLDelay         metricMysqlReplicaSQLDelay
+	metricMysqlReplicaThreadRunning    metricMysqlReplicaThreadRunning
 	metricMysqlReplicaTimeBehindSource metricMysqlReplicaTimeBehindSource
 	metricMysqlRowLocks                metricMysqlRowLocks
 	metricMysqlRowOperations           metricMysqlRowOperations
@@ -3319,6 +3585,8 @@ type MetricsBuilder struct {
 	metricMysqlThreads                 metricMysqlThreads
 	metricMysqlTmpResources            metricMysqlTmpResources
 	metricMysqlUptime                  metricMysqlUptime
+	metricMysqlWaitEventCount          metricMysqlWaitEventCount
+	metricMysqlWaitEventTime           metricMysqlWaitEventTime
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -3340,6 +3608,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricMysqlBufferPoolDataPages:     newMetricMysqlBufferPoolDataPages(mbc.Metrics.MysqlBufferPoolDataPages),
 		metricMysqlBufferPoolLimit:         newMetricMysqlBufferPoolLimit(mbc.Metrics.MysqlBufferPoolLimit),
 		metricMysqlBufferPoolOperations:    newMetricMysqlBufferPoolOperations(mbc.Metrics.MysqlBufferPoolOperations),
+		metricMysqlBufferPoolPageAging:     newMetricMysqlBufferPoolPageAging(mbc.Metrics.MysqlBufferPoolPageAging),
 		metricMysqlBufferPoolPageFlushes:   newMetricMysqlBufferPoolPageFlushes(mbc.Metrics.MysqlBufferPoolPageFlushes),
 		metricMysqlBufferPoolPages:         newMetricMysqlBufferPoolPages(mbc.Metrics.MysqlBufferPoolPages),
 		metricMysqlBufferPoolUsage:         newMetricMysqlBufferPoolUsage(mbc.Metrics.MysqlBufferPoolUsage),
@@ -3364,6 +3633,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricMysqlQueryCount:              newMetricMysqlQueryCount(mbc.Metrics.MysqlQueryCount),
 		metricMysqlQuerySlowCount:          newMetricMysqlQuerySlowCount(mbc.Metrics.MysqlQuerySlowCount),
 		metricMysqlReplicaSQLDelay:         newMetricMysqlReplicaSQLDelay(mbc.Metrics.MysqlReplicaSQLDelay),
+		metricMysqlReplicaThreadRunning:    newMetricMysqlReplicaThreadRunning(mbc.Metrics.MysqlReplicaThreadRunning),
 		metricMysqlReplicaTimeBehindSource: newMetricMysqlReplicaTimeBehindSource(mbc.Metrics.MysqlReplicaTimeBehindSource),
 		metricMysqlRowLocks:                newMetricMysqlRowLocks(mbc.Metrics.MysqlRowLocks),
 		metricMysqlRowOperations:           newMetricMysqlRowOperations(mbc.Metrics.MysqlRowOperations),
@@ -3380,6 +3650,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricMysqlThreads:                 newMetricMysqlThreads(mbc.Metrics.MysqlThreads),
 		metricMysqlTmpResources:            newMetricMysqlTmpResources(mbc.Metrics.MysqlTmpResources),
 		metricMysqlUptime:                  newMetricMysqlUptime(mbc.Metrics.MysqlUptime),
+		metricMysqlWaitEventCount:          newMetricMysqlWaitEventCount(mbc.Metrics.MysqlWaitEventCount),
+		metricMysqlWaitEventTime:           newMetricMysqlWaitEventTime(mbc.Metrics.MysqlWaitEventTime),
 	}
 	for _, op := range options {
 		op(mb)
@@ -3444,6 +3716,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlBufferPoolDataPages.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolLimit.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolOperations.emit(ils.Metrics())
+	mb.metricMysqlBufferPoolPageAging.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolPageFlushes.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolPages.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolUsage.emit(ils.Metrics())
@@ -3468,6 +3741,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlQueryCount.emit(ils.Metrics())
 	mb.metricMysqlQuerySlowCount.emit(ils.Metrics())
 	mb.metricMysqlReplicaSQLDelay.emit(ils.Metrics())
+	mb.metricMysqlReplicaThreadRunning.emit(ils.Metrics())
 	mb.metricMysqlReplicaTimeBehindSource.emit(ils.Metrics())
 	mb.metricMysqlRowLocks.emit(ils.Metrics())
 	mb.metricMysqlRowOperations.emit(ils.Metrics())
@@ -3484,6 +3758,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlThreads.emit(ils.Metrics())
 	mb.metricMysqlTmpResources.emit(ils.Metrics())
 	mb.metricMysqlUptime.emit(ils.Metrics())
+	mb.metricMysqlWaitEventCount.emit(ils.Metrics())
+	mb.metricMysqlWaitEventTime.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(mb.resourceAttributesConfig, rm)
@@ -3529,6 +3805,11 @@ func (mb *MetricsBuilder) RecordMysqlBufferPoolOperationsDataPoint(ts pcommon.Ti
 	return nil
 }
 
+// RecordMysqlBufferPoolPageAgingDataPoint adds a data point to mysql.buffer_pool.page_aging metric.
+func (mb *MetricsBuilder) RecordMysqlBufferPoolPageAgingDataPoint(ts pcommon.Timestamp, val int64, bufferPoolPageAgingAttributeValue AttributeBufferPoolPageAging) {
+	mb.metricMysqlBufferPoolPageAging.recordDataPoint(mb.startTime, ts, val, bufferPoolPageAgingAttributeValue.String())
+}
+
 // RecordMysqlBufferPoolPageFlushesDataPoint adds a data point to mysql.buffer_pool.page_flushes metric.
 func (mb *MetricsBuilder) RecordMysqlBufferPoolPageFlushesDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -3749,6 +4030,11 @@ func (mb *MetricsBuilder) RecordMysqlReplicaSQLDelayDataPoint(ts pcommon.Timesta
 	mb.metricMysqlReplicaSQLDelay.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordMysqlReplicaThreadRunningDataPoint adds a data point to mysql.replica.thread.running metric.
+func (mb *MetricsBuilder) RecordMysqlReplicaThreadRunningDataPoint(ts pcommon.Timestamp, val int64, replicaThreadAttributeValue AttributeReplicaThread) {
+	mb.metricMysqlReplicaThreadRunning.recordDataPoint(mb.startTime, ts, val, replicaThreadAttributeValue.String())
+}
+
 // RecordMysqlReplicaTimeBehindSourceDataPoint adds a data point to mysql.replica.time_behind_source metric.
 func (mb *MetricsBuilder) RecordMysqlReplicaTimeBehindSourceDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricMysqlReplicaTimeBehindSource.recordDataPoint(mb.startTime, ts, val)
@@ -3864,6 +4150,16 @@ func (mb *MetricsBuilder) RecordMysqlUptimeDataPoint(ts pcommon.Timestamp, input
 	return nil
 }
 
+// RecordMysqlWaitEventCountDataPoint adds a data point to mysql.wait_event.count metric.
+func (mb *MetricsBuilder) RecordMysqlWaitEventCountDataPoint(ts pcommon.Timestamp, val int64, waitEventNameAttributeValue string) {
+	mb.metricMysqlWaitEventCount.recordDataPoint(mb.startTime, ts, val, waitEventNameAttributeValue)
+}
+
+// RecordMysqlWaitEventTimeDataPoint adds a data point to mysql.wait_event.time metric.
+func (mb *MetricsBuilder) RecordMysqlWaitEventTimeDataPoint(ts pcommon.Timestamp, val int64, waitEventNameAttributeValue string) {
+	mb.metricMysqlWaitEventTime.recordDataPoint(mb.startTime, ts, val, waitEventNameAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -75,40 +75,79 @@ func (m *mySQLScraper) scrape(context.Context) (pmetric.Metrics, error) {
 
 	now := pcommon.NewTimestampFromTime(time.Now())
 
-	// collect innodb metrics.
-	innodbStats, innoErr := m.sqlclient.getInnodbStats()
-	if innoErr != nil {
-		m.logger.Error("Failed to fetch InnoDB stats", zap.Error(innoErr))
-	}
-
 	errs := &scrapererror.ScrapeErrors{}
-	for k, v := range innodbStats {
-		if k != "buffer_pool_size" {
-			continue
-		}
-		addPartialIfError(errs, m.mb.RecordMysqlBufferPoolLimitDataPoint(now, v))
+
+	// collect innodb metrics.
+	if m.config.QueryGroups.InnodbBufferPool {
+		m.scrapeInnodbStats(now, errs)
+		m.scrapeInnodbBufferPoolStats(now, errs)
 	}
 
 	// collect io_waits metrics.
-	m.scrapeTableIoWaitsStats(now, errs)
-	m.scrapeIndexIoWaitsStats(now, errs)
+	if m.config.QueryGroups.TableIoWaits {
+		m.scrapeTableIoWaitsStats(now, errs)
+	}
+	if m.config.QueryGroups.IndexIoWaits {
+		m.scrapeIndexIoWaitsStats(now, errs)
+	}
 
 	// collect performance event statements metrics.
-	m.scrapeStatementEventsStats(now, errs)
+	if m.config.QueryGroups.StatementEvents {
+		m.scrapeStatementEventsStats(now, errs)
+	}
 	// collect lock table events metrics
-	m.scrapeTableLockWaitEventStats(now, errs)
+	if m.config.QueryGroups.TableLockWaits {
+		m.scrapeTableLockWaitEventStats(now, errs)
+	}
+	// collect wait events metrics
+	if m.config.QueryGroups.WaitEvents {
+		m.scrapeWaitEventStats(now, errs)
+	}
 
 	// collect global status metrics.
 	m.scrapeGlobalStats(now, errs)
 
 	// colect replicas status metrics.
-	m.scrapeReplicaStatusStats(now)
+	if m.config.QueryGroups.ReplicaStatus {
+		m.scrapeReplicaStatusStats(now)
+	}
 
 	m.mb.EmitForResource(metadata.WithMysqlInstanceEndpoint(m.config.Endpoint))
 
 	return m.mb.Emit(), errs.Combine()
 }
 
+func (m *mySQLScraper) scrapeInnodbStats(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	innodbStats, err := m.sqlclient.getInnodbStats()
+	if err != nil {
+		m.logger.Error("Failed to fetch InnoDB stats", zap.Error(err))
+	}
+
+	for k, v := range innodbStats {
+		if k != "buffer_pool_size" {
+			continue
+		}
+		addPartialIfError(errs, m.mb.RecordMysqlBufferPoolLimitDataPoint(now, v))
+	}
+}
+
+func (m *mySQLScraper) scrapeInnodbBufferPoolStats(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// the query is only worth running when its metric is enabled.
+	if !m.config.MetricsBuilderConfig.Metrics.MysqlBufferPoolPageAging.Enabled {
+		return
+	}
+
+	s, err := m.sqlclient.getInnodbBufferPoolStats()
+	if err != nil {
+		m.logger.Error("Failed to fetch InnoDB buffer pool stats", zap.Error(err))
+		errs.AddPartial(2, err)
+		return
+	}
+
+	m.mb.RecordMysqlBufferPoolPageAgingDataPoint(now, s.pagesMadeYoung, metadata.AttributeBufferPoolPageAgingMadeYoung)
+	m.mb.RecordMysqlBufferPoolPageAgingDataPoint(now, s.pagesNotMadeYoung, metadata.AttributeBufferPoolPageAgingNotMadeYoung)
+}
+
 func (m *mySQLScraper) scrapeGlobalStats(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	globalStats, err := m.sqlclient.getGlobalStats()
 	if err != nil {
@@ -537,6 +576,27 @@ func (m *mySQLScraper) scrapeTableLockWaitEventStats(now pcommon.Timestamp, errs
 	}
 }
 
+func (m *mySQLScraper) scrapeWaitEventStats(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// the query is only worth running when one of its metrics is enabled.
+	if !m.config.MetricsBuilderConfig.Metrics.MysqlWaitEventCount.Enabled &&
+		!m.config.MetricsBuilderConfig.Metrics.MysqlWaitEventTime.Enabled {
+		return
+	}
+
+	waitEventStats, err := m.sqlclient.getWaitEventStats()
+	if err != nil {
+		m.logger.Error("Failed to fetch wait event stats", zap.Error(err))
+		errs.AddPartial(2, err)
+		return
+	}
+
+	for i := 0; i < len(waitEventStats); i++ {
+		s := waitEventStats[i]
+		m.mb.RecordMysqlWaitEventCountDataPoint(now, s.countStar, s.name)
+		m.mb.RecordMysqlWaitEventTimeDataPoint(now, s.sumTimerWait/picosecondsInNanoseconds, s.name)
+	}
+}
+
 func (m *mySQLScraper) scrapeReplicaStatusStats(now pcommon.Timestamp) {
 	replicaStatusStats, err := m.sqlclient.getReplicaStatusStats()
 	if err != nil {
@@ -553,6 +613,9 @@ func (m *mySQLScraper) scrapeReplicaStatusStats(now pcommon.Timestamp) {
 		}
 
 		m.mb.RecordMysqlReplicaSQLDelayDataPoint(now, s.sqlDelay)
+
+		m.mb.RecordMysqlReplicaThreadRunningDataPoint(now, boolToInt(s.replicaIORunning == "Yes"), metadata.AttributeReplicaThreadIo)
+		m.mb.RecordMysqlReplicaThreadRunningDataPoint(now, boolToInt(s.replicaSQLRunning == "Yes"), metadata.AttributeReplicaThreadSQL)
 	}
 }
 
@@ -594,6 +657,14 @@ func (m *mySQLScraper) recordDataUsage(now pcommon.Timestamp, globalStats map[st
 	m.mb.RecordMysqlBufferPoolUsageDataPoint(now, data-dirty, metadata.AttributeBufferPoolDataClean)
 }
 
+// boolToInt converts a bool to 1 or 0.
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 // parseInt converts string to int64.
 func parseInt(value string) (int64, error) {
 	return strconv.ParseInt(value, 10, 64)
@@ -50,18 +50,25 @@ func TestScrape(t *testing.T) {
 
 		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaSQLDelay.Enabled = true
 		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaTimeBehindSource.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaThreadRunning.Enabled = true
 
 		cfg.MetricsBuilderConfig.Metrics.MysqlConnectionCount.Enabled = true
 
+		cfg.MetricsBuilderConfig.Metrics.MysqlBufferPoolPageAging.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlWaitEventCount.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlWaitEventTime.Enabled = true
+
 		scraper := newMySQLScraper(receivertest.NewNopCreateSettings(), cfg)
 		scraper.sqlclient = &mockClient{
 			globalStatsFile:             "global_stats",
 			innodbStatsFile:             "innodb_stats",
+			innodbBufferPoolStatsFile:   "innodb_buffer_pool_stats",
 			tableIoWaitsFile:            "table_io_waits_stats",
 			indexIoWaitsFile:            "index_io_waits_stats",
 			statementEventsFile:         "statement_events",
 			tableLockWaitEventStatsFile: "table_lock_wait_event_stats",
 			replicaStatusFile:           "replica_stats",
+			waitEventStatsFile:          "wait_event_stats",
 		}
 
 		scraper.renameCommands = true
@@ -94,11 +101,13 @@ func TestScrape(t *testing.T) {
 		scraper.sqlclient = &mockClient{
 			globalStatsFile:             "global_stats_partial",
 			innodbStatsFile:             "innodb_stats_empty",
+			innodbBufferPoolStatsFile:   "innodb_buffer_pool_stats_empty",
 			tableIoWaitsFile:            "table_io_waits_stats_empty",
 			indexIoWaitsFile:            "index_io_waits_stats_empty",
 			statementEventsFile:         "statement_events_empty",
 			tableLockWaitEventStatsFile: "table_lock_wait_event_stats_empty",
 			replicaStatusFile:           "replica_stats_empty",
+			waitEventStatsFile:          "wait_event_stats_empty",
 		}
 
 		actualMetrics, scrapeErr := scraper.scrape(context.Background())
@@ -118,6 +127,34 @@ func TestScrape(t *testing.T) {
 		require.Equal(t, partialError.Failed, 5, "Expected partial error count to be 5")
 	})
 
+	t.Run("query groups disabled", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaTimeBehindSource.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlBufferPoolPageAging.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlWaitEventCount.Enabled = true
+		cfg.QueryGroups = QueryGroupsConfig{}
+
+		scraper := newMySQLScraper(receivertest.NewNopCreateSettings(), cfg)
+		// the mock fails reading the files of the queries that are not expected to run.
+		scraper.sqlclient = &mockClient{
+			globalStatsFile: "global_stats",
+		}
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		ms := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < ms.Len(); i++ {
+			assert.NotContains(t, []string{
+				"mysql.buffer_pool.limit",
+				"mysql.buffer_pool.page_aging",
+				"mysql.table.io.wait.count",
+				"mysql.index.io.wait.count",
+				"mysql.replica.time_behind_source",
+				"mysql.wait_event.count",
+			}, ms.At(i).Name())
+		}
+	})
 }
 
 var _ client = (*mockClient)(nil)
@@ -125,11 +162,13 @@ var _ client = (*mockClient)(nil)
 type mockClient struct {
 	globalStatsFile             string
 	innodbStatsFile             string
+	innodbBufferPoolStatsFile   string
 	tableIoWaitsFile            string
 	indexIoWaitsFile            string
 	statementEventsFile         string
 	tableLockWaitEventStatsFile string
 	replicaStatusFile           string
+	waitEventStatsFile          string
 }
 
 func readFile(fname string) (map[string]string, error) {
@@ -164,6 +203,24 @@ func (c *mockClient) getInnodbStats() (map[string]string, error) {
 	return readFile(c.innodbStatsFile)
 }
 
+func (c *mockClient) getInnodbBufferPoolStats() (innodbBufferPoolStats, error) {
+	var s innodbBufferPoolStats
+	file, err := os.Open(filepath.Join("testdata", "scraper", c.innodbBufferPoolStatsFile+".txt"))
+	if err != nil {
+		return s, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	if scanner.Scan() {
+		text := strings.Split(scanner.Text(), "\t")
+
+		s.pagesMadeYoung, _ = parseInt(text[0])
+		s.pagesNotMadeYoung, _ = parseInt(text[1])
+	}
+	return s, nil
+}
+
 func (c *mockClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 	var stats []TableIoWaitsStats
 	file, err := os.Open(filepath.Join("testdata", "scraper", c.tableIoWaitsFile+".txt"))
@@ -378,6 +435,28 @@ func (c *mockClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 	return stats, nil
 }
 
+func (c *mockClient) getWaitEventStats() ([]waitEventStats, error) {
+	var stats []waitEventStats
+	file, err := os.Open(filepath.Join("testdata", "scraper", c.waitEventStatsFile+".txt"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var s waitEventStats
+		text := strings.Split(scanner.Text(), "\t")
+
+		s.name = text[0]
+		s.countStar, _ = parseInt(text[1])
+		s.sumTimerWait, _ = parseInt(text[2])
+
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
 func (c *mockClient) Close() error {
 	return nil
 }
@@ -146,6 +146,26 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, testdataConfigYamlAsMap(), cfg)
 }
 
+func TestLoadConfigMoveAfterRead(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "move").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.Include = []string{"/var/spool/otlp/*.json"}
+	expected.StartAt = "beginning"
+	expected.MoveAfterRead = "/var/spool/otlp/done"
+	expected.AfterReadIdle = 10 * time.Second
+	storageID := component.NewID("file_storage")
+	expected.StorageID = &storageID
+	assert.Equal(t, expected, cfg)
+}
+
 func TestFileMixedSignals(t *testing.T) {
 	tempFolder := t.TempDir()
 	factory := NewFactory()
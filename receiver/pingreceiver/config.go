@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver/internal/metadata"
+)
+
+const (
+	defaultCount    = 3
+	defaultInterval = time.Second
+	defaultTimeout  = time.Second
+	defaultSize     = 56
+	// maxSize is the largest payload fitting in an IPv4 packet
+	maxSize = 65507 - 8
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingHost     = errors.New(`"host" must be specified`)
+	errInvalidCount    = errors.New(`"count" must not be negative`)
+	errInvalidInterval = errors.New(`"interval" must not be negative`)
+	errInvalidTimeout  = errors.New(`"timeout" must not be negative`)
+	errInvalidSize     = errors.New(`"size" must be between 0 and 65499`)
+	errProbeTooLong    = errors.New(`the probes of a target must not take longer than "collection_interval"`)
+)
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	metadata.MetricsBuilderConfig           `mapstructure:",squash"`
+	Targets                                 []*targetConfig `mapstructure:"targets"`
+}
+
+type targetConfig struct {
+	// Host is the host name or IP address the echo requests are sent to.
+	Host string `mapstructure:"host"`
+	// Count is the number of echo requests sent on every scrape.
+	Count int `mapstructure:"count"`
+	// Interval is the time between two echo requests.
+	Interval time.Duration `mapstructure:"interval"`
+	// Timeout is the time an echo reply is waited for.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Size is the size of the payload of the echo requests.
+	Size int `mapstructure:"size"`
+	// Privileged sends the echo requests on a raw socket, which requires
+	// CAP_NET_RAW, instead of an unprivileged ICMP datagram socket.
+	Privileged *bool `mapstructure:"privileged"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *targetConfig) Validate() error {
+	var err error
+
+	if cfg.Host == "" {
+		err = multierr.Append(err, errMissingHost)
+	}
+	if cfg.Count < 0 {
+		err = multierr.Append(err, errInvalidCount)
+	}
+	if cfg.Interval < 0 {
+		err = multierr.Append(err, errInvalidInterval)
+	}
+	if cfg.Timeout < 0 {
+		err = multierr.Append(err, errInvalidTimeout)
+	}
+	if cfg.Size < 0 || cfg.Size > maxSize {
+		err = multierr.Append(err, errInvalidSize)
+	}
+
+	return err
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	var err error
+
+	if len(cfg.Targets) == 0 {
+		err = multierr.Append(err, errors.New("no targets configured"))
+	}
+
+	for _, target := range cfg.Targets {
+		targetErr := target.Validate()
+		err = multierr.Append(err, targetErr)
+		if targetErr == nil && target.duration() > cfg.CollectionInterval {
+			err = multierr.Append(err, errProbeTooLong)
+		}
+	}
+
+	return err
+}
+
+func (cfg *targetConfig) count() int {
+	if cfg.Count == 0 {
+		return defaultCount
+	}
+	return cfg.Count
+}
+
+func (cfg *targetConfig) interval() time.Duration {
+	if cfg.Interval == 0 {
+		return defaultInterval
+	}
+	return cfg.Interval
+}
+
+func (cfg *targetConfig) timeout() time.Duration {
+	if cfg.Timeout == 0 {
+		return defaultTimeout
+	}
+	return cfg.Timeout
+}
+
+func (cfg *targetConfig) size() int {
+	if cfg.Size == 0 {
+		return defaultSize
+	}
+	return cfg.Size
+}
+
+func (cfg *targetConfig) privileged() bool {
+	return cfg.Privileged == nil || *cfg.Privileged
+}
+
+// duration returns the maximum duration of a probe: the last echo request is
+// sent after count-1 intervals and its reply is waited for until the timeout.
+func (cfg *targetConfig) duration() time.Duration {
+	return time.Duration(cfg.count()-1)*cfg.interval() + cfg.timeout()
+}
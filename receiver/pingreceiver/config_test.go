@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	privileged := false
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.CollectionInterval = 30 * time.Second
+	expected.Targets = []*targetConfig{
+		{Host: "opentelemetry.io"},
+		{
+			Host:       "10.0.0.1",
+			Count:      5,
+			Interval:   200 * time.Millisecond,
+			Timeout:    2 * time.Second,
+			Size:       1400,
+			Privileged: &privileged,
+		},
+	}
+	require.Equal(t, expected, cfg)
+}
+
+func TestValidate(t *testing.T) {
+	scraperSettings := scraperhelper.ScraperControllerSettings{CollectionInterval: 10 * time.Second}
+
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc:        "no targets",
+			cfg:         &Config{ScraperControllerSettings: scraperSettings},
+			expectedErr: fmt.Errorf("no targets configured"),
+		},
+		{
+			desc: "missing host",
+			cfg: &Config{
+				ScraperControllerSettings: scraperSettings,
+				Targets:                   []*targetConfig{{Count: 2}},
+			},
+			expectedErr: multierr.Combine(
+				errMissingHost,
+			),
+		},
+		{
+			desc: "invalid settings",
+			cfg: &Config{
+				ScraperControllerSettings: scraperSettings,
+				Targets: []*targetConfig{
+					{Host: "opentelemetry.io", Count: -1, Interval: -time.Second},
+					{Host: "10.0.0.1", Timeout: -time.Second, Size: 70000},
+				},
+			},
+			expectedErr: multierr.Combine(
+				errInvalidCount,
+				errInvalidInterval,
+				errInvalidTimeout,
+				errInvalidSize,
+			),
+		},
+		{
+			desc: "probe longer than collection interval",
+			cfg: &Config{
+				ScraperControllerSettings: scraperSettings,
+				Targets:                   []*targetConfig{{Host: "opentelemetry.io", Count: 11}},
+			},
+			expectedErr: multierr.Combine(
+				errProbeTooLong,
+			),
+		},
+		{
+			desc: "valid config",
+			cfg: &Config{
+				ScraperControllerSettings: scraperSettings,
+				Targets: []*targetConfig{
+					{Host: "opentelemetry.io"},
+					{Host: "10.0.0.1", Count: 10, Interval: 500 * time.Millisecond, Timeout: 2 * time.Second},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	protocolICMP     = 1
+	protocolIPv6ICMP = 58
+)
+
+// probeResult is the outcome of the echo requests sent to a target.
+type probeResult struct {
+	address string
+	sent    int
+	// rtts are the round-trip times of the echo requests which were replied
+	// to, in the order they were sent.
+	rtts []time.Duration
+}
+
+// probeFunc sends the echo requests of a probe to a target.
+type probeFunc func(ctx context.Context, target *targetConfig) (*probeResult, error)
+
+// probe sends count echo requests to the target, one every interval, and
+// waits for each reply until the timeout.
+func probe(ctx context.Context, target *targetConfig) (*probeResult, error) {
+	ip, err := resolve(ctx, target.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	network, listenAddr, protocol := "ip4:icmp", "0.0.0.0", protocolICMP
+	var requestType, replyType icmp.Type = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	if ip.To4() == nil {
+		network, listenAddr, protocol = "ip6:ipv6-icmp", "::", protocolIPv6ICMP
+		requestType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+	var dst net.Addr = &net.IPAddr{IP: ip}
+	if !target.privileged() {
+		// the kernel replaces the identifier of the echo requests sent on
+		// datagram sockets and only delivers the replies to their socket
+		network = "udp4"
+		if ip.To4() == nil {
+			network = "udp6"
+		}
+		dst = &net.UDPAddr{IP: ip}
+	}
+
+	conn, err := icmp.ListenPacket(network, listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the ICMP socket: %w", err)
+	}
+	defer conn.Close()
+
+	result := &probeResult{address: ip.String()}
+	id := rand.Intn(math.MaxUint16 + 1) // nolint:gosec
+	payload := make([]byte, target.size())
+	buf := make([]byte, 1500)
+	for seq := 0; seq < target.count(); seq++ {
+		if seq > 0 {
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-time.After(target.interval()):
+			}
+		}
+
+		msg := icmp.Message{
+			Type: requestType,
+			Body: &icmp.Echo{ID: id, Seq: seq, Data: payload},
+		}
+		var b []byte
+		if b, err = msg.Marshal(nil); err != nil {
+			return result, err
+		}
+		sentAt := time.Now()
+		if _, err = conn.WriteTo(b, dst); err != nil {
+			return result, fmt.Errorf("failed to send the echo request: %w", err)
+		}
+		result.sent++
+
+		deadline := sentAt.Add(target.timeout())
+		if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+			deadline = ctxDeadline
+		}
+		if err = conn.SetReadDeadline(deadline); err != nil {
+			return result, err
+		}
+		var replied bool
+		if replied, err = waitReply(conn, buf, protocol, replyType, id, seq, ip, target.privileged()); err != nil {
+			return result, err
+		}
+		if replied {
+			result.rtts = append(result.rtts, time.Since(sentAt))
+		}
+	}
+	return result, nil
+}
+
+// waitReply reads the ICMP messages until the reply to the echo request or
+// the read deadline, and returns whether the reply was received.
+func waitReply(conn *icmp.PacketConn, buf []byte, protocol int, replyType icmp.Type, id, seq int, ip net.IP, privileged bool) (bool, error) {
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to read the echo reply: %w", err)
+		}
+
+		msg, err := icmp.ParseMessage(protocol, buf[:n])
+		if err != nil || msg.Type != replyType {
+			continue
+		}
+		echo, ok := msg.Body.(*icmp.Echo)
+		if !ok || echo.Seq != seq {
+			continue
+		}
+		// raw sockets receive the ICMP messages of all the processes of the host
+		if privileged && (echo.ID != id || !peerIP(peer).Equal(ip)) {
+			continue
+		}
+		return true, nil
+	}
+}
+
+func peerIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.IPAddr:
+		return a.IP
+	case *net.UDPAddr:
+		return a.IP
+	}
+	return nil
+}
+
+// resolve returns the IP address of the host, IPv4 addresses are preferred.
+func resolve(ctx context.Context, host string) (net.IP, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip, nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		if addr.IP.To4() != nil {
+			return addr.IP, nil
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no address found for %s", host)
+	}
+	return addrs[0].IP, nil
+}
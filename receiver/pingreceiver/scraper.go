@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver/internal/metadata"
+)
+
+type pingScraper struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+	probe    probeFunc
+	// sent and received are the numbers of packets of every target since the
+	// start of the receiver.
+	sent     []int64
+	received []int64
+}
+
+// scrape probes the targets and produces metrics based on the replies
+func (s *pingScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var wg sync.WaitGroup
+	wg.Add(len(s.cfg.Targets))
+	var mux sync.Mutex
+
+	for idx, target := range s.cfg.Targets {
+		go func(tc *targetConfig, targetIndex int) {
+			defer wg.Done()
+
+			now := pcommon.NewTimestampFromTime(time.Now())
+			result, err := s.probe(ctx, tc)
+
+			mux.Lock()
+			defer mux.Unlock()
+			if err != nil {
+				s.mb.RecordPingErrorDataPoint(now, int64(1), tc.Host, err.Error())
+			}
+			if result == nil || result.sent == 0 {
+				return
+			}
+
+			s.sent[targetIndex] += int64(result.sent)
+			s.received[targetIndex] += int64(len(result.rtts))
+			s.mb.RecordPingPacketsSentDataPoint(now, s.sent[targetIndex], tc.Host, result.address)
+			s.mb.RecordPingPacketsReceivedDataPoint(now, s.received[targetIndex], tc.Host, result.address)
+
+			stats := result.stats()
+			s.mb.RecordPingPacketLossDataPoint(now, stats.loss, tc.Host, result.address)
+			if len(result.rtts) == 0 {
+				return
+			}
+			s.mb.RecordPingRttMinDataPoint(now, stats.min, tc.Host, result.address)
+			s.mb.RecordPingRttAvgDataPoint(now, stats.avg, tc.Host, result.address)
+			s.mb.RecordPingRttMaxDataPoint(now, stats.max, tc.Host, result.address)
+			s.mb.RecordPingRttStddevDataPoint(now, stats.stddev, tc.Host, result.address)
+			if len(result.rtts) > 1 {
+				s.mb.RecordPingJitterDataPoint(now, stats.jitter, tc.Host, result.address)
+			}
+		}(target, idx)
+	}
+
+	wg.Wait()
+
+	return s.mb.Emit(), nil
+}
+
+// probeStats are the statistics of a probe, the durations are in milliseconds.
+type probeStats struct {
+	min    float64
+	avg    float64
+	max    float64
+	stddev float64
+	jitter float64
+	loss   float64
+}
+
+func (r *probeResult) stats() probeStats {
+	stats := probeStats{loss: 1 - float64(len(r.rtts))/float64(r.sent)}
+	if len(r.rtts) == 0 {
+		return stats
+	}
+
+	ms := make([]float64, len(r.rtts))
+	for i, rtt := range r.rtts {
+		ms[i] = float64(rtt) / float64(time.Millisecond)
+	}
+
+	stats.min, stats.max = ms[0], ms[0]
+	var sum float64
+	for _, v := range ms {
+		stats.min = math.Min(stats.min, v)
+		stats.max = math.Max(stats.max, v)
+		sum += v
+	}
+	stats.avg = sum / float64(len(ms))
+
+	var squares, diffs float64
+	for i, v := range ms {
+		squares += (v - stats.avg) * (v - stats.avg)
+		if i > 0 {
+			diffs += math.Abs(v - ms[i-1])
+		}
+	}
+	stats.stddev = math.Sqrt(squares / float64(len(ms)))
+	if len(ms) > 1 {
+		stats.jitter = diffs / float64(len(ms)-1)
+	}
+	return stats
+}
+
+func newScraper(conf *Config, settings receiver.CreateSettings) *pingScraper {
+	return &pingScraper{
+		cfg:      conf,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(conf.MetricsBuilderConfig, settings),
+		probe:    probe,
+		sent:     make([]int64, len(conf.Targets)),
+		received: make([]int64, len(conf.Targets)),
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+func mockProbe(_ context.Context, target *targetConfig) (*probeResult, error) {
+	switch target.Host {
+	case "example.com":
+		return &probeResult{
+			address: "93.184.216.34",
+			sent:    4,
+			rtts:    []time.Duration{10 * time.Millisecond, 14 * time.Millisecond},
+		}, nil
+	case "10.0.0.1":
+		return &probeResult{address: "10.0.0.1", sent: 3}, nil
+	default:
+		return nil, errors.New("failed to open the ICMP socket: operation not permitted")
+	}
+}
+
+func TestScraperScrape(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{
+		{Host: "example.com"},
+		{Host: "10.0.0.1"},
+		{Host: "unreachable.example.com"},
+	}
+	cfg.Metrics.PingPacketsReceived.Enabled = true
+	cfg.Metrics.PingPacketsSent.Enabled = true
+	cfg.Metrics.PingRttStddev.Enabled = true
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	scraper.probe = mockProbe
+
+	// the numbers of packets are accumulated over the scrapes
+	_, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "expected_metrics", "all_metrics.yaml"))
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp(),
+	))
+}
+
+func TestProbeStats(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		result   *probeResult
+		expected probeStats
+	}{
+		{
+			desc:     "all lost",
+			result:   &probeResult{sent: 3},
+			expected: probeStats{loss: 1},
+		},
+		{
+			desc:     "single reply",
+			result:   &probeResult{sent: 1, rtts: []time.Duration{1500 * time.Microsecond}},
+			expected: probeStats{min: 1.5, avg: 1.5, max: 1.5},
+		},
+		{
+			desc: "partial loss",
+			result: &probeResult{sent: 5, rtts: []time.Duration{
+				20 * time.Millisecond,
+				10 * time.Millisecond,
+				30 * time.Millisecond,
+				20 * time.Millisecond,
+			}},
+			expected: probeStats{min: 10, avg: 20, max: 30, stddev: 7.0710678118654755, jitter: 40.0 / 3, loss: 0.2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			stats := tc.result.stats()
+			assert.InDelta(t, tc.expected.min, stats.min, 1e-9)
+			assert.InDelta(t, tc.expected.avg, stats.avg, 1e-9)
+			assert.InDelta(t, tc.expected.max, stats.max, 1e-9)
+			assert.InDelta(t, tc.expected.stddev, stats.stddev, 1e-9)
+			assert.InDelta(t, tc.expected.jitter, stats.jitter, 1e-9)
+			assert.InDelta(t, tc.expected.loss, stats.loss, 1e-9)
+		})
+	}
+}
+
+func TestProbeLoopback(t *testing.T) {
+	privileged := false
+	target := &targetConfig{Host: "127.0.0.1", Count: 2, Interval: 10 * time.Millisecond, Privileged: &privileged}
+
+	result, err := probe(context.Background(), target)
+	if err != nil {
+		// unprivileged ICMP sockets are only allowed to the groups of net.ipv4.ping_group_range
+		t.Skipf("cannot send echo requests: %v", err)
+	}
+	assert.Equal(t, "127.0.0.1", result.address)
+	assert.Equal(t, 2, result.sent)
+	assert.Len(t, result.rtts, 2)
+}
+
+func TestScraperDefaultMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{{Host: "10.0.0.1"}}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	scraper.probe = mockProbe
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// only the packet loss is reported when no echo request is replied to
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	assert.Equal(t, "ping.packet_loss", metrics.At(0).Name())
+	assert.Equal(t, 1.0, metrics.At(0).Gauge().DataPoints().At(0).DoubleValue())
+}
@@ -5,6 +5,8 @@ package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
+	"path/filepath"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -26,6 +28,24 @@ type Config struct {
 	APIVersion    string              `mapstructure:"api_version"`
 	SSHKey        string              `mapstructure:"ssh_key"`
 	SSHPassphrase configopaque.String `mapstructure:"ssh_passphrase"`
+
+	// RootlessSockets configures the discovery of the sockets of the rootless Podman services of the users.
+	// The containers of the discovered services are monitored along with the containers of the endpoint.
+	RootlessSockets RootlessSocketsConfig `mapstructure:"rootless_sockets"`
+
+	// AggregateByPod reports the sum of the metrics of the containers of each pod as the metrics of the pod,
+	// instead of the metrics of its containers. The containers outside of a pod are reported as is.
+	AggregateByPod bool `mapstructure:"aggregate_by_pod"`
+}
+
+// RootlessSocketsConfig configures the discovery of the rootless Podman sockets.
+type RootlessSocketsConfig struct {
+	// Enabled enables the discovery of the rootless sockets.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Pattern is the glob pattern of the paths of the rootless sockets.
+	// Default is "/run/user/*/podman/podman.sock"
+	Pattern string `mapstructure:"pattern"`
 }
 
 func (config Config) Validate() error {
@@ -35,5 +55,13 @@ func (config Config) Validate() error {
 	if config.CollectionInterval == 0 {
 		return errors.New("config.CollectionInterval must be specified")
 	}
+	if config.RootlessSockets.Enabled {
+		if config.RootlessSockets.Pattern == "" {
+			return errors.New("config.RootlessSockets.Pattern must be specified")
+		}
+		if _, err := filepath.Match(config.RootlessSockets.Pattern, ""); err != nil {
+			return fmt.Errorf("config.RootlessSockets.Pattern is invalid: %w", err)
+		}
+	}
 	return nil
 }
@@ -38,6 +38,9 @@ func TestLoadConfig(t *testing.T) {
 				APIVersion: defaultAPIVersion,
 				Endpoint:   "unix:///run/podman/podman.sock",
 				Timeout:    5 * time.Second,
+				RootlessSockets: RootlessSocketsConfig{
+					Pattern: defaultRootlessPattern,
+				},
 			},
 		},
 		{
@@ -50,6 +53,11 @@ func TestLoadConfig(t *testing.T) {
 				APIVersion: defaultAPIVersion,
 				Endpoint:   "http://example.com/",
 				Timeout:    20 * time.Second,
+				RootlessSockets: RootlessSocketsConfig{
+					Enabled: true,
+					Pattern: "/home/*/.podman.sock",
+				},
+				AggregateByPod: true,
 			},
 		},
 	}
@@ -68,3 +76,13 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.RootlessSockets.Enabled = true
+	cfg.RootlessSockets.Pattern = ""
+	assert.EqualError(t, cfg.Validate(), "config.RootlessSockets.Pattern must be specified")
+
+	cfg.RootlessSockets.Pattern = "/run/user/[/podman.sock"
+	assert.EqualError(t, cfg.Validate(), "config.RootlessSockets.Pattern is invalid: syntax error in pattern")
+}
@@ -16,7 +16,8 @@ import (
 )
 
 const (
-	defaultAPIVersion = "3.3.1"
+	defaultAPIVersion      = "3.3.1"
+	defaultRootlessPattern = "/run/user/*/podman/podman.sock"
 )
 
 func NewFactory() rcvr.Factory {
@@ -35,6 +36,9 @@ func createDefaultConfig() *Config {
 		Endpoint:                  "unix:///run/podman/podman.sock",
 		Timeout:                   5 * time.Second,
 		APIVersion:                defaultAPIVersion,
+		RootlessSockets: RootlessSocketsConfig{
+			Pattern: defaultRootlessPattern,
+		},
 	}
 }
 
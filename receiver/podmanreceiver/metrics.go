@@ -15,6 +15,11 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 )
 
+const (
+	podIDAttribute   = "podman.pod.id"
+	podNameAttribute = "podman.pod.name"
+)
+
 type point struct {
 	intVal     uint64
 	doubleVal  float64
@@ -42,6 +47,56 @@ func containerStatsToMetrics(ts time.Time, container container, stats *container
 	return md
 }
 
+// podStatsToMetrics reports the sum of the stats of the containers of a pod as the metrics of the pod.
+func podStatsToMetrics(ts time.Time, podID string, podName string, stats []*containerStats) pmetric.Metrics {
+	pbts := pcommon.NewTimestampFromTime(ts)
+
+	md := pmetric.NewMetrics()
+	rs := md.ResourceMetrics().AppendEmpty()
+
+	resourceAttr := rs.Resource().Attributes()
+	resourceAttr.PutStr(conventions.AttributeContainerRuntime, "podman")
+	resourceAttr.PutStr(podIDAttribute, podID)
+	resourceAttr.PutStr(podNameAttribute, podName)
+
+	podStats := sumContainerStats(stats)
+	ms := rs.ScopeMetrics().AppendEmpty().Metrics()
+	appendIOMetrics(ms, podStats, pbts)
+	appendCPUMetrics(ms, podStats, pbts)
+	appendNetworkMetrics(ms, podStats, pbts)
+	appendMemoryMetrics(ms, podStats, pbts)
+
+	return md
+}
+
+// sumContainerStats sums the stats of containers, the memory percentage is computed from the sums
+// of the memory usages and limits.
+func sumContainerStats(stats []*containerStats) *containerStats {
+	sum := &containerStats{}
+	for _, s := range stats {
+		sum.CPU += s.CPU
+		sum.CPUNano += s.CPUNano
+		sum.CPUSystemNano += s.CPUSystemNano
+		sum.MemUsage += s.MemUsage
+		sum.MemLimit += s.MemLimit
+		sum.NetInput += s.NetInput
+		sum.NetOutput += s.NetOutput
+		sum.BlockInput += s.BlockInput
+		sum.BlockOutput += s.BlockOutput
+		sum.PIDs += s.PIDs
+		for i, cpu := range s.PerCPU {
+			if i == len(sum.PerCPU) {
+				sum.PerCPU = append(sum.PerCPU, 0)
+			}
+			sum.PerCPU[i] += cpu
+		}
+	}
+	if sum.MemLimit > 0 {
+		sum.MemPerc = float64(sum.MemUsage) / float64(sum.MemLimit) * 100
+	}
+	return sum
+}
+
 func appendMemoryMetrics(ms pmetric.MetricSlice, stats *containerStats, ts pcommon.Timestamp) {
 	gaugeI(ms, "memory.usage.limit", "By", []point{{intVal: stats.MemLimit}}, ts)
 	gaugeI(ms, "memory.usage.total", "By", []point{{intVal: stats.MemUsage}}, ts)
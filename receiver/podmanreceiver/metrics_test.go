@@ -134,3 +134,45 @@ func genContainerStats() *containerStats {
 		PIDs:          3,
 	}
 }
+
+func TestTranslatePodStatsToMetrics(t *testing.T) {
+	first := genContainerStats()
+	second := genContainerStats()
+	second.PerCPU = []uint64{10, 10}
+	second.MemUsage = 13
+	second.MemLimit = 300
+
+	md := podStatsToMetrics(time.Now(), "pod1234", "podA", []*containerStats{first, second})
+	assert.Equal(t, 1, md.ResourceMetrics().Len())
+	rsm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"container.runtime": "podman",
+		"podman.pod.id":     "pod1234",
+		"podman.pod.name":   "podA",
+	}, rsm.Resource().Attributes().AsRaw())
+
+	metrics := rsm.ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, 11, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		switch m.Name() {
+		case "container.memory.usage.total":
+			assertMetricEqual(t, m, pmetric.MetricTypeGauge, []point{{intVal: 100}})
+		case "container.memory.usage.limit":
+			assertMetricEqual(t, m, pmetric.MetricTypeGauge, []point{{intVal: 500}})
+		case "container.memory.percent":
+			assertMetricEqual(t, m, pmetric.MetricTypeGauge, []point{{doubleVal: 20}})
+		case "container.cpu.usage.total":
+			assertMetricEqual(t, m, pmetric.MetricTypeSum, []point{{intVal: 2 * first.CPUNano}})
+		case "container.network.io.usage.tx_bytes":
+			assertMetricEqual(t, m, pmetric.MetricTypeSum, []point{{intVal: 2 * first.NetInput}})
+		case "container.cpu.usage.percpu":
+			assertMetricEqual(t, m, pmetric.MetricTypeSum, []point{
+				{intVal: 50, attributes: map[string]string{"core": "cpu0"}},
+				{intVal: 60, attributes: map[string]string{"core": "cpu1"}},
+				{intVal: 20, attributes: map[string]string{"core": "cpu2"}},
+				{intVal: 15, attributes: map[string]string{"core": "cpu3"}},
+			})
+		}
+	}
+}
@@ -9,6 +9,7 @@ package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver/internal/metadata"
 )
@@ -28,6 +30,12 @@ type receiver struct {
 	set           rcvr.CreateSettings
 	clientFactory clientFactory
 	scraper       *ContainerScraper
+
+	// rootless are the scrapers of the discovered rootless sockets, by socket path.
+	rootless map[string]*ContainerScraper
+	// ctx is the context of the event loops, cancelled on shutdown.
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 func newReceiver(
@@ -50,9 +58,10 @@ func newReceiver(
 		config:        config,
 		clientFactory: clientFactory,
 		set:           set,
+		rootless:      make(map[string]*ContainerScraper),
 	}
 
-	scrp, err := scraperhelper.NewScraper(metadata.Type, recv.scrape, scraperhelper.WithStart(recv.start))
+	scrp, err := scraperhelper.NewScraper(metadata.Type, recv.scrape, scraperhelper.WithStart(recv.start), scraperhelper.WithShutdown(recv.shutdown))
 	if err != nil {
 		return nil, err
 	}
@@ -70,38 +79,97 @@ func (r *receiver) start(ctx context.Context, _ component.Host) error {
 	if err = r.scraper.loadContainerList(ctx); err != nil {
 		return err
 	}
-	go r.scraper.containerEventLoop(ctx)
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	go r.scraper.containerEventLoop(r.ctx)
+
+	if r.config.RootlessSockets.Enabled {
+		r.discoverRootlessSockets(ctx)
+	}
+	return nil
+}
+
+func (r *receiver) shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
 	return nil
 }
 
+// discoverRootlessSockets starts monitoring the containers of the rootless sockets
+// which have appeared since the last discovery. The sockets which can't be reached
+// are skipped until the next discovery.
+func (r *receiver) discoverRootlessSockets(ctx context.Context) {
+	paths, err := filepath.Glob(r.config.RootlessSockets.Pattern)
+	if err != nil {
+		r.set.Logger.Error("Failed to discover the rootless Podman sockets", zap.Error(err))
+		return
+	}
+	for _, path := range paths {
+		endpoint := "unix://" + path
+		if _, ok := r.rootless[path]; ok || endpoint == r.config.Endpoint {
+			continue
+		}
+
+		cfg := *r.config
+		cfg.Endpoint = endpoint
+		podmanClient, err := r.clientFactory(r.set.Logger, &cfg)
+		if err != nil {
+			r.set.Logger.Debug("Failed to connect to the rootless Podman socket", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		scraper := newContainerScraper(podmanClient, r.set.Logger, &cfg)
+		if err = scraper.loadContainerList(ctx); err != nil {
+			r.set.Logger.Debug("Failed to list the containers of the rootless Podman socket", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		r.set.Logger.Info("Monitoring rootless Podman socket", zap.String("path", path))
+		r.rootless[path] = scraper
+		go scraper.containerEventLoop(r.ctx)
+	}
+}
+
 type result struct {
-	md  pmetric.Metrics
-	err error
+	container container
+	stats     containerStats
+	err       error
 }
 
 func (r *receiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	containers := r.scraper.getContainers()
-	results := make(chan result, len(containers))
+	scrapers := []*ContainerScraper{r.scraper}
+	if r.config.RootlessSockets.Enabled {
+		r.discoverRootlessSockets(ctx)
+		for _, scraper := range r.rootless {
+			scrapers = append(scrapers, scraper)
+		}
+	}
+
+	var count int
+	containers := make([][]container, len(scrapers))
+	for i, scraper := range scrapers {
+		containers[i] = scraper.getContainers()
+		count += len(containers[i])
+	}
+	results := make(chan result, count)
 
 	wg := &sync.WaitGroup{}
-	wg.Add(len(containers))
-	for _, c := range containers {
-		go func(c container) {
-			defer wg.Done()
-			stats, err := r.scraper.fetchContainerStats(ctx, c)
-			if err != nil {
-				results <- result{md: pmetric.Metrics{}, err: err}
-				return
-			}
-			results <- result{md: containerStatsToMetrics(time.Now(), c, &stats), err: nil}
-		}(c)
+	wg.Add(count)
+	for i, scraper := range scrapers {
+		for _, c := range containers[i] {
+			go func(scraper *ContainerScraper, c container) {
+				defer wg.Done()
+				stats, err := scraper.fetchContainerStats(ctx, c)
+				results <- result{container: c, stats: stats, err: err}
+			}(scraper, c)
+		}
 	}
 
 	wg.Wait()
 	close(results)
 
 	var errs error
+	now := time.Now()
 	md := pmetric.NewMetrics()
+	pods := make(map[string][]result)
 	for res := range results {
 		if res.err != nil {
 			// Don't know the number of failed metrics, but one container fetch is a partial error.
@@ -109,7 +177,18 @@ func (r *receiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			fmt.Println("No stats found!")
 			continue
 		}
-		res.md.ResourceMetrics().CopyTo(md.ResourceMetrics())
+		if r.config.AggregateByPod && res.container.Pod != "" {
+			pods[res.container.Pod] = append(pods[res.container.Pod], res)
+			continue
+		}
+		containerStatsToMetrics(now, res.container, &res.stats).ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+	}
+	for id, podResults := range pods {
+		stats := make([]*containerStats, len(podResults))
+		for i := range podResults {
+			stats[i] = &podResults[i].stats
+		}
+		podStatsToMetrics(now, id, podResults[0].container.PodName, stats).ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
 	}
 	return md, nil
 }
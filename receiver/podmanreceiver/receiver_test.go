@@ -10,6 +10,8 @@ import (
 	"context"
 	"errors"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -115,3 +117,129 @@ func (m mockConsumer) ConsumeMetrics(_ context.Context, md pmetric.Metrics) erro
 	m <- md
 	return nil
 }
+
+// containersClient lists its containers and returns their stats.
+type containersClient struct {
+	containers []container
+	statsByID  map[string]containerStats
+}
+
+func (c *containersClient) stats(_ context.Context, options url.Values) ([]containerStats, error) {
+	return []containerStats{c.statsByID[options.Get("containers")]}, nil
+}
+
+func (c *containersClient) ping(context.Context) error {
+	return nil
+}
+
+func (c *containersClient) list(context.Context, url.Values) ([]container, error) {
+	return c.containers, nil
+}
+
+func (c *containersClient) events(context.Context, url.Values) (<-chan event, <-chan error) {
+	return nil, nil
+}
+
+func TestScraperAggregateByPod(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.AggregateByPod = true
+
+	client := &containersClient{
+		containers: []container{
+			{ID: "c1", Pod: "p1", PodName: "pod"},
+			{ID: "c2", Pod: "p1", PodName: "pod"},
+			{ID: "c3"},
+		},
+		statsByID: map[string]containerStats{
+			"c1": {ContainerID: "c1", MemUsage: 10},
+			"c2": {ContainerID: "c2", MemUsage: 20},
+			"c3": {ContainerID: "c3", MemUsage: 40},
+		},
+	}
+	r := &receiver{
+		config:        cfg,
+		set:           receivertest.NewNopCreateSettings(),
+		clientFactory: func(*zap.Logger, *Config) (PodmanClient, error) { return client, nil },
+		rootless:      make(map[string]*ContainerScraper),
+	}
+	require.NoError(t, r.start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, r.shutdown(context.Background())) }()
+
+	md, err := r.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	usages := map[string]int64{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		id, ok := rm.Resource().Attributes().Get(podIDAttribute)
+		if !ok {
+			id, ok = rm.Resource().Attributes().Get("container.id")
+			require.True(t, ok)
+		}
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			if metrics.At(j).Name() == "container.memory.usage.total" {
+				usages[id.Str()] = metrics.At(j).Gauge().DataPoints().At(0).IntValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{"p1": 30, "c3": 40}, usages)
+}
+
+func TestDiscoverRootlessSockets(t *testing.T) {
+	dir := t.TempDir()
+	for _, uid := range []string{"1000", "1001"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, uid, "podman"), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, uid, "podman", "podman.sock"), nil, 0600))
+	}
+
+	cfg := createDefaultConfig()
+	cfg.RootlessSockets.Enabled = true
+	cfg.RootlessSockets.Pattern = filepath.Join(dir, "*", "podman", "podman.sock")
+
+	clients := map[string]PodmanClient{
+		cfg.Endpoint: &containersClient{
+			containers: []container{{ID: "root"}},
+			statsByID:  map[string]containerStats{"root": {ContainerID: "root"}},
+		},
+		"unix://" + filepath.Join(dir, "1000", "podman", "podman.sock"): &containersClient{
+			containers: []container{{ID: "user"}},
+			statsByID:  map[string]containerStats{"user": {ContainerID: "user"}},
+		},
+	}
+	r := &receiver{
+		config: cfg,
+		set:    receivertest.NewNopCreateSettings(),
+		clientFactory: func(_ *zap.Logger, cfg *Config) (PodmanClient, error) {
+			client, ok := clients[cfg.Endpoint]
+			if !ok {
+				return nil, errors.New("connection refused")
+			}
+			return client, nil
+		},
+		rootless: make(map[string]*ContainerScraper),
+	}
+	require.NoError(t, r.start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, r.shutdown(context.Background())) }()
+	assert.Len(t, r.rootless, 1)
+
+	md, err := r.scrape(context.Background())
+	require.NoError(t, err)
+	ids := map[string]bool{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		id, _ := md.ResourceMetrics().At(i).Resource().Attributes().Get("container.id")
+		ids[id.Str()] = true
+	}
+	assert.Equal(t, map[string]bool{"root": true, "user": true}, ids)
+
+	// the socket of the second user is discovered once it can be reached
+	clients["unix://"+filepath.Join(dir, "1001", "podman", "podman.sock")] = &containersClient{
+		containers: []container{{ID: "other"}},
+		statsByID:  map[string]containerStats{"other": {ContainerID: "other"}},
+	}
+	md, err = r.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, md.ResourceMetrics().Len())
+	assert.Len(t, r.rootless, 2)
+}
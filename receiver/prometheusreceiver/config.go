@@ -153,8 +153,20 @@ func (cfg *Config) validatePromConfig(promConfig *promconfig.Config) error {
 			return err
 		}
 
+		// The files of file_sd_configs are not checked: they are watched by the receiver,
+		// so that targets can be added once they are created.
 		for _, c := range sc.ServiceDiscoveryConfigs {
-			if c, ok := c.(*kubernetes.SDConfig); ok {
+			switch c := c.(type) {
+			case *kubernetes.SDConfig:
+				if err := checkTLSConfig(c.HTTPClientConfig.TLSConfig); err != nil {
+					return err
+				}
+			case *promHTTP.SDConfig:
+				if c.HTTPClientConfig.Authorization != nil {
+					if err := checkFile(c.HTTPClientConfig.Authorization.CredentialsFile); err != nil {
+						return fmt.Errorf("error checking http_sd_configs authorization credentials file %q: %w", c.HTTPClientConfig.Authorization.CredentialsFile, err)
+					}
+				}
 				if err := checkTLSConfig(c.HTTPClientConfig.TLSConfig); err != nil {
 					return err
 				}
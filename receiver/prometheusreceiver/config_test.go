@@ -175,6 +175,21 @@ func TestNonExistentAuthCredentialsFile(t *testing.T) {
 	require.True(t, strings.HasPrefix(gotErrMsg, wantErrMsg))
 }
 
+func TestHTTPSDConfigNonExistentAuthCredentialsFile(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid-config-prometheus-http-sd-config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	err = component.ValidateConfig(cfg)
+	require.NotNil(t, err, "Expected a non-nil error")
+	assert.Contains(t, err.Error(), `error checking http_sd_configs authorization credentials file "/nonexistentauthcredentialsfile"`)
+}
+
 func TestTLSConfigNonExistentCertFile(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid-config-prometheus-non-existent-cert-file.yaml"))
 	require.NoError(t, err)
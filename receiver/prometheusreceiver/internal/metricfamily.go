@@ -24,6 +24,9 @@ import (
 const (
 	traceIDKey = "trace_id"
 	spanIDKey  = "span_id"
+	// traceIDKeyAlias and spanIDKeyAlias are the label names used by some clients, e.g. traceID and spanID
+	traceIDKeyAlias = "traceid"
+	spanIDKeyAlias  = "spanid"
 )
 
 type metricFamily struct {
@@ -371,7 +374,7 @@ func convertExemplar(pe exemplar.Exemplar, e pmetric.Exemplar) {
 	e.FilteredAttributes().EnsureCapacity(len(pe.Labels))
 	for _, lb := range pe.Labels {
 		switch strings.ToLower(lb.Name) {
-		case traceIDKey:
+		case traceIDKey, traceIDKeyAlias:
 			var tid [16]byte
 			err := decodeAndCopyToLowerBytes(tid[:], []byte(lb.Value))
 			if err == nil {
@@ -379,7 +382,7 @@ func convertExemplar(pe exemplar.Exemplar, e pmetric.Exemplar) {
 			} else {
 				e.FilteredAttributes().PutStr(lb.Name, lb.Value)
 			}
-		case spanIDKey:
+		case spanIDKey, spanIDKeyAlias:
 			var sid [8]byte
 			err := decodeAndCopyToLowerBytes(sid[:], []byte(lb.Value))
 			if err == nil {
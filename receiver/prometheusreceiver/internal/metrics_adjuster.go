@@ -224,8 +224,18 @@ func (jm *JobsMap) get(job, instance string) *timeseriesMap {
 	return tsm2
 }
 
+// remove drops the timeseries of a job instance, e.g. when its target has been removed by service discovery.
+func (jm *JobsMap) remove(job, instance string) {
+	jm.Lock()
+	defer jm.Unlock()
+	delete(jm.jobsMap, job+":"+instance)
+}
+
 type MetricsAdjuster interface {
 	AdjustMetrics(metrics pmetric.Metrics) error
+	// RemoveTarget is called once the staleness markers of a target that went away have been adjusted,
+	// so that the state kept for the target can be released.
+	RemoveTarget(job, instance string)
 }
 
 // initialPointAdjuster takes a map from a metric instance to the initial point in the metrics instance
@@ -298,6 +308,12 @@ func (a *initialPointAdjuster) AdjustMetrics(metrics pmetric.Metrics) error {
 	return nil
 }
 
+// RemoveTarget forgets the initial points of the target, so that the start times of its
+// timeseries are reset if the target is discovered again.
+func (a *initialPointAdjuster) RemoveTarget(job, instance string) {
+	a.jobsMap.remove(job, instance)
+}
+
 func (a *initialPointAdjuster) adjustMetricHistogram(tsm *timeseriesMap, current pmetric.Metric) {
 	histogram := current.Histogram()
 	if histogram.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
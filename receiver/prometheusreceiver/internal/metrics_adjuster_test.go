@@ -637,6 +637,37 @@ func TestJobGC(t *testing.T) {
 	runScript(t, ma, "job1", "0", job1Script2)
 }
 
+func TestRemoveTarget(t *testing.T) {
+	script1 := []*metricsAdjusterTest{
+		{
+			description: "RemoveTarget: round 1 - initial instance, start time is established",
+			metrics:     metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t1, t1, 44))),
+			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t1, t1, 44))),
+		},
+		{
+			description: "RemoveTarget: round 2 - instance adjusted based on round 1",
+			metrics:     metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t2, t2, 66))),
+			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t1, t2, 66))),
+		},
+	}
+
+	script2 := []*metricsAdjusterTest{
+		{
+			description: "RemoveTarget: round 3 - target discovered again, start time is established again",
+			metrics:     metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t3, 88))),
+			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t3, 88))),
+		},
+	}
+
+	ma := NewInitialPointAdjuster(zap.NewNop(), time.Minute, true)
+	runScript(t, ma, "job", "0", script1)
+	// removing another target does not affect the timeseries of the job instance
+	ma.RemoveTarget("job", "1")
+	runScript(t, ma, "job", "0", script1[1:])
+	ma.RemoveTarget("job", "0")
+	runScript(t, ma, "job", "0", script2)
+}
+
 type metricsAdjusterTest struct {
 	description string
 	metrics     pmetric.Metrics
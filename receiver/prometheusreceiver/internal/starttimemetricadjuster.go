@@ -81,6 +81,9 @@ func (stma *startTimeMetricAdjuster) AdjustMetrics(metrics pmetric.Metrics) erro
 	return nil
 }
 
+// RemoveTarget does nothing, the start times are read from every scrape.
+func (stma *startTimeMetricAdjuster) RemoveTarget(string, string) {}
+
 func (stma *startTimeMetricAdjuster) getStartTime(metrics pmetric.Metrics) (float64, error) {
 	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
 		rm := metrics.ResourceMetrics().At(i)
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/exemplar"
@@ -42,6 +43,9 @@ type transaction struct {
 	sink           consumer.Metrics
 	externalLabels labels.Labels
 	nodeResource   pcommon.Resource
+	job, instance  string
+	// targetRemoved is set when the staleness markers written after the target went away are appended.
+	targetRemoved  bool
 	logger         *zap.Logger
 	buildInfo      component.BuildInfo
 	metricAdjuster MetricsAdjuster
@@ -109,6 +113,9 @@ func (t *transaction) Append(_ storage.SeriesRef, ls labels.Labels, atMs int64,
 	// See https://www.prometheus.io/docs/concepts/jobs_instances/#automatically-generated-labels-and-time-series
 	// up: 1 if the instance is healthy, i.e. reachable, or 0 if the scrape failed.
 	// But it can also be a staleNaN, which is inserted when the target goes away.
+	if metricName == scrapeUpMetricName && value.IsStaleNaN(val) {
+		t.targetRemoved = true
+	}
 	if metricName == scrapeUpMetricName && val != 1.0 && !value.IsStaleNaN(val) {
 		if val == 0.0 {
 			t.logger.Warn("Failed to scrape Prometheus endpoint",
@@ -135,6 +142,9 @@ func (t *transaction) Append(_ storage.SeriesRef, ls labels.Labels, atMs int64,
 func (t *transaction) getOrCreateMetricFamily(mn string) *metricFamily {
 	curMf, ok := t.families[mn]
 	if !ok {
+		if mf, found := t.familyOfCreatedSeries(mn); found {
+			return mf
+		}
 		fn := mn
 		if _, ok := t.mc.GetMetadata(mn); !ok {
 			fn = normalizeMetricName(mn)
@@ -149,6 +159,26 @@ func (t *transaction) getOrCreateMetricFamily(mn string) *metricFamily {
 	return curMf
 }
 
+// familyOfCreatedSeries returns the family of the counter, histogram or summary a `_created` series belongs to,
+// when it can't be found from the metadata of the series: OpenMetrics counters are named after their `_total`
+// series, and clients using the Prometheus text format expose `_created` series with their own gauge metadata.
+func (t *transaction) familyOfCreatedSeries(mn string) (*metricFamily, bool) {
+	if !strings.HasSuffix(mn, metricSuffixCreated) {
+		return nil, false
+	}
+	base := strings.TrimSuffix(mn, metricSuffixCreated)
+	for _, name := range []string{base + metricSuffixTotal, base} {
+		mf, ok := t.families[name]
+		if !ok {
+			continue
+		}
+		if mf.mtype == pmetric.MetricTypeSum || mf.mtype == pmetric.MetricTypeHistogram || mf.mtype == pmetric.MetricTypeSummary {
+			return mf, true
+		}
+	}
+	return nil, false
+}
+
 func (t *transaction) AppendExemplar(_ storage.SeriesRef, l labels.Labels, e exemplar.Exemplar) (storage.SeriesRef, error) {
 	select {
 	case <-t.ctx.Done():
@@ -162,6 +192,12 @@ func (t *transaction) AppendExemplar(_ storage.SeriesRef, l labels.Labels, e exe
 		}
 	}
 
+	// The external labels are added as for the samples, so that the exemplar is attached to the series of its sample
+	if len(t.externalLabels) != 0 {
+		l = append(l, t.externalLabels...)
+		sort.Sort(l)
+	}
+
 	l = l.WithoutEmpty()
 
 	if dupLabel, hasDup := l.HasDuplicateLabelNames(); hasDup {
@@ -227,6 +263,7 @@ func (t *transaction) initTransaction(labels labels.Labels) error {
 		return errNoJobInstance
 	}
 	t.nodeResource = CreateResource(job, instance, target.DiscoveredLabels())
+	t.job, t.instance = job, instance
 	t.isNew = false
 	return nil
 }
@@ -255,6 +292,13 @@ func (t *transaction) Commit() error {
 
 	err = t.sink.ConsumeMetrics(ctx, md)
 	t.obsrecv.EndMetricsOp(ctx, dataformat, numPoints, err)
+
+	if t.targetRemoved {
+		t.logger.Debug("Target removed, releasing its timeseries",
+			zap.String("job", t.job),
+			zap.String("instance", t.instance))
+		t.metricAdjuster.RemoveTarget(t.job, t.instance)
+	}
 	return err
 }
 
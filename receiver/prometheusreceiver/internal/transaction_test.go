@@ -6,6 +6,7 @@ package internal
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/prometheus/prometheus/model/exemplar"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/model/metadata"
+	"github.com/prometheus/prometheus/model/textparse"
+	"github.com/prometheus/prometheus/model/value"
 	"github.com/prometheus/prometheus/scrape"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -119,6 +122,41 @@ func TestTransactionAppendResource(t *testing.T) {
 	require.Equal(t, expectedResource, gotResource)
 }
 
+func TestTransactionRemovesStaleTarget(t *testing.T) {
+	adjuster := &startTimeAdjuster{startTime: startTimestamp}
+	sink := new(consumertest.MetricsSink)
+
+	// A failed scrape does not remove the target
+	tr := newTransaction(scrapeCtx, adjuster, sink, nil, receivertest.NewNopCreateSettings(), nopObsRecv(t), featuregate.GlobalRegistry())
+	_, err := tr.Append(0, labels.FromMap(map[string]string{
+		model.InstanceLabel:   "localhost:8080",
+		model.JobLabel:        "test",
+		model.MetricNameLabel: scrapeUpMetricName,
+	}), ts, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Commit())
+	assert.Empty(t, adjuster.removedTargets)
+
+	// The staleness markers written once the target went away remove it after they are sent
+	sink.Reset()
+	tr = newTransaction(scrapeCtx, adjuster, sink, nil, receivertest.NewNopCreateSettings(), nopObsRecv(t), featuregate.GlobalRegistry())
+	_, err = tr.Append(0, labels.FromMap(map[string]string{
+		model.InstanceLabel:   "localhost:8080",
+		model.JobLabel:        "test",
+		model.MetricNameLabel: "counter_test",
+	}), ts+interval, math.Float64frombits(value.StaleNaN))
+	assert.NoError(t, err)
+	_, err = tr.Append(0, labels.FromMap(map[string]string{
+		model.InstanceLabel:   "localhost:8080",
+		model.JobLabel:        "test",
+		model.MetricNameLabel: scrapeUpMetricName,
+	}), ts+interval, math.Float64frombits(value.StaleNaN))
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Commit())
+	assert.Equal(t, []string{"test:localhost:8080"}, adjuster.removedTargets)
+	assert.Equal(t, 2, sink.DataPointCount())
+}
+
 func TestReceiverVersionAndNameAreAttached(t *testing.T) {
 	sink := new(consumertest.MetricsSink)
 	tr := newTransaction(scrapeCtx, &startTimeAdjuster{startTime: startTimestamp}, sink, nil, receivertest.NewNopCreateSettings(), nopObsRecv(t), featuregate.GlobalRegistry())
@@ -274,6 +312,94 @@ func TestAppendExemplarWithEmptyLabelArray(t *testing.T) {
 	assert.Equal(t, errNoJobInstance, err)
 }
 
+func TestAppendExemplarWithExternalLabels(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	tr := newTransaction(scrapeCtx, &startTimeAdjuster{startTime: startTimestamp}, sink, labels.FromStrings("cluster", "test"), receivertest.NewNopCreateSettings(), nopObsRecv(t), featuregate.GlobalRegistry())
+
+	lb := labels.FromStrings(
+		model.InstanceLabel, "localhost:8080",
+		model.JobLabel, "test",
+		model.MetricNameLabel, "counter_test",
+		"foo", "bar",
+	)
+	_, err := tr.Append(0, lb, ts, 100)
+	require.NoError(t, err)
+	_, err = tr.AppendExemplar(0, lb, exemplar.Exemplar{
+		Value:  1,
+		Ts:     ts,
+		HasTs:  true,
+		Labels: labels.FromStrings("traceID", "10a47365b8aa04e08291fab9deca84db", "spanID", "719cee4a669fd7d1"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, tr.Commit())
+
+	mds := sink.AllMetrics()
+	require.Len(t, mds, 1)
+	metrics := mds[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	pt := metrics.At(0).Sum().DataPoints().At(0)
+	require.Equal(t, 1, pt.Exemplars().Len())
+	e := pt.Exemplars().At(0)
+	assert.Equal(t, pcommon.TraceID([16]byte{0x10, 0xa4, 0x73, 0x65, 0xb8, 0xaa, 0x04, 0xe0, 0x82, 0x91, 0xfa, 0xb9, 0xde, 0xca, 0x84, 0xdb}), e.TraceID())
+	assert.Equal(t, pcommon.SpanID([8]byte{0x71, 0x9c, 0xee, 0x4a, 0x66, 0x9f, 0xd7, 0xd1}), e.SpanID())
+	assert.Equal(t, 0, e.FilteredAttributes().Len())
+}
+
+func TestTransactionAppendCreatedSeries(t *testing.T) {
+	created := float64(startTimestamp / 1e9)
+	tests := []struct {
+		name     string
+		metadata testMetadataStore
+	}{
+		{
+			// OpenMetrics: the family of the counter is named after its _total series
+			name: "openmetrics",
+			metadata: testMetadataStore{
+				"counter_test": {Metric: "counter_test", Type: textparse.MetricTypeCounter},
+			},
+		},
+		{
+			// Prometheus text format: the _created series is exposed as a separate gauge
+			name: "text-format",
+			metadata: testMetadataStore{
+				"counter_test":         {Metric: "counter_test", Type: textparse.MetricTypeCounter},
+				"counter_test_created": {Metric: "counter_test_created", Type: textparse.MetricTypeGauge},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := new(consumertest.MetricsSink)
+			ctx := scrape.ContextWithMetricMetadataStore(scrape.ContextWithTarget(context.Background(), target), tt.metadata)
+			tr := newTransaction(ctx, &errorAdjuster{}, sink, nil, receivertest.NewNopCreateSettings(), nopObsRecv(t), featuregate.GlobalRegistry())
+
+			for _, pt := range []struct {
+				name  string
+				value float64
+			}{{"counter_test_total", 100}, {"counter_test_created", created}} {
+				_, err := tr.Append(0, labels.FromStrings(
+					model.InstanceLabel, "localhost:8080",
+					model.JobLabel, "test",
+					model.MetricNameLabel, pt.name,
+					"foo", "bar",
+				), ts, pt.value)
+				require.NoError(t, err)
+			}
+			require.NoError(t, tr.Commit())
+
+			mds := sink.AllMetrics()
+			require.Len(t, mds, 1)
+			metrics := mds[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			require.Equal(t, 1, metrics.Len(), "the _created series must not be emitted as a separate metric")
+			require.Equal(t, pmetric.MetricTypeSum, metrics.At(0).Type())
+			pt := metrics.At(0).Sum().DataPoints().At(0)
+			assert.Equal(t, 100.0, pt.DoubleValue())
+			assert.Equal(t, timestampFromFloat64(created), pt.StartTimestamp())
+		})
+	}
+}
+
 func nopObsRecv(t *testing.T) *obsreport.Receiver {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
 		ReceiverID:             component.NewID("prometheus"),
@@ -1401,8 +1527,15 @@ func (ea *errorAdjuster) AdjustMetrics(pmetric.Metrics) error {
 	return ea.err
 }
 
+func (ea *errorAdjuster) RemoveTarget(string, string) {}
+
 type startTimeAdjuster struct {
-	startTime pcommon.Timestamp
+	startTime      pcommon.Timestamp
+	removedTargets []string
+}
+
+func (s *startTimeAdjuster) RemoveTarget(job, instance string) {
+	s.removedTargets = append(s.removedTargets, job+":"+instance)
 }
 
 func (s *startTimeAdjuster) AdjustMetrics(metrics pmetric.Metrics) error {
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	gokitlog "github.com/go-kit/log"
+	promcfg "github.com/prometheus/prometheus/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+const serviceDiscoveryTargetPage = `
+# HELP sd_test_gauge A gauge exposed by a discovered target.
+# TYPE sd_test_gauge gauge
+sd_test_gauge 1
+`
+
+type targetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels,omitempty"`
+}
+
+// newDiscoveredTarget returns a server exposing a single gauge, and its host.
+func newDiscoveredTarget(t *testing.T) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(serviceDiscoveryTargetPage))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u.Host
+}
+
+// targetMetrics returns the sd_test_gauge data points scraped from the target, and whether
+// one of them is a staleness marker.
+func targetMetrics(sink *consumertest.MetricsSink, host string) (points int, stale bool) {
+	for _, md := range sink.AllMetrics() {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			rm := md.ResourceMetrics().At(i)
+			instance, ok := rm.Resource().Attributes().Get("service.instance.id")
+			if !ok || instance.Str() != host {
+				continue
+			}
+			for _, m := range getMetrics(rm) {
+				if m.Name() != "sd_test_gauge" || m.Type() != pmetric.MetricTypeGauge {
+					continue
+				}
+				for j := 0; j < m.Gauge().DataPoints().Len(); j++ {
+					points++
+					if m.Gauge().DataPoints().At(j).Flags().NoRecordedValue() {
+						stale = true
+					}
+				}
+			}
+		}
+	}
+	return points, stale
+}
+
+// testServiceDiscoveryReload checks that the receiver starts scraping the targets added to the
+// service discovery, and emits staleness markers for the targets which have been removed from it.
+func testServiceDiscoveryReload(t *testing.T, sdConfig string, setTargets func(hosts ...string)) {
+	if testing.Short() {
+		t.Skip("This test can take a long time")
+	}
+
+	first, second := newDiscoveredTarget(t), newDiscoveredTarget(t)
+	setTargets(first)
+
+	cfg, err := promcfg.Load(fmt.Sprintf(`
+scrape_configs:
+  - job_name: sd
+    scrape_interval: 1s
+    scrape_timeout: 500ms
+%s`, sdConfig), false, gokitlog.NewNopLogger())
+	require.NoError(t, err)
+
+	sink := new(consumertest.MetricsSink)
+	receiver := newPrometheusReceiver(receivertest.NewNopCreateSettings(), &Config{PrometheusConfig: cfg}, sink, featuregate.GlobalRegistry())
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, receiver.Shutdown(context.Background()))
+	})
+
+	assert.Eventually(t, func() bool {
+		points, _ := targetMetrics(sink, first)
+		return points > 0
+	}, 30*time.Second, 100*time.Millisecond, "the initial target must be scraped")
+
+	setTargets(second)
+
+	assert.Eventually(t, func() bool {
+		points, _ := targetMetrics(sink, second)
+		return points > 0
+	}, 30*time.Second, 100*time.Millisecond, "the added target must be scraped")
+	assert.Eventually(t, func() bool {
+		_, stale := targetMetrics(sink, first)
+		return stale
+	}, 30*time.Second, 100*time.Millisecond, "staleness markers must be emitted for the removed target")
+}
+
+func TestFileSDReload(t *testing.T) {
+	sdFile := filepath.Join(t.TempDir(), "targets.json")
+	setTargets := func(hosts ...string) {
+		content, err := json.Marshal([]targetGroup{{Targets: hosts}})
+		require.NoError(t, err)
+		// write the file atomically, so that it is never read partially written
+		tmp := sdFile + ".tmp"
+		require.NoError(t, os.WriteFile(tmp, content, 0600))
+		require.NoError(t, os.Rename(tmp, sdFile))
+	}
+
+	testServiceDiscoveryReload(t, fmt.Sprintf(`
+    file_sd_configs:
+      - files: [%q]
+        refresh_interval: 1s`, sdFile), setTargets)
+}
+
+func TestHTTPSDReload(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		targets []string
+	)
+	sdServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode([]targetGroup{{Targets: targets}})
+	}))
+	t.Cleanup(sdServer.Close)
+
+	setTargets := func(hosts ...string) {
+		mu.Lock()
+		defer mu.Unlock()
+		targets = hosts
+	}
+
+	testServiceDiscoveryReload(t, fmt.Sprintf(`
+    http_sd_configs:
+      - url: %s
+        refresh_interval: 1s`, sdServer.URL), setTargets)
+}
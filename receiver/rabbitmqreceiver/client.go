@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/zap"
@@ -16,12 +17,36 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/models"
 )
 
-// queuePath is the path to queues endpoint
-const queuePath = "/api/queues"
+const (
+	// queuePath is the path to queues endpoint
+	queuePath = "/api/queues"
+	// exchangePath is the path to exchanges endpoint
+	exchangePath = "/api/exchanges"
+	// vhostPath is the path to vhosts endpoint
+	vhostPath = "/api/vhosts"
+	// shovelPath is the path to shovels status endpoint, provided by the shovel management plugin
+	shovelPath = "/api/shovels"
+	// federationLinkPath is the path to federation links status endpoint, provided by the federation management plugin
+	federationLinkPath = "/api/federation-links"
+	// nodePath is the path to nodes endpoint
+	nodePath = "/api/nodes"
+)
 
 type client interface {
 	// GetQueues calls "/api/queues" endpoint to get list of queues for the target node
 	GetQueues(ctx context.Context) ([]*models.Queue, error)
+	// GetExchanges calls "/api/exchanges" endpoint to get list of exchanges
+	GetExchanges(ctx context.Context) ([]*models.Exchange, error)
+	// GetVHosts calls "/api/vhosts" endpoint to get list of vhosts
+	GetVHosts(ctx context.Context) ([]*models.VHost, error)
+	// GetShovels calls "/api/shovels" endpoint to get the status of the shovels
+	GetShovels(ctx context.Context) ([]*models.Shovel, error)
+	// GetFederationLinks calls "/api/federation-links" endpoint to get the status of the federation links
+	GetFederationLinks(ctx context.Context) ([]*models.FederationLink, error)
+	// GetNodes calls "/api/nodes" endpoint to get list of nodes of the cluster
+	GetNodes(ctx context.Context) ([]*models.Node, error)
+	// GetNodeMemory calls "/api/nodes/{node}/memory" endpoint to get the memory breakdown of a node
+	GetNodeMemory(ctx context.Context, node string) (*models.NodeMemory, error)
 }
 
 var _ client = (*rabbitmqClient)(nil)
@@ -66,10 +91,77 @@ func (c *rabbitmqClient) GetQueues(ctx context.Context) ([]*models.Queue, error)
 	return queues, nil
 }
 
+func (c *rabbitmqClient) GetExchanges(ctx context.Context) ([]*models.Exchange, error) {
+	var exchanges []*models.Exchange
+
+	if err := c.get(ctx, exchangePath, &exchanges); err != nil {
+		c.logger.Debug("Failed to retrieve exchanges", zap.Error(err))
+		return nil, err
+	}
+
+	return exchanges, nil
+}
+
+func (c *rabbitmqClient) GetVHosts(ctx context.Context) ([]*models.VHost, error) {
+	var vhosts []*models.VHost
+
+	if err := c.get(ctx, vhostPath, &vhosts); err != nil {
+		c.logger.Debug("Failed to retrieve vhosts", zap.Error(err))
+		return nil, err
+	}
+
+	return vhosts, nil
+}
+
+func (c *rabbitmqClient) GetShovels(ctx context.Context) ([]*models.Shovel, error) {
+	var shovels []*models.Shovel
+
+	if err := c.get(ctx, shovelPath, &shovels); err != nil {
+		c.logger.Debug("Failed to retrieve shovels", zap.Error(err))
+		return nil, err
+	}
+
+	return shovels, nil
+}
+
+func (c *rabbitmqClient) GetFederationLinks(ctx context.Context) ([]*models.FederationLink, error) {
+	var links []*models.FederationLink
+
+	if err := c.get(ctx, federationLinkPath, &links); err != nil {
+		c.logger.Debug("Failed to retrieve federation links", zap.Error(err))
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (c *rabbitmqClient) GetNodes(ctx context.Context) ([]*models.Node, error) {
+	var nodes []*models.Node
+
+	if err := c.get(ctx, nodePath, &nodes); err != nil {
+		c.logger.Debug("Failed to retrieve nodes", zap.Error(err))
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func (c *rabbitmqClient) GetNodeMemory(ctx context.Context, node string) (*models.NodeMemory, error) {
+	var memory models.NodeMemory
+
+	// Node names are escaped as a path segment, e.g. rabbit@host
+	if err := c.get(ctx, nodePath+"/"+url.PathEscape(node)+"/memory", &memory); err != nil {
+		c.logger.Debug("Failed to retrieve node memory", zap.String("node", node), zap.Error(err))
+		return nil, err
+	}
+
+	return &memory, nil
+}
+
 func (c *rabbitmqClient) get(ctx context.Context, path string, respObj interface{}) error {
 	// Construct endpoint and create request
-	url := c.hostEndpoint + path
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	endpoint := c.hostEndpoint + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
 	if err != nil {
 		return fmt.Errorf("failed to create get request for path %s: %w", path, err)
 	}
@@ -24,7 +24,13 @@ import (
 )
 
 const (
-	queuesAPIResponseFile = "get_queues_response.json"
+	queuesAPIResponseFile          = "get_queues_response.json"
+	exchangesAPIResponseFile       = "get_exchanges_response.json"
+	vhostsAPIResponseFile          = "get_vhosts_response.json"
+	shovelsAPIResponseFile         = "get_shovels_response.json"
+	federationLinksAPIResponseFile = "get_federation_links_response.json"
+	nodesAPIResponseFile           = "get_nodes_response.json"
+	nodeMemoryAPIResponseFile      = "get_node_memory_response.json"
 )
 
 func TestNewClient(t *testing.T) {
@@ -159,6 +165,73 @@ func TestGetQueuesDetails(t *testing.T) {
 	}
 }
 
+func TestGetOptionalDetails(t *testing.T) {
+	responses := map[string]string{
+		exchangePath:                             exchangesAPIResponseFile,
+		vhostPath:                                vhostsAPIResponseFile,
+		shovelPath:                               shovelsAPIResponseFile,
+		federationLinkPath:                       federationLinksAPIResponseFile,
+		nodePath:                                 nodesAPIResponseFile,
+		nodePath + "/rabbit@66a8f7a0e8a4/memory": nodeMemoryAPIResponseFile,
+	}
+
+	// Setup test server
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write(loadAPIResponseData(t, file))
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	tc := createTestClient(t, ts.URL)
+	ctx := context.Background()
+
+	var expectedExchanges []*models.Exchange
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, exchangesAPIResponseFile), &expectedExchanges))
+	exchanges, err := tc.GetExchanges(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedExchanges, exchanges)
+
+	var expectedVHosts []*models.VHost
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, vhostsAPIResponseFile), &expectedVHosts))
+	vhosts, err := tc.GetVHosts(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedVHosts, vhosts)
+
+	var expectedShovels []*models.Shovel
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, shovelsAPIResponseFile), &expectedShovels))
+	shovels, err := tc.GetShovels(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedShovels, shovels)
+
+	var expectedLinks []*models.FederationLink
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, federationLinksAPIResponseFile), &expectedLinks))
+	links, err := tc.GetFederationLinks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedLinks, links)
+
+	var expectedNodes []*models.Node
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, nodesAPIResponseFile), &expectedNodes))
+	nodes, err := tc.GetNodes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedNodes, nodes)
+
+	var expectedMemory *models.NodeMemory
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, nodeMemoryAPIResponseFile), &expectedMemory))
+	memory, err := tc.GetNodeMemory(ctx, "rabbit@66a8f7a0e8a4")
+	require.NoError(t, err)
+	require.Equal(t, expectedMemory, memory)
+
+	// Unknown node
+	memory, err = tc.GetNodeMemory(ctx, "rabbit@unknown")
+	require.Nil(t, memory)
+	require.EqualError(t, err, "non 200 code returned 404")
+}
+
 func createTestClient(t *testing.T, baseEndpoint string) client {
 	t.Helper()
 	cfg := createDefaultConfig().(*Config)
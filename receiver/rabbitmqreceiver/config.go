@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -32,9 +33,18 @@ type Config struct {
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
 	Username                                string              `mapstructure:"username"`
 	Password                                configopaque.String `mapstructure:"password"`
+	QueueFilter                             QueueFilterConfig   `mapstructure:"queue_filter"`
 	metadata.MetricsBuilderConfig           `mapstructure:",squash"`
 }
 
+// QueueFilterConfig selects the queues whose metrics are collected by matching their name against regular expressions.
+type QueueFilterConfig struct {
+	// Include only collects the queues whose name matches one of the expressions. All queues are collected if empty.
+	Include []string `mapstructure:"include"`
+	// Exclude never collects the queues whose name matches one of the expressions, even if they are included.
+	Exclude []string `mapstructure:"exclude"`
+}
+
 // Validate validates the configuration by checking for missing or invalid fields
 func (cfg *Config) Validate() error {
 	var err error
@@ -52,5 +62,16 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, wrappedErr)
 	}
 
+	for _, expr := range cfg.QueueFilter.Include {
+		if _, regexErr := regexp.Compile(expr); regexErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid queue_filter include expression %q: %w", expr, regexErr))
+		}
+	}
+	for _, expr := range cfg.QueueFilter.Exclude {
+		if _, regexErr := regexp.Compile(expr); regexErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid queue_filter exclude expression %q: %w", expr, regexErr))
+		}
+	}
+
 	return err
 }
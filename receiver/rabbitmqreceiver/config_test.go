@@ -4,6 +4,7 @@
 package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -76,6 +77,21 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%w: %s", errInvalidEndpoint, `parse "invalid://endpoint:  12efg": invalid port ":  12efg" after host`),
 			),
 		},
+		{
+			desc: "invalid queue filter",
+			cfg: &Config{
+				Username: "otelu",
+				Password: "otelp",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+				},
+				QueueFilter: QueueFilterConfig{
+					Include: []string{"^web"},
+					Exclude: []string{"["},
+				},
+			},
+			expectedErr: errors.New("invalid queue_filter exclude expression \"[\": error parsing regexp: missing closing ]: `[`"),
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
@@ -118,6 +134,10 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otelu"
 	expected.Password = "${env:RABBITMQ_PASSWORD}"
 	expected.CollectionInterval = 10 * time.Second
+	expected.QueueFilter = QueueFilterConfig{
+		Include: []string{"^web"},
+		Exclude: []string{"-tmp$"},
+	}
 
 	require.Equal(t, expected, cfg)
 }
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver"
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// queueFilter matches queue names against the expressions of a QueueFilterConfig
+type queueFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// newQueueFilter compiles the expressions of the config
+func newQueueFilter(cfg QueueFilterConfig) (*queueFilter, error) {
+	f := &queueFilter{}
+	for _, expr := range cfg.Include {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid queue_filter include expression %q: %w", expr, err)
+		}
+		f.include = append(f.include, re)
+	}
+	for _, expr := range cfg.Exclude {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid queue_filter exclude expression %q: %w", expr, err)
+		}
+		f.exclude = append(f.exclude, re)
+	}
+	return f, nil
+}
+
+// matches returns whether the metrics of the queue are collected. A nil filter matches every queue.
+func (f *queueFilter) matches(name string) bool {
+	if f == nil {
+		return true
+	}
+	for _, re := range f.exclude {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	if len(f.include) == 0 {
+		return true
+	}
+	for _, re := range f.include {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
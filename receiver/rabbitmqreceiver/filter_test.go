@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueFilter(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cfg      QueueFilterConfig
+		expected map[string]bool
+	}{
+		{
+			desc: "no expressions",
+			cfg:  QueueFilterConfig{},
+			expected: map[string]bool{
+				"webq1": true,
+				"test2": true,
+			},
+		},
+		{
+			desc: "include",
+			cfg: QueueFilterConfig{
+				Include: []string{"^web", "^orders$"},
+			},
+			expected: map[string]bool{
+				"webq1":      true,
+				"orders":     true,
+				"orders-dlq": false,
+				"test2":      false,
+			},
+		},
+		{
+			desc: "exclude",
+			cfg: QueueFilterConfig{
+				Exclude: []string{"-tmp$"},
+			},
+			expected: map[string]bool{
+				"webq1":     true,
+				"webq1-tmp": false,
+			},
+		},
+		{
+			desc: "exclude takes precedence over include",
+			cfg: QueueFilterConfig{
+				Include: []string{"^web"},
+				Exclude: []string{"-tmp$"},
+			},
+			expected: map[string]bool{
+				"webq1":     true,
+				"webq1-tmp": false,
+				"test2":     false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			f, err := newQueueFilter(tc.cfg)
+			require.NoError(t, err)
+			for name, expected := range tc.expected {
+				require.Equal(t, expected, f.matches(name), name)
+			}
+		})
+	}
+}
+
+func TestQueueFilterInvalid(t *testing.T) {
+	_, err := newQueueFilter(QueueFilterConfig{Include: []string{"("}})
+	require.EqualError(t, err, "invalid queue_filter include expression \"(\": error parsing regexp: missing closing ): `(`")
+
+	var f *queueFilter
+	require.True(t, f.matches("webq1"))
+}
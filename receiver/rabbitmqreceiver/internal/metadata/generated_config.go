@@ -26,11 +26,18 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 // MetricsConfig provides config for rabbitmq metrics.
 type MetricsConfig struct {
 	RabbitmqConsumerCount       MetricConfig `mapstructure:"rabbitmq.consumer.count"`
+	RabbitmqConsumerUtilization MetricConfig `mapstructure:"rabbitmq.consumer.utilization"`
+	RabbitmqExchangeMessageRate MetricConfig `mapstructure:"rabbitmq.exchange.message.rate"`
+	RabbitmqFederationLinkCount MetricConfig `mapstructure:"rabbitmq.federation.link.count"`
 	RabbitmqMessageAcknowledged MetricConfig `mapstructure:"rabbitmq.message.acknowledged"`
 	RabbitmqMessageCurrent      MetricConfig `mapstructure:"rabbitmq.message.current"`
 	RabbitmqMessageDelivered    MetricConfig `mapstructure:"rabbitmq.message.delivered"`
 	RabbitmqMessageDropped      MetricConfig `mapstructure:"rabbitmq.message.dropped"`
 	RabbitmqMessagePublished    MetricConfig `mapstructure:"rabbitmq.message.published"`
+	RabbitmqMessageRate         MetricConfig `mapstructure:"rabbitmq.message.rate"`
+	RabbitmqNodeMemoryUsage     MetricConfig `mapstructure:"rabbitmq.node.memory.usage"`
+	RabbitmqShovelState         MetricConfig `mapstructure:"rabbitmq.shovel.state"`
+	RabbitmqVhostMessageRate    MetricConfig `mapstructure:"rabbitmq.vhost.message.rate"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -38,6 +45,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		RabbitmqConsumerCount: MetricConfig{
 			Enabled: true,
 		},
+		RabbitmqConsumerUtilization: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqExchangeMessageRate: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqFederationLinkCount: MetricConfig{
+			Enabled: false,
+		},
 		RabbitmqMessageAcknowledged: MetricConfig{
 			Enabled: true,
 		},
@@ -53,6 +69,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		RabbitmqMessagePublished: MetricConfig{
 			Enabled: true,
 		},
+		RabbitmqMessageRate: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqNodeMemoryUsage: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqShovelState: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqVhostMessageRate: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -63,13 +91,17 @@ type ResourceAttributeConfig struct {
 
 // ResourceAttributesConfig provides config for rabbitmq resource attributes.
 type ResourceAttributesConfig struct {
-	RabbitmqNodeName  ResourceAttributeConfig `mapstructure:"rabbitmq.node.name"`
-	RabbitmqQueueName ResourceAttributeConfig `mapstructure:"rabbitmq.queue.name"`
-	RabbitmqVhostName ResourceAttributeConfig `mapstructure:"rabbitmq.vhost.name"`
+	RabbitmqExchangeName ResourceAttributeConfig `mapstructure:"rabbitmq.exchange.name"`
+	RabbitmqNodeName     ResourceAttributeConfig `mapstructure:"rabbitmq.node.name"`
+	RabbitmqQueueName    ResourceAttributeConfig `mapstructure:"rabbitmq.queue.name"`
+	RabbitmqVhostName    ResourceAttributeConfig `mapstructure:"rabbitmq.vhost.name"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		RabbitmqExchangeName: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		RabbitmqNodeName: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -27,11 +27,18 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					RabbitmqConsumerCount:       MetricConfig{Enabled: true},
+					RabbitmqConsumerUtilization: MetricConfig{Enabled: true},
+					RabbitmqExchangeMessageRate: MetricConfig{Enabled: true},
+					RabbitmqFederationLinkCount: MetricConfig{Enabled: true},
 					RabbitmqMessageAcknowledged: MetricConfig{Enabled: true},
 					RabbitmqMessageCurrent:      MetricConfig{Enabled: true},
 					RabbitmqMessageDelivered:    MetricConfig{Enabled: true},
 					RabbitmqMessageDropped:      MetricConfig{Enabled: true},
 					RabbitmqMessagePublished:    MetricConfig{Enabled: true},
+					RabbitmqMessageRate:         MetricConfig{Enabled: true},
+					RabbitmqNodeMemoryUsage:     MetricConfig{Enabled: true},
+					RabbitmqShovelState:         MetricConfig{Enabled: true},
+					RabbitmqVhostMessageRate:    MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: true},
@@ -45,11 +52,18 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					RabbitmqConsumerCount:       MetricConfig{Enabled: false},
+					RabbitmqConsumerUtilization: MetricConfig{Enabled: false},
+					RabbitmqExchangeMessageRate: MetricConfig{Enabled: false},
+					RabbitmqFederationLinkCount: MetricConfig{Enabled: false},
 					RabbitmqMessageAcknowledged: MetricConfig{Enabled: false},
 					RabbitmqMessageCurrent:      MetricConfig{Enabled: false},
 					RabbitmqMessageDelivered:    MetricConfig{Enabled: false},
 					RabbitmqMessageDropped:      MetricConfig{Enabled: false},
 					RabbitmqMessagePublished:    MetricConfig{Enabled: false},
+					RabbitmqMessageRate:         MetricConfig{Enabled: false},
+					RabbitmqNodeMemoryUsage:     MetricConfig{Enabled: false},
+					RabbitmqShovelState:         MetricConfig{Enabled: false},
+					RabbitmqVhostMessageRate:    MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: false},
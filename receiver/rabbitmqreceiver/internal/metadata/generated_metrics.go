@@ -11,6 +11,100 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeExchangeDirection specifies the a value exchange.direction attribute.
+type AttributeExchangeDirection int
+
+const (
+	_ AttributeExchangeDirection = iota
+	AttributeExchangeDirectionIn
+	AttributeExchangeDirectionOut
+)
+
+// String returns the string representation of the AttributeExchangeDirection.
+func (av AttributeExchangeDirection) String() string {
+	switch av {
+	case AttributeExchangeDirectionIn:
+		return "in"
+	case AttributeExchangeDirectionOut:
+		return "out"
+	}
+	return ""
+}
+
+// MapAttributeExchangeDirection is a helper map of string to AttributeExchangeDirection attribute value.
+var MapAttributeExchangeDirection = map[string]AttributeExchangeDirection{
+	"in":  AttributeExchangeDirectionIn,
+	"out": AttributeExchangeDirectionOut,
+}
+
+// AttributeFederationLinkState specifies the a value federation.link.state attribute.
+type AttributeFederationLinkState int
+
+const (
+	_ AttributeFederationLinkState = iota
+	AttributeFederationLinkStateStarting
+	AttributeFederationLinkStateRunning
+	AttributeFederationLinkStateError
+	AttributeFederationLinkStateShutdown
+)
+
+// String returns the string representation of the AttributeFederationLinkState.
+func (av AttributeFederationLinkState) String() string {
+	switch av {
+	case AttributeFederationLinkStateStarting:
+		return "starting"
+	case AttributeFederationLinkStateRunning:
+		return "running"
+	case AttributeFederationLinkStateError:
+		return "error"
+	case AttributeFederationLinkStateShutdown:
+		return "shutdown"
+	}
+	return ""
+}
+
+// MapAttributeFederationLinkState is a helper map of string to AttributeFederationLinkState attribute value.
+var MapAttributeFederationLinkState = map[string]AttributeFederationLinkState{
+	"starting": AttributeFederationLinkStateStarting,
+	"running":  AttributeFederationLinkStateRunning,
+	"error":    AttributeFederationLinkStateError,
+	"shutdown": AttributeFederationLinkStateShutdown,
+}
+
+// AttributeMessageOperation specifies the a value message.operation attribute.
+type AttributeMessageOperation int
+
+const (
+	_ AttributeMessageOperation = iota
+	AttributeMessageOperationPublish
+	AttributeMessageOperationDeliver
+	AttributeMessageOperationAck
+	AttributeMessageOperationRedeliver
+)
+
+// String returns the string representation of the AttributeMessageOperation.
+func (av AttributeMessageOperation) String() string {
+	switch av {
+	case AttributeMessageOperationPublish:
+		return "publish"
+	case AttributeMessageOperationDeliver:
+		return "deliver"
+	case AttributeMessageOperationAck:
+		return "ack"
+	case AttributeMessageOperationRedeliver:
+		return "redeliver"
+	}
+	return ""
+}
+
+// MapAttributeMessageOperation is a helper map of string to AttributeMessageOperation attribute value.
+var MapAttributeMessageOperation = map[string]AttributeMessageOperation{
+	"publish":   AttributeMessageOperationPublish,
+	"deliver":   AttributeMessageOperationDeliver,
+	"ack":       AttributeMessageOperationAck,
+	"redeliver": AttributeMessageOperationRedeliver,
+}
+
 // AttributeMessageState specifies the a value message.state attribute.
 type AttributeMessageState int
 
@@ -37,6 +131,36 @@ var MapAttributeMessageState = map[string]AttributeMessageState{
 	"unacknowledged": AttributeMessageStateUnacknowledged,
 }
 
+// AttributeShovelState specifies the a value shovel.state attribute.
+type AttributeShovelState int
+
+const (
+	_ AttributeShovelState = iota
+	AttributeShovelStateStarting
+	AttributeShovelStateRunning
+	AttributeShovelStateTerminated
+)
+
+// String returns the string representation of the AttributeShovelState.
+func (av AttributeShovelState) String() string {
+	switch av {
+	case AttributeShovelStateStarting:
+		return "starting"
+	case AttributeShovelStateRunning:
+		return "running"
+	case AttributeShovelStateTerminated:
+		return "terminated"
+	}
+	return ""
+}
+
+// MapAttributeShovelState is a helper map of string to AttributeShovelState attribute value.
+var MapAttributeShovelState = map[string]AttributeShovelState{
+	"starting":   AttributeShovelStateStarting,
+	"running":    AttributeShovelStateRunning,
+	"terminated": AttributeShovelStateTerminated,
+}
+
 type metricRabbitmqConsumerCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -88,6 +212,160 @@ func newMetricRabbitmqConsumerCount(cfg MetricConfig) metricRabbitmqConsumerCoun
 	return m
 }
 
+type metricRabbitmqConsumerUtilization struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.consumer.utilization metric with initial data.
+func (m *metricRabbitmqConsumerUtilization) init() {
+	m.data.SetName("rabbitmq.consumer.utilization")
+	m.data.SetDescription("The fraction of time the queue is able to immediately deliver messages to consumers.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqConsumerUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqConsumerUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqConsumerUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqConsumerUtilization(cfg MetricConfig) metricRabbitmqConsumerUtilization {
+	m := metricRabbitmqConsumerUtilization{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqExchangeMessageRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.exchange.message.rate metric with initial data.
+func (m *metricRabbitmqExchangeMessageRate) init() {
+	m.data.SetName("rabbitmq.exchange.message.rate")
+	m.data.SetDescription("The rate at which messages are published into and routed out of an exchange.")
+	m.data.SetUnit("{messages}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqExchangeMessageRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, exchangeDirectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("direction", exchangeDirectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqExchangeMessageRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqExchangeMessageRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqExchangeMessageRate(cfg MetricConfig) metricRabbitmqExchangeMessageRate {
+	m := metricRabbitmqExchangeMessageRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqFederationLinkCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.federation.link.count metric with initial data.
+func (m *metricRabbitmqFederationLinkCount) init() {
+	m.data.SetName("rabbitmq.federation.link.count")
+	m.data.SetDescription("The number of federation links of an upstream by state.")
+	m.data.SetUnit("{links}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqFederationLinkCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, federationUpstreamAttributeValue string, federationLinkStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", federationUpstreamAttributeValue)
+	dp.Attributes().PutStr("state", federationLinkStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqFederationLinkCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqFederationLinkCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqFederationLinkCount(cfg MetricConfig) metricRabbitmqFederationLinkCount {
+	m := metricRabbitmqFederationLinkCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRabbitmqMessageAcknowledged struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -345,6 +623,215 @@ func newMetricRabbitmqMessagePublished(cfg MetricConfig) metricRabbitmqMessagePu
 	return m
 }
 
+type metricRabbitmqMessageRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.message.rate metric with initial data.
+func (m *metricRabbitmqMessageRate) init() {
+	m.data.SetName("rabbitmq.message.rate")
+	m.data.SetDescription("The rate at which messages are processed by a queue.")
+	m.data.SetUnit("{messages}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqMessageRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, messageOperationAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("operation", messageOperationAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqMessageRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqMessageRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqMessageRate(cfg MetricConfig) metricRabbitmqMessageRate {
+	m := metricRabbitmqMessageRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqNodeMemoryUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.node.memory.usage metric with initial data.
+func (m *metricRabbitmqNodeMemoryUsage) init() {
+	m.data.SetName("rabbitmq.node.memory.usage")
+	m.data.SetDescription("The memory allocated by a node by category.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqNodeMemoryUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, memoryCategoryAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("category", memoryCategoryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqNodeMemoryUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqNodeMemoryUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqNodeMemoryUsage(cfg MetricConfig) metricRabbitmqNodeMemoryUsage {
+	m := metricRabbitmqNodeMemoryUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqShovelState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.shovel.state metric with initial data.
+func (m *metricRabbitmqShovelState) init() {
+	m.data.SetName("rabbitmq.shovel.state")
+	m.data.SetDescription("The state of a shovel. The value is 1 for the current state of the shovel.")
+	m.data.SetUnit("{state}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqShovelState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, shovelNameAttributeValue string, shovelStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("shovel", shovelNameAttributeValue)
+	dp.Attributes().PutStr("state", shovelStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqShovelState) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqShovelState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqShovelState(cfg MetricConfig) metricRabbitmqShovelState {
+	m := metricRabbitmqShovelState{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqVhostMessageRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.vhost.message.rate metric with initial data.
+func (m *metricRabbitmqVhostMessageRate) init() {
+	m.data.SetName("rabbitmq.vhost.message.rate")
+	m.data.SetDescription("The rate at which messages are processed by all the queues of a vHost.")
+	m.data.SetUnit("{messages}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqVhostMessageRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, messageOperationAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("operation", messageOperationAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqVhostMessageRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqVhostMessageRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqVhostMessageRate(cfg MetricConfig) metricRabbitmqVhostMessageRate {
+	m := metricRabbitmqVhostMessageRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -355,11 +842,18 @@ type MetricsBuilder struct {
 	buildInfo                         component.BuildInfo // contains version information
 	resourceAttributesConfig          ResourceAttributesConfig
 	metricRabbitmqConsumerCount       metricRabbitmqConsumerCount
+	metricRabbitmqConsumerUtilization metricRabbitmqConsumerUtilization
+	metricRabbitmqExchangeMessageRate metricRabbitmqExchangeMessageRate
+	metricRabbitmqFederationLinkCount metricRabbitmqFederationLinkCount
 	metricRabbitmqMessageAcknowledged metricRabbitmqMessageAcknowledged
 	metricRabbitmqMessageCurrent      metricRabbitmqMessageCurrent
 	metricRabbitmqMessageDelivered    metricRabbitmqMessageDelivered
 	metricRabbitmqMessageDropped      metricRabbitmqMessageDropped
 	metricRabbitmqMessagePublished    metricRabbitmqMessagePublished
+	metricRabbitmqMessageRate         metricRabbitmqMessageRate
+	metricRabbitmqNodeMemoryUsage     metricRabbitmqNodeMemoryUsage
+	metricRabbitmqShovelState         metricRabbitmqShovelState
+	metricRabbitmqVhostMessageRate    metricRabbitmqVhostMessageRate
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -379,11 +873,18 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		buildInfo:                         settings.BuildInfo,
 		resourceAttributesConfig:          mbc.ResourceAttributes,
 		metricRabbitmqConsumerCount:       newMetricRabbitmqConsumerCount(mbc.Metrics.RabbitmqConsumerCount),
+		metricRabbitmqConsumerUtilization: newMetricRabbitmqConsumerUtilization(mbc.Metrics.RabbitmqConsumerUtilization),
+		metricRabbitmqExchangeMessageRate: newMetricRabbitmqExchangeMessageRate(mbc.Metrics.RabbitmqExchangeMessageRate),
+		metricRabbitmqFederationLinkCount: newMetricRabbitmqFederationLinkCount(mbc.Metrics.RabbitmqFederationLinkCount),
 		metricRabbitmqMessageAcknowledged: newMetricRabbitmqMessageAcknowledged(mbc.Metrics.RabbitmqMessageAcknowledged),
 		metricRabbitmqMessageCurrent:      newMetricRabbitmqMessageCurrent(mbc.Metrics.RabbitmqMessageCurrent),
 		metricRabbitmqMessageDelivered:    newMetricRabbitmqMessageDelivered(mbc.Metrics.RabbitmqMessageDelivered),
 		metricRabbitmqMessageDropped:      newMetricRabbitmqMessageDropped(mbc.Metrics.RabbitmqMessageDropped),
 		metricRabbitmqMessagePublished:    newMetricRabbitmqMessagePublished(mbc.Metrics.RabbitmqMessagePublished),
+		metricRabbitmqMessageRate:         newMetricRabbitmqMessageRate(mbc.Metrics.RabbitmqMessageRate),
+		metricRabbitmqNodeMemoryUsage:     newMetricRabbitmqNodeMemoryUsage(mbc.Metrics.RabbitmqNodeMemoryUsage),
+		metricRabbitmqShovelState:         newMetricRabbitmqShovelState(mbc.Metrics.RabbitmqShovelState),
+		metricRabbitmqVhostMessageRate:    newMetricRabbitmqVhostMessageRate(mbc.Metrics.RabbitmqVhostMessageRate),
 	}
 	for _, op := range options {
 		op(mb)
@@ -404,6 +905,15 @@ func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
 // ResourceMetricsOption applies changes to provided resource metrics.
 type ResourceMetricsOption func(ResourceAttributesConfig, pmetric.ResourceMetrics)
 
+// WithRabbitmqExchangeName sets provided value as "rabbitmq.exchange.name" attribute for current resource.
+func WithRabbitmqExchangeName(val string) ResourceMetricsOption {
+	return func(rac ResourceAttributesConfig, rm pmetric.ResourceMetrics) {
+		if rac.RabbitmqExchangeName.Enabled {
+			rm.Resource().Attributes().PutStr("rabbitmq.exchange.name", val)
+		}
+	}
+}
+
 // WithRabbitmqNodeName sets provided value as "rabbitmq.node.name" attribute for current resource.
 func WithRabbitmqNodeName(val string) ResourceMetricsOption {
 	return func(rac ResourceAttributesConfig, rm pmetric.ResourceMetrics) {
@@ -464,11 +974,18 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricRabbitmqConsumerCount.emit(ils.Metrics())
+	mb.metricRabbitmqConsumerUtilization.emit(ils.Metrics())
+	mb.metricRabbitmqExchangeMessageRate.emit(ils.Metrics())
+	mb.metricRabbitmqFederationLinkCount.emit(ils.Metrics())
 	mb.metricRabbitmqMessageAcknowledged.emit(ils.Metrics())
 	mb.metricRabbitmqMessageCurrent.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDelivered.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDropped.emit(ils.Metrics())
 	mb.metricRabbitmqMessagePublished.emit(ils.Metrics())
+	mb.metricRabbitmqMessageRate.emit(ils.Metrics())
+	mb.metricRabbitmqNodeMemoryUsage.emit(ils.Metrics())
+	mb.metricRabbitmqShovelState.emit(ils.Metrics())
+	mb.metricRabbitmqVhostMessageRate.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(mb.resourceAttributesConfig, rm)
@@ -494,6 +1011,21 @@ func (mb *MetricsBuilder) RecordRabbitmqConsumerCountDataPoint(ts pcommon.Timest
 	mb.metricRabbitmqConsumerCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqConsumerUtilizationDataPoint adds a data point to rabbitmq.consumer.utilization metric.
+func (mb *MetricsBuilder) RecordRabbitmqConsumerUtilizationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricRabbitmqConsumerUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqExchangeMessageRateDataPoint adds a data point to rabbitmq.exchange.message.rate metric.
+func (mb *MetricsBuilder) RecordRabbitmqExchangeMessageRateDataPoint(ts pcommon.Timestamp, val float64, exchangeDirectionAttributeValue AttributeExchangeDirection) {
+	mb.metricRabbitmqExchangeMessageRate.recordDataPoint(mb.startTime, ts, val, exchangeDirectionAttributeValue.String())
+}
+
+// RecordRabbitmqFederationLinkCountDataPoint adds a data point to rabbitmq.federation.link.count metric.
+func (mb *MetricsBuilder) RecordRabbitmqFederationLinkCountDataPoint(ts pcommon.Timestamp, val int64, federationUpstreamAttributeValue string, federationLinkStateAttributeValue AttributeFederationLinkState) {
+	mb.metricRabbitmqFederationLinkCount.recordDataPoint(mb.startTime, ts, val, federationUpstreamAttributeValue, federationLinkStateAttributeValue.String())
+}
+
 // RecordRabbitmqMessageAcknowledgedDataPoint adds a data point to rabbitmq.message.acknowledged metric.
 func (mb *MetricsBuilder) RecordRabbitmqMessageAcknowledgedDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRabbitmqMessageAcknowledged.recordDataPoint(mb.startTime, ts, val)
@@ -519,6 +1051,26 @@ func (mb *MetricsBuilder) RecordRabbitmqMessagePublishedDataPoint(ts pcommon.Tim
 	mb.metricRabbitmqMessagePublished.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqMessageRateDataPoint adds a data point to rabbitmq.message.rate metric.
+func (mb *MetricsBuilder) RecordRabbitmqMessageRateDataPoint(ts pcommon.Timestamp, val float64, messageOperationAttributeValue AttributeMessageOperation) {
+	mb.metricRabbitmqMessageRate.recordDataPoint(mb.startTime, ts, val, messageOperationAttributeValue.String())
+}
+
+// RecordRabbitmqNodeMemoryUsageDataPoint adds a data point to rabbitmq.node.memory.usage metric.
+func (mb *MetricsBuilder) RecordRabbitmqNodeMemoryUsageDataPoint(ts pcommon.Timestamp, val int64, memoryCategoryAttributeValue string) {
+	mb.metricRabbitmqNodeMemoryUsage.recordDataPoint(mb.startTime, ts, val, memoryCategoryAttributeValue)
+}
+
+// RecordRabbitmqShovelStateDataPoint adds a data point to rabbitmq.shovel.state metric.
+func (mb *MetricsBuilder) RecordRabbitmqShovelStateDataPoint(ts pcommon.Timestamp, val int64, shovelNameAttributeValue string, shovelStateAttributeValue AttributeShovelState) {
+	mb.metricRabbitmqShovelState.recordDataPoint(mb.startTime, ts, val, shovelNameAttributeValue, shovelStateAttributeValue.String())
+}
+
+// RecordRabbitmqVhostMessageRateDataPoint adds a data point to rabbitmq.vhost.message.rate metric.
+func (mb *MetricsBuilder) RecordRabbitmqVhostMessageRateDataPoint(ts pcommon.Timestamp, val float64, messageOperationAttributeValue AttributeMessageOperation) {
+	mb.metricRabbitmqVhostMessageRate.recordDataPoint(mb.startTime, ts, val, messageOperationAttributeValue.String())
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -58,6 +58,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRabbitmqConsumerCountDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRabbitmqConsumerUtilizationDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordRabbitmqExchangeMessageRateDataPoint(ts, 1, AttributeExchangeDirection(1))
+
+			allMetricsCount++
+			mb.RecordRabbitmqFederationLinkCountDataPoint(ts, 1, "attr-val", AttributeFederationLinkState(1))
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRabbitmqMessageAcknowledgedDataPoint(ts, 1)
@@ -78,7 +87,19 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRabbitmqMessagePublishedDataPoint(ts, 1)
 
-			metrics := mb.Emit(WithRabbitmqNodeName("attr-val"), WithRabbitmqQueueName("attr-val"), WithRabbitmqVhostName("attr-val"))
+			allMetricsCount++
+			mb.RecordRabbitmqMessageRateDataPoint(ts, 1, AttributeMessageOperation(1))
+
+			allMetricsCount++
+			mb.RecordRabbitmqNodeMemoryUsageDataPoint(ts, 1, "attr-val")
+
+			allMetricsCount++
+			mb.RecordRabbitmqShovelStateDataPoint(ts, 1, "attr-val", AttributeShovelState(1))
+
+			allMetricsCount++
+			mb.RecordRabbitmqVhostMessageRateDataPoint(ts, 1, AttributeMessageOperation(1))
+
+			metrics := mb.Emit(WithRabbitmqExchangeName("attr-val"), WithRabbitmqNodeName("attr-val"), WithRabbitmqQueueName("attr-val"), WithRabbitmqVhostName("attr-val"))
 
 			if test.configSet == testSetNone {
 				assert.Equal(t, 0, metrics.ResourceMetrics().Len())
@@ -89,7 +110,14 @@ func TestMetricsBuilder(t *testing.T) {
 			rm := metrics.ResourceMetrics().At(0)
 			attrCount := 0
 			enabledAttrCount := 0
-			attrVal, ok := rm.Resource().Attributes().Get("rabbitmq.node.name")
+			attrVal, ok := rm.Resource().Attributes().Get("rabbitmq.exchange.name")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesConfig.RabbitmqExchangeName.Enabled, ok)
+			if mb.resourceAttributesConfig.RabbitmqExchangeName.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("rabbitmq.node.name")
 			attrCount++
 			assert.Equal(t, mb.resourceAttributesConfig.RabbitmqNodeName.Enabled, ok)
 			if mb.resourceAttributesConfig.RabbitmqNodeName.Enabled {
@@ -111,7 +139,7 @@ func TestMetricsBuilder(t *testing.T) {
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
 			assert.Equal(t, enabledAttrCount, rm.Resource().Attributes().Len())
-			assert.Equal(t, attrCount, 3)
+			assert.Equal(t, attrCount, 4)
 
 			assert.Equal(t, 1, rm.ScopeMetrics().Len())
 			ms := rm.ScopeMetrics().At(0).Metrics()
@@ -138,6 +166,53 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.consumer.utilization":
+					assert.False(t, validatedMetrics["rabbitmq.consumer.utilization"], "Found a duplicate in the metrics slice: rabbitmq.consumer.utilization")
+					validatedMetrics["rabbitmq.consumer.utilization"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The fraction of time the queue is able to immediately deliver messages to consumers.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "rabbitmq.exchange.message.rate":
+					assert.False(t, validatedMetrics["rabbitmq.exchange.message.rate"], "Found a duplicate in the metrics slice: rabbitmq.exchange.message.rate")
+					validatedMetrics["rabbitmq.exchange.message.rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The rate at which messages are published into and routed out of an exchange.", ms.At(i).Description())
+					assert.Equal(t, "{messages}/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.Equal(t, "in", attrVal.Str())
+				case "rabbitmq.federation.link.count":
+					assert.False(t, validatedMetrics["rabbitmq.federation.link.count"], "Found a duplicate in the metrics slice: rabbitmq.federation.link.count")
+					validatedMetrics["rabbitmq.federation.link.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of federation links of an upstream by state.", ms.At(i).Description())
+					assert.Equal(t, "{links}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("upstream")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.Equal(t, "starting", attrVal.Str())
 				case "rabbitmq.message.acknowledged":
 					assert.False(t, validatedMetrics["rabbitmq.message.acknowledged"], "Found a duplicate in the metrics slice: rabbitmq.message.acknowledged")
 					validatedMetrics["rabbitmq.message.acknowledged"] = true
@@ -211,6 +286,73 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.message.rate":
+					assert.False(t, validatedMetrics["rabbitmq.message.rate"], "Found a duplicate in the metrics slice: rabbitmq.message.rate")
+					validatedMetrics["rabbitmq.message.rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The rate at which messages are processed by a queue.", ms.At(i).Description())
+					assert.Equal(t, "{messages}/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("operation")
+					assert.True(t, ok)
+					assert.Equal(t, "publish", attrVal.Str())
+				case "rabbitmq.node.memory.usage":
+					assert.False(t, validatedMetrics["rabbitmq.node.memory.usage"], "Found a duplicate in the metrics slice: rabbitmq.node.memory.usage")
+					validatedMetrics["rabbitmq.node.memory.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The memory allocated by a node by category.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("category")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "rabbitmq.shovel.state":
+					assert.False(t, validatedMetrics["rabbitmq.shovel.state"], "Found a duplicate in the metrics slice: rabbitmq.shovel.state")
+					validatedMetrics["rabbitmq.shovel.state"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The state of a shovel. The value is 1 for the current state of the shovel.", ms.At(i).Description())
+					assert.Equal(t, "{state}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("shovel")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.Equal(t, "starting", attrVal.Str())
+				case "rabbitmq.vhost.message.rate":
+					assert.False(t, validatedMetrics["rabbitmq.vhost.message.rate"], "Found a duplicate in the metrics slice: rabbitmq.vhost.message.rate")
+					validatedMetrics["rabbitmq.vhost.message.rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The rate at which messages are processed by all the queues of a vHost.", ms.At(i).Description())
+					assert.Equal(t, "{messages}/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("operation")
+					assert.True(t, ok)
+					assert.Equal(t, "publish", attrVal.Str())
 				}
 			}
 		})
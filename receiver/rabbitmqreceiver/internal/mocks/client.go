@@ -15,6 +15,98 @@ type MockClient struct {
 	mock.Mock
 }
 
+// GetExchanges provides a mock function with given fields: ctx
+func (_m *MockClient) GetExchanges(ctx context.Context) ([]*models.Exchange, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*models.Exchange
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.Exchange); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Exchange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFederationLinks provides a mock function with given fields: ctx
+func (_m *MockClient) GetFederationLinks(ctx context.Context) ([]*models.FederationLink, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*models.FederationLink
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.FederationLink); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.FederationLink)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNodeMemory provides a mock function with given fields: ctx, node
+func (_m *MockClient) GetNodeMemory(ctx context.Context, node string) (*models.NodeMemory, error) {
+	ret := _m.Called(ctx, node)
+
+	var r0 *models.NodeMemory
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.NodeMemory); ok {
+		r0 = rf(ctx, node)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NodeMemory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, node)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNodes provides a mock function with given fields: ctx
+func (_m *MockClient) GetNodes(ctx context.Context) ([]*models.Node, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*models.Node
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.Node); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Node)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetQueues provides a mock function with given fields: ctx
 func (_m *MockClient) GetQueues(ctx context.Context) ([]*models.Queue, error) {
 	ret := _m.Called(ctx)
@@ -37,3 +129,49 @@ func (_m *MockClient) GetQueues(ctx context.Context) ([]*models.Queue, error) {
 
 	return r0, r1
 }
+
+// GetShovels provides a mock function with given fields: ctx
+func (_m *MockClient) GetShovels(ctx context.Context) ([]*models.Shovel, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*models.Shovel
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.Shovel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Shovel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVHosts provides a mock function with given fields: ctx
+func (_m *MockClient) GetVHosts(ctx context.Context) ([]*models.VHost, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*models.VHost
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.VHost); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VHost)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -14,7 +14,60 @@ type Queue struct {
 	Consumers              int64 `json:"consumers"`
 	UnacknowledgedMessages int64 `json:"messages_unacknowledged"`
 	ReadyMessages          int64 `json:"messages_ready"`
+	// ConsumerUtilisation is not set when the queue has no consumers
+	ConsumerUtilisation *float64 `json:"consumer_utilisation"`
 
 	// Embedded Metrics
 	MessageStats map[string]interface{} `json:"message_stats"`
 }
+
+// Exchange represents an exchange in the API response
+type Exchange struct {
+	// Identifiers
+	Name  string `json:"name"`
+	VHost string `json:"vhost"`
+
+	// Embedded Metrics
+	MessageStats map[string]interface{} `json:"message_stats"`
+}
+
+// VHost represents a vHost in the API response
+type VHost struct {
+	// Identifiers
+	Name string `json:"name"`
+
+	// Embedded Metrics
+	MessageStats map[string]interface{} `json:"message_stats"`
+}
+
+// Shovel represents the status of a shovel in the API response
+type Shovel struct {
+	// Identifiers
+	Name  string `json:"name"`
+	VHost string `json:"vhost"`
+	Node  string `json:"node"`
+
+	State string `json:"state"`
+}
+
+// FederationLink represents the status of a federation link in the API response
+type FederationLink struct {
+	// Identifiers
+	Upstream string `json:"upstream"`
+	VHost    string `json:"vhost"`
+	Node     string `json:"node"`
+
+	Status string `json:"status"`
+}
+
+// Node represents a node in the API response
+type Node struct {
+	// Identifiers
+	Name string `json:"name"`
+}
+
+// NodeMemory represents the memory breakdown of a node in the API response
+type NodeMemory struct {
+	// Bytes allocated by category, along with the totals and the strategy used to compute them
+	Memory map[string]interface{} `json:"memory"`
+}
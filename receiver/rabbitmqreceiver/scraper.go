@@ -6,12 +6,14 @@ package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/metadata"
@@ -28,6 +30,18 @@ const (
 	dropUnroutableStat = "drop_unroutable"
 )
 
+// Names of the exchange metrics in message_stats
+const (
+	publishInStat  = "publish_in"
+	publishOutStat = "publish_out"
+)
+
+// The memory breakdown entries which are not a category
+const (
+	memoryTotal    = "total"
+	memoryStrategy = "strategy"
+)
+
 // Metrics to gather from queue message_stats structure
 var messageStatMetrics = []string{
 	deliverStat,
@@ -43,6 +57,8 @@ type rabbitmqScraper struct {
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+
+	queueFilter *queueFilter
 }
 
 // newScraper creates a new scraper
@@ -57,6 +73,9 @@ func newScraper(logger *zap.Logger, cfg *Config, settings receiver.CreateSetting
 
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (r *rabbitmqScraper) start(_ context.Context, host component.Host) (err error) {
+	if r.queueFilter, err = newQueueFilter(r.cfg.QueueFilter); err != nil {
+		return
+	}
 	r.client, err = newClient(r.cfg, host, r.settings, r.logger)
 	return
 }
@@ -119,6 +138,16 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 			r.mb.RecordRabbitmqMessageDroppedDataPoint(now, val64)
 		}
 	}
+	if queue.ConsumerUtilisation != nil {
+		r.mb.RecordRabbitmqConsumerUtilizationDataPoint(now, *queue.ConsumerUtilisation)
+	}
+
+	for stat, operation := range metadata.MapAttributeMessageOperation {
+		if rate, ok := getRate(queue.MessageStats, stat); ok {
+			r.mb.RecordRabbitmqMessageRateDataPoint(now, rate, operation)
+		}
+	}
+
 	r.mb.EmitForResource(
 		metadata.WithRabbitmqQueueName(queue.Name),
 		metadata.WithRabbitmqNodeName(queue.Node),
@@ -126,6 +155,172 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 	)
 }
 
+// collectExchanges collects the message rates of every exchange, only queried if their metric is enabled
+func (r *rabbitmqScraper) collectExchanges(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !r.cfg.Metrics.RabbitmqExchangeMessageRate.Enabled {
+		return
+	}
+
+	exchanges, err := r.client.GetExchanges(ctx)
+	if err != nil {
+		errs.AddPartial(1, err)
+		return
+	}
+
+	for _, exchange := range exchanges {
+		if rate, ok := getRate(exchange.MessageStats, publishInStat); ok {
+			r.mb.RecordRabbitmqExchangeMessageRateDataPoint(now, rate, metadata.AttributeExchangeDirectionIn)
+		}
+		if rate, ok := getRate(exchange.MessageStats, publishOutStat); ok {
+			r.mb.RecordRabbitmqExchangeMessageRateDataPoint(now, rate, metadata.AttributeExchangeDirectionOut)
+		}
+
+		r.mb.EmitForResource(
+			metadata.WithRabbitmqExchangeName(exchange.Name),
+			metadata.WithRabbitmqVhostName(exchange.VHost),
+		)
+	}
+}
+
+// collectVHosts collects the message rates of every vHost along with the status of the shovels and
+// federation links it contains. Each of them is only queried if its metric is enabled.
+func (r *rabbitmqScraper) collectVHosts(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	names := map[string]bool{}
+
+	messageStats := map[string]map[string]interface{}{}
+	if r.cfg.Metrics.RabbitmqVhostMessageRate.Enabled {
+		vhosts, err := r.client.GetVHosts(ctx)
+		if err != nil {
+			errs.AddPartial(1, err)
+		}
+		for _, vhost := range vhosts {
+			names[vhost.Name] = true
+			messageStats[vhost.Name] = vhost.MessageStats
+		}
+	}
+
+	shovels := map[string][]*models.Shovel{}
+	if r.cfg.Metrics.RabbitmqShovelState.Enabled {
+		all, err := r.client.GetShovels(ctx)
+		if err != nil {
+			errs.AddPartial(1, err)
+		}
+		for _, shovel := range all {
+			names[shovel.VHost] = true
+			shovels[shovel.VHost] = append(shovels[shovel.VHost], shovel)
+		}
+	}
+
+	links := map[string][]*models.FederationLink{}
+	if r.cfg.Metrics.RabbitmqFederationLinkCount.Enabled {
+		all, err := r.client.GetFederationLinks(ctx)
+		if err != nil {
+			errs.AddPartial(1, err)
+		}
+		for _, link := range all {
+			names[link.VHost] = true
+			links[link.VHost] = append(links[link.VHost], link)
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		for stat, operation := range metadata.MapAttributeMessageOperation {
+			if rate, ok := getRate(messageStats[name], stat); ok {
+				r.mb.RecordRabbitmqVhostMessageRateDataPoint(now, rate, operation)
+			}
+		}
+		r.collectShovels(shovels[name], now)
+		r.collectFederationLinks(links[name], now)
+
+		r.mb.EmitForResource(metadata.WithRabbitmqVhostName(name))
+	}
+}
+
+// collectShovels records the state of the shovels of a vHost
+func (r *rabbitmqScraper) collectShovels(shovels []*models.Shovel, now pcommon.Timestamp) {
+	for _, shovel := range shovels {
+		state, ok := metadata.MapAttributeShovelState[shovel.State]
+		if !ok {
+			r.logger.Debug("unknown shovel state", zap.String("Shovel", shovel.Name), zap.String("State", shovel.State))
+			continue
+		}
+		r.mb.RecordRabbitmqShovelStateDataPoint(now, 1, shovel.Name, state)
+	}
+}
+
+// collectFederationLinks records the number of federation links of a vHost by upstream and state
+func (r *rabbitmqScraper) collectFederationLinks(links []*models.FederationLink, now pcommon.Timestamp) {
+	type linkKey struct {
+		upstream string
+		state    metadata.AttributeFederationLinkState
+	}
+
+	counts := map[linkKey]int64{}
+	for _, link := range links {
+		state, ok := metadata.MapAttributeFederationLinkState[link.Status]
+		if !ok {
+			r.logger.Debug("unknown federation link status", zap.String("Upstream", link.Upstream), zap.String("Status", link.Status))
+			continue
+		}
+		counts[linkKey{upstream: link.Upstream, state: state}]++
+	}
+
+	for key, count := range counts {
+		r.mb.RecordRabbitmqFederationLinkCountDataPoint(now, count, key.upstream, key.state)
+	}
+}
+
+// collectNodes collects the memory breakdown of every node of the cluster, only queried if its metric is enabled
+func (r *rabbitmqScraper) collectNodes(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !r.cfg.Metrics.RabbitmqNodeMemoryUsage.Enabled {
+		return
+	}
+
+	nodes, err := r.client.GetNodes(ctx)
+	if err != nil {
+		errs.AddPartial(1, err)
+		return
+	}
+
+	for _, node := range nodes {
+		memory, memoryErr := r.client.GetNodeMemory(ctx, node.Name)
+		if memoryErr != nil {
+			errs.AddPartial(1, memoryErr)
+			continue
+		}
+
+		for category, val := range memory.Memory {
+			if category == memoryTotal || category == memoryStrategy {
+				continue
+			}
+			val64, ok := convertValToInt64(val)
+			if !ok {
+				r.logger.Warn("memory category not int64", zap.String("Category", category), zap.String("Node", node.Name))
+				continue
+			}
+			r.mb.RecordRabbitmqNodeMemoryUsageDataPoint(now, val64, category)
+		}
+
+		r.mb.EmitForResource(metadata.WithRabbitmqNodeName(node.Name))
+	}
+}
+
+// getRate returns the rate of a message_stats metric, found in its "<name>_details" object
+func getRate(messageStats map[string]interface{}, stat string) (float64, bool) {
+	details, ok := messageStats[stat+"_details"].(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	rate, ok := details["rate"].(float64)
+	return rate, ok
+}
+
 // convertValToInt64 values from message state unmarshal as float64s but should be int64.
 // Need to do a double cast to get an int64.
 // This should never fail but worth checking just in case.
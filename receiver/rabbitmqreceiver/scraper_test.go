@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -17,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
@@ -150,3 +152,148 @@ func TestScaperScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScraperScrapeOptionalMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RabbitmqMessageRate.Enabled = true
+	cfg.Metrics.RabbitmqConsumerUtilization.Enabled = true
+	cfg.Metrics.RabbitmqExchangeMessageRate.Enabled = true
+	cfg.Metrics.RabbitmqVhostMessageRate.Enabled = true
+	cfg.Metrics.RabbitmqShovelState.Enabled = true
+	cfg.Metrics.RabbitmqFederationLinkCount.Enabled = true
+	cfg.Metrics.RabbitmqNodeMemoryUsage.Enabled = true
+	cfg.QueueFilter.Include = []string{"^web"}
+
+	testCases := []struct {
+		desc               string
+		shovelsErr         error
+		expectedShovelsDps int
+	}{
+		{
+			desc:               "Successful Collection",
+			expectedShovelsDps: 2,
+		},
+		{
+			desc:       "Shovel Plugin Disabled",
+			shovelsErr: errors.New("non 200 code returned 404"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockClient := mocks.MockClient{}
+			var queues []*models.Queue
+			require.NoError(t, json.Unmarshal(loadAPIResponseData(t, queuesAPIResponseFile), &queues))
+			mockClient.On("GetQueues", mock.Anything).Return(queues, nil)
+			var exchanges []*models.Exchange
+			require.NoError(t, json.Unmarshal(loadAPIResponseData(t, exchangesAPIResponseFile), &exchanges))
+			mockClient.On("GetExchanges", mock.Anything).Return(exchanges, nil)
+			var vhosts []*models.VHost
+			require.NoError(t, json.Unmarshal(loadAPIResponseData(t, vhostsAPIResponseFile), &vhosts))
+			mockClient.On("GetVHosts", mock.Anything).Return(vhosts, nil)
+			if tc.shovelsErr != nil {
+				mockClient.On("GetShovels", mock.Anything).Return(nil, tc.shovelsErr)
+			} else {
+				var shovels []*models.Shovel
+				require.NoError(t, json.Unmarshal(loadAPIResponseData(t, shovelsAPIResponseFile), &shovels))
+				mockClient.On("GetShovels", mock.Anything).Return(shovels, nil)
+			}
+			var links []*models.FederationLink
+			require.NoError(t, json.Unmarshal(loadAPIResponseData(t, federationLinksAPIResponseFile), &links))
+			mockClient.On("GetFederationLinks", mock.Anything).Return(links, nil)
+			var nodes []*models.Node
+			require.NoError(t, json.Unmarshal(loadAPIResponseData(t, nodesAPIResponseFile), &nodes))
+			mockClient.On("GetNodes", mock.Anything).Return(nodes, nil)
+			var memory *models.NodeMemory
+			require.NoError(t, json.Unmarshal(loadAPIResponseData(t, nodeMemoryAPIResponseFile), &memory))
+			mockClient.On("GetNodeMemory", mock.Anything, "rabbit@66a8f7a0e8a4").Return(memory, nil)
+
+			scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopCreateSettings())
+			scraper.client = &mockClient
+			var err error
+			scraper.queueFilter, err = newQueueFilter(cfg.QueueFilter)
+			require.NoError(t, err)
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			if tc.shovelsErr != nil {
+				require.EqualError(t, err, tc.shovelsErr.Error())
+				require.True(t, scrapererror.IsPartialScrapeError(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			// The test2 queue is filtered out and the amq.topic exchange has no message stats
+			require.Nil(t, findResource(actualMetrics, "rabbitmq.queue.name", "test2"))
+			require.Nil(t, findResource(actualMetrics, "rabbitmq.exchange.name", "amq.topic"))
+
+			queue := findResource(actualMetrics, "rabbitmq.queue.name", "webq1")
+			require.NotNil(t, queue)
+			require.Equal(t, 4, findMetric(t, queue, "rabbitmq.message.rate").Gauge().DataPoints().Len())
+			utilization := findMetric(t, queue, "rabbitmq.consumer.utilization").Gauge().DataPoints()
+			require.Equal(t, 1, utilization.Len())
+			assert.InDelta(t, 0.5256, utilization.At(0).DoubleValue(), 0.0001)
+
+			exchange := findResource(actualMetrics, "rabbitmq.exchange.name", "webex")
+			require.NotNil(t, exchange)
+			require.Equal(t, 2, findMetric(t, exchange, "rabbitmq.exchange.message.rate").Gauge().DataPoints().Len())
+
+			vhost := findResource(actualMetrics, "rabbitmq.vhost.name", "dev")
+			require.NotNil(t, vhost)
+			require.Equal(t, 4, findMetric(t, vhost, "rabbitmq.vhost.message.rate").Gauge().DataPoints().Len())
+			if tc.expectedShovelsDps > 0 {
+				require.Equal(t, tc.expectedShovelsDps, findMetric(t, vhost, "rabbitmq.shovel.state").Sum().DataPoints().Len())
+			}
+			linkDps := findMetric(t, vhost, "rabbitmq.federation.link.count").Sum().DataPoints()
+			require.Equal(t, 2, linkDps.Len())
+			for i := 0; i < linkDps.Len(); i++ {
+				upstream, _ := linkDps.At(i).Attributes().Get("upstream")
+				state, _ := linkDps.At(i).Attributes().Get("state")
+				switch upstream.Str() {
+				case "dc2":
+					assert.Equal(t, "running", state.Str())
+					assert.EqualValues(t, 2, linkDps.At(i).IntValue())
+				case "dc3":
+					assert.Equal(t, "error", state.Str())
+					assert.EqualValues(t, 1, linkDps.At(i).IntValue())
+				default:
+					assert.Failf(t, "unexpected upstream", "%s", upstream.Str())
+				}
+			}
+
+			node := findResource(actualMetrics, "rabbitmq.node.name", "rabbit@66a8f7a0e8a4")
+			require.NotNil(t, node)
+			require.Equal(t, 21, findMetric(t, node, "rabbitmq.node.memory.usage").Sum().DataPoints().Len())
+		})
+	}
+}
+
+// findResource returns the resource metrics of the queue, exchange, vHost or node identified by the
+// given resource attribute, or nil if there are none
+func findResource(md pmetric.Metrics, key, value string) *pmetric.ResourceMetrics {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		attrs := rm.Resource().Attributes()
+		if val, ok := attrs.Get(key); ok && val.Str() == value {
+			// Queues also have their node and vHost set, exchanges their vHost
+			if _, isQueue := attrs.Get("rabbitmq.queue.name"); isQueue && key != "rabbitmq.queue.name" {
+				continue
+			}
+			if _, isExchange := attrs.Get("rabbitmq.exchange.name"); isExchange && key == "rabbitmq.vhost.name" {
+				continue
+			}
+			return &rm
+		}
+	}
+	return nil
+}
+
+func findMetric(t *testing.T, rm *pmetric.ResourceMetrics, name string) pmetric.Metric {
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "%s", name)
+	return pmetric.Metric{}
+}
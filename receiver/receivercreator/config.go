@@ -45,7 +45,14 @@ type receiverTemplate struct {
 	// ResourceAttributes is a map of resource attributes to add to just this receiver's resource metrics.
 	// It can contain expr expressions for endpoint env value expansion
 	ResourceAttributes map[string]interface{} `mapstructure:"resource_attributes"`
-	rule               rule
+	// ConfigAnnotation is the name of the annotation of the discovered pods holding a YAML map of
+	// receiver config merged into the config of the template, e.g.
+	// io.opentelemetry.discovery.metrics/config. The values of the annotation take precedence.
+	ConfigAnnotation string `mapstructure:"config_annotation"`
+	// ConfigAnnotationKeys are the top-level config keys the annotation is allowed to set. The
+	// other keys of the annotation are ignored. It is required with ConfigAnnotation.
+	ConfigAnnotationKeys []string `mapstructure:"config_annotation_keys"`
+	rule                 rule
 }
 
 // resourceAttributes holds a map of default resource attributes for each Endpoint type.
@@ -124,6 +131,10 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 			return fmt.Errorf("subreceiver %q rule is invalid: %w", subreceiverKey, err)
 		}
 
+		if subreceiver.ConfigAnnotation != "" && len(subreceiver.ConfigAnnotationKeys) == 0 {
+			return fmt.Errorf("subreceiver %q config_annotation_keys must be set with config_annotation", subreceiverKey)
+		}
+
 		for k, v := range subreceiver.ResourceAttributes {
 			if _, ok := v.(string); !ok {
 				return fmt.Errorf("unsupported `resource_attributes` %q value %v in %s", k, v, subreceiverKey)
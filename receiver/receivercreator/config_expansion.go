@@ -6,9 +6,11 @@ package receivercreator // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/antonmedv/expr"
+	"gopkg.in/yaml.v3"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 )
@@ -142,3 +144,63 @@ func expandAny(input interface{}, env observer.EndpointEnv) (interface{}, error)
 		return v, nil
 	}
 }
+
+// annotationConfig returns the receiver config set as a YAML map by the given annotation of the
+// endpoint, or of its pod for port endpoints. It returns nil if the annotation is not set.
+func annotationConfig(env observer.EndpointEnv, annotation string) (userConfigMap, error) {
+	annotations, ok := env["annotations"].(map[string]string)
+	if !ok {
+		if pod, isPod := env["pod"].(observer.EndpointEnv); isPod {
+			annotations, _ = pod["annotations"].(map[string]string)
+		}
+	}
+	value, ok := annotations[annotation]
+	if !ok {
+		return nil, nil
+	}
+
+	cfg := userConfigMap{}
+	if err := yaml.Unmarshal([]byte(value), &cfg); err != nil {
+		return nil, fmt.Errorf("annotation %q is not a valid config map: %w", annotation, err)
+	}
+	return cfg, nil
+}
+
+// allowedConfig returns the entries of cfg whose key is one of the allowed keys, with the sorted
+// keys of the other entries.
+func allowedConfig(cfg userConfigMap, allowedKeys []string) (userConfigMap, []string) {
+	isAllowed := make(map[string]bool, len(allowedKeys))
+	for _, key := range allowedKeys {
+		isAllowed[key] = true
+	}
+	allowed := userConfigMap{}
+	var ignored []string
+	for key, val := range cfg {
+		if isAllowed[key] {
+			allowed[key] = val
+			continue
+		}
+		ignored = append(ignored, key)
+	}
+	sort.Strings(ignored)
+	return allowed, ignored
+}
+
+// mergeConfig returns a copy of cfg with the values of override. The maps in both configs are
+// merged recursively, the other values of override replace the ones of cfg.
+func mergeConfig(cfg, override userConfigMap) userConfigMap {
+	merged := userConfigMap{}
+	for key, val := range cfg {
+		merged[key] = val
+	}
+	for key, val := range override {
+		overrideMap, isMap := val.(map[string]interface{})
+		cfgMap, cfgIsMap := merged[key].(map[string]interface{})
+		if isMap && cfgIsMap {
+			merged[key] = map[string]interface{}(mergeConfig(cfgMap, overrideMap))
+			continue
+		}
+		merged[key] = val
+	}
+	return merged
+}
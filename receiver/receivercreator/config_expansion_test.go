@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 )
@@ -164,3 +165,109 @@ func Test_userConfigMap_resolve(t *testing.T) {
 		})
 	}
 }
+
+func Test_annotationConfig(t *testing.T) {
+	annotation := "io.opentelemetry.discovery.metrics/config"
+	tests := []struct {
+		name    string
+		env     observer.EndpointEnv
+		want    userConfigMap
+		wantErr string
+	}{
+		{
+			name: "pod annotation",
+			env: observer.EndpointEnv{"annotations": map[string]string{
+				annotation: "collection_interval: 10s\nmetrics_path: /custom/metrics",
+			}},
+			want: userConfigMap{
+				"collection_interval": "10s",
+				"metrics_path":        "/custom/metrics",
+			},
+		},
+		{
+			name: "port pod annotation",
+			env: observer.EndpointEnv{"pod": observer.EndpointEnv{"annotations": map[string]string{
+				annotation: "params:\n  format: [prometheus]",
+			}}},
+			want: userConfigMap{
+				"params": map[string]interface{}{
+					"format": []interface{}{"prometheus"},
+				},
+			},
+		},
+		{
+			name: "annotation not set",
+			env:  observer.EndpointEnv{"annotations": map[string]string{"scrape": "true"}},
+		},
+		{
+			name: "no annotations",
+			env:  observer.EndpointEnv{"name": "otel-agent"},
+		},
+		{
+			name:    "not a map",
+			env:     observer.EndpointEnv{"annotations": map[string]string{annotation: "10s"}},
+			wantErr: `annotation "io.opentelemetry.discovery.metrics/config" is not a valid config map`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := annotationConfig(tt.env, annotation)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_allowedConfig(t *testing.T) {
+	allowed, ignored := allowedConfig(userConfigMap{
+		"collection_interval": "10s",
+		"metrics_path":        "/custom/metrics",
+		"endpoint":            "attacker:9090",
+		"auth":                map[string]interface{}{"authenticator": "none"},
+	}, []string{"collection_interval", "metrics_path", "params"})
+
+	assert.Equal(t, userConfigMap{
+		"collection_interval": "10s",
+		"metrics_path":        "/custom/metrics",
+	}, allowed)
+	assert.Equal(t, []string{"auth", "endpoint"}, ignored)
+}
+
+func Test_mergeConfig(t *testing.T) {
+	cfg := userConfigMap{
+		"endpoint":            "`endpoint`",
+		"collection_interval": "30s",
+		"params": map[string]interface{}{
+			"format": "prometheus",
+			"module": "http",
+		},
+	}
+	merged := mergeConfig(cfg, userConfigMap{
+		"collection_interval": "10s",
+		"params": map[string]interface{}{
+			"module": "tcp",
+		},
+		"tls": map[string]interface{}{
+			"insecure": true,
+		},
+	})
+
+	assert.Equal(t, userConfigMap{
+		"endpoint":            "`endpoint`",
+		"collection_interval": "10s",
+		"params": map[string]interface{}{
+			"format": "prometheus",
+			"module": "tcp",
+		},
+		"tls": map[string]interface{}{
+			"insecure": true,
+		},
+	}, merged)
+	// The template config is unchanged
+	assert.Equal(t, "30s", cfg["collection_interval"])
+	assert.Equal(t, "http", cfg["params"].(map[string]interface{})["module"])
+}
@@ -87,9 +87,11 @@ func TestLoadConfig(t *testing.T) {
 							},
 							endpointID: "endpoint.id",
 						},
-						Rule:               `type == "port"`,
-						ResourceAttributes: map[string]interface{}{"one": "two"},
-						rule:               portRule,
+						Rule:                 `type == "port"`,
+						ResourceAttributes:   map[string]interface{}{"one": "two"},
+						ConfigAnnotation:     "io.opentelemetry.discovery.metrics/config",
+						ConfigAnnotationKeys: []string{"key"},
+						rule:                 portRule,
 					},
 					"nop/1": {
 						receiverConfig: receiverConfig{
@@ -160,6 +162,19 @@ func TestInvalidReceiverResourceAttributeValueType(t *testing.T) {
 	require.Nil(t, cfg)
 }
 
+func TestConfigAnnotationWithoutKeys(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.Nil(t, err)
+
+	factories.Receivers[("nop")] = &nopWithEndpointFactory{Factory: receivertest.NewNopFactory()}
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "invalid-config-annotation.yaml"), factories)
+	require.Contains(t, err.Error(), "error reading configuration for \"receiver_creator\": subreceiver \"examplereceiver/1\" config_annotation_keys must be set with config_annotation")
+	require.Nil(t, cfg)
+}
+
 type nopWithEndpointConfig struct {
 	Endpoint string `mapstructure:"endpoint"`
 	IntField int    `mapstructure:"int_field"`
@@ -98,7 +98,21 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 				zap.String("endpoint", e.Target),
 				zap.String("endpoint_id", string(e.ID)))
 
-			resolvedConfig, err := expandConfig(template.config, env)
+			templateConfig := template.config
+			if template.ConfigAnnotation != "" {
+				annotationCfg, annotationErr := annotationConfig(env, template.ConfigAnnotation)
+				if annotationErr != nil {
+					obs.params.TelemetrySettings.Logger.Error("unable to read annotation config", zap.String("receiver", template.id.String()), zap.String("endpoint_id", string(e.ID)), zap.Error(annotationErr))
+					continue
+				}
+				allowedCfg, ignoredKeys := allowedConfig(annotationCfg, template.ConfigAnnotationKeys)
+				if len(ignoredKeys) > 0 {
+					obs.params.TelemetrySettings.Logger.Warn("ignoring annotation config keys which are not allowed", zap.String("receiver", template.id.String()), zap.String("endpoint_id", string(e.ID)), zap.Strings("keys", ignoredKeys))
+				}
+				templateConfig = mergeConfig(templateConfig, allowedCfg)
+			}
+
+			resolvedConfig, err := expandConfig(templateConfig, env)
 			if err != nil {
 				obs.params.TelemetrySettings.Logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
 				continue
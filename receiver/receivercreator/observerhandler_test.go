@@ -418,6 +418,96 @@ func TestOnChange(t *testing.T) {
 	assert.Same(t, newRcvr, handler.receiversByEndpointID.Get("port-1")[0])
 }
 
+func TestOnAddWithConfigAnnotation(t *testing.T) {
+	annotatedPod := pod
+	annotatedPod.Annotations = map[string]string{
+		"io.opentelemetry.discovery.metrics/config": "int_field: 42\nendpoint: '`pod.name`.svc:9090'",
+		"io.opentelemetry.discovery.logs/config":    "int_field: [",
+	}
+	annotatedPortEndpoint := portEndpoint
+	annotatedPortEndpoint.Details = &observer.Port{
+		Name:      "http",
+		Pod:       annotatedPod,
+		Port:      1234,
+		Transport: observer.ProtocolTCP,
+	}
+
+	for _, test := range []struct {
+		name                   string
+		configAnnotation       string
+		configAnnotationKeys   []string
+		expectedReceiverConfig component.Config
+	}{
+		{
+			name:                 "annotation config merged",
+			configAnnotation:     "io.opentelemetry.discovery.metrics/config",
+			configAnnotationKeys: []string{"int_field", "endpoint"},
+			expectedReceiverConfig: &nopWithEndpointConfig{
+				IntField: 42,
+				Endpoint: "pod-1.svc:9090",
+			},
+		},
+		{
+			name:                 "annotation keys not allowed",
+			configAnnotation:     "io.opentelemetry.discovery.metrics/config",
+			configAnnotationKeys: []string{"int_field"},
+			expectedReceiverConfig: &nopWithEndpointConfig{
+				IntField: 42,
+				Endpoint: "localhost:1234",
+			},
+		},
+		{
+			name:                 "annotation not set",
+			configAnnotation:     "io.opentelemetry.discovery.traces/config",
+			configAnnotationKeys: []string{"int_field"},
+			expectedReceiverConfig: &nopWithEndpointConfig{
+				IntField: 12345678,
+				Endpoint: "localhost:1234",
+			},
+		},
+		{
+			name:                 "invalid annotation config",
+			configAnnotation:     "io.opentelemetry.discovery.logs/config",
+			configAnnotationKeys: []string{"int_field"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			rcvrCfg := receiverConfig{
+				id:         component.NewIDWithName("with.endpoint", "some.name"),
+				config:     userConfigMap{"int_field": 12345678},
+				endpointID: annotatedPortEndpoint.ID,
+			}
+			cfg.receiverTemplates = map[string]receiverTemplate{
+				rcvrCfg.id.String(): {
+					receiverConfig:       rcvrCfg,
+					rule:                 portRule,
+					Rule:                 `type == "port"`,
+					ResourceAttributes:   map[string]interface{}{},
+					ConfigAnnotation:     test.configAnnotation,
+					ConfigAnnotationKeys: test.configAnnotationKeys,
+				},
+			}
+
+			handler, mr := newObserverHandler(t, cfg, nil, consumertest.NewNop(), nil)
+			handler.OnAdd([]observer.Endpoint{annotatedPortEndpoint})
+
+			if test.expectedReceiverConfig == nil {
+				assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+				require.Nil(t, mr.startedComponent)
+				return
+			}
+
+			require.NoError(t, mr.lastError)
+			wr, ok := mr.startedComponent.(*wrappedReceiver)
+			require.True(t, ok)
+			rcvr, ok := wr.metrics.(*nopWithEndpointReceiver)
+			require.True(t, ok)
+			require.Equal(t, test.expectedReceiverConfig, rcvr.cfg)
+		})
+	}
+}
+
 type mockRunner struct {
 	receiverRunner
 	startedComponent  component.Component
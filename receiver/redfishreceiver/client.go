@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redfishreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redfishreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const chassisCollectionPath = "/redfish/v1/Chassis"
+
+// odataID is a link to another resource of the Redfish service.
+type odataID struct {
+	ID string `json:"@odata.id"`
+}
+
+type collection struct {
+	Members []odataID `json:"Members"`
+}
+
+type status struct {
+	State  string `json:"State"`
+	Health string `json:"Health"`
+}
+
+// present returns false when the component is not installed or is disabled,
+// in which case its readings are meaningless.
+func (s status) present() bool {
+	return s.State != "Absent" && s.State != "Disabled"
+}
+
+type chassis struct {
+	ID           string   `json:"Id"`
+	Name         string   `json:"Name"`
+	Manufacturer string   `json:"Manufacturer"`
+	Model        string   `json:"Model"`
+	SerialNumber string   `json:"SerialNumber"`
+	Status       status   `json:"Status"`
+	Thermal      *odataID `json:"Thermal"`
+	Power        *odataID `json:"Power"`
+}
+
+type thermal struct {
+	Temperatures []temperature `json:"Temperatures"`
+	Fans         []fan         `json:"Fans"`
+}
+
+type temperature struct {
+	Name           string   `json:"Name"`
+	ReadingCelsius *float64 `json:"ReadingCelsius"`
+	Status         status   `json:"Status"`
+}
+
+type fan struct {
+	Name string `json:"Name"`
+	// FanName is used instead of Name by services implementing older versions of the schema.
+	FanName      string   `json:"FanName"`
+	Reading      *float64 `json:"Reading"`
+	ReadingUnits string   `json:"ReadingUnits"`
+	Status       status   `json:"Status"`
+}
+
+type power struct {
+	PowerControl  []powerControl `json:"PowerControl"`
+	PowerSupplies []powerSupply  `json:"PowerSupplies"`
+}
+
+type powerControl struct {
+	Name               string   `json:"Name"`
+	PowerConsumedWatts *float64 `json:"PowerConsumedWatts"`
+}
+
+type powerSupply struct {
+	Name            string   `json:"Name"`
+	PowerInputWatts *float64 `json:"PowerInputWatts"`
+	Status          status   `json:"Status"`
+}
+
+// redfishClient reads the resources of a Redfish service.
+type redfishClient struct {
+	client   *http.Client
+	endpoint *url.URL
+	username string
+	password string
+}
+
+func newRedfishClient(client *http.Client, cfg *Config) (*redfishClient, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &redfishClient{
+		client:   client,
+		endpoint: endpoint,
+		username: cfg.Username,
+		password: string(cfg.Password),
+	}, nil
+}
+
+// get reads the resource at the path, which is usually the @odata.id of a link, into v.
+func (c *redfishClient) get(ctx context.Context, path string, v interface{}) error {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return fmt.Errorf("invalid resource path '%s': %w", path, err)
+	}
+	u := c.endpoint.ResolveReference(ref)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("OData-Version", "4.0")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return fmt.Errorf("request to %s failed with status %d", u.Path, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", u.Path, err)
+	}
+	return nil
+}
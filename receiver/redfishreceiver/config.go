@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redfishreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redfishreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redfishreceiver/internal/metadata"
+)
+
+var (
+	errMissingEndpoint = errors.New("endpoint must be specified")
+	errMissingUsername = errors.New("username must be specified when a password is set")
+)
+
+// Config defines the configuration for the Redfish receiver.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	confighttp.HTTPClientSettings           `mapstructure:",squash"`
+	MetricsBuilderConfig                    metadata.MetricsBuilderConfig `mapstructure:",squash"`
+
+	// Username is the user used to authenticate against the Redfish service with basic authentication.
+	Username string `mapstructure:"username"`
+	// Password is the password of the user.
+	Password configopaque.String `mapstructure:"password"`
+}
+
+// Validate checks if the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errMissingEndpoint
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint '%s': %w", cfg.Endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid endpoint '%s': scheme must be http or https", cfg.Endpoint)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid endpoint '%s': missing host", cfg.Endpoint)
+	}
+	if cfg.Password != "" && cfg.Username == "" {
+		return errMissingUsername
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redfishreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redfishreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	require.Equal(t, "https://bmc.example.com", cfg.Endpoint)
+	require.Equal(t, "monitoring", cfg.Username)
+	require.EqualValues(t, "secret", cfg.Password)
+	require.Equal(t, 2*time.Minute, cfg.CollectionInterval)
+	require.Equal(t, 30*time.Second, cfg.Timeout)
+	require.True(t, cfg.TLSSetting.InsecureSkipVerify)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		mutate      func(*Config)
+		expectedErr string
+	}{
+		{
+			desc:   "valid",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			desc:        "missing endpoint",
+			mutate:      func(cfg *Config) { cfg.Endpoint = "" },
+			expectedErr: errMissingEndpoint.Error(),
+		},
+		{
+			desc:        "unparsable endpoint",
+			mutate:      func(cfg *Config) { cfg.Endpoint = "https://bmc:port" },
+			expectedErr: "invalid endpoint 'https://bmc:port'",
+		},
+		{
+			desc:        "invalid scheme",
+			mutate:      func(cfg *Config) { cfg.Endpoint = "ipmi://bmc.example.com" },
+			expectedErr: "invalid endpoint 'ipmi://bmc.example.com': scheme must be http or https",
+		},
+		{
+			desc:        "missing host",
+			mutate:      func(cfg *Config) { cfg.Endpoint = "https:///redfish" },
+			expectedErr: "invalid endpoint 'https:///redfish': missing host",
+		},
+		{
+			desc:        "password without username",
+			mutate:      func(cfg *Config) { cfg.Username = "" },
+			expectedErr: errMissingUsername.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "https://bmc.example.com"
+			cfg.Username = "monitoring"
+			cfg.Password = "secret"
+			tc.mutate(cfg)
+			err := component.ValidateConfig(cfg)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
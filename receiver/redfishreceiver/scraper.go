@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redfishreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redfishreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redfishreceiver/internal/metadata"
+)
+
+var errClientNotInit = errors.New("client not initialized")
+
+type redfishScraper struct {
+	settings component.TelemetrySettings
+	cfg      *Config
+	client   *redfishClient
+	mb       *metadata.MetricsBuilder
+}
+
+func newRedfishScraper(settings receiver.CreateSettings, cfg *Config) *redfishScraper {
+	return &redfishScraper{
+		settings: settings.TelemetrySettings,
+		cfg:      cfg,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+	}
+}
+
+func (r *redfishScraper) start(_ context.Context, host component.Host) error {
+	httpClient, err := r.cfg.ToClient(host, r.settings)
+	if err != nil {
+		return err
+	}
+	r.client, err = newRedfishClient(httpClient, r.cfg)
+	return err
+}
+
+func (r *redfishScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if r.client == nil {
+		return pmetric.NewMetrics(), errClientNotInit
+	}
+
+	var chassisCollection collection
+	if err := r.client.get(ctx, chassisCollectionPath, &chassisCollection); err != nil {
+		r.settings.Logger.Error("failed to list the chassis of the Redfish service", zap.Error(err))
+		return pmetric.NewMetrics(), err
+	}
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for _, member := range chassisCollection.Members {
+		var c chassis
+		if err := r.client.get(ctx, member.ID, &c); err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to get chassis %s: %w", member.ID, err))
+			continue
+		}
+
+		recordHealth(c.Status.Health, func(val int64, health metadata.AttributeHealth) {
+			r.mb.RecordRedfishChassisStatusDataPoint(now, val, health)
+		})
+		if c.Thermal != nil {
+			r.recordThermal(ctx, now, c.Thermal.ID, errs)
+		}
+		if c.Power != nil {
+			r.recordPower(ctx, now, c.Power.ID, errs)
+		}
+
+		r.mb.EmitForResource(
+			metadata.WithRedfishChassisID(c.ID),
+			metadata.WithRedfishChassisName(c.Name),
+			metadata.WithRedfishChassisManufacturer(c.Manufacturer),
+			metadata.WithRedfishChassisModel(c.Model),
+			metadata.WithRedfishChassisSerialNumber(c.SerialNumber),
+		)
+	}
+
+	return r.mb.Emit(), errs.Combine()
+}
+
+func (r *redfishScraper) recordThermal(ctx context.Context, now pcommon.Timestamp, path string, errs *scrapererror.ScrapeErrors) {
+	var t thermal
+	if err := r.client.get(ctx, path, &t); err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to get thermal readings %s: %w", path, err))
+		return
+	}
+
+	for _, temp := range t.Temperatures {
+		if !temp.Status.present() || temp.ReadingCelsius == nil {
+			continue
+		}
+		r.mb.RecordRedfishTemperatureDataPoint(now, *temp.ReadingCelsius, temp.Name)
+	}
+
+	for _, f := range t.Fans {
+		if !f.Status.present() || f.Reading == nil {
+			continue
+		}
+		// Fans reporting their speed as a percentage of the maximum speed are not supported.
+		if f.ReadingUnits != "" && !strings.EqualFold(f.ReadingUnits, "RPM") {
+			r.settings.Logger.Debug("skipping fan reading with unsupported units",
+				zap.String("fan", f.Name), zap.String("units", f.ReadingUnits))
+			continue
+		}
+		name := f.Name
+		if name == "" {
+			name = f.FanName
+		}
+		r.mb.RecordRedfishFanSpeedDataPoint(now, int64(*f.Reading), name)
+	}
+}
+
+func (r *redfishScraper) recordPower(ctx context.Context, now pcommon.Timestamp, path string, errs *scrapererror.ScrapeErrors) {
+	var p power
+	if err := r.client.get(ctx, path, &p); err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to get power readings %s: %w", path, err))
+		return
+	}
+
+	for _, pc := range p.PowerControl {
+		if pc.PowerConsumedWatts == nil {
+			continue
+		}
+		r.mb.RecordRedfishPowerConsumptionDataPoint(now, *pc.PowerConsumedWatts, pc.Name)
+	}
+
+	for _, psu := range p.PowerSupplies {
+		if !psu.Status.present() {
+			continue
+		}
+		recordHealth(psu.Status.Health, func(val int64, health metadata.AttributeHealth) {
+			r.mb.RecordRedfishPowerSupplyStatusDataPoint(now, val, psu.Name, health)
+		})
+		if psu.PowerInputWatts != nil {
+			r.mb.RecordRedfishPowerSupplyInputPowerDataPoint(now, *psu.PowerInputWatts, psu.Name)
+		}
+	}
+}
+
+// recordHealth records 1 for the reported health and 0 for the others,
+// nothing is recorded when the health is not reported.
+func recordHealth(reported string, record func(int64, metadata.AttributeHealth)) {
+	current, ok := metadata.MapAttributeHealth[strings.ToLower(reported)]
+	if !ok {
+		return
+	}
+	for _, health := range metadata.MapAttributeHealth {
+		var val int64
+		if health == current {
+			val = 1
+		}
+		record(val, health)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redfishreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+var testResources = map[string]string{
+	"/redfish/v1/Chassis":            "chassis_collection.json",
+	"/redfish/v1/Chassis/1U":         "chassis.json",
+	"/redfish/v1/Chassis/1U/Thermal": "thermal.json",
+	"/redfish/v1/Chassis/1U/Power":   "power.json",
+}
+
+func newMockServer(t *testing.T, resources map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if user, pass, ok := req.BasicAuth(); !ok || user != "monitoring" || pass != "secret" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		file, ok := resources[req.URL.Path]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", "scraper", file))
+		require.NoError(t, err)
+		rw.Header().Set("Content-Type", "application/json")
+		_, err = rw.Write(body)
+		require.NoError(t, err)
+	}))
+}
+
+func newTestScraper(t *testing.T, endpoint string) *redfishScraper {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.Username = "monitoring"
+	cfg.Password = "secret"
+	cfg.MetricsBuilderConfig.ResourceAttributes.RedfishChassisSerialNumber.Enabled = true
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newRedfishScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	return scraper
+}
+
+func TestScraper(t *testing.T) {
+	server := newMockServer(t, testResources)
+	defer server.Close()
+
+	actualMetrics, err := newTestScraper(t, server.URL).scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
+
+func TestScraperPartialErrors(t *testing.T) {
+	resources := map[string]string{}
+	for path, file := range testResources {
+		resources[path] = file
+	}
+	delete(resources, "/redfish/v1/Chassis/1U/Thermal")
+	server := newMockServer(t, resources)
+	defer server.Close()
+
+	actualMetrics, err := newTestScraper(t, server.URL).scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.ErrorContains(t, err, "failed to get thermal readings /redfish/v1/Chassis/1U/Thermal")
+
+	// the power readings are still recorded
+	require.Equal(t, 1, actualMetrics.ResourceMetrics().Len())
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	names := map[string]bool{}
+	for i := 0; i < metrics.Len(); i++ {
+		names[metrics.At(i).Name()] = true
+	}
+	require.Equal(t, map[string]bool{
+		"redfish.chassis.status":           true,
+		"redfish.power.consumption":        true,
+		"redfish.power_supply.status":      true,
+		"redfish.power_supply.input_power": true,
+	}, names)
+}
+
+func TestScraperUnauthorized(t *testing.T) {
+	server := newMockServer(t, testResources)
+	defer server.Close()
+
+	scraper := newTestScraper(t, server.URL)
+	scraper.client.password = "wrong"
+	_, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "request to /redfish/v1/Chassis failed with status 401")
+}
+
+func TestScraperNotStarted(t *testing.T) {
+	scraper := newRedfishScraper(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config))
+	_, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInit)
+}
+
+func TestScraperFailedStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "https://localhost"
+	cfg.TLSSetting = configtls.TLSClientSetting{
+		TLSSetting: configtls.TLSSetting{
+			CAFile: "/non/existent",
+		},
+	}
+	scraper := newRedfishScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.Error(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+}
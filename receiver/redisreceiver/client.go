@@ -4,6 +4,8 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"fmt"
+
 	"github.com/go-redis/redis/v7"
 )
 
@@ -11,6 +13,14 @@ import (
 type client interface {
 	// retrieves a string of key/value pairs of redis metadata
 	retrieveInfo() (string, error)
+	// retrieves the nodes of the Redis Cluster, as listed by CLUSTER NODES
+	retrieveClusterNodes() (string, error)
+	// retrieves a string of key/value pairs of the Redis Cluster state
+	retrieveClusterInfo() (string, error)
+	// retrieves the latest latency spike of every event tracked by the latency monitor
+	retrieveLatencyLatest() ([]interface{}, error)
+	// retrieves the history of the latency spikes of an event
+	retrieveLatencyHistory(event string) ([]interface{}, error)
 	// line delimiter
 	// redis lines are delimited by \r\n, files (for testing) by \n
 	delimiter() string
@@ -42,6 +52,39 @@ func (c *redisClient) retrieveInfo() (string, error) {
 	return c.client.Info("all").Result()
 }
 
+// Retrieve the Redis Cluster nodes, one per line.
+func (c *redisClient) retrieveClusterNodes() (string, error) {
+	return c.client.ClusterNodes().Result()
+}
+
+// Retrieve the Redis Cluster state.
+func (c *redisClient) retrieveClusterInfo() (string, error) {
+	return c.client.ClusterInfo().Result()
+}
+
+// Retrieve LATENCY LATEST. Every event is an array of its name, the timestamp of
+// its latest spike, the latency of its latest spike and its maximum latency.
+func (c *redisClient) retrieveLatencyLatest() ([]interface{}, error) {
+	return c.latencyCmd("latest")
+}
+
+// Retrieve LATENCY HISTORY of an event. Every spike is an array of its timestamp and latency.
+func (c *redisClient) retrieveLatencyHistory(event string) ([]interface{}, error) {
+	return c.latencyCmd("history", event)
+}
+
+func (c *redisClient) latencyCmd(args ...interface{}) ([]interface{}, error) {
+	res, err := c.client.Do(append([]interface{}{"latency"}, args...)...).Result()
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected latency reply type %T", res)
+	}
+	return reply, nil
+}
+
 // close client to release connention pool.
 func (c *redisClient) close() error {
 	return c.client.Close()
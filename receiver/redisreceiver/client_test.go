@@ -33,6 +33,34 @@ func (fakeClient) retrieveInfo() (string, error) {
 	return readFile("info")
 }
 
+func (fakeClient) retrieveClusterNodes() (string, error) {
+	return readFile("cluster_nodes")
+}
+
+func (fakeClient) retrieveClusterInfo() (string, error) {
+	return readFile("cluster_info")
+}
+
+func (fakeClient) retrieveLatencyLatest() ([]interface{}, error) {
+	return []interface{}{
+		[]interface{}{"command", int64(1405067976), int64(251), int64(1001)},
+		[]interface{}{"fast-command", int64(1405067822), int64(4), int64(4)},
+	}, nil
+}
+
+func (fakeClient) retrieveLatencyHistory(event string) ([]interface{}, error) {
+	if event == "fast-command" {
+		return []interface{}{
+			[]interface{}{int64(1405067822), int64(4)},
+		}, nil
+	}
+	return []interface{}{
+		[]interface{}{int64(1405067822), int64(251)},
+		[]interface{}{int64(1405067941), int64(1001)},
+		[]interface{}{int64(1405067976), int64(251)},
+	}, nil
+}
+
 func (fakeClient) close() error {
 	return nil
 }
@@ -51,3 +79,10 @@ func TestRetrieveInfo(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, strings.HasPrefix(res, "# Server"))
 }
+
+func TestRetrieveClusterNodes(t *testing.T) {
+	g := fakeClient{}
+	res, err := g.retrieveClusterNodes()
+	require.Nil(t, err)
+	require.Contains(t, res, "myself,master")
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// Total number of hash slots of a Redis Cluster.
+const clusterSlots = 16384
+
+// Holds the fields of a node returned by the CLUSTER NODES command: e.g.
+// "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-5460"
+type clusterNode struct {
+	id      string
+	address string
+	primary bool
+	// myself is set for the node the command has been sent to.
+	myself bool
+	// slots is the number of hash slots served by a primary node.
+	slots int
+}
+
+// role returns the role of the node as a value of the role attribute.
+func (n clusterNode) role() string {
+	if n.primary {
+		return metadata.AttributeRolePrimary.String()
+	}
+	return metadata.AttributeRoleReplica.String()
+}
+
+// Turns the output of CLUSTER NODES, one node per line, into the nodes which can
+// be scraped. Nodes in the fail or handshake state, or without an address, are skipped.
+func parseClusterNodes(str string) ([]clusterNode, error) {
+	var nodes []clusterNode
+	for _, line := range strings.Split(str, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 8 {
+			return nil, fmt.Errorf("unexpected cluster node line '%s'", line)
+		}
+		flags := strings.Split(fields[2], ",")
+		if hasFlag(flags, "fail") || hasFlag(flags, "handshake") || hasFlag(flags, "noaddr") {
+			continue
+		}
+		// The address is followed by the cluster bus port and optionally the hostname,
+		// e.g. "127.0.0.1:30001@31001,hostname"
+		address, _, _ := strings.Cut(fields[1], "@")
+		node := clusterNode{
+			id:      fields[0],
+			address: address,
+			primary: hasFlag(flags, "master"),
+			myself:  hasFlag(flags, "myself"),
+		}
+		for _, slot := range fields[8:] {
+			count, err := countSlots(slot)
+			if err != nil {
+				return nil, err
+			}
+			node.slots += count
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func hasFlag(flags []string, flag string) bool {
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the number of hash slots of a slot entry of CLUSTER NODES, either a
+// single slot e.g. "42" or a range e.g. "0-5460". Slots being imported or
+// migrated, e.g. "[42->-e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca]", are not counted.
+func countSlots(str string) (int, error) {
+	if strings.HasPrefix(str, "[") {
+		return 0, nil
+	}
+	first, last, isRange := strings.Cut(str, "-")
+	if !isRange {
+		if _, err := strconv.Atoi(first); err != nil {
+			return 0, fmt.Errorf("unexpected cluster slot '%s'", str)
+		}
+		return 1, nil
+	}
+	start, err := strconv.Atoi(first)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected cluster slot range '%s'", str)
+	}
+	end, err := strconv.Atoi(last)
+	if err != nil || end < start {
+		return 0, fmt.Errorf("unexpected cluster slot range '%s'", str)
+	}
+	return end - start + 1, nil
+}
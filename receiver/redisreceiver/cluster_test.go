@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClusterNodes(t *testing.T) {
+	str, err := readFile("cluster_nodes")
+	require.NoError(t, err)
+	nodes, err := parseClusterNodes(str)
+	require.NoError(t, err)
+	// The failed replica is skipped
+	assert.Equal(t, []clusterNode{
+		{id: "07c37dfeb235213a872192d90877d0cd55635b91", address: "127.0.0.1:30004"},
+		{id: "67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1", address: "127.0.0.1:30002", primary: true, slots: 5462},
+		{id: "292f8b365bb7edb5e285caf0b7e6ddc7265d2f4f", address: "127.0.0.1:30003", primary: true, slots: 5461},
+		{id: "6ec23923021cf3ffec47632106199cb7f496ce01", address: "127.0.0.1:30005"},
+		{id: "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", address: "127.0.0.1:30001", primary: true, myself: true, slots: 5461},
+	}, nodes)
+	assert.Equal(t, "primary", nodes[1].role())
+	assert.Equal(t, "replica", nodes[0].role())
+}
+
+func TestParseClusterNodesInvalid(t *testing.T) {
+	_, err := parseClusterNodes("e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master")
+	assert.EqualError(t, err, "unexpected cluster node line 'e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master'")
+
+	_, err = parseClusterNodes("e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 5460-0")
+	assert.EqualError(t, err, "unexpected cluster slot range '5460-0'")
+}
+
+func TestCountSlots(t *testing.T) {
+	tests := []struct {
+		slot     string
+		expected int
+		err      string
+	}{
+		{slot: "42", expected: 1},
+		{slot: "0-5460", expected: 5461},
+		{slot: "[42->-e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca]", expected: 0},
+		{slot: "[42-<-e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca]", expected: 0},
+		{slot: "x", err: "unexpected cluster slot 'x'"},
+		{slot: "0-x", err: "unexpected cluster slot range '0-x'"},
+	}
+	for _, test := range tests {
+		t.Run(test.slot, func(t *testing.T) {
+			count, err := countSlots(test.slot)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, count)
+		})
+	}
+}
@@ -25,5 +25,18 @@ type Config struct {
 
 	TLS configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
 
+	// Cluster configures the scraping of all the nodes of a Redis Cluster.
+	Cluster ClusterConfig `mapstructure:"cluster"`
+
 	MetricsBuilderConfig metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
+
+// ClusterConfig configures the discovery of the nodes of a Redis Cluster.
+type ClusterConfig struct {
+	// Enabled discovers the nodes of the cluster the endpoint belongs to on
+	// every scrape and scrapes each of them, instead of only the endpoint.
+	Enabled bool `mapstructure:"enabled"`
+
+	// IncludeReplicas also scrapes the replica nodes of the cluster.
+	IncludeReplicas bool `mapstructure:"include_replicas"`
+}
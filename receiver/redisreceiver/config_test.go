@@ -39,6 +39,9 @@ func TestConfig(t *testing.T) {
 				Insecure: true,
 			},
 			Password: "test",
+			Cluster: ClusterConfig{
+				IncludeReplicas: true,
+			},
 			ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
 				CollectionInterval: 10 * time.Second,
 				InitialDelay:       time.Second,
@@ -48,3 +51,16 @@ func TestConfig(t *testing.T) {
 		cfg,
 	)
 }
+
+func TestClusterConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "cluster").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	assert.Equal(t, ClusterConfig{Enabled: true}, cfg.(*Config).Cluster)
+}
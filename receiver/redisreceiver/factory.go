@@ -35,6 +35,9 @@ func createDefaultConfig() component.Config {
 		TLS: configtls.TLSClientSetting{
 			Insecure: true,
 		},
+		Cluster: ClusterConfig{
+			IncludeReplicas: true,
+		},
 		ScraperControllerSettings: scs,
 		MetricsBuilderConfig:      metadata.DefaultMetricsBuilderConfig(),
 	}
@@ -29,7 +29,12 @@ type MetricsConfig struct {
 	RedisClientsConnected                  MetricConfig `mapstructure:"redis.clients.connected"`
 	RedisClientsMaxInputBuffer             MetricConfig `mapstructure:"redis.clients.max_input_buffer"`
 	RedisClientsMaxOutputBuffer            MetricConfig `mapstructure:"redis.clients.max_output_buffer"`
+	RedisClusterNodeSlots                  MetricConfig `mapstructure:"redis.cluster.node.slots"`
+	RedisClusterSlots                      MetricConfig `mapstructure:"redis.cluster.slots"`
 	RedisCmdCalls                          MetricConfig `mapstructure:"redis.cmd.calls"`
+	RedisCmdCallsFailed                    MetricConfig `mapstructure:"redis.cmd.calls.failed"`
+	RedisCmdCallsRejected                  MetricConfig `mapstructure:"redis.cmd.calls.rejected"`
+	RedisCmdLatency                        MetricConfig `mapstructure:"redis.cmd.latency"`
 	RedisCmdUsec                           MetricConfig `mapstructure:"redis.cmd.usec"`
 	RedisCommands                          MetricConfig `mapstructure:"redis.commands"`
 	RedisCommandsProcessed                 MetricConfig `mapstructure:"redis.commands.processed"`
@@ -43,6 +48,8 @@ type MetricsConfig struct {
 	RedisKeysExpired                       MetricConfig `mapstructure:"redis.keys.expired"`
 	RedisKeyspaceHits                      MetricConfig `mapstructure:"redis.keyspace.hits"`
 	RedisKeyspaceMisses                    MetricConfig `mapstructure:"redis.keyspace.misses"`
+	RedisLatencyHistory                    MetricConfig `mapstructure:"redis.latency.history"`
+	RedisLatencyMax                        MetricConfig `mapstructure:"redis.latency.max"`
 	RedisLatestFork                        MetricConfig `mapstructure:"redis.latest_fork"`
 	RedisMaxmemory                         MetricConfig `mapstructure:"redis.maxmemory"`
 	RedisMemoryFragmentationRatio          MetricConfig `mapstructure:"redis.memory.fragmentation_ratio"`
@@ -74,9 +81,24 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisClientsMaxOutputBuffer: MetricConfig{
 			Enabled: true,
 		},
+		RedisClusterNodeSlots: MetricConfig{
+			Enabled: false,
+		},
+		RedisClusterSlots: MetricConfig{
+			Enabled: false,
+		},
 		RedisCmdCalls: MetricConfig{
 			Enabled: false,
 		},
+		RedisCmdCallsFailed: MetricConfig{
+			Enabled: false,
+		},
+		RedisCmdCallsRejected: MetricConfig{
+			Enabled: false,
+		},
+		RedisCmdLatency: MetricConfig{
+			Enabled: false,
+		},
 		RedisCmdUsec: MetricConfig{
 			Enabled: false,
 		},
@@ -116,6 +138,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisKeyspaceMisses: MetricConfig{
 			Enabled: true,
 		},
+		RedisLatencyHistory: MetricConfig{
+			Enabled: false,
+		},
+		RedisLatencyMax: MetricConfig{
+			Enabled: false,
+		},
 		RedisLatestFork: MetricConfig{
 			Enabled: true,
 		},
@@ -171,11 +199,23 @@ type ResourceAttributeConfig struct {
 
 // ResourceAttributesConfig provides config for redis resource attributes.
 type ResourceAttributesConfig struct {
-	RedisVersion ResourceAttributeConfig `mapstructure:"redis.version"`
+	RedisClusterNodeAddress ResourceAttributeConfig `mapstructure:"redis.cluster.node.address"`
+	RedisClusterNodeID      ResourceAttributeConfig `mapstructure:"redis.cluster.node.id"`
+	RedisClusterNodeRole    ResourceAttributeConfig `mapstructure:"redis.cluster.node.role"`
+	RedisVersion            ResourceAttributeConfig `mapstructure:"redis.version"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		RedisClusterNodeAddress: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		RedisClusterNodeID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		RedisClusterNodeRole: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		RedisVersion: ResourceAttributeConfig{
 			Enabled: true,
 		},
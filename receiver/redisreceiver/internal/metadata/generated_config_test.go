@@ -30,7 +30,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisClientsConnected:                  MetricConfig{Enabled: true},
 					RedisClientsMaxInputBuffer:             MetricConfig{Enabled: true},
 					RedisClientsMaxOutputBuffer:            MetricConfig{Enabled: true},
+					RedisClusterNodeSlots:                  MetricConfig{Enabled: true},
+					RedisClusterSlots:                      MetricConfig{Enabled: true},
 					RedisCmdCalls:                          MetricConfig{Enabled: true},
+					RedisCmdCallsFailed:                    MetricConfig{Enabled: true},
+					RedisCmdCallsRejected:                  MetricConfig{Enabled: true},
+					RedisCmdLatency:                        MetricConfig{Enabled: true},
 					RedisCmdUsec:                           MetricConfig{Enabled: true},
 					RedisCommands:                          MetricConfig{Enabled: true},
 					RedisCommandsProcessed:                 MetricConfig{Enabled: true},
@@ -44,6 +49,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisKeysExpired:                       MetricConfig{Enabled: true},
 					RedisKeyspaceHits:                      MetricConfig{Enabled: true},
 					RedisKeyspaceMisses:                    MetricConfig{Enabled: true},
+					RedisLatencyHistory:                    MetricConfig{Enabled: true},
+					RedisLatencyMax:                        MetricConfig{Enabled: true},
 					RedisLatestFork:                        MetricConfig{Enabled: true},
 					RedisMaxmemory:                         MetricConfig{Enabled: true},
 					RedisMemoryFragmentationRatio:          MetricConfig{Enabled: true},
@@ -61,7 +68,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisUptime:                            MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					RedisVersion: ResourceAttributeConfig{Enabled: true},
+					RedisClusterNodeAddress: ResourceAttributeConfig{Enabled: true},
+					RedisClusterNodeID:      ResourceAttributeConfig{Enabled: true},
+					RedisClusterNodeRole:    ResourceAttributeConfig{Enabled: true},
+					RedisVersion:            ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -73,7 +83,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisClientsConnected:                  MetricConfig{Enabled: false},
 					RedisClientsMaxInputBuffer:             MetricConfig{Enabled: false},
 					RedisClientsMaxOutputBuffer:            MetricConfig{Enabled: false},
+					RedisClusterNodeSlots:                  MetricConfig{Enabled: false},
+					RedisClusterSlots:                      MetricConfig{Enabled: false},
 					RedisCmdCalls:                          MetricConfig{Enabled: false},
+					RedisCmdCallsFailed:                    MetricConfig{Enabled: false},
+					RedisCmdCallsRejected:                  MetricConfig{Enabled: false},
+					RedisCmdLatency:                        MetricConfig{Enabled: false},
 					RedisCmdUsec:                           MetricConfig{Enabled: false},
 					RedisCommands:                          MetricConfig{Enabled: false},
 					RedisCommandsProcessed:                 MetricConfig{Enabled: false},
@@ -87,6 +102,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisKeysExpired:                       MetricConfig{Enabled: false},
 					RedisKeyspaceHits:                      MetricConfig{Enabled: false},
 					RedisKeyspaceMisses:                    MetricConfig{Enabled: false},
+					RedisLatencyHistory:                    MetricConfig{Enabled: false},
+					RedisLatencyMax:                        MetricConfig{Enabled: false},
 					RedisLatestFork:                        MetricConfig{Enabled: false},
 					RedisMaxmemory:                         MetricConfig{Enabled: false},
 					RedisMemoryFragmentationRatio:          MetricConfig{Enabled: false},
@@ -104,7 +121,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisUptime:                            MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					RedisVersion: ResourceAttributeConfig{Enabled: false},
+					RedisClusterNodeAddress: ResourceAttributeConfig{Enabled: false},
+					RedisClusterNodeID:      ResourceAttributeConfig{Enabled: false},
+					RedisClusterNodeRole:    ResourceAttributeConfig{Enabled: false},
+					RedisVersion:            ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
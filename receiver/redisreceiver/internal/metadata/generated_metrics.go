@@ -11,6 +11,36 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributePercentile specifies the a value percentile attribute.
+type AttributePercentile int
+
+const (
+	_ AttributePercentile = iota
+	AttributePercentileP50
+	AttributePercentileP99
+	AttributePercentileP999
+)
+
+// String returns the string representation of the AttributePercentile.
+func (av AttributePercentile) String() string {
+	switch av {
+	case AttributePercentileP50:
+		return "p50"
+	case AttributePercentileP99:
+		return "p99"
+	case AttributePercentileP999:
+		return "p99.9"
+	}
+	return ""
+}
+
+// MapAttributePercentile is a helper map of string to AttributePercentile attribute value.
+var MapAttributePercentile = map[string]AttributePercentile{
+	"p50":   AttributePercentileP50,
+	"p99":   AttributePercentileP99,
+	"p99.9": AttributePercentileP999,
+}
+
 // AttributeRole specifies the a value role attribute.
 type AttributeRole int
 
@@ -37,6 +67,40 @@ var MapAttributeRole = map[string]AttributeRole{
 	"primary": AttributeRolePrimary,
 }
 
+// AttributeSlotState specifies the a value slot_state attribute.
+type AttributeSlotState int
+
+const (
+	_ AttributeSlotState = iota
+	AttributeSlotStateOk
+	AttributeSlotStatePfail
+	AttributeSlotStateFail
+	AttributeSlotStateUnassigned
+)
+
+// String returns the string representation of the AttributeSlotState.
+func (av AttributeSlotState) String() string {
+	switch av {
+	case AttributeSlotStateOk:
+		return "ok"
+	case AttributeSlotStatePfail:
+		return "pfail"
+	case AttributeSlotStateFail:
+		return "fail"
+	case AttributeSlotStateUnassigned:
+		return "unassigned"
+	}
+	return ""
+}
+
+// MapAttributeSlotState is a helper map of string to AttributeSlotState attribute value.
+var MapAttributeSlotState = map[string]AttributeSlotState{
+	"ok":         AttributeSlotStateOk,
+	"pfail":      AttributeSlotStatePfail,
+	"fail":       AttributeSlotStateFail,
+	"unassigned": AttributeSlotStateUnassigned,
+}
+
 // AttributeState specifies the a value state attribute.
 type AttributeState int
 
@@ -279,6 +343,110 @@ func newMetricRedisClientsMaxOutputBuffer(cfg MetricConfig) metricRedisClientsMa
 	return m
 }
 
+type metricRedisClusterNodeSlots struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.node.slots metric with initial data.
+func (m *metricRedisClusterNodeSlots) init() {
+	m.data.SetName("redis.cluster.node.slots")
+	m.data.SetDescription("Number of hash slots served by the Redis Cluster node")
+	m.data.SetUnit("{slots}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRedisClusterNodeSlots) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterNodeSlots) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterNodeSlots) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterNodeSlots(cfg MetricConfig) metricRedisClusterNodeSlots {
+	m := metricRedisClusterNodeSlots{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClusterSlots struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.slots metric with initial data.
+func (m *metricRedisClusterSlots) init() {
+	m.data.SetName("redis.cluster.slots")
+	m.data.SetDescription("Number of hash slots of the Redis Cluster by state, as seen by the node")
+	m.data.SetUnit("{slots}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisClusterSlots) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, slotStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", slotStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterSlots) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterSlots) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterSlots(cfg MetricConfig) metricRedisClusterSlots {
+	m := metricRedisClusterSlots{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisCmdCalls struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -332,6 +500,164 @@ func newMetricRedisCmdCalls(cfg MetricConfig) metricRedisCmdCalls {
 	return m
 }
 
+type metricRedisCmdCallsFailed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cmd.calls.failed metric with initial data.
+func (m *metricRedisCmdCallsFailed) init() {
+	m.data.SetName("redis.cmd.calls.failed")
+	m.data.SetDescription("Total number of calls for a command which failed during their execution")
+	m.data.SetUnit("")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCmdCallsFailed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cmd", cmdAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCmdCallsFailed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCmdCallsFailed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCmdCallsFailed(cfg MetricConfig) metricRedisCmdCallsFailed {
+	m := metricRedisCmdCallsFailed{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCmdCallsRejected struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cmd.calls.rejected metric with initial data.
+func (m *metricRedisCmdCallsRejected) init() {
+	m.data.SetName("redis.cmd.calls.rejected")
+	m.data.SetDescription("Total number of calls for a command rejected before being executed")
+	m.data.SetUnit("")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCmdCallsRejected) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cmd", cmdAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCmdCallsRejected) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCmdCallsRejected) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCmdCallsRejected(cfg MetricConfig) metricRedisCmdCallsRejected {
+	m := metricRedisCmdCallsRejected{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCmdLatency struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cmd.latency metric with initial data.
+func (m *metricRedisCmdLatency) init() {
+	m.data.SetName("redis.cmd.latency")
+	m.data.SetDescription("Command execution latency")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCmdLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, cmdAttributeValue string, percentileAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("cmd", cmdAttributeValue)
+	dp.Attributes().PutStr("percentile", percentileAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCmdLatency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCmdLatency) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCmdLatency(cfg MetricConfig) metricRedisCmdLatency {
+	m := metricRedisCmdLatency{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisCmdUsec struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -997,6 +1323,108 @@ func newMetricRedisKeyspaceMisses(cfg MetricConfig) metricRedisKeyspaceMisses {
 	return m
 }
 
+type metricRedisLatencyHistory struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latency.history metric with initial data.
+func (m *metricRedisLatencyHistory) init() {
+	m.data.SetName("redis.latency.history")
+	m.data.SetDescription("Latency spike of an event tracked by the latency monitor, recorded at the time it occurred")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencyHistory) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, eventAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencyHistory) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencyHistory) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencyHistory(cfg MetricConfig) metricRedisLatencyHistory {
+	m := metricRedisLatencyHistory{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencyMax struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latency.max metric with initial data.
+func (m *metricRedisLatencyMax) init() {
+	m.data.SetName("redis.latency.max")
+	m.data.SetDescription("Maximum latency spike of an event tracked by the latency monitor since server start")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencyMax) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, eventAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencyMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencyMax) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencyMax(cfg MetricConfig) metricRedisLatencyMax {
+	m := metricRedisLatencyMax{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisLatestFork struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1759,7 +2187,12 @@ type MetricsBuilder struct {
 	metricRedisClientsConnected                  metricRedisClientsConnected
 	metricRedisClientsMaxInputBuffer             metricRedisClientsMaxInputBuffer
 	metricRedisClientsMaxOutputBuffer            metricRedisClientsMaxOutputBuffer
+	metricRedisClusterNodeSlots                  metricRedisClusterNodeSlots
+	metricRedisClusterSlots                      metricRedisClusterSlots
 	metricRedisCmdCalls                          metricRedisCmdCalls
+	metricRedisCmdCallsFailed                    metricRedisCmdCallsFailed
+	metricRedisCmdCallsRejected                  metricRedisCmdCallsRejected
+	metricRedisCmdLatency                        metricRedisCmdLatency
 	metricRedisCmdUsec                           metricRedisCmdUsec
 	metricRedisCommands                          metricRedisCommands
 	metricRedisCommandsProcessed                 metricRedisCommandsProcessed
@@ -1773,6 +2206,8 @@ type MetricsBuilder struct {
 	metricRedisKeysExpired                       metricRedisKeysExpired
 	metricRedisKeyspaceHits                      metricRedisKeyspaceHits
 	metricRedisKeyspaceMisses                    metricRedisKeyspaceMisses
+	metricRedisLatencyHistory                    metricRedisLatencyHistory
+	metricRedisLatencyMax                        metricRedisLatencyMax
 	metricRedisLatestFork                        metricRedisLatestFork
 	metricRedisMaxmemory                         metricRedisMaxmemory
 	metricRedisMemoryFragmentationRatio          metricRedisMemoryFragmentationRatio
@@ -1810,7 +2245,12 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricRedisClientsConnected:                  newMetricRedisClientsConnected(mbc.Metrics.RedisClientsConnected),
 		metricRedisClientsMaxInputBuffer:             newMetricRedisClientsMaxInputBuffer(mbc.Metrics.RedisClientsMaxInputBuffer),
 		metricRedisClientsMaxOutputBuffer:            newMetricRedisClientsMaxOutputBuffer(mbc.Metrics.RedisClientsMaxOutputBuffer),
+		metricRedisClusterNodeSlots:                  newMetricRedisClusterNodeSlots(mbc.Metrics.RedisClusterNodeSlots),
+		metricRedisClusterSlots:                      newMetricRedisClusterSlots(mbc.Metrics.RedisClusterSlots),
 		metricRedisCmdCalls:                          newMetricRedisCmdCalls(mbc.Metrics.RedisCmdCalls),
+		metricRedisCmdCallsFailed:                    newMetricRedisCmdCallsFailed(mbc.Metrics.RedisCmdCallsFailed),
+		metricRedisCmdCallsRejected:                  newMetricRedisCmdCallsRejected(mbc.Metrics.RedisCmdCallsRejected),
+		metricRedisCmdLatency:                        newMetricRedisCmdLatency(mbc.Metrics.RedisCmdLatency),
 		metricRedisCmdUsec:                           newMetricRedisCmdUsec(mbc.Metrics.RedisCmdUsec),
 		metricRedisCommands:                          newMetricRedisCommands(mbc.Metrics.RedisCommands),
 		metricRedisCommandsProcessed:                 newMetricRedisCommandsProcessed(mbc.Metrics.RedisCommandsProcessed),
@@ -1824,6 +2264,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricRedisKeysExpired:                       newMetricRedisKeysExpired(mbc.Metrics.RedisKeysExpired),
 		metricRedisKeyspaceHits:                      newMetricRedisKeyspaceHits(mbc.Metrics.RedisKeyspaceHits),
 		metricRedisKeyspaceMisses:                    newMetricRedisKeyspaceMisses(mbc.Metrics.RedisKeyspaceMisses),
+		metricRedisLatencyHistory:                    newMetricRedisLatencyHistory(mbc.Metrics.RedisLatencyHistory),
+		metricRedisLatencyMax:                        newMetricRedisLatencyMax(mbc.Metrics.RedisLatencyMax),
 		metricRedisLatestFork:                        newMetricRedisLatestFork(mbc.Metrics.RedisLatestFork),
 		metricRedisMaxmemory:                         newMetricRedisMaxmemory(mbc.Metrics.RedisMaxmemory),
 		metricRedisMemoryFragmentationRatio:          newMetricRedisMemoryFragmentationRatio(mbc.Metrics.RedisMemoryFragmentationRatio),
@@ -1859,6 +2301,33 @@ func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
 // ResourceMetricsOption applies changes to provided resource metrics.
 type ResourceMetricsOption func(ResourceAttributesConfig, pmetric.ResourceMetrics)
 
+// WithRedisClusterNodeAddress sets provided value as "redis.cluster.node.address" attribute for current resource.
+func WithRedisClusterNodeAddress(val string) ResourceMetricsOption {
+	return func(rac ResourceAttributesConfig, rm pmetric.ResourceMetrics) {
+		if rac.RedisClusterNodeAddress.Enabled {
+			rm.Resource().Attributes().PutStr("redis.cluster.node.address", val)
+		}
+	}
+}
+
+// WithRedisClusterNodeID sets provided value as "redis.cluster.node.id" attribute for current resource.
+func WithRedisClusterNodeID(val string) ResourceMetricsOption {
+	return func(rac ResourceAttributesConfig, rm pmetric.ResourceMetrics) {
+		if rac.RedisClusterNodeID.Enabled {
+			rm.Resource().Attributes().PutStr("redis.cluster.node.id", val)
+		}
+	}
+}
+
+// WithRedisClusterNodeRole sets provided value as "redis.cluster.node.role" attribute for current resource.
+func WithRedisClusterNodeRole(val string) ResourceMetricsOption {
+	return func(rac ResourceAttributesConfig, rm pmetric.ResourceMetrics) {
+		if rac.RedisClusterNodeRole.Enabled {
+			rm.Resource().Attributes().PutStr("redis.cluster.node.role", val)
+		}
+	}
+}
+
 // WithRedisVersion sets provided value as "redis.version" attribute for current resource.
 func WithRedisVersion(val string) ResourceMetricsOption {
 	return func(rac ResourceAttributesConfig, rm pmetric.ResourceMetrics) {
@@ -1904,7 +2373,12 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricRedisClientsConnected.emit(ils.Metrics())
 	mb.metricRedisClientsMaxInputBuffer.emit(ils.Metrics())
 	mb.metricRedisClientsMaxOutputBuffer.emit(ils.Metrics())
+	mb.metricRedisClusterNodeSlots.emit(ils.Metrics())
+	mb.metricRedisClusterSlots.emit(ils.Metrics())
 	mb.metricRedisCmdCalls.emit(ils.Metrics())
+	mb.metricRedisCmdCallsFailed.emit(ils.Metrics())
+	mb.metricRedisCmdCallsRejected.emit(ils.Metrics())
+	mb.metricRedisCmdLatency.emit(ils.Metrics())
 	mb.metricRedisCmdUsec.emit(ils.Metrics())
 	mb.metricRedisCommands.emit(ils.Metrics())
 	mb.metricRedisCommandsProcessed.emit(ils.Metrics())
@@ -1918,6 +2392,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricRedisKeysExpired.emit(ils.Metrics())
 	mb.metricRedisKeyspaceHits.emit(ils.Metrics())
 	mb.metricRedisKeyspaceMisses.emit(ils.Metrics())
+	mb.metricRedisLatencyHistory.emit(ils.Metrics())
+	mb.metricRedisLatencyMax.emit(ils.Metrics())
 	mb.metricRedisLatestFork.emit(ils.Metrics())
 	mb.metricRedisMaxmemory.emit(ils.Metrics())
 	mb.metricRedisMemoryFragmentationRatio.emit(ils.Metrics())
@@ -1973,11 +2449,36 @@ func (mb *MetricsBuilder) RecordRedisClientsMaxOutputBufferDataPoint(ts pcommon.
 	mb.metricRedisClientsMaxOutputBuffer.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisClusterNodeSlotsDataPoint adds a data point to redis.cluster.node.slots metric.
+func (mb *MetricsBuilder) RecordRedisClusterNodeSlotsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRedisClusterNodeSlots.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisClusterSlotsDataPoint adds a data point to redis.cluster.slots metric.
+func (mb *MetricsBuilder) RecordRedisClusterSlotsDataPoint(ts pcommon.Timestamp, val int64, slotStateAttributeValue AttributeSlotState) {
+	mb.metricRedisClusterSlots.recordDataPoint(mb.startTime, ts, val, slotStateAttributeValue.String())
+}
+
 // RecordRedisCmdCallsDataPoint adds a data point to redis.cmd.calls metric.
 func (mb *MetricsBuilder) RecordRedisCmdCallsDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
 	mb.metricRedisCmdCalls.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
 }
 
+// RecordRedisCmdCallsFailedDataPoint adds a data point to redis.cmd.calls.failed metric.
+func (mb *MetricsBuilder) RecordRedisCmdCallsFailedDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
+	mb.metricRedisCmdCallsFailed.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
+}
+
+// RecordRedisCmdCallsRejectedDataPoint adds a data point to redis.cmd.calls.rejected metric.
+func (mb *MetricsBuilder) RecordRedisCmdCallsRejectedDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
+	mb.metricRedisCmdCallsRejected.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
+}
+
+// RecordRedisCmdLatencyDataPoint adds a data point to redis.cmd.latency metric.
+func (mb *MetricsBuilder) RecordRedisCmdLatencyDataPoint(ts pcommon.Timestamp, val float64, cmdAttributeValue string, percentileAttributeValue AttributePercentile) {
+	mb.metricRedisCmdLatency.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue, percentileAttributeValue.String())
+}
+
 // RecordRedisCmdUsecDataPoint adds a data point to redis.cmd.usec metric.
 func (mb *MetricsBuilder) RecordRedisCmdUsecDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
 	mb.metricRedisCmdUsec.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
@@ -2043,6 +2544,16 @@ func (mb *MetricsBuilder) RecordRedisKeyspaceMissesDataPoint(ts pcommon.Timestam
 	mb.metricRedisKeyspaceMisses.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisLatencyHistoryDataPoint adds a data point to redis.latency.history metric.
+func (mb *MetricsBuilder) RecordRedisLatencyHistoryDataPoint(ts pcommon.Timestamp, val int64, eventAttributeValue string) {
+	mb.metricRedisLatencyHistory.recordDataPoint(mb.startTime, ts, val, eventAttributeValue)
+}
+
+// RecordRedisLatencyMaxDataPoint adds a data point to redis.latency.max metric.
+func (mb *MetricsBuilder) RecordRedisLatencyMaxDataPoint(ts pcommon.Timestamp, val int64, eventAttributeValue string) {
+	mb.metricRedisLatencyMax.recordDataPoint(mb.startTime, ts, val, eventAttributeValue)
+}
+
 // RecordRedisLatestForkDataPoint adds a data point to redis.latest_fork metric.
 func (mb *MetricsBuilder) RecordRedisLatestForkDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRedisLatestFork.recordDataPoint(mb.startTime, ts, val)
@@ -70,9 +70,24 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisClientsMaxOutputBufferDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRedisClusterNodeSlotsDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordRedisClusterSlotsDataPoint(ts, 1, AttributeSlotState(1))
+
 			allMetricsCount++
 			mb.RecordRedisCmdCallsDataPoint(ts, 1, "attr-val")
 
+			allMetricsCount++
+			mb.RecordRedisCmdCallsFailedDataPoint(ts, 1, "attr-val")
+
+			allMetricsCount++
+			mb.RecordRedisCmdCallsRejectedDataPoint(ts, 1, "attr-val")
+
+			allMetricsCount++
+			mb.RecordRedisCmdLatencyDataPoint(ts, 1, "attr-val", AttributePercentile(1))
+
 			allMetricsCount++
 			mb.RecordRedisCmdUsecDataPoint(ts, 1, "attr-val")
 
@@ -124,6 +139,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisKeyspaceMissesDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRedisLatencyHistoryDataPoint(ts, 1, "attr-val")
+
+			allMetricsCount++
+			mb.RecordRedisLatencyMaxDataPoint(ts, 1, "attr-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRedisLatestForkDataPoint(ts, 1)
@@ -182,7 +203,7 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisUptimeDataPoint(ts, 1)
 
-			metrics := mb.Emit(WithRedisVersion("attr-val"))
+			metrics := mb.Emit(WithRedisClusterNodeAddress("attr-val"), WithRedisClusterNodeID("attr-val"), WithRedisClusterNodeRole("attr-val"), WithRedisVersion("attr-val"))
 
 			if test.configSet == testSetNone {
 				assert.Equal(t, 0, metrics.ResourceMetrics().Len())
@@ -193,7 +214,28 @@ func TestMetricsBuilder(t *testing.T) {
 			rm := metrics.ResourceMetrics().At(0)
 			attrCount := 0
 			enabledAttrCount := 0
-			attrVal, ok := rm.Resource().Attributes().Get("redis.version")
+			attrVal, ok := rm.Resource().Attributes().Get("redis.cluster.node.address")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesConfig.RedisClusterNodeAddress.Enabled, ok)
+			if mb.resourceAttributesConfig.RedisClusterNodeAddress.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("redis.cluster.node.id")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesConfig.RedisClusterNodeID.Enabled, ok)
+			if mb.resourceAttributesConfig.RedisClusterNodeID.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("redis.cluster.node.role")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesConfig.RedisClusterNodeRole.Enabled, ok)
+			if mb.resourceAttributesConfig.RedisClusterNodeRole.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("redis.version")
 			attrCount++
 			assert.Equal(t, mb.resourceAttributesConfig.RedisVersion.Enabled, ok)
 			if mb.resourceAttributesConfig.RedisVersion.Enabled {
@@ -201,7 +243,7 @@ func TestMetricsBuilder(t *testing.T) {
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
 			assert.Equal(t, enabledAttrCount, rm.Resource().Attributes().Len())
-			assert.Equal(t, attrCount, 1)
+			assert.Equal(t, attrCount, 4)
 
 			assert.Equal(t, 1, rm.ScopeMetrics().Len())
 			ms := rm.ScopeMetrics().At(0).Metrics()
@@ -266,6 +308,37 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.cluster.node.slots":
+					assert.False(t, validatedMetrics["redis.cluster.node.slots"], "Found a duplicate in the metrics slice: redis.cluster.node.slots")
+					validatedMetrics["redis.cluster.node.slots"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of hash slots served by the Redis Cluster node", ms.At(i).Description())
+					assert.Equal(t, "{slots}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.cluster.slots":
+					assert.False(t, validatedMetrics["redis.cluster.slots"], "Found a duplicate in the metrics slice: redis.cluster.slots")
+					validatedMetrics["redis.cluster.slots"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of hash slots of the Redis Cluster by state, as seen by the node", ms.At(i).Description())
+					assert.Equal(t, "{slots}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.Equal(t, "ok", attrVal.Str())
 				case "redis.cmd.calls":
 					assert.False(t, validatedMetrics["redis.cmd.calls"], "Found a duplicate in the metrics slice: redis.cmd.calls")
 					validatedMetrics["redis.cmd.calls"] = true
@@ -283,6 +356,58 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("cmd")
 					assert.True(t, ok)
 					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "redis.cmd.calls.failed":
+					assert.False(t, validatedMetrics["redis.cmd.calls.failed"], "Found a duplicate in the metrics slice: redis.cmd.calls.failed")
+					validatedMetrics["redis.cmd.calls.failed"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total number of calls for a command which failed during their execution", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cmd")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "redis.cmd.calls.rejected":
+					assert.False(t, validatedMetrics["redis.cmd.calls.rejected"], "Found a duplicate in the metrics slice: redis.cmd.calls.rejected")
+					validatedMetrics["redis.cmd.calls.rejected"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total number of calls for a command rejected before being executed", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cmd")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "redis.cmd.latency":
+					assert.False(t, validatedMetrics["redis.cmd.latency"], "Found a duplicate in the metrics slice: redis.cmd.latency")
+					validatedMetrics["redis.cmd.latency"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Command execution latency", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("cmd")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("percentile")
+					assert.True(t, ok)
+					assert.Equal(t, "p50", attrVal.Str())
 				case "redis.cmd.usec":
 					assert.False(t, validatedMetrics["redis.cmd.usec"], "Found a duplicate in the metrics slice: redis.cmd.usec")
 					validatedMetrics["redis.cmd.usec"] = true
@@ -472,6 +597,36 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.latency.history":
+					assert.False(t, validatedMetrics["redis.latency.history"], "Found a duplicate in the metrics slice: redis.latency.history")
+					validatedMetrics["redis.latency.history"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Latency spike of an event tracked by the latency monitor, recorded at the time it occurred", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("event")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "redis.latency.max":
+					assert.False(t, validatedMetrics["redis.latency.max"], "Found a duplicate in the metrics slice: redis.latency.max")
+					validatedMetrics["redis.latency.max"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Maximum latency spike of an event tracked by the latency monitor since server start", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("event")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
 				case "redis.latest_fork":
 					assert.False(t, validatedMetrics["redis.latest_fork"], "Found a duplicate in the metrics slice: redis.latest_fork")
 					validatedMetrics["redis.latest_fork"] = true
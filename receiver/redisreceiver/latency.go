@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Holds the fields of an event returned by the LATENCY LATEST command, but the
+// latency of the latest spike which LATENCY HISTORY also returns: e.g.
+// 1) "command" 2) (integer) 1405067976 3) (integer) 251 4) (integer) 1001
+type latencyEvent struct {
+	name string
+	// timestamp is the unix time of the latest spike.
+	timestamp int64
+	// max is the maximum latency of the event since server start in milliseconds.
+	max int64
+}
+
+// Holds the fields of a spike returned by the LATENCY HISTORY command: e.g.
+// 1) (integer) 1405067822 2) (integer) 251
+type latencySpike struct {
+	timestamp int64
+	latency   int64
+}
+
+// Turns the reply of LATENCY LATEST into latency events.
+func parseLatencyLatest(reply []interface{}) ([]latencyEvent, error) {
+	events := make([]latencyEvent, 0, len(reply))
+	for _, item := range reply {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 4 {
+			return nil, fmt.Errorf("unexpected latency event '%v'", item)
+		}
+		name, ok := fields[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected latency event name '%v'", fields[0])
+		}
+		event := latencyEvent{name: name}
+		var err error
+		if event.timestamp, err = toInt64(fields[1]); err != nil {
+			return nil, err
+		}
+		if event.max, err = toInt64(fields[3]); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Turns the reply of LATENCY HISTORY into latency spikes.
+func parseLatencyHistory(reply []interface{}) ([]latencySpike, error) {
+	spikes := make([]latencySpike, 0, len(reply))
+	for _, item := range reply {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 2 {
+			return nil, fmt.Errorf("unexpected latency spike '%v'", item)
+		}
+		var spike latencySpike
+		var err error
+		if spike.timestamp, err = toInt64(fields[0]); err != nil {
+			return nil, err
+		}
+		if spike.latency, err = toInt64(fields[1]); err != nil {
+			return nil, err
+		}
+		spikes = append(spikes, spike)
+	}
+	return spikes, nil
+}
+
+func toInt64(val interface{}) (int64, error) {
+	switch v := val.(type) {
+	case int64:
+		return v, nil
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	}
+	return 0, fmt.Errorf("unexpected integer '%v'", val)
+}
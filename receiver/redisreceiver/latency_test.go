@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLatencyLatest(t *testing.T) {
+	events, err := parseLatencyLatest([]interface{}{
+		[]interface{}{"command", int64(1405067976), int64(251), int64(1001)},
+		[]interface{}{"fork", "1405067822", "4", "5"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []latencyEvent{
+		{name: "command", timestamp: 1405067976, max: 1001},
+		{name: "fork", timestamp: 1405067822, max: 5},
+	}, events)
+
+	_, err = parseLatencyLatest([]interface{}{[]interface{}{"command", int64(1405067976)}})
+	assert.EqualError(t, err, "unexpected latency event '[command 1405067976]'")
+
+	_, err = parseLatencyLatest([]interface{}{[]interface{}{"command", int64(1405067976), int64(251), 1.5}})
+	assert.EqualError(t, err, "unexpected integer '1.5'")
+}
+
+func TestParseLatencyHistory(t *testing.T) {
+	spikes, err := parseLatencyHistory([]interface{}{
+		[]interface{}{int64(1405067822), int64(251)},
+		[]interface{}{int64(1405067941), int64(1001)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []latencySpike{
+		{timestamp: 1405067822, latency: 251},
+		{timestamp: 1405067941, latency: 1001},
+	}, spikes)
+
+	_, err = parseLatencyHistory([]interface{}{"1405067822"})
+	assert.EqualError(t, err, "unexpected latency spike '1405067822'")
+}
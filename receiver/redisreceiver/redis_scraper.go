@@ -5,6 +5,7 @@ package redisreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -14,7 +15,9 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
@@ -28,6 +31,21 @@ type redisScraper struct {
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
 	uptime   time.Duration
+	cfg      *Config
+	// latencySpikes holds the timestamp of the latest spike recorded per latency event.
+	latencySpikes map[string]int64
+	// newClient creates the client of a node discovered in cluster mode.
+	newClient func(address string) client
+	// nodes holds the state of the nodes scraped in cluster mode by address.
+	nodes map[string]*clusterNodeState
+}
+
+// Holds the connection to, and the state of, a node scraped in cluster mode.
+type clusterNodeState struct {
+	redisSvc      *redisSvc
+	uptime        time.Duration
+	startTime     pcommon.Timestamp
+	latencySpikes map[string]int64
 }
 
 const redisMaxDbs = 16 // Maximum possible number of redis databases
@@ -43,15 +61,24 @@ func newRedisScraper(cfg *Config, settings receiver.CreateSettings) (scraperhelp
 	if opts.TLSConfig, err = cfg.TLS.LoadTLSConfig(); err != nil {
 		return nil, err
 	}
-	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+	newClient := func(address string) client {
+		nodeOpts := *opts
+		nodeOpts.Addr = address
+		return newRedisClient(&nodeOpts)
+	}
+	return newRedisScraperWithClient(newRedisClient(opts), newClient, settings, cfg)
 }
 
-func newRedisScraperWithClient(client client, settings receiver.CreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
+func newRedisScraperWithClient(client client, newClient func(address string) client, settings receiver.CreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
 	rs := &redisScraper{
-		client:   client,
-		redisSvc: newRedisSvc(client),
-		settings: settings.TelemetrySettings,
-		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		client:        client,
+		redisSvc:      newRedisSvc(client),
+		settings:      settings.TelemetrySettings,
+		mb:            metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		cfg:           cfg,
+		latencySpikes: map[string]int64{},
+		newClient:     newClient,
+		nodes:         map[string]*clusterNodeState{},
 	}
 	return scraperhelper.NewScraper(
 		metadata.Type,
@@ -61,10 +88,14 @@ func newRedisScraperWithClient(client client, settings receiver.CreateSettings,
 }
 
 func (rs *redisScraper) shutdown(context.Context) error {
+	var errs error
+	for address := range rs.nodes {
+		errs = multierr.Append(errs, rs.removeClusterNode(address))
+	}
 	if rs.client != nil {
-		return rs.client.close()
+		errs = multierr.Append(errs, rs.client.close())
 	}
-	return nil
+	return errs
 }
 
 // Scrape is called periodically, querying Redis and building Metrics to send to
@@ -72,7 +103,12 @@ func (rs *redisScraper) shutdown(context.Context) error {
 // defined at startup time. Then builds 'keyspace' metrics if there are any
 // keyspace lines returned by Redis. There should be one keyspace line per
 // active Redis database, of which there can be 16.
+// In cluster mode, every node of the cluster is scraped and emitted as its own resource.
 func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
+	if rs.cfg.Cluster.Enabled {
+		return rs.scrapeCluster()
+	}
+
 	inf, err := rs.redisSvc.info()
 	if err != nil {
 		return pmetric.Metrics{}, err
@@ -89,11 +125,121 @@ func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
 	}
 	rs.uptime = currentUptime
 
-	rs.recordCommonMetrics(now, inf)
-	rs.recordKeyspaceMetrics(now, inf)
-	rs.recordRoleMetrics(now, inf)
-	rs.recordCmdStatsMetrics(now, inf)
-	return rs.mb.Emit(metadata.WithRedisVersion(rs.getRedisVersion(inf))), nil
+	rs.recordInfoMetrics(now, inf)
+
+	var errs scrapererror.ScrapeErrors
+	if err = rs.recordLatencyMetrics(now, rs.client, rs.latencySpikes); err != nil {
+		errs.AddPartial(1, err)
+	}
+	return rs.mb.Emit(metadata.WithRedisVersion(rs.getRedisVersion(inf))), errs.Combine()
+}
+
+// scrapeCluster discovers the nodes of the cluster through the endpoint and scrapes each of them.
+// The connections to the nodes which left the cluster are released.
+func (rs *redisScraper) scrapeCluster() (pmetric.Metrics, error) {
+	str, err := rs.client.retrieveClusterNodes()
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+	nodes, err := parseClusterNodes(str)
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+
+	var errs scrapererror.ScrapeErrors
+	scraped := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		if !node.primary && !rs.cfg.Cluster.IncludeReplicas {
+			continue
+		}
+		scraped[node.address] = true
+		if err = rs.scrapeClusterNode(node); err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to scrape cluster node %s: %w", node.address, err))
+		}
+	}
+
+	for address := range rs.nodes {
+		if scraped[address] {
+			continue
+		}
+		if err = rs.removeClusterNode(address); err != nil {
+			rs.settings.Logger.Warn("failed to close cluster node client", zap.String("address", address), zap.Error(err))
+		}
+	}
+	return rs.mb.Emit(), errs.Combine()
+}
+
+// scrapeClusterNode records the metrics of a cluster node and emits them for the node resource.
+func (rs *redisScraper) scrapeClusterNode(node clusterNode) error {
+	state, ok := rs.nodes[node.address]
+	if !ok {
+		// The endpoint is scraped with its own client, whatever address it announces.
+		c := rs.client
+		if !node.myself {
+			c = rs.newClient(node.address)
+		}
+		state = &clusterNodeState{
+			redisSvc:      newRedisSvc(c),
+			latencySpikes: map[string]int64{},
+		}
+		rs.nodes[node.address] = state
+	}
+
+	inf, err := state.redisSvc.info()
+	if err != nil {
+		return err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	currentUptime, err := inf.getUptimeInSeconds()
+	if err != nil {
+		return err
+	}
+	if state.uptime == time.Duration(0) || state.uptime > currentUptime {
+		state.startTime = pcommon.NewTimestampFromTime(now.AsTime().Add(-currentUptime))
+	}
+	state.uptime = currentUptime
+
+	rs.recordInfoMetrics(now, inf)
+	rs.mb.RecordRedisClusterNodeSlotsDataPoint(now, int64(node.slots))
+
+	var errs error
+	if rs.cfg.MetricsBuilderConfig.Metrics.RedisClusterSlots.Enabled {
+		clusterInf, clusterErr := state.redisSvc.clusterInfo()
+		if clusterErr == nil {
+			rs.recordClusterSlotsMetrics(now, clusterInf)
+		}
+		errs = multierr.Append(errs, clusterErr)
+	}
+	errs = multierr.Append(errs, rs.recordLatencyMetrics(now, state.redisSvc.client, state.latencySpikes))
+
+	rs.mb.EmitForResource(
+		metadata.WithRedisVersion(rs.getRedisVersion(inf)),
+		metadata.WithRedisClusterNodeAddress(node.address),
+		metadata.WithRedisClusterNodeID(node.id),
+		metadata.WithRedisClusterNodeRole(node.role()),
+		metadata.WithStartTimeOverride(state.startTime),
+	)
+	return errs
+}
+
+// removeClusterNode forgets a cluster node and closes its client, unless it is the client of the endpoint.
+func (rs *redisScraper) removeClusterNode(address string) error {
+	state := rs.nodes[address]
+	delete(rs.nodes, address)
+	if state.redisSvc.client == rs.client {
+		return nil
+	}
+	return state.redisSvc.client.close()
+}
+
+// recordInfoMetrics records the metrics of a node from its INFO key-value pairs.
+func (rs *redisScraper) recordInfoMetrics(ts pcommon.Timestamp, inf info) {
+	rs.recordCommonMetrics(ts, inf)
+	rs.recordKeyspaceMetrics(ts, inf)
+	rs.recordRoleMetrics(ts, inf)
+	rs.recordCmdStatsMetrics(ts, inf)
+	rs.recordCmdLatencyMetrics(ts, inf)
 }
 
 // recordCommonMetrics records metrics from Redis info key-value pairs.
@@ -168,7 +314,7 @@ func (rs *redisScraper) recordRoleMetrics(ts pcommon.Timestamp, inf info) {
 
 // recordCmdStatsMetrics records metrics from 'command_stats' Redis info key-value pairs
 // e.g. "cmdstat_mget:calls=1685,usec=6032,usec_per_call=3.58,rejected_calls=0,failed_calls=0"
-// but only calls and usec at the moment.
+// but usec_per_call, which is derived from calls and usec.
 func (rs *redisScraper) recordCmdStatsMetrics(ts pcommon.Timestamp, inf info) {
 	cmdPrefix := "cmdstat_"
 	for key, val := range inf {
@@ -187,11 +333,108 @@ func (rs *redisScraper) recordCmdStatsMetrics(ts pcommon.Timestamp, inf info) {
 			if err != nil { // skip bad items
 				continue
 			}
-			if subParts[0] == "calls" {
+			switch subParts[0] {
+			case "calls":
 				rs.mb.RecordRedisCmdCallsDataPoint(ts, parsed, cmd)
-			} else if subParts[0] == "usec" {
+			case "usec":
 				rs.mb.RecordRedisCmdUsecDataPoint(ts, parsed, cmd)
+			case "rejected_calls":
+				rs.mb.RecordRedisCmdCallsRejectedDataPoint(ts, parsed, cmd)
+			case "failed_calls":
+				rs.mb.RecordRedisCmdCallsFailedDataPoint(ts, parsed, cmd)
 			}
 		}
 	}
 }
+
+// recordCmdLatencyMetrics records metrics from 'latencystats' Redis info key-value pairs
+// e.g. "latency_percentiles_usec_get:p50=1.003,p99=2.007,p99.9=5.023"
+// Only the default percentiles are recorded.
+func (rs *redisScraper) recordCmdLatencyMetrics(ts pcommon.Timestamp, inf info) {
+	latencyPrefix := "latency_percentiles_usec_"
+	for key, val := range inf {
+		if !strings.HasPrefix(key, latencyPrefix) {
+			continue
+		}
+
+		cmd := key[len(latencyPrefix):]
+		for _, element := range strings.Split(strings.TrimSpace(val), ",") {
+			name, value, found := strings.Cut(element, "=")
+			if !found {
+				continue
+			}
+			percentile, ok := metadata.MapAttributePercentile[name]
+			if !ok {
+				continue
+			}
+			usec, err := strconv.ParseFloat(value, 64)
+			if err != nil { // skip bad items
+				continue
+			}
+			rs.mb.RecordRedisCmdLatencyDataPoint(ts, usec/1e6, cmd, percentile)
+		}
+	}
+}
+
+// recordClusterSlotsMetrics records metrics from CLUSTER INFO key-value pairs
+// e.g. "cluster_slots_assigned:16384", "cluster_slots_ok:16384"
+func (rs *redisScraper) recordClusterSlotsMetrics(ts pcommon.Timestamp, inf info) {
+	states := map[string]metadata.AttributeSlotState{
+		"cluster_slots_ok":    metadata.AttributeSlotStateOk,
+		"cluster_slots_pfail": metadata.AttributeSlotStatePfail,
+		"cluster_slots_fail":  metadata.AttributeSlotStateFail,
+	}
+	for key, state := range states {
+		val, err := strconv.ParseInt(inf[key], 10, 64)
+		if err != nil {
+			rs.settings.Logger.Warn("failed to parse cluster info int val", zap.String("key", key),
+				zap.String("val", inf[key]), zap.Error(err))
+			continue
+		}
+		rs.mb.RecordRedisClusterSlotsDataPoint(ts, val, state)
+	}
+	if assigned, err := strconv.ParseInt(inf["cluster_slots_assigned"], 10, 64); err == nil {
+		rs.mb.RecordRedisClusterSlotsDataPoint(ts, clusterSlots-assigned, metadata.AttributeSlotStateUnassigned)
+	}
+}
+
+// recordLatencyMetrics records the metrics of the latency monitor, queried only if one of them is enabled.
+// The history of an event is only queried when it had a spike since the previous scrape, and only the
+// spikes which have not been recorded yet are, each with the time it occurred.
+func (rs *redisScraper) recordLatencyMetrics(ts pcommon.Timestamp, c client, spikes map[string]int64) error {
+	metrics := rs.cfg.MetricsBuilderConfig.Metrics
+	if !metrics.RedisLatencyMax.Enabled && !metrics.RedisLatencyHistory.Enabled {
+		return nil
+	}
+
+	reply, err := c.retrieveLatencyLatest()
+	if err != nil {
+		return err
+	}
+	events, err := parseLatencyLatest(reply)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		rs.mb.RecordRedisLatencyMaxDataPoint(ts, event.max, event.name)
+		if !metrics.RedisLatencyHistory.Enabled || event.timestamp <= spikes[event.name] {
+			continue
+		}
+		historyReply, historyErr := c.retrieveLatencyHistory(event.name)
+		if historyErr != nil {
+			return historyErr
+		}
+		history, historyErr := parseLatencyHistory(historyReply)
+		if historyErr != nil {
+			return historyErr
+		}
+		for _, spike := range history {
+			if spike.timestamp > spikes[event.name] {
+				rs.mb.RecordRedisLatencyHistoryDataPoint(pcommon.NewTimestampFromTime(time.Unix(spike.timestamp, 0)), spike.latency, event.name)
+			}
+		}
+		spikes[event.name] = event.timestamp
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 
@@ -22,7 +23,7 @@ func TestRedisRunnable(t *testing.T) {
 	settings.Logger = logger
 	cfg := createDefaultConfig().(*Config)
 	rs := &redisScraper{mb: metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings)}
-	runner, err := newRedisScraperWithClient(newFakeClient(), settings, cfg)
+	runner, err := newRedisScraperWithClient(newFakeClient(), nil, settings, cfg)
 	require.NoError(t, err)
 	md, err := runner.Scrape(context.Background())
 	require.NoError(t, err)
@@ -47,3 +48,140 @@ func TestNewReceiver_invalid_auth_error(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to load TLS config")
 	assert.Nil(t, r)
 }
+
+func TestRedisClusterScrape(t *testing.T) {
+	tests := []struct {
+		name            string
+		includeReplicas bool
+		expectedNodes   []string
+	}{
+		{
+			name:            "include_replicas",
+			includeReplicas: true,
+			expectedNodes:   []string{"127.0.0.1:30004", "127.0.0.1:30002", "127.0.0.1:30003", "127.0.0.1:30005", "127.0.0.1:30001"},
+		},
+		{
+			name:          "primaries_only",
+			expectedNodes: []string{"127.0.0.1:30002", "127.0.0.1:30003", "127.0.0.1:30001"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Cluster.Enabled = true
+			cfg.Cluster.IncludeReplicas = test.includeReplicas
+			cfg.MetricsBuilderConfig.Metrics.RedisClusterSlots.Enabled = true
+			cfg.MetricsBuilderConfig.Metrics.RedisClusterNodeSlots.Enabled = true
+
+			var created []string
+			newClient := func(address string) client {
+				created = append(created, address)
+				return newFakeClient()
+			}
+			runner, err := newRedisScraperWithClient(newFakeClient(), newClient, receivertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+			md, err := runner.Scrape(context.Background())
+			require.NoError(t, err)
+
+			// The endpoint announcing itself as 127.0.0.1:30001 is scraped with its own client
+			assert.NotContains(t, created, "127.0.0.1:30001")
+			require.Equal(t, len(test.expectedNodes), md.ResourceMetrics().Len())
+			for i, address := range test.expectedNodes {
+				rm := md.ResourceMetrics().At(i)
+				attrVal, ok := rm.Resource().Attributes().Get("redis.cluster.node.address")
+				require.True(t, ok)
+				assert.Equal(t, address, attrVal.Str())
+				_, ok = rm.Resource().Attributes().Get("redis.cluster.node.id")
+				assert.True(t, ok)
+				_, ok = rm.Resource().Attributes().Get("redis.cluster.node.role")
+				assert.True(t, ok)
+				attrVal, ok = rm.Resource().Attributes().Get("redis.version")
+				require.True(t, ok)
+				assert.Equal(t, "5.0.7", attrVal.Str())
+
+				slots := findMetric(t, rm, "redis.cluster.slots").Sum().DataPoints()
+				assert.Equal(t, 4, slots.Len())
+			}
+
+			primary := md.ResourceMetrics().At(len(test.expectedNodes) - 1)
+			attrVal, _ := primary.Resource().Attributes().Get("redis.cluster.node.role")
+			assert.Equal(t, "primary", attrVal.Str())
+			nodeSlots := findMetric(t, primary, "redis.cluster.node.slots").Sum().DataPoints()
+			require.Equal(t, 1, nodeSlots.Len())
+			assert.EqualValues(t, 5461, nodeSlots.At(0).IntValue())
+		})
+	}
+}
+
+func TestRedisLatencyMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics.RedisLatencyMax.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.RedisLatencyHistory.Enabled = true
+	runner, err := newRedisScraperWithClient(newFakeClient(), nil, receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, 2, findMetric(t, rm, "redis.latency.max").Gauge().DataPoints().Len())
+	history := findMetric(t, rm, "redis.latency.history").Gauge().DataPoints()
+	require.Equal(t, 4, history.Len())
+	assert.EqualValues(t, 1405067822, history.At(0).Timestamp().AsTime().Unix())
+
+	// No spike occurred since the previous scrape
+	md, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	rm = md.ResourceMetrics().At(0)
+	assert.Equal(t, 2, findMetric(t, rm, "redis.latency.max").Gauge().DataPoints().Len())
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		assert.NotEqual(t, "redis.latency.history", ms.At(i).Name())
+	}
+}
+
+func TestRecordCmdStatsAndLatencyMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics.RedisCmdCallsRejected.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.RedisCmdCallsFailed.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.RedisCmdLatency.Enabled = true
+	settings := receivertest.NewNopCreateSettings()
+	rs := &redisScraper{
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+	}
+	inf := info{
+		"cmdstat_get":                  "calls=10,usec=20,usec_per_call=2.00,rejected_calls=3,failed_calls=1",
+		"latency_percentiles_usec_get": "p50=1.003,p99=2.007,p99.9=5.023,p99.99=9.999",
+	}
+	rs.recordInfoMetrics(0, inf)
+	rm := rs.mb.Emit().ResourceMetrics().At(0)
+
+	rejected := findMetric(t, rm, "redis.cmd.calls.rejected").Sum().DataPoints()
+	require.Equal(t, 1, rejected.Len())
+	assert.EqualValues(t, 3, rejected.At(0).IntValue())
+	failed := findMetric(t, rm, "redis.cmd.calls.failed").Sum().DataPoints()
+	require.Equal(t, 1, failed.Len())
+	assert.EqualValues(t, 1, failed.At(0).IntValue())
+
+	// Only the default percentiles are recorded
+	latency := findMetric(t, rm, "redis.cmd.latency").Gauge().DataPoints()
+	require.Equal(t, 3, latency.Len())
+	for i := 0; i < latency.Len(); i++ {
+		dp := latency.At(i)
+		percentile, _ := dp.Attributes().Get("percentile")
+		if percentile.Str() == "p99.9" {
+			assert.InDelta(t, 0.000005023, dp.DoubleValue(), 1e-12)
+		}
+	}
+}
+
+func findMetric(t *testing.T, rm pmetric.ResourceMetrics, name string) pmetric.Metric {
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "%s", name)
+	return pmetric.Metric{}
+}
@@ -28,6 +28,20 @@ func (p *redisSvc) info() (info, error) {
 	if err != nil {
 		return nil, err
 	}
+	return p.parse(str), nil
+}
+
+// Calls the Redis CLUSTER INFO command on the client and returns an `info` map.
+func (p *redisSvc) clusterInfo() (info, error) {
+	str, err := p.client.retrieveClusterInfo()
+	if err != nil {
+		return nil, err
+	}
+	return p.parse(str), nil
+}
+
+// Parses the "key:value" lines of str, skipping empty lines and section headers.
+func (p *redisSvc) parse(str string) info {
 	lines := strings.Split(str, p.delimiter)
 	attrs := make(map[string]string)
 	for _, line := range lines {
@@ -39,5 +53,5 @@ func (p *redisSvc) info() (info, error) {
 			attrs[pair[0]] = pair[1]
 		}
 	}
-	return attrs, nil
+	return attrs
 }
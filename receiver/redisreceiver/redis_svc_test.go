@@ -20,3 +20,11 @@ func TestParser(t *testing.T) {
 	require.Equal(t, 130, len(info))
 	require.Equal(t, "1.24", info["allocator_frag_ratio"]) // spot check
 }
+
+func TestClusterInfoParser(t *testing.T) {
+	s := newFakeAPIParser()
+	info, err := s.clusterInfo()
+	require.Nil(t, err)
+	require.Equal(t, 12, len(info))
+	require.Equal(t, "384", info["cluster_slots_pfail"])
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/multierr"
+)
+
+const (
+	salesforceKey = "salesforce"
+	oktaKey       = "okta"
+
+	// SalesforceIntervalHourly polls the hourly event log files
+	SalesforceIntervalHourly = "Hourly"
+	// SalesforceIntervalDaily polls the daily event log files
+	SalesforceIntervalDaily = "Daily"
+)
+
+// Config defines the configuration of the SaaS audit logs receiver.
+type Config struct {
+	// PollInterval is the time between two polls of the audit logs.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// InitialLookback is how far in the past the audit logs are read the first time
+	// the receiver polls them, when no cursor is stored.
+	InitialLookback time.Duration `mapstructure:"initial_lookback"`
+	// StorageID is the storage extension persisting the cursor of the last audit log read.
+	StorageID *component.ID `mapstructure:"storage"`
+
+	Salesforce *SalesforceConfig `mapstructure:"salesforce"`
+	Okta       *OktaConfig       `mapstructure:"okta"`
+}
+
+// SalesforceConfig defines the polling of the Salesforce Event Monitoring event log files.
+type SalesforceConfig struct {
+	// Endpoint is the instance URL, the authentication is typically configured
+	// with the oauth2client extension using the client credentials flow.
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+
+	APIVersion string `mapstructure:"api_version"`
+	// EventTypes restricts the event log files read to these event types, e.g. Login or API.
+	EventTypes []string `mapstructure:"event_types"`
+	// Interval is the interval of the event log files read, Hourly or Daily.
+	Interval string `mapstructure:"interval"`
+
+	Mapping MappingConfig `mapstructure:"mapping"`
+}
+
+// OktaConfig defines the polling of the Okta System Log.
+type OktaConfig struct {
+	// Endpoint is the URL of the Okta organization, e.g. https://example.okta.com.
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+
+	// APIToken authenticates the requests with an Okta API token, when they are
+	// not authenticated by an authenticator extension.
+	APIToken configopaque.String `mapstructure:"api_token"`
+	// Filter is a System Log filter expression restricting the events read,
+	// e.g. eventType eq "user.session.start".
+	Filter string `mapstructure:"filter"`
+	// Limit is the number of events read per request.
+	Limit int `mapstructure:"limit"`
+
+	Mapping MappingConfig `mapstructure:"mapping"`
+}
+
+// MappingConfig defines how the fields of the audit log records are mapped to log records.
+// The fields of nested objects are referenced with dot separated paths, e.g. actor.id.
+type MappingConfig struct {
+	// TimestampField is the field containing the time of the record.
+	TimestampField string `mapstructure:"timestamp_field"`
+	// TimestampLayout is the Go layout of the time of the record, RFC3339 by default.
+	TimestampLayout string `mapstructure:"timestamp_layout"`
+	// SeverityField is the field containing the severity text of the record.
+	SeverityField string `mapstructure:"severity_field"`
+	// BodyField is the field used as body of the log record, the whole record is used when empty.
+	BodyField string `mapstructure:"body_field"`
+	// Attributes maps fields of the record to log record attributes.
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+var (
+	errNoSource               = errors.New("a source of audit logs must be configured")
+	errInvalidPollInterval    = errors.New("poll_interval must be positive")
+	errInvalidInitialLookback = errors.New("initial_lookback must not be negative")
+	errNoSalesforceEndpoint   = errors.New("salesforce::endpoint must be specified")
+	errNoSalesforceAPIVersion = errors.New("salesforce::api_version must be specified")
+	errMultipleSources        = errors.New("only one source of audit logs can be configured")
+	errNoOktaEndpoint         = errors.New("okta::endpoint must be specified")
+	errNoOktaAuth             = errors.New("one of okta::api_token or okta::auth must be specified")
+	errInvalidOktaLimit       = fmt.Errorf("okta::limit must be between 1 and %d", maxOktaLimit)
+)
+
+var _ component.Config = (*Config)(nil)
+var _ confmap.Unmarshaler = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid
+func (c *Config) Validate() error {
+	if c.Salesforce == nil && c.Okta == nil {
+		return errNoSource
+	}
+	if c.Salesforce != nil && c.Okta != nil {
+		return errMultipleSources
+	}
+	var errs error
+	if c.PollInterval <= 0 {
+		errs = multierr.Append(errs, errInvalidPollInterval)
+	}
+	if c.InitialLookback < 0 {
+		errs = multierr.Append(errs, errInvalidInitialLookback)
+	}
+	if c.Salesforce != nil {
+		errs = multierr.Append(errs, c.Salesforce.validate())
+	}
+	if c.Okta != nil {
+		errs = multierr.Append(errs, c.Okta.validate())
+	}
+	return errs
+}
+
+func (c *SalesforceConfig) validate() error {
+	var errs error
+	if c.Endpoint == "" {
+		errs = multierr.Append(errs, errNoSalesforceEndpoint)
+	}
+	if c.APIVersion == "" {
+		errs = multierr.Append(errs, errNoSalesforceAPIVersion)
+	}
+	switch c.Interval {
+	case SalesforceIntervalHourly, SalesforceIntervalDaily:
+	default:
+		errs = multierr.Append(errs, fmt.Errorf("invalid salesforce::interval %q, must be %s or %s",
+			c.Interval, SalesforceIntervalHourly, SalesforceIntervalDaily))
+	}
+	return errs
+}
+
+func (c *OktaConfig) validate() error {
+	var errs error
+	if c.Endpoint == "" {
+		errs = multierr.Append(errs, errNoOktaEndpoint)
+	}
+	if c.APIToken == "" && c.Auth == nil {
+		errs = multierr.Append(errs, errNoOktaAuth)
+	}
+	if c.Limit < 1 || c.Limit > maxOktaLimit {
+		errs = multierr.Append(errs, errInvalidOktaLimit)
+	}
+	return errs
+}
+
+// Unmarshal a confmap.Conf into the config struct.
+func (c *Config) Unmarshal(conf *confmap.Conf) error {
+	err := conf.Unmarshal(c, confmap.WithErrorUnused())
+	if err != nil {
+		return err
+	}
+
+	if !conf.IsSet(salesforceKey) {
+		c.Salesforce = nil
+	}
+	if !conf.IsSet(oktaKey) {
+		c.Okta = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	storageID := component.NewID("file_storage")
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr error
+	}{
+		{
+			id:          component.NewIDWithName(metadata.Type, ""),
+			expectedErr: errNoSource,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "salesforce"),
+			expected: &Config{
+				PollInterval:    10 * time.Minute,
+				InitialLookback: 24 * time.Hour,
+				StorageID:       &storageID,
+				Salesforce: &SalesforceConfig{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: "https://example.my.salesforce.com",
+						Timeout:  defaultSalesforceTimeout,
+						Auth:     &configauth.Authentication{AuthenticatorID: component.NewID("oauth2client")},
+					},
+					APIVersion: defaultSalesforceAPIVersion,
+					EventTypes: []string{"Login", "API"},
+					Interval:   SalesforceIntervalDaily,
+					Mapping: MappingConfig{
+						TimestampField: "TIMESTAMP_DERIVED",
+						SeverityField:  "LOGIN_STATUS",
+						Attributes: map[string]string{
+							"EVENT_TYPE":      "salesforce.event_type",
+							"ORGANIZATION_ID": "salesforce.organization_id",
+							"USER_ID":         "enduser.id",
+							"CLIENT_IP":       "client.address",
+						},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid"),
+			expectedErr: multierr.Combine(
+				errInvalidPollInterval,
+				errNoSalesforceEndpoint,
+				errNoSalesforceAPIVersion,
+				errors.New(`invalid salesforce::interval "Weekly", must be Hourly or Daily`),
+			),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "okta"),
+			expected: &Config{
+				PollInterval: defaultPollInterval,
+				StorageID:    &storageID,
+				Okta: &OktaConfig{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: "https://example.okta.com",
+						Timeout:  defaultOktaTimeout,
+					},
+					APIToken: "${env:OKTA_API_TOKEN}",
+					Filter:   `eventType sw "user.session"`,
+					Limit:    500,
+					Mapping: MappingConfig{
+						TimestampField: "published",
+						SeverityField:  "severity",
+						Attributes: map[string]string{
+							"uuid":             "okta.uuid",
+							"eventType":        "okta.event_type",
+							"outcome.result":   "okta.outcome.result",
+							"actor.id":         "enduser.id",
+							"client.ipAddress": "client.address",
+						},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "okta_invalid"),
+			expectedErr: multierr.Combine(
+				errNoOktaEndpoint,
+				errNoOktaAuth,
+				errInvalidOktaLimit,
+			),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "multiple"),
+			expectedErr: errMultipleSources,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr.Error())
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
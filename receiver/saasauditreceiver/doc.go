@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package saasauditreceiver implements a receiver polling the audit logs of SaaS
+// APIs, such as the event log files of Salesforce Event Monitoring.
+package saasauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver"
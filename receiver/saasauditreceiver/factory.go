@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver/internal/metadata"
+)
+
+const (
+	defaultPollInterval         = 5 * time.Minute
+	defaultSalesforceAPIVersion = "58.0"
+	defaultSalesforceTimeout    = 30 * time.Second
+	defaultOktaTimeout          = 30 * time.Second
+	defaultOktaLimit            = 1000
+)
+
+// NewFactory creates a factory for the SaaS audit logs receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		PollInterval: defaultPollInterval,
+		Salesforce: &SalesforceConfig{
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Timeout: defaultSalesforceTimeout,
+			},
+			APIVersion: defaultSalesforceAPIVersion,
+			Interval:   SalesforceIntervalHourly,
+			Mapping: MappingConfig{
+				TimestampField: "TIMESTAMP_DERIVED",
+				Attributes: map[string]string{
+					"EVENT_TYPE":      "salesforce.event_type",
+					"ORGANIZATION_ID": "salesforce.organization_id",
+					"USER_ID":         "enduser.id",
+				},
+			},
+		},
+		Okta: &OktaConfig{
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Timeout: defaultOktaTimeout,
+			},
+			Limit: defaultOktaLimit,
+			Mapping: MappingConfig{
+				TimestampField: "published",
+				SeverityField:  "severity",
+				Attributes: map[string]string{
+					"uuid":             "okta.uuid",
+					"eventType":        "okta.event_type",
+					"outcome.result":   "okta.outcome.result",
+					"actor.id":         "enduser.id",
+					"client.ipAddress": "client.address",
+				},
+			},
+		},
+	}
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	rCfg := cfg.(*Config)
+	var src source
+	if rCfg.Salesforce != nil {
+		src = newSalesforceSource(params.TelemetrySettings, rCfg.Salesforce)
+	} else {
+		src = newOktaSource(params.TelemetrySettings, rCfg.Okta)
+	}
+	return newPoller(params, rCfg, src, consumer), nil
+}
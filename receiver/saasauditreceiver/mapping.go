@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// record is an audit log record, as decoded from JSON or CSV.
+type record map[string]interface{}
+
+// get returns the value of a field of the record, the fields of nested
+// objects being referenced by dot separated paths.
+func (r record) get(path string) (interface{}, bool) {
+	var current interface{} = map[string]interface{}(r)
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// mapRecord sets the timestamp, severity, body and attributes of the log record from the audit log record.
+func (c MappingConfig) mapRecord(r record, lr plog.LogRecord) error {
+	if c.TimestampField != "" {
+		if v, ok := r.get(c.TimestampField); ok {
+			s, isString := v.(string)
+			if !isString {
+				return fmt.Errorf("timestamp field %s is not a string", c.TimestampField)
+			}
+			layout := c.TimestampLayout
+			if layout == "" {
+				layout = time.RFC3339Nano
+			}
+			ts, err := time.Parse(layout, s)
+			if err != nil {
+				return fmt.Errorf("invalid timestamp field %s: %w", c.TimestampField, err)
+			}
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		}
+	}
+
+	if c.SeverityField != "" {
+		if v, ok := r.get(c.SeverityField); ok {
+			lr.SetSeverityText(fmt.Sprint(v))
+		}
+	}
+
+	for field, attribute := range c.Attributes {
+		if v, ok := r.get(field); ok {
+			if err := lr.Attributes().PutEmpty(attribute).FromRaw(v); err != nil {
+				return fmt.Errorf("invalid field %s: %w", field, err)
+			}
+		}
+	}
+
+	if c.BodyField == "" {
+		return lr.Body().SetEmptyMap().FromRaw(r)
+	}
+	if v, ok := r.get(c.BodyField); ok {
+		return lr.Body().FromRaw(v)
+	}
+	return nil
+}
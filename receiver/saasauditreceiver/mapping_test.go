@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestMapRecord(t *testing.T) {
+	r := record{
+		"published": "2023-07-01T12:00:00.5Z",
+		"severity":  "WARN",
+		"actor":     map[string]interface{}{"id": "00u1", "type": "User"},
+		"outcome":   map[string]interface{}{"result": "FAILURE"},
+		"message":   "User login failed",
+	}
+
+	tests := []struct {
+		name    string
+		mapping MappingConfig
+		check   func(t *testing.T, lr plog.LogRecord)
+	}{
+		{
+			name: "whole record",
+			mapping: MappingConfig{
+				TimestampField: "published",
+				SeverityField:  "severity",
+				Attributes:     map[string]string{"actor.id": "enduser.id", "outcome": "outcome", "missing": "missing"},
+			},
+			check: func(t *testing.T, lr plog.LogRecord) {
+				assert.Equal(t, time.Date(2023, 7, 1, 12, 0, 0, 500000000, time.UTC), lr.Timestamp().AsTime())
+				assert.Equal(t, "WARN", lr.SeverityText())
+				assert.Equal(t, map[string]interface{}{
+					"enduser.id": "00u1",
+					"outcome":    map[string]interface{}{"result": "FAILURE"},
+				}, lr.Attributes().AsRaw())
+				assert.Equal(t, map[string]interface{}(r), lr.Body().Map().AsRaw())
+			},
+		},
+		{
+			name: "body field",
+			mapping: MappingConfig{
+				TimestampField:  "published",
+				TimestampLayout: "2006-01-02T15:04:05Z",
+				BodyField:       "message",
+			},
+			check: func(t *testing.T, lr plog.LogRecord) {
+				assert.Equal(t, time.Date(2023, 7, 1, 12, 0, 0, 500000000, time.UTC), lr.Timestamp().AsTime())
+				assert.Equal(t, "User login failed", lr.Body().Str())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			require.NoError(t, tt.mapping.mapRecord(r, lr))
+			tt.check(t, lr)
+		})
+	}
+}
+
+func TestMapRecordInvalidTimestamp(t *testing.T) {
+	lr := plog.NewLogRecord()
+	assert.EqualError(t, MappingConfig{TimestampField: "actor"}.mapRecord(record{"actor": map[string]interface{}{}}, lr), "timestamp field actor is not a string")
+	assert.ErrorContains(t, MappingConfig{TimestampField: "published"}.mapRecord(record{"published": "yesterday"}, lr), "invalid timestamp field published")
+}
@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	attributeOktaOrgURL = "okta.org_url"
+
+	oktaLogsPath = "/api/v1/logs"
+	maxOktaLimit = 1000
+
+	// oktaTimeLayout is the layout of the since parameter of the System Log API
+	oktaTimeLayout = "2006-01-02T15:04:05.000Z"
+)
+
+// oktaCursor is the position in the System Log: the link of the next page of events
+// returned by the API or, before the first page is read, the time the events are read from.
+type oktaCursor struct {
+	Since time.Time `json:"since"`
+	Next  string    `json:"next,omitempty"`
+}
+
+type oktaError struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorSummary string `json:"errorSummary"`
+}
+
+// oktaSource reads the events of the Okta System Log.
+// See https://developer.okta.com/docs/reference/api/system-log/
+type oktaSource struct {
+	settings component.TelemetrySettings
+	config   *OktaConfig
+	client   *http.Client
+}
+
+func newOktaSource(settings component.TelemetrySettings, cfg *OktaConfig) *oktaSource {
+	return &oktaSource{
+		settings: settings,
+		config:   cfg,
+	}
+}
+
+func (s *oktaSource) start(_ context.Context, host component.Host) error {
+	client, err := s.config.ToClient(host, s.settings)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP client: %w", err)
+	}
+	s.client = client
+	return nil
+}
+
+func (s *oktaSource) initialCursor(since time.Time) ([]byte, error) {
+	return json.Marshal(oktaCursor{Since: since.UTC().Truncate(time.Millisecond)})
+}
+
+func (s *oktaSource) resource(res pcommon.Resource) {
+	res.Attributes().PutStr(attributeOktaOrgURL, s.config.Endpoint)
+}
+
+func (s *oktaSource) mapping() MappingConfig {
+	return s.config.Mapping
+}
+
+// poll reads the events published since the cursor, in the order of their publication.
+// When polling, the API returns a link to the next page of events even with the last
+// page, so the events published later are read from that link on the next poll.
+func (s *oktaSource) poll(ctx context.Context, rawCursor []byte, emit emitFunc) error {
+	var cursor oktaCursor
+	if err := json.Unmarshal(rawCursor, &cursor); err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	path := cursor.Next
+	if path == "" {
+		path = s.firstPage(cursor.Since)
+	}
+	for {
+		var events []record
+		header, err := s.get(ctx, path, &events)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		next := nextLink(header)
+		if next == "" {
+			// Without a link the events are read again from the last one published,
+			// which is read twice.
+			next = s.firstPage(lastPublished(events, cursor.Since))
+		}
+		rawNext, err := json.Marshal(oktaCursor{Next: next})
+		if err != nil {
+			return err
+		}
+		if err = emit(ctx, events, rawNext); err != nil {
+			return err
+		}
+
+		// The rate limit of the endpoint is reached, the next page is read once it is reset
+		if header.Get("X-Rate-Limit-Remaining") == "0" {
+			return &rateLimitError{
+				retryAfter: oktaRateLimitReset(header),
+				err:        fmt.Errorf("GET %s: rate limit reached", oktaLogsPath),
+			}
+		}
+		path = next
+	}
+}
+
+// firstPage returns the path of the events published since the given time.
+func (s *oktaSource) firstPage(since time.Time) string {
+	query := url.Values{}
+	query.Set("since", since.UTC().Format(oktaTimeLayout))
+	query.Set("sortOrder", "ASCENDING")
+	query.Set("limit", strconv.Itoa(s.config.Limit))
+	if s.config.Filter != "" {
+		query.Set("filter", s.config.Filter)
+	}
+	return oktaLogsPath + "?" + query.Encode()
+}
+
+func (s *oktaSource) get(ctx context.Context, path string, events *[]record) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(s.config.Endpoint, "/")+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.config.APIToken != "" {
+		req.Header.Set("Authorization", "SSWS "+string(s.config.APIToken))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = checkRateLimit(resp); err != nil {
+		// Okta gives the time the rate limit is reset rather than a Retry-After header
+		var rateLimitErr *rateLimitError
+		if errors.As(err, &rateLimitErr) && rateLimitErr.retryAfter == 0 {
+			rateLimitErr.retryAfter = oktaRateLimitReset(resp.Header)
+		}
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var oErr oktaError
+		_ = json.NewDecoder(resp.Body).Decode(&oErr)
+		err = fmt.Errorf("%s %s: %s", req.Method, req.URL.Path, resp.Status)
+		if oErr.ErrorCode != "" {
+			err = fmt.Errorf("%w: %s: %s", err, oErr.ErrorCode, oErr.ErrorSummary)
+		}
+		return nil, err
+	}
+	if err = json.NewDecoder(resp.Body).Decode(events); err != nil {
+		return nil, fmt.Errorf("unable to decode System Log events: %w", err)
+	}
+	return resp.Header, nil
+}
+
+// nextLink returns the path of the next page link of the Link headers, e.g.
+// <https://example.okta.com/api/v1/logs?after=1690000000000_1>; rel="next".
+func nextLink(header http.Header) string {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			target, params, found := strings.Cut(strings.TrimSpace(link), ";")
+			if !found || !strings.Contains(params, `rel="next"`) {
+				continue
+			}
+			u, err := url.Parse(strings.Trim(strings.TrimSpace(target), "<>"))
+			if err != nil {
+				continue
+			}
+			return u.RequestURI()
+		}
+	}
+	return ""
+}
+
+// lastPublished returns the publication time of the last event, or the given time
+// when it cannot be read.
+func lastPublished(events []record, since time.Time) time.Time {
+	if v, ok := events[len(events)-1].get("published"); ok {
+		if s, isString := v.(string); isString {
+			if published, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return published
+			}
+		}
+	}
+	return since
+}
+
+// oktaRateLimitReset returns the time until the rate limit is reset, given in seconds
+// since the epoch by the X-Rate-Limit-Reset header.
+func oktaRateLimitReset(header http.Header) time.Duration {
+	reset, err := strconv.ParseInt(header.Get("X-Rate-Limit-Reset"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	if wait := time.Until(time.Unix(reset, 0)); wait > 0 {
+		return wait
+	}
+	return 0
+}
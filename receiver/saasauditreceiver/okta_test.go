@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func newTestOktaSource(t *testing.T, handler http.HandlerFunc) *oktaSource {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	cfg := createDefaultConfig().(*Config).Okta
+	cfg.Endpoint = srv.URL
+	cfg.APIToken = "token"
+	cfg.Filter = `eventType eq "user.session.start"`
+	cfg.Limit = 2
+	s := newOktaSource(componenttest.NewNopTelemetrySettings(), cfg)
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	return s
+}
+
+func TestOktaPoll(t *testing.T) {
+	var srvURL string
+	s := newTestOktaSource(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "SSWS token", r.Header.Get("Authorization"))
+		assert.Equal(t, "/api/v1/logs", r.URL.Path)
+		query := r.URL.Query()
+		switch query.Get("after") {
+		case "":
+			assert.Equal(t, "2023-07-01T00:00:00.000Z", query.Get("since"))
+			assert.Equal(t, "ASCENDING", query.Get("sortOrder"))
+			assert.Equal(t, "2", query.Get("limit"))
+			assert.Equal(t, `eventType eq "user.session.start"`, query.Get("filter"))
+			w.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/logs?since=2023-07-01T00%%3A00%%3A00.000Z&limit=2>; rel="self"`, srvURL))
+			w.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/logs?after=2_2&limit=2>; rel="next"`, srvURL))
+			fmt.Fprint(w, `[
+				{"uuid":"1","published":"2023-07-01T00:10:00.000Z","eventType":"user.session.start","severity":"INFO","actor":{"id":"00u1"}},
+				{"uuid":"2","published":"2023-07-01T00:20:00.000Z","eventType":"user.session.start","severity":"INFO","actor":{"id":"00u2"}}]`)
+		case "2_2":
+			w.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/logs?after=3_3&limit=2>; rel="next"`, srvURL))
+			fmt.Fprint(w, `[{"uuid":"3","published":"2023-07-01T00:30:00.000Z","eventType":"user.session.start","severity":"WARN","outcome":{"result":"FAILURE"}}]`)
+		case "3_3":
+			w.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/logs?after=3_3&limit=2>; rel="next"`, srvURL))
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	})
+	srvURL = s.config.Endpoint
+
+	cursor, err := s.initialCursor(time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	var emitted [][]record
+	var cursors []oktaCursor
+	emit := func(_ context.Context, records []record, next []byte) error {
+		emitted = append(emitted, records)
+		var c oktaCursor
+		require.NoError(t, json.Unmarshal(next, &c))
+		cursors = append(cursors, c)
+		return nil
+	}
+	require.NoError(t, s.poll(context.Background(), cursor, emit))
+
+	require.Len(t, emitted, 2)
+	require.Len(t, emitted[0], 2)
+	assert.Equal(t, "1", emitted[0][0]["uuid"])
+	actorID, ok := emitted[0][1].get("actor.id")
+	require.True(t, ok)
+	assert.Equal(t, "00u2", actorID)
+	require.Len(t, emitted[1], 1)
+	result, ok := emitted[1][0].get("outcome.result")
+	require.True(t, ok)
+	assert.Equal(t, "FAILURE", result)
+	assert.Equal(t, []oktaCursor{
+		{Next: "/api/v1/logs?after=2_2&limit=2"},
+		{Next: "/api/v1/logs?after=3_3&limit=2"},
+	}, cursors)
+
+	// The next poll continues from the link of the last page
+	emitted = nil
+	cursor, err = json.Marshal(cursors[1])
+	require.NoError(t, err)
+	require.NoError(t, s.poll(context.Background(), cursor, emit))
+	assert.Empty(t, emitted)
+}
+
+func TestOktaPollRateLimitRemaining(t *testing.T) {
+	reset := time.Now().Add(time.Minute)
+	s := newTestOktaSource(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("after") != "" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("X-Rate-Limit-Remaining", "0")
+		w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set("Link", `</api/v1/logs?after=1_1>; rel="next"`)
+		fmt.Fprint(w, `[{"uuid":"1","published":"2023-07-01T00:10:00.000Z"}]`)
+	})
+
+	cursor, err := s.initialCursor(time.Now())
+	require.NoError(t, err)
+	var emitted int
+	err = s.poll(context.Background(), cursor, func(context.Context, []record, []byte) error {
+		emitted++
+		return nil
+	})
+
+	// The events read are emitted before the poll is stopped
+	assert.Equal(t, 1, emitted)
+	var rateLimitErr *rateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.InDelta(t, time.Until(reset).Seconds(), rateLimitErr.retryAfter.Seconds(), 2)
+}
+
+func TestOktaPollErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		check   func(t *testing.T, err error)
+	}{
+		{
+			name: "too many requests",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(time.Now().Add(30*time.Second).Unix(), 10))
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			check: func(t *testing.T, err error) {
+				var rateLimitErr *rateLimitError
+				require.True(t, errors.As(err, &rateLimitErr))
+				assert.InDelta(t, 30, rateLimitErr.retryAfter.Seconds(), 2)
+			},
+		},
+		{
+			name: "invalid token",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"errorCode":"E0000011","errorSummary":"Invalid token provided"}`)
+			},
+			check: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "GET /api/v1/logs: 401 Unauthorized: E0000011: Invalid token provided")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestOktaSource(t, tt.handler)
+			cursor, err := s.initialCursor(time.Now())
+			require.NoError(t, err)
+			err = s.poll(context.Background(), cursor, func(context.Context, []record, []byte) error {
+				t.Error("unexpected records")
+				return nil
+			})
+			tt.check(t, err)
+		})
+	}
+}
+
+func TestNextLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		links    []string
+		expected string
+	}{
+		{
+			name:     "separate headers",
+			links:    []string{`<https://example.okta.com/api/v1/logs?limit=2>; rel="self"`, `<https://example.okta.com/api/v1/logs?after=1_1&limit=2>; rel="next"`},
+			expected: "/api/v1/logs?after=1_1&limit=2",
+		},
+		{
+			name:     "single header",
+			links:    []string{`<https://example.okta.com/api/v1/logs?limit=2>; rel="self", <https://example.okta.com/api/v1/logs?after=1_1>; rel="next"`},
+			expected: "/api/v1/logs?after=1_1",
+		},
+		{
+			name:  "no next link",
+			links: []string{`<https://example.okta.com/api/v1/logs?limit=2>; rel="self"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for _, link := range tt.links {
+				header.Add("Link", link)
+			}
+			assert.Equal(t, tt.expected, nextLink(header))
+		})
+	}
+}
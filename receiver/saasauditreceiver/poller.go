@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+)
+
+const cursorStorageKey = "cursor"
+
+// emitFunc sends the records of a page of audit logs, and persists the cursor
+// after which the next audit logs are read once they are consumed.
+type emitFunc func(ctx context.Context, records []record, cursor []byte) error
+
+// source is a SaaS API the audit logs are polled from.
+type source interface {
+	// start creates the client of the API.
+	start(ctx context.Context, host component.Host) error
+	// initialCursor returns the cursor of the audit logs published after the given time.
+	initialCursor(since time.Time) ([]byte, error)
+	// poll reads the audit logs published after the cursor, page by page.
+	poll(ctx context.Context, cursor []byte, emit emitFunc) error
+	// resource sets the attributes of the resource of the audit logs.
+	resource(res pcommon.Resource)
+	// mapping returns the mapping rules of the audit log records.
+	mapping() MappingConfig
+}
+
+// rateLimitError is returned by the sources when the API rejected a request because
+// of its rate limits. The poll is retried after retryAfter when it is set.
+type rateLimitError struct {
+	retryAfter time.Duration
+	err        error
+}
+
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("rate limited: %v", e.err)
+}
+
+func (e *rateLimitError) Unwrap() error {
+	return e.err
+}
+
+// checkRateLimit returns a rateLimitError for the responses with the status codes of rate
+// limited requests, honoring their Retry-After header.
+func checkRateLimit(resp *http.Response) error {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return nil
+	}
+	err := &rateLimitError{err: fmt.Errorf("%s %s: %s", resp.Request.Method, resp.Request.URL.Path, resp.Status)}
+	retryAfter := resp.Header.Get("Retry-After")
+	if seconds, parseErr := strconv.Atoi(retryAfter); parseErr == nil {
+		err.retryAfter = time.Duration(seconds) * time.Second
+	} else if date, parseErr := http.ParseTime(retryAfter); parseErr == nil {
+		err.retryAfter = time.Until(date)
+	}
+	return err
+}
+
+// poller polls the audit logs of a source, and persists the cursor of the last
+// audit logs consumed, so that they are read once across restarts.
+type poller struct {
+	settings receiver.CreateSettings
+	config   *Config
+	source   source
+	consumer consumer.Logs
+
+	storageClient storage.Client
+	cursor        []byte
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newPoller(settings receiver.CreateSettings, cfg *Config, src source, consumer consumer.Logs) *poller {
+	return &poller{
+		settings:      settings,
+		config:        cfg,
+		source:        src,
+		consumer:      consumer,
+		storageClient: storage.NewNopClient(),
+	}
+}
+
+func (p *poller) Start(ctx context.Context, host component.Host) error {
+	if p.config.StorageID != nil {
+		client, err := getStorageClient(ctx, host, *p.config.StorageID, p.settings.ID)
+		if err != nil {
+			return err
+		}
+		p.storageClient = client
+	}
+	if err := p.source.start(ctx, host); err != nil {
+		return err
+	}
+	if err := p.loadCursor(ctx); err != nil {
+		return err
+	}
+
+	pollCtx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.wg.Add(1)
+	go p.run(pollCtx)
+	return nil
+}
+
+func (p *poller) Shutdown(ctx context.Context) error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
+	return p.storageClient.Close(ctx)
+}
+
+func (p *poller) run(ctx context.Context) {
+	defer p.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		wait := p.config.PollInterval
+		err := p.source.poll(ctx, p.cursor, p.emit)
+		var rateLimitErr *rateLimitError
+		switch {
+		case err == nil || ctx.Err() != nil:
+		case errors.As(err, &rateLimitErr):
+			if rateLimitErr.retryAfter > 0 {
+				wait = rateLimitErr.retryAfter
+			}
+			p.settings.Logger.Warn("Audit logs API rate limit reached, polling later", zap.Duration("retry_after", wait), zap.Error(err))
+		default:
+			p.settings.Logger.Error("Unable to poll audit logs", zap.Error(err))
+		}
+		timer.Reset(wait)
+	}
+}
+
+// emit consumes the records, then persists the cursor of the next records.
+func (p *poller) emit(ctx context.Context, records []record, cursor []byte) error {
+	if len(records) > 0 {
+		logs := plog.NewLogs()
+		rl := logs.ResourceLogs().AppendEmpty()
+		p.source.resource(rl.Resource())
+		logRecords := rl.ScopeLogs().AppendEmpty().LogRecords()
+		logRecords.EnsureCapacity(len(records))
+
+		mapping := p.source.mapping()
+		observedTime := pcommon.NewTimestampFromTime(time.Now())
+		for _, r := range records {
+			lr := logRecords.AppendEmpty()
+			lr.SetObservedTimestamp(observedTime)
+			if err := mapping.mapRecord(r, lr); err != nil {
+				return fmt.Errorf("unable to map audit log record: %w", err)
+			}
+		}
+		if err := p.consumer.ConsumeLogs(ctx, logs); err != nil {
+			return err
+		}
+	}
+
+	p.cursor = cursor
+	if err := p.storageClient.Set(ctx, cursorStorageKey, cursor); err != nil {
+		p.settings.Logger.Error("Unable to persist the cursor of the audit logs", zap.Error(err))
+	}
+	return nil
+}
+
+func (p *poller) loadCursor(ctx context.Context) error {
+	cursor, err := p.storageClient.Get(ctx, cursorStorageKey)
+	if err != nil {
+		p.settings.Logger.Info("Unable to load the cursor of the audit logs, continuing without a previous cursor", zap.Error(err))
+	}
+	if cursor == nil {
+		if cursor, err = p.source.initialCursor(time.Now().Add(-p.config.InitialLookback)); err != nil {
+			return err
+		}
+	}
+	p.cursor = cursor
+	return nil
+}
+
+func getStorageClient(ctx context.Context, host component.Host, storageID component.ID, componentID component.ID) (storage.Client, error) {
+	extension, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, "")
+}
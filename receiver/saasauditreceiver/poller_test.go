@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+type mockSource struct {
+	mu      sync.Mutex
+	pages   [][]record
+	cursors []string
+	since   time.Time
+}
+
+func (m *mockSource) start(context.Context, component.Host) error {
+	return nil
+}
+
+func (m *mockSource) initialCursor(since time.Time) ([]byte, error) {
+	m.since = since
+	return []byte("initial"), nil
+}
+
+func (m *mockSource) poll(ctx context.Context, cursor []byte, emit emitFunc) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cursors = append(m.cursors, string(cursor))
+	for len(m.pages) > 0 {
+		if err := emit(ctx, m.pages[0], []byte(m.pages[0][0]["id"].(string))); err != nil {
+			return err
+		}
+		m.pages = m.pages[1:]
+	}
+	return nil
+}
+
+func (m *mockSource) resource(res pcommon.Resource) {
+	res.Attributes().PutStr("source", "mock")
+}
+
+func (m *mockSource) mapping() MappingConfig {
+	return MappingConfig{Attributes: map[string]string{"id": "id"}}
+}
+
+func TestPollerEmit(t *testing.T) {
+	src := &mockSource{pages: [][]record{{{"id": "1"}, {"id": "2"}}, {{"id": "3"}}}}
+	cfg := createDefaultConfig().(*Config)
+	cfg.InitialLookback = time.Hour
+	sink := &consumertest.LogsSink{}
+	p := newPoller(receivertest.NewNopCreateSettings(), cfg, src, sink)
+	require.NoError(t, p.loadCursor(context.Background()))
+	assert.Equal(t, "initial", string(p.cursor))
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), src.since, time.Minute)
+
+	require.NoError(t, src.poll(context.Background(), p.cursor, p.emit))
+	require.Len(t, sink.AllLogs(), 2)
+	assert.Equal(t, 2, sink.AllLogs()[0].LogRecordCount())
+	rl := sink.AllLogs()[0].ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{"source": "mock"}, rl.Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"id": "1"}, rl.ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw())
+	assert.Equal(t, "3", string(p.cursor))
+}
+
+func TestPollerConsumerError(t *testing.T) {
+	src := &mockSource{pages: [][]record{{{"id": "1"}}}}
+	cfg := createDefaultConfig().(*Config)
+	p := newPoller(receivertest.NewNopCreateSettings(), cfg, src, consumertest.NewErr(errors.New("unavailable")))
+	p.cursor = []byte("0")
+
+	assert.EqualError(t, src.poll(context.Background(), p.cursor, p.emit), "unavailable")
+	// the cursor is not moved until the records are consumed
+	assert.Equal(t, "0", string(p.cursor))
+}
+
+func TestPollerStartShutdown(t *testing.T) {
+	src := &mockSource{pages: [][]record{{{"id": "1"}}}}
+	cfg := createDefaultConfig().(*Config)
+	sink := &consumertest.LogsSink{}
+	p := newPoller(receivertest.NewNopCreateSettings(), cfg, src, sink)
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	src.mu.Lock()
+	defer src.mu.Unlock()
+	assert.Equal(t, []string{"initial"}, src.cursors)
+}
+
+func TestPollerUnknownStorage(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	storageID := component.NewID("file_storage")
+	cfg.StorageID = &storageID
+	p := newPoller(receivertest.NewNopCreateSettings(), cfg, &mockSource{}, consumertest.NewNop())
+	assert.EqualError(t, p.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'file_storage' not found")
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	req := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/events"}}
+	assert.NoError(t, checkRateLimit(&http.Response{StatusCode: http.StatusOK, Request: req}))
+
+	err := checkRateLimit(&http.Response{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests", Request: req, Header: http.Header{"Retry-After": []string{"120"}}})
+	var rateLimitErr *rateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, 2*time.Minute, rateLimitErr.retryAfter)
+	assert.EqualError(t, err, "rate limited: GET /events: 429 Too Many Requests")
+
+	err = checkRateLimit(&http.Response{StatusCode: http.StatusServiceUnavailable, Request: req, Header: http.Header{}})
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Zero(t, rateLimitErr.retryAfter)
+}
@@ -0,0 +1,234 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saasauditreceiver"
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	attributeSalesforceInstanceURL = "salesforce.instance_url"
+
+	// salesforceTimeLayout is the layout of the datetime fields of the Salesforce API
+	salesforceTimeLayout = "2006-01-02T15:04:05.000-0700"
+	// soqlTimeLayout is the layout of the datetime literals of SOQL
+	soqlTimeLayout = "2006-01-02T15:04:05Z"
+
+	salesforceRequestLimitExceeded = "REQUEST_LIMIT_EXCEEDED"
+)
+
+// salesforceCursor is the creation time of the last event log file read, with the
+// identifiers of the files created during the same second, as SOQL datetime literals
+// have a precision of one second.
+type salesforceCursor struct {
+	CreatedDate time.Time `json:"created_date"`
+	IDs         []string  `json:"ids"`
+}
+
+type eventLogFile struct {
+	ID          string `json:"Id"`
+	EventType   string `json:"EventType"`
+	CreatedDate string `json:"CreatedDate"`
+	LogFile     string `json:"LogFile"`
+}
+
+type queryResponse struct {
+	Done           bool           `json:"done"`
+	NextRecordsURL string         `json:"nextRecordsUrl"`
+	Records        []eventLogFile `json:"records"`
+}
+
+type salesforceError struct {
+	ErrorCode string `json:"errorCode"`
+	Message   string `json:"message"`
+}
+
+// salesforceSource reads the event log files of Salesforce Event Monitoring.
+// See https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/using_resources_event_log_files.htm
+type salesforceSource struct {
+	settings component.TelemetrySettings
+	config   *SalesforceConfig
+	client   *http.Client
+}
+
+func newSalesforceSource(settings component.TelemetrySettings, cfg *SalesforceConfig) *salesforceSource {
+	return &salesforceSource{
+		settings: settings,
+		config:   cfg,
+	}
+}
+
+func (s *salesforceSource) start(_ context.Context, host component.Host) error {
+	client, err := s.config.ToClient(host, s.settings)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP client: %w", err)
+	}
+	s.client = client
+	return nil
+}
+
+func (s *salesforceSource) initialCursor(since time.Time) ([]byte, error) {
+	return json.Marshal(salesforceCursor{CreatedDate: since.UTC().Truncate(time.Second)})
+}
+
+func (s *salesforceSource) resource(res pcommon.Resource) {
+	res.Attributes().PutStr(attributeSalesforceInstanceURL, s.config.Endpoint)
+}
+
+func (s *salesforceSource) mapping() MappingConfig {
+	return s.config.Mapping
+}
+
+// poll reads the event log files created since the cursor, in the order of their creation.
+func (s *salesforceSource) poll(ctx context.Context, rawCursor []byte, emit emitFunc) error {
+	var cursor salesforceCursor
+	if err := json.Unmarshal(rawCursor, &cursor); err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	path := fmt.Sprintf("/services/data/v%s/query?q=%s", s.config.APIVersion, url.QueryEscape(s.query(cursor.CreatedDate)))
+	for path != "" {
+		var resp queryResponse
+		if err := s.get(ctx, path, func(body io.Reader) error {
+			return json.NewDecoder(body).Decode(&resp)
+		}); err != nil {
+			return err
+		}
+
+		for _, file := range resp.Records {
+			createdDate, err := time.Parse(salesforceTimeLayout, file.CreatedDate)
+			if err != nil {
+				return fmt.Errorf("invalid CreatedDate of event log file %s: %w", file.ID, err)
+			}
+			createdDate = createdDate.UTC().Truncate(time.Second)
+			if createdDate.Equal(cursor.CreatedDate) && contains(cursor.IDs, file.ID) {
+				continue
+			}
+
+			records, err := s.readLogFile(ctx, file)
+			if err != nil {
+				return err
+			}
+			if createdDate.After(cursor.CreatedDate) {
+				cursor = salesforceCursor{CreatedDate: createdDate}
+			}
+			cursor.IDs = append(cursor.IDs, file.ID)
+			next, err := json.Marshal(cursor)
+			if err != nil {
+				return err
+			}
+			if err = emit(ctx, records, next); err != nil {
+				return err
+			}
+		}
+
+		path = ""
+		if !resp.Done {
+			path = resp.NextRecordsURL
+		}
+	}
+	return nil
+}
+
+// query returns the SOQL query of the event log files created since the given time.
+func (s *salesforceSource) query(since time.Time) string {
+	var b strings.Builder
+	b.WriteString("SELECT Id, EventType, CreatedDate, LogFile FROM EventLogFile")
+	fmt.Fprintf(&b, " WHERE CreatedDate >= %s AND Interval = '%s'", since.UTC().Format(soqlTimeLayout), s.config.Interval)
+	if len(s.config.EventTypes) > 0 {
+		quoted := make([]string, len(s.config.EventTypes))
+		for i, eventType := range s.config.EventTypes {
+			quoted[i] = "'" + strings.ReplaceAll(eventType, "'", `\'`) + "'"
+		}
+		fmt.Fprintf(&b, " AND EventType IN (%s)", strings.Join(quoted, ", "))
+	}
+	b.WriteString(" ORDER BY CreatedDate ASC")
+	return b.String()
+}
+
+// readLogFile downloads an event log file, which is a CSV file with a header line.
+func (s *salesforceSource) readLogFile(ctx context.Context, file eventLogFile) ([]record, error) {
+	var records []record
+	err := s.get(ctx, file.LogFile, func(body io.Reader) error {
+		reader := csv.NewReader(body)
+		header, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for {
+			values, readErr := reader.Read()
+			if errors.Is(readErr, io.EOF) {
+				return nil
+			}
+			if readErr != nil {
+				return readErr
+			}
+			r := make(record, len(header))
+			for i, name := range header {
+				if values[i] != "" {
+					r[name] = values[i]
+				}
+			}
+			records = append(records, r)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read event log file %s: %w", file.ID, err)
+	}
+	return records, nil
+}
+
+func (s *salesforceSource) get(ctx context.Context, path string, decode func(io.Reader) error) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(s.config.Endpoint, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err = checkRateLimit(resp); err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errs []salesforceError
+		_ = json.NewDecoder(resp.Body).Decode(&errs)
+		err = fmt.Errorf("%s %s: %s", req.Method, req.URL.Path, resp.Status)
+		if len(errs) > 0 {
+			err = fmt.Errorf("%w: %s: %s", err, errs[0].ErrorCode, errs[0].Message)
+			// The daily limit of API requests of the organization is reached
+			if errs[0].ErrorCode == salesforceRequestLimitExceeded {
+				return &rateLimitError{err: err}
+			}
+		}
+		return err
+	}
+	return decode(resp.Body)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
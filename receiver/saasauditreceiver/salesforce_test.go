@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saasauditreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func newTestSalesforceSource(t *testing.T, handler http.HandlerFunc) *salesforceSource {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	cfg := createDefaultConfig().(*Config).Salesforce
+	cfg.Endpoint = srv.URL
+	cfg.EventTypes = []string{"Login"}
+	s := newSalesforceSource(componenttest.NewNopTelemetrySettings(), cfg)
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	return s
+}
+
+func TestSalesforcePoll(t *testing.T) {
+	since := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	s := newTestSalesforceSource(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services/data/v58.0/query":
+			assert.Equal(t, "SELECT Id, EventType, CreatedDate, LogFile FROM EventLogFile WHERE CreatedDate >= 2023-07-01T00:00:00Z AND Interval = 'Hourly' AND EventType IN ('Login') ORDER BY CreatedDate ASC", r.URL.Query().Get("q"))
+			fmt.Fprint(w, `{"done":false,"nextRecordsUrl":"/services/data/v58.0/query/01g-2000","records":[
+				{"Id":"0AT1","EventType":"Login","CreatedDate":"2023-07-01T00:00:00.000+0000","LogFile":"/services/data/v58.0/sobjects/EventLogFile/0AT1/LogFile"},
+				{"Id":"0AT2","EventType":"Login","CreatedDate":"2023-07-01T01:10:00.000+0000","LogFile":"/services/data/v58.0/sobjects/EventLogFile/0AT2/LogFile"}]}`)
+		case "/services/data/v58.0/query/01g-2000":
+			fmt.Fprint(w, `{"done":true,"records":[
+				{"Id":"0AT3","EventType":"Login","CreatedDate":"2023-07-01T01:10:00.000+0000","LogFile":"/services/data/v58.0/sobjects/EventLogFile/0AT3/LogFile"}]}`)
+		case "/services/data/v58.0/sobjects/EventLogFile/0AT1/LogFile":
+			fmt.Fprint(w, "\"EVENT_TYPE\",\"TIMESTAMP_DERIVED\",\"USER_ID\"\n\"Login\",\"2023-06-30T23:10:00.000Z\",\"0051\"\n")
+		case "/services/data/v58.0/sobjects/EventLogFile/0AT2/LogFile":
+			fmt.Fprint(w, "\"EVENT_TYPE\",\"TIMESTAMP_DERIVED\",\"USER_ID\"\n\"Login\",\"2023-07-01T00:10:00.000Z\",\"0052\"\n\"Login\",\"2023-07-01T00:20:00.000Z\",\"\"\n")
+		case "/services/data/v58.0/sobjects/EventLogFile/0AT3/LogFile":
+			fmt.Fprint(w, "\"EVENT_TYPE\",\"TIMESTAMP_DERIVED\",\"USER_ID\"\n")
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	})
+
+	cursor, err := s.initialCursor(since.Add(500 * time.Millisecond))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"created_date":"2023-07-01T00:00:00Z","ids":null}`, string(cursor))
+
+	// the first file was already read
+	cursor, err = json.Marshal(salesforceCursor{CreatedDate: since, IDs: []string{"0AT1"}})
+	require.NoError(t, err)
+
+	var emitted [][]record
+	var cursors []salesforceCursor
+	require.NoError(t, s.poll(context.Background(), cursor, func(_ context.Context, records []record, next []byte) error {
+		emitted = append(emitted, records)
+		var c salesforceCursor
+		require.NoError(t, json.Unmarshal(next, &c))
+		cursors = append(cursors, c)
+		return nil
+	}))
+
+	assert.Equal(t, [][]record{
+		{
+			{"EVENT_TYPE": "Login", "TIMESTAMP_DERIVED": "2023-07-01T00:10:00.000Z", "USER_ID": "0052"},
+			{"EVENT_TYPE": "Login", "TIMESTAMP_DERIVED": "2023-07-01T00:20:00.000Z"},
+		},
+		nil,
+	}, emitted)
+	created := time.Date(2023, 7, 1, 1, 10, 0, 0, time.UTC)
+	assert.Equal(t, []salesforceCursor{
+		{CreatedDate: created, IDs: []string{"0AT2"}},
+		{CreatedDate: created, IDs: []string{"0AT2", "0AT3"}},
+	}, cursors)
+}
+
+func TestSalesforcePollErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		check   func(t *testing.T, err error)
+	}{
+		{
+			name: "request limit exceeded",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `[{"errorCode":"REQUEST_LIMIT_EXCEEDED","message":"TotalRequests Limit exceeded."}]`)
+			},
+			check: func(t *testing.T, err error) {
+				var rateLimitErr *rateLimitError
+				require.True(t, errors.As(err, &rateLimitErr))
+				assert.Zero(t, rateLimitErr.retryAfter)
+			},
+		},
+		{
+			name: "too many requests",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			check: func(t *testing.T, err error) {
+				var rateLimitErr *rateLimitError
+				require.True(t, errors.As(err, &rateLimitErr))
+				assert.Equal(t, 30*time.Second, rateLimitErr.retryAfter)
+			},
+		},
+		{
+			name: "invalid session",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `[{"errorCode":"INVALID_SESSION_ID","message":"Session expired or invalid"}]`)
+			},
+			check: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "GET /services/data/v58.0/query: 401 Unauthorized: INVALID_SESSION_ID: Session expired or invalid")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSalesforceSource(t, tt.handler)
+			cursor, err := s.initialCursor(time.Now())
+			require.NoError(t, err)
+			err = s.poll(context.Background(), cursor, func(context.Context, []record, []byte) error {
+				t.Error("unexpected records")
+				return nil
+			})
+			tt.check(t, err)
+		})
+	}
+}
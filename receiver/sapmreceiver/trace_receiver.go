@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
 )
@@ -170,6 +171,9 @@ func (sr *sapmReceiver) Start(_ context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	if err = httpdecompressionextension.WrapServer(host, sr.config.Decompression, sr.server); err != nil {
+		return err
+	}
 
 	sr.shutdownWG.Add(1)
 	// run the server on a routine
@@ -26,6 +26,9 @@ type Protocols struct {
 // Config defines configuration for skywalking receiver.
 type Config struct {
 	Protocols `mapstructure:"protocols"`
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies
+	// of the `http` protocol.
+	Decompression *component.ID `mapstructure:"decompression"`
 }
 
 var _ component.Config = (*Config)(nil)
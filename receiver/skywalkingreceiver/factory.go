@@ -99,6 +99,7 @@ func createConfiguration(rCfg *Config) (*configuration, error) {
 
 	if rCfg.Protocols.HTTP != nil {
 		c.CollectorHTTPSettings = *rCfg.Protocols.HTTP
+		c.CollectorHTTPDecompression = rCfg.Decompression
 		if c.CollectorHTTPPort, err = extractPortFromEndpoint(rCfg.Protocols.HTTP.Endpoint); err != nil {
 			return nil, fmt.Errorf("unable to extract port for the HTTP endpoint: %w", err)
 		}
@@ -25,6 +25,7 @@ import (
 	profile "skywalking.apache.org/repo/goapi/collect/language/profile/v3"
 	management "skywalking.apache.org/repo/goapi/collect/management/v3"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver/internal/trace"
 )
 
@@ -33,6 +34,7 @@ import (
 type configuration struct {
 	CollectorHTTPPort           int
 	CollectorHTTPSettings       confighttp.HTTPServerSettings
+	CollectorHTTPDecompression  *component.ID
 	CollectorGRPCPort           int
 	CollectorGRPCServerSettings configgrpc.GRPCServerSettings
 }
@@ -132,6 +134,9 @@ func (sr *swReceiver) startCollector(host component.Host) error {
 		if cerr != nil {
 			return cerr
 		}
+		if cerr = httpdecompressionextension.WrapServer(host, sr.config.CollectorHTTPDecompression, sr.collectorServer); cerr != nil {
+			return cerr
+		}
 
 		sr.goroutines.Add(1)
 		go func() {
@@ -26,27 +26,29 @@ const (
 
 var (
 	// Config error messages
-	errMsgInvalidEndpointWError            = `invalid endpoint '%s': must be in '[scheme]://[host]:[port]' format: %w`
-	errMsgInvalidEndpoint                  = `invalid endpoint '%s': must be in '[scheme]://[host]:[port]' format`
-	errMsgAttributeConfigNoEnumOIDOrPrefix = `attribute '%s' must contain one of either an enum, oid, or indexed_value_prefix`
-	errMsgResourceAttributeNoOIDOrPrefix   = `resource_attribute '%s' must contain one of either an oid or indexed_value_prefix`
-	errMsgMetricNoUnit                     = `metric '%s' must have a unit`
-	errMsgMetricNoGaugeOrSum               = `metric '%s' must have one of either a gauge or sum`
-	errMsgMetricNoOIDs                     = `metric '%s' must have one of either scalar_oids or indexed_oids`
-	errMsgGaugeBadValueType                = `metric '%s' gauge value_type must be either int or double`
-	errMsgSumBadValueType                  = `metric '%s' sum value_type must be either int or double`
-	errMsgSumBadAggregation                = `metric '%s' sum aggregation value must be either cumulative or delta`
-	errMsgScalarOIDNoOID                   = `metric '%s' scalar_oid must contain an oid`
-	errMsgScalarAttributeNoName            = `metric '%s' scalar_oid attribute must contain a name`
-	errMsgScalarAttributeBadName           = `metric '%s' scalar_oid attribute name '%s' must match an attribute config`
-	errMsgScalarOIDBadAttribute            = `metric '%s' scalar_oid attribute name '%s' must match attribute config with enum values`
-	errMsgScalarAttributeBadValue          = `metric '%s' scalar_oid attribute '%s' value '%s' must match one of the possible enum values for the attribute config`
-	errMsgColumnOIDNoOID                   = `metric '%s' column_oid must contain an oid`
-	errMsgColumnAttributeNoName            = `metric '%s' column_oid attribute must contain a name`
-	errMsgColumnAttributeBadName           = `metric '%s' column_oid attribute name '%s' must match an attribute config`
-	errMsgColumnAttributeBadValue          = `metric '%s' column_oid attribute '%s' value '%s' must match one of the possible enum values for the attribute config`
-	errMsgColumnResourceAttributeBadName   = `metric '%s' column_oid resource_attribute '%s' must match a resource_attribute config`
-	errMsgColumnIndexedAttributeRequired   = `metric '%s' column_oid must either have a resource_attribute or an indexed_value_prefix/oid attribute`
+	errMsgInvalidEndpointWError              = `invalid endpoint '%s': must be in '[scheme]://[host]:[port]' format: %w`
+	errMsgInvalidEndpoint                    = `invalid endpoint '%s': must be in '[scheme]://[host]:[port]' format`
+	errMsgAttributeConfigNoEnumOIDOrPrefix   = `attribute '%s' must contain one of either an enum, oid, indexed_value_prefix, or indexed_value`
+	errMsgAttributeValueMappingNoOID         = `attribute '%s' value_mapping can only be used with an oid`
+	errMsgResourceAttributeNoOIDOrPrefix     = `resource_attribute '%s' must contain one of either an oid, indexed_value_prefix, or indexed_value`
+	errMsgResourceAttributeValueMappingNoOID = `resource_attribute '%s' value_mapping can only be used with an oid`
+	errMsgMetricNoUnit                       = `metric '%s' must have a unit`
+	errMsgMetricNoGaugeOrSum                 = `metric '%s' must have one of either a gauge or sum`
+	errMsgMetricNoOIDs                       = `metric '%s' must have one of either scalar_oids or indexed_oids`
+	errMsgGaugeBadValueType                  = `metric '%s' gauge value_type must be either int or double`
+	errMsgSumBadValueType                    = `metric '%s' sum value_type must be either int or double`
+	errMsgSumBadAggregation                  = `metric '%s' sum aggregation value must be either cumulative or delta`
+	errMsgScalarOIDNoOID                     = `metric '%s' scalar_oid must contain an oid`
+	errMsgScalarAttributeNoName              = `metric '%s' scalar_oid attribute must contain a name`
+	errMsgScalarAttributeBadName             = `metric '%s' scalar_oid attribute name '%s' must match an attribute config`
+	errMsgScalarOIDBadAttribute              = `metric '%s' scalar_oid attribute name '%s' must match attribute config with enum values`
+	errMsgScalarAttributeBadValue            = `metric '%s' scalar_oid attribute '%s' value '%s' must match one of the possible enum values for the attribute config`
+	errMsgColumnOIDNoOID                     = `metric '%s' column_oid must contain an oid`
+	errMsgColumnAttributeNoName              = `metric '%s' column_oid attribute must contain a name`
+	errMsgColumnAttributeBadName             = `metric '%s' column_oid attribute name '%s' must match an attribute config`
+	errMsgColumnAttributeBadValue            = `metric '%s' column_oid attribute '%s' value '%s' must match one of the possible enum values for the attribute config`
+	errMsgColumnResourceAttributeBadName     = `metric '%s' column_oid resource_attribute '%s' must match a resource_attribute config`
+	errMsgColumnIndexedAttributeRequired     = `metric '%s' column_oid must either have a resource_attribute or an indexed_value_prefix/indexed_value/oid attribute`
 
 	// Config errors
 	errEmptyEndpoint        = errors.New("endpoint must be specified")
@@ -132,17 +134,25 @@ type Config struct {
 type ResourceAttributeConfig struct {
 	// Description is optional and describes what the resource attribute represents
 	Description string `mapstructure:"description"`
-	// OID is required only if IndexedValuePrefix is not defined.
+	// OID is required only if IndexedValuePrefix and IndexedValue are not defined.
 	// This is the column OID which will provide indexed values to be used for this resource attribute. These indexed values
 	// will ultimately each be associated with a different "resource" as an attribute on that resource. Indexed metric values
 	// will then be used to associate metric datapoints to the matching "resource" (based on matching indexes).
 	OID string `mapstructure:"oid"`
-	// IndexedValuePrefix is required only if OID is not defined.
+	// IndexedValuePrefix is required only if OID and IndexedValue are not defined.
 	// This will be used alongside indexed metric values for this resource attribute. The prefix value concatenated with
 	// specific indexes of metric indexed values (Ex: prefix.1.2) will ultimately each be associated with a different "resource"
 	// as an attribute on that resource. The related indexed metric values will then be used to associate metric datapoints to
 	// those resources.
 	IndexedValuePrefix string `mapstructure:"indexed_value_prefix"` // required and valid if no oid field
+	// IndexedValue is required only if OID and IndexedValuePrefix are not defined.
+	// When true, the index of metric indexed values (Ex: 1.2) is used as the value of this resource attribute. This allows
+	// the index columns of a table, such as ifIndex, to be used as resource attributes without retrieving them.
+	IndexedValue bool `mapstructure:"indexed_value"`
+	// ValueMapping is optional and only valid alongside OID.
+	// This maps the values returned for the column OID, such as the integers of an enumeration, to the values used for
+	// this resource attribute. Values without a mapping are used as is.
+	ValueMapping map[string]string `mapstructure:"value_mapping"`
 }
 
 // AttributeConfig contains config info about all of the metric attributes that will be used by this receiver.
@@ -151,15 +161,22 @@ type AttributeConfig struct {
 	Value string `mapstructure:"value"`
 	// Description is optional and describes what the attribute represents
 	Description string `mapstructure:"description"`
-	// Enum is required only if OID and IndexedValuePrefix are not defined.
+	// Enum is required only if OID, IndexedValuePrefix, and IndexedValue are not defined.
 	// This contains a list of possible values that can be associated with this attribute
 	Enum []string `mapstructure:"enum"`
-	// OID is required only if Enum and IndexedValuePrefix are not defined.
+	// OID is required only if Enum, IndexedValuePrefix, and IndexedValue are not defined.
 	// This is the column OID which will provide indexed values to be uased for this attribute (alongside a metric with ColumnOIDs)
 	OID string `mapstructure:"oid"`
-	// IndexedValuePrefix is required only if Enum and OID are not defined.
+	// IndexedValuePrefix is required only if Enum, OID, and IndexedValue are not defined.
 	// This is used alongside metrics with ColumnOIDs to assign attribute values using this prefix + the OID index of the metric value
 	IndexedValuePrefix string `mapstructure:"indexed_value_prefix"`
+	// IndexedValue is required only if Enum, OID, and IndexedValuePrefix are not defined.
+	// When true, this is used alongside metrics with ColumnOIDs to assign attribute values using the OID index of the metric value
+	IndexedValue bool `mapstructure:"indexed_value"`
+	// ValueMapping is optional and only valid alongside OID.
+	// This maps the values returned for the column OID, such as the integers of an enumeration, to the values used for
+	// this attribute. Values without a mapping are used as is.
+	ValueMapping map[string]string `mapstructure:"value_mapping"`
 }
 
 // MetricConfig contains config info about a given metric
@@ -177,6 +194,9 @@ type MetricConfig struct {
 	// for this metric.
 	ScalarOIDs []ScalarOID `mapstructure:"scalar_oids"`
 	ColumnOIDs []ColumnOID `mapstructure:"column_oids"`
+	// Scale is optional and multiplies the returned SNMP values of this metric, for example to convert
+	// tenths of degrees to degrees with 0.1. Values are not scaled when it is 0.
+	Scale float64 `mapstructure:"scale"`
 }
 
 // GaugeMetric contains info about the value of the gauge metric
@@ -542,11 +562,14 @@ func validateAttributeConfigs(cfg *Config) error {
 		return nil
 	}
 
-	// Make sure each Attribute has either an OID, Enum, or IndexedValuePrefix
+	// Make sure each Attribute has either an OID, Enum, IndexedValuePrefix, or IndexedValue
 	for attrName, attrCfg := range attributes {
-		if len(attrCfg.Enum) == 0 && attrCfg.OID == "" && attrCfg.IndexedValuePrefix == "" {
+		if len(attrCfg.Enum) == 0 && attrCfg.OID == "" && attrCfg.IndexedValuePrefix == "" && !attrCfg.IndexedValue {
 			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgAttributeConfigNoEnumOIDOrPrefix, attrName))
 		}
+		if len(attrCfg.ValueMapping) > 0 && attrCfg.OID == "" {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgAttributeValueMappingNoOID, attrName))
+		}
 	}
 
 	return combinedErr
@@ -561,11 +584,14 @@ func validateResourceAttributeConfigs(cfg *Config) error {
 		return nil
 	}
 
-	// Make sure each Resource Attribute has either an OID or IndexedValuePrefix
+	// Make sure each Resource Attribute has either an OID, IndexedValuePrefix, or IndexedValue
 	for attrName, attrCfg := range resourceAttributes {
-		if attrCfg.OID == "" && attrCfg.IndexedValuePrefix == "" {
+		if attrCfg.OID == "" && attrCfg.IndexedValuePrefix == "" && !attrCfg.IndexedValue {
 			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgResourceAttributeNoOIDOrPrefix, attrName))
 		}
+		if len(attrCfg.ValueMapping) > 0 && attrCfg.OID == "" {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgResourceAttributeValueMappingNoOID, attrName))
+		}
 	}
 
 	return combinedErr
@@ -155,6 +155,29 @@ func (h configHelper) getAttributeConfigOID(name string) string {
 	return attrConfig.OID
 }
 
+// getAttributeConfigIndexedValue returns whether an attribute config uses the OID index as value
+func (h configHelper) getAttributeConfigIndexedValue(name string) bool {
+	attrConfig := h.cfg.Attributes[name]
+	if attrConfig == nil {
+		return false
+	}
+
+	return attrConfig.IndexedValue
+}
+
+// getAttributeConfigMappedValue returns the mapped value of an attribute config for a value of its column OID
+func (h configHelper) getAttributeConfigMappedValue(name string, value string) string {
+	attrConfig := h.cfg.Attributes[name]
+	if attrConfig == nil {
+		return value
+	}
+
+	if mappedValue, ok := attrConfig.ValueMapping[value]; ok {
+		return mappedValue
+	}
+	return value
+}
+
 // getResourceAttributeConfigIndexedValuePrefix returns the indexed value prefix of a resource attribute config
 func (h configHelper) getResourceAttributeConfigIndexedValuePrefix(name string) string {
 	attrConfig := h.cfg.ResourceAttributes[name]
@@ -175,6 +198,29 @@ func (h configHelper) getResourceAttributeConfigOID(name string) string {
 	return attrConfig.OID
 }
 
+// getResourceAttributeConfigIndexedValue returns whether a resource attribute config uses the OID index as value
+func (h configHelper) getResourceAttributeConfigIndexedValue(name string) bool {
+	attrConfig := h.cfg.ResourceAttributes[name]
+	if attrConfig == nil {
+		return false
+	}
+
+	return attrConfig.IndexedValue
+}
+
+// getResourceAttributeConfigMappedValue returns the mapped value of a resource attribute config for a value of its column OID
+func (h configHelper) getResourceAttributeConfigMappedValue(name string, value string) string {
+	attrConfig := h.cfg.ResourceAttributes[name]
+	if attrConfig == nil {
+		return value
+	}
+
+	if mappedValue, ok := attrConfig.ValueMapping[value]; ok {
+		return mappedValue
+	}
+	return value
+}
+
 // getMetricConfigAttributes returns the metric config attributes for a given OID
 func (h configHelper) getMetricConfigAttributes(oid string) []Attribute {
 	return h.metricAttributesByOID[oid]
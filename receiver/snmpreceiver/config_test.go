@@ -315,6 +315,12 @@ func getBaseAttrConfig(attrType string) map[string]*AttributeConfig {
 				IndexedValuePrefix: "p",
 			},
 		}
+	case "index":
+		return map[string]*AttributeConfig{
+			"a2": {
+				IndexedValue: true,
+			},
+		}
 	default:
 		return map[string]*AttributeConfig{
 			"a2": {
@@ -486,6 +492,64 @@ func TestLoadConfigMetricConfigs(t *testing.T) {
 	expectedConfigNoResourceAttributeOIDOrPrefix.ResourceAttributes["ra1"].OID = ""
 	expectedConfigNoResourceAttributeOIDOrPrefix.Metrics["m3"].ColumnOIDs[0].ResourceAttributes = []string{"ra1"}
 
+	expectedConfigAttributeValueMappingNoOID := factory.CreateDefaultConfig().(*Config)
+	expectedConfigAttributeValueMappingNoOID.Metrics = getBaseMetricConfig(true, false)
+	expectedConfigAttributeValueMappingNoOID.Attributes = getBaseAttrConfig("index")
+	expectedConfigAttributeValueMappingNoOID.Attributes["a2"].ValueMapping = map[string]string{"1": "up"}
+	expectedConfigAttributeValueMappingNoOID.Metrics["m3"].ColumnOIDs[0].Attributes = []Attribute{
+		{
+			Name: "a2",
+		},
+	}
+
+	expectedConfigResourceAttributeValueMappingNoOID := factory.CreateDefaultConfig().(*Config)
+	expectedConfigResourceAttributeValueMappingNoOID.Metrics = getBaseMetricConfig(true, false)
+	expectedConfigResourceAttributeValueMappingNoOID.ResourceAttributes = getBaseResourceAttrConfig("prefix")
+	expectedConfigResourceAttributeValueMappingNoOID.ResourceAttributes["ra1"].ValueMapping = map[string]string{"1": "up"}
+	expectedConfigResourceAttributeValueMappingNoOID.Metrics["m3"].ColumnOIDs[0].ResourceAttributes = []string{"ra1"}
+
+	expectedConfigTableGood := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTableGood.ResourceAttributes = map[string]*ResourceAttributeConfig{
+		"storage.index": {IndexedValue: true},
+		"storage.type": {
+			OID:          "1.3.6.1.2.1.25.2.3.1.2",
+			ValueMapping: map[string]string{".1.3.6.1.2.1.25.2.1.4": "fixed_disk"},
+		},
+	}
+	expectedConfigTableGood.Attributes = map[string]*AttributeConfig{
+		"interface.index": {IndexedValue: true},
+		"interface.status": {
+			OID:          "1.3.6.1.2.1.2.2.1.8",
+			ValueMapping: map[string]string{"1": "up", "2": "down"},
+		},
+	}
+	expectedConfigTableGood.Metrics = map[string]*MetricConfig{
+		"interface.status": {
+			Unit:  "1",
+			Gauge: &GaugeMetric{ValueType: "int"},
+			ColumnOIDs: []ColumnOID{
+				{
+					OID: "1.3.6.1.2.1.2.2.1.8",
+					Attributes: []Attribute{
+						{Name: "interface.index"},
+						{Name: "interface.status"},
+					},
+				},
+			},
+		},
+		"storage.size": {
+			Unit:  "By",
+			Scale: 4096,
+			Gauge: &GaugeMetric{ValueType: "int"},
+			ColumnOIDs: []ColumnOID{
+				{
+					OID:                "1.3.6.1.2.1.25.2.3.1.5",
+					ResourceAttributes: []string{"storage.index", "storage.type"},
+				},
+			},
+		},
+	}
+
 	expectedConfigComplexGood := factory.CreateDefaultConfig().(*Config)
 	expectedConfigComplexGood.ResourceAttributes = getBaseResourceAttrConfig("prefix")
 	expectedConfigComplexGood.ResourceAttributes["ra2"] = &ResourceAttributeConfig{OID: "1"}
@@ -823,6 +887,24 @@ func TestLoadConfigMetricConfigs(t *testing.T) {
 			expectedCfg: expectedConfigNoResourceAttributeOIDOrPrefix,
 			expectedErr: fmt.Sprintf(errMsgResourceAttributeNoOIDOrPrefix, "ra1"),
 		},
+		{
+			name:        "AttributeValueMappingWithoutOIDErrors",
+			nameVal:     "attribute_value_mapping_no_oid",
+			expectedCfg: expectedConfigAttributeValueMappingNoOID,
+			expectedErr: fmt.Sprintf(errMsgAttributeValueMappingNoOID, "a2"),
+		},
+		{
+			name:        "ResourceAttributeValueMappingWithoutOIDErrors",
+			nameVal:     "resource_attribute_value_mapping_no_oid",
+			expectedCfg: expectedConfigResourceAttributeValueMappingNoOID,
+			expectedErr: fmt.Sprintf(errMsgResourceAttributeValueMappingNoOID, "ra1"),
+		},
+		{
+			name:        "TableConfigGood",
+			nameVal:     "table_good",
+			expectedCfg: expectedConfigTableGood,
+			expectedErr: "",
+		},
 		{
 			name:        "ComplexConfigGood",
 			nameVal:     "complex_good",
@@ -180,6 +180,18 @@ func (h *otelMetricHelper) addMetricDataPoint(resourceKey string, metricName str
 	// Creates a data point based on the SNMP data
 	dp.SetTimestamp(h.dataPointTime)
 
+	// Scaled values are computed as floats before being converted to the metric's value type
+	if metricCfg.Scale != 0 {
+		switch data.valueType {
+		case floatVal:
+			data.value = data.value.(float64) * metricCfg.Scale
+		case integerVal:
+			data.value = float64(data.value.(int64)) * metricCfg.Scale
+			data.valueType = floatVal
+		case stringVal, notSupportedVal:
+		}
+	}
+
 	// Not explicitly checking these casts as this should be made safe in the client
 	switch data.valueType {
 	case floatVal:
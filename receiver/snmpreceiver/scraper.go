@@ -257,8 +257,10 @@ func getScalarDataPointAttributes(configHelper *configHelper, oid string) map[st
 // Enum attribute value - comes from the metric config's attribute data
 // Indexed prefix attribute value - comes from the current SNMP data's index and the attribute
 // config's prefix value
+// Indexed attribute value - comes from the current SNMP data's index
 // Indexed OID attribute value - comes from the previously collected indexed attribute data
-// using the current index and attribute config to access the correct value
+// using the current index and attribute config to access the correct value, optionally mapped
+// by the attribute config's value mapping
 func getIndexedDataPointAttributes(
 	configHelper *configHelper,
 	columnOID string,
@@ -282,8 +284,12 @@ func getIndexedDataPointAttributes(
 		switch {
 		case prefix != "":
 			attributeValue = prefix + indexString
+		case configHelper.getAttributeConfigIndexedValue(attributeName):
+			attributeValue = strings.TrimPrefix(indexString, ".")
 		case oid != "":
-			attributeValue = columnOIDIndexedAttributeValues[oid][indexString]
+			if value, ok := columnOIDIndexedAttributeValues[oid][indexString]; ok {
+				attributeValue = configHelper.getAttributeConfigMappedValue(attributeName, value)
+			}
 		default:
 			attributeValue = attribute.Value
 		}
@@ -300,8 +306,8 @@ func getIndexedDataPointAttributes(
 
 // getResourceAttributes creates a map of key/values for all related resource attributes. Keys
 // will come directly from the metric config's resource attribute values. Values will come
-// from the related attribute config's prefix value plus the index OR the previously collected
-// resource attribute indexed data.
+// from the related attribute config's prefix value plus the index, the index itself OR the
+// previously collected (and optionally mapped) resource attribute indexed data.
 func getResourceAttributes(
 	configHelper *configHelper,
 	columnOID string,
@@ -316,6 +322,8 @@ func getResourceAttributes(
 		switch {
 		case prefix != "":
 			resourceAttributes[attributeName] = prefix + indexString
+		case configHelper.getResourceAttributeConfigIndexedValue(attributeName):
+			resourceAttributes[attributeName] = strings.TrimPrefix(indexString, ".")
 		case oid != "":
 			attributeValue := columnOIDIndexedResourceAttributeValues[oid][indexString]
 
@@ -323,7 +331,7 @@ func getResourceAttributes(
 				return nil, errors.New(errMsgResourceAttributeEmptyValue)
 			}
 
-			resourceAttributes[attributeName] = attributeValue
+			resourceAttributes[attributeName] = configHelper.getResourceAttributeConfigMappedValue(attributeName, attributeValue)
 		default:
 			return nil, errors.New(errMsgResourceAttributeEmptyValue)
 		}
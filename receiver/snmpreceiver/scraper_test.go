@@ -2194,6 +2194,115 @@ func TestScrape(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			desc: "Indexed config with index, mapped and scaled values creates metric (19)",
+			testFunc: func(t *testing.T) {
+				mockClient := new(MockClient)
+				resourceAttrData := []SNMPData{
+					{
+						columnOID: ".0",
+						oid:       ".0.1",
+						value:     ".1.3.6.1.2.1.25.2.1.4",
+						valueType: stringVal,
+					},
+					{
+						columnOID: ".0",
+						oid:       ".0.2",
+						value:     ".1.3.6.1.2.1.25.2.1.2",
+						valueType: stringVal,
+					},
+				}
+				attrData := []SNMPData{
+					{
+						columnOID: ".2",
+						oid:       ".2.1",
+						value:     int64(1),
+						valueType: integerVal,
+					},
+					{
+						columnOID: ".2",
+						oid:       ".2.2",
+						value:     int64(2),
+						valueType: integerVal,
+					},
+				}
+				metricData := []SNMPData{
+					{
+						columnOID: ".1",
+						oid:       ".1.1",
+						value:     int64(10),
+						valueType: integerVal,
+					},
+					{
+						columnOID: ".1",
+						oid:       ".1.2",
+						value:     int64(20),
+						valueType: integerVal,
+					},
+				}
+				mockClient.On("Connect").Return(nil)
+				mockClient.On("Close").Return(nil)
+				mockClient.On("GetIndexedData", []string{".0"}, mock.Anything).Return(resourceAttrData).Once()
+				mockClient.On("GetIndexedData", []string{".2"}, mock.Anything).Return(attrData).Once()
+				mockClient.On("GetIndexedData", []string{".1"}, mock.Anything).Return(metricData).Once()
+				scraper := &snmpScraper{
+					cfg: &Config{
+						ResourceAttributes: map[string]*ResourceAttributeConfig{
+							"rattr1": {
+								IndexedValue: true,
+							},
+							"rattr2": {
+								OID:          ".0",
+								ValueMapping: map[string]string{".1.3.6.1.2.1.25.2.1.4": "fixed_disk"},
+							},
+						},
+						Attributes: map[string]*AttributeConfig{
+							"attr1": {
+								OID:          ".2",
+								ValueMapping: map[string]string{"1": "up"},
+							},
+						},
+						Metrics: map[string]*MetricConfig{
+							"metric1": {
+								Description: "test description",
+								Unit:        "By",
+								Scale:       1024,
+								Gauge: &GaugeMetric{
+									ValueType: "int",
+								},
+								ColumnOIDs: []ColumnOID{
+									{
+										OID:                ".1",
+										ResourceAttributes: []string{"rattr1", "rattr2"},
+										Attributes: []Attribute{
+											{
+												Name: "attr1",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					settings: receivertest.NewNopCreateSettings(),
+					client:   mockClient,
+					logger:   zap.NewNop(),
+				}
+
+				expectedMetricGen := func(t *testing.T) pmetric.Metrics {
+					goldenPath := filepath.Join("testdata", "expected_metrics",
+						"19_indexed_value_mapping_and_scale_golden.yaml")
+					expectedMetrics, err := golden.ReadMetrics(goldenPath)
+					require.NoError(t, err)
+					return expectedMetrics
+				}
+				expectedMetrics := expectedMetricGen(t)
+				metrics, err := scraper.scrape(context.Background())
+				require.NoError(t, err)
+				err = pmetrictest.CompareMetrics(expectedMetrics, metrics, pmetrictest.IgnoreTimestamp())
+				require.NoError(t, err)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
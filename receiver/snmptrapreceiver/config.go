@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/multierr"
+)
+
+const (
+	defaultEndpoint      = "0.0.0.0:162"
+	defaultVersion       = "v2c"
+	defaultSecurityLevel = "no_auth_no_priv"
+	defaultAuthType      = "MD5"
+	defaultPrivacyType   = "DES"
+)
+
+var (
+	errEmptyEndpoint        = errors.New("endpoint must be specified")
+	errBadVersion           = errors.New("version must be either v2c or v3")
+	errEmptyUser            = errors.New("user must be specified when version is v3")
+	errBadSecurityLevel     = errors.New("security_level must be either no_auth_no_priv, auth_no_priv, or auth_priv")
+	errBadAuthType          = errors.New("auth_type must be either MD5, SHA, SHA224, SHA256, SHA384, SHA512")
+	errEmptyAuthPassword    = errors.New("auth_password must be specified when security_level is auth_no_priv or auth_priv")
+	errBadPrivacyType       = errors.New("privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C")
+	errEmptyPrivacyPassword = errors.New("privacy_password must be specified when security_level is auth_priv")
+)
+
+// Config defines the configuration of the SNMP trap receiver.
+type Config struct {
+	// Endpoint is the UDP address the receiver listens on for traps and informs.
+	// Default: 0.0.0.0:162
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Version is the version of SNMP of the received traps.
+	// Valid options: v2c, which also accepts v1 traps, and v3.
+	// Default: v2c
+	Version string `mapstructure:"version"`
+
+	// Community is the community string v1 and v2c traps must have.
+	// Traps of any community are accepted if empty.
+	Community configopaque.String `mapstructure:"community"`
+
+	// User is the SNMP user traps must be sent by.
+	// Only valid for version "v3"
+	User string `mapstructure:"user"`
+
+	// SecurityLevel is the minimum security level of the received traps.
+	// Only valid for version "v3"
+	// Valid options: "no_auth_no_priv", "auth_no_priv", "auth_priv"
+	// Default: "no_auth_no_priv"
+	SecurityLevel string `mapstructure:"security_level"`
+
+	// AuthType is the authentication protocol of the user.
+	// Only valid for version "v3" and if "no_auth_no_priv" is not selected for SecurityLevel
+	// Valid options: "md5", "sha", "sha224", "sha256", "sha384", "sha512"
+	// Default: "md5"
+	AuthType string `mapstructure:"auth_type"`
+
+	// AuthPassword is the authentication password of the user.
+	// Only valid for version "v3" and if "no_auth_no_priv" is not selected for SecurityLevel
+	AuthPassword configopaque.String `mapstructure:"auth_password"`
+
+	// PrivacyType is the privacy protocol of the user.
+	// Only valid for version "v3" and if "auth_priv" is selected for SecurityLevel
+	// Valid options: "des", "aes", "aes192", "aes256", "aes192c", "aes256c"
+	// Default: "des"
+	PrivacyType string `mapstructure:"privacy_type"`
+
+	// PrivacyPassword is the privacy password of the user.
+	// Only valid for version "v3" and if "auth_priv" is selected for SecurityLevel
+	PrivacyPassword configopaque.String `mapstructure:"privacy_password"`
+
+	// EngineID is the hex encoded authoritative engine ID of the receiver, used by the senders of v3 informs.
+	// Only valid for version "v3"
+	EngineID string `mapstructure:"engine_id"`
+
+	// MIBPaths lists the MIB files, or directories of MIB files, the names of the OIDs are loaded from.
+	MIBPaths []string `mapstructure:"mib_paths"`
+
+	// OIDNames maps numeric OIDs to names, in addition to the names loaded from MIBs.
+	OIDNames map[string]string `mapstructure:"oid_names"`
+}
+
+// Validate validates the configuration.
+func (cfg *Config) Validate() error {
+	var combinedErr error
+
+	if cfg.Endpoint == "" {
+		combinedErr = multierr.Append(combinedErr, errEmptyEndpoint)
+	} else if _, _, err := net.SplitHostPort(cfg.Endpoint); err != nil {
+		combinedErr = multierr.Append(combinedErr, fmt.Errorf("invalid endpoint '%s': %w", cfg.Endpoint, err))
+	}
+
+	switch strings.ToUpper(cfg.Version) {
+	case "V2C":
+	case "V3":
+		combinedErr = multierr.Append(combinedErr, validateSecurity(cfg))
+	default:
+		combinedErr = multierr.Append(combinedErr, errBadVersion)
+	}
+
+	for oid := range cfg.OIDNames {
+		if !isNumericOID(oid) {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf("oid_names: invalid OID '%s'", oid))
+		}
+	}
+
+	return combinedErr
+}
+
+// validateSecurity validates all v3 related security configs
+func validateSecurity(cfg *Config) error {
+	var combinedErr error
+
+	if cfg.User == "" {
+		combinedErr = multierr.Append(combinedErr, errEmptyUser)
+	}
+
+	if cfg.EngineID != "" {
+		if _, err := hex.DecodeString(cfg.EngineID); err != nil {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf("engine_id must be hex encoded: %w", err))
+		}
+	}
+
+	switch strings.ToUpper(cfg.SecurityLevel) {
+	case "NO_AUTH_NO_PRIV":
+		return combinedErr
+	case "AUTH_NO_PRIV":
+		return multierr.Append(combinedErr, validateAuth(cfg))
+	case "AUTH_PRIV":
+		combinedErr = multierr.Append(combinedErr, validateAuth(cfg))
+		return multierr.Append(combinedErr, validatePrivacy(cfg))
+	default:
+		return multierr.Append(combinedErr, errBadSecurityLevel)
+	}
+}
+
+// validateAuth validates the AuthType and AuthPassword
+func validateAuth(cfg *Config) error {
+	var combinedErr error
+
+	if cfg.AuthPassword == "" {
+		combinedErr = multierr.Append(combinedErr, errEmptyAuthPassword)
+	}
+
+	switch strings.ToUpper(cfg.AuthType) {
+	case "MD5", "SHA", "SHA224", "SHA256", "SHA384", "SHA512": // ok
+	default:
+		combinedErr = multierr.Append(combinedErr, errBadAuthType)
+	}
+
+	return combinedErr
+}
+
+// validatePrivacy validates the PrivacyType and PrivacyPassword
+func validatePrivacy(cfg *Config) error {
+	var combinedErr error
+
+	if cfg.PrivacyPassword == "" {
+		combinedErr = multierr.Append(combinedErr, errEmptyPrivacyPassword)
+	}
+
+	switch strings.ToUpper(cfg.PrivacyType) {
+	case "DES", "AES", "AES192", "AES192C", "AES256", "AES256C": // ok
+	default:
+		combinedErr = multierr.Append(combinedErr, errBadPrivacyType)
+	}
+
+	return combinedErr
+}
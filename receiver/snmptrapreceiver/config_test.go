@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "v2c"),
+			expected: &Config{
+				Endpoint:      "localhost:1162",
+				Version:       defaultVersion,
+				Community:     "private",
+				SecurityLevel: defaultSecurityLevel,
+				AuthType:      defaultAuthType,
+				PrivacyType:   defaultPrivacyType,
+				MIBPaths:      []string{"testdata/mibs"},
+				OIDNames: map[string]string{
+					"1.3.6.1.4.1.8072.2.3.0.1": "netSnmpExampleHeartbeatNotification",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "v3"),
+			expected: &Config{
+				Endpoint:        "localhost:1162",
+				Version:         "v3",
+				User:            "otel",
+				SecurityLevel:   "auth_priv",
+				AuthType:        "SHA256",
+				AuthPassword:    "auth-secret",
+				PrivacyType:     "AES",
+				PrivacyPassword: "privacy-secret",
+				EngineID:        "8000000001020304",
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid"),
+			expectedErr: "invalid endpoint 'localhost': address localhost: missing port in address; " +
+				"version must be either v2c or v3; " +
+				"oid_names: invalid OID 'netSnmp'",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_v3"),
+			expectedErr: "user must be specified when version is v3; " +
+				"engine_id must be hex encoded: encoding/hex: invalid byte: U+006E 'n'; " +
+				"auth_password must be specified when security_level is auth_no_priv or auth_priv; " +
+				"auth_type must be either MD5, SHA, SHA224, SHA256, SHA384, SHA512; " +
+				"privacy_password must be specified when security_level is auth_priv; " +
+				"privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := createDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// builtinOIDs holds the OIDs of the nodes of SNMPv2-SMI, and of the objects of SNMPv2-MIB and IF-MIB
+// commonly found in traps, so that they are resolved without loading MIBs.
+var builtinOIDs = map[string]string{
+	"iso":                   "1",
+	"org":                   "1.3",
+	"dod":                   "1.3.6",
+	"internet":              "1.3.6.1",
+	"directory":             "1.3.6.1.1",
+	"mgmt":                  "1.3.6.1.2",
+	"mib-2":                 "1.3.6.1.2.1",
+	"system":                "1.3.6.1.2.1.1",
+	"sysDescr":              "1.3.6.1.2.1.1.1",
+	"sysObjectID":           "1.3.6.1.2.1.1.2",
+	"sysUpTime":             "1.3.6.1.2.1.1.3",
+	"sysContact":            "1.3.6.1.2.1.1.4",
+	"sysName":               "1.3.6.1.2.1.1.5",
+	"sysLocation":           "1.3.6.1.2.1.1.6",
+	"interfaces":            "1.3.6.1.2.1.2",
+	"ifIndex":               "1.3.6.1.2.1.2.2.1.1",
+	"ifDescr":               "1.3.6.1.2.1.2.2.1.2",
+	"ifType":                "1.3.6.1.2.1.2.2.1.3",
+	"ifAdminStatus":         "1.3.6.1.2.1.2.2.1.7",
+	"ifOperStatus":          "1.3.6.1.2.1.2.2.1.8",
+	"transmission":          "1.3.6.1.2.1.10",
+	"ifName":                "1.3.6.1.2.1.31.1.1.1.1",
+	"experimental":          "1.3.6.1.3",
+	"private":               "1.3.6.1.4",
+	"enterprises":           "1.3.6.1.4.1",
+	"security":              "1.3.6.1.5",
+	"snmpV2":                "1.3.6.1.6",
+	"snmpDomains":           "1.3.6.1.6.1",
+	"snmpProxys":            "1.3.6.1.6.2",
+	"snmpModules":           "1.3.6.1.6.3",
+	"snmpTrapOID":           "1.3.6.1.6.3.1.1.4.1",
+	"snmpTrapEnterprise":    "1.3.6.1.6.3.1.1.4.3",
+	"coldStart":             "1.3.6.1.6.3.1.1.5.1",
+	"warmStart":             "1.3.6.1.6.3.1.1.5.2",
+	"linkDown":              "1.3.6.1.6.3.1.1.5.3",
+	"linkUp":                "1.3.6.1.6.3.1.1.5.4",
+	"authenticationFailure": "1.3.6.1.6.3.1.1.5.5",
+	"snmpTrapAddress":       "1.3.6.1.6.3.18.1.3",
+	"snmpTrapCommunity":     "1.3.6.1.6.3.18.1.4",
+}
+
+// macroKeywords are the SMI macros whose values are OIDs.
+var macroKeywords = map[string]bool{
+	"OBJECT-TYPE":        true,
+	"OBJECT-IDENTITY":    true,
+	"MODULE-IDENTITY":    true,
+	"NOTIFICATION-TYPE":  true,
+	"OBJECT-GROUP":       true,
+	"NOTIFICATION-GROUP": true,
+	"MODULE-COMPLIANCE":  true,
+	"AGENT-CAPABILITIES": true,
+}
+
+// oidResolver resolves numeric OIDs to names.
+type oidResolver struct {
+	// names maps numeric OIDs to names.
+	names map[string]string
+}
+
+// newOIDResolver returns a resolver of the built-in OIDs, the OIDs defined by the MIBs found at mibPaths,
+// and the given OID names.
+func newOIDResolver(mibPaths []string, oidNames map[string]string) (*oidResolver, error) {
+	p := newMIBParser()
+	for _, path := range mibPaths {
+		files, err := mibFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			content, err := os.ReadFile(filepath.Clean(file))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read MIB: %w", err)
+			}
+			p.parse(string(content))
+		}
+	}
+
+	r := &oidResolver{names: make(map[string]string, len(builtinOIDs)+len(p.order)+len(oidNames))}
+	for name, oid := range builtinOIDs {
+		r.names[oid] = name
+	}
+	// Definitions are resolved in the order of the MIBs, the first name of an OID wins.
+	for _, name := range p.order {
+		if oid, ok := p.resolve(name, map[string]bool{}); ok {
+			if _, exists := r.names[oid]; !exists {
+				r.names[oid] = name
+			}
+		}
+	}
+	for oid, name := range oidNames {
+		r.names[strings.TrimPrefix(oid, ".")] = name
+	}
+	return r, nil
+}
+
+// resolve returns the name of the longest known prefix of oid, followed by the remaining arcs, e.g. ifIndex.3.
+// It returns false if no prefix of oid is known.
+func (r *oidResolver) resolve(oid string) (string, bool) {
+	oid = strings.TrimPrefix(oid, ".")
+	for prefix := oid; prefix != ""; {
+		if name, ok := r.names[prefix]; ok {
+			return name + oid[len(prefix):], true
+		}
+		i := strings.LastIndexByte(prefix, '.')
+		if i < 0 {
+			break
+		}
+		prefix = prefix[:i]
+	}
+	return "", false
+}
+
+// mibFiles returns path if it is a file, or the files of the directory.
+func mibFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load MIBs: %w", err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load MIBs: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+// oidDefinition defines an OID relatively to its parent.
+type oidDefinition struct {
+	// parent is the name of the parent node, or empty if arcs is absolute.
+	parent string
+	arcs   []string
+}
+
+// mibParser extracts the OID assignments of MIBs. It only understands the value assignments of
+// SMIv1 and SMIv2 and ignores everything else, which is enough to name the OIDs of traps.
+type mibParser struct {
+	definitions map[string]oidDefinition
+	// order holds the names of the definitions, in the order they were parsed.
+	order    []string
+	resolved map[string]string
+}
+
+func newMIBParser() *mibParser {
+	resolved := make(map[string]string, len(builtinOIDs))
+	for name, oid := range builtinOIDs {
+		resolved[name] = oid
+	}
+	return &mibParser{definitions: map[string]oidDefinition{}, resolved: resolved}
+}
+
+func (p *mibParser) define(name string, def oidDefinition) {
+	if _, exists := p.definitions[name]; exists {
+		return
+	}
+	p.definitions[name] = def
+	p.order = append(p.order, name)
+}
+
+// parse extracts the OID assignments of the MIB module(s) held by src.
+func (p *mibParser) parse(src string) {
+	tokens := tokenize(src)
+	// start is the first token of the current assignment.
+	start := 0
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i] != "::=" || i+1 >= len(tokens) {
+			continue
+		}
+
+		switch {
+		case tokens[i+1] == "{":
+			end := i + 2
+			for end < len(tokens) && tokens[end] != "}" {
+				end++
+			}
+			if name := assignedName(tokens, start, i); name != "" {
+				p.defineValue(name, tokens[i+2:end])
+			}
+		case isNumber(tokens[i+1]):
+			// SMIv1 traps are defined as the specific trap number of an enterprise:
+			// name TRAP-TYPE ENTERPRISE enterprise ... ::= number
+			for k := i - 1; k > start; k-- {
+				if tokens[k] == "TRAP-TYPE" && isValueName(tokens[k-1]) && k+2 < i && tokens[k+1] == "ENTERPRISE" {
+					p.define(tokens[k-1], oidDefinition{parent: tokens[k+2], arcs: []string{"0", tokens[i+1]}})
+					break
+				}
+			}
+		}
+		start = i + 1
+	}
+}
+
+// assignedName returns the name of the value assigned by the ::= token at index i.
+func assignedName(tokens []string, start, i int) string {
+	// name OBJECT IDENTIFIER ::= { ... }
+	if i-3 >= start && tokens[i-1] == "IDENTIFIER" && tokens[i-2] == "OBJECT" && isValueName(tokens[i-3]) {
+		return tokens[i-3]
+	}
+	// name MACRO ... ::= { ... }
+	for k := i - 1; k > start; k-- {
+		if macroKeywords[tokens[k]] && isValueName(tokens[k-1]) {
+			return tokens[k-1]
+		}
+	}
+	return ""
+}
+
+// defineValue defines name from an OID value, e.g. { parent 1 } or { iso org(3) dod(6) 1 },
+// which also defines the named intermediate nodes.
+func (p *mibParser) defineValue(name string, value []string) {
+	var def oidDefinition
+	for j := 0; j < len(value); j++ {
+		switch {
+		case isNumber(value[j]):
+			def.arcs = append(def.arcs, value[j])
+		case isValueName(value[j]) && j+3 < len(value) && value[j+1] == "(" && isNumber(value[j+2]) && value[j+3] == ")":
+			def.arcs = append(def.arcs, value[j+2])
+			p.define(value[j], def)
+			def = oidDefinition{parent: value[j]}
+			j += 3
+		case isValueName(value[j]) && j == 0:
+			def.parent = value[j]
+		default:
+			// Not an OID value.
+			return
+		}
+	}
+	if def.parent == "" && len(def.arcs) == 0 {
+		return
+	}
+	p.define(name, def)
+}
+
+// resolve returns the numeric OID of name.
+func (p *mibParser) resolve(name string, visiting map[string]bool) (string, bool) {
+	if oid, ok := p.resolved[name]; ok {
+		return oid, true
+	}
+	def, ok := p.definitions[name]
+	if !ok || visiting[name] {
+		return "", false
+	}
+	visiting[name] = true
+
+	arcs := def.arcs
+	if def.parent != "" {
+		parent, found := p.resolve(def.parent, visiting)
+		if !found {
+			return "", false
+		}
+		arcs = append([]string{parent}, arcs...)
+	}
+	oid := strings.Join(arcs, ".")
+	p.resolved[name] = oid
+	return oid, true
+}
+
+// tokenize splits an ASN.1 module into tokens, dropping comments. Quoted strings are replaced by a " token.
+func tokenize(src string) []string {
+	var tokens []string
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			// Comments end at the end of the line, or at the next --.
+			i += 2
+			for i < len(runes) && runes[i] != '\n' {
+				if runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '-' {
+					i++
+					break
+				}
+				i++
+			}
+			i++
+		case r == '"':
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				i++
+			}
+			i++
+			tokens = append(tokens, `"`)
+		case r == ':' && i+2 < len(runes) && runes[i+1] == ':' && runes[i+2] == '=':
+			tokens = append(tokens, "::=")
+			i += 3
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' ||
+				(runes[j] == '-' && !(j+1 < len(runes) && runes[j+1] == '-'))) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+	return tokens
+}
+
+// isValueName reports whether token is a value reference, which starts with a lowercase letter.
+func isValueName(token string) bool {
+	return token != "" && token[0] >= 'a' && token[0] <= 'z'
+}
+
+func isNumber(token string) bool {
+	if token == "" {
+		return false
+	}
+	for _, r := range token {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumericOID reports whether oid is made of numeric arcs, optionally starting with a dot.
+func isNumericOID(oid string) bool {
+	for _, arc := range strings.Split(strings.TrimPrefix(oid, "."), ".") {
+		if !isNumber(arc) {
+			return false
+		}
+	}
+	return true
+}
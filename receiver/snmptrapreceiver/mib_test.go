@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDResolver(t *testing.T) {
+	resolver, err := newOIDResolver([]string{filepath.Join("testdata", "mibs")}, map[string]string{
+		".1.3.6.1.4.1.99999.3": "acmeCustom",
+		"1.3.6.1.2.1.1.5":      "hostname",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		oid      string
+		expected string
+	}{
+		// Built-in
+		{oid: ".1.3.6.1.6.3.1.1.5.3", expected: "linkDown"},
+		{oid: "1.3.6.1.2.1.2.2.1.1.3", expected: "ifIndex.3"},
+		{oid: "1.3.6.1.4.1.12345.1", expected: "enterprises.12345.1"},
+		// Loaded from the MIB
+		{oid: "1.3.6.1.4.1.99999", expected: "acme"},
+		{oid: "1.3.6.1.4.1.99999.1.1.1.2.7", expected: "acmeSensorType.7"},
+		{oid: "1.3.6.1.4.1.99999.1.1.1.3.7", expected: "acmeSensorValue.7"},
+		{oid: "1.3.6.1.4.1.99999.2.1", expected: "acmeSensorThresholdExceeded"},
+		{oid: "1.3.6.1.4.1.99998", expected: "acmeV1"},
+		{oid: "1.3.6.1.4.1.99998.1.0.3", expected: "acmeFanFailure"},
+		// Configured
+		{oid: "1.3.6.1.4.1.99999.3.1", expected: "acmeCustom.1"},
+		{oid: "1.3.6.1.2.1.1.5.0", expected: "hostname.0"},
+	}
+	for _, tt := range tests {
+		name, ok := resolver.resolve(tt.oid)
+		assert.True(t, ok, tt.oid)
+		assert.Equal(t, tt.expected, name, tt.oid)
+	}
+
+	_, ok := resolver.resolve("2.5.4")
+	assert.False(t, ok)
+
+	// Definitions whose parent is unknown are ignored.
+	for _, name := range resolver.names {
+		assert.NotEqual(t, "acmeOrphan", name)
+	}
+}
+
+func TestOIDResolverMissingMIB(t *testing.T) {
+	_, err := newOIDResolver([]string{filepath.Join("testdata", "missing")}, nil)
+	assert.ErrorContains(t, err, "failed to load MIBs")
+}
+
+func TestTokenize(t *testing.T) {
+	src := `a OBJECT IDENTIFIER ::= { b 1 } -- comment ::= { c 2 }
+x-y DESCRIPTION "text -- not a comment" -- inline -- z`
+	assert.Equal(t, []string{"a", "OBJECT", "IDENTIFIER", "::=", "{", "b", "1", "}", "x-y", "DESCRIPTION", `"`, "z"}, tokenize(src))
+}
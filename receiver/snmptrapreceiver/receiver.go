@@ -0,0 +1,385 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver"
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gosnmp/gosnmp"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver/internal/metadata"
+)
+
+const (
+	scopeName = "otelcol/" + metadata.Type + "receiver"
+	transport = "udp"
+	format    = "snmp"
+
+	attributeVersion      = "snmp.version"
+	attributePDUType      = "snmp.pdu.type"
+	attributeTrapOID      = "snmp.trap.oid"
+	attributeTrapName     = "snmp.trap.name"
+	attributeUptime       = "snmp.uptime"
+	attributeAgentAddress = "snmp.agent.address"
+
+	oidSysUpTime   = "1.3.6.1.2.1.1.3.0"
+	oidSnmpTrapOID = "1.3.6.1.6.3.1.1.4.1.0"
+	// oidSnmpTraps is the prefix of the OIDs of the generic traps of SNMPv2-MIB.
+	oidSnmpTraps = "1.3.6.1.6.3.1.1.5"
+)
+
+type snmpTrapReceiver struct {
+	settings receiver.CreateSettings
+	cfg      *Config
+	next     consumer.Logs
+	obsrecv  *obsreport.Receiver
+	resolver *oidResolver
+	listener *gosnmp.TrapListener
+}
+
+func newSNMPTrapReceiver(set receiver.CreateSettings, cfg *Config, next consumer.Logs) (*snmpTrapReceiver, error) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &snmpTrapReceiver{
+		settings: set,
+		cfg:      cfg,
+		next:     next,
+		obsrecv:  obsrecv,
+	}, nil
+}
+
+func (r *snmpTrapReceiver) Start(_ context.Context, _ component.Host) error {
+	var err error
+	if r.resolver, err = newOIDResolver(r.cfg.MIBPaths, r.cfg.OIDNames); err != nil {
+		return err
+	}
+
+	params, err := r.params()
+	if err != nil {
+		return err
+	}
+	listener := gosnmp.NewTrapListener()
+	listener.Params = params
+	listener.OnNewTrap = r.handleTrap
+
+	errs := make(chan error, 1)
+	go func() {
+		if listenErr := listener.Listen(r.cfg.Endpoint); listenErr != nil {
+			errs <- listenErr
+		}
+	}()
+	select {
+	case <-listener.Listening():
+		r.listener = listener
+		return nil
+	case err = <-errs:
+		return fmt.Errorf("failed to listen on %s: %w", r.cfg.Endpoint, err)
+	}
+}
+
+func (r *snmpTrapReceiver) Shutdown(context.Context) error {
+	if r.listener != nil {
+		r.listener.Close()
+	}
+	return nil
+}
+
+// params returns the gosnmp parameters used to decode the received packets.
+func (r *snmpTrapReceiver) params() (*gosnmp.GoSNMP, error) {
+	params := &gosnmp.GoSNMP{
+		Version:   gosnmp.Version2c,
+		Community: string(r.cfg.Community),
+		Logger:    gosnmp.NewLogger(gosnmpLogger{r.settings.Logger}),
+	}
+	if strings.ToUpper(r.cfg.Version) != "V3" {
+		return params, nil
+	}
+
+	engineID, err := hex.DecodeString(r.cfg.EngineID)
+	if err != nil {
+		return nil, err
+	}
+	securityParams := &gosnmp.UsmSecurityParameters{
+		UserName:              r.cfg.User,
+		AuthoritativeEngineID: string(engineID),
+		Logger:                params.Logger,
+	}
+	params.Version = gosnmp.Version3
+	params.SecurityModel = gosnmp.UserSecurityModel
+	params.MsgFlags = msgFlags(r.cfg.SecurityLevel)
+	if params.MsgFlags&gosnmp.AuthNoPriv != 0 {
+		securityParams.AuthenticationProtocol = getAuthProtocol(r.cfg.AuthType)
+		securityParams.AuthenticationPassphrase = string(r.cfg.AuthPassword)
+	}
+	if params.MsgFlags&gosnmp.AuthPriv == gosnmp.AuthPriv {
+		securityParams.PrivacyProtocol = getPrivacyProtocol(r.cfg.PrivacyType)
+		securityParams.PrivacyPassphrase = string(r.cfg.PrivacyPassword)
+	}
+	params.SecurityParameters = securityParams
+	return params, nil
+}
+
+func (r *snmpTrapReceiver) handleTrap(packet *gosnmp.SnmpPacket, remote *net.UDPAddr) {
+	if reason := r.reject(packet); reason != "" {
+		r.settings.Logger.Debug("Dropping trap", zap.String("reason", reason), zap.Stringer("remote", remote))
+		return
+	}
+
+	ctx := r.obsrecv.StartLogsOp(context.Background())
+	ld := r.toLogs(packet, remote, time.Now())
+	err := r.next.ConsumeLogs(ctx, ld)
+	r.obsrecv.EndLogsOp(ctx, format, ld.LogRecordCount(), err)
+}
+
+// reject returns why the packet must be dropped, or an empty string if it is accepted.
+func (r *snmpTrapReceiver) reject(packet *gosnmp.SnmpPacket) string {
+	if strings.ToUpper(r.cfg.Version) != "V3" {
+		if packet.Version == gosnmp.Version3 {
+			return "unexpected SNMP version v3"
+		}
+		if r.cfg.Community != "" && packet.Community != string(r.cfg.Community) {
+			return "unexpected community"
+		}
+		return ""
+	}
+
+	if packet.Version != gosnmp.Version3 {
+		return "unexpected SNMP version v" + packet.Version.String()
+	}
+	if usm, ok := packet.SecurityParameters.(*gosnmp.UsmSecurityParameters); !ok || usm.UserName != r.cfg.User {
+		return "unexpected user"
+	}
+	required := msgFlags(r.cfg.SecurityLevel)
+	if packet.MsgFlags&required != required {
+		return "insufficient security level"
+	}
+	return ""
+}
+
+// toLogs converts a trap or inform to a log record, whose body holds its variable bindings.
+func (r *snmpTrapReceiver) toLogs(packet *gosnmp.SnmpPacket, remote *net.UDPAddr, received time.Time) plog.Logs {
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(received))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(received))
+
+	attrs := lr.Attributes()
+	attrs.PutStr(attributeVersion, "v"+packet.Version.String())
+	if remote != nil {
+		attrs.PutStr(conventions.AttributeNetSockPeerAddr, remote.IP.String())
+		attrs.PutInt(conventions.AttributeNetSockPeerPort, int64(remote.Port))
+	}
+
+	var trapOID string
+	switch packet.PDUType { // nolint:exhaustive
+	case gosnmp.Trap:
+		attrs.PutStr(attributePDUType, "trap")
+		attrs.PutStr(attributeAgentAddress, packet.AgentAddress)
+		attrs.PutInt(attributeUptime, int64(packet.Timestamp))
+		trapOID = v1TrapOID(packet.SnmpTrap)
+	case gosnmp.InformRequest:
+		attrs.PutStr(attributePDUType, "inform")
+	default:
+		attrs.PutStr(attributePDUType, "trap")
+	}
+
+	varbinds := lr.Body().SetEmptyMap()
+	for _, variable := range packet.Variables {
+		oid := strings.TrimPrefix(variable.Name, ".")
+		switch oid {
+		case oidSysUpTime:
+			if uptime, ok := toInt64(variable.Value); ok {
+				attrs.PutInt(attributeUptime, uptime)
+			}
+			continue
+		case oidSnmpTrapOID:
+			if value, ok := variable.Value.(string); ok {
+				trapOID = strings.TrimPrefix(value, ".")
+			}
+			continue
+		}
+
+		name, ok := r.resolver.resolve(oid)
+		if !ok {
+			name = oid
+		}
+		r.putValue(varbinds.PutEmpty(name), variable)
+	}
+
+	if trapOID != "" {
+		attrs.PutStr(attributeTrapOID, trapOID)
+		if name, ok := r.resolver.resolve(trapOID); ok {
+			attrs.PutStr(attributeTrapName, name)
+		}
+	}
+	return ld
+}
+
+// putValue sets dest to the value of the variable binding.
+func (r *snmpTrapReceiver) putValue(dest pcommon.Value, variable gosnmp.SnmpPDU) {
+	switch variable.Type { // nolint:exhaustive
+	case gosnmp.ObjectIdentifier:
+		oid, _ := variable.Value.(string)
+		if name, ok := r.resolver.resolve(oid); ok {
+			dest.SetStr(name)
+		} else {
+			dest.SetStr(strings.TrimPrefix(oid, "."))
+		}
+		return
+	case gosnmp.OctetString, gosnmp.Opaque, gosnmp.BitString:
+		b, _ := variable.Value.([]byte)
+		if isPrintable(b) {
+			dest.SetStr(string(b))
+		} else {
+			dest.SetEmptyBytes().FromRaw(b)
+		}
+		return
+	}
+
+	switch value := variable.Value.(type) {
+	case string:
+		dest.SetStr(value)
+	case bool:
+		dest.SetBool(value)
+	case float32:
+		dest.SetDouble(float64(value))
+	case float64:
+		dest.SetDouble(value)
+	case uint64:
+		if value > math.MaxInt64 {
+			dest.SetDouble(float64(value))
+		} else {
+			dest.SetInt(int64(value))
+		}
+	default:
+		if i, ok := toInt64(value); ok {
+			dest.SetInt(i)
+		}
+	}
+}
+
+// v1TrapOID returns the OID of a v1 trap, as translated to v2 by RFC 3584.
+func v1TrapOID(trap gosnmp.SnmpTrap) string {
+	if trap.GenericTrap != 6 {
+		return fmt.Sprintf("%s.%d", oidSnmpTraps, trap.GenericTrap+1)
+	}
+	return fmt.Sprintf("%s.0.%d", strings.TrimPrefix(trap.Enterprise, "."), trap.SpecificTrap)
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch i := v.(type) {
+	case int:
+		return int64(i), true
+	case int32:
+		return int64(i), true
+	case int64:
+		return i, true
+	case uint:
+		return int64(i), true
+	case uint32:
+		return int64(i), true
+	case uint64:
+		if i <= math.MaxInt64 {
+			return int64(i), true
+		}
+	}
+	return 0, false
+}
+
+// isPrintable reports whether b is valid UTF-8 text, as opposed to binary data such as a MAC address.
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func msgFlags(securityLevel string) gosnmp.SnmpV3MsgFlags {
+	switch strings.ToUpper(securityLevel) {
+	case "AUTH_NO_PRIV":
+		return gosnmp.AuthNoPriv
+	case "AUTH_PRIV":
+		return gosnmp.AuthPriv
+	default:
+		return gosnmp.NoAuthNoPriv
+	}
+}
+
+// getAuthProtocol gets gosnmp auth protocol based on config auth type
+func getAuthProtocol(authType string) gosnmp.SnmpV3AuthProtocol {
+	switch strings.ToUpper(authType) {
+	case "SHA":
+		return gosnmp.SHA
+	case "SHA224":
+		return gosnmp.SHA224
+	case "SHA256":
+		return gosnmp.SHA256
+	case "SHA384":
+		return gosnmp.SHA384
+	case "SHA512":
+		return gosnmp.SHA512
+	default:
+		return gosnmp.MD5
+	}
+}
+
+// getPrivacyProtocol gets gosnmp privacy protocol based on config privacy type
+func getPrivacyProtocol(privacyType string) gosnmp.SnmpV3PrivProtocol {
+	switch strings.ToUpper(privacyType) {
+	case "AES":
+		return gosnmp.AES
+	case "AES192":
+		return gosnmp.AES192
+	case "AES192C":
+		return gosnmp.AES192C
+	case "AES256":
+		return gosnmp.AES256
+	case "AES256C":
+		return gosnmp.AES256C
+	default:
+		return gosnmp.DES
+	}
+}
+
+// gosnmpLogger logs the messages of gosnmp, mostly about undecodable packets, at the debug level.
+type gosnmpLogger struct {
+	logger *zap.Logger
+}
+
+func (l gosnmpLogger) Print(v ...interface{}) {
+	l.logger.Debug(strings.TrimSpace(fmt.Sprint(v...)))
+}
+
+func (l gosnmpLogger) Printf(format string, v ...interface{}) {
+	l.logger.Debug(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
@@ -0,0 +1,357 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func newTestReceiver(t *testing.T, cfg *Config) *snmpTrapReceiver {
+	r, err := newSNMPTrapReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	r.resolver, err = newOIDResolver(cfg.MIBPaths, cfg.OIDNames)
+	require.NoError(t, err)
+	return r
+}
+
+func TestToLogs(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MIBPaths = []string{"testdata/mibs"}
+	r := newTestReceiver(t, cfg)
+	remote := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 49152}
+	received := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name       string
+		packet     *gosnmp.SnmpPacket
+		attributes map[string]interface{}
+		body       map[string]interface{}
+	}{
+		{
+			name: "v2c trap",
+			packet: &gosnmp.SnmpPacket{
+				Version:   gosnmp.Version2c,
+				Community: "public",
+				PDUType:   gosnmp.SNMPv2Trap,
+				Variables: []gosnmp.SnmpPDU{
+					{Name: ".1.3.6.1.2.1.1.3.0", Type: gosnmp.TimeTicks, Value: uint32(1234)},
+					{Name: ".1.3.6.1.6.3.1.1.4.1.0", Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.4.1.99999.2.1"},
+					{Name: ".1.3.6.1.4.1.99999.1.1.1.2.7", Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.4.1.99999.3"},
+					{Name: ".1.3.6.1.4.1.99999.1.1.1.3.7", Type: gosnmp.Integer, Value: 42},
+					{Name: ".1.3.6.1.4.1.99999.1.1.1.4.7", Type: gosnmp.OctetString, Value: []byte("boiler")},
+					{Name: ".1.3.6.1.4.1.12345.1", Type: gosnmp.OctetString, Value: []byte{0x00, 0x1b, 0x21}},
+					{Name: ".1.3.6.1.4.1.12345.2", Type: gosnmp.Counter64, Value: uint64(1 << 63)},
+				},
+			},
+			attributes: map[string]interface{}{
+				"snmp.version":       "v2c",
+				"snmp.pdu.type":      "trap",
+				"snmp.uptime":        int64(1234),
+				"snmp.trap.oid":      "1.3.6.1.4.1.99999.2.1",
+				"snmp.trap.name":     "acmeSensorThresholdExceeded",
+				"net.sock.peer.addr": "10.0.0.1",
+				"net.sock.peer.port": int64(49152),
+			},
+			body: map[string]interface{}{
+				"acmeSensorType.7":    "acme.3",
+				"acmeSensorValue.7":   int64(42),
+				"acmeSensorName.7":    "boiler",
+				"enterprises.12345.1": []byte{0x00, 0x1b, 0x21},
+				"enterprises.12345.2": float64(1 << 63),
+			},
+		},
+		{
+			name: "v1 specific trap",
+			packet: &gosnmp.SnmpPacket{
+				Version: gosnmp.Version1,
+				PDUType: gosnmp.Trap,
+				SnmpTrap: gosnmp.SnmpTrap{
+					Enterprise:   ".1.3.6.1.4.1.99998.1",
+					AgentAddress: "192.168.1.10",
+					GenericTrap:  6,
+					SpecificTrap: 3,
+					Timestamp:    500,
+					Variables: []gosnmp.SnmpPDU{
+						{Name: ".1.3.6.1.4.1.99999.1.1.1.1.2", Type: gosnmp.Integer, Value: 2},
+					},
+				},
+				Variables: []gosnmp.SnmpPDU{
+					{Name: ".1.3.6.1.4.1.99999.1.1.1.1.2", Type: gosnmp.Integer, Value: 2},
+				},
+			},
+			attributes: map[string]interface{}{
+				"snmp.version":       "v1",
+				"snmp.pdu.type":      "trap",
+				"snmp.agent.address": "192.168.1.10",
+				"snmp.uptime":        int64(500),
+				"snmp.trap.oid":      "1.3.6.1.4.1.99998.1.0.3",
+				"snmp.trap.name":     "acmeFanFailure",
+				"net.sock.peer.addr": "10.0.0.1",
+				"net.sock.peer.port": int64(49152),
+			},
+			body: map[string]interface{}{
+				"acmeSensorIndex.2": int64(2),
+			},
+		},
+		{
+			name: "v1 generic trap",
+			packet: &gosnmp.SnmpPacket{
+				Version: gosnmp.Version1,
+				PDUType: gosnmp.Trap,
+				SnmpTrap: gosnmp.SnmpTrap{
+					Enterprise:   ".1.3.6.1.4.1.99998.1",
+					AgentAddress: "192.168.1.10",
+					GenericTrap:  2,
+				},
+			},
+			attributes: map[string]interface{}{
+				"snmp.version":       "v1",
+				"snmp.pdu.type":      "trap",
+				"snmp.agent.address": "192.168.1.10",
+				"snmp.uptime":        int64(0),
+				"snmp.trap.oid":      "1.3.6.1.6.3.1.1.5.3",
+				"snmp.trap.name":     "linkDown",
+				"net.sock.peer.addr": "10.0.0.1",
+				"net.sock.peer.port": int64(49152),
+			},
+			body: map[string]interface{}{},
+		},
+		{
+			name: "inform with unknown trap",
+			packet: &gosnmp.SnmpPacket{
+				Version: gosnmp.Version3,
+				PDUType: gosnmp.InformRequest,
+				Variables: []gosnmp.SnmpPDU{
+					{Name: ".1.3.6.1.6.3.1.1.4.1.0", Type: gosnmp.ObjectIdentifier, Value: ".2.5.4.3"},
+					{Name: ".2.5.4.3.1", Type: gosnmp.Gauge32, Value: uint(7)},
+				},
+			},
+			attributes: map[string]interface{}{
+				"snmp.version":       "v3",
+				"snmp.pdu.type":      "inform",
+				"snmp.trap.oid":      "2.5.4.3",
+				"net.sock.peer.addr": "10.0.0.1",
+				"net.sock.peer.port": int64(49152),
+			},
+			body: map[string]interface{}{
+				"2.5.4.3.1": int64(7),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld := r.toLogs(tt.packet, remote, received)
+			require.Equal(t, 1, ld.LogRecordCount())
+			sl := ld.ResourceLogs().At(0).ScopeLogs().At(0)
+			assert.Equal(t, scopeName, sl.Scope().Name())
+			lr := sl.LogRecords().At(0)
+			assert.Equal(t, pcommon.NewTimestampFromTime(received), lr.Timestamp())
+			assert.Equal(t, tt.attributes, lr.Attributes().AsRaw())
+			assert.Equal(t, tt.body, lr.Body().Map().AsRaw())
+		})
+	}
+}
+
+func TestReject(t *testing.T) {
+	v2c := createDefaultConfig().(*Config)
+	v2c.Community = "private"
+
+	v3 := createDefaultConfig().(*Config)
+	v3.Version = "v3"
+	v3.User = "otel"
+	v3.SecurityLevel = "auth_no_priv"
+
+	tests := []struct {
+		name     string
+		cfg      *Config
+		packet   *gosnmp.SnmpPacket
+		expected string
+	}{
+		{
+			name:   "v2c accepted",
+			cfg:    v2c,
+			packet: &gosnmp.SnmpPacket{Version: gosnmp.Version2c, Community: "private"},
+		},
+		{
+			name:   "v1 accepted",
+			cfg:    v2c,
+			packet: &gosnmp.SnmpPacket{Version: gosnmp.Version1, Community: "private"},
+		},
+		{
+			name:     "v2c wrong community",
+			cfg:      v2c,
+			packet:   &gosnmp.SnmpPacket{Version: gosnmp.Version2c, Community: "public"},
+			expected: "unexpected community",
+		},
+		{
+			name:     "v2c receives v3",
+			cfg:      v2c,
+			packet:   &gosnmp.SnmpPacket{Version: gosnmp.Version3},
+			expected: "unexpected SNMP version v3",
+		},
+		{
+			name: "v3 accepted",
+			cfg:  v3,
+			packet: &gosnmp.SnmpPacket{
+				Version:            gosnmp.Version3,
+				MsgFlags:           gosnmp.AuthPriv,
+				SecurityParameters: &gosnmp.UsmSecurityParameters{UserName: "otel"},
+			},
+		},
+		{
+			name:     "v3 receives v2c",
+			cfg:      v3,
+			packet:   &gosnmp.SnmpPacket{Version: gosnmp.Version2c},
+			expected: "unexpected SNMP version v2c",
+		},
+		{
+			name: "v3 wrong user",
+			cfg:  v3,
+			packet: &gosnmp.SnmpPacket{
+				Version:            gosnmp.Version3,
+				MsgFlags:           gosnmp.AuthNoPriv,
+				SecurityParameters: &gosnmp.UsmSecurityParameters{UserName: "other"},
+			},
+			expected: "unexpected user",
+		},
+		{
+			name: "v3 insufficient security level",
+			cfg:  v3,
+			packet: &gosnmp.SnmpPacket{
+				Version:            gosnmp.Version3,
+				MsgFlags:           gosnmp.NoAuthNoPriv,
+				SecurityParameters: &gosnmp.UsmSecurityParameters{UserName: "otel"},
+			},
+			expected: "insufficient security level",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReceiver(t, tt.cfg)
+			assert.Equal(t, tt.expected, r.reject(tt.packet))
+		})
+	}
+}
+
+func TestReceiveTraps(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    func(cfg *Config)
+		client func(client *gosnmp.GoSNMP)
+	}{
+		{
+			name: "v2c",
+			cfg: func(cfg *Config) {
+				cfg.Community = "private"
+			},
+			client: func(client *gosnmp.GoSNMP) {
+				client.Version = gosnmp.Version2c
+				client.Community = "private"
+			},
+		},
+		{
+			name: "v3",
+			cfg: func(cfg *Config) {
+				cfg.Version = "v3"
+				cfg.User = "otel"
+				cfg.SecurityLevel = "auth_priv"
+				cfg.AuthType = "SHA256"
+				cfg.AuthPassword = "auth-secret"
+				cfg.PrivacyType = "AES"
+				cfg.PrivacyPassword = "privacy-secret"
+				cfg.EngineID = "8000000001020304"
+			},
+			client: func(client *gosnmp.GoSNMP) {
+				client.Version = gosnmp.Version3
+				client.SecurityModel = gosnmp.UserSecurityModel
+				client.MsgFlags = gosnmp.AuthPriv
+				client.SecurityParameters = &gosnmp.UsmSecurityParameters{
+					UserName:                 "otel",
+					AuthoritativeEngineID:    "\x80\x00\x00\x00\x01\x02\x03\x04",
+					AuthenticationProtocol:   gosnmp.SHA256,
+					AuthenticationPassphrase: "auth-secret",
+					PrivacyProtocol:          gosnmp.AES,
+					PrivacyPassphrase:        "privacy-secret",
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port := freeUDPPort(t)
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+			tt.cfg(cfg)
+			require.NoError(t, cfg.Validate())
+
+			sink := new(consumertest.LogsSink)
+			r, err := newSNMPTrapReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+			require.NoError(t, err)
+			require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() { assert.NoError(t, r.Shutdown(context.Background())) }()
+
+			client := &gosnmp.GoSNMP{
+				Target:  "127.0.0.1",
+				Port:    uint16(port),
+				Timeout: time.Second,
+			}
+			tt.client(client)
+			require.NoError(t, client.Connect())
+			defer client.Conn.Close()
+
+			_, err = client.SendTrap(gosnmp.SnmpTrap{
+				Variables: []gosnmp.SnmpPDU{
+					{Name: ".1.3.6.1.2.1.1.3.0", Type: gosnmp.TimeTicks, Value: uint32(100)},
+					{Name: ".1.3.6.1.6.3.1.1.4.1.0", Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.6.3.1.1.5.1"},
+					{Name: ".1.3.6.1.2.1.1.5.0", Type: gosnmp.OctetString, Value: "router-1"},
+				},
+			})
+			require.NoError(t, err)
+
+			require.Eventually(t, func() bool {
+				return sink.LogRecordCount() == 1
+			}, 5*time.Second, 10*time.Millisecond)
+			lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			name, ok := lr.Attributes().Get(attributeTrapName)
+			require.True(t, ok)
+			assert.Equal(t, "coldStart", name.Str())
+			assert.Equal(t, map[string]interface{}{"sysName.0": "router-1"}, lr.Body().Map().AsRaw())
+		})
+	}
+}
+
+func TestStartPortInUse(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = conn.LocalAddr().String()
+	r, err := newSNMPTrapReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, r.Start(context.Background(), componenttest.NewNopHost()), "failed to listen on")
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func freeUDPPort(t *testing.T) int {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).Port
+}
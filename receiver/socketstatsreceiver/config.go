@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+const (
+	protocolTCP = "tcp"
+	protocolUDP = "udp"
+)
+
+var (
+	errInvalidMaxFlows = errors.New("max_flows must be positive")
+	errNoProtocols     = errors.New("at least one protocol must be specified")
+)
+
+// Config defines the configuration for the socket statistics receiver.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+
+	// Protocols are the transport protocols of the flows to report, tcp and/or udp.
+	Protocols []string `mapstructure:"protocols"`
+
+	// MaxFlows is the maximum number of flows reported individually. The metrics of
+	// the flows opened once the limit is reached are aggregated in overflow data points.
+	MaxFlows int `mapstructure:"max_flows"`
+
+	// IncludeLoopback reports the flows between loopback addresses, which are ignored by default.
+	IncludeLoopback bool `mapstructure:"include_loopback"`
+}
+
+// Validate checks if the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	if len(cfg.Protocols) == 0 {
+		return errNoProtocols
+	}
+	for _, p := range cfg.Protocols {
+		if p != protocolTCP && p != protocolUDP {
+			return fmt.Errorf("invalid protocol '%s', must be tcp or udp", p)
+		}
+	}
+	if cfg.MaxFlows <= 0 {
+		return errInvalidMaxFlows
+	}
+	return nil
+}
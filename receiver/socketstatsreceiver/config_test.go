@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	require.Equal(t, 30*time.Second, cfg.CollectionInterval)
+	require.Equal(t, []string{protocolTCP}, cfg.Protocols)
+	require.Equal(t, 200, cfg.MaxFlows)
+	require.True(t, cfg.IncludeLoopback)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		mutate      func(*Config)
+		expectedErr string
+	}{
+		{
+			desc:   "valid",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			desc:        "no protocols",
+			mutate:      func(cfg *Config) { cfg.Protocols = nil },
+			expectedErr: errNoProtocols.Error(),
+		},
+		{
+			desc:        "invalid protocol",
+			mutate:      func(cfg *Config) { cfg.Protocols = []string{"sctp"} },
+			expectedErr: "invalid protocol 'sctp', must be tcp or udp",
+		},
+		{
+			desc:        "invalid max flows",
+			mutate:      func(cfg *Config) { cfg.MaxFlows = 0 },
+			expectedErr: errInvalidMaxFlows.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tc.mutate(cfg)
+			err := component.ValidateConfig(cfg)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package socketstatsreceiver reports the traffic, retransmissions and round-trip
+// times of the TCP and UDP sockets of a Linux host, by process, from snapshots of
+// the sockets taken with sock_diag at every scrape.
+package socketstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver"
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver"
+
+import (
+	"context"
+	"errors"
+	"runtime"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver/internal/metadata"
+)
+
+const defaultMaxFlows = 1000
+
+var errUnsupportedOS = errors.New("the socket statistics receiver is only supported on Linux")
+
+// NewFactory creates a factory for the socket statistics receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ScraperControllerSettings: scraperhelper.NewDefaultScraperControllerSettings(metadata.Type),
+		Protocols:                 []string{protocolTCP, protocolUDP},
+		MaxFlows:                  defaultMaxFlows,
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	if runtime.GOOS != "linux" {
+		return nil, errUnsupportedOS
+	}
+	cfg := rConf.(*Config)
+
+	fs := newFlowScraper(params, cfg)
+	scraper, err := scraperhelper.NewScraper(metadata.Type, fs.scrape)
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(
+		&cfg.ScraperControllerSettings, params, consumer,
+		scraperhelper.AddScraper(scraper),
+	)
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestType(t *testing.T) {
+	factory := NewFactory()
+	require.EqualValues(t, "socketstats", factory.Type())
+}
+
+func TestCreateDefaultConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.Equal(t, time.Minute, cfg.CollectionInterval)
+	require.Equal(t, []string{protocolTCP, protocolUDP}, cfg.Protocols)
+	require.Equal(t, defaultMaxFlows, cfg.MaxFlows)
+	require.False(t, cfg.IncludeLoopback)
+	require.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestCreateMetricsReceiver(t *testing.T) {
+	factory := NewFactory()
+	metricsReceiver, err := factory.CreateMetricsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		factory.CreateDefaultConfig(),
+		consumertest.NewNop(),
+	)
+	if runtime.GOOS != "linux" {
+		require.ErrorIs(t, err, errUnsupportedOS)
+		return
+	}
+	require.NoError(t, err)
+	require.NotNil(t, metricsReceiver)
+}
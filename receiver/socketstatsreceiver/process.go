@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver"
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const defaultProcPath = "/proc"
+
+// processInfo identifies the process owning a socket.
+type processInfo struct {
+	pid  int64
+	name string
+}
+
+// socketProcesses maps the inodes of the sockets to the processes owning them,
+// by reading the file descriptors of the processes in the proc filesystem.
+// The processes whose file descriptors can't be read, usually because the
+// collector isn't allowed to, are skipped.
+func socketProcesses(procPath string) (map[uint32]processInfo, error) {
+	entries, err := os.ReadDir(procPath)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := map[uint32]processInfo{}
+	for _, entry := range entries {
+		pid, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		fdPath := filepath.Join(procPath, entry.Name(), "fd")
+		fds, err := os.ReadDir(fdPath)
+		if err != nil {
+			continue
+		}
+
+		var info *processInfo
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdPath, fd.Name()))
+			if err != nil {
+				continue
+			}
+			inode, ok := parseSocketLink(link)
+			if !ok {
+				continue
+			}
+			if info == nil {
+				info = &processInfo{pid: pid, name: processName(procPath, entry.Name())}
+			}
+			// the first process found owns the sockets shared by several processes
+			if _, ok := owners[inode]; !ok {
+				owners[inode] = *info
+			}
+		}
+	}
+	return owners, nil
+}
+
+// parseSocketLink returns the inode of the socket a file descriptor links to: socket:[12345]
+func parseSocketLink(link string) (uint32, bool) {
+	if !strings.HasPrefix(link, "socket:[") || !strings.HasSuffix(link, "]") {
+		return 0, false
+	}
+	inode, err := strconv.ParseUint(link[len("socket:["):len(link)-1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(inode), true
+}
+
+func processName(procPath string, pid string) string {
+	comm, err := os.ReadFile(filepath.Join(procPath, pid, "comm"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(comm))
+}
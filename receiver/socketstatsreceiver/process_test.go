@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeProcess(t *testing.T, procPath, pid, comm string, links ...string) {
+	fdPath := filepath.Join(procPath, pid, "fd")
+	require.NoError(t, os.MkdirAll(fdPath, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, pid, "comm"), []byte(comm+"\n"), 0600))
+	for i, link := range links {
+		require.NoError(t, os.Symlink(link, filepath.Join(fdPath, string(rune('0'+i)))))
+	}
+}
+
+func TestSocketProcesses(t *testing.T) {
+	procPath := t.TempDir()
+	writeProcess(t, procPath, "100", "nginx", "/dev/null", "socket:[1000]", "socket:[1001]", "pipe:[5]")
+	writeProcess(t, procPath, "200", "curl", "socket:[2000]")
+	// sockets inherited by a child process are attributed to a single process
+	writeProcess(t, procPath, "300", "nginx", "socket:[1000]")
+	// the entries which aren't processes are ignored
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "net"), 0700))
+
+	owners, err := socketProcesses(procPath)
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]processInfo{
+		1000: {pid: 100, name: "nginx"},
+		1001: {pid: 100, name: "nginx"},
+		2000: {pid: 200, name: "curl"},
+	}, owners)
+
+	_, err = socketProcesses(filepath.Join(procPath, "missing"))
+	require.Error(t, err)
+}
+
+func TestParseSocketLink(t *testing.T) {
+	inode, ok := parseSocketLink("socket:[12345]")
+	require.True(t, ok)
+	require.Equal(t, uint32(12345), inode)
+
+	for _, link := range []string{"/dev/null", "socket:[", "socket:[abc]", "anon_inode:[eventfd]"} {
+		_, ok = parseSocketLink(link)
+		require.False(t, ok, link)
+	}
+}
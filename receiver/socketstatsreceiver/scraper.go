@@ -0,0 +1,377 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+)
+
+const (
+	scopeName = "otelcol/socketstatsreceiver"
+
+	metricIO          = "socketstats.io"
+	metricRetransmits = "socketstats.tcp.retransmits"
+	metricRTT         = "socketstats.tcp.rtt"
+	metricSockets     = "socketstats.sockets"
+
+	attributeTransport   = "network.transport"
+	attributeType        = "network.type"
+	attributeLocalAddr   = "network.local.address"
+	attributeLocalPort   = "network.local.port"
+	attributePeerAddr    = "network.peer.address"
+	attributePeerPort    = "network.peer.port"
+	attributePID         = "process.pid"
+	attributeProcessName = "process.executable.name"
+	attributeDirection   = "network.io.direction"
+	attributeOverflow    = "otel.metric.overflow"
+)
+
+// socketID identifies a socket across scrapes.
+type socketID struct {
+	protocol string
+	cookie   uint64
+}
+
+// flowKey identifies a flow, the traffic of the sockets of a process sharing the same 5-tuple.
+type flowKey struct {
+	protocol string
+	local    netip.AddrPort
+	peer     netip.AddrPort
+	process  processInfo
+}
+
+// flowStats holds the statistics of a flow since the previous scrape.
+type flowStats struct {
+	sockets       int64
+	bytesSent     uint64
+	bytesReceived uint64
+	retransmits   uint64
+	rttSum        time.Duration
+	rttCount      int64
+}
+
+func (f *flowStats) add(other *flowStats) {
+	f.sockets += other.sockets
+	f.bytesSent += other.bytesSent
+	f.bytesReceived += other.bytesReceived
+	f.retransmits += other.retransmits
+	f.rttSum += other.rttSum
+	f.rttCount += other.rttCount
+}
+
+type flowScraper struct {
+	logger   *zap.Logger
+	cfg      *Config
+	procPath string
+
+	sockets   func(protocol string) ([]socket, error)
+	processes func(procPath string) (map[uint32]processInfo, error)
+
+	// previous holds the statistics of the TCP sockets at the previous scrape,
+	// to report the traffic of the flows between scrapes.
+	previous     map[socketID]tcpInfo
+	previousTime time.Time
+	// tracked holds the flows reported individually, which are limited to max_flows.
+	tracked map[flowKey]bool
+}
+
+func newFlowScraper(settings receiver.CreateSettings, cfg *Config) *flowScraper {
+	return &flowScraper{
+		logger:    settings.Logger,
+		cfg:       cfg,
+		procPath:  defaultProcPath,
+		sockets:   dumpSockets,
+		processes: socketProcesses,
+		previous:  map[socketID]tcpInfo{},
+		tracked:   map[flowKey]bool{},
+	}
+}
+
+func (s *flowScraper) scrape(context.Context) (pmetric.Metrics, error) {
+	now := time.Now()
+	errs := &scrapererror.ScrapeErrors{}
+
+	var sockets []socket
+	for _, protocol := range s.cfg.Protocols {
+		protocolSockets, err := s.sockets(protocol)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to read %s sockets: %w", protocol, err))
+			continue
+		}
+		sockets = append(sockets, protocolSockets...)
+	}
+	if len(sockets) == 0 && errs.Combine() != nil {
+		return pmetric.NewMetrics(), errs.Combine()
+	}
+
+	owners, err := s.processes(s.procPath)
+	if err != nil {
+		// the flows are still reported, without their processes
+		s.logger.Debug("failed to read the processes owning the sockets", zap.Error(err))
+	}
+
+	flows := s.aggregate(sockets, owners)
+	overflow := s.track(flows)
+	metrics := s.metrics(flows, overflow, now)
+	s.previousTime = now
+	return metrics, errs.Combine()
+}
+
+// aggregate computes the statistics of the flows since the previous scrape.
+// No traffic is reported at the first scrape, since the sockets opened before
+// the collector was started would report all their traffic at once.
+func (s *flowScraper) aggregate(sockets []socket, owners map[uint32]processInfo) map[flowKey]*flowStats {
+	firstScrape := s.previousTime.IsZero()
+	current := make(map[socketID]tcpInfo, len(s.previous))
+	flows := map[flowKey]*flowStats{}
+
+	for _, sock := range sockets {
+		if !sock.connected() {
+			continue
+		}
+		if !s.cfg.IncludeLoopback && sock.peer.Addr().IsLoopback() {
+			continue
+		}
+
+		key := flowKey{protocol: sock.protocol, local: sock.local, peer: sock.peer, process: owners[sock.inode]}
+		stats, ok := flows[key]
+		if !ok {
+			stats = &flowStats{}
+			flows[key] = stats
+		}
+		stats.sockets++
+
+		if sock.tcpInfo == nil {
+			continue
+		}
+		id := socketID{protocol: sock.protocol, cookie: sock.cookie}
+		current[id] = *sock.tcpInfo
+		stats.rttSum += sock.tcpInfo.rtt
+		stats.rttCount++
+		if firstScrape {
+			continue
+		}
+		// the sockets opened since the previous scrape report all their traffic
+		prev := s.previous[id]
+		stats.bytesSent += counterDelta(sock.tcpInfo.bytesSent, prev.bytesSent)
+		stats.bytesReceived += counterDelta(sock.tcpInfo.bytesReceived, prev.bytesReceived)
+		stats.retransmits += counterDelta(uint64(sock.tcpInfo.retransmits), uint64(prev.retransmits))
+	}
+
+	s.previous = current
+	return flows
+}
+
+func counterDelta(current, previous uint64) uint64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
+
+// track updates the flows reported individually: the flows which are closed are
+// forgotten, and the new flows are tracked until max_flows is reached. The statistics
+// of the flows which aren't tracked are removed and aggregated by protocol.
+func (s *flowScraper) track(flows map[flowKey]*flowStats) map[string]*flowStats {
+	for key := range s.tracked {
+		if _, ok := flows[key]; !ok {
+			delete(s.tracked, key)
+		}
+	}
+
+	overflow := map[string]*flowStats{}
+	for _, key := range sortedKeys(flows) {
+		if s.tracked[key] {
+			continue
+		}
+		if len(s.tracked) < s.cfg.MaxFlows {
+			s.tracked[key] = true
+			continue
+		}
+		stats, ok := overflow[key.protocol]
+		if !ok {
+			stats = &flowStats{}
+			overflow[key.protocol] = stats
+		}
+		stats.add(flows[key])
+		delete(flows, key)
+	}
+	return overflow
+}
+
+// sortedKeys returns the keys of the flows in a stable order, so that the same
+// flows are tracked whatever the order the sockets are read in.
+func sortedKeys(flows map[flowKey]*flowStats) []flowKey {
+	keys := make([]flowKey, 0, len(flows))
+	for key := range flows {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.protocol != b.protocol {
+			return a.protocol < b.protocol
+		}
+		if a.local != b.local {
+			return compareAddrPort(a.local, b.local) < 0
+		}
+		if a.peer != b.peer {
+			return compareAddrPort(a.peer, b.peer) < 0
+		}
+		return a.process.pid < b.process.pid
+	})
+	return keys
+}
+
+func compareAddrPort(a, b netip.AddrPort) int {
+	if c := a.Addr().Compare(b.Addr()); c != 0 {
+		return c
+	}
+	return int(a.Port()) - int(b.Port())
+}
+
+func (s *flowScraper) metrics(flows map[flowKey]*flowStats, overflow map[string]*flowStats, now time.Time) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+
+	b := newMetricsBuilder(sm.Metrics(), s.previousTime, now)
+	for _, key := range sortedKeys(flows) {
+		b.record(flows[key], func(attrs pcommon.Map) { flowAttributes(key, attrs) })
+	}
+	for _, protocol := range s.cfg.Protocols {
+		if stats, ok := overflow[protocol]; ok {
+			b.record(stats, func(attrs pcommon.Map) {
+				attrs.PutStr(attributeTransport, protocol)
+				attrs.PutBool(attributeOverflow, true)
+			})
+		}
+	}
+	b.removeEmpty()
+	return md
+}
+
+func flowAttributes(key flowKey, attrs pcommon.Map) {
+	attrs.PutStr(attributeTransport, key.protocol)
+	if key.local.Addr().Is4() {
+		attrs.PutStr(attributeType, "ipv4")
+	} else {
+		attrs.PutStr(attributeType, "ipv6")
+	}
+	attrs.PutStr(attributeLocalAddr, key.local.Addr().String())
+	attrs.PutInt(attributeLocalPort, int64(key.local.Port()))
+	attrs.PutStr(attributePeerAddr, key.peer.Addr().String())
+	attrs.PutInt(attributePeerPort, int64(key.peer.Port()))
+	if key.process.pid != 0 {
+		attrs.PutInt(attributePID, key.process.pid)
+		attrs.PutStr(attributeProcessName, key.process.name)
+	}
+}
+
+// metricsBuilder records the statistics of the flows in the metrics of the receiver.
+type metricsBuilder struct {
+	metrics     pmetric.MetricSlice
+	io          pmetric.Sum
+	retransmits pmetric.Sum
+	rtt         pmetric.Gauge
+	flows       pmetric.Gauge
+	start       pcommon.Timestamp
+	now         pcommon.Timestamp
+	// deltas is false at the first scrape, when no traffic is reported.
+	deltas bool
+}
+
+func newMetricsBuilder(metrics pmetric.MetricSlice, previous time.Time, now time.Time) *metricsBuilder {
+	b := &metricsBuilder{
+		metrics: metrics,
+		start:   pcommon.NewTimestampFromTime(previous),
+		now:     pcommon.NewTimestampFromTime(now),
+		deltas:  !previous.IsZero(),
+	}
+	b.io = newDeltaSum(metrics.AppendEmpty(), metricIO, "The number of bytes sent and received by the flow.", "By")
+	b.retransmits = newDeltaSum(metrics.AppendEmpty(), metricRetransmits,
+		"The number of segments retransmitted by the flow.", "{segments}")
+	b.rtt = newGauge(metrics.AppendEmpty(), metricRTT, "The smoothed round-trip time of the flow.", "s")
+	b.flows = newGauge(metrics.AppendEmpty(), metricSockets, "The number of sockets of the flow.", "{sockets}")
+	return b
+}
+
+func newDeltaSum(m pmetric.Metric, name, description, unit string) pmetric.Sum {
+	m.SetName(name)
+	m.SetDescription(description)
+	m.SetUnit(unit)
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	return sum
+}
+
+func newGauge(m pmetric.Metric, name, description, unit string) pmetric.Gauge {
+	m.SetName(name)
+	m.SetDescription(description)
+	m.SetUnit(unit)
+	return m.SetEmptyGauge()
+}
+
+func (b *metricsBuilder) record(stats *flowStats, setAttributes func(pcommon.Map)) {
+	dp := b.flows.DataPoints().AppendEmpty()
+	dp.SetTimestamp(b.now)
+	dp.SetIntValue(stats.sockets)
+	setAttributes(dp.Attributes())
+
+	// only TCP sockets report their statistics
+	if stats.rttCount == 0 {
+		return
+	}
+
+	dp = b.rtt.DataPoints().AppendEmpty()
+	dp.SetTimestamp(b.now)
+	dp.SetDoubleValue((stats.rttSum / time.Duration(stats.rttCount)).Seconds())
+	setAttributes(dp.Attributes())
+
+	if !b.deltas {
+		return
+	}
+	b.recordIO(stats.bytesSent, "transmit", setAttributes)
+	b.recordIO(stats.bytesReceived, "receive", setAttributes)
+	dp = b.retransmits.DataPoints().AppendEmpty()
+	b.setDelta(dp, stats.retransmits)
+	setAttributes(dp.Attributes())
+}
+
+func (b *metricsBuilder) recordIO(val uint64, direction string, setAttributes func(pcommon.Map)) {
+	dp := b.io.DataPoints().AppendEmpty()
+	b.setDelta(dp, val)
+	setAttributes(dp.Attributes())
+	dp.Attributes().PutStr(attributeDirection, direction)
+}
+
+func (b *metricsBuilder) setDelta(dp pmetric.NumberDataPoint, val uint64) {
+	dp.SetStartTimestamp(b.start)
+	dp.SetTimestamp(b.now)
+	dp.SetIntValue(int64(val))
+}
+
+// removeEmpty removes the metrics without data points.
+func (b *metricsBuilder) removeEmpty() {
+	b.metrics.RemoveIf(func(m pmetric.Metric) bool {
+		switch m.Type() {
+		case pmetric.MetricTypeSum:
+			return m.Sum().DataPoints().Len() == 0
+		case pmetric.MetricTypeGauge:
+			return m.Gauge().DataPoints().Len() == 0
+		case pmetric.MetricTypeEmpty, pmetric.MetricTypeHistogram, pmetric.MetricTypeExponentialHistogram, pmetric.MetricTypeSummary:
+		}
+		return false
+	})
+}
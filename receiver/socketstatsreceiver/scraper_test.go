@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+const (
+	stateEstablished = 1
+	stateClose       = 7
+)
+
+func tcpSocket(local, peer string, cookie uint64, sent, received uint64, retransmits uint32) socket {
+	return socket{
+		protocol: protocolTCP,
+		state:    stateEstablished,
+		local:    netip.MustParseAddrPort(local),
+		peer:     netip.MustParseAddrPort(peer),
+		inode:    uint32(cookie),
+		cookie:   cookie,
+		tcpInfo:  &tcpInfo{bytesSent: sent, bytesReceived: received, retransmits: retransmits, rtt: 2 * time.Millisecond},
+	}
+}
+
+func udpSocket(local, peer string, inode uint32) socket {
+	return socket{
+		protocol: protocolUDP,
+		state:    stateEstablished,
+		local:    netip.MustParseAddrPort(local),
+		peer:     netip.MustParseAddrPort(peer),
+		inode:    inode,
+		cookie:   uint64(inode),
+	}
+}
+
+type fakeHost struct {
+	sockets map[string][]socket
+	errs    map[string]error
+}
+
+func newTestScraper(cfg *Config, host *fakeHost) *flowScraper {
+	s := newFlowScraper(receivertest.NewNopCreateSettings(), cfg)
+	s.sockets = func(protocol string) ([]socket, error) {
+		return host.sockets[protocol], host.errs[protocol]
+	}
+	s.processes = func(string) (map[uint32]processInfo, error) {
+		return map[uint32]processInfo{
+			1: {pid: 100, name: "nginx"},
+			2: {pid: 100, name: "nginx"},
+			3: {pid: 200, name: "curl"},
+		}, nil
+	}
+	return s
+}
+
+// dataPoint returns the data point of a metric for the flow of a peer port.
+func dataPoint(t *testing.T, md pmetric.Metrics, name string, peerPort int64, direction string) pmetric.NumberDataPoint {
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		if m.Name() != name {
+			continue
+		}
+		var dps pmetric.NumberDataPointSlice
+		if m.Type() == pmetric.MetricTypeSum {
+			dps = m.Sum().DataPoints()
+		} else {
+			dps = m.Gauge().DataPoints()
+		}
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			port, _ := dp.Attributes().Get(attributePeerPort)
+			dir, _ := dp.Attributes().Get(attributeDirection)
+			if port.Int() == peerPort && dir.Str() == direction {
+				return dp
+			}
+		}
+	}
+	require.FailNowf(t, "data point not found", "%s for peer port %d", name, peerPort)
+	return pmetric.NumberDataPoint{}
+}
+
+func metricNames(md pmetric.Metrics) []string {
+	var names []string
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		names = append(names, metrics.At(i).Name())
+	}
+	return names
+}
+
+func TestScrape(t *testing.T) {
+	host := &fakeHost{sockets: map[string][]socket{
+		protocolTCP: {
+			tcpSocket("10.0.0.1:443", "10.0.0.2:50000", 1, 1000, 200, 1),
+			tcpSocket("10.0.0.1:443", "10.0.0.3:50001", 2, 5000, 500, 0),
+			// not part of a flow
+			{protocol: protocolTCP, state: tcpStateListen, local: netip.MustParseAddrPort("0.0.0.0:443"), peer: netip.MustParseAddrPort("0.0.0.0:0"), inode: 1},
+			tcpSocket("127.0.0.1:8080", "127.0.0.1:50002", 4, 10, 10, 0),
+		},
+		protocolUDP: {
+			udpSocket("10.0.0.1:40000", "10.0.0.53:53", 3),
+			{protocol: protocolUDP, state: stateClose, local: netip.MustParseAddrPort("0.0.0.0:68"), peer: netip.MustParseAddrPort("0.0.0.0:0")},
+		},
+	}}
+	s := newTestScraper(createDefaultConfig().(*Config), host)
+
+	// the first scrape only reports the flows, not their traffic
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{metricRTT, metricSockets}, metricNames(md))
+	require.Equal(t, 5, md.DataPointCount())
+
+	dp := dataPoint(t, md, metricSockets, 53, "")
+	require.Equal(t, int64(1), dp.IntValue())
+	require.Equal(t, map[string]interface{}{
+		attributeTransport:   "udp",
+		attributeType:        "ipv4",
+		attributeLocalAddr:   "10.0.0.1",
+		attributeLocalPort:   int64(40000),
+		attributePeerAddr:    "10.0.0.53",
+		attributePeerPort:    int64(53),
+		attributePID:         int64(200),
+		attributeProcessName: "curl",
+	}, dp.Attributes().AsRaw())
+	require.Equal(t, 0.002, dataPoint(t, md, metricRTT, 50000, "").DoubleValue())
+
+	// the second scrape reports the traffic since the first one
+	firstScrape := s.previousTime
+	host.sockets[protocolTCP] = []socket{
+		tcpSocket("10.0.0.1:443", "10.0.0.2:50000", 1, 1500, 300, 3),
+		// a new socket reports all its traffic
+		tcpSocket("10.0.0.1:443", "10.0.0.4:50003", 5, 700, 70, 0),
+	}
+	md, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{metricIO, metricRetransmits, metricRTT, metricSockets}, metricNames(md))
+
+	dp = dataPoint(t, md, metricIO, 50000, "transmit")
+	require.Equal(t, int64(500), dp.IntValue())
+	require.Equal(t, firstScrape.UnixNano(), dp.StartTimestamp().AsTime().UnixNano())
+	require.Equal(t, int64(100), dataPoint(t, md, metricIO, 50000, "receive").IntValue())
+	require.Equal(t, int64(2), dataPoint(t, md, metricRetransmits, 50000, "").IntValue())
+	require.Equal(t, int64(700), dataPoint(t, md, metricIO, 50003, "transmit").IntValue())
+	require.Equal(t, int64(70), dataPoint(t, md, metricIO, 50003, "receive").IntValue())
+
+	// the closed flow is forgotten
+	require.Len(t, s.tracked, 3)
+	require.Len(t, s.previous, 2)
+}
+
+func TestScrapeLoopback(t *testing.T) {
+	host := &fakeHost{sockets: map[string][]socket{
+		protocolTCP: {tcpSocket("127.0.0.1:8080", "127.0.0.1:50002", 1, 10, 10, 0)},
+	}}
+	cfg := createDefaultConfig().(*Config)
+	cfg.IncludeLoopback = true
+	md, err := newTestScraper(cfg, host).scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), dataPoint(t, md, metricSockets, 50002, "").IntValue())
+}
+
+func TestScrapeOverflow(t *testing.T) {
+	host := &fakeHost{sockets: map[string][]socket{
+		protocolTCP: {
+			tcpSocket("10.0.0.1:443", "10.0.0.2:50000", 1, 1000, 100, 0),
+			tcpSocket("10.0.0.1:443", "10.0.0.3:50001", 2, 2000, 200, 0),
+			tcpSocket("10.0.0.1:443", "10.0.0.4:50002", 3, 3000, 300, 0),
+		},
+	}}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Protocols = []string{protocolTCP}
+	cfg.MaxFlows = 2
+	s := newTestScraper(cfg, host)
+
+	_, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	// the flows tracked first stay tracked while they are open
+	host.sockets[protocolTCP] = []socket{
+		tcpSocket("10.0.0.1:443", "10.0.0.1:49999", 4, 10, 1, 0),
+		tcpSocket("10.0.0.1:443", "10.0.0.2:50000", 1, 1100, 110, 0),
+		tcpSocket("10.0.0.1:443", "10.0.0.3:50001", 2, 2200, 220, 0),
+		tcpSocket("10.0.0.1:443", "10.0.0.4:50002", 3, 3300, 330, 0),
+	}
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(100), dataPoint(t, md, metricIO, 50000, "transmit").IntValue())
+	require.Equal(t, int64(200), dataPoint(t, md, metricIO, 50001, "transmit").IntValue())
+
+	// the other flows are aggregated
+	dp := dataPoint(t, md, metricIO, 0, "transmit")
+	require.Equal(t, map[string]interface{}{
+		attributeTransport: "tcp",
+		attributeOverflow:  true,
+		attributeDirection: "transmit",
+	}, dp.Attributes().AsRaw())
+	require.Equal(t, int64(310), dp.IntValue())
+	require.Equal(t, int64(2), dataPoint(t, md, metricSockets, 0, "").IntValue())
+
+	// a flow is tracked once another one is closed
+	host.sockets[protocolTCP] = []socket{
+		tcpSocket("10.0.0.1:443", "10.0.0.3:50001", 2, 2200, 220, 0),
+		tcpSocket("10.0.0.1:443", "10.0.0.4:50002", 3, 3400, 340, 0),
+		tcpSocket("10.0.0.1:443", "10.0.0.5:50004", 6, 5, 1, 0),
+	}
+	md, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), dataPoint(t, md, metricIO, 50001, "transmit").IntValue())
+	require.Equal(t, int64(100), dataPoint(t, md, metricIO, 50002, "transmit").IntValue())
+	require.Equal(t, int64(5), dataPoint(t, md, metricIO, 0, "transmit").IntValue())
+	require.Len(t, s.tracked, 2)
+}
+
+func TestScrapeErrors(t *testing.T) {
+	host := &fakeHost{
+		sockets: map[string][]socket{protocolUDP: {udpSocket("10.0.0.1:40000", "10.0.0.53:53", 3)}},
+		errs:    map[string]error{protocolTCP: errors.New("permission denied")},
+	}
+	s := newTestScraper(createDefaultConfig().(*Config), host)
+	s.processes = func(string) (map[uint32]processInfo, error) {
+		return nil, errors.New("no such file or directory")
+	}
+
+	md, err := s.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.EqualError(t, err, "failed to read tcp sockets: permission denied")
+	// the flows are reported without their processes
+	dp := dataPoint(t, md, metricSockets, 53, "")
+	_, ok := dp.Attributes().Get(attributePID)
+	require.False(t, ok)
+
+	host.errs[protocolUDP] = errors.New("permission denied")
+	md, err = s.scrape(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 0, md.DataPointCount())
+}
@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"golang.org/x/sys/cpu"
+)
+
+// The sockets are read with the sock_diag netlink interface of the kernel,
+// see https://man7.org/linux/man-pages/man7/sock_diag.7.html.
+const (
+	familyIPv4 = 2
+	familyIPv6 = 10
+
+	ipProtoTCP = 6
+	ipProtoUDP = 17
+
+	nlmsgHdrLen      = 16
+	sockDiagByFamily = 20
+	nlmFRequest      = 0x1
+	nlmFDump         = 0x300
+
+	inetDiagReqV2Len = 56
+	inetDiagMsgLen   = 72
+	inetDiagInfo     = 2
+
+	// offsets of the fields of struct tcp_info
+	tcpInfoRTT           = 68
+	tcpInfoTotalRetrans  = 100
+	tcpInfoBytesAcked    = 120
+	tcpInfoBytesReceived = 128
+	tcpInfoMinLen        = 136
+
+	tcpStateTimeWait = 6
+	tcpStateListen   = 10
+)
+
+var errShortMessage = errors.New("sock_diag message too short")
+
+// nativeEndian is the byte order of the host, used by the kernel for the
+// fields of the netlink messages which aren't in network byte order.
+var nativeEndian binary.ByteOrder = binary.LittleEndian
+
+func init() {
+	if cpu.IsBigEndian {
+		nativeEndian = binary.BigEndian
+	}
+}
+
+// socket is an open socket along with its statistics.
+type socket struct {
+	protocol string
+	state    uint8
+	local    netip.AddrPort
+	peer     netip.AddrPort
+	inode    uint32
+	cookie   uint64
+	// tcpInfo is only reported for TCP sockets.
+	tcpInfo *tcpInfo
+}
+
+// tcpInfo holds the statistics of a TCP socket since it was opened.
+type tcpInfo struct {
+	bytesSent     uint64
+	bytesReceived uint64
+	retransmits   uint32
+	rtt           time.Duration
+}
+
+// connected returns false for the sockets which aren't part of a flow:
+// listening TCP sockets, TCP sockets waiting to be closed, and unconnected UDP sockets.
+func (s socket) connected() bool {
+	if s.protocol == protocolTCP {
+		return s.state != tcpStateListen && s.state != tcpStateTimeWait
+	}
+	return s.peer.Port() != 0
+}
+
+// newDumpRequest returns the netlink message requesting the sockets
+// of a family and of a protocol, with their TCP statistics.
+func newDumpRequest(family uint8, protocol string, seq uint32) []byte {
+	msg := make([]byte, nlmsgHdrLen+inetDiagReqV2Len)
+	nativeEndian.PutUint32(msg[0:4], uint32(len(msg)))
+	nativeEndian.PutUint16(msg[4:6], sockDiagByFamily)
+	nativeEndian.PutUint16(msg[6:8], nlmFRequest|nlmFDump)
+	nativeEndian.PutUint32(msg[8:12], seq)
+
+	req := msg[nlmsgHdrLen:]
+	req[0] = family
+	if protocol == protocolTCP {
+		req[1] = ipProtoTCP
+		req[2] = 1 << (inetDiagInfo - 1)
+	} else {
+		req[1] = ipProtoUDP
+	}
+	// all the states
+	nativeEndian.PutUint32(req[4:8], 0xffffffff)
+	return msg
+}
+
+// parseInetDiagMsg parses the payload of a message returned by a sock_diag dump.
+func parseInetDiagMsg(protocol string, data []byte) (socket, error) {
+	if len(data) < inetDiagMsgLen {
+		return socket{}, errShortMessage
+	}
+
+	s := socket{
+		protocol: protocol,
+		state:    data[1],
+		inode:    nativeEndian.Uint32(data[68:72]),
+		cookie:   uint64(nativeEndian.Uint32(data[48:52]))<<32 | uint64(nativeEndian.Uint32(data[44:48])),
+	}
+	localPort := binary.BigEndian.Uint16(data[4:6])
+	peerPort := binary.BigEndian.Uint16(data[6:8])
+	switch data[0] {
+	case familyIPv4:
+		s.local = netip.AddrPortFrom(netip.AddrFrom4(*(*[4]byte)(data[8:12])), localPort)
+		s.peer = netip.AddrPortFrom(netip.AddrFrom4(*(*[4]byte)(data[24:28])), peerPort)
+	case familyIPv6:
+		s.local = netip.AddrPortFrom(netip.AddrFrom16(*(*[16]byte)(data[8:24])).Unmap(), localPort)
+		s.peer = netip.AddrPortFrom(netip.AddrFrom16(*(*[16]byte)(data[24:40])).Unmap(), peerPort)
+	default:
+		return socket{}, fmt.Errorf("unsupported address family %d", data[0])
+	}
+
+	// the attributes follow the message, aligned on 4 bytes
+	attrs := data[inetDiagMsgLen:]
+	for len(attrs) >= 4 {
+		attrLen := int(nativeEndian.Uint16(attrs[0:2]))
+		attrType := nativeEndian.Uint16(attrs[2:4])
+		if attrLen < 4 || attrLen > len(attrs) {
+			return socket{}, errShortMessage
+		}
+		if attrType == inetDiagInfo && protocol == protocolTCP {
+			s.tcpInfo = parseTCPInfo(attrs[4:attrLen])
+		}
+		aligned := (attrLen + 3) &^ 3
+		if aligned > len(attrs) {
+			break
+		}
+		attrs = attrs[aligned:]
+	}
+	return s, nil
+}
+
+// parseTCPInfo parses a struct tcp_info, nil is returned if the kernel
+// is too old to report the number of bytes sent and received.
+func parseTCPInfo(data []byte) *tcpInfo {
+	if len(data) < tcpInfoMinLen {
+		return nil
+	}
+	return &tcpInfo{
+		bytesSent:     nativeEndian.Uint64(data[tcpInfoBytesAcked : tcpInfoBytesAcked+8]),
+		bytesReceived: nativeEndian.Uint64(data[tcpInfoBytesReceived : tcpInfoBytesReceived+8]),
+		retransmits:   nativeEndian.Uint32(data[tcpInfoTotalRetrans : tcpInfoTotalRetrans+4]),
+		rtt:           time.Duration(nativeEndian.Uint32(data[tcpInfoRTT:tcpInfoRTT+4])) * time.Microsecond,
+	}
+}
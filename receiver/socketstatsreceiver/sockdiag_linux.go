@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+// +build linux
+
+package socketstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/socketstatsreceiver"
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// dumpSockets returns the IPv4 and IPv6 sockets of a protocol
+// in the network namespace of the collector.
+func dumpSockets(protocol string) ([]socket, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, unix.NETLINK_INET_DIAG)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sock_diag socket: %w", err)
+	}
+	defer unix.Close(fd)
+
+	var sockets []socket
+	for seq, family := range []uint8{familyIPv4, familyIPv6} {
+		req := newDumpRequest(family, protocol, uint32(seq+1))
+		if err = unix.Sendto(fd, req, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+			return nil, fmt.Errorf("failed to request %s sockets: %w", protocol, err)
+		}
+		if sockets, err = receiveSockets(fd, protocol, sockets); err != nil {
+			return nil, err
+		}
+	}
+	return sockets, nil
+}
+
+// receiveSockets reads the responses to a dump request until the end of the dump.
+func receiveSockets(fd int, protocol string, sockets []socket) ([]socket, error) {
+	buf := make([]byte, 8*os.Getpagesize())
+	for {
+		n, _, err := unix.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s sockets: %w", protocol, err)
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s sockets: %w", protocol, err)
+		}
+		for _, msg := range msgs {
+			switch msg.Header.Type {
+			case unix.NLMSG_DONE:
+				return sockets, nil
+			case unix.NLMSG_ERROR:
+				if len(msg.Data) < 4 {
+					return nil, errShortMessage
+				}
+				errno := -int32(nativeEndian.Uint32(msg.Data[0:4]))
+				return nil, fmt.Errorf("failed to dump %s sockets: %w", protocol, unix.Errno(errno))
+			}
+			s, err := parseInetDiagMsg(protocol, msg.Data)
+			if err != nil {
+				return nil, err
+			}
+			sockets = append(sockets, s)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package socketstatsreceiver
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newInetDiagMsg builds the payload of a message of a sock_diag dump.
+func newInetDiagMsg(family uint8, state uint8, local, peer netip.AddrPort, inode uint32, cookie uint64, info []byte) []byte {
+	msg := make([]byte, inetDiagMsgLen)
+	msg[0] = family
+	msg[1] = state
+	binary.BigEndian.PutUint16(msg[4:6], local.Port())
+	binary.BigEndian.PutUint16(msg[6:8], peer.Port())
+	localAddr, peerAddr := local.Addr().AsSlice(), peer.Addr().AsSlice()
+	copy(msg[8:24], localAddr)
+	copy(msg[24:40], peerAddr)
+	nativeEndian.PutUint32(msg[44:48], uint32(cookie))
+	nativeEndian.PutUint32(msg[48:52], uint32(cookie>>32))
+	nativeEndian.PutUint32(msg[68:72], inode)
+	if info == nil {
+		return msg
+	}
+
+	attr := make([]byte, 4+len(info))
+	nativeEndian.PutUint16(attr[0:2], uint16(len(attr)))
+	nativeEndian.PutUint16(attr[2:4], inetDiagInfo)
+	copy(attr[4:], info)
+	for len(attr)%4 != 0 {
+		attr = append(attr, 0)
+	}
+	return append(msg, attr...)
+}
+
+func newTCPInfo(sent, received uint64, retransmits uint32, rtt time.Duration) []byte {
+	info := make([]byte, 232)
+	nativeEndian.PutUint32(info[tcpInfoRTT:], uint32(rtt/time.Microsecond))
+	nativeEndian.PutUint32(info[tcpInfoTotalRetrans:], retransmits)
+	nativeEndian.PutUint64(info[tcpInfoBytesAcked:], sent)
+	nativeEndian.PutUint64(info[tcpInfoBytesReceived:], received)
+	return info
+}
+
+func TestNewDumpRequest(t *testing.T) {
+	req := newDumpRequest(familyIPv6, protocolTCP, 7)
+	require.Len(t, req, nlmsgHdrLen+inetDiagReqV2Len)
+	require.Equal(t, uint32(len(req)), nativeEndian.Uint32(req[0:4]))
+	require.Equal(t, uint16(sockDiagByFamily), nativeEndian.Uint16(req[4:6]))
+	require.Equal(t, uint16(nlmFRequest|nlmFDump), nativeEndian.Uint16(req[6:8]))
+	require.Equal(t, uint32(7), nativeEndian.Uint32(req[8:12]))
+	require.Equal(t, []byte{familyIPv6, ipProtoTCP, 2, 0}, req[16:20])
+	require.Equal(t, uint32(0xffffffff), nativeEndian.Uint32(req[20:24]))
+
+	req = newDumpRequest(familyIPv4, protocolUDP, 1)
+	require.Equal(t, []byte{familyIPv4, ipProtoUDP, 0, 0}, req[16:20])
+}
+
+func TestParseInetDiagMsg(t *testing.T) {
+	local := netip.MustParseAddrPort("10.0.0.1:43210")
+	peer := netip.MustParseAddrPort("10.0.0.2:443")
+	msg := newInetDiagMsg(familyIPv4, 1, local, peer, 1234, 1<<40|5, newTCPInfo(100, 2000, 3, 1500*time.Microsecond))
+
+	s, err := parseInetDiagMsg(protocolTCP, msg)
+	require.NoError(t, err)
+	require.Equal(t, socket{
+		protocol: protocolTCP,
+		state:    1,
+		local:    local,
+		peer:     peer,
+		inode:    1234,
+		cookie:   1<<40 | 5,
+		tcpInfo:  &tcpInfo{bytesSent: 100, bytesReceived: 2000, retransmits: 3, rtt: 1500 * time.Microsecond},
+	}, s)
+	require.True(t, s.connected())
+}
+
+func TestParseInetDiagMsgIPv6(t *testing.T) {
+	local := netip.MustParseAddrPort("[2001:db8::1]:53")
+	peer := netip.MustParseAddrPort("[2001:db8::2]:5353")
+	s, err := parseInetDiagMsg(protocolUDP, newInetDiagMsg(familyIPv6, 1, local, peer, 42, 1, nil))
+	require.NoError(t, err)
+	require.Equal(t, local, s.local)
+	require.Equal(t, peer, s.peer)
+	require.Nil(t, s.tcpInfo)
+	require.True(t, s.connected())
+
+	// IPv4 addresses of dual-stack sockets are reported as IPv4
+	mapped := netip.AddrPortFrom(netip.MustParseAddr("::ffff:10.0.0.1"), 80)
+	s, err = parseInetDiagMsg(protocolTCP, newInetDiagMsg(familyIPv6, 1, mapped, mapped, 42, 1, nil))
+	require.NoError(t, err)
+	require.Equal(t, netip.MustParseAddrPort("10.0.0.1:80"), s.local)
+}
+
+func TestParseInetDiagMsgOldKernel(t *testing.T) {
+	addr := netip.MustParseAddrPort("10.0.0.1:80")
+	s, err := parseInetDiagMsg(protocolTCP, newInetDiagMsg(familyIPv4, 1, addr, addr, 1, 1, make([]byte, 104)))
+	require.NoError(t, err)
+	require.Nil(t, s.tcpInfo)
+}
+
+func TestParseInetDiagMsgErrors(t *testing.T) {
+	addr := netip.MustParseAddrPort("10.0.0.1:80")
+	_, err := parseInetDiagMsg(protocolTCP, make([]byte, 10))
+	require.ErrorIs(t, err, errShortMessage)
+
+	_, err = parseInetDiagMsg(protocolTCP, newInetDiagMsg(7, 1, addr, addr, 1, 1, nil))
+	require.EqualError(t, err, "unsupported address family 7")
+
+	msg := newInetDiagMsg(familyIPv4, 1, addr, addr, 1, 1, newTCPInfo(1, 1, 1, time.Millisecond))
+	_, err = parseInetDiagMsg(protocolTCP, msg[:inetDiagMsgLen+20])
+	require.ErrorIs(t, err, errShortMessage)
+}
+
+func TestConnected(t *testing.T) {
+	peer := netip.MustParseAddrPort("10.0.0.2:443")
+	unconnected := netip.MustParseAddrPort("0.0.0.0:0")
+	require.False(t, socket{protocol: protocolTCP, state: tcpStateListen, peer: unconnected}.connected())
+	require.False(t, socket{protocol: protocolTCP, state: tcpStateTimeWait, peer: peer}.connected())
+	require.True(t, socket{protocol: protocolTCP, state: 1, peer: peer}.connected())
+	require.False(t, socket{protocol: protocolUDP, state: 7, peer: unconnected}.connected())
+	require.True(t, socket{protocol: protocolUDP, state: 1, peer: peer}.connected())
+}
@@ -47,6 +47,7 @@ type Query struct {
 	Logs               []LogsCfg   `mapstructure:"logs"`
 	TrackingColumn     string      `mapstructure:"tracking_column"`
 	TrackingStartValue string      `mapstructure:"tracking_start_value"`
+	ChunkSize          int         `mapstructure:"chunk_size"`
 }
 
 func (q Query) Validate() error {
@@ -54,6 +55,9 @@ func (q Query) Validate() error {
 	if q.SQL == "" {
 		errs = multierr.Append(errs, errors.New("'query.sql' cannot be empty"))
 	}
+	if q.ChunkSize < 0 {
+		errs = multierr.Append(errs, errors.New("'query.chunk_size' must not be negative"))
+	}
 	if len(q.Logs) == 0 && len(q.Metrics) == 0 {
 		errs = multierr.Append(errs, errors.New("at least one of 'query.logs' and 'query.metrics' must not be empty"))
 	}
@@ -71,13 +75,40 @@ func (q Query) Validate() error {
 }
 
 type LogsCfg struct {
-	BodyColumn string `mapstructure:"body_column"`
+	BodyColumn      string            `mapstructure:"body_column"`
+	BodyTemplate    string            `mapstructure:"body_template"`
+	SeverityColumn  string            `mapstructure:"severity_column"`
+	SeverityMapping map[string]string `mapstructure:"severity_mapping"`
+	TsColumn        string            `mapstructure:"ts_column"`
+	TsFormat        string            `mapstructure:"ts_format"`
+	TsTimezone      string            `mapstructure:"ts_timezone"`
 }
 
 func (config LogsCfg) Validate() error {
 	var errs error
-	if config.BodyColumn == "" {
-		errs = multierr.Append(errs, errors.New("'body_column' must not be empty"))
+	switch {
+	case config.BodyColumn == "" && config.BodyTemplate == "":
+		errs = multierr.Append(errs, errors.New("'body_column' must not be empty when 'body_template' is not set"))
+	case config.BodyColumn != "" && config.BodyTemplate != "":
+		errs = multierr.Append(errs, errors.New("'body_column' and 'body_template' cannot be both set"))
+	case config.BodyTemplate != "":
+		if _, err := parseBodyTemplate(config.BodyTemplate); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("'body_template' is invalid: %w", err))
+		}
+	}
+	if config.SeverityColumn == "" && len(config.SeverityMapping) > 0 {
+		errs = multierr.Append(errs, errors.New("'severity_mapping' requires 'severity_column' to be set"))
+	}
+	for value, severity := range config.SeverityMapping {
+		if _, ok := parseSeverity(severity); !ok {
+			errs = multierr.Append(errs, fmt.Errorf("'severity_mapping' has unsupported severity '%s' for value '%s'", severity, value))
+		}
+	}
+	if config.TsColumn == "" && (config.TsFormat != "" || config.TsTimezone != "") {
+		errs = multierr.Append(errs, errors.New("'ts_format' and 'ts_timezone' require 'ts_column' to be set"))
+	}
+	if _, err := time.LoadLocation(config.TsTimezone); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("'ts_timezone' is invalid: %w", err))
 	}
 	return errs
 }
@@ -120,6 +120,7 @@ func TestLoadConfig(t *testing.T) {
 						SQL:                "select * from test_logs where log_id > ?",
 						TrackingColumn:     "log_id",
 						TrackingStartValue: "10",
+						ChunkSize:          500,
 						Logs: []LogsCfg{
 							{
 								BodyColumn: "log_body",
@@ -129,11 +130,60 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			fname:        "config-logs-invalid-chunk-size.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'query.chunk_size' must not be negative",
+		},
 		{
 			fname:        "config-logs-missing-body-column.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
 			errorMessage: "'body_column' must not be empty",
 		},
+		{
+			fname: "config-logs-template.yaml",
+			id:    component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					CollectionInterval: 10 * time.Second,
+					InitialDelay:       time.Second,
+				},
+				Driver:     "mydriver",
+				DataSource: "host=localhost port=5432 user=me password=s3cr3t sslmode=disable",
+				Queries: []Query{
+					{
+						SQL:                "select * from audit_events where event_id > ?",
+						TrackingColumn:     "event_id",
+						TrackingStartValue: "0",
+						Logs: []LogsCfg{
+							{
+								BodyTemplate:    "{{ .user_name }} {{ .action }} {{ .resource }}",
+								SeverityColumn:  "level",
+								SeverityMapping: map[string]string{"E": "error", "W": "warn"},
+								TsColumn:        "created_at",
+								TsFormat:        "2006-01-02 15:04:05",
+								TsTimezone:      "Europe/Paris",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			fname:        "config-logs-body-column-and-template.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'body_column' and 'body_template' cannot be both set",
+		},
+		{
+			fname:        "config-logs-invalid-severity.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'severity_mapping' has unsupported severity 'extreme' for value 'X'",
+		},
+		{
+			fname:        "config-logs-invalid-timezone.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'ts_timezone' is invalid",
+		},
 		{
 			fname:        "config-unnecessary-aggregation.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
@@ -5,6 +5,7 @@ package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"context"
+	"errors"
 
 	// register db drivers
 	_ "github.com/SAP/go-hdb/driver"
@@ -21,6 +22,10 @@ type stringMap map[string]string
 
 type dbClient interface {
 	queryRows(ctx context.Context, args ...any) ([]stringMap, error)
+	// streamRows passes the rows of all the result sets of the query to handle, in chunks of at most
+	// chunkSize rows, or in a single chunk if chunkSize is zero. It stops at the first error returned
+	// by handle. Like queryRows, the returned error may only hold warnings about the scanned values.
+	streamRows(ctx context.Context, chunkSize int, handle func([]stringMap) error, args ...any) error
 }
 
 type dbSQLClient struct {
@@ -38,27 +43,60 @@ func newDbClient(db db, sql string, logger *zap.Logger) dbClient {
 }
 
 func (cl dbSQLClient) queryRows(ctx context.Context, args ...any) ([]stringMap, error) {
-	sqlRows, err := cl.db.QueryContext(ctx, cl.sql, args...)
-	if err != nil {
+	var out []stringMap
+	err := cl.streamRows(ctx, 0, func(rows []stringMap) error {
+		out = append(out, rows...)
+		return nil
+	}, args...)
+	if err != nil && !errors.Is(err, errNullValueWarning) {
 		return nil, err
 	}
-	var out []stringMap
-	colTypes, err := sqlRows.ColumnTypes()
+	return out, err
+}
+
+func (cl dbSQLClient) streamRows(ctx context.Context, chunkSize int, handle func([]stringMap) error, args ...any) error {
+	sqlRows, err := cl.db.QueryContext(ctx, cl.sql, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	scanner := newRowScanner(colTypes)
+	defer sqlRows.Close()
+
 	var warnings error
-	for sqlRows.Next() {
-		err = scanner.scan(sqlRows)
+	var chunk []stringMap
+	for {
+		colTypes, err := sqlRows.ColumnTypes()
 		if err != nil {
-			return nil, err
+			return err
+		}
+		scanner := newRowScanner(colTypes)
+		for sqlRows.Next() {
+			err = scanner.scan(sqlRows)
+			if err != nil {
+				return err
+			}
+			sm, scanErr := scanner.toStringMap()
+			if scanErr != nil {
+				warnings = multierr.Append(warnings, scanErr)
+			}
+			chunk = append(chunk, sm)
+			if chunkSize > 0 && len(chunk) == chunkSize {
+				if err = handle(chunk); err != nil {
+					return err
+				}
+				chunk = nil
+			}
 		}
-		sm, scanErr := scanner.toStringMap()
-		if scanErr != nil {
-			warnings = multierr.Append(warnings, scanErr)
+		if err = sqlRows.Err(); err != nil {
+			return err
+		}
+		if !sqlRows.NextResultSet() {
+			break
+		}
+	}
+	if len(chunk) > 0 {
+		if err := handle(chunk); err != nil {
+			return err
 		}
-		out = append(out, sm)
 	}
-	return out, warnings
+	return warnings
 }
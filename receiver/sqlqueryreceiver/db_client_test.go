@@ -104,40 +104,115 @@ func TestDBSQLClient_Nulls_MultiRow(t *testing.T) {
 	}, rows[1])
 }
 
+func TestDBSQLClient_MultiResultSets(t *testing.T) {
+	cl := dbSQLClient{
+		db: fakeDB{
+			rowVals:        [][]any{{42, "hello"}},
+			moreResultSets: [][][]any{{{43}, {44}}},
+		},
+		logger: zap.NewNop(),
+		sql:    "",
+	}
+	rows, err := cl.queryRows(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, []stringMap{
+		{"col_0": "42", "col_1": "hello"},
+		{"col_0": "43"},
+		{"col_0": "44"},
+	}, rows)
+}
+
+func TestDBSQLClient_StreamRows(t *testing.T) {
+	cl := dbSQLClient{
+		db: fakeDB{
+			rowVals:        [][]any{{1}, {2}, {3}},
+			moreResultSets: [][][]any{{{4}, {5}}},
+		},
+		logger: zap.NewNop(),
+		sql:    "",
+	}
+	var chunks [][]stringMap
+	err := cl.streamRows(context.Background(), 2, func(rows []stringMap) error {
+		chunks = append(chunks, rows)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, [][]stringMap{
+		{{"col_0": "1"}, {"col_0": "2"}},
+		{{"col_0": "3"}, {"col_0": "4"}},
+		{{"col_0": "5"}},
+	}, chunks)
+}
+
+func TestDBSQLClient_StreamRows_HandleError(t *testing.T) {
+	cl := dbSQLClient{
+		db:     fakeDB{rowVals: [][]any{{1}, {2}, {3}}},
+		logger: zap.NewNop(),
+		sql:    "",
+	}
+	handled := 0
+	err := cl.streamRows(context.Background(), 1, func(rows []stringMap) error {
+		handled++
+		return errors.New("consumer failure")
+	})
+	assert.EqualError(t, err, "consumer failure")
+	assert.Equal(t, 1, handled)
+}
+
 type fakeDB struct {
 	rowVals [][]any
+	// moreResultSets are the result sets returned after the one of rowVals.
+	moreResultSets [][][]any
 }
 
 func (db fakeDB) QueryContext(context.Context, string, ...any) (rows, error) {
-	return &fakeRows{vals: db.rowVals}, nil
+	return &fakeRows{sets: append([][][]any{db.rowVals}, db.moreResultSets...)}, nil
 }
 
 type fakeRows struct {
-	vals [][]any
+	sets [][][]any
+	set  int
 	row  int
 }
 
 func (r *fakeRows) ColumnTypes() ([]colType, error) {
 	var out []colType
-	for i := 0; i < len(r.vals[0]); i++ {
+	for i := 0; i < len(r.sets[r.set][0]); i++ {
 		out = append(out, fakeCol{fmt.Sprintf("col_%d", i)})
 	}
 	return out, nil
 }
 
 func (r *fakeRows) Next() bool {
-	return r.row < len(r.vals)
+	return r.row < len(r.sets[r.set])
 }
 
 func (r *fakeRows) Scan(dest ...any) error {
 	for i := range dest {
 		ptr := dest[i].(*any)
-		*ptr = r.vals[r.row][i]
+		*ptr = r.sets[r.set][r.row][i]
 	}
 	r.row++
 	return nil
 }
 
+func (r *fakeRows) NextResultSet() bool {
+	if r.set+1 >= len(r.sets) {
+		return false
+	}
+	r.set++
+	r.row = 0
+	return true
+}
+
+func (r *fakeRows) Err() error {
+	return nil
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
 type fakeCol struct {
 	name string
 }
@@ -160,3 +235,21 @@ func (c *fakeDBClient) queryRows(context.Context, ...any) ([]stringMap, error) {
 	c.requestCounter++
 	return c.stringMaps[idx], nil
 }
+
+func (c *fakeDBClient) streamRows(ctx context.Context, chunkSize int, handle func([]stringMap) error, args ...any) error {
+	rows, err := c.queryRows(ctx, args...)
+	if err != nil {
+		return err
+	}
+	for len(rows) > 0 {
+		n := len(rows)
+		if chunkSize > 0 && chunkSize < n {
+			n = chunkSize
+		}
+		if err = handle(rows[:n]); err != nil {
+			return err
+		}
+		rows = rows[n:]
+	}
+	return nil
+}
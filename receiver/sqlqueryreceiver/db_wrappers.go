@@ -18,6 +18,9 @@ type rows interface {
 	ColumnTypes() ([]colType, error)
 	Next() bool
 	Scan(dest ...any) error
+	NextResultSet() bool
+	Err() error
+	Close() error
 }
 
 type colType interface {
@@ -57,6 +60,18 @@ func (r rowsWrapper) Scan(dest ...any) error {
 	return r.rows.Scan(dest...)
 }
 
+func (r rowsWrapper) NextResultSet() bool {
+	return r.rows.NextResultSet()
+}
+
+func (r rowsWrapper) Err() error {
+	return r.rows.Err()
+}
+
+func (r rowsWrapper) Close() error {
+	return r.rows.Close()
+}
+
 type colWrapper struct {
 	ct *sql.ColumnType
 }
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// The ts_format values for timestamps stored as a number of seconds, milliseconds,
+// microseconds or nanoseconds since the Unix epoch.
+const (
+	tsFormatUnix   = "unix"
+	tsFormatUnixMs = "unix_ms"
+	tsFormatUnixUs = "unix_us"
+	tsFormatUnixNs = "unix_ns"
+)
+
+var severityNumbers = map[string]plog.SeverityNumber{
+	"trace":    plog.SeverityNumberTrace,
+	"trace2":   plog.SeverityNumberTrace2,
+	"trace3":   plog.SeverityNumberTrace3,
+	"trace4":   plog.SeverityNumberTrace4,
+	"debug":    plog.SeverityNumberDebug,
+	"debug2":   plog.SeverityNumberDebug2,
+	"debug3":   plog.SeverityNumberDebug3,
+	"debug4":   plog.SeverityNumberDebug4,
+	"info":     plog.SeverityNumberInfo,
+	"info2":    plog.SeverityNumberInfo2,
+	"info3":    plog.SeverityNumberInfo3,
+	"info4":    plog.SeverityNumberInfo4,
+	"warn":     plog.SeverityNumberWarn,
+	"warning":  plog.SeverityNumberWarn,
+	"warn2":    plog.SeverityNumberWarn2,
+	"warn3":    plog.SeverityNumberWarn3,
+	"warn4":    plog.SeverityNumberWarn4,
+	"error":    plog.SeverityNumberError,
+	"error2":   plog.SeverityNumberError2,
+	"error3":   plog.SeverityNumberError3,
+	"error4":   plog.SeverityNumberError4,
+	"fatal":    plog.SeverityNumberFatal,
+	"critical": plog.SeverityNumberFatal,
+	"fatal2":   plog.SeverityNumberFatal2,
+	"fatal3":   plog.SeverityNumberFatal3,
+	"fatal4":   plog.SeverityNumberFatal4,
+}
+
+// parseSeverity returns the severity number of a severity name like "info" or "ERROR".
+func parseSeverity(severity string) (plog.SeverityNumber, bool) {
+	number, ok := severityNumbers[strings.ToLower(strings.TrimSpace(severity))]
+	return number, ok
+}
+
+// parseBodyTemplate parses a body_template. Columns missing from a row are rendered as empty strings.
+func parseBodyTemplate(text string) (*template.Template, error) {
+	return template.New("body").Option("missingkey=zero").Parse(text)
+}
+
+// logsConverter converts the rows of a query to log records as configured by a LogsCfg.
+type logsConverter struct {
+	config       LogsCfg
+	bodyTemplate *template.Template
+	severities   map[string]plog.SeverityNumber
+	location     *time.Location
+}
+
+func newLogsConverter(config LogsCfg) (*logsConverter, error) {
+	converter := &logsConverter{
+		config:     config,
+		severities: map[string]plog.SeverityNumber{},
+	}
+	if config.BodyTemplate != "" {
+		bodyTemplate, err := parseBodyTemplate(config.BodyTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse body_template: %w", err)
+		}
+		converter.bodyTemplate = bodyTemplate
+	}
+	for value, severity := range config.SeverityMapping {
+		number, ok := parseSeverity(severity)
+		if !ok {
+			return nil, fmt.Errorf("unsupported severity '%s' for value '%s'", severity, value)
+		}
+		converter.severities[value] = number
+	}
+	location, err := time.LoadLocation(config.TsTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ts_timezone: %w", err)
+	}
+	converter.location = location
+	return converter, nil
+}
+
+func (c *logsConverter) rowToLog(row stringMap, logRecord plog.LogRecord) error {
+	if c.bodyTemplate != nil {
+		var body strings.Builder
+		if err := c.bodyTemplate.Execute(&body, row); err != nil {
+			return fmt.Errorf("rowToLog: failed to render body_template: %w", err)
+		}
+		logRecord.Body().SetStr(body.String())
+	} else {
+		logRecord.Body().SetStr(row[c.config.BodyColumn])
+	}
+
+	if c.config.SeverityColumn != "" {
+		value, found := row[c.config.SeverityColumn]
+		if !found {
+			return fmt.Errorf("rowToLog: severity_column '%s' not found in result set", c.config.SeverityColumn)
+		}
+		logRecord.SetSeverityText(value)
+		if number, ok := c.severities[value]; ok {
+			logRecord.SetSeverityNumber(number)
+		} else if number, ok := parseSeverity(value); ok {
+			logRecord.SetSeverityNumber(number)
+		}
+	}
+
+	if c.config.TsColumn != "" {
+		value, found := row[c.config.TsColumn]
+		if !found {
+			return fmt.Errorf("rowToLog: ts_column '%s' not found in result set", c.config.TsColumn)
+		}
+		ts, err := c.parseTimestamp(value)
+		if err != nil {
+			return fmt.Errorf("rowToLog: failed to parse ts_column '%s', value was %q: %w", c.config.TsColumn, value, err)
+		}
+		logRecord.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	}
+	return nil
+}
+
+// parseTimestamp parses a timestamp according to ts_format, which is either one of the
+// Unix epoch formats or a Go time layout, RFC 3339 by default. Timestamps without a time zone
+// are considered to be in ts_timezone, UTC by default.
+func (c *logsConverter) parseTimestamp(value string) (time.Time, error) {
+	var unit time.Duration
+	switch c.config.TsFormat {
+	case tsFormatUnix:
+		unit = time.Second
+	case tsFormatUnixMs:
+		unit = time.Millisecond
+	case tsFormatUnixUs:
+		unit = time.Microsecond
+	case tsFormatUnixNs:
+		unit = time.Nanosecond
+	case "":
+		return time.ParseInLocation(time.RFC3339, value, c.location)
+	default:
+		return time.ParseInLocation(c.config.TsFormat, value, c.location)
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, n*int64(unit)), nil
+}
@@ -6,7 +6,9 @@ package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -108,7 +110,7 @@ func (receiver *logsReceiver) createQueryReceivers() error {
 			continue
 		}
 		id := fmt.Sprintf("query-%d: %s", i, query.SQL)
-		queryReceiver := newLogsQueryReceiver(
+		queryReceiver, err := newLogsQueryReceiver(
 			id,
 			query,
 			receiver.createConnection,
@@ -116,6 +118,9 @@ func (receiver *logsReceiver) createQueryReceivers() error {
 			receiver.settings.Logger,
 			receiver.storageClient,
 		)
+		if err != nil {
+			return err
+		}
 		receiver.queryReceivers = append(receiver.queryReceivers, queryReceiver)
 	}
 	return nil
@@ -137,32 +142,33 @@ func (receiver *logsReceiver) startCollecting() {
 }
 
 func (receiver *logsReceiver) collect() {
-	logsChannel := make(chan plog.Logs)
+	var wg sync.WaitGroup
 	for _, queryReceiver := range receiver.queryReceivers {
+		wg.Add(1)
 		go func(queryReceiver *logsQueryReceiver) {
-			logs, err := queryReceiver.collect(context.Background())
+			defer wg.Done()
+			err := queryReceiver.collect(context.Background(), receiver.consumeLogs)
 			if err != nil {
 				receiver.settings.Logger.Error("error collecting logs", zap.Error(err), zap.String("query", queryReceiver.ID()))
 			}
-			logsChannel <- logs
 		}(queryReceiver)
 	}
+	wg.Wait()
+}
 
-	allLogs := plog.NewLogs()
-	for range receiver.queryReceivers {
-		logs := <-logsChannel
-		logs.ResourceLogs().MoveAndAppendTo(allLogs.ResourceLogs())
+// consumeLogs sends the logs collected from a chunk of rows to the next consumer.
+func (receiver *logsReceiver) consumeLogs(ctx context.Context, logs plog.Logs) error {
+	logRecordCount := logs.LogRecordCount()
+	if logRecordCount == 0 {
+		return nil
 	}
-
-	logRecordCount := allLogs.LogRecordCount()
-	if logRecordCount > 0 {
-		ctx := receiver.obsrecv.StartLogsOp(context.Background())
-		err := receiver.nextConsumer.ConsumeLogs(context.Background(), allLogs)
-		receiver.obsrecv.EndLogsOp(ctx, metadata.Type, logRecordCount, err)
-		if err != nil {
-			receiver.settings.Logger.Error("failed to send logs: %w", zap.Error(err))
-		}
+	obsCtx := receiver.obsrecv.StartLogsOp(ctx)
+	err := receiver.nextConsumer.ConsumeLogs(ctx, logs)
+	receiver.obsrecv.EndLogsOp(obsCtx, metadata.Type, logRecordCount, err)
+	if err != nil {
+		return fmt.Errorf("failed to send logs: %w", err)
 	}
+	return nil
 }
 
 func (receiver *logsReceiver) Shutdown(ctx context.Context) error {
@@ -177,15 +183,15 @@ func (receiver *logsReceiver) Shutdown(ctx context.Context) error {
 		queryReceiver.shutdown(ctx)
 	}
 
-	var errors error
+	var errs error
 	if receiver.storageClient != nil {
-		errors = multierr.Append(errors, receiver.storageClient.Close(ctx))
+		errs = multierr.Append(errs, receiver.storageClient.Close(ctx))
 	}
 
 	receiver.isStarted = false
 	receiver.settings.Logger.Debug("stopped.")
 
-	return errors
+	return errs
 }
 
 func (receiver *logsReceiver) stopCollecting() {
@@ -204,6 +210,7 @@ type logsQueryReceiver struct {
 
 	db            *sql.DB
 	client        dbClient
+	converters    []*logsConverter
 	trackingValue string
 	// TODO: Extract persistence into its own component
 	storageClient           storage.Client
@@ -217,7 +224,7 @@ func newLogsQueryReceiver(
 	clientProviderFunc clientProviderFunc,
 	logger *zap.Logger,
 	storageClient storage.Client,
-) *logsQueryReceiver {
+) (*logsQueryReceiver, error) {
 	queryReceiver := &logsQueryReceiver{
 		id:            id,
 		query:         query,
@@ -228,7 +235,14 @@ func newLogsQueryReceiver(
 	}
 	queryReceiver.trackingValue = queryReceiver.query.TrackingStartValue
 	queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValue")
-	return queryReceiver
+	for _, logsConfig := range query.Logs {
+		converter, err := newLogsConverter(logsConfig)
+		if err != nil {
+			return nil, err
+		}
+		queryReceiver.converters = append(queryReceiver.converters, converter)
+	}
+	return queryReceiver, nil
 }
 
 func (queryReceiver *logsQueryReceiver) ID() string {
@@ -265,33 +279,49 @@ func (queryReceiver *logsQueryReceiver) retrieveTrackingValue(ctx context.Contex
 
 }
 
-func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs, error) {
-	logs := plog.NewLogs()
-
-	var rows []stringMap
-	var err error
+// collect runs the query and sends the resulting logs to consume, one chunk of rows at a time.
+// The tracking value is only advanced, and persisted, once the logs of a chunk are consumed,
+// so that the rows of a chunk which failed to be sent are read again on the next collection.
+func (queryReceiver *logsQueryReceiver) collect(ctx context.Context, consume func(context.Context, plog.Logs) error) error {
+	var args []any
 	if queryReceiver.query.TrackingColumn != "" {
-		rows, err = queryReceiver.client.queryRows(ctx, queryReceiver.trackingValue)
-	} else {
-		rows, err = queryReceiver.client.queryRows(ctx)
-	}
-	if err != nil {
-		return logs, fmt.Errorf("error getting rows: %w", err)
+		args = append(args, queryReceiver.trackingValue)
 	}
 
-	var errs error
-	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
-	for logsConfigIndex, logsConfig := range queryReceiver.query.Logs {
-		for _, row := range rows {
-			rowToLog(row, logsConfig, scopeLogs.AppendEmpty())
-			if logsConfigIndex == 0 {
-				errs = multierr.Append(errs, queryReceiver.storeTrackingValue(ctx, row))
+	err := queryReceiver.client.streamRows(ctx, queryReceiver.query.ChunkSize, func(rows []stringMap) error {
+		logs := plog.NewLogs()
+		logRecords := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		var errs error
+		for _, converter := range queryReceiver.converters {
+			for _, row := range rows {
+				logRecord := plog.NewLogRecord()
+				if err := converter.rowToLog(row, logRecord); err != nil {
+					errs = multierr.Append(errs, err)
+					continue
+				}
+				logRecord.MoveTo(logRecords.AppendEmpty())
 			}
 		}
+		if errs != nil {
+			queryReceiver.logger.Warn("problems encountered converting rows to logs", zap.Error(errs))
+		}
+		if err := consume(ctx, logs); err != nil {
+			return err
+		}
+		return queryReceiver.storeTrackingValue(ctx, rows[len(rows)-1])
+	}, args...)
+	if err != nil {
+		if errors.Is(err, errNullValueWarning) {
+			queryReceiver.logger.Warn("problems encountered getting log rows", zap.Error(err))
+			return nil
+		}
+		return fmt.Errorf("error getting rows: %w", err)
 	}
-	return logs, nil
+	return nil
 }
 
+// storeTrackingValue advances the tracking value to the one of row, the last row consumed,
+// and persists it if storage is configured.
 func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context, row stringMap) error {
 	if queryReceiver.query.TrackingColumn == "" {
 		return nil
@@ -306,9 +336,5 @@ func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context,
 	return nil
 }
 
-func rowToLog(row stringMap, config LogsCfg, logRecord plog.LogRecord) {
-	logRecord.Body().SetStr(row[config.BodyColumn])
-}
-
 func (queryReceiver *logsQueryReceiver) shutdown(_ context.Context) {
 }
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+func newTestLogsQueryReceiver(t *testing.T, query Query, client dbClient) *logsQueryReceiver {
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID("sqlquery"), "")
+	queryReceiver, err := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), storageClient)
+	require.NoError(t, err)
+	queryReceiver.client = client
+	queryReceiver.trackingValue = queryReceiver.retrieveTrackingValue(context.Background())
+	t.Cleanup(func() { require.NoError(t, storageClient.Close(context.Background())) })
+	return queryReceiver
+}
+
+func TestLogsQueryReceiver_CollectInChunks(t *testing.T) {
+	queryReceiver := newTestLogsQueryReceiver(t, Query{
+		SQL:                "select * from logs where id > ?",
+		TrackingColumn:     "id",
+		TrackingStartValue: "0",
+		ChunkSize:          2,
+		Logs:               []LogsCfg{{BodyColumn: "body"}},
+	}, &fakeDBClient{stringMaps: [][]stringMap{{
+		{"id": "1", "body": "one"},
+		{"id": "2", "body": "two"},
+		{"id": "3", "body": "three"},
+	}}})
+
+	var consumed []plog.Logs
+	err := queryReceiver.collect(context.Background(), func(_ context.Context, logs plog.Logs) error {
+		consumed = append(consumed, logs)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, consumed, 2)
+	assert.Equal(t, 2, consumed[0].LogRecordCount())
+	assert.Equal(t, 1, consumed[1].LogRecordCount())
+	assert.Equal(t, "three", consumed[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+
+	assert.Equal(t, "3", queryReceiver.trackingValue)
+	stored, err := queryReceiver.storageClient.Get(context.Background(), queryReceiver.trackingValueStorageKey)
+	require.NoError(t, err)
+	assert.Equal(t, "3", string(stored))
+}
+
+func TestLogsQueryReceiver_TrackingValueNotAdvancedOnConsumeError(t *testing.T) {
+	queryReceiver := newTestLogsQueryReceiver(t, Query{
+		SQL:                "select * from logs where id > ?",
+		TrackingColumn:     "id",
+		TrackingStartValue: "0",
+		ChunkSize:          2,
+		Logs:               []LogsCfg{{BodyColumn: "body"}},
+	}, &fakeDBClient{stringMaps: [][]stringMap{{
+		{"id": "1", "body": "one"},
+		{"id": "2", "body": "two"},
+		{"id": "3", "body": "three"},
+	}}})
+
+	chunks := 0
+	err := queryReceiver.collect(context.Background(), func(context.Context, plog.Logs) error {
+		chunks++
+		if chunks == 2 {
+			return errors.New("consumer failure")
+		}
+		return nil
+	})
+	assert.ErrorContains(t, err, "consumer failure")
+
+	// only the first chunk was consumed
+	assert.Equal(t, "2", queryReceiver.trackingValue)
+	stored, err := queryReceiver.storageClient.Get(context.Background(), queryReceiver.trackingValueStorageKey)
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(stored))
+}
+
+func TestLogsQueryReceiver_RetrieveStoredTrackingValue(t *testing.T) {
+	queryReceiver := newTestLogsQueryReceiver(t, Query{
+		TrackingColumn:     "id",
+		TrackingStartValue: "0",
+	}, nil)
+	assert.Equal(t, "0", queryReceiver.retrieveTrackingValue(context.Background()))
+
+	require.NoError(t, queryReceiver.storageClient.Set(context.Background(), queryReceiver.trackingValueStorageKey, []byte("42")))
+	assert.Equal(t, "42", queryReceiver.retrieveTrackingValue(context.Background()))
+}
+
+func TestLogsQueryReceiver_SkipsRowsFailingConversion(t *testing.T) {
+	queryReceiver := newTestLogsQueryReceiver(t, Query{
+		SQL:  "select * from logs",
+		Logs: []LogsCfg{{BodyColumn: "body", TsColumn: "ts", TsFormat: "unix"}},
+	}, &fakeDBClient{stringMaps: [][]stringMap{{
+		{"body": "one", "ts": "1689935400"},
+		{"body": "two", "ts": "not a timestamp"},
+	}}})
+
+	var consumed []plog.Logs
+	err := queryReceiver.collect(context.Background(), func(_ context.Context, logs plog.Logs) error {
+		consumed = append(consumed, logs)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, consumed, 1)
+	require.Equal(t, 1, consumed[0].LogRecordCount())
+	assert.Equal(t, "one", consumed[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func rowToLog(t *testing.T, config LogsCfg, row stringMap) (plog.LogRecord, error) {
+	converter, err := newLogsConverter(config)
+	require.NoError(t, err)
+	logRecord := plog.NewLogRecord()
+	return logRecord, converter.rowToLog(row, logRecord)
+}
+
+func TestRowToLog_BodyColumn(t *testing.T) {
+	logRecord, err := rowToLog(t, LogsCfg{BodyColumn: "body"}, stringMap{"body": "some log"})
+	require.NoError(t, err)
+	assert.Equal(t, "some log", logRecord.Body().Str())
+	assert.Equal(t, plog.SeverityNumberUnspecified, logRecord.SeverityNumber())
+	assert.Equal(t, pcommon.Timestamp(0), logRecord.Timestamp())
+}
+
+func TestRowToLog_BodyTemplate(t *testing.T) {
+	config := LogsCfg{BodyTemplate: `{{ .user }} logged in from {{ index . "remote-addr" }}{{ .missing }}`}
+	logRecord, err := rowToLog(t, config, stringMap{"user": "alice", "remote-addr": "10.0.0.1"})
+	require.NoError(t, err)
+	assert.Equal(t, "alice logged in from 10.0.0.1", logRecord.Body().Str())
+}
+
+func TestRowToLog_Severity(t *testing.T) {
+	config := LogsCfg{
+		BodyColumn:      "body",
+		SeverityColumn:  "level",
+		SeverityMapping: map[string]string{"E": "error", "3": "warn"},
+	}
+	tests := []struct {
+		level          string
+		severityNumber plog.SeverityNumber
+	}{
+		{level: "E", severityNumber: plog.SeverityNumberError},
+		{level: "3", severityNumber: plog.SeverityNumberWarn},
+		{level: "INFO", severityNumber: plog.SeverityNumberInfo},
+		{level: "Warning", severityNumber: plog.SeverityNumberWarn},
+		{level: "debug2", severityNumber: plog.SeverityNumberDebug2},
+		{level: "unknown", severityNumber: plog.SeverityNumberUnspecified},
+	}
+	for _, tt := range tests {
+		t.Run(tt.level, func(t *testing.T) {
+			logRecord, err := rowToLog(t, config, stringMap{"body": "some log", "level": tt.level})
+			require.NoError(t, err)
+			assert.Equal(t, tt.level, logRecord.SeverityText())
+			assert.Equal(t, tt.severityNumber, logRecord.SeverityNumber())
+		})
+	}
+
+	_, err := rowToLog(t, config, stringMap{"body": "some log"})
+	assert.EqualError(t, err, "rowToLog: severity_column 'level' not found in result set")
+}
+
+func TestRowToLog_Timestamp(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	tests := []struct {
+		name     string
+		config   LogsCfg
+		value    string
+		expected time.Time
+	}{
+		{
+			name:     "rfc3339",
+			config:   LogsCfg{TsColumn: "ts"},
+			value:    "2023-07-21T10:30:00.123+02:00",
+			expected: time.Date(2023, 7, 21, 8, 30, 0, 123000000, time.UTC),
+		},
+		{
+			name:     "layout_in_utc",
+			config:   LogsCfg{TsColumn: "ts", TsFormat: "2006-01-02 15:04:05"},
+			value:    "2023-07-21 10:30:00",
+			expected: time.Date(2023, 7, 21, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "layout_in_timezone",
+			config:   LogsCfg{TsColumn: "ts", TsFormat: "2006-01-02 15:04:05", TsTimezone: "Europe/Paris"},
+			value:    "2023-07-21 10:30:00",
+			expected: time.Date(2023, 7, 21, 10, 30, 0, 0, paris),
+		},
+		{
+			name:     "layout_with_offset_ignores_timezone",
+			config:   LogsCfg{TsColumn: "ts", TsFormat: "2006-01-02 15:04:05-07:00", TsTimezone: "Europe/Paris"},
+			value:    "2023-07-21 10:30:00+00:00",
+			expected: time.Date(2023, 7, 21, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "unix",
+			config:   LogsCfg{TsColumn: "ts", TsFormat: "unix"},
+			value:    "1689935400",
+			expected: time.Date(2023, 7, 21, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "unix_ms",
+			config:   LogsCfg{TsColumn: "ts", TsFormat: "unix_ms"},
+			value:    "1689935400123",
+			expected: time.Date(2023, 7, 21, 10, 30, 0, 123000000, time.UTC),
+		},
+		{
+			name:     "unix_us",
+			config:   LogsCfg{TsColumn: "ts", TsFormat: "unix_us"},
+			value:    "1689935400123456",
+			expected: time.Date(2023, 7, 21, 10, 30, 0, 123456000, time.UTC),
+		},
+		{
+			name:     "unix_ns",
+			config:   LogsCfg{TsColumn: "ts", TsFormat: "unix_ns"},
+			value:    "1689935400123456789",
+			expected: time.Date(2023, 7, 21, 10, 30, 0, 123456789, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.BodyColumn = "body"
+			logRecord, err := rowToLog(t, tt.config, stringMap{"body": "some log", "ts": tt.value})
+			require.NoError(t, err)
+			assert.Equal(t, pcommon.NewTimestampFromTime(tt.expected), logRecord.Timestamp())
+		})
+	}
+}
+
+func TestRowToLog_TimestampErrors(t *testing.T) {
+	_, err := rowToLog(t, LogsCfg{BodyColumn: "body", TsColumn: "ts"}, stringMap{"body": "some log"})
+	assert.EqualError(t, err, "rowToLog: ts_column 'ts' not found in result set")
+
+	_, err = rowToLog(t, LogsCfg{BodyColumn: "body", TsColumn: "ts", TsFormat: "unix"}, stringMap{"body": "some log", "ts": "yesterday"})
+	assert.ErrorContains(t, err, `rowToLog: failed to parse ts_column 'ts', value was "yesterday"`)
+}
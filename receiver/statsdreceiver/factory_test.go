@@ -8,10 +8,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 type testHost struct {
@@ -54,3 +57,37 @@ func TestCreateMetricsReceiverWithNilConsumer(t *testing.T) {
 	assert.Error(t, err, "nil consumer")
 	assert.Nil(t, receiver)
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.NetAddr.Endpoint = "localhost:0"
+
+	params := receivertest.NewNopCreateSettings()
+	metricsConsumer := consumertest.NewNop()
+	logsConsumer := consumertest.NewNop()
+	mReceiver, err := createMetricsReceiver(context.Background(), params, cfg, metricsConsumer)
+	require.NoError(t, err)
+	lReceiver, err := createLogsReceiver(context.Background(), params, cfg, logsConsumer)
+	require.NoError(t, err)
+
+	// The metrics and logs receivers share the same endpoint
+	assert.Same(t, mReceiver, lReceiver)
+	r := lReceiver.(*sharedcomponent.SharedComponent).Unwrap().(*statsdReceiver)
+	assert.Equal(t, metricsConsumer, r.nextConsumer)
+	assert.Equal(t, logsConsumer, r.nextLogsConsumer)
+
+	require.NoError(t, lReceiver.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, lReceiver.Shutdown(context.Background()))
+}
+
+func TestCreateLogsReceiverWithNilConsumer(t *testing.T) {
+	receiver, err := createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		createDefaultConfig(),
+		nil,
+	)
+
+	assert.Error(t, err, "nil consumer")
+	assert.Nil(t, receiver)
+}
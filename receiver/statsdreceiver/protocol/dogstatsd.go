@@ -0,0 +1,228 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/protocol"
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	eventPrefix                   = "_e{"
+	serviceCheckPrefix            = "_sc|"
+	serviceCheckMessageFieldStart = "m:"
+	serviceCheckStatusUnknown     = 3
+	defaultEventAlertType         = "info"
+
+	typeEvent        = "event"
+	typeServiceCheck = "service_check"
+
+	attributeType                = "dogstatsd.type"
+	attributeEventTitle          = "dogstatsd.event.title"
+	attributeEventPriority       = "dogstatsd.event.priority"
+	attributeEventAlertType      = "dogstatsd.event.alert_type"
+	attributeEventAggregationKey = "dogstatsd.event.aggregation_key"
+	attributeEventSourceTypeName = "dogstatsd.event.source_type_name"
+	attributeServiceCheckName    = "dogstatsd.service_check.name"
+	attributeServiceCheckStatus  = "dogstatsd.service_check.status"
+	attributeHostName            = "host.name"
+)
+
+// serviceCheckStatuses are the names of the statuses of the service checks, indexed by their value.
+var serviceCheckStatuses = []struct {
+	text     string
+	severity plog.SeverityNumber
+}{
+	{"OK", plog.SeverityNumberInfo},
+	{"WARNING", plog.SeverityNumberWarn},
+	{"CRITICAL", plog.SeverityNumberError},
+	{"UNKNOWN", plog.SeverityNumberUnspecified},
+}
+
+// GetLogs gets the log records of the DogStatsD events and service checks preparing for flushing and reset them.
+func (p *StatsDParser) GetLogs() []BatchLogs {
+	batchLogs := make([]BatchLogs, 0, len(p.logsByAddress))
+	for _, batch := range p.logsByAddress {
+		batchLogs = append(batchLogs, batch)
+	}
+	p.logsByAddress = make(map[netAddr]BatchLogs)
+	return batchLogs
+}
+
+func (p *StatsDParser) aggregateLogRecord(line string, addr net.Addr, parse func(string, plog.LogRecord) error) error {
+	lr := plog.NewLogRecord()
+	if err := parse(line, lr); err != nil {
+		return err
+	}
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(timeNowFunc()))
+
+	addrKey := newNetAddr(addr)
+	batch, ok := p.logsByAddress[addrKey]
+	if !ok {
+		batch = BatchLogs{
+			Info: client.Info{
+				Addr: addr,
+			},
+			Logs: plog.NewLogs(),
+		}
+		sl := batch.Logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+		p.setVersionAndNameScope(sl.Scope())
+		p.logsByAddress[addrKey] = batch
+	}
+	lr.MoveTo(batch.Logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().AppendEmpty())
+	return nil
+}
+
+// parseEvent parses a DogStatsD event, whose format is
+// _e{<title length>,<text length>}:<title>|<text>|d:<timestamp>|h:<hostname>|p:<priority>|t:<alert type>|k:<aggregation key>|s:<source type name>|#<tags>
+func parseEvent(line string, lr plog.LogRecord) error {
+	header, rest, found := strings.Cut(strings.TrimPrefix(line, eventPrefix), "}:")
+	if !found {
+		return fmt.Errorf("invalid event format: %s", line)
+	}
+	lengths := strings.Split(header, ",")
+	if len(lengths) != 2 {
+		return fmt.Errorf("invalid event lengths: %s", header)
+	}
+	titleLength, err := strconv.Atoi(lengths[0])
+	if err != nil || titleLength <= 0 {
+		return fmt.Errorf("invalid event title length: %s", lengths[0])
+	}
+	textLength, err := strconv.Atoi(lengths[1])
+	if err != nil || textLength < 0 {
+		return fmt.Errorf("invalid event text length: %s", lengths[1])
+	}
+	if titleLength+1+textLength > len(rest) || rest[titleLength] != '|' {
+		return fmt.Errorf("event title and text do not match their lengths: %s", line)
+	}
+
+	attrs := lr.Attributes()
+	attrs.PutStr(attributeType, typeEvent)
+	attrs.PutStr(attributeEventTitle, unescapeNewLines(rest[:titleLength]))
+	lr.Body().SetStr(unescapeNewLines(rest[titleLength+1 : titleLength+1+textLength]))
+	setEventSeverity(lr, defaultEventAlertType)
+
+	fields := rest[titleLength+1+textLength:]
+	if fields == "" {
+		return nil
+	}
+	if fields[0] != '|' {
+		return fmt.Errorf("event title and text do not match their lengths: %s", line)
+	}
+	for _, field := range strings.Split(fields[1:], "|") {
+		switch {
+		case strings.HasPrefix(field, "d:"):
+			if err := setTimestamp(lr, strings.TrimPrefix(field, "d:")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(field, "h:"):
+			attrs.PutStr(attributeHostName, strings.TrimPrefix(field, "h:"))
+		case strings.HasPrefix(field, "p:"):
+			attrs.PutStr(attributeEventPriority, strings.TrimPrefix(field, "p:"))
+		case strings.HasPrefix(field, "t:"):
+			alertType := strings.TrimPrefix(field, "t:")
+			attrs.PutStr(attributeEventAlertType, alertType)
+			setEventSeverity(lr, alertType)
+		case strings.HasPrefix(field, "k:"):
+			attrs.PutStr(attributeEventAggregationKey, strings.TrimPrefix(field, "k:"))
+		case strings.HasPrefix(field, "s:"):
+			attrs.PutStr(attributeEventSourceTypeName, strings.TrimPrefix(field, "s:"))
+		case strings.HasPrefix(field, "#"):
+			putTags(attrs, strings.TrimPrefix(field, "#"))
+		default:
+			return fmt.Errorf("unrecognized event part: %s", field)
+		}
+	}
+	return nil
+}
+
+// parseServiceCheck parses a DogStatsD service check, whose format is
+// _sc|<name>|<status>|d:<timestamp>|h:<hostname>|#<tags>|m:<message>
+func parseServiceCheck(line string, lr plog.LogRecord) error {
+	parts := strings.Split(line, "|")
+	if len(parts) < 3 {
+		return fmt.Errorf("invalid service check format: %s", line)
+	}
+	name := parts[1]
+	if name == "" {
+		return fmt.Errorf("empty service check name: %s", line)
+	}
+	status, err := strconv.Atoi(parts[2])
+	if err != nil || status < 0 || status > serviceCheckStatusUnknown {
+		return fmt.Errorf("invalid service check status: %s", parts[2])
+	}
+
+	attrs := lr.Attributes()
+	attrs.PutStr(attributeType, typeServiceCheck)
+	attrs.PutStr(attributeServiceCheckName, name)
+	attrs.PutInt(attributeServiceCheckStatus, int64(status))
+	lr.SetSeverityText(serviceCheckStatuses[status].text)
+	lr.SetSeverityNumber(serviceCheckStatuses[status].severity)
+
+	for i, field := range parts[3:] {
+		switch {
+		case strings.HasPrefix(field, "d:"):
+			if err := setTimestamp(lr, strings.TrimPrefix(field, "d:")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(field, "h:"):
+			attrs.PutStr(attributeHostName, strings.TrimPrefix(field, "h:"))
+		case strings.HasPrefix(field, "#"):
+			putTags(attrs, strings.TrimPrefix(field, "#"))
+		case strings.HasPrefix(field, serviceCheckMessageFieldStart):
+			// The message is the last field, and can contain the separator
+			message := strings.TrimPrefix(strings.Join(parts[3+i:], "|"), serviceCheckMessageFieldStart)
+			message = strings.ReplaceAll(unescapeNewLines(message), `m\:`, serviceCheckMessageFieldStart)
+			lr.Body().SetStr(message)
+			return nil
+		default:
+			return fmt.Errorf("unrecognized service check part: %s", field)
+		}
+	}
+	return nil
+}
+
+// setEventSeverity sets the severity of an event from its alert type: error, warning, info or success.
+func setEventSeverity(lr plog.LogRecord, alertType string) {
+	lr.SetSeverityText(alertType)
+	switch alertType {
+	case "error":
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+	case "warning":
+		lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	default:
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	}
+}
+
+func setTimestamp(lr plog.LogRecord, value string) error {
+	secs, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse timestamp: %s", value)
+	}
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(secs, 0)))
+	return nil
+}
+
+// putTags adds the tags to the attributes. Unlike the tags of the metrics,
+// the tags of the events and service checks don't need to have a value.
+func putTags(attrs pcommon.Map, tags string) {
+	for _, tag := range strings.Split(tags, ",") {
+		k, v, _ := strings.Cut(tag, ":")
+		if k != "" {
+			attrs.PutStr(k, v)
+		}
+	}
+}
+
+func unescapeNewLines(s string) string {
+	return strings.ReplaceAll(s, `\n`, "\n")
+}
@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func Test_ParseEvent(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantBody     string
+		wantSeverity plog.SeverityNumber
+		wantTime     pcommon.Timestamp
+		wantAttrs    map[string]any
+		err          error
+	}{
+		{
+			name:         "title and text",
+			input:        "_e{5,4}:title|text",
+			wantBody:     "text",
+			wantSeverity: plog.SeverityNumberInfo,
+			wantAttrs: map[string]any{
+				"dogstatsd.type":        "event",
+				"dogstatsd.event.title": "title",
+			},
+		},
+		{
+			name:         "all fields",
+			input:        `_e{9,14}:the title|line\nline two|d:1690000000|h:myhost|p:low|t:error|k:key|s:source|#env:prod,canary`,
+			wantBody:     "line\nline two",
+			wantSeverity: plog.SeverityNumberError,
+			wantTime:     pcommon.NewTimestampFromTime(time.Unix(1690000000, 0)),
+			wantAttrs: map[string]any{
+				"dogstatsd.type":                   "event",
+				"dogstatsd.event.title":            "the title",
+				"host.name":                        "myhost",
+				"dogstatsd.event.priority":         "low",
+				"dogstatsd.event.alert_type":       "error",
+				"dogstatsd.event.aggregation_key":  "key",
+				"dogstatsd.event.source_type_name": "source",
+				"env":                              "prod",
+				"canary":                           "",
+			},
+		},
+		{
+			name:         "text containing the separator",
+			input:        "_e{5,5}:title|a|b|c|t:warning",
+			wantBody:     "a|b|c",
+			wantSeverity: plog.SeverityNumberWarn,
+			wantAttrs: map[string]any{
+				"dogstatsd.type":             "event",
+				"dogstatsd.event.title":      "title",
+				"dogstatsd.event.alert_type": "warning",
+			},
+		},
+		{
+			name:  "missing lengths",
+			input: "_e{5}:title|text",
+			err:   errors.New("invalid event lengths: 5"),
+		},
+		{
+			name:  "empty title",
+			input: "_e{0,4}:|text",
+			err:   errors.New("invalid event title length: 0"),
+		},
+		{
+			name:  "lengths too long",
+			input: "_e{5,10}:title|text",
+			err:   errors.New("event title and text do not match their lengths: _e{5,10}:title|text"),
+		},
+		{
+			name:  "lengths too short",
+			input: "_e{5,2}:title|text",
+			err:   errors.New("event title and text do not match their lengths: _e{5,2}:title|text"),
+		},
+		{
+			name:  "invalid timestamp",
+			input: "_e{5,4}:title|text|d:now",
+			err:   errors.New("parse timestamp: now"),
+		},
+		{
+			name:  "unrecognized field",
+			input: "_e{5,4}:title|text|x:y",
+			err:   errors.New("unrecognized event part: x:y"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			err := parseEvent(tt.input, lr)
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBody, lr.Body().Str())
+			assert.Equal(t, tt.wantSeverity, lr.SeverityNumber())
+			assert.Equal(t, tt.wantTime, lr.Timestamp())
+			assert.Equal(t, tt.wantAttrs, lr.Attributes().AsRaw())
+		})
+	}
+}
+
+func Test_ParseServiceCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantBody     string
+		wantSeverity plog.SeverityNumber
+		wantText     string
+		wantAttrs    map[string]any
+		err          error
+	}{
+		{
+			name:         "name and status",
+			input:        "_sc|my.check|0",
+			wantSeverity: plog.SeverityNumberInfo,
+			wantText:     "OK",
+			wantAttrs: map[string]any{
+				"dogstatsd.type":                 "service_check",
+				"dogstatsd.service_check.name":   "my.check",
+				"dogstatsd.service_check.status": int64(0),
+			},
+		},
+		{
+			name:         "all fields",
+			input:        `_sc|my.check|2|d:1690000000|h:myhost|#env:prod|m:down|unreachable\nm\:retrying`,
+			wantBody:     "down|unreachable\nm:retrying",
+			wantSeverity: plog.SeverityNumberError,
+			wantText:     "CRITICAL",
+			wantAttrs: map[string]any{
+				"dogstatsd.type":                 "service_check",
+				"dogstatsd.service_check.name":   "my.check",
+				"dogstatsd.service_check.status": int64(2),
+				"host.name":                      "myhost",
+				"env":                            "prod",
+			},
+		},
+		{
+			name:  "missing status",
+			input: "_sc|my.check",
+			err:   errors.New("invalid service check format: _sc|my.check"),
+		},
+		{
+			name:  "empty name",
+			input: "_sc||0",
+			err:   errors.New("empty service check name: _sc||0"),
+		},
+		{
+			name:  "invalid status",
+			input: "_sc|my.check|4",
+			err:   errors.New("invalid service check status: 4"),
+		},
+		{
+			name:  "unrecognized field",
+			input: "_sc|my.check|1|x:y",
+			err:   errors.New("unrecognized service check part: x:y"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			err := parseServiceCheck(tt.input, lr)
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBody, lr.Body().AsString())
+			assert.Equal(t, tt.wantSeverity, lr.SeverityNumber())
+			assert.Equal(t, tt.wantText, lr.SeverityText())
+			assert.Equal(t, tt.wantAttrs, lr.Attributes().AsRaw())
+		})
+	}
+}
+
+func TestStatsDParser_AggregateLogs(t *testing.T) {
+	p := &StatsDParser{}
+	require.NoError(t, p.Initialize(false, false, nil))
+
+	addr1, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+	addr2, _ := net.ResolveUDPAddr("udp", "5.6.7.8:5678")
+	require.NoError(t, p.Aggregate("_e{5,4}:title|text", addr1))
+	require.NoError(t, p.Aggregate("_sc|my.check|1", addr1))
+	require.NoError(t, p.Aggregate("_sc|my.check|0", addr2))
+	assert.Error(t, p.Aggregate("_sc|my.check", addr2))
+
+	// The events and service checks are not metrics
+	assert.Len(t, p.GetMetrics(), 0)
+
+	batches := p.GetLogs()
+	require.Len(t, batches, 2)
+	counts := map[string]int{}
+	for _, batch := range batches {
+		counts[batch.Info.Addr.String()] = batch.Logs.LogRecordCount()
+		scope := batch.Logs.ResourceLogs().At(0).ScopeLogs().At(0).Scope()
+		assert.Equal(t, receiverName, scope.Name())
+	}
+	assert.Equal(t, map[string]int{"1.2.3.4:5678": 2, "5.6.7.8:5678": 1}, counts)
+
+	assert.Len(t, p.GetLogs(), 0)
+}
+
+func TestStatsDParser_AggregateDistribution(t *testing.T) {
+	p := &StatsDParser{}
+	require.NoError(t, p.Initialize(false, false, []TimerHistogramMapping{
+		{StatsdType: "distribution", ObserverType: "histogram"},
+	}))
+
+	addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+	require.NoError(t, p.Aggregate("request.duration:10|d|#env:prod", addr))
+	require.NoError(t, p.Aggregate("request.duration:20|d|#env:prod", addr))
+	require.NoError(t, p.Aggregate("request.duration:30|h|#env:prod", addr))
+
+	metrics := p.GetMetrics()[0].Metrics
+	require.Equal(t, 1, metrics.MetricCount())
+	m := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "request.duration", m.Name())
+	require.Equal(t, pmetric.MetricTypeExponentialHistogram, m.Type())
+	dp := m.ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, uint64(2), dp.Count())
+	assert.Equal(t, 30.0, dp.Sum())
+}
@@ -14,25 +14,32 @@ import (
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/protocol"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/transport"
 )
 
 var _ receiver.Metrics = (*statsdReceiver)(nil)
+var _ receiver.Logs = (*statsdReceiver)(nil)
 
-// statsdReceiver implements the receiver.Metrics for StatsD protocol.
+// statsdReceiver implements the receiver.Metrics for StatsD protocol, and the
+// receiver.Logs for the events and service checks of the DogStatsD protocol.
 type statsdReceiver struct {
 	settings receiver.CreateSettings
 	config   *Config
 
-	server       transport.Server
-	reporter     transport.Reporter
-	parser       protocol.Parser
-	nextConsumer consumer.Metrics
-	cancel       context.CancelFunc
+	server           transport.Server
+	reporter         transport.Reporter
+	obsrecv          *obsreport.Receiver
+	parser           protocol.Parser
+	nextConsumer     consumer.Metrics
+	nextLogsConsumer consumer.Logs
+	cancel           context.CancelFunc
 }
 
 // New creates the StatsD receiver with the given parameters.
@@ -45,6 +52,17 @@ func New(
 		return nil, component.ErrNilNextConsumer
 	}
 
+	r, err := newReceiver(set, config)
+	if err != nil {
+		return nil, err
+	}
+	r.nextConsumer = nextConsumer
+	return r, nil
+}
+
+// newReceiver creates the StatsD receiver without next consumers, they are
+// set once the receiver is created for the metrics or logs pipelines.
+func newReceiver(set receiver.CreateSettings, config Config) (*statsdReceiver, error) {
 	if config.NetAddr.Endpoint == "" {
 		config.NetAddr.Endpoint = "localhost:8125"
 	}
@@ -54,11 +72,20 @@ func New(
 		return nil, err
 	}
 
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             set.ID,
+		Transport:              "udp",
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	r := &statsdReceiver{
-		settings:     set,
-		config:       &config,
-		nextConsumer: nextConsumer,
-		reporter:     rep,
+		settings: set,
+		config:   &config,
+		reporter: rep,
+		obsrecv:  obsrecv,
 		parser: &protocol.StatsDParser{
 			BuildInfo: set.BuildInfo,
 		},
@@ -95,7 +122,7 @@ func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 		return err
 	}
 	go func() {
-		if err := r.server.ListenAndServe(r.parser, r.nextConsumer, r.reporter, transferChan); err != nil {
+		if err := r.server.ListenAndServe(r.parser, r.reporter, transferChan); err != nil {
 			if !errors.Is(err, net.ErrClosed) {
 				host.ReportFatalError(err)
 			}
@@ -106,9 +133,18 @@ func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 			select {
 			case <-ticker.C:
 				batchMetrics := r.parser.GetMetrics()
-				for _, batch := range batchMetrics {
-					batchCtx := client.NewContext(ctx, batch.Info)
-					r.Flush(batchCtx, batch.Metrics, r.nextConsumer)
+				if r.nextConsumer != nil {
+					for _, batch := range batchMetrics {
+						batchCtx := client.NewContext(ctx, batch.Info)
+						r.Flush(batchCtx, batch.Metrics, r.nextConsumer)
+					}
+				}
+				batchLogs := r.parser.GetLogs()
+				if r.nextLogsConsumer != nil {
+					for _, batch := range batchLogs {
+						batchCtx := client.NewContext(ctx, batch.Info)
+						r.consumeLogs(batchCtx, batch.Logs)
+					}
 				}
 			case metric := <-transferChan:
 				_ = r.parser.Aggregate(metric.Raw, metric.Addr)
@@ -135,3 +171,15 @@ func (r *statsdReceiver) Shutdown(context.Context) error {
 func (r *statsdReceiver) Flush(ctx context.Context, metrics pmetric.Metrics, nextConsumer consumer.Metrics) error {
 	return nextConsumer.ConsumeMetrics(ctx, metrics)
 }
+
+// consumeLogs passes the events and service checks to the next logs consumer.
+func (r *statsdReceiver) consumeLogs(ctx context.Context, logs plog.Logs) {
+	numRecords := logs.LogRecordCount()
+	ctx = r.obsrecv.StartLogsOp(ctx)
+	err := r.nextLogsConsumer.ConsumeLogs(ctx, logs)
+	if err != nil {
+		r.settings.Logger.Error("StatsD receiver failed to push events and service checks into pipeline",
+			zap.Int("numRecords", numRecords), zap.Error(err))
+	}
+	r.obsrecv.EndLogsOp(ctx, "statsd", numRecords, err)
+}
@@ -18,8 +18,11 @@ import (
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/transport"
@@ -112,6 +115,24 @@ func TestStatsdReceiver_Flush(t *testing.T) {
 	assert.NoError(t, r.Shutdown(ctx))
 }
 
+func TestStatsdReceiver_ConsumeLogsError(t *testing.T) {
+	core, observed := observer.New(zap.ErrorLevel)
+	set := receivertest.NewNopCreateSettings()
+	set.Logger = zap.New(core)
+	r, err := newReceiver(set, *createDefaultConfig().(*Config))
+	require.NoError(t, err)
+	r.nextLogsConsumer = consumertest.NewErr(errors.New("pipeline error"))
+
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	r.consumeLogs(context.Background(), logs)
+
+	require.Equal(t, 1, observed.Len())
+	entry := observed.All()[0]
+	assert.Equal(t, "pipeline error", entry.ContextMap()["error"])
+	assert.EqualValues(t, 1, entry.ContextMap()["numRecords"])
+}
+
 func Test_statsdreceiver_EndToEnd(t *testing.T) {
 	addr := testutil.GetAvailableLocalAddress(t)
 	host, portStr, err := net.SplitHostPort(addr)
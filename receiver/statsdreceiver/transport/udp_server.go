@@ -10,8 +10,6 @@ import (
 	"net"
 	"strings"
 
-	"go.opentelemetry.io/collector/consumer"
-
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/protocol"
 )
 
@@ -37,11 +35,10 @@ func NewUDPServer(addr string) (Server, error) {
 
 func (u *udpServer) ListenAndServe(
 	parser protocol.Parser,
-	nextConsumer consumer.Metrics,
 	reporter Reporter,
 	transferChan chan<- Metric,
 ) error {
-	if parser == nil || nextConsumer == nil || reporter == nil {
+	if parser == nil || reporter == nil {
 		return errNilListenAndServeParameters
 	}
 
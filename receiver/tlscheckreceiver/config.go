@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver/internal/metadata"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingTarget   = errors.New(`one of "endpoint" or "file_path" must be specified`)
+	errTooManyTargets  = errors.New(`only one of "endpoint" or "file_path" can be specified`)
+	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <hostname>:<port>`)
+	errInvalidTimeout  = errors.New(`"timeout" must not be negative`)
+)
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	metadata.MetricsBuilderConfig           `mapstructure:",squash"`
+	Targets                                 []*targetConfig `mapstructure:"targets"`
+}
+
+type targetConfig struct {
+	// Endpoint is the host:port of a TLS server the certificates are read from.
+	Endpoint string `mapstructure:"endpoint"`
+	// FilePath is the path of a PEM file the certificates are read from.
+	FilePath string `mapstructure:"file_path"`
+	// ServerName is the name sent to the server and the certificate is
+	// validated for, the host of the endpoint by default.
+	ServerName string `mapstructure:"server_name"`
+	// CAFile is the path of the PEM file of the certificate authorities the
+	// chains are validated against, the system ones by default.
+	CAFile  string        `mapstructure:"ca_file"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *targetConfig) Validate() error {
+	var err error
+
+	switch {
+	case cfg.Endpoint == "" && cfg.FilePath == "":
+		err = multierr.Append(err, errMissingTarget)
+	case cfg.Endpoint != "" && cfg.FilePath != "":
+		err = multierr.Append(err, errTooManyTargets)
+	case cfg.Endpoint != "":
+		if _, _, splitErr := net.SplitHostPort(cfg.Endpoint); splitErr != nil {
+			err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), splitErr))
+		}
+	}
+
+	if cfg.Timeout < 0 {
+		err = multierr.Append(err, errInvalidTimeout)
+	}
+
+	return err
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	var err error
+
+	if len(cfg.Targets) == 0 {
+		err = multierr.Append(err, errors.New("no targets configured"))
+	}
+
+	for _, target := range cfg.Targets {
+		err = multierr.Append(err, target.Validate())
+	}
+
+	return err
+}
+
+// name returns the value of the tlscheck.target attribute of the target.
+func (cfg *targetConfig) name() string {
+	if cfg.Endpoint != "" {
+		return cfg.Endpoint
+	}
+	return cfg.FilePath
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.CollectionInterval = time.Hour
+	expected.Targets = []*targetConfig{
+		{Endpoint: "opentelemetry.io:443"},
+		{
+			Endpoint:   "10.0.0.12:8443",
+			ServerName: "internal.example.com",
+			CAFile:     "/etc/ssl/internal-ca.pem",
+			Timeout:    5 * time.Second,
+		},
+		{FilePath: "/etc/nginx/certs/server.pem"},
+	}
+	require.Equal(t, expected, cfg)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc:        "no targets",
+			cfg:         &Config{},
+			expectedErr: fmt.Errorf("no targets configured"),
+		},
+		{
+			desc: "missing target",
+			cfg: &Config{
+				Targets: []*targetConfig{{ServerName: "opentelemetry.io"}},
+			},
+			expectedErr: multierr.Combine(
+				errMissingTarget,
+			),
+		},
+		{
+			desc: "endpoint and file",
+			cfg: &Config{
+				Targets: []*targetConfig{{Endpoint: "opentelemetry.io:443", FilePath: "cert.pem"}},
+			},
+			expectedErr: multierr.Combine(
+				errTooManyTargets,
+			),
+		},
+		{
+			desc: "invalid endpoint and timeout",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{Endpoint: "opentelemetry.io:443"},
+					{Endpoint: "https://opentelemetry.io", Timeout: -time.Second},
+				},
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("%w: %s", errInvalidEndpoint, "address https://opentelemetry.io: too many colons in address"),
+				errInvalidTimeout,
+			),
+		},
+		{
+			desc: "valid config",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{Endpoint: "opentelemetry.io:443"},
+					{FilePath: "cert.pem"},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
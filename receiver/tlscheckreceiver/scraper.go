@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver/internal/metadata"
+)
+
+const defaultTimeout = 10 * time.Second
+
+var errNotStarted = errors.New("scraper not started")
+
+type tlscheckScraper struct {
+	// roots are the certificate authorities of every target, nil for the
+	// ones of the system.
+	roots    []*x509.CertPool
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+	now      func() time.Time
+}
+
+// start loads the certificate authorities the chains are validated against
+func (s *tlscheckScraper) start(_ context.Context, _ component.Host) (err error) {
+	s.roots = make([]*x509.CertPool, len(s.cfg.Targets))
+	for i, target := range s.cfg.Targets {
+		if target.CAFile == "" {
+			continue
+		}
+		pool, loadErr := loadCertPool(target.CAFile)
+		if loadErr != nil {
+			err = multierr.Append(err, loadErr)
+			continue
+		}
+		s.roots[i] = pool
+	}
+	return
+}
+
+// scrape reads the certificates of the targets and produces metrics based on them
+func (s *tlscheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.roots == nil {
+		return pmetric.NewMetrics(), errNotStarted
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(s.cfg.Targets))
+	var mux sync.Mutex
+
+	for idx, target := range s.cfg.Targets {
+		go func(tc *targetConfig, roots *x509.CertPool) {
+			defer wg.Done()
+
+			now := s.now()
+			ts := pcommon.NewTimestampFromTime(now)
+
+			var certs []*x509.Certificate
+			var err error
+			var duration time.Duration
+			if tc.Endpoint != "" {
+				start := time.Now()
+				certs, err = endpointCertificates(ctx, tc)
+				duration = time.Since(start)
+			} else {
+				certs, err = fileCertificates(tc.FilePath)
+			}
+
+			mux.Lock()
+			defer mux.Unlock()
+			if tc.Endpoint != "" {
+				s.mb.RecordTlscheckHandshakeDurationDataPoint(ts, duration.Milliseconds(), tc.name())
+			}
+			if err != nil {
+				s.mb.RecordTlscheckErrorDataPoint(ts, int64(1), tc.name(), err.Error())
+				return
+			}
+
+			for _, cert := range certs {
+				s.mb.RecordTlscheckTimeLeftDataPoint(ts, int64(cert.NotAfter.Sub(now)/time.Second), tc.name(),
+					commonName(cert.Subject), commonName(cert.Issuer), cert.SerialNumber.Text(16))
+			}
+
+			if verifyErr := verify(certs, roots, serverName(tc), now); verifyErr != nil {
+				s.settings.Logger.Debug("invalid certificate chain", zap.String("target", tc.name()), zap.Error(verifyErr))
+				s.mb.RecordTlscheckChainValidDataPoint(ts, int64(0), tc.name())
+			} else {
+				s.mb.RecordTlscheckChainValidDataPoint(ts, int64(1), tc.name())
+			}
+		}(target, s.roots[idx])
+	}
+
+	wg.Wait()
+
+	return s.mb.Emit(), nil
+}
+
+// endpointCertificates returns the certificates sent by the server during the
+// handshake, the leaf first.
+func endpointCertificates(ctx context.Context, target *targetConfig) ([]*x509.Certificate, error) {
+	timeout := target.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			ServerName: serverName(target),
+			// the chain is verified afterwards, so that the certificates of the
+			// servers with invalid chains are reported too
+			InsecureSkipVerify: true, // nolint:gosec
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", target.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate sent by %s", target.Endpoint)
+	}
+	return certs, nil
+}
+
+// fileCertificates returns the certificates of a PEM file, in their order in
+// the file.
+func fileCertificates(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, parseErr := x509.ParseCertificate(block.Bytes)
+		if parseErr != nil {
+			return nil, fmt.Errorf("failed to parse a certificate of %s: %w", path, parseErr)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found in %s", path)
+	}
+	return certs, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	certs, err := fileCertificates(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
+
+// verify verifies that the first certificate chains up to one of the roots,
+// using the other certificates as intermediates, and that it is valid for
+// dnsName unless it is empty.
+func verify(certs []*x509.Certificate, roots *x509.CertPool, dnsName string, now time.Time) error {
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       dnsName,
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	})
+	return err
+}
+
+// serverName returns the name the certificate of an endpoint must be valid
+// for, files are not checked against a name.
+func serverName(target *targetConfig) string {
+	if target.Endpoint == "" {
+		return ""
+	}
+	if target.ServerName != "" {
+		return target.ServerName
+	}
+	host, _, err := net.SplitHostPort(target.Endpoint)
+	if err != nil {
+		return target.Endpoint
+	}
+	return host
+}
+
+func commonName(name pkix.Name) string {
+	if name.CommonName != "" {
+		return name.CommonName
+	}
+	return name.String()
+}
+
+func newScraper(conf *Config, settings receiver.CreateSettings) *tlscheckScraper {
+	return &tlscheckScraper{
+		cfg:      conf,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(conf.MetricsBuilderConfig, settings),
+		now:      time.Now,
+	}
+}
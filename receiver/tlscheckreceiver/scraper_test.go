@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+var testNow = time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+// testChain is a chain of a leaf certificate for localhost, valid for 30
+// days, issued by an intermediate CA valid for 730 days.
+type testChain struct {
+	leaf      tls.Certificate
+	chainFile string
+	caFile    string
+}
+
+func newTestChain(t *testing.T) testChain {
+	dir := t.TempDir()
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	root := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root CA"},
+		NotBefore:             testNow.Add(-24 * time.Hour),
+		NotAfter:              testNow.Add(3650 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, root, root, &rootKey.PublicKey, rootKey)
+	require.NoError(t, err)
+	root, err = x509.ParseCertificate(rootDER)
+	require.NoError(t, err)
+
+	intermediateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	intermediate := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "Test Intermediate CA"},
+		NotBefore:             testNow.Add(-24 * time.Hour),
+		NotAfter:              testNow.Add(730 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	intermediateDER, err := x509.CreateCertificate(rand.Reader, intermediate, root, &intermediateKey.PublicKey, rootKey)
+	require.NoError(t, err)
+	intermediate, err = x509.ParseCertificate(intermediateDER)
+	require.NoError(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leaf := &x509.Certificate{
+		SerialNumber: big.NewInt(31),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    testNow.Add(-24 * time.Hour),
+		NotAfter:     testNow.Add(30 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leaf, intermediate, &leafKey.PublicKey, intermediateKey)
+	require.NoError(t, err)
+
+	chain := testChain{
+		leaf:      tls.Certificate{Certificate: [][]byte{leafDER, intermediateDER}, PrivateKey: leafKey},
+		chainFile: filepath.Join(dir, "chain.pem"),
+		caFile:    filepath.Join(dir, "ca.pem"),
+	}
+	writePEM(t, chain.chainFile, leafDER, intermediateDER)
+	writePEM(t, chain.caFile, rootDER)
+	return chain
+}
+
+func writePEM(t *testing.T, path string, ders ...[]byte) {
+	var data []byte
+	for _, der := range ders {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	require.NoError(t, os.WriteFile(path, data, 0600))
+}
+
+// newTLSServer starts a TLS server presenting the chain and returns its endpoint.
+func newTLSServer(t *testing.T, chain testChain) string {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{chain.leaf},
+		MinVersion:   tls.VersionTLS12,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, acceptErr := ln.Accept()
+			if acceptErr != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func TestScraperScrape(t *testing.T) {
+	chain := newTestChain(t)
+	endpoint := newTLSServer(t, chain)
+
+	testCases := []struct {
+		desc       string
+		target     *targetConfig
+		now        time.Time
+		goldenFile string
+	}{
+		{
+			desc:       "valid file",
+			target:     &targetConfig{FilePath: chain.chainFile, CAFile: chain.caFile},
+			now:        testNow,
+			goldenFile: "valid_chain.yaml",
+		},
+		{
+			desc:       "expired file",
+			target:     &targetConfig{FilePath: chain.chainFile, CAFile: chain.caFile},
+			now:        testNow.Add(60 * 24 * time.Hour),
+			goldenFile: "expired.yaml",
+		},
+		{
+			desc:       "untrusted file",
+			target:     &targetConfig{FilePath: chain.chainFile},
+			now:        testNow,
+			goldenFile: "untrusted.yaml",
+		},
+		{
+			desc:       "valid endpoint",
+			target:     &targetConfig{Endpoint: endpoint, CAFile: chain.caFile},
+			now:        testNow,
+			goldenFile: "valid_chain.yaml",
+		},
+		{
+			desc:       "valid endpoint with server name",
+			target:     &targetConfig{Endpoint: endpoint, ServerName: "localhost", CAFile: chain.caFile},
+			now:        testNow,
+			goldenFile: "valid_chain.yaml",
+		},
+		{
+			desc:       "endpoint with other server name",
+			target:     &targetConfig{Endpoint: endpoint, ServerName: "example.com", CAFile: chain.caFile},
+			now:        testNow,
+			goldenFile: "untrusted.yaml",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Targets = []*targetConfig{tc.target}
+
+			scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+			scraper.now = func() time.Time { return tc.now }
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "expected_metrics", tc.goldenFile))
+			require.NoError(t, err)
+
+			require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+				pmetrictest.IgnoreMetricAttributeValue("tlscheck.target"),
+				pmetrictest.IgnoreMetricDataPointsOrder(),
+				pmetrictest.IgnoreStartTimestamp(),
+				pmetrictest.IgnoreTimestamp(),
+			))
+		})
+	}
+}
+
+func TestScraperErrors(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	// closed so that the connections are refused
+	require.NoError(t, ln.Close())
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.TlscheckHandshakeDuration.Enabled = true
+	cfg.Targets = []*targetConfig{
+		{Endpoint: ln.Addr().String()},
+		{FilePath: filepath.Join("testdata", "missing.pem")},
+		{FilePath: filepath.Join("testdata", "config.yaml")},
+	}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	duration := metrics.At(1)
+	assert.Equal(t, "tlscheck.handshake.duration", duration.Name())
+	assert.Equal(t, 1, duration.Gauge().DataPoints().Len())
+
+	errs := metrics.At(0)
+	assert.Equal(t, "tlscheck.error", errs.Name())
+	targets := map[string]string{}
+	for i := 0; i < errs.Sum().DataPoints().Len(); i++ {
+		attrs := errs.Sum().DataPoints().At(i).Attributes()
+		target, _ := attrs.Get("tlscheck.target")
+		message, _ := attrs.Get("error.message")
+		targets[target.Str()] = message.Str()
+	}
+	assert.Len(t, targets, 3)
+	assert.Contains(t, targets[ln.Addr().String()], "connection refused")
+	assert.Contains(t, targets[filepath.Join("testdata", "missing.pem")], "missing.pem")
+	assert.Equal(t, "no certificate found in "+filepath.Join("testdata", "config.yaml"), targets[filepath.Join("testdata", "config.yaml")])
+}
+
+func TestScraperStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{{FilePath: "cert.pem", CAFile: filepath.Join("testdata", "missing.pem")}}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.Error(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+}
+
+func TestScraperNotStarted(t *testing.T) {
+	scraper := newScraper(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings())
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, errNotStarted.Error())
+	require.NoError(t, pmetrictest.CompareMetrics(pmetric.NewMetrics(), actualMetrics))
+}
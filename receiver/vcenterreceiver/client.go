@@ -7,13 +7,17 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
 	vt "github.com/vmware/govmomi/vim25/types"
 )
 
@@ -24,6 +28,7 @@ type vcenterClient struct {
 	finder    *find.Finder
 	pc        *property.Collector
 	pm        *performance.Manager
+	em        *event.Manager
 	cfg       *Config
 }
 
@@ -69,6 +74,7 @@ func (vc *vcenterClient) EnsureConnection(ctx context.Context) error {
 	vc.pc = property.DefaultCollector(vc.vimDriver)
 	vc.finder = find.NewFinder(vc.vimDriver)
 	vc.pm = performance.NewManager(vc.vimDriver)
+	vc.em = event.NewManager(vc.vimDriver)
 	return nil
 }
 
@@ -148,3 +154,88 @@ func (vc *vcenterClient) performanceQuery(
 		results:  result,
 	}, nil
 }
+
+// EventCollector returns a collector of the events matching the filter, positioned on the oldest event
+func (vc *vcenterClient) EventCollector(ctx context.Context, filter vt.EventFilterSpec) (*event.HistoryCollector, error) {
+	collector, err := vc.em.CreateCollectorForEvents(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create event collector: %w", err)
+	}
+	if err = collector.Rewind(ctx); err != nil {
+		_ = collector.Destroy(ctx)
+		return nil, fmt.Errorf("unable to rewind event collector: %w", err)
+	}
+	return collector, nil
+}
+
+// EventCategory returns the category of the event, which is one of info, warning, error or user
+func (vc *vcenterClient) EventCategory(ctx context.Context, e vt.BaseEvent) (string, error) {
+	return vc.em.EventCategory(ctx, e)
+}
+
+// TriggeredAlarms returns the alarms triggered on any entity of the inventory
+func (vc *vcenterClient) TriggeredAlarms(ctx context.Context) ([]vt.AlarmState, error) {
+	var root mo.Folder
+	err := vc.pc.RetrieveOne(ctx, vc.vimDriver.ServiceContent.RootFolder, []string{"triggeredAlarmState"}, &root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve triggered alarms: %w", err)
+	}
+	return root.TriggeredAlarmState, nil
+}
+
+// AlarmNames returns the names of the alarms by reference
+func (vc *vcenterClient) AlarmNames(ctx context.Context, refs []vt.ManagedObjectReference) (map[vt.ManagedObjectReference]string, error) {
+	names := map[vt.ManagedObjectReference]string{}
+	if len(refs) == 0 {
+		return names, nil
+	}
+	var alarms []mo.Alarm
+	if err := vc.pc.Retrieve(ctx, refs, []string{"info.name"}, &alarms); err != nil {
+		return names, fmt.Errorf("unable to retrieve alarm names: %w", err)
+	}
+	for _, alarm := range alarms {
+		names[alarm.Self] = alarm.Info.Name
+	}
+	return names, nil
+}
+
+// EntityNames returns the names of the managed entities by reference
+func (vc *vcenterClient) EntityNames(ctx context.Context, refs []vt.ManagedObjectReference) (map[vt.ManagedObjectReference]string, error) {
+	names := map[vt.ManagedObjectReference]string{}
+	if len(refs) == 0 {
+		return names, nil
+	}
+	var entities []mo.ManagedEntity
+	if err := vc.pc.Retrieve(ctx, refs, []string{"name"}, &entities); err != nil {
+		return names, fmt.Errorf("unable to retrieve entity names: %w", err)
+	}
+	for _, entity := range entities {
+		names[entity.Self] = entity.Name
+	}
+	return names, nil
+}
+
+// VMInstanceUUIDs returns the instance UUIDs of the virtual machines by reference.
+// The virtual machines which no longer exist are left out.
+func (vc *vcenterClient) VMInstanceUUIDs(ctx context.Context, refs []vt.ManagedObjectReference) map[vt.ManagedObjectReference]string {
+	uuids := map[vt.ManagedObjectReference]string{}
+	for _, ref := range refs {
+		var vm mo.VirtualMachine
+		if err := vc.pc.RetrieveOne(ctx, ref, []string{"config.instanceUuid"}, &vm); err != nil {
+			continue
+		}
+		if vm.Config != nil {
+			uuids[ref] = vm.Config.InstanceUuid
+		}
+	}
+	return uuids
+}
+
+// CurrentTime returns the current time of the vSphere server
+func (vc *vcenterClient) CurrentTime(ctx context.Context) (time.Time, error) {
+	now, err := methods.GetCurrentTime(ctx, vc.vimDriver)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to get the server time: %w", err)
+	}
+	return *now, nil
+}
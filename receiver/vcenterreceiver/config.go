@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -20,11 +21,37 @@ type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	configtls.TLSClientSetting              `mapstructure:"tls,omitempty"`
 	metadata.MetricsBuilderConfig           `mapstructure:",squash"`
-	Endpoint                                string `mapstructure:"endpoint"`
-	Username                                string `mapstructure:"username"`
-	Password                                string `mapstructure:"password"`
+	Endpoint                                string        `mapstructure:"endpoint"`
+	Username                                string        `mapstructure:"username"`
+	Password                                string        `mapstructure:"password"`
+	Events                                  *EventsConfig `mapstructure:"events"`
+	Alarms                                  *AlarmsConfig `mapstructure:"alarms"`
 }
 
+// EventsConfig is the configuration of the collection of vCenter events as logs
+type EventsConfig struct {
+	// PollInterval is the interval at which the new events are queried.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Types limits the events collected to the given event types, e.g. VmPoweredOnEvent.
+	// All the events are collected when no type is given.
+	Types []string `mapstructure:"types"`
+	// PageSize is the number of events read at once.
+	PageSize int32 `mapstructure:"page_size"`
+}
+
+// AlarmsConfig is the configuration of the collection of vCenter triggered alarms as logs
+type AlarmsConfig struct {
+	// PollInterval is the interval at which the triggered alarms are queried.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+}
+
+const maxEventsPageSize = 1000
+
+var (
+	errNegativePollInterval = errors.New("'poll_interval' must not be negative")
+	errEventsPageSize       = fmt.Errorf("'page_size' must be between 0 and %d", maxEventsPageSize)
+)
+
 // Validate checks to see if the supplied config will work for the receiver
 func (c *Config) Validate() error {
 	if c.Endpoint == "" {
@@ -54,6 +81,25 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
 
+	if c.Events != nil {
+		err = multierr.Append(err, c.Events.validate())
+	}
+
+	if c.Alarms != nil && c.Alarms.PollInterval < 0 {
+		err = multierr.Append(err, fmt.Errorf("alarms: %w", errNegativePollInterval))
+	}
+
+	return err
+}
+
+func (e *EventsConfig) validate() error {
+	var err error
+	if e.PollInterval < 0 {
+		err = multierr.Append(err, fmt.Errorf("events: %w", errNegativePollInterval))
+	}
+	if e.PageSize < 0 || e.PageSize > maxEventsPageSize {
+		err = multierr.Append(err, fmt.Errorf("events: %w", errEventsPageSize))
+	}
 	return err
 }
 
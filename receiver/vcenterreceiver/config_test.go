@@ -70,6 +70,43 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectedErr: errors.New("password not provided"),
 		},
+		{
+			desc: "valid events and alarms",
+			cfg: Config{
+				Endpoint: "https://vcsa.some-host",
+				Username: "otelu",
+				Password: "otelp",
+				Events: &EventsConfig{
+					PollInterval: time.Minute,
+					PageSize:     500,
+				},
+				Alarms: &AlarmsConfig{},
+			},
+		},
+		{
+			desc: "events page size too large",
+			cfg: Config{
+				Endpoint: "https://vcsa.some-host",
+				Username: "otelu",
+				Password: "otelp",
+				Events: &EventsConfig{
+					PageSize: 1001,
+				},
+			},
+			expectedErr: errEventsPageSize,
+		},
+		{
+			desc: "negative alarms poll interval",
+			cfg: Config{
+				Endpoint: "https://vcsa.some-host",
+				Username: "otelu",
+				Password: "otelp",
+				Alarms: &AlarmsConfig{
+					PollInterval: -time.Minute,
+				},
+			},
+			expectedErr: errNegativePollInterval,
+		},
 	}
 
 	for _, tc := range cases {
@@ -100,6 +137,13 @@ func TestLoadConfig(t *testing.T) {
 	expected.MetricsBuilderConfig = metadata.DefaultMetricsBuilderConfig()
 	expected.MetricsBuilderConfig.Metrics.VcenterHostCPUUtilization.Enabled = false
 	expected.CollectionInterval = 5 * time.Minute
+	expected.Events = &EventsConfig{
+		PollInterval: 30 * time.Second,
+		Types:        []string{"VmPoweredOnEvent", "VmPoweredOffEvent"},
+	}
+	expected.Alarms = &AlarmsConfig{
+		PollInterval: 2 * time.Minute,
+	}
 
 	if diff := cmp.Diff(expected, cfg, cmpopts.IgnoreUnexported(metadata.MetricConfig{})); diff != "" {
 		t.Errorf("Config mismatch (-expected +actual):\n%s", diff)
@@ -23,6 +23,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -37,7 +38,10 @@ func createDefaultConfig() component.Config {
 	}
 }
 
-var errConfigNotVcenter = errors.New("config was not an vcenter receiver config")
+var (
+	errConfigNotVcenter = errors.New("config was not an vcenter receiver config")
+	errNoLogsCollected  = errors.New("one of 'events' or 'alarms' must be configured to collect logs")
+)
 
 func createMetricsReceiver(
 	_ context.Context,
@@ -68,3 +72,22 @@ func createMetricsReceiver(
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotVcenter
+	}
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	if cfg.Events == nil && cfg.Alarms == nil {
+		return nil, errNoLogsCollected
+	}
+	return newLogsReceiver(params.Logger, cfg, consumer), nil
+}
@@ -63,3 +63,32 @@ func TestCreateMetricsReceiver(t *testing.T) {
 		t.Run(testCase.desc, testCase.testFn)
 	}
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	_, err := createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		cfg,
+		consumertest.NewNop(),
+	)
+	require.ErrorIs(t, err, errNoLogsCollected)
+
+	cfg.Events = &EventsConfig{}
+	r, err := createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		cfg,
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+
+	_, err = createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		nil,
+		consumertest.NewNop(),
+	)
+	require.ErrorIs(t, err, errConfigNotVcenter)
+}
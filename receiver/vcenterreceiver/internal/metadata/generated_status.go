@@ -9,4 +9,5 @@ import (
 const (
 	Type             = "vcenter"
 	MetricsStability = component.StabilityLevelAlpha
+	LogsStability    = component.StabilityLevelDevelopment
 )
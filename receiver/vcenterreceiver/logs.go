@@ -0,0 +1,445 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	vt "github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultLogsPollInterval = time.Minute
+	defaultEventsPageSize   = 100
+	alarmStatusCleared      = "cleared"
+)
+
+var _ receiver.Logs = (*vcenterLogsReceiver)(nil)
+
+// vcenterLogsReceiver collects the vCenter events and the triggered alarms as logs.
+type vcenterLogsReceiver struct {
+	client   *vcenterClient
+	config   *Config
+	consumer consumer.Logs
+	logger   *zap.Logger
+
+	wg     *sync.WaitGroup
+	cancel context.CancelFunc
+
+	// lastEventTime and lastEventKey identify the last event collected, the
+	// events are collected from the creation time of the last event on.
+	lastEventTime time.Time
+	lastEventKey  int32
+	// alarms are the alarms triggered at the last poll by alarm state key.
+	alarms map[string]vt.AlarmState
+}
+
+// entityResource holds the resource attributes of the entity an event or an alarm is about.
+type entityResource struct {
+	clusterName      string
+	hostName         string
+	resourcePoolName string
+	datastoreName    string
+	vmName           string
+	vmID             string
+}
+
+func newLogsReceiver(logger *zap.Logger, config *Config, consumer consumer.Logs) *vcenterLogsReceiver {
+	return &vcenterLogsReceiver{
+		client:   newVcenterClient(config),
+		config:   config,
+		consumer: consumer,
+		logger:   logger,
+		wg:       &sync.WaitGroup{},
+	}
+}
+
+func (r *vcenterLogsReceiver) Start(ctx context.Context, _ component.Host) error {
+	// don't fail to start if we cannot establish connection, the position of
+	// the events is set at the first successful poll instead
+	if err := r.client.EnsureConnection(ctx); err != nil {
+		r.logger.Error(fmt.Sprintf("unable to establish a connection to the vSphere SDK %s", err.Error()))
+	} else if r.config.Events != nil {
+		if err = r.initEventPosition(ctx); err != nil {
+			r.logger.Error("unable to set the position of the events", zap.Error(err))
+		}
+	}
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.startPolling(cancelCtx)
+	return nil
+}
+
+func (r *vcenterLogsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return r.client.Disconnect(ctx)
+}
+
+// startPolling polls the events and the alarms from the same goroutine, as
+// they share the connection to the vSphere SDK.
+func (r *vcenterLogsReceiver) startPolling(ctx context.Context) {
+	var eventsTick, alarmsTick <-chan time.Time
+	var tickers []*time.Ticker
+	if r.config.Events != nil {
+		t := time.NewTicker(pollInterval(r.config.Events.PollInterval))
+		tickers = append(tickers, t)
+		eventsTick = t.C
+	}
+	if r.config.Alarms != nil {
+		t := time.NewTicker(pollInterval(r.config.Alarms.PollInterval))
+		tickers = append(tickers, t)
+		alarmsTick = t.C
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() {
+			for _, t := range tickers {
+				t.Stop()
+			}
+		}()
+		for {
+			select {
+			case <-eventsTick:
+				if err := r.pollEvents(ctx); err != nil {
+					r.logger.Error("error while polling for events", zap.Error(err))
+				}
+			case <-alarmsTick:
+				if err := r.pollAlarms(ctx); err != nil {
+					r.logger.Error("error while polling for alarms", zap.Error(err))
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func pollInterval(interval time.Duration) time.Duration {
+	if interval == 0 {
+		return defaultLogsPollInterval
+	}
+	return interval
+}
+
+// initEventPosition sets the position of the events to the current time of
+// the server, so that only the events created from now on are collected.
+func (r *vcenterLogsReceiver) initEventPosition(ctx context.Context) error {
+	now, err := r.client.CurrentTime(ctx)
+	if err != nil {
+		return err
+	}
+	r.lastEventTime = now
+	return nil
+}
+
+func (r *vcenterLogsReceiver) pollEvents(ctx context.Context) error {
+	if err := r.client.EnsureConnection(ctx); err != nil {
+		return fmt.Errorf("unable to connect to vSphere SDK: %w", err)
+	}
+	if r.lastEventTime.IsZero() {
+		return r.initEventPosition(ctx)
+	}
+
+	begin := r.lastEventTime
+	collector, err := r.client.EventCollector(ctx, vt.EventFilterSpec{
+		Time:        &vt.EventFilterSpecByTime{BeginTime: &begin},
+		EventTypeId: r.config.Events.Types,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if destroyErr := collector.Destroy(ctx); destroyErr != nil {
+			r.logger.Debug("unable to destroy the event collector", zap.Error(destroyErr))
+		}
+	}()
+
+	pageSize := r.config.Events.PageSize
+	if pageSize == 0 {
+		pageSize = defaultEventsPageSize
+	}
+	for {
+		events, err := collector.ReadNextEvents(ctx, pageSize)
+		if err != nil {
+			return fmt.Errorf("unable to read events: %w", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		// The events created at the time of the last event collected are
+		// read again, the event keys are increasing.
+		newEvents := make([]vt.BaseEvent, 0, len(events))
+		for _, e := range events {
+			if e.GetEvent().Key > r.lastEventKey {
+				newEvents = append(newEvents, e)
+			}
+		}
+		if len(newEvents) == 0 {
+			continue
+		}
+
+		logs := r.eventsToLogs(ctx, newEvents)
+		if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			return fmt.Errorf("unable to consume events: %w", err)
+		}
+		for _, e := range newEvents {
+			if ev := e.GetEvent(); ev.Key > r.lastEventKey {
+				r.lastEventKey = ev.Key
+				r.lastEventTime = ev.CreatedTime
+			}
+		}
+	}
+}
+
+func (r *vcenterLogsReceiver) eventsToLogs(ctx context.Context, events []vt.BaseEvent) plog.Logs {
+	var vmRefs []vt.ManagedObjectReference
+	for _, e := range events {
+		if vm := e.GetEvent().Vm; vm != nil {
+			vmRefs = append(vmRefs, vm.Vm)
+		}
+	}
+	vmIDs := r.client.VMInstanceUUIDs(ctx, vmRefs)
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	logs := plog.NewLogs()
+	resources := map[entityResource]plog.LogRecordSlice{}
+	for _, e := range events {
+		ev := e.GetEvent()
+		res := eventResource(ev, vmIDs)
+		lr := resourceLogRecords(logs, resources, res).AppendEmpty()
+
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(ev.CreatedTime))
+		lr.SetObservedTimestamp(now)
+		lr.Body().SetStr(ev.FullFormattedMessage)
+
+		category, err := r.client.EventCategory(ctx, e)
+		if err != nil {
+			r.logger.Debug("unable to get the category of an event", zap.Int32("key", ev.Key), zap.Error(err))
+		} else {
+			lr.SetSeverityNumber(severityFromEventCategory(category))
+			lr.SetSeverityText(category)
+		}
+
+		attrs := lr.Attributes()
+		attrs.PutStr("vcenter.event.type", eventType(e))
+		attrs.PutInt("vcenter.event.key", int64(ev.Key))
+		attrs.PutInt("vcenter.event.chain_id", int64(ev.ChainId))
+		if ev.UserName != "" {
+			attrs.PutStr("vcenter.event.user", ev.UserName)
+		}
+		if ev.Datacenter != nil {
+			attrs.PutStr("vcenter.datacenter.name", ev.Datacenter.Name)
+		}
+	}
+	return logs
+}
+
+// eventType returns the type of the event, e.g. VmPoweredOnEvent.
+func eventType(e vt.BaseEvent) string {
+	switch ev := e.(type) {
+	case *vt.EventEx:
+		return ev.EventTypeId
+	case *vt.ExtendedEvent:
+		return ev.EventTypeId
+	}
+	return reflect.TypeOf(e).Elem().Name()
+}
+
+func eventResource(ev *vt.Event, vmIDs map[vt.ManagedObjectReference]string) entityResource {
+	var res entityResource
+	if ev.ComputeResource != nil && ev.ComputeResource.ComputeResource.Type == "ClusterComputeResource" {
+		res.clusterName = ev.ComputeResource.Name
+	}
+	if ev.Host != nil {
+		res.hostName = ev.Host.Name
+	}
+	if ev.Ds != nil {
+		res.datastoreName = ev.Ds.Name
+	}
+	if ev.Vm != nil {
+		res.vmName = ev.Vm.Name
+		res.vmID = vmIDs[ev.Vm.Vm]
+	}
+	return res
+}
+
+func severityFromEventCategory(category string) plog.SeverityNumber {
+	switch category {
+	case "error":
+		return plog.SeverityNumberError
+	case "warning":
+		return plog.SeverityNumberWarn
+	case "info", "user":
+		return plog.SeverityNumberInfo
+	}
+	return plog.SeverityNumberUnspecified
+}
+
+func (r *vcenterLogsReceiver) pollAlarms(ctx context.Context) error {
+	if err := r.client.EnsureConnection(ctx); err != nil {
+		return fmt.Errorf("unable to connect to vSphere SDK: %w", err)
+	}
+
+	states, err := r.client.TriggeredAlarms(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Only the alarms which were triggered, changed status or were cleared
+	// since the last poll are collected.
+	current := make(map[string]vt.AlarmState, len(states))
+	var changed []vt.AlarmState
+	for _, state := range states {
+		current[state.Key] = state
+		if previous, ok := r.alarms[state.Key]; !ok || previous.OverallStatus != state.OverallStatus {
+			changed = append(changed, state)
+		}
+	}
+	var cleared []vt.AlarmState
+	for key, state := range r.alarms {
+		if _, ok := current[key]; !ok {
+			cleared = append(cleared, state)
+		}
+	}
+
+	if len(changed) > 0 || len(cleared) > 0 {
+		logs := r.alarmsToLogs(ctx, changed, cleared)
+		if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			return fmt.Errorf("unable to consume alarms: %w", err)
+		}
+	}
+	r.alarms = current
+	return nil
+}
+
+func (r *vcenterLogsReceiver) alarmsToLogs(ctx context.Context, changed, cleared []vt.AlarmState) plog.Logs {
+	all := append(append([]vt.AlarmState{}, changed...), cleared...)
+	var alarmRefs, entityRefs, vmRefs []vt.ManagedObjectReference
+	for _, state := range all {
+		alarmRefs = append(alarmRefs, state.Alarm)
+		entityRefs = append(entityRefs, state.Entity)
+		if state.Entity.Type == "VirtualMachine" {
+			vmRefs = append(vmRefs, state.Entity)
+		}
+	}
+	// The alarms are collected without their names when the names cannot be
+	// retrieved, e.g. when the alarm or the entity was removed.
+	alarmNames, err := r.client.AlarmNames(ctx, alarmRefs)
+	if err != nil {
+		r.logger.Debug("unable to get the alarm names", zap.Error(err))
+	}
+	entityNames, err := r.client.EntityNames(ctx, entityRefs)
+	if err != nil {
+		r.logger.Debug("unable to get the entity names", zap.Error(err))
+	}
+	vmIDs := r.client.VMInstanceUUIDs(ctx, vmRefs)
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	logs := plog.NewLogs()
+	resources := map[entityResource]plog.LogRecordSlice{}
+	for i, state := range all {
+		entityName := entityNames[state.Entity]
+		res := alarmResource(state.Entity, entityName, vmIDs)
+		lr := resourceLogRecords(logs, resources, res).AppendEmpty()
+
+		status := string(state.OverallStatus)
+		if i >= len(changed) {
+			status = alarmStatusCleared
+			lr.SetTimestamp(now)
+		} else {
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(state.Time))
+		}
+		lr.SetObservedTimestamp(now)
+		lr.SetSeverityNumber(severityFromAlarmStatus(status))
+		lr.SetSeverityText(status)
+
+		alarmName := alarmNames[state.Alarm]
+		lr.Body().SetStr(fmt.Sprintf("Alarm '%s' on %s '%s' is %s", alarmName, state.Entity.Type, entityName, status))
+
+		attrs := lr.Attributes()
+		attrs.PutStr("vcenter.alarm.key", state.Key)
+		attrs.PutStr("vcenter.alarm.name", alarmName)
+		attrs.PutStr("vcenter.alarm.status", status)
+		attrs.PutStr("vcenter.alarm.entity.type", state.Entity.Type)
+		attrs.PutStr("vcenter.alarm.entity.name", entityName)
+		if state.Acknowledged != nil {
+			attrs.PutBool("vcenter.alarm.acknowledged", *state.Acknowledged)
+		}
+	}
+	return logs
+}
+
+func alarmResource(entity vt.ManagedObjectReference, name string, vmIDs map[vt.ManagedObjectReference]string) entityResource {
+	var res entityResource
+	switch entity.Type {
+	case "ClusterComputeResource":
+		res.clusterName = name
+	case "HostSystem":
+		res.hostName = name
+	case "ResourcePool":
+		res.resourcePoolName = name
+	case "Datastore":
+		res.datastoreName = name
+	case "VirtualMachine":
+		res.vmName = name
+		res.vmID = vmIDs[entity]
+	}
+	return res
+}
+
+func severityFromAlarmStatus(status string) plog.SeverityNumber {
+	switch status {
+	case string(vt.ManagedEntityStatusRed):
+		return plog.SeverityNumberError
+	case string(vt.ManagedEntityStatusYellow):
+		return plog.SeverityNumberWarn
+	case string(vt.ManagedEntityStatusGreen), string(vt.ManagedEntityStatusGray), alarmStatusCleared:
+		return plog.SeverityNumberInfo
+	}
+	return plog.SeverityNumberUnspecified
+}
+
+// resourceLogRecords returns the log records of the resource of the entity,
+// which are added to the logs on first use.
+func resourceLogRecords(logs plog.Logs, resources map[entityResource]plog.LogRecordSlice, res entityResource) plog.LogRecordSlice {
+	if records, ok := resources[res]; ok {
+		return records
+	}
+
+	rl := logs.ResourceLogs().AppendEmpty()
+	attrs := rl.Resource().Attributes()
+	putNonEmpty(attrs, "vcenter.cluster.name", res.clusterName)
+	putNonEmpty(attrs, "vcenter.host.name", res.hostName)
+	putNonEmpty(attrs, "vcenter.resource_pool.name", res.resourcePoolName)
+	putNonEmpty(attrs, "vcenter.datastore.name", res.datastoreName)
+	putNonEmpty(attrs, "vcenter.vm.name", res.vmName)
+	putNonEmpty(attrs, "vcenter.vm.id", res.vmID)
+
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	resources[res] = records
+	return records
+}
+
+func putNonEmpty(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	vt "github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func newTestLogsReceiver(c *vim25.Client, cfg *Config, sink *consumertest.LogsSink) *vcenterLogsReceiver {
+	r := newLogsReceiver(zap.NewNop(), cfg, sink)
+	r.client = &vcenterClient{
+		moClient: &govmomi.Client{
+			Client:         c,
+			SessionManager: session.NewManager(c),
+		},
+		vimDriver: c,
+		finder:    find.NewFinder(c),
+		pc:        property.DefaultCollector(c),
+		em:        event.NewManager(c),
+		cfg:       cfg,
+	}
+	return r
+}
+
+// logRecordsByAttribute returns the log records with the given attribute value along with their resource.
+func logRecordsByAttribute(logs []plog.Logs, key, value string) ([]plog.LogRecord, []plog.ResourceLogs) {
+	var records []plog.LogRecord
+	var resources []plog.ResourceLogs
+	for _, l := range logs {
+		for i := 0; i < l.ResourceLogs().Len(); i++ {
+			rl := l.ResourceLogs().At(i)
+			lrs := rl.ScopeLogs().At(0).LogRecords()
+			for j := 0; j < lrs.Len(); j++ {
+				if v, ok := lrs.At(j).Attributes().Get(key); ok && v.Str() == value {
+					records = append(records, lrs.At(j))
+					resources = append(resources, rl)
+				}
+			}
+		}
+	}
+	return records, resources
+}
+
+func TestPollEvents(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		sink := new(consumertest.LogsSink)
+		r := newTestLogsReceiver(c, &Config{
+			Events: &EventsConfig{
+				Types: []string{"VmPoweredOffEvent"},
+			},
+		}, sink)
+
+		// The first poll sets the position of the events.
+		require.NoError(t, r.pollEvents(ctx))
+		require.Equal(t, 0, sink.LogRecordCount())
+
+		vms, err := r.client.VMs(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, vms)
+		vm := vms[0]
+		task, err := vm.PowerOff(ctx)
+		require.NoError(t, err)
+		require.NoError(t, task.Wait(ctx))
+
+		require.NoError(t, r.pollEvents(ctx))
+		records, resources := logRecordsByAttribute(sink.AllLogs(), "vcenter.event.type", "VmPoweredOffEvent")
+		require.Len(t, records, 1)
+		require.Equal(t, 1, sink.LogRecordCount())
+		require.NotEmpty(t, records[0].Body().Str())
+
+		vmName, ok := resources[0].Resource().Attributes().Get("vcenter.vm.name")
+		require.True(t, ok)
+		require.Equal(t, vm.Name(), vmName.Str())
+		_, ok = resources[0].Resource().Attributes().Get("vcenter.vm.id")
+		require.True(t, ok)
+		_, ok = resources[0].Resource().Attributes().Get("vcenter.host.name")
+		require.True(t, ok)
+
+		// The events are collected once.
+		require.NoError(t, r.pollEvents(ctx))
+		require.Equal(t, 1, sink.LogRecordCount())
+	})
+}
+
+func TestPollAlarms(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		sink := new(consumertest.LogsSink)
+		r := newTestLogsReceiver(c, &Config{
+			Alarms: &AlarmsConfig{},
+		}, sink)
+
+		vms, err := r.client.VMs(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, vms)
+		vm := vms[0]
+
+		// An alarm triggered at the last poll which is no longer triggered is
+		// collected as cleared.
+		r.alarms = map[string]vt.AlarmState{
+			"alarm-1.vm-1": {
+				Key:           "alarm-1.vm-1",
+				Entity:        vm.Reference(),
+				Alarm:         vt.ManagedObjectReference{Type: "Alarm", Value: "alarm-1"},
+				OverallStatus: vt.ManagedEntityStatusRed,
+			},
+		}
+		require.NoError(t, r.pollAlarms(ctx))
+
+		records, resources := logRecordsByAttribute(sink.AllLogs(), "vcenter.alarm.key", "alarm-1.vm-1")
+		require.Len(t, records, 1)
+		status, ok := records[0].Attributes().Get("vcenter.alarm.status")
+		require.True(t, ok)
+		require.Equal(t, alarmStatusCleared, status.Str())
+		require.Equal(t, plog.SeverityNumberInfo, records[0].SeverityNumber())
+
+		vmName, ok := resources[0].Resource().Attributes().Get("vcenter.vm.name")
+		require.True(t, ok)
+		require.Equal(t, vm.Name(), vmName.Str())
+
+		// Nothing is collected while the triggered alarms do not change.
+		require.NoError(t, r.pollAlarms(ctx))
+		require.Equal(t, 1, sink.LogRecordCount())
+	})
+}
+
+func TestAlarmsToLogsStatusChange(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		sink := new(consumertest.LogsSink)
+		r := newTestLogsReceiver(c, &Config{
+			Alarms: &AlarmsConfig{},
+		}, sink)
+
+		hosts, err := find.NewFinder(c).HostSystemList(ctx, "*")
+		require.NoError(t, err)
+		require.NotEmpty(t, hosts)
+
+		acknowledged := true
+		logs := r.alarmsToLogs(ctx, []vt.AlarmState{
+			{
+				Key:           "alarm-2.host-1",
+				Entity:        hosts[0].Reference(),
+				Alarm:         vt.ManagedObjectReference{Type: "Alarm", Value: "alarm-2"},
+				OverallStatus: vt.ManagedEntityStatusYellow,
+				Acknowledged:  &acknowledged,
+			},
+		}, nil)
+
+		records, resources := logRecordsByAttribute([]plog.Logs{logs}, "vcenter.alarm.key", "alarm-2.host-1")
+		require.Len(t, records, 1)
+		require.Equal(t, plog.SeverityNumberWarn, records[0].SeverityNumber())
+		require.Equal(t, "yellow", records[0].SeverityText())
+		ack, ok := records[0].Attributes().Get("vcenter.alarm.acknowledged")
+		require.True(t, ok)
+		require.True(t, ack.Bool())
+
+		hostName, ok := resources[0].Resource().Attributes().Get("vcenter.host.name")
+		require.True(t, ok)
+		require.Equal(t, hosts[0].Name(), hostName.Str())
+	})
+}
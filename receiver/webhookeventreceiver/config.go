@@ -7,6 +7,7 @@ import (
 	"errors"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.uber.org/multierr"
 )
@@ -24,6 +25,7 @@ type Config struct {
 	WriteTimeout                  string                   `mapstructure:"write_timeout"` // wait time for writing request response in ms. Default is twenty seconds.
 	Path                          string                   `mapstructure:"path"`          // path for data collection. Default is <host>:<port>/services/collector
 	HealthPath                    string                   `mapstructure:"health_path"`   // path for health check api. Default is /services/collector/health
+	Decompression                 *component.ID            `mapstructure:"decompression"` // ID of the httpdecompression extension decompressing request bodies. Optional.
 }
 
 func (cfg *Config) Validate() error {
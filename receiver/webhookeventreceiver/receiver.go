@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver/internal/metadata"
 )
 
@@ -104,6 +105,9 @@ func (er *eventReceiver) Start(_ context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	if err = httpdecompressionextension.WrapServer(host, er.cfg.Decompression, er.server); err != nil {
+		return err
+	}
 
 	readTimeout, err := time.ParseDuration(er.cfg.ReadTimeout)
 	if err != nil {
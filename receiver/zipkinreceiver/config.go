@@ -4,7 +4,10 @@
 package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
 )
 
@@ -15,11 +18,19 @@ type Config struct {
 	// If enabled the zipkin receiver will attempt to parse string tags/binary annotations into int/bool/float.
 	// Disabled by default
 	ParseStringTags bool `mapstructure:"parse_string_tags"`
+	// GRPC configures the gRPC server receiving spans with the zipkin.proto3.SpanService/Report method.
+	// The gRPC server is disabled when not set.
+	GRPC *configgrpc.GRPCServerSettings `mapstructure:"grpc"`
+	// Decompression is the ID of the httpdecompression extension decompressing the request bodies.
+	Decompression *component.ID `mapstructure:"decompression"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.GRPC != nil && cfg.GRPC.NetAddr.Endpoint == "" {
+		return errors.New("grpc endpoint must be specified")
+	}
 	return nil
 }
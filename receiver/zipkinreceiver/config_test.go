@@ -10,7 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver/internal/metadata"
@@ -48,6 +50,20 @@ func TestLoadConfig(t *testing.T) {
 				ParseStringTags: true,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "grpc"),
+			expected: &Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: defaultBindEndpoint,
+				},
+				GRPC: &configgrpc.GRPCServerSettings{
+					NetAddr: confignet.NetAddr{
+						Endpoint:  "0.0.0.0:9412",
+						Transport: "tcp",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -64,3 +80,9 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGRPCEndpoint(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.GRPC = &configgrpc.GRPCServerSettings{}
+	assert.EqualError(t, component.ValidateConfig(cfg), "grpc endpoint must be specified")
+}
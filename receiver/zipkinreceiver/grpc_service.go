@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
+
+import (
+	"context"
+
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The zipkin-go module only ships the messages of zipkin.proto, the SpanService
+// below mirrors the service definition of the Zipkin API:
+//
+//	service SpanService {
+//	  rpc Report(ListOfSpans) returns (ReportResponse) {}
+//	}
+//
+// ReportResponse is an empty message, so it is encoded the same as emptypb.Empty.
+const (
+	spanServiceName    = "zipkin.proto3.SpanService"
+	spanServiceReport  = "Report"
+	spanServiceFullRPC = "/" + spanServiceName + "/" + spanServiceReport
+)
+
+// spanServiceServer is the server API of the Zipkin SpanService.
+type spanServiceServer interface {
+	Report(context.Context, *zipkin_proto3.ListOfSpans) (*emptypb.Empty, error)
+}
+
+var spanServiceDesc = grpc.ServiceDesc{
+	ServiceName: spanServiceName,
+	HandlerType: (*spanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: spanServiceReport,
+			Handler:    reportHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zipkin.proto",
+}
+
+func reportHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(zipkin_proto3.ListOfSpans)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(spanServiceServer).Report(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: spanServiceFullRPC,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(spanServiceServer).Report(ctx, req.(*zipkin_proto3.ListOfSpans))
+	}
+	return interceptor(ctx, in, info, handler)
+}
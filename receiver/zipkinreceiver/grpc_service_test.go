@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configgrpc"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func availableAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func startGRPCReceiver(t *testing.T, next consumer.Traces) *grpc.ClientConn {
+	addr := availableAddress(t)
+	cfg := &Config{
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: "localhost:0",
+		},
+		GRPC: &configgrpc.GRPCServerSettings{
+			NetAddr: confignet.NetAddr{
+				Endpoint:  addr,
+				Transport: "tcp",
+			},
+		},
+	}
+	zr, err := newReceiver(cfg, next, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, zr.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, zr.Shutdown(context.Background())) })
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func reportSpans(ctx context.Context, conn *grpc.ClientConn, spans *zipkin_proto3.ListOfSpans) error {
+	return conn.Invoke(ctx, spanServiceFullRPC, spans, &emptypb.Empty{})
+}
+
+func TestReceiverGRPC(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	conn := startGRPCReceiver(t, sink)
+
+	now := time.Now()
+	spans := &zipkin_proto3.ListOfSpans{
+		Spans: []*zipkin_proto3.Span{
+			{
+				TraceId:       []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+				Id:            []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				Name:          "get",
+				Kind:          zipkin_proto3.Span_SERVER,
+				Timestamp:     uint64(now.UnixMicro()),
+				Duration:      1000,
+				LocalEndpoint: &zipkin_proto3.Endpoint{ServiceName: "frontend"},
+				Tags:          map[string]string{"http.method": "GET"},
+			},
+			{
+				TraceId:       []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+				ParentId:      []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				Id:            []byte{0x02, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				Name:          "query",
+				Kind:          zipkin_proto3.Span_CLIENT,
+				Timestamp:     uint64(now.UnixMicro()),
+				Duration:      500,
+				LocalEndpoint: &zipkin_proto3.Endpoint{ServiceName: "frontend"},
+			},
+		},
+	}
+	require.NoError(t, reportSpans(context.Background(), conn, spans))
+
+	require.Len(t, sink.AllTraces(), 1)
+	td := sink.AllTraces()[0]
+	assert.Equal(t, 2, td.SpanCount())
+	serviceName, ok := td.ResourceSpans().At(0).Resource().Attributes().Get("service.name")
+	require.True(t, ok)
+	assert.Equal(t, "frontend", serviceName.Str())
+	var names []string
+	ss := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	for i := 0; i < ss.Len(); i++ {
+		names = append(names, ss.At(i).Name())
+		assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", ss.At(i).TraceID().String())
+	}
+	assert.ElementsMatch(t, []string{"get", "query"}, names)
+}
+
+func TestReceiverGRPCInvalidSpans(t *testing.T) {
+	conn := startGRPCReceiver(t, consumertest.NewNop())
+
+	spans := &zipkin_proto3.ListOfSpans{
+		Spans: []*zipkin_proto3.Span{
+			{
+				TraceId: []byte{0x01},
+				Id:      []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+			},
+		},
+	}
+	err := reportSpans(context.Background(), conn, spans)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestReceiverGRPCConsumerError(t *testing.T) {
+	conn := startGRPCReceiver(t, consumertest.NewErr(errors.New("consumer error")))
+
+	spans := &zipkin_proto3.ListOfSpans{
+		Spans: []*zipkin_proto3.Span{
+			{
+				TraceId: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+				Id:      []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				Name:    "get",
+			},
+		},
+	}
+	err := reportSpans(context.Background(), conn, spans)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "consumer error")
+}
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	zipkinmodel "github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
+)
+
+// rejectedSpan describes a span of a request which could not be parsed, the
+// trace and span IDs are set when they could be read.
+type rejectedSpan struct {
+	Index   int    `json:"index"`
+	TraceID string `json:"traceId,omitempty"`
+	ID      string `json:"id,omitempty"`
+	Error   string `json:"error"`
+}
+
+// partialResponse is the body of the responses to the requests whose spans
+// were not all accepted.
+type partialResponse struct {
+	Accepted int            `json:"accepted"`
+	Rejected []rejectedSpan `json:"rejected"`
+}
+
+// v2ToTraceSpansPartially parses the Zipkin v2 JSON or Protobuf spans of a
+// request one by one, and returns the spans which could be parsed along with
+// the spans which could not. It returns an error when the request is not a
+// list of spans.
+func (zr *zipkinReceiver) v2ToTraceSpansPartially(blob []byte, hdr http.Header) (ptrace.Traces, []rejectedSpan, error) {
+	var spans []*zipkinmodel.SpanModel
+	var rejected []rejectedSpan
+	var err error
+	if isProtobuf(hdr) {
+		spans, rejected, err = parseProtobufSpans(blob, hdr.Get("X-B3-Flags") == "1")
+	} else {
+		spans, rejected, err = parseJSONSpans(blob)
+	}
+	if err != nil {
+		return ptrace.Traces{}, nil, err
+	}
+
+	// The spans are translated one by one as well, as the translation fails
+	// on invalid span links or events.
+	translator := zipkinv2.ToTranslator{ParseStringTags: zr.config.ParseStringTags}
+	accepted := make([]*zipkinmodel.SpanModel, 0, len(spans))
+	for i, span := range spans {
+		if span == nil {
+			continue
+		}
+		if _, err = translator.ToTraces([]*zipkinmodel.SpanModel{span}); err != nil {
+			rejected = append(rejected, rejectedSpan{
+				Index:   i,
+				TraceID: span.TraceID.String(),
+				ID:      span.ID.String(),
+				Error:   err.Error(),
+			})
+			continue
+		}
+		accepted = append(accepted, span)
+	}
+
+	td, err := translator.ToTraces(accepted)
+	if err != nil {
+		return ptrace.Traces{}, nil, err
+	}
+	return td, rejected, nil
+}
+
+// parseJSONSpans parses a JSON list of spans. The spans which could not be
+// parsed are nil.
+func parseJSONSpans(blob []byte) ([]*zipkinmodel.SpanModel, []rejectedSpan, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(blob, &raw); err != nil {
+		return nil, nil, err
+	}
+
+	spans := make([]*zipkinmodel.SpanModel, len(raw))
+	var rejected []rejectedSpan
+	for i, r := range raw {
+		span := &zipkinmodel.SpanModel{}
+		if err := json.Unmarshal(r, span); err != nil {
+			// Report the IDs of the span as sent, as they may be the reason
+			// why it could not be parsed.
+			var ids struct {
+				TraceID string `json:"traceId"`
+				ID      string `json:"id"`
+			}
+			_ = json.Unmarshal(r, &ids)
+			rejected = append(rejected, rejectedSpan{
+				Index:   i,
+				TraceID: ids.TraceID,
+				ID:      ids.ID,
+				Error:   err.Error(),
+			})
+			continue
+		}
+		spans[i] = span
+	}
+	return spans, rejected, nil
+}
+
+// parseProtobufSpans parses a protobuf list of spans. The spans which could
+// not be parsed are nil.
+func parseProtobufSpans(blob []byte, debugWasSet bool) ([]*zipkinmodel.SpanModel, []rejectedSpan, error) {
+	list := &zipkin_proto3.ListOfSpans{}
+	if err := proto.Unmarshal(blob, list); err != nil {
+		return nil, nil, err
+	}
+
+	spans := make([]*zipkinmodel.SpanModel, len(list.Spans))
+	var rejected []rejectedSpan
+	for i, s := range list.Spans {
+		// zipkin_proto3 only parses lists of spans, so each span is parsed
+		// as a list of its own.
+		single, err := proto.Marshal(&zipkin_proto3.ListOfSpans{Spans: []*zipkin_proto3.Span{s}})
+		if err == nil {
+			var parsed []*zipkinmodel.SpanModel
+			if parsed, err = zipkin_proto3.ParseSpans(single, debugWasSet); err == nil && len(parsed) == 1 {
+				spans[i] = parsed[0]
+				continue
+			}
+		}
+		rejection := rejectedSpan{
+			Index:   i,
+			TraceID: hex.EncodeToString(s.TraceId),
+			ID:      hex.EncodeToString(s.Id),
+		}
+		if err != nil {
+			rejection.Error = err.Error()
+		} else {
+			rejection.Error = "span could not be parsed"
+		}
+		rejected = append(rejected, rejection)
+	}
+	return spans, rejected, nil
+}
+
+// writePartialResponse logs the spans which were rejected along with the
+// client which sent them, and writes them in the response.
+func (zr *zipkinReceiver) writePartialResponse(w http.ResponseWriter, r *http.Request, statusCode int, accepted int, rejected []rejectedSpan) {
+	zr.settings.Logger.Warn("Rejected spans which could not be parsed",
+		zap.String("remote_addr", r.RemoteAddr),
+		zap.String("user_agent", r.UserAgent()),
+		zap.Int("accepted", accepted),
+		zap.Int("rejected", len(rejected)),
+		zap.String("error", rejected[0].Error))
+
+	body, err := json.Marshal(partialResponse{Accepted: accepted, Rejected: rejected})
+	if err != nil {
+		w.WriteHeader(statusCode)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
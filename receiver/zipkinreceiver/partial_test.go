@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	validJSONSpan    = `{"traceId":"4d1e00c0db9010db86154a4ba6e91385","id":"86154a4ba6e91385","name":"get","timestamp":1472470996199000,"duration":207000,"localEndpoint":{"serviceName":"frontend"}}`
+	otherJSONSpan    = `{"traceId":"4d1e00c0db9010db86154a4ba6e91385","id":"4d1e00c0db9010db","parentId":"86154a4ba6e91385","name":"query","timestamp":1472470996250000,"duration":100000,"localEndpoint":{"serviceName":"backend"}}`
+	invalidJSONSpan  = `{"traceId":"xyz","id":"86154a4ba6e91386","name":"broken"}`
+	missingIDSpan    = `{"traceId":"4d1e00c0db9010db86154a4ba6e91385","name":"anonymous"}`
+	partialTestRoute = "/api/v2/spans"
+)
+
+func newPartialTestReceiver(t *testing.T, next *consumertest.TracesSink) *zipkinReceiver {
+	cfg := &Config{
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: "",
+		},
+	}
+	zr, err := newReceiver(cfg, next, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	return zr
+}
+
+func TestReceiverPartialJSON(t *testing.T) {
+	body := "[" + validJSONSpan + "," + invalidJSONSpan + "," + otherJSONSpan + "," + missingIDSpan + "]"
+	r := httptest.NewRequest("POST", partialTestRoute, bytes.NewBufferString(body))
+	r.Header.Add("content-type", "application/json")
+
+	next := new(consumertest.TracesSink)
+	zr := newPartialTestReceiver(t, next)
+
+	resp := httptest.NewRecorder()
+	zr.ServeHTTP(resp, r)
+
+	require.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+
+	var partial partialResponse
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &partial))
+	assert.Equal(t, 2, partial.Accepted)
+	require.Len(t, partial.Rejected, 2)
+	assert.Equal(t, 1, partial.Rejected[0].Index)
+	assert.Equal(t, "xyz", partial.Rejected[0].TraceID)
+	assert.Equal(t, "86154a4ba6e91386", partial.Rejected[0].ID)
+	assert.NotEmpty(t, partial.Rejected[0].Error)
+	assert.Equal(t, 3, partial.Rejected[1].Index)
+	assert.Equal(t, "4d1e00c0db9010db86154a4ba6e91385", partial.Rejected[1].TraceID)
+	assert.Empty(t, partial.Rejected[1].ID)
+
+	assert.Equal(t, 2, next.SpanCount())
+}
+
+func TestReceiverPartialJSONAllRejected(t *testing.T) {
+	body := "[" + invalidJSONSpan + "]"
+	r := httptest.NewRequest("POST", partialTestRoute, bytes.NewBufferString(body))
+	r.Header.Add("content-type", "application/json")
+
+	next := new(consumertest.TracesSink)
+	zr := newPartialTestReceiver(t, next)
+
+	resp := httptest.NewRecorder()
+	zr.ServeHTTP(resp, r)
+
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+
+	var partial partialResponse
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &partial))
+	assert.Equal(t, 0, partial.Accepted)
+	require.Len(t, partial.Rejected, 1)
+	assert.Equal(t, 0, partial.Rejected[0].Index)
+
+	assert.Equal(t, 0, next.SpanCount())
+}
+
+func TestReceiverPartialProtobuf(t *testing.T) {
+	list := &zipkin_proto3.ListOfSpans{
+		Spans: []*zipkin_proto3.Span{
+			{
+				TraceId:   []byte{0x4d, 0x1e, 0x00, 0xc0, 0xdb, 0x90, 0x10, 0xdb, 0x86, 0x15, 0x4a, 0x4b, 0xa6, 0xe9, 0x13, 0x85},
+				Id:        []byte{0x86, 0x15, 0x4a, 0x4b, 0xa6, 0xe9, 0x13, 0x85},
+				Name:      "get",
+				Timestamp: 1472470996199000,
+				Duration:  207000,
+				LocalEndpoint: &zipkin_proto3.Endpoint{
+					ServiceName: "frontend",
+				},
+			},
+			{
+				TraceId: []byte{0x01, 0x02, 0x03},
+				Id:      []byte{0x86, 0x15, 0x4a, 0x4b, 0xa6, 0xe9, 0x13, 0x86},
+				Name:    "broken",
+			},
+		},
+	}
+	body, err := proto.Marshal(list)
+	require.NoError(t, err)
+
+	r := httptest.NewRequest("POST", partialTestRoute, bytes.NewBuffer(body))
+	r.Header.Add("content-type", "application/protobuf")
+
+	next := new(consumertest.TracesSink)
+	zr := newPartialTestReceiver(t, next)
+
+	resp := httptest.NewRecorder()
+	zr.ServeHTTP(resp, r)
+
+	require.Equal(t, http.StatusAccepted, resp.Code)
+
+	var partial partialResponse
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &partial))
+	assert.Equal(t, 1, partial.Accepted)
+	require.Len(t, partial.Rejected, 1)
+	assert.Equal(t, 1, partial.Rejected[0].Index)
+	assert.Equal(t, "010203", partial.Rejected[0].TraceID)
+	assert.Equal(t, "86154a4ba6e91386", partial.Rejected[0].ID)
+
+	assert.Equal(t, 1, next.SpanCount())
+}
+
+func TestIsProtobuf(t *testing.T) {
+	tests := []struct {
+		contentType string
+		expected    bool
+	}{
+		{contentType: "application/x-protobuf", expected: true},
+		{contentType: "application/protobuf", expected: true},
+		{contentType: "application/x-protobuf; charset=utf-8", expected: true},
+		{contentType: "application/json", expected: false},
+		{contentType: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			hdr := http.Header{}
+			hdr.Set("Content-Type", tt.contentType)
+			assert.Equal(t, tt.expected, isProtobuf(hdr))
+		})
+	}
+}
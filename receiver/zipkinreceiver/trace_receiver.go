@@ -9,17 +9,26 @@ import (
 	"context"
 	"errors"
 	"io"
+	"mime"
 	"net"
 	"net/http"
 	"strings"
 	"sync"
 
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver"
-
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpdecompressionextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv1"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
 )
@@ -29,6 +38,7 @@ const (
 	receiverTransportV1JSON   = "http_v1_json"
 	receiverTransportV2JSON   = "http_v2_json"
 	receiverTransportV2PROTO  = "http_v2_proto"
+	receiverTransportV2GRPC   = "grpc_v2_proto"
 )
 
 var errNextConsumerRespBody = []byte(`"Internal Server Error"`)
@@ -39,6 +49,7 @@ type zipkinReceiver struct {
 
 	shutdownWG sync.WaitGroup
 	server     *http.Server
+	grpc       *grpc.Server
 	config     *Config
 
 	v1ThriftUnmarshaler      ptrace.Unmarshaler
@@ -52,6 +63,7 @@ type zipkinReceiver struct {
 }
 
 var _ http.Handler = (*zipkinReceiver)(nil)
+var _ spanServiceServer = (*zipkinReceiver)(nil)
 
 // newReceiver creates a new zipkinReceiver reference.
 func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver.CreateSettings) (*zipkinReceiver, error) {
@@ -59,7 +71,7 @@ func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver
 		return nil, component.ErrNilNextConsumer
 	}
 
-	transports := []string{receiverTransportV1Thrift, receiverTransportV1JSON, receiverTransportV2JSON, receiverTransportV2PROTO}
+	transports := []string{receiverTransportV1Thrift, receiverTransportV1JSON, receiverTransportV2JSON, receiverTransportV2PROTO, receiverTransportV2GRPC}
 	obsrecvrs := make(map[string]*obsreport.Receiver)
 	for _, transport := range transports {
 		obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
@@ -87,7 +99,7 @@ func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver
 	return zr, nil
 }
 
-// Start spins up the receiver's HTTP server and makes the receiver start its processing.
+// Start spins up the receiver's HTTP and gRPC servers and makes the receiver start its processing.
 func (zr *zipkinReceiver) Start(_ context.Context, host component.Host) error {
 	if host == nil {
 		return errors.New("nil host")
@@ -98,6 +110,9 @@ func (zr *zipkinReceiver) Start(_ context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	if err = httpdecompressionextension.WrapServer(host, zr.config.Decompression, zr.server); err != nil {
+		return err
+	}
 
 	var listener net.Listener
 	listener, err = zr.config.HTTPServerSettings.ToListener()
@@ -113,6 +128,36 @@ func (zr *zipkinReceiver) Start(_ context.Context, host component.Host) error {
 		}
 	}()
 
+	if zr.config.GRPC != nil {
+		if err = zr.startGRPCServer(host); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (zr *zipkinReceiver) startGRPCServer(host component.Host) error {
+	var err error
+	zr.grpc, err = zr.config.GRPC.ToServer(host, zr.settings.TelemetrySettings)
+	if err != nil {
+		return err
+	}
+	zr.grpc.RegisterService(&spanServiceDesc, zr)
+
+	var listener net.Listener
+	listener, err = zr.config.GRPC.ToListener()
+	if err != nil {
+		return err
+	}
+	zr.shutdownWG.Add(1)
+	go func() {
+		defer zr.shutdownWG.Done()
+
+		if errGRPC := zr.grpc.Serve(listener); !errors.Is(errGRPC, grpc.ErrServerStopped) && errGRPC != nil {
+			host.ReportFatalError(errGRPC)
+		}
+	}()
 	return nil
 }
 
@@ -134,7 +179,7 @@ func (zr *zipkinReceiver) v2ToTraceSpans(blob []byte, hdr http.Header) (reqs ptr
 	unmarshaler := zr.jsonUnmarshaler
 
 	// Zipkin can send protobuf via http
-	if hdr.Get("Content-Type") == "application/x-protobuf" {
+	if isProtobuf(hdr) {
 		// TODO: (@odeke-em) record the unique types of Content-Type uploads
 		if debugWasSet {
 			unmarshaler = zr.protobufDebugUnmarshaler
@@ -146,14 +191,28 @@ func (zr *zipkinReceiver) v2ToTraceSpans(blob []byte, hdr http.Header) (reqs ptr
 	return unmarshaler.UnmarshalTraces(blob)
 }
 
+// isProtobuf returns whether the Content-Type of a request is protobuf, as
+// sent by Zipkin clients with either application/x-protobuf or
+// application/protobuf.
+func isProtobuf(hdr http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(hdr.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/x-protobuf" || mediaType == "application/protobuf"
+}
+
 // Shutdown tells the receiver that should stop reception,
 // giving it a chance to perform any necessary clean-up and shutting down
-// its HTTP server.
+// its HTTP and gRPC servers.
 func (zr *zipkinReceiver) Shutdown(context.Context) error {
 	var err error
 	if zr.server != nil {
 		err = zr.server.Close()
 	}
+	if zr.grpc != nil {
+		zr.grpc.GracefulStop()
+	}
 	zr.shutdownWG.Wait()
 	return err
 }
@@ -220,17 +279,30 @@ func (zr *zipkinReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	_ = r.Body.Close()
 
 	var td ptrace.Traces
+	var rejected []rejectedSpan
 	var err error
 	if asZipkinv1 {
 		td, err = zr.v1ToTraceSpans(slurp, r.Header)
 	} else {
 		td, err = zr.v2ToTraceSpans(slurp, r.Header)
+		if err != nil {
+			// Accept the spans which can be parsed rather than failing the
+			// whole request, and report the others to the client.
+			var partialErr error
+			if td, rejected, partialErr = zr.v2ToTraceSpansPartially(slurp, r.Header); partialErr == nil {
+				err = nil
+			}
+		}
 	}
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if len(rejected) > 0 && td.SpanCount() == 0 {
+		zr.writePartialResponse(w, r, http.StatusBadRequest, 0, rejected)
+		return
+	}
 
 	consumerErr := zr.nextConsumer.ConsumeTraces(ctx, td)
 
@@ -249,6 +321,10 @@ func (zr *zipkinReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Finally send back the response "Accepted" as
 	// required at https://zipkin.io/zipkin-api/#/default/post_spans
+	if len(rejected) > 0 {
+		zr.writePartialResponse(w, r, http.StatusAccepted, td.SpanCount(), rejected)
+		return
+	}
 	w.WriteHeader(http.StatusAccepted)
 }
 
@@ -259,8 +335,38 @@ func transportType(r *http.Request, asZipkinv1 bool) string {
 		}
 		return receiverTransportV1JSON
 	}
-	if r.Header.Get("Content-Type") == "application/x-protobuf" {
+	if isProtobuf(r.Header) {
 		return receiverTransportV2PROTO
 	}
 	return receiverTransportV2JSON
 }
+
+// Report implements the Zipkin SpanService, it receives the spans sent with gRPC
+// and sends them along to the nextConsumer.
+func (zr *zipkinReceiver) Report(ctx context.Context, spans *zipkin_proto3.ListOfSpans) (*emptypb.Empty, error) {
+	obsrecv := zr.obsrecvrs[receiverTransportV2GRPC]
+	ctx = obsrecv.StartTracesOp(ctx)
+
+	// The translator parses the protobuf encoding of the spans, as sent over HTTP.
+	blob, err := proto.Marshal(spans)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	unmarshaler := zr.protobufUnmarshaler
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if flags := md.Get("x-b3-flags"); len(flags) > 0 && flags[0] == "1" {
+			unmarshaler = zr.protobufDebugUnmarshaler
+		}
+	}
+	td, err := unmarshaler.UnmarshalTraces(blob)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	consumerErr := zr.nextConsumer.ConsumeTraces(ctx, td)
+	obsrecv.EndTracesOp(ctx, zipkinV2TagValue, td.SpanCount(), consumerErr)
+	if consumerErr != nil {
+		return nil, consumerErr
+	}
+	return &emptypb.Empty{}, nil
+}